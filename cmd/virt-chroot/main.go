@@ -5,7 +5,6 @@ import (
 	"os"
 	"runtime"
 	"strconv"
-	"strings"
 	"syscall"
 
 	"github.com/spf13/cobra"
@@ -65,50 +64,7 @@ func main() {
 		},
 	}
 
-	mntCmd := &cobra.Command{
-		Use:   "mount",
-		Short: "mount operations in a specific mount namespace",
-		Args:  cobra.MinimumNArgs(2),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			var mntOpts uint = 0
-
-			fsType := cmd.Flag("type").Value.String()
-			mntOptions := cmd.Flag("options").Value.String()
-			for _, opt := range strings.Split(mntOptions, ",") {
-				opt = strings.TrimSpace(opt)
-				switch opt {
-				case "ro":
-					mntOpts = mntOpts | syscall.MS_RDONLY
-				case "bind":
-					mntOpts = mntOpts | syscall.MS_BIND
-				default:
-					return fmt.Errorf("mount option %s is not supported", opt)
-				}
-			}
-
-			// Ensure that sourceFile is a real path. It will be kept open until used
-			// by the syscall via the file descriptor path in proc (SafePath) to ensure
-			// that no symlink injection can happen after the check.
-			sourceFile, err := safepath.NewFileNoFollow(args[0])
-			if err != nil {
-				return fmt.Errorf("mount source invalid: %v", err)
-			}
-			defer sourceFile.Close()
-
-			// Ensure that targetFile is a real path. It will be kept open until used
-			// by the syscall via the file descriptor path in proc (SafePath) to ensure
-			// that no symlink injection can happen after the check.
-			targetFile, err := safepath.NewFileNoFollow(args[1])
-			if err != nil {
-				return fmt.Errorf("mount target invalid: %v", err)
-			}
-			defer targetFile.Close()
-
-			return syscall.Mount(sourceFile.SafePath(), targetFile.SafePath(), fsType, uintptr(mntOpts), "")
-		},
-	}
-	mntCmd.Flags().StringP("options", "o", "", "comma separated list of mount options")
-	mntCmd.Flags().StringP("type", "t", "", "fstype")
+	mntCmd := NewMountCommand()
 
 	umntCmd := &cobra.Command{
 		Use:   "umount",
@@ -0,0 +1,240 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/sys/unix"
+
+	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/unsafepath"
+)
+
+// fstab-style source of mounts which only change the propagation of the target
+const noneSource = "none"
+
+var (
+	mountOperations = map[string]uintptr{
+		"bind":  unix.MS_BIND,
+		"rbind": unix.MS_BIND | unix.MS_REC,
+		"move":  unix.MS_MOVE,
+	}
+	mountFlags = map[string]uintptr{
+		"defaults": 0,
+		"rw":       0,
+		"ro":       unix.MS_RDONLY,
+		"nosuid":   unix.MS_NOSUID,
+		"nodev":    unix.MS_NODEV,
+		"noexec":   unix.MS_NOEXEC,
+	}
+	mountPropagations = map[string]uintptr{
+		"shared":      unix.MS_SHARED,
+		"rshared":     unix.MS_SHARED | unix.MS_REC,
+		"slave":       unix.MS_SLAVE,
+		"rslave":      unix.MS_SLAVE | unix.MS_REC,
+		"private":     unix.MS_PRIVATE,
+		"rprivate":    unix.MS_PRIVATE | unix.MS_REC,
+		"unbindable":  unix.MS_UNBINDABLE,
+		"runbindable": unix.MS_UNBINDABLE | unix.MS_REC,
+	}
+)
+
+type mountOptions struct {
+	operation   uintptr
+	flags       uintptr
+	propagation uintptr
+}
+
+// parseMountOptions parses a comma separated list of fstab-style mount options,
+// rejecting unknown, repeated and conflicting options.
+func parseMountOptions(options string) (*mountOptions, error) {
+	opts := &mountOptions{}
+	if options == "" {
+		return opts, nil
+	}
+
+	var operation, propagation string
+	seen := map[string]bool{}
+	for _, opt := range strings.Split(options, ",") {
+		opt = strings.TrimSpace(opt)
+		if seen[opt] {
+			return nil, fmt.Errorf("mount option %q is specified more than once", opt)
+		}
+		seen[opt] = true
+
+		if flag, ok := mountOperations[opt]; ok {
+			if operation != "" {
+				return nil, fmt.Errorf("mount options %q and %q conflict", operation, opt)
+			}
+			operation = opt
+			opts.operation = flag
+		} else if flag, ok := mountPropagations[opt]; ok {
+			if propagation != "" {
+				return nil, fmt.Errorf("mount options %q and %q conflict", propagation, opt)
+			}
+			propagation = opt
+			opts.propagation = flag
+		} else if flag, ok := mountFlags[opt]; ok {
+			opts.flags |= flag
+		} else {
+			return nil, fmt.Errorf("mount option %s is not supported", opt)
+		}
+	}
+
+	if seen["ro"] && seen["rw"] {
+		return nil, fmt.Errorf("mount options \"ro\" and \"rw\" conflict")
+	}
+	if operation == "move" && opts.flags != 0 {
+		return nil, fmt.Errorf("mount option \"move\" can't be combined with mount flags")
+	}
+	return opts, nil
+}
+
+// isBind tells if the mount is a bind mount, whose flags are applied by a remount
+func (o *mountOptions) isBind() bool {
+	return o.operation&unix.MS_BIND != 0
+}
+
+// onlyPropagation tells if the mount only changes the propagation of the target
+func (o *mountOptions) onlyPropagation(fsType string) bool {
+	return o.operation == 0 && fsType == "" && o.propagation != 0
+}
+
+// resolvePath resolves the links in path. The resolved path is link-free, which lets the
+// mount refuse links which show up after the prefixes were checked.
+func resolvePath(path string) (string, error) {
+	resolved, err := safepath.JoinAndResolveWithRelativeRoot("/", path)
+	if err != nil {
+		return "", err
+	}
+	return unsafepath.UnsafeAbsolute(resolved.Raw()), nil
+}
+
+// validatePathPrefix ensures that the resolved path is one of the prefixes or below them.
+// No prefixes allow any path.
+func validatePathPrefix(path string, prefixes []string) error {
+	if len(prefixes) == 0 {
+		return nil
+	}
+	for _, prefix := range prefixes {
+		// Prefixes are resolved as well, as the node may link them elsewhere, like /var/run to /run
+		if resolved, err := resolvePath(prefix); err == nil {
+			prefix = resolved
+		}
+		prefix = filepath.Clean(prefix)
+		if path == prefix || strings.HasPrefix(path, strings.TrimSuffix(prefix, "/")+"/") {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not below any of the allowed prefixes %v", path, prefixes)
+}
+
+// remount applies the flags or the propagation to the mount on top of the target.
+// The target is opened again, the file descriptor held before mounting refers to
+// the underlying mount.
+func remount(target string, flags uintptr) error {
+	targetPath, err := safepath.NewPathNoFollow(target)
+	if err != nil {
+		return fmt.Errorf("mount target invalid: %v", err)
+	}
+	return targetPath.ExecuteNoFollow(func(safePath string) error {
+		return unix.Mount("", safePath, "", flags, "")
+	})
+}
+
+func mount(source, target, fsType string, opts *mountOptions) error {
+	if !opts.onlyPropagation(fsType) {
+		// Ensure that sourceFile is a real path. It will be kept open until used
+		// by the syscall via the file descriptor path in proc (SafePath) to ensure
+		// that no symlink injection can happen after the check.
+		sourceFile, err := safepath.NewFileNoFollow(source)
+		if err != nil {
+			return fmt.Errorf("mount source invalid: %v", err)
+		}
+		defer sourceFile.Close()
+
+		// Ensure that targetFile is a real path. It will be kept open until used
+		// by the syscall via the file descriptor path in proc (SafePath) to ensure
+		// that no symlink injection can happen after the check.
+		targetFile, err := safepath.NewFileNoFollow(target)
+		if err != nil {
+			return fmt.Errorf("mount target invalid: %v", err)
+		}
+		defer targetFile.Close()
+
+		flags := opts.operation
+		if !opts.isBind() {
+			flags |= opts.flags
+		}
+		if err := unix.Mount(sourceFile.SafePath(), targetFile.SafePath(), fsType, flags, ""); err != nil {
+			return err
+		}
+	}
+
+	// The kernel ignores the flags of a new bind mount, like mount(8) they are applied by a remount
+	if opts.isBind() && opts.flags != 0 {
+		if err := remount(target, unix.MS_REMOUNT|unix.MS_BIND|opts.flags); err != nil {
+			return fmt.Errorf("failed to apply the mount flags: %v", err)
+		}
+	}
+	if opts.propagation != 0 {
+		if err := remount(target, opts.propagation); err != nil {
+			return fmt.Errorf("failed to change the mount propagation: %v", err)
+		}
+	}
+	return nil
+}
+
+func NewMountCommand() *cobra.Command {
+	var sourcePrefixes, targetPrefixes []string
+
+	mntCmd := &cobra.Command{
+		Use:   "mount",
+		Short: "mount operations in a specific mount namespace",
+		Example: "virt-chroot mount -o ro,bind <source> <target>\n" +
+			"virt-chroot mount -o move <source> <target>\n" +
+			"virt-chroot mount -o rslave none <target>",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			source, target := args[0], args[1]
+			fsType := cmd.Flag("type").Value.String()
+
+			opts, err := parseMountOptions(cmd.Flag("options").Value.String())
+			if err != nil {
+				return err
+			}
+
+			if opts.onlyPropagation(fsType) {
+				if source != noneSource {
+					return fmt.Errorf("the source of a propagation change must be %q", noneSource)
+				}
+			} else if opts.operation == 0 && fsType == "" {
+				return fmt.Errorf("either a bind, rbind or move mount option, or a fstype is required")
+			} else {
+				// Links below an allowed prefix could point anywhere, the prefixes are checked on the resolved paths
+				if source, err = resolvePath(source); err != nil {
+					return fmt.Errorf("mount source invalid: %v", err)
+				}
+				if err := validatePathPrefix(source, sourcePrefixes); err != nil {
+					return fmt.Errorf("mount source invalid: %v", err)
+				}
+			}
+			if target, err = resolvePath(target); err != nil {
+				return fmt.Errorf("mount target invalid: %v", err)
+			}
+			if err := validatePathPrefix(target, targetPrefixes); err != nil {
+				return fmt.Errorf("mount target invalid: %v", err)
+			}
+
+			return mount(source, target, fsType, opts)
+		},
+	}
+	mntCmd.Flags().StringP("options", "o", "", "comma separated list of fstab-style mount options")
+	mntCmd.Flags().StringP("type", "t", "", "fstype")
+	mntCmd.Flags().StringSliceVar(&sourcePrefixes, "source-prefix", nil, "path prefixes the source is allowed to be below")
+	mntCmd.Flags().StringSliceVar(&targetPrefixes, "target-prefix", nil, "path prefixes the target is allowed to be below")
+
+	return mntCmd
+}
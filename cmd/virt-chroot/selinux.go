@@ -41,7 +41,7 @@ func NewGetEnforceCommand() *cobra.Command {
 func RelabelCommand() *cobra.Command {
 	relabelCommad := &cobra.Command{
 		Use:       "relabel",
-		Short:     "relabel a file with the given selinux label, if the path is not labeled like this already",
+		Short:     "relabel a file, socket or device node with the given selinux label, if the path is not labeled like this already",
 		Example:   "virt-chroot selinux relabel <new-label> <file-path>",
 		ValidArgs: nil,
 		Args:      cobra.ExactArgs(2),
@@ -68,8 +68,10 @@ func RelabelCommand() *cobra.Command {
 
 			if fileInfo, err := safepath.StatAtNoFollow(safePath); err != nil {
 				return fmt.Errorf("could not stat file %v. Reason: %v", safePath, err)
-			} else if (fileInfo.Mode() & os.ModeSocket) != 0 {
-				return relabelUnixSocket(filePath, label)
+			} else if (fileInfo.Mode() & (os.ModeSocket | os.ModeDevice)) != 0 {
+				// Opening a device node opens the device, which fails or blocks when it is
+				// in use, e.g. a hotplugged block device attached to QEMU
+				return relabelWithoutOpening(filePath, label)
 			}
 
 			writeableFD, err := os.OpenFile(filePath, os.O_APPEND|unix.S_IWRITE, os.ModePerm)
@@ -115,7 +117,8 @@ func getLabel(file *os.File) (string, error) {
 	return string(buffer[:labelLength]), nil
 }
 
-func relabelUnixSocket(filePath, label string) error {
+// relabelWithoutOpening relabels sockets and device nodes through the path of their O_PATH file descriptor
+func relabelWithoutOpening(filePath, label string) error {
 	if currentLabel, err := selinux.FileLabel(filePath); err != nil {
 		return fmt.Errorf("could not retrieve label of file %s. Reason: %v", filePath, err)
 	} else if currentLabel != label {
@@ -48,6 +48,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/hooks"
 	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
 	"kubevirt.io/kubevirt/pkg/ignition"
+	"kubevirt.io/kubevirt/pkg/network/vhostuser"
 	putil "kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
@@ -226,6 +227,13 @@ func initializeDirs(ephemeralDiskDir string,
 	if err != nil {
 		panic(err)
 	}
+
+	// The volume of the channel sockets is only mounted when the VMI has channels
+	if _, err := os.Stat(putil.VirtChannelsDir); err == nil {
+		if err := virtlauncher.InitializeDisksDirectories(putil.VirtChannelsDir); err != nil {
+			panic(err)
+		}
+	}
 }
 
 func detectDomainWithUUID(domainManager virtwrap.DomainManager) *api.Domain {
@@ -349,6 +357,8 @@ func main() {
 	allowEmulation := pflag.Bool("allow-emulation", false, "Allow use of software emulation as fallback")
 	runWithNonRoot := pflag.Bool("run-as-nonroot", false, "Run virtqemud with the 'virt' user")
 	imageVolumeEnabled := pflag.Bool("image-volume", false, "Generated with ImageVolume instead of containerDisk") //remove this once ImageVolume is GAed
+	imageIntegrityCheck := pflag.Bool("image-integrity-check", false, "Periodically check the containerDisk and ephemeral images for corruption")
+	imageIntegrityCheckInterval := pflag.Duration("image-integrity-check-interval", ephemeraldisk.DefaultIntegrityCheckInterval, "Interval between consecutive integrity checks of the containerDisk and ephemeral images")
 	hookSidecars := pflag.Uint("hook-sidecars", 0, "Number of requested hook sidecars, virt-launcher will wait for all of them to become available")
 	diskMemoryLimitBytes := pflag.Int64("disk-memory-limit", virtconfig.DefaultDiskVerificationMemoryLimitBytes, "Memory limit for disk verification")
 	ovmfPath := pflag.String("ovmf-path", "/usr/share/OVMF", "The directory that contains the EFI roms (like OVMF_CODE.fd)")
@@ -422,6 +432,8 @@ func main() {
 	defer domainConn.Close()
 
 	var agentStore = agentpoller.NewAsyncAgentStore()
+	// Guest agent commands which are not allowed by the VMI are refused for every user of the connection
+	domainConn = agentpoller.FilterAgentCommands(domainConn, &agentStore)
 
 	notifier := notifyclient.NewNotifier(*virtShareDir)
 	defer notifier.Close()
@@ -462,6 +474,26 @@ func main() {
 	// Send domain notifications to virt-handler
 	startDomainEventMonitoring(notifier, domainConn, events, vmi, domainName, &agentStore, *qemuAgentSysInterval, *qemuAgentFileInterval, *qemuAgentUserInterval, *qemuAgentVersionInterval, *qemuAgentFSFreezeStatusInterval, metadataCache)
 
+	if *imageIntegrityCheck {
+		integrityChecker := ephemeraldisk.NewIntegrityChecker(filepath.Join(*ephemeralDiskDir, "disk-data"), *imageIntegrityCheckInterval, *diskMemoryLimitBytes,
+			func(result api.ImageIntegrityMetadata) { metadataCache.ImageIntegrity.Store(result) })
+		go integrityChecker.Run(stopChan)
+	}
+
+	if vhostuser.MayHaveInterfaces(vmi.Spec.Domain.Devices.Interfaces) {
+		vhostUserMonitor := vhostuser.NewMonitor(vhostuser.DefaultMonitorInterval,
+			func() (*api.DomainSpec, error) {
+				dom, err := domainConn.LookupDomainByName(domainName)
+				if err != nil {
+					return nil, err
+				}
+				defer dom.Free()
+				return util.GetDomainSpecWithFlags(dom, 0)
+			},
+			func(result api.VhostUserMetadata) { metadataCache.VhostUser.Store(result) })
+		go vhostUserMonitor.Run(stopChan)
+	}
+
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt,
 		syscall.SIGHUP,
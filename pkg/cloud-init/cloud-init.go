@@ -32,12 +32,14 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"sigs.k8s.io/yaml"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 	"kubevirt.io/client-go/precond"
 
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+	"kubevirt.io/kubevirt/pkg/guesttime"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/net/dns"
 )
@@ -73,6 +75,7 @@ type CloudInitData struct {
 	ConfigDriveMetaData *ConfigDriveMetadata
 	UserData            string
 	NetworkData         string
+	VendorData          string
 	DevicesData         *[]DeviceData
 	VolumeName          string
 }
@@ -144,6 +147,9 @@ func ReadCloudInitVolumeDataSource(vmi *v1.VirtualMachineInstance, secretSourceD
 			cloudInitData, err = readCloudInitNoCloudSource(volume.CloudInitNoCloud)
 			cloudInitData.NoCloudMetaData = readCloudInitNoCloudMetaData(hostname, cloudInitUUIDFromVMI(vmi), instancetype, keys)
 			cloudInitData.VolumeName = volume.Name
+			if err == nil {
+				cloudInitData.VendorData, err = ntpVendorData(guesttime.NTPServers(vmi))
+			}
 			return cloudInitData, err
 		}
 		if volume.CloudInitConfigDrive != nil {
@@ -157,12 +163,34 @@ func ReadCloudInitVolumeDataSource(vmi *v1.VirtualMachineInstance, secretSourceD
 			cloudInitData, err = readCloudInitConfigDriveSource(volume.CloudInitConfigDrive)
 			cloudInitData.ConfigDriveMetaData = readCloudInitConfigDriveMetaData(vmi.Name, uuid, hostname, vmi.Namespace, keys, instancetype)
 			cloudInitData.VolumeName = volume.Name
+			if err == nil {
+				cloudInitData.VendorData, err = ntpVendorData(guesttime.NTPServers(vmi))
+			}
 			return cloudInitData, err
 		}
 	}
 	return nil, nil
 }
 
+// ntpVendorData returns the cloud-config setting the NTP servers of the cluster time policy.
+// It is passed as vendor data, so that the user data can still override it.
+func ntpVendorData(servers []string) (string, error) {
+	if len(servers) == 0 {
+		return "", nil
+	}
+
+	cloudConfig, err := yaml.Marshal(map[string]interface{}{
+		"ntp": map[string]interface{}{
+			"enabled": true,
+			"servers": servers,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return "#cloud-config\n" + string(cloudConfig), nil
+}
+
 func isNoCloudAccessCredential(accessCred v1.AccessCredential) bool {
 	return accessCred.SSHPublicKey != nil && accessCred.SSHPublicKey.PropagationMethod.NoCloud != nil
 }
@@ -576,13 +604,14 @@ func GenerateLocalData(vmi *v1.VirtualMachineInstance, instanceType string, data
 	domainBasePath := getDomainBasePath(vmi.Name, vmi.Namespace)
 	dataBasePath := fmt.Sprintf("%s/data", domainBasePath)
 
-	var dataPath, metaFile, userFile, networkFile, iso, isoStaging string
+	var dataPath, metaFile, userFile, networkFile, vendorFile, iso, isoStaging string
 	switch data.DataSource {
 	case DataSourceNoCloud:
 		dataPath = dataBasePath
 		metaFile = fmt.Sprintf("%s/%s", dataPath, "meta-data")
 		userFile = fmt.Sprintf("%s/%s", dataPath, "user-data")
 		networkFile = fmt.Sprintf("%s/%s", dataPath, "network-config")
+		vendorFile = fmt.Sprintf("%s/%s", dataPath, "vendor-data")
 		iso = GetIsoFilePath(DataSourceNoCloud, vmi.Name, vmi.Namespace)
 		isoStaging = fmt.Sprintf(isoStagingFmt, iso)
 		if data.NoCloudMetaData == nil {
@@ -601,6 +630,7 @@ func GenerateLocalData(vmi *v1.VirtualMachineInstance, instanceType string, data
 		metaFile = fmt.Sprintf("%s/%s", dataPath, "meta_data.json")
 		userFile = fmt.Sprintf("%s/%s", dataPath, "user_data")
 		networkFile = fmt.Sprintf("%s/%s", dataPath, "network_data.json")
+		vendorFile = fmt.Sprintf("%s/%s", dataPath, "vendor_data.json")
 		iso = GetIsoFilePath(DataSourceConfigDrive, vmi.Name, vmi.Namespace)
 		isoStaging = fmt.Sprintf(isoStagingFmt, iso)
 		if data.ConfigDriveMetaData == nil {
@@ -638,7 +668,12 @@ func GenerateLocalData(vmi *v1.VirtualMachineInstance, instanceType string, data
 		networkData = []byte(data.NetworkData)
 	}
 
-	err = diskutils.RemoveFilesIfExist(userFile, metaFile, networkFile, isoStaging)
+	vendorData, err := formatVendorData(data.DataSource, data.VendorData)
+	if err != nil {
+		return err
+	}
+
+	err = diskutils.RemoveFilesIfExist(userFile, metaFile, networkFile, vendorFile, isoStaging)
 	if err != nil {
 		return err
 	}
@@ -663,6 +698,14 @@ func GenerateLocalData(vmi *v1.VirtualMachineInstance, instanceType string, data
 		defer os.Remove(networkFile)
 	}
 
+	if len(vendorData) > 0 {
+		err = os.WriteFile(vendorFile, vendorData, 0600)
+		if err != nil {
+			return err
+		}
+		defer os.Remove(vendorFile)
+	}
+
 	switch data.DataSource {
 	case DataSourceNoCloud:
 		err = cloudInitIsoFunc(isoStaging, "cidata", dataBasePath)
@@ -686,3 +729,15 @@ func GenerateLocalData(vmi *v1.VirtualMachineInstance, instanceType string, data
 	log.Log.V(2).Infof("generated nocloud iso file %s", iso)
 	return nil
 }
+
+// formatVendorData returns the content of the vendor data file of the data source.
+// ConfigDrive expects a JSON document holding the cloud-config under the cloud-init key.
+func formatVendorData(source DataSourceType, vendorData string) ([]byte, error) {
+	if vendorData == "" {
+		return nil, nil
+	}
+	if source == DataSourceConfigDrive {
+		return json.Marshal(map[string]string{"cloud-init": vendorData})
+	}
+	return []byte(vendorData), nil
+}
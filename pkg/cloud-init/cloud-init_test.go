@@ -612,6 +612,66 @@ var _ = Describe("CloudInit", func() {
 		})
 	})
 
+	Describe("NTP servers of the guest time policy", func() {
+		const expectedVendorData = "#cloud-config\nntp:\n  enabled: true\n  servers:\n  - 10.0.0.1\n  - 10.0.0.2\n"
+		var vendorDataFiles map[string]string
+
+		BeforeEach(func() {
+			vendorDataFiles = map[string]string{}
+			isoCreationFunc = func(isoOutFile, _ string, inDir string) error {
+				for _, file := range []string{"vendor-data", "openstack/latest/vendor_data.json"} {
+					if content, err := os.ReadFile(filepath.Join(inDir, file)); err == nil {
+						vendorDataFiles[file] = string(content)
+					}
+				}
+				_, err := os.Create(isoOutFile)
+				return err
+			}
+		})
+
+		newVMI := func(volumeSource v1.VolumeSource) *v1.VirtualMachineInstance {
+			vmi := createEmptyVMIWithVolumes([]v1.Volume{{Name: "cloudinit", VolumeSource: volumeSource}})
+			vmi.Name = "fake-domain"
+			vmi.Namespace = "fake-namespace"
+			vmi.Annotations = map[string]string{v1.GuestNTPServersAnnotation: "10.0.0.1,10.0.0.2"}
+			return vmi
+		}
+
+		It("should not pass vendor data without NTP servers", func() {
+			vmi := newVMI(v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: "fake"}})
+			vmi.Annotations = nil
+
+			cloudInitData, err := ReadCloudInitVolumeDataSource(vmi, tmpDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cloudInitData.VendorData).To(BeEmpty())
+			Expect(GenerateLocalData(vmi, "", cloudInitData)).To(Succeed())
+			Expect(vendorDataFiles).To(BeEmpty())
+		})
+
+		It("should pass the NTP servers as NoCloud vendor data", func() {
+			vmi := newVMI(v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: "fake"}})
+
+			cloudInitData, err := ReadCloudInitVolumeDataSource(vmi, tmpDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cloudInitData.VendorData).To(Equal(expectedVendorData))
+			Expect(GenerateLocalData(vmi, "", cloudInitData)).To(Succeed())
+			Expect(vendorDataFiles).To(Equal(map[string]string{"vendor-data": expectedVendorData}))
+		})
+
+		It("should pass the NTP servers as ConfigDrive vendor data", func() {
+			vmi := newVMI(v1.VolumeSource{CloudInitConfigDrive: &v1.CloudInitConfigDriveSource{UserData: "fake"}})
+
+			cloudInitData, err := ReadCloudInitVolumeDataSource(vmi, tmpDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(GenerateLocalData(vmi, "", cloudInitData)).To(Succeed())
+			Expect(vendorDataFiles).To(HaveKey("openstack/latest/vendor_data.json"))
+
+			vendorData := map[string]string{}
+			Expect(json.Unmarshal([]byte(vendorDataFiles["openstack/latest/vendor_data.json"]), &vendorData)).To(Succeed())
+			Expect(vendorData).To(Equal(map[string]string{"cloud-init": expectedVendorData}))
+		})
+	})
+
 	Describe("PrepareLocalPath", func() {
 		It("should create the correct directory structure", func() {
 			namespace := "fake-namespace"
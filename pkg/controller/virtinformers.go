@@ -62,6 +62,8 @@ import (
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	"kubevirt.io/api/migrations"
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/api/overcommit"
+	overcommitv1 "kubevirt.io/api/overcommit/v1alpha1"
 	poolv1 "kubevirt.io/api/pool/v1beta1"
 	"kubevirt.io/api/snapshot"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
@@ -149,6 +151,12 @@ type KubeInformerFactory interface {
 	// Watches MigrationPolicy objects
 	MigrationPolicy() cache.SharedIndexInformer
 
+	// Watches OvercommitProfile objects
+	OvercommitProfile() cache.SharedIndexInformer
+
+	// Watches VirtualizationQuota objects
+	VirtualizationQuota() cache.SharedIndexInformer
+
 	// Watches VirtualMachineClone objects
 	VirtualMachineClone() cache.SharedIndexInformer
 
@@ -797,6 +805,22 @@ func (f *kubeInformerFactory) MigrationPolicy() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) OvercommitProfile() cache.SharedIndexInformer {
+	return f.getInformer("overcommitProfileInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().OvercommitV1alpha1().RESTClient(), overcommit.ResourceOvercommitProfiles, k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &overcommitv1.OvercommitProfile{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualizationQuota() cache.SharedIndexInformer {
+	return f.getInformer("virtualizationQuotaInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().OvercommitV1alpha1().RESTClient(), overcommit.ResourceVirtualizationQuotas, k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &overcommitv1.VirtualizationQuota{}, f.defaultResync, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		})
+	})
+}
+
 func GetVirtualMachineCloneInformerIndexers() cache.Indexers {
 	getkey := func(vmClone *clone.VirtualMachineClone, resourceName string) string {
 		return fmt.Sprintf("%s/%s", vmClone.Namespace, resourceName)
@@ -0,0 +1,71 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cpuburst
+
+import (
+	"fmt"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	startLayout = "15:04"
+	day         = 24 * time.Hour
+)
+
+// ParseStart returns the offset from midnight at which a window opens.
+func ParseStart(start string) (time.Duration, error) {
+	t, err := time.Parse(startLayout, start)
+	if err != nil {
+		return 0, fmt.Errorf("invalid window start %q, expected the %q format", start, startLayout)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// Evaluate returns whether now is within one of the windows, and the time at which a window
+// opens or closes next. Windows with an invalid start or duration are ignored.
+func Evaluate(windows []v1.CPUBurstWindow, now time.Time) (active bool, next time.Time) {
+	now = now.UTC()
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	considerNext := func(t time.Time) {
+		if t.After(now) && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+
+	for _, window := range windows {
+		offset, err := ParseStart(window.Start)
+		if err != nil || window.Duration.Duration <= 0 || window.Duration.Duration > day {
+			continue
+		}
+		// A window opened on the previous day may still be open
+		for _, opening := range []time.Time{midnight.Add(offset - day), midnight.Add(offset), midnight.Add(offset + day)} {
+			closing := opening.Add(window.Duration.Duration)
+			if !now.Before(opening) && now.Before(closing) {
+				active = true
+			}
+			considerNext(opening)
+			considerNext(closing)
+		}
+	}
+	return active, next
+}
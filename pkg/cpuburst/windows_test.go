@@ -0,0 +1,71 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cpuburst
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("CPU burst windows", func() {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2025, time.March, 10, hour, minute, 0, 0, time.UTC)
+	}
+
+	window := func(start string, duration time.Duration) v1.CPUBurstWindow {
+		return v1.CPUBurstWindow{Start: start, Duration: metav1.Duration{Duration: duration}}
+	}
+
+	DescribeTable("should be evaluated", func(windows []v1.CPUBurstWindow, now time.Time, expectedActive bool, expectedNext time.Time) {
+		active, next := Evaluate(windows, now)
+		Expect(active).To(Equal(expectedActive))
+		Expect(next).To(Equal(expectedNext))
+	},
+		Entry("before a window", []v1.CPUBurstWindow{window("02:00", time.Hour)}, at(1, 30), false, at(2, 0)),
+		Entry("within a window", []v1.CPUBurstWindow{window("02:00", time.Hour)}, at(2, 30), true, at(3, 0)),
+		Entry("when a window opens", []v1.CPUBurstWindow{window("02:00", time.Hour)}, at(2, 0), true, at(3, 0)),
+		Entry("when a window closes", []v1.CPUBurstWindow{window("02:00", time.Hour)}, at(3, 0), false, at(2, 0).Add(24*time.Hour)),
+		Entry("within a window opened on the previous day", []v1.CPUBurstWindow{window("23:00", 2*time.Hour)}, at(0, 30), true, at(1, 0)),
+		Entry("with several windows", []v1.CPUBurstWindow{window("22:00", time.Hour), window("06:00", time.Hour)}, at(4, 0), false, at(6, 0)),
+		Entry("with an invalid window", []v1.CPUBurstWindow{window("2am", time.Hour)}, at(2, 30), false, time.Time{}),
+		Entry("without windows", nil, at(2, 30), false, time.Time{}),
+	)
+
+	DescribeTable("should parse the start", func(start string, expected time.Duration, expectErr bool) {
+		offset, err := ParseStart(start)
+		if expectErr {
+			Expect(err).To(HaveOccurred())
+			return
+		}
+		Expect(err).ToNot(HaveOccurred())
+		Expect(offset).To(Equal(expected))
+	},
+		Entry("midnight", "00:00", time.Duration(0), false),
+		Entry("afternoon", "14:45", 14*time.Hour+45*time.Minute, false),
+		Entry("without minutes", "02", time.Duration(0), true),
+		Entry("out of range", "24:00", time.Duration(0), true),
+	)
+})
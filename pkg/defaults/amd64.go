@@ -17,6 +17,8 @@ package defaults
 
 import (
 	v1 "kubevirt.io/api/core/v1"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 func setDefaultAmd64DisksBus(spec *v1.VirtualMachineInstanceSpec) {
@@ -24,6 +26,10 @@ func setDefaultAmd64DisksBus(spec *v1.VirtualMachineInstanceSpec) {
 	// guest operating systems (we support only q35 and therefore IDE is not supported)
 	// TODO: consider making this OS-specific (VIRTIO for linux, SATA for others)
 	bus := v1.DiskBusSATA
+	// microvm has no SATA controller
+	if virtconfig.IsMicroVM(spec) {
+		bus = v1.DiskBusVirtio
+	}
 
 	for i := range spec.Domain.Devices.Disks {
 		disk := &spec.Domain.Devices.Disks[i].DiskDevice
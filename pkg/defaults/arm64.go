@@ -70,11 +70,17 @@ func setDefaultArm64DisksBus(spec *v1.VirtualMachineInstanceSpec) {
 
 }
 
+// setDefaultArm64Watchdog set default watchdog to i6300esb, the PCI watchdog is the same as on amd64
+func setDefaultArm64Watchdog(spec *v1.VirtualMachineInstanceSpec) {
+	SetAmd64Watchdog(spec)
+}
+
 // SetArm64Defaults is mutating function for mutating-webhook
 func SetArm64Defaults(spec *v1.VirtualMachineInstanceSpec) {
 	setDefaultArm64CPUModel(spec)
 	setDefaultArm64Bootloader(spec)
 	setDefaultArm64DisksBus(spec)
+	setDefaultArm64Watchdog(spec)
 }
 
 func IsARM64(vmiSpec *v1.VirtualMachineInstanceSpec) bool {
@@ -31,7 +31,9 @@ import (
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/guesttime"
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
+	"kubevirt.io/kubevirt/pkg/network/ipam"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -66,6 +68,7 @@ func SetDefaultVirtualMachineInstance(clusterConfig *virtconfig.ClusterConfig, v
 	if err := SetDefaultVirtualMachineInstanceSpec(clusterConfig, &vmi.Spec); err != nil {
 		return err
 	}
+	guesttime.SetDefaultNTPServers(clusterConfig.GetGuestTime(), vmi)
 	setDefaultFeatures(&vmi.Spec)
 	v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 	setDefaultHypervFeatureDependencies(&vmi.Spec)
@@ -198,9 +201,13 @@ func SetDefaultVirtualMachineInstanceSpec(clusterConfig *virtconfig.ClusterConfi
 	SetDefaultGuestCPUTopology(clusterConfig, spec)
 	setDefaultPullPoliciesOnContainerDisks(spec)
 	setDefaultEvictionStrategy(clusterConfig, spec)
+	setShutdownLadderGracePeriod(spec)
 	if err := vmispec.SetDefaultNetworkInterface(clusterConfig, spec); err != nil {
 		return err
 	}
+	if err := ipam.SetDefaultPodNetworkCIDRs(clusterConfig.GetMasqueradeIPAM(), spec); err != nil {
+		return err
+	}
 	util.SetDefaultVolumeDisk(spec)
 	return nil
 }
@@ -211,6 +218,19 @@ func setDefaultEvictionStrategy(clusterConfig *virtconfig.ClusterConfig, spec *v
 	}
 }
 
+// setShutdownLadderGracePeriod defaults the grace period to the sum of the steps of the shutdown ladder,
+// a grace period set by the user is kept.
+func setShutdownLadderGracePeriod(spec *v1.VirtualMachineInstanceSpec) {
+	if spec.ShutdownLadder == nil || spec.TerminationGracePeriodSeconds != nil {
+		return
+	}
+	var gracePeriod int64
+	for _, step := range spec.ShutdownLadder.Steps {
+		gracePeriod += step.TimeoutSeconds
+	}
+	spec.TerminationGracePeriodSeconds = &gracePeriod
+}
+
 func setDefaultMachineType(clusterConfig *virtconfig.ClusterConfig, spec *v1.VirtualMachineInstanceSpec) {
 	machineType := clusterConfig.GetMachineType(spec.Architecture)
 
@@ -0,0 +1,166 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ephemeraldisk
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"kubevirt.io/client-go/log"
+
+	osdisk "kubevirt.io/kubevirt/pkg/os/disk"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	DefaultIntegrityCheckInterval = time.Hour
+
+	// integrityCheckPause is the pause between the checks of two images, so that
+	// a pass over many images does not compete with the guest for the disk bandwidth
+	integrityCheckPause = 10 * time.Second
+)
+
+type imageChecker func(imagePath string, diskMemoryLimitBytes int64) (*osdisk.CheckResult, error)
+type imageInspector func(imagePath string, diskMemoryLimitBytes int64) (*osdisk.DiskInfo, error)
+
+// IntegrityChecker periodically checks the qcow2 images created for containerDisk and
+// ephemeral volumes, as well as their backing containerDisk images when these are qcow2.
+// Raw images have no metadata which could be checked.
+//
+// The images are in use by the running domain, so that a check can see metadata which is
+// being updated. An image is therefore reported as corrupted only once two consecutive
+// passes found corruptions.
+type IntegrityChecker struct {
+	mountBaseDir         string
+	interval             time.Duration
+	pause                time.Duration
+	diskMemoryLimitBytes int64
+	check                imageChecker
+	inspect              imageInspector
+	report               func(api.ImageIntegrityMetadata)
+
+	suspects map[string]bool
+	reported api.ImageIntegrityMetadata
+}
+
+func NewIntegrityChecker(mountBaseDir string, interval time.Duration, diskMemoryLimitBytes int64, report func(api.ImageIntegrityMetadata)) *IntegrityChecker {
+	return &IntegrityChecker{
+		mountBaseDir:         mountBaseDir,
+		interval:             interval,
+		pause:                integrityCheckPause,
+		diskMemoryLimitBytes: diskMemoryLimitBytes,
+		check:                osdisk.CheckImage,
+		inspect:              osdisk.GetInUseDiskInfo,
+		report:               report,
+		suspects:             map[string]bool{},
+	}
+}
+
+// Run checks the images every interval until stopChan is closed. The first pass only
+// happens after one interval, the images have just been created when the domain starts.
+func (c *IntegrityChecker) Run(stopChan <-chan struct{}) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopChan:
+			return
+		case <-ticker.C:
+			c.checkImages(stopChan)
+		}
+	}
+}
+
+func (c *IntegrityChecker) checkImages(stopChan <-chan struct{}) {
+	images, err := filepath.Glob(filepath.Join(c.mountBaseDir, "*", "disk.qcow2"))
+	if err != nil {
+		log.Log.Reason(err).Error("failed to list the images to check")
+		return
+	}
+	sort.Strings(images)
+
+	corrupted := map[string]string{}
+	for i, image := range images {
+		if i > 0 {
+			select {
+			case <-stopChan:
+				return
+			case <-time.After(c.pause):
+			}
+		}
+		volumeName := filepath.Base(filepath.Dir(image))
+		if message := c.checkVolumeImages(image); message != "" {
+			corrupted[volumeName] = message
+		}
+	}
+
+	var confirmed []string
+	suspects := map[string]bool{}
+	for volumeName, message := range corrupted {
+		if c.suspects[volumeName] {
+			confirmed = append(confirmed, message)
+		} else {
+			log.Log.Warningf("image of volume %s may be corrupted, checking again on the next pass: %s", volumeName, message)
+		}
+		suspects[volumeName] = true
+	}
+	c.suspects = suspects
+	sort.Strings(confirmed)
+
+	result := api.ImageIntegrityMetadata{
+		Corrupted: len(confirmed) > 0,
+		Message:   strings.Join(confirmed, "; "),
+	}
+	if result != c.reported {
+		c.reported = result
+		c.report(result)
+	}
+}
+
+// checkVolumeImages checks the image of a volume and its qcow2 backing image. It returns
+// a message describing the corruptions found, or an empty message if there are none.
+func (c *IntegrityChecker) checkVolumeImages(image string) string {
+	volumeName := filepath.Base(filepath.Dir(image))
+	images := []string{image}
+	info, err := c.inspect(image, c.diskMemoryLimitBytes)
+	if err != nil {
+		log.Log.Reason(err).Warningf("failed to inspect the image of volume %s", volumeName)
+	} else if info.BackingFile != "" && info.BackingFormat == "qcow2" {
+		images = append(images, info.BackingFile)
+	}
+
+	var messages []string
+	for _, imagePath := range images {
+		result, err := c.check(imagePath, c.diskMemoryLimitBytes)
+		if err != nil {
+			// A failed check is not a proof of corruption, e.g. the image may be
+			// too large for the limits of the check
+			log.Log.Reason(err).Warningf("failed to check the image %s of volume %s", imagePath, volumeName)
+			continue
+		}
+		if result.IsCorrupted() {
+			messages = append(messages, fmt.Sprintf("image %s of volume %s has %d corruptions", imagePath, volumeName, result.Corruptions))
+		}
+	}
+	return strings.Join(messages, ", ")
+}
@@ -0,0 +1,147 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ephemeraldisk
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	osdisk "kubevirt.io/kubevirt/pkg/os/disk"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("IntegrityChecker", func() {
+	const backingImage = "/var/run/kubevirt/container-disks/disk_0.img"
+
+	var (
+		mountBaseDir string
+		checker      *IntegrityChecker
+		reports      []api.ImageIntegrityMetadata
+		corruptions  map[string]int64
+		backings     map[string]*osdisk.DiskInfo
+		checked      []string
+		stop         chan struct{}
+	)
+
+	createImage := func(volumeName string) string {
+		Expect(os.Mkdir(filepath.Join(mountBaseDir, volumeName), 0755)).To(Succeed())
+		image := filepath.Join(mountBaseDir, volumeName, "disk.qcow2")
+		Expect(os.WriteFile(image, nil, 0640)).To(Succeed())
+		return image
+	}
+
+	BeforeEach(func() {
+		mountBaseDir = GinkgoT().TempDir()
+		reports = nil
+		corruptions = map[string]int64{}
+		backings = map[string]*osdisk.DiskInfo{}
+		checked = nil
+		stop = make(chan struct{})
+
+		checker = NewIntegrityChecker(mountBaseDir, DefaultIntegrityCheckInterval, 0, func(result api.ImageIntegrityMetadata) {
+			reports = append(reports, result)
+		})
+		checker.pause = 0
+		checker.check = func(imagePath string, _ int64) (*osdisk.CheckResult, error) {
+			checked = append(checked, imagePath)
+			return &osdisk.CheckResult{Filename: imagePath, Format: "qcow2", Corruptions: corruptions[imagePath]}, nil
+		}
+		checker.inspect = func(imagePath string, _ int64) (*osdisk.DiskInfo, error) {
+			if info, exists := backings[imagePath]; exists {
+				return info, nil
+			}
+			return &osdisk.DiskInfo{Format: "qcow2"}, nil
+		}
+	})
+
+	It("should report a corruption only once it is found on two consecutive passes", func() {
+		image := createImage("disk0")
+		createImage("disk1")
+		corruptions[image] = 2
+
+		checker.checkImages(stop)
+		Expect(reports).To(BeEmpty())
+
+		checker.checkImages(stop)
+		Expect(reports).To(ConsistOf(api.ImageIntegrityMetadata{
+			Corrupted: true,
+			Message:   fmt.Sprintf("image %s of volume disk0 has 2 corruptions", image),
+		}))
+
+		By("not reporting the same result again")
+		checker.checkImages(stop)
+		Expect(reports).To(HaveLen(1))
+
+		By("reporting once the corruption is gone")
+		delete(corruptions, image)
+		checker.checkImages(stop)
+		Expect(reports).To(HaveLen(2))
+		Expect(reports[1]).To(Equal(api.ImageIntegrityMetadata{}))
+	})
+
+	It("should not report a corruption which is not found again", func() {
+		image := createImage("disk0")
+		corruptions[image] = 1
+
+		checker.checkImages(stop)
+		delete(corruptions, image)
+		checker.checkImages(stop)
+		corruptions[image] = 1
+		checker.checkImages(stop)
+		Expect(reports).To(BeEmpty())
+	})
+
+	It("should check qcow2 backing images", func() {
+		image := createImage("containerdisk")
+		backings[image] = &osdisk.DiskInfo{Format: "qcow2", BackingFile: backingImage, BackingFormat: "qcow2"}
+		corruptions[backingImage] = 3
+
+		checker.checkImages(stop)
+		checker.checkImages(stop)
+		Expect(checked).To(Equal([]string{image, backingImage, image, backingImage}))
+		Expect(reports).To(ConsistOf(api.ImageIntegrityMetadata{
+			Corrupted: true,
+			Message:   fmt.Sprintf("image %s of volume containerdisk has 3 corruptions", backingImage),
+		}))
+	})
+
+	It("should not check raw backing images", func() {
+		image := createImage("ephemeral")
+		backings[image] = &osdisk.DiskInfo{Format: "qcow2", BackingFile: "/dev/ephemeral", BackingFormat: "raw"}
+
+		checker.checkImages(stop)
+		Expect(checked).To(Equal([]string{image}))
+	})
+
+	It("should not consider a failed check as a corruption", func() {
+		createImage("disk0")
+		checker.check = func(string, int64) (*osdisk.CheckResult, error) {
+			return nil, fmt.Errorf("failed to invoke qemu-img")
+		}
+
+		checker.checkImages(stop)
+		checker.checkImages(stop)
+		Expect(reports).To(BeEmpty())
+	})
+})
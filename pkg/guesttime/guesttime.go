@@ -0,0 +1,81 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package guesttime injects the time synchronization policy of the cluster into the guests.
+package guesttime
+
+import (
+	"slices"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// SetDefaultNTPServers offers the NTP servers of the cluster time policy to the guest.
+// They are set in the DHCP options of bridge and masquerade interfaces which do not set their own NTP servers,
+// and annotated on VMIs with a cloud-init volume, to be passed to the guest through cloud-init vendor data.
+func SetDefaultNTPServers(config *v1.GuestTimeConfiguration, vmi *v1.VirtualMachineInstance) {
+	if config == nil || len(config.NTPServers) == 0 {
+		return
+	}
+
+	for i := range vmi.Spec.Domain.Devices.Interfaces {
+		iface := &vmi.Spec.Domain.Devices.Interfaces[i]
+		if iface.Bridge == nil && iface.Masquerade == nil {
+			continue
+		}
+		if iface.DHCPOptions == nil {
+			iface.DHCPOptions = &v1.DHCPOptions{}
+		}
+		if len(iface.DHCPOptions.NTPServers) == 0 {
+			iface.DHCPOptions.NTPServers = slices.Clone(config.NTPServers)
+		}
+	}
+
+	if !hasCloudInitVolume(vmi.Spec.Volumes) {
+		return
+	}
+	if _, exists := vmi.Annotations[v1.GuestNTPServersAnnotation]; exists {
+		return
+	}
+	if vmi.Annotations == nil {
+		vmi.Annotations = map[string]string{}
+	}
+	vmi.Annotations[v1.GuestNTPServersAnnotation] = strings.Join(config.NTPServers, ",")
+}
+
+// NTPServers returns the NTP servers annotated on the VMI for cloud-init.
+func NTPServers(vmi *v1.VirtualMachineInstance) []string {
+	var servers []string
+	for _, server := range strings.Split(vmi.Annotations[v1.GuestNTPServersAnnotation], ",") {
+		if server = strings.TrimSpace(server); server != "" {
+			servers = append(servers, server)
+		}
+	}
+	return servers
+}
+
+func hasCloudInitVolume(volumes []v1.Volume) bool {
+	for _, volume := range volumes {
+		if volume.CloudInitNoCloud != nil || volume.CloudInitConfigDrive != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guesttime_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/guesttime"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+var _ = Describe("Guest time policy", func() {
+	config := &v1.GuestTimeConfiguration{NTPServers: []string{"10.0.0.1", "10.0.0.2"}}
+
+	It("should not change the VMI without NTP servers", func() {
+		vmi := libvmi.New(libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()), libvmi.WithNetwork(v1.DefaultPodNetwork()))
+		expectedVMI := vmi.DeepCopy()
+
+		guesttime.SetDefaultNTPServers(&v1.GuestTimeConfiguration{}, vmi)
+		Expect(vmi).To(Equal(expectedVMI))
+	})
+
+	It("should set the NTP servers in the DHCP options of bridge and masquerade interfaces", func() {
+		vmi := libvmi.New(
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding("bridge")),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithSRIOVBinding("sriov")),
+		)
+
+		guesttime.SetDefaultNTPServers(config, vmi)
+
+		interfaces := vmi.Spec.Domain.Devices.Interfaces
+		Expect(interfaces[0].DHCPOptions.NTPServers).To(Equal(config.NTPServers))
+		Expect(interfaces[1].DHCPOptions.NTPServers).To(Equal(config.NTPServers))
+		Expect(interfaces[2].DHCPOptions).To(BeNil())
+		Expect(vmi.Annotations).ToNot(HaveKey(v1.GuestNTPServersAnnotation))
+	})
+
+	It("should keep the NTP servers set on the interface", func() {
+		iface := libvmi.InterfaceDeviceWithMasqueradeBinding()
+		iface.DHCPOptions = &v1.DHCPOptions{NTPServers: []string{"192.168.0.1"}}
+		vmi := libvmi.New(libvmi.WithInterface(iface))
+
+		guesttime.SetDefaultNTPServers(config, vmi)
+		Expect(vmi.Spec.Domain.Devices.Interfaces[0].DHCPOptions.NTPServers).To(ConsistOf("192.168.0.1"))
+	})
+
+	DescribeTable("should annotate the NTP servers on VMIs with", func(cloudInit libvmi.Option) {
+		vmi := libvmi.New(cloudInit)
+
+		guesttime.SetDefaultNTPServers(config, vmi)
+		Expect(vmi.Annotations).To(HaveKeyWithValue(v1.GuestNTPServersAnnotation, "10.0.0.1,10.0.0.2"))
+		Expect(guesttime.NTPServers(vmi)).To(Equal(config.NTPServers))
+	},
+		Entry("a cloud-init NoCloud volume", libvmi.WithCloudInitNoCloud()),
+		Entry("a cloud-init ConfigDrive volume", libvmi.WithCloudInitConfigDrive()),
+	)
+
+	It("should keep the NTP servers annotated on the VMI", func() {
+		vmi := libvmi.New(libvmi.WithCloudInitNoCloud(), libvmi.WithAnnotation(v1.GuestNTPServersAnnotation, "192.168.0.1"))
+
+		guesttime.SetDefaultNTPServers(config, vmi)
+		Expect(guesttime.NTPServers(vmi)).To(ConsistOf("192.168.0.1"))
+	})
+})
@@ -44,6 +44,7 @@ It has these top-level messages:
 	DirtyRateStatsResponse
 	ScreenshotResponse
 	BackupRequest
+	ChangeMediaRequest
 */
 package v1
 
@@ -367,10 +368,12 @@ func (m *DiskInfo) GetVirtualSize() uint64 {
 }
 
 type ClusterConfig struct {
-	ExpandDisksEnabled        bool `protobuf:"varint,1,opt,name=ExpandDisksEnabled" json:"ExpandDisksEnabled,omitempty"`
-	FreePageReportingDisabled bool `protobuf:"varint,2,opt,name=FreePageReportingDisabled" json:"FreePageReportingDisabled,omitempty"`
-	BochsDisplayForEFIGuests  bool `protobuf:"varint,3,opt,name=BochsDisplayForEFIGuests" json:"BochsDisplayForEFIGuests,omitempty"`
-	SerialConsoleLogDisabled  bool `protobuf:"varint,4,opt,name=SerialConsoleLogDisabled" json:"SerialConsoleLogDisabled,omitempty"`
+	ExpandDisksEnabled        bool              `protobuf:"varint,1,opt,name=ExpandDisksEnabled" json:"ExpandDisksEnabled,omitempty"`
+	FreePageReportingDisabled bool              `protobuf:"varint,2,opt,name=FreePageReportingDisabled" json:"FreePageReportingDisabled,omitempty"`
+	BochsDisplayForEFIGuests  bool              `protobuf:"varint,3,opt,name=BochsDisplayForEFIGuests" json:"BochsDisplayForEFIGuests,omitempty"`
+	SerialConsoleLogDisabled  bool              `protobuf:"varint,4,opt,name=SerialConsoleLogDisabled" json:"SerialConsoleLogDisabled,omitempty"`
+	MachineTypeAliases        map[string]string `protobuf:"bytes,5,rep,name=MachineTypeAliases" json:"MachineTypeAliases,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	PrHelperSocketPath        string            `protobuf:"bytes,6,opt,name=PrHelperSocketPath" json:"PrHelperSocketPath,omitempty"`
 }
 
 func (m *ClusterConfig) Reset()                    { *m = ClusterConfig{} }
@@ -406,6 +409,20 @@ func (m *ClusterConfig) GetSerialConsoleLogDisabled() bool {
 	return false
 }
 
+func (m *ClusterConfig) GetMachineTypeAliases() map[string]string {
+	if m != nil {
+		return m.MachineTypeAliases
+	}
+	return nil
+}
+
+func (m *ClusterConfig) GetPrHelperSocketPath() string {
+	if m != nil {
+		return m.PrHelperSocketPath
+	}
+	return ""
+}
+
 type InterfaceBindingMigration struct {
 	Method string `protobuf:"bytes,1,opt,name=Method" json:"Method,omitempty"`
 }
@@ -600,8 +617,9 @@ func (*EmptyRequest) ProtoMessage()               {}
 func (*EmptyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 type Response struct {
-	Success bool   `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+	Success    bool   `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+	Message    string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+	Incomplete bool   `protobuf:"varint,3,opt,name=incomplete" json:"incomplete,omitempty"`
 }
 
 func (m *Response) Reset()                    { *m = Response{} }
@@ -623,6 +641,13 @@ func (m *Response) GetMessage() string {
 	return ""
 }
 
+func (m *Response) GetIncomplete() bool {
+	if m != nil {
+		return m.Incomplete
+	}
+	return false
+}
+
 type DomainResponse struct {
 	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
 	Domain   string    `protobuf:"bytes,2,opt,name=domain" json:"domain,omitempty"`
@@ -1015,6 +1040,30 @@ func (m *BackupRequest) GetOptions() []byte {
 	return nil
 }
 
+type ChangeMediaRequest struct {
+	Vmi     *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	Options []byte `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (m *ChangeMediaRequest) Reset()                    { *m = ChangeMediaRequest{} }
+func (m *ChangeMediaRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChangeMediaRequest) ProtoMessage()               {}
+func (*ChangeMediaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *ChangeMediaRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *ChangeMediaRequest) GetOptions() []byte {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QemuVersionResponse)(nil), "kubevirt.cmd.v1.QemuVersionResponse")
 	proto.RegisterType((*VMI)(nil), "kubevirt.cmd.v1.VMI")
@@ -1050,6 +1099,7 @@ func init() {
 	proto.RegisterType((*DirtyRateStatsResponse)(nil), "kubevirt.cmd.v1.DirtyRateStatsResponse")
 	proto.RegisterType((*ScreenshotResponse)(nil), "kubevirt.cmd.v1.ScreenshotResponse")
 	proto.RegisterType((*BackupRequest)(nil), "kubevirt.cmd.v1.BackupRequest")
+	proto.RegisterType((*ChangeMediaRequest)(nil), "kubevirt.cmd.v1.ChangeMediaRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1097,6 +1147,7 @@ type CmdClient interface {
 	GetDomainDirtyRateStats(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*DirtyRateStatsResponse, error)
 	GetScreenshot(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*ScreenshotResponse, error)
 	BackupVirtualMachine(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error)
+	ChangeMedia(ctx context.Context, in *ChangeMediaRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
 type cmdClient struct {
@@ -1413,6 +1464,15 @@ func (c *cmdClient) BackupVirtualMachine(ctx context.Context, in *BackupRequest,
 	return out, nil
 }
 
+func (c *cmdClient) ChangeMedia(ctx context.Context, in *ChangeMediaRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/ChangeMedia", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Cmd service
 
 type CmdServer interface {
@@ -1450,6 +1510,7 @@ type CmdServer interface {
 	GetDomainDirtyRateStats(context.Context, *EmptyRequest) (*DirtyRateStatsResponse, error)
 	GetScreenshot(context.Context, *VMIRequest) (*ScreenshotResponse, error)
 	BackupVirtualMachine(context.Context, *BackupRequest) (*Response, error)
+	ChangeMedia(context.Context, *ChangeMediaRequest) (*Response, error)
 }
 
 func RegisterCmdServer(s *grpc.Server, srv CmdServer) {
@@ -2068,6 +2129,24 @@ func _Cmd_BackupVirtualMachine_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_ChangeMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).ChangeMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/ChangeMedia",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).ChangeMedia(ctx, req.(*ChangeMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Cmd_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubevirt.cmd.v1.Cmd",
 	HandlerType: (*CmdServer)(nil),
@@ -2208,6 +2287,10 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BackupVirtualMachine",
 			Handler:    _Cmd_BackupVirtualMachine_Handler,
 		},
+		{
+			MethodName: "ChangeMedia",
+			Handler:    _Cmd_ChangeMedia_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/handler-launcher-com/cmd/v1/cmd.proto",
@@ -2216,125 +2299,131 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/handler-launcher-com/cmd/v1/cmd.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1920 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5f, 0x73, 0xdb, 0xc6,
-	0x11, 0x17, 0x45, 0x4a, 0x22, 0x57, 0x7f, 0x62, 0x9f, 0x25, 0x19, 0x52, 0x6b, 0x5b, 0xbd, 0x76,
-	0x5c, 0xa5, 0x93, 0x48, 0xb5, 0xe3, 0x64, 0x3a, 0x9e, 0x4e, 0xc6, 0x11, 0x45, 0x29, 0x4a, 0x4c,
-	0x9b, 0x06, 0x25, 0x79, 0x9a, 0x36, 0x93, 0x39, 0x01, 0x27, 0xf2, 0x2a, 0xe0, 0x8e, 0xc1, 0x1d,
-	0x58, 0x33, 0x4f, 0x9d, 0x71, 0xa7, 0x0f, 0x9d, 0xe9, 0xe7, 0x6b, 0x9f, 0xfa, 0x2d, 0xfa, 0x9e,
-	0xb9, 0x03, 0x40, 0x81, 0x04, 0x20, 0x5a, 0x43, 0x3e, 0x11, 0x77, 0xb7, 0xfb, 0xdb, 0xbd, 0xbd,
-	0xdd, 0xbd, 0x1f, 0x40, 0xf8, 0xb8, 0x77, 0xd5, 0xd9, 0xef, 0x12, 0xee, 0x7a, 0x34, 0xf8, 0xd4,
-	0x23, 0x21, 0x77, 0xba, 0x34, 0xf8, 0xd4, 0x11, 0xfe, 0xbe, 0xe3, 0xbb, 0xfb, 0xfd, 0x27, 0xfa,
-	0x67, 0xaf, 0x17, 0x08, 0x25, 0xd0, 0x47, 0x57, 0xe1, 0x05, 0xed, 0xb3, 0x40, 0xed, 0xe9, 0xb9,
-	0xfe, 0x13, 0x7c, 0x09, 0xf7, 0xde, 0x50, 0x3f, 0x3c, 0xa7, 0x81, 0x64, 0x82, 0xdb, 0x54, 0xf6,
-	0x04, 0x97, 0x14, 0x7d, 0x0e, 0xd5, 0x20, 0x7e, 0xb6, 0x4a, 0x3b, 0xa5, 0xdd, 0xe5, 0xa7, 0x5b,
-	0x7b, 0x63, 0xaa, 0x7b, 0x89, 0xb0, 0x3d, 0x14, 0x45, 0x16, 0x2c, 0xf5, 0x23, 0x24, 0x6b, 0x7e,
-	0xa7, 0xb4, 0x5b, 0xb3, 0x93, 0x21, 0x7e, 0x04, 0xe5, 0xf3, 0xe6, 0x89, 0x11, 0xf0, 0xd9, 0x37,
-	0x52, 0x70, 0x03, 0xbb, 0x62, 0x27, 0x43, 0xfc, 0x04, 0xca, 0xf5, 0xd6, 0x19, 0x5a, 0x83, 0x79,
-	0xe6, 0x9a, 0xb5, 0x55, 0x7b, 0x9e, 0xb9, 0x68, 0x1b, 0xaa, 0x92, 0x5d, 0x78, 0x8c, 0x77, 0xa4,
-	0x35, 0xbf, 0x53, 0xde, 0x5d, 0xb5, 0x87, 0x63, 0xbc, 0x0f, 0x4b, 0xed, 0xe8, 0x39, 0xa3, 0xb6,
-	0x0e, 0x0b, 0x7d, 0xe2, 0x85, 0xd4, 0xb8, 0x51, 0xb1, 0xa3, 0x01, 0x6e, 0xc0, 0x42, 0x8b, 0x74,
-	0xa8, 0xd4, 0xcb, 0x8e, 0x08, 0xb9, 0x32, 0x1a, 0x15, 0x3b, 0x1a, 0x20, 0x04, 0x95, 0x90, 0x33,
-	0x15, 0xbb, 0x6e, 0x9e, 0xf5, 0x9c, 0x64, 0x3f, 0x51, 0xab, 0x6c, 0xa0, 0xcd, 0x33, 0x7e, 0x06,
-	0x8b, 0x4d, 0xea, 0x8b, 0x60, 0x80, 0x36, 0x61, 0x91, 0xf8, 0x29, 0xa0, 0x78, 0x94, 0x87, 0x84,
-	0xff, 0x53, 0x82, 0x4a, 0x9d, 0x7a, 0x5e, 0xc6, 0xd7, 0x7d, 0x58, 0xf4, 0x0d, 0x9c, 0x11, 0x5f,
-	0x7e, 0x7a, 0x3f, 0x13, 0xe9, 0xc8, 0x9a, 0x1d, 0x8b, 0xa1, 0x4f, 0x60, 0xa1, 0xa7, 0xb7, 0x61,
-	0x95, 0x77, 0xca, 0xbb, 0xcb, 0x4f, 0x37, 0x33, 0xf2, 0x66, 0x93, 0x76, 0x24, 0x84, 0xbe, 0x80,
-	0x9a, 0xcb, 0xa4, 0x22, 0xdc, 0xa1, 0xd2, 0xaa, 0x18, 0x0d, 0x2b, 0xa3, 0x11, 0xc7, 0xd1, 0xbe,
-	0x16, 0x45, 0xbb, 0x50, 0x71, 0x7a, 0xa1, 0xb4, 0x16, 0x8c, 0xca, 0x7a, 0x46, 0xa5, 0xde, 0x3a,
-	0xb3, 0x8d, 0x04, 0x7e, 0x01, 0xd5, 0x53, 0xd1, 0x13, 0x9e, 0xe8, 0x0c, 0xd0, 0x33, 0x00, 0x1e,
-	0xfa, 0xe4, 0x07, 0x87, 0x7a, 0x9e, 0xb4, 0x4a, 0x46, 0x77, 0x23, 0xab, 0x4b, 0x3d, 0xcf, 0xae,
-	0x69, 0x41, 0xfd, 0x24, 0xf1, 0xbf, 0x4a, 0xb0, 0xd8, 0x6e, 0x1e, 0x30, 0x21, 0x11, 0x86, 0x15,
-	0x9f, 0xf0, 0xf0, 0x92, 0x38, 0x2a, 0x0c, 0x68, 0x60, 0xe2, 0x54, 0xb3, 0x47, 0xe6, 0x74, 0x16,
-	0xf5, 0x02, 0xe1, 0x86, 0x4e, 0x12, 0xe1, 0x64, 0x98, 0x4e, 0xc0, 0xf2, 0x48, 0x02, 0xa2, 0x3b,
-	0x50, 0x96, 0x57, 0xa1, 0x55, 0x31, 0xb3, 0xfa, 0x51, 0x1f, 0xde, 0x25, 0xf1, 0x99, 0x37, 0xb0,
-	0x16, 0xcc, 0x64, 0x3c, 0xc2, 0xff, 0x2c, 0x41, 0xf5, 0x90, 0xc9, 0xab, 0x13, 0x7e, 0x29, 0x8c,
-	0x90, 0x08, 0x7c, 0xa2, 0x62, 0x47, 0xe2, 0x11, 0xda, 0x81, 0xe5, 0x0b, 0xe2, 0x5c, 0x31, 0xde,
-	0x39, 0x62, 0x1e, 0x8d, 0xdd, 0x48, 0x4f, 0xa1, 0x87, 0x00, 0xda, 0x5f, 0xe2, 0xb5, 0x93, 0xfc,
-	0xa9, 0xd8, 0xa9, 0x19, 0x8d, 0xa0, 0x43, 0x92, 0x08, 0x54, 0x8c, 0x40, 0x7a, 0x0a, 0xff, 0xbf,
-	0x04, 0xab, 0x75, 0x2f, 0x94, 0x8a, 0x06, 0x75, 0xc1, 0x2f, 0x59, 0x07, 0xed, 0x01, 0x6a, 0xbc,
-	0xeb, 0x11, 0xee, 0x6a, 0xff, 0x64, 0x83, 0x93, 0x0b, 0x8f, 0x46, 0xa9, 0x54, 0xb5, 0x73, 0x56,
-	0xd0, 0x1f, 0x61, 0xeb, 0x28, 0xa0, 0x54, 0xe7, 0x83, 0x4d, 0x7b, 0x22, 0x50, 0x8c, 0x77, 0x0e,
-	0x99, 0x8c, 0xd4, 0xe6, 0x8d, 0x5a, 0xb1, 0x00, 0x7a, 0x0e, 0xd6, 0x81, 0x70, 0xba, 0xf2, 0x90,
-	0xc9, 0x9e, 0x47, 0x06, 0x47, 0x22, 0x68, 0x1c, 0x9d, 0x1c, 0x87, 0x54, 0x2a, 0x69, 0xf6, 0x53,
-	0xb5, 0x0b, 0xd7, 0xb5, 0x6e, 0x9b, 0x06, 0x8c, 0x78, 0x75, 0xc1, 0xa5, 0xf0, 0xe8, 0x4b, 0x71,
-	0x6d, 0xb8, 0x12, 0xe9, 0x16, 0xad, 0xe3, 0xcf, 0x60, 0xeb, 0x84, 0x2b, 0x1a, 0x5c, 0x12, 0x87,
-	0x1e, 0x30, 0xee, 0x32, 0xde, 0x69, 0xb2, 0x4e, 0x40, 0x94, 0x3e, 0xc7, 0x4d, 0x5d, 0x7c, 0xaa,
-	0x2b, 0xdc, 0xe4, 0x40, 0xa2, 0x11, 0xfe, 0xdf, 0x12, 0x6c, 0x9c, 0x47, 0xc1, 0x6b, 0x12, 0xa7,
-	0xcb, 0x38, 0x7d, 0xdd, 0xd3, 0x0a, 0x12, 0x7d, 0x0b, 0xeb, 0xa3, 0x0b, 0x51, 0xa6, 0xc5, 0x7d,
-	0x2d, 0x5b, 0x6d, 0xd1, 0xb2, 0x9d, 0xab, 0x84, 0x9e, 0xc1, 0x46, 0x93, 0xfa, 0x07, 0xc4, 0xf3,
-	0x84, 0xe0, 0x6d, 0x45, 0x94, 0x6c, 0xd1, 0x80, 0x89, 0x28, 0x9a, 0xab, 0x76, 0xfe, 0x22, 0xfa,
-	0x3d, 0xdc, 0x6b, 0x05, 0x54, 0xcf, 0x3b, 0x44, 0x51, 0xf7, 0x5c, 0x78, 0xa1, 0x1f, 0xd7, 0x6f,
-	0xcd, 0xce, 0x5b, 0xd2, 0x0d, 0x58, 0xc5, 0x35, 0x65, 0xe2, 0x95, 0xd7, 0x80, 0x93, 0xa2, 0xb3,
-	0x87, 0xa2, 0xa8, 0x0d, 0x35, 0x93, 0x00, 0x3a, 0x77, 0xe3, 0xca, 0xfd, 0x3c, 0xa3, 0x97, 0x1b,
-	0xa6, 0xbd, 0xa1, 0x5e, 0x83, 0xab, 0x60, 0x60, 0x5f, 0xe3, 0x14, 0x64, 0xdd, 0x62, 0x61, 0xd6,
-	0x1d, 0xc2, 0xaa, 0x93, 0x4e, 0x5b, 0x6b, 0xc9, 0x6c, 0xe0, 0x61, 0xb6, 0x0d, 0xa4, 0xa5, 0xec,
-	0x51, 0x25, 0xf4, 0xbe, 0x04, 0x5b, 0x2c, 0x49, 0x83, 0x43, 0xe1, 0x13, 0xc6, 0xbf, 0x52, 0x8a,
-	0x38, 0x5d, 0x9f, 0x72, 0x65, 0x55, 0xcd, 0xde, 0x1a, 0x1f, 0xb8, 0xb7, 0x93, 0x22, 0x9c, 0x68,
-	0xaf, 0xc5, 0x76, 0x10, 0x07, 0x34, 0x5c, 0x1c, 0x26, 0xa1, 0x55, 0x33, 0xd6, 0xbf, 0xbc, 0xad,
-	0xf5, 0x21, 0x40, 0x64, 0x36, 0x07, 0x79, 0xfb, 0x2d, 0xac, 0x8d, 0x1e, 0x84, 0x6e, 0x5c, 0x57,
-	0x74, 0x10, 0x67, 0xbb, 0x7e, 0x44, 0xfb, 0xe9, 0xcb, 0x2d, 0x2f, 0x31, 0x92, 0xee, 0x15, 0xdf,
-	0x7b, 0xcf, 0xe7, 0xff, 0x50, 0xda, 0x7e, 0x09, 0x0f, 0x6f, 0x8e, 0x42, 0x8e, 0xa1, 0x91, 0x5b,
-	0xb4, 0x96, 0x46, 0xfb, 0x11, 0xee, 0x17, 0xec, 0x2a, 0x07, 0xe6, 0xc5, 0xa8, 0xbf, 0xbf, 0xcb,
-	0xf8, 0x5b, 0x58, 0xed, 0x29, 0x93, 0xb8, 0x0f, 0x70, 0xde, 0x3c, 0xb1, 0xe9, 0x8f, 0xba, 0xc1,
-	0xa0, 0xc7, 0x50, 0xee, 0xfb, 0x2c, 0xae, 0xe1, 0xec, 0xe5, 0xa4, 0x25, 0xb5, 0x00, 0x7a, 0x01,
-	0x4b, 0x22, 0x3a, 0x86, 0xd8, 0xfa, 0xe3, 0x0f, 0x3b, 0x34, 0x3b, 0x51, 0xc3, 0xa7, 0x70, 0xe7,
-	0xda, 0x9f, 0x5b, 0x5a, 0xb7, 0x46, 0xad, 0xaf, 0x5c, 0xa3, 0xbe, 0x2f, 0xc1, 0x72, 0xe3, 0x1d,
-	0x75, 0x12, 0xc4, 0x87, 0x00, 0xae, 0x39, 0x95, 0x57, 0xc4, 0xa7, 0x71, 0xf0, 0x52, 0x33, 0x1a,
-	0xa9, 0x2e, 0x7c, 0x9f, 0x70, 0x37, 0xb9, 0xf2, 0xe2, 0xa1, 0xe6, 0x1a, 0x5f, 0x05, 0x9d, 0xa4,
-	0x99, 0x98, 0x67, 0xf4, 0x18, 0xd6, 0x14, 0xf3, 0xa9, 0x08, 0x55, 0x9b, 0x3a, 0x82, 0xbb, 0xd2,
-	0xf4, 0x90, 0x05, 0x7b, 0x6c, 0x16, 0xaf, 0xc1, 0x4a, 0xc3, 0xef, 0xa9, 0x41, 0xec, 0x05, 0xfe,
-	0x12, 0xaa, 0x76, 0x8a, 0xcb, 0xc9, 0xd0, 0x71, 0xa8, 0x94, 0xf1, 0x05, 0x93, 0x0c, 0xf5, 0x8a,
-	0x4f, 0xa5, 0x24, 0x9d, 0x24, 0x31, 0x92, 0x21, 0xfe, 0x01, 0xd6, 0xa2, 0xdc, 0x9a, 0x96, 0x48,
-	0x6e, 0xc2, 0x62, 0xb4, 0xf9, 0xd8, 0x42, 0x3c, 0xc2, 0x1c, 0xee, 0x45, 0x06, 0x4c, 0x77, 0x9d,
-	0xd6, 0xca, 0x0e, 0x2c, 0xbb, 0xd7, 0x68, 0xc9, 0x25, 0x9e, 0x9a, 0xc2, 0xef, 0xe0, 0xae, 0xb9,
-	0xd0, 0x4c, 0x35, 0x4d, 0x69, 0xed, 0x13, 0xb8, 0xdb, 0x19, 0xc7, 0x8a, 0x6d, 0x66, 0x17, 0xf0,
-	0x3f, 0x4a, 0xb0, 0x61, 0x4c, 0x9f, 0x49, 0x1a, 0xbc, 0x64, 0x52, 0x4d, 0x6b, 0xfe, 0x19, 0x6c,
-	0x74, 0xf2, 0xf0, 0x62, 0x17, 0xf2, 0x17, 0xf1, 0xbf, 0x4b, 0x60, 0x19, 0x37, 0x34, 0xa7, 0x91,
-	0x03, 0xa9, 0xa8, 0x3f, 0x75, 0xd8, 0x9f, 0x83, 0xd5, 0x29, 0x80, 0x8c, 0x9d, 0x29, 0x5c, 0xc7,
-	0x03, 0x58, 0x89, 0xca, 0x66, 0x3a, 0x17, 0xb6, 0xa1, 0x4a, 0xdf, 0x31, 0x55, 0x17, 0x6e, 0x64,
-	0x72, 0xc1, 0x1e, 0x8e, 0x75, 0xee, 0x49, 0xe5, 0xbe, 0x0e, 0x55, 0x4c, 0x21, 0xe3, 0x11, 0xfe,
-	0x0e, 0xee, 0x98, 0x48, 0xb4, 0x34, 0x51, 0xfe, 0xc0, 0xb2, 0xcd, 0x16, 0xe2, 0x7c, 0x6e, 0x21,
-	0x7e, 0x13, 0xe7, 0x59, 0x84, 0x3d, 0xd5, 0xde, 0xb0, 0x80, 0x55, 0xcd, 0xe9, 0x7e, 0xa2, 0xb7,
-	0xed, 0x56, 0x5f, 0xc0, 0x66, 0xc8, 0x2f, 0x8d, 0xea, 0x69, 0x9e, 0xd3, 0x05, 0xab, 0xf8, 0x2d,
-	0xdc, 0x8d, 0xde, 0x50, 0x0e, 0x43, 0xbf, 0x77, 0x5b, 0xa3, 0xdb, 0x50, 0x75, 0x43, 0xbf, 0xd7,
-	0x22, 0xaa, 0x1b, 0x1f, 0xfe, 0x70, 0x8c, 0x2f, 0xe0, 0xa3, 0x76, 0xe3, 0x7c, 0x16, 0xb5, 0xa7,
-	0x9b, 0x19, 0xed, 0x1b, 0x56, 0x14, 0x37, 0xe2, 0x78, 0x88, 0xff, 0x5e, 0x82, 0xad, 0x97, 0xe6,
-	0x9d, 0xb9, 0x49, 0x89, 0x0c, 0x03, 0xaa, 0x2f, 0xc4, 0x19, 0x94, 0xba, 0x37, 0x8e, 0x19, 0x1b,
-	0xce, 0x2e, 0xe0, 0xef, 0x35, 0xdf, 0xfd, 0x2b, 0x75, 0x54, 0xe4, 0x47, 0x9b, 0x3a, 0x01, 0x55,
-	0xb3, 0xbb, 0x6a, 0x24, 0x6c, 0x1e, 0xb2, 0x40, 0x0d, 0x6c, 0xa2, 0xe8, 0x4c, 0xda, 0x26, 0x86,
-	0x15, 0x37, 0x01, 0x6c, 0x5e, 0x44, 0xf6, 0xca, 0xf6, 0xc8, 0x1c, 0x96, 0x80, 0xda, 0x4e, 0x40,
-	0x29, 0x97, 0x5d, 0x31, 0x75, 0x38, 0x11, 0x54, 0x7c, 0xe6, 0x27, 0xcd, 0xc1, 0x3c, 0xeb, 0x39,
-	0x97, 0x28, 0x62, 0x6a, 0x74, 0xc5, 0x36, 0xcf, 0xf8, 0x0d, 0xac, 0x1e, 0x10, 0xe7, 0x2a, 0xec,
-	0xcd, 0x2c, 0x78, 0x4f, 0xff, 0xbb, 0x09, 0xe5, 0xba, 0xef, 0xa2, 0x57, 0x80, 0xda, 0x03, 0xee,
-	0x8c, 0x72, 0x05, 0xf4, 0x8b, 0x5c, 0xc8, 0xc8, 0xf8, 0x76, 0xf1, 0xd6, 0xf0, 0x1c, 0x7a, 0x0d,
-	0xf7, 0x5a, 0x24, 0x94, 0x74, 0x66, 0x80, 0x6f, 0x60, 0xe3, 0x8c, 0xf7, 0x66, 0x0a, 0xd9, 0x86,
-	0xf5, 0xa8, 0x91, 0x8c, 0x21, 0x66, 0x89, 0xfc, 0x48, 0xbf, 0xb9, 0x19, 0xd4, 0x86, 0xcd, 0xb3,
-	0xb8, 0x8d, 0xcc, 0x32, 0x98, 0x36, 0x95, 0x54, 0xcd, 0x0c, 0xf0, 0x14, 0xac, 0xb6, 0xb8, 0x54,
-	0x36, 0xbd, 0x10, 0x62, 0x76, 0xa8, 0x36, 0x6c, 0xb6, 0xbb, 0xa1, 0x72, 0xc5, 0xdf, 0xf8, 0xcc,
-	0x30, 0x5f, 0x01, 0xfa, 0x96, 0x79, 0xde, 0xcc, 0xf0, 0x5a, 0xb0, 0x7e, 0x48, 0x3d, 0xaa, 0x66,
-	0x77, 0x38, 0x6f, 0x61, 0x23, 0xe2, 0xcf, 0xe3, 0x90, 0xbf, 0xca, 0x7e, 0xe7, 0x1a, 0xe3, 0xd9,
-	0x13, 0x4f, 0x5d, 0x97, 0xe4, 0x50, 0xe9, 0x94, 0x04, 0x1d, 0xaa, 0xa6, 0xf0, 0xf4, 0x4f, 0xf0,
-	0xa0, 0x4e, 0xb8, 0x43, 0xc7, 0xa2, 0x79, 0xfd, 0xed, 0x61, 0xba, 0xa3, 0x67, 0x1d, 0x4e, 0xbc,
-	0xc8, 0xc9, 0x96, 0x70, 0xeb, 0x1e, 0x25, 0x3c, 0xec, 0x4d, 0x81, 0xf9, 0x67, 0x78, 0x74, 0xc4,
-	0x38, 0xf1, 0xd8, 0x78, 0x25, 0xcd, 0xc2, 0xe1, 0x57, 0x80, 0xbe, 0x16, 0xaa, 0xe7, 0x85, 0x9d,
-	0xaf, 0x85, 0x54, 0x87, 0xb4, 0xcf, 0x1c, 0x2a, 0xa7, 0xc0, 0x6b, 0x42, 0xed, 0x98, 0xaa, 0x88,
-	0xbb, 0xa3, 0x07, 0x19, 0xc9, 0xf4, 0x5b, 0xc8, 0xf6, 0xa3, 0xec, 0x0b, 0xed, 0xc8, 0x4b, 0x85,
-	0x49, 0xaa, 0xb5, 0x21, 0x9c, 0xb9, 0xd3, 0x26, 0x61, 0xfe, 0xa6, 0x00, 0x73, 0xe4, 0x42, 0x34,
-	0x3d, 0x6f, 0xe5, 0x98, 0xaa, 0x21, 0xe7, 0x9f, 0x04, 0x8b, 0x33, 0xcb, 0x99, 0xd7, 0x05, 0x03,
-	0x5a, 0x3d, 0xa6, 0x86, 0x5b, 0x4f, 0xf4, 0xf3, 0x71, 0x3e, 0x60, 0x86, 0x97, 0xcf, 0xa1, 0xbf,
-	0x98, 0x10, 0xa4, 0x38, 0xf2, 0x24, 0xe8, 0x8f, 0xf3, 0xa1, 0xf3, 0x58, 0xf6, 0x1c, 0x3a, 0x80,
-	0x8a, 0xe6, 0xa2, 0x93, 0x30, 0x6f, 0x3c, 0xf3, 0x06, 0x54, 0x34, 0x57, 0x47, 0xbf, 0xcc, 0x62,
-	0x5c, 0xbf, 0xf9, 0x6e, 0x3f, 0x28, 0x58, 0x4d, 0x35, 0xe3, 0xda, 0x90, 0x1b, 0xe7, 0x34, 0x8d,
-	0x71, 0x4e, 0x5e, 0x74, 0x26, 0x69, 0x6a, 0x6d, 0xaa, 0xc7, 0x1a, 0xab, 0x9a, 0x21, 0x85, 0x45,
-	0xb8, 0xe0, 0x0b, 0x7c, 0x8a, 0xdf, 0x4e, 0xea, 0x79, 0xfa, 0x6c, 0x52, 0x7f, 0xac, 0xdc, 0x3e,
-	0x3d, 0x73, 0xfe, 0x95, 0x89, 0xfb, 0x48, 0x86, 0x86, 0xd4, 0x5b, 0x67, 0x72, 0xca, 0xcb, 0x2e,
-	0x83, 0x19, 0xff, 0xc1, 0x31, 0xcd, 0x9d, 0x0c, 0xc7, 0x54, 0xc5, 0xf4, 0x7d, 0xd2, 0xf6, 0x77,
-	0xb2, 0x1f, 0x61, 0x47, 0x79, 0x3f, 0x9e, 0x43, 0x04, 0xd6, 0x8f, 0xa9, 0xca, 0x50, 0xf5, 0x9b,
-	0x5d, 0xcc, 0x7e, 0x6b, 0x2a, 0xe4, 0xfa, 0x78, 0x0e, 0x7d, 0x0f, 0x28, 0x4b, 0xc4, 0x51, 0xde,
-	0xf7, 0xaa, 0x02, 0xb6, 0x7e, 0x73, 0x48, 0x1c, 0xb8, 0x3f, 0x6c, 0x5a, 0xa3, 0x8c, 0x7c, 0x52,
-	0x7c, 0x7e, 0x9b, 0xf3, 0x89, 0x2f, 0x8f, 0xd1, 0x9b, 0x5e, 0xb3, 0xaa, 0xe3, 0x3e, 0xe4, 0xde,
-	0x37, 0xc7, 0xe7, 0xd7, 0xd9, 0xc0, 0x67, 0x58, 0x7b, 0xc4, 0x04, 0x23, 0x62, 0x3d, 0x91, 0x09,
-	0x8e, 0xf0, 0xef, 0x1b, 0xc3, 0x71, 0x50, 0xf9, 0x6e, 0xbe, 0xff, 0xe4, 0x62, 0xd1, 0xfc, 0x31,
-	0xf9, 0xd9, 0xcf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x83, 0xa6, 0x93, 0x1a, 0xc5, 0x1c, 0x00, 0x00,
+	// 2009 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x6d, 0x6f, 0xdb, 0xc8,
+	0xf1, 0x8f, 0x2c, 0xd9, 0x91, 0xc6, 0x0f, 0x97, 0x6c, 0xfc, 0x40, 0xeb, 0xff, 0x4f, 0xe2, 0xb2,
+	0x45, 0xea, 0x2b, 0xee, 0xec, 0x26, 0x97, 0x0b, 0x8a, 0xa0, 0x28, 0x12, 0xcb, 0xb2, 0xe3, 0xbb,
+	0x28, 0x51, 0x28, 0xdb, 0x41, 0xaf, 0xbd, 0x1e, 0xd6, 0xe4, 0x4a, 0xda, 0x9a, 0xdc, 0xe5, 0x71,
+	0x97, 0x6a, 0x94, 0x57, 0x05, 0xae, 0xe8, 0x8b, 0x02, 0xfd, 0x20, 0xfd, 0x3e, 0x05, 0xfa, 0xae,
+	0x9f, 0xa5, 0xd8, 0x25, 0x29, 0x53, 0x22, 0x69, 0xc5, 0x90, 0x5e, 0x69, 0x77, 0x67, 0xe6, 0x37,
+	0xb3, 0xb3, 0x33, 0xb3, 0xb3, 0x14, 0x7c, 0xee, 0x5f, 0xf6, 0xf6, 0xfb, 0x98, 0x39, 0x2e, 0x09,
+	0xbe, 0x74, 0x71, 0xc8, 0xec, 0x3e, 0x09, 0xbe, 0xb4, 0xb9, 0xb7, 0x6f, 0x7b, 0xce, 0xfe, 0xe0,
+	0xb1, 0xfa, 0xd9, 0xf3, 0x03, 0x2e, 0x39, 0xfa, 0xec, 0x32, 0xbc, 0x20, 0x03, 0x1a, 0xc8, 0x3d,
+	0xb5, 0x36, 0x78, 0x6c, 0x76, 0xe1, 0xde, 0x3b, 0xe2, 0x85, 0xe7, 0x24, 0x10, 0x94, 0x33, 0x8b,
+	0x08, 0x9f, 0x33, 0x41, 0xd0, 0xd7, 0x50, 0x0d, 0xe2, 0xb1, 0x51, 0xda, 0x29, 0xed, 0x2e, 0x3f,
+	0xd9, 0xde, 0x9b, 0x10, 0xdd, 0x4b, 0x98, 0xad, 0x11, 0x2b, 0x32, 0xe0, 0xf6, 0x20, 0x42, 0x32,
+	0x16, 0x76, 0x4a, 0xbb, 0x35, 0x2b, 0x99, 0x9a, 0x0f, 0xa1, 0x7c, 0xde, 0x3a, 0xd1, 0x0c, 0x1e,
+	0xfd, 0x46, 0x70, 0xa6, 0x61, 0x57, 0xac, 0x64, 0x6a, 0x3e, 0x86, 0x72, 0xa3, 0x7d, 0x86, 0xd6,
+	0x60, 0x81, 0x3a, 0x9a, 0xb6, 0x6a, 0x2d, 0x50, 0x07, 0xd5, 0xa1, 0x2a, 0xe8, 0x85, 0x4b, 0x59,
+	0x4f, 0x18, 0x0b, 0x3b, 0xe5, 0xdd, 0x55, 0x6b, 0x34, 0x37, 0xf7, 0xe1, 0x76, 0x27, 0x1a, 0x67,
+	0xc4, 0xd6, 0x61, 0x71, 0x80, 0xdd, 0x90, 0x68, 0x33, 0x2a, 0x56, 0x34, 0x31, 0x9b, 0xb0, 0xd8,
+	0xc6, 0x3d, 0x22, 0x14, 0xd9, 0xe6, 0x21, 0x93, 0x5a, 0xa2, 0x62, 0x45, 0x13, 0x84, 0xa0, 0x12,
+	0x32, 0x2a, 0x63, 0xd3, 0xf5, 0x58, 0xad, 0x09, 0xfa, 0x91, 0x18, 0x65, 0x0d, 0xad, 0xc7, 0xe6,
+	0x53, 0x58, 0x6a, 0x11, 0x8f, 0x07, 0x43, 0xb4, 0x09, 0x4b, 0xd8, 0x4b, 0x01, 0xc5, 0xb3, 0x3c,
+	0x24, 0xf3, 0x3f, 0x25, 0xa8, 0x34, 0x88, 0xeb, 0x66, 0x6c, 0xdd, 0x87, 0x25, 0x4f, 0xc3, 0x69,
+	0xf6, 0xe5, 0x27, 0x5b, 0x19, 0x4f, 0x47, 0xda, 0xac, 0x98, 0x0d, 0x7d, 0x01, 0x8b, 0xbe, 0xda,
+	0x86, 0x51, 0xde, 0x29, 0xef, 0x2e, 0x3f, 0xd9, 0xcc, 0xf0, 0xeb, 0x4d, 0x5a, 0x11, 0x13, 0x7a,
+	0x06, 0x35, 0x87, 0x0a, 0x89, 0x99, 0x4d, 0x84, 0x51, 0xd1, 0x12, 0x46, 0x46, 0x22, 0xf6, 0xa3,
+	0x75, 0xc5, 0x8a, 0x76, 0xa1, 0x62, 0xfb, 0xa1, 0x30, 0x16, 0xb5, 0xc8, 0x7a, 0x46, 0xa4, 0xd1,
+	0x3e, 0xb3, 0x34, 0x87, 0xf9, 0x02, 0xaa, 0xa7, 0xdc, 0xe7, 0x2e, 0xef, 0x0d, 0xd1, 0x53, 0x00,
+	0x16, 0x7a, 0xf8, 0x07, 0x9b, 0xb8, 0xae, 0x30, 0x4a, 0x5a, 0x76, 0x23, 0x2b, 0x4b, 0x5c, 0xd7,
+	0xaa, 0x29, 0x46, 0x35, 0x12, 0xe6, 0x3f, 0x4a, 0xb0, 0xd4, 0x69, 0x1d, 0x50, 0x2e, 0x90, 0x09,
+	0x2b, 0x1e, 0x66, 0x61, 0x17, 0xdb, 0x32, 0x0c, 0x48, 0xa0, 0xfd, 0x54, 0xb3, 0xc6, 0xd6, 0x54,
+	0x14, 0xf9, 0x01, 0x77, 0x42, 0x3b, 0xf1, 0x70, 0x32, 0x4d, 0x07, 0x60, 0x79, 0x2c, 0x00, 0xd1,
+	0x1d, 0x28, 0x8b, 0xcb, 0xd0, 0xa8, 0xe8, 0x55, 0x35, 0x54, 0x87, 0xd7, 0xc5, 0x1e, 0x75, 0x87,
+	0xc6, 0xa2, 0x5e, 0x8c, 0x67, 0xe6, 0xdf, 0x4b, 0x50, 0x3d, 0xa4, 0xe2, 0xf2, 0x84, 0x75, 0xb9,
+	0x66, 0xe2, 0x81, 0x87, 0x65, 0x6c, 0x48, 0x3c, 0x43, 0x3b, 0xb0, 0x7c, 0x81, 0xed, 0x4b, 0xca,
+	0x7a, 0x47, 0xd4, 0x25, 0xb1, 0x19, 0xe9, 0x25, 0xf4, 0x00, 0x40, 0xd9, 0x8b, 0xdd, 0x4e, 0x12,
+	0x3f, 0x15, 0x2b, 0xb5, 0xa2, 0x10, 0x94, 0x4b, 0x12, 0x86, 0x8a, 0x66, 0x48, 0x2f, 0x99, 0xff,
+	0x2e, 0xc3, 0x6a, 0xc3, 0x0d, 0x85, 0x24, 0x41, 0x83, 0xb3, 0x2e, 0xed, 0xa1, 0x3d, 0x40, 0xcd,
+	0x0f, 0x3e, 0x66, 0x8e, 0xb2, 0x4f, 0x34, 0x19, 0xbe, 0x70, 0x49, 0x14, 0x4a, 0x55, 0x2b, 0x87,
+	0x82, 0x7e, 0x0b, 0xdb, 0x47, 0x01, 0x21, 0x2a, 0x1e, 0x2c, 0xe2, 0xf3, 0x40, 0x52, 0xd6, 0x3b,
+	0xa4, 0x22, 0x12, 0x5b, 0xd0, 0x62, 0xc5, 0x0c, 0xe8, 0x39, 0x18, 0x07, 0xdc, 0xee, 0x8b, 0x43,
+	0x2a, 0x7c, 0x17, 0x0f, 0x8f, 0x78, 0xd0, 0x3c, 0x3a, 0x39, 0x0e, 0x89, 0x90, 0x42, 0xef, 0xa7,
+	0x6a, 0x15, 0xd2, 0x95, 0x6c, 0x87, 0x04, 0x14, 0xbb, 0x0d, 0xce, 0x04, 0x77, 0xc9, 0x6b, 0x7e,
+	0xa5, 0xb8, 0x12, 0xc9, 0x16, 0xd1, 0x51, 0x17, 0x50, 0x0b, 0xdb, 0x7d, 0xca, 0xc8, 0xe9, 0xd0,
+	0x27, 0x2f, 0x5d, 0x8a, 0x05, 0x49, 0xe2, 0xf0, 0x59, 0x36, 0x96, 0xd2, 0x1e, 0xda, 0xcb, 0x0a,
+	0x36, 0x99, 0x0c, 0x86, 0x56, 0x0e, 0xa2, 0xf2, 0x66, 0x3b, 0x78, 0x45, 0x5c, 0x9f, 0x04, 0x1d,
+	0x6e, 0x5f, 0x12, 0xd9, 0xc6, 0xb2, 0x6f, 0x2c, 0xe9, 0xa3, 0xcc, 0xa1, 0xd4, 0x9b, 0xb0, 0x55,
+	0x00, 0xaf, 0xa2, 0xeb, 0x92, 0x0c, 0xe3, 0x18, 0x51, 0xc3, 0xf1, 0x0a, 0x54, 0x8b, 0x2b, 0xd0,
+	0xf3, 0x85, 0xdf, 0x94, 0xcc, 0xaf, 0x60, 0xfb, 0x84, 0x49, 0x12, 0x74, 0xb1, 0x4d, 0x0e, 0x28,
+	0x73, 0x28, 0xeb, 0xb5, 0x68, 0x2f, 0xc0, 0x52, 0x85, 0xe9, 0xa6, 0xaa, 0x2d, 0xb2, 0xcf, 0x9d,
+	0x24, 0xde, 0xa2, 0x99, 0xf9, 0xdf, 0xdb, 0xb0, 0x71, 0x1e, 0xc5, 0x46, 0x6c, 0xc3, 0x5b, 0x5f,
+	0x09, 0x08, 0xf4, 0x2d, 0xac, 0x8f, 0x13, 0xa2, 0x44, 0x32, 0x4a, 0x05, 0xc5, 0x24, 0x22, 0x5b,
+	0xb9, 0x42, 0xe8, 0x29, 0x6c, 0xb4, 0x88, 0x77, 0x80, 0x5d, 0x97, 0x73, 0xd6, 0x91, 0x58, 0x8a,
+	0x36, 0x09, 0x28, 0x8f, 0x82, 0x65, 0xd5, 0xca, 0x27, 0xa2, 0x5f, 0xc3, 0xbd, 0x76, 0x40, 0xd4,
+	0xba, 0x8d, 0x25, 0x71, 0xce, 0xb9, 0x1b, 0x7a, 0x71, 0x79, 0xaa, 0x59, 0x79, 0x24, 0x75, 0xbf,
+	0xc8, 0xb8, 0x64, 0x18, 0x95, 0x82, 0xfb, 0x25, 0xa9, 0x29, 0xd6, 0x88, 0x15, 0x75, 0xa0, 0xa6,
+	0xe3, 0x5b, 0xa5, 0x66, 0x1c, 0x10, 0x5f, 0x67, 0xe4, 0x72, 0xdd, 0xb4, 0x37, 0x92, 0x8b, 0xe2,
+	0xe1, 0x0a, 0xa7, 0x20, 0xa9, 0x96, 0x0a, 0x93, 0xea, 0x10, 0x56, 0xed, 0x74, 0xcc, 0x19, 0xb7,
+	0xf5, 0x06, 0x1e, 0x5c, 0x1f, 0x99, 0xd6, 0xb8, 0x10, 0xfa, 0xa9, 0x04, 0xdb, 0x34, 0x09, 0x83,
+	0x43, 0xee, 0x61, 0xca, 0x5e, 0x4a, 0x89, 0xed, 0xbe, 0x47, 0x98, 0x34, 0xaa, 0x7a, 0x6f, 0xcd,
+	0x4f, 0xdc, 0xdb, 0x49, 0x11, 0x4e, 0xb4, 0xd7, 0x62, 0x3d, 0x88, 0x01, 0x1a, 0x11, 0x47, 0x41,
+	0x68, 0xd4, 0xb4, 0xf6, 0xdf, 0xdd, 0x54, 0xfb, 0x08, 0x20, 0x4e, 0xb9, 0x2c, 0x72, 0xfd, 0x3d,
+	0xac, 0x8d, 0x1f, 0x44, 0x4e, 0xe6, 0xec, 0xa7, 0x33, 0x27, 0x2f, 0x30, 0x92, 0xe2, 0x9c, 0x4a,
+	0xaa, 0xfa, 0x6b, 0x78, 0x70, 0xbd, 0x17, 0x6e, 0x92, 0xa2, 0xf5, 0x1f, 0x61, 0xab, 0x60, 0x57,
+	0x39, 0x30, 0x2f, 0xc6, 0xed, 0xfd, 0x55, 0xc6, 0xde, 0xc2, 0x6c, 0x4f, 0x57, 0x85, 0x01, 0xc0,
+	0x79, 0xeb, 0xc4, 0x22, 0x3f, 0xaa, 0xfa, 0x89, 0x1e, 0x41, 0x79, 0xe0, 0xd1, 0x38, 0x87, 0xb3,
+	0x77, 0xaf, 0xe2, 0x54, 0x0c, 0xe8, 0x05, 0xdc, 0xe6, 0xd1, 0x31, 0xc4, 0xda, 0x1f, 0x7d, 0xda,
+	0xa1, 0x59, 0x89, 0x98, 0x79, 0x0a, 0x77, 0xae, 0xec, 0xb9, 0xa1, 0x76, 0x63, 0x5c, 0xfb, 0xca,
+	0x15, 0xea, 0x4f, 0x25, 0x58, 0x6e, 0x7e, 0x20, 0x76, 0x82, 0xf8, 0x00, 0xc0, 0xd1, 0xa7, 0xf2,
+	0x06, 0x7b, 0x24, 0x76, 0x5e, 0x6a, 0x45, 0x21, 0x35, 0xb8, 0xe7, 0x61, 0xe6, 0x24, 0x37, 0x7a,
+	0x3c, 0x55, 0xad, 0xd4, 0xcb, 0xa0, 0x97, 0x14, 0x13, 0x3d, 0x46, 0x8f, 0x60, 0x4d, 0x52, 0x8f,
+	0xf0, 0x50, 0x76, 0x88, 0xcd, 0x99, 0x23, 0x74, 0x0d, 0x59, 0xb4, 0x26, 0x56, 0xcd, 0x35, 0x58,
+	0x69, 0x7a, 0xbe, 0x1c, 0xc6, 0x56, 0x98, 0x7f, 0x82, 0xaa, 0x95, 0x6a, 0x55, 0x45, 0x68, 0xdb,
+	0x44, 0x88, 0xf8, 0xfe, 0x4c, 0xa6, 0x8a, 0xe2, 0x11, 0x21, 0x70, 0x2f, 0x09, 0x8c, 0x64, 0xaa,
+	0x76, 0x41, 0x99, 0xcd, 0x3d, 0xdf, 0x25, 0x92, 0xc4, 0x57, 0x60, 0x6a, 0xc5, 0xfc, 0x01, 0xd6,
+	0xa2, 0xd8, 0x9b, 0xb5, 0x8f, 0xde, 0x84, 0xa5, 0xc8, 0x39, 0xb1, 0x05, 0xf1, 0xcc, 0x64, 0x70,
+	0x2f, 0x52, 0xa0, 0xab, 0xef, 0xac, 0x5a, 0x76, 0x60, 0xd9, 0xb9, 0x42, 0x4b, 0x7a, 0x98, 0xd4,
+	0x92, 0xf9, 0x01, 0xee, 0xea, 0xfb, 0x5c, 0x67, 0xdb, 0x8c, 0xda, 0xbe, 0x80, 0xbb, 0xbd, 0x49,
+	0xac, 0x58, 0x67, 0x96, 0x60, 0xfe, 0xad, 0x04, 0x1b, 0x5a, 0xf5, 0x99, 0x20, 0xc1, 0x6b, 0x2a,
+	0xe4, 0xac, 0xea, 0x9f, 0xc2, 0x46, 0x2f, 0x0f, 0x2f, 0x36, 0x21, 0x9f, 0x68, 0xfe, 0xb3, 0x04,
+	0x86, 0x36, 0x43, 0xb5, 0x74, 0x62, 0x28, 0x24, 0xf1, 0x66, 0x76, 0xfb, 0x73, 0x30, 0x7a, 0x05,
+	0x90, 0xb1, 0x31, 0x85, 0x74, 0x73, 0x08, 0x2b, 0x51, 0x5a, 0xcd, 0x66, 0x42, 0x1d, 0xaa, 0xe4,
+	0x03, 0x95, 0x0d, 0xee, 0x44, 0x2a, 0x17, 0xad, 0xd1, 0x5c, 0xc5, 0x9e, 0x90, 0xce, 0xdb, 0x50,
+	0xc6, 0x1d, 0x74, 0x3c, 0x33, 0xbf, 0x83, 0x3b, 0xda, 0x13, 0x6d, 0xf5, 0x4e, 0xf8, 0xc4, 0xb4,
+	0xce, 0x26, 0xea, 0x42, 0x6e, 0xa2, 0x7e, 0x03, 0x77, 0x53, 0xd8, 0x33, 0xed, 0xcd, 0xe4, 0xb0,
+	0xaa, 0x5a, 0xda, 0x8f, 0xe4, 0xa6, 0xd5, 0xec, 0x19, 0x6c, 0x86, 0xac, 0xab, 0x45, 0x4f, 0xf3,
+	0x8c, 0x2e, 0xa0, 0x9a, 0xef, 0xe1, 0x6e, 0xf4, 0x40, 0x3b, 0x0c, 0x3d, 0xff, 0xa6, 0x4a, 0xeb,
+	0x50, 0x75, 0x42, 0xcf, 0xd7, 0x9d, 0x67, 0x74, 0xf8, 0xa3, 0xb9, 0x79, 0x01, 0x9f, 0x75, 0x9a,
+	0xe7, 0xf3, 0xc8, 0x3d, 0x55, 0xec, 0xc8, 0x40, 0x77, 0x4d, 0x71, 0xa1, 0x8e, 0xa7, 0xe6, 0x5f,
+	0x4b, 0xb0, 0xfd, 0x5a, 0x7f, 0x32, 0x68, 0x11, 0x2c, 0xc2, 0x80, 0xa8, 0x0b, 0x73, 0x0e, 0xa9,
+	0xee, 0x4e, 0x62, 0xc6, 0x8a, 0xb3, 0x04, 0xf3, 0x7b, 0xd5, 0x0f, 0xff, 0x99, 0xd8, 0x32, 0xb2,
+	0xa3, 0x43, 0xec, 0x80, 0xc8, 0xf9, 0x5d, 0x45, 0x02, 0x36, 0x0f, 0x69, 0x20, 0x87, 0x16, 0x96,
+	0x64, 0x2e, 0x65, 0xd3, 0x84, 0x15, 0x27, 0x01, 0x6c, 0x5d, 0x44, 0xfa, 0xca, 0xd6, 0xd8, 0x9a,
+	0x29, 0x00, 0x75, 0xec, 0x80, 0x10, 0x26, 0xfa, 0x7c, 0x66, 0x77, 0x22, 0xa8, 0x78, 0xd4, 0x4b,
+	0x8a, 0x83, 0x1e, 0xab, 0x35, 0x07, 0x4b, 0xac, 0x73, 0x74, 0xc5, 0xd2, 0x63, 0xf3, 0x1d, 0xac,
+	0x1e, 0x60, 0xfb, 0x32, 0xf4, 0xe7, 0xe7, 0xbc, 0x73, 0x40, 0x8d, 0x3e, 0x66, 0x3d, 0xd2, 0x22,
+	0x0e, 0xc5, 0x73, 0xc3, 0x7d, 0xf2, 0xaf, 0x2d, 0x28, 0x37, 0x3c, 0x07, 0xbd, 0x01, 0xd4, 0x19,
+	0x32, 0x7b, 0xbc, 0x47, 0x41, 0xff, 0x97, 0x0b, 0x19, 0x29, 0xaf, 0x17, 0xbb, 0xcc, 0xbc, 0x85,
+	0xde, 0xc2, 0xbd, 0x36, 0x0e, 0x05, 0x99, 0x1b, 0xe0, 0x3b, 0xd8, 0x38, 0x63, 0xfe, 0x5c, 0x21,
+	0x3b, 0xb0, 0x1e, 0x15, 0xa8, 0x09, 0xc4, 0xec, 0x03, 0x62, 0xac, 0x8e, 0x5d, 0x0f, 0x6a, 0xc1,
+	0xe6, 0x19, 0xeb, 0xe6, 0xc1, 0xce, 0xe4, 0x4c, 0x8b, 0x08, 0x22, 0xe7, 0x06, 0x78, 0x0a, 0x46,
+	0x87, 0x77, 0xa5, 0x45, 0x2e, 0x38, 0x9f, 0x1f, 0xaa, 0x05, 0x9b, 0x9d, 0x7e, 0x28, 0x1d, 0xfe,
+	0x17, 0x36, 0x37, 0xcc, 0x37, 0x80, 0xbe, 0xa5, 0xae, 0x3b, 0x37, 0xbc, 0x36, 0xac, 0x1f, 0x12,
+	0xd5, 0x23, 0xce, 0x0d, 0xf1, 0x3d, 0x6c, 0x44, 0x7d, 0xfb, 0x24, 0xe4, 0xcf, 0x32, 0x52, 0x93,
+	0xfd, 0xfd, 0xd4, 0x53, 0x57, 0x29, 0x39, 0x12, 0x3a, 0xc5, 0x41, 0x8f, 0xc8, 0x19, 0x2c, 0xfd,
+	0x3d, 0xdc, 0x6f, 0xa8, 0x4f, 0x8a, 0x13, 0xde, 0x1c, 0x29, 0x98, 0xf1, 0xe8, 0x69, 0x8f, 0x61,
+	0x37, 0x32, 0xb2, 0xcd, 0x9d, 0x86, 0x4b, 0x30, 0x0b, 0xfd, 0x19, 0x30, 0xff, 0x00, 0x0f, 0x8f,
+	0x28, 0xc3, 0x2e, 0xfd, 0x48, 0xe6, 0x6f, 0xf0, 0x1b, 0x40, 0xaf, 0xb8, 0xf4, 0xdd, 0xb0, 0xf7,
+	0x8a, 0x0b, 0x79, 0x48, 0x06, 0xd4, 0x26, 0x62, 0x06, 0xbc, 0x16, 0xd4, 0x8e, 0x89, 0x8c, 0xde,
+	0x04, 0xe8, 0x7e, 0x86, 0x33, 0xfd, 0xfa, 0xa9, 0x3f, 0xcc, 0x3e, 0xa4, 0xc7, 0x1e, 0x2b, 0x3a,
+	0xa8, 0xd6, 0x46, 0x70, 0xfa, 0xae, 0x9c, 0x86, 0xf9, 0x8b, 0x02, 0xcc, 0xb1, 0x8b, 0x56, 0xd7,
+	0xbc, 0x95, 0x63, 0x22, 0x47, 0x6f, 0x89, 0x69, 0xb0, 0x66, 0x86, 0x9c, 0x79, 0x86, 0x68, 0xd0,
+	0xea, 0x31, 0xd1, 0x3d, 0xfb, 0x54, 0x3b, 0x1f, 0xe5, 0x03, 0x66, 0xfa, 0xfd, 0x5b, 0xe8, 0x8f,
+	0xda, 0x05, 0xa9, 0xde, 0x7b, 0x1a, 0xf4, 0xe7, 0xf9, 0xd0, 0x79, 0xdd, 0xfb, 0x2d, 0x74, 0x00,
+	0x15, 0xd5, 0xe3, 0x4e, 0xc3, 0xbc, 0xf6, 0xcc, 0x9b, 0x50, 0x51, 0x6f, 0x00, 0xf4, 0xff, 0x59,
+	0x8c, 0xab, 0x17, 0x77, 0xfd, 0x7e, 0x01, 0x35, 0x55, 0x8c, 0x6b, 0xa3, 0x9e, 0x3b, 0xa7, 0x68,
+	0x4c, 0xf6, 0xfa, 0x75, 0xf3, 0x3a, 0x96, 0x54, 0xf6, 0x18, 0x13, 0x59, 0x33, 0x6a, 0x8d, 0x91,
+	0x59, 0xf0, 0xc7, 0x46, 0xaa, 0x6f, 0x9e, 0x56, 0xf3, 0xd4, 0xd9, 0xa4, 0xfe, 0xaf, 0xba, 0x79,
+	0x78, 0xe6, 0xfc, 0xd9, 0x15, 0xd7, 0x91, 0x4c, 0x1b, 0xd2, 0x68, 0x9f, 0x89, 0x19, 0x2f, 0xbb,
+	0x0c, 0x66, 0xb4, 0xe1, 0x99, 0xee, 0x64, 0x38, 0x26, 0x32, 0x7e, 0x16, 0x4c, 0xdb, 0xfe, 0x4e,
+	0x86, 0x3c, 0xf1, 0x9e, 0x30, 0x6f, 0x21, 0x0c, 0xeb, 0xc7, 0x44, 0x66, 0x9e, 0x00, 0xd7, 0x9b,
+	0x98, 0xfd, 0xc6, 0x55, 0xf8, 0x86, 0x30, 0x6f, 0xa1, 0xef, 0x01, 0x65, 0x1b, 0x7c, 0x94, 0xf7,
+	0x9d, 0xac, 0xe0, 0x15, 0x70, 0xbd, 0x4b, 0x6c, 0xd8, 0x1a, 0x15, 0xad, 0xf1, 0x4e, 0x7f, 0x9a,
+	0x7f, 0x7e, 0x99, 0xf3, 0x69, 0x31, 0xef, 0xa5, 0xa0, 0x6b, 0xcd, 0xaa, 0xf2, 0xfb, 0xa8, 0xa7,
+	0xbf, 0xde, 0x3f, 0x3f, 0xcf, 0x3a, 0x3e, 0xf3, 0x1a, 0x88, 0x3a, 0xc1, 0xa8, 0x61, 0x9f, 0xda,
+	0x09, 0x8e, 0xf5, 0xf5, 0xd3, 0x22, 0x64, 0x39, 0xd5, 0xb2, 0xa3, 0xac, 0x29, 0xd9, 0x86, 0xfe,
+	0x5a, 0xc0, 0x83, 0xca, 0x77, 0x0b, 0x83, 0xc7, 0x17, 0x4b, 0xfa, 0x0f, 0xe4, 0xaf, 0xfe, 0x37,
+	0x00, 0x97, 0x64, 0x18, 0xf3, 0x6d, 0x1e, 0x00, 0x00,
 }
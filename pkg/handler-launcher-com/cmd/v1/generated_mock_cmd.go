@@ -81,6 +81,26 @@ func (mr *MockCmdClientMockRecorder) CancelVirtualMachineMigration(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelVirtualMachineMigration", reflect.TypeOf((*MockCmdClient)(nil).CancelVirtualMachineMigration), varargs...)
 }
 
+// ChangeMedia mocks base method.
+func (m *MockCmdClient) ChangeMedia(ctx context.Context, in *ChangeMediaRequest, opts ...grpc.CallOption) (*Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangeMedia", varargs...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeMedia indicates an expected call of ChangeMedia.
+func (mr *MockCmdClientMockRecorder) ChangeMedia(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeMedia", reflect.TypeOf((*MockCmdClient)(nil).ChangeMedia), varargs...)
+}
+
 // DeleteVirtualMachine mocks base method.
 func (m *MockCmdClient) DeleteVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
 	m.ctrl.T.Helper()
@@ -775,6 +795,21 @@ func (mr *MockCmdServerMockRecorder) CancelVirtualMachineMigration(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelVirtualMachineMigration", reflect.TypeOf((*MockCmdServer)(nil).CancelVirtualMachineMigration), arg0, arg1)
 }
 
+// ChangeMedia mocks base method.
+func (m *MockCmdServer) ChangeMedia(arg0 context.Context, arg1 *ChangeMediaRequest) (*Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeMedia", arg0, arg1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeMedia indicates an expected call of ChangeMedia.
+func (mr *MockCmdServerMockRecorder) ChangeMedia(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeMedia", reflect.TypeOf((*MockCmdServer)(nil).ChangeMedia), arg0, arg1)
+}
+
 // DeleteVirtualMachine mocks base method.
 func (m *MockCmdServer) DeleteVirtualMachine(arg0 context.Context, arg1 *VMIRequest) (*Response, error) {
 	m.ctrl.T.Helper()
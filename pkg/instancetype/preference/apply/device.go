@@ -20,6 +20,8 @@
 package apply
 
 import (
+	"slices"
+
 	virtv1 "kubevirt.io/api/core/v1"
 	v1beta1 "kubevirt.io/api/instancetype/v1beta1"
 
@@ -63,6 +65,10 @@ func ApplyDevicePreferences(preferenceSpec *v1beta1.VirtualMachinePreferenceSpec
 		vmiSpec.Domain.Devices.UseVirtioTransitional = pointer.P(*preferenceSpec.Devices.PreferredUseVirtioTransitional)
 	}
 
+	if len(preferenceSpec.Devices.PreferredVirtioTransitionalDevices) > 0 && len(vmiSpec.Domain.Devices.VirtioTransitionalDevices) == 0 {
+		vmiSpec.Domain.Devices.VirtioTransitionalDevices = slices.Clone(preferenceSpec.Devices.PreferredVirtioTransitionalDevices)
+	}
+
 	if preferenceSpec.Devices.PreferredBlockMultiQueue != nil && vmiSpec.Domain.Devices.BlockMultiQueue == nil {
 		vmiSpec.Domain.Devices.BlockMultiQueue = pointer.P(*preferenceSpec.Devices.PreferredBlockMultiQueue)
 	}
@@ -120,6 +120,7 @@ var _ = Describe("Preference.Devices", func() {
 				PreferredDiskDedicatedIoThread:      pointer.P(true),
 				PreferredDisableHotplug:             pointer.P(true),
 				PreferredUseVirtioTransitional:      pointer.P(true),
+				PreferredVirtioTransitionalDevices:  []virtv1.VirtioDeviceClass{virtv1.VirtioDeviceClassDisk},
 				PreferredNetworkInterfaceMultiQueue: pointer.P(true),
 				PreferredBlockMultiQueue:            pointer.P(true),
 				PreferredDiskBlockSize: &virtv1.BlockSize{
@@ -188,6 +189,7 @@ var _ = Describe("Preference.Devices", func() {
 		// Assert that everything that isn't defined in the VM/VMI should use Preferences
 		Expect(vmi.Spec.Domain.Devices.DisableHotplug).To(Equal(*preferenceSpec.Devices.PreferredDisableHotplug))
 		Expect(vmi.Spec.Domain.Devices.UseVirtioTransitional).To(HaveValue(Equal(*preferenceSpec.Devices.PreferredUseVirtioTransitional)))
+		Expect(vmi.Spec.Domain.Devices.VirtioTransitionalDevices).To(Equal(preferenceSpec.Devices.PreferredVirtioTransitionalDevices))
 		Expect(vmi.Spec.Domain.Devices.Disks[1].Cache).To(Equal(preferenceSpec.Devices.PreferredDiskCache))
 		Expect(vmi.Spec.Domain.Devices.Disks[1].IO).To(Equal(preferenceSpec.Devices.PreferredDiskIO))
 		Expect(vmi.Spec.Domain.Devices.Disks[1].BlockSize).To(HaveValue(Equal(*preferenceSpec.Devices.PreferredDiskBlockSize)))
@@ -222,6 +224,15 @@ var _ = Describe("Preference.Devices", func() {
 		Expect(vmi.Spec.Domain.Devices.Disks[1].DiskDevice.Disk.Bus).To(Equal(diskTypeForTest))
 	})
 
+	It("should not apply PreferredVirtioTransitionalDevices when the VMI defines VirtioTransitionalDevices", func() {
+		vmi.Spec.Domain.Devices.VirtioTransitionalDevices = []virtv1.VirtioDeviceClass{virtv1.VirtioDeviceClassInterface}
+		preferenceSpec.Devices.PreferredVirtioTransitionalDevices = []virtv1.VirtioDeviceClass{virtv1.VirtioDeviceClassDisk}
+
+		Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+
+		Expect(vmi.Spec.Domain.Devices.VirtioTransitionalDevices).To(ConsistOf(virtv1.VirtioDeviceClassInterface))
+	})
+
 	Context("PreferredDiskDedicatedIoThread", func() {
 		DescribeTable("should be ignored when", func(preferenceSpec *v1beta1.VirtualMachinePreferenceSpec) {
 			Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
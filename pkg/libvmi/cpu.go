@@ -86,6 +86,26 @@ func WithNUMAGuestMappingPassthrough() Option {
 	}
 }
 
+// WithNUMANode appends a node to the manual guest NUMA topology.
+func WithNUMANode(cpus, memory string, distances ...v1.NUMADistance) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		if vmi.Spec.Domain.CPU == nil {
+			vmi.Spec.Domain.CPU = &v1.CPU{}
+		}
+		if vmi.Spec.Domain.CPU.NUMA == nil {
+			vmi.Spec.Domain.CPU.NUMA = &v1.NUMA{}
+		}
+		if vmi.Spec.Domain.CPU.NUMA.Manual == nil {
+			vmi.Spec.Domain.CPU.NUMA.Manual = &v1.NUMAManualTopology{}
+		}
+		vmi.Spec.Domain.CPU.NUMA.Manual.Nodes = append(vmi.Spec.Domain.CPU.NUMA.Manual.Nodes, v1.NUMANode{
+			CPUs:      cpus,
+			Memory:    resource.MustParse(memory),
+			Distances: distances,
+		})
+	}
+}
+
 func WithArchitecture(arch string) Option {
 	return func(vmi *v1.VirtualMachineInstance) {
 		vmi.Spec.Architecture = arch
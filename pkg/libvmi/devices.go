@@ -93,6 +93,20 @@ func WithDownwardMetricsChannel() Option {
 	}
 }
 
+// WithChannel adds a virtio-serial channel with the given name
+func WithChannel(name string) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Domain.Devices.Channels = append(vmi.Spec.Domain.Devices.Channels, v1.Channel{Name: name})
+	}
+}
+
+// WithSerial adds an additional serial port with the given name
+func WithSerial(name string) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Domain.Devices.Serials = append(vmi.Spec.Domain.Devices.Serials, v1.Serial{Name: name})
+	}
+}
+
 func WithoutSerialConsole() Option {
 	return func(vmi *v1.VirtualMachineInstance) {
 		enabled := false
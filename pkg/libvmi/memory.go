@@ -55,6 +55,22 @@ func WithMaxGuest(memory string) Option {
 	}
 }
 
+// WithVirtioMemHotplug hot(un)plugs the memory through a virtio-mem device of the given block size.
+// An empty block size keeps the default one.
+func WithVirtioMemHotplug(blockSize string) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		if vmi.Spec.Domain.Memory == nil {
+			vmi.Spec.Domain.Memory = &v1.Memory{}
+		}
+		virtioMem := &v1.VirtioMemHotplug{}
+		if blockSize != "" {
+			quantity := resource.MustParse(blockSize)
+			virtioMem.BlockSize = &quantity
+		}
+		vmi.Spec.Domain.Memory.Hotplug = &v1.MemoryHotplug{VirtioMem: virtioMem}
+	}
+}
+
 // Deprecated: Use WithMemoryRequest instead
 // WithResourceMemory specifies the vmi memory resource.
 func WithResourceMemory(value string) Option {
@@ -63,21 +63,30 @@ func ValidateLiveUpdateMemory(vmSpec *v1.VirtualMachineInstanceSpec, maxGuest *r
 		return fmt.Errorf("Memory hotplug is not compatible with guest mapping passthrough")
 	}
 
-	if domain.LaunchSecurity != nil {
-		return fmt.Errorf("Memory hotplug is not compatible with encrypted VMs")
+	if domain.CPU != nil &&
+		domain.CPU.NUMA != nil &&
+		domain.CPU.NUMA.Manual != nil {
+		return fmt.Errorf("Memory hotplug is not compatible with a manual guest NUMA topology")
 	}
 
-	blockAlignment := HotplugBlockAlignmentBytes
-	if domain.Memory != nil &&
-		domain.Memory.Hugepages != nil &&
-		domain.Memory.Hugepages.PageSize == "1Gi" {
-		blockAlignment = Hotplug1GHugePagesBlockAlignmentBytes
+	if domain.LaunchSecurity != nil {
+		return fmt.Errorf("Memory hotplug is not compatible with encrypted VMs")
 	}
 
 	if domain.Memory == nil ||
 		domain.Memory.Guest == nil {
 		return fmt.Errorf("Guest memory must be configured when memory hotplug is enabled")
 	}
+
+	if IsVirtioMemHotplug(domain.Memory) && domain.Memory.Hotplug.VirtioMem.BlockSize != nil {
+		blockSize := domain.Memory.Hotplug.VirtioMem.BlockSize.Value()
+		minBlockSize := minHotplugBlockSize(domain.Memory)
+		if blockSize < minBlockSize || blockSize&(blockSize-1) != 0 {
+			return fmt.Errorf("Memory hotplug block size must be a power of 2 and at least %s",
+				resource.NewQuantity(minBlockSize, resource.BinarySI))
+		}
+	}
+	blockAlignment := hotplugBlockSize(domain.Memory)
 	if maxGuest == nil {
 		return fmt.Errorf("Max guest memory must be configured when memory hotplug is enabled")
 	}
@@ -106,36 +115,58 @@ func ValidateLiveUpdateMemory(vmSpec *v1.VirtualMachineInstanceSpec, maxGuest *r
 	return nil
 }
 
+// IsVirtioMemHotplug reports whether the memory is hot(un)plugged through a virtio-mem device created at boot.
+func IsVirtioMemHotplug(memory *v1.Memory) bool {
+	return memory != nil && memory.Hotplug != nil && memory.Hotplug.VirtioMem != nil
+}
+
+func minHotplugBlockSize(memory *v1.Memory) int64 {
+	if memory != nil &&
+		memory.Hugepages != nil &&
+		memory.Hugepages.PageSize == "1Gi" {
+		return Hotplug1GHugePagesBlockAlignmentBytes
+	}
+	return HotplugBlockAlignmentBytes
+}
+
+func hotplugBlockSize(memory *v1.Memory) int64 {
+	if IsVirtioMemHotplug(memory) && memory.Hotplug.VirtioMem.BlockSize != nil {
+		return memory.Hotplug.VirtioMem.BlockSize.Value()
+	}
+	return minHotplugBlockSize(memory)
+}
+
 func BuildMemoryDevice(vmi *v1.VirtualMachineInstance) (*api.MemoryDevice, error) {
-	domain := vmi.Spec.Domain
+	return buildMemoryDevice(vmi.Spec.Domain.Memory, *vmi.Status.Memory.GuestAtBoot)
+}
+
+// BuildBootMemoryDevice builds the virtio-mem device the domain is defined with.
+// The guest boots with its Guest memory, so none of the device memory is requested yet.
+func BuildBootMemoryDevice(vmi *v1.VirtualMachineInstance) (*api.MemoryDevice, error) {
+	return buildMemoryDevice(vmi.Spec.Domain.Memory, *vmi.Spec.Domain.Memory.Guest)
+}
 
-	pluggableMemory := domain.Memory.MaxGuest.DeepCopy()
-	pluggableMemory.Sub(*vmi.Status.Memory.GuestAtBoot)
+func buildMemoryDevice(memory *v1.Memory, guestAtBoot resource.Quantity) (*api.MemoryDevice, error) {
+	pluggableMemory := memory.MaxGuest.DeepCopy()
+	pluggableMemory.Sub(guestAtBoot)
 	pluggableMemorySize, err := vcpu.QuantityToByte(pluggableMemory)
 	if err != nil {
 		return nil, err
 	}
 
-	requestedHotPlugMemory := domain.Memory.Guest.DeepCopy()
-	requestedHotPlugMemory.Sub(*vmi.Status.Memory.GuestAtBoot)
+	requestedHotPlugMemory := memory.Guest.DeepCopy()
+	requestedHotPlugMemory.Sub(guestAtBoot)
 	pluggableMemoryRequested, err := vcpu.QuantityToByte(requestedHotPlugMemory)
 	if err != nil {
 		return nil, err
 	}
 
-	blockAlignment := HotplugBlockAlignmentBytes
-	if domain.Memory != nil &&
-		domain.Memory.Hugepages != nil &&
-		domain.Memory.Hugepages.PageSize == "1Gi" {
-		blockAlignment = Hotplug1GHugePagesBlockAlignmentBytes
-	}
-
 	return &api.MemoryDevice{
 		Model: "virtio-mem",
 		Target: &api.MemoryTarget{
 			Size:      pluggableMemorySize,
 			Node:      "0",
-			Block:     api.Memory{Unit: "b", Value: uint64(blockAlignment)},
+			Block:     api.Memory{Unit: "b", Value: uint64(hotplugBlockSize(memory))},
 			Requested: pluggableMemoryRequested,
 		},
 	}, nil
@@ -64,6 +64,10 @@ var _ = Describe("LiveUpdate Memory", func() {
 					libvmi.WithHugepages("2Mi"),
 					libvmi.WithGuestMemory("1Gi"),
 				),
+				Entry("a manual guest NUMA topology is configured", "4Gi",
+					libvmi.WithNUMANode("0", "1Gi"),
+					libvmi.WithGuestMemory("1Gi"),
+				),
 				Entry("guest memory is not set", "4Gi"),
 				Entry("guest memory is greater than maxGuest", "2Gi",
 					libvmi.WithGuestMemory("4Gi"),
@@ -81,7 +85,31 @@ var _ = Describe("LiveUpdate Memory", func() {
 				Entry("guest memory is less than 1Gi", "4Gi",
 					libvmi.WithGuestMemory("1022Mi"),
 				),
+				Entry("virtio-mem block size is not a power of 2", "4Gi",
+					libvmi.WithGuestMemory("1Gi"),
+					libvmi.WithVirtioMemHotplug("6Mi"),
+				),
+				Entry("virtio-mem block size is smaller than the hugepages", "4Gi",
+					libvmi.WithGuestMemory("1Gi"),
+					libvmi.WithHugepages("1Gi"),
+					libvmi.WithVirtioMemHotplug("2Mi"),
+				),
+				Entry("guest memory is not aligned to the virtio-mem block size", "4Gi",
+					libvmi.WithGuestMemory("1088Mi"),
+					libvmi.WithVirtioMemHotplug("128Mi"),
+				),
 			)
+
+			It("should accept a virtio-mem block size which is a power of 2", func() {
+				vm := libvmi.NewVirtualMachine(libvmi.New(
+					libvmi.WithArchitecture("amd64"),
+					libvmi.WithGuestMemory("1Gi"),
+					libvmi.WithVirtioMemHotplug("128Mi"),
+				))
+
+				maxGuest := resource.MustParse("4Gi")
+				Expect(memory.ValidateLiveUpdateMemory(&vm.Spec.Template.Spec, &maxGuest)).To(Succeed())
+			})
 		})
 
 		Context("virtio-mem device", func() {
@@ -140,6 +168,38 @@ var _ = Describe("LiveUpdate Memory", func() {
 				Entry("when using a VM with 2Mi sized hugepages", libvmi.WithHugepages("2Mi")),
 				Entry("when using a VM with 1Gi sized hugepages", libvmi.WithHugepages("1Gi")),
 			)
+
+			It("should use the virtio-mem block size", func() {
+				vmi := libvmi.New(
+					libvmi.WithGuestMemory("1Gi"),
+					libvmi.WithMaxGuest("4Gi"),
+					libvmi.WithVirtioMemHotplug("128Mi"),
+				)
+
+				memoryDevice, err := memory.BuildBootMemoryDevice(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(memoryDevice.Target.Block).To(Equal(api.Memory{Unit: "b", Value: 128 * 1024 * 1024}))
+			})
+
+			It("should build the boot device with all its memory unplugged", func() {
+				vmi := libvmi.New(
+					libvmi.WithGuestMemory("1Gi"),
+					libvmi.WithMaxGuest("4Gi"),
+					libvmi.WithVirtioMemHotplug(""),
+				)
+
+				memoryDevice, err := memory.BuildBootMemoryDevice(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(*memoryDevice).To(Equal(api.MemoryDevice{
+					Model: "virtio-mem",
+					Target: &api.MemoryTarget{
+						Size:      api.Memory{Unit: "b", Value: 3 * 1024 * 1024 * 1024},
+						Node:      "0",
+						Block:     api.Memory{Unit: "b", Value: uint64(memory.HotplugBlockAlignmentBytes)},
+						Requested: api.Memory{Unit: "b", Value: 0},
+					},
+				}))
+			})
 		})
 
 	})
@@ -31,6 +31,7 @@ var (
 		activeConsoleConnections,
 		activeUSBRedirConnections,
 		vmiLastConnectionTimestamp,
+		rateLimitedStreamConnections,
 	}
 
 	namespaceAndVMILabels = []string{"namespace", "vmi"}
@@ -67,6 +68,14 @@ var (
 		namespaceAndVMILabels,
 	)
 
+	rateLimitedStreamConnections = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_api_rate_limited_connections_total",
+			Help: "Amount of console, VNC, USB redirection and portforward connections rejected because the user or the vmi opened too many of them, broken down by namespace, subresource and exceeded limit (user or vmi).",
+		},
+		[]string{"namespace", "subresource", "limit"},
+	)
+
 	vmiLastConnectionTimestamp = operatormetrics.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_last_api_connection_timestamp_seconds",
@@ -115,3 +124,9 @@ func NewActiveUSBRedirConnection(namespace, name string) Decrementer {
 func SetVMILastConnectionTimestamp(namespace, name string) {
 	vmiLastConnectionTimestamp.WithLabelValues(namespace, name).Set(float64(time.Now().Unix()))
 }
+
+// IncRateLimitedStreamConnections increments the metric for the connections to a subresource of the namespace
+// rejected because the given limit was exceeded
+func IncRateLimitedStreamConnections(namespace, subresource, limit string) {
+	rateLimitedStreamConnections.WithLabelValues(namespace, subresource, limit).Inc()
+}
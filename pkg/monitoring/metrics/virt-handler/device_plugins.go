@@ -0,0 +1,86 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_handler
+
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+
+	device_manager "kubevirt.io/kubevirt/pkg/virt-handler/device-manager"
+)
+
+// DevicePluginStatuses returns the status of the device plugins of virt-handler, indexed by resource name
+type DevicePluginStatuses func() map[string]device_manager.DevicePluginStatus
+
+var (
+	devicePluginNode     string
+	devicePluginStatuses DevicePluginStatuses
+
+	devicePluginCollector = operatormetrics.Collector{
+		Metrics: []operatormetrics.Metric{
+			devicePluginStatus,
+			devicePluginRestarts,
+		},
+		CollectCallback: devicePluginCollectorCallback,
+	}
+
+	devicePluginStatus = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_device_plugin_status",
+			Help: "The phase of the device plugins of virt-handler, broken down by resource name. The value is 1 for the current phase of the plugin.",
+		},
+		[]string{"node", "resource", "phase"},
+	)
+
+	devicePluginRestarts = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_device_plugin_restarts_total",
+			Help: "The number of times a device plugin of virt-handler was started again after returning, broken down by resource name.",
+		},
+		[]string{"node", "resource"},
+	)
+)
+
+func setupDevicePluginCollector(nodeName string, statuses DevicePluginStatuses) {
+	devicePluginNode = nodeName
+	devicePluginStatuses = statuses
+}
+
+func devicePluginCollectorCallback() []operatormetrics.CollectorResult {
+	if devicePluginStatuses == nil {
+		return []operatormetrics.CollectorResult{}
+	}
+
+	var crs []operatormetrics.CollectorResult
+	for resource, status := range devicePluginStatuses() {
+		crs = append(crs,
+			operatormetrics.CollectorResult{
+				Metric: devicePluginStatus,
+				Labels: []string{devicePluginNode, resource, string(status.Phase)},
+				Value:  1,
+			},
+			operatormetrics.CollectorResult{
+				Metric: devicePluginRestarts,
+				Labels: []string{devicePluginNode, resource},
+				Value:  float64(status.Restarts),
+			},
+		)
+	}
+	return crs
+}
@@ -0,0 +1,60 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_handler
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+
+	device_manager "kubevirt.io/kubevirt/pkg/virt-handler/device-manager"
+)
+
+var _ = Describe("device plugin metrics", func() {
+	AfterEach(func() {
+		setupDevicePluginCollector("", nil)
+	})
+
+	It("should not report anything without device plugins", func() {
+		Expect(devicePluginCollectorCallback()).To(BeEmpty())
+	})
+
+	It("should report the phase and the restarts of the device plugins", func() {
+		setupDevicePluginCollector("test-node", func() map[string]device_manager.DevicePluginStatus {
+			return map[string]device_manager.DevicePluginStatus{
+				"devices.kubevirt.io/kvm": {Phase: device_manager.DevicePluginBackoff, Restarts: 3},
+			}
+		})
+
+		Expect(devicePluginCollectorCallback()).To(ConsistOf(
+			operatormetrics.CollectorResult{
+				Metric: devicePluginStatus,
+				Labels: []string{"test-node", "devices.kubevirt.io/kvm", "Backoff"},
+				Value:  1,
+			},
+			operatormetrics.CollectorResult{
+				Metric: devicePluginRestarts,
+				Labels: []string{"test-node", "devices.kubevirt.io/kvm"},
+				Value:  3,
+			},
+		))
+	})
+})
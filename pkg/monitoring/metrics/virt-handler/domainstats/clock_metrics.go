@@ -0,0 +1,55 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domainstats
+
+import (
+	"time"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+)
+
+var guestClockDriftSeconds = operatormetrics.NewGauge(
+	operatormetrics.MetricOpts{
+		Name: "kubevirt_vmi_guest_clock_drift_seconds",
+		Help: "Difference between the guest time reported by the guest agent and the host time. Positive when the guest clock is ahead of the host clock.",
+	},
+)
+
+type clockMetrics struct{}
+
+func (clockMetrics) Describe() []operatormetrics.Metric {
+	return []operatormetrics.Metric{
+		guestClockDriftSeconds,
+	}
+}
+
+func (clockMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operatormetrics.CollectorResult {
+	var crs []operatormetrics.CollectorResult
+
+	if vmiReport.vmiStats.DomainStats == nil || vmiReport.vmiStats.DomainStats.ClockDrift == nil {
+		return crs
+	}
+
+	if clockDrift := vmiReport.vmiStats.DomainStats.ClockDrift; clockDrift.DriftSet {
+		crs = append(crs, vmiReport.newCollectorResult(guestClockDriftSeconds, time.Duration(clockDrift.Drift).Seconds()))
+	}
+
+	return crs
+}
@@ -0,0 +1,63 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domainstats
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/testing"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("clock metrics", func() {
+	Context("on Collect", func() {
+		vmi := &k6tv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-vmi-1",
+				Namespace: "test-ns-1",
+			},
+		}
+
+		It("should collect the guest clock drift", func() {
+			vmiStats := &VirtualMachineInstanceStats{
+				DomainStats: &stats.DomainStats{
+					ClockDrift: &stats.DomainStatsClockDrift{DriftSet: true, Drift: -1500000000},
+				},
+			}
+			crs := clockMetrics{}.Collect(newVirtualMachineInstanceReport(vmi, vmiStats))
+			Expect(crs).To(HaveLen(1))
+			Expect(crs).To(ContainElement(testing.GomegaContainsCollectorResultMatcher(guestClockDriftSeconds, -1.5)))
+		})
+
+		It("should return empty result if the drift is not set", func() {
+			vmiStats := &VirtualMachineInstanceStats{
+				DomainStats: &stats.DomainStats{
+					ClockDrift: &stats.DomainStatsClockDrift{},
+				},
+			}
+			crs := clockMetrics{}.Collect(newVirtualMachineInstanceReport(vmi, vmiStats))
+			Expect(crs).To(BeEmpty())
+		})
+	})
+})
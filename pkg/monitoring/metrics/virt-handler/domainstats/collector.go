@@ -40,6 +40,7 @@ var (
 		networkMetrics{},
 		cpuAffinityMetrics{},
 		filesystemMetrics{},
+		clockMetrics{},
 	}
 
 	Collector = operatormetrics.Collector{
@@ -29,7 +29,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler/migrationdomainstats"
 )
 
-func SetupMetrics(nodeName string, MaxRequestsInFlight int, vmiInformer cache.SharedIndexInformer, machines []libvirtxml.CapsGuestMachine) error {
+func SetupMetrics(nodeName string, MaxRequestsInFlight int, vmiInformer cache.SharedIndexInformer, machines []libvirtxml.CapsGuestMachine, devicePlugins DevicePluginStatuses) error {
 	if err := workqueue.SetupMetrics(); err != nil {
 		return err
 	}
@@ -50,10 +50,13 @@ func SetupMetrics(nodeName string, MaxRequestsInFlight int, vmiInformer cache.Sh
 		return err
 	}
 
+	setupDevicePluginCollector(nodeName, devicePlugins)
+
 	return operatormetrics.RegisterCollector(
 		domainstats.Collector,
 		domainstats.DomainDirtyRateStatsCollector,
 		migrationdomainstats.MigrationStatsCollector,
+		devicePluginCollector,
 	)
 }
 
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"net"
 	"regexp"
+	"strings"
 
 	"kubevirt.io/kubevirt/pkg/network/link"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
@@ -111,8 +112,12 @@ func validateInterfacesFields(field *k8sfield.Path, spec *v1.VirtualMachineInsta
 		causes = append(causes, validateInterfaceModel(field, idx, iface)...)
 		causes = append(causes, validateMacAddress(field, idx, iface)...)
 		causes = append(causes, validatePciAddress(field, idx, iface)...)
+		causes = append(causes, validateCCWAddress(field, idx, iface, spec.Architecture)...)
 		causes = append(causes, validatePortConfiguration(field, idx, iface, networksByName[iface.Name])...)
 		causes = append(causes, validateDHCPOptions(field, idx, iface)...)
+		causes = append(causes, validateInterfaceCoalesce(field, idx, iface)...)
+		causes = append(causes, validateInterfaceOffloads(field, idx, iface)...)
+		causes = append(causes, validateInterfaceQueueSizes(field, idx, iface)...)
 	}
 	return causes
 }
@@ -190,6 +195,79 @@ func validatePciAddress(field *k8sfield.Path, idx int, iface v1.Interface) []met
 	return nil
 }
 
+func validateCCWAddress(field *k8sfield.Path, idx int, iface v1.Interface, architecture string) []metav1.StatusCause {
+	if iface.CCWAddress == "" {
+		return nil
+	}
+	// The architecture is defaulted by the mutating webhook, the address is only rejected once it is known
+	if architecture != "" && architecture != "s390x" {
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf(
+				"interface %s can't have a CCW address on %s, CCW addresses are only available on s390x.",
+				field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+				architecture,
+			),
+			Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("ccwAddress").String(),
+		}}
+	}
+	if iface.PciAddress != "" {
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf(
+				"interface %s can't have both a PCI and a CCW address.",
+				field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+			),
+			Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("ccwAddress").String(),
+		}}
+	}
+	if _, err := hwutil.ParseCCWAddress(iface.CCWAddress); err != nil {
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf(
+				"interface %s has malformed CCW address (%s).",
+				field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+				iface.CCWAddress,
+			),
+			Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("ccwAddress").String(),
+		}}
+	}
+	return nil
+}
+
+// validateCCWAddressesUnique rejects interfaces placed on a CCW address already used by another
+// interface or by a disk, both are plugged on the same channel subsystem.
+func validateCCWAddressesUnique(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	pinned := make(map[string]string)
+	for _, disk := range spec.Domain.Devices.Disks {
+		if disk.Disk != nil && disk.Disk.CCWAddress != "" {
+			pinned[strings.ToLower(disk.Disk.CCWAddress)] = "disk " + disk.Name
+		}
+	}
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.CCWAddress == "" {
+			continue
+		}
+		busID := strings.ToLower(iface.CCWAddress)
+		if other, exists := pinned[busID]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf(
+					"interface %s is placed on the CCW address %s, which is already used by %s.",
+					field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+					iface.CCWAddress,
+					other,
+				),
+				Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("ccwAddress").String(),
+			})
+			continue
+		}
+		pinned[busID] = "interface " + iface.Name
+	}
+	return causes
+}
+
 func validatePortConfiguration(field *k8sfield.Path, idx int, iface v1.Interface, network v1.Network) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if network.Pod != nil && iface.Ports != nil {
@@ -270,10 +348,220 @@ func validateDHCPOptions(field *k8sfield.Path, idx int, iface v1.Interface) []me
 	if iface.DHCPOptions != nil {
 		causes = append(causes, validateDHCPExtraOptions(field, iface)...)
 		causes = append(causes, validateDHCPNTPServersAreValidIPv4Addresses(field, iface, idx)...)
+		causes = append(causes, validateDHCPNextServerIsValidIPv4Address(field, iface, idx)...)
+		causes = append(causes, validateDHCPDNSOverrides(field, iface, idx)...)
 	}
 	return causes
 }
 
+func validateDHCPNextServerIsValidIPv4Address(field *k8sfield.Path, iface v1.Interface, idx int) []metav1.StatusCause {
+	if iface.DHCPOptions.NextServer != "" && net.ParseIP(iface.DHCPOptions.NextServer).To4() == nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "The BOOTP next server must be a valid IPv4 address.",
+			Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("dhcpOptions", "nextServer").String(),
+		}}
+	}
+	return nil
+}
+
+func validateDHCPDNSOverrides(field *k8sfield.Path, iface v1.Interface, idx int) []metav1.StatusCause {
+	dhcpOptionsField := field.Child("domain", "devices", "interfaces").Index(idx).Child("dhcpOptions")
+	dhcpOptions := iface.DHCPOptions
+	if len(dhcpOptions.DNSServers) == 0 && len(dhcpOptions.SearchDomains) == 0 {
+		return nil
+	}
+	if iface.Masquerade == nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "DNS servers and search domains can only be served to the guest with the masquerade binding.",
+			Field:   dhcpOptionsField.String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	for index, ip := range dhcpOptions.DNSServers {
+		if net.ParseIP(ip) == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "DNS servers must be a list of valid IP addresses.",
+				Field:   dhcpOptionsField.Child("dnsServers").Index(index).String(),
+			})
+		}
+	}
+	for index, domain := range dhcpOptions.SearchDomains {
+		if errs := k8svalidation.IsDNS1123Subdomain(domain); len(errs) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("Search domain %s is not valid: %s", domain, errs[0]),
+				Field:   dhcpOptionsField.Child("searchDomains").Index(index).String(),
+			})
+		}
+	}
+	return causes
+}
+
+// validateCreationNetworkBootModel rejects boot interfaces the firmware can't boot from.
+// The s390x firmware is only able to boot from virtio-net-ccw devices.
+func validateCreationNetworkBootModel(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		causes = append(causes, validateNetworkBootModel(field, idx, iface, spec.Architecture)...)
+	}
+	return causes
+}
+
+func validateNetworkBootModel(field *k8sfield.Path, idx int, iface v1.Interface, architecture string) []metav1.StatusCause {
+	if iface.BootOrder == nil || architecture != "s390x" {
+		return nil
+	}
+	if getInterfaceModel(iface) != v1.VirtIO || iface.PciAddress != "" {
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf(
+				"interface %s can only boot from the network on s390x with the virtio model on the ccw bus.",
+				field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+			),
+			Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("bootOrder").String(),
+		}}
+	}
+	return nil
+}
+
+func validateInterfaceCoalesce(field *k8sfield.Path, idx int, iface v1.Interface) []metav1.StatusCause {
+	if iface.Coalesce == nil {
+		return nil
+	}
+	ifaceField := field.Child("domain", "devices", "interfaces").Index(idx)
+	// Interrupt coalescing is applied by the host on the tap device backing a virtio-net interface
+	if iface.SRIOV != nil || getInterfaceModel(iface) != v1.VirtIO {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("interface %s coalesce is supported only for virtio interfaces backed by a tap device.", ifaceField.Child("name").String()),
+			Field:   ifaceField.Child("coalesce").String(),
+		}}
+	}
+	if iface.Coalesce.RxMaxFrames == 0 {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("interface %s coalesce rxMaxFrames must be greater than 0.", ifaceField.Child("name").String()),
+			Field:   ifaceField.Child("coalesce", "rxMaxFrames").String(),
+		}}
+	}
+	return nil
+}
+
+// QEMU does not accept virtio-net queues smaller than 256
+const minInterfaceQueueSize = 256
+
+func validateInterfaceQueueSizes(field *k8sfield.Path, idx int, iface v1.Interface) []metav1.StatusCause {
+	if iface.RxQueueSize == 0 && iface.TxQueueSize == 0 {
+		return nil
+	}
+	ifaceField := field.Child("domain", "devices", "interfaces").Index(idx)
+	if iface.SRIOV != nil || getInterfaceModel(iface) != v1.VirtIO {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("interface %s queue sizes are supported only for virtio interfaces.", ifaceField.Child("name").String()),
+			Field:   ifaceField.Child("model").String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	queueSizes := []struct {
+		name string
+		size uint32
+	}{
+		{name: "rxQueueSize", size: iface.RxQueueSize},
+		{name: "txQueueSize", size: iface.TxQueueSize},
+	}
+	for _, queueSize := range queueSizes {
+		name, size := queueSize.name, queueSize.size
+		if size == 0 {
+			continue
+		}
+		if !hwutil.IsValidVirtqueueSize(size, minInterfaceQueueSize) {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interface %s %s must be a power of 2 between %d and %d.",
+					ifaceField.Child("name").String(), name, minInterfaceQueueSize, hwutil.MaxVirtqueueSize),
+				Field: ifaceField.Child(name).String(),
+			})
+		}
+	}
+	return causes
+}
+
+// RSS distributes the received packets across the queues of an interface, it requires multi-queue interfaces.
+func validateNetworkInterfaceRSS(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	devices := spec.Domain.Devices
+	if devices.NetworkInterfaceRSS == nil || !*devices.NetworkInterfaceRSS {
+		return nil
+	}
+	if devices.NetworkInterfaceMultiQueue == nil || !*devices.NetworkInterfaceMultiQueue {
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires %s to be enabled.",
+				field.Child("domain", "devices", "networkInterfaceRSS").String(),
+				field.Child("domain", "devices", "networkInterfaceMultiqueue").String()),
+			Field: field.Child("domain", "devices", "networkInterfaceRSS").String(),
+		}}
+	}
+	return nil
+}
+
+func validateInterfaceOffloads(field *k8sfield.Path, idx int, iface v1.Interface) []metav1.StatusCause {
+	if iface.Offloads == nil {
+		return nil
+	}
+	// The offloads are features of the virtio-net device, negotiated with the guest driver
+	if iface.SRIOV != nil || getInterfaceModel(iface) != v1.VirtIO {
+		ifaceField := field.Child("domain", "devices", "interfaces").Index(idx)
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("interface %s offloads are supported only for virtio interfaces.", ifaceField.Child("name").String()),
+			Field:   ifaceField.Child("offloads").String(),
+		}}
+	}
+	return nil
+}
+
+// validateInterfaceTxQueueSize rejects the TX queue size unless the interface may have a vhost-user backend,
+// as QEMU ignores it on the other backends. Such a backend can only be set up by a binding plugin which leaves
+// the domain interface to its sidecar.
+func validateInterfaceTxQueueSize(
+	field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, bindings map[string]v1.InterfaceBindingPlugin,
+) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.TxQueueSize == 0 || mayHaveVhostUserBackend(iface, bindings) {
+			continue
+		}
+		ifaceField := field.Child("domain", "devices", "interfaces").Index(idx)
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("interface %s txQueueSize is supported only for vhost-user backends.", ifaceField.Child("name").String()),
+			Field:   ifaceField.Child("txQueueSize").String(),
+		})
+	}
+	return causes
+}
+
+func mayHaveVhostUserBackend(iface v1.Interface, bindings map[string]v1.InterfaceBindingPlugin) bool {
+	if iface.Binding == nil {
+		return false
+	}
+	plugin, exists := bindings[iface.Binding.Name]
+	return exists && (plugin.DomainAttachmentType == "" || plugin.DomainAttachmentType == v1.NoAttachment)
+}
+
+func getInterfaceModel(iface v1.Interface) string {
+	if iface.Model != "" {
+		return iface.Model
+	}
+	return v1.VirtIO
+}
+
 func validateDHCPExtraOptions(field *k8sfield.Path, iface v1.Interface) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	privateOptions := iface.DHCPOptions.PrivateOptions
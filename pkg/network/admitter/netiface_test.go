@@ -31,6 +31,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/pointer"
 )
 
 var _ = Describe("Validating VMI network spec", func() {
@@ -232,6 +233,300 @@ var _ = Describe("Validating VMI network spec", func() {
 		Entry("valid address B", "0001:02:00.0"),
 	)
 
+	DescribeTable("should reject invalid CCW addresses", func(ccwAddress string) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		spec.Domain.Devices.Interfaces[0].CCWAddress = ccwAddress
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: fmt.Sprintf("interface fake.domain.devices.interfaces[0].name has malformed CCW address (%s).", ccwAddress),
+			Field:   "fake.domain.devices.interfaces[0].ccwAddress",
+		}))
+	},
+		Entry("wrong channel subsystem", "1.0.0001"),
+		Entry("subchannel set out of range", "0.4.0001"),
+		Entry("short device number", "0.0.001"),
+	)
+
+	It("should reject an interface with both a PCI and a CCW address", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		spec.Domain.Devices.Interfaces[0].CCWAddress = "0.0.0002"
+		spec.Domain.Devices.Interfaces[0].PciAddress = "0000:81:11.1"
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interface fake.domain.devices.interfaces[0].name can't have both a PCI and a CCW address.",
+			Field:   "fake.domain.devices.interfaces[0].ccwAddress",
+		}))
+	})
+
+	It("should reject a CCW address on another architecture than s390x", func() {
+		spec := &v1.VirtualMachineInstanceSpec{Architecture: "arm64"}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		spec.Domain.Devices.Interfaces[0].CCWAddress = "0.0.0002"
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueNotSupported",
+			Message: "interface fake.domain.devices.interfaces[0].name can't have a CCW address on arm64, CCW addresses are only available on s390x.",
+			Field:   "fake.domain.devices.interfaces[0].ccwAddress",
+		}))
+	})
+
+	DescribeTable("should reject an interface on a CCW address already in use", func(spec *v1.VirtualMachineInstanceSpec, usedBy string) {
+		spec.Architecture = "s390x"
+		spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, v1.Interface{
+			Name:                   "blue",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			CCWAddress:             "0.0.000B",
+		})
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork(), {
+			Name:          "blue",
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "blue-nad"}},
+		}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueDuplicate",
+			Message: fmt.Sprintf("interface fake.domain.devices.interfaces[1].name is placed on the CCW address 0.0.000B, which is already used by %s.", usedBy),
+			Field:   "fake.domain.devices.interfaces[1].ccwAddress",
+		}))
+	},
+		Entry("by another interface", &v1.VirtualMachineInstanceSpec{
+			Domain: v1.DomainSpec{Devices: v1.Devices{Interfaces: []v1.Interface{{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				CCWAddress:             "0.0.000b",
+			}}}},
+		}, "interface default"),
+		Entry("by a disk", &v1.VirtualMachineInstanceSpec{
+			Domain: v1.DomainSpec{Devices: v1.Devices{
+				Disks:      []v1.Disk{{Name: "disk0", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio, CCWAddress: "0.0.000b"}}}},
+				Interfaces: []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()},
+			}},
+		}, "disk disk0"),
+	)
+
+	DescribeTable("should validate the interface coalesce", func(iface v1.Interface, expectedCauses []metav1.StatusCause) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{iface}
+		spec.Networks = []v1.Network{{
+			Name:          "net1",
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "net1-nad"}},
+		}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(expectedCauses))
+	},
+		Entry("accept coalesce on a virtio interface",
+			v1.Interface{Name: "net1", Coalesce: &v1.InterfaceCoalesce{RxMaxFrames: 64},
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+			nil,
+		),
+		Entry("reject coalesce on an emulated interface",
+			v1.Interface{Name: "net1", Model: "e1000", Coalesce: &v1.InterfaceCoalesce{RxMaxFrames: 64},
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+			[]metav1.StatusCause{{
+				Type:    "FieldValueInvalid",
+				Message: "interface fake.domain.devices.interfaces[0].name coalesce is supported only for virtio interfaces backed by a tap device.",
+				Field:   "fake.domain.devices.interfaces[0].coalesce",
+			}},
+		),
+		Entry("reject coalesce on SR-IOV binding",
+			v1.Interface{Name: "net1", Coalesce: &v1.InterfaceCoalesce{RxMaxFrames: 64},
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}},
+			[]metav1.StatusCause{{
+				Type:    "FieldValueInvalid",
+				Message: "interface fake.domain.devices.interfaces[0].name coalesce is supported only for virtio interfaces backed by a tap device.",
+				Field:   "fake.domain.devices.interfaces[0].coalesce",
+			}},
+		),
+		Entry("reject coalesce without received frames",
+			v1.Interface{Name: "net1", Coalesce: &v1.InterfaceCoalesce{},
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+			[]metav1.StatusCause{{
+				Type:    "FieldValueInvalid",
+				Message: "interface fake.domain.devices.interfaces[0].name coalesce rxMaxFrames must be greater than 0.",
+				Field:   "fake.domain.devices.interfaces[0].coalesce.rxMaxFrames",
+			}},
+		),
+	)
+
+	DescribeTable("should validate RSS", func(multiQueue *bool, expectedCauses []metav1.StatusCause) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.NetworkInterfaceMultiQueue = multiQueue
+		spec.Domain.Devices.NetworkInterfaceRSS = pointer.P(true)
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(expectedCauses))
+	},
+		Entry("accept RSS with multi-queue interfaces", pointer.P(true), nil),
+		Entry("reject RSS without multi-queue interfaces", nil, []metav1.StatusCause{{
+			Type:    "FieldValueInvalid",
+			Message: "fake.domain.devices.networkInterfaceRSS requires fake.domain.devices.networkInterfaceMultiqueue to be enabled.",
+			Field:   "fake.domain.devices.networkInterfaceRSS",
+		}}),
+	)
+
+	DescribeTable("should validate the interface queue sizes", func(iface v1.Interface, expectedCauses []metav1.StatusCause) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{iface}
+		spec.Networks = []v1.Network{{
+			Name:          "net1",
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "net1-nad"}},
+		}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{
+			networkBindings: map[string]v1.InterfaceBindingPlugin{
+				"vhostuser":  {SidecarImage: "vhostuser-binding", DomainAttachmentType: v1.NoAttachment},
+				"managedtap": {DomainAttachmentType: v1.ManagedTap},
+			},
+		})
+		Expect(validator.Validate()).To(ConsistOf(expectedCauses))
+	},
+		Entry("accept queue sizes on a virtio interface",
+			v1.Interface{Name: "net1", RxQueueSize: 1024,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+			nil,
+		),
+		Entry("reject queue sizes on an emulated interface",
+			v1.Interface{Name: "net1", Model: "e1000", RxQueueSize: 1024,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+			[]metav1.StatusCause{{
+				Type:    "FieldValueInvalid",
+				Message: "interface fake.domain.devices.interfaces[0].name queue sizes are supported only for virtio interfaces.",
+				Field:   "fake.domain.devices.interfaces[0].model",
+			}},
+		),
+		Entry("reject queue sizes which are not a power of 2",
+			v1.Interface{Name: "net1", RxQueueSize: 768,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+			[]metav1.StatusCause{{
+				Type:    "FieldValueInvalid",
+				Message: "interface fake.domain.devices.interfaces[0].name rxQueueSize must be a power of 2 between 256 and 1024.",
+				Field:   "fake.domain.devices.interfaces[0].rxQueueSize",
+			}},
+		),
+		Entry("reject queue sizes out of range",
+			v1.Interface{Name: "net1", RxQueueSize: 128, TxQueueSize: 2048,
+				Binding: &v1.PluginBinding{Name: "vhostuser"}},
+			[]metav1.StatusCause{{
+				Type:    "FieldValueInvalid",
+				Message: "interface fake.domain.devices.interfaces[0].name rxQueueSize must be a power of 2 between 256 and 1024.",
+				Field:   "fake.domain.devices.interfaces[0].rxQueueSize",
+			}, {
+				Type:    "FieldValueInvalid",
+				Message: "interface fake.domain.devices.interfaces[0].name txQueueSize must be a power of 2 between 256 and 1024.",
+				Field:   "fake.domain.devices.interfaces[0].txQueueSize",
+			}},
+		),
+		Entry("accept the TX queue size on an interface of a binding plugin leaving it to its sidecar",
+			v1.Interface{Name: "net1", TxQueueSize: 1024, Binding: &v1.PluginBinding{Name: "vhostuser"}},
+			nil,
+		),
+		Entry("reject the TX queue size on a bridge interface",
+			v1.Interface{Name: "net1", TxQueueSize: 1024,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+			[]metav1.StatusCause{{
+				Type:    "FieldValueInvalid",
+				Message: "interface fake.domain.devices.interfaces[0].name txQueueSize is supported only for vhost-user backends.",
+				Field:   "fake.domain.devices.interfaces[0].txQueueSize",
+			}},
+		),
+		Entry("reject the TX queue size on an interface of a binding plugin with a tap attachment",
+			v1.Interface{Name: "net1", TxQueueSize: 1024, Binding: &v1.PluginBinding{Name: "managedtap"}},
+			[]metav1.StatusCause{{
+				Type:    "FieldValueInvalid",
+				Message: "interface fake.domain.devices.interfaces[0].name txQueueSize is supported only for vhost-user backends.",
+				Field:   "fake.domain.devices.interfaces[0].txQueueSize",
+			}},
+		),
+	)
+
+	DescribeTable("should validate the interface offloads", func(iface v1.Interface, expectedCauses []metav1.StatusCause) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{iface}
+		spec.Networks = []v1.Network{{
+			Name:          "net1",
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "net1-nad"}},
+		}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(expectedCauses))
+	},
+		Entry("accept offloads on a virtio interface",
+			v1.Interface{Name: "net1", Offloads: &v1.InterfaceOffloads{Host: &v1.InterfaceHostOffloads{TSO4: pointer.P(false)}},
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+			nil,
+		),
+		Entry("reject offloads on an emulated interface",
+			v1.Interface{Name: "net1", Model: "e1000", Offloads: &v1.InterfaceOffloads{},
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+			[]metav1.StatusCause{{
+				Type:    "FieldValueInvalid",
+				Message: "interface fake.domain.devices.interfaces[0].name offloads are supported only for virtio interfaces.",
+				Field:   "fake.domain.devices.interfaces[0].offloads",
+			}},
+		),
+		Entry("reject offloads on SR-IOV binding",
+			v1.Interface{Name: "net1", Offloads: &v1.InterfaceOffloads{},
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}},
+			[]metav1.StatusCause{{
+				Type:    "FieldValueInvalid",
+				Message: "interface fake.domain.devices.interfaces[0].name offloads are supported only for virtio interfaces.",
+				Field:   "fake.domain.devices.interfaces[0].offloads",
+			}},
+		),
+	)
+
+	DescribeTable("should validate the network boot interface model on s390x", func(iface v1.Interface, architecture string, expectedCauses []metav1.StatusCause) {
+		spec := &v1.VirtualMachineInstanceSpec{Architecture: architecture}
+		spec.Domain.Devices.Interfaces = []v1.Interface{iface}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.ValidateCreation()).To(ConsistOf(expectedCauses))
+		Expect(validator.Validate()).To(BeEmpty())
+	},
+		Entry("accept virtio on s390x",
+			v1.Interface{Name: "default", Model: v1.VirtIO, BootOrder: pointer.P(uint(1)),
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}},
+			"s390x", nil,
+		),
+		Entry("accept e1000 on amd64",
+			v1.Interface{Name: "default", Model: "e1000", BootOrder: pointer.P(uint(1)),
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}},
+			"amd64", nil,
+		),
+		Entry("reject e1000 on s390x",
+			v1.Interface{Name: "default", Model: "e1000", BootOrder: pointer.P(uint(1)),
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}},
+			"s390x",
+			[]metav1.StatusCause{{
+				Type:    "FieldValueNotSupported",
+				Message: "interface fake.domain.devices.interfaces[0].name can only boot from the network on s390x with the virtio model on the ccw bus.",
+				Field:   "fake.domain.devices.interfaces[0].bootOrder",
+			}},
+		),
+		Entry("reject a PCI address on s390x",
+			v1.Interface{Name: "default", PciAddress: "0000:81:11.1", BootOrder: pointer.P(uint(1)),
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}},
+			"s390x",
+			[]metav1.StatusCause{{
+				Type:    "FieldValueNotSupported",
+				Message: "interface fake.domain.devices.interfaces[0].name can only boot from the network on s390x with the virtio model on the ccw bus.",
+				Field:   "fake.domain.devices.interfaces[0].bootOrder",
+			}},
+		),
+	)
+
 	When("the interface port is specified", func() {
 		DescribeTable("should reject interface port with", func(ports []v1.Port, expectedCauses []metav1.StatusCause) {
 			spec := &v1.VirtualMachineInstanceSpec{}
@@ -362,6 +657,30 @@ var _ = Describe("Validating VMI network spec", func() {
 					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.ntpServers[1]",
 				}},
 			),
+			Entry(
+				"non-IPv4 BOOTP next server",
+				v1.DHCPOptions{NextServer: "tftp.kubevirt.io"},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "The BOOTP next server must be a valid IPv4 address.",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.nextServer",
+				}},
+			),
+			Entry(
+				"invalid DNS servers and search domains",
+				v1.DHCPOptions{DNSServers: []string{"10.0.0.53", "resolver"}, SearchDomains: []string{"corp.example.com", "Corp_Example"}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "DNS servers must be a list of valid IP addresses.",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.dnsServers[1]",
+				}, {
+					Type: "FieldValueInvalid",
+					Message: "Search domain Corp_Example is not valid: a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, " +
+						"'-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is " +
+						"'[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')",
+					Field: "fake.domain.devices.interfaces[0].dhcpOptions.searchDomains[1]",
+				}},
+			),
 		)
 
 		DescribeTable("should accept interface DHCP options with", func(dhcpOpts v1.DHCPOptions) {
@@ -380,6 +699,12 @@ var _ = Describe("Validating VMI network spec", func() {
 				PrivateOptions: []v1.DHCPPrivateOptions{{Option: 240, Value: "extra.options.kubevirt.io"}},
 			}),
 			Entry(" valid NTP servers", v1.DHCPOptions{NTPServers: []string{"127.0.0.1", "127.0.0.2"}}),
+			Entry("DNS servers and search domains", v1.DHCPOptions{
+				DNSServers: []string{"10.0.0.53", "2001:db8::53"}, SearchDomains: []string{"corp.example.com"},
+			}),
+			Entry("network boot parameters", v1.DHCPOptions{
+				BootFileName: "s390x/kernel.img", TFTPServerName: "tftp.kubevirt.io", NextServer: "10.0.3.1",
+			}),
 			Entry(
 				"unique DHCPPrivateOptions",
 				v1.DHCPOptions{
@@ -390,5 +715,22 @@ var _ = Describe("Validating VMI network spec", func() {
 				},
 			),
 		)
+
+		It("should reject DNS servers on a binding other than masquerade", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				DHCPOptions:            &v1.DHCPOptions{DNSServers: []string{"10.0.0.53"}},
+			}}
+			spec.Networks = []v1.Network{{Name: "default", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}}}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.Validate()).To(ContainElement(metav1.StatusCause{
+				Type:    "FieldValueNotSupported",
+				Message: "DNS servers and search domains can only be served to the guest with the masquerade binding.",
+				Field:   "fake.domain.devices.interfaces[0].dhcpOptions",
+			}))
+		})
 	})
 })
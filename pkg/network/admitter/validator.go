@@ -29,6 +29,7 @@ type clusterConfigChecker interface {
 	IsBridgeInterfaceOnPodNetworkEnabled() bool
 	MacvtapEnabled() bool
 	PasstEnabled() bool
+	GetNetworkBindings() map[string]v1.InterfaceBindingPlugin
 }
 
 type Validator struct {
@@ -58,6 +59,9 @@ func (v Validator) Validate() []metav1.StatusCause {
 	causes = append(causes, validateInterfaceNameUnique(v.field, v.vmiSpec)...)
 	causes = append(causes, validateInterfacesAssignedToNetworks(v.field, v.vmiSpec)...)
 	causes = append(causes, validateInterfacesFields(v.field, v.vmiSpec)...)
+	causes = append(causes, validateCCWAddressesUnique(v.field, v.vmiSpec)...)
+	causes = append(causes, validateInterfaceTxQueueSize(v.field, v.vmiSpec, v.configChecker.GetNetworkBindings())...)
+	causes = append(causes, validateNetworkInterfaceRSS(v.field, v.vmiSpec)...)
 
 	return causes
 }
@@ -66,6 +70,7 @@ func (v Validator) ValidateCreation() []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
 	causes = append(causes, validateCreationSlirpBinding(v.field, v.vmiSpec)...)
+	causes = append(causes, validateCreationNetworkBootModel(v.field, v.vmiSpec)...)
 
 	return causes
 }
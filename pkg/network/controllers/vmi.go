@@ -95,18 +95,28 @@ func calculateSecondaryIfaceStatuses(
 			return nil, fmt.Errorf("could not find the pod interface name for network [%s]", network.Name)
 		}
 
-		_, exists := networkStatusesByPodIfaceName[podIfaceName]
+		networkStatus, exists := networkStatusesByPodIfaceName[podIfaceName]
+		// The VF MAC reported by Multus is the one seen by the guest.
+		// It is recorded so the same MAC can be requested for the VF of a migration target.
+		isSRIOV := isSRIOVInterface(vmi.Spec.Domain.Devices.Interfaces, network.Name)
 		switch {
 		case exists && vmiIfaceStatus == nil:
-			interfaceStatuses = append(interfaceStatuses, v1.VirtualMachineInstanceNetworkInterface{
+			newIfaceStatus := v1.VirtualMachineInstanceNetworkInterface{
 				Name:             network.Name,
 				InfoSource:       vmispec.InfoSourceMultusStatus,
 				PodInterfaceName: podIfaceName,
-			})
+			}
+			if isSRIOV {
+				newIfaceStatus.MAC = networkStatus.Mac
+			}
+			interfaceStatuses = append(interfaceStatuses, newIfaceStatus)
 		case exists && vmiIfaceStatus != nil:
 			updatedIfaceStatus := *vmiIfaceStatus
 			updatedIfaceStatus.InfoSource = vmispec.AddInfoSource(updatedIfaceStatus.InfoSource, vmispec.InfoSourceMultusStatus)
 			updatedIfaceStatus.PodInterfaceName = podIfaceName
+			if isSRIOV && updatedIfaceStatus.MAC == "" {
+				updatedIfaceStatus.MAC = networkStatus.Mac
+			}
 			interfaceStatuses = append(interfaceStatuses, updatedIfaceStatus)
 		case !exists && vmiIfaceStatus != nil:
 			updatedIfaceStatus := *vmiIfaceStatus
@@ -119,6 +129,12 @@ func calculateSecondaryIfaceStatuses(
 	return interfaceStatuses, nil
 }
 
+// isSRIOVInterface reports whether the named interface uses the SR-IOV binding.
+func isSRIOVInterface(ifaces []v1.Interface, name string) bool {
+	iface := vmispec.LookupInterfaceByName(ifaces, name)
+	return iface != nil && iface.SRIOV != nil
+}
+
 func filterUnspecifiedSpecIfaces(
 	ifaceStatuses []v1.VirtualMachineInstanceNetworkInterface,
 	networks []v1.Network,
@@ -81,6 +81,10 @@ func SingleClientDHCPServer(
 		leaseDuration: infiniteLease,
 		options:       options,
 	}
+	if customDHCPOptions != nil {
+		handler.nextServerIP = bootpNextServer(customDHCPOptions)
+		handler.bootFileName = customDHCPOptions.BootFileName
+	}
 
 	l, err := NewUDP4FilterListener(serverIface, ":67")
 	if err != nil {
@@ -186,12 +190,23 @@ func prepareDHCPOptions(
 	return dhcpOptions, nil
 }
 
+// bootpNextServer returns the address to advertise in the BOOTP siaddr field.
+// Without an explicit next server, the TFTP server name is used if it is an IPv4 address.
+func bootpNextServer(customDHCPOptions *v1.DHCPOptions) net.IP {
+	if customDHCPOptions.NextServer != "" {
+		return net.ParseIP(customDHCPOptions.NextServer).To4()
+	}
+	return net.ParseIP(customDHCPOptions.TFTPServerName).To4()
+}
+
 type DHCPHandler struct {
 	serverIP      net.IP
 	clientIP      net.IP
 	clientMAC     net.HardwareAddr
 	leaseDuration time.Duration
 	options       dhcp.Options
+	nextServerIP  net.IP
+	bootFileName  string
 }
 
 func (h *DHCPHandler) ServeDHCP(p dhcp.Packet, msgType dhcp.MessageType, _ dhcp.Options) (d dhcp.Packet) {
@@ -207,13 +222,11 @@ func (h *DHCPHandler) ServeDHCP(p dhcp.Packet, msgType dhcp.MessageType, _ dhcp.
 
 	case dhcp.Discover:
 		log.Log.V(4).Info("The request has message type DISCOVER")
-		return dhcp.ReplyPacket(p, dhcp.Offer, h.serverIP, h.clientIP, h.leaseDuration,
-			h.options.SelectOrderOrAll(nil))
+		return h.reply(p, dhcp.Offer)
 
 	case dhcp.Request:
 		log.Log.V(4).Info("The request has message type REQUEST")
-		return dhcp.ReplyPacket(p, dhcp.ACK, h.serverIP, h.clientIP, h.leaseDuration,
-			h.options.SelectOrderOrAll(nil))
+		return h.reply(p, dhcp.ACK)
 
 	default:
 		log.Log.V(4).Info("The request has unhandled message type")
@@ -222,6 +235,21 @@ func (h *DHCPHandler) ServeDHCP(p dhcp.Packet, msgType dhcp.MessageType, _ dhcp.
 	}
 }
 
+func (h *DHCPHandler) reply(p dhcp.Packet, msgType dhcp.MessageType) dhcp.Packet {
+	reply := dhcp.ReplyPacket(p, msgType, h.serverIP, h.clientIP, h.leaseDuration,
+		h.options.SelectOrderOrAll(nil))
+
+	// Network boot firmwares like the s390x one ignore options 66 and 67
+	// and only look at the legacy BOOTP header fields.
+	if h.nextServerIP != nil {
+		reply.SetSIAddr(h.nextServerIP)
+	}
+	if h.bootFileName != "" {
+		reply.SetFile([]byte(h.bootFileName))
+	}
+	return reply
+}
+
 func sortRoutes(routes []netlink.Route) []netlink.Route {
 	// Default route must come last, otherwise it may not get applied
 	// because there is no route to its gateway yet
@@ -249,4 +249,61 @@ var _ = Describe("DHCP Server", func() {
 			})
 		})
 	})
+
+	Context("BOOTP header of the replies", func() {
+		var (
+			clientMAC = net.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01}
+			clientIP  = net.ParseIP("10.0.2.2").To4()
+		)
+
+		newHandler := func(dhcpOptions *v1.DHCPOptions) *DHCPHandler {
+			handler := &DHCPHandler{
+				clientIP:      clientIP,
+				clientMAC:     clientMAC,
+				serverIP:      net.ParseIP("10.0.2.1").To4(),
+				leaseDuration: infiniteLease,
+				options:       dhcp4.Options{},
+			}
+			if dhcpOptions != nil {
+				handler.nextServerIP = bootpNextServer(dhcpOptions)
+				handler.bootFileName = dhcpOptions.BootFileName
+			}
+			return handler
+		}
+
+		discover := func() dhcp4.Packet {
+			return dhcp4.RequestPacket(dhcp4.Discover, clientMAC, nil, []byte{1, 2, 3, 4}, false, nil)
+		}
+
+		It("should leave the boot fields empty without DHCP options", func() {
+			reply := newHandler(nil).ServeDHCP(discover(), dhcp4.Discover, nil)
+			Expect(reply.SIAddr().Equal(net.IPv4zero)).To(BeTrue())
+			Expect(reply.File()).To(BeEmpty())
+		})
+
+		It("should advertise the next server and boot file", func() {
+			reply := newHandler(&v1.DHCPOptions{
+				BootFileName:   "s390x/kernel.img",
+				TFTPServerName: "tftp.kubevirt.io",
+				NextServer:     "10.0.3.1",
+			}).ServeDHCP(discover(), dhcp4.Discover, nil)
+			Expect(reply.SIAddr().String()).To(Equal("10.0.3.1"))
+			Expect(reply.File()).To(Equal([]byte("s390x/kernel.img")))
+		})
+
+		It("should fall back to an IPv4 TFTP server name for the next server", func() {
+			reply := newHandler(&v1.DHCPOptions{
+				BootFileName:   "pxelinux.0",
+				TFTPServerName: "10.0.3.2",
+			}).ServeDHCP(discover(), dhcp4.Discover, nil)
+			Expect(reply.SIAddr().String()).To(Equal("10.0.3.2"))
+		})
+
+		It("should not set the next server from a TFTP host name", func() {
+			reply := newHandler(&v1.DHCPOptions{
+				TFTPServerName: "tftp.kubevirt.io",
+			}).ServeDHCP(discover(), dhcp4.Discover, nil)
+			Expect(reply.SIAddr().Equal(net.IPv4zero)).To(BeTrue())
+		})
+	})
 })
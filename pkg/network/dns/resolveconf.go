@@ -83,6 +83,23 @@ func ParseNameservers(content string) (*Nameservers, error) {
 	}, nil
 }
 
+// NameserversFromIPs splits the given nameserver addresses by IP family, ignoring invalid ones.
+func NameserversFromIPs(addresses []string) *Nameservers {
+	nameservers := &Nameservers{}
+	for _, address := range addresses {
+		parsedIP := net.ParseIP(address)
+		if parsedIP == nil {
+			continue
+		}
+		if ipv4 := parsedIP.To4(); ipv4 != nil {
+			nameservers.IPv4 = append(nameservers.IPv4, ipv4)
+		} else {
+			nameservers.IPv6 = append(nameservers.IPv6, parsedIP.To16())
+		}
+	}
+	return nameservers
+}
+
 func ParseSearchDomains(content string) ([]string, error) {
 	var searchDomains []string
 
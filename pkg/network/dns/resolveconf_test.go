@@ -75,6 +75,14 @@ var _ = Describe("Resolveconf", func() {
 		})
 	})
 
+	Context("Function NameserversFromIPs()", func() {
+		It("should split the nameservers by IP family and ignore invalid ones", func() {
+			nameservers := NameserversFromIPs([]string{"10.0.0.53", "2001:db8::53", "mynameserver"})
+			Expect(nameservers.IPv4).To(Equal([][]byte{{10, 0, 0, 53}}))
+			Expect(nameservers.IPv6).To(Equal([][]byte{net.ParseIP("2001:db8::53").To16()}))
+		})
+	})
+
 	Context("Function ParseSearchDomains()", func() {
 		It("should return a string of search domains", func() {
 			resolvConf := "search cluster.local svc.cluster.local example.com\nnameserver 8.8.8.8\n"
@@ -21,6 +21,7 @@ package domainspec
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 
 	"github.com/vishvananda/netlink"
@@ -74,12 +75,46 @@ func (b *TapLibvirtSpecGenerator) Generate() error {
 			ifaces[i].MTU = domainIface.MTU
 			ifaces[i].MAC = domainIface.MAC
 			ifaces[i].Target = domainIface.Target
+			if domainIface.Target.Device != b.podInterfaceName {
+				return b.alignQueuesWithTap(&ifaces[i])
+			}
 			break
 		}
 	}
 	return nil
 }
 
+// alignQueuesWithTap keeps the queues of the domain interface consistent with the tap device
+// created by virt-handler, as the kernel refuses to open a multi-queue tap device with a single
+// queue and vice versa.
+// A domain interface requesting multiple queues falls back to a single queue on a single queue
+// tap device, the opposite can't be fixed without knowing the queue count the tap was sized for.
+func (b *TapLibvirtSpecGenerator) alignQueuesWithTap(iface *api.Interface) error {
+	multiQueueTap, err := b.handler.IsMultiQueueTap(iface.Target.Device)
+	if err != nil {
+		log.Log.Reason(err).Warningf("failed to read the queue mode of tap device %s, keeping the queues of interface %s",
+			iface.Target.Device, b.vmiSpecIface.Name)
+		return nil
+	}
+
+	multiQueueIface := iface.Driver != nil && iface.Driver.Queues != nil && *iface.Driver.Queues > 1
+	switch {
+	case multiQueueIface && !multiQueueTap:
+		log.Log.Warningf("tap device %s has a single queue, using a single queue for interface %s instead of %d",
+			iface.Target.Device, b.vmiSpecIface.Name, *iface.Driver.Queues)
+		iface.Driver.Queues = nil
+		// RSS has no queues to distribute the packets to, vhost can serve the interface again
+		if iface.Driver.RSS != "" {
+			iface.Driver.Name = "vhost"
+			iface.Driver.RSS = ""
+			iface.Driver.RSSHashReport = ""
+		}
+	case !multiQueueIface && multiQueueTap:
+		return fmt.Errorf("tap device %s is multi-queue but interface %s uses a single queue", iface.Target.Device, b.vmiSpecIface.Name)
+	}
+	return nil
+}
+
 func (b *TapLibvirtSpecGenerator) discoverDomainIfaceSpec() (*api.Interface, error) {
 	podNicLink, err := b.handler.LinkByName(b.podInterfaceName)
 	if err != nil {
@@ -94,13 +129,16 @@ func (b *TapLibvirtSpecGenerator) discoverDomainIfaceSpec() (*api.Interface, err
 		mac = &podNicLink.Attrs().HardwareAddr
 	}
 
-	targetName, err := b.getTargetName()
+	targetName, targetLink, err := b.getTarget()
 	if err != nil {
 		return nil, err
 	}
+	if targetLink == nil {
+		targetLink = podNicLink
+	}
 	return &api.Interface{
 		MAC: &api.MAC{MAC: mac.String()},
-		MTU: &api.MTU{Size: strconv.Itoa(podNicLink.Attrs().MTU)},
+		MTU: domainIfaceMTU(targetLink, podNicLink),
 		Target: &api.InterfaceTarget{
 			Device:  targetName,
 			Managed: "no",
@@ -110,14 +148,29 @@ func (b *TapLibvirtSpecGenerator) discoverDomainIfaceSpec() (*api.Interface, err
 
 // The method tries to find a tap device based on the hashed network name
 // in case such device doesn't exist, the pod interface is used as the target
-func (b *TapLibvirtSpecGenerator) getTargetName() (string, error) {
+func (b *TapLibvirtSpecGenerator) getTarget() (string, netlink.Link, error) {
 	tapName := virtnetlink.GenerateTapDeviceName(b.podInterfaceName, b.vmiSpecNetwork)
-	if _, err := b.handler.LinkByName(tapName); err != nil {
+	tapLink, err := b.handler.LinkByName(tapName)
+	if err != nil {
 		var linkNotFoundErr netlink.LinkNotFoundError
 		if errors.As(err, &linkNotFoundErr) {
-			return b.podInterfaceName, nil
+			return b.podInterfaceName, nil, nil
 		}
-		return "", err
+		return "", nil, err
+	}
+	return tapName, tapLink, nil
+}
+
+// domainIfaceMTU returns the MTU the guest should use, which is the one virt-handler configured on the
+// device the domain interface is attached to, falling back to the pod interface MTU.
+// The guest negotiates it through the virtio host MTU feature instead of relying on DHCP.
+func domainIfaceMTU(targetLink, podNicLink netlink.Link) *api.MTU {
+	mtu := targetLink.Attrs().MTU
+	if mtu <= 0 {
+		mtu = podNicLink.Attrs().MTU
+	}
+	if mtu <= 0 {
+		return nil
 	}
-	return tapName, nil
+	return &api.MTU{Size: strconv.Itoa(mtu)}
 }
@@ -34,6 +34,7 @@ import (
 
 	dutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -91,6 +92,7 @@ var _ = Describe("Pod Network", func() {
 
 			It("Should use the tap device as the target", func() {
 				mockNetwork.EXPECT().LinkByName(tapName).Return(tapInterface, nil)
+				mockNetwork.EXPECT().IsMultiQueueTap(tapName).Return(false, nil)
 
 				Expect(specGenerator.Generate()).To(Succeed())
 
@@ -105,14 +107,75 @@ var _ = Describe("Pod Network", func() {
 				verifyTapDomain(domain.Spec.Devices.Interfaces, primaryPodIfaceName, mtu, specMAC)
 			})
 
+			It("Should use the tap device MTU", func() {
+				const tapMTU = 1400
+				tapInterface.Attrs().MTU = tapMTU
+				mockNetwork.EXPECT().LinkByName(tapName).Return(tapInterface, nil)
+				mockNetwork.EXPECT().IsMultiQueueTap(tapName).Return(false, nil)
+
+				Expect(specGenerator.Generate()).To(Succeed())
+
+				verifyTapDomain(domain.Spec.Devices.Interfaces, tapName, strconv.Itoa(tapMTU), specMAC)
+			})
+
 			It("Should use the pod interface MAC address", func() {
 				mockNetwork.EXPECT().LinkByName(tapName).Return(tapInterface, nil)
+				mockNetwork.EXPECT().IsMultiQueueTap(tapName).Return(false, nil)
 				vmi.Spec.Domain.Devices.Interfaces[0].MacAddress = ""
 
 				Expect(specGenerator.Generate()).To(Succeed())
 
 				verifyTapDomain(domain.Spec.Devices.Interfaces, tapName, mtu, fakeMac.String())
 			})
+
+			Context("with multiple queues", func() {
+				BeforeEach(func() {
+					domain.Spec.Devices.Interfaces[0].Driver = &api.InterfaceDriver{Name: "vhost", Queues: pointer.P(uint(4))}
+					mockNetwork.EXPECT().LinkByName(tapName).Return(tapInterface, nil)
+				})
+
+				It("Should keep the queues on a multi-queue tap device", func() {
+					mockNetwork.EXPECT().IsMultiQueueTap(tapName).Return(true, nil)
+
+					Expect(specGenerator.Generate()).To(Succeed())
+
+					Expect(domain.Spec.Devices.Interfaces[0].Driver.Queues).To(Equal(pointer.P(uint(4))))
+				})
+
+				It("Should fall back to a single queue on a single queue tap device", func() {
+					mockNetwork.EXPECT().IsMultiQueueTap(tapName).Return(false, nil)
+
+					Expect(specGenerator.Generate()).To(Succeed())
+
+					Expect(domain.Spec.Devices.Interfaces[0].Driver.Queues).To(BeNil())
+				})
+
+				It("Should disable RSS on a single queue tap device", func() {
+					domain.Spec.Devices.Interfaces[0].Driver.Name = "qemu"
+					domain.Spec.Devices.Interfaces[0].Driver.RSS = "on"
+					domain.Spec.Devices.Interfaces[0].Driver.RSSHashReport = "on"
+					mockNetwork.EXPECT().IsMultiQueueTap(tapName).Return(false, nil)
+
+					Expect(specGenerator.Generate()).To(Succeed())
+
+					Expect(domain.Spec.Devices.Interfaces[0].Driver).To(Equal(&api.InterfaceDriver{Name: "vhost"}))
+				})
+
+				It("Should keep the queues when the queue mode of the tap device can't be read", func() {
+					mockNetwork.EXPECT().IsMultiQueueTap(tapName).Return(false, os.ErrNotExist)
+
+					Expect(specGenerator.Generate()).To(Succeed())
+
+					Expect(domain.Spec.Devices.Interfaces[0].Driver.Queues).To(Equal(pointer.P(uint(4))))
+				})
+			})
+
+			It("Should fail when a single queue interface targets a multi-queue tap device", func() {
+				mockNetwork.EXPECT().LinkByName(tapName).Return(tapInterface, nil)
+				mockNetwork.EXPECT().IsMultiQueueTap(tapName).Return(true, nil)
+
+				Expect(specGenerator.Generate()).To(MatchError(ContainSubstring("is multi-queue but interface")))
+			})
 		})
 	})
 })
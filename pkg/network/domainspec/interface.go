@@ -36,14 +36,24 @@ func LookupIfaceByAliasName(ifaces []api.Interface, name string) *api.Interface
 	return nil
 }
 
-func DomainAttachmentByInterfaceName(vmiSpecIfaces []v1.Interface, networkBindings map[string]v1.InterfaceBindingPlugin) map[string]string {
-	domainAttachmentByPluginName := map[string]string{}
-	for name, binding := range networkBindings {
-		if binding.DomainAttachmentType != "" {
-			domainAttachmentByPluginName[name] = string(binding.DomainAttachmentType)
-		}
-	}
+// domainAttachmentByType maps the domain attachment types a binding plugin may declare
+// to the attachment the domain consumes. An empty value means KubeVirt does not define
+// the domain interface and leaves it to the binding plugin sidecar.
+var domainAttachmentByType = map[v1.DomainAttachmentType]v1.DomainAttachmentType{
+	v1.Tap:          v1.Tap,
+	v1.ManagedTap:   v1.Tap,
+	v1.OVS:          v1.Tap,
+	v1.NoAttachment: "",
+	v1.VDPA:         v1.VDPA,
+}
 
+// IsSupportedDomainAttachmentType reports whether a binding plugin may declare the given domain attachment type.
+func IsSupportedDomainAttachmentType(domainAttachmentType v1.DomainAttachmentType) bool {
+	_, exists := domainAttachmentByType[domainAttachmentType]
+	return exists
+}
+
+func DomainAttachmentByInterfaceName(vmiSpecIfaces []v1.Interface, networkBindings map[string]v1.InterfaceBindingPlugin) map[string]string {
 	domainAttachmentByInterfaceName := map[string]string{}
 	for _, iface := range vmiSpecIfaces {
 		if iface.Masquerade != nil || iface.Bridge != nil || iface.DeprecatedMacvtap != nil {
@@ -51,12 +61,12 @@ func DomainAttachmentByInterfaceName(vmiSpecIfaces []v1.Interface, networkBindin
 			// The macvtap scenario is tracking old VMIs that are still processed in the reconcile loop.
 			domainAttachmentByInterfaceName[iface.Name] = string(v1.Tap)
 		} else if iface.Binding != nil {
-			if domainAttachmentType, exist := domainAttachmentByPluginName[iface.Binding.Name]; exist {
-				// For domain consumption, handle the `managedTap` type as `tap`.
-				if domainAttachmentType == string(v1.ManagedTap) {
-					domainAttachmentType = string(v1.Tap)
-				}
-				domainAttachmentByInterfaceName[iface.Name] = domainAttachmentType
+			binding, exists := networkBindings[iface.Binding.Name]
+			if !exists {
+				continue
+			}
+			if domainAttachmentType := domainAttachmentByType[binding.DomainAttachmentType]; domainAttachmentType != "" {
+				domainAttachmentByInterfaceName[iface.Name] = string(domainAttachmentType)
 			}
 		}
 	}
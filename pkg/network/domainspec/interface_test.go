@@ -103,7 +103,6 @@ var _ = Describe("VMI interfaces", func() {
 				iface2: string(v1.Tap),
 				iface4: string(v1.Tap),
 				iface5: string(v1.Tap),
-				iface6: otherDoaminAttachemnt,
 			}
 			Expect(domainspec.DomainAttachmentByInterfaceName(vmiSpecIfaces, networkBindings)).To(Equal(expectedMap))
 		})
@@ -115,8 +114,41 @@ var _ = Describe("VMI interfaces", func() {
 				map[string]string{iface1: string(v1.Tap)},
 			))
 		})
+
+		It("should consider an ovs type as a tap type", func() {
+			vmiIfaces := []v1.Interface{{Name: iface1, Binding: &v1.PluginBinding{Name: binding1}}}
+			netBindings := map[string]v1.InterfaceBindingPlugin{binding1: {DomainAttachmentType: v1.OVS}}
+			Expect(domainspec.DomainAttachmentByInterfaceName(vmiIfaces, netBindings)).To(Equal(
+				map[string]string{iface1: string(v1.Tap)},
+			))
+		})
+
+		It("should keep a vdpa type", func() {
+			vmiIfaces := []v1.Interface{{Name: iface1, Binding: &v1.PluginBinding{Name: binding1}}}
+			netBindings := map[string]v1.InterfaceBindingPlugin{binding1: {DomainAttachmentType: v1.VDPA}}
+			Expect(domainspec.DomainAttachmentByInterfaceName(vmiIfaces, netBindings)).To(Equal(
+				map[string]string{iface1: string(v1.VDPA)},
+			))
+		})
+
+		It("should leave the domain interface of a none type to the binding plugin", func() {
+			vmiIfaces := []v1.Interface{{Name: iface1, Binding: &v1.PluginBinding{Name: binding1}}}
+			netBindings := map[string]v1.InterfaceBindingPlugin{binding1: {DomainAttachmentType: v1.NoAttachment}}
+			Expect(domainspec.DomainAttachmentByInterfaceName(vmiIfaces, netBindings)).To(BeEmpty())
+		})
 	})
 
+	DescribeTable("IsSupportedDomainAttachmentType", func(domainAttachmentType v1.DomainAttachmentType, expected bool) {
+		Expect(domainspec.IsSupportedDomainAttachmentType(domainAttachmentType)).To(Equal(expected))
+	},
+		Entry("tap", v1.Tap, true),
+		Entry("managedTap", v1.ManagedTap, true),
+		Entry("ovs", v1.OVS, true),
+		Entry("none", v1.NoAttachment, true),
+		Entry("vdpa", v1.VDPA, true),
+		Entry("an unknown type", v1.DomainAttachmentType(otherDoaminAttachemnt), false),
+	)
+
 	Context("BindingMigrationByInterfaceName", func() {
 		It("should return the correct mapping", func() {
 			expectedMap := map[string]*cmdv1.InterfaceBindingMigration{
@@ -24,8 +24,12 @@ package driver
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
 
 	netutils "k8s.io/utils/net"
 
@@ -60,6 +64,7 @@ type NetworkHandler interface {
 	HasIPv4GlobalUnicastAddress(interfaceName string) (bool, error)
 	HasIPv6GlobalUnicastAddress(interfaceName string) (bool, error)
 	IsIpv4Primary() (bool, error)
+	IsMultiQueueTap(name string) (bool, error)
 }
 
 type NetworkUtilsHandler struct{}
@@ -125,6 +130,20 @@ func (h *NetworkUtilsHandler) IsIpv4Primary() (bool, error) {
 	return !netutils.IsIPv6String(podIP), nil
 }
 
+// IsMultiQueueTap reports whether the tap device was created with multi-queue support,
+// the kernel only lets it be opened with the same queue mode.
+func (h *NetworkUtilsHandler) IsMultiQueueTap(name string) (bool, error) {
+	flags, err := os.ReadFile(filepath.Join("/sys/class/net", name, "tun_flags"))
+	if err != nil {
+		return false, err
+	}
+	value, err := strconv.ParseUint(strings.TrimSpace(string(flags)), 0, 32)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse the flags of tap device %s: %v", name, err)
+	}
+	return value&unix.IFF_MULTI_QUEUE != 0, nil
+}
+
 func (h *NetworkUtilsHandler) ReadIPAddressesFromLink(interfaceName string) (string, string, error) {
 	link, err := h.LinkByName(interfaceName)
 	if err != nil {
@@ -170,6 +189,14 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *cache.DHCPConfig, bridgeInterfaceNa
 		searchDomains = append([]string{domain}, searchDomains...)
 	}
 
+	// The guest may be served its own DNS configuration while the pod keeps the cluster DNS
+	if dhcpOptions != nil && len(dhcpOptions.DNSServers) > 0 {
+		nameservers = dns.NameserversFromIPs(dhcpOptions.DNSServers)
+	}
+	if dhcpOptions != nil && len(dhcpOptions.SearchDomains) > 0 {
+		searchDomains = dhcpOptions.SearchDomains
+	}
+
 	if nic.IP.IPNet != nil {
 		// panic in case the DHCP server failed during the vm creation
 		// but ignore dhcp errors when the vm is destroyed or shutting down
@@ -103,6 +103,21 @@ func (mr *MockNetworkHandlerMockRecorder) IsIpv4Primary() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsIpv4Primary", reflect.TypeOf((*MockNetworkHandler)(nil).IsIpv4Primary))
 }
 
+// IsMultiQueueTap mocks base method.
+func (m *MockNetworkHandler) IsMultiQueueTap(name string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsMultiQueueTap", name)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsMultiQueueTap indicates an expected call of IsMultiQueueTap.
+func (mr *MockNetworkHandlerMockRecorder) IsMultiQueueTap(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsMultiQueueTap", reflect.TypeOf((*MockNetworkHandler)(nil).IsMultiQueueTap), name)
+}
+
 // LinkByName mocks base method.
 func (m *MockNetworkHandler) LinkByName(name string) (netlink.Link, error) {
 	m.ctrl.T.Helper()
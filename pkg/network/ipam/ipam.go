@@ -0,0 +1,101 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package ipam allocates the guest subnets of masquerade interfaces on the pod network.
+package ipam
+
+import (
+	"fmt"
+	"sync"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+// Allocator is an IPAM plugin allocating the guest subnets of masquerade interfaces.
+type Allocator interface {
+	// Allocate returns the guest IPv4 and IPv6 subnets for the VMI spec.
+	// An empty subnet leaves the virt-launcher default in place.
+	Allocate(config *v1.MasqueradeIPAMConfiguration, spec *v1.VirtualMachineInstanceSpec) (ipv4CIDR, ipv6CIDR string, err error)
+	// Validate checks the plugin configuration.
+	Validate(config *v1.MasqueradeIPAMConfiguration) error
+}
+
+var (
+	pluginsLock sync.RWMutex
+	plugins     = map[string]Allocator{
+		PoolPlugin: poolAllocator{},
+	}
+)
+
+// RegisterPlugin makes an IPAM plugin available under the given name.
+func RegisterPlugin(name string, allocator Allocator) {
+	pluginsLock.Lock()
+	defer pluginsLock.Unlock()
+	plugins[name] = allocator
+}
+
+// LookupPlugin returns the IPAM plugin registered under the given name.
+func LookupPlugin(name string) (Allocator, error) {
+	pluginsLock.RLock()
+	defer pluginsLock.RUnlock()
+	allocator, exists := plugins[name]
+	if !exists {
+		return nil, fmt.Errorf("unknown masquerade IPAM plugin %q", name)
+	}
+	return allocator, nil
+}
+
+// SetDefaultPodNetworkCIDRs allocates the guest subnets of a masquerade interface on the pod network
+// when the VMI does not set them itself.
+func SetDefaultPodNetworkCIDRs(config *v1.MasqueradeIPAMConfiguration, spec *v1.VirtualMachineInstanceSpec) error {
+	if config == nil {
+		return nil
+	}
+
+	podNetwork := vmispec.LookupPodNetwork(spec.Networks)
+	if podNetwork == nil {
+		return nil
+	}
+	iface := vmispec.LookupInterfaceByName(spec.Domain.Devices.Interfaces, podNetwork.Name)
+	if iface == nil || iface.Masquerade == nil {
+		return nil
+	}
+	if podNetwork.Pod.VMNetworkCIDR != "" && podNetwork.Pod.VMIPv6NetworkCIDR != "" {
+		return nil
+	}
+
+	allocator, err := LookupPlugin(config.Plugin)
+	if err != nil {
+		return err
+	}
+	ipv4CIDR, ipv6CIDR, err := allocator.Allocate(config, spec)
+	if err != nil {
+		return fmt.Errorf("failed to allocate the guest subnet of interface %s: %v", iface.Name, err)
+	}
+
+	if podNetwork.Pod.VMNetworkCIDR == "" {
+		podNetwork.Pod.VMNetworkCIDR = ipv4CIDR
+	}
+	if podNetwork.Pod.VMIPv6NetworkCIDR == "" {
+		podNetwork.Pod.VMIPv6NetworkCIDR = ipv6CIDR
+	}
+	return nil
+}
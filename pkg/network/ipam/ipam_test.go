@@ -0,0 +1,147 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ipam_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/ipam"
+)
+
+var _ = Describe("Masquerade IPAM", func() {
+	Context("pool plugin", func() {
+		var allocator ipam.Allocator
+
+		BeforeEach(func() {
+			var err error
+			allocator, err = ipam.LookupPlugin(ipam.PoolPlugin)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		DescribeTable("should allocate", func(config v1.MasqueradeIPAMConfiguration, expectedIPv4, expectedIPv6 string) {
+			ipv4CIDR, ipv6CIDR, err := allocator.Allocate(&config, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ipv4CIDR).To(Equal(expectedIPv4))
+			Expect(ipv6CIDR).To(Equal(expectedIPv6))
+		},
+			Entry("the first subnet of the pools",
+				v1.MasqueradeIPAMConfiguration{IPv4Pool: "10.10.0.0/16", IPv6Pool: "fd20::/112"},
+				"10.10.0.0/24", "fd20::/120",
+			),
+			Entry("only an IPv4 subnet without IPv6 pool",
+				v1.MasqueradeIPAMConfiguration{IPv4Pool: "10.10.0.0/16"},
+				"10.10.0.0/24", "",
+			),
+			Entry("the subnet following an excluded subnet",
+				v1.MasqueradeIPAMConfiguration{IPv4Pool: "10.10.0.0/16", ExcludedCIDRs: []string{"10.10.0.128/25"}},
+				"10.10.1.0/24", "",
+			),
+			Entry("the subnet following an excluded CIDR larger than a subnet",
+				v1.MasqueradeIPAMConfiguration{
+					IPv4Pool:      "10.10.0.0/16",
+					IPv6Pool:      "fd20::/112",
+					ExcludedCIDRs: []string{"10.10.0.0/20", "fd20::/119"},
+				},
+				"10.10.16.0/24", "fd20::200/120",
+			),
+		)
+
+		DescribeTable("should fail to validate", func(config v1.MasqueradeIPAMConfiguration, expectedErr string) {
+			Expect(allocator.Validate(&config)).To(MatchError(ContainSubstring(expectedErr)))
+		},
+			Entry("without pool", v1.MasqueradeIPAMConfiguration{}, "at least one of ipv4Pool and ipv6Pool is required"),
+			Entry("with an IPv6 pool as IPv4 pool", v1.MasqueradeIPAMConfiguration{IPv4Pool: "fd20::/112"}, "wrong IP family"),
+			Entry("with a pool smaller than a subnet", v1.MasqueradeIPAMConfiguration{IPv4Pool: "10.10.0.0/26"}, "smaller than a /24"),
+			Entry("with an invalid excluded CIDR",
+				v1.MasqueradeIPAMConfiguration{IPv4Pool: "10.10.0.0/16", ExcludedCIDRs: []string{"10.10.0.0"}},
+				"10.10.0.0",
+			),
+			Entry("with an exhausted pool",
+				v1.MasqueradeIPAMConfiguration{IPv4Pool: "10.10.0.0/23", ExcludedCIDRs: []string{"10.10.0.0/24", "10.10.1.0/28"}},
+				"no /24 subnet of pool 10.10.0.0/23 is free",
+			),
+		)
+
+		It("should validate a pool with free subnets", func() {
+			config := &v1.MasqueradeIPAMConfiguration{IPv4Pool: "10.10.0.0/16", ExcludedCIDRs: []string{"10.10.0.0/24"}}
+			Expect(allocator.Validate(config)).To(Succeed())
+		})
+	})
+
+	It("should fail to look up an unknown plugin", func() {
+		_, err := ipam.LookupPlugin("unknown")
+		Expect(err).To(MatchError(`unknown masquerade IPAM plugin "unknown"`))
+	})
+
+	Context("SetDefaultPodNetworkCIDRs", func() {
+		config := &v1.MasqueradeIPAMConfiguration{
+			Plugin:   ipam.PoolPlugin,
+			IPv4Pool: "10.10.0.0/16",
+			IPv6Pool: "fd20::/112",
+		}
+
+		It("should set the guest subnets of a masquerade interface", func() {
+			vmi := libvmi.New(libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()), libvmi.WithNetwork(v1.DefaultPodNetwork()))
+
+			Expect(ipam.SetDefaultPodNetworkCIDRs(config, &vmi.Spec)).To(Succeed())
+
+			Expect(vmi.Spec.Networks[0].Pod.VMNetworkCIDR).To(Equal("10.10.0.0/24"))
+			Expect(vmi.Spec.Networks[0].Pod.VMIPv6NetworkCIDR).To(Equal("fd20::/120"))
+		})
+
+		It("should keep the guest subnets set by the VMI", func() {
+			podNetwork := v1.DefaultPodNetwork()
+			podNetwork.Pod.VMNetworkCIDR = "192.168.0.0/24"
+			vmi := libvmi.New(libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()), libvmi.WithNetwork(podNetwork))
+
+			Expect(ipam.SetDefaultPodNetworkCIDRs(config, &vmi.Spec)).To(Succeed())
+
+			Expect(vmi.Spec.Networks[0].Pod.VMNetworkCIDR).To(Equal("192.168.0.0/24"))
+			Expect(vmi.Spec.Networks[0].Pod.VMIPv6NetworkCIDR).To(Equal("fd20::/120"))
+		})
+
+		DescribeTable("should not change the VMI", func(config *v1.MasqueradeIPAMConfiguration, vmi *v1.VirtualMachineInstance) {
+			origSpec := vmi.Spec.DeepCopy()
+
+			Expect(ipam.SetDefaultPodNetworkCIDRs(config, &vmi.Spec)).To(Succeed())
+
+			Expect(vmi.Spec).To(Equal(*origSpec))
+		},
+			Entry("without IPAM configuration", nil,
+				libvmi.New(libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()), libvmi.WithNetwork(v1.DefaultPodNetwork())),
+			),
+			Entry("with a bridge interface on the pod network", config,
+				libvmi.New(libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding("default")), libvmi.WithNetwork(v1.DefaultPodNetwork())),
+			),
+			Entry("without pod network", config, libvmi.New()),
+		)
+
+		It("should fail with an unknown plugin", func() {
+			vmi := libvmi.New(libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()), libvmi.WithNetwork(v1.DefaultPodNetwork()))
+
+			err := ipam.SetDefaultPodNetworkCIDRs(&v1.MasqueradeIPAMConfiguration{Plugin: "unknown"}, &vmi.Spec)
+			Expect(err).To(MatchError(ContainSubstring("unknown masquerade IPAM plugin")))
+		})
+	})
+})
@@ -0,0 +1,160 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ipam
+
+import (
+	"fmt"
+	"net/netip"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	PoolPlugin = "pool"
+
+	// The guest subnets have the size of the virt-launcher defaults, 10.0.2.0/24 and fd10:0:2::/120.
+	ipv4SubnetBits = 24
+	ipv6SubnetBits = 120
+)
+
+// poolAllocator allocates the first subnet of the pools that does not overlap the excluded CIDRs.
+// The guest network is local to the virt-launcher pod, so that all VMIs can share the same subnet.
+type poolAllocator struct{}
+
+func (poolAllocator) Allocate(config *v1.MasqueradeIPAMConfiguration, _ *v1.VirtualMachineInstanceSpec) (string, string, error) {
+	excluded, err := parsePrefixes(config.ExcludedCIDRs)
+	if err != nil {
+		return "", "", err
+	}
+
+	var ipv4CIDR, ipv6CIDR string
+	if config.IPv4Pool != "" {
+		if ipv4CIDR, err = allocateSubnet(config.IPv4Pool, ipv4SubnetBits, excluded); err != nil {
+			return "", "", err
+		}
+	}
+	if config.IPv6Pool != "" {
+		if ipv6CIDR, err = allocateSubnet(config.IPv6Pool, ipv6SubnetBits, excluded); err != nil {
+			return "", "", err
+		}
+	}
+	return ipv4CIDR, ipv6CIDR, nil
+}
+
+func (a poolAllocator) Validate(config *v1.MasqueradeIPAMConfiguration) error {
+	if config.IPv4Pool == "" && config.IPv6Pool == "" {
+		return fmt.Errorf("at least one of ipv4Pool and ipv6Pool is required")
+	}
+	if config.IPv4Pool != "" {
+		if err := validatePool(config.IPv4Pool, true, ipv4SubnetBits); err != nil {
+			return err
+		}
+	}
+	if config.IPv6Pool != "" {
+		if err := validatePool(config.IPv6Pool, false, ipv6SubnetBits); err != nil {
+			return err
+		}
+	}
+	_, _, err := a.Allocate(config, nil)
+	return err
+}
+
+func validatePool(cidr string, ipv4 bool, subnetBits int) error {
+	pool, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return err
+	}
+	if pool.Addr().Is4() != ipv4 {
+		return fmt.Errorf("pool %s has the wrong IP family", cidr)
+	}
+	if pool.Bits() > subnetBits {
+		return fmt.Errorf("pool %s is smaller than a /%d guest subnet", cidr, subnetBits)
+	}
+	return nil
+}
+
+func parsePrefixes(cidrs []string) ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
+}
+
+func allocateSubnet(poolCIDR string, subnetBits int, excluded []netip.Prefix) (string, error) {
+	pool, err := netip.ParsePrefix(poolCIDR)
+	if err != nil {
+		return "", err
+	}
+	pool = pool.Masked()
+	if pool.Bits() > subnetBits {
+		return "", fmt.Errorf("pool %s is smaller than a /%d guest subnet", poolCIDR, subnetBits)
+	}
+
+	subnet := netip.PrefixFrom(pool.Addr(), subnetBits)
+	for pool.Contains(subnet.Addr()) {
+		overlapping, found := findOverlapping(subnet, excluded)
+		if !found {
+			return subnet.String(), nil
+		}
+		// Skip the whole excluded CIDR if it is larger than a subnet
+		if overlapping.Bits() < subnetBits {
+			subnet = netip.PrefixFrom(lastAddr(overlapping), subnetBits).Masked()
+		}
+		next := lastAddr(subnet).Next()
+		if !next.IsValid() {
+			break
+		}
+		subnet = netip.PrefixFrom(next, subnetBits)
+	}
+	return "", fmt.Errorf("no /%d subnet of pool %s is free of the excluded CIDRs", subnetBits, poolCIDR)
+}
+
+func findOverlapping(subnet netip.Prefix, prefixes []netip.Prefix) (netip.Prefix, bool) {
+	for _, prefix := range prefixes {
+		if prefix.Overlaps(subnet) {
+			return prefix, true
+		}
+	}
+	return netip.Prefix{}, false
+}
+
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	addr := prefix.Masked().Addr().As16()
+	hostBits := prefix.Addr().BitLen() - prefix.Bits()
+	for i := len(addr) - 1; hostBits > 0; i-- {
+		if hostBits >= 8 {
+			addr[i] = 0xff
+			hostBits -= 8
+			continue
+		}
+		addr[i] |= byte(1<<hostBits) - 1
+		hostBits = 0
+	}
+	last := netip.AddrFrom16(addr)
+	if prefix.Addr().Is4() {
+		return last.Unmap()
+	}
+	return last
+}
@@ -136,7 +136,7 @@ func shouldRunPasstRepair(vmi *v1.VirtualMachineInstance, registeredPlugins map[
 	}
 
 	registeredPlugin, exists := registeredPlugins[binding.Name]
-	if !exists || registeredPlugin.DomainAttachmentType != "" {
+	if !exists || (registeredPlugin.DomainAttachmentType != "" && registeredPlugin.DomainAttachmentType != v1.NoAttachment) {
 		return false
 	}
 
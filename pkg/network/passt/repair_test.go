@@ -57,6 +57,9 @@ var _ = Describe("Passt Repair Handler", func() {
 				"managedTap": {
 					DomainAttachmentType: v1.ManagedTap,
 				},
+				"ovs": {
+					DomainAttachmentType: v1.OVS,
+				},
 			},
 		}
 
@@ -105,6 +108,12 @@ var _ = Describe("Passt Repair Handler", func() {
 				libvmi.WithNetwork(v1.DefaultPodNetwork()),
 			),
 		),
+		Entry("When an iface connected to pod network uses ovs attachment",
+			libvmi.New(
+				libvmi.WithInterface(libvmi.InterfaceWithBindingPlugin("default", v1.PluginBinding{Name: "ovs"})),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			),
+		),
 		Entry("When there is no iface connected to pod network",
 			libvmi.New(
 				libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding("secondary")),
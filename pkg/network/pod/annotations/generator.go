@@ -90,18 +90,27 @@ func (g Generator) Generate(vmi *v1.VirtualMachineInstance) (map[string]string,
 	return annotations, nil
 }
 
-// GenerateFromSource generates ordinal pod interfaces naming scheme for a migration target in case the migration source pod uses it
+// GenerateFromSource generates network annotations for a migration target, based on the migration source:
+//   - Ordinal pod interfaces naming scheme, in case the migration source pod uses it.
+//   - The VF MAC recorded for SR-IOV interfaces, so the guest sees the same MAC once the target VF is plugged.
 func (g Generator) GenerateFromSource(vmi *v1.VirtualMachineInstance, sourcePod *k8scorev1.Pod) (map[string]string, error) {
-	if !namescheme.PodHasOrdinalInterfaceName(multus.NetworkStatusesFromPod(sourcePod)) {
+	ifaces, recordedSRIOVMACsExist := ifacesWithRecordedSRIOVMACs(vmi)
+
+	var networkNameScheme map[string]string
+	switch {
+	case namescheme.PodHasOrdinalInterfaceName(multus.NetworkStatusesFromPod(sourcePod)):
+		networkNameScheme = namescheme.CreateOrdinalNetworkNameScheme(vmi.Spec.Networks)
+	case recordedSRIOVMACsExist:
+		networkNameScheme = namescheme.CreateHashedNetworkNameScheme(vmi.Spec.Networks)
+	default:
 		return nil, nil
 	}
 
-	ordinalNameScheme := namescheme.CreateOrdinalNetworkNameScheme(vmi.Spec.Networks)
 	multusNetworksAnnotation, err := multus.GenerateCNIAnnotationFromNameScheme(
 		vmi.Namespace,
-		vmi.Spec.Domain.Devices.Interfaces,
+		ifaces,
 		vmi.Spec.Networks,
-		ordinalNameScheme,
+		networkNameScheme,
 		g.clusterConfigurer.GetNetworkBindings(),
 	)
 	if err != nil {
@@ -208,3 +217,20 @@ func ifacesAndNetsForMultusAnnotationUpdate(vmi *v1.VirtualMachineInstance) ([]v
 	}
 	return ifacesToAnnotate, networksToAnnotate, ifaceChangeRequired
 }
+
+// ifacesWithRecordedSRIOVMACs returns the VMI interfaces, where SR-IOV interfaces with no MAC in the spec
+// request the VF MAC recorded in the VMI status.
+func ifacesWithRecordedSRIOVMACs(vmi *v1.VirtualMachineInstance) ([]v1.Interface, bool) {
+	ifaces := make([]v1.Interface, 0, len(vmi.Spec.Domain.Devices.Interfaces))
+	recordedMACsExist := false
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.SRIOV != nil && iface.MacAddress == "" {
+			if ifaceStatus := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, iface.Name); ifaceStatus != nil && ifaceStatus.MAC != "" {
+				iface.MacAddress = ifaceStatus.MAC
+				recordedMACsExist = true
+			}
+		}
+		ifaces = append(ifaces, iface)
+	}
+	return ifaces, recordedMACsExist
+}
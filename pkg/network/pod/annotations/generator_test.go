@@ -218,6 +218,66 @@ var _ = Describe("Annotations Generator", func() {
 
 			Expect(annotations).To(BeEmpty())
 		})
+
+		Context("with SR-IOV interface", func() {
+			const (
+				sriovNetworkName = "sriov"
+				sriovNADName     = "sriov-nad"
+				vfMAC            = "02:00:00:00:00:01"
+			)
+
+			BeforeEach(func() {
+				vmi.Spec.Domain.Devices.Interfaces = append(vmi.Spec.Domain.Devices.Interfaces, libvmi.InterfaceDeviceWithSRIOVBinding(sriovNetworkName))
+				vmi.Spec.Networks = append(vmi.Spec.Networks, *libvmi.MultusNetwork(sriovNetworkName, sriovNADName))
+				vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{Name: sriovNetworkName, MAC: vfMAC}}
+			})
+
+			It("should request the recorded VF MAC when source pod has ordinal naming", func() {
+				sourcePodAnnotations := map[string]string{}
+				sourcePodAnnotations[networkv1.NetworkStatusAnnot] = `[
+							{"interface":"eth0", "name":"default"},
+							{"interface":"net1", "name":"test1", "namespace":"default"},
+							{"interface":"net2", "name":"test1", "namespace":"other-namespace"},
+							{"interface":"net3", "name":"sriov-nad", "namespace":"default"}
+						]`
+
+				sourcePod := newStubVirtLauncherPod(vmi, sourcePodAnnotations)
+
+				generator := annotations.NewGenerator(stubClusterConfig{})
+				convertedAnnotations, err := generator.GenerateFromSource(vmi, sourcePod)
+				Expect(err).ToNot(HaveOccurred())
+
+				expectedMultusNetworksAnnotation := `[
+							{"interface":"net1", "name":"test1", "namespace":"default"},
+							{"interface":"net2", "name":"test1", "namespace":"other-namespace"},
+							{"interface":"net3", "mac":"02:00:00:00:00:01", "name":"sriov-nad", "namespace":"default"}
+						]`
+
+				Expect(convertedAnnotations[networkv1.NetworkAttachmentAnnot]).To(MatchJSON(expectedMultusNetworksAnnotation))
+			})
+
+			It("should request the recorded VF MAC when source pod does not have ordinal naming", func() {
+				sourcePodAnnotations := map[string]string{}
+				sourcePodAnnotations[networkv1.NetworkStatusAnnot] = `[
+							{"interface":"pod16477688c0e", "name":"test1", "namespace":"default"},
+							{"interface":"podb1f51a511f1", "name":"test1", "namespace":"other-namespace"}
+						]`
+
+				sourcePod := newStubVirtLauncherPod(vmi, sourcePodAnnotations)
+
+				generator := annotations.NewGenerator(stubClusterConfig{})
+				convertedAnnotations, err := generator.GenerateFromSource(vmi, sourcePod)
+				Expect(err).ToNot(HaveOccurred())
+
+				expectedMultusNetworksAnnotation := `[
+							{"interface":"pod16477688c0e", "name":"test1", "namespace":"default"},
+							{"interface":"podb1f51a511f1", "name":"test1", "namespace":"other-namespace"},
+							{"interface":"podf767c48f0c6", "mac":"02:00:00:00:00:01", "name":"sriov-nad", "namespace":"default"}
+						]`
+
+				Expect(convertedAnnotations[networkv1.NetworkAttachmentAnnot]).To(MatchJSON(expectedMultusNetworksAnnotation))
+			})
+		})
 	})
 
 	Context("Network Info annotation", func() {
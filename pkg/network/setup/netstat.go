@@ -104,7 +104,7 @@ func (c *NetStat) UpdateStatus(vmi *v1.VirtualMachineInstance, domain *api.Domai
 
 	interfacesStatus := ifacesStatusFromDomainInterfaces(domain.Spec.Devices.Interfaces)
 	interfacesStatus = append(interfacesStatus,
-		sriovIfacesStatusFromDomainHostDevices(domain.Spec.Devices.HostDevices, vmiInterfacesSpecByName)...,
+		sriovIfacesStatusFromDomainHostDevices(domain.Spec.Devices.HostDevices, vmiInterfacesSpecByName, vmi.Status.Interfaces)...,
 	)
 
 	var err error
@@ -194,14 +194,19 @@ func ifacesStatusFromMultus(
 	multusStatusNetworksByName map[string]v1.VirtualMachineInstanceNetworkInterface,
 	vmIfacesSpecByName map[string]v1.Interface,
 ) []v1.VirtualMachineInstanceNetworkInterface {
-	for multusIfaceName := range multusStatusNetworksByName {
+	for multusIfaceName, multusIfaceStatus := range multusStatusNetworksByName {
 		ifaceStatus := netvmispec.LookupInterfaceStatusByName(interfacesStatus, multusIfaceName)
-		_, existInSpec := vmIfacesSpecByName[multusIfaceName]
+		ifaceSpec, existInSpec := vmIfacesSpecByName[multusIfaceName]
 		if existInSpec && ifaceStatus == nil {
-			interfacesStatus = append(interfacesStatus, v1.VirtualMachineInstanceNetworkInterface{
+			newIfaceStatus := v1.VirtualMachineInstanceNetworkInterface{
 				Name:       multusIfaceName,
 				InfoSource: netvmispec.InfoSourceMultusStatus,
-			})
+			}
+			// SR-IOV VFs are plugged after a migration, their recorded MAC is kept until then
+			if ifaceSpec.SRIOV != nil {
+				newIfaceStatus.MAC = multusIfaceStatus.MAC
+			}
+			interfacesStatus = append(interfacesStatus, newIfaceStatus)
 		} else if ifaceStatus != nil {
 			ifaceStatus.InfoSource = netvmispec.AddInfoSource(ifaceStatus.InfoSource, netvmispec.InfoSourceMultusStatus)
 		}
@@ -305,7 +310,11 @@ func linkStateFromDomain(linkState *api.LinkState) string {
 	return linkState.State
 }
 
-func sriovIfacesStatusFromDomainHostDevices(hostDevices []api.HostDevice, vmiIfacesSpecByName map[string]v1.Interface) []v1.VirtualMachineInstanceNetworkInterface {
+func sriovIfacesStatusFromDomainHostDevices(
+	hostDevices []api.HostDevice,
+	vmiIfacesSpecByName map[string]v1.Interface,
+	prevIfaceStatuses []v1.VirtualMachineInstanceNetworkInterface,
+) []v1.VirtualMachineInstanceNetworkInterface {
 	var vmiStatusIfaces []v1.VirtualMachineInstanceNetworkInterface
 
 	for _, hostDevice := range filterHostDevicesByAlias(hostDevices, deviceinfo.SRIOVAliasPrefix) {
@@ -316,6 +325,12 @@ func sriovIfacesStatusFromDomainHostDevices(hostDevices []api.HostDevice, vmiIfa
 		if iface, exists := vmiIfacesSpecByName[vmiStatusIface.Name]; exists {
 			vmiStatusIface.MAC = iface.MacAddress
 		}
+		if vmiStatusIface.MAC == "" {
+			// Without a MAC in the spec, the VF keeps the MAC recorded from the Multus network-status
+			if prevIfaceStatus := netvmispec.LookupInterfaceStatusByName(prevIfaceStatuses, vmiStatusIface.Name); prevIfaceStatus != nil {
+				vmiStatusIface.MAC = prevIfaceStatus.MAC
+			}
+		}
 		vmiStatusIfaces = append(vmiStatusIfaces, vmiStatusIface)
 	}
 	return vmiStatusIfaces
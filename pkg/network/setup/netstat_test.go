@@ -587,6 +587,56 @@ var _ = Describe("netstat", func() {
 		}), "the SR-IOV interface should be reported in the status, associated to the network")
 	})
 
+	It("should report SR-IOV interface with the VF MAC recorded from the Multus network-status", func() {
+		const (
+			networkName    = "sriov-network"
+			vfMAC          = "02:00:00:00:00:01"
+			guestIfaceName = "eth1"
+		)
+
+		setup.addSRIOVNetworkInterface(
+			newVMISpecIfaceWithSRIOVBinding(networkName),
+			newVMISpecMultusNetwork(networkName),
+		)
+		setup.Vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{
+			{Name: networkName, MAC: vfMAC, InfoSource: netvmispec.InfoSourceMultusStatus},
+		}
+		setup.addGuestAgentInterfaces(
+			newDomainStatusIface(nil, vfMAC, guestIfaceName),
+		)
+
+		Expect(setup.NetStat.UpdateStatus(setup.Vmi, setup.Domain)).To(Succeed())
+
+		Expect(setup.Vmi.Status.Interfaces).To(Equal([]v1.VirtualMachineInstanceNetworkInterface{
+			{
+				Name:          networkName,
+				InterfaceName: guestIfaceName,
+				MAC:           vfMAC,
+				InfoSource:    netvmispec.NewInfoSource(netvmispec.InfoSourceDomain, netvmispec.InfoSourceGuestAgent, netvmispec.InfoSourceMultusStatus),
+				QueueCount:    netsetup.UnknownInterfaceQueueCount,
+			},
+		}), "the SR-IOV interface should keep the recorded VF MAC")
+	})
+
+	It("should keep the recorded VF MAC of an SR-IOV interface which is not plugged yet", func() {
+		const (
+			networkName = "sriov-network"
+			vfMAC       = "02:00:00:00:00:01"
+		)
+
+		setup.Vmi.Spec.Domain.Devices.Interfaces = append(setup.Vmi.Spec.Domain.Devices.Interfaces, newVMISpecIfaceWithSRIOVBinding(networkName))
+		setup.Vmi.Spec.Networks = append(setup.Vmi.Spec.Networks, newVMISpecMultusNetwork(networkName))
+		setup.Vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{
+			{Name: networkName, MAC: vfMAC, InfoSource: netvmispec.InfoSourceMultusStatus},
+		}
+
+		Expect(setup.NetStat.UpdateStatus(setup.Vmi, setup.Domain)).To(Succeed())
+
+		Expect(setup.Vmi.Status.Interfaces).To(Equal([]v1.VirtualMachineInstanceNetworkInterface{
+			{Name: networkName, MAC: vfMAC, InfoSource: netvmispec.InfoSourceMultusStatus},
+		}), "the SR-IOV interface should keep the recorded VF MAC until it is plugged")
+	})
+
 	It("should not report link local addresses for masquerade binding when reported by guest-agent", func() {
 		const (
 			primaryNetworkName = "primary"
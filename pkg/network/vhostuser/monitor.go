@@ -0,0 +1,156 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vhostuser
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	DefaultMonitorInterval = 10 * time.Second
+
+	// initialRecheckInterval is the delay before a socket which was not served is checked again,
+	// it doubles on each pass the socket is still not served, up to the monitor interval.
+	initialRecheckInterval = time.Second
+)
+
+// Monitor periodically checks that the backends of the vhost-user interfaces QEMU connects to
+// still serve their sockets. The sockets are only inspected, never connected to, as a backend may
+// accept a single connection, the one of QEMU. A socket which does not exist reveals a backend which
+// is down, a socket left behind by a backend which crashed is not detected.
+//
+// QEMU reconnects on its own once the backend serves the socket again, a restart of the backend
+// which is faster than the interval is therefore not reported. A socket is reported as
+// disconnected only once two consecutive passes did not find it served. While a socket is not
+// served, the sockets are checked again with an exponential backoff, so that a backend is
+// reported as soon as it is down and as connected again as soon as it is back.
+type Monitor struct {
+	interval   time.Duration
+	domainSpec func() (*api.DomainSpec, error)
+	report     func(api.VhostUserMetadata)
+
+	suspects map[string]bool
+	reported api.VhostUserMetadata
+	recheck  time.Duration
+}
+
+// NewMonitor returns a monitor of the vhost-user interfaces of the domain spec returned by domainSpec.
+func NewMonitor(interval time.Duration, domainSpec func() (*api.DomainSpec, error), report func(api.VhostUserMetadata)) *Monitor {
+	return &Monitor{
+		interval:   interval,
+		domainSpec: domainSpec,
+		report:     report,
+		suspects:   map[string]bool{},
+		recheck:    initialRecheckInterval,
+	}
+}
+
+// Run checks the sockets until stopChan is closed.
+func (m *Monitor) Run(stopChan <-chan struct{}) {
+	timer := time.NewTimer(m.interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-stopChan:
+			return
+		case <-timer.C:
+			m.checkSockets()
+			timer.Reset(m.nextCheck())
+		}
+	}
+}
+
+// nextCheck returns the delay before the next pass.
+func (m *Monitor) nextCheck() time.Duration {
+	if len(m.suspects) == 0 {
+		m.recheck = initialRecheckInterval
+		return m.interval
+	}
+	delay := m.recheck
+	m.recheck = min(2*m.recheck, m.interval)
+	return min(delay, m.interval)
+}
+
+func (m *Monitor) checkSockets() {
+	spec, err := m.domainSpec()
+	if err != nil {
+		// The domain is not defined before the VMI starts
+		log.Log.Reason(err).V(4).Info("failed to get the domain spec to check the vhost-user sockets")
+		return
+	}
+
+	var messages []string
+	suspects := map[string]bool{}
+	for i := range spec.Devices.Interfaces {
+		iface := &spec.Devices.Interfaces[i]
+		if !isClient(iface) {
+			continue
+		}
+		message := m.checkSocket(iface)
+		if message == "" {
+			continue
+		}
+		if m.suspects[iface.Source.Path] {
+			messages = append(messages, message)
+		} else {
+			log.Log.Warningf("%s, checking again on the next pass", message)
+		}
+		suspects[iface.Source.Path] = true
+	}
+	m.suspects = suspects
+
+	result := api.VhostUserMetadata{
+		Disconnected: len(messages) > 0,
+		Message:      strings.Join(messages, "; "),
+	}
+	if result != m.reported {
+		m.reported = result
+		m.report(result)
+	}
+}
+
+// checkSocket returns a message describing why the backend of the interface is not reachable,
+// or an empty message if its socket is served.
+func (m *Monitor) checkSocket(iface *api.Interface) string {
+	name := iface.Source.Path
+	if iface.Alias != nil {
+		name = fmt.Sprintf("%s of interface %s", name, iface.Alias.GetName())
+	}
+
+	info, err := os.Stat(iface.Source.Path)
+	if os.IsNotExist(err) {
+		return fmt.Sprintf("the vhost-user backend is not serving socket %s", name)
+	}
+	if err != nil {
+		log.Log.Reason(err).Warningf("failed to check the vhost-user socket %s", name)
+		return ""
+	}
+	if info.Mode()&os.ModeSocket == 0 {
+		return fmt.Sprintf("the vhost-user socket %s is not a socket", name)
+	}
+	return ""
+}
@@ -0,0 +1,175 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vhostuser
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("Monitor", func() {
+	var (
+		socketDir string
+		spec      *api.DomainSpec
+		specErr   error
+		monitor   *Monitor
+		reports   []api.VhostUserMetadata
+	)
+
+	addInterface := func(name string, mode string) string {
+		path := filepath.Join(socketDir, name+".sock")
+		spec.Devices.Interfaces = append(spec.Devices.Interfaces, api.Interface{
+			Type:   "vhostuser",
+			Source: api.InterfaceSource{Type: "unix", Path: path, Mode: mode},
+			Alias:  api.NewUserDefinedAlias(name),
+		})
+		return path
+	}
+
+	serve := func(path string) {
+		listener, err := net.Listen("unix", path)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(listener.Close)
+	}
+
+	BeforeEach(func() {
+		socketDir = GinkgoT().TempDir()
+		spec = &api.DomainSpec{}
+		specErr = nil
+		reports = nil
+		monitor = NewMonitor(DefaultMonitorInterval, func() (*api.DomainSpec, error) {
+			return spec, specErr
+		}, func(result api.VhostUserMetadata) {
+			reports = append(reports, result)
+		})
+	})
+
+	It("should not report served sockets", func() {
+		serve(addInterface("net1", "client"))
+
+		monitor.checkSockets()
+		monitor.checkSockets()
+		Expect(reports).To(BeEmpty())
+	})
+
+	It("should report a socket only once it is missing on two consecutive passes", func() {
+		path := addInterface("net1", "client")
+
+		monitor.checkSockets()
+		Expect(reports).To(BeEmpty())
+
+		monitor.checkSockets()
+		Expect(reports).To(ConsistOf(api.VhostUserMetadata{
+			Disconnected: true,
+			Message:      fmt.Sprintf("the vhost-user backend is not serving socket %s of interface net1", path),
+		}))
+	})
+
+	It("should report a path which is not a socket", func() {
+		path := addInterface("net1", "client")
+		Expect(os.WriteFile(path, nil, 0640)).To(Succeed())
+
+		monitor.checkSockets()
+		monitor.checkSockets()
+		Expect(reports).To(ConsistOf(api.VhostUserMetadata{
+			Disconnected: true,
+			Message:      fmt.Sprintf("the vhost-user socket %s of interface net1 is not a socket", path),
+		}))
+	})
+
+	It("should not connect to the sockets", func() {
+		listener, err := net.Listen("unix", addInterface("net1", "client"))
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(listener.Close)
+
+		monitor.checkSockets()
+		monitor.checkSockets()
+		Expect(listener.(*net.UnixListener).SetDeadline(time.Now())).To(Succeed())
+		_, err = listener.Accept()
+		Expect(errors.Is(err, os.ErrDeadlineExceeded)).To(BeTrue())
+		Expect(reports).To(BeEmpty())
+	})
+
+	It("should check the sockets which are not served again with a backoff", func() {
+		path := addInterface("net1", "client")
+
+		monitor.checkSockets()
+		Expect(monitor.nextCheck()).To(Equal(time.Second))
+		monitor.checkSockets()
+		Expect(monitor.nextCheck()).To(Equal(2 * time.Second))
+		monitor.checkSockets()
+		Expect(monitor.nextCheck()).To(Equal(4 * time.Second))
+		monitor.checkSockets()
+		Expect(monitor.nextCheck()).To(Equal(8 * time.Second))
+		monitor.checkSockets()
+		Expect(monitor.nextCheck()).To(Equal(DefaultMonitorInterval))
+
+		serve(path)
+		monitor.checkSockets()
+		Expect(monitor.nextCheck()).To(Equal(DefaultMonitorInterval))
+		Expect(monitor.recheck).To(Equal(time.Second))
+	})
+
+	It("should not report a backend which restarted between two passes", func() {
+		path := addInterface("net1", "client")
+
+		monitor.checkSockets()
+		serve(path)
+		monitor.checkSockets()
+		Expect(reports).To(BeEmpty())
+	})
+
+	It("should report the backend once it serves the socket again", func() {
+		path := addInterface("net1", "client")
+		monitor.checkSockets()
+		monitor.checkSockets()
+		Expect(reports).To(HaveLen(1))
+
+		serve(path)
+		monitor.checkSockets()
+		Expect(reports).To(HaveLen(2))
+		Expect(reports[1]).To(Equal(api.VhostUserMetadata{}))
+	})
+
+	It("should ignore the sockets QEMU serves", func() {
+		addInterface("net1", "server")
+
+		monitor.checkSockets()
+		monitor.checkSockets()
+		Expect(reports).To(BeEmpty())
+	})
+
+	It("should not report anything before the domain is defined", func() {
+		specErr = fmt.Errorf("domain not found")
+
+		monitor.checkSockets()
+		monitor.checkSockets()
+		Expect(reports).To(BeEmpty())
+	})
+})
@@ -0,0 +1,142 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package vhostuser handles the vhost-user interfaces which network binding plugins add to the
+// domain, to connect the guest to a userspace dataplane such as OVS-DPDK.
+package vhostuser
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	interfaceType    = "vhostuser"
+	sourceModeClient = "client"
+
+	// ReconnectTimeout is the number of seconds QEMU waits between two attempts to connect again
+	// to the socket of a backend which closed the connection, e.g. because it restarted. QEMU only
+	// supports a fixed interval, the shortest one is used as an attempt on a socket which is not
+	// served yet fails right away.
+	ReconnectTimeout = 1
+)
+
+var reconnectElement = fmt.Sprintf(`<reconnect enabled="yes" timeout="%d"/>`, ReconnectTimeout)
+
+// SetDefaultReconnect enables the reconnection of the vhost-user interfaces QEMU connects to
+// as a client and which do not configure it, so that their guest NICs recover once their backend
+// restarted.
+// The domain XML comes from the hooks and may hold elements the domain spec does not model, it is
+// therefore not marshalled again: the reconnect elements are inserted into it and everything else
+// is kept as it is.
+func SetDefaultReconnect(domainXML string) (string, error) {
+	offsets, err := missingReconnectOffsets(domainXML)
+	if err != nil {
+		return "", err
+	}
+
+	// Insert from the end of the document, so that the offsets left to handle stay valid
+	sort.Sort(sort.Reverse(sort.IntSlice(offsets)))
+	for _, offset := range offsets {
+		if strings.HasSuffix(domainXML[:offset], "/>") {
+			// <source .../> becomes <source ...><reconnect .../></source>
+			domainXML = domainXML[:offset-2] + ">" + reconnectElement + "</source>" + domainXML[offset:]
+		} else {
+			domainXML = domainXML[:offset] + reconnectElement + domainXML[offset:]
+		}
+	}
+	return domainXML, nil
+}
+
+// missingReconnectOffsets returns the offsets right after the <source> start tag of the client
+// vhost-user interfaces which do not have a <reconnect> element.
+func missingReconnectOffsets(domainXML string) ([]int, error) {
+	var (
+		offsets []int
+		path    []string
+		// offset of the <source> of the current interface, if reconnection should be enabled on it
+		sourceOffset int
+		isVhostUser  bool
+		hasReconnect bool
+	)
+
+	decoder := xml.NewDecoder(strings.NewReader(domainXML))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return offsets, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the domain XML: %v", err)
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			path = append(path, element.Name.Local)
+			switch strings.Join(path, "/") {
+			case "domain/devices/interface":
+				isVhostUser = attr(element, "type") == interfaceType
+				sourceOffset, hasReconnect = 0, false
+			case "domain/devices/interface/source":
+				if isVhostUser && attr(element, "mode") == sourceModeClient && attr(element, "path") != "" {
+					sourceOffset = int(decoder.InputOffset())
+				}
+			case "domain/devices/interface/source/reconnect":
+				hasReconnect = true
+			}
+		case xml.EndElement:
+			if strings.Join(path, "/") == "domain/devices/interface" && sourceOffset != 0 && !hasReconnect {
+				offsets = append(offsets, sourceOffset)
+			}
+			path = path[:len(path)-1]
+		}
+	}
+}
+
+func attr(element xml.StartElement, name string) string {
+	for _, a := range element.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// isClient returns true if the interface is a vhost-user interface whose backend serves the socket.
+func isClient(iface *api.Interface) bool {
+	return iface.Type == interfaceType && iface.Source.Mode == sourceModeClient && iface.Source.Path != ""
+}
+
+// MayHaveInterfaces returns true if any of the interfaces uses a network binding plugin, as
+// these are the only ones which can be vhost-user interfaces.
+func MayHaveInterfaces(interfaces []v1.Interface) bool {
+	for _, iface := range interfaces {
+		if iface.Binding != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vhostuser_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vhostuser"
+)
+
+var _ = Describe("vhost-user interfaces", func() {
+	const reconnect = `<reconnect enabled="yes" timeout="1"/>`
+
+	DescribeTable("should enable the reconnection of the client interfaces", func(domainXML, expectedXML string) {
+		Expect(vhostuser.SetDefaultReconnect(domainXML)).To(Equal(expectedXML))
+	},
+		Entry("with a self-closing source",
+			`<domain><devices><interface type="vhostuser"><source type="unix" path="/var/run/vhostuser/sock0" mode="client"/></interface></devices></domain>`,
+			`<domain><devices><interface type="vhostuser"><source type="unix" path="/var/run/vhostuser/sock0" mode="client">`+reconnect+`</source></interface></devices></domain>`,
+		),
+		Entry("with a source holding elements",
+			`<domain><devices><interface type='vhostuser'><source type='unix' path='/var/run/vhostuser/sock0' mode='client'>
+  <other/>
+</source></interface></devices></domain>`,
+			`<domain><devices><interface type='vhostuser'><source type='unix' path='/var/run/vhostuser/sock0' mode='client'>`+reconnect+`
+  <other/>
+</source></interface></devices></domain>`,
+		),
+		Entry("with several interfaces",
+			`<domain><devices>`+
+				`<interface type="ethernet"><source mode="client" path="/tmp/sock"/></interface>`+
+				`<interface type="vhostuser"><source type="unix" path="/var/run/vhostuser/sock0" mode="client"></source></interface>`+
+				`<interface type="vhostuser"><source type="unix" path="/var/run/vhostuser/sock1" mode="server"></source></interface>`+
+				`<interface type="vhostuser"><source type="unix" path="/var/run/vhostuser/sock2" mode="client"></source></interface>`+
+				`</devices></domain>`,
+			`<domain><devices>`+
+				`<interface type="ethernet"><source mode="client" path="/tmp/sock"/></interface>`+
+				`<interface type="vhostuser"><source type="unix" path="/var/run/vhostuser/sock0" mode="client">`+reconnect+`</source></interface>`+
+				`<interface type="vhostuser"><source type="unix" path="/var/run/vhostuser/sock1" mode="server"></source></interface>`+
+				`<interface type="vhostuser"><source type="unix" path="/var/run/vhostuser/sock2" mode="client">`+reconnect+`</source></interface>`+
+				`</devices></domain>`,
+		),
+		Entry("with the reconnection set by the binding plugin",
+			`<domain><devices><interface type="vhostuser"><source type="unix" path="/var/run/vhostuser/sock0" mode="client"><reconnect enabled="no"/></source></interface></devices></domain>`,
+			`<domain><devices><interface type="vhostuser"><source type="unix" path="/var/run/vhostuser/sock0" mode="client"><reconnect enabled="no"/></source></interface></devices></domain>`,
+		),
+	)
+
+	It("should keep the elements the domain spec does not model", func() {
+		domainXML := `<domain type="kvm" xmlns:qemu="http://libvirt.org/schemas/domain/qemu/1.0">` +
+			`<devices><interface type="vhostuser"><source type="unix" path="/var/run/vhostuser/sock0" mode="client"/></interface></devices>` +
+			`<qemu:commandline><qemu:arg value="-foo"/></qemu:commandline></domain>`
+
+		Expect(vhostuser.SetDefaultReconnect(domainXML)).To(Equal(strings.Replace(domainXML,
+			`mode="client"/>`, `mode="client">`+reconnect+`</source>`, 1)))
+	})
+
+	It("should fail on an invalid domain XML", func() {
+		_, err := vhostuser.SetDefaultReconnect(`<domain><devices>`)
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("should detect the interfaces which may be vhost-user interfaces", func(interfaces []v1.Interface, expected bool) {
+		Expect(vhostuser.MayHaveInterfaces(interfaces)).To(Equal(expected))
+	},
+		Entry("without interfaces", nil, false),
+		Entry("with core bindings", []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}, false),
+		Entry("with a binding plugin", []v1.Interface{
+			*v1.DefaultMasqueradeNetworkInterface(),
+			{Name: "net1", Binding: &v1.PluginBinding{Name: "vhostuser"}},
+		}, true),
+	)
+})
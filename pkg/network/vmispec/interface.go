@@ -183,10 +183,12 @@ func BindingPluginNetworkWithDeviceInfoExist(ifaces []v1.Interface, bindingPlugi
 	return false
 }
 
+// HasBindingPluginDeviceInfo reports whether the device info of the interface network is needed in the pod,
+// either by the binding plugin sidecar or by the vdpa domain attachment.
 func HasBindingPluginDeviceInfo(iface v1.Interface, bindingPlugins map[string]v1.InterfaceBindingPlugin) bool {
 	if iface.Binding != nil {
 		binding, exist := bindingPlugins[iface.Binding.Name]
-		return exist && binding.DownwardAPI == v1.DeviceInfo
+		return exist && (binding.DownwardAPI == v1.DeviceInfo || binding.DomainAttachmentType == v1.VDPA)
 	}
 	return false
 }
@@ -196,11 +196,13 @@ var _ = Describe("VMI network spec", func() {
 	const (
 		deviceInfoPlugin    = "deviceinfo"
 		nonDeviceInfoPlugin = "non_deviceinfo"
+		vdpaPlugin          = "vdpa"
 	)
 
 	bindingPlugins := map[string]v1.InterfaceBindingPlugin{
 		deviceInfoPlugin:    {DownwardAPI: v1.DeviceInfo},
 		nonDeviceInfoPlugin: {},
+		vdpaPlugin:          {DomainAttachmentType: v1.VDPA},
 	}
 	Context("binding plugin network with device info", func() {
 		It("returns false given non binding-plugin interface", func() {
@@ -221,6 +223,12 @@ var _ = Describe("VMI network spec", func() {
 				bindingPlugins,
 			)).To(BeTrue())
 		})
+		It("returns true when interface binding is plugin with vdpa domain attachment", func() {
+			Expect(netvmispec.HasBindingPluginDeviceInfo(
+				interfaceWithBindingPlugin("net2", vdpaPlugin),
+				bindingPlugins,
+			)).To(BeTrue())
+		})
 	})
 	Context("binding plugin network with device info exist", func() {
 		It("returns false when there is no network with device info plugin", func() {
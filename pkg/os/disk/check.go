@@ -0,0 +1,80 @@
+package disk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	// qemu-img check exit codes, see qemu-img(1)
+	checkExitCorruptions = 2
+	checkExitLeaks       = 3
+
+	// checkCPUTimeLimitSeconds bounds the CPU time of a single image check
+	checkCPUTimeLimitSeconds = 60
+)
+
+type CheckResult struct {
+	Filename    string `json:"filename"`
+	Format      string `json:"format"`
+	CheckErrors int64  `json:"check-errors"`
+	Corruptions int64  `json:"corruptions"`
+	Leaks       int64  `json:"leaks"`
+}
+
+// IsCorrupted returns true if the check found corruptions. Leaked clusters only waste space and
+// are not considered as corruptions.
+func (r *CheckResult) IsCorrupted() bool {
+	return r.Corruptions > 0
+}
+
+// GetInUseDiskInfo returns the information about an image which may be opened by a running domain
+func GetInUseDiskInfo(imagePath string, diskMemoryLimitBytes int64) (*DiskInfo, error) {
+	out, err := limitedQemuImg(fmt.Sprintf("info -U %v --output json", imagePath), diskMemoryLimitBytes, checkCPUTimeLimitSeconds).Output()
+	if err != nil {
+		return nil, qemuImgError(err)
+	}
+	info := &DiskInfo{}
+	if err := json.Unmarshal(out, info); err != nil {
+		return nil, fmt.Errorf("failed to parse disk info: %v", err)
+	}
+	return info, nil
+}
+
+// CheckImage checks the metadata of a qcow2 image for consistency. The image may be in use by a
+// running domain, its metadata is then read without taking the image lock.
+func CheckImage(imagePath string, diskMemoryLimitBytes int64) (*CheckResult, error) {
+	cmd := limitedQemuImg(fmt.Sprintf("check -U %v --output json", imagePath), diskMemoryLimitBytes, checkCPUTimeLimitSeconds)
+	log.Log.V(4).Infof("checking image. running command: %s", cmd.String())
+	out, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) || (exitErr.ExitCode() != checkExitCorruptions && exitErr.ExitCode() != checkExitLeaks) {
+			return nil, qemuImgError(err)
+		}
+	}
+	result := &CheckResult{}
+	if err := json.Unmarshal(out, result); err != nil {
+		return nil, fmt.Errorf("failed to parse check result: %v", err)
+	}
+	if result.CheckErrors > 0 {
+		return nil, fmt.Errorf("failed to check image %s: %d check errors", imagePath, result.CheckErrors)
+	}
+	return result, nil
+}
+
+func limitedQemuImg(args string, memoryLimitBytes, cpuLimitSeconds int64) *exec.Cmd {
+	// #nosec No risk for attacker injection. The image paths are generated by virt-launcher
+	return exec.Command("bash", "-c", fmt.Sprintf("ulimit -t %d && ulimit -v %d && %v %s", cpuLimitSeconds, memoryLimitBytes/1024, QEMUIMGPath, args))
+}
+
+func qemuImgError(err error) error {
+	if e, ok := err.(*exec.ExitError); ok && len(e.Stderr) > 0 {
+		return fmt.Errorf("failed to invoke qemu-img: %v: '%v'", err, string(e.Stderr))
+	}
+	return fmt.Errorf("failed to invoke qemu-img: %v", err)
+}
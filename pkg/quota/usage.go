@@ -0,0 +1,142 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package quota
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+	overcommitv1 "kubevirt.io/api/overcommit/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+)
+
+// Counted reports whether the resources of the VMI are counted by the VirtualizationQuotas of its namespace
+func Counted(vmi *v1.VirtualMachineInstance) bool {
+	return !vmi.IsFinal()
+}
+
+// Usage returns the domain resources of the VMI counted by a VirtualizationQuota.
+// Unlike the requests of the virt-launcher pod, they do not depend on the overcommit of the VMI.
+func Usage(vmi *v1.VirtualMachineInstance) k8sv1.ResourceList {
+	domain := vmi.Spec.Domain
+	vcpus := int64(1)
+	if domain.CPU != nil {
+		if n := hardware.GetNumberOfVCPUs(domain.CPU); n > 0 {
+			vcpus = n
+		}
+	}
+
+	return k8sv1.ResourceList{
+		overcommitv1.ResourceVCPUs:       *resource.NewQuantity(vcpus, resource.DecimalSI),
+		overcommitv1.ResourceGuestMemory: guestMemory(vmi),
+		overcommitv1.ResourceGPUs:        *resource.NewQuantity(int64(len(domain.Devices.GPUs)), resource.DecimalSI),
+		overcommitv1.ResourceHostDevices: *resource.NewQuantity(int64(len(domain.Devices.HostDevices)), resource.DecimalSI),
+	}
+}
+
+func guestMemory(vmi *v1.VirtualMachineInstance) resource.Quantity {
+	if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Guest != nil {
+		return vmi.Spec.Domain.Memory.Guest.DeepCopy()
+	}
+	if memory, ok := vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceMemory]; ok {
+		return memory.DeepCopy()
+	}
+	if memory, ok := vmi.Spec.Domain.Resources.Limits[k8sv1.ResourceMemory]; ok {
+		return memory.DeepCopy()
+	}
+	return *resource.NewQuantity(0, resource.BinarySI)
+}
+
+// Growth returns the domain resources which updated counts on top of vmi.
+// Resources which do not grow are left out.
+func Growth(vmi, updated *v1.VirtualMachineInstance) k8sv1.ResourceList {
+	current := Usage(vmi)
+	growth := k8sv1.ResourceList{}
+	for name, quantity := range Usage(updated) {
+		quantity.Sub(current[name])
+		if quantity.Sign() > 0 {
+			growth[name] = quantity
+		}
+	}
+	return growth
+}
+
+// Add adds the resources of b to a, and returns a
+func Add(a, b k8sv1.ResourceList) k8sv1.ResourceList {
+	for name, quantity := range b {
+		sum := a[name]
+		sum.Add(quantity)
+		a[name] = sum
+	}
+	return a
+}
+
+// Mask returns the resources of list which are limited by hard, resources missing from list are zero
+func Mask(list, hard k8sv1.ResourceList) k8sv1.ResourceList {
+	masked := k8sv1.ResourceList{}
+	for name := range hard {
+		quantity := list[name]
+		masked[name] = quantity.DeepCopy()
+	}
+	return masked
+}
+
+// Exceeded returns the sorted names of the resources whose hard limit is exceeded
+// when the requested resources are added to the used ones
+func Exceeded(hard, used, requested k8sv1.ResourceList) []k8sv1.ResourceName {
+	var exceeded []k8sv1.ResourceName
+	for name, limit := range hard {
+		quantity, ok := requested[name]
+		if !ok || quantity.IsZero() {
+			continue
+		}
+		total := used[name]
+		total.Add(quantity)
+		if total.Cmp(limit) > 0 {
+			exceeded = append(exceeded, name)
+		}
+	}
+	sort.Slice(exceeded, func(i, j int) bool { return exceeded[i] < exceeded[j] })
+	return exceeded
+}
+
+// ExceededQuotas returns a message for each of the VirtualizationQuotas whose hard limits are exceeded
+// when the requested resources are added to the used ones
+func ExceededQuotas(quotas []*overcommitv1.VirtualizationQuota, requested k8sv1.ResourceList) []string {
+	var messages []string
+	for _, vq := range quotas {
+		exceeded := Exceeded(vq.Spec.Hard, vq.Status.Used, requested)
+		if len(exceeded) == 0 {
+			continue
+		}
+		names := make([]string, 0, len(exceeded))
+		for _, name := range exceeded {
+			names = append(names, string(name))
+		}
+		messages = append(messages, fmt.Sprintf("exceeded VirtualizationQuota %s: %s", vq.Name, strings.Join(names, ", ")))
+	}
+	return messages
+}
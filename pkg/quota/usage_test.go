@@ -0,0 +1,133 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package quota
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	overcommitv1 "kubevirt.io/api/overcommit/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+var _ = Describe("Virtualization quota usage", func() {
+	DescribeTable("should count the domain resources of the VMI", func(vmi *v1.VirtualMachineInstance, vcpus, guestMemory string) {
+		usage := Usage(vmi)
+		Expect(usage.Name(overcommitv1.ResourceVCPUs, resource.DecimalSI).String()).To(Equal(vcpus))
+		Expect(usage.Name(overcommitv1.ResourceGuestMemory, resource.BinarySI).String()).To(Equal(guestMemory))
+	},
+		Entry("without CPU topology", libvmi.New(libvmi.WithMemoryRequest("1Gi")), "1", "1Gi"),
+		Entry("with a CPU topology", libvmi.New(libvmi.WithCPUCount(2, 2, 2), libvmi.WithMemoryRequest("1Gi")), "8", "1Gi"),
+		Entry("with an overcommitted guest memory", libvmi.New(libvmi.WithGuestMemory("4Gi"), libvmi.WithMemoryRequest("1Gi")), "1", "4Gi"),
+		Entry("with a memory limit only", libvmi.New(libvmi.WithMemoryLimit("2Gi")), "1", "2Gi"),
+	)
+
+	It("should count the GPUs and host devices of the VMI", func() {
+		vmi := libvmi.New()
+		vmi.Spec.Domain.Devices.GPUs = []v1.GPU{{Name: "gpu1"}, {Name: "gpu2"}}
+		vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{{Name: "dev1"}}
+
+		usage := Usage(vmi)
+		Expect(usage.Name(overcommitv1.ResourceGPUs, resource.DecimalSI).Value()).To(Equal(int64(2)))
+		Expect(usage.Name(overcommitv1.ResourceHostDevices, resource.DecimalSI).Value()).To(Equal(int64(1)))
+	})
+
+	It("should not count final VMIs", func() {
+		vmi := libvmi.New()
+		vmi.Status.Phase = v1.Running
+		Expect(Counted(vmi)).To(BeTrue())
+		vmi.Status.Phase = v1.Failed
+		Expect(Counted(vmi)).To(BeFalse())
+	})
+
+	It("should restrict a resource list to the limited resources", func() {
+		masked := Mask(k8sv1.ResourceList{
+			overcommitv1.ResourceVCPUs: resource.MustParse("4"),
+			overcommitv1.ResourceGPUs:  resource.MustParse("1"),
+		}, k8sv1.ResourceList{
+			overcommitv1.ResourceVCPUs:       resource.MustParse("8"),
+			overcommitv1.ResourceGuestMemory: resource.MustParse("8Gi"),
+		})
+		Expect(masked).To(HaveLen(2))
+		Expect(masked.Name(overcommitv1.ResourceVCPUs, resource.DecimalSI).Value()).To(Equal(int64(4)))
+		Expect(masked.Name(overcommitv1.ResourceGuestMemory, resource.BinarySI).IsZero()).To(BeTrue())
+	})
+
+	DescribeTable("should report the exceeded resources", func(requested k8sv1.ResourceList, expected []k8sv1.ResourceName) {
+		hard := k8sv1.ResourceList{
+			overcommitv1.ResourceVCPUs:       resource.MustParse("8"),
+			overcommitv1.ResourceGuestMemory: resource.MustParse("8Gi"),
+			overcommitv1.ResourceGPUs:        resource.MustParse("0"),
+		}
+		used := k8sv1.ResourceList{
+			overcommitv1.ResourceVCPUs:       resource.MustParse("6"),
+			overcommitv1.ResourceGuestMemory: resource.MustParse("4Gi"),
+		}
+		Expect(Exceeded(hard, used, requested)).To(Equal(expected))
+	},
+		Entry("within the quota", k8sv1.ResourceList{
+			overcommitv1.ResourceVCPUs:       resource.MustParse("2"),
+			overcommitv1.ResourceGuestMemory: resource.MustParse("4Gi"),
+			overcommitv1.ResourceGPUs:        resource.MustParse("0"),
+		}, nil),
+		Entry("beyond the quota", k8sv1.ResourceList{
+			overcommitv1.ResourceVCPUs:       resource.MustParse("4"),
+			overcommitv1.ResourceGuestMemory: resource.MustParse("8Gi"),
+			overcommitv1.ResourceGPUs:        resource.MustParse("1"),
+		}, []k8sv1.ResourceName{overcommitv1.ResourceGPUs, overcommitv1.ResourceGuestMemory, overcommitv1.ResourceVCPUs}),
+		Entry("with resources the quota does not limit", k8sv1.ResourceList{
+			overcommitv1.ResourceHostDevices: resource.MustParse("4"),
+		}, nil),
+	)
+
+	It("should only count the growth of the domain resources", func() {
+		vmi := libvmi.New(libvmi.WithCPUCount(1, 2, 1), libvmi.WithGuestMemory("2Gi"), libvmi.WithMemoryRequest("2Gi"))
+		updated := libvmi.New(libvmi.WithCPUCount(1, 4, 1), libvmi.WithGuestMemory("1Gi"), libvmi.WithMemoryRequest("1Gi"))
+
+		growth := Growth(vmi, updated)
+		Expect(growth).To(HaveLen(1))
+		Expect(growth.Name(overcommitv1.ResourceVCPUs, resource.DecimalSI).Value()).To(Equal(int64(2)))
+	})
+
+	It("should report the exceeded VirtualizationQuotas", func() {
+		newQuota := func(name, hardVCPUs string) *overcommitv1.VirtualizationQuota {
+			return &overcommitv1.VirtualizationQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: overcommitv1.VirtualizationQuotaSpec{Hard: k8sv1.ResourceList{
+					overcommitv1.ResourceVCPUs: resource.MustParse(hardVCPUs),
+				}},
+				Status: overcommitv1.VirtualizationQuotaStatus{Used: k8sv1.ResourceList{
+					overcommitv1.ResourceVCPUs: resource.MustParse("6"),
+				}},
+			}
+		}
+		quotas := []*overcommitv1.VirtualizationQuota{newQuota("small", "8"), newQuota("large", "16")}
+
+		Expect(ExceededQuotas(quotas, k8sv1.ResourceList{overcommitv1.ResourceVCPUs: resource.MustParse("2")})).To(BeEmpty())
+		Expect(ExceededQuotas(quotas, k8sv1.ResourceList{overcommitv1.ResourceVCPUs: resource.MustParse("4")})).To(
+			ConsistOf("exceeded VirtualizationQuota small: vcpus"))
+	})
+})
@@ -27,6 +27,7 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/cache"
@@ -96,6 +97,7 @@ func (admitter *VMBackupAdmitter) Admit(ctx context.Context, ar *admissionv1.Adm
 		}
 		causes = validateSource(vmBackup.Spec.Source, causes)
 		causes = validateBackupMode(vmBackup, causes)
+		causes = validateBandwidth(vmBackup.Spec.Bandwidth, causes)
 
 	case admissionv1.Update:
 		prevObj := &backupv1.VirtualMachineBackup{}
@@ -231,3 +233,14 @@ func validatePVCNameExists(vmBackup *backupv1.VirtualMachineBackup, causes []met
 	}
 	return causes
 }
+
+func validateBandwidth(bandwidth *resource.Quantity, causes []metav1.StatusCause) []metav1.StatusCause {
+	if bandwidth != nil && bandwidth.Sign() < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "bandwidth must not be negative",
+			Field:   k8sfield.NewPath("spec", "bandwidth").String(),
+		})
+	}
+	return causes
+}
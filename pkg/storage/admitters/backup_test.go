@@ -29,6 +29,7 @@ import (
 
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/cache"
@@ -447,6 +448,40 @@ var _ = Describe("Validating VirtualMachineBackup Admitter", func() {
 			Expect(resp.Result).To(BeNil())
 		})
 	})
+
+	Context("Bandwidth validation", func() {
+		It("should reject a negative bandwidth", func() {
+			backup := &backupv1.VirtualMachineBackup{
+				Spec: backupv1.VirtualMachineBackupSpec{
+					Source:    sourceRef,
+					PvcName:   pointer.P("test-pvc"),
+					Bandwidth: pointer.P(resource.MustParse("-1Mi")),
+				},
+			}
+
+			ar := createBackupAdmissionReview(backup)
+			resp := admitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Type).To(Equal(metav1.CauseTypeFieldValueInvalid))
+			Expect(resp.Result.Details.Causes[0].Message).Should(Equal("bandwidth must not be negative"))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.bandwidth"))
+		})
+
+		It("should accept a bandwidth limit", func() {
+			backup := &backupv1.VirtualMachineBackup{
+				Spec: backupv1.VirtualMachineBackupSpec{
+					Source:    sourceRef,
+					PvcName:   pointer.P("test-pvc"),
+					Bandwidth: pointer.P(resource.MustParse("100Mi")),
+				},
+			}
+
+			ar := createBackupAdmissionReview(backup)
+			resp := admitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+	})
 })
 
 func createBackupAdmissionReview(backup *backupv1.VirtualMachineBackup) *admissionv1.AdmissionReview {
@@ -50,6 +50,7 @@ func ValidateDisks(field *k8sfield.Path, disks []v1.Disk) []metav1.StatusCause {
 		causes = append(causes, validateDiskName(field, idx, disks)...)
 		causes = append(causes, validateDeviceTarget(field, idx, disk)...)
 		causes = append(causes, validatePciAddress(field, idx, disk)...)
+		causes = append(causes, validateCCWAddress(field, idx, disk)...)
 		causes = append(causes, validateBootOrderValue(field, idx, disk)...)
 		causes = append(causes, validateBusSupport(field, idx, disk)...)
 		causes = append(causes, validateSerialNumValue(field, idx, disk)...)
@@ -57,10 +58,68 @@ func ValidateDisks(field *k8sfield.Path, disks []v1.Disk) []metav1.StatusCause {
 		causes = append(causes, validateCacheMode(field, idx, disk)...)
 		causes = append(causes, validateIOMode(field, idx, disk)...)
 		causes = append(causes, validateErrorPolicy(field, idx, disk)...)
+		causes = append(causes, validateReadErrorPolicy(field, idx, disk)...)
+		causes = append(causes, validateIOTune(field, idx, disk)...)
 		// Verify disk and volume name can be a valid container name since disk
 		// name can become a container name which will fail to schedule if invalid
 		causes = append(causes, validateDiskNameAsContainerName(field, idx, disk)...)
 		causes = append(causes, validateBlockSize(field, idx, disk)...)
+		causes = append(causes, validateQueueSize(field, idx, disk)...)
+		causes = append(causes, validateZoned(field, idx, disk)...)
+	}
+	return causes
+}
+
+// ValidateSCSIControllers validates that disks are only mapped to existing SCSI controllers.
+func ValidateSCSIControllers(field *k8sfield.Path, devices *v1.Devices) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	controllerCount := uint32(1)
+	if devices.SCSIControllerCount != nil {
+		if *devices.SCSIControllerCount == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be greater than 0, if supplied", field.Child("scsiControllerCount").String()),
+				Field:   field.Child("scsiControllerCount").String(),
+			})
+			return causes
+		}
+		controllerCount = *devices.SCSIControllerCount
+	}
+	if devices.SCSIControllerQueues != nil && *devices.SCSIControllerQueues == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater than 0, if supplied", field.Child("scsiControllerQueues").String()),
+			Field:   field.Child("scsiControllerQueues").String(),
+		})
+	}
+
+	for idx, disk := range devices.Disks {
+		var controller *uint32
+		switch {
+		case disk.Disk != nil:
+			controller = disk.Disk.SCSIController
+		case disk.LUN != nil:
+			controller = disk.LUN.SCSIController
+		}
+		if controller == nil {
+			continue
+		}
+		diskType := getDiskType(disk)
+		controllerField := field.Child("disks").Index(idx).Child(diskType, "scsiController")
+		if getDiskBus(disk) != v1.DiskBusSCSI {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s can only be set for the scsi bus", controllerField.String()),
+				Field:   controllerField.String(),
+			})
+		} else if *controller >= controllerCount {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s refers to SCSI controller %d but only %d SCSI controllers are defined",
+					controllerField.String(), *controller, controllerCount),
+				Field: controllerField.String(),
+			})
+		}
 	}
 	return causes
 }
@@ -76,6 +135,118 @@ func ValidateContainerDisks(field *k8sfield.Path, spec *v1.VirtualMachineInstanc
 	return causes
 }
 
+// ValidateVhostUserBlkDisks validates that the disks of vhost-user-blk volumes are virtio disks
+// without the settings libvirt rejects on vhost-user disks.
+func ValidateVhostUserBlkDisks(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	vhostUserBlkVolumes := make(map[string]struct{})
+	for _, volume := range spec.Volumes {
+		if volume.VhostUserBlk != nil {
+			vhostUserBlkVolumes[volume.Name] = struct{}{}
+		}
+	}
+
+	var causes []metav1.StatusCause
+	for idx, disk := range spec.Domain.Devices.Disks {
+		if _, exists := vhostUserBlkVolumes[disk.Name]; !exists {
+			continue
+		}
+		diskField := field.Child("domain", "devices", "disks").Index(idx)
+		if disk.Disk == nil || (disk.Disk.Bus != "" && disk.Disk.Bus != v1.DiskBusVirtio) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be a disk on the virtio bus to use a vhost-user-blk volume", diskField.String()),
+				Field:   diskField.String(),
+			})
+			continue
+		}
+
+		unsupportedSettings := []struct {
+			field *k8sfield.Path
+			isSet bool
+		}{
+			{diskField.Child("disk", "readonly"), disk.Disk.ReadOnly},
+			{diskField.Child("serial"), disk.Serial != ""},
+			{diskField.Child("cache"), disk.Cache != ""},
+			{diskField.Child("io"), disk.IO != ""},
+			{diskField.Child("errorPolicy"), disk.ErrorPolicy != nil},
+			{diskField.Child("rerrorPolicy"), disk.RerrorPolicy != nil},
+			{diskField.Child("ioTune"), disk.IOTune != nil},
+			{diskField.Child("blockSize"), disk.BlockSize != nil},
+			{diskField.Child("shareable"), disk.Shareable != nil && *disk.Shareable},
+			{diskField.Child("dedicatedIOThread"), disk.DedicatedIOThread != nil && *disk.DedicatedIOThread},
+		}
+		for _, setting := range unsupportedSettings {
+			if setting.isSet {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s is not supported with vhost-user-blk volumes", setting.field.String()),
+					Field:   setting.field.String(),
+				})
+			}
+		}
+	}
+	return causes
+}
+
+// ValidateHostBlockDeviceDisks validates that host block device volumes are passed to the guest as virtio disks.
+func ValidateHostBlockDeviceDisks(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	hostBlockDeviceVolumes := make(map[string]struct{})
+	for _, volume := range spec.Volumes {
+		if volume.HostBlockDevice != nil {
+			hostBlockDeviceVolumes[volume.Name] = struct{}{}
+		}
+	}
+
+	var causes []metav1.StatusCause
+	for idx, disk := range spec.Domain.Devices.Disks {
+		if _, exists := hostBlockDeviceVolumes[disk.Name]; !exists {
+			continue
+		}
+		if disk.Disk == nil || (disk.Disk.Bus != "" && disk.Disk.Bus != v1.DiskBusVirtio) {
+			diskField := field.Child("domain", "devices", "disks").Index(idx)
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be a disk on the virtio bus to use a host block device volume", diskField.String()),
+				Field:   diskField.String(),
+			})
+		}
+	}
+	return causes
+}
+
+// ValidateCCWAddresses validates that disks are only pinned to CCW addresses on s390x, and that no
+// two disks are pinned to the same address. The architecture is defaulted by the mutating webhook,
+// the addresses are only rejected once it is known.
+func ValidateCCWAddresses(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	pinned := make(map[string]string)
+	for idx, disk := range spec.Domain.Devices.Disks {
+		if disk.Disk == nil || disk.Disk.CCWAddress == "" {
+			continue
+		}
+		ccwField := field.Child("domain", "devices", "disks").Index(idx).Child("ccwAddress")
+		if spec.Architecture != "" && spec.Architecture != "s390x" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s is not supported on %s, CCW addresses are only available on s390x", ccwField.String(), spec.Architecture),
+				Field:   ccwField.String(),
+			})
+			continue
+		}
+		busID := strings.ToLower(disk.Disk.CCWAddress)
+		if other, exists := pinned[busID]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("disks %s and %s are both placed on the CCW address %s", other, disk.Name, disk.Disk.CCWAddress),
+				Field:   ccwField.String(),
+			})
+			continue
+		}
+		pinned[busID] = disk.Name
+	}
+	return causes
+}
+
 func validateDiskName(field *k8sfield.Path, idx int, disks []v1.Disk) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	for otherIdx, disk := range disks {
@@ -138,6 +309,38 @@ func validatePciAddress(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.St
 	return causes
 }
 
+func validateCCWAddress(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if disk.Disk == nil || disk.Disk.CCWAddress == "" {
+		return causes
+	}
+
+	if disk.Disk.Bus != v1.DiskBusVirtio {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("disk %s - setting a CCW address is only possible with bus type virtio.", field.Child("domain", "devices", "disks", "disk").Index(idx).Child("name").String()),
+			Field:   field.Child("domain", "devices", "disks", "disk").Index(idx).Child("ccwAddress").String(),
+		})
+	}
+
+	if disk.Disk.PciAddress != "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("disk %s can't have both a PCI and a CCW address.", field.Child("domain", "devices", "disks", "disk").Index(idx).Child("name").String()),
+			Field:   field.Child("domain", "devices", "disks", "disk").Index(idx).Child("ccwAddress").String(),
+		})
+	}
+
+	if _, err := hwutil.ParseCCWAddress(disk.Disk.CCWAddress); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("disk %s has malformed CCW address (%s).", field.Child("domain", "devices", "disks", "disk").Index(idx).Child("name").String(), disk.Disk.CCWAddress),
+			Field:   field.Child("domain", "devices", "disks", "disk").Index(idx).Child("ccwAddress").String(),
+		})
+	}
+	return causes
+}
+
 func validateBootOrderValue(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if disk.BootOrder != nil && *disk.BootOrder < 1 {
@@ -208,10 +411,19 @@ func validateBusSupport(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.St
 				Field:   field.Index(idx).Child("disk", "bus").String(),
 			})
 		}
+	case v1.DiskBusNVMe:
+		// NVMe namespaces are plain block devices, there is neither a tray nor SCSI passthrough
+		if diskType != "disk" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("Bus type %s is only supported for disk devices", bus),
+				Field:   field.Index(idx).Child(diskType, "bus").String(),
+			})
+		}
 	case v1.DiskBusSCSI, v1.DiskBusUSB:
 		break
 	default:
-		supportedBuses := []v1.DiskBus{v1.DiskBusVirtio, v1.DiskBusSCSI, v1.DiskBusSATA, v1.DiskBusUSB}
+		supportedBuses := []v1.DiskBus{v1.DiskBusVirtio, v1.DiskBusSCSI, v1.DiskBusSATA, v1.DiskBusUSB, v1.DiskBusNVMe}
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
 			Message: fmt.Sprintf("%s is set with an unrecognized bus %s, must be one of: %v", field.Index(idx).String(), bus, supportedBuses),
@@ -290,6 +502,95 @@ func validateErrorPolicy(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.S
 	return causes
 }
 
+// validateReadErrorPolicy rejects the enospace policy, which libvirt only supports for write errors.
+func validateReadErrorPolicy(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if disk.RerrorPolicy != nil && *disk.RerrorPolicy != v1.DiskErrorPolicyStop && *disk.RerrorPolicy != v1.DiskErrorPolicyIgnore && *disk.RerrorPolicy != v1.DiskErrorPolicyReport {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s has invalid value \"%s\"", field.Index(idx).Child("rerrorPolicy").String(), *disk.RerrorPolicy),
+			Field:   field.Index(idx).Child("rerrorPolicy").String(),
+		})
+	}
+	return causes
+}
+
+// validateIOTune rejects the IO throttling combinations libvirt refuses: a total limit together
+// with the read or write limit of the same kind, and burst limits without or below their base limit.
+func validateIOTune(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
+	if disk.IOTune == nil {
+		return nil
+	}
+	var causes []metav1.StatusCause
+	ioTune := disk.IOTune
+	ioTuneField := field.Index(idx).Child("ioTune")
+
+	for _, limits := range []struct {
+		total        string
+		totalIsSet   bool
+		others       []string
+		othersAreSet bool
+	}{
+		{"totalBytesSec", ioTune.TotalBytesSec != 0, []string{"readBytesSec", "writeBytesSec"}, ioTune.ReadBytesSec != 0 || ioTune.WriteBytesSec != 0},
+		{"totalIopsSec", ioTune.TotalIOPSSec != 0, []string{"readIopsSec", "writeIopsSec"}, ioTune.ReadIOPSSec != 0 || ioTune.WriteIOPSSec != 0},
+	} {
+		if limits.totalIsSet && limits.othersAreSet {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s cannot be combined with %s or %s", ioTuneField.Child(limits.total).String(),
+					ioTuneField.Child(limits.others[0]).String(), ioTuneField.Child(limits.others[1]).String()),
+				Field: ioTuneField.Child(limits.total).String(),
+			})
+		}
+	}
+
+	burst := ioTune.Burst
+	if burst == nil {
+		return causes
+	}
+	burstField := ioTuneField.Child("burst")
+	for _, limit := range []struct {
+		name  string
+		base  uint64
+		burst uint64
+	}{
+		{"totalBytesSec", ioTune.TotalBytesSec, burst.TotalBytesSec},
+		{"readBytesSec", ioTune.ReadBytesSec, burst.ReadBytesSec},
+		{"writeBytesSec", ioTune.WriteBytesSec, burst.WriteBytesSec},
+		{"totalIopsSec", ioTune.TotalIOPSSec, burst.TotalIOPSSec},
+		{"readIopsSec", ioTune.ReadIOPSSec, burst.ReadIOPSSec},
+		{"writeIopsSec", ioTune.WriteIOPSSec, burst.WriteIOPSSec},
+	} {
+		if limit.burst == 0 {
+			continue
+		}
+		if limit.base == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s requires %s to be set", burstField.Child(limit.name).String(), ioTuneField.Child(limit.name).String()),
+				Field:   burstField.Child(limit.name).String(),
+			})
+		} else if limit.burst < limit.base {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be lower than %s", burstField.Child(limit.name).String(), ioTuneField.Child(limit.name).String()),
+				Field:   burstField.Child(limit.name).String(),
+			})
+		}
+	}
+
+	hasBurstLimit := burst.TotalBytesSec != 0 || burst.ReadBytesSec != 0 || burst.WriteBytesSec != 0 ||
+		burst.TotalIOPSSec != 0 || burst.ReadIOPSSec != 0 || burst.WriteIOPSSec != 0
+	if burst.LengthSeconds != 0 && !hasBurstLimit {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires at least one burst limit", burstField.Child("lengthSeconds").String()),
+			Field:   burstField.Child("lengthSeconds").String(),
+		})
+	}
+	return causes
+}
+
 func validateDiskNameAsContainerName(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	for _, err := range validation.IsDNS1123Label(disk.Name) {
@@ -368,6 +669,55 @@ func validateBlockSize(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.Sta
 	return causes
 }
 
+func validateQueueSize(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
+	if disk.Disk == nil || disk.Disk.QueueSize == nil {
+		return nil
+	}
+
+	queueSizeField := field.Index(idx).Child("disk", "queueSize").String()
+	// Disks on the scsi bus share the virtqueues of their virtio-scsi controller, libvirt
+	// only allows to set the number of its queues, not their size
+	if disk.Disk.Bus != v1.DiskBusVirtio {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s can only be set for the virtio bus", queueSizeField),
+			Field:   queueSizeField,
+		}}
+	}
+	queueSize := *disk.Disk.QueueSize
+	if !hwutil.IsValidVirtqueueSize(queueSize, hwutil.MinVirtqueueSize) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be a power of 2 between %d and %d", queueSizeField, hwutil.MinVirtqueueSize, hwutil.MaxVirtqueueSize),
+			Field:   queueSizeField,
+		}}
+	}
+	return nil
+}
+
+func validateZoned(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
+	if disk.Disk == nil || !disk.Disk.Zoned {
+		return nil
+	}
+
+	zonedField := field.Index(idx).Child("disk", "zoned").String()
+	if disk.Disk.Bus != v1.DiskBusVirtio {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s can only be set for the virtio bus", zonedField),
+			Field:   zonedField,
+		}}
+	}
+	if disk.Cache != "" && disk.Cache != v1.CacheNone {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires the cache mode %s", zonedField, v1.CacheNone),
+			Field:   field.Index(idx).Child("cache").String(),
+		}}
+	}
+	return nil
+}
+
 func ValidatePath(field *k8sfield.Path, path string) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if path == "/" {
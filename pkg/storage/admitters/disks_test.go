@@ -26,6 +26,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -141,6 +142,34 @@ var _ = Describe("Disk Validation", func() {
 			Expect(causes[0].Field).To(Equal("fake.domain.devices.disks.disk[0].pciAddress"))
 		})
 
+		DescribeTable("should reject disks with an invalid CCW address", func(target v1.DiskTarget) {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name:       "testdisk",
+				DiskDevice: v1.DiskDevice{Disk: &target},
+			})
+			causes := ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.disks.disk[0].ccwAddress"))
+		},
+			Entry("on a non-virtio bus", v1.DiskTarget{CCWAddress: "0.0.0001", Bus: v1.DiskBusSCSI}),
+			Entry("with a malformed device number", v1.DiskTarget{CCWAddress: "0.0.001", Bus: v1.DiskBusVirtio}),
+			Entry("with an out of range subchannel set", v1.DiskTarget{CCWAddress: "0.4.0001", Bus: v1.DiskBusVirtio}),
+			Entry("together with a PCI address", v1.DiskTarget{CCWAddress: "0.0.0001", PciAddress: "0000:04:10.0", Bus: v1.DiskBusVirtio}),
+		)
+
+		It("should accept disks with a CCW address on the virtio bus", func() {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{
+						CCWAddress: "0.0.0001",
+						Bus:        v1.DiskBusVirtio,
+					},
+				},
+			})
+			Expect(ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)).To(BeEmpty())
+		})
+
 		It("should reject disk with multiple targets ", func() {
 			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
 				Name: "testdisk",
@@ -231,11 +260,33 @@ var _ = Describe("Disk Validation", func() {
 					Disk: &v1.DiskTarget{},
 				},
 			})
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk6",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{
+						Bus: v1.DiskBusNVMe,
+					},
+				},
+			})
 
 			causes := ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
 			Expect(causes).To(BeEmpty())
 		})
 
+		DescribeTable("should reject the nvme bus for", func(disk v1.DiskDevice, expectedField string) {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name:       "testdisk",
+				DiskDevice: disk,
+			})
+
+			causes := ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("a LUN", v1.DiskDevice{LUN: &v1.LunTarget{Bus: v1.DiskBusNVMe}}, "fake[0].lun.bus"),
+			Entry("a CD-ROM", v1.DiskDevice{CDRom: &v1.CDRomTarget{Bus: v1.DiskBusNVMe}}, "fake[0].cdrom.bus"),
+		)
+
 		It("should reject disks with unsupported buses", func() {
 			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
 				Name: "testdisk1",
@@ -329,6 +380,35 @@ var _ = Describe("Disk Validation", func() {
 			Entry("enospace", v1.DiskErrorPolicyEnospace),
 		)
 
+		DescribeTable("should reject disk with invalid rerrorPolicy", func(policy string) {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk", RerrorPolicy: pointer.P(v1.DiskErrorPolicy(policy)), DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{}}})
+
+			causes := ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(1))
+			Expect(string(causes[0].Type)).To(Equal("FieldValueInvalid"))
+			Expect(causes[0].Field).To(Equal("fake[0].rerrorPolicy"))
+			Expect(causes[0].Message).To(Equal(fmt.Sprintf("fake[0].rerrorPolicy has invalid value \"%s\"", policy)))
+		},
+			Entry("with arbitrary string", "unsupported"),
+			Entry("with empty string", ""),
+			Entry("with enospace, which only applies to write errors", "enospace"),
+		)
+
+		DescribeTable("It should accept a disk with a valid rerrorPolicy", func(mode v1.DiskErrorPolicy) {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk", ErrorPolicy: pointer.P(v1.DiskErrorPolicyStop), RerrorPolicy: pointer.P(mode), DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{}}})
+
+			causes := ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(BeEmpty())
+		},
+			Entry("stop", v1.DiskErrorPolicyStop),
+			Entry("report", v1.DiskErrorPolicyReport),
+			Entry("ignore", v1.DiskErrorPolicyIgnore),
+		)
+
 		It("should reject invalid SN characters", func() {
 			order := uint(1)
 			sn := "$$$$"
@@ -565,4 +645,260 @@ var _ = Describe("Disk Validation", func() {
 			})
 		})
 	})
+
+	Context("with queue size", func() {
+		virtioDisk := func(bus v1.DiskBus, queueSize uint32) []v1.Disk {
+			return []v1.Disk{{Name: "disk0", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: bus, QueueSize: pointer.P(queueSize)}}}}
+		}
+
+		DescribeTable("should accept a virtio disk with a queue size of", func(queueSize uint32) {
+			Expect(ValidateDisks(k8sfield.NewPath("fake"), virtioDisk(v1.DiskBusVirtio, queueSize))).To(BeEmpty())
+		},
+			Entry("4", uint32(4)),
+			Entry("256", uint32(256)),
+			Entry("1024", uint32(1024)),
+		)
+
+		DescribeTable("should reject", func(bus v1.DiskBus, queueSize uint32, expectedMessage string) {
+			causes := ValidateDisks(k8sfield.NewPath("fake"), virtioDisk(bus, queueSize))
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake[0].disk.queueSize"))
+			Expect(causes[0].Message).To(Equal(expectedMessage))
+		},
+			Entry("a queue size on the sata bus", v1.DiskBusSATA, uint32(256), "fake[0].disk.queueSize can only be set for the virtio bus"),
+			Entry("a queue size lower than 4", v1.DiskBusVirtio, uint32(2), "fake[0].disk.queueSize must be a power of 2 between 4 and 1024"),
+			Entry("a queue size greater than 1024", v1.DiskBusVirtio, uint32(2048), "fake[0].disk.queueSize must be a power of 2 between 4 and 1024"),
+			Entry("a queue size which is not a power of 2", v1.DiskBusVirtio, uint32(100), "fake[0].disk.queueSize must be a power of 2 between 4 and 1024"),
+		)
+	})
+
+	Context("with zoned", func() {
+		zonedDisk := func(bus v1.DiskBus, cache v1.DriverCache) []v1.Disk {
+			return []v1.Disk{{Name: "disk0", Cache: cache, DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: bus, Zoned: true}}}}
+		}
+
+		DescribeTable("should accept a zoned virtio disk with the cache mode", func(cache v1.DriverCache) {
+			Expect(ValidateDisks(k8sfield.NewPath("fake"), zonedDisk(v1.DiskBusVirtio, cache))).To(BeEmpty())
+		},
+			Entry("unset", v1.DriverCache("")),
+			Entry("none", v1.CacheNone),
+		)
+
+		DescribeTable("should reject", func(bus v1.DiskBus, cache v1.DriverCache, expectedField, expectedMessage string) {
+			causes := ValidateDisks(k8sfield.NewPath("fake"), zonedDisk(bus, cache))
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+			Expect(causes[0].Message).To(Equal(expectedMessage))
+		},
+			Entry("a zoned disk on the sata bus", v1.DiskBusSATA, v1.DriverCache(""), "fake[0].disk.zoned", "fake[0].disk.zoned can only be set for the virtio bus"),
+			Entry("a zoned disk with the writethrough cache mode", v1.DiskBusVirtio, v1.CacheWriteThrough, "fake[0].cache", "fake[0].disk.zoned requires the cache mode none"),
+		)
+	})
+
+	Context("with IO throttling", func() {
+		ioTuneDisk := func(ioTune *v1.DiskIOTune) []v1.Disk {
+			return []v1.Disk{{Name: "disk0", IOTune: ioTune, DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}}}
+		}
+
+		DescribeTable("should accept", func(ioTune *v1.DiskIOTune) {
+			Expect(ValidateDisks(k8sfield.NewPath("fake"), ioTuneDisk(ioTune))).To(BeEmpty())
+		},
+			Entry("total limits", &v1.DiskIOTune{TotalBytesSec: 1000, TotalIOPSSec: 100}),
+			Entry("read and write limits", &v1.DiskIOTune{ReadBytesSec: 1000, WriteBytesSec: 500, ReadIOPSSec: 100, WriteIOPSSec: 50}),
+			Entry("a total bytes limit with read and write IOPS limits", &v1.DiskIOTune{TotalBytesSec: 1000, ReadIOPSSec: 100, WriteIOPSSec: 50}),
+			Entry("burst limits with a length", &v1.DiskIOTune{TotalIOPSSec: 100, Burst: &v1.DiskIOTuneBurst{TotalIOPSSec: 500, LengthSeconds: 10}}),
+			Entry("a burst limit equal to its limit", &v1.DiskIOTune{ReadBytesSec: 1000, Burst: &v1.DiskIOTuneBurst{ReadBytesSec: 1000}}),
+		)
+
+		DescribeTable("should reject", func(ioTune *v1.DiskIOTune, expectedField, expectedMessage string) {
+			causes := ValidateDisks(k8sfield.NewPath("fake"), ioTuneDisk(ioTune))
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+			Expect(causes[0].Message).To(Equal(expectedMessage))
+		},
+			Entry("a total bytes limit with a read bytes limit", &v1.DiskIOTune{TotalBytesSec: 1000, ReadBytesSec: 500},
+				"fake[0].ioTune.totalBytesSec", "fake[0].ioTune.totalBytesSec cannot be combined with fake[0].ioTune.readBytesSec or fake[0].ioTune.writeBytesSec"),
+			Entry("a total IOPS limit with a write IOPS limit", &v1.DiskIOTune{TotalIOPSSec: 100, WriteIOPSSec: 50},
+				"fake[0].ioTune.totalIopsSec", "fake[0].ioTune.totalIopsSec cannot be combined with fake[0].ioTune.readIopsSec or fake[0].ioTune.writeIopsSec"),
+			Entry("a burst limit without its limit", &v1.DiskIOTune{TotalIOPSSec: 100, Burst: &v1.DiskIOTuneBurst{WriteBytesSec: 1000}},
+				"fake[0].ioTune.burst.writeBytesSec", "fake[0].ioTune.burst.writeBytesSec requires fake[0].ioTune.writeBytesSec to be set"),
+			Entry("a burst limit lower than its limit", &v1.DiskIOTune{ReadIOPSSec: 100, Burst: &v1.DiskIOTuneBurst{ReadIOPSSec: 50}},
+				"fake[0].ioTune.burst.readIopsSec", "fake[0].ioTune.burst.readIopsSec must not be lower than fake[0].ioTune.readIopsSec"),
+			Entry("a burst length without burst limits", &v1.DiskIOTune{TotalIOPSSec: 100, Burst: &v1.DiskIOTuneBurst{LengthSeconds: 10}},
+				"fake[0].ioTune.burst.lengthSeconds", "fake[0].ioTune.burst.lengthSeconds requires at least one burst limit"),
+		)
+	})
+
+	Context("with ValidateVhostUserBlkDisks", func() {
+		vhostUserBlkSpec := func(disk v1.Disk) *v1.VirtualMachineInstanceSpec {
+			disk.Name = "disk0"
+			return &v1.VirtualMachineInstanceSpec{
+				Domain: v1.DomainSpec{Devices: v1.Devices{Disks: []v1.Disk{disk}}},
+				Volumes: []v1.Volume{{
+					Name: "disk0",
+					VolumeSource: v1.VolumeSource{
+						VhostUserBlk: &v1.VhostUserBlkVolumeSource{Path: "/var/tmp/spdk/vhost.0"},
+					},
+				}},
+			}
+		}
+
+		It("should accept a virtio disk", func() {
+			disk := v1.Disk{DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}}
+			Expect(ValidateVhostUserBlkDisks(k8sfield.NewPath("fake"), vhostUserBlkSpec(disk))).To(BeEmpty())
+		})
+
+		It("should ignore the disks of other volumes", func() {
+			spec := vhostUserBlkSpec(v1.Disk{DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}})
+			spec.Domain.Devices.Disks = append(spec.Domain.Devices.Disks, v1.Disk{
+				Name:       "disk1",
+				Serial:     "serial",
+				DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSATA}},
+			})
+			Expect(ValidateVhostUserBlkDisks(k8sfield.NewPath("fake"), spec)).To(BeEmpty())
+		})
+
+		DescribeTable("should reject", func(disk v1.Disk, expectedField string, expectedMessage string) {
+			causes := ValidateVhostUserBlkDisks(k8sfield.NewPath("fake"), vhostUserBlkSpec(disk))
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+			Expect(causes[0].Message).To(Equal(expectedMessage))
+		},
+			Entry("a cdrom", v1.Disk{DiskDevice: v1.DiskDevice{CDRom: &v1.CDRomTarget{Bus: v1.DiskBusSATA}}},
+				"fake.domain.devices.disks[0]", "fake.domain.devices.disks[0] must be a disk on the virtio bus to use a vhost-user-blk volume"),
+			Entry("a disk on the sata bus", v1.Disk{DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSATA}}},
+				"fake.domain.devices.disks[0]", "fake.domain.devices.disks[0] must be a disk on the virtio bus to use a vhost-user-blk volume"),
+			Entry("a read-only disk", v1.Disk{DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio, ReadOnly: true}}},
+				"fake.domain.devices.disks[0].disk.readonly", "fake.domain.devices.disks[0].disk.readonly is not supported with vhost-user-blk volumes"),
+			Entry("a serial", v1.Disk{Serial: "serial", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}},
+				"fake.domain.devices.disks[0].serial", "fake.domain.devices.disks[0].serial is not supported with vhost-user-blk volumes"),
+			Entry("a cache mode", v1.Disk{Cache: v1.CacheNone, DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}},
+				"fake.domain.devices.disks[0].cache", "fake.domain.devices.disks[0].cache is not supported with vhost-user-blk volumes"),
+			Entry("an error policy", v1.Disk{ErrorPolicy: pointer.P(v1.DiskErrorPolicyReport), DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}},
+				"fake.domain.devices.disks[0].errorPolicy", "fake.domain.devices.disks[0].errorPolicy is not supported with vhost-user-blk volumes"),
+			Entry("a read error policy", v1.Disk{RerrorPolicy: pointer.P(v1.DiskErrorPolicyReport), DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}},
+				"fake.domain.devices.disks[0].rerrorPolicy", "fake.domain.devices.disks[0].rerrorPolicy is not supported with vhost-user-blk volumes"),
+			Entry("IO throttling", v1.Disk{IOTune: &v1.DiskIOTune{TotalIOPSSec: 100}, DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}},
+				"fake.domain.devices.disks[0].ioTune", "fake.domain.devices.disks[0].ioTune is not supported with vhost-user-blk volumes"),
+			Entry("a dedicated IO thread", v1.Disk{DedicatedIOThread: pointer.P(true), DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}},
+				"fake.domain.devices.disks[0].dedicatedIOThread", "fake.domain.devices.disks[0].dedicatedIOThread is not supported with vhost-user-blk volumes"),
+		)
+	})
+
+	Context("with ValidateHostBlockDeviceDisks", func() {
+		hostBlockDeviceSpec := func(disk v1.Disk) *v1.VirtualMachineInstanceSpec {
+			disk.Name = "disk0"
+			return &v1.VirtualMachineInstanceSpec{
+				Domain: v1.DomainSpec{Devices: v1.Devices{Disks: []v1.Disk{disk}}},
+				Volumes: []v1.Volume{{
+					Name: "disk0",
+					VolumeSource: v1.VolumeSource{
+						HostBlockDevice: &v1.HostBlockDeviceVolumeSource{Path: "/dev/nvme1n1"},
+					},
+				}},
+			}
+		}
+
+		DescribeTable("should accept", func(disk v1.Disk) {
+			Expect(ValidateHostBlockDeviceDisks(k8sfield.NewPath("fake"), hostBlockDeviceSpec(disk))).To(BeEmpty())
+		},
+			Entry("a virtio disk", v1.Disk{DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}}),
+			Entry("a disk without bus", v1.Disk{DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{}}}),
+		)
+
+		DescribeTable("should reject", func(disk v1.Disk) {
+			causes := ValidateHostBlockDeviceDisks(k8sfield.NewPath("fake"), hostBlockDeviceSpec(disk))
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.disks[0]"))
+			Expect(causes[0].Message).To(Equal("fake.domain.devices.disks[0] must be a disk on the virtio bus to use a host block device volume"))
+		},
+			Entry("a lun", v1.Disk{DiskDevice: v1.DiskDevice{LUN: &v1.LunTarget{Bus: v1.DiskBusSCSI}}}),
+			Entry("a disk on the sata bus", v1.Disk{DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSATA}}}),
+		)
+	})
+
+	Context("with ValidateCCWAddresses", func() {
+		ccwDisk := func(name, ccwAddress string) v1.Disk {
+			return v1.Disk{Name: name, DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio, CCWAddress: ccwAddress}}}
+		}
+		ccwSpec := func(architecture string, disks ...v1.Disk) *v1.VirtualMachineInstanceSpec {
+			return &v1.VirtualMachineInstanceSpec{
+				Architecture: architecture,
+				Domain:       v1.DomainSpec{Devices: v1.Devices{Disks: disks}},
+			}
+		}
+
+		It("should accept disks on distinct CCW addresses on s390x", func() {
+			spec := ccwSpec("s390x", ccwDisk("disk0", "0.0.0001"), ccwDisk("disk1", "0.1.0001"), v1.Disk{Name: "disk2"})
+			Expect(ValidateCCWAddresses(k8sfield.NewPath("fake"), spec)).To(BeEmpty())
+		})
+
+		It("should reject a CCW address on another architecture", func() {
+			causes := ValidateCCWAddresses(k8sfield.NewPath("fake"), ccwSpec("amd64", ccwDisk("disk0", "0.0.0001")))
+			Expect(causes).To(ConsistOf(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "fake.domain.devices.disks[0].ccwAddress is not supported on amd64, CCW addresses are only available on s390x",
+				Field:   "fake.domain.devices.disks[0].ccwAddress",
+			}))
+		})
+
+		DescribeTable("should reject disks on the same CCW address", func(ccwAddress string) {
+			causes := ValidateCCWAddresses(k8sfield.NewPath("fake"), ccwSpec("s390x", ccwDisk("disk0", "0.0.000a"), ccwDisk("disk1", ccwAddress)))
+			Expect(causes).To(ConsistOf(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("disks disk0 and disk1 are both placed on the CCW address %s", ccwAddress),
+				Field:   "fake.domain.devices.disks[1].ccwAddress",
+			}))
+		},
+			Entry("with the same spelling", "0.0.000a"),
+			Entry("with an upper case device number", "0.0.000A"),
+		)
+	})
+
+	Context("with ValidateSCSIControllers", func() {
+		scsiDisk := func(name string, controller *uint32) v1.Disk {
+			return v1.Disk{Name: name, DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSCSI, SCSIController: controller}}}
+		}
+		scsiLUN := func(name string, controller *uint32) v1.Disk {
+			return v1.Disk{Name: name, DiskDevice: v1.DiskDevice{LUN: &v1.LunTarget{Bus: v1.DiskBusSCSI, SCSIController: controller}}}
+		}
+
+		DescribeTable("should accept disks mapped to existing controllers", func(controllerCount *uint32, disks ...v1.Disk) {
+			devices := &v1.Devices{SCSIControllerCount: controllerCount, Disks: disks}
+			Expect(ValidateSCSIControllers(k8sfield.NewPath("fake"), devices)).To(BeEmpty())
+		},
+			Entry("without mapping", nil, scsiDisk("disk0", nil)),
+			Entry("on the default controller", nil, scsiDisk("disk0", pointer.P(uint32(0)))),
+			Entry("on multiple controllers", pointer.P(uint32(2)),
+				scsiDisk("disk0", pointer.P(uint32(0))), scsiLUN("lun0", pointer.P(uint32(1)))),
+		)
+
+		DescribeTable("should reject", func(controllerCount *uint32, disk v1.Disk, expectedField, expectedMessage string) {
+			devices := &v1.Devices{SCSIControllerCount: controllerCount, Disks: []v1.Disk{disk}}
+			causes := ValidateSCSIControllers(k8sfield.NewPath("fake"), devices)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+			Expect(causes[0].Message).To(Equal(expectedMessage))
+		},
+			Entry("a zero controller count", pointer.P(uint32(0)), scsiDisk("disk0", nil),
+				"fake.scsiControllerCount", "fake.scsiControllerCount must be greater than 0, if supplied"),
+			Entry("a disk on a missing controller", pointer.P(uint32(2)), scsiDisk("disk0", pointer.P(uint32(2))),
+				"fake.disks[0].disk.scsiController",
+				"fake.disks[0].disk.scsiController refers to SCSI controller 2 but only 2 SCSI controllers are defined"),
+			Entry("a LUN on a missing default controller", nil, scsiLUN("lun0", pointer.P(uint32(1))),
+				"fake.disks[0].lun.scsiController",
+				"fake.disks[0].lun.scsiController refers to SCSI controller 1 but only 1 SCSI controllers are defined"),
+			Entry("a controller on a non-scsi bus", nil,
+				v1.Disk{Name: "disk0", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio, SCSIController: pointer.P(uint32(0))}}},
+				"fake.disks[0].disk.scsiController", "fake.disks[0].disk.scsiController can only be set for the scsi bus"),
+		)
+
+		It("should reject zero controller queues", func() {
+			devices := &v1.Devices{SCSIControllerQueues: pointer.P(uint32(0)), Disks: []v1.Disk{scsiDisk("disk0", nil)}}
+			causes := ValidateSCSIControllers(k8sfield.NewPath("fake"), devices)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.scsiControllerQueues"))
+			Expect(causes[0].Message).To(Equal("fake.scsiControllerQueues must be greater than 0, if supplied"))
+		})
+	})
 })
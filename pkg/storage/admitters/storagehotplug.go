@@ -31,6 +31,7 @@ import (
 
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
 // AdmitHotplugStorage compares the old and new volumes and disks, and ensures that they match and are valid.
@@ -52,6 +53,15 @@ func AdmitHotplugStorage(newVolumes, oldVolumes []v1.Volume, newDisks, oldDisks
 	newDiskMap := getDiskMap(newDisks)
 	oldDiskMap := getDiskMap(oldDisks)
 
+	if !config.PersistentReservationEnabled() && hasNewReservationLUN(newHotplugVolumeMap, oldHotplugVolumeMap, newDiskMap) {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.PersistentReservation),
+			},
+		})
+	}
+
 	permanentAr := verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap, newDiskMap, oldDiskMap, migratedVolumeMap)
 	if permanentAr != nil {
 		return permanentAr
@@ -149,6 +159,20 @@ func validateExpectedDisksAndFilesystems(volumes []v1.Volume, disks []v1.Disk, f
 	return nil
 }
 
+// hasNewReservationLUN returns whether a LUN hotplugged by this update requests a SCSI persistent reservation.
+// LUNs which are already attached are left alone, so disabling the feature gate does not block unrelated updates.
+func hasNewReservationLUN(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1.Volume, newDisks map[string]v1.Disk) bool {
+	for name := range newHotplugVolumeMap {
+		if _, ok := oldHotplugVolumeMap[name]; ok {
+			continue
+		}
+		if disk, ok := newDisks[name]; ok && disk.DiskDevice.LUN != nil && disk.DiskDevice.LUN.Reservation {
+			return true
+		}
+	}
+	return false
+}
+
 func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1.Volume, newDisks, oldDisks map[string]v1.Disk,
 	migratedVols map[string]bool) *admissionv1.AdmissionResponse {
 	for k, v := range newHotplugVolumeMap {
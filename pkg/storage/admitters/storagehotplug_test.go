@@ -540,6 +540,54 @@ var _ = Describe("Storage Hotplug Admitter", func() {
 		)
 	})
 
+	Context("with persistent reservation", func() {
+		makeReservationLUNDisks := func(indexes ...int) []v1.Disk {
+			res := makeLUNDisks(indexes...)
+			if len(res) > 0 {
+				res[len(res)-1].LUN.Reservation = true
+			}
+			return res
+		}
+
+		It("should reject hotplugging a LUN with reservation when the feature gate is disabled", func() {
+			testHotplugResponse(
+				makeVolumes(0, 1),
+				makeVolumes(0),
+				makeReservationLUNDisks(0, 1),
+				makeLUNDisks(0),
+				makeFilesystems(),
+				makeStatus(2, 1),
+				makeExpected("PersistentReservation feature gate is not enabled in kubevirt-config", ""))
+		})
+
+		It("should accept hotplugging a LUN next to a LUN with reservation when the feature gate is disabled", func() {
+			newDisks := makeLUNDisks(0, 1)
+			newDisks[0].LUN.Reservation = true
+			oldDisks := makeLUNDisks(0)
+			oldDisks[0].LUN.Reservation = true
+			testHotplugResponse(
+				makeVolumes(0, 1),
+				makeVolumes(0),
+				newDisks,
+				oldDisks,
+				makeFilesystems(),
+				makeStatus(2, 1),
+				nil)
+		})
+
+		It("should accept hotplugging a LUN with reservation when the feature gate is enabled", func() {
+			testHotplugResponse(
+				makeVolumes(0, 1),
+				makeVolumes(0),
+				makeReservationLUNDisks(0, 1),
+				makeLUNDisks(0),
+				makeFilesystems(),
+				makeStatus(2, 1),
+				nil,
+				featuregate.PersistentReservation)
+		})
+	})
+
 	DescribeTable("should allow change for a persistent volume if it is a migrated volume", func(hotpluggable bool) {
 		disks := []v1.Disk{
 			{
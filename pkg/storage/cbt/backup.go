@@ -312,6 +312,7 @@ func (ctrl *VMBackupController) sync(backup *backupv1.VirtualMachineBackup) *Syn
 		Cmd:             backupv1.Start,
 		BackupStartTime: &backup.CreationTimestamp,
 		SkipQuiesce:     backup.Spec.SkipQuiesce,
+		Bandwidth:       backup.Spec.Bandwidth,
 	}
 	if backup.Spec.Mode == nil {
 		backup.Spec.Mode = pointer.P(backupv1.PushMode)
@@ -124,6 +124,7 @@ const (
 	manifestData           = "manifest-data"
 	manifestsPath          = "/manifests/all"
 	secretManifestPath     = "/manifests/secret"
+	ovaManifestPath        = "/manifests/ova"
 	externalHostKey        = "external_host"
 	internalHostKey        = "internal_host"
 	externalCaConfigMapKey = "external_ca_cm"
@@ -993,6 +994,9 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 	}, corev1.EnvVar{
 		Name:  "EXPORT_SECRET_DEF_URI",
 		Value: secretManifestPath,
+	}, corev1.EnvVar{
+		Name:  "EXPORT_VM_OVA_URI",
+		Value: ovaManifestPath,
 	})
 
 	tokenSecretRef := ""
@@ -91,6 +91,9 @@ var (
 		{
 			Name:  "EXPORT_VM_DEF_URI",
 			Value: manifestsPath,
+		}, {
+			Name:  "EXPORT_VM_OVA_URI",
+			Value: ovaManifestPath,
 		}, {
 			Name:  "CERT_FILE",
 			Value: "/cert/tls.crt",
@@ -97,6 +97,12 @@ func (ctrl *VMExportController) getLinks(pvcs []*corev1.PersistentVolumeClaim, e
 			Url:  scheme + path.Join(hostAndBase, linkType, paths.SecretURI),
 		})
 	}
+	if paths.OVAURI != "" {
+		exportLink.Manifests = append(exportLink.Manifests, exportv1.VirtualMachineExportManifest{
+			Type: exportv1.OVA,
+			Url:  scheme + path.Join(hostAndBase, linkType, paths.OVAURI),
+		})
+	}
 
 	for _, pvc := range pvcs {
 		if pvc == nil || exporterPod.Status.Phase != corev1.PodRunning {
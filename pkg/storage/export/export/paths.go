@@ -40,6 +40,7 @@ type VolumeInfo struct {
 type ServerPaths struct {
 	VMURI     string
 	SecretURI string
+	OVAURI    string
 	Volumes   []VolumeInfo
 }
 
@@ -67,6 +68,7 @@ func CreateServerPaths(env map[string]string) *ServerPaths {
 	result := &ServerPaths{
 		VMURI:     env["EXPORT_VM_DEF_URI"],
 		SecretURI: env["EXPORT_SECRET_DEF_URI"],
+		OVAURI:    env["EXPORT_VM_OVA_URI"],
 	}
 	for k, v := range env {
 		if strings.HasSuffix(k, "_EXPORT_PATH") {
@@ -92,6 +92,7 @@ type ExportServerConfig struct {
 	GzipHandler        func(string) http.Handler
 	VmHandler          func([]export.VolumeInfo, func() (string, error), func() (*corev1.ConfigMap, error)) http.Handler
 	TokenSecretHandler func(TokenGetterFunc) http.Handler
+	OvaHandler         func([]export.VolumeInfo) http.Handler
 
 	PermissionChecker func(string) bool
 
@@ -144,6 +145,10 @@ func (s *exportServer) initHandler() {
 		mux.Handle(filepath.Join(internal, s.Paths.SecretURI), tokenChecker(s.TokenGetter, s.TokenSecretHandler(s.TokenGetter)))
 		mux.Handle(filepath.Join(external, s.Paths.SecretURI), tokenChecker(s.TokenGetter, s.TokenSecretHandler(s.TokenGetter)))
 	}
+	if s.Paths.OVAURI != "" {
+		mux.Handle(filepath.Join(internal, s.Paths.OVAURI), tokenChecker(s.TokenGetter, s.OvaHandler(s.Paths.Volumes)))
+		mux.Handle(filepath.Join(external, s.Paths.OVAURI), tokenChecker(s.TokenGetter, s.OvaHandler(s.Paths.Volumes)))
+	}
 	// Readiness probe
 	mux.HandleFunc(export.ReadinessPath, s.readyHandler)
 
@@ -255,6 +260,10 @@ func NewExportServer(config ExportServerConfig) service.Service {
 		es.TokenSecretHandler = secretHandler
 	}
 
+	if es.OvaHandler == nil {
+		es.OvaHandler = ovaHandler
+	}
+
 	if es.TokenGetter == nil {
 		es.TokenGetter = func() (string, error) {
 			return getToken(es.TokenFile)
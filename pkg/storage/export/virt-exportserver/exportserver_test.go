@@ -20,11 +20,14 @@
 package virtexportserver
 
 import (
+	"archive/tar"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -38,6 +41,7 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	"sigs.k8s.io/yaml"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/storage/export/export"
 )
 
@@ -69,6 +73,9 @@ func newTestServer(token string) *exportServer {
 		TokenSecretHandler: func(tgf TokenGetterFunc) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
+		OvaHandler: func([]export.VolumeInfo) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
 		TokenGetter: func() (string, error) {
 			return token, nil
 		},
@@ -295,6 +302,34 @@ var _ = Describe("exportserver", func() {
 		),
 	)
 
+	DescribeTable("should handle OVA URI", func(header bool, expectedStatus int) {
+		token := "foo"
+		es := newTestServer(token)
+		es.Paths = &export.ServerPaths{OVAURI: "/manifests/ova"}
+		es.initHandler()
+
+		httpServer := httptest.NewServer(es.handler)
+		defer httpServer.Close()
+
+		client := http.Client{}
+		url := httpServer.URL + "/internal/manifests/ova"
+		if !header {
+			url += "?x-kubevirt-export-token=bar"
+		}
+		req, err := http.NewRequest("GET", url, nil)
+		Expect(err).ToNot(HaveOccurred())
+		if header {
+			req.Header.Set("x-kubevirt-export-token", token)
+		}
+		res, err := client.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(expectedStatus))
+	},
+		Entry("with valid token", true, http.StatusOK),
+		Entry("with bad token", false, http.StatusUnauthorized),
+	)
+
 	Context("Vm handler", func() {
 		var (
 			orgGetExportName       = getExportName
@@ -770,4 +805,133 @@ var _ = Describe("exportserver", func() {
 			verifySecret(string(list.Items[0].Raw))
 		})
 	})
+
+	Context("OVA handler", func() {
+		var orgGetExpandedVM = getExpandedVM
+
+		AfterEach(func() {
+			getExpandedVM = orgGetExpandedVM
+		})
+
+		newVM := func(volumes ...virtv1.Volume) *virtv1.VirtualMachine {
+			vm := &virtv1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "testvm"},
+				Spec: virtv1.VirtualMachineSpec{
+					Template: &virtv1.VirtualMachineInstanceTemplateSpec{
+						Spec: virtv1.VirtualMachineInstanceSpec{
+							Domain: virtv1.DomainSpec{
+								CPU:    &virtv1.CPU{Cores: 2, Sockets: 2},
+								Memory: &virtv1.Memory{Guest: pointer.P(resource.MustParse("1Gi"))},
+								Devices: virtv1.Devices{
+									Interfaces: []virtv1.Interface{{Name: "default", Model: "e1000e", MacAddress: "02:00:00:00:00:01"}},
+								},
+							},
+							Networks: []virtv1.Network{*virtv1.DefaultPodNetwork()},
+							Volumes:  volumes,
+						},
+					},
+				},
+			}
+			for _, volume := range volumes {
+				vm.Spec.Template.Spec.Domain.Devices.Disks = append(vm.Spec.Template.Spec.Domain.Devices.Disks, virtv1.Disk{
+					Name:       volume.Name,
+					DiskDevice: virtv1.DiskDevice{Disk: &virtv1.DiskTarget{Bus: virtv1.DiskBusVirtio}},
+				})
+			}
+			return vm
+		}
+
+		readOVA := func(body io.Reader) map[string][]byte {
+			files := map[string][]byte{}
+			tr := tar.NewReader(body)
+			var names []string
+			for {
+				header, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+				Expect(err).ToNot(HaveOccurred())
+				content, err := io.ReadAll(tr)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(content).To(HaveLen(int(header.Size)))
+				files[header.Name] = content
+				names = append(names, header.Name)
+			}
+			Expect(names).ToNot(BeEmpty())
+			Expect(names[0]).To(Equal("testvm.ovf"))
+			return files
+		}
+
+		DescribeTable("should return error on non GET", func(verb string) {
+			req, err := http.NewRequest(verb, "https://test.blah.invalid/manifests/ova", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			ovaHandler(nil).ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+		},
+			Entry("POST", "POST"),
+			Entry("PUT", "PUT"),
+			Entry("PATCH", "PATCH"),
+			Entry("DELETE", "DELETE"),
+		)
+
+		It("should return 404 if getExpandedVM returns nil", func() {
+			getExpandedVM = func() *virtv1.VirtualMachine {
+				return nil
+			}
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/manifests/ova", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			ovaHandler(nil).ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("should return the OVF descriptor and the disks", func() {
+			exportDir := GinkgoT().TempDir()
+			pvcDir := filepath.Join(exportDir, "my-pvc")
+			Expect(os.Mkdir(pvcDir, 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(pvcDir, "disk.img"), []byte("disk data"), 0644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(exportDir, "my-dv"), make([]byte, grainSize), 0644)).To(Succeed())
+			vi := []export.VolumeInfo{
+				{Path: pvcDir},
+				{Path: filepath.Join(exportDir, "my-dv")},
+			}
+			getExpandedVM = func() *virtv1.VirtualMachine {
+				return newVM(
+					virtv1.Volume{Name: "rootdisk", VolumeSource: virtv1.VolumeSource{
+						PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{ClaimName: "my.pvc"},
+						},
+					}},
+					virtv1.Volume{Name: "datadisk", VolumeSource: virtv1.VolumeSource{
+						DataVolume: &virtv1.DataVolumeSource{Name: "my-dv"},
+					}},
+					virtv1.Volume{Name: "cloudinit", VolumeSource: virtv1.VolumeSource{
+						CloudInitNoCloud: &virtv1.CloudInitNoCloudSource{UserData: "#cloud-config"},
+					}},
+				)
+			}
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/manifests/ova", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			ovaHandler(vi).ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(resp.Header().Get("Content-Disposition")).To(Equal(`attachment; filename="testvm.ova"`))
+
+			files := readOVA(resp.Body)
+			Expect(files).To(HaveLen(3))
+			Expect(files).To(HaveKey("testvm-rootdisk.vmdk"))
+			Expect(files).To(HaveKey("testvm-datadisk.vmdk"))
+			raw := readStreamOptimizedVMDK(files["testvm-rootdisk.vmdk"])
+			Expect(string(raw[:len("disk data")])).To(Equal("disk data"))
+
+			ovf := string(files["testvm.ovf"])
+			Expect(ovf).To(ContainSubstring(fmt.Sprintf(`<File ovf:href="testvm-rootdisk.vmdk" ovf:id="file1" ovf:size="%d">`, len(files["testvm-rootdisk.vmdk"]))))
+			Expect(ovf).To(ContainSubstring(fmt.Sprintf(`<Disk ovf:capacity="%d" ovf:capacityAllocationUnits="byte" ovf:diskId="vmdisk2" ovf:fileRef="file2"`, grainSize)))
+			Expect(ovf).To(ContainSubstring("<rasd:VirtualQuantity>4</rasd:VirtualQuantity>"))
+			Expect(ovf).To(ContainSubstring("<rasd:VirtualQuantity>1024</rasd:VirtualQuantity>"))
+			Expect(ovf).To(ContainSubstring("<rasd:ResourceSubType>E1000e</rasd:ResourceSubType>"))
+			Expect(ovf).To(ContainSubstring("<rasd:HostResource>ovf:/disk/vmdisk2</rasd:HostResource>"))
+		})
+	})
 })
@@ -0,0 +1,182 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"archive/tar"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/export"
+)
+
+func ovaHandler(vi []export.VolumeInfo) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		vm := getExpandedVM()
+		if vm == nil || vm.Spec.Template == nil {
+			log.Log.Error("VM definition not found")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		files, images, disks, err := openOVADisks(vm, vi)
+		defer func() {
+			for _, f := range files {
+				f.Close()
+			}
+		}()
+		if err != nil {
+			log.Log.Reason(err).Error("error reading the disks of the VM")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		descriptor, err := xml.MarshalIndent(newOVFEnvelope(vm, disks), "", "  ")
+		if err != nil {
+			log.Log.Reason(err).Error("error creating the OVF descriptor")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		descriptor = append([]byte(xml.Header), descriptor...)
+
+		w.Header().Set("Content-Type", "application/x-tar")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", vm.Name+".ova"))
+		// The OVF descriptor has to be the first file of the archive
+		tw := tar.NewWriter(w)
+		if err := tw.WriteHeader(ovaFileHeader(vm.Name+".ovf", int64(len(descriptor)))); err != nil {
+			log.Log.Reason(err).Error("error writing response body")
+			return
+		}
+		if _, err := tw.Write(descriptor); err != nil {
+			log.Log.Reason(err).Error("error writing response body")
+			return
+		}
+		n := int64(len(descriptor))
+		for i, image := range images {
+			if err := tw.WriteHeader(ovaFileHeader(disks[i].File, disks[i].Size)); err != nil {
+				log.Log.Reason(err).Error("error writing response body")
+				return
+			}
+			written, err := image.WriteTo(tw, disks[i].File)
+			n += written
+			if err != nil {
+				log.Log.Reason(err).Error("error writing response body")
+				return
+			}
+		}
+		if err := tw.Close(); err != nil {
+			log.Log.Reason(err).Error("error writing response body")
+		}
+		log.Log.Infof("Wrote %d bytes\n", n)
+	})
+}
+
+func ovaFileHeader(name string, size int64) *tar.Header {
+	return &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0644,
+		Size:     size,
+		Format:   tar.FormatUSTAR,
+	}
+}
+
+// openOVADisks opens the exported volumes backing the disks of the VM, the returned files have to be closed
+// by the caller even if an error is returned
+func openOVADisks(vm *virtv1.VirtualMachine, vi []export.VolumeInfo) ([]*os.File, []*streamOptimizedVMDK, []ovaDisk, error) {
+	var files []*os.File
+	var images []*streamOptimizedVMDK
+	var disks []ovaDisk
+	spec := vm.Spec.Template.Spec
+	for _, disk := range spec.Domain.Devices.Disks {
+		// CD-ROMs and LUNs are not converted
+		if disk.Disk == nil {
+			continue
+		}
+		info := getOVAVolumeInfo(spec.Volumes, disk.Name, vi)
+		if info == nil {
+			continue
+		}
+		fi, err := os.Stat(info.Path)
+		if err != nil {
+			return files, nil, nil, err
+		}
+		p := info.Path
+		if fi.IsDir() {
+			p = path.Join(p, "disk.img")
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return files, nil, nil, err
+		}
+		files = append(files, f)
+		image, err := newStreamOptimizedVMDK(f)
+		if err != nil {
+			return files, nil, nil, err
+		}
+		images = append(images, image)
+		disks = append(disks, ovaDisk{
+			Name:     disk.Name,
+			Bus:      disk.Disk.Bus,
+			File:     fmt.Sprintf("%s-%s.vmdk", vm.Name, disk.Name),
+			Size:     image.Size(),
+			Capacity: image.Capacity(),
+		})
+	}
+	return files, images, disks, nil
+}
+
+// getOVAVolumeInfo returns the exported volume backing the disk, volumes are mounted in the exporter pod
+// under the name of their PVC with dots replaced by dashes
+func getOVAVolumeInfo(volumes []virtv1.Volume, diskName string, vi []export.VolumeInfo) *export.VolumeInfo {
+	for _, volume := range volumes {
+		if volume.Name != diskName {
+			continue
+		}
+		claimName := ""
+		if volume.DataVolume != nil {
+			claimName = volume.DataVolume.Name
+		}
+		if volume.PersistentVolumeClaim != nil {
+			claimName = volume.PersistentVolumeClaim.ClaimName
+		}
+		if claimName == "" {
+			return nil
+		}
+		claimName = strings.ReplaceAll(claimName, ".", "-")
+		for i := range vi {
+			if filepath.Base(filepath.Clean(vi[i].Path)) == claimName {
+				return &vi[i]
+			}
+		}
+	}
+	return nil
+}
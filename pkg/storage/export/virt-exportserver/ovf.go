@@ -0,0 +1,343 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	k8sv1 "k8s.io/api/core/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	ovfNamespace    = "http://schemas.dmtf.org/ovf/envelope/1"
+	rasdNamespace   = "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ResourceAllocationSettingData"
+	vssdNamespace   = "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_VirtualSystemSettingData"
+	vmwareNamespace = "http://www.vmware.com/schema/ovf"
+
+	vmdkStreamOptimizedFormat = "http://www.vmware.com/interfaces/specifications/vmdk.html#streamOptimized"
+	ovfVirtualSystemType      = "vmx-13"
+
+	// Resource types of the CIM_ResourceAllocationSettingData schema
+	resourceTypeProcessor      = 3
+	resourceTypeMemory         = 4
+	resourceTypeSCSIController = 6
+	resourceTypeEthernet       = 10
+	resourceTypeDisk           = 17
+	resourceTypeSATAController = 20
+
+	scsiControllerInstanceID = 3
+	sataControllerInstanceID = 4
+	firstDeviceInstanceID    = 5
+)
+
+type ovfEnvelope struct {
+	XMLName        xml.Name          `xml:"Envelope"`
+	Xmlns          string            `xml:"xmlns,attr"`
+	XmlnsOvf       string            `xml:"xmlns:ovf,attr"`
+	XmlnsRasd      string            `xml:"xmlns:rasd,attr"`
+	XmlnsVssd      string            `xml:"xmlns:vssd,attr"`
+	XmlnsVmw       string            `xml:"xmlns:vmw,attr"`
+	References     []ovfFile         `xml:"References>File"`
+	DiskSection    ovfDiskSection    `xml:"DiskSection"`
+	NetworkSection ovfNetworkSection `xml:"NetworkSection"`
+	VirtualSystem  ovfVirtualSystem  `xml:"VirtualSystem"`
+}
+
+type ovfFile struct {
+	Href string `xml:"ovf:href,attr"`
+	ID   string `xml:"ovf:id,attr"`
+	Size int64  `xml:"ovf:size,attr"`
+}
+
+type ovfDiskSection struct {
+	Info  string    `xml:"Info"`
+	Disks []ovfDisk `xml:"Disk"`
+}
+
+type ovfDisk struct {
+	Capacity                int64  `xml:"ovf:capacity,attr"`
+	CapacityAllocationUnits string `xml:"ovf:capacityAllocationUnits,attr"`
+	DiskID                  string `xml:"ovf:diskId,attr"`
+	FileRef                 string `xml:"ovf:fileRef,attr"`
+	Format                  string `xml:"ovf:format,attr"`
+}
+
+type ovfNetworkSection struct {
+	Info     string       `xml:"Info"`
+	Networks []ovfNetwork `xml:"Network"`
+}
+
+type ovfNetwork struct {
+	Name        string `xml:"ovf:name,attr"`
+	Description string `xml:"Description"`
+}
+
+type ovfVirtualSystem struct {
+	ID                     string                    `xml:"ovf:id,attr"`
+	Info                   string                    `xml:"Info"`
+	Name                   string                    `xml:"Name"`
+	OperatingSystemSection ovfOperatingSystemSection `xml:"OperatingSystemSection"`
+	VirtualHardwareSection ovfVirtualHardwareSection `xml:"VirtualHardwareSection"`
+}
+
+type ovfOperatingSystemSection struct {
+	ID     int    `xml:"ovf:id,attr"`
+	OSType string `xml:"vmw:osType,attr"`
+	Info   string `xml:"Info"`
+}
+
+type ovfVirtualHardwareSection struct {
+	Info    string      `xml:"Info"`
+	System  ovfSystem   `xml:"System"`
+	Items   []ovfItem   `xml:"Item"`
+	Configs []ovfConfig `xml:"vmw:Config"`
+}
+
+type ovfSystem struct {
+	ElementName             string `xml:"vssd:ElementName"`
+	InstanceID              int    `xml:"vssd:InstanceID"`
+	VirtualSystemIdentifier string `xml:"vssd:VirtualSystemIdentifier"`
+	VirtualSystemType       string `xml:"vssd:VirtualSystemType"`
+}
+
+// ovfItem is a CIM_ResourceAllocationSettingData, its elements are ordered alphabetically as required by the schema
+type ovfItem struct {
+	Address             string `xml:"rasd:Address,omitempty"`
+	AddressOnParent     string `xml:"rasd:AddressOnParent,omitempty"`
+	AllocationUnits     string `xml:"rasd:AllocationUnits,omitempty"`
+	AutomaticAllocation *bool  `xml:"rasd:AutomaticAllocation,omitempty"`
+	Connection          string `xml:"rasd:Connection,omitempty"`
+	Description         string `xml:"rasd:Description,omitempty"`
+	ElementName         string `xml:"rasd:ElementName"`
+	HostResource        string `xml:"rasd:HostResource,omitempty"`
+	InstanceID          int    `xml:"rasd:InstanceID"`
+	Parent              int    `xml:"rasd:Parent,omitempty"`
+	ResourceSubType     string `xml:"rasd:ResourceSubType,omitempty"`
+	ResourceType        int    `xml:"rasd:ResourceType"`
+	VirtualQuantity     int64  `xml:"rasd:VirtualQuantity,omitempty"`
+}
+
+type ovfConfig struct {
+	Required bool   `xml:"ovf:required,attr"`
+	Key      string `xml:"vmw:key,attr"`
+	Value    string `xml:"vmw:value,attr"`
+}
+
+// ovaDisk is a disk of the VM packaged in an OVA
+type ovaDisk struct {
+	// Name is the name of the disk in the VM spec
+	Name string
+	Bus  virtv1.DiskBus
+	// File is the name of the VMDK image in the OVA
+	File string
+	// Size is the size of the VMDK image in bytes
+	Size int64
+	// Capacity is the capacity of the disk in bytes
+	Capacity int64
+}
+
+// newOVFEnvelope maps the VM spec to an OVF descriptor, the disks are attached in the order of the VM spec
+func newOVFEnvelope(vm *virtv1.VirtualMachine, disks []ovaDisk) *ovfEnvelope {
+	envelope := &ovfEnvelope{
+		Xmlns:     ovfNamespace,
+		XmlnsOvf:  ovfNamespace,
+		XmlnsRasd: rasdNamespace,
+		XmlnsVssd: vssdNamespace,
+		XmlnsVmw:  vmwareNamespace,
+		DiskSection: ovfDiskSection{
+			Info: "Virtual disk information",
+		},
+		NetworkSection: ovfNetworkSection{
+			Info: "The list of logical networks",
+		},
+		VirtualSystem: ovfVirtualSystem{
+			ID:   vm.Name,
+			Info: "A virtual machine",
+			Name: vm.Name,
+			OperatingSystemSection: ovfOperatingSystemSection{
+				ID:     1,
+				OSType: "otherGuest64",
+				Info:   "The kind of installed guest operating system",
+			},
+			VirtualHardwareSection: ovfVirtualHardwareSection{
+				Info: "Virtual hardware requirements",
+				System: ovfSystem{
+					ElementName:             "Virtual Hardware Family",
+					VirtualSystemIdentifier: vm.Name,
+					VirtualSystemType:       ovfVirtualSystemType,
+				},
+			},
+		},
+	}
+
+	var spec virtv1.VirtualMachineInstanceSpec
+	if vm.Spec.Template != nil {
+		spec = vm.Spec.Template.Spec
+	}
+	hardware := &envelope.VirtualSystem.VirtualHardwareSection
+	hardware.Items = append(hardware.Items,
+		ovfItem{
+			AllocationUnits: "hertz * 10^6",
+			Description:     "Number of Virtual CPUs",
+			ElementName:     fmt.Sprintf("%d virtual CPU(s)", vcpus(spec.Domain)),
+			InstanceID:      1,
+			ResourceType:    resourceTypeProcessor,
+			VirtualQuantity: vcpus(spec.Domain),
+		},
+		ovfItem{
+			AllocationUnits: "byte * 2^20",
+			Description:     "Memory Size",
+			ElementName:     fmt.Sprintf("%dMB of memory", memoryMiB(spec.Domain)),
+			InstanceID:      2,
+			ResourceType:    resourceTypeMemory,
+			VirtualQuantity: memoryMiB(spec.Domain),
+		},
+	)
+
+	var hasSCSI, hasSATA bool
+	for _, disk := range disks {
+		if disk.Bus == virtv1.DiskBusSATA {
+			hasSATA = true
+		} else {
+			hasSCSI = true
+		}
+	}
+	if hasSCSI {
+		hardware.Items = append(hardware.Items, ovfItem{
+			Address:         "0",
+			Description:     "SCSI Controller",
+			ElementName:     "SCSI Controller 0",
+			InstanceID:      scsiControllerInstanceID,
+			ResourceSubType: "lsilogic",
+			ResourceType:    resourceTypeSCSIController,
+		})
+	}
+	if hasSATA {
+		hardware.Items = append(hardware.Items, ovfItem{
+			Address:         "0",
+			Description:     "SATA Controller",
+			ElementName:     "SATA Controller 0",
+			InstanceID:      sataControllerInstanceID,
+			ResourceSubType: "vmware.sata.ahci",
+			ResourceType:    resourceTypeSATAController,
+		})
+	}
+
+	instanceID := firstDeviceInstanceID
+	var scsiDisks, sataDisks int
+	for i, disk := range disks {
+		fileID := fmt.Sprintf("file%d", i+1)
+		diskID := fmt.Sprintf("vmdisk%d", i+1)
+		envelope.References = append(envelope.References, ovfFile{
+			Href: disk.File,
+			ID:   fileID,
+			Size: disk.Size,
+		})
+		envelope.DiskSection.Disks = append(envelope.DiskSection.Disks, ovfDisk{
+			Capacity:                disk.Capacity,
+			CapacityAllocationUnits: "byte",
+			DiskID:                  diskID,
+			FileRef:                 fileID,
+			Format:                  vmdkStreamOptimizedFormat,
+		})
+
+		// VMware has no virtio disks, virtio and SCSI disks are attached to the LSI Logic controller
+		parent := scsiControllerInstanceID
+		unit := scsiDisks
+		if disk.Bus == virtv1.DiskBusSATA {
+			parent = sataControllerInstanceID
+			unit = sataDisks
+			sataDisks++
+		} else {
+			scsiDisks++
+		}
+		hardware.Items = append(hardware.Items, ovfItem{
+			AddressOnParent: fmt.Sprintf("%d", unit),
+			ElementName:     disk.Name,
+			HostResource:    "ovf:/disk/" + diskID,
+			InstanceID:      instanceID,
+			Parent:          parent,
+			ResourceType:    resourceTypeDisk,
+		})
+		instanceID++
+	}
+
+	for _, network := range spec.Networks {
+		envelope.NetworkSection.Networks = append(envelope.NetworkSection.Networks, ovfNetwork{
+			Name:        network.Name,
+			Description: fmt.Sprintf("The %s network", network.Name),
+		})
+	}
+	for _, iface := range spec.Domain.Devices.Interfaces {
+		automaticAllocation := true
+		hardware.Items = append(hardware.Items, ovfItem{
+			Address:             iface.MacAddress,
+			AutomaticAllocation: &automaticAllocation,
+			Connection:          iface.Name,
+			ElementName:         iface.Name,
+			InstanceID:          instanceID,
+			ResourceSubType:     ovfEthernetSubType(iface.Model),
+			ResourceType:        resourceTypeEthernet,
+		})
+		instanceID++
+	}
+
+	if spec.Domain.Firmware != nil && spec.Domain.Firmware.Bootloader != nil && spec.Domain.Firmware.Bootloader.EFI != nil {
+		hardware.Configs = append(hardware.Configs, ovfConfig{
+			Key:   "firmware",
+			Value: "efi",
+		})
+	}
+
+	return envelope
+}
+
+func vcpus(domain virtv1.DomainSpec) int64 {
+	if domain.CPU == nil {
+		return 1
+	}
+	cores, sockets, threads := max(domain.CPU.Cores, 1), max(domain.CPU.Sockets, 1), max(domain.CPU.Threads, 1)
+	return int64(cores * sockets * threads)
+}
+
+func memoryMiB(domain virtv1.DomainSpec) int64 {
+	const mib = 1024 * 1024
+	if domain.Memory != nil && domain.Memory.Guest != nil {
+		return (domain.Memory.Guest.Value() + mib - 1) / mib
+	}
+	if memory, ok := domain.Resources.Requests[k8sv1.ResourceMemory]; ok {
+		return (memory.Value() + mib - 1) / mib
+	}
+	return 0
+}
+
+func ovfEthernetSubType(model string) string {
+	switch model {
+	case "e1000":
+		return "E1000"
+	case "e1000e":
+		return "E1000e"
+	default:
+		return "VmxNet3"
+	}
+}
@@ -0,0 +1,395 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/adler32"
+	"io"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// The disks of an OVA are packaged as streamOptimized VMDK images, as described in
+// https://www.vmware.com/app/vmdk/?src=vmdk
+// The size of every entry of a tar archive has to be known before the entry is written, so the grains
+// are stored in uncompressed deflate blocks, and only the grains the filesystem holds data for are written.
+const (
+	sectorSize        = 512
+	grainSectors      = 128
+	grainSize         = grainSectors * sectorSize
+	gtEntries         = 512
+	gtSectors         = gtEntries * 4 / sectorSize
+	vmdkDescSectors   = 20
+	vmdkOverheadSects = grainSectors
+
+	vmdkMagic           = 0x564d444b
+	vmdkVersion         = 3
+	vmdkFlags           = 1 | 1<<16 | 1<<17 // valid new line detection, compressed grains and markers
+	vmdkGDAtEnd         = 0xffffffffffffffff
+	vmdkCompressDeflate = 1
+
+	markerEOS    = 0
+	markerGT     = 1
+	markerGD     = 2
+	markerFooter = 3
+
+	// maxStoredBlockSize is the maximum size of an uncompressed deflate block
+	maxStoredBlockSize = 0xffff
+)
+
+type vmdkHeader struct {
+	MagicNumber        uint32
+	Version            uint32
+	Flags              uint32
+	Capacity           uint64
+	GrainSize          uint64
+	DescriptorOffset   uint64
+	DescriptorSize     uint64
+	NumGTEsPerGT       uint32
+	RGDOffset          uint64
+	GDOffset           uint64
+	OverHead           uint64
+	UncleanShutdown    uint8
+	SingleEndLineChar  uint8
+	NonEndLineChar     uint8
+	DoubleEndLineChar1 uint8
+	DoubleEndLineChar2 uint8
+	CompressAlgorithm  uint16
+	Pad                [433]uint8
+}
+
+// streamOptimizedVMDK converts a raw disk image to a streamOptimized VMDK image
+type streamOptimizedVMDK struct {
+	file *os.File
+	// size is the size of the raw disk image in bytes
+	size int64
+	// allocated are the ranges of grains of the raw disk image which may contain data, in ascending order
+	allocated []grainRange
+}
+
+// grainRange is the range of grains [first, end)
+type grainRange struct {
+	first int64
+	end   int64
+}
+
+func newStreamOptimizedVMDK(file *os.File) (*streamOptimizedVMDK, error) {
+	// the size of block devices is not reported by stat
+	size, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	allocated, err := allocatedGrains(file, size)
+	if err != nil {
+		return nil, err
+	}
+	return &streamOptimizedVMDK{
+		file:      file,
+		size:      size,
+		allocated: allocated,
+	}, nil
+}
+
+// allocatedGrains finds the grains of the raw disk image holding data from the holes reported by the
+// filesystem, without reading the image. Every grain is considered allocated when the holes can't be found,
+// e.g. on block devices.
+func allocatedGrains(file *os.File, size int64) ([]grainRange, error) {
+	grains := (size + grainSize - 1) / grainSize
+	if grains == 0 {
+		return nil, nil
+	}
+
+	var ranges []grainRange
+	for offset := int64(0); offset < size; {
+		data, err := file.Seek(offset, unix.SEEK_DATA)
+		if errors.Is(err, unix.ENXIO) {
+			// there is no data after offset
+			break
+		}
+		if err != nil {
+			return []grainRange{{first: 0, end: grains}}, nil
+		}
+		hole, err := file.Seek(data, unix.SEEK_HOLE)
+		if err != nil {
+			return []grainRange{{first: 0, end: grains}}, nil
+		}
+
+		r := grainRange{first: data / grainSize, end: min((hole+grainSize-1)/grainSize, grains)}
+		if last := len(ranges) - 1; last >= 0 && ranges[last].end >= r.first {
+			ranges[last].end = max(ranges[last].end, r.end)
+		} else {
+			ranges = append(ranges, r)
+		}
+		offset = hole
+	}
+	return ranges, nil
+}
+
+// Capacity returns the capacity of the disk in bytes
+func (v *streamOptimizedVMDK) Capacity() int64 {
+	return v.capacitySectors() * sectorSize
+}
+
+func (v *streamOptimizedVMDK) capacitySectors() int64 {
+	return (v.size + sectorSize - 1) / sectorSize
+}
+
+func (v *streamOptimizedVMDK) numGrains() int64 {
+	return (v.size + grainSize - 1) / grainSize
+}
+
+func (v *streamOptimizedVMDK) numGTs() int64 {
+	return (v.numGrains() + gtEntries - 1) / gtEntries
+}
+
+func (v *streamOptimizedVMDK) gdSectors() int64 {
+	return (v.numGTs()*4 + sectorSize - 1) / sectorSize
+}
+
+// grainSectorsWithMarker is the number of sectors taken by a grain, its marker and the deflate framing
+func grainSectorsWithMarker() int64 {
+	return (12 + storedZlibSize(grainSize) + sectorSize - 1) / sectorSize
+}
+
+// Size returns the size of the VMDK image in bytes
+func (v *streamOptimizedVMDK) Size() int64 {
+	sectors := int64(vmdkOverheadSects)
+	for _, r := range v.allocated {
+		sectors += (r.end - r.first) * grainSectorsWithMarker()
+	}
+	sectors += v.numGTs() * (1 + gtSectors)
+	sectors += 1 + v.gdSectors()
+	// the footer and the end of stream marker
+	sectors += 1 + 1 + 1
+	return sectors * sectorSize
+}
+
+func (v *streamOptimizedVMDK) header(gdOffset uint64) vmdkHeader {
+	return vmdkHeader{
+		MagicNumber:        vmdkMagic,
+		Version:            vmdkVersion,
+		Flags:              vmdkFlags,
+		Capacity:           uint64(v.capacitySectors()),
+		GrainSize:          grainSectors,
+		DescriptorOffset:   1,
+		DescriptorSize:     vmdkDescSectors,
+		NumGTEsPerGT:       gtEntries,
+		GDOffset:           gdOffset,
+		OverHead:           vmdkOverheadSects,
+		SingleEndLineChar:  '\n',
+		NonEndLineChar:     ' ',
+		DoubleEndLineChar1: '\r',
+		DoubleEndLineChar2: '\n',
+		CompressAlgorithm:  vmdkCompressDeflate,
+	}
+}
+
+func (v *streamOptimizedVMDK) descriptor(name string) []byte {
+	// the geometry is only used by BIOS guests, it is reported as the one of an IDE disk
+	cylinders := v.capacitySectors() / (255 * 63)
+	if cylinders > 65535 {
+		cylinders = 65535
+	}
+	return []byte(fmt.Sprintf(`# Disk DescriptorFile
+version=1
+CID=fffffffe
+parentCID=ffffffff
+createType="streamOptimized"
+
+# Extent description
+RW %d SPARSE "%s"
+
+# The Disk Data Base
+#DDB
+
+ddb.virtualHWVersion = "4"
+ddb.geometry.cylinders = "%d"
+ddb.geometry.heads = "255"
+ddb.geometry.sectors = "63"
+ddb.adapterType = "lsilogic"
+`, v.capacitySectors(), name, cylinders))
+}
+
+// WriteTo writes the VMDK image named name to w
+func (v *streamOptimizedVMDK) WriteTo(w io.Writer, name string) (int64, error) {
+	sw := &sectorWriter{w: w}
+
+	var header bytes.Buffer
+	if err := binary.Write(&header, binary.LittleEndian, v.header(vmdkGDAtEnd)); err != nil {
+		return sw.written, err
+	}
+	descriptor := v.descriptor(name)
+	if len(descriptor) > vmdkDescSectors*sectorSize {
+		return sw.written, fmt.Errorf("the descriptor of %s is too large", name)
+	}
+	header.Write(descriptor)
+	if err := sw.writeSectors(header.Bytes(), vmdkOverheadSects); err != nil {
+		return sw.written, err
+	}
+
+	// the grains are read while they are written, the allocated ones being known in advance to
+	// report the size of the image
+	grain := make([]byte, grainSize)
+	allocated := v.allocated
+	gd := make([]uint32, v.numGTs())
+	for gtIndex := range gd {
+		gt := make([]uint32, gtEntries)
+		for entry := range gt {
+			grainIndex := int64(gtIndex*gtEntries + entry)
+			for len(allocated) > 0 && allocated[0].end <= grainIndex {
+				allocated = allocated[1:]
+			}
+			if len(allocated) == 0 || grainIndex < allocated[0].first {
+				continue
+			}
+			if err := v.readGrain(grainIndex, grain); err != nil {
+				return sw.written, err
+			}
+			gt[entry] = uint32(sw.sector())
+			if err := sw.writeGrain(uint64(grainIndex)*grainSectors, grain); err != nil {
+				return sw.written, err
+			}
+		}
+		if err := sw.writeMarker(gtSectors, markerGT); err != nil {
+			return sw.written, err
+		}
+		gd[gtIndex] = uint32(sw.sector())
+		if err := sw.writeTable(gt, gtSectors); err != nil {
+			return sw.written, err
+		}
+	}
+
+	if err := sw.writeMarker(uint64(v.gdSectors()), markerGD); err != nil {
+		return sw.written, err
+	}
+	gdOffset := uint64(sw.sector())
+	if err := sw.writeTable(gd, v.gdSectors()); err != nil {
+		return sw.written, err
+	}
+
+	if err := sw.writeMarker(1, markerFooter); err != nil {
+		return sw.written, err
+	}
+	var footer bytes.Buffer
+	if err := binary.Write(&footer, binary.LittleEndian, v.header(gdOffset)); err != nil {
+		return sw.written, err
+	}
+	if err := sw.writeSectors(footer.Bytes(), 1); err != nil {
+		return sw.written, err
+	}
+	if err := sw.writeMarker(0, markerEOS); err != nil {
+		return sw.written, err
+	}
+	return sw.written, nil
+}
+
+// readGrain reads a grain of the raw disk image, the end of the image is read as zeros
+func (v *streamOptimizedVMDK) readGrain(index int64, grain []byte) error {
+	n, err := v.file.ReadAt(grain, index*grainSize)
+	if err != nil && err != io.EOF {
+		return err
+	}
+	clear(grain[n:])
+	return nil
+}
+
+type sectorWriter struct {
+	w       io.Writer
+	written int64
+}
+
+func (s *sectorWriter) sector() int64 {
+	return s.written / sectorSize
+}
+
+// writeSectors writes data padded with zeros to the given number of sectors
+func (s *sectorWriter) writeSectors(data []byte, sectors int64) error {
+	padded := make([]byte, sectors*sectorSize)
+	copy(padded, data)
+	n, err := s.w.Write(padded)
+	s.written += int64(n)
+	return err
+}
+
+func (s *sectorWriter) writeMarker(value uint64, markerType uint32) error {
+	marker := make([]byte, sectorSize)
+	binary.LittleEndian.PutUint64(marker[0:], value)
+	binary.LittleEndian.PutUint32(marker[12:], markerType)
+	return s.writeSectors(marker, 1)
+}
+
+func (s *sectorWriter) writeTable(entries []uint32, sectors int64) error {
+	table := make([]byte, len(entries)*4)
+	for i, entry := range entries {
+		binary.LittleEndian.PutUint32(table[i*4:], entry)
+	}
+	return s.writeSectors(table, sectors)
+}
+
+// writeGrain writes a grain marker followed by the grain in a zlib stream of uncompressed deflate blocks
+func (s *sectorWriter) writeGrain(lba uint64, grain []byte) error {
+	var buf bytes.Buffer
+	marker := make([]byte, 12)
+	binary.LittleEndian.PutUint64(marker[0:], lba)
+	binary.LittleEndian.PutUint32(marker[8:], uint32(storedZlibSize(len(grain))))
+	buf.Write(marker)
+	writeStoredZlib(&buf, grain)
+	return s.writeSectors(buf.Bytes(), grainSectorsWithMarker())
+}
+
+// storedZlibSize returns the size of a zlib stream holding data of the given size in uncompressed deflate blocks
+func storedZlibSize(size int) int64 {
+	blocks := (size + maxStoredBlockSize - 1) / maxStoredBlockSize
+	if blocks == 0 {
+		blocks = 1
+	}
+	// the zlib header, the header of every block and the adler32 checksum
+	return int64(2 + blocks*5 + size + 4)
+}
+
+func writeStoredZlib(buf *bytes.Buffer, data []byte) {
+	// deflate without a preset dictionary and a 32K window, with the fastest compression level
+	buf.Write([]byte{0x78, 0x01})
+	for offset := 0; offset < len(data) || offset == 0; offset += maxStoredBlockSize {
+		end := min(offset+maxStoredBlockSize, len(data))
+		final := byte(0)
+		if end == len(data) {
+			final = 1
+		}
+		blockLen := uint16(end - offset)
+		buf.WriteByte(final)
+		buf.Write(binary.LittleEndian.AppendUint16(nil, blockLen))
+		buf.Write(binary.LittleEndian.AppendUint16(nil, ^blockLen))
+		buf.Write(data[offset:end])
+		if end == len(data) {
+			break
+		}
+	}
+	buf.Write(binary.BigEndian.AppendUint32(nil, adler32.Checksum(data)))
+}
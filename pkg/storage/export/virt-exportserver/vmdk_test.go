@@ -0,0 +1,166 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/sys/unix"
+)
+
+// readStreamOptimizedVMDK follows the grain directory of the footer and returns the raw disk image
+func readStreamOptimizedVMDK(data []byte) []byte {
+	readHeader := func(offset int) vmdkHeader {
+		var header vmdkHeader
+		Expect(binary.Read(bytes.NewReader(data[offset:offset+sectorSize]), binary.LittleEndian, &header)).To(Succeed())
+		Expect(header.MagicNumber).To(BeEquivalentTo(vmdkMagic))
+		return header
+	}
+	Expect(len(data) % sectorSize).To(BeZero())
+	header := readHeader(0)
+	Expect(header.GDOffset).To(BeEquivalentTo(uint64(vmdkGDAtEnd)))
+
+	// the image ends with the footer marker, the footer and the end of stream marker
+	eos := len(data) - sectorSize
+	Expect(data[eos:]).To(Equal(make([]byte, sectorSize)))
+	Expect(binary.LittleEndian.Uint32(data[eos-2*sectorSize+12:])).To(BeEquivalentTo(markerFooter))
+	footer := readHeader(eos - sectorSize)
+	Expect(footer.Capacity).To(Equal(header.Capacity))
+
+	raw := make([]byte, footer.Capacity*sectorSize)
+	grains := (footer.Capacity + grainSectors - 1) / grainSectors
+	numGTs := (grains + gtEntries - 1) / gtEntries
+	for i := uint64(0); i < numGTs; i++ {
+		gtOffset := binary.LittleEndian.Uint32(data[footer.GDOffset*sectorSize+i*4:])
+		for j := uint64(0); j < gtEntries; j++ {
+			grainOffset := binary.LittleEndian.Uint32(data[uint64(gtOffset)*sectorSize+j*4:])
+			if grainOffset == 0 {
+				continue
+			}
+			marker := data[uint64(grainOffset)*sectorSize:]
+			lba := binary.LittleEndian.Uint64(marker)
+			Expect(lba).To(Equal((i*gtEntries + j) * grainSectors))
+			size := binary.LittleEndian.Uint32(marker[8:])
+			reader, err := zlib.NewReader(bytes.NewReader(marker[12 : 12+size]))
+			Expect(err).ToNot(HaveOccurred())
+			grain, err := io.ReadAll(reader)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(grain).To(HaveLen(grainSize))
+			copy(raw[lba*sectorSize:], grain)
+		}
+	}
+	return raw
+}
+
+var _ = Describe("streamOptimized VMDK", func() {
+	newRawImage := func(size int64, data map[int64][]byte) *os.File {
+		f, err := os.Create(filepath.Join(GinkgoT().TempDir(), "disk.img"))
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(f.Close)
+		Expect(f.Truncate(size)).To(Succeed())
+		for offset, content := range data {
+			_, err := f.WriteAt(content, offset)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		return f
+	}
+
+	It("should only write the grains containing data", func() {
+		data := map[int64][]byte{
+			0:                 []byte("boot sector"),
+			3*grainSize + 100: bytes.Repeat([]byte{0xaa}, grainSize),
+		}
+		f := newRawImage(10*grainSize+sectorSize, data)
+		if hole, err := f.Seek(0, unix.SEEK_HOLE); err != nil || hole >= 10*grainSize {
+			Skip("the filesystem of the temporary directory does not report holes")
+		}
+		image, err := newStreamOptimizedVMDK(f)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(image.Capacity()).To(BeEquivalentTo(10*grainSize + sectorSize))
+
+		var out bytes.Buffer
+		n, err := image.WriteTo(&out, "disk.vmdk")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(BeEquivalentTo(out.Len()))
+		Expect(image.Size()).To(BeEquivalentTo(out.Len()))
+		// grains 0, 3 and 4 hold data
+		Expect(out.Len()).To(Equal((vmdkOverheadSects + 3*int(grainSectorsWithMarker()) + 1 + gtSectors + 1 + 1 + 3) * sectorSize))
+		Expect(out.String()).To(ContainSubstring(`createType="streamOptimized"`))
+
+		raw, err := io.ReadAll(io.NewSectionReader(f, 0, image.Capacity()))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(readStreamOptimizedVMDK(out.Bytes())).To(Equal(raw))
+	})
+
+	It("should write several grain tables", func() {
+		f := newRawImage((gtEntries+1)*grainSize, map[int64][]byte{
+			gtEntries * grainSize: []byte("last grain"),
+		})
+		image, err := newStreamOptimizedVMDK(f)
+		Expect(err).ToNot(HaveOccurred())
+
+		var out bytes.Buffer
+		_, err = image.WriteTo(&out, "disk.vmdk")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(image.Size()).To(BeEquivalentTo(out.Len()))
+
+		raw, err := io.ReadAll(io.NewSectionReader(f, 0, image.Capacity()))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(readStreamOptimizedVMDK(out.Bytes())).To(Equal(raw))
+	})
+
+	It("should write every grain when the holes are unknown", func() {
+		f := newRawImage(3*grainSize, map[int64][]byte{
+			grainSize: []byte("second grain"),
+		})
+		image, err := newStreamOptimizedVMDK(f)
+		Expect(err).ToNot(HaveOccurred())
+		// what a block device reports
+		image.allocated = []grainRange{{first: 0, end: 3}}
+
+		var out bytes.Buffer
+		_, err = image.WriteTo(&out, "disk.vmdk")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(image.Size()).To(BeEquivalentTo(out.Len()))
+		Expect(out.Len()).To(Equal((vmdkOverheadSects + 3*int(grainSectorsWithMarker()) + 1 + gtSectors + 1 + 1 + 3) * sectorSize))
+
+		raw, err := io.ReadAll(io.NewSectionReader(f, 0, image.Capacity()))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(readStreamOptimizedVMDK(out.Bytes())).To(Equal(raw))
+	})
+
+	It("should convert an empty image", func() {
+		image, err := newStreamOptimizedVMDK(newRawImage(0, nil))
+		Expect(err).ToNot(HaveOccurred())
+
+		var out bytes.Buffer
+		_, err = image.WriteTo(&out, "disk.vmdk")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(image.Size()).To(BeEquivalentTo(out.Len()))
+		Expect(readStreamOptimizedVMDK(out.Bytes())).To(BeEmpty())
+	})
+})
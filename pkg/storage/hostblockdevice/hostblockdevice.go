@@ -0,0 +1,50 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package hostblockdevice implements the volumes passing a raw block device of the node
+// straight through to a VMI.
+package hostblockdevice
+
+import (
+	"path/filepath"
+	"slices"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// GetMountedDevicePath returns the path the block device of the volume is mounted at in the virt-launcher pod.
+// It matches the path of block PVCs, so that the device is handled like any other block volume.
+func GetMountedDevicePath(volumeName string) string {
+	return filepath.Join(string(filepath.Separator), "dev", volumeName)
+}
+
+// IsPermitted returns true if the path is in the allowlist of the hostBlockDevices configuration.
+func IsPermitted(path string, config *v1.HostBlockDevicesConfiguration) bool {
+	return config != nil && slices.Contains(config.PermittedPaths, path)
+}
+
+// HasHostBlockDeviceVolume returns true if any of the volumes is a host block device.
+func HasHostBlockDeviceVolume(volumes []v1.Volume) bool {
+	for _, volume := range volumes {
+		if volume.HostBlockDevice != nil {
+			return true
+		}
+	}
+	return false
+}
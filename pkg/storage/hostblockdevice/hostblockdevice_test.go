@@ -0,0 +1,54 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hostblockdevice
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("host block device volumes", func() {
+	It("should mount the device of each volume like a block PVC", func() {
+		Expect(GetMountedDevicePath("disk0")).To(Equal("/dev/disk0"))
+	})
+
+	DescribeTable("should permit", func(path string, config *v1.HostBlockDevicesConfiguration, expected bool) {
+		Expect(IsPermitted(path, config)).To(Equal(expected))
+	},
+		Entry("no path without configuration", "/dev/nvme1n1", nil, false),
+		Entry("a path in the allowlist", "/dev/nvme1n1",
+			&v1.HostBlockDevicesConfiguration{PermittedPaths: []string{"/dev/nvme0n1", "/dev/nvme1n1"}}, true),
+		Entry("no path missing from the allowlist", "/dev/nvme2n1",
+			&v1.HostBlockDevicesConfiguration{PermittedPaths: []string{"/dev/nvme0n1", "/dev/nvme1n1"}}, false),
+	)
+
+	DescribeTable("should detect host block device volumes", func(volumes []v1.Volume, expected bool) {
+		Expect(HasHostBlockDeviceVolume(volumes)).To(Equal(expected))
+	},
+		Entry("without volumes", nil, false),
+		Entry("with other volumes", []v1.Volume{{Name: "disk0", VolumeSource: v1.VolumeSource{EmptyDisk: &v1.EmptyDiskSource{}}}}, false),
+		Entry("with a host block device volume", []v1.Volume{
+			{Name: "disk0", VolumeSource: v1.VolumeSource{EmptyDisk: &v1.EmptyDiskSource{}}},
+			{Name: "disk1", VolumeSource: v1.VolumeSource{HostBlockDevice: &v1.HostBlockDeviceVolumeSource{Path: "/dev/nvme1n1"}}},
+		}, true),
+	)
+})
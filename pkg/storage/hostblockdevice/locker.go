@@ -0,0 +1,235 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hostblockdevice
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+	"k8s.io/apimachinery/pkg/types"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/checkpoint"
+	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+// blockDeviceNumber returns the device number of the block device.
+var blockDeviceNumber = func(device *safepath.Path) (uint64, error) {
+	fileInfo, err := safepath.StatAtNoFollow(device)
+	if err != nil {
+		return 0, err
+	}
+	if fileInfo.Mode()&os.ModeDevice == 0 || fileInfo.Mode()&os.ModeCharDevice != 0 {
+		return 0, fmt.Errorf("%s is not a block device", device.String())
+	}
+	return uint64(fileInfo.Sys().(*syscall.Stat_t).Rdev), nil //nolint:unconvert // Rdev is uint32 on e.g. MIPS.
+}
+
+// openExclusive opens the block device with O_EXCL. The kernel refuses the exclusive open of a
+// block device which is mounted, holds a mounted partition or is already claimed by someone else.
+var openExclusive = func(device *safepath.Path) (*os.File, error) {
+	fd, err := safepath.OpenAtNoFollow(device)
+	if err != nil {
+		return nil, err
+	}
+	defer util.CloseIOAndCheckErr(fd, nil)
+
+	file, err := os.OpenFile(fd.SafePath(), os.O_RDONLY|syscall.O_EXCL, 0)
+	if errors.Is(err, syscall.EBUSY) {
+		return nil, fmt.Errorf("block device %s is in use on the node", device.String())
+	}
+	return file, err
+}
+
+// fileOwner returns the owner of the open block device.
+var fileOwner = func(file *os.File) (int, int, error) {
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return 0, 0, err
+	}
+	stat := fileInfo.Sys().(*syscall.Stat_t)
+	return int(stat.Uid), int(stat.Gid), nil
+}
+
+// chownFile changes the owner of the open block device.
+var chownFile = func(file *os.File, uid, gid int) error {
+	return file.Chown(uid, gid)
+}
+
+// chownHostDevice changes the owner of a block device the locker does not hold open anymore,
+// through its path on the node.
+var chownHostDevice = func(hostPath string, rdev uint64, uid, gid int) error {
+	device, err := safepath.JoinAndResolveWithRelativeRoot(util.HostRootMount, hostPath)
+	if err != nil {
+		return err
+	}
+	if current, err := blockDeviceNumber(device); err != nil {
+		return err
+	} else if current != rdev {
+		return fmt.Errorf("%s is not the block device %d:%d anymore", hostPath, unix.Major(rdev), unix.Minor(rdev))
+	}
+	return safepath.ChownAtNoFollow(device, uid, gid)
+}
+
+type lockedDevice struct {
+	vmiUID types.UID
+	file   *os.File
+}
+
+// deviceOwner is the owner a block device had before it was claimed for a VMI.
+type deviceOwner struct {
+	Rdev     uint64 `json:"rdev"`
+	HostPath string `json:"hostPath"`
+	UID      int    `json:"uid"`
+	GID      int    `json:"gid"`
+}
+
+// vmiDeviceOwners is checkpointed per VMI, so that the owners survive a restart of virt-handler.
+type vmiDeviceOwners struct {
+	Devices []deviceOwner `json:"devices"`
+}
+
+// Locker holds the block devices of host block device volumes open exclusively on behalf of the VMIs,
+// so that a device is neither mounted on the node nor passed to another VMI while the VMI runs.
+// It remembers the owner of each device, which is handed to the unprivileged launcher, and restores
+// it when the VMI releases the device.
+type Locker struct {
+	lock    sync.Mutex
+	devices map[uint64]*lockedDevice
+	owners  checkpoint.CheckpointManager
+}
+
+func NewLocker(ownerStateDir string) *Locker {
+	return &Locker{
+		devices: make(map[uint64]*lockedDevice),
+		owners:  checkpoint.NewSimpleCheckpointManager(ownerStateDir),
+	}
+}
+
+// Lock claims the block device for the VMI. Claiming a device the VMI already holds is a no-op.
+// The hostPath is the path of the device on the node, used to restore its owner if the device
+// is not held open anymore when the VMI releases it.
+func (l *Locker) Lock(vmiUID types.UID, device *safepath.Path, hostPath string) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	rdev, err := blockDeviceNumber(device)
+	if err != nil {
+		return err
+	}
+	if owner, exists := l.devices[rdev]; exists {
+		if owner.vmiUID == vmiUID {
+			return nil
+		}
+		return fmt.Errorf("block device %s is already used by the vmi with uid %s", device.String(), owner.vmiUID)
+	}
+
+	file, err := openExclusive(device)
+	if err != nil {
+		return err
+	}
+	if err := l.recordOwner(vmiUID, rdev, hostPath, file); err != nil {
+		util.CloseIOAndCheckErr(file, nil)
+		return fmt.Errorf("failed to record the owner of block device %s: %v", device.String(), err)
+	}
+	l.devices[rdev] = &lockedDevice{vmiUID: vmiUID, file: file}
+	log.Log.Infof("Locked block device %s for the vmi with uid %s", device.String(), vmiUID)
+	return nil
+}
+
+// recordOwner checkpoints the owner of the device the first time the VMI claims it. A device claimed
+// again after a restart of virt-handler already belongs to the launcher, its recorded owner is kept.
+func (l *Locker) recordOwner(vmiUID types.UID, rdev uint64, hostPath string, file *os.File) error {
+	owners, err := l.getOwners(vmiUID)
+	if err != nil {
+		return err
+	}
+	for _, owner := range owners.Devices {
+		if owner.Rdev == rdev {
+			return nil
+		}
+	}
+
+	uid, gid, err := fileOwner(file)
+	if err != nil {
+		return err
+	}
+	owners.Devices = append(owners.Devices, deviceOwner{Rdev: rdev, HostPath: hostPath, UID: uid, GID: gid})
+	return l.owners.Store(string(vmiUID), owners)
+}
+
+func (l *Locker) getOwners(vmiUID types.UID) (*vmiDeviceOwners, error) {
+	owners := &vmiDeviceOwners{}
+	if err := l.owners.Get(string(vmiUID), owners); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	return owners, nil
+}
+
+// Release restores the owner of the block devices held by the VMI and closes them.
+func (l *Locker) Release(vmiUID types.UID) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	owners, err := l.getOwners(vmiUID)
+	if err != nil {
+		return fmt.Errorf("failed to get the owners of the block devices of the vmi with uid %s: %v", vmiUID, err)
+	}
+
+	restored := make(map[uint64]bool)
+	for rdev, locked := range l.devices {
+		if locked.vmiUID != vmiUID {
+			continue
+		}
+		for _, owner := range owners.Devices {
+			if owner.Rdev != rdev {
+				continue
+			}
+			if err := chownFile(locked.file, owner.UID, owner.GID); err != nil {
+				log.Log.Reason(err).Errorf("Failed to restore the owner of block device %d:%d", unix.Major(rdev), unix.Minor(rdev))
+			}
+			restored[rdev] = true
+		}
+		util.CloseIOAndCheckErr(locked.file, nil)
+		delete(l.devices, rdev)
+		log.Log.Infof("Released block device %d:%d of the vmi with uid %s", unix.Major(rdev), unix.Minor(rdev), vmiUID)
+	}
+
+	// The devices are not held open anymore if virt-handler restarted after the VMI went away
+	for _, owner := range owners.Devices {
+		if restored[owner.Rdev] {
+			continue
+		}
+		if err := chownHostDevice(owner.HostPath, owner.Rdev, owner.UID, owner.GID); err != nil {
+			log.Log.Reason(err).Errorf("Failed to restore the owner of block device %s", owner.HostPath)
+		}
+	}
+
+	if err := l.owners.Delete(string(vmiUID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete the owners of the block devices of the vmi with uid %s: %v", vmiUID, err)
+	}
+	return nil
+}
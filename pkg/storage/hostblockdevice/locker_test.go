@@ -0,0 +1,165 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hostblockdevice
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"kubevirt.io/kubevirt/pkg/safepath"
+)
+
+var _ = Describe("Locker", func() {
+	const (
+		vmiUID      = types.UID("vmi-uid")
+		otherVMIUID = types.UID("other-vmi-uid")
+	)
+
+	type owner struct{ uid, gid int }
+
+	var (
+		locker        *Locker
+		ownerStateDir string
+		devices       map[string]uint64
+		owners        map[string]owner
+		fileDevices   map[*os.File]string
+		openedFiles   []*os.File
+		nvme0, nvme1  *safepath.Path
+	)
+
+	newDevice := func(name string, rdev uint64) *safepath.Path {
+		path := filepath.Join(GinkgoT().TempDir(), name)
+		Expect(os.WriteFile(path, nil, 0600)).To(Succeed())
+		devicePath, err := safepath.JoinAndResolveWithRelativeRoot("/", path)
+		Expect(err).ToNot(HaveOccurred())
+		devices[devicePath.String()] = rdev
+		return devicePath
+	}
+
+	BeforeEach(func() {
+		ownerStateDir = GinkgoT().TempDir()
+		locker = NewLocker(ownerStateDir)
+		devices = make(map[string]uint64)
+		owners = make(map[string]owner)
+		fileDevices = make(map[*os.File]string)
+		openedFiles = nil
+
+		origBlockDeviceNumber, origOpenExclusive := blockDeviceNumber, openExclusive
+		origFileOwner, origChownFile, origChownHostDevice := fileOwner, chownFile, chownHostDevice
+		DeferCleanup(func() {
+			blockDeviceNumber, openExclusive = origBlockDeviceNumber, origOpenExclusive
+			fileOwner, chownFile, chownHostDevice = origFileOwner, origChownFile, origChownHostDevice
+		})
+		blockDeviceNumber = func(device *safepath.Path) (uint64, error) {
+			return devices[device.String()], nil
+		}
+		openExclusive = func(device *safepath.Path) (*os.File, error) {
+			fd, err := safepath.OpenAtNoFollow(device)
+			Expect(err).ToNot(HaveOccurred())
+			defer fd.Close()
+			file, err := os.Open(fd.SafePath())
+			openedFiles = append(openedFiles, file)
+			fileDevices[file] = device.String()
+			return file, err
+		}
+		fileOwner = func(file *os.File) (int, int, error) {
+			o := owners[fileDevices[file]]
+			return o.uid, o.gid, nil
+		}
+		chownFile = func(file *os.File, uid, gid int) error {
+			owners[fileDevices[file]] = owner{uid, gid}
+			return nil
+		}
+		chownHostDevice = func(hostPath string, _ uint64, uid, gid int) error {
+			owners[hostPath] = owner{uid, gid}
+			return nil
+		}
+
+		nvme0 = newDevice("nvme0n1", 1)
+		nvme1 = newDevice("nvme1n1", 2)
+	})
+
+	It("should lock the devices of a VMI only once", func() {
+		Expect(locker.Lock(vmiUID, nvme0, "/dev/nvme0n1")).To(Succeed())
+		Expect(locker.Lock(vmiUID, nvme1, "/dev/nvme1n1")).To(Succeed())
+		Expect(locker.Lock(vmiUID, nvme0, "/dev/nvme0n1")).To(Succeed())
+		Expect(openedFiles).To(HaveLen(2))
+	})
+
+	It("should refuse a device locked by another VMI", func() {
+		Expect(locker.Lock(vmiUID, nvme0, "/dev/nvme0n1")).To(Succeed())
+		Expect(locker.Lock(otherVMIUID, nvme0, "/dev/nvme0n1")).To(MatchError(ContainSubstring("is already used by the vmi with uid vmi-uid")))
+		Expect(locker.Lock(otherVMIUID, nvme1, "/dev/nvme1n1")).To(Succeed())
+	})
+
+	It("should refuse a device reached through another path", func() {
+		Expect(locker.Lock(vmiUID, nvme0, "/dev/nvme0n1")).To(Succeed())
+		Expect(locker.Lock(otherVMIUID, newDevice("nvme-eui.0025388b91b2d3a1", 1), "/dev/disk/by-id/nvme-eui.0025388b91b2d3a1")).To(
+			MatchError(ContainSubstring("is already used by the vmi with uid vmi-uid")))
+	})
+
+	It("should close the devices of a VMI on release", func() {
+		Expect(locker.Lock(vmiUID, nvme0, "/dev/nvme0n1")).To(Succeed())
+		Expect(locker.Lock(otherVMIUID, nvme1, "/dev/nvme1n1")).To(Succeed())
+
+		Expect(locker.Release(vmiUID)).To(Succeed())
+		_, err := openedFiles[0].Stat()
+		Expect(err).To(MatchError(os.ErrClosed))
+		_, err = openedFiles[1].Stat()
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(locker.Lock(otherVMIUID, nvme0, "/dev/nvme0n1")).To(Succeed())
+	})
+
+	It("should restore the owner of the devices on release", func() {
+		owners[nvme0.String()] = owner{0, 6}
+		Expect(locker.Lock(vmiUID, nvme0, "/dev/nvme0n1")).To(Succeed())
+		owners[nvme0.String()] = owner{107, 107}
+
+		Expect(locker.Release(vmiUID)).To(Succeed())
+		Expect(owners).To(HaveKeyWithValue(nvme0.String(), owner{0, 6}))
+		Expect(filepath.Join(ownerStateDir, string(vmiUID))).ToNot(BeAnExistingFile())
+	})
+
+	It("should keep the owner recorded before a restart of virt-handler", func() {
+		owners[nvme0.String()] = owner{0, 6}
+		Expect(locker.Lock(vmiUID, nvme0, "/dev/nvme0n1")).To(Succeed())
+		owners[nvme0.String()] = owner{107, 107}
+
+		locker = NewLocker(ownerStateDir)
+		Expect(locker.Lock(vmiUID, nvme0, "/dev/nvme0n1")).To(Succeed())
+		Expect(locker.Release(vmiUID)).To(Succeed())
+		Expect(owners).To(HaveKeyWithValue(nvme0.String(), owner{0, 6}))
+	})
+
+	It("should restore the owner through the node path of a device released after a restart of virt-handler", func() {
+		owners[nvme0.String()] = owner{0, 6}
+		Expect(locker.Lock(vmiUID, nvme0, "/dev/nvme0n1")).To(Succeed())
+
+		locker = NewLocker(ownerStateDir)
+		Expect(locker.Release(vmiUID)).To(Succeed())
+		Expect(owners).To(HaveKeyWithValue("/dev/nvme0n1", owner{0, 6}))
+	})
+})
@@ -0,0 +1,218 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package memorydump
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// An encrypted memory dump starts with the magic, followed by the length of the wrapped key and the
+// AES-256 key wrapped with RSA-OAEP. The memory follows in chunks sealed with AES-GCM, each prefixed by
+// its sealed length. The chunk index is part of the nonce and the last chunk is flagged in it, so
+// reordered or truncated dumps fail to decrypt.
+const (
+	encryptedDumpMagic     = "KVMDENC1"
+	encryptedDumpChunkSize = 64 * 1024
+	encryptedDumpKeySize   = 32
+
+	minEncryptionKeyBits = 2048
+)
+
+// ParseEncryptionPublicKey parses a PEM encoded RSA public key, either PKIX or PKCS #1.
+func ParseEncryptionPublicKey(pemKey string) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(pemKey))
+	if block == nil {
+		return nil, fmt.Errorf("the encryption public key is not PEM encoded")
+	}
+
+	var publicKey *rsa.PublicKey
+	if key, err := x509.ParsePKIXPublicKey(block.Bytes); err == nil {
+		rsaKey, isRSA := key.(*rsa.PublicKey)
+		if !isRSA {
+			return nil, fmt.Errorf("the encryption public key must be an RSA key")
+		}
+		publicKey = rsaKey
+	} else if rsaKey, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		publicKey = rsaKey
+	} else {
+		return nil, fmt.Errorf("failed to parse the encryption public key: %v", err)
+	}
+
+	if publicKey.N.BitLen() < minEncryptionKeyBits {
+		return nil, fmt.Errorf("the encryption public key must be at least %d bits long", minEncryptionKeyBits)
+	}
+	return publicKey, nil
+}
+
+// ParseDecryptionPrivateKey parses a PEM encoded RSA private key, either PKCS #8 or PKCS #1.
+func ParseDecryptionPrivateKey(pemKey string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(pemKey))
+	if block == nil {
+		return nil, fmt.Errorf("the decryption private key is not PEM encoded")
+	}
+
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		rsaKey, isRSA := key.(*rsa.PrivateKey)
+		if !isRSA {
+			return nil, fmt.Errorf("the decryption private key must be an RSA key")
+		}
+		return rsaKey, nil
+	}
+	rsaKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the decryption private key: %v", err)
+	}
+	return rsaKey, nil
+}
+
+// EncryptMemoryDump encrypts the memory dump read from src with a random key wrapped by publicKey and writes it to dst.
+func EncryptMemoryDump(dst io.Writer, src io.Reader, publicKey *rsa.PublicKey) error {
+	key := make([]byte, encryptedDumpKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return err
+	}
+	wrappedKey, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, publicKey, key, nil)
+	if err != nil {
+		return err
+	}
+	aead, err := newDumpAEAD(key)
+	if err != nil {
+		return err
+	}
+
+	header := bytes.NewBufferString(encryptedDumpMagic)
+	if err := binary.Write(header, binary.BigEndian, uint16(len(wrappedKey))); err != nil {
+		return err
+	}
+	header.Write(wrappedKey)
+	if _, err := dst.Write(header.Bytes()); err != nil {
+		return err
+	}
+
+	reader := bufio.NewReaderSize(src, encryptedDumpChunkSize)
+	chunk := make([]byte, encryptedDumpChunkSize)
+	for index := uint64(0); ; index++ {
+		n, err := io.ReadFull(reader, chunk)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return err
+		}
+		_, err = reader.Peek(1)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		last := err == io.EOF
+
+		sealed := aead.Seal(nil, dumpChunkNonce(index, last), chunk[:n], nil)
+		if err := binary.Write(dst, binary.BigEndian, uint32(len(sealed))); err != nil {
+			return err
+		}
+		if _, err := dst.Write(sealed); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+// DecryptMemoryDump decrypts a memory dump encrypted by EncryptMemoryDump with the private key matching its public key.
+func DecryptMemoryDump(dst io.Writer, src io.Reader, privateKey *rsa.PrivateKey) error {
+	magic := make([]byte, len(encryptedDumpMagic))
+	if _, err := io.ReadFull(src, magic); err != nil || string(magic) != encryptedDumpMagic {
+		return fmt.Errorf("not an encrypted memory dump")
+	}
+	var wrappedKeyLen uint16
+	if err := binary.Read(src, binary.BigEndian, &wrappedKeyLen); err != nil {
+		return err
+	}
+	wrappedKey := make([]byte, wrappedKeyLen)
+	if _, err := io.ReadFull(src, wrappedKey); err != nil {
+		return err
+	}
+	key, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, privateKey, wrappedKey, nil)
+	if err != nil {
+		return fmt.Errorf("failed to unwrap the memory dump key: %v", err)
+	}
+	aead, err := newDumpAEAD(key)
+	if err != nil {
+		return err
+	}
+
+	for index := uint64(0); ; index++ {
+		var sealedLen uint32
+		if err := binary.Read(src, binary.BigEndian, &sealedLen); err != nil {
+			if err == io.EOF {
+				return errors.New("the encrypted memory dump is truncated")
+			}
+			return err
+		}
+		if sealedLen > encryptedDumpChunkSize+uint32(aead.Overhead()) {
+			return fmt.Errorf("invalid chunk size %d in the encrypted memory dump", sealedLen)
+		}
+		sealed := make([]byte, sealedLen)
+		if _, err := io.ReadFull(src, sealed); err != nil {
+			return err
+		}
+
+		last := false
+		chunk, err := aead.Open(nil, dumpChunkNonce(index, false), sealed, nil)
+		if err != nil {
+			last = true
+			if chunk, err = aead.Open(nil, dumpChunkNonce(index, true), sealed, nil); err != nil {
+				return fmt.Errorf("failed to decrypt the memory dump: %v", err)
+			}
+		}
+		if _, err := dst.Write(chunk); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+func newDumpAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func dumpChunkNonce(index uint64, last bool) []byte {
+	nonce := make([]byte, 12)
+	if last {
+		nonce[0] = 1
+	}
+	binary.BigEndian.PutUint64(nonce[4:], index)
+	return nonce
+}
@@ -0,0 +1,163 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package memorydump
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MemoryDump encryption", Ordered, func() {
+	var privateKey *rsa.PrivateKey
+
+	encodeKey := func(blockType string, der []byte) string {
+		return string(pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}))
+	}
+
+	newDump := func(size int) []byte {
+		dump := make([]byte, size)
+		for i := range dump {
+			dump[i] = byte(i % 251)
+		}
+		return dump
+	}
+
+	encrypt := func(dump []byte) []byte {
+		encrypted := &bytes.Buffer{}
+		Expect(EncryptMemoryDump(encrypted, bytes.NewReader(dump), &privateKey.PublicKey)).To(Succeed())
+		return encrypted.Bytes()
+	}
+
+	BeforeAll(func() {
+		var err error
+		privateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	DescribeTable("should decrypt the encrypted memory dump", func(size int) {
+		dump := newDump(size)
+
+		decrypted := &bytes.Buffer{}
+		Expect(DecryptMemoryDump(decrypted, bytes.NewReader(encrypt(dump)), privateKey)).To(Succeed())
+		Expect(decrypted.Bytes()).To(Equal(dump))
+	},
+		Entry("when empty", 0),
+		Entry("when smaller than a chunk", 1000),
+		Entry("when the size of a chunk", encryptedDumpChunkSize),
+		Entry("when spanning several chunks", 3*encryptedDumpChunkSize+5),
+	)
+
+	It("should not store the memory in plaintext", func() {
+		dump := bytes.Repeat([]byte("secret-password"), 1000)
+		Expect(bytes.Contains(encrypt(dump), []byte("secret-password"))).To(BeFalse())
+	})
+
+	It("should fail to decrypt a truncated memory dump", func() {
+		encrypted := encrypt(newDump(2 * encryptedDumpChunkSize))
+		lastChunkLen := encryptedDumpChunkSize + 4 + 16
+
+		err := DecryptMemoryDump(&bytes.Buffer{}, bytes.NewReader(encrypted[:len(encrypted)-lastChunkLen]), privateKey)
+		Expect(err).To(MatchError(ContainSubstring("truncated")))
+	})
+
+	It("should fail to decrypt with another private key", func() {
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = DecryptMemoryDump(&bytes.Buffer{}, bytes.NewReader(encrypt(newDump(10))), otherKey)
+		Expect(err).To(MatchError(ContainSubstring("failed to unwrap the memory dump key")))
+	})
+
+	Context("public key", func() {
+		It("should parse a PKIX encoded key", func() {
+			der, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(ParseEncryptionPublicKey(encodeKey("PUBLIC KEY", der))).To(Equal(&privateKey.PublicKey))
+		})
+
+		It("should parse a PKCS #1 encoded key", func() {
+			der := x509.MarshalPKCS1PublicKey(&privateKey.PublicKey)
+
+			Expect(ParseEncryptionPublicKey(encodeKey("RSA PUBLIC KEY", der))).To(Equal(&privateKey.PublicKey))
+		})
+
+		It("should reject a key which is not PEM encoded", func() {
+			_, err := ParseEncryptionPublicKey("not a key")
+			Expect(err).To(MatchError("the encryption public key is not PEM encoded"))
+		})
+
+		It("should reject a key which is not an RSA key", func() {
+			ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).ToNot(HaveOccurred())
+			der, err := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = ParseEncryptionPublicKey(encodeKey("PUBLIC KEY", der))
+			Expect(err).To(MatchError("the encryption public key must be an RSA key"))
+		})
+
+		It("should reject a short RSA key", func() {
+			shortKey, err := rsa.GenerateKey(rand.Reader, 1024)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = ParseEncryptionPublicKey(encodeKey("RSA PUBLIC KEY", x509.MarshalPKCS1PublicKey(&shortKey.PublicKey)))
+			Expect(err).To(MatchError("the encryption public key must be at least 2048 bits long"))
+		})
+	})
+
+	Context("private key", func() {
+		It("should parse a PKCS #8 encoded key", func() {
+			der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(ParseDecryptionPrivateKey(encodeKey("PRIVATE KEY", der))).To(Equal(privateKey))
+		})
+
+		It("should parse a PKCS #1 encoded key", func() {
+			der := x509.MarshalPKCS1PrivateKey(privateKey)
+
+			Expect(ParseDecryptionPrivateKey(encodeKey("RSA PRIVATE KEY", der))).To(Equal(privateKey))
+		})
+
+		It("should reject a key which is not PEM encoded", func() {
+			_, err := ParseDecryptionPrivateKey("not a key")
+			Expect(err).To(MatchError("the decryption private key is not PEM encoded"))
+		})
+
+		It("should reject a key which is not an RSA key", func() {
+			ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).ToNot(HaveOccurred())
+			der, err := x509.MarshalPKCS8PrivateKey(ecKey)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = ParseDecryptionPrivateKey(encodeKey("PRIVATE KEY", der))
+			Expect(err).To(MatchError("the decryption private key must be an RSA key"))
+		})
+	})
+})
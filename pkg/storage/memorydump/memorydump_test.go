@@ -165,6 +165,24 @@ var _ = Describe("MemoryDump", func() {
 		})
 	})
 
+	It("should add the memory dump volume with the encryption public key to the vmi", func() {
+		const publicKey = "-----BEGIN PUBLIC KEY-----"
+		vm, vmi := createVirtualMachineWithMemoryDump(v1.MemoryDumpAssociating)
+		vm.Status.MemoryDumpRequest.EncryptionPublicKey = publicKey
+
+		vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(HandleRequest(virtClient, vm, vmi, pvcStore)).To(Succeed())
+
+		vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vmi.Spec.Volumes).To(HaveLen(1))
+		Expect(vmi.Spec.Volumes[0].MemoryDump).ToNot(BeNil())
+		Expect(vmi.Spec.Volumes[0].MemoryDump.ClaimName).To(Equal(testPVCName))
+		Expect(vmi.Spec.Volumes[0].MemoryDump.EncryptionPublicKey).To(Equal(publicKey))
+	})
+
 	DescribeTable("should remove memory dump volume from vmi volumes and update pvc annotation", func(phase v1.MemoryDumpPhase, expectedAnnotation string) {
 		vm, vmi := createVirtualMachineWithMemoryDump(phase)
 
@@ -21,16 +21,19 @@ package reservation
 
 import (
 	"path/filepath"
+	"slices"
 
 	v1 "kubevirt.io/api/core/v1"
 )
 
 const (
-	sourceDaemonsPath     = "/var/run/kubevirt/daemons"
-	hostSourceDaemonsPath = "/proc/1/root" + sourceDaemonsPath
-	prHelperDir           = "pr"
-	prHelperSocket        = "pr-helper.sock"
-	prResourceName        = "pr-helper"
+	sourceDaemonsPath = "/var/run/kubevirt/daemons"
+	hostRootPath      = "/proc/1/root"
+	prHelperDir       = "pr"
+	prHelperSocket    = "pr-helper.sock"
+	prResourceName    = "pr-helper"
+	// the leading dot keeps the directory apart from the hotplugged volumes
+	hotplugPrHelperDir = ".pr-helper"
 )
 
 func GetPrResourceName() string {
@@ -41,18 +44,42 @@ func GetPrHelperSocketDir() string {
 	return filepath.Join(sourceDaemonsPath, prHelperDir)
 }
 
-func GetPrHelperHostSocketDir() string {
-	return filepath.Join(hostSourceDaemonsPath, prHelperDir)
+// GetPrHelperHostSocketDir returns the directory of the pr-helper socket as seen from virt-handler
+func GetPrHelperHostSocketDir(socketPath string) string {
+	return filepath.Join(hostRootPath, filepath.Dir(socketPath))
 }
 
 func GetPrHelperSocketPath() string {
 	return filepath.Join(GetPrHelperSocketDir(), prHelperSocket)
 }
 
+// GetConfiguredPrHelperSocketPath returns the pr-helper socket path set in the cluster configuration,
+// or the default one when it is not set.
+func GetConfiguredPrHelperSocketPath(config *v1.PersistentReservationConfiguration) string {
+	if config != nil && config.SocketPath != "" {
+		return config.SocketPath
+	}
+	return GetPrHelperSocketPath()
+}
+
+// IsStorageClassAllowed returns whether LUNs backed by a PVC of the storage class can request a persistent reservation
+func IsStorageClassAllowed(config *v1.PersistentReservationConfiguration, storageClass string) bool {
+	if config == nil || len(config.StorageClasses) == 0 {
+		return true
+	}
+	return slices.Contains(config.StorageClasses, storageClass)
+}
+
 func GetPrHelperSocket() string {
 	return prHelperSocket
 }
 
+// GetHotplugPrHelperDir returns the directory, relative to the hotplug disks directory of the virt-launcher pod,
+// where virt-handler exposes the pr-helper socket to hotplugged LUNs.
+func GetHotplugPrHelperDir() string {
+	return hotplugPrHelperDir
+}
+
 func HasVMIPersistentReservation(vmi *v1.VirtualMachineInstance) bool {
 	return HasVMISpecPersistentReservation(&vmi.Spec)
 }
@@ -65,3 +92,19 @@ func HasVMISpecPersistentReservation(vmiSpec *v1.VirtualMachineInstanceSpec) boo
 	}
 	return false
 }
+
+// HasVMIHotplugPersistentReservation returns whether a hotplugged LUN of the VMI requests a SCSI persistent reservation.
+func HasVMIHotplugPersistentReservation(vmi *v1.VirtualMachineInstance) bool {
+	hotplugVolumes := map[string]struct{}{}
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if volumeStatus.HotplugVolume != nil {
+			hotplugVolumes[volumeStatus.Name] = struct{}{}
+		}
+	}
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		if _, isHotplug := hotplugVolumes[disk.Name]; isHotplug && disk.DiskDevice.LUN != nil && disk.DiskDevice.LUN.Reservation {
+			return true
+		}
+	}
+	return false
+}
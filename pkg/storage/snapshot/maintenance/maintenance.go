@@ -0,0 +1,170 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package maintenance implements the snapshots the VM controller takes of a running
+// VirtualMachine before risky changes are applied to it.
+package maintenance
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	// SnapshotLabel marks the VirtualMachineSnapshots taken before a risky change,
+	// its value is the name of the VM.
+	SnapshotLabel = "snapshot.kubevirt.io/maintenance"
+	// SuccessWindowAnnotation records the success window of the VM policy at the time the snapshot was taken.
+	SuccessWindowAnnotation = "snapshot.kubevirt.io/maintenance-success-window"
+
+	DefaultSuccessWindow = 24 * time.Hour
+
+	ErrorReason           = "MaintenanceSnapshotError"
+	SnapshotCreatedReason = "MaintenanceSnapshotCreated"
+	SnapshotFailedReason  = "MaintenanceSnapshotFailed"
+)
+
+// Changes returns the risky changes between the VM spec and the running VMI.
+// CPU, memory and interfaces are compared to the VMI, as this is what hotplug compares,
+// while the firmware is compared to the VM spec the VMI was started from.
+func Changes(vmSpec *v1.VirtualMachineSpec, vmi *v1.VirtualMachineInstance, startVMSpec *v1.VirtualMachineSpec) []string {
+	var changes []string
+	template := &vmSpec.Template.Spec
+
+	if template.Domain.CPU != nil && vmi.Spec.Domain.CPU != nil &&
+		template.Domain.CPU.Sockets != vmi.Spec.Domain.CPU.Sockets {
+		changes = append(changes, "CPU")
+	}
+
+	if template.Domain.Memory != nil && template.Domain.Memory.Guest != nil &&
+		vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Guest != nil &&
+		!template.Domain.Memory.Guest.Equal(*vmi.Spec.Domain.Memory.Guest) {
+		changes = append(changes, "memory")
+	}
+
+	if !interfaceNames(template.Domain.Devices.Interfaces).Equal(interfaceNames(vmi.Spec.Domain.Devices.Interfaces)) {
+		changes = append(changes, "interfaces")
+	}
+
+	if startVMSpec != nil && startVMSpec.Template != nil &&
+		!equality.Semantic.DeepEqual(withoutUUID(template.Domain.Firmware), withoutUUID(startVMSpec.Template.Spec.Domain.Firmware)) {
+		changes = append(changes, "firmware")
+	}
+
+	return changes
+}
+
+func interfaceNames(interfaces []v1.Interface) sets.Set[string] {
+	names := sets.New[string]()
+	for _, iface := range interfaces {
+		if iface.State != v1.InterfaceStateAbsent {
+			names.Insert(iface.Name)
+		}
+	}
+	return names
+}
+
+// withoutUUID drops the firmware UUID, which the VM controller persists
+// in the VM spec after the VM may have been started.
+func withoutUUID(firmware *v1.Firmware) *v1.Firmware {
+	if firmware == nil {
+		return &v1.Firmware{}
+	}
+	firmware = firmware.DeepCopy()
+	firmware.UUID = ""
+	return firmware
+}
+
+// SnapshotName returns the name of the maintenance snapshot taken before
+// the current generation of the VM is applied.
+func SnapshotName(vm *v1.VirtualMachine) string {
+	return fmt.Sprintf("%s-maintenance-%d", vm.Name, vm.Generation)
+}
+
+func NewSnapshot(vm *v1.VirtualMachine) *snapshotv1.VirtualMachineSnapshot {
+	return &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      SnapshotName(vm),
+			Namespace: vm.Namespace,
+			Labels: map[string]string{
+				SnapshotLabel: vm.Name,
+			},
+			Annotations: map[string]string{
+				SuccessWindowAnnotation: successWindow(vm.Spec.MaintenanceSnapshot).String(),
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(vm, v1.VirtualMachineGroupVersionKind),
+			},
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.P(v1.GroupVersion.Group),
+				Kind:     v1.VirtualMachineGroupVersionKind.Kind,
+				Name:     vm.Name,
+			},
+		},
+	}
+}
+
+func successWindow(policy *v1.MaintenanceSnapshotPolicy) time.Duration {
+	if policy == nil || policy.SuccessWindow == nil {
+		return DefaultSuccessWindow
+	}
+	return policy.SuccessWindow.Duration
+}
+
+// IsMaintenanceSnapshot returns true if the snapshot was taken before a risky change.
+func IsMaintenanceSnapshot(snapshot *snapshotv1.VirtualMachineSnapshot) bool {
+	_, exists := snapshot.Labels[SnapshotLabel]
+	return exists
+}
+
+// Completed returns true once the snapshot either succeeded or failed.
+func Completed(snapshot *snapshotv1.VirtualMachineSnapshot) bool {
+	return snapshot.Status != nil &&
+		(snapshot.Status.Phase == snapshotv1.Succeeded || snapshot.Status.Phase == snapshotv1.Failed)
+}
+
+// TimeUntilExpiry returns how long a succeeded maintenance snapshot has to be kept.
+// A zero or negative duration means the success window is over.
+func TimeUntilExpiry(snapshot *snapshotv1.VirtualMachineSnapshot, now time.Time) (time.Duration, error) {
+	window := DefaultSuccessWindow
+	if value, exists := snapshot.Annotations[SuccessWindowAnnotation]; exists {
+		var err error
+		if window, err = time.ParseDuration(value); err != nil {
+			return 0, fmt.Errorf("invalid %s annotation: %v", SuccessWindowAnnotation, err)
+		}
+	}
+
+	takenAt := snapshot.CreationTimestamp.Time
+	if snapshot.Status != nil && snapshot.Status.CreationTime != nil {
+		takenAt = snapshot.Status.CreationTime.Time
+	}
+	return takenAt.Add(window).Sub(now), nil
+}
@@ -0,0 +1,146 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package maintenance_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/snapshot/maintenance"
+)
+
+var _ = Describe("Maintenance snapshots", func() {
+	Context("Changes", func() {
+		newVMI := func() *v1.VirtualMachineInstance {
+			return libvmi.New(
+				libvmi.WithName("testvm"),
+				libvmi.WithCPUCount(1, 1, 2),
+				libvmi.WithGuestMemory("1Gi"),
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			)
+		}
+
+		newVMSpec := func(vmi *v1.VirtualMachineInstance) *v1.VirtualMachineSpec {
+			return &libvmi.NewVirtualMachine(vmi).Spec
+		}
+
+		It("should not report changes when the VM matches the VMI", func() {
+			vmi := newVMI()
+			vmSpec := newVMSpec(vmi.DeepCopy())
+			Expect(maintenance.Changes(vmSpec, vmi, vmSpec.DeepCopy())).To(BeEmpty())
+		})
+
+		DescribeTable("should report", func(update func(*v1.VirtualMachineSpec), expected string) {
+			vmi := newVMI()
+			vmSpec := newVMSpec(vmi.DeepCopy())
+			startVMSpec := vmSpec.DeepCopy()
+			update(vmSpec)
+			Expect(maintenance.Changes(vmSpec, vmi, startVMSpec)).To(ConsistOf(expected))
+		},
+			Entry("CPU sockets", func(spec *v1.VirtualMachineSpec) {
+				spec.Template.Spec.Domain.CPU.Sockets = 4
+			}, "CPU"),
+			Entry("guest memory", func(spec *v1.VirtualMachineSpec) {
+				spec.Template.Spec.Domain.Memory.Guest = pointer.P(resource.MustParse("2Gi"))
+			}, "memory"),
+			Entry("a hotplugged interface", func(spec *v1.VirtualMachineSpec) {
+				spec.Template.Spec.Domain.Devices.Interfaces = append(spec.Template.Spec.Domain.Devices.Interfaces,
+					v1.Interface{Name: "blue", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}})
+			}, "interfaces"),
+			Entry("an unplugged interface", func(spec *v1.VirtualMachineSpec) {
+				spec.Template.Spec.Domain.Devices.Interfaces[0].State = v1.InterfaceStateAbsent
+			}, "interfaces"),
+			Entry("a firmware change", func(spec *v1.VirtualMachineSpec) {
+				spec.Template.Spec.Domain.Firmware = &v1.Firmware{Bootloader: &v1.Bootloader{EFI: &v1.EFI{}}}
+			}, "firmware"),
+		)
+
+		It("should ignore the firmware UUID persisted after the VM started", func() {
+			vmi := newVMI()
+			vmSpec := newVMSpec(vmi.DeepCopy())
+			startVMSpec := vmSpec.DeepCopy()
+			vmSpec.Template.Spec.Domain.Firmware = &v1.Firmware{UUID: "6a1a24a1-4061-4607-8bf4-a3963d0c5895"}
+			Expect(maintenance.Changes(vmSpec, vmi, startVMSpec)).To(BeEmpty())
+		})
+	})
+
+	Context("NewSnapshot", func() {
+		It("should snapshot the VM with its success window", func() {
+			vm := libvmi.NewVirtualMachine(libvmi.New(libvmi.WithName("testvm"), libvmi.WithNamespace("default")))
+			vm.Generation = 3
+			vm.Spec.MaintenanceSnapshot = &v1.MaintenanceSnapshotPolicy{SuccessWindow: &metav1.Duration{Duration: time.Hour}}
+
+			snapshot := maintenance.NewSnapshot(vm)
+			Expect(snapshot.Name).To(Equal("testvm-maintenance-3"))
+			Expect(snapshot.Namespace).To(Equal("default"))
+			Expect(snapshot.Spec.Source.Name).To(Equal("testvm"))
+			Expect(snapshot.Spec.Source.Kind).To(Equal("VirtualMachine"))
+			Expect(snapshot.Annotations).To(HaveKeyWithValue(maintenance.SuccessWindowAnnotation, "1h0m0s"))
+			Expect(snapshot.OwnerReferences).To(ConsistOf(HaveField("Name", "testvm")))
+			Expect(maintenance.IsMaintenanceSnapshot(snapshot)).To(BeTrue())
+		})
+	})
+
+	Context("TimeUntilExpiry", func() {
+		now := time.Now()
+
+		newSnapshot := func(takenAt time.Time, window string) *snapshotv1.VirtualMachineSnapshot {
+			snapshot := &snapshotv1.VirtualMachineSnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{},
+				},
+				Status: &snapshotv1.VirtualMachineSnapshotStatus{
+					CreationTime: &metav1.Time{Time: takenAt},
+					Phase:        snapshotv1.Succeeded,
+				},
+			}
+			if window != "" {
+				snapshot.Annotations[maintenance.SuccessWindowAnnotation] = window
+			}
+			return snapshot
+		}
+
+		DescribeTable("should return", func(snapshot *snapshotv1.VirtualMachineSnapshot, expected time.Duration) {
+			remaining, err := maintenance.TimeUntilExpiry(snapshot, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(remaining).To(Equal(expected))
+		},
+			Entry("the remaining success window", newSnapshot(now.Add(-time.Hour), "2h"), time.Hour),
+			Entry("a negative duration once the window is over", newSnapshot(now.Add(-3*time.Hour), "2h"), -time.Hour),
+			Entry("the remaining default window", newSnapshot(now.Add(-time.Hour), ""), 23*time.Hour),
+		)
+
+		It("should fail on an invalid success window", func() {
+			_, err := maintenance.TimeUntilExpiry(newSnapshot(now, "a day"), now)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
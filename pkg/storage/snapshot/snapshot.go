@@ -43,6 +43,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/controller"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/snapshot/maintenance"
 	storageutils "kubevirt.io/kubevirt/pkg/storage/utils"
 )
 
@@ -256,6 +257,10 @@ func (ctrl *VMSnapshotController) updateVMSnapshot(vmSnapshot *snapshotv1.Virtua
 		}
 	}
 
+	if maintenance.IsMaintenanceSnapshot(vmSnapshot) && vmSnapshotSucceeded(vmSnapshot) && !terminating {
+		return ctrl.garbageCollectMaintenanceSnapshot(vmSnapshot)
+	}
+
 	if retry == 0 {
 		return timeUntilDeadline(vmSnapshot), nil
 	}
@@ -263,6 +268,34 @@ func (ctrl *VMSnapshotController) updateVMSnapshot(vmSnapshot *snapshotv1.Virtua
 	return retry, nil
 }
 
+// garbageCollectMaintenanceSnapshot deletes a maintenance snapshot once its success window
+// is over and the VM is ready. The snapshot is kept while the VM is not ready,
+// so that it can be restored, and is checked again on the next VM update.
+func (ctrl *VMSnapshotController) garbageCollectMaintenanceSnapshot(vmSnapshot *snapshotv1.VirtualMachineSnapshot) (time.Duration, error) {
+	remaining, err := maintenance.TimeUntilExpiry(vmSnapshot, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	if remaining > 0 {
+		return remaining, nil
+	}
+
+	vm, err := ctrl.getVM(vmSnapshot)
+	if err != nil {
+		return 0, err
+	}
+	if vm == nil || !vm.Status.Ready {
+		return 0, nil
+	}
+
+	log.Log.V(2).Infof("Deleting maintenance vmsnapshot %s/%s", vmSnapshot.Namespace, vmSnapshot.Name)
+	err = ctrl.Client.VirtualMachineSnapshot(vmSnapshot.Namespace).Delete(context.Background(), vmSnapshot.Name, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return 0, err
+	}
+	return 0, nil
+}
+
 func (ctrl *VMSnapshotController) unfreezeSource(vmSnapshot *snapshotv1.VirtualMachineSnapshot) error {
 	if vmSnapshot == nil {
 		return nil
@@ -62,6 +62,7 @@ import (
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/instancetype/revision"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/snapshot/maintenance"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/util"
 )
@@ -1328,6 +1329,26 @@ var _ = Describe("Snapshot controlleer", func() {
 				Expect(*contentDeletes).To(Equal(1))
 			})
 
+			DescribeTable("maintenance snapshot should", func(takenAt time.Time, vmReady bool, expectedDeletes int) {
+				vmSnapshot := createVMSnapshotSuccess()
+				vmSnapshot.Labels = map[string]string{maintenance.SnapshotLabel: vmName}
+				vmSnapshot.Annotations = map[string]string{maintenance.SuccessWindowAnnotation: "1h"}
+				vmSnapshot.Status.CreationTime = &metav1.Time{Time: takenAt}
+				vm := createVM()
+				vm.Status.Ready = vmReady
+
+				vmSource.Add(vm)
+				deletes := expectVMSnapshotDelete(vmSnapshotClient, vmSnapshot.Name)
+				addVirtualMachineSnapshot(vmSnapshot)
+
+				controller.processVMSnapshotWorkItem()
+				Expect(*deletes).To(Equal(expectedDeletes))
+			},
+				Entry("be deleted once the success window is over and the VM is ready", time.Now().Add(-2*time.Hour), true, 1),
+				Entry("be kept during the success window", time.Now(), true, 0),
+				Entry("be kept while the VM is not ready", time.Now().Add(-2*time.Hour), false, 0),
+			)
+
 			It("should create VolumeSnapshot", func() {
 				vm := createLockedVM()
 				storageClass := createStorageClass()
@@ -2911,6 +2932,21 @@ func expectVMSnapshotContentUpdateStatus(client *kubevirtfake.Clientset, content
 	return &calls
 }
 
+func expectVMSnapshotDelete(client *kubevirtfake.Clientset, name string) *int {
+	calls := 0
+	client.Fake.PrependReactor("delete", "virtualmachinesnapshots", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+		delete, ok := action.(testing.DeleteAction)
+		Expect(ok).To(BeTrue())
+
+		Expect(delete.GetName()).To(Equal(name))
+
+		calls++
+
+		return true, nil, nil
+	})
+	return &calls
+}
+
 func expectVMSnapshotContentDelete(client *kubevirtfake.Clientset, name string) *int {
 	calls := 0
 	client.Fake.PrependReactor("delete", "virtualmachinesnapshotcontents", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
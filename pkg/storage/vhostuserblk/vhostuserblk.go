@@ -0,0 +1,52 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package vhostuserblk implements the volumes served by a vhost-user-blk backend, such as SPDK,
+// through a unix socket on the node.
+package vhostuserblk
+
+import (
+	"path/filepath"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+var socketBaseDir = filepath.Join(util.VirtPrivateDir, "vhost-user-blk")
+
+// GetMountedSocketDir returns the directory the socket directory of the volume is mounted at in the virt-launcher pod.
+func GetMountedSocketDir(volumeName string) string {
+	return filepath.Join(socketBaseDir, volumeName)
+}
+
+// GetMountedSocketPath returns the path of the socket of the volume in the virt-launcher pod.
+func GetMountedSocketPath(volumeName string, path string) string {
+	return filepath.Join(GetMountedSocketDir(volumeName), filepath.Base(path))
+}
+
+// HasVhostUserBlkVolume returns true if any of the volumes is served by a vhost-user-blk backend.
+func HasVhostUserBlkVolume(volumes []v1.Volume) bool {
+	for _, volume := range volumes {
+		if volume.VhostUserBlk != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vhostuserblk_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/storage/vhostuserblk"
+)
+
+var _ = Describe("vhost-user-blk volumes", func() {
+	It("should mount the socket directory of each volume separately", func() {
+		Expect(vhostuserblk.GetMountedSocketDir("disk0")).To(Equal("/var/run/kubevirt-private/vhost-user-blk/disk0"))
+		Expect(vhostuserblk.GetMountedSocketPath("disk0", "/var/tmp/spdk/vhost.0")).To(Equal("/var/run/kubevirt-private/vhost-user-blk/disk0/vhost.0"))
+	})
+
+	DescribeTable("should detect vhost-user-blk volumes", func(volumes []v1.Volume, expected bool) {
+		Expect(vhostuserblk.HasVhostUserBlkVolume(volumes)).To(Equal(expected))
+	},
+		Entry("without volumes", nil, false),
+		Entry("with other volumes", []v1.Volume{{Name: "disk0", VolumeSource: v1.VolumeSource{EmptyDisk: &v1.EmptyDiskSource{}}}}, false),
+		Entry("with a vhost-user-blk volume", []v1.Volume{
+			{Name: "disk0", VolumeSource: v1.VolumeSource{EmptyDisk: &v1.EmptyDiskSource{}}},
+			{Name: "disk1", VolumeSource: v1.VolumeSource{VhostUserBlk: &v1.VhostUserBlkVolumeSource{Path: "/var/tmp/spdk/vhost.0"}}},
+		}, true),
+	)
+})
@@ -1,6 +1,15 @@
 package tpm
 
-import v1 "kubevirt.io/api/core/v1"
+import (
+	"path/filepath"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+// ExternalSocketDir is the directory the socket directory of an external swtpm is mounted at in the virt-launcher pod
+var ExternalSocketDir = filepath.Join(util.VirtPrivateDir, "swtpm-external")
 
 func HasDevice(vmiSpec *v1.VirtualMachineInstanceSpec) bool {
 	return vmiSpec.Domain.Devices.TPM != nil &&
@@ -12,3 +21,13 @@ func HasPersistentDevice(vmiSpec *v1.VirtualMachineInstanceSpec) bool {
 		vmiSpec.Domain.Devices.TPM.Persistent != nil &&
 		*vmiSpec.Domain.Devices.TPM.Persistent
 }
+
+// HasExternalDevice returns true if the vTPM is backed by an externally managed swtpm
+func HasExternalDevice(vmiSpec *v1.VirtualMachineInstanceSpec) bool {
+	return HasDevice(vmiSpec) && vmiSpec.Domain.Devices.TPM.External != nil
+}
+
+// GetExternalSocketPath returns the path of the socket of the external swtpm in the virt-launcher pod
+func GetExternalSocketPath(external *v1.TPMExternal) string {
+	return filepath.Join(ExternalSocketDir, filepath.Base(external.SocketPath))
+}
@@ -35,8 +35,23 @@ import (
 
 const (
 	PCI_ADDRESS_PATTERN = `^([\da-fA-F]{4}):([\da-fA-F]{2}):([\da-fA-F]{2})\.([0-7]{1})$`
+	// The guest sees the virtual channel subsystem as 0, so it is the only one accepted
+	CCW_ADDRESS_PATTERN = `^0\.([0-3])\.([\da-fA-F]{4})$`
 )
 
+const (
+	// virtio requires the size of a virtqueue to be a power of 2, QEMU limits it to 1024
+	MinVirtqueueSize = 4
+	MaxVirtqueueSize = 1024
+)
+
+// IsValidVirtqueueSize returns whether size is a power of 2 between minSize and MaxVirtqueueSize.
+// Devices accepting smaller virtqueues than MinVirtqueueSize do not exist, minSize is only raised
+// for devices requiring larger ones.
+func IsValidVirtqueueSize(size, minSize uint32) bool {
+	return size >= minSize && size <= MaxVirtqueueSize && size&(size-1) == 0
+}
+
 // Parse linux cpuset into an array of ints
 // See: http://man7.org/linux/man-pages/man7/cpuset.7.html#FORMATS
 func ParseCPUSetLine(cpusetLine string, limit int) (cpusList []int, err error) {
@@ -100,6 +115,12 @@ func GetNumberOfVCPUs(cpuSpec *v1.CPU) int64 {
 	return int64(vCPUs)
 }
 
+// GetSGXEPCSizeMiB returns the size of the SGX enclave page cache of the guest in MiB, rounded up
+func GetSGXEPCSizeMiB(sgx *v1.SGX) int64 {
+	const mebibyte = 1024 * 1024
+	return (sgx.EPCSize.Value() + mebibyte - 1) / mebibyte
+}
+
 // ParsePciAddress returns an array of PCI DBSF fields (domain, bus, slot, function)
 func ParsePciAddress(pciAddress string) ([]string, error) {
 	pciAddrRegx, err := regexp.Compile(PCI_ADDRESS_PATTERN)
@@ -113,6 +134,19 @@ func ParsePciAddress(pciAddress string) ([]string, error) {
 	return res[1:], nil
 }
 
+// ParseCCWAddress returns an array of CCW bus ID fields (subchannel set id, device number)
+func ParseCCWAddress(ccwAddress string) ([]string, error) {
+	ccwAddrRegx, err := regexp.Compile(CCW_ADDRESS_PATTERN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile ccw address pattern, %v", err)
+	}
+	res := ccwAddrRegx.FindStringSubmatch(ccwAddress)
+	if len(res) == 0 {
+		return nil, fmt.Errorf("failed to parse ccw address %s", ccwAddress)
+	}
+	return res[1:], nil
+}
+
 func GetDeviceNumaNode(pciAddress string) (*uint32, error) {
 	pciBasePath := "/sys/bus/pci/devices"
 	numaNodePath := filepath.Join(pciBasePath, pciAddress, "numa_node")
@@ -22,6 +22,7 @@ package hardware
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	v1 "kubevirt.io/api/core/v1"
 )
@@ -91,6 +92,14 @@ var _ = Describe("Hardware utils test", func() {
 	})
 
 	Context("parse PCI address", func() {
+		DescribeTable("should round the SGX EPC size up to MiB", func(size string, expected int64) {
+			Expect(GetSGXEPCSizeMiB(&v1.SGX{EPCSize: resource.MustParse(size)})).To(Equal(expected))
+		},
+			Entry("with a multiple of 1Mi", "64Mi", int64(64)),
+			Entry("with a partial MiB", "1500Ki", int64(2)),
+			Entry("with a decimal size", "100M", int64(96)),
+		)
+
 		It("shoud return an array of PCI DBSF fields (domain, bus, slot, function) or an error for malformed address", func() {
 			testData := []struct {
 				addr        string
@@ -119,4 +128,45 @@ var _ = Describe("Hardware utils test", func() {
 			}
 		})
 	})
+
+	Context("parse CCW address", func() {
+		It("should return an array of CCW bus ID fields (ssid, devno) or an error for malformed address", func() {
+			testData := []struct {
+				addr        string
+				expectation []string
+			}{
+				{"0.0.0001", []string{"0", "0001"}},
+				{"0.3.fFfF", []string{"3", "fFfF"}},
+				{"", nil},
+				{"invalid address", nil},
+				{"0.0.001", nil},   // short device number
+				{"0.4.0001", nil},  // invalid subchannel set
+				{"fe.0.0001", nil}, // only the virtual channel subsystem is addressable
+				{"0.0.000g", nil},  // invalid digit in device number
+			}
+
+			for _, t := range testData {
+				res, err := ParseCCWAddress(t.addr)
+				Expect(res).To(Equal(t.expectation))
+				if t.expectation == nil {
+					Expect(err).To(HaveOccurred())
+				} else {
+					Expect(err).ToNot(HaveOccurred())
+				}
+			}
+		})
+	})
+
+	Context("virtqueue size", func() {
+		DescribeTable("should validate", func(size, minSize uint32, expected bool) {
+			Expect(IsValidVirtqueueSize(size, minSize)).To(Equal(expected))
+		},
+			Entry("the minimum size", uint32(MinVirtqueueSize), uint32(MinVirtqueueSize), true),
+			Entry("the maximum size", uint32(MaxVirtqueueSize), uint32(MinVirtqueueSize), true),
+			Entry("a size below the minimum", uint32(2), uint32(MinVirtqueueSize), false),
+			Entry("a size below a raised minimum", uint32(128), uint32(256), false),
+			Entry("a size above the maximum", uint32(2048), uint32(MinVirtqueueSize), false),
+			Entry("a size which is not a power of 2", uint32(384), uint32(MinVirtqueueSize), false),
+		)
+	})
 })
@@ -4,6 +4,7 @@ import (
 	"crypto/rand"
 	"fmt"
 	"math/big"
+	"path/filepath"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -21,6 +22,7 @@ const (
 	VirtImageVolumeDir                        = "/var/run/kubevirt-image-volume"
 	VirtKernelBootVolumeDir                   = "/var/run/kubevirt-kernel-boot"
 	VirtPrivateDir                            = "/var/run/kubevirt-private"
+	VirtChannelsDir                           = "/var/run/kubevirt-channels"
 	KubeletRoot                               = "/var/lib/kubelet"
 	KubeletPodsDir                            = KubeletRoot + "/pods"
 	HostRootMount                             = "/proc/1/root/"
@@ -195,3 +197,27 @@ func GenerateKubeVirtGroupVersionKind(obj runtime.Object) (runtime.Object, error
 
 	return objCopy, nil
 }
+
+// SocketPathNamespace returns the namespace a socket path is dedicated to. The socket path has to be a clean
+// absolute path located in the directory of the namespace in one of the permitted directories of the
+// configuration, <permitted directory>/<namespace>, or in one of its subdirectories.
+func SocketPathNamespace(path string, config *v1.HostSocketDirectoriesConfiguration) (string, bool) {
+	if config == nil || !filepath.IsAbs(path) || filepath.Clean(path) != path {
+		return "", false
+	}
+	for _, dir := range config.PermittedDirectories {
+		relativeDir, err := filepath.Rel(dir, filepath.Dir(path))
+		if err != nil || relativeDir == "." || relativeDir == ".." || strings.HasPrefix(relativeDir, ".."+string(filepath.Separator)) {
+			continue
+		}
+		return strings.Split(relativeDir, string(filepath.Separator))[0], true
+	}
+	return "", false
+}
+
+// IsPermittedSocketPath returns true if the socket path is located in the directory of the namespace in one
+// of the permitted directories of the configuration. Tenants can't reach the sockets of other namespaces.
+func IsPermittedSocketPath(path, namespace string, config *v1.HostSocketDirectoriesConfiguration) bool {
+	socketNamespace, ok := SocketPathNamespace(path, config)
+	return ok && socketNamespace == namespace
+}
@@ -99,3 +99,27 @@ var _ = Describe("Host Device VMI Predicates", func() {
 		Expect(IsHostDevVMI(vmi)).To(BeTrue())
 	})
 })
+
+var _ = Describe("Socket path allowlist", func() {
+	permitted := &v1.HostSocketDirectoriesConfiguration{PermittedDirectories: []string{"/var/tmp/spdk", "/run/vhost"}}
+
+	DescribeTable("should permit", func(path string, config *v1.HostSocketDirectoriesConfiguration, expected bool) {
+		Expect(IsPermittedSocketPath(path, "ns1", config)).To(Equal(expected))
+	},
+		Entry("no socket without configuration", "/var/tmp/spdk/ns1/vhost.0", nil, false),
+		Entry("a socket in the namespace directory of a permitted directory", "/var/tmp/spdk/ns1/vhost.0", permitted, true),
+		Entry("a socket in a subdirectory of the namespace directory", "/run/vhost/ns1/vm1/vhost.0", permitted, true),
+		Entry("no socket directly in a permitted directory", "/var/tmp/spdk/vhost.0", permitted, false),
+		Entry("no socket in the namespace directory of another namespace", "/var/tmp/spdk/ns2/vhost.0", permitted, false),
+		Entry("no socket in another directory", "/var/lib/kubelet/ns1/vhost.0", permitted, false),
+		Entry("no socket in a directory sharing the prefix of a permitted one", "/var/tmp/spdk2/ns1/vhost.0", permitted, false),
+		Entry("no socket escaping a permitted directory", "/var/tmp/spdk/ns1/../../../etc/vhost.0", permitted, false),
+		Entry("no relative socket path", "spdk/ns1/vhost.0", permitted, false),
+	)
+
+	It("should return the namespace a socket is dedicated to", func() {
+		namespace, ok := SocketPathNamespace("/run/vhost/ns2/vm1/vhost.0", permitted)
+		Expect(ok).To(BeTrue())
+		Expect(namespace).To(Equal("ns2"))
+	})
+})
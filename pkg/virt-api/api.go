@@ -52,6 +52,7 @@ import (
 
 	backupv1 "kubevirt.io/api/backup/v1alpha1"
 	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 	clientutil "kubevirt.io/client-go/util"
@@ -240,7 +241,8 @@ func (app *virtAPIApp) composeSubresources() {
 		subws.Doc(fmt.Sprintf("KubeVirt \"%s\" Subresource API.", version.Version))
 		subws.Path(definitions.GroupVersionBasePath(version))
 
-		subresourceApp := rest.NewSubresourceAPIApp(app.virtCli, app.consoleServerPort, app.handlerTLSConfiguration, app.clusterConfig)
+		subresourceApp := rest.NewSubresourceAPIApp(app.virtCli, app.consoleServerPort, app.handlerTLSConfiguration, app.clusterConfig, app.authorizor)
+		streamRateLimiter := rest.NewStreamRateLimiter(app.authorizor)
 
 		restartRouteBuilder := subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("restart")).
 			To(subresourceApp.RestartVMRequestHandler).
@@ -357,12 +359,14 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("console")).
+			Filter(streamRateLimiter.Filter("console")).
 			To(subresourceApp.ConsoleRequestHandler).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Operation(version.Version + "Console").
 			Doc("Open a websocket connection to a serial console on the specified VirtualMachineInstance."))
 
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("vnc")).
+			Filter(streamRateLimiter.Filter("vnc")).
 			To(subresourceApp.VNCRequestHandler).
 			Param(definitions.NamespaceParam(subws)).
 			Param(definitions.NameParam(subws)).
@@ -377,6 +381,7 @@ func (app *virtAPIApp) composeSubresources() {
 			Operation(version.Version + "VNCScreenshot").
 			Doc("Get a PNG VNC screenshot of the specified VirtualMachineInstance."))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("usbredir")).
+			Filter(streamRateLimiter.Filter("usbredir")).
 			To(subresourceApp.USBRedirRequestHandler).
 			Param(definitions.NamespaceParam(subws)).
 			Param(definitions.NameParam(subws)).
@@ -385,12 +390,14 @@ func (app *virtAPIApp) composeSubresources() {
 
 		// VMI endpoint
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("portforward") + definitions.PortPath).
+			Filter(streamRateLimiter.Filter("portforward")).
 			To(subresourceApp.PortForwardRequestHandler(subresourceApp.FetchVirtualMachineInstance)).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Param(definitions.PortForwardPortParameter(subws)).
 			Operation(version.Version + "vmi-PortForward").
 			Doc("Open a websocket connection forwarding traffic to the specified VirtualMachineInstance and port."))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("portforward") + definitions.PortPath + definitions.ProtocolPath).
+			Filter(streamRateLimiter.Filter("portforward")).
 			To(subresourceApp.PortForwardRequestHandler(subresourceApp.FetchVirtualMachineInstance)).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Param(definitions.PortForwardPortParameter(subws)).
@@ -405,12 +412,14 @@ func (app *virtAPIApp) composeSubresources() {
 
 		// VM endpoint
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmGVR) + definitions.SubResourcePath("portforward") + definitions.PortPath).
+			Filter(streamRateLimiter.Filter("portforward")).
 			To(subresourceApp.PortForwardRequestHandler(subresourceApp.FetchVirtualMachineInstanceForVM)).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Param(definitions.PortForwardPortParameter(subws)).
 			Operation(version.Version + "vm-PortForward").
 			Doc("Open a websocket connection forwarding traffic to the running VMI for the specified VirtualMachine and port."))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmGVR) + definitions.SubResourcePath("portforward") + definitions.PortPath + definitions.ProtocolPath).
+			Filter(streamRateLimiter.Filter("portforward")).
 			To(subresourceApp.PortForwardRequestHandler(subresourceApp.FetchVirtualMachineInstanceForVM)).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Param(definitions.PortForwardPortParameter(subws)).
@@ -489,6 +498,16 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.VirtualMachineInstanceFileSystemList{}).
 			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("stats")).
+			To(subresourceApp.Stats).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"Stats").
+			Doc("Get the CPU, memory, disk and network usage of a Virtual Machine Instance").
+			Writes(v1.VirtualMachineInstanceStats{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceStats{}))
+
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("objectgraph")).
 			To(subresourceApp.VMIObjectGraph).
 			Consumes(restful.MIME_JSON).
@@ -531,6 +550,18 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("changemedia")).
+			To(subresourceApp.ChangeMediaVMIRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.ChangeMediaOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vmi-changemedia").
+			Doc("Changes the medium of a CD-ROM of a running Virtual Machine Instance").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusConflict, "Conflict", ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("addvolume")).
 			To(subresourceApp.VMAddVolumeRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -569,6 +600,20 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("revert")).
+			To(subresourceApp.RevertVMRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.RevertOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vm-revert").
+			Doc("Revert a stopped Virtual Machine to one of its snapshots.").
+			Writes(snapshotv1.VirtualMachineRestore{}).
+			Returns(http.StatusAccepted, "Accepted", snapshotv1.VirtualMachineRestore{}).
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusConflict, "Conflict", "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		// AMD SEV endpoints
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("sev/fetchcertchain")).
 			To(subresourceApp.SEVFetchCertChainRequestHandler).
@@ -735,6 +780,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachines/evacuate/cancel",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachines/revert",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/guestosinfo",
 						Namespaced: true,
@@ -747,6 +796,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/filesystemlist",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/stats",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/addvolume",
 						Namespaced: true,
@@ -755,6 +808,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/removevolume",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/changemedia",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/objectgraph",
 						Namespaced: true,
@@ -968,14 +1025,14 @@ func (app *virtAPIApp) prepareCertManager() {
 
 func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers) {
 	http.HandleFunc(components.VMICreateValidatePath, func(w http.ResponseWriter, r *http.Request) {
-		validating_webhook.ServeVMICreate(w, r, app.clusterConfig, app.kubeVirtServiceAccounts,
+		validating_webhook.ServeVMICreate(w, r, app.clusterConfig, informers, app.kubeVirtServiceAccounts,
 			func(field *field.Path, vmiSpec *v1.VirtualMachineInstanceSpec, clusterCfg *virtconfig.ClusterConfig) []metav1.StatusCause {
 				return netadmitter.Validate(field, vmiSpec, clusterCfg)
 			},
 		)
 	})
 	http.HandleFunc(components.VMIUpdateValidatePath, func(w http.ResponseWriter, r *http.Request) {
-		validating_webhook.ServeVMIUpdate(w, r, app.clusterConfig, app.kubeVirtServiceAccounts)
+		validating_webhook.ServeVMIUpdate(w, r, app.clusterConfig, informers, app.kubeVirtServiceAccounts)
 	})
 	http.HandleFunc(components.VMValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMs(w, r, app.clusterConfig, app.virtCli, informers, app.kubeVirtServiceAccounts)
@@ -1180,6 +1237,8 @@ func (app *virtAPIApp) Run() {
 	vmRestoreInformer := kubeInformerFactory.VirtualMachineRestore()
 	vmBackupInformer := kubeInformerFactory.VirtualMachineBackup()
 	namespaceInformer := kubeInformerFactory.Namespace()
+	overcommitProfileInformer := kubeInformerFactory.OvercommitProfile()
+	virtualizationQuotaInformer := kubeInformerFactory.VirtualizationQuota()
 
 	stopChan := make(chan struct{}, 1)
 	defer close(stopChan)
@@ -1214,11 +1273,13 @@ func (app *virtAPIApp) Run() {
 	kubeInformerFactory.WaitForCacheSync(stopChan)
 
 	webhookInformers := &webhooks.Informers{
-		VMIPresetInformer:  vmiPresetInformer,
-		VMRestoreInformer:  vmRestoreInformer,
-		VMBackupInformer:   vmBackupInformer,
-		DataSourceInformer: dataSourceInformer,
-		NamespaceInformer:  namespaceInformer,
+		VMIPresetInformer:           vmiPresetInformer,
+		VMRestoreInformer:           vmRestoreInformer,
+		VMBackupInformer:            vmBackupInformer,
+		DataSourceInformer:          dataSourceInformer,
+		NamespaceInformer:           namespaceInformer,
+		OvercommitProfileInformer:   overcommitProfileInformer,
+		VirtualizationQuotaInformer: virtualizationQuotaInformer,
 	}
 
 	// Build webhook subresources
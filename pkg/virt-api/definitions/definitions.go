@@ -34,6 +34,10 @@ import (
 
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 
+	"kubevirt.io/api/overcommit"
+
+	overcommitv1 "kubevirt.io/api/overcommit/v1alpha1"
+
 	restful "github.com/emicklei/go-restful/v3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -64,6 +68,7 @@ func ComposeAPIDefinitions() []*restful.WebService {
 		backupApiServiceDefinitions,
 		instancetypeApiServiceDefinitions,
 		migrationPoliciesApiServiceDefinitions,
+		overcommitApiServiceDefinitions,
 		poolApiServiceDefinitions,
 		vmCloneDefinitions,
 	} {
@@ -195,6 +200,32 @@ func migrationPoliciesApiServiceDefinitions() []*restful.WebService {
 	return []*restful.WebService{ws, ws2}
 }
 
+func overcommitApiServiceDefinitions() []*restful.WebService {
+	opGVR := overcommitv1.SchemeGroupVersion.WithResource(overcommit.ResourceOvercommitProfiles)
+	vqGVR := overcommitv1.SchemeGroupVersion.WithResource(overcommit.ResourceVirtualizationQuotas)
+
+	ws, err := groupVersionProxyBase(overcommitv1.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericClusterResourceProxy(ws, opGVR, &overcommitv1.OvercommitProfile{}, overcommitv1.OvercommitProfileKind.Kind, &overcommitv1.OvercommitProfileList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, vqGVR, &overcommitv1.VirtualizationQuota{}, overcommitv1.VirtualizationQuotaKind.Kind, &overcommitv1.VirtualizationQuotaList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws2, err := resourceProxyAutodiscovery(opGVR)
+	if err != nil {
+		panic(err)
+	}
+	return []*restful.WebService{ws, ws2}
+}
+
 func instancetypeApiServiceDefinitions() []*restful.WebService {
 	instancetypeGVR := instancetypev1beta1.SchemeGroupVersion.WithResource(instancetype.PluralResourceName)
 	clusterInstancetypeGVR := instancetypev1beta1.SchemeGroupVersion.WithResource(instancetype.ClusterPluralResourceName)
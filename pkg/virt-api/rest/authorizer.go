@@ -79,6 +79,9 @@ var noAuthEndpoints = map[string]struct{}{
 
 type VirtApiAuthorizor interface {
 	Authorize(req *restful.Request) (bool, string, error)
+	// AuthorizeResource checks whether the user of an authenticated request may access the given resource,
+	// for subresources acting on further resources on behalf of the user.
+	AuthorizeResource(req *restful.Request, attributes *authv1.ResourceAttributes) (bool, string, error)
 	AddUserHeaders(header []string)
 	GetUserHeaders() []string
 	AddGroupHeaders(header []string)
@@ -175,23 +178,11 @@ func (a *authorizor) generateAccessReview(req *restful.Request) (*authv1.Subject
 		return nil, fmt.Errorf("no URL in http request")
 	}
 
-	userName, err := a.getUserName(req.Request.Header)
+	r, err := a.newUserAccessReview(req.Request.Header)
 	if err != nil {
 		return nil, err
 	}
 
-	userGroups, err := a.getUserGroups(req.Request.Header)
-	if err != nil {
-		return nil, err
-	}
-
-	r := &authv1.SubjectAccessReview{}
-	r.Spec = authv1.SubjectAccessReviewSpec{
-		User:   userName,
-		Groups: userGroups,
-		Extra:  a.getUserExtras(req.Request.Header),
-	}
-
 	// URL examples
 	// /apis/subresources.kubevirt.io/v1alpha3/namespaces/default/virtualmachineinstances/testvmi/console
 	// /apis/subresources.kubevirt.io/v1alpha3/namespaces/default/expand-vm-spec
@@ -211,6 +202,26 @@ func (a *authorizor) generateAccessReview(req *restful.Request) (*authv1.Subject
 	return r, nil
 }
 
+func (a *authorizor) newUserAccessReview(header http.Header) (*authv1.SubjectAccessReview, error) {
+	userName, err := a.getUserName(header)
+	if err != nil {
+		return nil, err
+	}
+
+	userGroups, err := a.getUserGroups(header)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &authv1.SubjectAccessReview{}
+	r.Spec = authv1.SubjectAccessReviewSpec{
+		User:   userName,
+		Groups: userGroups,
+		Extra:  a.getUserExtras(header),
+	}
+	return r, nil
+}
+
 func addNamespacedResourceAttributes(pathSplit []string, requestMethod string, r *authv1.SubjectAccessReview) error {
 	// URL example
 	// /apis/subresources.kubevirt.io/v1alpha3/namespaces/default/virtualmachineinstances/testvmi/console
@@ -339,6 +350,24 @@ func (a *authorizor) Authorize(req *restful.Request) (bool, string, error) {
 		return false, fmt.Sprintf("%v", err), nil
 	}
 
+	return a.review(r)
+}
+
+func (a *authorizor) AuthorizeResource(req *restful.Request, attributes *authv1.ResourceAttributes) (bool, string, error) {
+	if !isAuthenticated(req) {
+		return false, "request is not authenticated", nil
+	}
+
+	r, err := a.newUserAccessReview(req.Request.Header)
+	if err != nil {
+		return false, fmt.Sprintf("%v", err), nil
+	}
+	r.Spec.ResourceAttributes = attributes
+
+	return a.review(r)
+}
+
+func (a *authorizor) review(r *authv1.SubjectAccessReview) (bool, string, error) {
 	result, err := a.client.Create(context.Background(), r, metav1.CreateOptions{})
 	if err != nil {
 		return false, "internal server error", err
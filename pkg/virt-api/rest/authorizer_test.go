@@ -191,6 +191,67 @@ var _ = Describe("Authorizer", func() {
 
 			})
 
+			Context("with other resources", func() {
+				restoreAttributes := &authv1.ResourceAttributes{
+					Namespace: "default",
+					Verb:      "create",
+					Group:     "snapshot.kubevirt.io",
+					Version:   "v1beta1",
+					Resource:  "virtualmachinerestores",
+				}
+
+				allowed := func(allowed bool) func(review *authv1.SubjectAccessReview) (*authv1.SubjectAccessReview, error) {
+					return func(sar *authv1.SubjectAccessReview) (*authv1.SubjectAccessReview, error) {
+						Expect(sar.Spec.User).To(Equal("user"))
+						Expect(sar.Spec.Groups).To(Equal([]string{"userGroup"}))
+						Expect(sar.Spec.Extra).To(HaveKeyWithValue("test", authv1.ExtraValue{"userExtraValue"}))
+						Expect(sar.Spec.ResourceAttributes).To(Equal(restoreAttributes))
+						sar.Status.Allowed = allowed
+						sar.Status.Reason = "just because"
+						return sar, nil
+					}
+				}
+
+				BeforeEach(func() {
+					req.Request.Method = http.MethodPut
+					req.Request.URL.Path = "/apis/subresources.kubevirt.io/v1/namespaces/default/virtualmachines/testvm/revert"
+				})
+
+				It("should reject unauthenticated user", func() {
+					req.Request.TLS = nil
+
+					result, reason, err := app.AuthorizeResource(req, restoreAttributes)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).To(BeFalse())
+					Expect(reason).To(Equal("request is not authenticated"))
+				})
+
+				It("should reject if auth check fails", func() {
+					allowedFn = func(sar *authv1.SubjectAccessReview) (*authv1.SubjectAccessReview, error) {
+						return nil, errors.New("internal error")
+					}
+
+					result, _, err := app.AuthorizeResource(req, restoreAttributes)
+					Expect(err).To(HaveOccurred())
+					Expect(result).To(BeFalse())
+				})
+
+				It("should reject unauthorized user", func() {
+					allowedFn = allowed(false)
+					result, reason, err := app.AuthorizeResource(req, restoreAttributes)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).To(BeFalse())
+					Expect(reason).To(Equal("just because"))
+				})
+
+				It("should allow authorized user", func() {
+					allowedFn = allowed(true)
+					result, _, err := app.AuthorizeResource(req, restoreAttributes)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).To(BeTrue())
+				})
+			})
+
 			DescribeTable("should allow all users for info endpoints", func(path string) {
 				req.Request.TLS = nil
 				req.Request.URL.Path = path
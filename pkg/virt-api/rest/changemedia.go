@@ -0,0 +1,116 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+func (app *SubresourceAPIApp) ChangeMediaVMIRequestHandler(request *restful.Request, response *restful.Response) {
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body: change media parameters are required"), response)
+		return
+	}
+
+	opts := &v1.ChangeMediaOptions{}
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+	if opts.DiskName == "" {
+		writeError(errors.NewBadRequest("DiskName must be set"), response)
+		return
+	}
+
+	// The body was consumed by the decoder, forward the decoded options to virt-handler
+	body, err := json.Marshal(opts)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	request.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if !vmi.IsRunning() {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		if err := validateChangeMediaOptions(vmi, opts); err != nil {
+			return errors.NewBadRequest(err.Error())
+		}
+		return nil
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.ChangeMediaURI(vmi)
+	}
+
+	app.putRequestHandler(request, response, validate, getURL, false)
+}
+
+// validateChangeMediaOptions checks that the disk is a CD-ROM and that the volume, if any, can be inserted into it.
+// Only the volume of the CD-ROM itself or volumes which are not used by any disk can be inserted.
+func validateChangeMediaOptions(vmi *v1.VirtualMachineInstance, opts *v1.ChangeMediaOptions) error {
+	var cdrom *v1.Disk
+	for i, disk := range vmi.Spec.Domain.Devices.Disks {
+		if disk.Name == opts.DiskName {
+			cdrom = &vmi.Spec.Domain.Devices.Disks[i]
+		} else if opts.VolumeName != "" && disk.Name == opts.VolumeName {
+			return fmt.Errorf("volume %s is used by the disk %s", opts.VolumeName, disk.Name)
+		}
+	}
+	if cdrom == nil {
+		return fmt.Errorf("disk %s does not exist", opts.DiskName)
+	}
+	if cdrom.CDRom == nil {
+		return fmt.Errorf("disk %s is not a CD-ROM", opts.DiskName)
+	}
+
+	if opts.VolumeName == "" {
+		return nil
+	}
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name != opts.VolumeName {
+			continue
+		}
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			if volume.PersistentVolumeClaim.Hotpluggable {
+				return fmt.Errorf("volume %s is hotpluggable and can not be inserted into a CD-ROM", opts.VolumeName)
+			}
+		case volume.DataVolume != nil:
+			if volume.DataVolume.Hotpluggable {
+				return fmt.Errorf("volume %s is hotpluggable and can not be inserted into a CD-ROM", opts.VolumeName)
+			}
+		default:
+			return fmt.Errorf("volume %s can not be inserted into a CD-ROM, only persistentVolumeClaim and dataVolume volumes can", opts.VolumeName)
+		}
+		return nil
+	}
+	return fmt.Errorf("volume %s does not exist", opts.VolumeName)
+}
@@ -0,0 +1,100 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+var _ = Describe("ChangeMedia Subresource API", func() {
+	It("should reject a request without a body", func() {
+		request := restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMIName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder := httptest.NewRecorder()
+		response := restful.NewResponse(recorder)
+
+		app := &SubresourceAPIApp{}
+		app.ChangeMediaVMIRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+	})
+
+	DescribeTable("should validate the change media options", func(opts *v1.ChangeMediaOptions, expectedErr string) {
+		vmi := libvmi.New(
+			libvmi.WithCDRom("cdrom", v1.DiskBusSATA, "iso-1"),
+			libvmi.WithPersistentVolumeClaim("disk", "rootdisk"),
+		)
+		vmi.Spec.Volumes = append(vmi.Spec.Volumes,
+			v1.Volume{
+				Name: "iso-2",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "iso-2"},
+					},
+				},
+			},
+			v1.Volume{
+				Name: "iso-3",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "iso-3"},
+						Hotpluggable:                      true,
+					},
+				},
+			},
+			v1.Volume{
+				Name: "iso-4",
+				VolumeSource: v1.VolumeSource{
+					ContainerDisk: &v1.ContainerDiskSource{Image: "iso-4"},
+				},
+			},
+		)
+
+		err := validateChangeMediaOptions(vmi, opts)
+		if expectedErr == "" {
+			Expect(err).ToNot(HaveOccurred())
+		} else {
+			Expect(err).To(MatchError(expectedErr))
+		}
+	},
+		Entry("to insert an unused volume", &v1.ChangeMediaOptions{DiskName: "cdrom", VolumeName: "iso-2"}, ""),
+		Entry("to insert the volume of the CD-ROM again", &v1.ChangeMediaOptions{DiskName: "cdrom", VolumeName: "cdrom"}, ""),
+		Entry("to eject the medium", &v1.ChangeMediaOptions{DiskName: "cdrom"}, ""),
+		Entry("with a missing disk", &v1.ChangeMediaOptions{DiskName: "missing"}, "disk missing does not exist"),
+		Entry("with a disk which is not a CD-ROM", &v1.ChangeMediaOptions{DiskName: "disk"}, "disk disk is not a CD-ROM"),
+		Entry("with a missing volume", &v1.ChangeMediaOptions{DiskName: "cdrom", VolumeName: "missing"}, "volume missing does not exist"),
+		Entry("with a volume used by another disk", &v1.ChangeMediaOptions{DiskName: "cdrom", VolumeName: "disk"}, "volume disk is used by the disk disk"),
+		Entry("with a hotpluggable volume", &v1.ChangeMediaOptions{DiskName: "cdrom", VolumeName: "iso-3"},
+			"volume iso-3 is hotpluggable and can not be inserted into a CD-ROM"),
+		Entry("with an unsupported volume", &v1.ChangeMediaOptions{DiskName: "cdrom", VolumeName: "iso-4"},
+			"volume iso-4 can not be inserted into a CD-ROM, only persistentVolumeClaim and dataVolume volumes can"),
+	)
+})
@@ -14,6 +14,7 @@ import (
 
 	restful "github.com/emicklei/go-restful/v3"
 	gomock "go.uber.org/mock/gomock"
+	v1 "k8s.io/api/authorization/v1"
 )
 
 // MockVirtApiAuthorizor is a mock of VirtApiAuthorizor interface.
@@ -92,6 +93,22 @@ func (mr *MockVirtApiAuthorizorMockRecorder) Authorize(req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockVirtApiAuthorizor)(nil).Authorize), req)
 }
 
+// AuthorizeResource mocks base method.
+func (m *MockVirtApiAuthorizor) AuthorizeResource(req *restful.Request, attributes *v1.ResourceAttributes) (bool, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeResource", req, attributes)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AuthorizeResource indicates an expected call of AuthorizeResource.
+func (mr *MockVirtApiAuthorizorMockRecorder) AuthorizeResource(req, attributes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeResource", reflect.TypeOf((*MockVirtApiAuthorizor)(nil).AuthorizeResource), req, attributes)
+}
+
 // GetExtraPrefixHeaders mocks base method.
 func (m *MockVirtApiAuthorizor) GetExtraPrefixHeaders() []string {
 	m.ctrl.T.Helper()
@@ -36,6 +36,7 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	kutil "kubevirt.io/kubevirt/pkg/util"
 )
@@ -119,6 +120,12 @@ func (app *SubresourceAPIApp) validateMemoryDumpRequest(vm *v1.VirtualMachine, m
 		memoryDumpReq.ClaimName = vm.Status.MemoryDumpRequest.ClaimName
 	}
 
+	if memoryDumpReq.EncryptionPublicKey != "" {
+		if _, err := memorydump.ParseEncryptionPublicKey(memoryDumpReq.EncryptionPublicKey); err != nil {
+			return errors.NewBadRequest(err.Error())
+		}
+	}
+
 	vmi, statErr := app.FetchVirtualMachineInstance(vm.Namespace, vm.Name)
 	if statErr != nil {
 		return statErr
@@ -0,0 +1,169 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	authv1 "k8s.io/api/authorization/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/api/core"
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+const (
+	vmNotStopped        = "VM is not stopped"
+	snapshotNotReadyFmt = "VirtualMachineSnapshot %s is not ready to use"
+	snapshotNotOfVMFmt  = "VirtualMachineSnapshot %s is not a snapshot of VM %s"
+)
+
+var (
+	snapshotResource = snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshots").GroupResource()
+	restoreResource  = snapshotv1.SchemeGroupVersion.WithResource("virtualmachinerestores").GroupResource()
+)
+
+// RevertVMRequestHandler reverts a stopped VM to one of its snapshots. The volumes are
+// restored in place, the restore controller picks the restore mechanism of each volume
+// and restores the backend storage (persistent TPM and EFI) along with them.
+// The VirtualMachineRestore is created by virt-api, so the user must be allowed to create it.
+func (app *SubresourceAPIApp) RevertVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+	ctx := request.Request.Context()
+
+	if !app.clusterConfig.SnapshotEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, featuregate.SnapshotGate)), response)
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body"), response)
+		return
+	}
+	opts := &v1.RevertOptions{}
+	defer request.Request.Body.Close()
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+	if opts.VirtualMachineSnapshotName == "" {
+		writeError(errors.NewBadRequest("Revert requires the VirtualMachineSnapshot name to be set"), response)
+		return
+	}
+
+	if statErr := app.authorizeRestoreCreation(request, namespace); statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	vm, statErr := app.fetchVirtualMachine(name, namespace)
+	if statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+	if statErr = app.validateRevertRequest(ctx, vm, opts.VirtualMachineSnapshotName); statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	restore, err := app.virtCli.VirtualMachineRestore(namespace).Create(ctx, newRevertRestore(vm, opts.VirtualMachineSnapshotName), k8smetav1.CreateOptions{DryRun: opts.DryRun})
+	if err != nil {
+		log.Log.Object(vm).Reason(err).Error("Failed to create the VirtualMachineRestore")
+		if statErr, ok := err.(*errors.StatusError); ok {
+			writeError(statErr, response)
+			return
+		}
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	response.WriteHeaderAndJson(http.StatusAccepted, restore, restful.MIME_JSON)
+}
+
+func (app *SubresourceAPIApp) authorizeRestoreCreation(request *restful.Request, namespace string) *errors.StatusError {
+	allowed, reason, err := app.authorizor.AuthorizeResource(request, &authv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      "create",
+		Group:     restoreResource.Group,
+		Version:   snapshotv1.SchemeGroupVersion.Version,
+		Resource:  restoreResource.Resource,
+	})
+	if err != nil {
+		return errors.NewInternalError(fmt.Errorf("unable to authorize the creation of the VirtualMachineRestore: %v", err))
+	}
+	if !allowed {
+		return errors.NewForbidden(restoreResource, "", fmt.Errorf("%s", reason))
+	}
+	return nil
+}
+
+func (app *SubresourceAPIApp) validateRevertRequest(ctx context.Context, vm *v1.VirtualMachine, snapshotName string) *errors.StatusError {
+	_, err := app.virtCli.VirtualMachineInstance(vm.Namespace).Get(ctx, vm.Name, k8smetav1.GetOptions{})
+	if err == nil {
+		return errors.NewConflict(v1.Resource("virtualmachine"), vm.Name, fmt.Errorf(vmNotStopped))
+	} else if !errors.IsNotFound(err) {
+		return errors.NewInternalError(fmt.Errorf("unable to retrieve vmi [%s]: %v", vm.Name, err))
+	}
+
+	snapshot, err := app.virtCli.VirtualMachineSnapshot(vm.Namespace).Get(ctx, snapshotName, k8smetav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return errors.NewNotFound(snapshotResource, snapshotName)
+		}
+		return errors.NewInternalError(fmt.Errorf("unable to retrieve vmsnapshot [%s]: %v", snapshotName, err))
+	}
+	if snapshot.Status == nil || snapshot.Status.SourceUID == nil || *snapshot.Status.SourceUID != vm.UID {
+		return errors.NewBadRequest(fmt.Sprintf(snapshotNotOfVMFmt, snapshotName, vm.Name))
+	}
+	if snapshot.Status.ReadyToUse == nil || !*snapshot.Status.ReadyToUse {
+		return errors.NewConflict(snapshotResource, snapshotName, fmt.Errorf(snapshotNotReadyFmt, snapshotName))
+	}
+	return nil
+}
+
+func newRevertRestore(vm *v1.VirtualMachine, snapshotName string) *snapshotv1.VirtualMachineRestore {
+	return &snapshotv1.VirtualMachineRestore{
+		ObjectMeta: k8smetav1.ObjectMeta{
+			GenerateName: vm.Name + "-revert-",
+			Namespace:    vm.Namespace,
+		},
+		Spec: snapshotv1.VirtualMachineRestoreSpec{
+			Target: k8sv1.TypedLocalObjectReference{
+				APIGroup: pointer.P(core.GroupName),
+				Kind:     "VirtualMachine",
+				Name:     vm.Name,
+			},
+			VirtualMachineSnapshotName: snapshotName,
+			VolumeRestorePolicy:        pointer.P(snapshotv1.VolumeRestorePolicyInPlace),
+		},
+	}
+}
@@ -0,0 +1,244 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	authv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Revert Subresource API", func() {
+	const (
+		testSnapshotName = "testsnapshot"
+		testVMUID        = types.UID("testvm-uid")
+	)
+
+	var (
+		request  *restful.Request
+		response *restful.Response
+
+		virtClient *kubecli.MockKubevirtClient
+		authorizor *MockVirtApiAuthorizor
+		app        *SubresourceAPIApp
+
+		kv = &v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubevirt",
+				Namespace: "kubevirt",
+			},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{},
+				},
+			},
+			Status: v1.KubeVirtStatus{
+				Phase: v1.KubeVirtPhaseDeployed,
+			},
+		}
+	)
+
+	config, _, kvStore := testutils.NewFakeClusterConfigUsingKV(kv)
+
+	enableSnapshotFeatureGate := func() {
+		kvConfig := kv.DeepCopy()
+		kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{featuregate.SnapshotGate}
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder := httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+
+		fakeClient := fake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(fakeClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(fakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshot(metav1.NamespaceDefault).Return(fakeClient.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineRestore(metav1.NamespaceDefault).Return(fakeClient.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault)).AnyTimes()
+
+		restoreAttributes := &authv1.ResourceAttributes{
+			Namespace: metav1.NamespaceDefault,
+			Verb:      "create",
+			Group:     snapshotv1.SchemeGroupVersion.Group,
+			Version:   snapshotv1.SchemeGroupVersion.Version,
+			Resource:  "virtualmachinerestores",
+		}
+		authorizor = NewMockVirtApiAuthorizor(ctrl)
+		authorizor.EXPECT().AuthorizeResource(request, restoreAttributes).Return(true, "", nil).AnyTimes()
+
+		app = NewSubresourceAPIApp(virtClient, 0, &tls.Config{InsecureSkipVerify: true}, config, authorizor)
+		enableSnapshotFeatureGate()
+		DeferCleanup(testutils.UpdateFakeKubeVirtClusterConfig, kvStore, kv)
+	})
+
+	createVM := func() {
+		vm := libvmi.NewVirtualMachine(libvmi.New(libvmi.WithName(testVMName), libvmi.WithNamespace(metav1.NamespaceDefault)))
+		vm.UID = testVMUID
+		_, err := virtClient.VirtualMachine(metav1.NamespaceDefault).Create(context.Background(), vm, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	createSnapshot := func(sourceUID types.UID, readyToUse bool) {
+		snapshot := &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testSnapshotName,
+				Namespace: metav1.NamespaceDefault,
+			},
+			Status: &snapshotv1.VirtualMachineSnapshotStatus{
+				SourceUID:  pointer.P(sourceUID),
+				ReadyToUse: pointer.P(readyToUse),
+			},
+		}
+		_, err := virtClient.VirtualMachineSnapshot(metav1.NamespaceDefault).Create(context.Background(), snapshot, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	listRestores := func() []snapshotv1.VirtualMachineRestore {
+		restores, err := virtClient.VirtualMachineRestore(metav1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return restores.Items
+	}
+
+	It("should create an in place restore of the VM", func() {
+		createVM()
+		createSnapshot(testVMUID, true)
+		request.Request.Body = newRevertBody(&v1.RevertOptions{VirtualMachineSnapshotName: testSnapshotName})
+
+		app.RevertVMRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+
+		restores := listRestores()
+		Expect(restores).To(HaveLen(1))
+		Expect(restores[0].GenerateName).To(Equal(testVMName + "-revert-"))
+		Expect(restores[0].Spec.Target.Kind).To(Equal("VirtualMachine"))
+		Expect(restores[0].Spec.Target.Name).To(Equal(testVMName))
+		Expect(restores[0].Spec.VirtualMachineSnapshotName).To(Equal(testSnapshotName))
+		Expect(restores[0].Spec.VolumeRestorePolicy).To(HaveValue(Equal(snapshotv1.VolumeRestorePolicyInPlace)))
+	})
+
+	DescribeTable("should fail", func(vmRunning bool, sourceUID types.UID, readyToUse, snapshotExists bool, expectedStatusCode int) {
+		createVM()
+		if vmRunning {
+			vmi := libvmi.New(libvmi.WithName(testVMName), libvmi.WithNamespace(metav1.NamespaceDefault))
+			_, err := virtClient.VirtualMachineInstance(metav1.NamespaceDefault).Create(context.Background(), vmi, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+		if snapshotExists {
+			createSnapshot(sourceUID, readyToUse)
+		}
+		request.Request.Body = newRevertBody(&v1.RevertOptions{VirtualMachineSnapshotName: testSnapshotName})
+
+		app.RevertVMRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(expectedStatusCode))
+		Expect(listRestores()).To(BeEmpty())
+	},
+		Entry("when the VM is running", true, testVMUID, true, true, http.StatusConflict),
+		Entry("when the snapshot does not exist", false, testVMUID, true, false, http.StatusNotFound),
+		Entry("when the snapshot is of another VM", false, types.UID("other-uid"), true, true, http.StatusBadRequest),
+		Entry("when the snapshot is not ready", false, testVMUID, false, true, http.StatusConflict),
+	)
+
+	It("should fail when the snapshot name is missing", func() {
+		createVM()
+		request.Request.Body = newRevertBody(&v1.RevertOptions{})
+
+		app.RevertVMRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should fail when the user may not create VirtualMachineRestores", func() {
+		authorizor = NewMockVirtApiAuthorizor(gomock.NewController(GinkgoT()))
+		authorizor.EXPECT().AuthorizeResource(request, gomock.Any()).Return(false, "not allowed", nil)
+		app.authorizor = authorizor
+		createVM()
+		createSnapshot(testVMUID, true)
+		request.Request.Body = newRevertBody(&v1.RevertOptions{VirtualMachineSnapshotName: testSnapshotName})
+
+		app.RevertVMRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusForbidden))
+		Expect(listRestores()).To(BeEmpty())
+	})
+
+	It("should fail when the authorization check fails", func() {
+		authorizor = NewMockVirtApiAuthorizor(gomock.NewController(GinkgoT()))
+		authorizor.EXPECT().AuthorizeResource(request, gomock.Any()).Return(false, "internal server error", errors.New("internal error"))
+		app.authorizor = authorizor
+		createVM()
+		createSnapshot(testVMUID, true)
+		request.Request.Body = newRevertBody(&v1.RevertOptions{VirtualMachineSnapshotName: testSnapshotName})
+
+		app.RevertVMRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusInternalServerError))
+		Expect(listRestores()).To(BeEmpty())
+	})
+
+	It("should fail when the VM does not exist", func() {
+		request.Request.Body = newRevertBody(&v1.RevertOptions{VirtualMachineSnapshotName: testSnapshotName})
+
+		app.RevertVMRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusNotFound))
+	})
+
+	It("should fail when the Snapshot feature gate is disabled", func() {
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+		createVM()
+		createSnapshot(testVMUID, true)
+		request.Request.Body = newRevertBody(&v1.RevertOptions{VirtualMachineSnapshotName: testSnapshotName})
+
+		app.RevertVMRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusBadRequest))
+		Expect(listRestores()).To(BeEmpty())
+	})
+})
+
+func newRevertBody(opts *v1.RevertOptions) io.ReadCloser {
+	optsJson, _ := json.Marshal(opts)
+	return &readCloserWrapper{bytes.NewReader(optsJson)}
+}
@@ -0,0 +1,138 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/flowcontrol"
+
+	apimetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
+)
+
+const (
+	// Every user can open up to streamUserBurst websocket connections at once, then streamUserQPS per second
+	streamUserQPS   float32 = 5
+	streamUserBurst         = 20
+	// Up to streamVMIBurst websocket connections can be opened at once to a VMI, then streamVMIQPS per second
+	streamVMIQPS   float32 = 1
+	streamVMIBurst         = 10
+
+	// The token buckets of the users and VMIs which did not open a connection for that long are dropped,
+	// by then they are full again
+	streamRateLimiterIdleTimeout = 10 * time.Minute
+)
+
+type streamRateLimiterEntry struct {
+	limiter  flowcontrol.RateLimiter
+	lastUsed time.Time
+}
+
+// StreamRateLimiter limits the rate at which the websocket subresources (console, VNC, ...) are opened,
+// per user and per VMI, to protect virt-handler from connection storms.
+type StreamRateLimiter struct {
+	lock       sync.Mutex
+	authorizor VirtApiAuthorizor
+	users      map[string]*streamRateLimiterEntry
+	vmis       map[string]*streamRateLimiterEntry
+	lastPrune  time.Time
+}
+
+func NewStreamRateLimiter(authorizor VirtApiAuthorizor) *StreamRateLimiter {
+	return &StreamRateLimiter{
+		authorizor: authorizor,
+		users:      map[string]*streamRateLimiterEntry{},
+		vmis:       map[string]*streamRateLimiterEntry{},
+		lastPrune:  time.Now(),
+	}
+}
+
+// Filter rejects the requests to the given subresource with 429 Too Many Requests when the user or the VMI
+// exceeded their rate of connections
+func (l *StreamRateLimiter) Filter(subresource string) restful.FilterFunction {
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		namespace := request.PathParameter("namespace")
+		name := request.PathParameter("name")
+		if limit := l.accept(l.getUserName(request.Request.Header), namespace+"/"+name); limit != "" {
+			apimetrics.IncRateLimitedStreamConnections(namespace, subresource, limit)
+			writeError(errors.NewTooManyRequests(
+				fmt.Sprintf("too many %s connections opened by the %s, try again later", subresource, limit), 1), response)
+			return
+		}
+		chain.ProcessFilter(request, response)
+	}
+}
+
+func (l *StreamRateLimiter) getUserName(header http.Header) string {
+	if l.authorizor == nil {
+		return ""
+	}
+	for _, key := range l.authorizor.GetUserHeaders() {
+		if user, ok := header[key]; ok && len(user) > 0 {
+			return user[0]
+		}
+	}
+	return ""
+}
+
+// accept takes a token from the buckets of the user and of the VMI, it returns the limit which was exceeded if any
+func (l *StreamRateLimiter) accept(user, vmi string) string {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastPrune) > streamRateLimiterIdleTimeout {
+		pruneStreamRateLimiters(l.users, now)
+		pruneStreamRateLimiters(l.vmis, now)
+		l.lastPrune = now
+	}
+
+	// a user which is not allowed by its bucket does not take a token from the bucket of the VMI
+	if user != "" && !getStreamRateLimiter(l.users, user, streamUserQPS, streamUserBurst, now).TryAccept() {
+		return "user"
+	}
+	if !getStreamRateLimiter(l.vmis, vmi, streamVMIQPS, streamVMIBurst, now).TryAccept() {
+		return "vmi"
+	}
+	return ""
+}
+
+func getStreamRateLimiter(entries map[string]*streamRateLimiterEntry, key string, qps float32, burst int, now time.Time) flowcontrol.RateLimiter {
+	entry, exists := entries[key]
+	if !exists {
+		entry = &streamRateLimiterEntry{limiter: flowcontrol.NewTokenBucketRateLimiter(qps, burst)}
+		entries[key] = entry
+	}
+	entry.lastUsed = now
+	return entry.limiter
+}
+
+func pruneStreamRateLimiters(entries map[string]*streamRateLimiterEntry, now time.Time) {
+	for key, entry := range entries {
+		if now.Sub(entry.lastUsed) > streamRateLimiterIdleTimeout {
+			delete(entries, key)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	restful "github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Stream rate limiter", func() {
+	var limiter *StreamRateLimiter
+
+	BeforeEach(func() {
+		limiter = NewStreamRateLimiter(&authorizor{userHeaders: []string{userHeader}})
+	})
+
+	It("should limit the connections to a VMI", func() {
+		for i := 0; i < streamVMIBurst; i++ {
+			Expect(limiter.accept("", "default/testvmi")).To(BeEmpty())
+		}
+		Expect(limiter.accept("", "default/testvmi")).To(Equal("vmi"))
+		Expect(limiter.accept("", "default/othervmi")).To(BeEmpty())
+	})
+
+	It("should limit the connections of a user across VMIs", func() {
+		for i := 0; i < streamUserBurst; i++ {
+			Expect(limiter.accept("alice", fmt.Sprintf("default/testvmi%d", i))).To(BeEmpty())
+		}
+		Expect(limiter.accept("alice", "default/othervmi")).To(Equal("user"))
+		Expect(limiter.accept("bob", "default/othervmi")).To(BeEmpty())
+	})
+
+	It("should reject the requests exceeding the limits with 429", func() {
+		ws := new(restful.WebService)
+		ws.Route(ws.GET("/namespaces/{namespace}/virtualmachineinstances/{name}/console").
+			Filter(limiter.Filter("console")).
+			To(func(_ *restful.Request, response *restful.Response) {
+				response.WriteHeader(http.StatusOK)
+			}))
+		container := restful.NewContainer()
+		container.Add(ws)
+
+		serve := func() int {
+			request := httptest.NewRequest(http.MethodGet, "/namespaces/default/virtualmachineinstances/testvmi/console", nil)
+			request.Header.Set(userHeader, "alice")
+			recorder := httptest.NewRecorder()
+			container.ServeHTTP(recorder, request)
+			return recorder.Code
+		}
+		for i := 0; i < streamVMIBurst; i++ {
+			Expect(serve()).To(Equal(http.StatusOK))
+		}
+		Expect(serve()).To(Equal(http.StatusTooManyRequests))
+	})
+})
@@ -940,6 +940,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Entry("for GuestOSInfo", app.GuestOSInfo),
 			Entry("for UserList", app.UserList),
 			Entry("for Filesystem", app.FilesystemList),
+			Entry("for Stats", app.Stats),
 		)
 
 		DescribeTable("should fail when the VMI is not running", func(fn subRes) {
@@ -959,6 +960,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Entry("for GuestOSInfo", app.GuestOSInfo),
 			Entry("for UserList", app.UserList),
 			Entry("for FilesystemList", app.FilesystemList),
+			Entry("for Stats", app.Stats),
 		)
 
 		DescribeTable("should fail when VMI does not have agent connected", func(fn subRes) {
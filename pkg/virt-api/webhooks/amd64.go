@@ -22,6 +22,7 @@ package webhooks
 import (
 	"fmt"
 	"slices"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
@@ -30,6 +31,7 @@ import (
 
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/arch"
 )
 
 // ValidateVirtualMachineInstanceAmd64Setting is a validation function for validating-webhook on Amd64
@@ -37,24 +39,31 @@ func ValidateVirtualMachineInstanceAmd64Setting(field *k8sfield.Path, spec *v1.V
 	var statusCauses []metav1.StatusCause
 	validateWatchdogAmd64(field, spec, &statusCauses)
 	validateVideoTypeAmd64(field, spec, &statusCauses)
+	validateSerialConsoleTargetTypeAmd64(field, spec, &statusCauses)
 	return statusCauses
 }
 
-func validateVideoTypeAmd64(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
-	if spec.Domain.Devices.Video == nil {
+func validateSerialConsoleTargetTypeAmd64(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	targetType := spec.Domain.Devices.SerialConsoleTargetType
+	supportedTargetTypes := arch.NewConverter("amd64").SupportedSerialTargetTypes()
+	if targetType == "" || slices.Contains(supportedTargetTypes, targetType) {
 		return
 	}
 
-	videoType := spec.Domain.Devices.Video.Type
+	names := make([]string, 0, len(supportedTargetTypes))
+	for _, supportedTargetType := range supportedTargetTypes {
+		names = append(names, string(supportedTargetType))
+	}
+	*statusCauses = append(*statusCauses, metav1.StatusCause{
+		Type:    metav1.CauseTypeFieldValueNotSupported,
+		Message: fmt.Sprintf("amd64 only supports the %s serial console target types", strings.Join(names, ", ")),
+		Field:   field.Child("domain", "devices", "serialConsoleTargetType").String(),
+	})
+}
 
+func validateVideoTypeAmd64(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
 	validTypes := []string{"vga", "cirrus", "virtio", "ramfb", "bochs"}
-	if !slices.Contains(validTypes, videoType) {
-		*statusCauses = append(*statusCauses, metav1.StatusCause{
-			Type:    metav1.CauseTypeFieldValueNotSupported,
-			Message: fmt.Sprintf("video model '%s' is not supported on amd64 architecture", videoType),
-			Field:   field.Child("domain", "devices", "video").Child("type").String(),
-		})
-	}
+	validateVideoTypes(field, spec, "amd64", validTypes, statusCauses)
 }
 
 func validateWatchdogAmd64(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
@@ -25,7 +25,6 @@ package webhooks
 
 import (
 	"fmt"
-	"slices"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
@@ -42,26 +41,25 @@ func ValidateVirtualMachineInstanceArm64Setting(field *k8sfield.Path, spec *v1.V
 	validateWatchdog(field, spec, &statusCauses)
 	validateSoundDevice(field, spec, &statusCauses)
 	validateVideoTypeArm64(field, spec, &statusCauses)
+	validateSerialConsoleTargetTypeArm64(field, spec, &statusCauses)
 	return statusCauses
 }
 
-func validateVideoTypeArm64(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
-	if spec.Domain.Devices.Video == nil {
-		return
-	}
-
-	videoType := spec.Domain.Devices.Video.Type
-
-	validTypes := []string{"virtio", "ramfb"}
-	if !slices.Contains(validTypes, videoType) {
+func validateSerialConsoleTargetTypeArm64(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	if spec.Domain.Devices.SerialConsoleTargetType != "" {
 		*statusCauses = append(*statusCauses, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueNotSupported,
-			Message: fmt.Sprintf("video model '%s' is not supported on arm64 architecture", videoType),
-			Field:   field.Child("domain", "devices", "video").Child("type").String(),
+			Message: "Arm64 does not support selecting the serial console target type",
+			Field:   field.Child("domain", "devices", "serialConsoleTargetType").String(),
 		})
 	}
 }
 
+func validateVideoTypeArm64(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	validTypes := []string{"virtio", "ramfb"}
+	validateVideoTypes(field, spec, "arm64", validTypes, statusCauses)
+}
+
 func validateBootOptions(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
 	if spec.Domain.Firmware != nil && spec.Domain.Firmware.Bootloader != nil {
 		if spec.Domain.Firmware.Bootloader.BIOS != nil {
@@ -133,10 +131,11 @@ func validateDiskBus(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec,
 }
 
 func validateWatchdog(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
-	if spec.Domain.Devices.Watchdog != nil {
+	watchdog := spec.Domain.Devices.Watchdog
+	if watchdog != nil && !isOnlyI6300ESBWatchdog(watchdog) {
 		*statusCauses = append(*statusCauses, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueNotSupported,
-			Message: "Arm64 not support Watchdog device",
+			Message: "Arm64 only supports I6300ESB watchdog device",
 			Field:   field.Child("domain", "devices", "watchdog").String(),
 		})
 	}
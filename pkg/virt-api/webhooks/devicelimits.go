@@ -0,0 +1,89 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package webhooks
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/arch"
+)
+
+// reservedPCIDevices is the number of PCI slots left to the controllers and devices attached automatically,
+// e.g. the virtio-serial and SCSI controllers, the memory balloon or the random number generator
+const reservedPCIDevices = 8
+
+// ValidateDeviceLimits rejects the VMIs with more devices than the domains can have on their architecture,
+// which would otherwise only fail when the domain is defined
+func ValidateDeviceLimits(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	limits := arch.NewConverter(spec.Architecture).DeviceLimits()
+	devices := spec.Domain.Devices
+
+	virtioDisks := 0
+	for _, disk := range devices.Disks {
+		if isVirtioDisk(disk) {
+			virtioDisks++
+		}
+	}
+
+	if limits.PCIDevices > 0 {
+		pciDevices := virtioDisks + len(devices.Interfaces) + len(devices.HostDevices) + len(devices.GPUs) + len(devices.Filesystems)
+		if maxPCIDevices := limits.PCIDevices - reservedPCIDevices; pciDevices > maxPCIDevices {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s has %d disks on the virtio bus, interfaces, host devices, GPUs and filesystems, "+
+					"%s supports at most %d of them", field.Child("domain", "devices").String(), pciDevices, spec.Architecture, maxPCIDevices),
+				Field: field.Child("domain", "devices").String(),
+			})
+		}
+	}
+
+	if limits.CCWDevices > 0 {
+		ccwDevices := virtioDisks + len(devices.Interfaces) + len(devices.HostDevices) + len(devices.Filesystems)
+		if ccwDevices > limits.CCWDevices {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s has %d disks on the virtio bus, interfaces, host devices and filesystems, "+
+					"%s supports at most %d of them", field.Child("domain", "devices").String(), ccwDevices, spec.Architecture, limits.CCWDevices),
+				Field: field.Child("domain", "devices").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+// isVirtioDisk tells whether the disk is on the virtio bus, which is the default one of the disks and LUNs
+func isVirtioDisk(disk v1.Disk) bool {
+	switch {
+	case disk.Disk != nil:
+		return disk.Disk.Bus == "" || disk.Disk.Bus == v1.DiskBusVirtio
+	case disk.LUN != nil:
+		return disk.LUN.Bus == "" || disk.LUN.Bus == v1.DiskBusVirtio
+	case disk.CDRom != nil:
+		return disk.CDRom.Bus == v1.DiskBusVirtio
+	}
+	return disk.DiskDevice == v1.DiskDevice{}
+}
@@ -0,0 +1,114 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package mutators
+
+import (
+	"fmt"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	overcommitv1 "kubevirt.io/api/overcommit/v1alpha1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+)
+
+// applyOvercommitProfile sets the CPU and memory requests of the VMI from its domain resources and the
+// ratios of the OvercommitProfile matching its namespace. Requests and limits set on the VMI are kept,
+// so that the profile only replaces the cluster wide ratios virt-controller would otherwise apply.
+func applyOvercommitProfile(vmi *v1.VirtualMachineInstance, profileInformer, namespaceInformer cache.SharedIndexInformer) error {
+	obj, exists, err := namespaceInformer.GetStore().GetByKey(vmi.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to get namespace %s: %v", vmi.Namespace, err)
+	}
+	if !exists {
+		return nil
+	}
+	namespace := obj.(*k8sv1.Namespace)
+
+	profile := matchOvercommitProfile(profileInformer.GetStore().List(), namespace.Labels)
+	if profile == nil {
+		return nil
+	}
+	log.Log.Object(vmi).V(4).Infof("Apply OvercommitProfile %s", profile.Name)
+
+	resources := &vmi.Spec.Domain.Resources
+	if ratio := profile.Spec.CPUAllocationRatio; ratio != nil && *ratio > 0 && !vmi.IsCPUDedicated() {
+		vcpus := int64(1)
+		if vmi.Spec.Domain.CPU != nil {
+			vcpus = hardware.GetNumberOfVCPUs(vmi.Spec.Domain.CPU)
+		}
+		if vcpus > 0 {
+			setRequestIfUnset(resources, k8sv1.ResourceCPU, *resource.NewMilliQuantity(vcpus*1000/int64(*ratio), resource.DecimalSI))
+		}
+	}
+
+	memory := vmi.Spec.Domain.Memory
+	if overcommit := profile.Spec.MemoryOvercommit; overcommit != nil && *overcommit > 0 &&
+		memory != nil && memory.Guest != nil && memory.Hugepages == nil {
+		setRequestIfUnset(resources, k8sv1.ResourceMemory, *resource.NewQuantity(memory.Guest.Value()*100/int64(*overcommit), resource.BinarySI))
+	}
+
+	return nil
+}
+
+func setRequestIfUnset(resources *v1.ResourceRequirements, name k8sv1.ResourceName, quantity resource.Quantity) {
+	if _, hasRequest := resources.Requests[name]; hasRequest {
+		return
+	}
+	if _, hasLimit := resources.Limits[name]; hasLimit {
+		return
+	}
+	if resources.Requests == nil {
+		resources.Requests = k8sv1.ResourceList{}
+	}
+	resources.Requests[name] = quantity
+}
+
+// matchOvercommitProfile returns the profile whose namespace selector matches the most namespace labels,
+// or nil if no profile matches. Profiles matching as many labels are chosen by their names' lexicographic
+// order, to stay deterministic.
+func matchOvercommitProfile(profiles []interface{}, namespaceLabels map[string]string) *overcommitv1.OvercommitProfile {
+	var matched *overcommitv1.OvercommitProfile
+	for _, obj := range profiles {
+		profile := obj.(*overcommitv1.OvercommitProfile)
+		if !selectorMatches(profile.Spec.NamespaceSelector, namespaceLabels) {
+			continue
+		}
+		if matched == nil ||
+			len(profile.Spec.NamespaceSelector) > len(matched.Spec.NamespaceSelector) ||
+			(len(profile.Spec.NamespaceSelector) == len(matched.Spec.NamespaceSelector) && profile.Name < matched.Name) {
+			matched = profile
+		}
+	}
+	return matched
+}
+
+func selectorMatches(selector overcommitv1.LabelSelector, labels map[string]string) bool {
+	for key, value := range selector {
+		if labelValue, exists := labels[key]; !exists || labelValue != value {
+			return false
+		}
+	}
+	return true
+}
@@ -42,9 +42,11 @@ import (
 )
 
 type VMIsMutator struct {
-	ClusterConfig           *virtconfig.ClusterConfig
-	VMIPresetInformer       cache.SharedIndexInformer
-	KubeVirtServiceAccounts map[string]struct{}
+	ClusterConfig             *virtconfig.ClusterConfig
+	VMIPresetInformer         cache.SharedIndexInformer
+	NamespaceInformer         cache.SharedIndexInformer
+	OvercommitProfileInformer cache.SharedIndexInformer
+	KubeVirtServiceAccounts   map[string]struct{}
 }
 
 const presetDeprecationWarning = "kubevirt.io/v1 VirtualMachineInstancePresets is now deprecated and will be removed in v2."
@@ -109,6 +111,12 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 			return webhookutils.ToAdmissionResponseError(err)
 		}
 
+		if mutator.ClusterConfig.OvercommitProfilesEnabled() {
+			if err := applyOvercommitProfile(newVMI, mutator.OvercommitProfileInformer, mutator.NamespaceInformer); err != nil {
+				return webhookutils.ToAdmissionResponseError(err)
+			}
+		}
+
 		// Add foreground finalizer
 		newVMI.Finalizers = append(newVMI.Finalizers, v1.VirtualMachineInstanceFinalizer)
 
@@ -38,6 +38,7 @@ import (
 	"kubevirt.io/client-go/api"
 
 	v1 "kubevirt.io/api/core/v1"
+	overcommitv1 "kubevirt.io/api/overcommit/v1alpha1"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/libvmi"
@@ -940,6 +941,17 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 			}, "LUN", v1.DiskBusVirtio),
 	)
 
+	It("should default the disk bus to virtio on the microvm machine type", func() {
+		vmi.Spec.Domain.Machine = &v1.Machine{Type: "microvm"}
+		vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks,
+			v1.Disk{Name: "a"},
+			v1.Disk{Name: "b", DiskDevice: v1.DiskDevice{CDRom: &v1.CDRomTarget{}}},
+		)
+		_, vmiSpec, _ := getMetaSpecStatusFromAdmitWithArch("amd64")
+		Expect(vmiSpec.Domain.Devices.Disks[0].Disk.Bus).To(Equal(v1.DiskBusVirtio))
+		Expect(vmiSpec.Domain.Devices.Disks[1].CDRom.Bus).To(Equal(v1.DiskBusVirtio))
+	})
+
 	var (
 		vmxFeature = v1.CPUFeature{
 			Name:   nodelabellerutil.VmxFeature,
@@ -1112,6 +1124,95 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 		Expect(status.RuntimeUser).NotTo(BeZero())
 	})
 
+	Context("with overcommit profiles", func() {
+		var profileInformer cache.SharedIndexInformer
+
+		newProfile := func(name string, namespaceSelector map[string]string, cpuAllocationRatio, memoryOvercommit uint32) *overcommitv1.OvercommitProfile {
+			return &overcommitv1.OvercommitProfile{
+				ObjectMeta: k8smetav1.ObjectMeta{Name: name},
+				Spec: overcommitv1.OvercommitProfileSpec{
+					NamespaceSelector:  namespaceSelector,
+					CPUAllocationRatio: pointer.P(cpuAllocationRatio),
+					MemoryOvercommit:   pointer.P(memoryOvercommit),
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{featuregate.OvercommitProfiles},
+						},
+					},
+				},
+			})
+
+			namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+			Expect(namespaceInformer.GetStore().Add(&k8sv1.Namespace{
+				ObjectMeta: k8smetav1.ObjectMeta{Name: "dev", Labels: map[string]string{"tier": "dev", "team": "a"}},
+			})).To(Succeed())
+			profileInformer, _ = testutils.NewFakeInformerFor(&overcommitv1.OvercommitProfile{})
+			mutator.NamespaceInformer = namespaceInformer
+			mutator.OvercommitProfileInformer = profileInformer
+
+			vmi.Namespace = "dev"
+			vmi.Spec.Domain.CPU = &v1.CPU{Sockets: 4, Cores: 1, Threads: 1}
+			vmi.Spec.Domain.Memory = &v1.Memory{Guest: pointer.P(resource.MustParse("2Gi"))}
+		})
+
+		It("should set the requests from the most specific matching profile", func() {
+			Expect(profileInformer.GetStore().Add(newProfile("all", nil, 2, 100))).To(Succeed())
+			Expect(profileInformer.GetStore().Add(newProfile("dev-b", map[string]string{"tier": "dev"}, 4, 150))).To(Succeed())
+			Expect(profileInformer.GetStore().Add(newProfile("dev-a", map[string]string{"tier": "dev"}, 8, 200))).To(Succeed())
+			Expect(profileInformer.GetStore().Add(newProfile("prod", map[string]string{"tier": "prod"}, 1, 100))).To(Succeed())
+
+			_, spec, _ := getMetaSpecStatusFromAdmit()
+			Expect(spec.Domain.Resources.Requests.Cpu().String()).To(Equal("500m"))
+			Expect(spec.Domain.Resources.Requests.Memory().String()).To(Equal("1Gi"))
+		})
+
+		It("should keep the requests and limits set on the VMI", func() {
+			Expect(profileInformer.GetStore().Add(newProfile("dev", map[string]string{"tier": "dev"}, 4, 200))).To(Succeed())
+			vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("2Gi")}
+			vmi.Spec.Domain.Resources.Limits = k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("4")}
+
+			_, spec, _ := getMetaSpecStatusFromAdmit()
+			Expect(spec.Domain.Resources.Requests.Cpu().String()).To(Equal("4"))
+			Expect(spec.Domain.Resources.Requests.Memory().String()).To(Equal("2Gi"))
+		})
+
+		It("should not set a CPU request for dedicated CPUs", func() {
+			Expect(profileInformer.GetStore().Add(newProfile("dev", map[string]string{"tier": "dev"}, 4, 100))).To(Succeed())
+			vmi.Spec.Domain.CPU.DedicatedCPUPlacement = true
+
+			_, spec, _ := getMetaSpecStatusFromAdmit()
+			Expect(spec.Domain.Resources.Requests).ToNot(HaveKey(k8sv1.ResourceCPU))
+		})
+
+		It("should not set requests without a matching profile", func() {
+			Expect(profileInformer.GetStore().Add(newProfile("prod", map[string]string{"tier": "prod"}, 4, 200))).To(Succeed())
+
+			_, spec, _ := getMetaSpecStatusFromAdmit()
+			Expect(spec.Domain.Resources.Requests).To(BeEmpty())
+		})
+	})
+
+	DescribeTable("should default the termination grace period from the shutdown ladder", func(gracePeriod *int64, expectedGracePeriod int64) {
+		vmi.Spec.TerminationGracePeriodSeconds = gracePeriod
+		vmi.Spec.ShutdownLadder = &v1.ShutdownLadder{Steps: []v1.ShutdownStep{
+			{Method: v1.ShutdownMethodGuestAgent, TimeoutSeconds: 30},
+			{Method: v1.ShutdownMethodACPI, TimeoutSeconds: 60},
+		}}
+
+		_, vmiSpec, _ := getMetaSpecStatusFromAdmit()
+		Expect(vmiSpec.TerminationGracePeriodSeconds).To(HaveValue(Equal(expectedGracePeriod)))
+	},
+		Entry("when the grace period is not set", nil, int64(90)),
+		Entry("but keep the grace period set by the user", pointer.P(int64(180)), int64(180)),
+	)
+
 	DescribeTable("evictionStrategy should match the", func(f func(*v1.VirtualMachineInstanceSpec) v1.EvictionStrategy) {
 		expected := f(&vmi.Spec)
 		_, vmiSpec, _ := getMetaSpecStatusFromAdmit()
@@ -1157,6 +1258,22 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 		}),
 	)
 
+	It("should apply the NTP servers of the cluster time policy", func() {
+		kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+		kvCR.Spec.Configuration.GuestTime = &v1.GuestTimeConfiguration{NTPServers: []string{"10.0.0.1"}}
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+		vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+			Name:         "cloudinit",
+			VolumeSource: v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: "fake"}},
+		})
+
+		vmiMeta, vmiSpec, _ := getMetaSpecStatusFromAdmit()
+		Expect(vmiSpec.Domain.Devices.Interfaces).ToNot(BeEmpty())
+		Expect(vmiSpec.Domain.Devices.Interfaces[0].DHCPOptions).ToNot(BeNil())
+		Expect(vmiSpec.Domain.Devices.Interfaces[0].DHCPOptions.NTPServers).To(ConsistOf("10.0.0.1"))
+		Expect(vmiMeta.Annotations).To(HaveKeyWithValue(v1.GuestNTPServersAnnotation, "10.0.0.1"))
+	})
+
 	It("should set guest memory status on VMI creation", func() {
 		memory := resource.MustParse("128Mi")
 		vmi.Spec.Domain.Memory = &v1.Memory{
@@ -0,0 +1,101 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package webhooks
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
+)
+
+type pciAddressRequest struct {
+	kind    string
+	name    *k8sfield.Path
+	field   *k8sfield.Path
+	address string
+	owner   string
+}
+
+// ValidatePCIAddressConflicts rejects the disks and interfaces requesting the PCI address of another device,
+// which would otherwise only fail when the domain is defined, and suggests the next free slot on the same bus
+func ValidatePCIAddressConflicts(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var requests []pciAddressRequest
+	for idx, disk := range spec.Domain.Devices.Disks {
+		if disk.Disk == nil || disk.Disk.PciAddress == "" {
+			continue
+		}
+		diskField := field.Child("domain", "devices", "disks").Index(idx)
+		requests = append(requests, pciAddressRequest{
+			kind:    "disk",
+			name:    diskField.Child("name"),
+			field:   diskField.Child("disk", "pciAddress"),
+			address: disk.Disk.PciAddress,
+		})
+	}
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.PciAddress == "" {
+			continue
+		}
+		ifaceField := field.Child("domain", "devices", "interfaces").Index(idx)
+		requests = append(requests, pciAddressRequest{
+			kind:    "interface",
+			name:    ifaceField.Child("name"),
+			field:   ifaceField.Child("pciAddress"),
+			address: iface.PciAddress,
+		})
+	}
+
+	allocator := device.NewPciAddressAllocator()
+	var conflicts []pciAddressRequest
+	for _, request := range requests {
+		// malformed addresses are reported by the disk and interface validation
+		owner, err := allocator.Reserve(request.address, request.name.String())
+		if err != nil {
+			continue
+		}
+		if owner != "" {
+			request.owner = owner
+			conflicts = append(conflicts, request)
+		}
+	}
+
+	var causes []metav1.StatusCause
+	for _, conflict := range conflicts {
+		message := fmt.Sprintf("%s %s PCI address %s conflicts with %s",
+			conflict.kind, conflict.name.String(), conflict.address, conflict.owner)
+		// the suggested slots are reserved so that every conflicting device gets a distinct one
+		if freeSlot, err := allocator.NextFreeSlot(conflict.address); err == nil && freeSlot != "" {
+			if _, err := allocator.Reserve(freeSlot, conflict.name.String()); err == nil {
+				message += fmt.Sprintf(", the next free slot is %s", freeSlot)
+			}
+		}
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueDuplicate,
+			Message: message,
+			Field:   conflict.field.String(),
+		})
+	}
+	return causes
+}
@@ -21,7 +21,6 @@ package webhooks
 
 import (
 	"fmt"
-	"slices"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
@@ -37,24 +36,38 @@ func ValidateVirtualMachineInstanceS390XSetting(field *k8sfield.Path, spec *v1.V
 	var statusCauses []metav1.StatusCause
 	validateWatchdogS390x(field, spec, &statusCauses)
 	validateVideoTypeS390x(field, spec, &statusCauses)
+	validatePTPClockS390x(field, spec, &statusCauses)
+	validateSerialConsoleTargetTypeS390x(field, spec, &statusCauses)
 	return statusCauses
 }
 
-func validateVideoTypeS390x(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
-	if spec.Domain.Devices.Video == nil {
+func validateSerialConsoleTargetTypeS390x(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	if spec.Domain.Devices.SerialConsoleTargetType == "" {
 		return
 	}
 
-	videoType := spec.Domain.Devices.Video.Type
+	*statusCauses = append(*statusCauses, metav1.StatusCause{
+		Type:    metav1.CauseTypeFieldValueNotSupported,
+		Message: "selecting the serial console target type is not supported on s390x architecture",
+		Field:   field.Child("domain", "devices", "serialConsoleTargetType").String(),
+	})
+}
 
-	validTypes := []string{"virtio"}
-	if !slices.Contains(validTypes, videoType) {
-		*statusCauses = append(*statusCauses, metav1.StatusCause{
-			Type:    metav1.CauseTypeFieldValueNotSupported,
-			Message: fmt.Sprintf("video model '%s' is not supported on s390x architecture", videoType),
-			Field:   field.Child("domain", "devices", "video").Child("type").String(),
-		})
+func validatePTPClockS390x(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	if spec.Domain.Clock == nil || spec.Domain.Clock.PTP == nil {
+		return
 	}
+
+	*statusCauses = append(*statusCauses, metav1.StatusCause{
+		Type:    metav1.CauseTypeFieldValueNotSupported,
+		Message: "PTP clock is not supported on s390x architecture",
+		Field:   field.Child("domain", "clock", "ptp").String(),
+	})
+}
+
+func validateVideoTypeS390x(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	validTypes := []string{"virtio"}
+	validateVideoTypes(field, spec, "s390x", validTypes, statusCauses)
 }
 
 func validateWatchdogS390x(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
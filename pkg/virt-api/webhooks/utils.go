@@ -20,7 +20,11 @@
 package webhooks
 
 import (
+	"fmt"
+	"slices"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -64,9 +68,31 @@ var MigrationGroupVersionResource = metav1.GroupVersionResource{
 }
 
 type Informers struct {
-	VMIPresetInformer  cache.SharedIndexInformer
-	VMRestoreInformer  cache.SharedIndexInformer
-	VMBackupInformer   cache.SharedIndexInformer
-	DataSourceInformer cache.SharedIndexInformer
-	NamespaceInformer  cache.SharedIndexInformer
+	VMIPresetInformer           cache.SharedIndexInformer
+	VMRestoreInformer           cache.SharedIndexInformer
+	VMBackupInformer            cache.SharedIndexInformer
+	DataSourceInformer          cache.SharedIndexInformer
+	NamespaceInformer           cache.SharedIndexInformer
+	OvercommitProfileInformer   cache.SharedIndexInformer
+	VirtualizationQuotaInformer cache.SharedIndexInformer
+}
+
+// validateVideoTypes rejects the video devices whose type is not supported on the architecture.
+func validateVideoTypes(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, arch string, validTypes []string, statusCauses *[]metav1.StatusCause) {
+	validate := func(videoField *k8sfield.Path, videoType string) {
+		if !slices.Contains(validTypes, videoType) {
+			*statusCauses = append(*statusCauses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("video model '%s' is not supported on %s architecture", videoType, arch),
+				Field:   videoField.Child("type").String(),
+			})
+		}
+	}
+
+	if spec.Domain.Devices.Video != nil {
+		validate(field.Child("domain", "devices", "video"), spec.Domain.Devices.Video.Type)
+	}
+	for i, video := range spec.Domain.Devices.Videos {
+		validate(field.Child("domain", "devices", "videos").Index(i), video.Type)
+	}
 }
@@ -25,8 +25,11 @@ import (
 	"fmt"
 	"net"
 	"path/filepath"
+	"regexp"
 	"slices"
+	"strconv"
 	"strings"
+	"unicode"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	k8sv1 "k8s.io/api/core/v1"
@@ -34,6 +37,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
 
@@ -42,9 +46,13 @@ import (
 	"kubevirt.io/kubevirt/pkg/hooks"
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/quota"
 	storageadmitters "kubevirt.io/kubevirt/pkg/storage/admitters"
+	"kubevirt.io/kubevirt/pkg/storage/hostblockdevice"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/tpm"
+	"kubevirt.io/kubevirt/pkg/util"
 
 	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
@@ -71,7 +79,21 @@ const (
 
 var validIOThreadsPolicies = []v1.IOThreadsPolicy{v1.IOThreadsPolicyShared, v1.IOThreadsPolicyAuto, v1.IOThreadsPolicySupplementalPool}
 var validCPUFeaturePolicies = map[string]*struct{}{"": nil, "force": nil, "require": nil, "optional": nil, "disable": nil, "forbid": nil}
-var validPanicDeviceModels = []v1.PanicDeviceModel{v1.Hyperv, v1.Isa, v1.Pvpanic}
+var validPanicDeviceModels = []v1.PanicDeviceModel{v1.Hyperv, v1.Isa, v1.Pvpanic, v1.PvpanicPCI}
+
+// channelNameMaxLen keeps the channel socket path within the unix socket path length limit
+const channelNameMaxLen = 64
+
+var validChannelName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+var reservedChannelNames = []string{"org.qemu.guest_agent.0", downwardmetrics.DownwardMetricsSerialDeviceName}
+
+// serialNameMaxLen keeps the serial port alias within the libvirt alias length limit
+const serialNameMaxLen = 32
+
+// maxSerialPorts is the number of ISA serial ports, COM1 to COM4, emulated by QEMU
+const maxSerialPorts = 4
+
+var validSerialName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
 
 var restrictedVmiLabels = map[string]bool{
 	v1.CreatedByLabel:               true,
@@ -93,9 +115,10 @@ var invalidPanicDeviceModelErrFmt = "invalid PanicDeviceModel(%s)"
 type SpecValidator func(*k8sfield.Path, *v1.VirtualMachineInstanceSpec, *virtconfig.ClusterConfig) []metav1.StatusCause
 
 type VMICreateAdmitter struct {
-	ClusterConfig           *virtconfig.ClusterConfig
-	SpecValidators          []SpecValidator
-	KubeVirtServiceAccounts map[string]struct{}
+	ClusterConfig               *virtconfig.ClusterConfig
+	SpecValidators              []SpecValidator
+	KubeVirtServiceAccounts     map[string]struct{}
+	VirtualizationQuotaInformer cache.SharedIndexInformer
 }
 
 func (admitter *VMICreateAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
@@ -127,13 +150,28 @@ func (admitter *VMICreateAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 	causes = append(causes, ValidateVirtualMachineInstanceMetadata(k8sfield.NewPath("metadata"), &vmi.ObjectMeta, admitter.ClusterConfig, isKubeVirtServiceAccount)...)
 	causes = append(causes, webhooks.ValidateVirtualMachineInstanceHyperv(k8sfield.NewPath("spec").Child("domain").Child("features").Child("hyperv"), &vmi.Spec)...)
 	causes = append(causes, ValidateVirtualMachineInstancePerArch(k8sfield.NewPath("spec"), &vmi.Spec)...)
+	causes = append(causes, validatePanicDeviceModelsPerArch(k8sfield.NewPath("spec"), &vmi.Spec, admitter.ClusterConfig)...)
+	causes = append(causes, validateHostSocketNamespaces(k8sfield.NewPath("spec"), &vmi.Spec, ar.Request.Namespace, admitter.ClusterConfig)...)
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	if admitter.ClusterConfig.VirtualizationQuotasEnabled() && admitter.VirtualizationQuotaInformer != nil {
+		namespace := ar.Request.Namespace
+		if namespace == "" {
+			namespace = vmi.Namespace
+		}
+		if causes := validateVirtualizationQuotas(quota.Usage(vmi), namespace, admitter.VirtualizationQuotaInformer); len(causes) > 0 {
+			return webhookutils.ToAdmissionResponse(causes)
+		}
+	}
+
+	warnings := warnDeprecatedAPIs(&vmi.Spec, admitter.ClusterConfig)
+	warnings = append(warnings, warnPS2InputDevices(k8sfield.NewPath("spec"), &vmi.Spec)...)
+
 	return &admissionv1.AdmissionResponse{
 		Allowed:  true,
-		Warnings: warnDeprecatedAPIs(&vmi.Spec, admitter.ClusterConfig),
+		Warnings: warnings,
 	}
 }
 
@@ -167,8 +205,10 @@ func ValidateVirtualMachineInstancePerArch(field *k8sfield.Path, spec *v1.Virtua
 			Message: fmt.Sprintf("unsupported architecture: %s", arch),
 			Field:   field.Child("architecture").String(),
 		})
+		return causes
 	}
 
+	causes = append(causes, webhooks.ValidateDeviceLimits(field, spec)...)
 	return causes
 }
 
@@ -180,9 +220,11 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateMemoryRequestsNegativeOrNull(field, spec)...)
 	causes = append(causes, validateMemoryLimitsNegativeOrNull(field, spec)...)
 	causes = append(causes, validateHugepagesMemoryRequests(field, spec)...)
+	causes = append(causes, validateHugepagesNUMAPolicy(field, spec)...)
 	causes = append(causes, validateGuestMemoryLimit(field, spec, config)...)
 	causes = append(causes, validateEmulatedMachine(field, spec, config)...)
 	causes = append(causes, validateFirmwareACPI(field.Child("acpi"), spec)...)
+	causes = append(causes, validateFirmwareFWCfg(field.Child("domain", "firmware", "fwCfg"), spec)...)
 	causes = append(causes, validateCPURequestNotNegative(field, spec)...)
 	causes = append(causes, validateCPULimitNotNegative(field, spec)...)
 	causes = append(causes, validateCpuRequestDoesNotExceedLimit(field, spec)...)
@@ -190,6 +232,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateNUMA(field, spec, config)...)
 	causes = append(causes, validateCPUIsolatorThread(field, spec)...)
 	causes = append(causes, validateCPUFeaturePolicies(field, spec)...)
+	causes = append(causes, validateCPUCache(field, spec)...)
 	causes = append(causes, validateCPUHotplug(field, spec)...)
 	causes = append(causes, validateStartStrategy(field, spec)...)
 	causes = append(causes, validateRealtime(field, spec)...)
@@ -202,10 +245,15 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, draadmitter.ValidateCreation(field, spec, config)...)
 
 	causes = append(causes, validateBootOrder(field, spec, config)...)
+	causes = append(causes, webhooks.ValidatePCIAddressConflicts(field, spec)...)
 
-	causes = append(causes, validateInputDevices(field, spec)...)
+	causes = append(causes, validateInputDevices(field, spec, config)...)
 
 	causes = append(causes, validateIOThreadsPolicy(field, spec)...)
+	causes = append(causes, validateIOThreadsPinning(field, spec)...)
+	causes = append(causes, validateTPM(field.Child("domain", "devices", "tpm"), spec, config)...)
+	causes = append(causes, validateSGX(field, spec, config)...)
+	causes = append(causes, validateShutdownLadder(field.Child("shutdownLadder"), spec)...)
 	causes = append(causes, validateProbe(field.Child("readinessProbe"), spec.ReadinessProbe)...)
 	causes = append(causes, validateProbe(field.Child("livenessProbe"), spec.LivenessProbe)...)
 
@@ -217,9 +265,13 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateDomainSpec(field.Child("domain"), &spec.Domain)...)
 	causes = append(causes, validateVolumes(field.Child("volumes"), spec.Volumes, config)...)
 	causes = append(causes, storageadmitters.ValidateContainerDisks(field, spec)...)
+	causes = append(causes, storageadmitters.ValidateVhostUserBlkDisks(field, spec)...)
+	causes = append(causes, storageadmitters.ValidateHostBlockDeviceDisks(field, spec)...)
+	causes = append(causes, storageadmitters.ValidateCCWAddresses(field, spec)...)
 	causes = append(causes, storageadmitters.ValidateUtilityVolumesNotPresentOnCreation(field, spec)...)
 
 	causes = append(causes, validateAccessCredentials(field.Child("accessCredentials"), spec.AccessCredentials, spec.Volumes)...)
+	causes = append(causes, validateGuestAgent(field, spec)...)
 
 	if spec.DNSPolicy != "" {
 		causes = append(causes, validateDNSPolicy(&spec.DNSPolicy, field.Child("dnsPolicy"))...)
@@ -234,8 +286,14 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validatePersistentReservation(field, spec, config)...)
 	causes = append(causes, validateDownwardMetrics(field, spec, config)...)
 	causes = append(causes, validateFilesystemsWithVirtIOFSEnabled(field, spec, config)...)
+	causes = append(causes, validateVirtiofsOptions(field, spec)...)
 	causes = append(causes, validateVideoConfig(field, spec, config)...)
 	causes = append(causes, validatePanicDevices(field, spec, config)...)
+	causes = append(causes, validateChannels(field, spec)...)
+	causes = append(causes, validateSerials(field, spec)...)
+	causes = append(causes, validateVirtioTransitionalDevices(field, spec)...)
+	causes = append(causes, validateBalloon(field, spec)...)
+	causes = append(causes, validateMicroVM(field, spec, config)...)
 
 	return causes
 }
@@ -265,6 +323,42 @@ func validateFilesystemsWithVirtIOFSEnabled(field *k8sfield.Path, spec *v1.Virtu
 	return causes
 }
 
+func validateVirtiofsOptions(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	for idx, fs := range spec.Domain.Devices.Filesystems {
+		if fs.Virtiofs == nil {
+			continue
+		}
+		virtiofsField := field.Child("domain", "devices", "filesystems").Index(idx).Child("virtiofs")
+
+		switch fs.Virtiofs.Cache {
+		case "", v1.VirtiofsCacheAuto, v1.VirtiofsCacheAlways, v1.VirtiofsCacheMetadata, v1.VirtiofsCacheNever:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s is not supported: %s (allowed values: %v)",
+					virtiofsField.Child("cache").String(),
+					fs.Virtiofs.Cache,
+					[]v1.VirtiofsCacheMode{v1.VirtiofsCacheAuto, v1.VirtiofsCacheAlways, v1.VirtiofsCacheMetadata, v1.VirtiofsCacheNever},
+				),
+				Field: virtiofsField.Child("cache").String(),
+			})
+		}
+
+		if queueSize := fs.Virtiofs.QueueSize; queueSize != nil && !hwutil.IsValidVirtqueueSize(*queueSize, hwutil.MinVirtqueueSize) {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be a power of 2 between %d and %d",
+					virtiofsField.Child("queueSize").String(), hwutil.MinVirtqueueSize, hwutil.MaxVirtqueueSize),
+				Field: virtiofsField.Child("queueSize").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
 func validateDownwardMetrics(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
@@ -337,13 +431,19 @@ func validateInterfaceBootOrder(field *k8sfield.Path, spec *v1.VirtualMachineIns
 	return causes
 }
 
-func validateInputDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
+func validateInputDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
 	for idx, input := range spec.Domain.Devices.Inputs {
+		inputField := field.Child("domain", "devices", "inputs").Index(idx)
+		if input.Bus == v1.InputBusPS2 {
+			causes = append(causes, validatePS2InputDevice(field, inputField, spec, input, config)...)
+			continue
+		}
+
 		if input.Bus != v1.InputBusVirtio && input.Bus != v1.InputBusUSB && input.Bus != "" {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
-				Message: "Input device can have only virtio or usb bus.",
-				Field:   field.Child("domain", "devices", "inputs").Index(idx).Child("bus").String(),
+				Message: "Input device can have only virtio, usb or ps2 bus.",
+				Field:   inputField.Child("bus").String(),
 			})
 		}
 
@@ -351,13 +451,52 @@ func validateInputDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
 				Message: "Input device can have only tablet type.",
-				Field:   field.Child("domain", "devices", "inputs").Index(idx).Child("type").String(),
+				Field:   inputField.Child("type").String(),
 			})
 		}
 	}
 	return causes
 }
 
+func validatePS2InputDevice(field, inputField *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, input v1.Input, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	if !config.PS2InputEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled", featuregate.PS2InputGate),
+			Field:   inputField.Child("bus").String(),
+		})
+	}
+	// The i8042 controller serving the ps2 bus is only emulated on x86
+	if spec.Architecture != "" && spec.Architecture != "amd64" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("No ps2 input support for architecture: %s", spec.Architecture),
+			Field:   field.Child("architecture").String(),
+		})
+	}
+	if input.Type != v1.InputTypeKeyboard && input.Type != v1.InputTypeMouse {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Input device on the ps2 bus can have only keyboard or mouse type.",
+			Field:   inputField.Child("type").String(),
+		})
+	}
+	return causes
+}
+
+// warnPS2InputDevices warns about the limitations of ps2 input devices, which sit on the
+// fixed i8042 controller of the machine instead of a hotpluggable bus
+func warnPS2InputDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []string {
+	var warnings []string
+	for idx, input := range spec.Domain.Devices.Inputs {
+		if input.Bus == v1.InputBusPS2 {
+			warnings = append(warnings, fmt.Sprintf("%s uses the ps2 bus, ps2 input devices can neither be hotplugged nor unplugged.",
+				field.Child("domain", "devices", "inputs").Index(idx).String()))
+		}
+	}
+	return warnings
+}
+
 func validateIOThreadsPolicy(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if spec.Domain.IOThreadsPolicy == nil {
@@ -392,6 +531,96 @@ func validateIOThreadsPolicy(field *k8sfield.Path, spec *v1.VirtualMachineInstan
 	return causes
 }
 
+func validateTPM(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	if !tpm.HasDevice(spec) {
+		return nil
+	}
+	var causes []metav1.StatusCause
+	device := spec.Domain.Devices.TPM
+
+	switch device.Version {
+	case "", v1.TPMVersion12, v1.TPMVersion20:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s must be one of %s, %s", field.Child("version").String(), v1.TPMVersion12, v1.TPMVersion20),
+			Field:   field.Child("version").String(),
+		})
+	}
+
+	if device.External == nil {
+		return causes
+	}
+	if !config.ExternalTPMEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled", featuregate.ExternalTPMGate),
+			Field:   field.Child("external").String(),
+		})
+	}
+	if !filepath.IsAbs(device.External.SocketPath) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be an absolute path to the socket of the swtpm", field.Child("external", "socketPath").String()),
+			Field:   field.Child("external", "socketPath").String(),
+		})
+	} else if _, ok := util.SocketPathNamespace(device.External.SocketPath, config.GetExternalTPMSockets()); !ok {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s '%s' is not in a permitted swtpm socket directory", field.Child("external", "socketPath").String(), device.External.SocketPath),
+			Field:   field.Child("external", "socketPath").String(),
+		})
+	}
+	// the version and the state of the vTPM are owned by the external swtpm
+	if device.Version != "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s cannot be set with an external swtpm", field.Child("version").String()),
+			Field:   field.Child("version").String(),
+		})
+	}
+	if tpm.HasPersistentDevice(spec) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s cannot be set with an external swtpm", field.Child("persistent").String()),
+			Field:   field.Child("persistent").String(),
+		})
+	}
+	return causes
+}
+
+func validateIOThreadsPinning(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.Domain.IOThreads == nil || len(spec.Domain.IOThreads.Pinning) == 0 {
+		return causes
+	}
+	pinningField := field.Child("domain", "ioThreads", "pinning")
+	if spec.Domain.CPU == nil || !spec.Domain.CPU.DedicatedCPUPlacement {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires dedicatedCpuPlacement", pinningField.String()),
+			Field:   pinningField.String(),
+		})
+	}
+	for id, cpus := range spec.Domain.IOThreads.Pinning {
+		if thread, err := strconv.Atoi(id); err != nil || strconv.Itoa(thread) != id || thread < 1 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is not a valid iothread ID, it must be a positive integer", id),
+				Field:   pinningField.Key(id).String(),
+			})
+		}
+		if _, err := hwutil.ParseCPUSetLine(cpus, 50000); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is not a valid cpuset: %v", cpus, err),
+				Field:   pinningField.Key(id).String(),
+			})
+		}
+	}
+	return causes
+}
+
 func validateProbe(field *k8sfield.Path, probe *v1.Probe) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if probe == nil {
@@ -546,6 +775,170 @@ func validateSoundDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 	return causes
 }
 
+func validateSGX(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	sgx := spec.Domain.Devices.SGX
+	if sgx == nil {
+		return nil
+	}
+	var causes []metav1.StatusCause
+	sgxField := field.Child("domain", "devices", "sgx")
+
+	if !config.SGXEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled", featuregate.SGXGate),
+			Field:   sgxField.String(),
+		})
+	}
+	if spec.Architecture != "" && spec.Architecture != "amd64" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("No SGX support for architecture: %s", spec.Architecture),
+			Field:   field.Child("architecture").String(),
+		})
+	}
+	if sgx.EPCSize.Sign() <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater than zero", sgxField.Child("epcSize").String()),
+			Field:   sgxField.Child("epcSize").String(),
+		})
+	}
+	// The EPC and virtio-mem both need the single memory device of the domain
+	if spec.Domain.Memory != nil && spec.Domain.Memory.MaxGuest != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s cannot be combined with memory hotplug", sgxField.String()),
+			Field:   sgxField.String(),
+		})
+	}
+	return causes
+}
+
+func validateShutdownLadder(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	ladder := spec.ShutdownLadder
+	if ladder == nil {
+		return nil
+	}
+	if len(ladder.Steps) == 0 {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must contain at least one step", field.Child("steps").String()),
+			Field:   field.Child("steps").String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	for i, step := range ladder.Steps {
+		stepField := field.Child("steps").Index(i)
+		switch step.Method {
+		case v1.ShutdownMethodGuestAgent, v1.ShutdownMethodACPI:
+		default:
+			// Destroying the domain is always the implicit last step
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s must be one of %s, %s", stepField.Child("method").String(), v1.ShutdownMethodGuestAgent, v1.ShutdownMethodACPI),
+				Field:   stepField.Child("method").String(),
+			})
+		}
+		if step.TimeoutSeconds <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be greater than zero", stepField.Child("timeoutSeconds").String()),
+				Field:   stepField.Child("timeoutSeconds").String(),
+			})
+		}
+	}
+	return causes
+}
+
+// guestAgentCommandDependencies are the guest agent commands KubeVirt executes together with an allowed command,
+// e.g. the filesystems are thawed and their freeze status is read around a freeze
+var guestAgentCommandDependencies = map[string][]string{
+	"guest-fsfreeze-freeze":      {"guest-fsfreeze-thaw", "guest-fsfreeze-status"},
+	"guest-fsfreeze-freeze-list": {"guest-fsfreeze-thaw", "guest-fsfreeze-status"},
+	"guest-fsfreeze-thaw":        {"guest-fsfreeze-status"},
+	"guest-exec":                 {"guest-exec-status"},
+}
+
+func validateGuestAgent(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	if spec.GuestAgent == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	commandsField := field.Child("guestAgent", "allowedCommands")
+	allowed := map[string]bool{}
+	for i, command := range spec.GuestAgent.AllowedCommands {
+		if !strings.HasPrefix(command, "guest-") {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is not a guest agent command", commandsField.Index(i).String()),
+				Field:   commandsField.Index(i).String(),
+			})
+		}
+		allowed[command] = true
+	}
+
+	requireCommands := func(requiredBy *k8sfield.Path, commands ...string) {
+		for _, command := range commands {
+			if !allowed[command] {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s requires the %s guest agent command to be allowed in %s", requiredBy.String(), command, commandsField.String()),
+					Field:   commandsField.String(),
+				})
+			}
+		}
+	}
+
+	for i, command := range spec.GuestAgent.AllowedCommands {
+		requireCommands(commandsField.Index(i), guestAgentCommandDependencies[command]...)
+	}
+
+	// Exec probes run their command in the guest through the guest agent
+	if spec.ReadinessProbe != nil && spec.ReadinessProbe.Exec != nil {
+		requireCommands(field.Child("readinessProbe", "exec"), "guest-exec", "guest-exec-status")
+	}
+	if spec.LivenessProbe != nil && spec.LivenessProbe.Exec != nil {
+		requireCommands(field.Child("livenessProbe", "exec"), "guest-exec", "guest-exec-status")
+	}
+
+	for idx, accessCred := range spec.AccessCredentials {
+		credField := field.Child("accessCredentials").Index(idx)
+		if accessCred.UserPassword != nil && accessCred.UserPassword.PropagationMethod.QemuGuestAgent != nil {
+			requireCommands(credField.Child("userPassword", "propagationMethod", "qemuGuestAgent"), "guest-set-user-password")
+		}
+		if accessCred.SSHPublicKey != nil && accessCred.SSHPublicKey.PropagationMethod.QemuGuestAgent != nil {
+			// The keys are written with the guest-ssh commands, or with the file commands on older agents
+			sshCommands := []string{"guest-ssh-get-authorized-keys", "guest-ssh-add-authorized-keys", "guest-ssh-remove-authorized-keys"}
+			fileCommands := []string{"guest-exec", "guest-exec-status", "guest-file-open", "guest-file-close", "guest-file-read", "guest-file-write"}
+			if !allCommandsAllowed(allowed, fileCommands) {
+				requireCommands(credField.Child("sshPublicKey", "propagationMethod", "qemuGuestAgent"), sshCommands...)
+			}
+		}
+	}
+
+	if spec.ShutdownLadder != nil {
+		for i, step := range spec.ShutdownLadder.Steps {
+			if step.Method == v1.ShutdownMethodGuestAgent {
+				requireCommands(field.Child("shutdownLadder", "steps").Index(i), "guest-shutdown")
+			}
+		}
+	}
+
+	return causes
+}
+
+func allCommandsAllowed(allowed map[string]bool, commands []string) bool {
+	for _, command := range commands {
+		if !allowed[command] {
+			return false
+		}
+	}
+	return true
+}
+
 func validateLaunchSecurity(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	launchSecurity := spec.Domain.LaunchSecurity
@@ -663,6 +1056,55 @@ func validateCPUFeaturePolicies(field *k8sfield.Path, spec *v1.VirtualMachineIns
 	return causes
 }
 
+func validateCPUCache(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	if spec.Domain.CPU == nil || spec.Domain.CPU.Cache == nil {
+		return nil
+	}
+	cache := spec.Domain.CPU.Cache
+	cacheField := field.Child("domain", "cpu", "cache")
+
+	var causes []metav1.StatusCause
+	switch cache.Mode {
+	case v1.CPUCacheModePassthrough:
+		if spec.Domain.CPU.Model != v1.CPUModeHostPassthrough {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("CPU cache mode %s is only supported with the %s CPU model", cache.Mode, v1.CPUModeHostPassthrough),
+				Field:   cacheField.Child("mode").String(),
+			})
+		}
+		if cache.Level != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("CPU cache mode %s does not support setting a cache level", cache.Mode),
+				Field:   cacheField.Child("level").String(),
+			})
+		}
+	case v1.CPUCacheModeEmulate, v1.CPUCacheModeDisable:
+		if cache.Mode == v1.CPUCacheModeEmulate && cache.Level == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("CPU cache mode %s requires the cache level to be set", cache.Mode),
+				Field:   cacheField.Child("level").String(),
+			})
+		}
+		if cache.Level != nil && *cache.Level != 3 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("CPU cache mode %s only supports cache level 3", cache.Mode),
+				Field:   cacheField.Child("level").String(),
+			})
+		}
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("CPU cache mode %s is not supported", cache.Mode),
+			Field:   cacheField.Child("mode").String(),
+		})
+	}
+	return causes
+}
+
 func validateCPUIsolatorThread(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if spec.Domain.CPU != nil && spec.Domain.CPU.IsolateEmulatorThread && !spec.Domain.CPU.DedicatedCPUPlacement {
@@ -723,6 +1165,108 @@ func validateNUMA(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, con
 			})
 		}
 	}
+	if spec.Domain.CPU != nil && spec.Domain.CPU.NUMA != nil && spec.Domain.CPU.NUMA.Manual != nil {
+		causes = append(causes, validateManualNUMA(field, spec, config)...)
+	}
+	return causes
+}
+
+func validateManualNUMA(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	manualField := field.Child("domain", "cpu", "numa", "manual")
+	invalid := func(field *k8sfield.Path, format string, args ...interface{}) []metav1.StatusCause {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf(format, args...),
+			Field:   field.String(),
+		}}
+	}
+
+	if !config.NUMAEnabled() {
+		return invalid(manualField, "NUMA feature gate is not enabled in kubevirt-config, invalid entry %s", manualField.String())
+	}
+	if spec.Domain.CPU.NUMA.GuestMappingPassthrough != nil {
+		return invalid(manualField, "%s and %s are mutually exclusive",
+			manualField.String(), field.Child("domain", "cpu", "numa", "guestMappingPassthrough").String())
+	}
+	nodes := spec.Domain.CPU.NUMA.Manual.Nodes
+	if len(nodes) == 0 {
+		return invalid(manualField.Child("nodes"), "%s must define at least one NUMA node", manualField.Child("nodes").String())
+	}
+
+	cpuSpec := *spec.Domain.CPU
+	if cpuSpec.MaxSockets != 0 {
+		cpuSpec.Sockets = cpuSpec.MaxSockets
+	}
+	vCPUs := int(hwutil.GetNumberOfVCPUs(&cpuSpec))
+	if vCPUs == 0 {
+		vCPUs = 1
+	}
+
+	var causes []metav1.StatusCause
+	assignedCPUs := map[int]struct{}{}
+	totalMemory := resource.NewQuantity(0, resource.BinarySI)
+	distances := map[[2]uint32]uint32{}
+	for i, node := range nodes {
+		nodeField := manualField.Child("nodes").Index(i)
+		cpus, err := hwutil.ParseCPUSetLine(node.CPUs, 50000)
+		if err != nil {
+			causes = append(causes, invalid(nodeField.Child("cpus"), "%s '%s' is not a valid CPU list: %v",
+				nodeField.Child("cpus").String(), node.CPUs, err)...)
+			continue
+		}
+		for _, cpu := range cpus {
+			if cpu >= vCPUs {
+				causes = append(causes, invalid(nodeField.Child("cpus"), "%s refers to vCPU %d, but the VMI only has %d vCPUs",
+					nodeField.Child("cpus").String(), cpu, vCPUs)...)
+			} else if _, assigned := assignedCPUs[cpu]; assigned {
+				causes = append(causes, invalid(nodeField.Child("cpus"), "%s assigns vCPU %d to more than one NUMA node",
+					nodeField.Child("cpus").String(), cpu)...)
+			}
+			assignedCPUs[cpu] = struct{}{}
+		}
+		if node.Memory.Sign() <= 0 {
+			causes = append(causes, invalid(nodeField.Child("memory"), "%s must be greater than zero",
+				nodeField.Child("memory").String())...)
+		}
+		totalMemory.Add(node.Memory)
+		for j, distance := range node.Distances {
+			distanceField := nodeField.Child("distances").Index(j)
+			if int(distance.Node) >= len(nodes) {
+				causes = append(causes, invalid(distanceField.Child("node"), "%s refers to the non-existing NUMA node %d",
+					distanceField.Child("node").String(), distance.Node)...)
+			}
+			if distance.Value < 10 || distance.Value > 255 {
+				causes = append(causes, invalid(distanceField.Child("value"), "%s must be between 10 and 255",
+					distanceField.Child("value").String())...)
+			} else if int(distance.Node) == i && distance.Value != 10 {
+				causes = append(causes, invalid(distanceField.Child("value"), "%s must be 10 for the distance of a NUMA node to itself",
+					distanceField.Child("value").String())...)
+			}
+			if _, exists := distances[[2]uint32{uint32(i), distance.Node}]; exists {
+				causes = append(causes, invalid(distanceField.Child("node"), "%s sets the distance to NUMA node %d more than once",
+					nodeField.Child("distances").String(), distance.Node)...)
+			} else if reverse, exists := distances[[2]uint32{distance.Node, uint32(i)}]; exists && reverse != distance.Value {
+				causes = append(causes, invalid(distanceField.Child("value"), "%s must be %d, the distance of NUMA node %d back to node %d",
+					distanceField.Child("value").String(), reverse, distance.Node, i)...)
+			}
+			distances[[2]uint32{uint32(i), distance.Node}] = distance.Value
+		}
+	}
+	if len(causes) == 0 && len(assignedCPUs) != vCPUs {
+		causes = append(causes, invalid(manualField.Child("nodes"), "%s must assign each of the %d vCPUs to a NUMA node",
+			manualField.Child("nodes").String(), vCPUs)...)
+	}
+
+	guestMemory := spec.Domain.Resources.Requests.Memory()
+	if spec.Domain.Memory != nil && spec.Domain.Memory.Guest != nil {
+		guestMemory = spec.Domain.Memory.Guest
+	} else if guestMemory.IsZero() {
+		guestMemory = spec.Domain.Resources.Limits.Memory()
+	}
+	if totalMemory.Cmp(*guestMemory) != 0 {
+		causes = append(causes, invalid(manualField.Child("nodes"), "the memory of the NUMA nodes in %s adds up to %s, but the guest memory is %s",
+			manualField.Child("nodes").String(), totalMemory.String(), guestMemory.String())...)
+	}
 	return causes
 }
 
@@ -939,10 +1483,11 @@ func validateEmulatedMachine(field *k8sfield.Path, spec *v1.VirtualMachineInstan
 	var causes []metav1.StatusCause
 	if machine := spec.Domain.Machine; machine != nil && len(machine.Type) > 0 {
 		supportedMachines := config.GetEmulatedMachines(spec.Architecture)
+		machineType := config.ResolveMachineType(spec.Architecture, machine.Type)
 		var match = false
 		for _, val := range supportedMachines {
 			// The pattern are hardcoded, so this should not throw an error
-			if ok, _ := filepath.Match(val, machine.Type); ok {
+			if ok, _ := filepath.Match(val, machineType); ok {
 				match = true
 				break
 			}
@@ -1039,8 +1584,45 @@ func validateHugepagesMemoryRequests(field *k8sfield.Path, spec *v1.VirtualMachi
 	return causes
 }
 
-func validateMemoryLimitsNegativeOrNull(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
-	var causes []metav1.StatusCause
+func validateHugepagesNUMAPolicy(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	if spec.Domain.Memory == nil || spec.Domain.Memory.Hugepages == nil || spec.Domain.Memory.Hugepages.NUMAPolicy == nil {
+		return nil
+	}
+	policy := spec.Domain.Memory.Hugepages.NUMAPolicy
+	policyField := field.Child("domain", "memory", "hugepages", "numaPolicy")
+
+	var causes []metav1.StatusCause
+	switch policy.Mode {
+	case v1.HugepagesNUMAModeStrict, v1.HugepagesNUMAModeInterleave, v1.HugepagesNUMAModePreferred:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s '%s' is not supported", policyField.Child("mode").String(), policy.Mode),
+			Field:   policyField.Child("mode").String(),
+		})
+	}
+	if _, err := hwutil.ParseCPUSetLine(policy.NodeSet, 1024); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s '%s' is not a valid NUMA node list: %v", policyField.Child("nodeSet").String(), policy.NodeSet, err),
+			Field:   policyField.Child("nodeSet").String(),
+		})
+	}
+	if spec.Domain.CPU != nil && spec.Domain.CPU.NUMA != nil && spec.Domain.CPU.NUMA.GuestMappingPassthrough != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s can not be set together with %s, which already binds the hugepages to the host NUMA nodes",
+				policyField.String(),
+				field.Child("domain", "cpu", "numa", "guestMappingPassthrough").String(),
+			),
+			Field: policyField.String(),
+		})
+	}
+	return causes
+}
+
+func validateMemoryLimitsNegativeOrNull(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
 	if spec.Domain.Resources.Limits.Memory().Value() < 0 {
 		causes = append(causes, metav1.StatusCause{
 			Type: metav1.CauseTypeFieldValueInvalid,
@@ -1220,6 +1802,17 @@ func ValidateVirtualMachineInstanceMetadata(field *k8sfield.Path, metadata *meta
 		})
 	}
 
+	if value, exists := annotations[v1.MemBalloonStatsPeriodAnnotation]; exists {
+		if _, err := strconv.ParseUint(value, 10, 32); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be a non-negative number of seconds",
+					field.Child("annotations", v1.MemBalloonStatsPeriodAnnotation).String()),
+				Field: field.Child("annotations").String(),
+			})
+		}
+	}
+
 	return causes
 }
 
@@ -1357,6 +1950,98 @@ func validateFirmwareACPI(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 	return causes
 }
 
+// QEMU limits the fw_cfg file names to 56 bytes, including the terminating NUL.
+const fwCfgMaxNameLength = 55
+
+func validateFirmwareFWCfg(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	if spec.Domain.Firmware == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	names := map[string]struct{}{}
+	for i, entry := range spec.Domain.Firmware.FWCfg {
+		entryField := field.Index(i)
+
+		if !strings.HasPrefix(entry.Name, "opt/") || len(entry.Name) <= len("opt/") {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must start with opt/", entryField.Child("name")),
+				Field:   entryField.Child("name").String(),
+			})
+		} else if len(entry.Name) > fwCfgMaxNameLength {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not exceed %d characters", entryField.Child("name"), fwCfgMaxNameLength),
+				Field:   entryField.Child("name").String(),
+			})
+		} else if !isValidFWCfgArgValue(entry.Name) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not contain ',', '=' or non-printable characters", entryField.Child("name")),
+				Field:   entryField.Child("name").String(),
+			})
+		}
+		if _, exists := names[entry.Name]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("%s '%s' is already used by another fw_cfg entry", entryField.Child("name"), entry.Name),
+				Field:   entryField.Child("name").String(),
+			})
+		}
+		names[entry.Name] = struct{}{}
+
+		if entry.Key == "" || entry.Key == "." || entry.Key == ".." || strings.Contains(entry.Key, "/") ||
+			!isValidFWCfgArgValue(entry.Key) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be a valid ConfigMap or Secret key", entryField.Child("key")),
+				Field:   entryField.Child("key").String(),
+			})
+		}
+
+		causes = append(causes, validateFWCfgVolumeRef(entryField, entry.VolumeNameRef, spec.Volumes)...)
+	}
+	return causes
+}
+
+// isValidFWCfgArgValue rejects the values which would inject properties into the -fw_cfg argument of QEMU.
+func isValidFWCfgArgValue(value string) bool {
+	if strings.ContainsAny(value, ",=") {
+		return false
+	}
+	for _, r := range value {
+		if r > unicode.MaxASCII || !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func validateFWCfgVolumeRef(field *k8sfield.Path, nameRef string, volumes []v1.Volume) []metav1.StatusCause {
+	for _, volume := range volumes {
+		if nameRef != volume.Name {
+			continue
+		}
+
+		if volume.ConfigMap != nil || volume.Secret != nil {
+			return nil
+		}
+
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s refers to Volume of unsupported type.", field.String()),
+			Field:   field.Child("volumeNameRef").String(),
+		}}
+	}
+
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("%s does not have a matching Volume.", field.String()),
+		Field:   field.Child("volumeNameRef").String(),
+	}}
+}
+
 func validateACPIRef(field *k8sfield.Path, nameRef string, volumes []v1.Volume, fieldName string) []metav1.StatusCause {
 	if nameRef == "" {
 		return nil
@@ -1396,6 +2081,20 @@ func validateFirmware(field *k8sfield.Path, firmware *v1.Firmware) []metav1.Stat
 	return causes
 }
 
+func validateClock(field *k8sfield.Path, clock *v1.Clock) []metav1.StatusCause {
+	if clock == nil || clock.PTP == nil || clock.Timer == nil || clock.Timer.KVM == nil {
+		return nil
+	}
+	if clock.Timer.KVM.Enabled != nil && !*clock.Timer.KVM.Enabled {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires the kvm timer, which is disabled", field.Child("ptp").String()),
+			Field:   field.Child("ptp").String(),
+		}}
+	}
+	return nil
+}
+
 func efiBootEnabled(firmware *v1.Firmware) bool {
 	return firmware != nil && firmware.Bootloader != nil && firmware.Bootloader.EFI != nil
 }
@@ -1413,7 +2112,10 @@ func validateDomainSpec(field *k8sfield.Path, spec *v1.DomainSpec) []metav1.Stat
 	var causes []metav1.StatusCause
 
 	causes = append(causes, storageadmitters.ValidateDisks(field.Child("devices").Child("disks"), spec.Devices.Disks)...)
+	causes = append(causes, storageadmitters.ValidateSCSIControllers(field.Child("devices"), &spec.Devices)...)
 	causes = append(causes, validateFirmware(field.Child("firmware"), spec.Firmware)...)
+	causes = append(causes, validateRootFilesystem(field, spec)...)
+	causes = append(causes, validateClock(field.Child("clock"), spec.Clock)...)
 
 	if secureBootEnabled(spec.Firmware) && !smmFeatureEnabled(spec.Features) {
 		causes = append(causes, metav1.StatusCause{
@@ -1552,6 +2254,63 @@ func validateAccessCredentials(field *k8sfield.Path, accessCredentials []v1.Acce
 	return causes
 }
 
+func validateHostBlockDevice(field *k8sfield.Path, hostBlockDevice *v1.HostBlockDeviceVolumeSource, usedPaths map[string]struct{}, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if !config.HostBlockDeviceEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled", featuregate.HostBlockDeviceGate),
+			Field:   field.String(),
+		})
+	}
+	pathField := field.Child("path")
+	if !hostblockdevice.IsPermitted(hostBlockDevice.Path, config.GetHostBlockDevices()) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s '%s' is not a permitted host block device", pathField.String(), hostBlockDevice.Path),
+			Field:   pathField.String(),
+		})
+	}
+	if _, exists := usedPaths[hostBlockDevice.Path]; exists {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueDuplicate,
+			Message: fmt.Sprintf("%s '%s' is already used by another volume", pathField.String(), hostBlockDevice.Path),
+			Field:   pathField.String(),
+		})
+	}
+	return causes
+}
+
+// validateHostSocketNamespaces ensures that the sockets of the node a VMI connects to are dedicated to its namespace.
+// The spec validation only knows that they are in a namespace directory of a permitted directory.
+func validateHostSocketNamespaces(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, namespace string, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if tpm := spec.Domain.Devices.TPM; tpm != nil && tpm.External != nil {
+		if socketNamespace, ok := util.SocketPathNamespace(tpm.External.SocketPath, config.GetExternalTPMSockets()); ok && socketNamespace != namespace {
+			socketPathField := field.Child("domain", "devices", "tpm", "external", "socketPath")
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s '%s' is not in the swtpm socket directory of namespace %s", socketPathField.String(), tpm.External.SocketPath, namespace),
+				Field:   socketPathField.String(),
+			})
+		}
+	}
+	for idx, volume := range spec.Volumes {
+		if volume.VhostUserBlk == nil {
+			continue
+		}
+		if socketNamespace, ok := util.SocketPathNamespace(volume.VhostUserBlk.Path, config.GetVhostUserBlkSockets()); ok && socketNamespace != namespace {
+			pathField := field.Child("volumes").Index(idx).Child("vhostUserBlk", "path")
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s '%s' is not in the vhost-user-blk socket directory of namespace %s", pathField.String(), volume.VhostUserBlk.Path, namespace),
+				Field:   pathField.String(),
+			})
+		}
+	}
+	return causes
+}
+
 func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	nameMap := make(map[string]int)
@@ -1561,6 +2320,8 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 	downwardMetricVolumeCount := 0
 	memoryDumpVolumeCount := 0
 
+	hostBlockDevicePaths := make(map[string]struct{})
+
 	for idx, volume := range volumes {
 		// verify name is unique
 		otherIdx, ok := nameMap[volume.Name]
@@ -1631,6 +2392,12 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 			memoryDumpVolumeCount++
 			volumeSourceSetCount++
 		}
+		if volume.VhostUserBlk != nil {
+			volumeSourceSetCount++
+		}
+		if volume.HostBlockDevice != nil {
+			volumeSourceSetCount++
+		}
 
 		if volumeSourceSetCount != 1 {
 			causes = append(causes, metav1.StatusCause{
@@ -1791,6 +2558,34 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 			}
 		}
 
+		if vhostUserBlk := volume.VhostUserBlk; vhostUserBlk != nil {
+			if !config.VhostUserBlkEnabled() {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s feature gate is not enabled", featuregate.VhostUserBlkGate),
+					Field:   field.Index(idx).String(),
+				})
+			}
+			if !filepath.IsAbs(vhostUserBlk.Path) {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s must be an absolute path to the socket of the vhost-user-blk backend", field.Index(idx).Child("vhostUserBlk", "path").String()),
+					Field:   field.Index(idx).Child("vhostUserBlk", "path").String(),
+				})
+			} else if _, ok := util.SocketPathNamespace(vhostUserBlk.Path, config.GetVhostUserBlkSockets()); !ok {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s '%s' is not in a permitted vhost-user-blk socket directory", field.Index(idx).Child("vhostUserBlk", "path").String(), vhostUserBlk.Path),
+					Field:   field.Index(idx).Child("vhostUserBlk", "path").String(),
+				})
+			}
+		}
+
+		if hostBlockDevice := volume.HostBlockDevice; hostBlockDevice != nil {
+			causes = append(causes, validateHostBlockDevice(field.Index(idx).Child("hostBlockDevice"), hostBlockDevice, hostBlockDevicePaths, config)...)
+			hostBlockDevicePaths[hostBlockDevice.Path] = struct{}{}
+		}
+
 		if volume.ConfigMap != nil {
 			if volume.ConfigMap.LocalObjectReference.Name == "" {
 				causes = append(causes, metav1.StatusCause{
@@ -1895,6 +2690,52 @@ func validateKernelBoot(field *k8sfield.Path, kernelBoot *v1.KernelBoot) []metav
 	return causes
 }
 
+// validateRootFilesystem rejects a virtiofs root filesystem which does not match a virtiofs filesystem
+// of the domain, or which conflicts with the root set by the kernel arguments.
+func validateRootFilesystem(field *k8sfield.Path, spec *v1.DomainSpec) []metav1.StatusCause {
+	if spec.Firmware == nil || spec.Firmware.KernelBoot == nil || spec.Firmware.KernelBoot.RootFilesystem == "" {
+		return nil
+	}
+	kernelBoot := spec.Firmware.KernelBoot
+	kernelBootField := field.Child("firmware", "kernelBoot")
+
+	var causes []metav1.StatusCause
+	if kernelBoot.Container == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "a root filesystem cannot be provided without an external kernel",
+			Field:   kernelBootField.Child("rootFilesystem").String(),
+		})
+	}
+
+	found := false
+	for _, fs := range spec.Devices.Filesystems {
+		if fs.Name == kernelBoot.RootFilesystem && fs.Virtiofs != nil {
+			found = true
+			break
+		}
+	}
+	if !found {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must match a virtiofs filesystem", kernelBootField.Child("rootFilesystem")),
+			Field:   kernelBootField.Child("rootFilesystem").String(),
+		})
+	}
+
+	for _, arg := range strings.Fields(kernelBoot.KernelArgs) {
+		if strings.HasPrefix(arg, "root=") || strings.HasPrefix(arg, "rootfstype=") {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("kernel arguments cannot set %s when a root filesystem is provided", arg),
+				Field:   kernelBootField.Child("kernelArgs").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
 // validateSpecAffinity is function that validate spec.affinity
 // instead of bring in the whole kubernetes lib we simply copy it from kubernetes/pkg/apis/core/validation/validation.go
 func validateSpecAffinity(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
@@ -1990,24 +2831,97 @@ func validateCPUHotplug(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpe
 func validateVideoConfig(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
-	if spec.Domain.Devices.Video == nil {
+	devices := spec.Domain.Devices
+	if devices.Video == nil && len(devices.Videos) == 0 {
 		return causes
 	}
 
+	videoField := field.Child("video")
+	if devices.Video == nil {
+		videoField = field.Child("videos")
+	}
+
 	if !config.VideoConfigEnabled() {
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
 			Message: fmt.Sprintf("Video configuration is specified but the %s feature gate is not enabled", featuregate.VideoConfig),
-			Field:   field.Child("video").String(),
+			Field:   videoField.String(),
 		})
 		return causes
 	}
 
-	if spec.Domain.Devices.AutoattachGraphicsDevice != nil && !*spec.Domain.Devices.AutoattachGraphicsDevice {
+	if devices.AutoattachGraphicsDevice != nil && !*devices.AutoattachGraphicsDevice {
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
 			Message: "Video configuration is not allowed when autoattachGraphicsDevice is set to false",
-			Field:   field.Child("video").String(),
+			Field:   videoField.String(),
+		})
+	}
+
+	if devices.Video != nil && len(devices.Videos) > 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "video and videos cannot be set at the same time",
+			Field:   field.Child("videos").String(),
+		})
+	}
+
+	if devices.Video != nil {
+		causes = append(causes, validateVideoDevice(field.Child("video"), devices.Video)...)
+	}
+	for i := range devices.Videos {
+		videoField := field.Child("videos").Index(i)
+		causes = append(causes, validateVideoDevice(videoField, &devices.Videos[i])...)
+		// QEMU only accepts a single VGA compatible device, the primary one
+		if i > 0 && devices.Videos[i].Type != v1.VirtIO {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s must be of type %s, only the primary video device can be of another type", videoField, v1.VirtIO),
+				Field:   videoField.Child("type").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+// virtio-gpu supports up to 16 display heads
+const maxVideoHeads = 16
+
+func validateVideoDevice(field *k8sfield.Path, video *v1.VideoDevice) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if video.Heads != nil && (*video.Heads == 0 || *video.Heads > maxVideoHeads) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be between 1 and %d", field.Child("heads"), maxVideoHeads),
+			Field:   field.Child("heads").String(),
+		})
+	}
+
+	if video.VRAM != nil && video.VRAM.Value() < 1024 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be at least 1Ki", field.Child("vram")),
+			Field:   field.Child("vram").String(),
+		})
+	}
+
+	switch video.Accel3D {
+	case "":
+	case v1.VideoAccel3DVirgl, v1.VideoAccel3DVenus:
+		if video.Type != v1.VirtIO {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s is only supported on video devices of type %s", field.Child("accel3d"), v1.VirtIO),
+				Field:   field.Child("accel3d").String(),
+			})
+		}
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s '%s' is not supported, supported values are %s and %s", field.Child("accel3d"), video.Accel3D, v1.VideoAccel3DVirgl, v1.VideoAccel3DVenus),
+			Field:   field.Child("accel3d").String(),
 		})
 	}
 
@@ -2042,23 +2956,260 @@ func validatePanicDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 		return causes
 	}
 
+	for idx, panicDevice := range spec.Domain.Devices.PanicDevices {
+		if cause := validatePanicDeviceModel(field.Child("domain", "devices", "panicDevices").Index(idx).Child("model"), panicDevice.Model); cause != nil {
+			causes = append(causes, *cause)
+		}
+	}
+
+	return causes
+}
+
+// validatePanicDeviceModelsPerArch rejects the panic device models the architecture has no bus for.
+// It is only applied to new panic devices, to keep existing VMs with such devices updatable.
+func validatePanicDeviceModelsPerArch(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
 	arch := spec.Architecture
 	if arch == "" {
 		arch = config.GetDefaultArchitecture()
 	}
 
-	if arch == "s390x" {
+	for idx, panicDevice := range spec.Domain.Devices.PanicDevices {
+		modelField := field.Child("domain", "devices", "panicDevices").Index(idx).Child("model")
+		// arm64 and s390x have no ISA bus, only the PCI variant can be used there
+		if arch != "amd64" && panicDevice.Model != nil && *panicDevice.Model != v1.PvpanicPCI {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("panic device model %s is not supported on %s architecture, only %s is", *panicDevice.Model, arch, v1.PvpanicPCI),
+				Field:   modelField.String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+func validateChannels(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	names := map[string]struct{}{}
+	for idx, channel := range spec.Domain.Devices.Channels {
+		nameField := field.Child("domain", "devices", "channels").Index(idx).Child("name")
+		switch {
+		case channel.Name == "":
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf(requiredFieldFmt, nameField.String()),
+				Field:   nameField.String(),
+			})
+		case len(channel.Name) > channelNameMaxLen || !validChannelName.MatchString(channel.Name):
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("channel name %s must be at most %d characters long and consist of alphanumeric characters, '.', '_' or '-'",
+					channel.Name, channelNameMaxLen),
+				Field: nameField.String(),
+			})
+		case slices.Contains(reservedChannelNames, channel.Name):
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("channel name %s is reserved", channel.Name),
+				Field:   nameField.String(),
+			})
+		default:
+			if _, exists := names[channel.Name]; exists {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueDuplicate,
+					Message: fmt.Sprintf("channel name %s is used more than once", channel.Name),
+					Field:   nameField.String(),
+				})
+			}
+			names[channel.Name] = struct{}{}
+		}
+	}
+	return causes
+}
+
+func validateSerials(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	devices := spec.Domain.Devices
+	serialPorts := len(devices.Serials)
+	if devices.AutoattachSerialConsole == nil || *devices.AutoattachSerialConsole {
+		serialPorts++
+	}
+	if serialPorts > maxSerialPorts {
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
-			Message: fmt.Sprintf("custom panic devices are not supported on %s architecture", arch),
-			Field:   field.Child("domain", "devices", "panicDevices").String(),
+			Message: fmt.Sprintf("at most %d serial ports are supported, including the auto-attached serial console", maxSerialPorts),
+			Field:   field.Child("domain", "devices", "serials").String(),
 		})
 	}
 
-	for idx, panicDevice := range spec.Domain.Devices.PanicDevices {
-		if cause := validatePanicDeviceModel(field.Child("domain", "devices", "panicDevices").Index(idx).Child("model"), panicDevice.Model); cause != nil {
-			causes = append(causes, *cause)
+	names := map[string]struct{}{}
+	for idx, serial := range devices.Serials {
+		nameField := field.Child("domain", "devices", "serials").Index(idx).Child("name")
+		switch {
+		case serial.Name == "":
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf(requiredFieldFmt, nameField.String()),
+				Field:   nameField.String(),
+			})
+		case len(serial.Name) > serialNameMaxLen || !validSerialName.MatchString(serial.Name):
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("serial port name %s must be at most %d characters long and consist of alphanumeric characters, '_' or '-'",
+					serial.Name, serialNameMaxLen),
+				Field: nameField.String(),
+			})
+		default:
+			if _, exists := names[serial.Name]; exists {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueDuplicate,
+					Message: fmt.Sprintf("serial port name %s is used more than once", serial.Name),
+					Field:   nameField.String(),
+				})
+			}
+			names[serial.Name] = struct{}{}
+		}
+	}
+	return causes
+}
+
+func validateVirtioTransitionalDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	supportedClasses := []v1.VirtioDeviceClass{
+		v1.VirtioDeviceClassDisk,
+		v1.VirtioDeviceClassInterface,
+		v1.VirtioDeviceClassController,
+		v1.VirtioDeviceClassRng,
+		v1.VirtioDeviceClassBalloon,
+	}
+	classes := map[v1.VirtioDeviceClass]struct{}{}
+	for idx, class := range spec.Domain.Devices.VirtioTransitionalDevices {
+		classField := field.Child("domain", "devices", "virtioTransitionalDevices").Index(idx)
+		if !slices.Contains(supportedClasses, class) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s '%s' is not supported, supported values are %v", classField, class, supportedClasses),
+				Field:   classField.String(),
+			})
+			continue
 		}
+		if _, exists := classes[class]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("virtio device class %s is listed more than once", class),
+				Field:   classField.String(),
+			})
+		}
+		classes[class] = struct{}{}
+	}
+	return causes
+}
+
+func validateBalloon(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	devices := spec.Domain.Devices
+	if devices.Balloon != nil && devices.AutoattachMemBalloon != nil && !*devices.AutoattachMemBalloon {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s cannot be set when %s is false", field.Child("domain", "devices", "balloon").String(), field.Child("domain", "devices", "autoattachMemBalloon").String()),
+			Field:   field.Child("domain", "devices", "balloon").String(),
+		})
+	}
+	return causes
+}
+
+// validateMicroVM rejects the devices the microvm machine type cannot host, as it has no PCI
+// bus, no USB controller and boots with the minimal qboot firmware.
+func validateMicroVM(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if !virtconfig.IsMicroVM(spec) {
+		return causes
+	}
+	machineField := field.Child("domain", "machine", "type")
+	if !config.MicroVMEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.MicroVMGate),
+			Field:   machineField.String(),
+		})
+	}
+
+	arch := spec.Architecture
+	if arch == "" {
+		arch = config.GetDefaultArchitecture()
+	}
+	if arch != "amd64" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("the microvm machine type is not supported on %s architecture", arch),
+			Field:   machineField.String(),
+		})
+	}
+
+	unsupported := func(fieldPath *k8sfield.Path, device string) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s are not supported on the microvm machine type", device),
+			Field:   fieldPath.String(),
+		})
+	}
+
+	devicesField := field.Child("domain", "devices")
+	devices := &spec.Domain.Devices
+	if len(devices.GPUs) > 0 {
+		unsupported(devicesField.Child("gpus"), "GPUs")
+	}
+	if len(devices.HostDevices) > 0 {
+		unsupported(devicesField.Child("hostDevices"), "host devices")
+	}
+	if devices.ClientPassthrough != nil {
+		unsupported(devicesField.Child("clientPassthrough"), "USB redirection devices")
+	}
+	for idx, input := range devices.Inputs {
+		if input.Bus != v1.InputBusVirtio {
+			unsupported(devicesField.Child("inputs").Index(idx).Child("bus"), "USB input devices")
+		}
+	}
+	for idx, disk := range devices.Disks {
+		var bus v1.DiskBus
+		switch {
+		case disk.Disk != nil:
+			bus = disk.Disk.Bus
+		case disk.CDRom != nil:
+			bus = disk.CDRom.Bus
+		case disk.LUN != nil:
+			bus = disk.LUN.Bus
+		}
+		if bus != "" && bus != v1.DiskBusVirtio && bus != v1.DiskBusSCSI {
+			unsupported(devicesField.Child("disks").Index(idx), fmt.Sprintf("%s disks", bus))
+		}
+	}
+	for idx, iface := range devices.Interfaces {
+		if iface.SRIOV != nil || (iface.Model != "" && iface.Model != v1.VirtIO) {
+			unsupported(devicesField.Child("interfaces").Index(idx), "non virtio interfaces")
+		}
+	}
+	if len(devices.Filesystems) > 0 {
+		unsupported(devicesField.Child("filesystems"), "filesystems")
+	}
+	if devices.Sound != nil {
+		unsupported(devicesField.Child("sound"), "sound devices")
+	}
+	if devices.Watchdog != nil {
+		unsupported(devicesField.Child("watchdog"), "watchdog devices")
+	}
+	if devices.Video != nil {
+		unsupported(devicesField.Child("video"), "video devices")
+	}
+	if len(devices.Videos) > 0 {
+		unsupported(devicesField.Child("videos"), "video devices")
+	}
+	if devices.AutoattachVSOCK != nil && *devices.AutoattachVSOCK {
+		unsupported(devicesField.Child("autoattachVSOCK"), "VSOCK devices")
+	}
+	if firmware := spec.Domain.Firmware; firmware != nil && firmware.Bootloader != nil && firmware.Bootloader.EFI != nil {
+		unsupported(field.Child("domain", "firmware", "bootloader", "efi"), "EFI bootloaders")
 	}
 
 	return causes
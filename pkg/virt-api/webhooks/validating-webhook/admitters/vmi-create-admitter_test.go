@@ -37,8 +37,10 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
+	overcommitv1 "kubevirt.io/api/overcommit/v1alpha1"
 	"kubevirt.io/client-go/api"
 
 	"kubevirt.io/kubevirt/pkg/hooks"
@@ -123,6 +125,53 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		Expect(resp.Result.Details.Causes).To(Equal(expectedStatusCauses))
 	})
 
+	Context("with VirtualizationQuotas", func() {
+		var admitter *VMICreateAdmitter
+
+		BeforeEach(func() {
+			quotaInformer, _ := testutils.NewFakeInformerWithIndexersFor(&overcommitv1.VirtualizationQuota{}, cache.Indexers{
+				cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+			})
+			Expect(quotaInformer.GetStore().Add(&overcommitv1.VirtualizationQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: metav1.NamespaceDefault},
+				Spec: overcommitv1.VirtualizationQuotaSpec{Hard: k8sv1.ResourceList{
+					overcommitv1.ResourceVCPUs:       resource.MustParse("8"),
+					overcommitv1.ResourceGuestMemory: resource.MustParse("8Gi"),
+				}},
+				Status: overcommitv1.VirtualizationQuotaStatus{Used: k8sv1.ResourceList{
+					overcommitv1.ResourceVCPUs:       resource.MustParse("6"),
+					overcommitv1.ResourceGuestMemory: resource.MustParse("4Gi"),
+				}},
+			})).To(Succeed())
+			admitter = &VMICreateAdmitter{
+				ClusterConfig:               config,
+				KubeVirtServiceAccounts:     kubeVirtServiceAccounts,
+				VirtualizationQuotaInformer: quotaInformer,
+			}
+		})
+
+		DescribeTable("should admit a VMI", func(featureGate bool, namespace string, cores uint32, allowed bool) {
+			if featureGate {
+				enableFeatureGates(featuregate.VirtualizationQuotas)
+			}
+			vmi := newBaseVmi(libvmi.WithNamespace(namespace), libvmi.WithCPUCount(cores, 1, 1))
+			ar, err := newAdmissionReviewForVMICreation(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := admitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(Equal(allowed))
+			if !allowed {
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Message).To(Equal("exceeded VirtualizationQuota quota: vcpus"))
+			}
+		},
+			Entry("within the quota", true, metav1.NamespaceDefault, uint32(2), true),
+			Entry("not when it exceeds the quota", true, metav1.NamespaceDefault, uint32(4), false),
+			Entry("exceeding the quota of another namespace", true, "other", uint32(4), true),
+			Entry("exceeding the quota without the feature gate", false, metav1.NamespaceDefault, uint32(4), true),
+		)
+	})
+
 	It("should reject invalid VirtualMachineInstance spec on create", func() {
 		vmi := newBaseVmi()
 		vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
@@ -477,6 +526,18 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				featuregate.SidecarGate,
 			),
 		)
+
+		DescribeTable("should validate the balloon statistics period annotation", func(value string, expectedCauses int) {
+			causes := ValidateVirtualMachineInstanceMetadata(k8sfield.NewPath("metadata"), &metav1.ObjectMeta{
+				Annotations: map[string]string{v1.MemBalloonStatsPeriodAnnotation: value},
+			}, config, false)
+			Expect(causes).To(HaveLen(expectedCauses))
+		},
+			Entry("accept a period", "30", 0),
+			Entry("accept disabled statistics", "0", 0),
+			Entry("reject a negative period", "-1", 1),
+			Entry("reject a duration", "30s", 1),
+		)
 	})
 
 	Context("with VirtualMachineInstance spec", func() {
@@ -514,6 +575,26 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Entry("Wrong prefix s390x", "s390x", "test-s390-ccw-virtio"),
 		)
 
+		It("should validate the machine type a machine type alias resolves to", func() {
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.ArchitectureConfiguration = &v1.ArchConfiguration{
+				Amd64: &v1.ArchSpecificConfiguration{MachineTypeAliases: map[string]string{
+					"stable":  "pc-q35-8.2",
+					"invalid": "test",
+				}},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+			vmi.Spec.Architecture = "amd64"
+
+			vmi.Spec.Domain.Machine = &v1.Machine{Type: "stable"}
+			Expect(ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)).To(BeEmpty())
+
+			vmi.Spec.Domain.Machine = &v1.Machine{Type: "invalid"}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.machine.type"))
+		})
+
 		It("should accept valid hostname", func() {
 			vmi.Spec.Hostname = "test"
 
@@ -702,11 +783,11 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 					Type: v1.InputTypeTablet,
 					Name: "tablet0",
 				}, 0, []string{}, "Expect no errors"),
-			Entry("and reject input with ps2 bus",
+			Entry("and reject input with ps2 bus when the PS2Input feature gate is disabled",
 				v1.Input{
-					Type: v1.InputTypeTablet,
-					Name: "tablet0",
-					Bus:  v1.InputBus("ps2"),
+					Type: v1.InputTypeKeyboard,
+					Name: "keyboard0",
+					Bus:  v1.InputBusPS2,
 				}, 1, []string{"fake.domain.devices.inputs[0].bus"}, "Expect bus error"),
 			Entry("and reject input with keyboard type and virtio bus",
 				v1.Input{
@@ -724,10 +805,53 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				v1.Input{
 					Type: v1.InputTypeKeyboard,
 					Name: "tablet0",
-					Bus:  v1.InputBus("ps2"),
+					Bus:  v1.InputBus("ide"),
 				}, 2, []string{"fake.domain.devices.inputs[0].bus", "fake.domain.devices.inputs[0].type"}, "Expect type error"),
 		)
 
+		Context("with ps2 input devices", func() {
+			BeforeEach(func() {
+				enableFeatureGates(featuregate.PS2InputGate)
+			})
+
+			DescribeTable("should accept", func(inputType v1.InputType) {
+				vmi.Spec.Domain.Devices.Inputs = []v1.Input{{Name: "input0", Type: inputType, Bus: v1.InputBusPS2}}
+				Expect(ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)).To(BeEmpty())
+			},
+				Entry("a keyboard", v1.InputTypeKeyboard),
+				Entry("a mouse", v1.InputTypeMouse),
+			)
+
+			It("should reject a tablet", func() {
+				vmi.Spec.Domain.Devices.Inputs = []v1.Input{{Name: "tablet0", Type: v1.InputTypeTablet, Bus: v1.InputBusPS2}}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.inputs[0].type"))
+				Expect(causes[0].Message).To(Equal("Input device on the ps2 bus can have only keyboard or mouse type."))
+			})
+
+			It("should reject architectures other than amd64", func() {
+				vmi.Spec.Architecture = "arm64"
+				vmi.Spec.Domain.Devices.Inputs = []v1.Input{{Name: "keyboard0", Type: v1.InputTypeKeyboard, Bus: v1.InputBusPS2}}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(ContainElement(metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "No ps2 input support for architecture: arm64",
+					Field:   "fake.architecture",
+				}))
+			})
+
+			It("should warn about the hotplug limitations", func() {
+				vmi.Spec.Domain.Devices.Inputs = []v1.Input{
+					{Name: "tablet0", Type: v1.InputTypeTablet, Bus: v1.InputBusUSB},
+					{Name: "keyboard0", Type: v1.InputTypeKeyboard, Bus: v1.InputBusPS2},
+				}
+				Expect(warnPS2InputDevices(k8sfield.NewPath("spec"), &vmi.Spec)).To(ConsistOf(
+					"spec.domain.devices.inputs[1] uses the ps2 bus, ps2 input devices can neither be hotplugged nor unplugged.",
+				))
+			})
+		})
+
 		It("should reject negative requests.cpu value", func() {
 			vm := api.NewMinimalVMI("testvm")
 
@@ -1029,6 +1153,31 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(BeEmpty())
 		})
 
+		DescribeTable("should validate the hugepages NUMA policy", func(policy *v1.HugepagesNUMAPolicy, numa *v1.NUMA, expectedField string) {
+			vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{
+				k8sv1.ResourceMemory: resource.MustParse("64Mi"),
+			}
+			vmi.Spec.Domain.Memory = &v1.Memory{Hugepages: &v1.Hugepages{PageSize: "2Mi", NUMAPolicy: policy}}
+			vmi.Spec.Domain.CPU = &v1.CPU{NUMA: numa}
+
+			causes := validateHugepagesNUMAPolicy(k8sfield.NewPath("fake"), &vmi.Spec)
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+			} else {
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			}
+		},
+			Entry("with a strict policy", &v1.HugepagesNUMAPolicy{Mode: v1.HugepagesNUMAModeStrict, NodeSet: "0"}, nil, ""),
+			Entry("with an interleave policy on a node range", &v1.HugepagesNUMAPolicy{Mode: v1.HugepagesNUMAModeInterleave, NodeSet: "0-1,3"}, nil, ""),
+			Entry("with a preferred policy", &v1.HugepagesNUMAPolicy{Mode: v1.HugepagesNUMAModePreferred, NodeSet: "1"}, nil, ""),
+			Entry("with an unknown mode", &v1.HugepagesNUMAPolicy{Mode: "restrictive", NodeSet: "0"}, nil, "fake.domain.memory.hugepages.numaPolicy.mode"),
+			Entry("with an empty node set", &v1.HugepagesNUMAPolicy{Mode: v1.HugepagesNUMAModeStrict}, nil, "fake.domain.memory.hugepages.numaPolicy.nodeSet"),
+			Entry("with an invalid node set", &v1.HugepagesNUMAPolicy{Mode: v1.HugepagesNUMAModeStrict, NodeSet: "node0"}, nil, "fake.domain.memory.hugepages.numaPolicy.nodeSet"),
+			Entry("with the guest NUMA passthrough", &v1.HugepagesNUMAPolicy{Mode: v1.HugepagesNUMAModeStrict, NodeSet: "0"},
+				&v1.NUMA{GuestMappingPassthrough: &v1.NUMAGuestMappingPassthrough{}}, "fake.domain.memory.hugepages.numaPolicy"),
+		)
+
 		DescribeTable("should verify LUN is mapped to PVC volume",
 			func(volume *v1.Volume, expectedErrors int) {
 				vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
@@ -1171,6 +1320,33 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Message).To(Equal("the number of iothreads needs to be set and positive for the dedicated policy"))
 		})
 
+		It("should allow an explicit iothreads pinning with dedicated cpus", func() {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.IOThreadsPolicy = pointer.P(v1.IOThreadsPolicyShared)
+			vmi.Spec.Domain.IOThreads = &v1.DiskIOThreads{Pinning: map[string]string{"1": "4-5,7"}}
+			vmi.Spec.Domain.CPU = &v1.CPU{Cores: 2, DedicatedCPUPlacement: true}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("spec"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject an invalid iothreads pinning", func(cpu *v1.CPU, pinning map[string]string, expectedField, expectedMessage string) {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.IOThreadsPolicy = pointer.P(v1.IOThreadsPolicyShared)
+			vmi.Spec.Domain.IOThreads = &v1.DiskIOThreads{Pinning: pinning}
+			vmi.Spec.Domain.CPU = cpu
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("spec"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+			Expect(causes[0].Message).To(ContainSubstring(expectedMessage))
+		},
+			Entry("without dedicated cpus", &v1.CPU{Cores: 2}, map[string]string{"1": "4"},
+				"spec.domain.ioThreads.pinning", "spec.domain.ioThreads.pinning requires dedicatedCpuPlacement"),
+			Entry("with an invalid iothread ID", &v1.CPU{Cores: 2, DedicatedCPUPlacement: true}, map[string]string{"0": "4"},
+				"spec.domain.ioThreads.pinning[0]", "0 is not a valid iothread ID"),
+			Entry("with an invalid cpuset", &v1.CPU{Cores: 2, DedicatedCPUPlacement: true}, map[string]string{"1": "4-x"},
+				"spec.domain.ioThreads.pinning[1]", "4-x is not a valid cpuset"),
+		)
+
 		It("should reject multiple configurations of vGPU displays with ramfb", func() {
 			vmi := api.NewMinimalVMI("testvm")
 			vmi.Spec.Domain.Devices.GPUs = []v1.GPU{
@@ -1259,6 +1435,26 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Entry("DV should be rejected when the deprecated feature gate is enabled", featuregate.VirtIOFSGate, false, libvmi.WithFilesystemDV("sharedtestdisk")),
 		)
 
+		DescribeTable("virtiofs options", func(virtiofs *v1.FilesystemVirtiofs, expectedField string) {
+			vmi := libvmi.New(libvmi.WithConfigMapFs("sharedconfigmap", "sharedconfigmap"))
+			vmi.Spec.Domain.Devices.Filesystems[0].Virtiofs = virtiofs
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+			} else {
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			}
+		},
+			Entry("should accept the defaults", &v1.FilesystemVirtiofs{}, ""),
+			Entry("should accept all options", &v1.FilesystemVirtiofs{Cache: v1.VirtiofsCacheNever, QueueSize: pointer.P(uint32(256)), Xattr: pointer.P(true)}, ""),
+			Entry("should reject an unknown cache mode", &v1.FilesystemVirtiofs{Cache: "writeback"}, "fake.domain.devices.filesystems[0].virtiofs.cache"),
+			Entry("should reject a queue size lower than 4", &v1.FilesystemVirtiofs{QueueSize: pointer.P(uint32(2))}, "fake.domain.devices.filesystems[0].virtiofs.queueSize"),
+			Entry("should reject a queue size greater than 1024", &v1.FilesystemVirtiofs{QueueSize: pointer.P(uint32(2048))}, "fake.domain.devices.filesystems[0].virtiofs.queueSize"),
+			Entry("should reject a queue size which is not a power of 2", &v1.FilesystemVirtiofs{QueueSize: pointer.P(uint32(100))}, "fake.domain.devices.filesystems[0].virtiofs.queueSize"),
+		)
+
 		It("should reject host devices when feature gate is disabled", func() {
 			vmi := api.NewMinimalVMI("testvm")
 			vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{
@@ -1429,6 +1625,76 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Message).To(Equal("either fake.startStrategy or fake.livenessProbe should be provided.Pausing VMI with LivenessProbe is not supported"))
 		})
 
+		DescribeTable("should validate the channels", func(channels []v1.Channel, expectedField, expectedMessage string) {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Channels = channels
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+				return
+			}
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+			Expect(causes[0].Message).To(ContainSubstring(expectedMessage))
+		},
+			Entry("and accept valid names", []v1.Channel{{Name: "org.example.agent.0"}, {Name: "com.example.other_agent-1"}}, "", ""),
+			Entry("and reject an empty name", []v1.Channel{{Name: ""}}, "fake.domain.devices.channels[0].name", "required"),
+			Entry("and reject an invalid name", []v1.Channel{{Name: "org/example"}}, "fake.domain.devices.channels[0].name", "must be at most 64 characters long"),
+			Entry("and reject a too long name", []v1.Channel{{Name: strings.Repeat("a", 65)}}, "fake.domain.devices.channels[0].name", "must be at most 64 characters long"),
+			Entry("and reject the guest agent name", []v1.Channel{{Name: "org.qemu.guest_agent.0"}}, "fake.domain.devices.channels[0].name", "is reserved"),
+			Entry("and reject a duplicated name", []v1.Channel{{Name: "org.example.agent.0"}, {Name: "org.example.agent.0"}}, "fake.domain.devices.channels[1].name", "used more than once"),
+		)
+
+		It("should reject balloon settings when the memory balloon is not attached", func() {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.AutoattachMemBalloon = pointer.P(false)
+			vmi.Spec.Domain.Devices.Balloon = &v1.BalloonDevice{DeflateOnOOM: pointer.P(true)}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.balloon"))
+			Expect(causes[0].Message).To(Equal("fake.domain.devices.balloon cannot be set when fake.domain.devices.autoattachMemBalloon is false"))
+		})
+
+		DescribeTable("should validate the serial ports", func(serials []v1.Serial, autoattachSerialConsole *bool, expectedField, expectedMessage string) {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Serials = serials
+			vmi.Spec.Domain.Devices.AutoattachSerialConsole = autoattachSerialConsole
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+				return
+			}
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+			Expect(causes[0].Message).To(ContainSubstring(expectedMessage))
+		},
+			Entry("and accept valid names", []v1.Serial{{Name: "uart1"}, {Name: "debug_port-2"}}, nil, "", ""),
+			Entry("and accept 4 serial ports without serial console", []v1.Serial{{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}}, pointer.P(false), "", ""),
+			Entry("and reject more than 4 serial ports with the serial console", []v1.Serial{{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}}, nil, "fake.domain.devices.serials", "at most 4 serial ports"),
+			Entry("and reject an empty name", []v1.Serial{{Name: ""}}, nil, "fake.domain.devices.serials[0].name", "required"),
+			Entry("and reject an invalid name", []v1.Serial{{Name: "uart.1"}}, nil, "fake.domain.devices.serials[0].name", "must be at most 32 characters long"),
+			Entry("and reject a too long name", []v1.Serial{{Name: strings.Repeat("a", 33)}}, nil, "fake.domain.devices.serials[0].name", "must be at most 32 characters long"),
+			Entry("and reject a duplicated name", []v1.Serial{{Name: "uart1"}, {Name: "uart1"}}, nil, "fake.domain.devices.serials[1].name", "used more than once"),
+		)
+
+		DescribeTable("should validate the virtio transitional device classes", func(classes []v1.VirtioDeviceClass, expectedField, expectedMessage string) {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.VirtioTransitionalDevices = classes
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+				return
+			}
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+			Expect(causes[0].Message).To(ContainSubstring(expectedMessage))
+		},
+			Entry("and accept known classes", []v1.VirtioDeviceClass{v1.VirtioDeviceClassDisk, v1.VirtioDeviceClassBalloon}, "", ""),
+			Entry("and reject an unknown class", []v1.VirtioDeviceClass{"gpu"}, "fake.domain.devices.virtioTransitionalDevices[0]", "'gpu' is not supported"),
+			Entry("and reject a duplicated class", []v1.VirtioDeviceClass{v1.VirtioDeviceClassRng, v1.VirtioDeviceClassRng},
+				"fake.domain.devices.virtioTransitionalDevices[1]", "listed more than once"),
+		)
+
 		Context("with panic devices defined", func() {
 			It("should fail when PanicDevices featuregate is disabled", func() {
 				vmi := api.NewMinimalVMI("testvm")
@@ -1458,16 +1724,108 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				Expect(causes[0].Message).To(Equal(fmt.Sprintf(invalidPanicDeviceModelErrFmt, panicDeviceModel)))
 			})
 
-			It("should reject panic devices on s390x architecture", func() {
+			DescribeTable("should reject panic device models without a PCI variant", func(arch string, model v1.PanicDeviceModel) {
 				enableFeatureGates(featuregate.PanicDevicesGate)
 				vmi := api.NewMinimalVMI("testvm")
-				vmi.Spec.Architecture = "s390x"
-				vmi.Spec.Domain.Devices.PanicDevices = []v1.PanicDevice{{Model: pointer.P(v1.Isa)}}
+				vmi.Spec.Architecture = arch
+				vmi.Spec.Domain.Devices.PanicDevices = []v1.PanicDevice{{Model: pointer.P(model)}}
+				causes := validatePanicDeviceModelsPerArch(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.panicDevices[0].model"))
+				Expect(causes[0].Message).To(Equal(fmt.Sprintf("panic device model %s is not supported on %s architecture, only pvpanic-pci is", model, arch)))
+			},
+				Entry("isa on s390x", "s390x", v1.Isa),
+				Entry("pvpanic on s390x", "s390x", v1.Pvpanic),
+				Entry("isa on arm64", "arm64", v1.Isa),
+				Entry("hyperv on arm64", "arm64", v1.Hyperv),
+			)
+
+			DescribeTable("should allow the pvpanic-pci model", func(arch string, model *v1.PanicDeviceModel) {
+				enableFeatureGates(featuregate.PanicDevicesGate)
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Architecture = arch
+				vmi.Spec.Domain.Devices.PanicDevices = []v1.PanicDevice{{Model: model}}
 				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			},
+				Entry("on amd64", "amd64", pointer.P(v1.PvpanicPCI)),
+				Entry("on arm64", "arm64", pointer.P(v1.PvpanicPCI)),
+				Entry("on s390x", "s390x", pointer.P(v1.PvpanicPCI)),
+				Entry("as the default on s390x", "s390x", nil),
+			)
+		})
+
+		Context("with the microvm machine type", func() {
+			newMicroVMI := func() *v1.VirtualMachineInstance {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Machine = &v1.Machine{Type: "microvm"}
+				return vmi
+			}
+
+			It("should fail when the MicroVM feature gate is disabled", func() {
+				vmi := newMicroVMI()
+				causes := validateMicroVM(k8sfield.NewPath("fake"), &vmi.Spec, config)
 				Expect(causes).To(HaveLen(1))
-				Expect(causes[0].Field).To(Equal("fake.domain.devices.panicDevices"))
-				Expect(causes[0].Message).To(Equal("custom panic devices are not supported on s390x architecture"))
+				Expect(causes[0].Field).To(Equal("fake.domain.machine.type"))
+				Expect(causes[0].Message).To(Equal("MicroVM feature gate is not enabled in kubevirt-config"))
+			})
+
+			It("should allow virtio devices", func() {
+				enableFeatureGates(featuregate.MicroVMGate)
+				vmi := newMicroVMI()
+				vmi.Spec.Domain.Devices.Disks = []v1.Disk{{
+					Name:       "disk0",
+					DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}},
+				}}
+				vmi.Spec.Domain.Devices.Inputs = []v1.Input{{Name: "tablet", Type: v1.InputTypeTablet, Bus: v1.InputBusVirtio}}
+				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+				Expect(validateMicroVM(k8sfield.NewPath("fake"), &vmi.Spec, config)).To(BeEmpty())
+			})
+
+			It("should reject other architectures", func() {
+				enableFeatureGates(featuregate.MicroVMGate)
+				vmi := newMicroVMI()
+				vmi.Spec.Architecture = "arm64"
+				causes := validateMicroVM(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Message).To(Equal("the microvm machine type is not supported on arm64 architecture"))
 			})
+
+			DescribeTable("should reject unsupported devices", func(mutate func(*v1.VirtualMachineInstanceSpec), field, message string) {
+				enableFeatureGates(featuregate.MicroVMGate)
+				vmi := newMicroVMI()
+				mutate(&vmi.Spec)
+				causes := validateMicroVM(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(ConsistOf(metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueNotSupported,
+					Field:   field,
+					Message: message,
+				}))
+			},
+				Entry("GPU", func(spec *v1.VirtualMachineInstanceSpec) {
+					spec.Domain.Devices.GPUs = []v1.GPU{{Name: "gpu0", DeviceName: "nvidia.com/gpu"}}
+				}, "fake.domain.devices.gpus", "GPUs are not supported on the microvm machine type"),
+				Entry("host device", func(spec *v1.VirtualMachineInstanceSpec) {
+					spec.Domain.Devices.HostDevices = []v1.HostDevice{{Name: "usb0", DeviceName: "kubevirt.io/usb"}}
+				}, "fake.domain.devices.hostDevices", "host devices are not supported on the microvm machine type"),
+				Entry("USB redirection", func(spec *v1.VirtualMachineInstanceSpec) {
+					spec.Domain.Devices.ClientPassthrough = &v1.ClientPassthroughDevices{}
+				}, "fake.domain.devices.clientPassthrough", "USB redirection devices are not supported on the microvm machine type"),
+				Entry("USB input", func(spec *v1.VirtualMachineInstanceSpec) {
+					spec.Domain.Devices.Inputs = []v1.Input{{Name: "tablet", Type: v1.InputTypeTablet}}
+				}, "fake.domain.devices.inputs[0].bus", "USB input devices are not supported on the microvm machine type"),
+				Entry("SATA disk", func(spec *v1.VirtualMachineInstanceSpec) {
+					spec.Domain.Devices.Disks = []v1.Disk{{Name: "cdrom", DiskDevice: v1.DiskDevice{CDRom: &v1.CDRomTarget{Bus: v1.DiskBusSATA}}}}
+				}, "fake.domain.devices.disks[0]", "sata disks are not supported on the microvm machine type"),
+				Entry("e1000 interface", func(spec *v1.VirtualMachineInstanceSpec) {
+					iface := v1.DefaultBridgeNetworkInterface()
+					iface.Model = "e1000"
+					spec.Domain.Devices.Interfaces = []v1.Interface{*iface}
+				}, "fake.domain.devices.interfaces[0]", "non virtio interfaces are not supported on the microvm machine type"),
+				Entry("EFI", func(spec *v1.VirtualMachineInstanceSpec) {
+					spec.Domain.Firmware = &v1.Firmware{Bootloader: &v1.Bootloader{EFI: &v1.EFI{}}}
+				}, "fake.domain.firmware.bootloader.efi", "EFI bootloaders are not supported on the microvm machine type"),
+			)
 		})
 
 		Context("with kernel boot defined", func() {
@@ -1535,6 +1893,48 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				Entry("with kernel args, with container that has initrd and kernel defined but without image - should reject",
 					createKernelBoot(validKernelArgs, validInitrd, validKernel, withoutImage), false),
 			)
+
+			Context("with a virtiofs root filesystem", func() {
+				var domain *v1.DomainSpec
+
+				BeforeEach(func() {
+					domain = &v1.DomainSpec{
+						Firmware: &v1.Firmware{KernelBoot: createKernelBoot(validKernelArgs, validInitrd, validKernel, validImage)},
+						Devices: v1.Devices{
+							Filesystems: []v1.Filesystem{{Name: "rootfs", Virtiofs: &v1.FilesystemVirtiofs{}}},
+						},
+					}
+					domain.Firmware.KernelBoot.RootFilesystem = "rootfs"
+				})
+
+				It("should accept a matching virtiofs filesystem", func() {
+					Expect(validateRootFilesystem(k8sfield.NewPath("domain"), domain)).To(BeEmpty())
+				})
+
+				It("should reject a root filesystem without an external kernel", func() {
+					domain.Firmware.KernelBoot.Container = nil
+					causes := validateRootFilesystem(k8sfield.NewPath("domain"), domain)
+					Expect(causes).To(HaveLen(1))
+					Expect(causes[0].Field).To(Equal("domain.firmware.kernelBoot.rootFilesystem"))
+				})
+
+				It("should reject a root filesystem without a matching filesystem", func() {
+					domain.Firmware.KernelBoot.RootFilesystem = "other"
+					causes := validateRootFilesystem(k8sfield.NewPath("domain"), domain)
+					Expect(causes).To(HaveLen(1))
+					Expect(causes[0].Field).To(Equal("domain.firmware.kernelBoot.rootFilesystem"))
+				})
+
+				DescribeTable("should reject kernel arguments setting the root", func(kernelArgs string) {
+					domain.Firmware.KernelBoot.KernelArgs = kernelArgs
+					causes := validateRootFilesystem(k8sfield.NewPath("domain"), domain)
+					Expect(causes).To(HaveLen(1))
+					Expect(causes[0].Field).To(Equal("domain.firmware.kernelBoot.kernelArgs"))
+				},
+					Entry("with root", "console=ttyS0 root=/dev/vda"),
+					Entry("with rootfstype", "rootfstype=ext4"),
+				)
+			})
 		})
 
 		It("should detect invalid containerDisk paths", func() {
@@ -1732,6 +2132,90 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		})
 	})
 
+	Context("with a manual NUMA topology", func() {
+		newManualNUMAVMI := func(opts ...libvmi.Option) *v1.VirtualMachineInstance {
+			return newBaseVmi(append([]libvmi.Option{libvmi.WithCPUCount(2, 1, 2)}, opts...)...)
+		}
+
+		It("should accept nodes covering all vCPUs and the guest memory", func() {
+			vmi := newManualNUMAVMI(
+				libvmi.WithNUMANode("0-1", "256Mi", v1.NUMADistance{Node: 1, Value: 21}),
+				libvmi.WithNUMANode("2,3", "256Mi", v1.NUMADistance{Node: 0, Value: 21}),
+			)
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject", func(expectedField, expectedMessage string, opts ...libvmi.Option) {
+			vmi := newManualNUMAVMI(opts...)
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(ContainElement(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   expectedField,
+				Message: expectedMessage,
+			}))
+		},
+			Entry("the combination with guest mapping passthrough",
+				"fake.domain.cpu.numa.manual",
+				"fake.domain.cpu.numa.manual and fake.domain.cpu.numa.guestMappingPassthrough are mutually exclusive",
+				libvmi.WithNUMANode("0-3", "512Mi"),
+				func(vmi *v1.VirtualMachineInstance) {
+					vmi.Spec.Domain.CPU.NUMA.GuestMappingPassthrough = &v1.NUMAGuestMappingPassthrough{}
+				},
+			),
+			Entry("a vCPU outside of the VMI vCPUs",
+				"fake.domain.cpu.numa.manual.nodes[0].cpus",
+				"fake.domain.cpu.numa.manual.nodes[0].cpus refers to vCPU 4, but the VMI only has 4 vCPUs",
+				libvmi.WithNUMANode("0-4", "512Mi"),
+			),
+			Entry("a vCPU assigned to several nodes",
+				"fake.domain.cpu.numa.manual.nodes[1].cpus",
+				"fake.domain.cpu.numa.manual.nodes[1].cpus assigns vCPU 1 to more than one NUMA node",
+				libvmi.WithNUMANode("0-1", "256Mi"),
+				libvmi.WithNUMANode("1-3", "256Mi"),
+			),
+			Entry("a vCPU not assigned to any node",
+				"fake.domain.cpu.numa.manual.nodes",
+				"fake.domain.cpu.numa.manual.nodes must assign each of the 4 vCPUs to a NUMA node",
+				libvmi.WithNUMANode("0-2", "512Mi"),
+			),
+			Entry("nodes memory not matching the guest memory",
+				"fake.domain.cpu.numa.manual.nodes",
+				"the memory of the NUMA nodes in fake.domain.cpu.numa.manual.nodes adds up to 768Mi, but the guest memory is 512Mi",
+				libvmi.WithNUMANode("0-1", "256Mi"),
+				libvmi.WithNUMANode("2-3", "512Mi"),
+			),
+			Entry("a distance to a non-existing node",
+				"fake.domain.cpu.numa.manual.nodes[0].distances[0].node",
+				"fake.domain.cpu.numa.manual.nodes[0].distances[0].node refers to the non-existing NUMA node 1",
+				libvmi.WithNUMANode("0-3", "512Mi", v1.NUMADistance{Node: 1, Value: 20}),
+			),
+			Entry("a distance out of range",
+				"fake.domain.cpu.numa.manual.nodes[1].distances[0].value",
+				"fake.domain.cpu.numa.manual.nodes[1].distances[0].value must be between 10 and 255",
+				libvmi.WithNUMANode("0-1", "256Mi"),
+				libvmi.WithNUMANode("2-3", "256Mi", v1.NUMADistance{Node: 0, Value: 256}),
+			),
+			Entry("a distance of a node to itself other than 10",
+				"fake.domain.cpu.numa.manual.nodes[0].distances[0].value",
+				"fake.domain.cpu.numa.manual.nodes[0].distances[0].value must be 10 for the distance of a NUMA node to itself",
+				libvmi.WithNUMANode("0-3", "512Mi", v1.NUMADistance{Node: 0, Value: 20}),
+			),
+			Entry("a distance set more than once",
+				"fake.domain.cpu.numa.manual.nodes[0].distances[1].node",
+				"fake.domain.cpu.numa.manual.nodes[0].distances sets the distance to NUMA node 1 more than once",
+				libvmi.WithNUMANode("0-1", "256Mi", v1.NUMADistance{Node: 1, Value: 20}, v1.NUMADistance{Node: 1, Value: 30}),
+				libvmi.WithNUMANode("2-3", "256Mi"),
+			),
+			Entry("asymmetric distances",
+				"fake.domain.cpu.numa.manual.nodes[1].distances[0].value",
+				"fake.domain.cpu.numa.manual.nodes[1].distances[0].value must be 20, the distance of NUMA node 0 back to node 1",
+				libvmi.WithNUMANode("0-1", "256Mi", v1.NUMADistance{Node: 1, Value: 20}),
+				libvmi.WithNUMANode("2-3", "256Mi", v1.NUMADistance{Node: 0, Value: 30}),
+			),
+		)
+	})
+
 	Context("with AccessCredentials", func() {
 		var vmi *v1.VirtualMachineInstance
 
@@ -2000,6 +2484,39 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		})
 	})
 
+	Context("with CPU cache", func() {
+		DescribeTable("should accept", func(model string, cache *v1.CPUCache) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.CPU = &v1.CPU{Model: model, Cache: cache}
+			Expect(ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)).To(BeEmpty())
+		},
+			Entry("passthrough with the host-passthrough model", v1.CPUModeHostPassthrough,
+				&v1.CPUCache{Mode: v1.CPUCacheModePassthrough}),
+			Entry("emulate of level 3", "", &v1.CPUCache{Mode: v1.CPUCacheModeEmulate, Level: pointer.P(uint32(3))}),
+			Entry("disable of all levels", "", &v1.CPUCache{Mode: v1.CPUCacheModeDisable}),
+			Entry("disable of level 3", "", &v1.CPUCache{Mode: v1.CPUCacheModeDisable, Level: pointer.P(uint32(3))}),
+		)
+
+		DescribeTable("should reject", func(model string, cache *v1.CPUCache, expectedField string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.CPU = &v1.CPU{Model: model, Cache: cache}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("an unknown mode", "", &v1.CPUCache{Mode: "unknown"}, "fake.domain.cpu.cache.mode"),
+			Entry("passthrough without the host-passthrough model", v1.CPUModeHostModel,
+				&v1.CPUCache{Mode: v1.CPUCacheModePassthrough}, "fake.domain.cpu.cache.mode"),
+			Entry("passthrough with a level", v1.CPUModeHostPassthrough,
+				&v1.CPUCache{Mode: v1.CPUCacheModePassthrough, Level: pointer.P(uint32(3))}, "fake.domain.cpu.cache.level"),
+			Entry("emulate without a level", "", &v1.CPUCache{Mode: v1.CPUCacheModeEmulate}, "fake.domain.cpu.cache.level"),
+			Entry("emulate of level 2", "",
+				&v1.CPUCache{Mode: v1.CPUCacheModeEmulate, Level: pointer.P(uint32(2))}, "fake.domain.cpu.cache.level"),
+			Entry("disable of level 1", "",
+				&v1.CPUCache{Mode: v1.CPUCacheModeDisable, Level: pointer.P(uint32(1))}, "fake.domain.cpu.cache.level"),
+		)
+	})
+
 	Context("with downwardmetrics virtio serial", func() {
 		var vmi *v1.VirtualMachineInstance
 		validate := func() []metav1.StatusCause {
@@ -2114,6 +2631,71 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(BeEmpty())
 		})
 
+		DescribeTable("should validate vhostUserBlk volumes", func(featureGates []string, path string, expectedMessages ...string) {
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = featureGates
+			kvConfig.Spec.Configuration.VhostUserBlkSockets = &v1.HostSocketDirectoriesConfiguration{
+				PermittedDirectories: []string{"/var/tmp/spdk"},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "testVhostUserBlk",
+				VolumeSource: v1.VolumeSource{
+					VhostUserBlk: &v1.VhostUserBlkVolumeSource{Path: path},
+				},
+			})
+
+			causes := validateVolumes(k8sfield.NewPath("fake"), vmi.Spec.Volumes, config)
+			Expect(causes).To(HaveLen(len(expectedMessages)))
+			for i, message := range expectedMessages {
+				Expect(causes[i].Message).To(Equal(message))
+			}
+		},
+			Entry("and accept them if the feature gate is enabled", []string{featuregate.VhostUserBlkGate}, "/var/tmp/spdk/default/vhost.0"),
+			Entry("and reject them if the feature gate is not enabled", nil, "/var/tmp/spdk/default/vhost.0",
+				"VhostUserBlk feature gate is not enabled"),
+			Entry("and reject a relative socket path", []string{featuregate.VhostUserBlkGate}, "vhost.0",
+				"fake[0].vhostUserBlk.path must be an absolute path to the socket of the vhost-user-blk backend"),
+			Entry("and reject a socket outside of the permitted directories", []string{featuregate.VhostUserBlkGate}, "/var/lib/kubelet/vhost.0",
+				"fake[0].vhostUserBlk.path '/var/lib/kubelet/vhost.0' is not in a permitted vhost-user-blk socket directory"),
+			Entry("and reject a socket outside of the namespace directories", []string{featuregate.VhostUserBlkGate}, "/var/tmp/spdk/vhost.0",
+				"fake[0].vhostUserBlk.path '/var/tmp/spdk/vhost.0' is not in a permitted vhost-user-blk socket directory"),
+			Entry("and reject a socket escaping the permitted directories", []string{featuregate.VhostUserBlkGate}, "/var/tmp/spdk/../../../etc/vhost.0",
+				"fake[0].vhostUserBlk.path '/var/tmp/spdk/../../../etc/vhost.0' is not in a permitted vhost-user-blk socket directory"),
+		)
+
+		DescribeTable("should validate hostBlockDevice volumes", func(featureGates []string, paths []string, expectedMessages ...string) {
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = featureGates
+			kvConfig.Spec.Configuration.HostBlockDevices = &v1.HostBlockDevicesConfiguration{
+				PermittedPaths: []string{"/dev/nvme1n1", "/dev/nvme2n1"},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+			for i, path := range paths {
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+					Name: fmt.Sprintf("testHostBlockDevice%d", i),
+					VolumeSource: v1.VolumeSource{
+						HostBlockDevice: &v1.HostBlockDeviceVolumeSource{Path: path},
+					},
+				})
+			}
+
+			causes := validateVolumes(k8sfield.NewPath("fake"), vmi.Spec.Volumes, config)
+			Expect(causes).To(HaveLen(len(expectedMessages)))
+			for i, message := range expectedMessages {
+				Expect(causes[i].Message).To(Equal(message))
+			}
+		},
+			Entry("and accept permitted devices if the feature gate is enabled",
+				[]string{featuregate.HostBlockDeviceGate}, []string{"/dev/nvme1n1", "/dev/nvme2n1"}),
+			Entry("and reject them if the feature gate is not enabled", nil, []string{"/dev/nvme1n1"},
+				"HostBlockDevice feature gate is not enabled"),
+			Entry("and reject a device which is not permitted", []string{featuregate.HostBlockDeviceGate}, []string{"/dev/sda"},
+				"fake[0].hostBlockDevice.path '/dev/sda' is not a permitted host block device"),
+			Entry("and reject a device used by two volumes", []string{featuregate.HostBlockDeviceGate}, []string{"/dev/nvme1n1", "/dev/nvme1n1"},
+				"fake[1].hostBlockDevice.path '/dev/nvme1n1' is already used by another volume"),
+		)
+
 		It("should reject VMI creation with utility volumes in spec", func() {
 			vmi.Spec.UtilityVolumes = []v1.UtilityVolume{
 				{
@@ -2390,6 +2972,22 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Message).To(Equal("UEFI secure boot is currently not supported on aarch64 Arch"))
 		})
 
+		DescribeTable("should validate the PTP clock", func(clock *v1.Clock, expectedCauses int) {
+			causes := validateClock(k8sfield.NewPath("fake"), clock)
+			Expect(causes).To(HaveLen(expectedCauses))
+			if expectedCauses != 0 {
+				Expect(causes[0].Field).To(Equal("fake.ptp"))
+			}
+		},
+			Entry("without clock", nil, 0),
+			Entry("with a PTP clock", &v1.Clock{PTP: &v1.PTPClock{}}, 0),
+			Entry("with a PTP clock and the kvm timer", &v1.Clock{PTP: &v1.PTPClock{}, Timer: &v1.Timer{KVM: &v1.KVMTimer{}}}, 0),
+			Entry("with a PTP clock and a disabled kvm timer",
+				&v1.Clock{PTP: &v1.PTPClock{}, Timer: &v1.Timer{KVM: &v1.KVMTimer{Enabled: pointer.P(false)}}}, 1),
+			Entry("with a disabled kvm timer without PTP clock",
+				&v1.Clock{Timer: &v1.Timer{KVM: &v1.KVMTimer{Enabled: pointer.P(false)}}}, 0),
+		)
+
 		DescribeTable("should validate ACPI", func(acpi *v1.ACPI, volumes []v1.Volume, expectedLen int, expectedMessage string) {
 			vmi.Spec.Domain.Firmware = &v1.Firmware{ACPI: acpi}
 			vmi.Spec.Volumes = volumes
@@ -2452,6 +3050,63 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				}, 1, "Volume of unsupported type"),
 		)
 
+		DescribeTable("should validate fw_cfg entries", func(entries []v1.FWCfgEntry, expectedFields ...string) {
+			vmi.Spec.Domain.Firmware = &v1.Firmware{FWCfg: entries}
+			vmi.Spec.Volumes = []v1.Volume{
+				{
+					Name: "config",
+					VolumeSource: v1.VolumeSource{
+						ConfigMap: &v1.ConfigMapVolumeSource{
+							LocalObjectReference: k8sv1.LocalObjectReference{Name: "configmap-config"},
+						},
+					},
+				},
+				{
+					Name: "secret",
+					VolumeSource: v1.VolumeSource{
+						Secret: &v1.SecretVolumeSource{SecretName: "secret-config"},
+					},
+				},
+				{
+					Name:         "disk",
+					VolumeSource: v1.VolumeSource{EmptyDisk: &v1.EmptyDiskSource{}},
+				},
+			}
+			causes := validateFirmwareFWCfg(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(len(expectedFields)))
+			for i, field := range expectedFields {
+				Expect(causes[i].Field).To(Equal(field))
+			}
+		},
+			Entry("with a configmap entry", []v1.FWCfgEntry{{Name: "opt/com.example/config", VolumeNameRef: "config", Key: "data"}}),
+			Entry("with a secret entry", []v1.FWCfgEntry{{Name: "opt/com.example/config", VolumeNameRef: "secret", Key: "data"}}),
+			Entry("with a name outside of opt/",
+				[]v1.FWCfgEntry{{Name: "etc/config", VolumeNameRef: "config", Key: "data"}}, "fake[0].name"),
+			Entry("with a too long name",
+				[]v1.FWCfgEntry{{Name: "opt/" + strings.Repeat("a", 52), VolumeNameRef: "config", Key: "data"}}, "fake[0].name"),
+			Entry("with duplicate names",
+				[]v1.FWCfgEntry{
+					{Name: "opt/com.example/config", VolumeNameRef: "config", Key: "data"},
+					{Name: "opt/com.example/config", VolumeNameRef: "secret", Key: "data"},
+				}, "fake[1].name"),
+			Entry("with a name injecting a file property",
+				[]v1.FWCfgEntry{{Name: "opt/a,file=/etc/shadow", VolumeNameRef: "config", Key: "data"}}, "fake[0].name"),
+			Entry("with a name containing an equal sign",
+				[]v1.FWCfgEntry{{Name: "opt/a=b", VolumeNameRef: "config", Key: "data"}}, "fake[0].name"),
+			Entry("with a name containing a non-printable character",
+				[]v1.FWCfgEntry{{Name: "opt/a\nb", VolumeNameRef: "config", Key: "data"}}, "fake[0].name"),
+			Entry("with an invalid key",
+				[]v1.FWCfgEntry{{Name: "opt/com.example/config", VolumeNameRef: "config", Key: "../data"}}, "fake[0].key"),
+			Entry("with a key injecting a property",
+				[]v1.FWCfgEntry{{Name: "opt/com.example/config", VolumeNameRef: "config", Key: "data,string=x"}}, "fake[0].key"),
+			Entry("with a key containing a non-printable character",
+				[]v1.FWCfgEntry{{Name: "opt/com.example/config", VolumeNameRef: "config", Key: "da\x00ta"}}, "fake[0].key"),
+			Entry("without a matching volume",
+				[]v1.FWCfgEntry{{Name: "opt/com.example/config", VolumeNameRef: "missing", Key: "data"}}, "fake[0].volumeNameRef"),
+			Entry("with a volume of unsupported type",
+				[]v1.FWCfgEntry{{Name: "opt/com.example/config", VolumeNameRef: "disk", Key: "data"}}, "fake[0].volumeNameRef"),
+		)
+
 		DescribeTable("validating cpu model with", func(model string, expectedLen int) {
 			vmi.Spec.Domain.CPU = &v1.CPU{Model: model}
 
@@ -3609,6 +4264,14 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Entry("no watchdog configured", nil, "", false),
 		)
 
+		It("should reject a PTP clock on s390x", func() {
+			vmi.Spec.Domain.Clock = &v1.Clock{PTP: &v1.PTPClock{}}
+			causes := webhooks.ValidateVirtualMachineInstanceS390XSetting(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.clock.ptp"))
+			Expect(causes[0].Message).To(Equal("PTP clock is not supported on s390x architecture"))
+		})
+
 		DescribeTable("validate for arm64",
 			func(watchdog *v1.Watchdog, expectedMessage string, shouldReject bool) {
 				vmi.Spec.Domain.Devices.Watchdog = watchdog
@@ -3622,24 +4285,373 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 					Expect(causes).To(BeEmpty())
 				}
 			},
-			Entry("I6300ESB is rejected", &v1.Watchdog{
+			Entry("I6300ESB is accepted", &v1.Watchdog{
 				Name: "w5",
 				WatchdogDevice: v1.WatchdogDevice{
 					I6300ESB: &v1.I6300ESBWatchdog{Action: v1.WatchdogActionPoweroff},
 				},
-			}, "Arm64 not support Watchdog device", true),
+			}, "", false),
 
 			Entry("Diag288 is rejected", &v1.Watchdog{
 				Name: "w6",
 				WatchdogDevice: v1.WatchdogDevice{
 					Diag288: &v1.Diag288Watchdog{Action: v1.WatchdogActionPoweroff},
 				},
-			}, "Arm64 not support Watchdog device", true),
+			}, "Arm64 only supports I6300ESB watchdog device", true),
 
 			Entry("no watchdog configured", nil, "", false),
 		)
 	})
 
+	Context("Serial console target type validation", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+		})
+
+		DescribeTable("validate for amd64", func(targetType v1.SerialTargetType, shouldReject bool) {
+			vmi.Spec.Domain.Devices.SerialConsoleTargetType = targetType
+			causes := webhooks.ValidateVirtualMachineInstanceAmd64Setting(k8sfield.NewPath("fake"), &vmi.Spec)
+
+			if shouldReject {
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.serialConsoleTargetType"))
+			} else {
+				Expect(causes).To(BeEmpty())
+			}
+		},
+			Entry("unset is accepted", v1.SerialTargetType(""), false),
+			Entry("isa-serial is accepted", v1.SerialTargetTypeISA, false),
+			Entry("pci-serial is accepted", v1.SerialTargetTypePCI, false),
+			Entry("usb-serial is accepted", v1.SerialTargetTypeUSB, false),
+			Entry("an unknown type is rejected", v1.SerialTargetType("spapr-vio-serial"), true),
+		)
+
+		It("should reject a serial console target type on arm64", func() {
+			vmi.Spec.Domain.Devices.SerialConsoleTargetType = v1.SerialTargetTypePCI
+			causes := webhooks.ValidateVirtualMachineInstanceArm64Setting(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.serialConsoleTargetType"))
+		})
+
+		It("should reject a serial console target type on s390x", func() {
+			vmi.Spec.Domain.Devices.SerialConsoleTargetType = v1.SerialTargetTypePCI
+			causes := webhooks.ValidateVirtualMachineInstanceS390XSetting(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.serialConsoleTargetType"))
+		})
+	})
+
+	Context("TPM validation", func() {
+		enableExternalTPM := func(featureGates ...string) {
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = featureGates
+			kvConfig.Spec.Configuration.ExternalTPMSockets = &v1.HostSocketDirectoriesConfiguration{
+				PermittedDirectories: []string{"/var/run/vtpm"},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+		}
+
+		AfterEach(func() {
+			disableFeatureGates()
+		})
+
+		DescribeTable("should accept", func(device *v1.TPMDevice) {
+			enableExternalTPM(featuregate.ExternalTPMGate)
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.TPM = device
+
+			Expect(validateTPM(k8sfield.NewPath("fake"), &vmi.Spec, config)).To(BeEmpty())
+		},
+			Entry("a TPM 1.2", &v1.TPMDevice{Version: v1.TPMVersion12, Persistent: pointer.P(true)}),
+			Entry("a TPM 2.0", &v1.TPMDevice{Version: v1.TPMVersion20}),
+			Entry("an external swtpm", &v1.TPMDevice{External: &v1.TPMExternal{SocketPath: "/var/run/vtpm/default/testvmi.sock"}}),
+			Entry("an invalid disabled TPM", &v1.TPMDevice{Enabled: pointer.P(false), Version: "3.0"}),
+		)
+
+		DescribeTable("should reject", func(featureGates []string, device *v1.TPMDevice, expectedMessages ...string) {
+			enableExternalTPM(featureGates...)
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.TPM = device
+
+			causes := validateTPM(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(len(expectedMessages)))
+			for i, message := range expectedMessages {
+				Expect(causes[i].Message).To(Equal(message))
+			}
+		},
+			Entry("an unsupported version", nil, &v1.TPMDevice{Version: "3.0"},
+				"fake.version must be one of 1.2, 2.0"),
+			Entry("an external swtpm without the feature gate", nil,
+				&v1.TPMDevice{External: &v1.TPMExternal{SocketPath: "/var/run/vtpm/default/testvmi.sock"}},
+				"ExternalTPM feature gate is not enabled"),
+			Entry("an external swtpm on a relative socket path", []string{featuregate.ExternalTPMGate},
+				&v1.TPMDevice{External: &v1.TPMExternal{SocketPath: "testvmi.sock"}},
+				"fake.external.socketPath must be an absolute path to the socket of the swtpm"),
+			Entry("an external swtpm outside of the permitted socket directories", []string{featuregate.ExternalTPMGate},
+				&v1.TPMDevice{External: &v1.TPMExternal{SocketPath: "/var/lib/kubelet/testvmi.sock"}},
+				"fake.external.socketPath '/var/lib/kubelet/testvmi.sock' is not in a permitted swtpm socket directory"),
+			Entry("an external swtpm outside of the namespace directories", []string{featuregate.ExternalTPMGate},
+				&v1.TPMDevice{External: &v1.TPMExternal{SocketPath: "/var/run/vtpm/testvmi.sock"}},
+				"fake.external.socketPath '/var/run/vtpm/testvmi.sock' is not in a permitted swtpm socket directory"),
+			Entry("an external swtpm with a version and a persistent state", []string{featuregate.ExternalTPMGate},
+				&v1.TPMDevice{Version: v1.TPMVersion12, Persistent: pointer.P(true), External: &v1.TPMExternal{SocketPath: "/var/run/vtpm/default/testvmi.sock"}},
+				"fake.version cannot be set with an external swtpm", "fake.persistent cannot be set with an external swtpm"),
+		)
+	})
+
+	Context("host socket namespace validation", func() {
+		BeforeEach(func() {
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.ExternalTPMSockets = &v1.HostSocketDirectoriesConfiguration{
+				PermittedDirectories: []string{"/var/run/vtpm"},
+			}
+			kvConfig.Spec.Configuration.VhostUserBlkSockets = &v1.HostSocketDirectoriesConfiguration{
+				PermittedDirectories: []string{"/var/tmp/spdk"},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+		})
+
+		newVMI := func(tpmSocketPath, vhostUserBlkPath string) *v1.VirtualMachineInstance {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.TPM = &v1.TPMDevice{External: &v1.TPMExternal{SocketPath: tpmSocketPath}}
+			vmi.Spec.Volumes = []v1.Volume{{
+				Name:         "spdk-disk",
+				VolumeSource: v1.VolumeSource{VhostUserBlk: &v1.VhostUserBlkVolumeSource{Path: vhostUserBlkPath}},
+			}}
+			return vmi
+		}
+
+		It("should accept sockets in the directories of the namespace", func() {
+			vmi := newVMI("/var/run/vtpm/ns1/testvmi.sock", "/var/tmp/spdk/ns1/vm1/vhost.0")
+			Expect(validateHostSocketNamespaces(k8sfield.NewPath("fake"), &vmi.Spec, "ns1", config)).To(BeEmpty())
+		})
+
+		It("should reject sockets in the directories of another namespace", func() {
+			vmi := newVMI("/var/run/vtpm/ns2/testvmi.sock", "/var/tmp/spdk/ns2/vhost.0")
+			causes := validateHostSocketNamespaces(k8sfield.NewPath("fake"), &vmi.Spec, "ns1", config)
+			Expect(causes).To(HaveLen(2))
+			Expect(causes[0].Message).To(Equal("fake.domain.devices.tpm.external.socketPath '/var/run/vtpm/ns2/testvmi.sock' is not in the swtpm socket directory of namespace ns1"))
+			Expect(causes[1].Message).To(Equal("fake.volumes[0].vhostUserBlk.path '/var/tmp/spdk/ns2/vhost.0' is not in the vhost-user-blk socket directory of namespace ns1"))
+		})
+	})
+
+	Context("SGX validation", func() {
+		AfterEach(func() {
+			disableFeatureGates()
+		})
+
+		It("should accept an EPC when the feature gate is enabled", func() {
+			enableFeatureGates(featuregate.SGXGate)
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.SGX = &v1.SGX{EPCSize: resource.MustParse("64Mi")}
+
+			Expect(validateSGX(k8sfield.NewPath("fake"), &vmi.Spec, config)).To(BeEmpty())
+		})
+
+		DescribeTable("should reject", func(featureGates []string, mutate func(*v1.VirtualMachineInstanceSpec), expectedMessages ...string) {
+			enableFeatureGates(featureGates...)
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.SGX = &v1.SGX{EPCSize: resource.MustParse("64Mi")}
+			mutate(&vmi.Spec)
+
+			causes := validateSGX(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(len(expectedMessages)))
+			for i, message := range expectedMessages {
+				Expect(causes[i].Message).To(Equal(message))
+			}
+		},
+			Entry("an EPC without the feature gate", nil, func(*v1.VirtualMachineInstanceSpec) {},
+				"SGX feature gate is not enabled"),
+			Entry("an EPC on a non amd64 architecture", []string{featuregate.SGXGate},
+				func(spec *v1.VirtualMachineInstanceSpec) { spec.Architecture = "arm64" },
+				"No SGX support for architecture: arm64"),
+			Entry("an empty EPC", []string{featuregate.SGXGate},
+				func(spec *v1.VirtualMachineInstanceSpec) { spec.Domain.Devices.SGX.EPCSize = resource.MustParse("0") },
+				"fake.domain.devices.sgx.epcSize must be greater than zero"),
+			Entry("an EPC with memory hotplug", []string{featuregate.SGXGate},
+				func(spec *v1.VirtualMachineInstanceSpec) {
+					spec.Domain.Memory = &v1.Memory{Guest: pointer.P(resource.MustParse("1Gi")), MaxGuest: pointer.P(resource.MustParse("4Gi"))}
+				},
+				"fake.domain.devices.sgx cannot be combined with memory hotplug"),
+		)
+	})
+
+	Context("Shutdown ladder validation", func() {
+		It("should accept a ladder escalating from the guest agent to ACPI", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.ShutdownLadder = &v1.ShutdownLadder{Steps: []v1.ShutdownStep{
+				{Method: v1.ShutdownMethodGuestAgent, TimeoutSeconds: 30},
+				{Method: v1.ShutdownMethodACPI, TimeoutSeconds: 60},
+			}}
+
+			Expect(validateShutdownLadder(k8sfield.NewPath("fake"), &vmi.Spec)).To(BeEmpty())
+		})
+
+		DescribeTable("should reject", func(steps []v1.ShutdownStep, expectedMessages ...string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.ShutdownLadder = &v1.ShutdownLadder{Steps: steps}
+
+			causes := validateShutdownLadder(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(len(expectedMessages)))
+			for i, message := range expectedMessages {
+				Expect(causes[i].Message).To(Equal(message))
+			}
+		},
+			Entry("a ladder without steps", nil,
+				"fake.steps must contain at least one step"),
+			Entry("an explicit destroy step", []v1.ShutdownStep{{Method: v1.ShutdownMethodDestroy, TimeoutSeconds: 30}},
+				"fake.steps[0].method must be one of GuestAgent, ACPI"),
+			Entry("a step without timeout", []v1.ShutdownStep{
+				{Method: v1.ShutdownMethodGuestAgent, TimeoutSeconds: 30},
+				{Method: v1.ShutdownMethodACPI},
+			},
+				"fake.steps[1].timeoutSeconds must be greater than zero"),
+		)
+	})
+
+	Context("Guest agent allowed commands validation", func() {
+		It("should accept any command when the guest agent is not restricted", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.ReadinessProbe = &v1.Probe{Handler: v1.Handler{Exec: &k8sv1.ExecAction{Command: []string{"true"}}}}
+
+			Expect(validateGuestAgent(k8sfield.NewPath("fake"), &vmi.Spec)).To(BeEmpty())
+		})
+
+		It("should accept the commands needed by the VMI", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.GuestAgent = &v1.GuestAgent{AllowedCommands: []string{"guest-exec", "guest-exec-status", "guest-shutdown"}}
+			vmi.Spec.ReadinessProbe = &v1.Probe{Handler: v1.Handler{Exec: &k8sv1.ExecAction{Command: []string{"true"}}}}
+			vmi.Spec.ShutdownLadder = &v1.ShutdownLadder{Steps: []v1.ShutdownStep{{Method: v1.ShutdownMethodGuestAgent, TimeoutSeconds: 30}}}
+
+			Expect(validateGuestAgent(k8sfield.NewPath("fake"), &vmi.Spec)).To(BeEmpty())
+		})
+
+		DescribeTable("should reject", func(spec v1.VirtualMachineInstanceSpec, expectedMessages ...string) {
+			causes := validateGuestAgent(k8sfield.NewPath("fake"), &spec)
+			Expect(causes).To(HaveLen(len(expectedMessages)))
+			for i, message := range expectedMessages {
+				Expect(causes[i].Message).To(Equal(message))
+			}
+		},
+			Entry("a command which is not a guest agent command", v1.VirtualMachineInstanceSpec{
+				GuestAgent: &v1.GuestAgent{AllowedCommands: []string{"query-status"}},
+			},
+				"fake.guestAgent.allowedCommands[0] is not a guest agent command"),
+			Entry("an exec probe without guest-exec-status", v1.VirtualMachineInstanceSpec{
+				GuestAgent:    &v1.GuestAgent{AllowedCommands: []string{"guest-exec"}},
+				LivenessProbe: &v1.Probe{Handler: v1.Handler{Exec: &k8sv1.ExecAction{Command: []string{"true"}}}},
+			},
+				"fake.guestAgent.allowedCommands[0] requires the guest-exec-status guest agent command to be allowed in fake.guestAgent.allowedCommands",
+				"fake.livenessProbe.exec requires the guest-exec-status guest agent command to be allowed in fake.guestAgent.allowedCommands"),
+			Entry("a filesystem freeze without guest-fsfreeze-status", v1.VirtualMachineInstanceSpec{
+				GuestAgent: &v1.GuestAgent{AllowedCommands: []string{"guest-fsfreeze-freeze", "guest-fsfreeze-thaw"}},
+			},
+				"fake.guestAgent.allowedCommands[0] requires the guest-fsfreeze-status guest agent command to be allowed in fake.guestAgent.allowedCommands",
+				"fake.guestAgent.allowedCommands[1] requires the guest-fsfreeze-status guest agent command to be allowed in fake.guestAgent.allowedCommands"),
+			Entry("a password propagated by the guest agent without guest-set-user-password", v1.VirtualMachineInstanceSpec{
+				GuestAgent: &v1.GuestAgent{},
+				AccessCredentials: []v1.AccessCredential{{UserPassword: &v1.UserPasswordAccessCredential{
+					PropagationMethod: v1.UserPasswordAccessCredentialPropagationMethod{
+						QemuGuestAgent: &v1.QemuGuestAgentUserPasswordAccessCredentialPropagation{},
+					},
+				}}},
+			},
+				"fake.accessCredentials[0].userPassword.propagationMethod.qemuGuestAgent requires the guest-set-user-password guest agent command to be allowed in fake.guestAgent.allowedCommands"),
+			Entry("ssh keys propagated by the guest agent without the guest-ssh commands", v1.VirtualMachineInstanceSpec{
+				GuestAgent: &v1.GuestAgent{AllowedCommands: []string{"guest-ssh-get-authorized-keys", "guest-ssh-add-authorized-keys"}},
+				AccessCredentials: []v1.AccessCredential{{SSHPublicKey: &v1.SSHPublicKeyAccessCredential{
+					PropagationMethod: v1.SSHPublicKeyAccessCredentialPropagationMethod{
+						QemuGuestAgent: &v1.QemuGuestAgentSSHPublicKeyAccessCredentialPropagation{Users: []string{"fedora"}},
+					},
+				}}},
+			},
+				"fake.accessCredentials[0].sshPublicKey.propagationMethod.qemuGuestAgent requires the guest-ssh-remove-authorized-keys guest agent command to be allowed in fake.guestAgent.allowedCommands"),
+			Entry("a guest agent shutdown step without guest-shutdown", v1.VirtualMachineInstanceSpec{
+				GuestAgent:     &v1.GuestAgent{},
+				ShutdownLadder: &v1.ShutdownLadder{Steps: []v1.ShutdownStep{{Method: v1.ShutdownMethodGuestAgent, TimeoutSeconds: 30}}},
+			},
+				"fake.shutdownLadder.steps[0] requires the guest-shutdown guest agent command to be allowed in fake.guestAgent.allowedCommands"),
+		)
+	})
+
+	Context("Device limits validation", func() {
+		newVMIWithDevices := func(arch string, disks, interfaces int, bus v1.DiskBus) *v1.VirtualMachineInstance {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Architecture = arch
+			for i := 0; i < disks; i++ {
+				vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+					Name:       fmt.Sprintf("disk%d", i),
+					DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: bus}},
+				})
+			}
+			for i := 0; i < interfaces; i++ {
+				vmi.Spec.Domain.Devices.Interfaces = append(vmi.Spec.Domain.Devices.Interfaces, v1.Interface{Name: fmt.Sprintf("net%d", i)})
+			}
+			return vmi
+		}
+
+		DescribeTable("should accept", func(vmi *v1.VirtualMachineInstance) {
+			Expect(ValidateVirtualMachineInstancePerArch(k8sfield.NewPath("fake"), &vmi.Spec)).To(BeEmpty())
+		},
+			Entry("as many PCI devices as amd64 supports", newVMIWithDevices("amd64", 200, 24, v1.DiskBusVirtio)),
+			Entry("more disks on the scsi bus than PCI slots on amd64", newVMIWithDevices("amd64", 256, 1, v1.DiskBusSCSI)),
+			Entry("more virtio disks than PCI slots on s390x", newVMIWithDevices("s390x", 256, 16, v1.DiskBusVirtio)),
+		)
+
+		DescribeTable("should reject more PCI devices than supported", func(vmi *v1.VirtualMachineInstance, expectedMessage string) {
+			causes := ValidateVirtualMachineInstancePerArch(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices"))
+			Expect(causes[0].Message).To(Equal(expectedMessage))
+		},
+			Entry("on amd64", newVMIWithDevices("amd64", 200, 25, v1.DiskBusVirtio),
+				"fake.domain.devices has 225 disks on the virtio bus, interfaces, host devices, GPUs and filesystems, amd64 supports at most 224 of them"),
+			Entry("on arm64 with disks on the default bus", newVMIWithDevices("arm64", 225, 0, ""),
+				"fake.domain.devices has 225 disks on the virtio bus, interfaces, host devices, GPUs and filesystems, arm64 supports at most 224 of them"),
+		)
+	})
+
+	Context("PCI address conflicts validation", func() {
+		It("should accept distinct PCI addresses", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{
+				{Name: "disk0", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio, PciAddress: "0000:00:05.0"}}},
+			}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default", PciAddress: "0000:00:06.0"}}
+
+			Expect(webhooks.ValidatePCIAddressConflicts(k8sfield.NewPath("fake"), &vmi.Spec)).To(BeEmpty())
+		})
+
+		It("should reject conflicting PCI addresses and suggest distinct free slots", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{
+				{Name: "disk0", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio, PciAddress: "0000:00:05.0"}}},
+				{Name: "disk1", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio, PciAddress: "0000:00:06.0"}}},
+			}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				{Name: "default", PciAddress: "0000:00:05.0"},
+				{Name: "secondary", PciAddress: "0000:00:05.0"},
+			}
+
+			Expect(webhooks.ValidatePCIAddressConflicts(k8sfield.NewPath("fake"), &vmi.Spec)).To(ConsistOf(
+				metav1.StatusCause{
+					Type: metav1.CauseTypeFieldValueDuplicate,
+					Message: "interface fake.domain.devices.interfaces[0].name PCI address 0000:00:05.0 conflicts with " +
+						"fake.domain.devices.disks[0].name, the next free slot is 0000:00:07.0",
+					Field: "fake.domain.devices.interfaces[0].pciAddress",
+				},
+				metav1.StatusCause{
+					Type: metav1.CauseTypeFieldValueDuplicate,
+					Message: "interface fake.domain.devices.interfaces[1].name PCI address 0000:00:05.0 conflicts with " +
+						"fake.domain.devices.disks[0].name, the next free slot is 0000:00:08.0",
+					Field: "fake.domain.devices.interfaces[1].pciAddress",
+				},
+			))
+		})
+	})
+
 	Context("with VideoConfig", func() {
 		var vmi *v1.VirtualMachineInstance
 		BeforeEach(func() {
@@ -3726,6 +4738,48 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Entry("s390x rejects none", "s390x", "none"),
 			Entry("s390x rejects invalid model", "s390x", "invalidmodel"),
 		)
+
+		It("should reject unsupported models of multiple video devices per architecture", func() {
+			vmi.Spec.Domain.Devices.Video = nil
+			vmi.Spec.Domain.Devices.Videos = []v1.VideoDevice{{Type: v1.VirtIO}, {Type: "vga"}}
+			vmi.Spec.Architecture = "arm64"
+			causes := ValidateVirtualMachineInstancePerArch(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.videos[1].type"))
+		})
+
+		It("should accept multiple virtio video devices", func() {
+			vmi.Spec.Domain.Devices.Video = nil
+			vmi.Spec.Domain.Devices.Videos = []v1.VideoDevice{
+				{Type: "vga", Heads: pointer.P(uint32(1))},
+				{Type: v1.VirtIO, Heads: pointer.P(uint32(2)), VRAM: pointer.P(resource.MustParse("64Mi")), Accel3D: v1.VideoAccel3DVirgl},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject invalid video devices", func(video *v1.VideoDevice, videos []v1.VideoDevice, expectedField string) {
+			vmi.Spec.Domain.Devices.Video = video
+			vmi.Spec.Domain.Devices.Videos = videos
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("with both video and videos",
+				&v1.VideoDevice{Type: v1.VirtIO}, []v1.VideoDevice{{Type: v1.VirtIO}}, "fake.videos"),
+			Entry("with a secondary video device which is not virtio",
+				nil, []v1.VideoDevice{{Type: v1.VirtIO}, {Type: "vga"}}, "fake.videos[1].type"),
+			Entry("without display heads",
+				&v1.VideoDevice{Type: v1.VirtIO, Heads: pointer.P(uint32(0))}, nil, "fake.video.heads"),
+			Entry("with too many display heads",
+				&v1.VideoDevice{Type: v1.VirtIO, Heads: pointer.P(uint32(17))}, nil, "fake.video.heads"),
+			Entry("with a too small video memory",
+				&v1.VideoDevice{Type: v1.VirtIO, VRAM: pointer.P(resource.MustParse("512"))}, nil, "fake.video.vram"),
+			Entry("with 3D acceleration on a vga device",
+				&v1.VideoDevice{Type: "vga", Accel3D: v1.VideoAccel3DVirgl}, nil, "fake.video.accel3d"),
+			Entry("with an unknown 3D acceleration",
+				&v1.VideoDevice{Type: v1.VirtIO, Accel3D: "unknown"}, nil, "fake.video.accel3d"),
+		)
 	})
 
 	Context("with DRA GPUs", func() {
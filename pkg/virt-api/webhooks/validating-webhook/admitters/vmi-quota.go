@@ -0,0 +1,59 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"fmt"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
+
+	overcommitv1 "kubevirt.io/api/overcommit/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/quota"
+)
+
+// validateVirtualizationQuotas rejects domain resources which exceed a VirtualizationQuota of the namespace.
+// The usage of the namespace is taken from the status of the quotas.
+func validateVirtualizationQuotas(requested k8sv1.ResourceList, namespace string, quotaInformer cache.SharedIndexInformer) []metav1.StatusCause {
+	objs, err := quotaInformer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("failed to list the VirtualizationQuotas of namespace %s: %v", namespace, err),
+		}}
+	}
+	quotas := make([]*overcommitv1.VirtualizationQuota, 0, len(objs))
+	for _, obj := range objs {
+		quotas = append(quotas, obj.(*overcommitv1.VirtualizationQuota))
+	}
+
+	var causes []metav1.StatusCause
+	for _, message := range quota.ExceededQuotas(quotas, requested) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   k8sfield.NewPath("spec", "domain").String(),
+			Message: message,
+		})
+	}
+	return causes
+}
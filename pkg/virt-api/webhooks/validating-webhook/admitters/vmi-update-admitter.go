@@ -26,9 +26,12 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
 
+	drautil "kubevirt.io/kubevirt/pkg/dra"
+	"kubevirt.io/kubevirt/pkg/quota"
 	storageadmitters "kubevirt.io/kubevirt/pkg/storage/admitters"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -40,12 +43,14 @@ const nodeNameExtraInfo = "authentication.kubernetes.io/node-name"
 type VMIUpdateAdmitter struct {
 	clusterConfig           *virtconfig.ClusterConfig
 	kubeVirtServiceAccounts map[string]struct{}
+	quotaInformer           cache.SharedIndexInformer
 }
 
-func NewVMIUpdateAdmitter(config *virtconfig.ClusterConfig, kubeVirtServiceAccounts map[string]struct{}) *VMIUpdateAdmitter {
+func NewVMIUpdateAdmitter(config *virtconfig.ClusterConfig, kubeVirtServiceAccounts map[string]struct{}, quotaInformer cache.SharedIndexInformer) *VMIUpdateAdmitter {
 	return &VMIUpdateAdmitter{
 		clusterConfig:           config,
 		kubeVirtServiceAccounts: kubeVirtServiceAccounts,
+		quotaInformer:           quotaInformer,
 	}
 }
 
@@ -107,6 +112,9 @@ func (admitter *VMIUpdateAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 			if hotplugResponse != nil {
 				return hotplugResponse
 			}
+			if response := admitter.admitVirtualizationQuotas(oldVMI, newVMI); response != nil {
+				return response
+			}
 		} else {
 			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 				{
@@ -129,6 +137,22 @@ func (admitter *VMIUpdateAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 	}
 }
 
+// admitVirtualizationQuotas rejects a hotplug of CPU sockets or guest memory which exceeds a VirtualizationQuota,
+// only the growth of the domain resources is checked since the quotas already count the VMI.
+func (admitter *VMIUpdateAdmitter) admitVirtualizationQuotas(oldVMI, newVMI *v1.VirtualMachineInstance) *admissionv1.AdmissionResponse {
+	if !admitter.clusterConfig.VirtualizationQuotasEnabled() || admitter.quotaInformer == nil {
+		return nil
+	}
+	growth := quota.Growth(oldVMI, newVMI)
+	if len(growth) == 0 {
+		return nil
+	}
+	if causes := validateVirtualizationQuotas(growth, newVMI.Namespace, admitter.quotaInformer); len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+	return nil
+}
+
 func admitVMILabelsUpdate(
 	newVMI *v1.VirtualMachineInstance,
 	oldVMI *v1.VirtualMachineInstance,
@@ -176,6 +200,14 @@ func admitHotplug(
 		return response
 	}
 
+	if response := admitIOThreadsUpdate(&oldVMI.Spec.Domain, &newVMI.Spec.Domain); response != nil {
+		return response
+	}
+
+	if response := admitUSBDevicesUpdate(&oldVMI.Spec.Domain.Devices, &newVMI.Spec.Domain.Devices, clusterConfig); response != nil {
+		return response
+	}
+
 	if response := storageadmitters.AdmitUtilityVolumes(&newVMI.Spec, &oldVMI.Spec, oldVMI.Status.VolumeStatus, clusterConfig); response != nil {
 		return response
 	}
@@ -222,6 +254,85 @@ func admitHotplugMemory(oldMemory, newMemory *v1.Memory) *admissionv1.AdmissionR
 		})
 	}
 
+	if !equality.Semantic.DeepEqual(oldMemory.Hotplug, newMemory.Hotplug) {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "Memory hotplug changed",
+			},
+		})
+	}
+
+	return nil
+}
+
+func admitIOThreadsUpdate(oldDomain, newDomain *v1.DomainSpec) *admissionv1.AdmissionResponse {
+	if !equality.Semantic.DeepEqual(oldDomain.IOThreadsPolicy, newDomain.IOThreadsPolicy) {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "IOThreads policy changed",
+			},
+		})
+	}
+
+	if equality.Semantic.DeepEqual(oldDomain.IOThreads, newDomain.IOThreads) {
+		return nil
+	}
+
+	if newDomain.IOThreadsPolicy == nil || *newDomain.IOThreadsPolicy != v1.IOThreadsPolicySupplementalPool ||
+		newDomain.IOThreads == nil || newDomain.IOThreads.SupplementalPoolThreadCount == nil ||
+		*newDomain.IOThreads.SupplementalPoolThreadCount < 1 {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "IOThreads can only be changed to a supplementalPoolThreadCount of at least 1 with the supplementalPool policy",
+			},
+		})
+	}
+
+	if oldDomain.IOThreads != nil && oldDomain.IOThreads.SupplementalPoolThreadCount != nil &&
+		*newDomain.IOThreads.SupplementalPoolThreadCount < *oldDomain.IOThreads.SupplementalPoolThreadCount {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "supplementalPoolThreadCount can't be decreased on a running VMI",
+			},
+		})
+	}
+
+	return nil
+}
+
+// admitUSBDevicesUpdate allows USB host devices and USB redirection to be hot-plugged, every other host device
+// has to stay as it is.
+func admitUSBDevicesUpdate(oldDevices, newDevices *v1.Devices, clusterConfig *virtconfig.ClusterConfig) *admissionv1.AdmissionResponse {
+	usbResources := map[string]struct{}{}
+	if hostDevs := clusterConfig.GetPermittedHostDevices(); hostDevs != nil {
+		for _, usbHostDev := range hostDevs.USB {
+			usbResources[usbHostDev.ResourceName] = struct{}{}
+		}
+	}
+
+	nonUSBHostDevices := func(hostDevices []v1.HostDevice) []v1.HostDevice {
+		var filtered []v1.HostDevice
+		for _, hostDev := range hostDevices {
+			if _, isUSB := usbResources[hostDev.DeviceName]; !isUSB || drautil.IsHostDeviceDRA(hostDev) {
+				filtered = append(filtered, hostDev)
+			}
+		}
+		return filtered
+	}
+
+	if !equality.Semantic.DeepEqual(nonUSBHostDevices(oldDevices.HostDevices), nonUSBHostDevices(newDevices.HostDevices)) {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "only USB host devices can be hot-plugged",
+			},
+		})
+	}
+
 	return nil
 }
 
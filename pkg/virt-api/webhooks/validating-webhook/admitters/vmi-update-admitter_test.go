@@ -33,11 +33,14 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
 
 	"kubevirt.io/client-go/api"
 
 	v1 "kubevirt.io/api/core/v1"
+	overcommitv1 "kubevirt.io/api/overcommit/v1alpha1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
@@ -62,7 +65,7 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 		},
 	}
 	config, _, kvStore := testutils.NewFakeClusterConfigUsingKV(kv)
-	vmiUpdateAdmitter := NewVMIUpdateAdmitter(config, webhooks.KubeVirtServiceAccounts(kubeVirtNamespace))
+	vmiUpdateAdmitter := NewVMIUpdateAdmitter(config, webhooks.KubeVirtServiceAccounts(kubeVirtNamespace), nil)
 
 	enableFeatureGate := func(featureGate string) {
 		kvConfig := kv.DeepCopy()
@@ -519,4 +522,206 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 		Expect(resp.Allowed).To(BeFalse())
 	})
 
+	It("should reject updates to the memory hotplug mode", func() {
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.CPU = &v1.CPU{}
+		maxGuest := resource.MustParse("128Mi")
+		vmi.Spec.Domain.Memory = &v1.Memory{
+			MaxGuest: &maxGuest,
+		}
+		updateVmi := vmi.DeepCopy()
+		updateVmi.Spec.Domain.Memory.Hotplug = &v1.MemoryHotplug{VirtioMem: &v1.VirtioMemHotplug{}}
+
+		newVMIBytes, _ := json.Marshal(&updateVmi)
+		oldVMIBytes, _ := json.Marshal(&vmi)
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				UserInfo: authv1.UserInfo{Username: "system:serviceaccount:kubevirt:" + components.ControllerServiceAccountName},
+				Resource: webhooks.VirtualMachineInstanceGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: newVMIBytes,
+				},
+				OldObject: runtime.RawExtension{
+					Raw: oldVMIBytes,
+				},
+				Operation: admissionv1.Update,
+			},
+		}
+		resp := vmiUpdateAdmitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(Equal("Memory hotplug changed"))
+	})
+
+	Context("with VirtualizationQuotas", func() {
+		var quotaAdmitter *VMIUpdateAdmitter
+
+		BeforeEach(func() {
+			quotaInformer, _ := testutils.NewFakeInformerWithIndexersFor(&overcommitv1.VirtualizationQuota{}, cache.Indexers{
+				cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+			})
+			Expect(quotaInformer.GetStore().Add(&overcommitv1.VirtualizationQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: metav1.NamespaceDefault},
+				Spec: overcommitv1.VirtualizationQuotaSpec{Hard: k8sv1.ResourceList{
+					overcommitv1.ResourceVCPUs:       resource.MustParse("8"),
+					overcommitv1.ResourceGuestMemory: resource.MustParse("8Gi"),
+				}},
+				Status: overcommitv1.VirtualizationQuotaStatus{Used: k8sv1.ResourceList{
+					overcommitv1.ResourceVCPUs:       resource.MustParse("6"),
+					overcommitv1.ResourceGuestMemory: resource.MustParse("4Gi"),
+				}},
+			})).To(Succeed())
+			quotaAdmitter = NewVMIUpdateAdmitter(config, webhooks.KubeVirtServiceAccounts(kubeVirtNamespace), quotaInformer)
+		})
+
+		DescribeTable("should admit a hotplug", func(featureGate bool, update func(*v1.VirtualMachineInstance), expectedCause string) {
+			if featureGate {
+				enableFeatureGate(featuregate.VirtualizationQuotas)
+			}
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.CPU = &v1.CPU{Sockets: 2, Cores: 1, Threads: 1, MaxSockets: 8}
+			vmi.Spec.Domain.Memory = &v1.Memory{Guest: pointer.P(resource.MustParse("2Gi")), MaxGuest: pointer.P(resource.MustParse("16Gi"))}
+			updateVmi := vmi.DeepCopy()
+			update(updateVmi)
+
+			newVMIBytes, _ := json.Marshal(&updateVmi)
+			oldVMIBytes, _ := json.Marshal(&vmi)
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					UserInfo: authv1.UserInfo{Username: "system:serviceaccount:kubevirt:" + components.ControllerServiceAccountName},
+					Resource: webhooks.VirtualMachineInstanceGroupVersionResource,
+					Object: runtime.RawExtension{
+						Raw: newVMIBytes,
+					},
+					OldObject: runtime.RawExtension{
+						Raw: oldVMIBytes,
+					},
+					Operation: admissionv1.Update,
+				},
+			}
+			resp := quotaAdmitter.Admit(context.Background(), ar)
+			if expectedCause == "" {
+				Expect(resp.Allowed).To(BeTrue())
+				return
+			}
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ConsistOf(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+				"Message": Equal(expectedCause),
+			})))
+		},
+			Entry("of CPU sockets within the quota", true,
+				func(vmi *v1.VirtualMachineInstance) { vmi.Spec.Domain.CPU.Sockets = 4 }, ""),
+			Entry("not of CPU sockets exceeding the quota", true,
+				func(vmi *v1.VirtualMachineInstance) { vmi.Spec.Domain.CPU.Sockets = 5 }, "exceeded VirtualizationQuota quota: vcpus"),
+			Entry("of guest memory within the quota", true,
+				func(vmi *v1.VirtualMachineInstance) {
+					vmi.Spec.Domain.Memory.Guest = pointer.P(resource.MustParse("6Gi"))
+				}, ""),
+			Entry("not of guest memory exceeding the quota", true,
+				func(vmi *v1.VirtualMachineInstance) {
+					vmi.Spec.Domain.Memory.Guest = pointer.P(resource.MustParse("8Gi"))
+				}, "exceeded VirtualizationQuota quota: guestMemory"),
+			Entry("exceeding the quota without the feature gate", false,
+				func(vmi *v1.VirtualMachineInstance) { vmi.Spec.Domain.CPU.Sockets = 5 }, ""),
+		)
+	})
+
+	DescribeTable("Updates of iothreads", func(oldPolicy, newPolicy *v1.IOThreadsPolicy, oldIOThreads, newIOThreads *v1.DiskIOThreads, expected types.GomegaMatcher) {
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.CPU = &v1.CPU{}
+		updateVmi := vmi.DeepCopy()
+		vmi.Spec.Domain.IOThreadsPolicy = oldPolicy
+		vmi.Spec.Domain.IOThreads = oldIOThreads
+		updateVmi.Spec.Domain.IOThreadsPolicy = newPolicy
+		updateVmi.Spec.Domain.IOThreads = newIOThreads
+
+		newVMIBytes, _ := json.Marshal(&updateVmi)
+		oldVMIBytes, _ := json.Marshal(&vmi)
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				UserInfo: authv1.UserInfo{Username: "system:serviceaccount:kubevirt:" + components.ControllerServiceAccountName},
+				Resource: webhooks.VirtualMachineInstanceGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: newVMIBytes,
+				},
+				OldObject: runtime.RawExtension{
+					Raw: oldVMIBytes,
+				},
+				Operation: admissionv1.Update,
+			},
+		}
+		resp := vmiUpdateAdmitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(expected)
+	},
+		Entry("allow update of the supplemental pool thread count",
+			pointer.P(v1.IOThreadsPolicySupplementalPool), pointer.P(v1.IOThreadsPolicySupplementalPool),
+			&v1.DiskIOThreads{SupplementalPoolThreadCount: pointer.P(uint32(2))},
+			&v1.DiskIOThreads{SupplementalPoolThreadCount: pointer.P(uint32(4))},
+			BeTrue()),
+		Entry("deny update of the supplemental pool thread count to 0",
+			pointer.P(v1.IOThreadsPolicySupplementalPool), pointer.P(v1.IOThreadsPolicySupplementalPool),
+			&v1.DiskIOThreads{SupplementalPoolThreadCount: pointer.P(uint32(2))},
+			&v1.DiskIOThreads{SupplementalPoolThreadCount: pointer.P(uint32(0))},
+			BeFalse()),
+		Entry("deny decreasing the supplemental pool thread count",
+			pointer.P(v1.IOThreadsPolicySupplementalPool), pointer.P(v1.IOThreadsPolicySupplementalPool),
+			&v1.DiskIOThreads{SupplementalPoolThreadCount: pointer.P(uint32(4))},
+			&v1.DiskIOThreads{SupplementalPoolThreadCount: pointer.P(uint32(2))},
+			BeFalse()),
+		Entry("deny update of the iothreads policy",
+			pointer.P(v1.IOThreadsPolicySupplementalPool), pointer.P(v1.IOThreadsPolicyAuto),
+			&v1.DiskIOThreads{SupplementalPoolThreadCount: pointer.P(uint32(2))},
+			&v1.DiskIOThreads{SupplementalPoolThreadCount: pointer.P(uint32(2))},
+			BeFalse()),
+		Entry("deny adding a supplemental pool without the supplementalPool policy",
+			pointer.P(v1.IOThreadsPolicyShared), pointer.P(v1.IOThreadsPolicyShared),
+			nil,
+			&v1.DiskIOThreads{SupplementalPoolThreadCount: pointer.P(uint32(2))},
+			BeFalse()),
+	)
+
+	DescribeTable("Updates of host devices", func(oldHostDevices, newHostDevices []v1.HostDevice, expected types.GomegaMatcher) {
+		kvConfig := kv.DeepCopy()
+		kvConfig.Spec.Configuration.PermittedHostDevices = &v1.PermittedHostDevices{
+			USB: []v1.USBHostDevice{{ResourceName: "kubevirt.io/usb-storage"}},
+		}
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.CPU = &v1.CPU{}
+		updateVmi := vmi.DeepCopy()
+		vmi.Spec.Domain.Devices.HostDevices = oldHostDevices
+		updateVmi.Spec.Domain.Devices.HostDevices = newHostDevices
+		updateVmi.Spec.Domain.Devices.ClientPassthrough = &v1.ClientPassthroughDevices{}
+
+		newVMIBytes, _ := json.Marshal(&updateVmi)
+		oldVMIBytes, _ := json.Marshal(&vmi)
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				UserInfo: authv1.UserInfo{Username: "system:serviceaccount:kubevirt:" + components.ControllerServiceAccountName},
+				Resource: webhooks.VirtualMachineInstanceGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: newVMIBytes,
+				},
+				OldObject: runtime.RawExtension{
+					Raw: oldVMIBytes,
+				},
+				Operation: admissionv1.Update,
+			},
+		}
+		resp := vmiUpdateAdmitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(expected)
+	},
+		Entry("allow adding a USB host device",
+			nil,
+			[]v1.HostDevice{{Name: "usb1", DeviceName: "kubevirt.io/usb-storage"}},
+			BeTrue()),
+		Entry("allow removing a USB host device",
+			[]v1.HostDevice{{Name: "gpu1", DeviceName: "nvidia.com/TU104GL_Tesla_T4"}, {Name: "usb1", DeviceName: "kubevirt.io/usb-storage"}},
+			[]v1.HostDevice{{Name: "gpu1", DeviceName: "nvidia.com/TU104GL_Tesla_T4"}},
+			BeTrue()),
+		Entry("deny adding a host device other than USB",
+			nil,
+			[]v1.HostDevice{{Name: "gpu1", DeviceName: "nvidia.com/TU104GL_Tesla_T4"}},
+			BeFalse()),
+	)
 })
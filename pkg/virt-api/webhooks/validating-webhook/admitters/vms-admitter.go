@@ -23,8 +23,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,6 +38,7 @@ import (
 	"kubevirt.io/client-go/kubecli"
 
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/cpuburst"
 	"kubevirt.io/kubevirt/pkg/defaults"
 	"kubevirt.io/kubevirt/pkg/instancetype/conflict"
 	instancetypeWebhooks "kubevirt.io/kubevirt/pkg/instancetype/webhooks/vm"
@@ -152,6 +155,13 @@ func (admitter *VMsAdmitter) Admit(ctx context.Context, ar *admissionv1.Admissio
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	if !hasUnchangedPanicDevices(ar.Request, &vm) {
+		causes = validatePanicDeviceModelsPerArch(k8sfield.NewPath("spec", "template", "spec"), &vmCopy.Spec.Template.Spec, admitter.ClusterConfig)
+		if len(causes) > 0 {
+			return webhookutils.ToAdmissionResponse(causes)
+		}
+	}
+
 	causes, err = storageadmitters.Admit(admitter.VirtClient, ctx, ar.Request, &vm, admitter.ClusterConfig)
 	if err != nil {
 		return webhookutils.ToAdmissionResponseError(err)
@@ -173,6 +183,7 @@ func (admitter *VMsAdmitter) Admit(ctx context.Context, ar *admissionv1.Admissio
 	}
 
 	warnings := warnDeprecatedAPIs(&vm.Spec.Template.Spec, admitter.ClusterConfig)
+	warnings = append(warnings, warnPS2InputDevices(k8sfield.NewPath("spec", "template", "spec"), &vm.Spec.Template.Spec)...)
 	if vm.Spec.Running != nil {
 		warnings = append(warnings, "spec.running is deprecated, please use spec.runStrategy instead.")
 	}
@@ -183,6 +194,18 @@ func (admitter *VMsAdmitter) Admit(ctx context.Context, ar *admissionv1.Admissio
 	}
 }
 
+// hasUnchangedPanicDevices reports whether an update keeps the panic devices of the VM as they are.
+func hasUnchangedPanicDevices(request *admissionv1.AdmissionRequest, vm *v1.VirtualMachine) bool {
+	if request.Operation != admissionv1.Update {
+		return false
+	}
+	oldVM := v1.VirtualMachine{}
+	if err := json.Unmarshal(request.OldObject.Raw, &oldVM); err != nil || oldVM.Spec.Template == nil {
+		return false
+	}
+	return equality.Semantic.DeepEqual(oldVM.Spec.Template.Spec.Domain.Devices.PanicDevices, vm.Spec.Template.Spec.Domain.Devices.PanicDevices)
+}
+
 func (admitter *VMsAdmitter) AdmitStatus(ctx context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
 	vm, _, err := webhookutils.GetVMFromAdmissionReview(ar)
 	if err != nil {
@@ -223,6 +246,88 @@ func ValidateVirtualMachineSpec(field *k8sfield.Path, spec *v1.VirtualMachineSpe
 	causes = append(causes, storageadmitters.ValidateDataVolumeTemplate(field, spec)...)
 	causes = append(causes, validateRunStrategy(field, spec, config)...)
 	causes = append(causes, validateLiveUpdateFeatures(field, spec, config)...)
+	causes = append(causes, validateCPUBurst(field.Child("cpuBurst"), spec.CPUBurst, &spec.Template.Spec, config)...)
+	causes = append(causes, validateMaintenanceSnapshot(field.Child("maintenanceSnapshot"), spec.MaintenanceSnapshot, config)...)
+
+	return causes
+}
+
+func validateCPUBurst(field *k8sfield.Path, burst *v1.CPUBurst, vmiSpec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	if burst == nil {
+		return causes
+	}
+
+	if !config.CPUBurstWindowsEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt resource", featuregate.CPUBurstWindows),
+			Field:   field.String(),
+		})
+	}
+
+	if burst.CPULimit.Sign() <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater than zero", field.Child("cpuLimit").String()),
+			Field:   field.Child("cpuLimit").String(),
+		})
+	} else if cpuRequest, exists := vmiSpec.Domain.Resources.Requests[k8sv1.ResourceCPU]; exists && burst.CPULimit.Cmp(cpuRequest) < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not be lower than the CPU request %s of the VM",
+				field.Child("cpuLimit").String(), cpuRequest.String()),
+			Field: field.Child("cpuLimit").String(),
+		})
+	}
+
+	if len(burst.Windows) == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must contain at least one window", field.Child("windows").String()),
+			Field:   field.Child("windows").String(),
+		})
+	}
+
+	for i, window := range burst.Windows {
+		if _, err := cpuburst.ParseStart(window.Start); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: err.Error(),
+				Field:   field.Child("windows").Index(i).Child("start").String(),
+			})
+		}
+		if window.Duration.Duration <= 0 || window.Duration.Duration > 24*time.Hour {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be greater than zero and at most 24h", field.Child("windows").Index(i).Child("duration").String()),
+				Field:   field.Child("windows").Index(i).Child("duration").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+func validateMaintenanceSnapshot(field *k8sfield.Path, policy *v1.MaintenanceSnapshotPolicy, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	if policy == nil {
+		return causes
+	}
+
+	if !config.MaintenanceSnapshotsEnabled() || !config.SnapshotEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s and %s feature gates must be enabled in kubevirt resource", featuregate.MaintenanceSnapshots, featuregate.SnapshotGate),
+			Field:   field.String(),
+		})
+	}
+
+	if policy.SuccessWindow != nil && policy.SuccessWindow.Duration <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater than zero", field.Child("successWindow").String()),
+			Field:   field.Child("successWindow").String(),
+		})
+	}
 
 	return causes
 }
@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -250,6 +251,46 @@ var _ = Describe("Validating VM Admitter", func() {
 		Expect(resp.Allowed).To(BeTrue())
 	})
 
+	Context("with panic devices on arm64", func() {
+		var oldVM *v1.VirtualMachine
+
+		BeforeEach(func() {
+			enableFeatureGate(featuregate.PanicDevicesGate)
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Architecture = "arm64"
+			vmi.Spec.Domain.Devices.PanicDevices = []v1.PanicDevice{{Model: pointer.P(v1.Isa)}}
+			oldVM = &v1.VirtualMachine{
+				Spec: v1.VirtualMachineSpec{
+					RunStrategy: pointer.P(v1.RunStrategyHalted),
+					Template:    &v1.VirtualMachineInstanceTemplateSpec{Spec: vmi.Spec},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			disableFeatureGates()
+		})
+
+		It("should reject a model without a PCI variant on creation", func() {
+			resp := admitVm(vmsAdmitter, oldVM)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.template.spec.domain.devices.panicDevices[0].model"))
+		})
+
+		It("should accept updates keeping the panic devices", func() {
+			vm := oldVM.DeepCopy()
+			vm.Spec.Template.ObjectMeta.Labels = map[string]string{"updated": "true"}
+			Expect(admitVmUpdate(vmsAdmitter, oldVM, vm).Allowed).To(BeTrue())
+		})
+
+		It("should reject updates adding a model without a PCI variant", func() {
+			vm := oldVM.DeepCopy()
+			vm.Spec.Template.Spec.Domain.Devices.PanicDevices = append(vm.Spec.Template.Spec.Domain.Devices.PanicDevices,
+				v1.PanicDevice{Model: pointer.P(v1.Pvpanic)})
+			Expect(admitVmUpdate(vmsAdmitter, oldVM, vm).Allowed).To(BeFalse())
+		})
+	})
+
 	It("should accept VM requesting hugepages but missing spec.template.spec.domain.resources.requests.memory - bug #9102", func() {
 		vmi := api.NewMinimalVMI("testvmi")
 		vmi.Spec.Domain.Resources = v1.ResourceRequirements{}
@@ -1618,8 +1659,134 @@ var _ = Describe("Validating VM Admitter", func() {
 			Entry("reject invalid runstrategy", v1.VirtualMachineRunStrategy("invalid"), "", false),
 		)
 	})
+
+	Context("CPU burst", func() {
+		AfterEach(func() {
+			disableFeatureGates()
+		})
+
+		validBurst := func() *v1.CPUBurst {
+			return &v1.CPUBurst{
+				CPULimit: resource.MustParse("4"),
+				Windows:  []v1.CPUBurstWindow{{Start: "02:30", Duration: metav1.Duration{Duration: time.Hour}}},
+			}
+		}
+
+		DescribeTable("validate should", func(burst *v1.CPUBurst, featureGate string, expectedField string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceCPU] = resource.MustParse("2")
+			vm := &v1.VirtualMachine{
+				Spec: v1.VirtualMachineSpec{
+					RunStrategy: pointer.P(v1.RunStrategyAlways),
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: vmi.Spec,
+					},
+					CPUBurst: burst,
+				},
+			}
+			enableFeatureGate(featureGate)
+			resp := admitVm(vmsAdmitter, vm)
+			if expectedField == "" {
+				Expect(resp.Allowed).To(BeTrue())
+				return
+			}
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ContainElement(HaveField("Field", expectedField)))
+		},
+			Entry("accept valid windows", validBurst(), featuregate.CPUBurstWindows, ""),
+			Entry("reject windows if the feature gate is not enabled", validBurst(), "", "spec.cpuBurst"),
+			Entry("reject a zero CPU limit", func() *v1.CPUBurst {
+				burst := validBurst()
+				burst.CPULimit = resource.MustParse("0")
+				return burst
+			}(), featuregate.CPUBurstWindows, "spec.cpuBurst.cpuLimit"),
+			Entry("reject a negative CPU limit", func() *v1.CPUBurst {
+				burst := validBurst()
+				burst.CPULimit = resource.MustParse("-1")
+				return burst
+			}(), featuregate.CPUBurstWindows, "spec.cpuBurst.cpuLimit"),
+			Entry("reject a CPU limit lower than the CPU request", func() *v1.CPUBurst {
+				burst := validBurst()
+				burst.CPULimit = resource.MustParse("1500m")
+				return burst
+			}(), featuregate.CPUBurstWindows, "spec.cpuBurst.cpuLimit"),
+			Entry("reject no windows", func() *v1.CPUBurst {
+				burst := validBurst()
+				burst.Windows = []v1.CPUBurstWindow{}
+				return burst
+			}(), featuregate.CPUBurstWindows, "spec.cpuBurst.windows"),
+			Entry("reject an invalid start", func() *v1.CPUBurst {
+				burst := validBurst()
+				burst.Windows[0].Start = "2am"
+				return burst
+			}(), featuregate.CPUBurstWindows, "spec.cpuBurst.windows[0].start"),
+			Entry("reject a duration longer than a day", func() *v1.CPUBurst {
+				burst := validBurst()
+				burst.Windows[0].Duration = metav1.Duration{Duration: 25 * time.Hour}
+				return burst
+			}(), featuregate.CPUBurstWindows, "spec.cpuBurst.windows[0].duration"),
+		)
+	})
+
+	Context("maintenance snapshot", func() {
+		AfterEach(func() {
+			disableFeatureGates()
+		})
+
+		DescribeTable("validate should", func(policy *v1.MaintenanceSnapshotPolicy, featureGates []string, expectedField string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vm := &v1.VirtualMachine{
+				Spec: v1.VirtualMachineSpec{
+					RunStrategy: pointer.P(v1.RunStrategyAlways),
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: vmi.Spec,
+					},
+					MaintenanceSnapshot: policy,
+				},
+			}
+			enableFeatureGate(featureGates...)
+			resp := admitVm(vmsAdmitter, vm)
+			if expectedField == "" {
+				Expect(resp.Allowed).To(BeTrue())
+				return
+			}
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ContainElement(HaveField("Field", expectedField)))
+		},
+			Entry("accept the default policy", &v1.MaintenanceSnapshotPolicy{},
+				[]string{featuregate.MaintenanceSnapshots, featuregate.SnapshotGate}, ""),
+			Entry("accept a success window", &v1.MaintenanceSnapshotPolicy{SuccessWindow: &metav1.Duration{Duration: time.Hour}},
+				[]string{featuregate.MaintenanceSnapshots, featuregate.SnapshotGate}, ""),
+			Entry("reject the policy if the feature gate is not enabled", &v1.MaintenanceSnapshotPolicy{},
+				[]string{featuregate.SnapshotGate}, "spec.maintenanceSnapshot"),
+			Entry("reject the policy if snapshots are not enabled", &v1.MaintenanceSnapshotPolicy{},
+				[]string{featuregate.MaintenanceSnapshots}, "spec.maintenanceSnapshot"),
+			Entry("reject a zero success window", &v1.MaintenanceSnapshotPolicy{SuccessWindow: &metav1.Duration{}},
+				[]string{featuregate.MaintenanceSnapshots, featuregate.SnapshotGate}, "spec.maintenanceSnapshot.successWindow"),
+		)
+	})
 })
 
+func admitVmUpdate(admitter *VMsAdmitter, oldVM, vm *v1.VirtualMachine) *admissionv1.AdmissionResponse {
+	oldVMBytes, _ := json.Marshal(oldVM)
+	vmBytes, _ := json.Marshal(vm)
+
+	ar := &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Resource: webhooks.VirtualMachineGroupVersionResource,
+			Object: runtime.RawExtension{
+				Raw: vmBytes,
+			},
+			OldObject: runtime.RawExtension{
+				Raw: oldVMBytes,
+			},
+			Operation: admissionv1.Update,
+		},
+	}
+
+	return admitter.Admit(context.Background(), ar)
+}
+
 func admitVm(admitter *VMsAdmitter, vm *v1.VirtualMachine) *admissionv1.AdmissionResponse {
 	vmBytes, _ := json.Marshal(vm)
 
@@ -37,18 +37,26 @@ func ServeVMICreate(
 	resp http.ResponseWriter,
 	req *http.Request,
 	clusterConfig *virtconfig.ClusterConfig,
+	informers *webhooks.Informers,
 	kubeVirtServiceAccounts map[string]struct{},
 	specValidators ...admitters.SpecValidator,
 ) {
 	validating_webhooks.Serve(resp, req, &admitters.VMICreateAdmitter{
-		ClusterConfig:           clusterConfig,
-		KubeVirtServiceAccounts: kubeVirtServiceAccounts,
-		SpecValidators:          specValidators,
+		ClusterConfig:               clusterConfig,
+		KubeVirtServiceAccounts:     kubeVirtServiceAccounts,
+		SpecValidators:              specValidators,
+		VirtualizationQuotaInformer: informers.VirtualizationQuotaInformer,
 	})
 }
 
-func ServeVMIUpdate(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig, kubeVirtServiceAccounts map[string]struct{}) {
-	validating_webhooks.Serve(resp, req, admitters.NewVMIUpdateAdmitter(clusterConfig, kubeVirtServiceAccounts))
+func ServeVMIUpdate(
+	resp http.ResponseWriter,
+	req *http.Request,
+	clusterConfig *virtconfig.ClusterConfig,
+	informers *webhooks.Informers,
+	kubeVirtServiceAccounts map[string]struct{},
+) {
+	validating_webhooks.Serve(resp, req, admitters.NewVMIUpdateAdmitter(clusterConfig, kubeVirtServiceAccounts, informers.VirtualizationQuotaInformer))
 }
 
 func ServeVMs(
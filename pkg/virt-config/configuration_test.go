@@ -150,6 +150,32 @@ var _ = Describe("test configuration", func() {
 		Entry("when s390x unset, GetMachineType should return the default with s390x", "s390x", "", "", "", virtconfig.DefaultS390XMachineType),
 	)
 
+	DescribeTable("should resolve machine type aliases", func(cpuArch, machineType, expectedMachineType string) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVWithCPUArch(&v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubevirt",
+				Namespace: "kubevirt",
+			},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					ArchitectureConfiguration: &v1.ArchConfiguration{
+						Amd64: &v1.ArchSpecificConfiguration{MachineTypeAliases: map[string]string{"stable": "pc-q35-8.2"}},
+						Arm64: &v1.ArchSpecificConfiguration{MachineTypeAliases: map[string]string{"stable": "virt-8.2"}},
+					},
+				},
+			},
+			Status: v1.KubeVirtStatus{
+				Phase: "Deployed",
+			},
+		}, cpuArch)
+		Expect(clusterConfig.ResolveMachineType(cpuArch, machineType)).To(Equal(expectedMachineType))
+	},
+		Entry("to the amd64 machine type", "amd64", "stable", "pc-q35-8.2"),
+		Entry("to the arm64 machine type", "arm64", "stable", "virt-8.2"),
+		Entry("keeping a machine type which is not an alias", "amd64", "pc-q35-9.0", "pc-q35-9.0"),
+		Entry("keeping an alias which is not defined for the architecture", "s390x", "stable", "stable"),
+	)
+
 	It("architectureConfiguration fields should not have higher priority when deprecated options are set", func() {
 		const machineType = "quantum-qc35"
 		const ovmfPath = "/usr/share/something"
@@ -220,3 +220,47 @@ func (config *ClusterConfig) IncrementalBackupEnabled() bool {
 func (config *ClusterConfig) MigrationPriorityQueueEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.MigrationPriorityQueue)
 }
+
+func (config *ClusterConfig) ImageIntegrityCheckEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.ImageIntegrityCheck)
+}
+
+func (config *ClusterConfig) OvercommitProfilesEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.OvercommitProfiles)
+}
+
+func (config *ClusterConfig) CPUBurstWindowsEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.CPUBurstWindows)
+}
+
+func (config *ClusterConfig) VirtualizationQuotasEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VirtualizationQuotas)
+}
+
+func (config *ClusterConfig) MaintenanceSnapshotsEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.MaintenanceSnapshots)
+}
+
+func (config *ClusterConfig) VhostUserBlkEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VhostUserBlkGate)
+}
+
+func (config *ClusterConfig) MicroVMEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.MicroVMGate)
+}
+
+func (config *ClusterConfig) ExternalTPMEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.ExternalTPMGate)
+}
+
+func (config *ClusterConfig) SGXEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.SGXGate)
+}
+
+func (config *ClusterConfig) HostBlockDeviceEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.HostBlockDeviceGate)
+}
+
+func (config *ClusterConfig) PS2InputEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.PS2InputGate)
+}
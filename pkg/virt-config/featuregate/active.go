@@ -151,6 +151,73 @@ const (
 	// Alpha: v1.7.0
 	//
 	MigrationPriorityQueue = "MigrationPriorityQueue"
+
+	// Alpha: v1.7.0
+	//
+	// ImageIntegrityCheck enables virt-launcher to periodically check the qcow2 images of
+	// containerDisk and ephemeral volumes for corruption.
+	ImageIntegrityCheck = "ImageIntegrityCheck"
+
+	// Alpha: v1.7.0
+	//
+	// OvercommitProfiles enables virt-api to apply the CPU and memory overcommit of the
+	// OvercommitProfile matching the namespace of a new VMI.
+	OvercommitProfiles = "OvercommitProfiles"
+
+	// Alpha: v1.7.0
+	//
+	// CPUBurstWindows enables virt-controller to raise the CPU limit of virt-launcher pods
+	// during the windows declared in the VirtualMachine spec. It requires in-place pod resize.
+	CPUBurstWindows = "CPUBurstWindows"
+
+	// Alpha: v1.7.0
+	//
+	// VirtualizationQuotas enables virt-api to reject new VMIs whose vCPUs, guest memory,
+	// GPUs or host devices exceed a VirtualizationQuota of their namespace.
+	VirtualizationQuotas = "VirtualizationQuotas"
+
+	// Alpha: v1.7.0
+	//
+	// MaintenanceSnapshots enables virt-controller to snapshot VirtualMachines opting in via
+	// spec.maintenanceSnapshot before CPU, memory or interface hotplug and firmware changes.
+	// It requires the Snapshot feature gate.
+	MaintenanceSnapshots = "MaintenanceSnapshots"
+
+	// Alpha: v1.7.0
+	//
+	// VhostUserBlk allows VMIs to consume block devices served by vhost-user-blk backends, such as SPDK,
+	// through a unix socket on the node.
+	VhostUserBlkGate = "VhostUserBlk"
+
+	// Alpha: v1.7.0
+	//
+	// MicroVM allows VMIs to run on the microvm machine type of amd64, which has no PCI bus and
+	// attaches the virtio devices through virtio-mmio. The machine type has to be added to the
+	// emulated machines.
+	MicroVMGate = "MicroVM"
+
+	// Alpha: v1.7.0
+	//
+	// ExternalTPM allows VMIs to connect their vTPM to an externally managed swtpm
+	// through a unix socket on the node.
+	ExternalTPMGate = "ExternalTPM"
+
+	// Alpha: v1.7.0
+	//
+	// SGX allows VMIs to use an Intel SGX enclave page cache allocated from the EPC of the node.
+	SGXGate = "SGX"
+
+	// Alpha: v1.7.0
+	//
+	// HostBlockDevice allows VMIs to consume a block device of the node permitted in the hostBlockDevices
+	// configuration, which virt-handler holds exclusively for the VMI while it runs.
+	HostBlockDeviceGate = "HostBlockDevice"
+
+	// Alpha: v1.7.0
+	//
+	// PS2Input allows VMIs to use keyboard and mouse input devices on the ps2 bus, for legacy guests
+	// which can use neither usb nor virtio input devices.
+	PS2InputGate = "PS2Input"
 )
 
 func init() {
@@ -187,4 +254,15 @@ func init() {
 	RegisterFeatureGate(FeatureGate{Name: PasstIPStackMigration, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: IncrementalBackupGate, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: MigrationPriorityQueue, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: ImageIntegrityCheck, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: OvercommitProfiles, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: CPUBurstWindows, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VirtualizationQuotas, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: MaintenanceSnapshots, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VhostUserBlkGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: MicroVMGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: ExternalTPMGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: SGXGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: HostBlockDeviceGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: PS2InputGate, State: Alpha})
 }
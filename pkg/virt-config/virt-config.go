@@ -91,6 +91,14 @@ const (
 	DefaultVMRolloutStrategy = v1.VMRolloutStrategyLiveUpdate
 )
 
+// MicroVMMachineType is the minimal amd64 machine type of QEMU, which has no PCI bus
+const MicroVMMachineType = "microvm"
+
+// IsMicroVM returns true if the VMI runs on the microvm machine type
+func IsMicroVM(spec *v1.VirtualMachineInstanceSpec) bool {
+	return spec.Domain.Machine != nil && spec.Domain.Machine.Type == MicroVMMachineType
+}
+
 func IsARM64(arch string) bool {
 	return arch == "arm64"
 }
@@ -142,6 +150,27 @@ func (c *ClusterConfig) GetMachineType(arch string) string {
 	}
 }
 
+// GetMachineTypeAliases returns the machine type aliases configured for the given architecture
+func (c *ClusterConfig) GetMachineTypeAliases(arch string) map[string]string {
+	switch arch {
+	case "arm64":
+		return c.GetConfig().ArchitectureConfiguration.Arm64.MachineTypeAliases
+	case "s390x":
+		return c.GetConfig().ArchitectureConfiguration.S390x.MachineTypeAliases
+	default:
+		return c.GetConfig().ArchitectureConfiguration.Amd64.MachineTypeAliases
+	}
+}
+
+// ResolveMachineType returns the machine type the given alias stands for,
+// or the given machine type itself when it is not an alias
+func (c *ClusterConfig) ResolveMachineType(arch, machineType string) string {
+	if resolved, isAlias := c.GetMachineTypeAliases(arch)[machineType]; isAlias {
+		return resolved
+	}
+	return machineType
+}
+
 func (c *ClusterConfig) GetCPUModel() string {
 	return c.GetConfig().CPUModel
 }
@@ -455,6 +484,38 @@ func (c *ClusterConfig) GetNetworkBindings() map[string]v1.InterfaceBindingPlugi
 	return nil
 }
 
+func (c *ClusterConfig) GetMasqueradeIPAM() *v1.MasqueradeIPAMConfiguration {
+	networkConfig := c.GetConfig().NetworkConfiguration
+	if networkConfig != nil {
+		return networkConfig.MasqueradeIPAM
+	}
+	return nil
+}
+
+func (c *ClusterConfig) GetGuestTime() *v1.GuestTimeConfiguration {
+	return c.GetConfig().GuestTime
+}
+
+func (c *ClusterConfig) GetPersistentReservation() *v1.PersistentReservationConfiguration {
+	return c.GetConfig().PersistentReservation
+}
+
+func (c *ClusterConfig) GetHostBlockDevices() *v1.HostBlockDevicesConfiguration {
+	return c.GetConfig().HostBlockDevices
+}
+
+func (c *ClusterConfig) GetGuestCrashNotifications() *v1.GuestCrashNotificationsConfiguration {
+	return c.GetConfig().GuestCrashNotifications
+}
+
+func (c *ClusterConfig) GetVhostUserBlkSockets() *v1.HostSocketDirectoriesConfiguration {
+	return c.GetConfig().VhostUserBlkSockets
+}
+
+func (c *ClusterConfig) GetExternalTPMSockets() *v1.HostSocketDirectoriesConfiguration {
+	return c.GetConfig().ExternalTPMSockets
+}
+
 func (config *ClusterConfig) VGADisplayForEFIGuestsEnabled() bool {
 	VGADisplayForEFIGuestsAnnotationExists := false
 	kv := config.GetConfigFromKubeVirtCR()
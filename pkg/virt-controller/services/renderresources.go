@@ -504,9 +504,9 @@ func GetMemoryOverhead(vmi *v1.VirtualMachineInstance, cpuArch string, additiona
 		overhead.Add(resource.MustParse("256Mi"))
 	}
 
-	// Having a TPM device will spawn a swtpm process
+	// Having a TPM device will spawn a swtpm process, unless the device is backed by an external swtpm
 	// In `ps`, swtpm has VSZ of 53808 and RSS of 3496, so 53Mi should do
-	if tpm.HasDevice(&vmi.Spec) {
+	if tpm.HasDevice(&vmi.Spec) && !tpm.HasExternalDevice(&vmi.Spec) {
 		overhead.Add(resource.MustParse("53Mi"))
 	}
 
@@ -595,9 +595,35 @@ func getRequiredResources(vmi *v1.VirtualMachineInstance, allowEmulation bool) k
 	if util.IsAutoAttachVSOCK(vmi) {
 		res[VhostVsockDevice] = resource.MustParse("1")
 	}
+	if sgx := vmi.Spec.Domain.Devices.SGX; sgx != nil {
+		// The sgx-epc device plugin exposes a device per MiB of EPC
+		res[SGXEPCDevice] = *resource.NewQuantity(hardware.GetSGXEPCSizeMiB(sgx), resource.DecimalSI)
+	}
+	if requiresAccel3D(vmi, "") {
+		res[DRIRenderDevice] = resource.MustParse("1")
+	}
+	// venus maps the blob resources of virtio-gpu through udmabuf
+	if requiresAccel3D(vmi, v1.VideoAccel3DVenus) {
+		res[UdmabufDevice] = resource.MustParse("1")
+	}
 	return res
 }
 
+// requiresAccel3D tells whether a video device of the VMI is 3D accelerated,
+// with the given accelerator or any of them when it is empty.
+func requiresAccel3D(vmi *v1.VirtualMachineInstance, accel3D v1.VideoAccel3D) bool {
+	videos := vmi.Spec.Domain.Devices.Videos
+	if vmi.Spec.Domain.Devices.Video != nil {
+		videos = append([]v1.VideoDevice{*vmi.Spec.Domain.Devices.Video}, videos...)
+	}
+	for _, video := range videos {
+		if video.Accel3D != "" && (accel3D == "" || video.Accel3D == accel3D) {
+			return true
+		}
+	}
+	return false
+}
+
 func WithVirtualizationResources(virtResources k8sv1.ResourceList) ResourceRendererOption {
 	return func(renderer *ResourceRenderer) {
 		copyResources(virtResources, renderer.vmLimits)
@@ -619,6 +645,9 @@ func validatePermittedHostDevices(spec *v1.VirtualMachineInstanceSpec, config *v
 		for _, dev := range hostDevs.USB {
 			supportedHostDevicesMap[dev.ResourceName] = true
 		}
+		for _, dev := range hostDevs.InputDevices {
+			supportedHostDevicesMap[dev.ResourceName] = true
+		}
 		//TODO @alayp: add proper validation for DRA GPUs in beta
 		if !config.GPUsWithDRAGateEnabled() {
 			for _, hostDev := range spec.Domain.Devices.GPUs {
@@ -21,7 +21,9 @@ import (
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/network/downwardapi"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
+	"kubevirt.io/kubevirt/pkg/storage/hostblockdevice"
 	"kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/storage/vhostuserblk"
 	"kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virtiofs"
@@ -166,6 +168,16 @@ func withVMIVolumes(pvcStore cache.Store, vmiSpecVolumes []v1.Volume, vmiVolumeS
 				renderer.handleHostDisk(volume)
 			}
 
+			if volume.VhostUserBlk != nil {
+				if err := renderer.handleVhostUserBlk(volume); err != nil {
+					return err
+				}
+			}
+
+			if volume.HostBlockDevice != nil {
+				renderer.handleHostBlockDevice(volume)
+			}
+
 			if volume.DataVolume != nil {
 				if err := renderer.handleDataVolume(volume, pvcStore); err != nil {
 					return err
@@ -501,6 +513,41 @@ func withSidecarVolumes(hookSidecars hooks.HookSidecarList) VolumeRendererOption
 	}
 }
 
+// withChannelVolumes shares the sockets of the user-defined channels with the hook sidecars
+func withChannelVolumes() VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		renderer.podVolumes = append(renderer.podVolumes, emptyDirVolume(channelSocks))
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, mountPath(channelSocks, util.VirtChannelsDir))
+		return nil
+	}
+}
+
+func withExternalTPM(external *v1.TPMExternal) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		// The directory of the socket is mounted read-write, it must not expose anything else of the node
+		if !util.IsPermittedSocketPath(external.SocketPath, renderer.namespace, renderer.clusterConfig.GetExternalTPMSockets()) {
+			return fmt.Errorf("the socket %s of the external swtpm is not in a permitted directory of namespace %s", external.SocketPath, renderer.namespace)
+		}
+
+		const volumeName = "swtpm-external"
+		hostPathType := k8sv1.HostPathDirectory
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+			Name:      volumeName,
+			MountPath: tpm.ExternalSocketDir,
+		})
+		renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+			Name: volumeName,
+			VolumeSource: k8sv1.VolumeSource{
+				HostPath: &k8sv1.HostPathVolumeSource{
+					Path: filepath.Dir(external.SocketPath),
+					Type: &hostPathType,
+				},
+			},
+		})
+		return nil
+	}
+}
+
 func withVirioFS() VolumeRendererOption {
 	return func(renderer *VolumeRenderer) error {
 		renderer.podVolumeMounts = append(renderer.podVolumeMounts, mountPath(virtiofs.VirtioFSContainers, virtiofs.VirtioFSContainersMountBaseDir))
@@ -686,6 +733,48 @@ func (vr *VolumeRenderer) handleHostDisk(volume v1.Volume) {
 	})
 }
 
+func (vr *VolumeRenderer) handleVhostUserBlk(volume v1.Volume) error {
+	// The directory of the socket is mounted read-write, it must not expose anything else of the node
+	if !util.IsPermittedSocketPath(volume.VhostUserBlk.Path, vr.namespace, vr.clusterConfig.GetVhostUserBlkSockets()) {
+		return fmt.Errorf("the socket %s of the vhost-user-blk volume %s is not in a permitted directory of namespace %s", volume.VhostUserBlk.Path, volume.Name, vr.namespace)
+	}
+
+	hostPathType := k8sv1.HostPathDirectory
+
+	vr.podVolumeMounts = append(vr.podVolumeMounts, k8sv1.VolumeMount{
+		Name:      volume.Name,
+		MountPath: vhostuserblk.GetMountedSocketDir(volume.Name),
+	})
+	vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
+		Name: volume.Name,
+		VolumeSource: k8sv1.VolumeSource{
+			HostPath: &k8sv1.HostPathVolumeSource{
+				Path: filepath.Dir(volume.VhostUserBlk.Path),
+				Type: &hostPathType,
+			},
+		},
+	})
+	return nil
+}
+
+func (vr *VolumeRenderer) handleHostBlockDevice(volume v1.Volume) {
+	hostPathType := k8sv1.HostPathBlockDev
+
+	vr.podVolumeMounts = append(vr.podVolumeMounts, k8sv1.VolumeMount{
+		Name:      volume.Name,
+		MountPath: hostblockdevice.GetMountedDevicePath(volume.Name),
+	})
+	vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
+		Name: volume.Name,
+		VolumeSource: k8sv1.VolumeSource{
+			HostPath: &k8sv1.HostPathVolumeSource{
+				Path: volume.HostBlockDevice.Path,
+				Type: &hostPathType,
+			},
+		},
+	})
+}
+
 func (vr *VolumeRenderer) addSecretVolume(volume v1.Volume) {
 	vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
 		Name: volume.Name,
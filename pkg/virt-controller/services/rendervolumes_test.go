@@ -15,6 +15,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
+	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
@@ -163,6 +164,114 @@ var _ = Describe("Container spec renderer", func() {
 		})
 	})
 
+	Context("with vhost-user-blk volume option", func() {
+		const (
+			volumeName = "spdk-disk"
+			socketPath = "/var/tmp/spdk/ns1/vhost.0"
+		)
+
+		var expectedHostPathType = k8sv1.HostPathDirectory
+
+		var socketsConfig *virtconfig.ClusterConfig
+
+		newVhostUserBlkVolume := func(path string) v1.Volume {
+			return v1.Volume{
+				Name: volumeName,
+				VolumeSource: v1.VolumeSource{
+					VhostUserBlk: &v1.VhostUserBlkVolumeSource{Path: path},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			socketsConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				VhostUserBlkSockets: &v1.HostSocketDirectoriesConfiguration{PermittedDirectories: []string{"/var/tmp/spdk"}},
+			})
+
+			var err error
+			vsr, err = NewVolumeRenderer(socketsConfig, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir, withVMIVolumes(nil, []v1.Volume{newVhostUserBlkVolume(socketPath)}, nil))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should refuse a socket outside of the permitted directories", func() {
+			_, err := NewVolumeRenderer(socketsConfig, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir, withVMIVolumes(nil, []v1.Volume{newVhostUserBlkVolume("/var/lib/kubelet/vhost.0")}, nil))
+			Expect(err).To(MatchError(ContainSubstring("is not in a permitted directory")))
+		})
+
+		It("should refuse a socket in the directory of another namespace", func() {
+			_, err := NewVolumeRenderer(socketsConfig, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir, withVMIVolumes(nil, []v1.Volume{newVhostUserBlkVolume("/var/tmp/spdk/ns2/vhost.0")}, nil))
+			Expect(err).To(MatchError(ContainSubstring("is not in a permitted directory of namespace ns1")))
+		})
+
+		It("should feature the default mount points plus the socket directory mount", func() {
+			Expect(vsr.Mounts()).To(ConsistOf(
+				append(
+					defaultVolumeMounts(),
+					k8sv1.VolumeMount{
+						Name:      volumeName,
+						MountPath: "/var/run/kubevirt-private/vhost-user-blk/" + volumeName})))
+		})
+
+		It("should feature the default volumes plus the socket directory of the node", func() {
+			Expect(vsr.Volumes()).To(ConsistOf(
+				append(
+					defaultVolumes(),
+					k8sv1.Volume{
+						Name: volumeName,
+						VolumeSource: k8sv1.VolumeSource{
+							HostPath: &k8sv1.HostPathVolumeSource{
+								Type: &expectedHostPathType,
+								Path: "/var/tmp/spdk/ns1",
+							}},
+					})))
+		})
+	})
+
+	Context("with host block device volume option", func() {
+		const (
+			volumeName = "nvme-disk"
+			devicePath = "/dev/nvme1n1"
+		)
+
+		var expectedHostPathType = k8sv1.HostPathBlockDev
+
+		BeforeEach(func() {
+			hostBlockDevice := v1.Volume{
+				Name: volumeName,
+				VolumeSource: v1.VolumeSource{
+					HostBlockDevice: &v1.HostBlockDeviceVolumeSource{Path: devicePath},
+				},
+			}
+
+			var err error
+			vsr, err = NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir, withVMIVolumes(nil, []v1.Volume{hostBlockDevice}, nil))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should feature the default mount points plus the device mounted like a block PVC", func() {
+			Expect(vsr.Mounts()).To(ConsistOf(
+				append(
+					defaultVolumeMounts(),
+					k8sv1.VolumeMount{
+						Name:      volumeName,
+						MountPath: "/dev/" + volumeName})))
+		})
+
+		It("should feature the default volumes plus the block device of the node", func() {
+			Expect(vsr.Volumes()).To(ConsistOf(
+				append(
+					defaultVolumes(),
+					k8sv1.Volume{
+						Name: volumeName,
+						VolumeSource: k8sv1.VolumeSource{
+							HostPath: &k8sv1.HostPathVolumeSource{
+								Type: &expectedHostPathType,
+								Path: devicePath,
+							}},
+					})))
+		})
+	})
+
 	Context("with CloudInitConfigDrive option", func() {
 		const (
 			cloudInitDriveName = "pepitos-drive"
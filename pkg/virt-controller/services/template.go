@@ -57,6 +57,7 @@ import (
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	"kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/tpm"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/net/dns"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -70,6 +71,7 @@ const (
 	containerDisks   = "container-disks"
 	hotplugDisks     = "hotplug-disks"
 	hookSidecarSocks = "hook-sidecar-sockets"
+	channelSocks     = "channel-sockets"
 	varRun           = "/var/run"
 	virtBinDir       = "virt-bin-share-dir"
 	hotplugDisk      = "hotplug-disk"
@@ -81,6 +83,9 @@ const TunDevice = "devices.kubevirt.io/tun"
 const VhostNetDevice = "devices.kubevirt.io/vhost-net"
 const SevDevice = "devices.kubevirt.io/sev"
 const VhostVsockDevice = "devices.kubevirt.io/vhost-vsock"
+const SGXEPCDevice = "devices.kubevirt.io/sgx-epc"
+const DRIRenderDevice = "devices.kubevirt.io/dri-render"
+const UdmabufDevice = "devices.kubevirt.io/udmabuf"
 const PrDevice = "devices.kubevirt.io/pr-helper"
 
 const debugLogs = "debugLogs"
@@ -363,6 +368,10 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 		})
 	}
 
+	if err := t.checkPersistentReservationStorageClasses(vmi); err != nil {
+		return nil, err
+	}
+
 	networkToResourceMap, err := multus.NetworkToResource(t.virtClient, vmi)
 	if err != nil {
 		return nil, err
@@ -411,6 +420,9 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 		if t.clusterConfig.ImageVolumeEnabled() {
 			command = append(command, "--image-volume")
 		}
+		if t.clusterConfig.ImageIntegrityCheckEnabled() {
+			command = append(command, "--image-integrity-check")
+		}
 		if customDebugFilters, exists := vmi.Annotations[v1.CustomLibvirtLogFiltersAnnotation]; exists {
 			log.Log.Object(vmi).Infof("Applying custom debug filters for vmi %s: %s", vmi.Name, customDebugFilters)
 			command = append(command, "--libvirt-log-filters", customDebugFilters)
@@ -712,7 +724,7 @@ func (t *TemplateService) newNodeSelectorRenderer(vmi *v1.VirtualMachineInstance
 	if vmi.Status.Machine != nil && vmi.Status.Machine.Type != "" {
 		machineType = vmi.Status.Machine.Type
 	} else if vmi.Spec.Domain.Machine != nil && vmi.Spec.Domain.Machine.Type != "" {
-		machineType = vmi.Spec.Domain.Machine.Type
+		machineType = t.clusterConfig.ResolveMachineType(vmi.Spec.Architecture, vmi.Spec.Domain.Machine.Type)
 	}
 
 	if machineType != "" {
@@ -779,6 +791,9 @@ func newSidecarContainerRenderer(sidecarName string, vmiSpec *v1.VirtualMachineI
 
 	var mounts []k8sv1.VolumeMount
 	mounts = append(mounts, sidecarVolumeMount(sidecarName))
+	if len(vmiSpec.Spec.Domain.Devices.Channels) > 0 {
+		mounts = append(mounts, mountPath(channelSocks, util.VirtChannelsDir))
+	}
 	if requestedHookSidecar.DownwardAPI == v1.DeviceInfo {
 		mounts = append(mounts, mountPath(downwardapi.NetworkInfoVolumeName, downwardapi.MountPath))
 	}
@@ -861,6 +876,9 @@ func (t *TemplateService) newVolumeRenderer(vmi *v1.VirtualMachineInstance, imag
 	if len(requestedHookSidecarList) != 0 {
 		volumeOpts = append(volumeOpts, withSidecarVolumes(requestedHookSidecarList))
 	}
+	if len(vmi.Spec.Domain.Devices.Channels) > 0 {
+		volumeOpts = append(volumeOpts, withChannelVolumes())
+	}
 
 	if hasHugePages(vmi) {
 		volumeOpts = append(volumeOpts, withHugepages())
@@ -883,6 +901,10 @@ func (t *TemplateService) newVolumeRenderer(vmi *v1.VirtualMachineInstance, imag
 		volumeOpts = append(volumeOpts, withVirioFS())
 	}
 
+	if tpm.HasExternalDevice(&vmi.Spec) {
+		volumeOpts = append(volumeOpts, withExternalTPM(vmi.Spec.Domain.Devices.TPM.External))
+	}
+
 	volumeRenderer, err := NewVolumeRenderer(
 		t.clusterConfig,
 		imageVolumeFeatureGateEnabled,
@@ -1572,6 +1594,50 @@ func (t *TemplateService) VMIResourcePredicates(vmi *v1.VirtualMachineInstance,
 	}
 }
 
+// checkPersistentReservationStorageClasses refuses LUNs requesting a persistent reservation whose PVC does not belong
+// to one of the storage classes allowed in the cluster configuration
+func (t *TemplateService) checkPersistentReservationStorageClasses(vmi *v1.VirtualMachineInstance) error {
+	prConfig := t.clusterConfig.GetPersistentReservation()
+	if prConfig == nil || len(prConfig.StorageClasses) == 0 {
+		return nil
+	}
+	volumes := map[string]*v1.Volume{}
+	for i := range vmi.Spec.Volumes {
+		volumes[vmi.Spec.Volumes[i].Name] = &vmi.Spec.Volumes[i]
+	}
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		if disk.LUN == nil || !disk.LUN.Reservation {
+			continue
+		}
+		volume, exists := volumes[disk.Name]
+		if !exists {
+			continue
+		}
+		claimName := types.PVCNameFromVirtVolume(volume)
+		if volume.Ephemeral != nil && volume.Ephemeral.PersistentVolumeClaim != nil {
+			claimName = volume.Ephemeral.PersistentVolumeClaim.ClaimName
+		}
+		if claimName == "" {
+			continue
+		}
+		pvc, err := types.GetPersistentVolumeClaimFromCache(vmi.Namespace, claimName, t.persistentVolumeClaimStore)
+		if err != nil {
+			return err
+		}
+		if pvc == nil {
+			continue
+		}
+		storageClass := ""
+		if pvc.Spec.StorageClassName != nil {
+			storageClass = *pvc.Spec.StorageClassName
+		}
+		if !reservation.IsStorageClassAllowed(prConfig, storageClass) {
+			return fmt.Errorf("LUN %s requests a persistent reservation, which is not allowed for storage class %q of PVC %s", disk.Name, storageClass, claimName)
+		}
+	}
+	return nil
+}
+
 func (t *TemplateService) doesVMIRequireAutoMemoryLimits(vmi *v1.VirtualMachineInstance) bool {
 	return t.doesVMIRequireAutoResourceLimits(vmi, k8sv1.ResourceMemory)
 }
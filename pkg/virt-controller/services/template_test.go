@@ -256,6 +256,25 @@ var _ = Describe("Template", func() {
 			})
 		})
 
+		DescribeTable("should pass the image integrity check option", func(featureGateEnabled bool) {
+			config, kvStore, svc = configFactory(defaultArch)
+			if featureGateEnabled {
+				enableFeatureGate(featuregate.ImageIntegrityCheck)
+			}
+
+			pod, err := svc.RenderLaunchManifest(newMinimalWithContainerDisk("random"))
+			Expect(err).NotTo(HaveOccurred())
+
+			if featureGateEnabled {
+				Expect(pod.Spec.Containers[0].Command).To(ContainElement("--image-integrity-check"))
+			} else {
+				Expect(pod.Spec.Containers[0].Command).NotTo(ContainElement("--image-integrity-check"))
+			}
+		},
+			Entry("when the ImageIntegrityCheck feature gate is enabled", true),
+			Entry("not when the ImageIntegrityCheck feature gate is disabled", false),
+		)
+
 		It("should not set seccomp profile by default", func() {
 			_, kvStore, svc = configFactory(defaultArch)
 			pod, err := svc.RenderLaunchManifest(newMinimalWithContainerDisk("random"))
@@ -1199,6 +1218,22 @@ var _ = Describe("Template", func() {
 				Entry("when both spec and status machine types are provided, status takes precedence", "specMachineType", "statusMachineType", "statusMachineType"),
 			)
 
+			It("should add node selector for the machine type a machine type alias resolves to", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.ArchitectureConfiguration = &v1.ArchConfiguration{
+					Amd64: &v1.ArchSpecificConfiguration{MachineTypeAliases: map[string]string{"stable": "pc-q35-8.2"}},
+				}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+				vmi := libvmi.New(libvmi.WithNamespace("default"), libvmi.WithArchitecture("amd64"))
+				vmi.Spec.Domain.Machine = &v1.Machine{Type: "stable"}
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.SupportedMachineTypeLabel+"pc-q35-8.2", "true"))
+			})
+
 			It("should add node selectors from kubevirt-config configMap", func() {
 				config, kvStore, svc = configFactory(defaultArch)
 				kvConfig := kv.DeepCopy()
@@ -2710,6 +2745,66 @@ var _ = Describe("Template", func() {
 			})
 		})
 
+		Context("with persistent reservation storage classes", func() {
+			const namespace = "testns"
+
+			newReservationVMI := func(pvcName string) *v1.VirtualMachineInstance {
+				return &v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: namespace, UID: "1234"},
+					Spec: v1.VirtualMachineInstanceSpec{
+						Domain: v1.DomainSpec{
+							Devices: v1.Devices{
+								Disks: []v1.Disk{{
+									Name:       "lun",
+									DiskDevice: v1.DiskDevice{LUN: &v1.LunTarget{Bus: v1.DiskBusSCSI, Reservation: true}},
+								}},
+							},
+						},
+						Volumes: []v1.Volume{{
+							Name: "lun",
+							VolumeSource: v1.VolumeSource{
+								PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: pvcName}},
+							},
+						}},
+					},
+				}
+			}
+
+			BeforeEach(func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.PersistentReservation = &v1.PersistentReservationConfiguration{
+					StorageClasses: []string{"multipath"},
+				}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+			})
+
+			DescribeTable("should", func(storageClass *string, shouldSucceed bool) {
+				pvcName := "pvc-reservation"
+				Expect(pvcCache.Add(&k8sv1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: pvcName},
+					Spec: k8sv1.PersistentVolumeClaimSpec{
+						StorageClassName: storageClass,
+						VolumeMode:       pointer.P(k8sv1.PersistentVolumeBlock),
+					},
+				})).To(Succeed())
+				DeferCleanup(func() {
+					Expect(pvcCache.Delete(&k8sv1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: pvcName}})).To(Succeed())
+				})
+
+				_, err := svc.RenderLaunchManifest(newReservationVMI(pvcName))
+				if shouldSucceed {
+					Expect(err).ToNot(HaveOccurred())
+				} else {
+					Expect(err).To(MatchError(ContainSubstring("persistent reservation")))
+				}
+			},
+				Entry("allow a reservation on a LUN of an allowed storage class", pointer.P("multipath"), true),
+				Entry("refuse a reservation on a LUN of another storage class", pointer.P("local"), false),
+				Entry("refuse a reservation on a LUN without a storage class", nil, false),
+			)
+		})
+
 		Context("with hotplug volumes", func() {
 			It("should render without any hotplug volumes listed in volumeStatus or having `Hotpluggable` flag", func() {
 				config, kvStore, svc = configFactory(defaultArch)
@@ -4884,6 +4979,35 @@ var _ = Describe("Template", func() {
 			})
 		})
 
+		DescribeTable("should share the channel sockets with the sidecars", func(channels []v1.Channel, shared bool) {
+			vmi := api.NewMinimalVMI("channels-sidecar-test")
+			vmi.Annotations = map[string]string{
+				hooks.HookSidecarListAnnotationName: `[{"image": "some-image:v1", "imagePullPolicy": "IfNotPresent"}]`,
+			}
+			vmi.Spec.Domain.Devices.Channels = channels
+			config, kvStore, svc = configFactory(defaultArch)
+			pod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			channelsMount := k8sv1.VolumeMount{Name: "channel-sockets", MountPath: "/var/run/kubevirt-channels"}
+			channelsVolume := k8sv1.Volume{
+				Name:         "channel-sockets",
+				VolumeSource: k8sv1.VolumeSource{EmptyDir: &k8sv1.EmptyDirVolumeSource{}},
+			}
+			if shared {
+				Expect(pod.Spec.Volumes).To(ContainElement(channelsVolume))
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(channelsMount))
+				Expect(pod.Spec.Containers[1].VolumeMounts).To(ContainElement(channelsMount))
+			} else {
+				Expect(pod.Spec.Volumes).ToNot(ContainElement(channelsVolume))
+				Expect(pod.Spec.Containers[0].VolumeMounts).ToNot(ContainElement(channelsMount))
+				Expect(pod.Spec.Containers[1].VolumeMounts).ToNot(ContainElement(channelsMount))
+			}
+		},
+			Entry("with channels", []v1.Channel{{Name: "org.example.agent.0"}}, true),
+			Entry("not without channels", nil, false),
+		)
+
 		Context("with pvc in VMI annotations for sidecar", func() {
 			var vmi *v1.VirtualMachineInstance
 			const (
@@ -5030,6 +5154,45 @@ var _ = Describe("Template", func() {
 			})
 		})
 
+		It("should mount the socket directory of an external swtpm", func() {
+			config, kvStore, svc = configFactory(defaultArch)
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.ExternalTPMSockets = &v1.HostSocketDirectoriesConfiguration{
+				PermittedDirectories: []string{"/var/run/vtpm"},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.TPM = &v1.TPMDevice{
+				External: &v1.TPMExternal{SocketPath: "/var/run/vtpm/default/testvmi.sock"},
+			}
+			pod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pod.Spec.Volumes).To(ContainElement(k8sv1.Volume{
+				Name: "swtpm-external",
+				VolumeSource: k8sv1.VolumeSource{
+					HostPath: &k8sv1.HostPathVolumeSource{
+						Path: "/var/run/vtpm/default",
+						Type: pointer.P(k8sv1.HostPathDirectory),
+					},
+				},
+			}))
+			Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(k8sv1.VolumeMount{
+				Name:      "swtpm-external",
+				MountPath: "/var/run/kubevirt-private/swtpm-external",
+			}))
+		})
+
+		It("should refuse an external swtpm outside of the permitted socket directories", func() {
+			config, kvStore, svc = configFactory(defaultArch)
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.TPM = &v1.TPMDevice{
+				External: &v1.TPMExternal{SocketPath: "/var/run/vtpm/testvmi.sock"},
+			}
+			_, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).To(MatchError(ContainSubstring("is not in a permitted directory")))
+		})
+
 		Context("with shared filesystem disks", func() {
 			createFSPVC := func(name string, accessMode k8sv1.PersistentVolumeAccessMode) *k8sv1.PersistentVolumeClaim {
 				return &k8sv1.PersistentVolumeClaim{
@@ -5192,6 +5355,44 @@ var _ = Describe("Template", func() {
 		})
 	})
 
+	Context("with SGX", func() {
+		It("should request the EPC of the guest in MiB", func() {
+			vmi := api.NewMinimalVMI("fake-vmi")
+			vmi.Spec.Domain.Devices.SGX = &v1.SGX{EPCSize: resource.MustParse("1500Ki")}
+
+			pod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).NotTo(HaveOccurred())
+			epc := pod.Spec.Containers[0].Resources.Limits[k8sv1.ResourceName(SGXEPCDevice)]
+			Expect(epc.Value()).To(Equal(int64(2)))
+		})
+	})
+
+	Context("with 3D accelerated video", func() {
+		BeforeEach(func() {
+			config, kvStore, svc = configFactory(defaultArch)
+		})
+
+		It("should request a render node", func() {
+			vmi := api.NewMinimalVMI("fake-vmi")
+			vmi.Spec.Domain.Devices.Video = &v1.VideoDevice{Type: "virtio", Accel3D: v1.VideoAccel3DVirgl}
+
+			pod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pod.Spec.Containers[0].Resources.Limits).To(HaveKey(k8sv1.ResourceName(DRIRenderDevice)))
+			Expect(pod.Spec.Containers[0].Resources.Limits).ToNot(HaveKey(k8sv1.ResourceName(UdmabufDevice)))
+		})
+
+		It("should request udmabuf for venus", func() {
+			vmi := api.NewMinimalVMI("fake-vmi")
+			vmi.Spec.Domain.Devices.Videos = []v1.VideoDevice{{Type: "virtio", Accel3D: v1.VideoAccel3DVenus}}
+
+			pod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pod.Spec.Containers[0].Resources.Limits).To(HaveKey(k8sv1.ResourceName(DRIRenderDevice)))
+			Expect(pod.Spec.Containers[0].Resources.Limits).To(HaveKey(k8sv1.ResourceName(UdmabufDevice)))
+		})
+	})
+
 	Context("with auto CPU limits", func() {
 		const (
 			rqNamespace   = "rq-namespace"
@@ -8,6 +8,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/api"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
@@ -92,4 +93,32 @@ var _ = Describe("virtiofs container", func() {
 		Expect(container[1].SecurityContext.RunAsNonRoot).To(HaveValue(BeTrue()))
 		Expect(container[1].SecurityContext.AllowPrivilegeEscalation).To(HaveValue(BeFalse()))
 	})
+
+	DescribeTable("should pass the filesystem options to virtiofsd", func(virtiofs *v1.FilesystemVirtiofs, expectedArgs, unexpectedArgs []string) {
+		vmi := api.NewMinimalVMI("testvm")
+		vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+			Name: "sharedtestdisk",
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: testutils.NewFakePersistentVolumeSource(),
+			},
+		})
+		vmi.Spec.Domain.Devices.Filesystems = append(vmi.Spec.Domain.Devices.Filesystems, v1.Filesystem{
+			Name:     "sharedtestdisk",
+			Virtiofs: virtiofs,
+		})
+
+		containers := generateVirtioFSContainers(vmi, "virtiofs-container", config)
+		Expect(containers).To(HaveLen(1))
+		for _, arg := range expectedArgs {
+			Expect(containers[0].Args).To(ContainElement(arg))
+		}
+		for _, arg := range unexpectedArgs {
+			Expect(containers[0].Args).ToNot(ContainElement(arg))
+		}
+	},
+		Entry("with the defaults", &v1.FilesystemVirtiofs{}, []string{"--sandbox=none", "--cache=auto"}, []string{"--xattr"}),
+		Entry("with a cache mode", &v1.FilesystemVirtiofs{Cache: v1.VirtiofsCacheNever}, []string{"--cache=never"}, []string{"--cache=auto"}),
+		Entry("with xattr enabled", &v1.FilesystemVirtiofs{Xattr: pointer.P(true)}, []string{"--xattr"}, nil),
+		Entry("with xattr disabled", &v1.FilesystemVirtiofs{Xattr: pointer.P(false)}, nil, []string{"--xattr"}),
+	)
 })
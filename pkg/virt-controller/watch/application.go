@@ -38,9 +38,11 @@ import (
 	clone "kubevirt.io/api/clone/v1beta1"
 
 	clonecontroller "kubevirt.io/kubevirt/pkg/virt-controller/watch/clone"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/cpuburst"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/migration"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/node"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/pool"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/quota"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/replicaset"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vm"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vmi"
@@ -184,6 +186,11 @@ type VirtControllerApp struct {
 	vmController *vm.Controller
 	vmInformer   cache.SharedIndexInformer
 
+	cpuBurstController *cpuburst.Controller
+
+	quotaController             *quota.Controller
+	virtualizationQuotaInformer cache.SharedIndexInformer
+
 	controllerRevisionInformer cache.SharedIndexInformer
 
 	dataVolumeInformer     cache.SharedIndexInformer
@@ -264,6 +271,8 @@ type VirtControllerApp struct {
 	migrationControllerThreads        int
 	evacuationControllerThreads       int
 	disruptionBudgetControllerThreads int
+	cpuBurstControllerThreads         int
+	quotaControllerThreads            int
 	launcherSubGid                    int64
 	exportControllerThreads           int
 	snapshotControllerThreads         int
@@ -402,6 +411,7 @@ func Execute() {
 	app.pdbInformer = app.informerFactory.K8SInformerFactory().Policy().V1().PodDisruptionBudgets().Informer()
 
 	app.vmInformer = app.informerFactory.VirtualMachine()
+	app.virtualizationQuotaInformer = app.informerFactory.VirtualizationQuota()
 
 	app.migrationInformer = app.informerFactory.VirtualMachineInstanceMigration()
 
@@ -492,6 +502,8 @@ func Execute() {
 	app.initPool()
 	app.initVirtualMachines()
 	app.initDisruptionBudgetController()
+	app.initCPUBurstController()
+	app.initQuotaController()
 	app.initEvacuationController()
 	app.initSnapshotController()
 	app.initRestoreController()
@@ -614,6 +626,8 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 
 		go vca.evacuationController.Run(vca.evacuationControllerThreads, stop)
 		go vca.disruptionBudgetController.Run(vca.disruptionBudgetControllerThreads, stop)
+		go vca.cpuBurstController.Run(vca.cpuBurstControllerThreads, stop)
+		go vca.quotaController.Run(vca.quotaControllerThreads, stop)
 		go vca.nodeController.Run(vca.nodeControllerThreads, stop)
 		go vca.vmiController.Run(vca.vmiControllerThreads, stop)
 		if vca.isDRAEnabled {
@@ -815,6 +829,8 @@ func (vca *VirtControllerApp) initVirtualMachines() {
 		vca.namespaceInformer,
 		vca.persistentVolumeClaimInformer,
 		vca.controllerRevisionInformer,
+		vca.vmSnapshotInformer,
+		vca.virtualizationQuotaInformer,
 		recorder,
 		vca.clientSet,
 		vca.clusterConfig,
@@ -856,6 +872,34 @@ func (vca *VirtControllerApp) initDisruptionBudgetController() {
 	}
 }
 
+func (vca *VirtControllerApp) initCPUBurstController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "cpuburst-controller")
+	vca.cpuBurstController, err = cpuburst.NewController(
+		vca.clientSet,
+		vca.vmInformer,
+		vca.vmiInformer,
+		vca.kvPodInformer,
+		recorder,
+		vca.clusterConfig,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initQuotaController() {
+	var err error
+	vca.quotaController, err = quota.NewController(
+		vca.clientSet,
+		vca.virtualizationQuotaInformer,
+		vca.vmiInformer,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) initWorkloadUpdaterController() {
 	var err error
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "workload-update-controller")
@@ -1073,6 +1117,12 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.disruptionBudgetControllerThreads, "disruption-budget-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for disruption budget controller")
 
+	flag.IntVar(&vca.cpuBurstControllerThreads, "cpu-burst-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for CPU burst controller")
+
+	flag.IntVar(&vca.quotaControllerThreads, "virtualization-quota-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for virtualization quota controller")
+
 	flag.Int64Var(&vca.launcherSubGid, "launcher-subgid", defaultLauncherSubGid,
 		"ID of subgroup to virt-launcher")
 
@@ -47,6 +47,7 @@ import (
 	exportv1 "kubevirt.io/api/export/v1beta1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	overcommitv1 "kubevirt.io/api/overcommit/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
@@ -93,6 +94,7 @@ var _ = Describe("Application", func() {
 		vmInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachine{})
 		vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
 		vmSnapshotInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
+		quotaInformer, _ := testutils.NewFakeInformerFor(&overcommitv1.VirtualizationQuota{})
 		vmSnapshotContentInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
 		migrationInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstanceMigration{})
 		nodeInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Node{})
@@ -172,6 +174,8 @@ var _ = Describe("Application", func() {
 			namespaceInformer,
 			pvcInformer,
 			crInformer,
+			vmSnapshotInformer,
+			quotaInformer,
 			recorder,
 			virtClient,
 			config,
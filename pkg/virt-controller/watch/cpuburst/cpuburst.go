@@ -0,0 +1,279 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cpuburst
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/cpuburst"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// SteadyCPULimitAnnotation keeps the CPU limit of the compute container while the pod bursts,
+	// so that it can be restored once the window is over, even across virt-controller restarts.
+	SteadyCPULimitAnnotation = "kubevirt.io/cpu-burst-steady-limit"
+
+	CPUBurstStartedReason = "CPUBurstStarted"
+	CPUBurstEndedReason   = "CPUBurstEnded"
+	FailedCPUBurstReason  = "FailedCPUBurst"
+
+	computeContainerName = "compute"
+)
+
+// Controller raises the CPU limit of the virt-launcher pod of a VM during the windows declared in
+// its spec, and restores the steady-state limit afterwards. The pod is resized in place.
+type Controller struct {
+	clientset     kubecli.KubevirtClient
+	Queue         workqueue.TypedRateLimitingInterface[string]
+	vmStore       cache.Store
+	vmiStore      cache.Store
+	podIndexer    cache.Indexer
+	recorder      record.EventRecorder
+	clusterConfig *virtconfig.ClusterConfig
+	clock         clock.Clock
+	hasSynced     func() bool
+}
+
+func NewController(clientset kubecli.KubevirtClient,
+	vmInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	podInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+	clusterConfig *virtconfig.ClusterConfig,
+) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		Queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-cpu-burst"},
+		),
+		vmStore:       vmInformer.GetStore(),
+		vmiStore:      vmiInformer.GetStore(),
+		podIndexer:    podInformer.GetIndexer(),
+		recorder:      recorder,
+		clusterConfig: clusterConfig,
+		clock:         clock.RealClock{},
+	}
+
+	c.hasSynced = func() bool {
+		return vmInformer.HasSynced() && vmiInformer.HasSynced() && podInformer.HasSynced()
+	}
+
+	_, err := vmInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueue,
+		UpdateFunc: func(_, curr interface{}) { c.enqueue(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The VM and its VMI share the same key
+	_, err = vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueue,
+		UpdateFunc: func(_, curr interface{}) { c.enqueue(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to extract key from object.")
+		return
+	}
+	c.Queue.Add(key)
+}
+
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting CPU burst controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping CPU burst controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing VirtualMachine %v", key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed VirtualMachine %v", key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	obj, exists, err := c.vmStore.GetByKey(key)
+	if err != nil || !exists {
+		return err
+	}
+	vm := obj.(*v1.VirtualMachine)
+
+	obj, exists, err = c.vmiStore.GetByKey(key)
+	if err != nil || !exists {
+		return err
+	}
+	vmi := obj.(*v1.VirtualMachineInstance)
+	if !vmi.IsRunning() || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+
+	pod, err := controller.CurrentVMIPod(vmi, c.podIndexer)
+	if err != nil || pod == nil || pod.DeletionTimestamp != nil {
+		return err
+	}
+
+	// Pods which still burst are restored once the feature gate is disabled
+	active := false
+	if vm.Spec.CPUBurst != nil && c.clusterConfig.CPUBurstWindowsEnabled() {
+		now := c.clock.Now()
+		var next time.Time
+		active, next = cpuburst.Evaluate(vm.Spec.CPUBurst.Windows, now)
+		if !next.IsZero() {
+			c.Queue.AddAfter(key, next.Sub(now))
+		}
+	}
+
+	return c.sync(vm, pod, active)
+}
+
+func (c *Controller) sync(vm *v1.VirtualMachine, pod *k8sv1.Pod, active bool) error {
+	container := computeContainer(pod)
+	if container == nil {
+		return nil
+	}
+	currentLimit, hasLimit := container.Resources.Limits[k8sv1.ResourceCPU]
+	steadyLimit, bursting := pod.Annotations[SteadyCPULimitAnnotation]
+
+	switch {
+	case active && !bursting:
+		// Raising the limit of a pod without limit or changing its QoS class is not possible in place
+		if !hasLimit || pod.Status.QOSClass == k8sv1.PodQOSGuaranteed {
+			log.Log.Object(vm).V(3).Infof("The CPU limit of pod %s can not be raised in place", pod.Name)
+			return nil
+		}
+		if err := c.patchSteadyLimit(pod, patch.WithAdd(annotationPath(), currentLimit.String())); err != nil {
+			return err
+		}
+		if err := c.resize(vm, pod, vm.Spec.CPUBurst.CPULimit); err != nil {
+			return err
+		}
+		c.recorder.Eventf(vm, k8sv1.EventTypeNormal, CPUBurstStartedReason, "Raised the CPU limit of pod %s from %s to %s", pod.Name, currentLimit.String(), vm.Spec.CPUBurst.CPULimit.String())
+
+	case active && bursting:
+		// The CPU limit of the window may have been changed while it is open
+		if !currentLimit.Equal(vm.Spec.CPUBurst.CPULimit) {
+			return c.resize(vm, pod, vm.Spec.CPUBurst.CPULimit)
+		}
+
+	case !active && bursting:
+		limit, err := resource.ParseQuantity(steadyLimit)
+		if err != nil {
+			log.Log.Object(vm).Reason(err).Errorf("Invalid steady-state CPU limit on pod %s", pod.Name)
+			return c.patchSteadyLimit(pod, patch.WithRemove(annotationPath()))
+		}
+		if !currentLimit.Equal(limit) {
+			if err := c.resize(vm, pod, limit); err != nil {
+				return err
+			}
+		}
+		if err := c.patchSteadyLimit(pod, patch.WithRemove(annotationPath())); err != nil {
+			return err
+		}
+		c.recorder.Eventf(vm, k8sv1.EventTypeNormal, CPUBurstEndedReason, "Restored the CPU limit of pod %s to %s", pod.Name, limit.String())
+	}
+
+	return nil
+}
+
+func (c *Controller) resize(vm *v1.VirtualMachine, pod *k8sv1.Pod, limit resource.Quantity) error {
+	resizedPod := pod.DeepCopy()
+	computeContainer(resizedPod).Resources.Limits[k8sv1.ResourceCPU] = limit
+	_, err := c.clientset.CoreV1().Pods(pod.Namespace).UpdateResize(context.Background(), pod.Name, resizedPod, metav1.UpdateOptions{})
+	if err != nil {
+		c.recorder.Eventf(vm, k8sv1.EventTypeWarning, FailedCPUBurstReason, "Failed to resize the CPU limit of pod %s to %s: %v", pod.Name, limit.String(), err)
+		return fmt.Errorf("failed to resize pod %s: %v", pod.Name, err)
+	}
+	return nil
+}
+
+func (c *Controller) patchSteadyLimit(pod *k8sv1.Pod, option patch.PatchOption) error {
+	patchBytes, err := patch.New(option).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.CoreV1().Pods(pod.Namespace).Patch(context.Background(), pod.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+func annotationPath() string {
+	return fmt.Sprintf("/metadata/annotations/%s", patch.EscapeJSONPointer(SteadyCPULimitAnnotation))
+}
+
+func computeContainer(pod *k8sv1.Pod) *k8sv1.Container {
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name == computeContainerName {
+			return &pod.Spec.Containers[i]
+		}
+	}
+	return nil
+}
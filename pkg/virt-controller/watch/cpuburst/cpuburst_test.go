@@ -0,0 +1,224 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cpuburst
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("CPU burst controller", func() {
+	var (
+		kubeClient *fake.Clientset
+		recorder   *record.FakeRecorder
+		ctrl       *Controller
+		fakeClock  *testclock.FakeClock
+	)
+
+	initController := func(featureGates ...string) {
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		kubeClient = fake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+
+		// Make sure that all unexpected calls to kubeClient will fail
+		kubeClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			Expect(action).To(BeNil())
+			return true, nil, nil
+		})
+
+		vmInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		podInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+
+		var err error
+		ctrl, err = NewController(virtClient, vmInformer, vmiInformer, podInformer, recorder, config)
+		Expect(err).ToNot(HaveOccurred())
+		fakeClock = testclock.NewFakeClock(time.Date(2025, time.March, 10, 1, 0, 0, 0, time.UTC))
+		ctrl.clock = fakeClock
+	}
+
+	newVM := func() (*v1.VirtualMachine, *v1.VirtualMachineInstance) {
+		vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault), libvmi.WithName("testvmi"))
+		vmi.UID = "vmi-uid"
+		vmi.Status.Phase = v1.Running
+		vm := libvmi.NewVirtualMachine(vmi.DeepCopy())
+		vm.Spec.CPUBurst = &v1.CPUBurst{
+			CPULimit: resource.MustParse("4"),
+			Windows: []v1.CPUBurstWindow{
+				{Start: "02:00", Duration: metav1.Duration{Duration: time.Hour}},
+			},
+		}
+		return vm, vmi
+	}
+
+	newPod := func(vmi *v1.VirtualMachineInstance, qosClass k8sv1.PodQOSClass, annotations map[string]string) *k8sv1.Pod {
+		return &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "virt-launcher-testvmi",
+				Namespace:       vmi.Namespace,
+				Annotations:     annotations,
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(vmi, v1.VirtualMachineInstanceGroupVersionKind)},
+			},
+			Spec: k8sv1.PodSpec{
+				Containers: []k8sv1.Container{{
+					Name: computeContainerName,
+					Resources: k8sv1.ResourceRequirements{
+						Requests: k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("500m")},
+						Limits:   k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("1")},
+					},
+				}},
+			},
+			Status: k8sv1.PodStatus{QOSClass: qosClass},
+		}
+	}
+
+	addObjects := func(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance, pod *k8sv1.Pod) {
+		Expect(ctrl.vmStore.Add(vm)).To(Succeed())
+		Expect(ctrl.vmiStore.Add(vmi)).To(Succeed())
+		Expect(ctrl.podIndexer.Add(pod)).To(Succeed())
+	}
+
+	expectResize := func(limit string) {
+		kubeClient.Fake.PrependReactor("update", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update := action.(testing.UpdateAction)
+			Expect(update.GetSubresource()).To(Equal("resize"))
+			pod := update.GetObject().(*k8sv1.Pod)
+			Expect(computeContainer(pod).Resources.Limits.Cpu().String()).To(Equal(limit))
+			return true, pod, nil
+		})
+	}
+
+	expectPatch := func(expectedPatch string) {
+		kubeClient.Fake.PrependReactor("patch", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			patch := action.(testing.PatchAction)
+			Expect(string(patch.GetPatch())).To(Equal(expectedPatch))
+			return true, nil, nil
+		})
+	}
+
+	execute := func(vm *v1.VirtualMachine) {
+		key, err := controller.KeyFunc(vm)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ctrl.execute(key)).To(Succeed())
+	}
+
+	It("should not resize the pod outside of a window", func() {
+		initController(featuregate.CPUBurstWindows)
+		vm, vmi := newVM()
+		addObjects(vm, vmi, newPod(vmi, k8sv1.PodQOSBurstable, map[string]string{}))
+
+		execute(vm)
+		Expect(kubeClient.Actions()).To(BeEmpty())
+		Expect(ctrl.Queue.Len()).To(BeZero())
+	})
+
+	It("should raise the CPU limit when a window opens", func() {
+		initController(featuregate.CPUBurstWindows)
+		vm, vmi := newVM()
+		addObjects(vm, vmi, newPod(vmi, k8sv1.PodQOSBurstable, map[string]string{}))
+		fakeClock.SetTime(time.Date(2025, time.March, 10, 2, 30, 0, 0, time.UTC))
+
+		expectPatch(`[{"op":"add","path":"/metadata/annotations/kubevirt.io~1cpu-burst-steady-limit","value":"1"}]`)
+		expectResize("4")
+		execute(vm)
+		Expect(kubeClient.Actions()).To(HaveLen(2))
+		testutils.ExpectEvent(recorder, CPUBurstStartedReason)
+	})
+
+	It("should restore the steady-state CPU limit when a window closes", func() {
+		initController(featuregate.CPUBurstWindows)
+		vm, vmi := newVM()
+		pod := newPod(vmi, k8sv1.PodQOSBurstable, map[string]string{SteadyCPULimitAnnotation: "1"})
+		pod.Spec.Containers[0].Resources.Limits[k8sv1.ResourceCPU] = resource.MustParse("4")
+		addObjects(vm, vmi, pod)
+
+		expectResize("1")
+		expectPatch(`[{"op":"remove","path":"/metadata/annotations/kubevirt.io~1cpu-burst-steady-limit"}]`)
+		execute(vm)
+		Expect(kubeClient.Actions()).To(HaveLen(2))
+		testutils.ExpectEvent(recorder, CPUBurstEndedReason)
+	})
+
+	It("should restore the steady-state CPU limit when the feature gate is disabled", func() {
+		initController()
+		vm, vmi := newVM()
+		pod := newPod(vmi, k8sv1.PodQOSBurstable, map[string]string{SteadyCPULimitAnnotation: "1"})
+		pod.Spec.Containers[0].Resources.Limits[k8sv1.ResourceCPU] = resource.MustParse("4")
+		addObjects(vm, vmi, pod)
+		fakeClock.SetTime(time.Date(2025, time.March, 10, 2, 30, 0, 0, time.UTC))
+
+		expectResize("1")
+		expectPatch(`[{"op":"remove","path":"/metadata/annotations/kubevirt.io~1cpu-burst-steady-limit"}]`)
+		execute(vm)
+		testutils.ExpectEvent(recorder, CPUBurstEndedReason)
+	})
+
+	It("should not resize a Guaranteed pod", func() {
+		initController(featuregate.CPUBurstWindows)
+		vm, vmi := newVM()
+		addObjects(vm, vmi, newPod(vmi, k8sv1.PodQOSGuaranteed, map[string]string{}))
+		fakeClock.SetTime(time.Date(2025, time.March, 10, 2, 30, 0, 0, time.UTC))
+
+		execute(vm)
+		Expect(kubeClient.Actions()).To(BeEmpty())
+	})
+
+	It("should report a failed resize", func() {
+		initController(featuregate.CPUBurstWindows)
+		vm, vmi := newVM()
+		addObjects(vm, vmi, newPod(vmi, k8sv1.PodQOSBurstable, map[string]string{}))
+		fakeClock.SetTime(time.Date(2025, time.March, 10, 2, 30, 0, 0, time.UTC))
+
+		expectPatch(`[{"op":"add","path":"/metadata/annotations/kubevirt.io~1cpu-burst-steady-limit","value":"1"}]`)
+		kubeClient.Fake.PrependReactor("update", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			return true, nil, errors.New("resize is infeasible")
+		})
+		key, err := controller.KeyFunc(vm)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ctrl.execute(key)).To(MatchError(ContainSubstring("resize is infeasible")))
+		testutils.ExpectEvent(recorder, FailedCPUBurstReason)
+	})
+})
@@ -102,6 +102,15 @@ const defaultCatchAllPendingTimeoutSeconds = int64(60 * 15)
 
 var migrationBackoffError = errors.New(controller.MigrationBackoffReason)
 
+// incompatibleHostModelError is returned when no node can provide the host-model CPU of a VMI
+type incompatibleHostModelError struct {
+	message string
+}
+
+func (e *incompatibleHostModelError) Error() string {
+	return e.message
+}
+
 type templateService interface {
 	RenderMigrationManifest(vmi *virtv1.VirtualMachineInstance, migration *virtv1.VirtualMachineInstanceMigration, sourcePod *k8sv1.Pod) (*k8sv1.Pod, error)
 	RenderLaunchManifest(vmi *virtv1.VirtualMachineInstance) (*k8sv1.Pod, error)
@@ -743,6 +752,20 @@ func (c *Controller) processMigrationPhase(
 					LastProbeTime: v1.Now(),
 				}
 				migrationCopy.Status.Conditions = append(migrationCopy.Status.Conditions, condition)
+			} else if incompatibleErr := (*incompatibleHostModelError)(nil); errors.As(syncError, &incompatibleErr) {
+				if err := c.failMigration(migrationCopy); err != nil {
+					return err
+				}
+				condition := virtv1.VirtualMachineInstanceMigrationCondition{
+					Type:          virtv1.VirtualMachineInstanceMigrationRejectedByIncompatibleCPU,
+					Status:        k8sv1.ConditionTrue,
+					LastProbeTime: v1.Now(),
+					Reason:        controller.NoSuitableNodesForHostModelMigration,
+					Message:       incompatibleErr.message,
+				}
+				migrationCopy.Status.Conditions = append(migrationCopy.Status.Conditions, condition)
+				c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedMigrationReason, "Migration failed: %s", incompatibleErr.message)
+				log.Log.Object(migration).Error(incompatibleErr.message)
 			}
 		} else {
 			if migration.IsDecentralizedSource() && vmi.IsRunning() {
@@ -954,6 +977,10 @@ func (c *Controller) createTargetPod(migration *virtv1.VirtualMachineInstanceMig
 		for k, v := range nodeSelectors {
 			templatePod.Spec.NodeSelector[k] = v
 		}
+
+		if err := c.checkHostModelCompatibility(migration, vmi, templatePod.Spec.NodeSelector); err != nil {
+			return err
+		}
 	}
 
 	// Ensure migration happens only between nodes with the same CPU vendor
@@ -2372,6 +2399,67 @@ func (c *Controller) alertIfHostModelIsUnschedulable(vmi *virtv1.VirtualMachineI
 	}
 }
 
+// checkHostModelCompatibility fails fast when no node, besides the source one, provides the
+// host-model CPU model and the features required by the migration target pod. Nodes which are
+// not schedulable by KubeVirt yet are not considered, and neither is a cluster without any other
+// node: the target pod is still created and the pending pod timeouts apply.
+func (c *Controller) checkHostModelCompatibility(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance, nodeSelector map[string]string) error {
+	requiredNodeLabels := map[string]string{}
+	for key, value := range nodeSelector {
+		if strings.HasPrefix(key, virtv1.SupportedHostModelMigrationCPU) || strings.HasPrefix(key, virtv1.CPUFeatureLabel) {
+			requiredNodeLabels[key] = value
+		}
+	}
+
+	var closestNode string
+	var closestNodeMissing []string
+	for _, obj := range c.nodeStore.List() {
+		node := obj.(*k8sv1.Node)
+		if node.Name == vmi.Status.NodeName || node.Labels[virtv1.NodeSchedulable] != "true" {
+			continue
+		}
+		if !isNodeSuitableForHostModelMigration(node, migration.Spec.AddedNodeSelector) {
+			continue
+		}
+
+		missing := missingHostModelLabels(node, requiredNodeLabels)
+		if len(missing) == 0 {
+			return nil
+		}
+		if closestNodeMissing == nil || len(missing) < len(closestNodeMissing) ||
+			(len(missing) == len(closestNodeMissing) && node.Name < closestNode) {
+			closestNode = node.Name
+			closestNodeMissing = missing
+		}
+	}
+
+	if closestNodeMissing == nil {
+		return nil
+	}
+	return &incompatibleHostModelError{
+		message: fmt.Sprintf("no node supports the host-model CPU of VMI %s/%s, the closest node %s is missing %s",
+			vmi.Namespace, vmi.Name, closestNode, strings.Join(closestNodeMissing, ", ")),
+	}
+}
+
+// missingHostModelLabels returns the CPU model and features required by the host-model
+// node labels which the node does not provide, in a human readable form.
+func missingHostModelLabels(node *k8sv1.Node, requiredNodeLabels map[string]string) []string {
+	var missing []string
+	for key, value := range requiredNodeLabels {
+		if node.Labels[key] == value {
+			continue
+		}
+		if model, isModel := strings.CutPrefix(key, virtv1.SupportedHostModelMigrationCPU); isModel {
+			missing = append(missing, "CPU model "+model)
+		} else {
+			missing = append(missing, "CPU feature "+strings.TrimPrefix(key, virtv1.CPUFeatureLabel))
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
 func getNodeSelectorsFromVMIMigrationSourceState(sourceState *virtv1.VirtualMachineInstanceMigrationSourceState) (map[string]string, error) {
 	result, nodeSelectorKeyForHostModel, err := getHostCpuModelFromMap(sourceState.NodeSelectors)
 	if err != nil {
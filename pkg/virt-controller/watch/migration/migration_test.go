@@ -23,6 +23,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"strings"
 	"time"
 
@@ -2209,6 +2210,97 @@ var _ = Describe("Migration watcher", func() {
 			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulDeletePodReason)
 			expectPodDoesNotExist(vmi.Namespace, string(vmi.UID), string(migration.UID))
 		})
+
+		Context("before creating the target pod", func() {
+			const sourceNodeName = "sourceNode"
+
+			var vmi *v1.VirtualMachineInstance
+			var migration *v1.VirtualMachineInstanceMigration
+
+			newSchedulableNode := func(name string, labels map[string]string) *k8sv1.Node {
+				node := newNode(name)
+				node.Labels = map[string]string{v1.NodeSchedulable: "true"}
+				maps.Copy(node.Labels, labels)
+				return node
+			}
+
+			BeforeEach(func() {
+				vmi = newVirtualMachine("testvmi", v1.Running)
+				addNodeNameToVMI(vmi, sourceNodeName)
+				vmi.Spec.Domain.CPU = &v1.CPU{Model: v1.CPUModeHostModel}
+				migration = newMigration("testmigration", vmi.Name, v1.MigrationPending)
+
+				addNode(newSchedulableNode(sourceNodeName, map[string]string{
+					v1.HostModelCPULabel + "Skylake":              "true",
+					v1.SupportedHostModelMigrationCPU + "Skylake": "true",
+					v1.HostModelRequiredFeaturesLabel + "avx512f": "true",
+					v1.HostModelRequiredFeaturesLabel + "pku":     "true",
+				}))
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+				addPod(newSourcePodForVirtualMachine(vmi))
+			})
+
+			It("should fail the migration with the features missing on the closest node", func() {
+				addNode(newSchedulableNode("node01", map[string]string{
+					v1.SupportedHostModelMigrationCPU + "Skylake": "true",
+					v1.CPUFeatureLabel + "avx512f":                "true",
+				}))
+				addNode(newSchedulableNode("node02", nil))
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, virtcontroller.FailedMigrationReason)
+				expectMigrationFailedState(migration.Namespace, migration.Name)
+				expectPodDoesNotExist(vmi.Namespace, string(vmi.UID), string(migration.UID))
+				updatedVMIM, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(migration.Namespace).Get(context.Background(), migration.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updatedVMIM.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":    Equal(v1.VirtualMachineInstanceMigrationRejectedByIncompatibleCPU),
+					"Status":  Equal(k8sv1.ConditionTrue),
+					"Reason":  Equal(virtcontroller.NoSuitableNodesForHostModelMigration),
+					"Message": Equal("no node supports the host-model CPU of VMI default/testvmi, the closest node node01 is missing CPU feature pku"),
+				})))
+			})
+
+			It("should only consider the nodes selected by the migration", func() {
+				migration.Spec.AddedNodeSelector = map[string]string{"zone": "east"}
+				Expect(controller.migrationIndexer.Update(migration)).To(Succeed())
+				addNode(newSchedulableNode("node01", map[string]string{
+					v1.SupportedHostModelMigrationCPU + "Skylake": "true",
+					v1.CPUFeatureLabel + "avx512f":                "true",
+					v1.CPUFeatureLabel + "pku":                    "true",
+				}))
+				addNode(newSchedulableNode("node02", map[string]string{"zone": "east"}))
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, virtcontroller.FailedMigrationReason)
+				expectMigrationFailedState(migration.Namespace, migration.Name)
+				expectMigrationCondition(migration.Namespace, migration.Name, v1.VirtualMachineInstanceMigrationRejectedByIncompatibleCPU)
+			})
+
+			DescribeTable("should create the target pod", func(nodes ...*k8sv1.Node) {
+				for _, node := range nodes {
+					addNode(node)
+				}
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCreatePodReason)
+				expectMigrationPendingState(migration.Namespace, migration.Name)
+			},
+				Entry("when a node supports the host-model CPU",
+					newSchedulableNode("node01", nil),
+					newSchedulableNode("node02", map[string]string{
+						v1.SupportedHostModelMigrationCPU + "Skylake": "true",
+						v1.CPUFeatureLabel + "avx512f":                "true",
+						v1.CPUFeatureLabel + "pku":                    "true",
+					}),
+				),
+				Entry("when there is no other schedulable node", newNode("node01")),
+			)
+		})
 	})
 
 	Context("CPU vendor label constraints", func() {
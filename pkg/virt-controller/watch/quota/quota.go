@@ -0,0 +1,201 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	overcommitv1 "kubevirt.io/api/overcommit/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/quota"
+)
+
+// Controller keeps the usage in the status of the VirtualizationQuotas up to date
+// with the domain resources of the VMIs in their namespace.
+type Controller struct {
+	clientset    kubecli.KubevirtClient
+	Queue        workqueue.TypedRateLimitingInterface[string]
+	quotaIndexer cache.Indexer
+	vmiIndexer   cache.Indexer
+	hasSynced    func() bool
+}
+
+func NewController(clientset kubecli.KubevirtClient,
+	quotaInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		Queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-virtualization-quota"},
+		),
+		quotaIndexer: quotaInformer.GetIndexer(),
+		vmiIndexer:   vmiInformer.GetIndexer(),
+	}
+
+	c.hasSynced = func() bool {
+		return quotaInformer.HasSynced() && vmiInformer.HasSynced()
+	}
+
+	_, err := quotaInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueue,
+		UpdateFunc: func(_, curr interface{}) { c.enqueue(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueNamespaceQuotas,
+		UpdateFunc: func(_, curr interface{}) { c.enqueueNamespaceQuotas(curr) },
+		DeleteFunc: c.enqueueNamespaceQuotas,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to extract key from object.")
+		return
+	}
+	c.Queue.Add(key)
+}
+
+func (c *Controller) enqueueNamespaceQuotas(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	vmi, ok := obj.(*v1.VirtualMachineInstance)
+	if !ok {
+		log.Log.Errorf("unexpected object %T", obj)
+		return
+	}
+	quotas, err := c.quotaIndexer.ByIndex(cache.NamespaceIndex, vmi.Namespace)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to list the VirtualizationQuotas of namespace %s", vmi.Namespace)
+		return
+	}
+	for _, quota := range quotas {
+		c.enqueue(quota)
+	}
+}
+
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting virtualization quota controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping virtualization quota controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing VirtualizationQuota %v", key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed VirtualizationQuota %v", key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	obj, exists, err := c.quotaIndexer.GetByKey(key)
+	if err != nil || !exists {
+		return err
+	}
+	vq := obj.(*overcommitv1.VirtualizationQuota)
+	if vq.DeletionTimestamp != nil {
+		return nil
+	}
+
+	used, err := c.namespaceUsage(vq.Namespace)
+	if err != nil {
+		return err
+	}
+
+	status := overcommitv1.VirtualizationQuotaStatus{
+		Hard: quota.Mask(vq.Spec.Hard, vq.Spec.Hard),
+		Used: quota.Mask(used, vq.Spec.Hard),
+	}
+	if equality.Semantic.DeepEqual(vq.Status, status) {
+		return nil
+	}
+
+	vqCopy := vq.DeepCopy()
+	vqCopy.Status = status
+	_, err = c.clientset.VirtualizationQuota(vq.Namespace).UpdateStatus(context.Background(), vqCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to update the status of VirtualizationQuota %s: %v", key, err)
+	}
+	return nil
+}
+
+func (c *Controller) namespaceUsage(namespace string) (k8sv1.ResourceList, error) {
+	objs, err := c.vmiIndexer.ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		return nil, err
+	}
+	used := k8sv1.ResourceList{}
+	for _, obj := range objs {
+		vmi := obj.(*v1.VirtualMachineInstance)
+		if quota.Counted(vmi) {
+			quota.Add(used, quota.Usage(vmi))
+		}
+	}
+	return used, nil
+}
@@ -0,0 +1,148 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package quota
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	overcommitv1 "kubevirt.io/api/overcommit/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Virtualization quota controller", func() {
+	var (
+		client     *kubevirtfake.Clientset
+		ctrl       *Controller
+		quotaStore cache.Store
+		vmiStore   cache.Store
+	)
+
+	BeforeEach(func() {
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		client = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualizationQuota(metav1.NamespaceDefault).
+			Return(client.OvercommitV1alpha1().VirtualizationQuotas(metav1.NamespaceDefault)).AnyTimes()
+
+		quotaInformer, _ := testutils.NewFakeInformerWithIndexersFor(&overcommitv1.VirtualizationQuota{}, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		})
+		vmiInformer, _ := testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstance{}, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		})
+		quotaStore = quotaInformer.GetStore()
+		vmiStore = vmiInformer.GetStore()
+
+		var err error
+		ctrl, err = NewController(virtClient, quotaInformer, vmiInformer)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	addQuota := func(hard k8sv1.ResourceList) *overcommitv1.VirtualizationQuota {
+		vq := &overcommitv1.VirtualizationQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: metav1.NamespaceDefault},
+			Spec:       overcommitv1.VirtualizationQuotaSpec{Hard: hard},
+		}
+		_, err := client.OvercommitV1alpha1().VirtualizationQuotas(vq.Namespace).Create(context.Background(), vq, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(quotaStore.Add(vq)).To(Succeed())
+		return vq
+	}
+
+	addVMI := func(name string, phase v1.VirtualMachineInstancePhase, opts ...libvmi.Option) {
+		opts = append(opts, libvmi.WithNamespace(metav1.NamespaceDefault))
+		vmi := libvmi.New(append([]libvmi.Option{libvmi.WithName(name)}, opts...)...)
+		vmi.Status.Phase = phase
+		Expect(vmiStore.Add(vmi)).To(Succeed())
+	}
+
+	getStatus := func() overcommitv1.VirtualizationQuotaStatus {
+		vq, err := client.OvercommitV1alpha1().VirtualizationQuotas(metav1.NamespaceDefault).Get(context.Background(), "quota", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vq.Status
+	}
+
+	It("should sum the domain resources of the VMIs which are not final", func() {
+		addQuota(k8sv1.ResourceList{
+			overcommitv1.ResourceVCPUs:       resource.MustParse("16"),
+			overcommitv1.ResourceGuestMemory: resource.MustParse("16Gi"),
+		})
+		addVMI("running", v1.Running, libvmi.WithCPUCount(2, 2, 1), libvmi.WithGuestMemory("4Gi"))
+		addVMI("pending", v1.Pending, libvmi.WithMemoryRequest("1Gi"))
+		addVMI("succeeded", v1.Succeeded, libvmi.WithCPUCount(8, 1, 1), libvmi.WithGuestMemory("8Gi"))
+
+		ctrl.enqueue(quotaStore.List()[0])
+		Expect(ctrl.Execute()).To(BeTrue())
+
+		status := getStatus()
+		Expect(status.Hard).To(HaveLen(2))
+		Expect(status.Used).To(HaveLen(2))
+		Expect(status.Used.Name(overcommitv1.ResourceVCPUs, resource.DecimalSI).String()).To(Equal("5"))
+		Expect(status.Used.Name(overcommitv1.ResourceGuestMemory, resource.BinarySI).String()).To(Equal("5Gi"))
+	})
+
+	It("should report a zero usage for limited resources no VMI uses", func() {
+		addQuota(k8sv1.ResourceList{overcommitv1.ResourceGPUs: resource.MustParse("2")})
+		addVMI("running", v1.Running)
+
+		ctrl.enqueue(quotaStore.List()[0])
+		Expect(ctrl.Execute()).To(BeTrue())
+
+		status := getStatus()
+		Expect(status.Used).To(HaveKey(overcommitv1.ResourceGPUs))
+		Expect(status.Used.Name(overcommitv1.ResourceGPUs, resource.DecimalSI).IsZero()).To(BeTrue())
+	})
+
+	It("should not update an up to date status", func() {
+		vq := addQuota(k8sv1.ResourceList{overcommitv1.ResourceVCPUs: resource.MustParse("4")})
+		vq.Status = overcommitv1.VirtualizationQuotaStatus{
+			Hard: k8sv1.ResourceList{overcommitv1.ResourceVCPUs: resource.MustParse("4")},
+			Used: k8sv1.ResourceList{overcommitv1.ResourceVCPUs: resource.MustParse("0")},
+		}
+		Expect(quotaStore.Update(vq)).To(Succeed())
+
+		ctrl.enqueue(vq)
+		Expect(ctrl.Execute()).To(BeTrue())
+		Expect(client.Actions()).To(HaveLen(1))
+	})
+
+	It("should enqueue the quotas of the namespace of a VMI", func() {
+		addQuota(k8sv1.ResourceList{overcommitv1.ResourceVCPUs: resource.MustParse("4")})
+
+		ctrl.enqueueNamespaceQuotas(libvmi.New(libvmi.WithNamespace(metav1.NamespaceDefault)))
+		Expect(ctrl.Queue.Len()).To(Equal(1))
+
+		ctrl.enqueueNamespaceQuotas(libvmi.New(libvmi.WithNamespace("other")))
+		Expect(ctrl.Queue.Len()).To(Equal(1))
+	})
+})
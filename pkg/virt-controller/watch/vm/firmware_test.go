@@ -31,6 +31,7 @@ import (
 	"kubevirt.io/client-go/kubevirt/fake"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	firmwareutil "kubevirt.io/kubevirt/pkg/util/firmware"
 )
 
 var _ = Describe("VM Firmware Controller", func() {
@@ -86,7 +87,7 @@ var _ = Describe("VM Firmware Controller", func() {
 		Expect(vm).To(Equal(originalVM))
 		Expect(err).NotTo(HaveOccurred())
 
-		legacyUUID := CalculateLegacyUUID(vm.Name)
+		legacyUUID := firmwareutil.CalculateLegacyUUID(vm.Name)
 		expectedVM := originalVM.DeepCopy()
 		expectedVM.Spec.Template.Spec.Domain.Firmware = &v1.Firmware{UUID: legacyUUID}
 		Expect(updatedVM).To(Equal(expectedVM))
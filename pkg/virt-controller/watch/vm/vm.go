@@ -61,17 +61,23 @@ import (
 	"k8s.io/utils/trace"
 
 	virtv1 "kubevirt.io/api/core/v1"
+	overcommitv1 "kubevirt.io/api/overcommit/v1alpha1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
+	drautil "kubevirt.io/kubevirt/pkg/dra"
+	"kubevirt.io/kubevirt/pkg/quota"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
 	storagehotplug "kubevirt.io/kubevirt/pkg/storage/hotplug"
 	"kubevirt.io/kubevirt/pkg/storage/memorydump"
+	"kubevirt.io/kubevirt/pkg/storage/snapshot/maintenance"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
+	firmwareutil "kubevirt.io/kubevirt/pkg/util/firmware"
 	"kubevirt.io/kubevirt/pkg/util/hardware"
 	"kubevirt.io/kubevirt/pkg/util/migrations"
 	traceUtils "kubevirt.io/kubevirt/pkg/util/trace"
@@ -124,6 +130,8 @@ const (
 	hotplugMemoryErrorReason           = "HotPlugMemoryError"
 	volumesUpdateErrorReason           = "VolumesUpdateError"
 	tolerationsChangeErrorReason       = "TolerationsChangeError"
+	ioThreadsChangeErrorReason         = "IOThreadsChangeError"
+	usbDevicesChangeErrorReason        = "USBDevicesChangeError"
 	annotationsLabelsChangeErrorReason = "AnnotationsLabelsChangeError"
 )
 
@@ -137,6 +145,8 @@ func NewController(vmiInformer cache.SharedIndexInformer,
 	namespaceInformer cache.SharedIndexInformer,
 	pvcInformer cache.SharedIndexInformer,
 	crInformer cache.SharedIndexInformer,
+	vmSnapshotInformer cache.SharedIndexInformer,
+	quotaInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -159,6 +169,8 @@ func NewController(vmiInformer cache.SharedIndexInformer,
 		namespaceStore:         namespaceInformer.GetStore(),
 		pvcStore:               pvcInformer.GetStore(),
 		crIndexer:              crInformer.GetIndexer(),
+		vmSnapshotStore:        vmSnapshotInformer.GetStore(),
+		quotaIndexer:           quotaInformer.GetIndexer(),
 		instancetypeController: instancetypeController,
 		recorder:               recorder,
 		clientset:              clientset,
@@ -178,7 +190,8 @@ func NewController(vmiInformer cache.SharedIndexInformer,
 	c.hasSynced = func() bool {
 		return vmiInformer.HasSynced() && vmInformer.HasSynced() &&
 			dataVolumeInformer.HasSynced() && dataSourceInformer.HasSynced() &&
-			pvcInformer.HasSynced() && crInformer.HasSynced()
+			pvcInformer.HasSynced() && crInformer.HasSynced() &&
+			vmSnapshotInformer.HasSynced() && quotaInformer.HasSynced()
 	}
 
 	_, err := vmInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -215,6 +228,15 @@ func NewController(vmiInformer cache.SharedIndexInformer,
 		return nil, err
 	}
 
+	_, err = vmSnapshotInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addVirtualMachineSnapshot,
+		DeleteFunc: c.deleteVirtualMachineSnapshot,
+		UpdateFunc: c.updateVirtualMachineSnapshot,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	_, err = kubeVirtInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		UpdateFunc: c.handleKubeVirtUpdate,
 	})
@@ -288,6 +310,8 @@ type Controller struct {
 	namespaceStore         cache.Store
 	pvcStore               cache.Store
 	crIndexer              cache.Indexer
+	vmSnapshotStore        cache.Store
+	quotaIndexer           cache.Indexer
 	instancetypeController instancetypeHandler
 	recorder               record.EventRecorder
 	expectations           *controller.UIDTrackingControllerExpectations
@@ -662,6 +686,12 @@ func (c *Controller) handleCPUChangeRequest(vm *virtv1.VirtualMachine, vmi *virt
 		return nil
 	}
 
+	updatedVMI := vmi.DeepCopy()
+	updatedVMI.Spec.Domain.CPU.Sockets = vmCopyWithInstancetype.Spec.Template.Spec.Domain.CPU.Sockets
+	if err := c.checkVirtualizationQuotas(vmi, updatedVMI); err != nil {
+		return err
+	}
+
 	if err := c.VMICPUsPatch(vmCopyWithInstancetype, vmi); err != nil {
 		log.Log.Object(vmi).Errorf("unable to patch vmi to add cpu topology status: %v", err)
 		return err
@@ -770,6 +800,151 @@ func (c *Controller) handleTolerationsChangeRequest(vm *virtv1.VirtualMachine, v
 	return nil
 }
 
+// hasLiveUpdatableIOThreads reports whether iothreads can be added to the supplemental pool
+// of a running VM. Dedicated CPUs are allocated to the pool iothreads, the VM has to restart to change them.
+func hasLiveUpdatableIOThreads(vmiSpec *virtv1.VirtualMachineInstanceSpec) bool {
+	return vmiSpec.Domain.IOThreadsPolicy != nil && *vmiSpec.Domain.IOThreadsPolicy == virtv1.IOThreadsPolicySupplementalPool &&
+		(vmiSpec.Domain.CPU == nil || !vmiSpec.Domain.CPU.DedicatedCPUPlacement)
+}
+
+// isIOThreadsPoolShrinking reports whether the supplemental pool loses iothreads. Every disk is served
+// by all the iothreads of the pool, so they can only be removed by restarting the VM.
+func isIOThreadsPoolShrinking(oldIOThreads, newIOThreads *virtv1.DiskIOThreads) bool {
+	poolSize := func(ioThreads *virtv1.DiskIOThreads) uint32 {
+		if ioThreads == nil || ioThreads.SupplementalPoolThreadCount == nil {
+			return 0
+		}
+		return *ioThreads.SupplementalPoolThreadCount
+	}
+	return poolSize(newIOThreads) < poolSize(oldIOThreads)
+}
+
+func (c *Controller) handleIOThreadsChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+
+	vmCopyWithInstancetype := vm.DeepCopy()
+	if err := c.instancetypeController.ApplyToVM(vmCopyWithInstancetype); err != nil {
+		return err
+	}
+
+	ioThreads := vmCopyWithInstancetype.Spec.Template.Spec.Domain.IOThreads
+	if !hasLiveUpdatableIOThreads(&vmi.Spec) || ioThreads == nil || equality.Semantic.DeepEqual(ioThreads, vmi.Spec.Domain.IOThreads) ||
+		isIOThreadsPoolShrinking(vmi.Spec.Domain.IOThreads, ioThreads) {
+		return nil
+	}
+
+	if migrations.IsMigrating(vmi) {
+		return fmt.Errorf("iothreads should not be changed during VMI migration")
+	}
+
+	patchset := patch.New()
+	if vmi.Spec.Domain.IOThreads == nil {
+		patchset.AddOption(patch.WithAdd("/spec/domain/ioThreads", ioThreads))
+	} else {
+		patchset.AddOption(
+			patch.WithTest("/spec/domain/ioThreads", vmi.Spec.Domain.IOThreads),
+			patch.WithReplace("/spec/domain/ioThreads", ioThreads))
+	}
+	generatedPatch, err := patchset.GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, generatedPatch, metav1.PatchOptions{}); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update iothreads: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (c *Controller) permittedUSBResources() map[string]struct{} {
+	usbResources := map[string]struct{}{}
+	if hostDevs := c.clusterConfig.GetPermittedHostDevices(); hostDevs != nil {
+		for _, usbHostDev := range hostDevs.USB {
+			usbResources[usbHostDev.ResourceName] = struct{}{}
+		}
+	}
+	return usbResources
+}
+
+// hasOnlyUSBHostDeviceChanges reports whether the two host device lists differ at most in the devices
+// backed by one of the USB resources. Those are the only ones virt-handler can hot-plug.
+func hasOnlyUSBHostDeviceChanges(oldHostDevices, newHostDevices []virtv1.HostDevice, usbResources map[string]struct{}) bool {
+	nonUSBHostDevices := func(hostDevices []virtv1.HostDevice) []virtv1.HostDevice {
+		var filtered []virtv1.HostDevice
+		for _, hostDev := range hostDevices {
+			if _, isUSB := usbResources[hostDev.DeviceName]; !isUSB || drautil.IsHostDeviceDRA(hostDev) {
+				filtered = append(filtered, hostDev)
+			}
+		}
+		return filtered
+	}
+	return equality.Semantic.DeepEqual(nonUSBHostDevices(oldHostDevices), nonUSBHostDevices(newHostDevices))
+}
+
+func (c *Controller) handleUSBDevicesChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+
+	vmCopyWithInstancetype := vm.DeepCopy()
+	if err := c.instancetypeController.ApplyToVM(vmCopyWithInstancetype); err != nil {
+		return err
+	}
+
+	devices := &vmCopyWithInstancetype.Spec.Template.Spec.Domain.Devices
+	hostDevicesChanged := !equality.Semantic.DeepEqual(devices.HostDevices, vmi.Spec.Domain.Devices.HostDevices)
+	clientPassthroughChanged := !equality.Semantic.DeepEqual(devices.ClientPassthrough, vmi.Spec.Domain.Devices.ClientPassthrough)
+	if !hostDevicesChanged && !clientPassthroughChanged {
+		return nil
+	}
+
+	if hostDevicesChanged && !hasOnlyUSBHostDeviceChanges(vmi.Spec.Domain.Devices.HostDevices, devices.HostDevices, c.permittedUSBResources()) {
+		return nil
+	}
+
+	if migrations.IsMigrating(vmi) {
+		return fmt.Errorf("USB devices should not be changed during VMI migration")
+	}
+
+	patchset := patch.New()
+	if hostDevicesChanged {
+		addReplaceOrRemove(patchset, "/spec/domain/devices/hostDevices",
+			vmi.Spec.Domain.Devices.HostDevices, len(vmi.Spec.Domain.Devices.HostDevices) > 0,
+			devices.HostDevices, len(devices.HostDevices) > 0)
+	}
+	if clientPassthroughChanged {
+		addReplaceOrRemove(patchset, "/spec/domain/devices/clientPassthrough",
+			vmi.Spec.Domain.Devices.ClientPassthrough, vmi.Spec.Domain.Devices.ClientPassthrough != nil,
+			devices.ClientPassthrough, devices.ClientPassthrough != nil)
+	}
+	generatedPatch, err := patchset.GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, generatedPatch, metav1.PatchOptions{}); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update USB devices: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func addReplaceOrRemove(patchset *patch.PatchSet, path string, oldValue interface{}, oldSet bool, newValue interface{}, newSet bool) {
+	switch {
+	case !oldSet:
+		patchset.AddOption(patch.WithAdd(path, newValue))
+	case !newSet:
+		patchset.AddOption(patch.WithTest(path, oldValue), patch.WithRemove(path))
+	default:
+		patchset.AddOption(patch.WithTest(path, oldValue), patch.WithReplace(path, newValue))
+	}
+}
+
 func (c *Controller) handleAffinityChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
 	if vmi == nil || vmi.DeletionTimestamp != nil {
 		return nil
@@ -1033,7 +1208,8 @@ func (c *Controller) syncRunStrategy(vm *virtv1.VirtualMachine, vmi *virtv1.Virt
 					return vm, common.NewSyncError(fmt.Errorf(failureDeletingVmiErrFormat, err), vmiFailedDeleteReason)
 				}
 
-				if vmiFailed {
+				// A guest which powered itself off is not restarted, even if the VMI failed afterwards
+				if vmiFailed && !isGuestInitiatedShutdown(vmi) {
 					if err := c.addStartRequest(vm); err != nil {
 						return vm, common.NewSyncError(fmt.Errorf("failed to patch VM with start action: %v", err), vmiFailedDeleteReason)
 					}
@@ -1188,6 +1364,11 @@ func (c *Controller) isVMIStopExpected(vm *virtv1.VirtualMachine) bool {
 	return dels > 0
 }
 
+// isGuestInitiatedShutdown returns whether the guest of the VMI powered itself off
+func isGuestInitiatedShutdown(vmi *virtv1.VirtualMachineInstance) bool {
+	return controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceGuestInitiatedShutdown, k8score.ConditionTrue)
+}
+
 // isSetToStart determines whether a VM is configured to be started (running).
 func isSetToStart(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
 	runStrategy, err := vm.RunStrategy()
@@ -1208,7 +1389,7 @@ func isSetToStart(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance)
 		return hasStartRequest(vm)
 	case virtv1.RunStrategyRerunOnFailure:
 		if vmi != nil {
-			return vmi.Status.Phase != virtv1.Succeeded
+			return vmi.Status.Phase != virtv1.Succeeded && !isGuestInitiatedShutdown(vmi)
 		}
 		return true
 	case virtv1.RunStrategyOnce:
@@ -1921,7 +2102,7 @@ func setupStableFirmwareUUID(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachi
 		return
 	}
 
-	vmi.Spec.Domain.Firmware.UUID = CalculateLegacyUUID(vmi.Name)
+	vmi.Spec.Domain.Firmware.UUID = firmwareutil.CalculateLegacyUUID(vmi.Name)
 }
 
 // listControllerFromNamespace takes a namespace and returns all VirtualMachines
@@ -2126,6 +2307,49 @@ func (c *Controller) listVMsMatchingPVC(namespace, pvcName string) ([]*virtv1.Vi
 }
 
 // addPVC handles the addition of a PVC, enqueuing affected VMIs.
+func (c *Controller) addVirtualMachineSnapshot(obj interface{}) {
+	c.queueVMForMaintenanceSnapshot(obj.(*snapshotv1.VirtualMachineSnapshot))
+}
+
+func (c *Controller) updateVirtualMachineSnapshot(old, cur interface{}) {
+	curSnapshot := cur.(*snapshotv1.VirtualMachineSnapshot)
+	oldSnapshot := old.(*snapshotv1.VirtualMachineSnapshot)
+	if curSnapshot.ResourceVersion == oldSnapshot.ResourceVersion {
+		return
+	}
+	c.queueVMForMaintenanceSnapshot(curSnapshot)
+}
+
+func (c *Controller) deleteVirtualMachineSnapshot(obj interface{}) {
+	snapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("couldn't get object from tombstone %+v", obj)).Error(failedProcessDeleteNotificationErrMsg)
+			return
+		}
+		snapshot, ok = tombstone.Obj.(*snapshotv1.VirtualMachineSnapshot)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("tombstone contained object that is not a snapshot %#v", obj)).Error(failedProcessDeleteNotificationErrMsg)
+			return
+		}
+	}
+	c.queueVMForMaintenanceSnapshot(snapshot)
+}
+
+// queueVMForMaintenanceSnapshot wakes up the VM holding back changes until its maintenance snapshot completes
+func (c *Controller) queueVMForMaintenanceSnapshot(snapshot *snapshotv1.VirtualMachineSnapshot) {
+	if !maintenance.IsMaintenanceSnapshot(snapshot) {
+		return
+	}
+	if controllerRef := metav1.GetControllerOf(snapshot); controllerRef != nil {
+		if vm := c.resolveControllerRef(snapshot.Namespace, controllerRef); vm != nil {
+			log.Log.V(4).Object(snapshot).Infof("Maintenance snapshot updated for vm %s", vm.Name)
+			c.enqueueVm(vm)
+		}
+	}
+}
+
 func (c *Controller) addPVC(obj interface{}) {
 	pvc := obj.(*k8score.PersistentVolumeClaim)
 	if pvc.DeletionTimestamp != nil {
@@ -3004,6 +3228,17 @@ func (c *Controller) addRestartRequiredIfNeeded(lastSeenVMSpec *virtv1.VirtualMa
 			lastSeenVM.Spec.Template.Spec.Domain.Memory.Guest = currentVM.Spec.Template.Spec.Domain.Memory.Guest
 		}
 
+		if hasLiveUpdatableIOThreads(&lastSeenVM.Spec.Template.Spec) && hasLiveUpdatableIOThreads(&currentVM.Spec.Template.Spec) &&
+			!isIOThreadsPoolShrinking(lastSeenVM.Spec.Template.Spec.Domain.IOThreads, currentVM.Spec.Template.Spec.Domain.IOThreads) {
+			lastSeenVM.Spec.Template.Spec.Domain.IOThreads = currentVM.Spec.Template.Spec.Domain.IOThreads
+		}
+
+		lastSeenDevices, currentDevices := &lastSeenVM.Spec.Template.Spec.Domain.Devices, &currentVM.Spec.Template.Spec.Domain.Devices
+		if hasOnlyUSBHostDeviceChanges(lastSeenDevices.HostDevices, currentDevices.HostDevices, c.permittedUSBResources()) {
+			lastSeenDevices.HostDevices = currentDevices.HostDevices
+		}
+		lastSeenDevices.ClientPassthrough = currentDevices.ClientPassthrough
+
 		lastSeenVM.Spec.Template.Spec.NodeSelector = currentVM.Spec.Template.Spec.NodeSelector
 		lastSeenVM.Spec.Template.Spec.Affinity = currentVM.Spec.Template.Spec.Affinity
 		lastSeenVM.Spec.Template.Spec.Tolerations = currentVM.Spec.Template.Spec.Tolerations
@@ -3191,7 +3426,11 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 	vmCopy := vm.DeepCopy()
 	vm.Spec.RunStrategy = origRunStrategy
 
-	if c.netSynchronizer != nil {
+	maintenanceSnapshotCompleted, err := c.handleMaintenanceSnapshot(vmCopy, vmi, startVMSpec)
+	if err != nil {
+		return vm, vmi, common.NewSyncError(fmt.Errorf("Error encountered while handling maintenance snapshot: %v", err), maintenance.ErrorReason), nil
+	}
+	if c.netSynchronizer != nil && maintenanceSnapshotCompleted {
 		syncedVM, err := c.netSynchronizer.Sync(vmCopy, vmi)
 		if err != nil {
 			return vm, vmi, handleSynchronizerErr(err), nil
@@ -3226,7 +3465,7 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 	}
 
 	conditionManager := controller.NewVirtualMachineConditionManager()
-	if c.clusterConfig.IsVMRolloutStrategyLiveUpdate() && !restartRequired && !conditionManager.HasCondition(vm, virtv1.VirtualMachineRestartRequired) {
+	if c.clusterConfig.IsVMRolloutStrategyLiveUpdate() && !restartRequired && !conditionManager.HasCondition(vm, virtv1.VirtualMachineRestartRequired) && maintenanceSnapshotCompleted {
 		if err := c.handleCPUChangeRequest(vmCopy, vmi); err != nil {
 			return vm, vmi, common.NewSyncError(fmt.Errorf("Error encountered while handling CPU change request: %v", err), hotplugCPUErrorReason), nil
 		}
@@ -3239,6 +3478,14 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 			return vm, vmi, common.NewSyncError(fmt.Errorf("Error encountered while handling tolerations change request: %v", err), tolerationsChangeErrorReason), nil
 		}
 
+		if err := c.handleIOThreadsChangeRequest(vmCopy, vmi); err != nil {
+			return vm, vmi, common.NewSyncError(fmt.Errorf("Error encountered while handling iothreads change request: %v", err), ioThreadsChangeErrorReason), nil
+		}
+
+		if err := c.handleUSBDevicesChangeRequest(vmCopy, vmi); err != nil {
+			return vm, vmi, common.NewSyncError(fmt.Errorf("Error encountered while handling USB devices change request: %v", err), usbDevicesChangeErrorReason), nil
+		}
+
 		if err := c.handleMemoryHotplugRequest(vmCopy, vmi); err != nil {
 			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling memory hotplug requests: %v", err), hotplugMemoryErrorReason), nil
 		}
@@ -3261,6 +3508,58 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 	return vm, vmi, nil, nil
 }
 
+// handleMaintenanceSnapshot takes a snapshot of a running VM opting in to maintenance snapshots
+// before risky changes are applied to it. It returns false as long as the snapshot is in progress.
+// A failed snapshot does not block the changes, but is reported by an event.
+func (c *Controller) handleMaintenanceSnapshot(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, startVMSpec *virtv1.VirtualMachineSpec) (bool, error) {
+	if vm.Spec.MaintenanceSnapshot == nil || !c.clusterConfig.MaintenanceSnapshotsEnabled() || !c.clusterConfig.SnapshotEnabled() {
+		return true, nil
+	}
+
+	if vmi == nil || vmi.DeletionTimestamp != nil || !vmi.IsRunning() {
+		return true, nil
+	}
+
+	vmCopyWithInstancetype := vm.DeepCopy()
+	if err := c.instancetypeController.ApplyToVM(vmCopyWithInstancetype); err != nil {
+		return false, err
+	}
+
+	changes := maintenance.Changes(&vmCopyWithInstancetype.Spec, vmi, startVMSpec)
+	if len(changes) == 0 {
+		return true, nil
+	}
+
+	// The VM is requeued by the snapshot informer once the snapshot progresses
+	snapshotName := maintenance.SnapshotName(vm)
+	obj, exists, err := c.vmSnapshotStore.GetByKey(controller.NamespacedKey(vm.Namespace, snapshotName))
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		_, err := c.clientset.VirtualMachineSnapshot(vm.Namespace).Create(context.Background(), maintenance.NewSnapshot(vm), metav1.CreateOptions{})
+		if apiErrors.IsAlreadyExists(err) {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+		c.recorder.Eventf(vm, k8score.EventTypeNormal, maintenance.SnapshotCreatedReason,
+			"Created snapshot %s before applying %s changes", snapshotName, strings.Join(changes, ", "))
+		return false, nil
+	}
+
+	snapshot := obj.(*snapshotv1.VirtualMachineSnapshot)
+	if !maintenance.Completed(snapshot) {
+		return false, nil
+	}
+
+	if snapshot.Status.Phase == snapshotv1.Failed {
+		c.recorder.Eventf(vm, k8score.EventTypeWarning, maintenance.SnapshotFailedReason,
+			"Snapshot %s failed, applying %s changes without it", snapshotName, strings.Join(changes, ", "))
+	}
+	return true, nil
+}
+
 func handleSynchronizerErr(err error) common.SyncError {
 	if err == nil {
 		return nil
@@ -3382,6 +3681,12 @@ func (c *Controller) handleMemoryHotplugRequest(vm *virtv1.VirtualMachine, vmi *
 		return nil
 	}
 
+	updatedVMI := vmi.DeepCopy()
+	updatedVMI.Spec.Domain.Memory.Guest = vmCopyWithInstancetype.Spec.Template.Spec.Domain.Memory.Guest
+	if err := c.checkVirtualizationQuotas(vmi, updatedVMI); err != nil {
+		return err
+	}
+
 	memoryDelta := resource.NewQuantity(vmCopyWithInstancetype.Spec.Template.Spec.Domain.Memory.Guest.Value()-vmi.Status.Memory.GuestCurrent.Value(), resource.BinarySI)
 
 	patchSet := patch.New(
@@ -3437,6 +3742,30 @@ func (c *Controller) handleMemoryHotplugRequest(vm *virtv1.VirtualMachine, vmi *
 	return nil
 }
 
+// checkVirtualizationQuotas returns an error if the domain resources updatedVMI adds to vmi exceed a
+// VirtualizationQuota of its namespace. The hotplug is retried until the quota allows it.
+func (c *Controller) checkVirtualizationQuotas(vmi, updatedVMI *virtv1.VirtualMachineInstance) error {
+	if !c.clusterConfig.VirtualizationQuotasEnabled() {
+		return nil
+	}
+	growth := quota.Growth(vmi, updatedVMI)
+	if len(growth) == 0 {
+		return nil
+	}
+	objs, err := c.quotaIndexer.ByIndex(cache.NamespaceIndex, vmi.Namespace)
+	if err != nil {
+		return err
+	}
+	quotas := make([]*overcommitv1.VirtualizationQuota, 0, len(objs))
+	for _, obj := range objs {
+		quotas = append(quotas, obj.(*overcommitv1.VirtualizationQuota))
+	}
+	if messages := quota.ExceededQuotas(quotas, growth); len(messages) > 0 {
+		return fmt.Errorf("%s", strings.Join(messages, "; "))
+	}
+	return nil
+}
+
 func (c *Controller) handleDeclarativeVolumeHotplug(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
 	if c.clusterConfig.HotplugVolumesEnabled() || !c.clusterConfig.DeclarativeHotplugVolumesEnabled() {
 		log.Log.Object(vm).V(4).Info("Declarative hotplug volumes are not enabled, skipping")
@@ -29,6 +29,8 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	instancetypeapi "kubevirt.io/api/instancetype"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	overcommitv1 "kubevirt.io/api/overcommit/v1alpha1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/api"
 	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
 	"kubevirt.io/client-go/kubecli"
@@ -46,6 +48,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/libdv"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
+	"kubevirt.io/kubevirt/pkg/storage/snapshot/maintenance"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
@@ -84,6 +87,8 @@ var _ = Describe("VirtualMachine", func() {
 		var kvStore cache.Store
 		var virtFakeClient *fake.Clientset
 		var dataVolumeInformer cache.SharedIndexInformer
+		var vmSnapshotInformer cache.SharedIndexInformer
+		var quotaInformer cache.SharedIndexInformer
 
 		BeforeEach(func() {
 			virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
@@ -108,6 +113,10 @@ var _ = Describe("VirtualMachine", func() {
 			vmInformer, _ := testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachine{}, virtcontroller.GetVirtualMachineInformerIndexers())
 			pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
 			namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+			vmSnapshotInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
+			quotaInformer, _ = testutils.NewFakeInformerWithIndexersFor(&overcommitv1.VirtualizationQuota{}, cache.Indexers{
+				cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+			})
 
 			ns1 := &k8sv1.Namespace{
 				ObjectMeta: metav1.ObjectMeta{
@@ -147,6 +156,8 @@ var _ = Describe("VirtualMachine", func() {
 				namespaceInformer,
 				pvcInformer,
 				crInformer,
+				vmSnapshotInformer,
+				quotaInformer,
 				recorder,
 				virtClient,
 				config,
@@ -327,7 +338,7 @@ var _ = Describe("VirtualMachine", func() {
 		sanityExecute := func(vm *v1.VirtualMachine) {
 			controllertesting.SanityExecute(controller, []cache.Store{
 				controller.vmiIndexer, controller.vmIndexer, controller.dataSourceStore, controller.dataVolumeStore,
-				controller.namespaceStore, controller.pvcStore, controller.crIndexer,
+				controller.namespaceStore, controller.pvcStore, controller.crIndexer, controller.vmSnapshotStore,
 			}, Default)
 		}
 
@@ -1633,6 +1644,35 @@ var _ = Describe("VirtualMachine", func() {
 				Entry("once", v1.RunStrategyOnce),
 			)
 
+			It("should not restart a failed VMI whose guest powered itself off with runStrategy RerunOnFailure", func() {
+				vm, vmi := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.Running = nil
+				vm.Spec.RunStrategy = pointer.P(v1.RunStrategyRerunOnFailure)
+				vmi.Status.Phase = v1.Failed
+				vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+					Type:   v1.VirtualMachineInstanceGuestInitiatedShutdown,
+					Status: k8sv1.ConditionTrue,
+					Reason: v1.VirtualMachineInstanceReasonGuestPoweroff,
+				}}
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.TODO(), vmi, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				controller.vmiIndexer.Add(vmi)
+
+				shouldExpectVMIFinalizerRemoval()
+
+				sanityExecute(vm)
+
+				testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(Succeed())
+				Expect(vm.Status.StateChangeRequests).To(BeEmpty())
+			})
+
 			DescribeTable("should calculated expected backoff delay", func(failCount, minExpectedDelay int, maxExpectedDelay int) {
 
 				for i := 0; i < 1000; i++ {
@@ -5277,12 +5317,135 @@ var _ = Describe("VirtualMachine", func() {
 				}))),
 		)
 
+		Context("Maintenance snapshots", func() {
+			BeforeEach(func() {
+				virtClient.EXPECT().VirtualMachineSnapshot(metav1.NamespaceDefault).Return(
+					virtFakeClient.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault),
+				).AnyTimes()
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							DeveloperConfiguration: &v1.DeveloperConfiguration{
+								FeatureGates: []string{featuregate.MaintenanceSnapshots, featuregate.SnapshotGate},
+							},
+							VMRolloutStrategy: &liveUpdate,
+						},
+					},
+				})
+			})
+
+			newVMWithCPUHotplug := func() (*v1.VirtualMachine, *v1.VirtualMachineInstance) {
+				vm, _ := watchtesting.DefaultVirtualMachine(true)
+				vm.Generation = 2
+				vm.Spec.MaintenanceSnapshot = &v1.MaintenanceSnapshotPolicy{}
+				vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{Sockets: 2}
+
+				vmi := api.NewMinimalVMI(vm.Name)
+				vmi.Spec.Domain.CPU = &v1.CPU{Sockets: 1, MaxSockets: 4}
+				vmi.Status.Phase = v1.Running
+				return vm, vmi
+			}
+
+			createSnapshot := func(vm *v1.VirtualMachine, phase snapshotv1.VirtualMachineSnapshotPhase) {
+				snapshot := maintenance.NewSnapshot(vm)
+				snapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{Phase: phase}
+				Expect(vmSnapshotInformer.GetStore().Add(snapshot)).To(Succeed())
+			}
+
+			It("should take a snapshot and hold back a CPU hotplug", func() {
+				vm, vmi := newVMWithCPUHotplug()
+
+				completed, err := controller.handleMaintenanceSnapshot(vm, vmi, &vm.Spec)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(completed).To(BeFalse())
+
+				snapshot, err := virtFakeClient.SnapshotV1beta1().VirtualMachineSnapshots(vm.Namespace).Get(context.Background(), maintenance.SnapshotName(vm), metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(snapshot.Spec.Source.Name).To(Equal(vm.Name))
+				testutils.ExpectEvent(recorder, maintenance.SnapshotCreatedReason)
+			})
+
+			DescribeTable("should", func(phase snapshotv1.VirtualMachineSnapshotPhase, expectedCompleted bool, expectedEvent string) {
+				vm, vmi := newVMWithCPUHotplug()
+				createSnapshot(vm, phase)
+
+				completed, err := controller.handleMaintenanceSnapshot(vm, vmi, &vm.Spec)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(completed).To(Equal(expectedCompleted))
+				if expectedEvent != "" {
+					testutils.ExpectEvent(recorder, expectedEvent)
+				}
+			},
+				Entry("hold back the change while the snapshot is in progress", snapshotv1.InProgress, false, ""),
+				Entry("apply the change once the snapshot succeeded", snapshotv1.Succeeded, true, ""),
+				Entry("apply the change if the snapshot failed", snapshotv1.Failed, true, maintenance.SnapshotFailedReason),
+			)
+
+			It("should not take a snapshot without risky changes", func() {
+				vm, vmi := newVMWithCPUHotplug()
+				vmi.Spec.Domain.CPU.Sockets = 2
+
+				completed, err := controller.handleMaintenanceSnapshot(vm, vmi, &vm.Spec)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(completed).To(BeTrue())
+
+				snapshots, err := virtFakeClient.SnapshotV1beta1().VirtualMachineSnapshots(vm.Namespace).List(context.Background(), metav1.ListOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(snapshots.Items).To(BeEmpty())
+			})
+
+			It("should requeue the VM when its snapshot is updated", func() {
+				vm, _ := newVMWithCPUHotplug()
+				vm.UID = "vm-uid"
+				Expect(controller.vmIndexer.Add(vm)).To(Succeed())
+
+				oldSnapshot := maintenance.NewSnapshot(vm)
+				oldSnapshot.ResourceVersion = "1"
+				snapshot := oldSnapshot.DeepCopy()
+				snapshot.ResourceVersion = "2"
+				snapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{Phase: snapshotv1.Succeeded}
+
+				controller.updateVirtualMachineSnapshot(oldSnapshot, snapshot)
+				Expect(mockQueue.Len()).To(Equal(1))
+			})
+
+			It("should not take a snapshot if the VM does not opt in", func() {
+				vm, vmi := newVMWithCPUHotplug()
+				vm.Spec.MaintenanceSnapshot = nil
+
+				completed, err := controller.handleMaintenanceSnapshot(vm, vmi, &vm.Spec)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(completed).To(BeTrue())
+
+				snapshots, err := virtFakeClient.SnapshotV1beta1().VirtualMachineSnapshots(vm.Namespace).List(context.Background(), metav1.ListOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(snapshots.Items).To(BeEmpty())
+			})
+		})
+
 		Context("Live update features", func() {
 			const maxSocketsFromSpec uint32 = 24
 			const maxSocketsFromConfig uint32 = 48
 			maxGuestFromSpec := resource.MustParse("4Gi")
 			maxGuestFromConfig := resource.MustParse("8Gi")
 
+			addVirtualizationQuota := func(hard, used k8sv1.ResourceList) {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							DeveloperConfiguration: &v1.DeveloperConfiguration{
+								FeatureGates: []string{featuregate.VirtualizationQuotas},
+							},
+						},
+					},
+				})
+				Expect(quotaInformer.GetStore().Add(&overcommitv1.VirtualizationQuota{
+					ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: metav1.NamespaceDefault},
+					Spec:       overcommitv1.VirtualizationQuotaSpec{Hard: hard},
+					Status:     overcommitv1.VirtualizationQuotaStatus{Used: used},
+				})).To(Succeed())
+			}
+
 			Context("CPU", func() {
 				It("should honour the maximum CPU sockets from VM spec", func() {
 					vm, _ := watchtesting.DefaultVirtualMachine(true)
@@ -5422,6 +5585,34 @@ var _ = Describe("VirtualMachine", func() {
 					Expect(vmi.Spec.Domain.Resources.Limits.Cpu().String()).To(Equal(expectedCpuLim.String()))
 				})
 
+				DescribeTable("should only patch VMI when the CPU hotplug fits the VirtualizationQuota", func(hardVCPUs string, allowed bool) {
+					addVirtualizationQuota(
+						k8sv1.ResourceList{overcommitv1.ResourceVCPUs: resource.MustParse(hardVCPUs)},
+						k8sv1.ResourceList{overcommitv1.ResourceVCPUs: resource.MustParse("6")},
+					)
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{Sockets: 4}
+
+					vmi := api.NewMinimalVMI(vm.Name)
+					vmi.Spec.Domain.CPU = &v1.CPU{Sockets: 2, MaxSockets: 8}
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+
+					err = controller.handleCPUChangeRequest(vm, vmi)
+					vmi, getErr := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+					Expect(getErr).NotTo(HaveOccurred())
+					if allowed {
+						Expect(err).ToNot(HaveOccurred())
+						Expect(vmi.Spec.Domain.CPU.Sockets).To(Equal(uint32(4)))
+					} else {
+						Expect(err).To(MatchError("exceeded VirtualizationQuota quota: vcpus"))
+						Expect(vmi.Spec.Domain.CPU.Sockets).To(Equal(uint32(2)))
+					}
+				},
+					Entry("within the quota", "8", true),
+					Entry("not beyond the quota", "7", false),
+				)
+
 				It("should raise RestartRequired condition for ARM64 VM", func() {
 					vm, _ := watchtesting.DefaultVirtualMachine(true)
 					vm.Spec.Template.Spec.Architecture = "arm64"
@@ -5541,6 +5732,46 @@ var _ = Describe("VirtualMachine", func() {
 					}),
 				)
 
+				DescribeTable("should only patch VMI when the memory hotplug fits the VirtualizationQuota", func(hardGuestMemory string, allowed bool) {
+					addVirtualizationQuota(
+						k8sv1.ResourceList{overcommitv1.ResourceGuestMemory: resource.MustParse(hardGuestMemory)},
+						k8sv1.ResourceList{overcommitv1.ResourceGuestMemory: resource.MustParse("2Gi")},
+					)
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					newMemory := resource.MustParse("2Gi")
+					vm.Spec.Template.Spec.Domain.Memory = &v1.Memory{Guest: &newMemory}
+					vm.Spec.Template.Spec.Architecture = "amd64"
+
+					vmi := api.NewMinimalVMI(vm.Name)
+					guestMemory := resource.MustParse("1Gi")
+					vmi.Spec.Domain.Memory = &v1.Memory{Guest: &guestMemory, MaxGuest: &maxGuestFromSpec}
+					vmi.Status.Memory = &v1.MemoryStatus{
+						GuestAtBoot:    &guestMemory,
+						GuestCurrent:   &guestMemory,
+						GuestRequested: &guestMemory,
+					}
+					virtcontroller.NewVirtualMachineInstanceConditionManager().UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+						Type:   v1.VirtualMachineInstanceIsMigratable,
+						Status: k8sv1.ConditionTrue,
+					})
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+
+					err = controller.handleMemoryHotplugRequest(vm, vmi)
+					vmi, getErr := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+					Expect(getErr).NotTo(HaveOccurred())
+					if allowed {
+						Expect(err).ToNot(HaveOccurred())
+						Expect(vmi.Spec.Domain.Memory.Guest.Cmp(newMemory)).To(Equal(0))
+					} else {
+						Expect(err).To(MatchError("exceeded VirtualizationQuota quota: guestMemory"))
+						Expect(vmi.Spec.Domain.Memory.Guest.Cmp(guestMemory)).To(Equal(0))
+					}
+				},
+					Entry("within the quota", "3Gi", true),
+					Entry("not beyond the quota", "2560Mi", false),
+				)
+
 				It("should not patch VMI if memory hotplug is already in progress", func() {
 					vm, _ := watchtesting.DefaultVirtualMachine(true)
 					newMemory := resource.MustParse("128Mi")
@@ -5926,6 +6157,180 @@ var _ = Describe("VirtualMachine", func() {
 				)
 			})
 
+			Context("IOThreads", func() {
+				withSupplementalPool := func(vmiSpec *v1.VirtualMachineInstanceSpec, count uint32) {
+					vmiSpec.Domain.IOThreadsPolicy = pointer.P(v1.IOThreadsPolicySupplementalPool)
+					vmiSpec.Domain.IOThreads = &v1.DiskIOThreads{SupplementalPoolThreadCount: pointer.P(count)}
+				}
+
+				It("should live-update the supplemental pool thread count", func() {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+							},
+						},
+					})
+
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					withSupplementalPool(&vm.Spec.Template.Spec, 4)
+					withSupplementalPool(&vmi.Spec, 2)
+
+					vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+					Expect(err).To(Succeed())
+
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+
+					addVirtualMachine(vm)
+
+					sanityExecute(vm)
+
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(HaveLen(1))
+
+					By("Expecting to see the updated VMI with the new supplemental pool thread count")
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vmi.Spec.Domain.IOThreads.SupplementalPoolThreadCount).To(HaveValue(BeEquivalentTo(4)))
+				})
+
+				It("should not live-update the iothreads of a VM with dedicated CPUs", func() {
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					withSupplementalPool(&vm.Spec.Template.Spec, 4)
+					withSupplementalPool(&vmi.Spec, 2)
+					vmi.Spec.Domain.CPU = &v1.CPU{DedicatedCPUPlacement: true}
+
+					Expect(controller.handleIOThreadsChangeRequest(vm, vmi)).To(Succeed())
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(BeEmpty())
+				})
+
+				It("should not live-update a shrinking supplemental pool", func() {
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					withSupplementalPool(&vm.Spec.Template.Spec, 2)
+					withSupplementalPool(&vmi.Spec, 4)
+
+					Expect(controller.handleIOThreadsChangeRequest(vm, vmi)).To(Succeed())
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(BeEmpty())
+				})
+
+				DescribeTable("should require a restart", func(dedicatedCPUs bool, changeIOThreads func(vmiSpec *v1.VirtualMachineInstanceSpec), expectedRestartRequired bool) {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+							},
+						},
+					})
+
+					lastSeenVM, vmi := watchtesting.DefaultVirtualMachine(true)
+					withSupplementalPool(&lastSeenVM.Spec.Template.Spec, 2)
+					lastSeenVM.Spec.Template.Spec.Domain.CPU = &v1.CPU{DedicatedCPUPlacement: dedicatedCPUs}
+					vm := lastSeenVM.DeepCopy()
+					changeIOThreads(&vm.Spec.Template.Spec)
+
+					Expect(controller.addRestartRequiredIfNeeded(&lastSeenVM.Spec, vm, vmi)).To(Equal(expectedRestartRequired))
+				},
+					Entry("not when the supplemental pool thread count grows", false, func(vmiSpec *v1.VirtualMachineInstanceSpec) {
+						withSupplementalPool(vmiSpec, 4)
+					}, false),
+					Entry("when the supplemental pool thread count shrinks", false, func(vmiSpec *v1.VirtualMachineInstanceSpec) {
+						withSupplementalPool(vmiSpec, 1)
+					}, true),
+					Entry("when the iothreads policy changes", false, func(vmiSpec *v1.VirtualMachineInstanceSpec) {
+						vmiSpec.Domain.IOThreadsPolicy = pointer.P(v1.IOThreadsPolicyAuto)
+						vmiSpec.Domain.IOThreads = nil
+					}, true),
+					Entry("when the supplemental pool of a VM with dedicated CPUs changes", true, func(vmiSpec *v1.VirtualMachineInstanceSpec) {
+						withSupplementalPool(vmiSpec, 4)
+					}, true),
+				)
+
+				DescribeTable("should tell whether the iothreads are live-updatable", func(policy *v1.IOThreadsPolicy, cpu *v1.CPU, expected bool) {
+					vmiSpec := &v1.VirtualMachineInstanceSpec{}
+					vmiSpec.Domain.IOThreadsPolicy = policy
+					vmiSpec.Domain.CPU = cpu
+					Expect(hasLiveUpdatableIOThreads(vmiSpec)).To(Equal(expected))
+				},
+					Entry("with the supplementalPool policy", pointer.P(v1.IOThreadsPolicySupplementalPool), nil, true),
+					Entry("with the supplementalPool policy and dedicated CPUs",
+						pointer.P(v1.IOThreadsPolicySupplementalPool), &v1.CPU{DedicatedCPUPlacement: true}, false),
+					Entry("with the auto policy", pointer.P(v1.IOThreadsPolicyAuto), nil, false),
+					Entry("without policy", nil, nil, false),
+				)
+			})
+
+			Context("USB devices", func() {
+				usbHostDevice := v1.HostDevice{Name: "usb1", DeviceName: "kubevirt.io/usb-storage"}
+				gpuHostDevice := v1.HostDevice{Name: "gpu1", DeviceName: "nvidia.com/GP102GL_Tesla_P40"}
+
+				BeforeEach(func() {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+								PermittedHostDevices: &v1.PermittedHostDevices{
+									USB: []v1.USBHostDevice{{ResourceName: usbHostDevice.DeviceName}},
+								},
+							},
+						},
+					})
+				})
+
+				It("should live-update the USB host devices and client passthrough", func() {
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Devices.HostDevices = []v1.HostDevice{gpuHostDevice, usbHostDevice}
+					vm.Spec.Template.Spec.Domain.Devices.ClientPassthrough = &v1.ClientPassthroughDevices{}
+					vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{gpuHostDevice}
+
+					vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+					Expect(err).To(Succeed())
+
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+
+					addVirtualMachine(vm)
+
+					sanityExecute(vm)
+
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(HaveLen(1))
+
+					By("Expecting to see the updated VMI with the new USB devices")
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vmi.Spec.Domain.Devices.HostDevices).To(Equal([]v1.HostDevice{gpuHostDevice, usbHostDevice}))
+					Expect(vmi.Spec.Domain.Devices.ClientPassthrough).ToNot(BeNil())
+				})
+
+				It("should not live-update host devices other than USB", func() {
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Devices.HostDevices = []v1.HostDevice{gpuHostDevice, usbHostDevice}
+
+					Expect(controller.handleUSBDevicesChangeRequest(vm, vmi)).To(Succeed())
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(BeEmpty())
+				})
+
+				DescribeTable("should require a restart", func(changeDevices func(devices *v1.Devices), expectedRestartRequired bool) {
+					lastSeenVM, vmi := watchtesting.DefaultVirtualMachine(true)
+					lastSeenVM.Spec.Template.Spec.Domain.Devices.HostDevices = []v1.HostDevice{gpuHostDevice}
+					vm := lastSeenVM.DeepCopy()
+					changeDevices(&vm.Spec.Template.Spec.Domain.Devices)
+
+					Expect(controller.addRestartRequiredIfNeeded(&lastSeenVM.Spec, vm, vmi)).To(Equal(expectedRestartRequired))
+				},
+					Entry("not when a USB host device is added", func(devices *v1.Devices) {
+						devices.HostDevices = append(devices.HostDevices, usbHostDevice)
+					}, false),
+					Entry("not when client passthrough is enabled", func(devices *v1.Devices) {
+						devices.ClientPassthrough = &v1.ClientPassthroughDevices{}
+					}, false),
+					Entry("when a host device other than USB is removed", func(devices *v1.Devices) {
+						devices.HostDevices = nil
+					}, true),
+				)
+			})
+
 			Context("Affinity", func() {
 				It("should be live-updated", func() {
 					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
@@ -36,6 +36,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/controller"
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/storage/vhostuserblk"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
 )
 
@@ -258,6 +259,11 @@ func (c *Controller) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virt
 				ClaimName: volume.Name,
 			}
 		}
+		if volume.VhostUserBlk != nil && status.VhostUserBlkVolume == nil {
+			status.VhostUserBlkVolume = &virtv1.VhostUserBlkVolumeInfo{
+				SocketPath: vhostuserblk.GetMountedSocketPath(volume.Name, volume.VhostUserBlk.Path),
+			}
+		}
 		pvcName := storagetypes.PVCNameFromVirtVolume(&volume)
 
 		if _, ok := hotplugVolumesMap[volume.Name]; ok {
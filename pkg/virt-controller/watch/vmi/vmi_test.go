@@ -3208,6 +3208,23 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 				[]int{0},
 				makeVolumeStatusesForUpdateWithMemoryDump(0, 0),
 				[]string{}),
+			Entry("should update volume status with the socket path, if a new vhost-user-blk volume is added",
+				makeVolumeStatusesForUpdate(),
+				[]*virtv1.Volume{{
+					Name: "volume0",
+					VolumeSource: virtv1.VolumeSource{
+						VhostUserBlk: &virtv1.VhostUserBlkVolumeSource{Path: "/var/tmp/spdk/vhost.0"},
+					},
+				}},
+				[]int{},
+				[]int{},
+				[]virtv1.VolumeStatus{{
+					Name: "volume0",
+					VhostUserBlkVolume: &virtv1.VhostUserBlkVolumeInfo{
+						SocketPath: "/var/run/kubevirt-private/vhost-user-blk/volume0/vhost.0",
+					},
+				}},
+				[]string{}),
 		)
 
 		DescribeTable("Should properly calculate if it needs to handle hotplug volumes", func(hotplugVolumes []*virtv1.Volume, attachmentPods []*k8sv1.Pod, match gomegaTypes.GomegaMatcher) {
@@ -148,6 +148,7 @@ func generateDeviceRulesForVMI(vmi *v1.VirtualMachineInstance, isolationRes isol
 				continue
 			}
 		case volume.VolumeSource.Ephemeral != nil:
+		case volume.VolumeSource.HostBlockDevice != nil:
 		default:
 			continue
 		}
@@ -78,7 +78,7 @@ type MigrationOptions struct {
 }
 
 type LauncherClient interface {
-	SyncVirtualMachine(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
+	SyncVirtualMachine(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) (bool, error)
 	PauseVirtualMachine(vmi *v1.VirtualMachineInstance) error
 	UnpauseVirtualMachine(vmi *v1.VirtualMachineInstance) error
 	FreezeVirtualMachine(vmi *v1.VirtualMachineInstance, unfreezeTimeoutSeconds int32) error
@@ -113,6 +113,7 @@ type LauncherClient interface {
 	GetDomainDirtyRateStats() (dirtyRateMbps int64, err error)
 	GetScreenshot(*v1.VirtualMachineInstance) (*cmdv1.ScreenshotResponse, error)
 	VirtualMachineBackup(vmi *v1.VirtualMachineInstance, options *backupv1.BackupOptions) error
+	ChangeMedia(vmi *v1.VirtualMachineInstance, options *v1.ChangeMediaOptions) error
 }
 
 type VirtLauncherClient struct {
@@ -306,8 +307,29 @@ func (c *VirtLauncherClient) genericSendVMICmd(cmdName string,
 	return err
 }
 
-func (c *VirtLauncherClient) SyncVirtualMachine(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error {
-	return c.genericSendVMICmd("SyncVMI", c.v1client.SyncVirtualMachine, vmi, options)
+// SyncVirtualMachine reports whether virt-launcher deferred part of the sync, e.g. the attachment
+// of a disk which is not ready yet
+func (c *VirtLauncherClient) SyncVirtualMachine(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) (bool, error) {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return false, err
+	}
+
+	request := &cmdv1.VMIRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Options: options,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+	response, err := c.v1client.SyncVirtualMachine(ctx, request)
+
+	if err = handleError(err, "SyncVMI", response); err != nil {
+		return false, err
+	}
+	return response.GetIncomplete(), nil
 }
 
 func (c *VirtLauncherClient) PauseVirtualMachine(vmi *v1.VirtualMachineInstance) error {
@@ -801,3 +823,28 @@ func (c *VirtLauncherClient) VirtualMachineBackup(vmi *v1.VirtualMachineInstance
 	err = handleError(err, "Backup", response)
 	return err
 }
+
+func (c *VirtLauncherClient) ChangeMedia(vmi *v1.VirtualMachineInstance, options *v1.ChangeMediaOptions) error {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+
+	optionsJson, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+
+	request := &cmdv1.ChangeMediaRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Options: optionsJson,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+	defer cancel()
+	response, err := c.v1client.ChangeMedia(ctx, request)
+
+	return handleError(err, "ChangeMedia", response)
+}
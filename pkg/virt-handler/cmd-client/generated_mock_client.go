@@ -59,6 +59,20 @@ func (mr *MockLauncherClientMockRecorder) CancelVirtualMachineMigration(vmi any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelVirtualMachineMigration", reflect.TypeOf((*MockLauncherClient)(nil).CancelVirtualMachineMigration), vmi)
 }
 
+// ChangeMedia mocks base method.
+func (m *MockLauncherClient) ChangeMedia(vmi *v1.VirtualMachineInstance, options *v1.ChangeMediaOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeMedia", vmi, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeMedia indicates an expected call of ChangeMedia.
+func (mr *MockLauncherClientMockRecorder) ChangeMedia(vmi, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeMedia", reflect.TypeOf((*MockLauncherClient)(nil).ChangeMedia), vmi, options)
+}
+
 // Close mocks base method.
 func (m *MockLauncherClient) Close() {
 	m.ctrl.T.Helper()
@@ -450,11 +464,12 @@ func (mr *MockLauncherClientMockRecorder) SyncMigrationTarget(vmi, options any)
 }
 
 // SyncVirtualMachine mocks base method.
-func (m *MockLauncherClient) SyncVirtualMachine(vmi *v1.VirtualMachineInstance, options *v10.VirtualMachineOptions) error {
+func (m *MockLauncherClient) SyncVirtualMachine(vmi *v1.VirtualMachineInstance, options *v10.VirtualMachineOptions) (bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SyncVirtualMachine", vmi, options)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // SyncVirtualMachine indicates an expected call of SyncVirtualMachine.
@@ -64,6 +64,8 @@ const (
 	VolumeMountedToPodReason = "VolumeMountedToPod"
 	//VolumeUnplugged is the reason set when the volume is completely unplugged from the VMI
 	VolumeUnplugged = "VolumeUnplugged"
+	//VolumeIOError is the reason set when IO errors are reported on a volume
+	VolumeIOError = "VolumeIOError"
 	//VMIDefined is the reason set when a VMI is defined
 	VMIDefined = "VirtualMachineInstance defined."
 	//VMIStarted is the reason set when a VMI is started
@@ -72,6 +74,10 @@ const (
 	VMIShutdown = "The VirtualMachineInstance was shut down."
 	//VMICrashed is the reason set when a VMI crashed
 	VMICrashed = "The VirtualMachineInstance crashed."
+	//VMIGuestPanicked is the message set when a panic device of a VMI reported a guest kernel panic
+	VMIGuestPanicked = "The guest kernel panicked."
+	//VMIGuestWatchdogFired is the message set when the watchdog device of a VMI expired
+	VMIGuestWatchdogFired = "The watchdog device of the guest expired."
 	//VMIAbortingMigration is the reason set when migration is being aborted
 	VMIAbortingMigration = "VirtualMachineInstance is aborting migration."
 	//VMIMigrating in the reason set when the VMI is migrating
@@ -271,6 +277,12 @@ func (c *BaseController) setupDevicesOwnerships(vmi *v1.VirtualMachineInstance,
 		}
 	}
 
+	if vmi.Spec.Domain.Devices.SGX != nil {
+		if err := c.claimDeviceOwnership(virtLauncherRootMount, "sgx_vepc"); err != nil {
+			return fmt.Errorf("failed to set up file ownership for /dev/sgx_vepc: %v", err)
+		}
+	}
+
 	if err := c.configureHostDisks(vmi, virtLauncherRootMount, recorder); err != nil {
 		return err
 	}
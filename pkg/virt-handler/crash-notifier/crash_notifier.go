@@ -0,0 +1,117 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package crash_notifier
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const postTimeout = 10 * time.Second
+
+// Notification is the JSON document posted to the guest crash notifications sink
+type Notification struct {
+	Reason    string      `json:"reason"`
+	Message   string      `json:"message"`
+	Timestamp metav1.Time `json:"timestamp"`
+	VMI       VMIMetadata `json:"vmi"`
+}
+
+// VMIMetadata identifies the VMI whose guest crashed
+type VMIMetadata struct {
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace"`
+	UID       types.UID         `json:"uid"`
+	NodeName  string            `json:"nodeName,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+// Notifier posts guest crash notifications to the webhook sink of the cluster config
+type Notifier struct {
+	client *http.Client
+	sink   func() *v1.GuestCrashNotificationsConfiguration
+}
+
+// NewNotifier returns a notifier posting to the sink returned by the given getter.
+// The sink is looked up on every notification, so it follows the cluster config.
+func NewNotifier(sink func() *v1.GuestCrashNotificationsConfiguration) *Notifier {
+	return &Notifier{
+		client: &http.Client{Timeout: postTimeout},
+		sink:   sink,
+	}
+}
+
+// Notify posts the notification in the background if a sink is configured.
+// Delivery is best effort, a failure is only logged.
+func (n *Notifier) Notify(vmi *v1.VirtualMachineInstance, reason, message string) {
+	sink := n.sink()
+	if sink == nil || sink.URL == "" {
+		return
+	}
+
+	notification := newNotification(vmi, reason, message)
+	go func() {
+		if err := n.post(sink.URL, notification); err != nil {
+			log.Log.Object(vmi).Reason(err).Warningf("Failed to deliver the guest crash notification to %s", sink.URL)
+		}
+	}()
+}
+
+func newNotification(vmi *v1.VirtualMachineInstance, reason, message string) *Notification {
+	return &Notification{
+		Reason:    reason,
+		Message:   message,
+		Timestamp: metav1.Now(),
+		VMI: VMIMetadata{
+			Name:      vmi.Name,
+			Namespace: vmi.Namespace,
+			UID:       vmi.UID,
+			NodeName:  vmi.Status.NodeName,
+			Labels:    vmi.Labels,
+		},
+	}
+}
+
+func (n *Notifier) post(url string, notification *Notification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	resp, err := n.client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package crash_notifier_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	crashnotifier "kubevirt.io/kubevirt/pkg/virt-handler/crash-notifier"
+)
+
+var _ = Describe("Guest crash notifier", func() {
+	var (
+		server        *httptest.Server
+		notifications chan crashnotifier.Notification
+		vmi           *v1.VirtualMachineInstance
+	)
+
+	BeforeEach(func() {
+		notifications = make(chan crashnotifier.Notification, 1)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.Method).To(Equal(http.MethodPost))
+			Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+
+			var notification crashnotifier.Notification
+			Expect(json.NewDecoder(r.Body).Decode(&notification)).To(Succeed())
+			notifications <- notification
+		}))
+		DeferCleanup(server.Close)
+
+		vmi = &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "testvmi",
+				Namespace: "default",
+				UID:       "1234",
+				Labels:    map[string]string{"app": "test"},
+			},
+			Status: v1.VirtualMachineInstanceStatus{NodeName: "node01"},
+		}
+	})
+
+	It("should post the notification to the configured sink", func() {
+		notifier := crashnotifier.NewNotifier(func() *v1.GuestCrashNotificationsConfiguration {
+			return &v1.GuestCrashNotificationsConfiguration{URL: server.URL}
+		})
+		notifier.Notify(vmi, v1.VirtualMachineInstanceReasonGuestPanic, "The guest kernel panicked.")
+
+		var notification crashnotifier.Notification
+		Eventually(notifications).Should(Receive(&notification))
+		Expect(notification.Reason).To(Equal(v1.VirtualMachineInstanceReasonGuestPanic))
+		Expect(notification.Message).To(Equal("The guest kernel panicked."))
+		Expect(notification.Timestamp.IsZero()).To(BeFalse())
+		Expect(notification.VMI).To(Equal(crashnotifier.VMIMetadata{
+			Name:      "testvmi",
+			Namespace: "default",
+			UID:       "1234",
+			NodeName:  "node01",
+			Labels:    map[string]string{"app": "test"},
+		}))
+	})
+
+	It("should not post anything without a sink", func() {
+		notifier := crashnotifier.NewNotifier(func() *v1.GuestCrashNotificationsConfiguration {
+			return nil
+		})
+		notifier.Notify(vmi, v1.VirtualMachineInstanceReasonGuestWatchdog, "The watchdog device of the guest expired.")
+		Consistently(notifications).ShouldNot(Receive())
+	})
+
+	It("should follow the sink of the cluster config", func() {
+		var sink *v1.GuestCrashNotificationsConfiguration
+		notifier := crashnotifier.NewNotifier(func() *v1.GuestCrashNotificationsConfiguration {
+			return sink
+		})
+		notifier.Notify(vmi, v1.VirtualMachineInstanceReasonGuestPanic, "The guest kernel panicked.")
+		Consistently(notifications).ShouldNot(Receive())
+
+		sink = &v1.GuestCrashNotificationsConfiguration{URL: server.URL}
+		notifier.Notify(vmi, v1.VirtualMachineInstanceReasonGuestPanic, "The guest kernel panicked.")
+		Eventually(notifications).Should(Receive())
+	})
+})
@@ -0,0 +1,223 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"fmt"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	guestAgentChannelName = "org.qemu.guest_agent.0"
+
+	// maxGuestClockDriftSeconds is the drift of the guest clock tolerated before the VMI is degraded,
+	// it leaves room for the time synchronization of the guest to catch up after a pause or a migration
+	maxGuestClockDriftSeconds = 2
+)
+
+// degradation is a reason why a running VMI does not work as expected
+type degradation struct {
+	reason  string
+	message string
+}
+
+// degradationCheck returns the degradations of the VMI, based on what libvirt reported on the domain
+type degradationCheck func(vmi *v1.VirtualMachineInstance, domain *api.Domain) []degradation
+
+// degradationChecks are evaluated in order, the reason of the Degraded condition is
+// the one of the first degradation found.
+var degradationChecks = []degradationCheck{
+	checkIOError,
+	checkImageCorrupted,
+	checkVhostUserDisconnected,
+	checkAgentDisconnected,
+	checkInterfaceLinkDown,
+	checkBalloonDriverMissing,
+	checkClockDrift,
+}
+
+func checkIOError(_ *v1.VirtualMachineInstance, domain *api.Domain) []degradation {
+	if domain.Status.Status != api.Paused || domain.Status.Reason != api.ReasonPausedIOError {
+		return nil
+	}
+	return []degradation{{
+		reason:  v1.VirtualMachineInstanceReasonIOError,
+		message: "the VMI is paused because of an IO error",
+	}}
+}
+
+// checkImageCorrupted reports the corruptions found by the integrity check of virt-launcher
+func checkImageCorrupted(_ *v1.VirtualMachineInstance, domain *api.Domain) []degradation {
+	imageIntegrity := domain.Spec.Metadata.KubeVirt.ImageIntegrity
+	if imageIntegrity == nil || !imageIntegrity.Corrupted {
+		return nil
+	}
+	return []degradation{{
+		reason:  v1.VirtualMachineInstanceReasonImageCorrupted,
+		message: imageIntegrity.Message,
+	}}
+}
+
+// checkVhostUserDisconnected reports the vhost-user interfaces whose backend virt-launcher found down
+func checkVhostUserDisconnected(_ *v1.VirtualMachineInstance, domain *api.Domain) []degradation {
+	vhostUser := domain.Spec.Metadata.KubeVirt.VhostUser
+	if vhostUser == nil || !vhostUser.Disconnected {
+		return nil
+	}
+	return []degradation{{
+		reason:  v1.VirtualMachineInstanceReasonVhostUserDisconnected,
+		message: vhostUser.Message,
+	}}
+}
+
+// checkAgentDisconnected reports a guest agent which reported the guest OS before, but is
+// not connected anymore. Guests which never ran the agent are not degraded.
+func checkAgentDisconnected(vmi *v1.VirtualMachineInstance, domain *api.Domain) []degradation {
+	if vmi.Status.GuestOSInfo.Name == "" || domain.Status.Status != api.Running {
+		return nil
+	}
+	for _, channel := range domain.Spec.Devices.Channels {
+		if channel.Target != nil && channel.Target.Name == guestAgentChannelName && channel.Target.State != "connected" {
+			return []degradation{{
+				reason:  v1.VirtualMachineInstanceReasonAgentDisconnected,
+				message: "the guest agent disconnected",
+			}}
+		}
+	}
+	return nil
+}
+
+// checkInterfaceLinkDown reports the interfaces whose link is down, while it is not requested
+// to be down.
+func checkInterfaceLinkDown(vmi *v1.VirtualMachineInstance, _ *api.Domain) []degradation {
+	desiredDown := map[string]bool{}
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		desiredDown[iface.Name] = iface.State == v1.InterfaceStateLinkDown
+	}
+
+	var degradations []degradation
+	for _, iface := range vmi.Status.Interfaces {
+		if iface.LinkState != string(v1.InterfaceStateLinkDown) || desiredDown[iface.Name] {
+			continue
+		}
+		degradations = append(degradations, degradation{
+			reason:  v1.VirtualMachineInstanceReasonInterfaceLinkDown,
+			message: fmt.Sprintf("the link of interface %s is down", iface.Name),
+		})
+	}
+	return degradations
+}
+
+// checkBalloonDriverMissing reports a guest which has a balloon device but no driver for it, so
+// that memory statistics and free page reporting are not functional. Only the guest agent of
+// Windows guests reports the drivers.
+func checkBalloonDriverMissing(_ *v1.VirtualMachineInstance, domain *api.Domain) []degradation {
+	guestDrivers := domain.Status.GuestDrivers
+	balloon := domain.Spec.Devices.Ballooning
+	if guestDrivers == nil || guestDrivers.Balloon || balloon == nil || balloon.Model == "none" {
+		return nil
+	}
+	return []degradation{{
+		reason:  v1.VirtualMachineInstanceReasonGuestDriverMissing,
+		message: "the guest has no driver for the virtio balloon device",
+	}}
+}
+
+// checkClockDrift reports a guest clock which drifted away from the host clock, as measured by
+// virt-launcher through the guest agent. It usually means that kvm-clock or the Hyper-V
+// enlightenments are not functional in the guest.
+func checkClockDrift(_ *v1.VirtualMachineInstance, domain *api.Domain) []degradation {
+	clockDrift := domain.Status.ClockDrift
+	if clockDrift == nil || domain.Status.Status != api.Running {
+		return nil
+	}
+
+	drift, direction := clockDrift.Seconds, "ahead of"
+	if drift < 0 {
+		drift, direction = -drift, "behind"
+	}
+	if drift <= maxGuestClockDriftSeconds {
+		return nil
+	}
+	return []degradation{{
+		reason:  v1.VirtualMachineInstanceReasonClockDrift,
+		message: fmt.Sprintf("the guest clock is %ds %s the host clock", drift, direction),
+	}}
+}
+
+// calculateDegradedCondition returns the Degraded condition of the VMI, or nil if it is not degraded
+func calculateDegradedCondition(vmi *v1.VirtualMachineInstance, domain *api.Domain, checks []degradationCheck) *v1.VirtualMachineInstanceCondition {
+	var degradations []degradation
+	for _, check := range checks {
+		degradations = append(degradations, check(vmi, domain)...)
+	}
+	if len(degradations) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(degradations))
+	for _, d := range degradations {
+		messages = append(messages, fmt.Sprintf("%s: %s", d.reason, d.message))
+	}
+	return &v1.VirtualMachineInstanceCondition{
+		Type:    v1.VirtualMachineInstanceDegraded,
+		Status:  k8sv1.ConditionTrue,
+		Reason:  degradations[0].reason,
+		Message: strings.Join(messages, "; "),
+	}
+}
+
+func (c *VirtualMachineController) updateDegradedCondition(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	var condition *v1.VirtualMachineInstanceCondition
+	if domain != nil && vmi.IsRunning() {
+		condition = calculateDegradedCondition(vmi, domain, degradationChecks)
+	}
+
+	current := condManager.GetCondition(vmi, v1.VirtualMachineInstanceDegraded)
+	if condition == nil {
+		if current != nil {
+			c.logger.Object(vmi).V(3).Info("Removing degraded condition")
+			condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceDegraded)
+		}
+		return
+	}
+	if current != nil && current.Reason == condition.Reason && current.Message == condition.Message {
+		return
+	}
+
+	now := metav1.Now()
+	condition.LastProbeTime = now
+	condition.LastTransitionTime = now
+	if current != nil {
+		condition.LastTransitionTime = current.LastTransitionTime
+	} else {
+		c.recorder.Event(vmi, k8sv1.EventTypeWarning, condition.Reason, condition.Message)
+	}
+	condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceDegraded)
+	vmi.Status.Conditions = append(vmi.Status.Conditions, *condition)
+}
@@ -0,0 +1,202 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("Degraded condition", func() {
+	newDomain := func(status api.LifeCycle, reason api.StateChangeReason, agentState string) *api.Domain {
+		domain := api.NewMinimalDomain("testvmi")
+		domain.Status.Status = status
+		domain.Status.Reason = reason
+		if agentState != "" {
+			domain.Spec.Devices.Channels = []api.Channel{{
+				Type:   "unix",
+				Target: &api.ChannelTarget{Name: guestAgentChannelName, Type: "virtio", State: agentState},
+			}}
+		}
+		return domain
+	}
+
+	withImageIntegrity := func(domain *api.Domain, corrupted bool, message string) *api.Domain {
+		domain.Spec.Metadata.KubeVirt.ImageIntegrity = &api.ImageIntegrityMetadata{Corrupted: corrupted, Message: message}
+		return domain
+	}
+
+	withVhostUser := func(domain *api.Domain, disconnected bool, message string) *api.Domain {
+		domain.Spec.Metadata.KubeVirt.VhostUser = &api.VhostUserMetadata{Disconnected: disconnected, Message: message}
+		return domain
+	}
+
+	withBalloon := func(domain *api.Domain, guestDrivers *api.GuestDrivers) *api.Domain {
+		domain.Spec.Devices.Ballooning = &api.MemBalloon{Model: v1.VirtIO}
+		domain.Status.GuestDrivers = guestDrivers
+		return domain
+	}
+
+	withClockDrift := func(domain *api.Domain, seconds int64) *api.Domain {
+		domain.Status.ClockDrift = &api.GuestClockDrift{Seconds: seconds}
+		return domain
+	}
+
+	newVMI := func(agentReported bool, linkStates map[string]string, desiredDown ...string) *v1.VirtualMachineInstance {
+		vmi := libvmi.New(libvmi.WithName("testvmi"))
+		vmi.Status.Phase = v1.Running
+		if agentReported {
+			vmi.Status.GuestOSInfo.Name = "Fedora"
+		}
+		for name, state := range linkStates {
+			iface := v1.Interface{Name: name}
+			for _, down := range desiredDown {
+				if down == name {
+					iface.State = v1.InterfaceStateLinkDown
+				}
+			}
+			vmi.Spec.Domain.Devices.Interfaces = append(vmi.Spec.Domain.Devices.Interfaces, iface)
+			vmi.Status.Interfaces = append(vmi.Status.Interfaces, v1.VirtualMachineInstanceNetworkInterface{Name: name, LinkState: state})
+		}
+		return vmi
+	}
+
+	DescribeTable("should be calculated", func(vmi *v1.VirtualMachineInstance, domain *api.Domain, expectedReason, expectedMessage string) {
+		condition := calculateDegradedCondition(vmi, domain, degradationChecks)
+		if expectedReason == "" {
+			Expect(condition).To(BeNil())
+			return
+		}
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Type).To(Equal(v1.VirtualMachineInstanceDegraded))
+		Expect(condition.Status).To(Equal(k8sv1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(expectedReason))
+		Expect(condition.Message).To(Equal(expectedMessage))
+	},
+		Entry("healthy VMI",
+			newVMI(true, map[string]string{"default": "up"}), newDomain(api.Running, api.ReasonUnknown, "connected"), "", ""),
+		Entry("guest without agent",
+			newVMI(false, nil), newDomain(api.Running, api.ReasonUnknown, "disconnected"), "", ""),
+		Entry("paused on IO error",
+			newVMI(false, nil), newDomain(api.Paused, api.ReasonPausedIOError, ""),
+			v1.VirtualMachineInstanceReasonIOError, "IOError: the VMI is paused because of an IO error"),
+		Entry("paused by the user",
+			newVMI(false, nil), newDomain(api.Paused, api.ReasonPausedUser, ""), "", ""),
+		Entry("corrupted image",
+			newVMI(false, nil), withImageIntegrity(newDomain(api.Running, api.ReasonUnknown, ""), true, "image of volume disk0 has 1 corruptions"),
+			v1.VirtualMachineInstanceReasonImageCorrupted, "ImageCorrupted: image of volume disk0 has 1 corruptions"),
+		Entry("image checked without corruptions",
+			newVMI(false, nil), withImageIntegrity(newDomain(api.Running, api.ReasonUnknown, ""), false, ""), "", ""),
+		Entry("disconnected vhost-user backend",
+			newVMI(false, nil), withVhostUser(newDomain(api.Running, api.ReasonUnknown, ""), true, "the vhost-user backend is not serving socket /sock of interface net1"),
+			v1.VirtualMachineInstanceReasonVhostUserDisconnected, "VhostUserBackendDisconnected: the vhost-user backend is not serving socket /sock of interface net1"),
+		Entry("connected vhost-user backend",
+			newVMI(false, nil), withVhostUser(newDomain(api.Running, api.ReasonUnknown, ""), false, ""), "", ""),
+		Entry("missing balloon driver",
+			newVMI(true, nil), withBalloon(newDomain(api.Running, api.ReasonUnknown, "connected"), &api.GuestDrivers{}),
+			v1.VirtualMachineInstanceReasonGuestDriverMissing, "GuestDriverMissing: the guest has no driver for the virtio balloon device"),
+		Entry("balloon driver",
+			newVMI(true, nil), withBalloon(newDomain(api.Running, api.ReasonUnknown, "connected"), &api.GuestDrivers{Balloon: true}), "", ""),
+		Entry("guest drivers not reported",
+			newVMI(true, nil), withBalloon(newDomain(api.Running, api.ReasonUnknown, "connected"), nil), "", ""),
+		Entry("guest clock ahead of the host",
+			newVMI(true, nil), withClockDrift(newDomain(api.Running, api.ReasonUnknown, "connected"), 30),
+			v1.VirtualMachineInstanceReasonClockDrift, "GuestClockDrift: the guest clock is 30s ahead of the host clock"),
+		Entry("guest clock behind the host",
+			newVMI(true, nil), withClockDrift(newDomain(api.Running, api.ReasonUnknown, "connected"), -5),
+			v1.VirtualMachineInstanceReasonClockDrift, "GuestClockDrift: the guest clock is 5s behind the host clock"),
+		Entry("tolerated guest clock drift",
+			newVMI(true, nil), withClockDrift(newDomain(api.Running, api.ReasonUnknown, "connected"), -2), "", ""),
+		Entry("disconnected agent",
+			newVMI(true, nil), newDomain(api.Running, api.ReasonUnknown, "disconnected"),
+			v1.VirtualMachineInstanceReasonAgentDisconnected, "AgentDisconnected: the guest agent disconnected"),
+		Entry("link down",
+			newVMI(false, map[string]string{"default": "down"}), newDomain(api.Running, api.ReasonUnknown, ""),
+			v1.VirtualMachineInstanceReasonInterfaceLinkDown, "InterfaceLinkDown: the link of interface default is down"),
+		Entry("link requested to be down",
+			newVMI(false, map[string]string{"default": "down"}, "default"), newDomain(api.Running, api.ReasonUnknown, ""), "", ""),
+		Entry("several degradations",
+			newVMI(true, map[string]string{"default": "down"}), newDomain(api.Paused, api.ReasonPausedIOError, "disconnected"),
+			v1.VirtualMachineInstanceReasonIOError,
+			"IOError: the VMI is paused because of an IO error; InterfaceLinkDown: the link of interface default is down"),
+	)
+
+	Context("update", func() {
+		var (
+			c           *VirtualMachineController
+			recorder    *record.FakeRecorder
+			condManager *controller.VirtualMachineInstanceConditionManager
+		)
+
+		BeforeEach(func() {
+			recorder = record.NewFakeRecorder(10)
+			recorder.IncludeObject = false
+			c = &VirtualMachineController{BaseController: &BaseController{
+				logger:   log.Log.With("controller", "vm"),
+				recorder: recorder,
+			}}
+			condManager = controller.NewVirtualMachineInstanceConditionManager()
+		})
+
+		It("should add, update and remove the condition", func() {
+			vmi := newVMI(true, map[string]string{"default": "up"})
+			domain := newDomain(api.Paused, api.ReasonPausedIOError, "connected")
+
+			c.updateDegradedCondition(vmi, domain, condManager)
+			condition := condManager.GetCondition(vmi, v1.VirtualMachineInstanceDegraded)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonIOError))
+			Expect(recorder.Events).To(Receive(Equal("Warning IOError IOError: the VMI is paused because of an IO error")))
+			transitionTime := condition.LastTransitionTime
+
+			domain = newDomain(api.Running, api.ReasonUnknown, "disconnected")
+			c.updateDegradedCondition(vmi, domain, condManager)
+			condition = condManager.GetCondition(vmi, v1.VirtualMachineInstanceDegraded)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonAgentDisconnected))
+			Expect(condition.LastTransitionTime).To(Equal(transitionTime))
+			Expect(recorder.Events).ToNot(Receive())
+
+			domain = newDomain(api.Running, api.ReasonUnknown, "connected")
+			c.updateDegradedCondition(vmi, domain, condManager)
+			Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceDegraded)).To(BeFalse())
+		})
+
+		It("should remove the condition once the VMI is not running", func() {
+			vmi := newVMI(false, nil)
+			c.updateDegradedCondition(vmi, newDomain(api.Paused, api.ReasonPausedIOError, ""), condManager)
+			Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceDegraded)).To(BeTrue())
+
+			vmi.Status.Phase = v1.Succeeded
+			c.updateDegradedCondition(vmi, newDomain(api.Shutoff, api.ReasonShutdown, ""), condManager)
+			Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceDegraded)).To(BeFalse())
+		})
+	})
+})
@@ -24,7 +24,10 @@ package device_manager
 import (
 	"bufio"
 	"bytes"
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"io"
 	"net"
 	"os"
 	"os/exec"
@@ -47,6 +50,8 @@ type DeviceHandler interface {
 	GetDeviceDriver(basepath string, pciAddress string) (string, error)
 	GetDeviceNumaNode(basepath string, pciAddress string) (numaNode int)
 	GetDevicePCIID(basepath string, pciAddress string) (string, error)
+	GetDeviceEnableCount(basepath string, pciAddress string) (int, error)
+	VerifyDeviceReset(basepath string, pciAddress string) error
 	GetMdevParentPCIAddr(mdevUUID string) (string, error)
 	CreateMDEVType(mdevType string, parentID string) error
 	RemoveMDEVType(mdevUUID string) error
@@ -120,6 +125,58 @@ func (h *DeviceUtilsHandler) GetDevicePCIID(basepath string, pciAddress string)
 	return "", fmt.Errorf("no pci_id is found")
 }
 
+// GetDeviceEnableCount gets how many users enabled the device, vfio-pci keeps
+// the device enabled while a VM uses it
+func (h *DeviceUtilsHandler) GetDeviceEnableCount(basepath string, pciAddress string) (int, error) {
+	// #nosec No risk for path injection. Reading static path of PCI data
+	enableCount, err := os.ReadFile(filepath.Join(basepath, pciAddress, "enable"))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(string(bytes.TrimSpace(enableCount)))
+}
+
+// VerifyDeviceReset resets the device with the reset methods it supports (FLR, bus reset...) and
+// verifies that it still responds afterwards. A wedged device reads all ones from its config space.
+func (h *DeviceUtilsHandler) VerifyDeviceReset(basepath string, pciAddress string) error {
+	// The reset attribute is only present when the device supports a reset method
+	resetPath := filepath.Join(basepath, pciAddress, "reset")
+	if _, err := os.Stat(resetPath); err == nil {
+		if err := writeSysfsAttribute(resetPath, "1"); err != nil {
+			return fmt.Errorf("failed to reset the device: %v", err)
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	// #nosec No risk for path injection. Reading static path of PCI data
+	config, err := os.Open(filepath.Join(basepath, pciAddress, "config"))
+	if err != nil {
+		return err
+	}
+	defer config.Close()
+
+	vendorID := make([]byte, 2)
+	if _, err := io.ReadFull(config, vendorID); err != nil {
+		return fmt.Errorf("failed to read the config space of the device: %v", err)
+	}
+	if binary.LittleEndian.Uint16(vendorID) == 0xffff {
+		return fmt.Errorf("the device does not respond after the reset")
+	}
+	return nil
+}
+
+func writeSysfsAttribute(path, value string) error {
+	// #nosec No risk for path injection. Writing static path of PCI data
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.WriteString(value)
+	return err
+}
+
 // /sys/class/mdev_bus/0000:00:03.0/53764d0e-85a0-42b4-af5c-2046b460b1dc
 func (h *DeviceUtilsHandler) GetMdevParentPCIAddr(mdevUUID string) (string, error) {
 	mdevLink, err := os.Readlink(filepath.Join(mdevBasePath, mdevUUID))
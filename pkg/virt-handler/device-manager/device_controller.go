@@ -23,27 +23,113 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"time"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
+	"kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-handler/selinux"
 )
 
 var defaultBackoffTime = []time.Duration{1 * time.Second, 2 * time.Second, 5 * time.Second, 10 * time.Second}
 
+const (
+	// maxParallelPluginStarts bounds the number of device plugins which are
+	// concurrently serving their socket and registering with the kubelet
+	maxParallelPluginStarts = 8
+	// initializedPollInterval is how often a starting device plugin is checked
+	// for completing its registration, to hand its start slot to the next one
+	initializedPollInterval = 50 * time.Millisecond
+)
+
+type DevicePluginPhase string
+
+const (
+	// DevicePluginPending means the plugin waits for a start slot
+	DevicePluginPending DevicePluginPhase = "Pending"
+	// DevicePluginStarting means the plugin is serving its socket and registering with the kubelet
+	DevicePluginStarting DevicePluginPhase = "Starting"
+	// DevicePluginRunning means the plugin is registered with the kubelet
+	DevicePluginRunning DevicePluginPhase = "Running"
+	// DevicePluginBackoff means the plugin returned and waits to be started again
+	DevicePluginBackoff DevicePluginPhase = "Backoff"
+	// DevicePluginStopped means the plugin was stopped by the controller
+	DevicePluginStopped DevicePluginPhase = "Stopped"
+)
+
+// DevicePluginStatus is the last observed state of a controlled device plugin.
+type DevicePluginStatus struct {
+	Phase DevicePluginPhase
+	// Restarts counts how many times the plugin was started again after returning
+	Restarts int
+	// LastError is the error the plugin returned last, if any
+	LastError error
+}
+
+type devicePluginStatusTracker struct {
+	lock   sync.Mutex
+	status DevicePluginStatus
+}
+
+func newDevicePluginStatusTracker() *devicePluginStatusTracker {
+	return &devicePluginStatusTracker{status: DevicePluginStatus{Phase: DevicePluginPending}}
+}
+
+func (t *devicePluginStatusTracker) get() DevicePluginStatus {
+	if t == nil {
+		return DevicePluginStatus{Phase: DevicePluginPending}
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.status
+}
+
+func (t *devicePluginStatusTracker) setPhase(phase DevicePluginPhase) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	// A stopped plugin never comes back, late updates of its goroutine are dropped
+	if t.status.Phase != DevicePluginStopped {
+		t.status.Phase = phase
+	}
+}
+
+func (t *devicePluginStatusTracker) setReturned(err error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.status.Phase != DevicePluginStopped {
+		t.status.Phase = DevicePluginBackoff
+	}
+	t.status.LastError = err
+}
+
+func (t *devicePluginStatusTracker) setRestarted() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.status.Restarts++
+}
+
 type controlledDevice struct {
 	devicePlugin Device
 	started      bool
 	stopChan     chan struct{}
 	backoff      []time.Duration
+	// startSlots is shared by all the plugins of a controller to bound the
+	// parallel starts, a nil channel does not bound them
+	startSlots chan struct{}
+	status     *devicePluginStatusTracker
 }
 
 func (c *controlledDevice) Start() {
@@ -63,10 +149,26 @@ func (c *controlledDevice) Start() {
 	if backoff == nil {
 		backoff = defaultBackoffTime
 	}
+	if c.status == nil {
+		c.status = newDevicePluginStatusTracker()
+	}
+	status := c.status
+	startSlots := c.startSlots
 
 	go func() {
-		for {
-			err := dev.Start(stop)
+		for attempt := 0; ; attempt++ {
+			if attempt > 0 {
+				status.setRestarted()
+			}
+			status.setPhase(DevicePluginPending)
+			if !acquireStartSlot(startSlots, stop) {
+				status.setPhase(DevicePluginStopped)
+				return
+			}
+			status.setPhase(DevicePluginStarting)
+
+			err := startWithSlot(dev, stop, startSlots, status)
+			status.setReturned(err)
 			if err != nil {
 				logger.Reason(err).Errorf("Error starting %s device plugin", deviceName)
 				retries = int(math.Min(float64(retries+1), float64(len(backoff)-1)))
@@ -77,6 +179,7 @@ func (c *controlledDevice) Start() {
 			select {
 			case <-stop:
 				// Ok we don't want to re-register
+				status.setPhase(DevicePluginStopped)
 				return
 			case <-time.After(backoff[retries]):
 				// Wait a little and re-register
@@ -89,10 +192,56 @@ func (c *controlledDevice) Start() {
 	c.started = true
 }
 
+func acquireStartSlot(startSlots chan struct{}, stop <-chan struct{}) bool {
+	if startSlots == nil {
+		return true
+	}
+	select {
+	case startSlots <- struct{}{}:
+		return true
+	case <-stop:
+		return false
+	}
+}
+
+// startWithSlot runs the device plugin until it returns. The start slot is
+// released as soon as the plugin is initialized, or when it returns early.
+func startWithSlot(dev Device, stop <-chan struct{}, startSlots chan struct{}, status *devicePluginStatusTracker) error {
+	returned := make(chan struct{})
+	watcherDone := make(chan struct{})
+	go func() {
+		defer close(watcherDone)
+		if startSlots != nil {
+			defer func() { <-startSlots }()
+		}
+		ticker := time.NewTicker(initializedPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-returned:
+				return
+			case <-ticker.C:
+				if dev.GetInitialized() {
+					status.setPhase(DevicePluginRunning)
+					return
+				}
+			}
+		}
+	}()
+
+	err := dev.Start(stop)
+	close(returned)
+	<-watcherDone
+	return err
+}
+
 func (c *controlledDevice) Stop() {
 	if !c.started {
 		return
 	}
+	if c.status != nil {
+		c.status.setPhase(DevicePluginStopped)
+	}
 	close(c.stopChan)
 
 	c.stopChan = nil
@@ -117,6 +266,22 @@ func PermanentHostDevicePlugins(maxDevices int, permissions string) []Device {
 	return ret
 }
 
+// optionalDevicePluginPaths are the devices which are only advertised when the
+// node has them. Their kernel modules don't create the device node on the first
+// access, and may be loaded after virt-handler started.
+var optionalDevicePluginPaths = map[string]string{
+	// Needed by QEMU for the blob resources of virtio-gpu
+	"udmabuf": "/dev/udmabuf",
+	// Needed by QEMU to render the 3D accelerated video devices
+	"dri-render": "/dev/dri/renderD128",
+}
+
+func nodeHasDevice(deviceRoot, devicePath string) bool {
+	_, err := os.Stat(filepath.Join(deviceRoot, devicePath))
+	// Since this is a boolean question, any error means "no"
+	return err == nil
+}
+
 type DeviceControllerInterface interface {
 	Initialized() bool
 	RefreshMediatedDeviceTypes()
@@ -130,11 +295,36 @@ type DeviceController struct {
 	maxDevices          int
 	permissions         string
 	backoff             []time.Duration
+	deviceRoot          string
 	virtConfig          *virtconfig.ClusterConfig
 	stop                chan struct{}
 	mdevTypesManager    *MDEVTypesManager
 	nodeStore           cache.Store
 	mdevRefreshWG       *sync.WaitGroup
+	startSlots          chan struct{}
+	recorder            record.EventRecorder
+	// lastPermittedConfig is the config the started plugins were last refreshed with
+	lastPermittedConfig *permittedDevicesConfig
+}
+
+// permittedDevicesConfig holds the parts of the cluster config which decide
+// what device plugins are started on top of the permanent ones.
+type permittedDevicesConfig struct {
+	hostDevices           *v1.PermittedHostDevices
+	mediatedDevices       *v1.MediatedDevicesConfiguration
+	mdevHandlingDisabled  bool
+	sev                   bool
+	vsock                 bool
+	persistentReservation bool
+}
+
+func (p permittedDevicesConfig) equal(other permittedDevicesConfig) bool {
+	return p.sev == other.sev &&
+		p.vsock == other.vsock &&
+		p.persistentReservation == other.persistentReservation &&
+		p.mdevHandlingDisabled == other.mdevHandlingDisabled &&
+		equality.Semantic.DeepEqual(p.hostDevices, other.hostDevices) &&
+		equality.Semantic.DeepEqual(p.mediatedDevices, other.mediatedDevices)
 }
 
 func NewDeviceController(
@@ -144,6 +334,7 @@ func NewDeviceController(
 	permanentPlugins []Device,
 	clusterConfig *virtconfig.ClusterConfig,
 	nodeStore cache.Store,
+	recorder record.EventRecorder,
 ) *DeviceController {
 	permanentPluginsMap := make(map[string]Device, len(permanentPlugins))
 	for i := range permanentPlugins {
@@ -157,10 +348,13 @@ func NewDeviceController(
 		maxDevices:       maxDevices,
 		permissions:      permissions,
 		backoff:          defaultBackoffTime,
+		deviceRoot:       util.HostRootMount,
 		virtConfig:       clusterConfig,
 		mdevTypesManager: NewMDEVTypesManager(),
 		nodeStore:        nodeStore,
 		mdevRefreshWG:    &sync.WaitGroup{},
+		startSlots:       make(chan struct{}, maxParallelPluginStarts),
+		recorder:         recorder,
 	}
 
 	return controller
@@ -180,21 +374,43 @@ func (c *DeviceController) updatePermittedHostDevicePlugins() []Device {
 		Name      string
 		Path      string
 		IsAllowed func() bool
+		// Optional devices are only advertised when the node has them
+		Optional bool
 	}{
-		{"sev", "/dev/sev", c.virtConfig.WorkloadEncryptionSEVEnabled},
-		{"vhost-vsock", "/dev/vhost-vsock", c.virtConfig.VSOCKEnabled},
+		{"sev", "/dev/sev", c.virtConfig.WorkloadEncryptionSEVEnabled, false},
+		{"vhost-vsock", "/dev/vhost-vsock", c.virtConfig.VSOCKEnabled, true},
 	}
 	for _, dev := range featureGatedDevices {
-		if dev.IsAllowed() {
-			permittedDevices = append(
-				permittedDevices,
-				NewGenericDevicePlugin(dev.Name, dev.Path, c.maxDevices, c.permissions, true),
-			)
+		if !dev.IsAllowed() {
+			continue
+		}
+		if dev.Optional && !nodeHasDevice(c.deviceRoot, dev.Path) {
+			log.Log.V(4).Infof("Device %s is not present on the node, not advertising it", dev.Path)
+			continue
+		}
+		permittedDevices = append(
+			permittedDevices,
+			NewGenericDevicePlugin(dev.Name, dev.Path, c.maxDevices, c.permissions, true),
+		)
+	}
+
+	for name, path := range optionalDevicePluginPaths {
+		if !nodeHasDevice(c.deviceRoot, path) {
+			log.Log.V(4).Infof("Device %s is not present on the node, not advertising it", path)
+			continue
+		}
+		permittedDevices = append(permittedDevices, NewGenericDevicePlugin(name, path, c.maxDevices, c.permissions, false))
+	}
+
+	if c.virtConfig.SGXEnabled() && nodeHasDevice(c.deviceRoot, sgxVEPCDevicePath) {
+		if epcSize := discoverSGXEPCSize(c.deviceRoot); epcSize > 0 {
+			permittedDevices = append(permittedDevices, NewSGXDevicePlugin(epcSize, c.permissions))
 		}
 	}
 
 	if c.virtConfig.PersistentReservationEnabled() {
-		d, err := NewSocketDevicePlugin(reservation.GetPrResourceName(), reservation.GetPrHelperSocketDir(), reservation.GetPrHelperSocket(), c.maxDevices, selinux.SELinuxExecutor{}, NewPermissionManager())
+		socketPath := reservation.GetConfiguredPrHelperSocketPath(c.virtConfig.GetPersistentReservation())
+		d, err := NewSocketDevicePlugin(reservation.GetPrResourceName(), filepath.Dir(socketPath), filepath.Base(socketPath), c.maxDevices, selinux.SELinuxExecutor{}, NewPermissionManager())
 		if err != nil {
 			log.Log.Reason(err).Errorf("failed to configure the desired mdev types, failed to get node details")
 		} else {
@@ -222,7 +438,24 @@ func (c *DeviceController) updatePermittedHostDevicePlugins() []Device {
 		for pciResourceName, pciDevices := range discoverPermittedHostPCIDevices(supportedPCIDeviceMap) {
 			log.Log.V(4).Infof("Discovered PCIs %d devices on the node for the resource: %s", len(pciDevices), pciResourceName)
 			// add a device plugin only for new devices
-			permittedDevices = append(permittedDevices, NewPCIDevicePlugin(pciDevices, pciResourceName))
+			permittedDevices = append(permittedDevices, NewPCIDevicePlugin(pciDevices, pciResourceName, c.recordNodeEvent))
+		}
+	}
+	if len(hostDevs.VDPADevices) != 0 {
+		supportedVDPADeviceMap := make(map[string]string)
+		for _, vdpaDev := range hostDevs.VDPADevices {
+			log.Log.V(4).Infof("Permitted vDPA device in the cluster, ID: %s, resourceName: %s, externalProvider: %t",
+				strings.ToLower(vdpaDev.PCIVendorSelector),
+				vdpaDev.ResourceName,
+				vdpaDev.ExternalResourceProvider)
+			// do not add a device plugin for this resource if it's being provided via an external device plugin
+			if !vdpaDev.ExternalResourceProvider {
+				supportedVDPADeviceMap[strings.ToLower(vdpaDev.PCIVendorSelector)] = vdpaDev.ResourceName
+			}
+		}
+		for vdpaResourceName, vdpaDevices := range discoverPermittedHostVDPADevices(supportedVDPADeviceMap) {
+			log.Log.V(4).Infof("Discovered %d vDPA devices on the node for the resource: %s", len(vdpaDevices), vdpaResourceName)
+			permittedDevices = append(permittedDevices, NewVDPADevicePlugin(vdpaDevices, vdpaResourceName))
 		}
 	}
 	if len(hostDevs.MediatedDevices) != 0 {
@@ -249,6 +482,10 @@ func (c *DeviceController) updatePermittedHostDevicePlugins() []Device {
 		permittedDevices = append(permittedDevices, NewUSBDevicePlugin(resourceName, pluginDevices))
 	}
 
+	for resourceName, devicePath := range discoverAllowedInputDevices(hostDevs.InputDevices) {
+		permittedDevices = append(permittedDevices, NewInputDevicePlugin(resourceName, devicePath))
+	}
+
 	return permittedDevices
 }
 
@@ -260,6 +497,96 @@ func removeSelectorSpaces(selectorName string) string {
 	return typeNameStr
 }
 
+func (c *DeviceController) permittedDevicesConfig() permittedDevicesConfig {
+	config := permittedDevicesConfig{
+		sev:                   c.virtConfig.WorkloadEncryptionSEVEnabled(),
+		vsock:                 c.virtConfig.VSOCKEnabled(),
+		persistentReservation: c.virtConfig.PersistentReservationEnabled(),
+		mdevHandlingDisabled:  c.virtConfig.MediatedDevicesHandlingDisabled(),
+	}
+	if hostDevs := c.virtConfig.GetPermittedHostDevices(); hostDevs != nil {
+		config.hostDevices = hostDevs.DeepCopy()
+	}
+	if mdevConfig := c.virtConfig.GetConfig().MediatedDevicesConfiguration; mdevConfig != nil {
+		config.mediatedDevices = mdevConfig.DeepCopy()
+	}
+	return config
+}
+
+// permittedDevicesConfigChanged tells if the cluster config changed in a way
+// which may start or stop device plugins since the last refresh.
+func (c *DeviceController) permittedDevicesConfigChanged() bool {
+	c.startedPluginsMutex.Lock()
+	defer c.startedPluginsMutex.Unlock()
+	return c.lastPermittedConfig == nil || !c.lastPermittedConfig.equal(c.permittedDevicesConfig())
+}
+
+// refreshPermittedDevicesOnConfigChange is called on every update of the KubeVirt CR. It configures the
+// desired mediated device types, and only rediscovers the devices of the node when the mediated devices
+// or the permitted devices changed.
+func (c *DeviceController) refreshPermittedDevicesOnConfigChange() {
+	mdevTypesChanged := c.refreshMediatedDeviceTypes()
+	if !mdevTypesChanged && !c.permittedDevicesConfigChanged() {
+		log.DefaultLogger().V(4).Info("permitted host devices did not change, skipping the device plugins refresh")
+		return
+	}
+	c.refreshPermittedDevices()
+}
+
+// refreshPermittedDevicesOnUSBChange rediscovers the devices of the node when USB devices
+// are plugged or unplugged, so that the USB device plugins expose the devices matching their selectors.
+func (c *DeviceController) refreshPermittedDevicesOnUSBChange(stop <-chan struct{}) {
+	if !nodeHasDevice(c.deviceRoot, usbDevicesPath) {
+		log.DefaultLogger().V(4).Infof("%s is not present on the node, not watching the USB devices", usbDevicesPath)
+		return
+	}
+	err := watchUSBDevices(filepath.Join(c.deviceRoot, usbDevicesPath), stop, func() {
+		if hostDevs := c.virtConfig.GetPermittedHostDevices(); hostDevs == nil || len(hostDevs.USB) == 0 {
+			return
+		}
+		log.DefaultLogger().V(3).Info("USB devices were plugged or unplugged, refreshing the device plugins")
+		c.refreshPermittedDevices()
+	})
+	if err != nil {
+		log.DefaultLogger().Reason(err).Error("failed to watch the USB devices, plugged devices won't be discovered")
+	}
+}
+
+// refreshPermittedDevicesOnOptionalDeviceChange advertises the optional devices
+// when their kernel modules are loaded or unloaded after virt-handler started
+func (c *DeviceController) refreshPermittedDevicesOnOptionalDeviceChange(stop <-chan struct{}) {
+	err := watchOptionalDevices(c.deviceRoot, stop, func() {
+		log.DefaultLogger().V(3).Info("Optional devices appeared or disappeared, refreshing the device plugins")
+		c.refreshPermittedDevices()
+	})
+	if err != nil {
+		log.DefaultLogger().Reason(err).Error("failed to watch the optional devices, loaded modules won't be discovered")
+	}
+}
+
+// pluginDevicesProvider is implemented by the device plugins which advertise
+// a set of discovered devices
+type pluginDevicesProvider interface {
+	deviceIDs() []string
+}
+
+// sameDevices tells if a started device plugin advertises the devices of a newly
+// discovered one. Plugins which do not tell their devices are assumed unchanged.
+func sameDevices(started, discovered Device) bool {
+	startedProvider, ok := started.(pluginDevicesProvider)
+	if !ok {
+		return true
+	}
+	discoveredProvider, ok := discovered.(pluginDevicesProvider)
+	if !ok {
+		return true
+	}
+	startedIDs, discoveredIDs := startedProvider.deviceIDs(), discoveredProvider.deviceIDs()
+	slices.Sort(startedIDs)
+	slices.Sort(discoveredIDs)
+	return slices.Equal(startedIDs, discoveredIDs)
+}
+
 func (c *DeviceController) splitPermittedDevices(devices []Device) (map[string]Device, map[string]struct{}) {
 	devicePluginsToRun := make(map[string]Device)
 	devicePluginsToStop := make(map[string]struct{})
@@ -273,11 +600,12 @@ func (c *DeviceController) splitPermittedDevices(devices []Device) (map[string]D
 	}
 
 	for _, device := range devices {
-		if _, isRunning := c.startedPlugins[device.GetDeviceName()]; !isRunning {
+		started, isRunning := c.startedPlugins[device.GetDeviceName()]
+		if !isRunning || !sameDevices(started.devicePlugin, device) {
+			// startDevice replaces the plugin of a resource whose devices changed
 			devicePluginsToRun[device.GetDeviceName()] = device
-		} else {
-			delete(devicePluginsToStop, device.GetDeviceName())
 		}
+		delete(devicePluginsToStop, device.GetDeviceName())
 	}
 
 	return devicePluginsToRun, devicePluginsToStop
@@ -344,6 +672,18 @@ func (c *DeviceController) getNode() (*k8sv1.Node, error) {
 	return node, nil
 }
 
+// nodeEventRecorder records an event about the devices of the node
+type nodeEventRecorder func(eventType, reason, message string)
+
+func (c *DeviceController) recordNodeEvent(eventType, reason, message string) {
+	node, err := c.getNode()
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to record the %s event on the node", reason)
+		return
+	}
+	c.recorder.Event(node, eventType, reason, message)
+}
+
 func (c *DeviceController) refreshPermittedDevices() {
 	c.mdevRefreshWG.Add(1)
 	logger := log.DefaultLogger()
@@ -358,6 +698,7 @@ func (c *DeviceController) refreshPermittedDevices() {
 	c.startedPluginsMutex.Lock()
 	defer c.startedPluginsMutex.Unlock()
 
+	c.lastPermittedConfig = pointer.P(c.permittedDevicesConfig())
 	enabledDevicePlugins, disabledDevicePlugins := c.splitPermittedDevices(
 		c.updatePermittedHostDevicePlugins(),
 	)
@@ -388,6 +729,8 @@ func (c *DeviceController) startDevice(resourceName string, dev Device) {
 	controlledDev := controlledDevice{
 		devicePlugin: dev,
 		backoff:      c.backoff,
+		startSlots:   c.startSlots,
+		status:       newDevicePluginStatusTracker(),
 	}
 	controlledDev.Start()
 	c.startedPlugins[resourceName] = controlledDev
@@ -413,12 +756,10 @@ func (c *DeviceController) Run(stop chan struct{}) {
 		}
 	}()
 
-	refreshMediatedDeviceTypesFn := func() {
-		c.refreshMediatedDeviceTypes()
-	}
-	c.virtConfig.SetConfigModifiedCallback(refreshMediatedDeviceTypesFn)
-	c.virtConfig.SetConfigModifiedCallback(c.refreshPermittedDevices)
+	c.virtConfig.SetConfigModifiedCallback(c.refreshPermittedDevicesOnConfigChange)
 	c.refreshPermittedDevices()
+	go c.refreshPermittedDevicesOnUSBChange(stop)
+	go c.refreshPermittedDevicesOnOptionalDeviceChange(stop)
 
 	// keep running until stop
 	<-stop
@@ -449,3 +790,14 @@ func (c *DeviceController) Initialized() bool {
 
 	return true
 }
+
+// PluginStatuses returns the status of the started device plugins, indexed by resource name.
+func (c *DeviceController) PluginStatuses() map[string]DevicePluginStatus {
+	c.startedPluginsMutex.Lock()
+	defer c.startedPluginsMutex.Unlock()
+	statuses := make(map[string]DevicePluginStatus, len(c.startedPlugins))
+	for name, dev := range c.startedPlugins {
+		statuses[name] = dev.status.get()
+	}
+	return statuses
+}
@@ -34,11 +34,13 @@ import (
 
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 	pluginapi "kubevirt.io/kubevirt/pkg/virt-handler/device-manager/deviceplugin/v1beta1"
 )
 
@@ -81,6 +83,27 @@ func NewFakePlugin(name string, path string) *FakePlugin {
 	}
 }
 
+// RegisteringFakePlugin keeps running once started and is only initialized
+// once the test marks it as registered.
+type RegisteringFakePlugin struct {
+	FakePlugin
+	registered atomic.Bool
+}
+
+func (fp *RegisteringFakePlugin) Start(stop <-chan struct{}) error {
+	atomic.AddInt32(&fp.Starts, 1)
+	<-stop
+	return nil
+}
+
+func (fp *RegisteringFakePlugin) GetInitialized() bool {
+	return fp.registered.Load()
+}
+
+func NewRegisteringFakePlugin(name string) *RegisteringFakePlugin {
+	return &RegisteringFakePlugin{FakePlugin: FakePlugin{deviceName: name}}
+}
+
 var _ = Describe("Device Controller", func() {
 	var workDir string
 	var err error
@@ -150,7 +173,7 @@ var _ = Describe("Device Controller", func() {
 	Context("Basic Tests", func() {
 		It("Should indicate if node has device", func() {
 			var noDevices []Device
-			deviceController := NewDeviceController(host, maxDevices, permissions, noDevices, fakeConfigMap, fakeNodeStore)
+			deviceController := NewDeviceController(host, maxDevices, permissions, noDevices, fakeConfigMap, fakeNodeStore, record.NewFakeRecorder(10))
 			devicePath := path.Join(workDir, "fake-device")
 			res := deviceController.NodeHasDevice(devicePath)
 			Expect(res).To(BeFalse())
@@ -165,6 +188,124 @@ var _ = Describe("Device Controller", func() {
 		})
 	})
 
+	Context("Optional devices", func() {
+		var deviceRoot string
+
+		createDevice := func(devicePath string) {
+			devicePath = path.Join(deviceRoot, devicePath)
+			Expect(os.MkdirAll(path.Dir(devicePath), 0755)).To(Succeed())
+			Expect(os.WriteFile(devicePath, nil, 0644)).To(Succeed())
+		}
+
+		pluginNames := func(plugins []Device) []string {
+			var names []string
+			for _, plugin := range plugins {
+				names = append(names, plugin.GetDeviceName())
+			}
+			return names
+		}
+
+		BeforeEach(func() {
+			deviceRoot = GinkgoT().TempDir()
+		})
+
+		It("should only advertise udmabuf while the node has it", func() {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+			deviceController := NewDeviceController(host, maxDevices, permissions, []Device{}, clusterConfig, fakeNodeStore, record.NewFakeRecorder(10))
+			deviceController.deviceRoot = deviceRoot
+			Expect(pluginNames(deviceController.updatePermittedHostDevicePlugins())).To(BeEmpty())
+
+			createDevice("/dev/udmabuf")
+			Expect(pluginNames(deviceController.updatePermittedHostDevicePlugins())).To(ConsistOf("udmabuf"))
+
+			Expect(os.Remove(path.Join(deviceRoot, "/dev/udmabuf"))).To(Succeed())
+			Expect(pluginNames(deviceController.updatePermittedHostDevicePlugins())).To(BeEmpty())
+		})
+
+		It("should only advertise dri-render when the node has a render node", func() {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+			deviceController := NewDeviceController(host, maxDevices, permissions, []Device{}, clusterConfig, fakeNodeStore, record.NewFakeRecorder(10))
+			deviceController.deviceRoot = deviceRoot
+			Expect(pluginNames(deviceController.updatePermittedHostDevicePlugins())).To(BeEmpty())
+
+			createDevice("/dev/dri/renderD128")
+			Expect(pluginNames(deviceController.updatePermittedHostDevicePlugins())).To(ConsistOf("dri-render"))
+		})
+
+		It("should not advertise the optional devices as permanent", func() {
+			createDevice("/dev/udmabuf")
+			createDevice("/dev/dri/renderD128")
+			Expect(pluginNames(PermanentHostDevicePlugins(maxDevices, permissions))).To(ConsistOf(
+				"kvm", "tun", "vhost-net"))
+		})
+
+		It("should notice the optional devices which are loaded or unloaded", func() {
+			originalSettleTime := optionalDevicesSettleTime
+			DeferCleanup(func() {
+				optionalDevicesSettleTime = originalSettleTime
+			})
+			optionalDevicesSettleTime = 10 * time.Millisecond
+			Expect(os.MkdirAll(path.Join(deviceRoot, "/dev"), 0755)).To(Succeed())
+
+			stop := make(chan struct{})
+			defer close(stop)
+			changes := make(chan struct{}, 10)
+			go func() {
+				defer GinkgoRecover()
+				Expect(watchOptionalDevices(deviceRoot, stop, func() { changes <- struct{}{} })).To(Succeed())
+			}()
+
+			// The watcher may not be set up yet, keep loading the module until it notices
+			Eventually(func(g Gomega) {
+				g.Expect(os.RemoveAll(path.Join(deviceRoot, "/dev/udmabuf"))).To(Succeed())
+				createDevice("/dev/udmabuf")
+				g.Eventually(changes, 100*time.Millisecond).Should(Receive())
+			}).Should(Succeed())
+			for len(changes) > 0 {
+				<-changes
+			}
+
+			Expect(os.Remove(path.Join(deviceRoot, "/dev/udmabuf"))).To(Succeed())
+			Eventually(changes).Should(Receive())
+
+			// The render node is created along with its directory
+			createDevice("/dev/dri/renderD128")
+			Eventually(changes).Should(Receive())
+		})
+
+		It("should only advertise vhost-vsock when enabled and the node has it", func() {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: []string{featuregate.VSOCKGate}},
+			})
+			deviceController := NewDeviceController(host, maxDevices, permissions, []Device{}, clusterConfig, fakeNodeStore, record.NewFakeRecorder(10))
+			deviceController.deviceRoot = deviceRoot
+			Expect(pluginNames(deviceController.updatePermittedHostDevicePlugins())).To(BeEmpty())
+
+			createDevice("/dev/vhost-vsock")
+			Expect(pluginNames(deviceController.updatePermittedHostDevicePlugins())).To(ConsistOf("vhost-vsock"))
+		})
+
+		It("should only advertise sgx-epc when enabled and the node has an EPC", func() {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: []string{featuregate.SGXGate}},
+			})
+			deviceController := NewDeviceController(host, maxDevices, permissions, []Device{}, clusterConfig, fakeNodeStore, record.NewFakeRecorder(10))
+			deviceController.deviceRoot = deviceRoot
+			createDevice("/dev/sgx_vepc")
+			Expect(pluginNames(deviceController.updatePermittedHostDevicePlugins())).To(BeEmpty())
+
+			for node, size := range []string{"67108864\n", "33554432\n"} {
+				sizePath := path.Join(deviceRoot, fmt.Sprintf("/sys/devices/system/node/node%d/x86/sgx_total_bytes", node))
+				Expect(os.MkdirAll(path.Dir(sizePath), 0755)).To(Succeed())
+				Expect(os.WriteFile(sizePath, []byte(size), 0644)).To(Succeed())
+			}
+			plugins := deviceController.updatePermittedHostDevicePlugins()
+			Expect(pluginNames(plugins)).To(ConsistOf("sgx-epc"))
+			// one device per MiB of EPC
+			Expect(plugins[0].(*GenericDevicePlugin).devs).To(HaveLen(96))
+		})
+	})
+
 	Context("Multiple Plugins", func() {
 		var deviceName1 string
 		var deviceName2 string
@@ -189,7 +330,7 @@ var _ = Describe("Device Controller", func() {
 
 		It("should start the device plugin immediately without delays", func() {
 			initialDevices := []Device{plugin2}
-			deviceController := NewDeviceController(host, maxDevices, permissions, initialDevices, fakeConfigMap, fakeNodeStore)
+			deviceController := NewDeviceController(host, maxDevices, permissions, initialDevices, fakeConfigMap, fakeNodeStore, record.NewFakeRecorder(10))
 			deviceController.backoff = []time.Duration{10 * time.Millisecond, 10 * time.Second}
 
 			runDeviceController(deviceController)
@@ -205,7 +346,7 @@ var _ = Describe("Device Controller", func() {
 			plugin2.Error = fmt.Errorf("failing")
 			initialDevices := []Device{plugin2}
 
-			deviceController := NewDeviceController(host, maxDevices, permissions, initialDevices, fakeConfigMap, fakeNodeStore)
+			deviceController := NewDeviceController(host, maxDevices, permissions, initialDevices, fakeConfigMap, fakeNodeStore, record.NewFakeRecorder(10))
 			deviceController.backoff = []time.Duration{10 * time.Millisecond, 300 * time.Millisecond}
 
 			runDeviceController(deviceController)
@@ -218,7 +359,7 @@ var _ = Describe("Device Controller", func() {
 
 		It("Should not block on other plugins", func() {
 			initialDevices := []Device{plugin1, plugin2}
-			deviceController := NewDeviceController(host, maxDevices, permissions, initialDevices, fakeConfigMap, fakeNodeStore)
+			deviceController := NewDeviceController(host, maxDevices, permissions, initialDevices, fakeConfigMap, fakeNodeStore, record.NewFakeRecorder(10))
 
 			runDeviceController(deviceController)
 
@@ -234,11 +375,62 @@ var _ = Describe("Device Controller", func() {
 			}, 5*time.Second).Should(BeNumerically(">=", 1))
 		})
 
+		It("should bound the device plugins starting in parallel", func() {
+			registering1 := NewRegisteringFakePlugin(deviceName1)
+			registering2 := NewRegisteringFakePlugin(deviceName2)
+			deviceController := NewDeviceController(host, maxDevices, permissions, []Device{registering1, registering2}, fakeConfigMap, fakeNodeStore, record.NewFakeRecorder(10))
+			deviceController.startSlots = make(chan struct{}, 1)
+
+			runDeviceController(deviceController)
+
+			Eventually(func() int32 {
+				return atomic.LoadInt32(&registering1.Starts) + atomic.LoadInt32(&registering2.Starts)
+			}, 5*time.Second).Should(BeEquivalentTo(1))
+			Consistently(func() int32 {
+				return atomic.LoadInt32(&registering1.Starts) + atomic.LoadInt32(&registering2.Starts)
+			}, 300*time.Millisecond).Should(BeEquivalentTo(1))
+			Expect(deviceController.Initialized()).To(BeFalse())
+
+			By("Completing the registration of the started plugin")
+			registering1.registered.Store(true)
+			registering2.registered.Store(true)
+			Eventually(func() int32 {
+				return atomic.LoadInt32(&registering1.Starts) + atomic.LoadInt32(&registering2.Starts)
+			}, 5*time.Second).Should(BeEquivalentTo(2))
+			Eventually(deviceController.PluginStatuses, 5*time.Second).Should(And(
+				HaveKeyWithValue(deviceName1, DevicePluginStatus{Phase: DevicePluginRunning}),
+				HaveKeyWithValue(deviceName2, DevicePluginStatus{Phase: DevicePluginRunning}),
+			))
+		})
+
+		It("should track the status of failing device plugins", func() {
+			plugin2.Error = fmt.Errorf("failing")
+			deviceController := NewDeviceController(host, maxDevices, permissions, []Device{plugin2}, fakeConfigMap, fakeNodeStore, record.NewFakeRecorder(10))
+			deviceController.backoff = []time.Duration{10 * time.Millisecond, 50 * time.Millisecond}
+
+			runDeviceController(deviceController)
+
+			Eventually(func() DevicePluginStatus {
+				return deviceController.PluginStatuses()[deviceName2]
+			}, 5*time.Second).Should(And(
+				HaveField("Restarts", BeNumerically(">=", 1)),
+				HaveField("LastError", MatchError("failing")),
+			))
+		})
+
+		It("should mark stopped device plugins", func() {
+			dev := controlledDevice{devicePlugin: plugin2, status: newDevicePluginStatusTracker()}
+			dev.Start()
+			dev.Stop()
+			Expect(dev.status.get().Phase).To(Equal(DevicePluginStopped))
+			Consistently(dev.status.get, 100*time.Millisecond).Should(HaveField("Phase", DevicePluginStopped))
+		})
+
 		It("Should remove device plugins if permittedHostDevices is removed from the CR", func() {
 			emptyConfigMap, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
 			Expect(emptyConfigMap.GetPermittedHostDevices()).To(BeNil())
 
-			deviceController := NewDeviceController(host, maxDevices, permissions, []Device{}, emptyConfigMap, fakeNodeStore)
+			deviceController := NewDeviceController(host, maxDevices, permissions, []Device{}, emptyConfigMap, fakeNodeStore, record.NewFakeRecorder(10))
 
 			deviceController.startDevice(deviceName1, plugin1)
 			deviceController.startDevice(deviceName2, plugin2)
@@ -254,12 +446,74 @@ var _ = Describe("Device Controller", func() {
 			}, 5*time.Second).Should(BeFalse())
 		})
 
+		It("should only refresh the device plugins when the permitted devices change", func() {
+			clusterConfig, _, kvStore := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+			deviceController := NewDeviceController(host, maxDevices, permissions, []Device{}, clusterConfig, fakeNodeStore, record.NewFakeRecorder(10))
+			deviceController.refreshPermittedDevices()
+			deviceController.startDevice(deviceName1, plugin1)
+
+			By("Updating the KubeVirt CR without touching the permitted devices")
+			kv := &v1.KubeVirt{Spec: v1.KubeVirtSpec{Configuration: v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{LogVerbosity: &v1.LogVerbosity{VirtHandler: 4}},
+			}}}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+			deviceController.refreshPermittedDevicesOnConfigChange()
+			Expect(deviceController.PluginStatuses()).To(HaveKey(deviceName1))
+
+			By("Permitting host devices")
+			kv.Spec.Configuration.PermittedHostDevices = &v1.PermittedHostDevices{
+				PciHostDevices: []v1.PciHostDevice{{
+					PCIVendorSelector:        "DEAD:BEEF",
+					ResourceName:             "example.org/fake-device2",
+					ExternalResourceProvider: true,
+				}},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+			deviceController.refreshPermittedDevicesOnConfigChange()
+			Expect(deviceController.PluginStatuses()).ToNot(HaveKey(deviceName1))
+		})
+
+		It("should refresh the device plugins when the mediated devices configuration changes", func() {
+			clusterConfig, _, kvStore := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+			deviceController := NewDeviceController(host, maxDevices, permissions, []Device{}, clusterConfig, fakeNodeStore, record.NewFakeRecorder(10))
+			deviceController.refreshPermittedDevices()
+			deviceController.startDevice(deviceName1, plugin1)
+
+			kv := &v1.KubeVirt{Spec: v1.KubeVirtSpec{Configuration: v1.KubeVirtConfiguration{
+				MediatedDevicesConfiguration: &v1.MediatedDevicesConfiguration{MediatedDeviceTypes: []string{"nvidia-222"}},
+			}}}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+			deviceController.refreshPermittedDevicesOnConfigChange()
+			Expect(deviceController.PluginStatuses()).ToNot(HaveKey(deviceName1))
+		})
+
+		It("should restart device plugins whose devices changed", func() {
+			newPlugin := func(ids ...string) *PCIDevicePlugin {
+				plugin := &PCIDevicePlugin{DevicePluginBase: &DevicePluginBase{resourceName: deviceName1}}
+				for _, id := range ids {
+					plugin.devs = append(plugin.devs, &pluginapi.Device{ID: id})
+				}
+				return plugin
+			}
+			deviceController := NewDeviceController(host, maxDevices, permissions, []Device{}, fakeConfigMap, fakeNodeStore, record.NewFakeRecorder(10))
+			deviceController.startedPlugins[deviceName1] = controlledDevice{devicePlugin: newPlugin("1", "2")}
+
+			enabled, disabled := deviceController.splitPermittedDevices([]Device{newPlugin("2", "1")})
+			Expect(enabled).To(BeEmpty())
+			Expect(disabled).To(BeEmpty())
+
+			changed := newPlugin("1", "3")
+			enabled, disabled = deviceController.splitPermittedDevices([]Device{changed})
+			Expect(enabled).To(HaveKeyWithValue(deviceName1, changed))
+			Expect(disabled).To(BeEmpty())
+		})
+
 		It("Should not remove permanent device plugins if permittedHostDevices is removed from the CR", func() {
 			emptyConfigMap, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
 			Expect(emptyConfigMap.GetPermittedHostDevices()).To(BeNil())
 
 			permanentPlugins := []Device{plugin1, plugin2}
-			deviceController := NewDeviceController(host, maxDevices, permissions, permanentPlugins, emptyConfigMap, fakeNodeStore)
+			deviceController := NewDeviceController(host, maxDevices, permissions, permanentPlugins, emptyConfigMap, fakeNodeStore, record.NewFakeRecorder(10))
 
 			runDeviceController(deviceController)
 
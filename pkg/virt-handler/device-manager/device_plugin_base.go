@@ -201,6 +201,14 @@ func (dpi *DevicePluginBase) cleanup() error {
 	return nil
 }
 
+func (dpi *DevicePluginBase) deviceIDs() []string {
+	ids := make([]string, 0, len(dpi.devs))
+	for _, dev := range dpi.devs {
+		ids = append(ids, dev.ID)
+	}
+	return ids
+}
+
 func (dpi *DevicePluginBase) GetInitialized() bool {
 	dpi.lock.Lock()
 	defer dpi.lock.Unlock()
@@ -68,6 +68,21 @@ func (mr *MockDeviceHandlerMockRecorder) GetDeviceDriver(basepath, pciAddress an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceDriver", reflect.TypeOf((*MockDeviceHandler)(nil).GetDeviceDriver), basepath, pciAddress)
 }
 
+// GetDeviceEnableCount mocks base method.
+func (m *MockDeviceHandler) GetDeviceEnableCount(basepath, pciAddress string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceEnableCount", basepath, pciAddress)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceEnableCount indicates an expected call of GetDeviceEnableCount.
+func (mr *MockDeviceHandlerMockRecorder) GetDeviceEnableCount(basepath, pciAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceEnableCount", reflect.TypeOf((*MockDeviceHandler)(nil).GetDeviceEnableCount), basepath, pciAddress)
+}
+
 // GetDeviceIOMMUGroup mocks base method.
 func (m *MockDeviceHandler) GetDeviceIOMMUGroup(basepath, pciAddress string) (string, error) {
 	m.ctrl.T.Helper()
@@ -155,3 +170,17 @@ func (mr *MockDeviceHandlerMockRecorder) RemoveMDEVType(mdevUUID any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMDEVType", reflect.TypeOf((*MockDeviceHandler)(nil).RemoveMDEVType), mdevUUID)
 }
+
+// VerifyDeviceReset mocks base method.
+func (m *MockDeviceHandler) VerifyDeviceReset(basepath, pciAddress string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyDeviceReset", basepath, pciAddress)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyDeviceReset indicates an expected call of VerifyDeviceReset.
+func (mr *MockDeviceHandlerMockRecorder) VerifyDeviceReset(basepath, pciAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyDeviceReset", reflect.TypeOf((*MockDeviceHandler)(nil).VerifyDeviceReset), basepath, pciAddress)
+}
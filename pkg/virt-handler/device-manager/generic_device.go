@@ -349,3 +349,68 @@ func (dpi *GenericDevicePlugin) setInitialized(initialized bool) {
 	defer dpi.lock.Unlock()
 	dpi.initialized = initialized
 }
+
+// optionalDevicesSettleTime is how long to wait for the optional device nodes to
+// settle before reporting that they appeared or disappeared
+var optionalDevicesSettleTime = 2 * time.Second
+
+// watchOptionalDevices calls onChange when one of the optional device nodes
+// below deviceRoot is created or removed
+func watchOptionalDevices(deviceRoot string, stop <-chan struct{}, onChange func()) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create a fsnotify watcher: %v", err)
+	}
+	defer watcher.Close()
+
+	devicePaths := make(map[string]struct{}, len(optionalDevicePluginPaths))
+	deviceDirs := map[string]struct{}{}
+	for _, devicePath := range optionalDevicePluginPaths {
+		devicePath = filepath.Join(deviceRoot, devicePath)
+		devicePaths[devicePath] = struct{}{}
+		deviceDirs[filepath.Dir(devicePath)] = struct{}{}
+	}
+	// The directory of a device node, like /dev/dri, may only be created
+	// along with the node, watch it once it exists
+	watchDeviceDirs := func() {
+		for dir := range deviceDirs {
+			if _, err := os.Stat(dir); err != nil {
+				continue
+			}
+			if err := watcher.Add(dir); err != nil {
+				log.Log.Reason(err).Errorf("failed to watch the device directory %s", dir)
+			}
+		}
+	}
+	devDir := filepath.Join(deviceRoot, "dev")
+	if err := watcher.Add(devDir); err != nil {
+		return fmt.Errorf("failed to watch the devices directory %s: %v", devDir, err)
+	}
+	watchDeviceDirs()
+
+	var settled <-chan time.Time
+	for {
+		select {
+		case <-stop:
+			return nil
+		case err := <-watcher.Errors:
+			log.Log.Reason(err).Errorf("error watching the optional devices")
+		case event := <-watcher.Events:
+			if !event.Op.Has(fsnotify.Create) && !event.Op.Has(fsnotify.Remove) && !event.Op.Has(fsnotify.Rename) {
+				continue
+			}
+			_, isDevice := devicePaths[event.Name]
+			_, isDeviceDir := deviceDirs[event.Name]
+			if !isDevice && !isDeviceDir {
+				continue
+			}
+			if isDeviceDir && event.Op.Has(fsnotify.Create) {
+				watchDeviceDirs()
+			}
+			settled = time.After(optionalDevicesSettleTime)
+		case <-settled:
+			settled = nil
+			onChange()
+		}
+	}
+}
@@ -0,0 +1,229 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package device_manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"google.golang.org/grpc"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/util"
+	pluginapi "kubevirt.io/kubevirt/pkg/virt-handler/device-manager/deviceplugin/v1beta1"
+)
+
+const inputDevicesPath = "/dev/input/"
+
+// InputDevicePlugin exposes a single host evdev device, to be passed through
+// to the guest as a virtio-input-host device.
+type InputDevicePlugin struct {
+	*DevicePluginBase
+}
+
+func NewInputDevicePlugin(resourceName string, devicePath string) *InputDevicePlugin {
+	s := strings.Split(resourceName, "/")
+	resourceID := s[0]
+	if len(s) > 1 {
+		resourceID = s[1]
+	}
+	resourceID = fmt.Sprintf("input-%s", resourceID)
+
+	return &InputDevicePlugin{
+		DevicePluginBase: &DevicePluginBase{
+			devs: []*pluginapi.Device{{
+				ID:     resourceID,
+				Health: pluginapi.Healthy,
+			}},
+			socketPath:   SocketPath(resourceID),
+			resourceName: resourceName,
+			deviceName:   resourceID,
+			devicePath:   devicePath,
+			deviceRoot:   util.HostRootMount,
+			initialized:  false,
+			lock:         &sync.Mutex{},
+			health:       make(chan deviceHealth),
+			done:         make(chan struct{}),
+			deregistered: make(chan struct{}),
+		},
+	}
+}
+
+func (dpi *InputDevicePlugin) Start(stop <-chan struct{}) (err error) {
+	logger := log.DefaultLogger()
+	dpi.stop = stop
+
+	err = dpi.cleanup()
+	if err != nil {
+		return err
+	}
+
+	sock, err := net.Listen("unix", dpi.socketPath)
+	if err != nil {
+		return fmt.Errorf("error creating GRPC server socket: %v", err)
+	}
+
+	dpi.server = grpc.NewServer([]grpc.ServerOption{}...)
+	defer dpi.stopDevicePlugin()
+
+	pluginapi.RegisterDevicePluginServer(dpi.server, dpi)
+
+	errChan := make(chan error, 2)
+
+	go func() {
+		errChan <- dpi.server.Serve(sock)
+	}()
+
+	err = waitForGRPCServer(dpi.socketPath, connectionTimeout)
+	if err != nil {
+		return fmt.Errorf("error starting the GRPC server: %v", err)
+	}
+
+	err = dpi.register()
+	if err != nil {
+		return fmt.Errorf("error registering with device plugin manager: %v", err)
+	}
+
+	go func() {
+		errChan <- dpi.healthCheck()
+	}()
+
+	dpi.setInitialized(true)
+	logger.Infof("%s device plugin started", dpi.resourceName)
+	err = <-errChan
+
+	return err
+}
+
+func (dpi *InputDevicePlugin) Allocate(_ context.Context, r *pluginapi.AllocateRequest) (*pluginapi.AllocateResponse, error) {
+	log.DefaultLogger().Infof("Input Allocate: resourceName: %s", dpi.resourceName)
+	log.DefaultLogger().Infof("Input Allocate: request: %v", r.ContainerRequests)
+
+	// The device may have disappeared since the last health check
+	if _, err := safepath.JoinAndResolveWithRelativeRoot(dpi.deviceRoot, dpi.devicePath); err != nil {
+		return nil, fmt.Errorf("error opening the input device %s: %v", dpi.devicePath, err)
+	}
+	// virt-handler hands the device node of the pod to the unprivileged launcher, the node on the host keeps its owner
+	response := &pluginapi.AllocateResponse{}
+	for range r.ContainerRequests {
+		containerResponse := &pluginapi.ContainerAllocateResponse{
+			Envs: map[string]string{
+				util.ResourceNameToEnvVar(v1.InputResourcePrefix, dpi.resourceName): dpi.devicePath,
+			},
+			Devices: []*pluginapi.DeviceSpec{{
+				HostPath:      dpi.devicePath,
+				ContainerPath: dpi.devicePath,
+				Permissions:   "rw",
+			}},
+		}
+		response.ContainerResponses = append(response.ContainerResponses, containerResponse)
+	}
+
+	return response, nil
+}
+
+func (dpi *InputDevicePlugin) healthCheck() error {
+	logger := log.DefaultLogger()
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to creating a fsnotify watcher: %v", err)
+	}
+	defer watcher.Close()
+
+	// This way we don't have to mount /dev from the node
+	devicePath := filepath.Join(dpi.deviceRoot, dpi.devicePath)
+
+	// Start watching the files before we check for their existence to avoid races
+	if err = watcher.Add(filepath.Dir(devicePath)); err != nil {
+		return fmt.Errorf("failed to add the device root path to the watcher: %v", err)
+	}
+
+	if _, err = os.Stat(devicePath); err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("could not stat the device: %v", err)
+		}
+		logger.Warningf("device '%s' is not present, the device plugin can't expose it.", dpi.devicePath)
+		dpi.health <- deviceHealth{DevId: dpi.deviceName, Health: pluginapi.Unhealthy}
+	}
+
+	if err = watcher.Add(filepath.Dir(dpi.socketPath)); err != nil {
+		return fmt.Errorf("failed to add the device-plugin kubelet path to the watcher: %v", err)
+	}
+
+	if _, err = os.Stat(dpi.socketPath); err != nil {
+		return fmt.Errorf("failed to stat the device-plugin socket: %v", err)
+	}
+
+	for {
+		select {
+		case <-dpi.stop:
+			return nil
+		case err := <-watcher.Errors:
+			logger.Reason(err).Errorf("error watching devices and device plugin directory")
+		case event := <-watcher.Events:
+			logger.V(4).Infof("health Event: %v", event)
+			if event.Name == devicePath {
+				// Health in this case is if the device path actually exists
+				if event.Op == fsnotify.Create {
+					logger.Infof("monitored input device %s appeared", dpi.devicePath)
+					dpi.health <- deviceHealth{DevId: dpi.deviceName, Health: pluginapi.Healthy}
+				} else if (event.Op == fsnotify.Remove) || (event.Op == fsnotify.Rename) {
+					logger.Infof("monitored input device %s disappeared", dpi.devicePath)
+					dpi.health <- deviceHealth{DevId: dpi.deviceName, Health: pluginapi.Unhealthy}
+				}
+			} else if event.Name == dpi.socketPath && event.Op == fsnotify.Remove {
+				logger.Infof("device socket file for device %s was removed, kubelet probably restarted.", dpi.resourceName)
+				return nil
+			}
+		}
+	}
+}
+
+// discoverAllowedInputDevices returns the device path of each permitted input device,
+// indexed by resource name. Devices provided by an external device plugin and paths
+// outside of /dev/input are skipped.
+func discoverAllowedInputDevices(inputDevices []v1.InputHostDevice) map[string]string {
+	devices := make(map[string]string)
+	for _, inputDev := range inputDevices {
+		if inputDev.ExternalResourceProvider {
+			log.Log.V(6).Infof("Skipping discovery of %s. To be handled by external device-plugin",
+				inputDev.ResourceName)
+			continue
+		}
+		devicePath := filepath.Clean(inputDev.DevicePath)
+		if !strings.HasPrefix(devicePath, inputDevicesPath) {
+			log.Log.Errorf("Refusing to expose %s for resource %s, only devices under %s are permitted",
+				inputDev.DevicePath, inputDev.ResourceName, inputDevicesPath)
+			continue
+		}
+		devices[inputDev.ResourceName] = devicePath
+	}
+	return devices
+}
@@ -0,0 +1,123 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package device_manager
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util"
+	pluginapi "kubevirt.io/kubevirt/pkg/virt-handler/device-manager/deviceplugin/v1beta1"
+)
+
+var _ = Describe("Input device", func() {
+	const (
+		resourceName = "example.org/kiosk-keyboard"
+		devicePath   = "/dev/input/by-id/usb-keyboard-event-kbd"
+	)
+
+	var (
+		dpi          *InputDevicePlugin
+		deviceRoot   string
+		hostDevice   string
+		stopPlugin   chan struct{}
+		createDevice = func(path string) {
+			Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+			Expect(os.WriteFile(path, nil, 0644)).To(Succeed())
+		}
+	)
+
+	BeforeEach(func() {
+		deviceRoot = GinkgoT().TempDir()
+		hostDevice = filepath.Join(deviceRoot, devicePath)
+		createDevice(hostDevice)
+
+		dpi = NewInputDevicePlugin(resourceName, devicePath)
+		dpi.deviceRoot = deviceRoot
+		dpi.socketPath = filepath.Join(GinkgoT().TempDir(), "input.sock")
+		stopPlugin = make(chan struct{})
+		dpi.stop = stopPlugin
+		DeferCleanup(func() { close(stopPlugin) })
+	})
+
+	It("should expose a single device named after the resource", func() {
+		Expect(dpi.GetDeviceName()).To(Equal(resourceName))
+		Expect(dpi.devs).To(HaveLen(1))
+		Expect(dpi.devs[0].ID).To(Equal("input-kiosk-keyboard"))
+	})
+
+	It("should mount the device and advertise its path on allocation", func() {
+		response, err := dpi.Allocate(context.Background(), &pluginapi.AllocateRequest{
+			ContainerRequests: []*pluginapi.ContainerAllocateRequest{{DevicesIDs: []string{"input-kiosk-keyboard"}}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.ContainerResponses).To(HaveLen(1))
+		Expect(response.ContainerResponses[0].Devices).To(ConsistOf(&pluginapi.DeviceSpec{
+			HostPath:      devicePath,
+			ContainerPath: devicePath,
+			Permissions:   "rw",
+		}))
+		Expect(response.ContainerResponses[0].Envs).To(HaveKeyWithValue(
+			util.ResourceNameToEnvVar(v1.InputResourcePrefix, resourceName), devicePath))
+	})
+
+	It("should fail the allocation when the device is missing", func() {
+		Expect(os.Remove(hostDevice)).To(Succeed())
+		_, err := dpi.Allocate(context.Background(), &pluginapi.AllocateRequest{
+			ContainerRequests: []*pluginapi.ContainerAllocateRequest{{DevicesIDs: []string{"input-kiosk-keyboard"}}},
+		})
+		Expect(err).To(MatchError(ContainSubstring("error opening the input device")))
+	})
+
+	It("should monitor the health of the device node", func() {
+		createDevice(dpi.socketPath)
+		go dpi.healthCheck()
+
+		By("Removing the (fake) device node")
+		Expect(os.Remove(hostDevice)).To(Succeed())
+		Eventually(dpi.health, 5*time.Second).Should(Receive(Equal(
+			deviceHealth{DevId: "input-kiosk-keyboard", Health: pluginapi.Unhealthy})))
+
+		By("Creating the (fake) device node again")
+		createDevice(hostDevice)
+		Eventually(dpi.health, 5*time.Second).Should(Receive(Equal(
+			deviceHealth{DevId: "input-kiosk-keyboard", Health: pluginapi.Healthy})))
+	})
+
+	It("should only discover permitted devices under /dev/input", func() {
+		Expect(discoverAllowedInputDevices([]v1.InputHostDevice{
+			{ResourceName: "example.org/keyboard", DevicePath: "/dev/input/event3"},
+			{ResourceName: "example.org/touchscreen", DevicePath: "/dev/input/by-path/../event4"},
+			{ResourceName: "example.org/external", DevicePath: "/dev/input/event5", ExternalResourceProvider: true},
+			{ResourceName: "example.org/disk", DevicePath: "/dev/input/../sda"},
+			{ResourceName: "example.org/tty", DevicePath: "/dev/ttyS0"},
+		})).To(Equal(map[string]string{
+			"example.org/keyboard":    "/dev/input/event3",
+			"example.org/touchscreen": "/dev/input/event4",
+		}))
+	})
+})
@@ -28,9 +28,11 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/fsnotify/fsnotify"
 	"google.golang.org/grpc"
+	k8sv1 "k8s.io/api/core/v1"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
@@ -43,6 +45,11 @@ const (
 	vfioDevicePath = "/dev/vfio/"
 	vfioMount      = "/dev/vfio/vfio"
 	pciBasePath    = "/sys/bus/pci/devices"
+
+	// releasedDevicesCheckInterval is how often the devices assigned to VMs are checked for their release
+	releasedDevicesCheckInterval = 10 * time.Second
+
+	PCIDeviceResetFailedReason = "PCIDeviceResetFailed"
 )
 
 type PCIDevice struct {
@@ -56,6 +63,13 @@ type PCIDevice struct {
 type PCIDevicePlugin struct {
 	*DevicePluginBase
 	iommuToPCIMap map[string]string
+	recordEvent   nodeEventRecorder
+	// assignedDevices are the IOMMU groups allocated to VMs, with whether the VM started to use them
+	assignedDevices map[string]bool
+	assignedLock    sync.Mutex
+	// resettingDevices are the released IOMMU groups withheld until they respond after their reset,
+	// with whether the failure of their reset was reported
+	resettingDevices map[string]bool
 }
 
 func (dpi *PCIDevicePlugin) Start(stop <-chan struct{}) (err error) {
@@ -104,7 +118,7 @@ func (dpi *PCIDevicePlugin) Start(stop <-chan struct{}) (err error) {
 	return err
 }
 
-func NewPCIDevicePlugin(pciDevices []*PCIDevice, resourceName string) *PCIDevicePlugin {
+func NewPCIDevicePlugin(pciDevices []*PCIDevice, resourceName string, recordEvent nodeEventRecorder) *PCIDevicePlugin {
 	serverSock := SocketPath(strings.Replace(resourceName, "/", "-", -1))
 	iommuToPCIMap := make(map[string]string)
 
@@ -123,7 +137,10 @@ func NewPCIDevicePlugin(pciDevices []*PCIDevice, resourceName string) *PCIDevice
 			done:         make(chan struct{}),
 			deregistered: make(chan struct{}),
 		},
-		iommuToPCIMap: iommuToPCIMap,
+		iommuToPCIMap:    iommuToPCIMap,
+		recordEvent:      recordEvent,
+		assignedDevices:  map[string]bool{},
+		resettingDevices: map[string]bool{},
 	}
 	return dpi
 }
@@ -163,6 +180,7 @@ func (dpi *PCIDevicePlugin) Allocate(_ context.Context, r *pluginapi.AllocateReq
 				continue
 			}
 			allocatedDevices = append(allocatedDevices, devPCIAddress)
+			dpi.markAssigned(devID)
 			deviceSpecs = append(deviceSpecs, formatVFIODeviceSpecs(devID)...)
 		}
 		containerResponse.Devices = deviceSpecs
@@ -222,10 +240,15 @@ func (dpi *PCIDevicePlugin) healthCheck() error {
 		return fmt.Errorf("failed to stat the device-plugin socket: %v", err)
 	}
 
+	releasedDevicesTicker := time.NewTicker(releasedDevicesCheckInterval)
+	defer releasedDevicesTicker.Stop()
+
 	for {
 		select {
 		case <-dpi.stop:
 			return nil
+		case <-releasedDevicesTicker.C:
+			dpi.verifyReleasedDevices()
 		case err := <-watcher.Errors:
 			logger.Reason(err).Errorf("error watching devices and device plugin directory")
 		case event := <-watcher.Events:
@@ -253,6 +276,72 @@ func (dpi *PCIDevicePlugin) healthCheck() error {
 	}
 }
 
+func (dpi *PCIDevicePlugin) markAssigned(devID string) {
+	dpi.assignedLock.Lock()
+	defer dpi.assignedLock.Unlock()
+	dpi.assignedDevices[devID] = false
+}
+
+// releasedDevices returns the assigned devices which the VMs stopped to use. vfio-pci keeps
+// a device enabled while a VM uses it, the device is released once it is disabled again.
+// Devices which are allocated but never used, e.g. because the pod failed to start, stay
+// assigned until they are allocated again.
+func (dpi *PCIDevicePlugin) releasedDevices() []string {
+	dpi.assignedLock.Lock()
+	defer dpi.assignedLock.Unlock()
+
+	var released []string
+	for devID, inUse := range dpi.assignedDevices {
+		enableCount, err := handler.GetDeviceEnableCount(pciBasePath, dpi.iommuToPCIMap[devID])
+		if err != nil {
+			log.DefaultLogger().Reason(err).Errorf("failed to check whether PCI device %s is in use", dpi.iommuToPCIMap[devID])
+			continue
+		}
+		switch {
+		case enableCount > 0:
+			dpi.assignedDevices[devID] = true
+		case inUse:
+			delete(dpi.assignedDevices, devID)
+			released = append(released, devID)
+		}
+	}
+	return released
+}
+
+// verifyReleasedDevices withholds the devices released by the VMs until they respond after their
+// reset, so that the next VM does not inherit a wedged device. Devices which do not reset cleanly
+// are verified again on the next checks and advertised again once they recover.
+func (dpi *PCIDevicePlugin) verifyReleasedDevices() {
+	for _, devID := range dpi.releasedDevices() {
+		dpi.health <- deviceHealth{
+			DevId:  devID,
+			Health: pluginapi.Unhealthy,
+		}
+		dpi.resettingDevices[devID] = false
+	}
+
+	logger := log.DefaultLogger()
+	for devID, reported := range dpi.resettingDevices {
+		pciAddress := dpi.iommuToPCIMap[devID]
+		if err := handler.VerifyDeviceReset(pciBasePath, pciAddress); err != nil {
+			if !reported {
+				message := fmt.Sprintf("PCI device %s of resource %s did not reset cleanly after its release and is withheld until it responds: %v", pciAddress, dpi.resourceName, err)
+				logger.Warning(message)
+				dpi.recordEvent(k8sv1.EventTypeWarning, PCIDeviceResetFailedReason, message)
+				dpi.resettingDevices[devID] = true
+			}
+			continue
+		}
+
+		logger.V(4).Infof("PCI device %s of resource %s was reset after its release", pciAddress, dpi.resourceName)
+		delete(dpi.resettingDevices, devID)
+		dpi.health <- deviceHealth{
+			DevId:  devID,
+			Health: pluginapi.Healthy,
+		}
+	}
+}
+
 func discoverPermittedHostPCIDevices(supportedPCIDeviceMap map[string]string) map[string][]*PCIDevice {
 	pciDevicesMap := make(map[string][]*PCIDevice)
 	err := filepath.Walk(pciBasePath, func(path string, info os.FileInfo, err error) error {
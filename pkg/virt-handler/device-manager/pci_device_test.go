@@ -20,7 +20,9 @@
 package device_manager
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"os"
 	"strings"
 
@@ -32,11 +34,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	pluginapi "kubevirt.io/kubevirt/pkg/virt-handler/device-manager/deviceplugin/v1beta1"
 )
 
 const (
@@ -145,7 +149,7 @@ pciHostDevices:
 
 		By("creating an empty device controller")
 		var noDevices []Device
-		deviceController := NewDeviceController("master", 100, "rw", noDevices, fakeClusterConfig, fakeNodeStore)
+		deviceController := NewDeviceController("master", 100, "rw", noDevices, fakeClusterConfig, fakeNodeStore, record.NewFakeRecorder(10))
 
 		By("adding a host device to the cluster config")
 		kvConfig := kv.DeepCopy()
@@ -189,3 +193,91 @@ pciHostDevices:
 		Ω(disabledDevicePlugins).Should(HaveKey(fakeName))
 	})
 })
+
+var _ = Describe("PCI Device reset verification", func() {
+	var (
+		mockPCI *MockDeviceHandler
+		dpi     *PCIDevicePlugin
+		events  []string
+	)
+
+	BeforeEach(func() {
+		mockPCI = NewMockDeviceHandler(gomock.NewController(GinkgoT()))
+		origHandler := handler
+		handler = mockPCI
+		DeferCleanup(func() { handler = origHandler })
+
+		events = nil
+		recordEvent := func(eventType, reason, message string) {
+			events = append(events, fmt.Sprintf("%s %s %s", eventType, reason, message))
+		}
+		dpi = NewPCIDevicePlugin([]*PCIDevice{{pciAddress: fakeAddress, iommuGroup: fakeIommuGroup, numaNode: -1}}, fakeName, recordEvent)
+		dpi.health = make(chan deviceHealth, 2)
+
+		_, err := dpi.Allocate(context.Background(), &pluginapi.AllocateRequest{
+			ContainerRequests: []*pluginapi.ContainerAllocateRequest{{DevicesIDs: []string{fakeIommuGroup}}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	useAndReleaseDevice := func() {
+		By("checking the device before the VM uses it")
+		mockPCI.EXPECT().GetDeviceEnableCount(pciBasePath, fakeAddress).Return(0, nil)
+		dpi.verifyReleasedDevices()
+
+		By("checking the device while the VM uses it")
+		mockPCI.EXPECT().GetDeviceEnableCount(pciBasePath, fakeAddress).Return(1, nil)
+		dpi.verifyReleasedDevices()
+
+		By("checking the device once the VM released it")
+		mockPCI.EXPECT().GetDeviceEnableCount(pciBasePath, fakeAddress).Return(0, nil)
+	}
+
+	It("should withhold a released device until it is reset", func() {
+		useAndReleaseDevice()
+		mockPCI.EXPECT().VerifyDeviceReset(pciBasePath, fakeAddress).Return(nil)
+		dpi.verifyReleasedDevices()
+
+		Expect(dpi.health).To(Receive(Equal(deviceHealth{DevId: fakeIommuGroup, Health: pluginapi.Unhealthy})))
+		Expect(dpi.health).To(Receive(Equal(deviceHealth{DevId: fakeIommuGroup, Health: pluginapi.Healthy})))
+		Expect(events).To(BeEmpty())
+
+		By("not checking the released device anymore")
+		dpi.verifyReleasedDevices()
+		Expect(dpi.health).ToNot(Receive())
+	})
+
+	It("should withhold a device which does not reset cleanly until it recovers", func() {
+		useAndReleaseDevice()
+		mockPCI.EXPECT().VerifyDeviceReset(pciBasePath, fakeAddress).Return(errors.New("the device does not respond after the reset"))
+		dpi.verifyReleasedDevices()
+
+		Expect(dpi.health).To(Receive(Equal(deviceHealth{DevId: fakeIommuGroup, Health: pluginapi.Unhealthy})))
+		Expect(dpi.health).ToNot(Receive())
+		Expect(events).To(ConsistOf(
+			"Warning PCIDeviceResetFailed PCI device 0000:00:00.0 of resource example.org/deadbeef did not reset cleanly after its release and is withheld until it responds: the device does not respond after the reset",
+		))
+
+		By("verifying the device again without reporting the failure again")
+		mockPCI.EXPECT().VerifyDeviceReset(pciBasePath, fakeAddress).Return(errors.New("the device does not respond after the reset"))
+		dpi.verifyReleasedDevices()
+		Expect(dpi.health).ToNot(Receive())
+		Expect(events).To(HaveLen(1))
+
+		By("advertising the device again once it responds")
+		mockPCI.EXPECT().VerifyDeviceReset(pciBasePath, fakeAddress).Return(nil)
+		dpi.verifyReleasedDevices()
+		Expect(dpi.health).To(Receive(Equal(deviceHealth{DevId: fakeIommuGroup, Health: pluginapi.Healthy})))
+
+		By("verifying the device again once the next VM released it")
+		_, err := dpi.Allocate(context.Background(), &pluginapi.AllocateRequest{
+			ContainerRequests: []*pluginapi.ContainerAllocateRequest{{DevicesIDs: []string{fakeIommuGroup}}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		useAndReleaseDevice()
+		mockPCI.EXPECT().VerifyDeviceReset(pciBasePath, fakeAddress).Return(nil)
+		dpi.verifyReleasedDevices()
+		Expect(dpi.health).To(Receive(Equal(deviceHealth{DevId: fakeIommuGroup, Health: pluginapi.Unhealthy})))
+		Expect(dpi.health).To(Receive(Equal(deviceHealth{DevId: fakeIommuGroup, Health: pluginapi.Healthy})))
+	})
+})
@@ -0,0 +1,67 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package device_manager
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	sgxEPCDeviceName  = "sgx-epc"
+	sgxVEPCDevicePath = "/dev/sgx_vepc"
+	// The EPC of each NUMA node is reported by the kernel since Linux 6.0
+	sgxEPCSizeGlob = "/sys/devices/system/node/node*/x86/sgx_total_bytes"
+	mebibyte       = 1024 * 1024
+)
+
+// NewSGXDevicePlugin exposes the enclave page cache of the node as the sgx-epc resource,
+// with one device per MiB of EPC so that the scheduler accounts for the EPC of the guests.
+// The EPC of the guests is allocated by QEMU through /dev/sgx_vepc.
+func NewSGXDevicePlugin(epcSizeMiB int, permissions string) *GenericDevicePlugin {
+	return NewGenericDevicePlugin(sgxEPCDeviceName, sgxVEPCDevicePath, epcSizeMiB, permissions, false)
+}
+
+// discoverSGXEPCSize returns the size in MiB of the enclave page cache of the node
+func discoverSGXEPCSize(deviceRoot string) int {
+	files, err := filepath.Glob(filepath.Join(deviceRoot, sgxEPCSizeGlob))
+	if err != nil {
+		return 0
+	}
+	var total int64
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			log.Log.Reason(err).Warningf("failed to read the SGX EPC size from %s", file)
+			continue
+		}
+		size, err := strconv.ParseInt(strings.TrimSpace(string(content)), 10, 64)
+		if err != nil {
+			log.Log.Reason(err).Warningf("failed to parse the SGX EPC size from %s", file)
+			continue
+		}
+		total += size
+	}
+	return int(total / mebibyte)
+}
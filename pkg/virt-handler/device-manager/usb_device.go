@@ -27,6 +27,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -49,6 +50,15 @@ var (
 
 var discoverLocalUSBDevicesFunc = discoverPluggedUSBDevices
 
+// usbDevicesSettleTime is how long to wait for the USB device nodes to settle
+// before reporting that USB devices were plugged or unplugged
+var usbDevicesSettleTime = 2 * time.Second
+
+const usbDevicesPath = "/dev/bus/usb"
+
+// anyUSBID is the parsed value of a selector field which matches any device
+const anyUSBID = -1
+
 // The sysfs metadata wrapper for the USB devices
 type USBDevice struct {
 	Name         string
@@ -60,6 +70,8 @@ type USBDevice struct {
 	DeviceNumber int
 	Serial       string
 	DevicePath   string
+	// The class codes of the device and of its interfaces
+	Classes []int
 }
 
 // The uniqueness in the system comes from bus and device number but having the vendor:product
@@ -176,6 +188,20 @@ func (plugin *USBDevicePlugin) setDeviceHealth(usbID string, isHealthy bool) {
 	}
 }
 
+// deviceIDs identifies the plugin devices by the USB devices they hold, as their IDs are
+// randomly generated on each discovery.
+func (plugin *USBDevicePlugin) deviceIDs() []string {
+	ids := make([]string, 0, len(plugin.devices))
+	for _, pd := range plugin.devices {
+		usbIDs := make([]string, 0, len(pd.Devices))
+		for _, usb := range pd.Devices {
+			usbIDs = append(usbIDs, usb.GetID())
+		}
+		ids = append(ids, strings.Join(usbIDs, ","))
+	}
+	return ids
+}
+
 func (plugin *USBDevicePlugin) devicesToKubeVirtDevicePlugin() []*pluginapi.Device {
 	devices := make([]*pluginapi.Device, 0, len(plugin.devices))
 	for _, pluginDevices := range plugin.devices {
@@ -468,11 +494,19 @@ type LocalDevices struct {
 	devices map[int][]*USBDevice
 }
 
-// finds by vendor and product
-func (l *LocalDevices) find(vendor, product int) *USBDevice {
-	if devices, exist := l.devices[vendor]; exist {
-		for _, local := range devices {
-			if local.Product == product {
+// finds a device matching the selector, skipping the given devices
+func (l *LocalDevices) find(selector usbSelector, skip []*USBDevice) *USBDevice {
+	vendors := []int{selector.vendor}
+	if selector.vendor == anyUSBID {
+		vendors = make([]int, 0, len(l.devices))
+		for vendor := range l.devices {
+			vendors = append(vendors, vendor)
+		}
+		slices.Sort(vendors)
+	}
+	for _, vendor := range vendors {
+		for _, local := range l.devices[vendor] {
+			if selector.matches(local) && !slices.Contains(skip, local) {
 				return local
 			}
 		}
@@ -508,13 +542,13 @@ func (l *LocalDevices) fetch(selectors []v1.USBSelector) ([]*USBDevice, bool) {
 
 	// we have to find all devices under this resource name
 	for _, selector := range selectors {
-		vendor, product, err := parseSelector(&selector)
+		usbSelector, err := parseSelector(&selector)
 		if err != nil {
 			log.Log.Reason(err).Warningf("Failed to convert selector: %+v", selector)
 			return nil, false
 		}
 
-		local := l.find(vendor, product)
+		local := l.find(usbSelector, usbdevs)
 		if local == nil {
 			return nil, false
 		}
@@ -545,6 +579,7 @@ func discoverPluggedUSBDevices() *LocalDevices {
 
 		// Get device information
 		if device := parseSysUeventFile(path); device != nil {
+			device.Classes = readUSBClasses(path)
 			usbDevices[device.Vendor] = append(usbDevices[device.Vendor], device)
 		}
 		return nil
@@ -556,20 +591,74 @@ func discoverPluggedUSBDevices() *LocalDevices {
 	return &LocalDevices{devices: usbDevices}
 }
 
-func parseSelector(s *v1.USBSelector) (int, int, error) {
-	val, err := strconv.ParseInt(s.Vendor, 16, 32)
+// readUSBClasses reads the class codes of a USB device and of its interfaces.
+// Most devices, e.g. smart card readers, only set the class of their interfaces.
+func readUSBClasses(path string) []int {
+	classFiles := []string{filepath.Join(path, "bDeviceClass")}
+	interfaceClassFiles, _ := filepath.Glob(filepath.Join(path, filepath.Base(path)+":*", "bInterfaceClass"))
+	classFiles = append(classFiles, interfaceClassFiles...)
+
+	var classes []int
+	for _, classFile := range classFiles {
+		content, err := os.ReadFile(classFile)
+		if err != nil {
+			continue
+		}
+		class, err := strconv.ParseInt(strings.TrimSpace(string(content)), 16, 32)
+		if err != nil {
+			log.Log.Reason(err).V(4).Infof("Skipping malformed USB class in %s", classFile)
+			continue
+		}
+		if !slices.Contains(classes, int(class)) {
+			classes = append(classes, int(class))
+		}
+	}
+	return classes
+}
+
+// usbSelector is a parsed USBSelector, anyUSBID matching any device
+type usbSelector struct {
+	vendor  int
+	product int
+	class   int
+}
+
+func (s usbSelector) matches(dev *USBDevice) bool {
+	return (s.vendor == anyUSBID || s.vendor == dev.Vendor) &&
+		(s.product == anyUSBID || s.product == dev.Product) &&
+		(s.class == anyUSBID || slices.Contains(dev.Classes, s.class))
+}
+
+func parseSelector(s *v1.USBSelector) (usbSelector, error) {
+	vendor, err := parseUSBID(s.Vendor)
 	if err != nil {
-		return -1, -1, err
+		return usbSelector{}, err
 	}
-	vendor := int(val)
 
-	val, err = strconv.ParseInt(s.Product, 16, 32)
+	product, err := parseUSBID(s.Product)
 	if err != nil {
-		return -1, -1, err
+		return usbSelector{}, err
 	}
-	product := int(val)
 
-	return vendor, product, nil
+	class := anyUSBID
+	if s.Class != "" {
+		if class, err = parseUSBID(s.Class); err != nil {
+			return usbSelector{}, err
+		}
+	}
+
+	return usbSelector{vendor: vendor, product: product, class: class}, nil
+}
+
+func parseUSBID(id string) (int, error) {
+	if id == "*" {
+		return anyUSBID, nil
+	}
+	val, err := strconv.ParseInt(id, 16, 32)
+	if err != nil {
+		return anyUSBID, err
+	}
+	return int(val), nil
 }
 
 func discoverAllowedUSBDevices(usbs []v1.USBHostDevice) map[string][]*PluginDevices {
@@ -597,6 +686,53 @@ func discoverAllowedUSBDevices(usbs []v1.USBHostDevice) map[string][]*PluginDevi
 	return plugins
 }
 
+// watchUSBDevices calls onChange once USB devices are plugged or unplugged on the node,
+// which shows by udev creating or removing their device nodes under the given path.
+func watchUSBDevices(path string, stop <-chan struct{}, onChange func()) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to creating a fsnotify watcher: %v", err)
+	}
+	defer watcher.Close()
+
+	// Each bus has its own directory of device nodes
+	if err := watcher.Add(path); err != nil {
+		return fmt.Errorf("failed to watch the USB buses directory %s: %v", path, err)
+	}
+	buses, err := os.ReadDir(path)
+	if err != nil {
+		return fmt.Errorf("failed to list the USB buses: %v", err)
+	}
+	for _, bus := range buses {
+		if err := watcher.Add(filepath.Join(path, bus.Name())); err != nil {
+			return fmt.Errorf("failed to watch the USB bus %s: %v", bus.Name(), err)
+		}
+	}
+
+	var settled <-chan time.Time
+	for {
+		select {
+		case <-stop:
+			return nil
+		case err := <-watcher.Errors:
+			log.Log.Reason(err).Errorf("error watching the USB devices")
+		case event := <-watcher.Events:
+			if !event.Op.Has(fsnotify.Create) && !event.Op.Has(fsnotify.Remove) && !event.Op.Has(fsnotify.Rename) {
+				continue
+			}
+			if event.Op.Has(fsnotify.Create) && filepath.Dir(event.Name) == path {
+				if err := watcher.Add(event.Name); err != nil {
+					log.Log.Reason(err).Errorf("failed to watch the USB bus %s", event.Name)
+				}
+			}
+			settled = time.After(usbDevicesSettleTime)
+		case <-settled:
+			settled = nil
+			onChange()
+		}
+	}
+}
+
 func NewUSBDevicePlugin(resourceName string, pluginDevices []*PluginDevices) *USBDevicePlugin {
 	s := strings.Split(resourceName, "/")
 	resourceID := s[0]
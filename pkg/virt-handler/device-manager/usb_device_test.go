@@ -21,6 +21,9 @@ package device_manager
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -38,6 +41,7 @@ var _ = Describe("USB Device", func() {
 			DeviceNumber: 11,
 			BCD:          0,
 			DevicePath:   "/dev/bus/usb/003/011",
+			Classes:      []int{0x00, 0x0b},
 		},
 		// Two identical devices
 		{
@@ -47,6 +51,7 @@ var _ = Describe("USB Device", func() {
 			DeviceNumber: 7,
 			BCD:          0,
 			DevicePath:   "/dev/bus/usb/004/007",
+			Classes:      []int{0x00, 0x08},
 		},
 		{
 			Vendor:       4321,
@@ -55,6 +60,7 @@ var _ = Describe("USB Device", func() {
 			DeviceNumber: 10,
 			BCD:          0,
 			DevicePath:   "/dev/bus/usb/002/010",
+			Classes:      []int{0x00, 0x08},
 		},
 	}
 
@@ -234,7 +240,141 @@ var _ = Describe("USB Device", func() {
 				},
 			},
 		),
+		Entry("1 resource with 1 selector matching any vendor",
+			[]v1.USBHostDevice{
+				{
+					ResourceName: resourceName1,
+					Selectors: []v1.USBSelector{
+						{
+							Vendor:  "*",
+							Product: fmt.Sprintf("%x", usbs[1].Product),
+						},
+					},
+				},
+			},
+			map[string][]*PluginDevices{
+				resourceName1: {
+					newPluginDevices(resourceName1, 0, []*USBDevice{usbs[1]}),
+					newPluginDevices(resourceName1, 1, []*USBDevice{usbs[2]}),
+				},
+			},
+		),
+		Entry("1 resource with 1 selector matching any product of a vendor",
+			[]v1.USBHostDevice{
+				{
+					ResourceName: resourceName1,
+					Selectors: []v1.USBSelector{
+						{
+							Vendor:  fmt.Sprintf("%x", usbs[0].Vendor),
+							Product: "*",
+						},
+					},
+				},
+			},
+			map[string][]*PluginDevices{
+				resourceName1: {
+					newPluginDevices(resourceName1, 0, []*USBDevice{usbs[0]}),
+				},
+			},
+		),
+		Entry("1 resource with 1 selector matching a device class",
+			[]v1.USBHostDevice{
+				{
+					ResourceName: resourceName1,
+					Selectors: []v1.USBSelector{
+						{
+							Vendor:  "*",
+							Product: "*",
+							Class:   "0b",
+						},
+					},
+				},
+			},
+			map[string][]*PluginDevices{
+				resourceName1: {
+					newPluginDevices(resourceName1, 0, []*USBDevice{usbs[0]}),
+				},
+			},
+		),
+		Entry("Should ignore a selector with a malformed class",
+			[]v1.USBHostDevice{
+				{
+					ResourceName: resourceName1,
+					Selectors: []v1.USBSelector{
+						{
+							Vendor:  "*",
+							Product: "*",
+							Class:   "smartcard",
+						},
+					},
+				},
+			},
+			map[string][]*PluginDevices{},
+		),
 	)
+
+	It("should read the classes of the device and of its interfaces", func() {
+		devicePath := filepath.Join(GinkgoT().TempDir(), "3-1")
+		for file, class := range map[string]string{
+			"bDeviceClass":                  "00\n",
+			"3-1:1.0/bInterfaceClass":       "0b\n",
+			"3-1:1.1/bInterfaceClass":       "03\n",
+			"3-1:1.2/bInterfaceClass":       "0b\n",
+			"3-1:1.3/bInterfaceDescription": "ignored\n",
+		} {
+			Expect(os.MkdirAll(filepath.Dir(filepath.Join(devicePath, file)), 0o755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(devicePath, file), []byte(class), 0o644)).To(Succeed())
+		}
+
+		Expect(readUSBClasses(devicePath)).To(ConsistOf(0x00, 0x0b, 0x03))
+	})
+
+	It("should identify the plugin devices by the USB devices they hold", func() {
+		plugin := &USBDevicePlugin{devices: []*PluginDevices{
+			newPluginDevices(resourceName1, 0, []*USBDevice{usbs[0], usbs[1]}),
+			newPluginDevices(resourceName1, 1, []*USBDevice{usbs[2]}),
+		}}
+
+		Expect(plugin.deviceIDs()).To(Equal([]string{
+			usbs[0].GetID() + "," + usbs[1].GetID(),
+			usbs[2].GetID(),
+		}))
+	})
+
+	It("should notify when USB devices are plugged or unplugged", func() {
+		originalSettleTime := usbDevicesSettleTime
+		defer func() {
+			usbDevicesSettleTime = originalSettleTime
+		}()
+		usbDevicesSettleTime = 10 * time.Millisecond
+
+		usbPath := GinkgoT().TempDir()
+		Expect(os.Mkdir(filepath.Join(usbPath, "001"), 0o755)).To(Succeed())
+
+		stop := make(chan struct{})
+		defer close(stop)
+		changes := make(chan struct{}, 10)
+		go func() {
+			defer GinkgoRecover()
+			Expect(watchUSBDevices(usbPath, stop, func() { changes <- struct{}{} })).To(Succeed())
+		}()
+
+		// The watcher may not be set up yet, keep plugging devices until it notices
+		deviceNumber := 0
+		Eventually(func(g Gomega) {
+			deviceNumber++
+			devicePath := filepath.Join(usbPath, "001", fmt.Sprintf("%03d", deviceNumber))
+			g.Expect(os.WriteFile(devicePath, nil, 0o644)).To(Succeed())
+			g.Eventually(changes, 100*time.Millisecond).Should(Receive())
+		}).Should(Succeed())
+		for len(changes) > 0 {
+			<-changes
+		}
+
+		Expect(os.Remove(filepath.Join(usbPath, "001", "001"))).To(Succeed())
+		Eventually(changes).Should(Receive())
+	})
+
 	It("Should return empty when encountering an error", func() {
 		originalPath := pathToUSBDevices
 		defer func() {
@@ -0,0 +1,293 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package device_manager
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"google.golang.org/grpc"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util"
+	pluginapi "kubevirt.io/kubevirt/pkg/virt-handler/device-manager/deviceplugin/v1beta1"
+)
+
+const (
+	vhostVDPADevicePrefix = "vhost-vdpa-"
+	vhostVDPADevicePath   = "/dev/"
+)
+
+var vdpaBasePath = "/sys/bus/vdpa/devices"
+
+// VDPADevice is a vDPA device bound to the vhost-vdpa bus driver.
+type VDPADevice struct {
+	pciID            string
+	parentPCIAddress string
+	devicePath       string
+	numaNode         int
+}
+
+// VDPADevicePlugin exposes the vhost-vdpa devices of a resource to the virt-launcher pod.
+// The devices are identified by the PCI address of their parent, the same way SR-IOV VFs are,
+// so that the CNI is handed the device allocated to the pod.
+type VDPADevicePlugin struct {
+	*DevicePluginBase
+	pciToDevicePathMap map[string]string
+}
+
+func NewVDPADevicePlugin(vdpaDevices []*VDPADevice, resourceName string) *VDPADevicePlugin {
+	serverSock := SocketPath(strings.Replace(resourceName, "/", "-", -1))
+	pciToDevicePathMap := make(map[string]string)
+
+	var devs []*pluginapi.Device
+	for _, vdpaDevice := range vdpaDevices {
+		pciToDevicePathMap[vdpaDevice.parentPCIAddress] = vdpaDevice.devicePath
+		dpiDev := &pluginapi.Device{
+			ID:     vdpaDevice.parentPCIAddress,
+			Health: pluginapi.Healthy,
+		}
+		if vdpaDevice.numaNode >= 0 {
+			dpiDev.Topology = &pluginapi.TopologyInfo{
+				Nodes: []*pluginapi.NUMANode{{ID: int64(vdpaDevice.numaNode)}},
+			}
+		}
+		devs = append(devs, dpiDev)
+	}
+
+	return &VDPADevicePlugin{
+		DevicePluginBase: &DevicePluginBase{
+			devs:         devs,
+			initialized:  false,
+			lock:         &sync.Mutex{},
+			socketPath:   serverSock,
+			devicePath:   vhostVDPADevicePath,
+			resourceName: resourceName,
+			deviceRoot:   util.HostRootMount,
+			health:       make(chan deviceHealth),
+			done:         make(chan struct{}),
+			deregistered: make(chan struct{}),
+		},
+		pciToDevicePathMap: pciToDevicePathMap,
+	}
+}
+
+func (dpi *VDPADevicePlugin) Start(stop <-chan struct{}) (err error) {
+	logger := log.DefaultLogger()
+	dpi.stop = stop
+
+	err = dpi.cleanup()
+	if err != nil {
+		return err
+	}
+
+	sock, err := net.Listen("unix", dpi.socketPath)
+	if err != nil {
+		return fmt.Errorf("error creating GRPC server socket: %v", err)
+	}
+
+	dpi.server = grpc.NewServer([]grpc.ServerOption{}...)
+	defer dpi.stopDevicePlugin()
+
+	pluginapi.RegisterDevicePluginServer(dpi.server, dpi)
+
+	errChan := make(chan error, 2)
+
+	go func() {
+		errChan <- dpi.server.Serve(sock)
+	}()
+
+	err = waitForGRPCServer(dpi.socketPath, connectionTimeout)
+	if err != nil {
+		return fmt.Errorf("error starting the GRPC server: %v", err)
+	}
+
+	err = dpi.register()
+	if err != nil {
+		return fmt.Errorf("error registering with device plugin manager: %v", err)
+	}
+
+	go func() {
+		errChan <- dpi.healthCheck()
+	}()
+
+	dpi.setInitialized(true)
+	logger.Infof("%s device plugin started", dpi.resourceName)
+	err = <-errChan
+
+	return err
+}
+
+func (dpi *VDPADevicePlugin) Allocate(_ context.Context, r *pluginapi.AllocateRequest) (*pluginapi.AllocateResponse, error) {
+	resourceNameEnvVar := util.ResourceNameToEnvVar(v1.VDPAResourcePrefix, dpi.resourceName)
+	resp := new(pluginapi.AllocateResponse)
+
+	for _, request := range r.ContainerRequests {
+		var allocatedDevices []string
+		deviceSpecs := make([]*pluginapi.DeviceSpec, 0)
+		for _, devID := range request.DevicesIDs {
+			devicePath, exist := dpi.pciToDevicePathMap[devID]
+			if !exist {
+				continue
+			}
+
+			// virt-handler hands the device node of the pod to the unprivileged launcher, the node on the host keeps its owner
+			allocatedDevices = append(allocatedDevices, devicePath)
+			deviceSpecs = append(deviceSpecs, &pluginapi.DeviceSpec{
+				HostPath:      devicePath,
+				ContainerPath: devicePath,
+				Permissions:   "rw",
+			})
+		}
+		resp.ContainerResponses = append(resp.ContainerResponses, &pluginapi.ContainerAllocateResponse{
+			Devices: deviceSpecs,
+			Envs:    map[string]string{resourceNameEnvVar: strings.Join(allocatedDevices, ",")},
+		})
+	}
+	return resp, nil
+}
+
+func (dpi *VDPADevicePlugin) healthCheck() error {
+	logger := log.DefaultLogger()
+	monitoredDevices := make(map[string]string)
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to creating a fsnotify watcher: %v", err)
+	}
+	defer watcher.Close()
+
+	// This way we don't have to mount /dev from the node
+	devicePath := filepath.Join(dpi.deviceRoot, dpi.devicePath)
+
+	// Start watching the files before we check for their existence to avoid races
+	err = watcher.Add(devicePath)
+	if err != nil {
+		return fmt.Errorf("failed to add the device root path to the watcher: %v", err)
+	}
+
+	for _, dev := range dpi.devs {
+		vhostVDPADevice := filepath.Join(dpi.deviceRoot, dpi.pciToDevicePathMap[dev.ID])
+		if _, err := os.Stat(vhostVDPADevice); err != nil {
+			logger.Reason(err).Warningf("device '%s' is not present, the device plugin can't expose it.", vhostVDPADevice)
+			dpi.health <- deviceHealth{DevId: dev.ID, Health: pluginapi.Unhealthy}
+		}
+		monitoredDevices[vhostVDPADevice] = dev.ID
+	}
+
+	dirName := filepath.Dir(dpi.socketPath)
+	err = watcher.Add(dirName)
+	if err != nil {
+		return fmt.Errorf("failed to add the device-plugin kubelet path to the watcher: %v", err)
+	}
+	_, err = os.Stat(dpi.socketPath)
+	if err != nil {
+		return fmt.Errorf("failed to stat the device-plugin socket: %v", err)
+	}
+
+	for {
+		select {
+		case <-dpi.stop:
+			return nil
+		case err := <-watcher.Errors:
+			logger.Reason(err).Errorf("error watching devices and device plugin directory")
+		case event := <-watcher.Events:
+			logger.V(4).Infof("health Event: %v", event)
+			if monDevId, exist := monitoredDevices[event.Name]; exist {
+				// Health in this case is if the device path actually exists
+				if event.Op == fsnotify.Create {
+					logger.Infof("monitored device %s appeared", event.Name)
+					dpi.health <- deviceHealth{DevId: monDevId, Health: pluginapi.Healthy}
+				} else if (event.Op == fsnotify.Remove) || (event.Op == fsnotify.Rename) {
+					logger.Infof("monitored device %s disappeared", event.Name)
+					dpi.health <- deviceHealth{DevId: monDevId, Health: pluginapi.Unhealthy}
+				}
+			} else if event.Name == dpi.socketPath && event.Op == fsnotify.Remove {
+				logger.Infof("device socket file for device %s was removed, kubelet probably restarted.", dpi.resourceName)
+				return nil
+			}
+		}
+	}
+}
+
+// discoverPermittedHostVDPADevices returns the vDPA devices bound to the vhost-vdpa driver,
+// indexed by resource name, whose parent PCI device matches one of the permitted vendor selectors.
+func discoverPermittedHostVDPADevices(supportedVDPADeviceMap map[string]string) map[string][]*VDPADevice {
+	vdpaDevicesMap := make(map[string][]*VDPADevice)
+	files, err := os.ReadDir(vdpaBasePath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.DefaultLogger().Reason(err).Errorf("failed to discover vDPA devices")
+		}
+		return vdpaDevicesMap
+	}
+	for _, info := range files {
+		// e.g. /sys/bus/vdpa/devices/vdpa0 -> ../../../devices/pci0000:00/0000:00:02.0/0000:65:00.2/vdpa0
+		vdpaDevicePath, err := filepath.EvalSymlinks(filepath.Join(vdpaBasePath, info.Name()))
+		if err != nil {
+			log.DefaultLogger().Reason(err).Errorf("failed to resolve the vDPA device: %s", info.Name())
+			continue
+		}
+		parentPCIAddress := filepath.Base(filepath.Dir(vdpaDevicePath))
+		pciID, err := handler.GetDevicePCIID(pciBasePath, parentPCIAddress)
+		if err != nil {
+			log.DefaultLogger().Reason(err).Errorf("failed get vendor:device ID for the parent of vDPA device: %s", info.Name())
+			continue
+		}
+		resourceName, supported := supportedVDPADeviceMap[pciID]
+		if !supported {
+			continue
+		}
+		vhostVDPADevice, err := getVhostVDPADevice(vdpaDevicePath)
+		if err != nil {
+			log.DefaultLogger().Reason(err).Infof("skipping vDPA device %s", info.Name())
+			continue
+		}
+		vdpaDevicesMap[resourceName] = append(vdpaDevicesMap[resourceName], &VDPADevice{
+			pciID:            pciID,
+			parentPCIAddress: parentPCIAddress,
+			devicePath:       filepath.Join(vhostVDPADevicePath, vhostVDPADevice),
+			numaNode:         handler.GetDeviceNumaNode(pciBasePath, parentPCIAddress),
+		})
+	}
+	return vdpaDevicesMap
+}
+
+// getVhostVDPADevice returns the name of the vhost-vdpa character device of a vDPA device,
+// which only exists when the device is bound to the vhost-vdpa bus driver.
+func getVhostVDPADevice(vdpaDevicePath string) (string, error) {
+	files, err := os.ReadDir(vdpaDevicePath)
+	if err != nil {
+		return "", err
+	}
+	for _, file := range files {
+		if strings.HasPrefix(file.Name(), vhostVDPADevicePrefix) {
+			return file.Name(), nil
+		}
+	}
+	return "", fmt.Errorf("the device is not bound to the vhost-vdpa driver")
+}
@@ -0,0 +1,123 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package device_manager
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util"
+	pluginapi "kubevirt.io/kubevirt/pkg/virt-handler/device-manager/deviceplugin/v1beta1"
+)
+
+var _ = Describe("vDPA device", func() {
+	const (
+		fakeVDPAResourceName = "example.org/vdpa"
+		fakeVDPAPCIID        = "15b3:101e"
+		fakeVDPAParent       = "0000:65:00.2"
+		fakeUnboundParent    = "0000:65:00.3"
+		fakeVDPANumaNode     = 1
+	)
+
+	var (
+		mockPCI          *MockDeviceHandler
+		originalBasePath string
+	)
+
+	createVDPADevice := func(sysfsRoot, name, parentPCIAddress string, vhostBound bool) {
+		devicePath := filepath.Join(sysfsRoot, "devices", parentPCIAddress, name)
+		Expect(os.MkdirAll(devicePath, 0700)).To(Succeed())
+		if vhostBound {
+			Expect(os.Mkdir(filepath.Join(devicePath, vhostVDPADevicePrefix+"0"), 0700)).To(Succeed())
+		}
+		Expect(os.Symlink(devicePath, filepath.Join(vdpaBasePath, name))).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		sysfsRoot := GinkgoT().TempDir()
+		originalBasePath = vdpaBasePath
+		vdpaBasePath = filepath.Join(sysfsRoot, "bus", "vdpa", "devices")
+		Expect(os.MkdirAll(vdpaBasePath, 0700)).To(Succeed())
+		DeferCleanup(func() { vdpaBasePath = originalBasePath })
+
+		createVDPADevice(sysfsRoot, "vdpa0", fakeVDPAParent, true)
+		createVDPADevice(sysfsRoot, "vdpa1", fakeUnboundParent, false)
+
+		mockPCI = NewMockDeviceHandler(gomock.NewController(GinkgoT()))
+		handler = mockPCI
+		mockPCI.EXPECT().GetDevicePCIID(pciBasePath, fakeVDPAParent).Return(fakeVDPAPCIID, nil).AnyTimes()
+		mockPCI.EXPECT().GetDevicePCIID(pciBasePath, fakeUnboundParent).Return(fakeVDPAPCIID, nil).AnyTimes()
+		mockPCI.EXPECT().GetDeviceNumaNode(pciBasePath, fakeVDPAParent).Return(fakeVDPANumaNode).AnyTimes()
+	})
+
+	It("should discover the permitted vDPA devices bound to the vhost-vdpa driver", func() {
+		devices := discoverPermittedHostVDPADevices(map[string]string{fakeVDPAPCIID: fakeVDPAResourceName})
+		Expect(devices).To(Equal(map[string][]*VDPADevice{
+			fakeVDPAResourceName: {{
+				pciID:            fakeVDPAPCIID,
+				parentPCIAddress: fakeVDPAParent,
+				devicePath:       "/dev/vhost-vdpa-0",
+				numaNode:         fakeVDPANumaNode,
+			}},
+		}))
+	})
+
+	It("should not discover vDPA devices which are not permitted", func() {
+		Expect(discoverPermittedHostVDPADevices(map[string]string{"dead:beef": fakeVDPAResourceName})).To(BeEmpty())
+	})
+
+	It("should expose the devices by their parent PCI address", func() {
+		dpi := NewVDPADevicePlugin(discoverPermittedHostVDPADevices(
+			map[string]string{fakeVDPAPCIID: fakeVDPAResourceName})[fakeVDPAResourceName], fakeVDPAResourceName)
+		Expect(dpi.devs).To(HaveLen(1))
+		Expect(dpi.devs[0].ID).To(Equal(fakeVDPAParent))
+		Expect(dpi.devs[0].Topology.Nodes[0].ID).To(Equal(int64(fakeVDPANumaNode)))
+	})
+
+	It("should mount the allocated device and advertise its path", func() {
+		const devicePath = "/dev/vhost-vdpa-0"
+		dpi := NewVDPADevicePlugin([]*VDPADevice{{
+			pciID:            fakeVDPAPCIID,
+			parentPCIAddress: fakeVDPAParent,
+			devicePath:       devicePath,
+			numaNode:         -1,
+		}}, fakeVDPAResourceName)
+
+		response, err := dpi.Allocate(context.Background(), &pluginapi.AllocateRequest{
+			ContainerRequests: []*pluginapi.ContainerAllocateRequest{{DevicesIDs: []string{fakeVDPAParent}}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.ContainerResponses).To(HaveLen(1))
+		Expect(response.ContainerResponses[0].Devices).To(ConsistOf(&pluginapi.DeviceSpec{
+			HostPath:      devicePath,
+			ContainerPath: devicePath,
+			Permissions:   "rw",
+		}))
+		Expect(response.ContainerResponses[0].Envs).To(HaveKeyWithValue(
+			util.ResourceNameToEnvVar(v1.VDPAResourcePrefix, fakeVDPAResourceName), devicePath))
+	})
+})
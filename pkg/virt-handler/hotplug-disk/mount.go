@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"syscall"
 
@@ -37,9 +38,11 @@ import (
 
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
+	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+	"kubevirt.io/kubevirt/pkg/virt-handler/selinux"
 
 	"github.com/opencontainers/runc/libcontainer/configs"
 
@@ -54,6 +57,7 @@ import (
 
 const (
 	unableFindHotplugMountedDir = "unable to find hotplug mounted directories for vmi without uid"
+	selinuxXattr                = "security.selinux"
 )
 
 var (
@@ -64,6 +68,10 @@ var (
 		return safepath.JoinAndResolveWithRelativeRoot("/proc/1/root", kubeletPodsDir, fmt.Sprintf("/%s/volumes/kubernetes.io~empty-dir/hotplug-disks", string(podUID)))
 	}
 
+	prHelperSocketDir = func(socketPath string) (*safepath.Path, error) {
+		return safepath.JoinAndResolveWithRelativeRoot("/proc/1/root", filepath.Dir(socketPath))
+	}
+
 	socketPath = func(podUID types.UID) string {
 		return fmt.Sprintf("/pods/%s/volumes/kubernetes.io~empty-dir/hotplug-disks/hp.sock", string(podUID))
 	}
@@ -132,6 +140,18 @@ var (
 		return isolation.IsBlockDevice(path)
 	}
 
+	getSELinuxLabel = func(path *safepath.Path) (string, error) {
+		label, err := safepath.GetxattrNoFollow(path, selinuxXattr)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(string(label), "\x00"), nil
+	}
+
+	relabelCommand = func(path *safepath.Path, label string) error {
+		return selinux.RelabelFiles(label, false, path)
+	}
+
 	isolationDetector = func() isolation.PodIsolationDetector {
 		return isolation.NewSocketBasedIsolationDetector()
 	}
@@ -154,6 +174,7 @@ type volumeMounter struct {
 	ownershipManager   diskutils.OwnershipManagerInterface
 	kubeletPodsDir     string
 	host               string
+	prHelperSocketPath string
 }
 
 // VolumeMounter is the interface used to mount and unmount volumes to/from a running virtlauncher pod.
@@ -179,7 +200,7 @@ type vmiMountTargetRecord struct {
 }
 
 // NewVolumeMounter creates a new VolumeMounter
-func NewVolumeMounter(mountStateDir string, kubeletPodsDir string, host string) VolumeMounter {
+func NewVolumeMounter(mountStateDir string, kubeletPodsDir string, host string, prHelperSocketPath string) VolumeMounter {
 	return &volumeMounter{
 		mountRecords:       make(map[types.UID]*vmiMountTargetRecord),
 		checkpointManager:  checkpoint.NewSimpleCheckpointManager(mountStateDir),
@@ -187,6 +208,7 @@ func NewVolumeMounter(mountStateDir string, kubeletPodsDir string, host string)
 		ownershipManager:   diskutils.DefaultOwnershipManager,
 		kubeletPodsDir:     kubeletPodsDir,
 		host:               host,
+		prHelperSocketPath: prHelperSocketPath,
 	}
 }
 
@@ -352,6 +374,45 @@ func (m *volumeMounter) mountFromPod(vmi *v1.VirtualMachineInstance, sourceUID t
 			return err
 		}
 	}
+	if reservation.HasVMIHotplugPersistentReservation(vmi) {
+		if err := m.mountPrHelperSocketDir(vmi, record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mountPrHelperSocketDir bind mounts the pr-helper socket directory of the node into the hotplug disks directory of
+// the virt-launcher pod. The pod only gets the pr-helper device when a LUN requests a reservation at start.
+func (m *volumeMounter) mountPrHelperSocketDir(vmi *v1.VirtualMachineInstance, record *vmiMountTargetRecord) error {
+	virtlauncherUID := m.findVirtlauncherUID(vmi)
+	if virtlauncherUID == "" {
+		// This is not the node the pod is running on.
+		return nil
+	}
+	target, err := m.hotplugDiskManager.GetFileSystemDirectoryTargetPathFromHostView(virtlauncherUID, reservation.GetHotplugPrHelperDir(), true)
+	if err != nil {
+		return err
+	}
+
+	isMounted, err := isMounted(target)
+	if err != nil {
+		return fmt.Errorf("failed to determine if %s is already mounted: %v", target, err)
+	}
+	if isMounted {
+		return nil
+	}
+	sourcePath, err := prHelperSocketDir(m.prHelperSocketPath)
+	if err != nil {
+		return fmt.Errorf("failed to find the pr-helper socket directory: %v", err)
+	}
+	if err := m.writePathToMountRecord(unsafepath.UnsafeAbsolute(target.Raw()), vmi, record); err != nil {
+		return err
+	}
+	if out, err := mountCommand(sourcePath, target); err != nil {
+		return fmt.Errorf("failed to bindmount the pr-helper socket directory from %v to %v: %v : %v", sourcePath, target, string(out), err)
+	}
+	log.DefaultLogger().V(1).Infof("successfully mounted the pr-helper socket directory")
 	return nil
 }
 
@@ -441,6 +502,9 @@ func (m *volumeMounter) mountBlockHotplugVolume(
 	} else if !isBlockExists {
 		return fmt.Errorf("target device %v exists but it is not a block device", devicePath)
 	}
+	if err := m.relabelBlockDevice(targetPath, devicePath); err != nil {
+		return err
+	}
 
 	dev, _, err := m.getBlockFileMajorMinor(devicePath, statDevice)
 	if err != nil {
@@ -454,6 +518,26 @@ func (m *volumeMounter) mountBlockHotplugVolume(
 	return m.ownershipManager.SetFileOwnership(devicePath)
 }
 
+// relabelBlockDevice gives the device node the SELinux context of the hotplug directory of
+// virt-launcher. The type transitions of custom policies may label the node otherwise on
+// creation, which denies QEMU access to the device.
+func (m *volumeMounter) relabelBlockDevice(targetPath, devicePath *safepath.Path) error {
+	label, err := getSELinuxLabel(targetPath)
+	if errors.Is(err, unix.ENODATA) || errors.Is(err, unix.ENOTSUP) {
+		// SELinux is disabled on the node
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get the SELinux context of %v: %v", targetPath, err)
+	}
+	if currentLabel, err := getSELinuxLabel(devicePath); err == nil && currentLabel == label {
+		return nil
+	}
+	if err := relabelCommand(devicePath, label); err != nil {
+		return fmt.Errorf("failed to relabel block device %v: %v", devicePath, err)
+	}
+	return nil
+}
+
 func (m *volumeMounter) getSourceMajorMinor(sourceUID types.UID, volumeName string) (uint64, os.FileMode, error) {
 	basePath, err := deviceBasePath(sourceUID, m.kubeletPodsDir)
 	if err != nil {
@@ -716,6 +800,14 @@ func (m *volumeMounter) Unmount(vmi *v1.VirtualMachineInstance, cgroupManager cg
 			}
 			currentHotplugPaths[unsafepath.UnsafeAbsolute(path.Raw())] = virtlauncherUID
 		}
+		if reservation.HasVMIHotplugPersistentReservation(vmi) {
+			path, err := m.hotplugDiskManager.GetFileSystemDirectoryTargetPathFromHostView(virtlauncherUID, reservation.GetHotplugPrHelperDir(), false)
+			if err == nil {
+				currentHotplugPaths[unsafepath.UnsafeAbsolute(path.Raw())] = virtlauncherUID
+			} else if !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+		}
 		newRecord := vmiMountTargetRecord{
 			MountTargetEntries: make([]vmiMountTargetEntry, 0),
 		}
@@ -78,6 +78,9 @@ var (
 	orgFindMntByDevice     = findMntByDevice
 	orgNodeIsolationResult = nodeIsolationResult
 	orgParentPathForMount  = parentPathForMount
+	orgGetSELinuxLabel     = getSELinuxLabel
+	orgRelabelCommand      = relabelCommand
+	orgPrHelperSocketDir   = prHelperSocketDir
 )
 
 var _ = Describe("HotplugVolume", func() {
@@ -286,7 +289,12 @@ var _ = Describe("HotplugVolume", func() {
 			statSourceDevice = func(fileName *safepath.Path) (os.FileInfo, error) {
 				return fakeStat(true, 0777, 123456), nil
 			}
-
+			getSELinuxLabel = func(_ *safepath.Path) (string, error) {
+				return "", unix.ENODATA
+			}
+			relabelCommand = func(_ *safepath.Path, _ string) error {
+				return fmt.Errorf("unexpected relabel")
+			}
 		})
 
 		AfterEach(func() {
@@ -295,6 +303,8 @@ var _ = Describe("HotplugVolume", func() {
 			mknodCommand = orgMknodCommand
 			isBlockDevice = orgIsBlockDevice
 			nodeIsolationResult = orgNodeIsolationResult
+			getSELinuxLabel = orgGetSELinuxLabel
+			relabelCommand = orgRelabelCommand
 		})
 
 		It("isBlockVolume should determine if we have a block volume", func() {
@@ -413,6 +423,58 @@ var _ = Describe("HotplugVolume", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		Context("relabelBlockDevice", func() {
+			const launcherLabel = "system_u:object_r:container_file_t:s0:c1,c2"
+			var targetPath, devicePath *safepath.Path
+			var relabeled []string
+
+			BeforeEach(func() {
+				targetPath, err = newDir(tempDir, "target")
+				Expect(err).ToNot(HaveOccurred())
+				devicePath, err = newFile(tempDir, "target", "device")
+				Expect(err).ToNot(HaveOccurred())
+				relabeled = nil
+				relabelCommand = func(_ *safepath.Path, label string) error {
+					relabeled = append(relabeled, label)
+					return nil
+				}
+			})
+
+			labels := func(targetLabel, deviceLabel string) func(*safepath.Path) (string, error) {
+				return func(path *safepath.Path) (string, error) {
+					if path == targetPath {
+						return targetLabel, nil
+					}
+					return deviceLabel, nil
+				}
+			}
+
+			It("should give the device the context of the hotplug directory", func() {
+				getSELinuxLabel = labels(launcherLabel, "system_u:object_r:fixed_disk_device_t:s0")
+				Expect(m.relabelBlockDevice(targetPath, devicePath)).To(Succeed())
+				Expect(relabeled).To(ConsistOf(launcherLabel))
+			})
+
+			It("should not relabel a device which has the context already", func() {
+				getSELinuxLabel = labels(launcherLabel, launcherLabel)
+				Expect(m.relabelBlockDevice(targetPath, devicePath)).To(Succeed())
+				Expect(relabeled).To(BeEmpty())
+			})
+
+			It("should not relabel when SELinux is disabled", func() {
+				Expect(m.relabelBlockDevice(targetPath, devicePath)).To(Succeed())
+				Expect(relabeled).To(BeEmpty())
+			})
+
+			It("should fail when the relabeling fails", func() {
+				getSELinuxLabel = labels(launcherLabel, "")
+				relabelCommand = func(_ *safepath.Path, _ string) error {
+					return fmt.Errorf("permission denied")
+				}
+				Expect(m.relabelBlockDevice(targetPath, devicePath)).To(MatchError(ContainSubstring("permission denied")))
+			})
+		})
+
 		It("getSourceMajorMinor should return an error if no uid", func() {
 			vmi.UID = ""
 			_, _, err := m.getSourceMajorMinor("fghij", "invalid")
@@ -595,6 +657,7 @@ var _ = Describe("HotplugVolume", func() {
 			unmountCommand = orgUnMountCommand
 			isMounted = orgIsMounted
 			isolationDetector = orgIsoDetector
+			prHelperSocketDir = orgPrHelperSocketDir
 		})
 
 		It("getSourcePodFile should find the disk.img file, if it exists", func() {
@@ -694,6 +757,49 @@ var _ = Describe("HotplugVolume", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should mount the pr-helper socket directory for hotplugged LUNs requesting a reservation", func() {
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{{
+				Name:       "lun0",
+				DiskDevice: v1.DiskDevice{LUN: &v1.LunTarget{Bus: v1.DiskBusSCSI, Reservation: true}},
+			}}
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{{Name: "lun0", HotplugVolume: &v1.HotplugVolumeStatus{}}}
+			prDir, err := newDir(tempDir, "pr")
+			Expect(err).ToNot(HaveOccurred())
+			prHelperSocketDir = func(string) (*safepath.Path, error) {
+				return prDir, nil
+			}
+			isMounted = func(path *safepath.Path) (bool, error) {
+				return false, nil
+			}
+			var target *safepath.Path
+			mountCommand = func(sourcePath, targetPath *safepath.Path) ([]byte, error) {
+				Expect(sourcePath).To(Equal(prDir))
+				target = targetPath
+				return []byte("Success"), nil
+			}
+
+			Expect(m.mountFromPod(vmi, "", cgroupManagerMock)).To(Succeed())
+			Expect(target).ToNot(BeNil())
+			Expect(unsafepath.UnsafeAbsolute(target.Raw())).To(Equal(filepath.Join(unsafepath.UnsafeAbsolute(targetPodPath.Raw()), ".pr-helper")))
+			record, err := m.getMountTargetRecord(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(record.MountTargetEntries).To(ConsistOf(vmiMountTargetEntry{TargetFile: unsafepath.UnsafeAbsolute(target.Raw())}))
+		})
+
+		It("should not mount the pr-helper socket directory for LUNs requesting a reservation at start", func() {
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{{
+				Name:       "lun0",
+				DiskDevice: v1.DiskDevice{LUN: &v1.LunTarget{Bus: v1.DiskBusSCSI, Reservation: true}},
+			}}
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{{Name: "lun0"}}
+			mountCommand = func(sourcePath, targetPath *safepath.Path) ([]byte, error) {
+				Fail("unexpected mount")
+				return nil, nil
+			}
+
+			Expect(m.mountFromPod(vmi, "", cgroupManagerMock)).To(Succeed())
+		})
+
 		It("unmountFileSystemHotplugVolumes should return error if isMounted returns error", func() {
 			testPath, err := newFile(tempDir, "test")
 			Expect(err).ToNot(HaveOccurred())
@@ -68,13 +68,13 @@ type MultipathSocketMonitor struct {
 	done                chan struct{}
 }
 
-func NewMultipathSocketMonitor() *MultipathSocketMonitor {
+func NewMultipathSocketMonitor(prHelperSocketPath string) *MultipathSocketMonitor {
 	const procRootRun = "/proc/1/root/run"
 	multipathSocketPath := filepath.Join(procRootRun, socket)
 	return &MultipathSocketMonitor{
 		Watcher:             filewatcher.New(multipathSocketPath, 1*time.Second),
 		MultipathSocketPath: multipathSocketPath,
-		HostDir:             reservation.GetPrHelperHostSocketDir(),
+		HostDir:             reservation.GetPrHelperHostSocketDir(prHelperSocketPath),
 		Mounter:             &mountManager{},
 	}
 }
@@ -0,0 +1,206 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+)
+
+const nodeSysfsPath = "/sys/devices/system/node"
+
+// nodeGuardrailError is returned when starting a VMI would violate the node guardrails.
+// It is irrecoverable on this node, the VMI is failed so that it can be rescheduled.
+type nodeGuardrailError struct {
+	msg string
+}
+
+func (e *nodeGuardrailError) Error() string { return e.msg }
+
+func newNodeGuardrailError(format string, args ...interface{}) *nodeGuardrailError {
+	return &nodeGuardrailError{msg: fmt.Sprintf(format, args...)}
+}
+
+// checkNodeGuardrails verifies, before the domain is defined, that the VMI can be started on this
+// node without breaching the configured node guardrails.
+func (c *VirtualMachineController) checkNodeGuardrails(vmi *v1.VirtualMachineInstance, cgroupManager cgroup.Manager) error {
+	guardrails := c.clusterConfig.GetConfig().NodeGuardrails
+	if guardrails == nil {
+		return nil
+	}
+
+	if guardrails.MaxVirtualMachineInstances != nil {
+		if err := checkVMIDensity(vmi, c.vmiStore.List(), *guardrails.MaxVirtualMachineInstances); err != nil {
+			return err
+		}
+	}
+
+	if vmi.IsCPUDedicated() {
+		cpuSet, err := cgroupManager.GetCpuSet()
+		if err != nil {
+			return err
+		}
+		cpus, err := hardware.ParseCPUSetLine(strings.TrimSpace(cpuSet), 50000)
+		if err != nil {
+			return err
+		}
+		if err := checkDedicatedCPUs(vmi, len(cpus)); err != nil {
+			return err
+		}
+	}
+
+	if guardrails.MinHugepagesLocalityPercent != nil {
+		if err := checkHugepagesFragmentation(vmi, nodeSysfsPath, *guardrails.MinHugepagesLocalityPercent); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkVMIDensity(vmi *v1.VirtualMachineInstance, vmis []interface{}, maxVMIs uint32) error {
+	var running uint32
+	for _, obj := range vmis {
+		other, ok := obj.(*v1.VirtualMachineInstance)
+		if !ok || other.UID == vmi.UID {
+			continue
+		}
+		if other.IsRunning() {
+			running++
+		}
+	}
+	if running >= maxVMIs {
+		return newNodeGuardrailError("node already runs %d VirtualMachineInstances, the maximum allowed is %d", running, maxVMIs)
+	}
+	return nil
+}
+
+// dedicatedCPUsRequired returns the amount of dedicated CPUs needed for the vCPUs,
+// the isolated emulator thread and the supplemental IOThreads pool of the VMI.
+func dedicatedCPUsRequired(vmi *v1.VirtualMachineInstance) (vcpus, emulator, iothreads int64) {
+	vcpus = hardware.GetNumberOfVCPUs(vmi.Spec.Domain.CPU)
+	if vmi.Spec.Domain.IOThreads != nil && vmi.Spec.Domain.IOThreads.SupplementalPoolThreadCount != nil {
+		iothreads = int64(*vmi.Spec.Domain.IOThreads.SupplementalPoolThreadCount)
+	}
+	if vmi.Spec.Domain.CPU.IsolateEmulatorThread {
+		emulator = 1
+		if _, exists := vmi.Annotations[v1.EmulatorThreadCompleteToEvenParity]; exists && vcpus%2 == 0 {
+			emulator = 2
+		}
+	}
+	return vcpus, emulator, iothreads
+}
+
+func checkDedicatedCPUs(vmi *v1.VirtualMachineInstance, available int) error {
+	vcpus, emulator, iothreads := dedicatedCPUsRequired(vmi)
+	if required := vcpus + emulator + iothreads; int64(available) < required {
+		return newNodeGuardrailError(
+			"%d dedicated CPUs are required (%d vCPUs, %d emulator thread CPUs, %d IOThread CPUs) but only %d are assigned",
+			required, vcpus, emulator, iothreads, available)
+	}
+	return nil
+}
+
+func vmiGuestMemory(vmi *v1.VirtualMachineInstance) *resource.Quantity {
+	if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Guest != nil {
+		return vmi.Spec.Domain.Memory.Guest
+	}
+	if memory, exists := vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceMemory]; exists {
+		return &memory
+	}
+	return nil
+}
+
+func checkHugepagesFragmentation(vmi *v1.VirtualMachineInstance, sysfsNodePath string, minLocalityPercent uint32) error {
+	if vmi.Spec.Domain.Memory == nil || vmi.Spec.Domain.Memory.Hugepages == nil {
+		return nil
+	}
+	memory := vmiGuestMemory(vmi)
+	if memory == nil {
+		return nil
+	}
+	pageSize, err := resource.ParseQuantity(vmi.Spec.Domain.Memory.Hugepages.PageSize)
+	if err != nil {
+		return fmt.Errorf("failed to parse hugepages size: %v", err)
+	}
+	pageSizeBytes := pageSize.Value()
+	if pageSizeBytes <= 0 {
+		return nil
+	}
+	requiredPages := (memory.Value() + pageSizeBytes - 1) / pageSizeBytes
+
+	freePerNode, err := freeHugepagesPerNUMANode(sysfsNodePath, pageSizeBytes/1024)
+	if err != nil {
+		return err
+	}
+
+	var total, largest int64
+	for _, free := range freePerNode {
+		total += free
+		if free > largest {
+			largest = free
+		}
+	}
+	if total < requiredPages {
+		return newNodeGuardrailError("%d hugepages of size %s are required but only %d are free on the node",
+			requiredPages, vmi.Spec.Domain.Memory.Hugepages.PageSize, total)
+	}
+	if largest*100 < requiredPages*int64(minLocalityPercent) {
+		return newNodeGuardrailError(
+			"hugepages are too fragmented: at most %d of the %d required hugepages of size %s are free on a single NUMA node, at least %d%% are expected",
+			largest, requiredPages, vmi.Spec.Domain.Memory.Hugepages.PageSize, minLocalityPercent)
+	}
+	return nil
+}
+
+// freeHugepagesPerNUMANode reads the amount of free hugepages of the given size from the
+// per NUMA node sysfs entries.
+func freeHugepagesPerNUMANode(sysfsNodePath string, pageSizeKiB int64) (map[string]int64, error) {
+	freeFiles, err := filepath.Glob(filepath.Join(sysfsNodePath, "node*", "hugepages",
+		fmt.Sprintf("hugepages-%dkB", pageSizeKiB), "free_hugepages"))
+	if err != nil {
+		return nil, err
+	}
+	freePerNode := map[string]int64{}
+	for _, freeFile := range freeFiles {
+		content, err := os.ReadFile(freeFile)
+		if err != nil {
+			return nil, err
+		}
+		free, err := strconv.ParseInt(strings.TrimSpace(string(content)), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", freeFile, err)
+		}
+		node := filepath.Base(filepath.Dir(filepath.Dir(filepath.Dir(freeFile))))
+		freePerNode[node] = free
+	}
+	return freePerNode, nil
+}
@@ -0,0 +1,141 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/libvmi/status"
+)
+
+var _ = Describe("Node guardrails", func() {
+
+	Context("VMI density", func() {
+		newRunningVMI := func(uid types.UID) interface{} {
+			return libvmi.New(libvmi.WithUID(uid), status.WithStatus(status.New(status.WithPhase(v1.Running))))
+		}
+
+		It("should allow the VMI when the maximum is not reached", func() {
+			vmi := libvmi.New(libvmi.WithUID("starting"))
+			vmis := []interface{}{newRunningVMI("a"), vmi}
+			Expect(checkVMIDensity(vmi, vmis, 2)).To(Succeed())
+		})
+
+		It("should ignore VMIs which are not running", func() {
+			vmi := libvmi.New(libvmi.WithUID("starting"))
+			vmis := []interface{}{newRunningVMI("a"), libvmi.New(libvmi.WithUID("b")), vmi}
+			Expect(checkVMIDensity(vmi, vmis, 2)).To(Succeed())
+		})
+
+		It("should refuse the VMI when the maximum is reached", func() {
+			vmi := libvmi.New(libvmi.WithUID("starting"))
+			vmis := []interface{}{newRunningVMI("a"), newRunningVMI("b"), vmi}
+			err := checkVMIDensity(vmi, vmis, 2)
+			Expect(err).To(BeAssignableToTypeOf(&nodeGuardrailError{}))
+			Expect(err).To(MatchError("node already runs 2 VirtualMachineInstances, the maximum allowed is 2"))
+		})
+	})
+
+	Context("dedicated CPUs", func() {
+		DescribeTable("should check the assigned CPUs", func(vmi *v1.VirtualMachineInstance, available int, expectedErr string) {
+			err := checkDedicatedCPUs(vmi, available)
+			if expectedErr == "" {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(expectedErr))
+			}
+		},
+			Entry("with enough CPUs for the vCPUs",
+				libvmi.New(libvmi.WithCPUCount(4, 1, 1), libvmi.WithDedicatedCPUPlacement()), 4, ""),
+			Entry("with enough CPUs for the vCPUs and the emulator thread",
+				libvmi.New(libvmi.WithCPUCount(4, 1, 1), libvmi.WithDedicatedCPUPlacement(), libvmi.WithIsolateEmulatorThread()), 5, ""),
+			Entry("without a CPU left for the emulator thread",
+				libvmi.New(libvmi.WithCPUCount(4, 1, 1), libvmi.WithDedicatedCPUPlacement(), libvmi.WithIsolateEmulatorThread()), 4,
+				"5 dedicated CPUs are required (4 vCPUs, 1 emulator thread CPUs, 0 IOThread CPUs) but only 4 are assigned"),
+			Entry("without a second CPU for the emulator thread when completing to even parity",
+				libvmi.New(libvmi.WithCPUCount(4, 1, 1), libvmi.WithDedicatedCPUPlacement(), libvmi.WithIsolateEmulatorThread(),
+					libvmi.WithAnnotation(v1.EmulatorThreadCompleteToEvenParity, "")), 5,
+				"6 dedicated CPUs are required (4 vCPUs, 2 emulator thread CPUs, 0 IOThread CPUs) but only 5 are assigned"),
+		)
+
+		It("should account for the supplemental IOThreads pool", func() {
+			vmi := libvmi.New(libvmi.WithCPUCount(2, 1, 1), libvmi.WithDedicatedCPUPlacement())
+			threads := uint32(2)
+			vmi.Spec.Domain.IOThreads = &v1.DiskIOThreads{SupplementalPoolThreadCount: &threads}
+			Expect(checkDedicatedCPUs(vmi, 4)).To(Succeed())
+			Expect(checkDedicatedCPUs(vmi, 3)).To(MatchError(
+				"4 dedicated CPUs are required (2 vCPUs, 0 emulator thread CPUs, 2 IOThread CPUs) but only 3 are assigned"))
+		})
+	})
+
+	Context("hugepages fragmentation", func() {
+		var sysfsNodePath string
+
+		setFreeHugepages := func(node, size string, free int) {
+			dir := filepath.Join(sysfsNodePath, node, "hugepages", "hugepages-"+size)
+			Expect(os.MkdirAll(dir, 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "free_hugepages"), []byte(strconv.Itoa(free)+"\n"), 0644)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			sysfsNodePath = GinkgoT().TempDir()
+		})
+
+		It("should ignore VMIs without hugepages", func() {
+			vmi := libvmi.New(libvmi.WithMemoryRequest("1Gi"))
+			Expect(checkHugepagesFragmentation(vmi, sysfsNodePath, 100)).To(Succeed())
+		})
+
+		It("should allow the VMI when enough hugepages are local to a NUMA node", func() {
+			setFreeHugepages("node0", "2048kB", 300)
+			setFreeHugepages("node1", "2048kB", 300)
+			vmi := libvmi.New(libvmi.WithMemoryRequest("1Gi"), libvmi.WithHugepages("2Mi"))
+			Expect(checkHugepagesFragmentation(vmi, sysfsNodePath, 50)).To(Succeed())
+		})
+
+		It("should only consider hugepages of the requested size", func() {
+			setFreeHugepages("node0", "2048kB", 512)
+			setFreeHugepages("node0", "1048576kB", 0)
+			vmi := libvmi.New(libvmi.WithMemoryRequest("1Gi"), libvmi.WithHugepages("1Gi"))
+			Expect(checkHugepagesFragmentation(vmi, sysfsNodePath, 50)).To(MatchError(
+				"1 hugepages of size 1Gi are required but only 0 are free on the node"))
+		})
+
+		It("should refuse the VMI when the hugepages are fragmented across NUMA nodes", func() {
+			setFreeHugepages("node0", "2048kB", 256)
+			setFreeHugepages("node1", "2048kB", 256)
+			vmi := libvmi.New(libvmi.WithMemoryRequest("1Gi"), libvmi.WithHugepages("2Mi"))
+			err := checkHugepagesFragmentation(vmi, sysfsNodePath, 75)
+			Expect(err).To(BeAssignableToTypeOf(&nodeGuardrailError{}))
+			Expect(err).To(MatchError("hugepages are too fragmented: at most 256 of the 512 required hugepages " +
+				"of size 2Mi are free on a single NUMA node, at least 75% are expected"))
+		})
+	})
+})
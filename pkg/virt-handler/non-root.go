@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	k8sv1 "k8s.io/api/core/v1"
 
@@ -31,9 +32,11 @@ import (
 
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
+	"kubevirt.io/kubevirt/pkg/network/domainspec"
 	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/storage/hostblockdevice"
 	"kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
 )
@@ -72,6 +75,22 @@ func changeOwnershipOfBlockDevices(vmi *v1.VirtualMachineInstance, res isolation
 	return nil
 }
 
+func changeOwnershipOfHostBlockDevices(vmi *v1.VirtualMachineInstance, res isolation.IsolationResult) error {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.VolumeSource.HostBlockDevice == nil {
+			continue
+		}
+		devPath, err := isolation.SafeJoin(res, hostblockdevice.GetMountedDevicePath(volume.Name))
+		if err != nil {
+			return fmt.Errorf("failed to resolve the host block device of volume %s: %v", volume.Name, err)
+		}
+		if err := diskutils.DefaultOwnershipManager.SetFileOwnership(devPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func changeOwnership(path *safepath.Path) error {
 	err := diskutils.DefaultOwnershipManager.SetFileOwnership(path)
 	if err != nil {
@@ -121,6 +140,9 @@ func (c *BaseController) prepareStorage(vmi *v1.VirtualMachineInstance, res isol
 	if err := changeOwnershipOfBlockDevices(vmi, res); err != nil {
 		return err
 	}
+	if err := changeOwnershipOfHostBlockDevices(vmi, res); err != nil {
+		return err
+	}
 	return changeOwnershipOfHostDisks(vmi, res)
 }
 
@@ -184,6 +206,84 @@ func (c *BaseController) prepareNetwork(vmi *v1.VirtualMachineInstance, res isol
 		}
 	}
 
+	if requiresVDPADevices(vmi, c.clusterConfig.GetNetworkBindings()) {
+		if err := prepareVDPA(res); err != nil {
+			return neterrors.CreateCriticalNetworkError(fmt.Errorf("failed to set up vhost-vdpa devices, %s", err))
+		}
+	}
+
+	return nil
+}
+
+func requiresVDPADevices(vmi *v1.VirtualMachineInstance, bindingPlugins map[string]v1.InterfaceBindingPlugin) bool {
+	for _, domainAttachment := range domainspec.DomainAttachmentByInterfaceName(vmi.Spec.Domain.Devices.Interfaces, bindingPlugins) {
+		if domainAttachment == string(v1.VDPA) {
+			return true
+		}
+	}
+	return false
+}
+
+// prepareVDPA hands the vhost-vdpa devices of the launcher pod to the unprivileged launcher.
+// The device plugin only passes the devices to the pod, their nodes on the host keep their owner.
+func prepareVDPA(res isolation.IsolationResult) error {
+	devPath, err := isolation.SafeJoin(res, "dev")
+	if err != nil {
+		return err
+	}
+
+	var files []os.DirEntry
+	err = devPath.ExecuteNoFollow(func(safePath string) (err error) {
+		files, err = os.ReadDir(safePath)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if !strings.HasPrefix(file.Name(), "vhost-vdpa-") {
+			continue
+		}
+		devicePath, err := safepath.JoinNoFollow(devPath, file.Name())
+		if err != nil {
+			return err
+		}
+		if err := diskutils.DefaultOwnershipManager.SetFileOwnership(devicePath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prepareInputDevices hands the evdev devices passed through to the VMI to the unprivileged launcher.
+// The device plugin only passes the devices to the pod, their nodes on the host keep their owner.
+func (c *BaseController) prepareInputDevices(vmi *v1.VirtualMachineInstance, res isolation.IsolationResult) error {
+	hostDevs := c.clusterConfig.GetPermittedHostDevices()
+	if hostDevs == nil || len(hostDevs.InputDevices) == 0 {
+		return nil
+	}
+
+	inputDevicePaths := make(map[string]string)
+	for _, inputDev := range hostDevs.InputDevices {
+		if !inputDev.ExternalResourceProvider {
+			inputDevicePaths[inputDev.ResourceName] = filepath.Clean(inputDev.DevicePath)
+		}
+	}
+
+	for _, hostDev := range vmi.Spec.Domain.Devices.HostDevices {
+		devicePath, exists := inputDevicePaths[hostDev.DeviceName]
+		if !exists {
+			continue
+		}
+		path, err := isolation.SafeJoin(res, devicePath)
+		if err != nil {
+			return fmt.Errorf("failed to resolve the input device %s: %v", devicePath, err)
+		}
+		if err := changeOwnership(path); err != nil {
+			return fmt.Errorf("failed to change the ownership of the input device %s: %v", devicePath, err)
+		}
+	}
 	return nil
 }
 
@@ -198,6 +298,9 @@ func (c *BaseController) nonRootSetup(vmi *v1.VirtualMachineInstance) error {
 	if err := c.prepareVFIO(res); err != nil {
 		return err
 	}
+	if err := c.prepareInputDevices(vmi, res); err != nil {
+		return err
+	}
 	if err := c.prepareNetwork(vmi, res); err != nil {
 		return err
 	}
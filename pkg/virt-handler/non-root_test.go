@@ -0,0 +1,113 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+)
+
+var _ = Describe("Non-root input devices", func() {
+	const (
+		keyboardResource = "example.org/keyboard"
+		externalResource = "example.org/external-mouse"
+	)
+
+	var (
+		controller       *BaseController
+		ownershipManager *diskutils.MockOwnershipManagerInterface
+		isolationResult  *isolation.MockIsolationResult
+		podRoot          *safepath.Path
+		rootDir          string
+	)
+
+	withHostDevice := func(deviceName string) libvmi.Option {
+		return func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Domain.Devices.HostDevices = append(vmi.Spec.Domain.Devices.HostDevices,
+				v1.HostDevice{Name: "input0", DeviceName: deviceName})
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+
+		rootDir = GinkgoT().TempDir()
+		inputDir := filepath.Join(rootDir, "dev", "input")
+		Expect(os.MkdirAll(filepath.Join(inputDir, "by-id"), 0o755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(inputDir, "event3"), nil, 0o644)).To(Succeed())
+		Expect(os.Symlink("../event3", filepath.Join(inputDir, "by-id", "usb-Vendor_Keyboard-event-kbd"))).To(Succeed())
+
+		var err error
+		podRoot, err = safepath.JoinAndResolveWithRelativeRoot(rootDir)
+		Expect(err).ToNot(HaveOccurred())
+		isolationResult = isolation.NewMockIsolationResult(ctrl)
+		isolationResult.EXPECT().MountRoot().Return(podRoot, nil).AnyTimes()
+
+		ownershipManager = diskutils.NewMockOwnershipManagerInterface(ctrl)
+		originalOwnershipManager := diskutils.DefaultOwnershipManager
+		diskutils.DefaultOwnershipManager = ownershipManager
+		DeferCleanup(func() { diskutils.DefaultOwnershipManager = originalOwnershipManager })
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			PermittedHostDevices: &v1.PermittedHostDevices{
+				InputDevices: []v1.InputHostDevice{
+					{ResourceName: keyboardResource, DevicePath: "/dev/input/by-id/usb-Vendor_Keyboard-event-kbd"},
+					{ResourceName: externalResource, DevicePath: "/dev/input/event4", ExternalResourceProvider: true},
+				},
+			},
+		})
+		controller = &BaseController{clusterConfig: config}
+	})
+
+	It("should hand the evdev node in the pod to the launcher", func() {
+		eventNode, err := podRoot.AppendAndResolveWithRelativeRoot("dev", "input", "event3")
+		Expect(err).ToNot(HaveOccurred())
+		ownershipManager.EXPECT().SetFileOwnership(eventNode).Return(nil)
+
+		Expect(controller.prepareInputDevices(libvmi.New(withHostDevice(keyboardResource)), isolationResult)).To(Succeed())
+	})
+
+	DescribeTable("should not change the ownership", func(vmi *v1.VirtualMachineInstance) {
+		Expect(controller.prepareInputDevices(vmi, isolationResult)).To(Succeed())
+	},
+		Entry("without input devices", libvmi.New()),
+		Entry("of devices provided by an external resource provider", libvmi.New(withHostDevice(externalResource))),
+		Entry("of host devices which are not input devices", libvmi.New(withHostDevice("example.org/gpu"))),
+	)
+
+	It("should fail when the evdev node is missing in the pod", func() {
+		Expect(os.Remove(filepath.Join(rootDir, "dev", "input", "event3"))).To(Succeed())
+
+		err := controller.prepareInputDevices(libvmi.New(withHostDevice(keyboardResource)), isolationResult)
+		Expect(err).To(MatchError(ContainSubstring("failed to resolve the input device /dev/input/by-id/usb-Vendor_Keyboard-event-kbd")))
+	})
+})
@@ -26,6 +26,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"libvirt.org/go/libvirtxml"
 
+	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
@@ -66,6 +67,8 @@ func virtualMachineOptions(
 			FreePageReportingDisabled: clusterConfig.IsFreePageReportingDisabled(),
 			BochsDisplayForEFIGuests:  bochsDisplay,
 			SerialConsoleLogDisabled:  clusterConfig.IsSerialConsoleLogDisabled(),
+			MachineTypeAliases:        clusterConfig.GetMachineTypeAliases(clusterConfig.GetClusterCPUArch()),
+			PrHelperSocketPath:        reservation.GetConfiguredPrHelperSocketPath(clusterConfig.GetPersistentReservation()),
 		}
 	}
 
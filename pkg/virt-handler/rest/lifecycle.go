@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/emicklei/go-restful/v3"
 
@@ -250,6 +251,31 @@ func (lh *LifecycleHandler) GetFilesystems(request *restful.Request, response *r
 	response.WriteEntity(fsList)
 }
 
+// GetStats measures the resource usage of the VMI between two samples of its domain stats
+func (lh *LifecycleHandler) GetStats(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	first, err := sampleDomainStats(client)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get domain stats")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	time.Sleep(statsSampleWindow)
+	second, err := sampleDomainStats(client)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get domain stats")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteEntity(newVMIStats(first, second))
+}
+
 func (lh *LifecycleHandler) getVMILauncherClient(request *restful.Request, response *restful.Response) (*v1.VirtualMachineInstance, cmdclient.LauncherClient, error) {
 	vmi, code, err := getVMI(request, lh.vmiStore)
 	if err != nil {
@@ -380,3 +406,37 @@ func (lh *LifecycleHandler) BackupHandler(request *restful.Request, response *re
 
 	response.WriteHeader(http.StatusAccepted)
 }
+
+func (lh *LifecycleHandler) ChangeMediaHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	if request.Request.Body == nil {
+		log.Log.Object(vmi).Reason(err).Error("Request with no body: change media parameters are required")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to retrieve change media parameters from request"))
+		return
+	}
+
+	opts := &v1.ChangeMediaOptions{}
+	err = yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+	switch err {
+	case io.EOF, nil:
+		break
+	default:
+		log.Log.Object(vmi).Reason(err).Error("Failed to decode change media parameters")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	err = client.ChangeMedia(vmi, opts)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed changing CD-ROM media")
+		response.WriteError(http.StatusBadRequest, err)
+		lh.recorder.Eventf(vmi, k8sv1.EventTypeWarning, "ChangeMediaError", "%s: %s", "Failed changing CD-ROM media", err.Error())
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
@@ -0,0 +1,152 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+// statsSampleWindow is the interval between the two domain stats samples the rates are measured from
+const statsSampleWindow = time.Second
+
+type domainStatsSample struct {
+	stats *stats.DomainStats
+	time  time.Time
+}
+
+func sampleDomainStats(client cmdclient.LauncherClient) (*domainStatsSample, error) {
+	domainStats, exists, err := client.GetDomainStats()
+	if err != nil {
+		return nil, err
+	}
+	if !exists || domainStats == nil {
+		return nil, fmt.Errorf("the domain does not exist")
+	}
+	return &domainStatsSample{stats: domainStats, time: time.Now()}, nil
+}
+
+// newVMIStats returns the resource usage of the VMI measured between two samples of its domain stats.
+// Disks and interfaces missing from one of the samples are not reported.
+func newVMIStats(first, second *domainStatsSample) *v1.VirtualMachineInstanceStats {
+	window := second.time.Sub(first.time)
+	vmiStats := &v1.VirtualMachineInstanceStats{
+		Timestamp: metav1.NewTime(second.time),
+		Window:    metav1.Duration{Duration: window},
+		CPU:       newCPUStats(first.stats, second.stats, window),
+		Memory:    newMemoryStats(second.stats.Memory),
+	}
+
+	firstBlocks := map[string]stats.DomainStatsBlock{}
+	for _, block := range first.stats.Block {
+		firstBlocks[block.Name] = block
+	}
+	for _, block := range second.stats.Block {
+		previous, exists := firstBlocks[block.Name]
+		if !block.NameSet || !exists {
+			continue
+		}
+		name := block.Name
+		if block.Alias != "" {
+			name = block.Alias
+		}
+		vmiStats.Disks = append(vmiStats.Disks, v1.VirtualMachineInstanceDiskStats{
+			Name:                name,
+			ReadBytesPerSecond:  rate(previous.RdBytes, block.RdBytes, window),
+			WriteBytesPerSecond: rate(previous.WrBytes, block.WrBytes, window),
+			ReadIOPS:            rate(previous.RdReqs, block.RdReqs, window),
+			WriteIOPS:           rate(previous.WrReqs, block.WrReqs, window),
+		})
+	}
+
+	firstInterfaces := map[string]stats.DomainStatsNet{}
+	for _, iface := range first.stats.Net {
+		firstInterfaces[iface.Name] = iface
+	}
+	for _, iface := range second.stats.Net {
+		previous, exists := firstInterfaces[iface.Name]
+		if !iface.NameSet || !exists {
+			continue
+		}
+		name := iface.Name
+		if iface.AliasSet {
+			name = iface.Alias
+		}
+		vmiStats.Interfaces = append(vmiStats.Interfaces, v1.VirtualMachineInstanceInterfaceStats{
+			Name:               name,
+			RxBytesPerSecond:   rate(previous.RxBytes, iface.RxBytes, window),
+			TxBytesPerSecond:   rate(previous.TxBytes, iface.TxBytes, window),
+			RxPacketsPerSecond: rate(previous.RxPkts, iface.RxPkts, window),
+			TxPacketsPerSecond: rate(previous.TxPkts, iface.TxPkts, window),
+		})
+	}
+
+	return vmiStats
+}
+
+func newCPUStats(first, second *stats.DomainStats, window time.Duration) v1.VirtualMachineInstanceCPUStats {
+	cpuStats := v1.VirtualMachineInstanceCPUStats{VCPUs: int32(second.NrVirtCpu)}
+	if cpuStats.VCPUs == 0 {
+		cpuStats.VCPUs = int32(len(second.Vcpu))
+	}
+	if first.Cpu != nil && second.Cpu != nil && first.Cpu.TimeSet && second.Cpu.TimeSet {
+		// the CPU time is in nanoseconds, a CPU fully used for a second uses a billion of them
+		cpuStats.UsageMillicores = rate(first.Cpu.Time, second.Cpu.Time, window) / int64(time.Millisecond)
+	}
+	return cpuStats
+}
+
+func newMemoryStats(memory *stats.DomainStatsMemory) v1.VirtualMachineInstanceMemoryStats {
+	var memoryStats v1.VirtualMachineInstanceMemoryStats
+	if memory == nil {
+		return memoryStats
+	}
+
+	// libvirt reports the memory stats in KiB
+	if memory.RSSSet {
+		memoryStats.RSSBytes = int64(memory.RSS) * 1024
+	}
+	if !memory.AvailableSet {
+		return memoryStats
+	}
+	memoryStats.GuestTotalBytes = int64(memory.Available) * 1024
+	switch {
+	case memory.UsableSet && memory.Usable <= memory.Available:
+		memoryStats.GuestUsedBytes = int64(memory.Available-memory.Usable) * 1024
+	case memory.UnusedSet && memory.Unused <= memory.Available:
+		memoryStats.GuestUsedBytes = int64(memory.Available-memory.Unused) * 1024
+	}
+	return memoryStats
+}
+
+// rate returns the per second rate of a counter, or zero when the counter was reset between the samples
+func rate(previous, current uint64, window time.Duration) int64 {
+	if current < previous || window <= 0 {
+		return 0
+	}
+	return int64(float64(current-previous) / window.Seconds())
+}
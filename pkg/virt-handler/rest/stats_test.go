@@ -0,0 +1,105 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package rest
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("VMI stats", func() {
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Now()
+	})
+
+	newSamples := func(first, second *stats.DomainStats) (*domainStatsSample, *domainStatsSample) {
+		return &domainStatsSample{stats: first, time: now}, &domainStatsSample{stats: second, time: now.Add(2 * time.Second)}
+	}
+
+	It("should measure the CPU usage between two samples", func() {
+		first, second := newSamples(
+			&stats.DomainStats{NrVirtCpu: 2, Cpu: &stats.DomainStatsCPU{TimeSet: true, Time: 1000000000}},
+			&stats.DomainStats{NrVirtCpu: 2, Cpu: &stats.DomainStatsCPU{TimeSet: true, Time: 4000000000}},
+		)
+
+		vmiStats := newVMIStats(first, second)
+		Expect(vmiStats.Window.Duration).To(Equal(2 * time.Second))
+		Expect(vmiStats.CPU).To(Equal(v1.VirtualMachineInstanceCPUStats{VCPUs: 2, UsageMillicores: 1500}))
+	})
+
+	DescribeTable("should report the memory usage", func(memory *stats.DomainStatsMemory, expected v1.VirtualMachineInstanceMemoryStats) {
+		first, second := newSamples(&stats.DomainStats{}, &stats.DomainStats{Memory: memory})
+		Expect(newVMIStats(first, second).Memory).To(Equal(expected))
+	},
+		Entry("without memory stats", nil, v1.VirtualMachineInstanceMemoryStats{}),
+		Entry("without balloon stats",
+			&stats.DomainStatsMemory{RSSSet: true, RSS: 2048},
+			v1.VirtualMachineInstanceMemoryStats{RSSBytes: 2048 * 1024},
+		),
+		Entry("with the usable memory of the guest",
+			&stats.DomainStatsMemory{RSSSet: true, RSS: 2048, AvailableSet: true, Available: 4096, UsableSet: true, Usable: 1024, UnusedSet: true, Unused: 512},
+			v1.VirtualMachineInstanceMemoryStats{RSSBytes: 2048 * 1024, GuestTotalBytes: 4096 * 1024, GuestUsedBytes: 3072 * 1024},
+		),
+		Entry("with the unused memory of the guest",
+			&stats.DomainStatsMemory{AvailableSet: true, Available: 4096, UnusedSet: true, Unused: 512},
+			v1.VirtualMachineInstanceMemoryStats{GuestTotalBytes: 4096 * 1024, GuestUsedBytes: 3584 * 1024},
+		),
+	)
+
+	It("should measure the disk and network throughput between two samples", func() {
+		first, second := newSamples(
+			&stats.DomainStats{
+				Block: []stats.DomainStatsBlock{
+					{NameSet: true, Name: "vda", Alias: "rootdisk", RdBytes: 1000, WrBytes: 2000, RdReqs: 10, WrReqs: 20},
+					{NameSet: true, Name: "vdb", Alias: "datadisk", RdBytes: 5000},
+				},
+				Net: []stats.DomainStatsNet{
+					{NameSet: true, Name: "tap0", AliasSet: true, Alias: "default", RxBytes: 1000, TxBytes: 1000, RxPkts: 10, TxPkts: 10},
+				},
+			},
+			&stats.DomainStats{
+				Block: []stats.DomainStatsBlock{
+					{NameSet: true, Name: "vda", Alias: "rootdisk", RdBytes: 5000, WrBytes: 10000, RdReqs: 30, WrReqs: 60},
+					{NameSet: true, Name: "vdb", Alias: "datadisk", RdBytes: 1000},
+					{NameSet: true, Name: "vdc", Alias: "hotplugged", RdBytes: 1000},
+				},
+				Net: []stats.DomainStatsNet{
+					{NameSet: true, Name: "tap0", AliasSet: true, Alias: "default", RxBytes: 3000, TxBytes: 5000, RxPkts: 20, TxPkts: 30},
+				},
+			},
+		)
+
+		vmiStats := newVMIStats(first, second)
+		Expect(vmiStats.Disks).To(Equal([]v1.VirtualMachineInstanceDiskStats{
+			{Name: "rootdisk", ReadBytesPerSecond: 2000, WriteBytesPerSecond: 4000, ReadIOPS: 10, WriteIOPS: 20},
+			{Name: "datadisk"},
+		}))
+		Expect(vmiStats.Interfaces).To(Equal([]v1.VirtualMachineInstanceInterfaceStats{
+			{Name: "default", RxBytesPerSecond: 1000, TxBytesPerSecond: 2000, RxPacketsPerSecond: 5, TxPacketsPerSecond: 10},
+		}))
+	})
+})
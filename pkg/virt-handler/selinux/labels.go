@@ -126,14 +126,19 @@ type SELinux interface {
 
 func RelabelFilesUnprivileged(continueOnError bool, files ...*safepath.Path) error {
 	const unprivilegedContainerSELinuxLabel = "system_u:object_r:container_file_t:s0"
-	relabelArgs := []string{"selinux", "relabel", unprivilegedContainerSELinuxLabel}
+	return RelabelFiles(unprivilegedContainerSELinuxLabel, continueOnError, files...)
+}
+
+// RelabelFiles sets the label on files, sockets and device nodes, if they are not labeled like this already
+func RelabelFiles(label string, continueOnError bool, files ...*safepath.Path) error {
+	relabelArgs := []string{"selinux", "relabel", label}
 	for _, file := range files {
 		cmd := exec.Command("virt-chroot", append(relabelArgs, "--root", unsafepath.UnsafeRoot(file.Raw()), unsafepath.UnsafeRelative(file.Raw()))...)
 		cmd.Stdout = os.Stdout
 		cmd.Stderr = os.Stderr
 		err := cmd.Run()
 		if err != nil {
-			err := fmt.Errorf("error relabeling file %s with label %s. Reason: %v", file, unprivilegedContainerSELinuxLabel, err)
+			err := fmt.Errorf("error relabeling file %s with label %s. Reason: %v", file, label, err)
 			if !continueOnError {
 				return err
 			} else {
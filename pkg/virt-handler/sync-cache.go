@@ -0,0 +1,83 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
+)
+
+// syncCache remembers, per VMI, the input of the last successful sync with virt-launcher.
+// Resyncs of a running VMI with the same input would only make virt-launcher convert the
+// VMI again and find no difference to the domain, so they can be skipped.
+type syncCache struct {
+	lock sync.Mutex
+	keys map[types.UID]string
+}
+
+func newSyncCache() *syncCache {
+	return &syncCache{
+		keys: make(map[types.UID]string),
+	}
+}
+
+// syncKey hashes everything the domain conversion depends on which can change between syncs,
+// the VMI generation and status included
+func syncKey(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) (string, error) {
+	input, err := json.Marshal(struct {
+		VMI     *v1.VirtualMachineInstance   `json:"vmi"`
+		Options *cmdv1.VirtualMachineOptions `json:"options"`
+	}{vmi, options})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(input)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (c *syncCache) synced(uid types.UID, key string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return key != "" && c.keys[uid] == key
+}
+
+func (c *syncCache) store(uid types.UID, key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if key == "" {
+		delete(c.keys, uid)
+		return
+	}
+	c.keys[uid] = key
+}
+
+func (c *syncCache) forget(uid types.UID) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.keys, uid)
+}
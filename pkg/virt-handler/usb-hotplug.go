@@ -0,0 +1,109 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	drautil "kubevirt.io/kubevirt/pkg/dra"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
+)
+
+// hotplugUSBDevices requests virt-launcher to attach or detach USB host-devices and USB redirection devices
+// when the VMI spec and the domain disagree on them.
+func (c *VirtualMachineController) hotplugUSBDevices(vmi *v1.VirtualMachineInstance) error {
+	domain, exists, _, err := c.getDomainFromCache(controller.VirtualMachineInstanceKey(vmi))
+	if err != nil {
+		return err
+	}
+
+	if !exists || usbDevicesInSync(vmi, &domain.Spec, c.permittedUSBResources()) {
+		c.usbHotplugExecutorPool.Delete(vmi.UID)
+		return nil
+	}
+
+	rateLimitedExecutor := c.usbHotplugExecutorPool.LoadOrStore(vmi.UID)
+	return rateLimitedExecutor.Exec(func() error {
+		return c.hotplugUSBDevicesCommand(vmi)
+	})
+}
+
+func (c *VirtualMachineController) hotplugUSBDevicesCommand(vmi *v1.VirtualMachineInstance) error {
+	const errMsgPrefix = "failed to hot-plug USB devices"
+
+	client, err := c.launcherClients.GetVerifiedLauncherClient(vmi)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	c.logger.V(3).Object(vmi).Info("sending hot-plug host-devices command")
+	if err := client.HotplugHostDevices(vmi); err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	return nil
+}
+
+func (c *VirtualMachineController) permittedUSBResources() map[string]struct{} {
+	usbResources := map[string]struct{}{}
+	if hostDevs := c.clusterConfig.GetPermittedHostDevices(); hostDevs != nil {
+		for _, usbHostDev := range hostDevs.USB {
+			usbResources[usbHostDev.ResourceName] = struct{}{}
+		}
+	}
+	return usbResources
+}
+
+// usbDevicesInSync reports whether the domain holds a redirection device only when client passthrough is requested
+// and a USB host-device for every VMI host-device backed by one of the USB resources.
+func usbDevicesInSync(vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec, usbResources map[string]struct{}) bool {
+	if (vmi.Spec.Domain.Devices.ClientPassthrough != nil) != (len(domainSpec.Devices.Redirs) > 0) {
+		return false
+	}
+
+	attachedUSBHostDevices := map[string]struct{}{}
+	for _, hostDev := range domainSpec.Devices.HostDevices {
+		if hostDev.Alias != nil && strings.HasPrefix(hostDev.Alias.GetName(), device.USBHostDeviceAliasPrefix) {
+			attachedUSBHostDevices[strings.TrimPrefix(hostDev.Alias.GetName(), device.USBHostDeviceAliasPrefix)] = struct{}{}
+		}
+	}
+
+	requestedUSBHostDevices := map[string]struct{}{}
+	for _, hostDev := range vmi.Spec.Domain.Devices.HostDevices {
+		if _, isUSB := usbResources[hostDev.DeviceName]; isUSB && !drautil.IsHostDeviceDRA(hostDev) {
+			requestedUSBHostDevices[hostDev.Name] = struct{}{}
+		}
+	}
+
+	if len(attachedUSBHostDevices) != len(requestedUSBHostDevices) {
+		return false
+	}
+	for name := range requestedUSBHostDevices {
+		if _, attached := attachedUSBHostDevices[name]; !attached {
+			return false
+		}
+	}
+	return true
+}
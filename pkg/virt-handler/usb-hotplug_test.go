@@ -0,0 +1,88 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
+)
+
+var _ = Describe("USB devices hotplug", func() {
+	const usbResource = "kubevirt.io/usb-storage"
+
+	usbResources := map[string]struct{}{usbResource: {}}
+
+	newUSBHostDevice := func(name string) api.HostDevice {
+		return api.HostDevice{Type: api.HostDeviceUSB, Alias: api.NewUserDefinedAlias(device.USBHostDeviceAliasPrefix + name)}
+	}
+
+	withClientPassthrough := func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Domain.Devices.ClientPassthrough = &v1.ClientPassthroughDevices{}
+	}
+
+	withHostDevice := func(name, deviceName string) libvmi.Option {
+		return func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Domain.Devices.HostDevices = append(vmi.Spec.Domain.Devices.HostDevices,
+				v1.HostDevice{Name: name, DeviceName: deviceName})
+		}
+	}
+
+	DescribeTable("should detect whether the domain USB devices match the VMI spec",
+		func(vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec, expectedInSync bool) {
+			Expect(usbDevicesInSync(vmi, domainSpec, usbResources)).To(Equal(expectedInSync))
+		},
+		Entry("without USB devices", libvmi.New(), &api.DomainSpec{}, true),
+		Entry("with client passthrough and redirection devices",
+			libvmi.New(withClientPassthrough),
+			&api.DomainSpec{Devices: api.Devices{Redirs: []api.RedirectedDevice{{Type: "unix", Bus: "usb"}}}},
+			true,
+		),
+		Entry("when client passthrough is requested but not attached",
+			libvmi.New(withClientPassthrough), &api.DomainSpec{}, false,
+		),
+		Entry("when client passthrough is attached but no longer requested",
+			libvmi.New(),
+			&api.DomainSpec{Devices: api.Devices{Redirs: []api.RedirectedDevice{{Type: "unix", Bus: "usb"}}}},
+			false,
+		),
+		Entry("with the requested USB host-device attached",
+			libvmi.New(withHostDevice("usb0", usbResource)),
+			&api.DomainSpec{Devices: api.Devices{HostDevices: []api.HostDevice{newUSBHostDevice("usb0")}}},
+			true,
+		),
+		Entry("when a USB host-device is requested but not attached",
+			libvmi.New(withHostDevice("usb0", usbResource)), &api.DomainSpec{}, false,
+		),
+		Entry("when a USB host-device is attached but no longer requested",
+			libvmi.New(),
+			&api.DomainSpec{Devices: api.Devices{HostDevices: []api.HostDevice{newUSBHostDevice("usb0")}}},
+			false,
+		),
+		Entry("ignoring host-devices which are not backed by a USB resource",
+			libvmi.New(withHostDevice("gpu0", "nvidia.com/GP102GL_Tesla_P40")), &api.DomainSpec{}, true,
+		),
+	)
+})
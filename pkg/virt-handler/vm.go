@@ -61,8 +61,10 @@ import (
 	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/safepath"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
+	"kubevirt.io/kubevirt/pkg/storage/hostblockdevice"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -74,6 +76,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 	containerdisk "kubevirt.io/kubevirt/pkg/virt-handler/container-disk"
+	crashnotifier "kubevirt.io/kubevirt/pkg/virt-handler/crash-notifier"
 	deviceManager "kubevirt.io/kubevirt/pkg/virt-handler/device-manager"
 	"kubevirt.io/kubevirt/pkg/virt-handler/heartbeat"
 	hotplugvolume "kubevirt.io/kubevirt/pkg/virt-handler/hotplug-disk"
@@ -101,8 +104,10 @@ type VirtualMachineController struct {
 	capabilities             *libvirtxml.Caps
 	clientset                kubecli.KubevirtClient
 	containerDiskMounter     containerdisk.Mounter
+	crashNotifier            *crashnotifier.Notifier
 	downwardMetricsManager   downwardMetricsManager
 	hotplugVolumeMounter     hotplugvolume.VolumeMounter
+	hostBlockDeviceLocker    *hostblockdevice.Locker
 	hostCpuModel             string
 	ioErrorRetryManager      *FailRetryManager
 	deviceManagerController  *deviceManager.DeviceController
@@ -110,6 +115,8 @@ type VirtualMachineController struct {
 	heartBeatInterval        time.Duration
 	netConf                  netconf
 	sriovHotplugExecutorPool *executor.RateLimitedExecutorPool
+	syncCache                *syncCache
+	usbHotplugExecutorPool   *executor.RateLimitedExecutorPool
 	vmiExpectations          *controller.UIDTrackingControllerExpectations
 	vmiGlobalStore           cache.Store
 	multipathSocketMonitor   *multipathmonitor.MultipathSocketMonitor
@@ -176,21 +183,30 @@ func NewVirtualMachineController(
 		return nil, err
 	}
 
+	hostBlockDeviceOwnerState := filepath.Join(virtPrivateDir, "host-block-device-owner-state")
+	if err := os.MkdirAll(hostBlockDeviceOwnerState, 0700); err != nil {
+		return nil, err
+	}
+
 	c := &VirtualMachineController{
 		BaseController:           baseCtrl,
 		capabilities:             capabilities,
 		clientset:                clientset,
 		containerDiskMounter:     containerdisk.NewMounter(podIsolationDetector, containerDiskState, clusterConfig),
+		crashNotifier:            crashnotifier.NewNotifier(clusterConfig.GetGuestCrashNotifications),
 		downwardMetricsManager:   downwardMetricsManager,
-		hotplugVolumeMounter:     hotplugvolume.NewVolumeMounter(hotplugState, kubeletPodsDir, host),
+		hotplugVolumeMounter:     hotplugvolume.NewVolumeMounter(hotplugState, kubeletPodsDir, host, reservation.GetConfiguredPrHelperSocketPath(clusterConfig.GetPersistentReservation())),
+		hostBlockDeviceLocker:    hostblockdevice.NewLocker(hostBlockDeviceOwnerState),
 		hostCpuModel:             hostCpuModel,
 		ioErrorRetryManager:      NewFailRetryManager("io-error-retry", 10*time.Second, 3*time.Minute, 30*time.Second),
 		heartBeatInterval:        1 * time.Minute,
 		netConf:                  netConf,
 		sriovHotplugExecutorPool: executor.NewRateLimitedExecutorPool(executor.NewExponentialLimitedBackoffCreator()),
+		syncCache:                newSyncCache(),
+		usbHotplugExecutorPool:   executor.NewRateLimitedExecutorPool(executor.NewExponentialLimitedBackoffCreator()),
 		vmiExpectations:          controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
 		vmiGlobalStore:           vmiGlobalStore,
-		multipathSocketMonitor:   multipathmonitor.NewMultipathSocketMonitor(),
+		multipathSocketMonitor:   multipathmonitor.NewMultipathSocketMonitor(reservation.GetConfiguredPrHelperSocketPath(clusterConfig.GetPersistentReservation())),
 	}
 
 	_, err = vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -229,12 +245,18 @@ func NewVirtualMachineController(
 		permissions,
 		deviceManager.PermanentHostDevicePlugins(maxDevices, permissions),
 		clusterConfig,
-		nodeStore)
+		nodeStore,
+		recorder)
 	c.heartBeat = heartbeat.NewHeartBeat(clientset.CoreV1(), c.deviceManagerController, clusterConfig, host)
 
 	return c, nil
 }
 
+// DevicePluginStatuses returns the status of the device plugins started by virt-handler, indexed by resource name.
+func (c *VirtualMachineController) DevicePluginStatuses() map[string]deviceManager.DevicePluginStatus {
+	return c.deviceManagerController.PluginStatuses()
+}
+
 func (c *VirtualMachineController) Run(threadiness int, stopCh chan struct{}) {
 	defer c.queue.ShutDown()
 	c.logger.Info("Starting virt-handler vms controller.")
@@ -614,11 +636,28 @@ func (c *VirtualMachineController) updateVolumeStatusesFromDomain(vmi *v1.Virtua
 	}
 
 	diskDeviceMap := make(map[string]string)
+	diskSerialMap := make(map[string]string)
+	diskIOThreadsMap := make(map[string][]uint32)
+	diskIOErrorsMap := make(map[string]api.DiskIOErrors)
+	diskBlockJobsMap := make(map[string]*v1.VolumeBlockJob)
 	if domain != nil {
 		for _, disk := range domain.Spec.Devices.Disks {
 			// don't care about empty cdroms
 			if disk.Source.File != "" || disk.Source.Dev != "" {
 				diskDeviceMap[disk.Alias.GetName()] = disk.Target.Device
+				diskSerialMap[disk.Alias.GetName()] = disk.Serial
+				diskIOThreadsMap[disk.Alias.GetName()] = diskIOThreads(disk)
+			}
+		}
+		for _, ioErrors := range domain.Status.DiskIOErrors {
+			diskIOErrorsMap[ioErrors.VolumeName] = ioErrors
+		}
+		for _, blockJob := range domain.Status.BlockJobs {
+			diskBlockJobsMap[blockJob.VolumeName] = &v1.VolumeBlockJob{
+				Type:             v1.VolumeBlockJobType(blockJob.Type),
+				Progress:         blockJob.Progress,
+				BytesPerSecond:   blockJob.BytesPerSecond,
+				RemainingSeconds: blockJob.RemainingSeconds,
 			}
 		}
 	}
@@ -637,6 +676,12 @@ func (c *VirtualMachineController) updateVolumeStatusesFromDomain(vmi *v1.Virtua
 		// relying on the fact that target will be "" if not in the map
 		// see updateHotplugVolumeStatus
 		volumeStatus.Target = diskDeviceMap[volumeStatus.Name]
+		volumeStatus.Serial = diskSerialMap[volumeStatus.Name]
+		volumeStatus.IOThreads = diskIOThreadsMap[volumeStatus.Name]
+		volumeStatus.BlockJob = diskBlockJobsMap[volumeStatus.Name]
+		if domain != nil {
+			volumeStatus.IOErrors = c.updateVolumeIOErrors(vmi, volumeStatus, diskIOErrorsMap)
+		}
 		if volumeStatus.HotplugVolume != nil {
 			hasHotplug = true
 			volumeStatus, tmpNeedsRefresh = c.updateHotplugVolumeStatus(vmi, volumeStatus, specVolumeMap)
@@ -661,6 +706,41 @@ func (c *VirtualMachineController) updateVolumeStatusesFromDomain(vmi *v1.Virtua
 	return hasHotplug
 }
 
+// diskIOThreads returns the ids of the iothreads serving the disk
+func diskIOThreads(disk api.Disk) []uint32 {
+	if disk.Driver == nil {
+		return nil
+	}
+	if disk.Driver.IOThread != nil {
+		return []uint32{uint32(*disk.Driver.IOThread)}
+	}
+	if disk.Driver.IOThreads == nil {
+		return nil
+	}
+	ids := make([]uint32, 0, len(disk.Driver.IOThreads.IOThread))
+	for _, iothread := range disk.Driver.IOThreads.IOThread {
+		ids = append(ids, iothread.Id)
+	}
+	return ids
+}
+
+// updateVolumeIOErrors returns the IO errors reported on the domain for the volume, and
+// records an event when new errors were reported.
+func (c *VirtualMachineController) updateVolumeIOErrors(vmi *v1.VirtualMachineInstance, volumeStatus v1.VolumeStatus, diskIOErrorsMap map[string]api.DiskIOErrors) *v1.VolumeIOErrors {
+	ioErrors, exists := diskIOErrorsMap[volumeStatus.Name]
+	if !exists {
+		return nil
+	}
+	if volumeStatus.IOErrors == nil || ioErrors.Count > volumeStatus.IOErrors.Count {
+		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, VolumeIOError, "Volume %s reported %d IO errors, last error: %s", volumeStatus.Name, ioErrors.Count, ioErrors.LastError)
+	}
+	return &v1.VolumeIOErrors{
+		Count:         ioErrors.Count,
+		LastError:     ioErrors.LastError,
+		LastErrorTime: ioErrors.LastErrorTime,
+	}
+}
+
 func (c *VirtualMachineController) updateGuestInfoFromDomain(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
 
 	if domain == nil || domain.Status.OSInfo.Name == "" || vmi.Status.GuestOSInfo.Name == domain.Status.OSInfo.Name {
@@ -754,7 +834,7 @@ func (c *VirtualMachineController) updateGuestAgentConditions(vmi *v1.VirtualMac
 		for _, channel := range domain.Spec.Devices.Channels {
 			if channel.Target != nil {
 				c.logger.V(4).Infof("Channel: %s, %s", channel.Target.Name, channel.Target.State)
-				if channel.Target.Name == "org.qemu.guest_agent.0" {
+				if channel.Target.Name == guestAgentChannelName {
 					if channel.Target.State == "connected" {
 						channelConnected = true
 					}
@@ -837,6 +917,75 @@ func (c *VirtualMachineController) updatePausedConditions(vmi *v1.VirtualMachine
 	}
 }
 
+func (c *VirtualMachineController) updateGuestInitiatedShutdownCondition(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	// The condition is kept once set, the guest can not take back its request
+	if domain == nil || domain.Status.ShutdownOrigin != api.ShutdownOriginGuest ||
+		condManager.HasCondition(vmi, v1.VirtualMachineInstanceGuestInitiatedShutdown) {
+		return
+	}
+	// The guest also powers off by itself when KubeVirt asks it to, e.g. with the ACPI power button
+	if shutdownRequestedByKubeVirt(vmi, domain) {
+		return
+	}
+
+	c.logger.Object(vmi).Info("The guest requested to power off")
+	condManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceGuestInitiatedShutdown,
+		Status:             k8sv1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             v1.VirtualMachineInstanceReasonGuestPoweroff,
+		Message:            "The guest requested to power off",
+	})
+}
+
+// shutdownRequestedByKubeVirt tells whether the VMI is being deleted or virt-launcher signaled the guest to shut down
+func shutdownRequestedByKubeVirt(vmi *v1.VirtualMachineInstance, domain *api.Domain) bool {
+	if vmi.IsMarkedForDeletion() {
+		return true
+	}
+	gracePeriod := domain.Spec.Metadata.KubeVirt.GracePeriod
+	return gracePeriod != nil &&
+		(gracePeriod.DeletionTimestamp != nil || (gracePeriod.MarkedForGracefulShutdown != nil && *gracePeriod.MarkedForGracefulShutdown))
+}
+
+func (c *VirtualMachineController) updateGuestPanickedCondition(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	// The condition is kept once set, like the panic is kept by virt-launcher
+	if domain == nil || !domain.Status.GuestPanicked ||
+		condManager.HasCondition(vmi, v1.VirtualMachineInstanceGuestPanicked) {
+		return
+	}
+
+	c.logger.Object(vmi).Warning("The guest kernel panicked")
+	condManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceGuestPanicked,
+		Status:             k8sv1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             v1.VirtualMachineInstanceReasonGuestPanic,
+		Message:            VMIGuestPanicked,
+	})
+	c.recorder.Event(vmi, k8sv1.EventTypeWarning, v1.VirtualMachineInstanceReasonGuestPanic, VMIGuestPanicked)
+	c.crashNotifier.Notify(vmi, v1.VirtualMachineInstanceReasonGuestPanic, VMIGuestPanicked)
+}
+
+func (c *VirtualMachineController) updateGuestWatchdogCondition(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	// The condition is kept once set, like the expiration is kept by virt-launcher
+	if domain == nil || !domain.Status.WatchdogFired ||
+		condManager.HasCondition(vmi, v1.VirtualMachineInstanceGuestWatchdogFired) {
+		return
+	}
+
+	c.logger.Object(vmi).Warning("The guest watchdog expired")
+	condManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceGuestWatchdogFired,
+		Status:             k8sv1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             v1.VirtualMachineInstanceReasonGuestWatchdog,
+		Message:            VMIGuestWatchdogFired,
+	})
+	c.recorder.Event(vmi, k8sv1.EventTypeWarning, v1.VirtualMachineInstanceReasonGuestWatchdog, VMIGuestWatchdogFired)
+	c.crashNotifier.Notify(vmi, v1.VirtualMachineInstanceReasonGuestWatchdog, VMIGuestWatchdogFired)
+}
+
 func dumpTargetFile(vmiName, volName string) string {
 	targetFileName := fmt.Sprintf("%s-%s-%s.memory.dump", vmiName, volName, time.Now().Format("20060102-150405"))
 	return targetFileName
@@ -1014,6 +1163,13 @@ func (c *VirtualMachineController) updateMemoryInfo(vmi *v1.VirtualMachineInstan
 	return nil
 }
 
+func (c *VirtualMachineController) updateIOThreadsInfo(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil || vmi == nil || domain.Spec.IOThreads == nil {
+		return
+	}
+	vmi.Status.CurrentIOThreadCount = pointer.P(uint32(domain.Spec.IOThreads.IOThreads))
+}
+
 func (c *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 	c.updateIsoSizeStatus(vmi)
 	err := c.updateSELinuxContext(vmi)
@@ -1025,9 +1181,11 @@ func (c *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMach
 	c.updateFSFreezeStatus(vmi, domain)
 	c.updateBackupStatus(vmi, domain)
 	c.updateMachineType(vmi, domain)
+	c.updateShutdownMethod(vmi, domain)
 	if err = c.updateMemoryInfo(vmi, domain); err != nil {
 		return err
 	}
+	c.updateIOThreadsInfo(vmi, domain)
 	cbt.SetChangedBlockTrackingOnVMIFromDomain(vmi, domain)
 	err = c.netStat.UpdateStatus(vmi, domain)
 	return err
@@ -1041,6 +1199,10 @@ func (c *VirtualMachineController) updateVMIConditions(vmi *v1.VirtualMachineIns
 		return err
 	}
 	c.updatePausedConditions(vmi, domain, condManager)
+	c.updateDegradedCondition(vmi, domain, condManager)
+	c.updateGuestInitiatedShutdownCondition(vmi, domain, condManager)
+	c.updateGuestPanickedCondition(vmi, domain, condManager)
+	c.updateGuestWatchdogCondition(vmi, domain, condManager)
 
 	return nil
 }
@@ -1121,6 +1283,14 @@ func (c *VirtualMachineController) handleSyncError(vmi *v1.VirtualMachineInstanc
 		c.logger.Errorf("virt-launcher reached an irrecoverable error. Updating VMI %s status to Failed", vmi.Name)
 		vmi.Status.Phase = v1.Failed
 	}
+
+	var guardrailErr *nodeGuardrailError
+	if goerror.As(syncError, &guardrailErr) {
+		c.logger.Errorf("starting VMI %s would violate the node guardrails. Updating VMI status to Failed", vmi.Name)
+		vmi.Status.Phase = v1.Failed
+		condManager.CheckFailure(vmi, syncError, v1.VirtualMachineInstanceReasonNodeGuardrailViolated)
+		return
+	}
 	condManager.CheckFailure(vmi, syncError, "Synchronizing with the Domain failed.")
 }
 
@@ -1529,6 +1699,11 @@ func (c *VirtualMachineController) processVmCleanup(vmi *v1.VirtualMachineInstan
 	c.teardownNetwork(vmi)
 
 	c.sriovHotplugExecutorPool.Delete(vmi.UID)
+	c.usbHotplugExecutorPool.Delete(vmi.UID)
+	c.syncCache.forget(vmi.UID)
+	if err := c.hostBlockDeviceLocker.Release(vmi.UID); err != nil {
+		return err
+	}
 
 	// Watch dog file and command client must be the last things removed here
 	c.launcherClients.CloseLauncherClient(vmi)
@@ -1785,6 +1960,10 @@ func (c *VirtualMachineController) checkVolumesForMigration(vmi *v1.VirtualMachi
 			if !shared {
 				return true, fmt.Errorf("cannot migrate VMI with non-shared HostDisk")
 			}
+		} else if volSrc.VhostUserBlk != nil {
+			return true, fmt.Errorf("cannot migrate VMI with a vhost-user-blk volume")
+		} else if volSrc.HostBlockDevice != nil {
+			return true, fmt.Errorf("cannot migrate VMI with a host block device volume")
 		} else {
 			if _, ok := filesystems[volume.Name]; ok {
 				c.logger.Object(vmi).Infof("Volume %s is shared with virtiofs, allow live migration", volume.Name)
@@ -1933,7 +2112,8 @@ func (c *VirtualMachineController) configureHousekeepingCgroup(vmi *v1.VirtualMa
 	return nil
 }
 
-func (c *VirtualMachineController) vmUpdateHelperDefault(vmi *v1.VirtualMachineInstance, domainExists bool) error {
+func (c *VirtualMachineController) vmUpdateHelperDefault(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
+	domainExists := domain != nil
 	client, err := c.launcherClients.GetLauncherClient(vmi)
 	if err != nil {
 		return fmt.Errorf(unableCreateVirtLauncherConnectionFmt, err)
@@ -1951,6 +2131,12 @@ func (c *VirtualMachineController) vmUpdateHelperDefault(vmi *v1.VirtualMachineI
 		return err
 	}
 
+	if !domainExists && !vmi.IsRunning() && !vmi.IsFinal() {
+		if err := c.checkNodeGuardrails(vmi, cgroupManager); err != nil {
+			return err
+		}
+	}
+
 	var errorTolerantFeaturesError []error
 	readyToProceed, err := c.handleVMIState(vmi, cgroupManager, &errorTolerantFeaturesError)
 	if err != nil {
@@ -1962,7 +2148,7 @@ func (c *VirtualMachineController) vmUpdateHelperDefault(vmi *v1.VirtualMachineI
 	}
 
 	// Synchronize the VirtualMachineInstance state
-	err = c.syncVirtualMachine(client, vmi, preallocatedVolumes)
+	err = c.syncVirtualMachine(client, vmi, domain, preallocatedVolumes)
 	if err != nil {
 		return err
 	}
@@ -1992,6 +2178,10 @@ func (c *VirtualMachineController) handleRunningVMI(vmi *v1.VirtualMachineInstan
 		c.logger.Object(vmi).Error(err.Error())
 	}
 
+	if err := c.hotplugUSBDevices(vmi); err != nil {
+		c.logger.Object(vmi).Error(err.Error())
+	}
+
 	if err := c.hotplugVolumeMounter.Mount(vmi, cgroupManager); err != nil {
 		if !goerror.Is(err, os.ErrNotExist) {
 			return err
@@ -2017,6 +2207,12 @@ func (c *VirtualMachineController) handleRunningVMI(vmi *v1.VirtualMachineInstan
 		*errorTolerantFeaturesError = append(*errorTolerantFeaturesError, err)
 	}
 
+	// The locks are only held in memory, take them again after a restart of virt-handler
+	if err := c.lockHostBlockDevices(vmi); err != nil {
+		c.recorder.Event(vmi, k8sv1.EventTypeWarning, "HostBlockDeviceLockFailed", err.Error())
+		*errorTolerantFeaturesError = append(*errorTolerantFeaturesError, err)
+	}
+
 	return nil
 }
 
@@ -2065,6 +2261,10 @@ func (c *VirtualMachineController) handleStartingVMI(
 		return false, fmt.Errorf("failed to configure vmi network: %w", err)
 	}
 
+	if err := c.lockHostBlockDevices(vmi); err != nil {
+		return false, err
+	}
+
 	if err := c.setupDevicesOwnerships(vmi, c.recorder); err != nil {
 		return false, err
 	}
@@ -2080,6 +2280,37 @@ func (c *VirtualMachineController) handleStartingVMI(
 	return true, nil
 }
 
+// lockHostBlockDevices claims the block devices of the host block device volumes exclusively for the VMI,
+// which fails if a device is mounted on the node or passed to another VMI.
+func (c *VirtualMachineController) lockHostBlockDevices(vmi *v1.VirtualMachineInstance) error {
+	if !hostblockdevice.HasHostBlockDeviceVolume(vmi.Spec.Volumes) {
+		return nil
+	}
+
+	isolationRes, err := c.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		return fmt.Errorf(failedDetectIsolationFmt, err)
+	}
+	mountRoot, err := isolationRes.MountRoot()
+	if err != nil {
+		return err
+	}
+
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.HostBlockDevice == nil {
+			continue
+		}
+		device, err := safepath.JoinNoFollow(mountRoot, hostblockdevice.GetMountedDevicePath(volume.Name))
+		if err != nil {
+			return fmt.Errorf("failed to resolve the host block device of volume %s: %v", volume.Name, err)
+		}
+		if err := c.hostBlockDeviceLocker.Lock(vmi.UID, device, volume.HostBlockDevice.Path); err != nil {
+			return fmt.Errorf("failed to lock the host block device of volume %s: %v", volume.Name, err)
+		}
+	}
+	return nil
+}
+
 func (c *VirtualMachineController) adjustResources(vmi *v1.VirtualMachineInstance) error {
 	err := c.podIsolationDetector.AdjustResources(vmi, c.clusterConfig.GetConfig().AdditionalGuestMemoryOverheadRatio)
 	if err != nil {
@@ -2097,21 +2328,36 @@ func (c *VirtualMachineController) shouldWaitForSEVAttestation(vmi *v1.VirtualMa
 	return false
 }
 
-func (c *VirtualMachineController) syncVirtualMachine(client cmdclient.LauncherClient, vmi *v1.VirtualMachineInstance, preallocatedVolumes []string) error {
+func (c *VirtualMachineController) syncVirtualMachine(client cmdclient.LauncherClient, vmi *v1.VirtualMachineInstance, domain *api.Domain, preallocatedVolumes []string) error {
 	smbios := c.clusterConfig.GetSMBIOS()
 	period := c.clusterConfig.GetMemBalloonStatsPeriod()
 
 	options := virtualMachineOptions(smbios, period, preallocatedVolumes, c.capabilities, c.clusterConfig)
 	options.InterfaceDomainAttachment = domainspec.DomainAttachmentByInterfaceName(vmi.Spec.Domain.Devices.Interfaces, c.clusterConfig.GetNetworkBindings())
 
-	err := client.SyncVirtualMachine(vmi, options)
+	// Resyncs of an unchanged VMI are skipped while the domain is running
+	key, err := syncKey(vmi, options)
+	if err != nil {
+		c.logger.Object(vmi).Reason(err).V(3).Info("failed to hash the VMI sync input")
+	}
+	if vmi.IsRunning() && domain != nil && domain.Status.Status == api.Running && c.syncCache.synced(vmi.UID, key) {
+		return nil
+	}
+	c.syncCache.forget(vmi.UID)
+
+	incomplete, err := client.SyncVirtualMachine(vmi, options)
 	if err != nil {
 		if strings.Contains(err.Error(), "EFI OVMF rom missing") {
 			return &virtLauncherCriticalSecurebootError{fmt.Sprintf("mismatch of Secure Boot setting and bootloaders: %v", err)}
 		}
+		return err
 	}
 
-	return err
+	// A sync which deferred a disk is not remembered, so the next resync retries it
+	if !incomplete {
+		c.syncCache.store(vmi.UID, key)
+	}
+	return nil
 }
 
 func (c *VirtualMachineController) handleHousekeeping(vmi *v1.VirtualMachineInstance, cgroupManager cgroup.Manager, domainExists bool) error {
@@ -2265,7 +2511,7 @@ func (c *VirtualMachineController) processVmUpdate(vmi *v1.VirtualMachineInstanc
 		return err
 	}
 
-	return c.vmUpdateHelperDefault(vmi, domain != nil)
+	return c.vmUpdateHelperDefault(vmi, domain)
 }
 
 func (c *VirtualMachineController) setVmPhaseForStatusReason(domain *api.Domain, vmi *v1.VirtualMachineInstance) error {
@@ -2318,6 +2564,10 @@ func (c *VirtualMachineController) calculateVmPhaseForStatusReason(domain *api.D
 		case !vmi.IsRunning() && !vmi.IsFinal():
 			return v1.Scheduled, nil
 		case !vmi.IsFinal():
+			// The domain of a guest which powered itself off may be gone before its shutoff state was seen
+			if controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, v1.VirtualMachineInstanceGuestInitiatedShutdown, k8sv1.ConditionTrue) {
+				return v1.Succeeded, nil
+			}
 			// That is unexpected. We should not be able to delete a VirtualMachineInstance before we stop it.
 			// However, if someone directly interacts with libvirt it is possible
 			return v1.Failed, nil
@@ -2433,6 +2683,24 @@ func (c *VirtualMachineController) updateMachineType(vmi *v1.VirtualMachineInsta
 	}
 }
 
+// updateShutdownMethod records which step of the shutdown ladder brought the guest down.
+func (c *VirtualMachineController) updateShutdownMethod(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil || vmi.Spec.ShutdownLadder == nil || domain.Status.Status != api.Shutoff {
+		return
+	}
+	gracePeriod := domain.Spec.Metadata.KubeVirt.GracePeriod
+	if gracePeriod == nil || gracePeriod.DeletionTimestamp == nil {
+		// The guest powered itself off without being asked to
+		return
+	}
+	switch domain.Status.Reason {
+	case api.ReasonShutdown:
+		vmi.Status.ShutdownMethod = v1.ShutdownMethod(gracePeriod.ShutdownMethod)
+	case api.ReasonDestroyed:
+		vmi.Status.ShutdownMethod = v1.ShutdownMethodDestroy
+	}
+}
+
 func parseLibvirtQuantity(value int64, unit string) *resource.Quantity {
 	switch unit {
 	case "b", "bytes":
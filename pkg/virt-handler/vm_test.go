@@ -926,6 +926,87 @@ var _ = Describe("VirtualMachineInstance", func() {
 			expectEvent(string(v1.AccessCredentialsSyncSuccess), false)
 		})
 
+		It("should skip the sync of an unchanged running VMI", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+
+			addVMI(vmi, domain)
+			key, err := virtcontroller.KeyFunc(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil).AnyTimes()
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil).AnyTimes()
+
+			observeVMIUpdate := func(resourceVersion string) *v1.VirtualMachineInstance {
+				updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				updatedVMI.ResourceVersion = resourceVersion
+				Expect(controller.vmiStore.Update(updatedVMI)).To(Succeed())
+				controller.vmiExpectations.SetExpectations(key, 0, 0)
+				controller.queue.Add(key)
+				return updatedVMI
+			}
+
+			By("syncing the VMI and its status update")
+			client.EXPECT().SyncVirtualMachine(gomock.Any(), gomock.Any()).Times(2)
+			sanityExecute()
+			observeVMIUpdate("2")
+			sanityExecute()
+
+			By("resyncing the unchanged VMI")
+			controller.queue.Add(key)
+			sanityExecute()
+
+			By("resyncing the changed VMI")
+			updatedVMI := observeVMIUpdate("3")
+			client.EXPECT().SyncVirtualMachine(updatedVMI, gomock.Any())
+			sanityExecute()
+		})
+
+		It("should not skip the resync of a VMI whose disk attachment was deferred", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+
+			addVMI(vmi, domain)
+			key, err := virtcontroller.KeyFunc(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil).AnyTimes()
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil).AnyTimes()
+
+			By("syncing the VMI and its status update while the hotplug disk is not ready")
+			client.EXPECT().SyncVirtualMachine(gomock.Any(), gomock.Any()).Return(true, nil).Times(2)
+			sanityExecute()
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			updatedVMI.ResourceVersion = "2"
+			Expect(controller.vmiStore.Update(updatedVMI)).To(Succeed())
+			controller.vmiExpectations.SetExpectations(key, 0, 0)
+			controller.queue.Add(key)
+			sanityExecute()
+
+			By("resyncing the unchanged VMI once the disk is ready")
+			client.EXPECT().SyncVirtualMachine(updatedVMI, gomock.Any()).Return(false, nil)
+			controller.queue.Add(key)
+			sanityExecute()
+
+			By("skipping the next resync of the unchanged VMI")
+			controller.queue.Add(key)
+			sanityExecute()
+		})
+
 		It("should update access credential condition if agent disconnects", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -1104,6 +1185,143 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(updatedVMI.Status.Phase).To(Equal(v1.Failed))
 		})
 
+		It("should move VirtualMachineInstance from Running to Succeeded if the guest powered off and the domain does not exist in cache", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.UID = vmiTestUUID
+			vmi.Status.Phase = v1.Running
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+				Type:   v1.VirtualMachineInstanceGuestInitiatedShutdown,
+				Status: k8sv1.ConditionTrue,
+				Reason: v1.VirtualMachineInstanceReasonGuestPoweroff,
+			}}
+
+			createVMI(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, VMIShutdown)
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.Phase).To(Equal(v1.Succeeded))
+		})
+
+		It("should add the GuestInitiatedShutdown condition when the guest powers off", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.Status.Phase = v1.Running
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Shutdown
+			domain.Status.Reason = api.ReasonUser
+			domain.Status.ShutdownOrigin = api.ShutdownOriginGuest
+
+			condManager := virtcontroller.NewVirtualMachineInstanceConditionManager()
+			controller.updateGuestInitiatedShutdownCondition(vmi, domain, condManager)
+			Expect(condManager.HasConditionWithStatusAndReason(vmi, v1.VirtualMachineInstanceGuestInitiatedShutdown, k8sv1.ConditionTrue, v1.VirtualMachineInstanceReasonGuestPoweroff)).To(BeTrue())
+
+			By("not adding the condition for a shutdown requested by the host")
+			vmi.Status.Conditions = nil
+			domain.Status.ShutdownOrigin = api.ShutdownOriginHost
+			controller.updateGuestInitiatedShutdownCondition(vmi, domain, condManager)
+			Expect(vmi.Status.Conditions).To(BeEmpty())
+		})
+
+		DescribeTable("should not add the GuestInitiatedShutdown condition when KubeVirt requested the shutdown", func(prepare func(*v1.VirtualMachineInstance, *api.Domain)) {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.Status.Phase = v1.Running
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Shutdown
+			domain.Status.Reason = api.ReasonUser
+			domain.Status.ShutdownOrigin = api.ShutdownOriginGuest
+			prepare(vmi, domain)
+
+			controller.updateGuestInitiatedShutdownCondition(vmi, domain, virtcontroller.NewVirtualMachineInstanceConditionManager())
+			Expect(vmi.Status.Conditions).To(BeEmpty())
+		},
+			Entry("when the VMI is deleted", func(vmi *v1.VirtualMachineInstance, _ *api.Domain) {
+				vmi.DeletionTimestamp = pointer.P(metav1.Now())
+			}),
+			Entry("when the guest was signaled to shut down", func(_ *v1.VirtualMachineInstance, domain *api.Domain) {
+				domain.Spec.Metadata.KubeVirt.GracePeriod = &api.GracePeriodMetadata{DeletionTimestamp: pointer.P(metav1.Now())}
+			}),
+			Entry("when the VMI is marked for a graceful shutdown", func(_ *v1.VirtualMachineInstance, domain *api.Domain) {
+				domain.Spec.Metadata.KubeVirt.GracePeriod = &api.GracePeriodMetadata{MarkedForGracefulShutdown: pointer.P(true)}
+			}),
+		)
+
+		It("should add the GuestPanicked condition and record an event when the guest panics", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.Status.Phase = v1.Running
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Crashed
+			domain.Status.Reason = api.ReasonPanicked
+			domain.Status.GuestPanicked = true
+
+			condManager := virtcontroller.NewVirtualMachineInstanceConditionManager()
+			controller.updateGuestPanickedCondition(vmi, domain, condManager)
+			Expect(condManager.HasConditionWithStatusAndReason(vmi, v1.VirtualMachineInstanceGuestPanicked, k8sv1.ConditionTrue, v1.VirtualMachineInstanceReasonGuestPanic)).To(BeTrue())
+			testutils.ExpectEvent(recorder, VMIGuestPanicked)
+
+			By("not recording the event again while the condition is set")
+			controller.updateGuestPanickedCondition(vmi, domain, condManager)
+			Expect(recorder.Events).To(BeEmpty())
+
+			By("not adding the condition when the guest did not panic")
+			vmi.Status.Conditions = nil
+			domain.Status.GuestPanicked = false
+			controller.updateGuestPanickedCondition(vmi, domain, condManager)
+			Expect(vmi.Status.Conditions).To(BeEmpty())
+		})
+
+		It("should add the GuestWatchdogFired condition and record an event when the guest watchdog expires", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.Status.Phase = v1.Running
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Shutoff
+			domain.Status.WatchdogFired = true
+
+			condManager := virtcontroller.NewVirtualMachineInstanceConditionManager()
+			controller.updateGuestWatchdogCondition(vmi, domain, condManager)
+			Expect(condManager.HasConditionWithStatusAndReason(vmi, v1.VirtualMachineInstanceGuestWatchdogFired, k8sv1.ConditionTrue, v1.VirtualMachineInstanceReasonGuestWatchdog)).To(BeTrue())
+			testutils.ExpectEvent(recorder, VMIGuestWatchdogFired)
+
+			By("not recording the event again while the condition is set")
+			controller.updateGuestWatchdogCondition(vmi, domain, condManager)
+			Expect(recorder.Events).To(BeEmpty())
+
+			By("not adding the condition when the watchdog did not expire")
+			vmi.Status.Conditions = nil
+			domain.Status.WatchdogFired = false
+			controller.updateGuestWatchdogCondition(vmi, domain, condManager)
+			Expect(vmi.Status.Conditions).To(BeEmpty())
+		})
+
+		DescribeTable("should record the step of the shutdown ladder which brought the guest down", func(reason api.StateChangeReason, recordedMethod string, expectedMethod v1.ShutdownMethod) {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.ShutdownLadder = &v1.ShutdownLadder{Steps: []v1.ShutdownStep{
+				{Method: v1.ShutdownMethodGuestAgent, TimeoutSeconds: 30},
+				{Method: v1.ShutdownMethodACPI, TimeoutSeconds: 30},
+			}}
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Shutoff
+			domain.Status.Reason = reason
+			domain.Spec.Metadata.KubeVirt.GracePeriod = &api.GracePeriodMetadata{
+				DeletionGracePeriodSeconds: 60,
+				DeletionTimestamp:          pointer.P(metav1.Now()),
+				ShutdownMethod:             recordedMethod,
+			}
+
+			controller.updateShutdownMethod(vmi, domain)
+			Expect(vmi.Status.ShutdownMethod).To(Equal(expectedMethod))
+		},
+			Entry("the guest agent", api.ReasonShutdown, string(v1.ShutdownMethodGuestAgent), v1.ShutdownMethodGuestAgent),
+			Entry("ACPI", api.ReasonShutdown, string(v1.ShutdownMethodACPI), v1.ShutdownMethodACPI),
+			Entry("the final destroy", api.ReasonDestroyed, string(v1.ShutdownMethodACPI), v1.ShutdownMethodDestroy),
+		)
+
 		It("should move VirtualMachineInstance to Failed if configuring the networks on the virt-launcher fails with critical error", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -1126,6 +1344,37 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(updatedVMI.Status.Phase).To(Equal(v1.Failed))
 		})
 
+		It("should move VirtualMachineInstance to Failed if starting it would violate the node guardrails", func() {
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				NodeGuardrails: &v1.NodeGuardrailsConfiguration{
+					MaxVirtualMachineInstances: pointer.P(uint32(0)),
+				},
+			})
+			controller.clusterConfig = config
+
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Scheduled
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			createVMI(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, "node already runs 0 VirtualMachineInstances")
+			testutils.ExpectEvent(recorder, VMICrashed)
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.Phase).To(Equal(v1.Failed))
+			Expect(updatedVMI.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(v1.VirtualMachineInstanceSynchronized),
+				"Status":  Equal(k8sv1.ConditionFalse),
+				"Reason":  Equal(v1.VirtualMachineInstanceReasonNodeGuardrailViolated),
+				"Message": Equal("node already runs 0 VirtualMachineInstances, the maximum allowed is 0"),
+			})))
+		})
+
 		It("should remove an error condition if a synchronization run succeeds", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -1284,7 +1533,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 
 				mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), gomock.Any()).Return(nil)
 				mockContainerDiskMounter.EXPECT().ComputeChecksums(gomock.Any()).Return(fakeDiskChecksums, nil)
-				client.EXPECT().SyncVirtualMachine(gomock.Any(), gomock.Any()).Return(nil)
+				client.EXPECT().SyncVirtualMachine(gomock.Any(), gomock.Any()).Return(false, nil)
 				mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), gomock.Any()).Return(nil)
 
 				sanityExecute()
@@ -1433,6 +1682,52 @@ var _ = Describe("VirtualMachineInstance", func() {
 				Expect(hasHotplug).To(BeTrue())
 			})
 
+			It("should report the serial of the volume disk", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.Status.Phase = v1.Running
+				vmi.Status.VolumeStatus = append(vmi.Status.VolumeStatus, v1.VolumeStatus{
+					Name: "test",
+				})
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+				domain.Spec.Devices.Disks = append(domain.Spec.Devices.Disks, api.Disk{
+					Alias:  api.NewUserDefinedAlias("test"),
+					Target: api.DiskTarget{Device: "sda"},
+					Source: api.DiskSource{File: "test"},
+					Serial: "0123456789abcdef0123",
+				})
+				addVMI(vmi, domain)
+				controller.updateVolumeStatusesFromDomain(vmi, domain)
+				Expect(vmi.Status.VolumeStatus[0].Target).To(Equal("sda"))
+				Expect(vmi.Status.VolumeStatus[0].Serial).To(Equal("0123456789abcdef0123"))
+			})
+
+			It("should report the iothreads serving the volume disk and the iothreads of the domain", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.Status.Phase = v1.Running
+				vmi.Status.VolumeStatus = append(vmi.Status.VolumeStatus, v1.VolumeStatus{
+					Name: "test",
+				})
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+				domain.Spec.IOThreads = &api.IOThreads{IOThreads: 3}
+				domain.Spec.Devices.Disks = append(domain.Spec.Devices.Disks, api.Disk{
+					Alias:  api.NewUserDefinedAlias("test"),
+					Target: api.DiskTarget{Device: "vda"},
+					Source: api.DiskSource{File: "test"},
+					Driver: &api.DiskDriver{IOThreads: &api.DiskIOThreads{
+						IOThread: []api.DiskIOThread{{Id: 1}, {Id: 2}},
+					}},
+				})
+				addVMI(vmi, domain)
+				controller.updateVolumeStatusesFromDomain(vmi, domain)
+				controller.updateIOThreadsInfo(vmi, domain)
+				Expect(vmi.Status.VolumeStatus[0].IOThreads).To(Equal([]uint32{1, 2}))
+				Expect(vmi.Status.CurrentIOThreadCount).To(HaveValue(BeEquivalentTo(3)))
+			})
+
 			DescribeTable("should generate a mount event, when able to move to mount", func(currentPhase v1.VolumePhase) {
 				vmi := api2.NewMinimalVMI("testvmi")
 				vmi.UID = vmiTestUUID
@@ -1610,6 +1905,88 @@ var _ = Describe("VirtualMachineInstance", func() {
 			})
 		})
 
+		Context("volume IO errors", func() {
+			It("should report the IO errors of the domain on the volume status", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.Status.Phase = v1.Running
+				vmi.Status.VolumeStatus = []v1.VolumeStatus{{Name: "rootdisk"}, {Name: "datadisk"}}
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+				domain.Status.DiskIOErrors = []api.DiskIOErrors{{
+					VolumeName:    "datadisk",
+					Count:         2,
+					LastError:     "enospc",
+					LastErrorTime: metav1.Now(),
+				}}
+
+				controller.updateVolumeStatusesFromDomain(vmi, domain)
+				Expect(vmi.Status.VolumeStatus).To(HaveLen(2))
+				Expect(vmi.Status.VolumeStatus[0].Name).To(Equal("datadisk"))
+				Expect(vmi.Status.VolumeStatus[0].IOErrors).ToNot(BeNil())
+				Expect(vmi.Status.VolumeStatus[0].IOErrors.Count).To(BeEquivalentTo(2))
+				Expect(vmi.Status.VolumeStatus[0].IOErrors.LastError).To(Equal("enospc"))
+				Expect(vmi.Status.VolumeStatus[1].IOErrors).To(BeNil())
+				testutils.ExpectEvent(recorder, VolumeIOError)
+
+				By("not recording an event again without new errors")
+				controller.updateVolumeStatusesFromDomain(vmi, domain)
+				Expect(recorder.Events).To(BeEmpty())
+
+				By("recording an event on new errors")
+				domain.Status.DiskIOErrors[0].Count = 3
+				controller.updateVolumeStatusesFromDomain(vmi, domain)
+				Expect(vmi.Status.VolumeStatus[0].IOErrors.Count).To(BeEquivalentTo(3))
+				testutils.ExpectEvent(recorder, VolumeIOError)
+			})
+
+			It("should keep the IO errors without domain", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.Status.VolumeStatus = []v1.VolumeStatus{{
+					Name:     "datadisk",
+					IOErrors: &v1.VolumeIOErrors{Count: 1, LastError: "eio"},
+				}}
+
+				controller.updateVolumeStatusesFromDomain(vmi, nil)
+				Expect(vmi.Status.VolumeStatus[0].IOErrors).To(Equal(&v1.VolumeIOErrors{Count: 1, LastError: "eio"}))
+			})
+		})
+
+		Context("volume block jobs", func() {
+			It("should report the block jobs of the domain on the volume status", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.Status.Phase = v1.Running
+				vmi.Status.VolumeStatus = []v1.VolumeStatus{{Name: "rootdisk"}, {Name: "datadisk"}}
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+				remainingSeconds := int64(30)
+				domain.Status.BlockJobs = []api.DiskBlockJob{{
+					VolumeName:       "datadisk",
+					Type:             string(v1.VolumeBlockJobCopy),
+					Progress:         40,
+					BytesPerSecond:   1024,
+					RemainingSeconds: &remainingSeconds,
+				}}
+
+				controller.updateVolumeStatusesFromDomain(vmi, domain)
+				Expect(vmi.Status.VolumeStatus).To(HaveLen(2))
+				Expect(vmi.Status.VolumeStatus[0].Name).To(Equal("datadisk"))
+				Expect(vmi.Status.VolumeStatus[0].BlockJob).To(Equal(&v1.VolumeBlockJob{
+					Type:             v1.VolumeBlockJobCopy,
+					Progress:         40,
+					BytesPerSecond:   1024,
+					RemainingSeconds: &remainingSeconds,
+				}))
+				Expect(vmi.Status.VolumeStatus[1].BlockJob).To(BeNil())
+
+				By("clearing the block job once it is gone")
+				domain.Status.BlockJobs = nil
+				controller.updateVolumeStatusesFromDomain(vmi, domain)
+				Expect(vmi.Status.VolumeStatus[0].BlockJob).To(BeNil())
+			})
+		})
+
 		Context("memory dump status events", func() {
 			It("Should trigger memory dump and generate InProgress event once mounted", func() {
 				vmi := api2.NewMinimalVMI("testvmi")
@@ -2156,6 +2533,36 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(blockMigrate).To(BeTrue())
 			Expect(err).To(Equal(fmt.Errorf("cannot migrate VMI with non-shared HostDisk")))
 		})
+		It("should not be allowed to live-migrate if the VMI uses a vhost-user-blk volume", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Volumes = []v1.Volume{
+				{
+					Name: "myvolume",
+					VolumeSource: v1.VolumeSource{
+						VhostUserBlk: &v1.VhostUserBlkVolumeSource{Path: "/var/tmp/spdk/vhost.0"},
+					},
+				},
+			}
+
+			blockMigrate, err := controller.checkVolumesForMigration(vmi)
+			Expect(blockMigrate).To(BeTrue())
+			Expect(err).To(MatchError("cannot migrate VMI with a vhost-user-blk volume"))
+		})
+		It("should not be allowed to live-migrate if the VMI uses a host block device volume", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Volumes = []v1.Volume{
+				{
+					Name: "myvolume",
+					VolumeSource: v1.VolumeSource{
+						HostBlockDevice: &v1.HostBlockDeviceVolumeSource{Path: "/dev/nvme1n1"},
+					},
+				},
+			}
+
+			blockMigrate, err := controller.checkVolumesForMigration(vmi)
+			Expect(blockMigrate).To(BeTrue())
+			Expect(err).To(MatchError("cannot migrate VMI with a host block device volume"))
+		})
 		DescribeTable("with host model", func(hostCpuModel string) {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.Spec.Domain.CPU = &v1.CPU{Model: v1.CPUModeHostModel}
@@ -32,6 +32,8 @@ type Cache struct {
 	AccessCredential SafeData[api.AccessCredentialMetadata]
 	MemoryDump       SafeData[api.MemoryDumpMetadata]
 	Backup           SafeData[api.BackupMetadata]
+	ImageIntegrity   SafeData[api.ImageIntegrityMetadata]
+	VhostUser        SafeData[api.VhostUserMetadata]
 
 	notificationSignal chan struct{}
 }
@@ -46,6 +48,8 @@ func NewCache() *Cache {
 	cache.AccessCredential.dirtyChanel = cache.notificationSignal
 	cache.MemoryDump.dirtyChanel = cache.notificationSignal
 	cache.Backup.dirtyChanel = cache.notificationSignal
+	cache.ImageIntegrity.dirtyChanel = cache.notificationSignal
+	cache.VhostUser.dirtyChanel = cache.notificationSignal
 	return cache
 }
 
@@ -47,5 +47,11 @@ func LoadKubevirtMetadata(metadataCache *Cache) api.KubeVirtMetadata {
 	if value, exists := metadataCache.MemoryDump.Load(); exists {
 		kubevirtMetadata.MemoryDump = &value
 	}
+	if value, exists := metadataCache.ImageIntegrity.Load(); exists {
+		kubevirtMetadata.ImageIntegrity = &value
+	}
+	if value, exists := metadataCache.VhostUser.Load(); exists {
+		kubevirtMetadata.VhostUser = &value
+	}
 	return kubevirtMetadata
 }
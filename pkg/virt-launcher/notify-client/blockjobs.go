@@ -0,0 +1,119 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package eventsclient
+
+import (
+	"sync"
+	"time"
+
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+const blockJobPollInterval = 5 * time.Second
+
+type blockJobSample struct {
+	jobType libvirt.DomainBlockJobType
+	cur     uint64
+	time    time.Time
+}
+
+// blockJobs samples the block jobs running on the disks of the domain.
+// The throughput of a job is measured between two consecutive samples of it.
+type blockJobs struct {
+	lock    sync.Mutex
+	samples map[string]blockJobSample
+	now     func() time.Time
+}
+
+func newBlockJobs() *blockJobs {
+	return &blockJobs{samples: map[string]blockJobSample{}, now: time.Now}
+}
+
+// sample returns the progress of the block jobs running on the given disks, sorted as the disks
+func (b *blockJobs) sample(dom cli.VirDomain, disks []api.Disk) []api.DiskBlockJob {
+	if b == nil {
+		return nil
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	now := b.now()
+	samples := map[string]blockJobSample{}
+	var jobs []api.DiskBlockJob
+	for _, disk := range disks {
+		if disk.Alias == nil || disk.Target.Device == "" {
+			continue
+		}
+		info, err := dom.GetBlockJobInfo(disk.Target.Device, 0)
+		if err != nil {
+			log.Log.Reason(err).V(4).Infof("Could not get the block job of disk %s", disk.Target.Device)
+			continue
+		}
+		jobType, supported := blockJobTypes[info.Type]
+		if !supported || info.End == 0 {
+			continue
+		}
+
+		volumeName := disk.Alias.GetName()
+		job := api.DiskBlockJob{
+			VolumeName: volumeName,
+			Type:       string(jobType),
+			Progress:   int32(info.Cur * 100 / info.End),
+		}
+		if previous, exists := b.samples[volumeName]; exists && previous.jobType == info.Type && info.Cur >= previous.cur {
+			if elapsed := now.Sub(previous.time).Seconds(); elapsed > 0 {
+				job.BytesPerSecond = int64(float64(info.Cur-previous.cur) / elapsed)
+			}
+			if job.BytesPerSecond > 0 {
+				remainingSeconds := int64(info.End-info.Cur) / job.BytesPerSecond
+				job.RemainingSeconds = &remainingSeconds
+			}
+		}
+		samples[volumeName] = blockJobSample{jobType: info.Type, cur: info.Cur, time: now}
+		jobs = append(jobs, job)
+	}
+	b.samples = samples
+	return jobs
+}
+
+// isActive tells whether block jobs were running on the domain when it was last sampled
+func (b *blockJobs) isActive() bool {
+	if b == nil {
+		return false
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return len(b.samples) > 0
+}
+
+var blockJobTypes = map[libvirt.DomainBlockJobType]v1.VolumeBlockJobType{
+	libvirt.DOMAIN_BLOCK_JOB_TYPE_PULL:          v1.VolumeBlockJobPull,
+	libvirt.DOMAIN_BLOCK_JOB_TYPE_COPY:          v1.VolumeBlockJobCopy,
+	libvirt.DOMAIN_BLOCK_JOB_TYPE_COMMIT:        v1.VolumeBlockJobCommit,
+	libvirt.DOMAIN_BLOCK_JOB_TYPE_ACTIVE_COMMIT: v1.VolumeBlockJobActiveCommit,
+	libvirt.DOMAIN_BLOCK_JOB_TYPE_BACKUP:        v1.VolumeBlockJobBackup,
+}
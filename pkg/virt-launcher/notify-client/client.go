@@ -67,6 +67,8 @@ type libvirtEvent struct {
 	Event             *libvirt.DomainEventLifecycle
 	AgentEvent        *libvirt.DomainEventAgentLifecycle
 	JobCompletedEvent *libvirt.DomainEventJobCompleted
+	IOErrorEvent      *libvirt.DomainEventIOErrorReason
+	RebootEvent       bool
 }
 
 func NewNotifier(virtShareDir string) *Notifier {
@@ -245,6 +247,11 @@ func newWatchEventError(err error) watch.Event {
 type eventCaller struct {
 	domainStatus             api.LifeCycle
 	domainStatusChangeReason api.StateChangeReason
+	diskIOErrors             *diskIOErrors
+	blockJobs                *blockJobs
+	shutdownOrigin           *shutdownOrigin
+	guestPanic               *guestPanic
+	guestWatchdog            *guestWatchdog
 }
 
 func (e *eventCaller) printStatus(status *api.DomainStatus) {
@@ -277,7 +284,7 @@ func (e eventNotifier) UpdateEvents(event watch.Event) {
 
 func (e *eventCaller) eventCallback(c cli.Connection, domain *api.Domain, libvirtEvent libvirtEvent, client *Notifier, events chan watch.Event,
 	interfaceStatus []api.InterfaceStatus, osInfo *api.GuestOSInfo, vmi *v1.VirtualMachineInstance, fsFreezeStatus *api.FSFreeze,
-	metadataCache *metadata.Cache) {
+	guestDrivers *api.GuestDrivers, clockDrift *api.GuestClockDrift, metadataCache *metadata.Cache) {
 
 	d, err := c.LookupDomainByName(util.DomainFromNamespaceName(domain.ObjectMeta.Namespace, domain.ObjectMeta.Name))
 	if err != nil {
@@ -322,6 +329,17 @@ func (e *eventCaller) eventCallback(c cli.Connection, domain *api.Domain, libvir
 
 		e.printStatus(&domain.Status)
 		e.updateStatus(&domain.Status)
+		domain.Status.DiskIOErrors = e.diskIOErrors.list()
+		domain.Status.BlockJobs = e.blockJobs.sample(d, domain.Spec.Devices.Disks)
+		domain.Status.ShutdownOrigin = e.shutdownOrigin.get()
+		domain.Status.GuestPanicked = e.guestPanic.get()
+		domain.Status.WatchdogFired = e.guestWatchdog.get()
+	}
+
+	if libvirtEvent.RebootEvent {
+		if err := client.SendK8sEvent(vmi, "Normal", "GuestReboot", "The guest rebooted"); err != nil {
+			log.Log.Reason(err).Error("Could not send k8s event")
+		}
 	}
 
 	switch domain.Status.Reason {
@@ -372,6 +390,8 @@ func (e *eventCaller) eventCallback(c cli.Connection, domain *api.Domain, libvir
 		if fsFreezeStatus != nil {
 			domain.Status.FSFreezeStatus = *fsFreezeStatus
 		}
+		domain.Status.GuestDrivers = guestDrivers
+		domain.Status.ClockDrift = clockDrift
 
 		err := client.SendDomainEvent(watch.Event{Type: watch.Modified, Object: domain})
 		if err != nil {
@@ -412,21 +432,32 @@ func (n *Notifier) StartDomainNotifier(
 		qemuAgentUserInterval,
 		qemuAgentVersionInterval,
 		qemuAgentFSFreezeStatusInterval,
+		disableMemoryStatsWithoutDriver(vmi),
 	)
 
+	ioErrors := newDiskIOErrors()
+	shutdown := &shutdownOrigin{}
+	panicked := &guestPanic{}
+	watchdog := &guestWatchdog{}
+
 	// Run the event process logic in a separate go-routine to not block libvirt
 	go func() {
 		var interfaceStatuses []api.InterfaceStatus
 		var guestOsInfo *api.GuestOSInfo
 		var fsFreezeStatus *api.FSFreeze
-		var eventCaller eventCaller
+		var guestDrivers *api.GuestDrivers
+		var clockDrift *api.GuestClockDrift
+		eventCaller := eventCaller{diskIOErrors: ioErrors, blockJobs: newBlockJobs(), shutdownOrigin: shutdown, guestPanic: panicked, guestWatchdog: watchdog}
+		// Block jobs only report their progress when polled, the domain is refreshed while
+		// the last refresh found jobs running on it
+		var blockJobPoll <-chan time.Time
 
 		for {
 			select {
 			case event := <-eventChan:
 				metadataCache.ResetNotification()
 				domainCache = util.NewDomainFromName(event.Domain, vmi.UID)
-				eventCaller.eventCallback(domainConn, domainCache, event, n, deleteNotificationSent, interfaceStatuses, guestOsInfo, vmi, fsFreezeStatus, metadataCache)
+				eventCaller.eventCallback(domainConn, domainCache, event, n, deleteNotificationSent, interfaceStatuses, guestOsInfo, vmi, fsFreezeStatus, guestDrivers, clockDrift, metadataCache)
 				log.Log.Infof("Domain name event: %v", domainCache.Spec.Name)
 				agentPoller.UpdateFromEvent(event.Event, event.AgentEvent)
 			case agentUpdate := <-agentStore.AgentUpdated:
@@ -434,9 +465,21 @@ func (n *Notifier) StartDomainNotifier(
 				interfaceStatuses = agentUpdate.DomainInfo.Interfaces
 				guestOsInfo = agentUpdate.DomainInfo.OSInfo
 				fsFreezeStatus = agentUpdate.DomainInfo.FSFreezeStatus
+				guestDrivers = agentUpdate.DomainInfo.GuestDrivers
+				clockDrift = agentUpdate.DomainInfo.ClockDrift
 
 				eventCaller.eventCallback(domainConn, domainCache, libvirtEvent{}, n, deleteNotificationSent,
-					interfaceStatuses, guestOsInfo, vmi, fsFreezeStatus, metadataCache)
+					interfaceStatuses, guestOsInfo, vmi, fsFreezeStatus, guestDrivers, clockDrift, metadataCache)
+			case <-blockJobPoll:
+				blockJobPoll = nil
+				if domainCache != nil {
+					domainCache = util.NewDomainFromName(
+						util.DomainFromNamespaceName(domainCache.ObjectMeta.Namespace, domainCache.ObjectMeta.Name),
+						vmi.UID,
+					)
+					eventCaller.eventCallback(domainConn, domainCache, libvirtEvent{}, n, deleteNotificationSent,
+						interfaceStatuses, guestOsInfo, vmi, fsFreezeStatus, guestDrivers, clockDrift, metadataCache)
+				}
 			case <-reconnectChan:
 				n.SendDomainEvent(newWatchEventError(fmt.Errorf("Libvirt reconnect, domain %s", domainName)))
 
@@ -458,16 +501,24 @@ func (n *Notifier) StartDomainNotifier(
 						guestOsInfo,
 						vmi,
 						fsFreezeStatus,
+						guestDrivers,
+						clockDrift,
 						metadataCache,
 					)
 				}
 			}
+
+			if blockJobPoll == nil && eventCaller.blockJobs.isActive() {
+				blockJobPoll = time.After(blockJobPollInterval)
+			}
 		}
 	}()
 
 	domainEventLifecycleCallback := func(c *libvirt.Connect, d *libvirt.Domain, event *libvirt.DomainEventLifecycle) {
 
 		log.Log.Infof("DomainLifecycle event %s with event id %d reason %d received", event.String(), event.Event, event.Detail)
+		shutdown.record(event)
+		panicked.record(event)
 		name, err := d.GetName()
 		if err != nil {
 			log.Log.Reason(err).Info(cantDetermineLibvirtDomainName)
@@ -532,6 +583,60 @@ func (n *Notifier) StartDomainNotifier(
 		}
 	}
 
+	domainEventIOErrorReasonCallback := func(c *libvirt.Connect, d *libvirt.Domain, event *libvirt.DomainEventIOErrorReason) {
+		log.Log.Warningf("Domain IO error event received: device %s, path %s, action %d, reason %s", event.DevAlias, event.SrcPath, event.Action, event.Reason)
+		ioErrors.record(event.DevAlias, event.Reason)
+		name, err := d.GetName()
+		if err != nil {
+			log.Log.Reason(err).Info(cantDetermineLibvirtDomainName)
+		}
+		select {
+		case eventChan <- libvirtEvent{IOErrorEvent: event, Domain: name}:
+		default:
+			log.Log.Infof(libvirtEventChannelFull)
+		}
+	}
+
+	domainEventRebootCallback := func(c *libvirt.Connect, d *libvirt.Domain) {
+		log.Log.Infof("Domain reboot event received")
+		name, err := d.GetName()
+		if err != nil {
+			log.Log.Reason(err).Info(cantDetermineLibvirtDomainName)
+		}
+		select {
+		case eventChan <- libvirtEvent{RebootEvent: true, Domain: name}:
+		default:
+			log.Log.Infof(libvirtEventChannelFull)
+		}
+	}
+
+	domainEventWatchdogCallback := func(c *libvirt.Connect, d *libvirt.Domain, event *libvirt.DomainEventWatchdog) {
+		log.Log.Warningf("Domain watchdog event received: action %d", event.Action)
+		watchdog.record(event)
+		name, err := d.GetName()
+		if err != nil {
+			log.Log.Reason(err).Info(cantDetermineLibvirtDomainName)
+		}
+		select {
+		case eventChan <- libvirtEvent{Domain: name}:
+		default:
+			log.Log.Infof(libvirtEventChannelFull)
+		}
+	}
+
+	domainEventBlockJobCallback := func(c *libvirt.Connect, d *libvirt.Domain, event *libvirt.DomainEventBlockJob) {
+		log.Log.Infof("Domain block job event type %d with status %d received for disk %s", event.Type, event.Status, event.Disk)
+		name, err := d.GetName()
+		if err != nil {
+			log.Log.Reason(err).Info(cantDetermineLibvirtDomainName)
+		}
+		select {
+		case eventChan <- libvirtEvent{Domain: name}:
+		default:
+			log.Log.Infof(libvirtEventChannelFull)
+		}
+	}
+
 	err := domainConn.DomainEventLifecycleRegister(domainEventLifecycleCallback)
 	if err != nil {
 		log.Log.Reason(err).Errorf("failed to register event callback with libvirt")
@@ -558,6 +663,26 @@ func (n *Notifier) StartDomainNotifier(
 		log.Log.Reason(err).Errorf("failed to register event job completed callback with libvirt")
 		return err
 	}
+	err = domainConn.DomainEventIOErrorReasonRegister(domainEventIOErrorReasonCallback)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to register IO error event callback with libvirt")
+		return err
+	}
+	err = domainConn.DomainEventRebootRegister(domainEventRebootCallback)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to register reboot event callback with libvirt")
+		return err
+	}
+	err = domainConn.DomainEventWatchdogRegister(domainEventWatchdogCallback)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to register watchdog event callback with libvirt")
+		return err
+	}
+	err = domainConn.DomainEventBlockJobRegister(domainEventBlockJobCallback)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to register block job event callback with libvirt")
+		return err
+	}
 
 	agentEventLifecycleCallback := func(c *libvirt.Connect, d *libvirt.Domain, event *libvirt.DomainEventAgentLifecycle) {
 		log.Log.Infof("GuestAgentLifecycle event state %d with reason %d received", event.State, event.Reason)
@@ -581,6 +706,11 @@ func (n *Notifier) StartDomainNotifier(
 	return nil
 }
 
+func disableMemoryStatsWithoutDriver(vmi *v1.VirtualMachineInstance) bool {
+	balloon := vmi.Spec.Domain.Devices.Balloon
+	return balloon != nil && balloon.DisableStatsWithoutDriver != nil && *balloon.DisableStatsWithoutDriver
+}
+
 func (n *Notifier) SendK8sEvent(vmi *v1.VirtualMachineInstance, severity string, reason string, message string) error {
 	vmiRef, err := reference.GetReference(scheme, vmi)
 	if err != nil {
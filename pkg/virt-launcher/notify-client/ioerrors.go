@@ -0,0 +1,79 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package eventsclient
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// diskIOErrors counts the IO errors libvirt reports per volume.
+// Errors are counted when libvirt reports them, and not when the event is processed,
+// so that no error is missed when the event channel is full.
+type diskIOErrors struct {
+	lock   sync.Mutex
+	errors map[string]*api.DiskIOErrors
+}
+
+func newDiskIOErrors() *diskIOErrors {
+	return &diskIOErrors{errors: map[string]*api.DiskIOErrors{}}
+}
+
+// record counts an IO error on the disk with the given alias
+func (d *diskIOErrors) record(devAlias, reason string) {
+	volumeName := strings.TrimPrefix(devAlias, api.UserAliasPrefix)
+	if volumeName == "" {
+		return
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	ioErrors, exists := d.errors[volumeName]
+	if !exists {
+		ioErrors = &api.DiskIOErrors{VolumeName: volumeName}
+		d.errors[volumeName] = ioErrors
+	}
+	ioErrors.Count++
+	ioErrors.LastError = reason
+	ioErrors.LastErrorTime = metav1.Now()
+}
+
+// list returns the IO errors of all volumes, sorted by volume name
+func (d *diskIOErrors) list() []api.DiskIOErrors {
+	if d == nil {
+		return nil
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	var list []api.DiskIOErrors
+	for _, ioErrors := range d.errors {
+		list = append(list, *ioErrors)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].VolumeName < list[j].VolumeName
+	})
+	return list
+}
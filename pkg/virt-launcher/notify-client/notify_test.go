@@ -107,7 +107,7 @@ var _ = Describe("Notify", func() {
 				mockLibvirt.DomainEXPECT().GetName().Return("test", nil).AnyTimes()
 				mockLibvirt.DomainEXPECT().GetXMLDesc(gomock.Eq(libvirt.DomainXMLFlags(0))).Return(string(x), nil)
 
-				e.eventCallback(mockLibvirt.VirtConnection, util.NewDomainFromName("test", "1234"), libvirtEvent{Event: &libvirt.DomainEventLifecycle{Event: event}}, client, deleteNotificationSent, nil, nil, nil, nil, metadataCache())
+				e.eventCallback(mockLibvirt.VirtConnection, util.NewDomainFromName("test", "1234"), libvirtEvent{Event: &libvirt.DomainEventLifecycle{Event: event}}, client, deleteNotificationSent, nil, nil, nil, nil, nil, nil, metadataCache())
 
 				timedOut := false
 				timeout := time.After(2 * time.Second)
@@ -138,7 +138,7 @@ var _ = Describe("Notify", func() {
 				mockLibvirt.DomainEXPECT().GetState().Return(libvirt.DOMAIN_NOSTATE, -1, libvirt.Error{Code: libvirt.ERR_NO_DOMAIN})
 				mockLibvirt.DomainEXPECT().GetName().Return("test", nil).AnyTimes()
 
-				e.eventCallback(mockLibvirt.VirtConnection, util.NewDomainFromName("test", "1234"), libvirtEvent{Event: &libvirt.DomainEventLifecycle{Event: libvirt.DOMAIN_EVENT_UNDEFINED}}, client, deleteNotificationSent, nil, nil, nil, nil, metadataCache())
+				e.eventCallback(mockLibvirt.VirtConnection, util.NewDomainFromName("test", "1234"), libvirtEvent{Event: &libvirt.DomainEventLifecycle{Event: libvirt.DOMAIN_EVENT_UNDEFINED}}, client, deleteNotificationSent, nil, nil, nil, nil, nil, nil, metadataCache())
 
 				timedOut := false
 				timeout := time.After(2 * time.Second)
@@ -178,7 +178,7 @@ var _ = Describe("Notify", func() {
 					},
 				}
 
-				e.eventCallback(mockLibvirt.VirtConnection, util.NewDomainFromName("test", "1234"), libvirtEvent{}, client, deleteNotificationSent, interfaceStatus, nil, nil, nil, metadataCache())
+				e.eventCallback(mockLibvirt.VirtConnection, util.NewDomainFromName("test", "1234"), libvirtEvent{}, client, deleteNotificationSent, interfaceStatus, nil, nil, nil, nil, nil, metadataCache())
 
 				timedOut := false
 				timeout := time.After(2 * time.Second)
@@ -209,7 +209,7 @@ var _ = Describe("Notify", func() {
 					Name: guestOsName,
 				}
 
-				e.eventCallback(mockLibvirt.VirtConnection, util.NewDomainFromName("test", "1234"), libvirtEvent{}, client, deleteNotificationSent, nil, &osInfoStatus, nil, nil, metadataCache())
+				e.eventCallback(mockLibvirt.VirtConnection, util.NewDomainFromName("test", "1234"), libvirtEvent{}, client, deleteNotificationSent, nil, &osInfoStatus, nil, nil, nil, nil, metadataCache())
 
 				timedOut := false
 				timeout := time.After(2 * time.Second)
@@ -239,7 +239,7 @@ var _ = Describe("Notify", func() {
 					Status: fsFrozenStatus,
 				}
 
-				e.eventCallback(mockLibvirt.VirtConnection, util.NewDomainFromName("test", "1234"), libvirtEvent{}, client, deleteNotificationSent, nil, nil, nil, &fsFreezeStatus, metadataCache())
+				e.eventCallback(mockLibvirt.VirtConnection, util.NewDomainFromName("test", "1234"), libvirtEvent{}, client, deleteNotificationSent, nil, nil, nil, &fsFreezeStatus, nil, nil, metadataCache())
 
 				timedOut := false
 				timeout := time.After(2 * time.Second)
@@ -253,6 +253,225 @@ var _ = Describe("Notify", func() {
 				}
 				Expect(timedOut).To(BeFalse())
 			})
+
+		It("should update the disk IO errors",
+			func() {
+				domain := api.NewMinimalDomain("test")
+				x, err := xml.Marshal(domain.Spec)
+				Expect(err).ToNot(HaveOccurred())
+				mockLibvirt.DomainEXPECT().Free()
+				mockLibvirt.DomainEXPECT().GetState().Return(libvirt.DOMAIN_RUNNING, -1, nil)
+				mockLibvirt.DomainEXPECT().GetName().Return("test", nil).AnyTimes()
+				mockLibvirt.DomainEXPECT().GetXMLDesc(gomock.Eq(libvirt.DomainXMLFlags(0))).Return(string(x), nil)
+
+				e.diskIOErrors = newDiskIOErrors()
+				e.diskIOErrors.record("ua-rootdisk", "eio")
+				e.diskIOErrors.record("ua-datadisk", "eio")
+				e.diskIOErrors.record("ua-datadisk", "enospc")
+				ioErrorEvent := &libvirt.DomainEventIOErrorReason{DevAlias: "ua-datadisk", Action: libvirt.DOMAIN_EVENT_IO_ERROR_REPORT, Reason: "enospc"}
+
+				e.eventCallback(mockLibvirt.VirtConnection, util.NewDomainFromName("test", "1234"), libvirtEvent{IOErrorEvent: ioErrorEvent}, client, deleteNotificationSent, nil, nil, nil, nil, nil, nil, metadataCache())
+
+				timedOut := false
+				timeout := time.After(2 * time.Second)
+				select {
+				case <-timeout:
+					timedOut = true
+				case event := <-eventChan:
+					newDomain, _ := event.Object.(*api.Domain)
+					Expect(newDomain.Status.DiskIOErrors).To(HaveLen(2))
+					Expect(newDomain.Status.DiskIOErrors[0].VolumeName).To(Equal("datadisk"))
+					Expect(newDomain.Status.DiskIOErrors[0].Count).To(BeEquivalentTo(2))
+					Expect(newDomain.Status.DiskIOErrors[0].LastError).To(Equal("enospc"))
+					Expect(newDomain.Status.DiskIOErrors[1].VolumeName).To(Equal("rootdisk"))
+					Expect(newDomain.Status.DiskIOErrors[1].Count).To(BeEquivalentTo(1))
+				}
+				Expect(timedOut).To(BeFalse())
+			})
+
+		It("should report the progress of the block jobs",
+			func() {
+				domain := api.NewMinimalDomain("test")
+				domain.Spec.Devices.Disks = []api.Disk{
+					{Target: api.DiskTarget{Device: "vda"}, Alias: api.NewUserDefinedAlias("rootdisk")},
+					{Target: api.DiskTarget{Device: "vdb"}, Alias: api.NewUserDefinedAlias("datadisk")},
+				}
+				x, err := xml.Marshal(domain.Spec)
+				Expect(err).ToNot(HaveOccurred())
+				mockLibvirt.DomainEXPECT().Free()
+				mockLibvirt.DomainEXPECT().GetState().Return(libvirt.DOMAIN_RUNNING, -1, nil)
+				mockLibvirt.DomainEXPECT().GetName().Return("test", nil).AnyTimes()
+				mockLibvirt.DomainEXPECT().GetXMLDesc(gomock.Eq(libvirt.DomainXMLFlags(0))).Return(string(x), nil)
+				mockLibvirt.DomainEXPECT().GetBlockJobInfo("vda", libvirt.DomainBlockJobInfoFlags(0)).Return(&libvirt.DomainBlockJobInfo{}, nil)
+				mockLibvirt.DomainEXPECT().GetBlockJobInfo("vdb", libvirt.DomainBlockJobInfoFlags(0)).Return(&libvirt.DomainBlockJobInfo{
+					Type: libvirt.DOMAIN_BLOCK_JOB_TYPE_COPY, Cur: 512, End: 1024,
+				}, nil)
+
+				e.blockJobs = newBlockJobs()
+				e.eventCallback(mockLibvirt.VirtConnection, util.NewDomainFromName("test", "1234"), libvirtEvent{}, client, deleteNotificationSent, nil, nil, nil, nil, nil, nil, metadataCache())
+
+				timedOut := false
+				timeout := time.After(2 * time.Second)
+				select {
+				case <-timeout:
+					timedOut = true
+				case event := <-eventChan:
+					newDomain, _ := event.Object.(*api.Domain)
+					Expect(newDomain.Status.BlockJobs).To(Equal([]api.DiskBlockJob{
+						{VolumeName: "datadisk", Type: string(v1.VolumeBlockJobCopy), Progress: 50},
+					}))
+				}
+				Expect(timedOut).To(BeFalse())
+				Expect(e.blockJobs.isActive()).To(BeTrue())
+			})
+
+		It("should report a shutdown requested by the guest",
+			func() {
+				domain := api.NewMinimalDomain("test")
+				x, err := xml.Marshal(domain.Spec)
+				Expect(err).ToNot(HaveOccurred())
+				mockLibvirt.DomainEXPECT().Free()
+				mockLibvirt.DomainEXPECT().GetState().Return(libvirt.DOMAIN_SHUTDOWN, int(libvirt.DOMAIN_SHUTDOWN_USER), nil)
+				mockLibvirt.DomainEXPECT().GetName().Return("test", nil).AnyTimes()
+				mockLibvirt.DomainEXPECT().GetXMLDesc(gomock.Eq(libvirt.DomainXMLFlags(0))).Return(string(x), nil)
+
+				e.shutdownOrigin = &shutdownOrigin{}
+				shutdownEvent := &libvirt.DomainEventLifecycle{Event: libvirt.DOMAIN_EVENT_SHUTDOWN, Detail: int(libvirt.DOMAIN_EVENT_SHUTDOWN_GUEST)}
+				e.shutdownOrigin.record(shutdownEvent)
+				e.shutdownOrigin.record(&libvirt.DomainEventLifecycle{Event: libvirt.DOMAIN_EVENT_SHUTDOWN, Detail: int(libvirt.DOMAIN_EVENT_SHUTDOWN_FINISHED)})
+				e.shutdownOrigin.record(&libvirt.DomainEventLifecycle{Event: libvirt.DOMAIN_EVENT_STOPPED, Detail: int(libvirt.DOMAIN_EVENT_STOPPED_SHUTDOWN)})
+
+				e.eventCallback(mockLibvirt.VirtConnection, util.NewDomainFromName("test", "1234"), libvirtEvent{Event: shutdownEvent}, client, deleteNotificationSent, nil, nil, nil, nil, nil, nil, metadataCache())
+
+				timedOut := false
+				timeout := time.After(2 * time.Second)
+				select {
+				case <-timeout:
+					timedOut = true
+				case event := <-eventChan:
+					newDomain, _ := event.Object.(*api.Domain)
+					Expect(newDomain.Status.Status).To(Equal(api.Shutdown))
+					Expect(newDomain.Status.ShutdownOrigin).To(Equal(api.ShutdownOriginGuest))
+				}
+				Expect(timedOut).To(BeFalse())
+			})
+
+		It("should report a guest panic after the domain was destroyed",
+			func() {
+				domain := api.NewMinimalDomain("test")
+				x, err := xml.Marshal(domain.Spec)
+				Expect(err).ToNot(HaveOccurred())
+				mockLibvirt.DomainEXPECT().Free()
+				mockLibvirt.DomainEXPECT().GetState().Return(libvirt.DOMAIN_SHUTOFF, int(libvirt.DOMAIN_SHUTOFF_CRASHED), nil)
+				mockLibvirt.DomainEXPECT().GetName().Return("test", nil).AnyTimes()
+				mockLibvirt.DomainEXPECT().GetXMLDesc(gomock.Eq(libvirt.DomainXMLFlags(0))).Return(string(x), nil)
+
+				e.guestPanic = &guestPanic{}
+				e.guestPanic.record(&libvirt.DomainEventLifecycle{Event: libvirt.DOMAIN_EVENT_CRASHED, Detail: int(libvirt.DOMAIN_EVENT_CRASHED_PANICKED)})
+				stoppedEvent := &libvirt.DomainEventLifecycle{Event: libvirt.DOMAIN_EVENT_STOPPED, Detail: int(libvirt.DOMAIN_EVENT_STOPPED_CRASHED)}
+				e.guestPanic.record(stoppedEvent)
+
+				e.eventCallback(mockLibvirt.VirtConnection, util.NewDomainFromName("test", "1234"), libvirtEvent{Event: stoppedEvent}, client, deleteNotificationSent, nil, nil, nil, nil, nil, nil, metadataCache())
+
+				timedOut := false
+				timeout := time.After(2 * time.Second)
+				select {
+				case <-timeout:
+					timedOut = true
+				case event := <-eventChan:
+					newDomain, _ := event.Object.(*api.Domain)
+					Expect(newDomain.Status.Status).To(Equal(api.Shutoff))
+					Expect(newDomain.Status.Reason).To(Equal(api.ReasonCrashed))
+					Expect(newDomain.Status.GuestPanicked).To(BeTrue())
+				}
+				Expect(timedOut).To(BeFalse())
+			})
+
+		It("should report a watchdog expiration after the domain was powered off",
+			func() {
+				domain := api.NewMinimalDomain("test")
+				x, err := xml.Marshal(domain.Spec)
+				Expect(err).ToNot(HaveOccurred())
+				mockLibvirt.DomainEXPECT().Free()
+				mockLibvirt.DomainEXPECT().GetState().Return(libvirt.DOMAIN_SHUTOFF, int(libvirt.DOMAIN_SHUTOFF_DESTROYED), nil)
+				mockLibvirt.DomainEXPECT().GetName().Return("test", nil).AnyTimes()
+				mockLibvirt.DomainEXPECT().GetXMLDesc(gomock.Eq(libvirt.DomainXMLFlags(0))).Return(string(x), nil)
+
+				e.guestWatchdog = &guestWatchdog{}
+				e.guestWatchdog.record(&libvirt.DomainEventWatchdog{Action: libvirt.DOMAIN_EVENT_WATCHDOG_POWEROFF})
+				stoppedEvent := &libvirt.DomainEventLifecycle{Event: libvirt.DOMAIN_EVENT_STOPPED, Detail: int(libvirt.DOMAIN_EVENT_STOPPED_DESTROYED)}
+
+				e.eventCallback(mockLibvirt.VirtConnection, util.NewDomainFromName("test", "1234"), libvirtEvent{Event: stoppedEvent}, client, deleteNotificationSent, nil, nil, nil, nil, nil, nil, metadataCache())
+
+				timedOut := false
+				timeout := time.After(2 * time.Second)
+				select {
+				case <-timeout:
+					timedOut = true
+				case event := <-eventChan:
+					newDomain, _ := event.Object.(*api.Domain)
+					Expect(newDomain.Status.Status).To(Equal(api.Shutoff))
+					Expect(newDomain.Status.WatchdogFired).To(BeTrue())
+					Expect(newDomain.Status.GuestPanicked).To(BeFalse())
+				}
+				Expect(timedOut).To(BeFalse())
+			})
+	})
+
+	Describe("Block jobs", func() {
+		var mockLibvirt *testing.Libvirt
+		var jobs *blockJobs
+		var now time.Time
+
+		disks := []api.Disk{{Target: api.DiskTarget{Device: "vda"}, Alias: api.NewUserDefinedAlias("rootdisk")}}
+
+		BeforeEach(func() {
+			mockLibvirt = testing.NewLibvirt(gomock.NewController(GinkgoT()))
+			now = time.Now()
+			jobs = newBlockJobs()
+			jobs.now = func() time.Time { return now }
+		})
+
+		expectBlockJob := func(jobType libvirt.DomainBlockJobType, cur, end uint64) {
+			mockLibvirt.DomainEXPECT().GetBlockJobInfo("vda", libvirt.DomainBlockJobInfoFlags(0)).Return(&libvirt.DomainBlockJobInfo{
+				Type: jobType, Cur: cur, End: end,
+			}, nil)
+		}
+
+		It("should measure the throughput of a job between two samples", func() {
+			expectBlockJob(libvirt.DOMAIN_BLOCK_JOB_TYPE_BACKUP, 1000, 10000)
+			Expect(jobs.sample(mockLibvirt.VirtDomain, disks)).To(Equal([]api.DiskBlockJob{
+				{VolumeName: "rootdisk", Type: string(v1.VolumeBlockJobBackup), Progress: 10},
+			}))
+
+			now = now.Add(2 * time.Second)
+			expectBlockJob(libvirt.DOMAIN_BLOCK_JOB_TYPE_BACKUP, 3000, 10000)
+			remainingSeconds := int64(7)
+			Expect(jobs.sample(mockLibvirt.VirtDomain, disks)).To(Equal([]api.DiskBlockJob{
+				{VolumeName: "rootdisk", Type: string(v1.VolumeBlockJobBackup), Progress: 30, BytesPerSecond: 1000, RemainingSeconds: &remainingSeconds},
+			}))
+		})
+
+		It("should not measure the throughput across different jobs", func() {
+			expectBlockJob(libvirt.DOMAIN_BLOCK_JOB_TYPE_COPY, 1000, 10000)
+			jobs.sample(mockLibvirt.VirtDomain, disks)
+
+			now = now.Add(2 * time.Second)
+			expectBlockJob(libvirt.DOMAIN_BLOCK_JOB_TYPE_ACTIVE_COMMIT, 3000, 10000)
+			Expect(jobs.sample(mockLibvirt.VirtDomain, disks)).To(Equal([]api.DiskBlockJob{
+				{VolumeName: "rootdisk", Type: string(v1.VolumeBlockJobActiveCommit), Progress: 30},
+			}))
+		})
+
+		It("should forget the jobs once they are gone", func() {
+			expectBlockJob(libvirt.DOMAIN_BLOCK_JOB_TYPE_COMMIT, 1000, 10000)
+			Expect(jobs.sample(mockLibvirt.VirtDomain, disks)).To(HaveLen(1))
+			Expect(jobs.isActive()).To(BeTrue())
+
+			expectBlockJob(0, 0, 0)
+			Expect(jobs.sample(mockLibvirt.VirtDomain, disks)).To(BeEmpty())
+			Expect(jobs.isActive()).To(BeFalse())
+		})
 	})
 
 	Describe("K8s Events", func() {
@@ -346,7 +565,7 @@ var _ = Describe("Notify", func() {
 			eventReason := "IOerror"
 			eventMessage := "VM Paused due to not enough space on volume: "
 			metadataCache := metadata.NewCache()
-			e.eventCallback(mockLibvirt.VirtConnection, domain, libvirtEvent{}, client, deleteNotificationSent, nil, nil, vmi, nil, metadataCache)
+			e.eventCallback(mockLibvirt.VirtConnection, domain, libvirtEvent{}, client, deleteNotificationSent, nil, nil, vmi, nil, nil, nil, metadataCache)
 			event := <-recorder.Events
 			Expect(event).To(Equal(fmt.Sprintf("%s %s %s involvedObject{kind=VirtualMachineInstance,apiVersion=kubevirt.io/v1}", eventType, eventReason, eventMessage)))
 		})
@@ -0,0 +1,52 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package eventsclient
+
+import (
+	"sync/atomic"
+
+	"libvirt.org/go/libvirt"
+)
+
+// guestPanic keeps whether a panic device of the domain reported a guest kernel panic.
+// Like the shutdown origin, it is recorded when libvirt reports the event, so that it is not missed
+// when the event channel is full or when the domain is destroyed right after the panic.
+type guestPanic struct {
+	panicked atomic.Bool
+}
+
+// record keeps a crash event reporting a guest panic, other events are ignored
+func (g *guestPanic) record(event *libvirt.DomainEventLifecycle) {
+	if event.Event != libvirt.DOMAIN_EVENT_CRASHED {
+		return
+	}
+
+	switch libvirt.DomainEventCrashedDetailType(event.Detail) {
+	case libvirt.DOMAIN_EVENT_CRASHED_PANICKED, libvirt.DOMAIN_EVENT_CRASHED_CRASHLOADED:
+		g.panicked.Store(true)
+	}
+}
+
+func (g *guestPanic) get() bool {
+	if g == nil {
+		return false
+	}
+	return g.panicked.Load()
+}
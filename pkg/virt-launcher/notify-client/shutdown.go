@@ -0,0 +1,67 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package eventsclient
+
+import (
+	"sync"
+
+	"libvirt.org/go/libvirt"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// shutdownOrigin keeps who requested the domain to shut down.
+// It is recorded when libvirt reports the event, and not when the event is processed,
+// so that it is not missed when the event channel is full.
+type shutdownOrigin struct {
+	lock   sync.Mutex
+	origin api.ShutdownOrigin
+}
+
+// record keeps the origin of a shutdown event, other events are ignored
+func (s *shutdownOrigin) record(event *libvirt.DomainEventLifecycle) {
+	if event.Event != libvirt.DOMAIN_EVENT_SHUTDOWN {
+		return
+	}
+
+	var origin api.ShutdownOrigin
+	switch libvirt.DomainEventShutdownDetailType(event.Detail) {
+	case libvirt.DOMAIN_EVENT_SHUTDOWN_GUEST:
+		origin = api.ShutdownOriginGuest
+	case libvirt.DOMAIN_EVENT_SHUTDOWN_HOST:
+		origin = api.ShutdownOriginHost
+	default:
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.origin = origin
+}
+
+func (s *shutdownOrigin) get() api.ShutdownOrigin {
+	if s == nil {
+		return ""
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.origin
+}
@@ -0,0 +1,44 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package eventsclient
+
+import (
+	"sync/atomic"
+
+	"libvirt.org/go/libvirt"
+)
+
+// guestWatchdog keeps whether the watchdog device of the domain expired because the guest stopped servicing it.
+// Like a guest panic, it is kept for the lifetime of the domain, as the watchdog action may reset
+// or power off the guest right after the expiration.
+type guestWatchdog struct {
+	fired atomic.Bool
+}
+
+func (g *guestWatchdog) record(_ *libvirt.DomainEventWatchdog) {
+	g.fired.Store(true)
+}
+
+func (g *guestWatchdog) get() bool {
+	if g == nil {
+		return false
+	}
+	return g.fired.Load()
+}
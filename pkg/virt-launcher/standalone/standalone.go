@@ -44,7 +44,7 @@ func HandleStandaloneMode(domainManager virtwrap.DomainManager) {
 		}
 
 		log.Log.Object(&vmi).Infof("Standalone mode: syncing VMI")
-		if _, err := domainManager.SyncVMI(&vmi, true, nil); err != nil {
+		if _, _, err := domainManager.SyncVMI(&vmi, true, nil); err != nil {
 			log.Log.Object(&vmi).Reason(err).Error("Failed to sync VMI, quitting")
 			panic(err)
 		}
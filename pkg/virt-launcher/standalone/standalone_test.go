@@ -65,7 +65,7 @@ var _ = Describe("HandleStandaloneMode", func() {
 		os.Setenv("STANDALONE_VMI", vmiJSON)
 		defer os.Unsetenv("STANDALONE_VMI")
 
-		mockDM.EXPECT().SyncVMI(gomock.Any(), true, nil).Return(nil, fmt.Errorf("sync error"))
+		mockDM.EXPECT().SyncVMI(gomock.Any(), true, nil).Return(nil, false, fmt.Errorf("sync error"))
 
 		Expect(func() {
 			standalone.HandleStandaloneMode(mockDM)
@@ -77,7 +77,7 @@ var _ = Describe("HandleStandaloneMode", func() {
 		os.Setenv("STANDALONE_VMI", vmiJSON)
 		defer os.Unsetenv("STANDALONE_VMI")
 
-		mockDM.EXPECT().SyncVMI(gomock.Any(), true, nil).Return(nil, nil)
+		mockDM.EXPECT().SyncVMI(gomock.Any(), true, nil).Return(nil, false, nil)
 
 		Expect(func() {
 			standalone.HandleStandaloneMode(mockDM)
@@ -92,7 +92,7 @@ metadata:
 		os.Setenv("STANDALONE_VMI", vmiYAML)
 		defer os.Unsetenv("STANDALONE_VMI")
 
-		mockDM.EXPECT().SyncVMI(gomock.Any(), true, nil).Return(nil, nil)
+		mockDM.EXPECT().SyncVMI(gomock.Any(), true, nil).Return(nil, false, nil)
 
 		Expect(func() {
 			standalone.HandleStandaloneMode(mockDM)
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"time"
 
 	"kubevirt.io/client-go/log"
 
@@ -62,6 +63,27 @@ type AgentInfo struct {
 	SupportedCommands []v1.GuestAgentCommandInfo `json:"supported_commands,omitempty"`
 }
 
+// GuestDevice of the guest, with the driver it is bound to
+type GuestDevice struct {
+	DriverName    string        `json:"driver-name"`
+	DriverVersion string        `json:"driver-version,omitempty"`
+	ID            GuestDeviceID `json:"id"`
+}
+
+// GuestDeviceID identifies a device of the guest
+type GuestDeviceID struct {
+	Type     string `json:"type"`
+	VendorID int    `json:"vendor-id"`
+	DeviceID int    `json:"device-id"`
+}
+
+const (
+	virtioVendorID = 0x1af4
+	// the transitional and modern device IDs of the virtio balloon
+	virtioBalloonTransitionalDeviceID = 0x1002
+	virtioBalloonDeviceID             = 0x1045
+)
+
 // parseFSFreezeStatus from the agent response
 func ParseFSFreezeStatus(agentReply string) (api.FSFreeze, error) {
 	response := stripAgentStringResponse(agentReply)
@@ -112,6 +134,44 @@ func parseFSDisks(fsDisks []FSDisk) []api.FSDisk {
 	return disks
 }
 
+// parseGuestDrivers from the agent response. The guest agent reports the devices which
+// are bound to a driver only.
+func parseGuestDrivers(agentReply string) (api.GuestDrivers, error) {
+	devices := []GuestDevice{}
+	response := stripAgentResponse(agentReply)
+
+	if err := json.Unmarshal([]byte(response), &devices); err != nil {
+		return api.GuestDrivers{}, err
+	}
+
+	drivers := api.GuestDrivers{}
+	for _, device := range devices {
+		if device.ID.Type != "pci" || device.ID.VendorID != virtioVendorID || device.DriverName == "" {
+			continue
+		}
+		switch device.ID.DeviceID {
+		case virtioBalloonTransitionalDeviceID, virtioBalloonDeviceID:
+			drivers.Balloon = true
+		}
+	}
+
+	return drivers, nil
+}
+
+// parseGuestTime from the agent response, the guest agent reports the time
+// in nanoseconds since the epoch
+func parseGuestTime(agentReply string) (time.Time, error) {
+	response := struct {
+		Return int64 `json:"return"`
+	}{}
+
+	if err := json.Unmarshal([]byte(agentReply), &response); err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(0, response.Return), nil
+}
+
 // parseAgent gets the agent version from response
 func parseAgent(agentReply string) (AgentInfo, error) {
 	const logLevelDebug = 3
@@ -20,6 +20,8 @@
 package agentpoller
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -102,5 +104,26 @@ var _ = Describe("Qemu agent poller", func() {
 			}
 			Expect(parseFilesystem(jsonInput)).To(Equal(expectedFilesystem))
 		})
+
+		DescribeTable("should parse the guest drivers", func(jsonInput string, expectedDrivers api.GuestDrivers) {
+			Expect(parseGuestDrivers(jsonInput)).To(Equal(expectedDrivers))
+		},
+			Entry("with the balloon driver", `{"return":[
+                {"driver-name":"VirtIO Balloon Driver","driver-version":"100.95.104.26200","id":{"type":"pci","vendor-id":6900,"device-id":4098}},
+                {"driver-name":"VirtIO Serial Driver","driver-version":"100.95.104.26200","id":{"type":"pci","vendor-id":6900,"device-id":4099}}
+            ]}`, api.GuestDrivers{Balloon: true}),
+			Entry("with the modern balloon driver", `{"return":[
+                {"driver-name":"VirtIO Balloon Driver","id":{"type":"pci","vendor-id":6900,"device-id":4165}}
+            ]}`, api.GuestDrivers{Balloon: true}),
+			Entry("without the balloon driver", `{"return":[
+                {"driver-name":"VirtIO Serial Driver","id":{"type":"pci","vendor-id":6900,"device-id":4099}},
+                {"driver-name":"Other Driver","id":{"type":"pci","vendor-id":32902,"device-id":4098}}
+            ]}`, api.GuestDrivers{}),
+			Entry("without devices", `{"return":[]}`, api.GuestDrivers{}),
+		)
+
+		It("should parse the guest time", func() {
+			Expect(parseGuestTime(`{"return":1700000000123456789}`)).To(Equal(time.Unix(0, 1700000000123456789)))
+		})
 	})
 })
@@ -21,6 +21,7 @@ package agentpoller
 import (
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -44,6 +45,8 @@ const (
 	GetFilesystem     AgentCommand = "guest-get-fsinfo"
 	GetAgent          AgentCommand = "guest-info"
 	GetFSFreezeStatus AgentCommand = "guest-fsfreeze-status"
+	GetDevices        AgentCommand = "guest-get-devices"
+	GetTime           AgentCommand = "guest-get-time"
 
 	pollInitialInterval = 10 * time.Second
 )
@@ -59,6 +62,9 @@ type AgentUpdatedEvent struct {
 type AsyncAgentStore struct {
 	store        sync.Map
 	AgentUpdated chan AgentUpdatedEvent
+
+	// allowedCommands is nil when all the guest agent commands are allowed
+	allowedCommands atomic.Pointer[map[string]struct{}]
 }
 
 // NewAsyncAgentStore creates new agent store
@@ -78,20 +84,32 @@ func (s *AsyncAgentStore) Store(key, value any) {
 
 	s.store.Store(key, value)
 
-	domainInfo := api.DomainGuestInfo{}
 	switch key {
-	case libvirt.DOMAIN_GUEST_INFO_OS, libvirt.DOMAIN_GUEST_INFO_INTERFACES, GetFSFreezeStatus:
+	case libvirt.DOMAIN_GUEST_INFO_OS, libvirt.DOMAIN_GUEST_INFO_INTERFACES, GetFSFreezeStatus, GetDevices:
 		updated := (oldData == nil) || !equality.Semantic.DeepEqual(oldData, value)
 		if !updated {
 			return
 		}
-		domainInfo.OSInfo = s.GetGuestOSInfo()
-		domainInfo.Interfaces = s.GetInterfaceStatus()
-		domainInfo.FSFreezeStatus = s.GetFSFreezeStatus()
-
-		s.AgentUpdated <- AgentUpdatedEvent{
-			DomainInfo: domainInfo,
+	case GetTime:
+		// The drift is reported in whole seconds, so that a guest whose clock is in sync
+		// does not fire an event on every poll
+		updated := (oldData == nil) || driftSeconds(oldData.(time.Duration)) != driftSeconds(value.(time.Duration))
+		if !updated {
+			return
 		}
+	default:
+		return
+	}
+
+	domainInfo := api.DomainGuestInfo{}
+	domainInfo.OSInfo = s.GetGuestOSInfo()
+	domainInfo.Interfaces = s.GetInterfaceStatus()
+	domainInfo.FSFreezeStatus = s.GetFSFreezeStatus()
+	domainInfo.GuestDrivers = s.GetGuestDrivers()
+	domainInfo.ClockDrift = s.GetGuestClockDrift()
+
+	s.AgentUpdated <- AgentUpdatedEvent{
+		DomainInfo: domainInfo,
 	}
 }
 
@@ -170,6 +188,17 @@ func (s *AsyncAgentStore) GetFSFreezeStatus() *api.FSFreeze {
 	return &fsfreezeStatus
 }
 
+// GetGuestDrivers returns the drivers of the guest devices, nil if the guest agent does not report them
+func (s *AsyncAgentStore) GetGuestDrivers() *api.GuestDrivers {
+	data, ok := s.store.Load(GetDevices)
+	if !ok {
+		return nil
+	}
+
+	guestDrivers := data.(api.GuestDrivers)
+	return &guestDrivers
+}
+
 // GetFS returns the filesystem list limited to the limit set
 // set limit to -1 to return the whole list
 func (s *AsyncAgentStore) GetFS(limit int) []api.Filesystem {
@@ -220,6 +249,35 @@ func (s *AsyncAgentStore) GetLoad() *stats.DomainStatsLoad {
 	return &load
 }
 
+// GetGuestClockDrift returns the drift of the guest clock from the host clock, or nil
+// if the guest agent did not report the guest time yet
+func (s *AsyncAgentStore) GetGuestClockDrift() *api.GuestClockDrift {
+	data, ok := s.store.Load(GetTime)
+	if !ok {
+		return nil
+	}
+
+	return &api.GuestClockDrift{Seconds: driftSeconds(data.(time.Duration))}
+}
+
+// GetClockDriftStats returns the drift of the guest clock from the host clock, in nanoseconds
+func (s *AsyncAgentStore) GetClockDriftStats() *stats.DomainStatsClockDrift {
+	data, ok := s.store.Load(GetTime)
+
+	clockDrift := stats.DomainStatsClockDrift{}
+	if !ok {
+		return &clockDrift
+	}
+
+	clockDrift.DriftSet = true
+	clockDrift.Drift = int64(data.(time.Duration))
+	return &clockDrift
+}
+
+func driftSeconds(drift time.Duration) int64 {
+	return int64(drift.Round(time.Second) / time.Second)
+}
+
 // PollerWorker collects the data from the guest agent
 // only unique items are stored as configuration
 type PollerWorker struct {
@@ -261,6 +319,13 @@ func (p *PollerWorker) Poll(execFunc func(), closeChan chan struct{}, initialInt
 	}
 }
 
+// guestClockDrift compares the guest time with the host time in the middle of the
+// agent round trip, so that the latency of the agent does not count as drift
+func guestClockDrift(guestTime, requestTime, responseTime time.Time) time.Duration {
+	hostTime := requestTime.Add(responseTime.Sub(requestTime) / 2)
+	return guestTime.Sub(hostTime)
+}
+
 func incrementPollInterval(interval, maxInterval time.Duration) time.Duration {
 	interval *= 2
 	if interval > maxInterval {
@@ -277,6 +342,13 @@ type AgentPoller struct {
 	agentConnected bool
 	workers        []PollerWorker
 	agentStore     *AsyncAgentStore
+
+	// disableMemoryStatsWithoutDriver opts in to disabling the balloon statistics
+	// while the guest has no balloon driver
+	disableMemoryStatsWithoutDriver bool
+	// memoryStatsPeriod is the period of the balloon statistics, while they are
+	// disabled because the guest has no balloon driver
+	memoryStatsPeriod uint
 }
 
 // CreatePoller creates the new structure that holds guest agent pollers
@@ -290,18 +362,20 @@ func CreatePoller(
 	qemuAgentUserInterval time.Duration,
 	qemuAgentVersionInterval time.Duration,
 	qemuAgentFSFreezeStatusInterval time.Duration,
+	disableMemoryStatsWithoutDriver bool,
 ) *AgentPoller {
 	return &AgentPoller{
-		Connection:     connection,
-		VmiUID:         vmiUID,
-		domainName:     domainName,
-		agentConnected: false,
-		agentStore:     store,
+		Connection:                      connection,
+		VmiUID:                          vmiUID,
+		domainName:                      domainName,
+		agentConnected:                  false,
+		agentStore:                      store,
+		disableMemoryStatsWithoutDriver: disableMemoryStatsWithoutDriver,
 		workers: []PollerWorker{
 			// Polling for QEMU agent commands
 			{
 				CallTick:      qemuAgentVersionInterval,
-				AgentCommands: []AgentCommand{GetAgent},
+				AgentCommands: []AgentCommand{GetAgent, GetDevices},
 			},
 			{
 				CallTick:      qemuAgentFileInterval,
@@ -311,6 +385,10 @@ func CreatePoller(
 				CallTick:      qemuAgentFSFreezeStatusInterval,
 				AgentCommands: []AgentCommand{GetFSFreezeStatus},
 			},
+			{
+				CallTick:      qemuAgentSysInterval,
+				AgentCommands: []AgentCommand{GetTime},
+			},
 			// Polling for guest info API
 			{
 				CallTick: qemuAgentSysInterval,
@@ -411,6 +489,10 @@ func executeAgentCommands(commands []AgentCommand, agentPoller *AgentPoller) {
 	log.Log.Infof("Polling command: %v", commands)
 
 	for _, command := range commands {
+		if !agentPoller.agentStore.CommandAllowed(string(command)) {
+			continue
+		}
+		requestTime := time.Now()
 		cmdResult, err := agentPoller.Connection.QemuAgentCommand(`{"execute":"`+string(command)+`"}`, agentPoller.domainName)
 		if err != nil {
 			// skip the command on error, it is not vital
@@ -439,11 +521,30 @@ func executeAgentCommands(commands []AgentCommand, agentPoller *AgentPoller) {
 				continue
 			}
 			agentPoller.agentStore.Store(GetAgent, agent)
+		case GetDevices:
+			guestDrivers, err := parseGuestDrivers(cmdResult)
+			if err != nil {
+				log.Log.Errorf("Cannot parse guest agent devices %s", err.Error())
+				continue
+			}
+			agentPoller.agentStore.Store(GetDevices, guestDrivers)
+			agentPoller.updateMemoryStatsPeriod(guestDrivers)
+		case GetTime:
+			guestTime, err := parseGuestTime(cmdResult)
+			if err != nil {
+				log.Log.Errorf("Cannot parse guest agent time %s", err.Error())
+				continue
+			}
+			agentPoller.agentStore.Store(GetTime, guestClockDrift(guestTime, requestTime, time.Now()))
 		}
 	}
 }
 
 func fetchAndStoreGuestInfo(infoTypes libvirt.DomainGuestInfoTypes, agentPoller *AgentPoller) {
+	infoTypes = agentPoller.agentStore.allowedGuestInfoTypes(infoTypes)
+	if infoTypes == 0 {
+		return
+	}
 	log.Log.Infof("Polling API operations: %v", infoTypes)
 
 	domain, err := agentPoller.Connection.LookupDomainByName(agentPoller.domainName)
@@ -29,6 +29,7 @@ import (
 	"libvirt.org/go/libvirt"
 
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/testing"
 )
 
@@ -105,6 +106,103 @@ var _ = Describe("Qemu agent poller", func() {
 		})
 	})
 
+	Context("with the guest drivers", func() {
+		const balloonXML = `<domain><devices><memballoon model="virtio"><stats period="10"></stats></memballoon></devices></domain>`
+
+		var agentPoller *AgentPoller
+
+		BeforeEach(func() {
+			agentPoller = &AgentPoller{
+				Connection:                      mockLibvirt.VirtConnection,
+				domainName:                      "fake",
+				agentStore:                      &agentStore,
+				disableMemoryStatsWithoutDriver: true,
+			}
+		})
+
+		It("should disable the balloon statistics while the guest has no balloon driver", func() {
+			mockLibvirt.DomainEXPECT().Free().Times(2)
+			mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(balloonXML, nil)
+			mockLibvirt.DomainEXPECT().SetMemoryStatsPeriod(0, libvirt.DOMAIN_MEM_LIVE).Return(nil)
+
+			agentPoller.updateMemoryStatsPeriod(api.GuestDrivers{})
+			Expect(agentPoller.memoryStatsPeriod).To(Equal(uint(10)))
+
+			By("not disabling the statistics again")
+			agentPoller.updateMemoryStatsPeriod(api.GuestDrivers{})
+
+			By("restoring the statistics once the driver is installed")
+			mockLibvirt.DomainEXPECT().SetMemoryStatsPeriod(10, libvirt.DOMAIN_MEM_LIVE).Return(nil)
+			agentPoller.updateMemoryStatsPeriod(api.GuestDrivers{Balloon: true})
+			Expect(agentPoller.memoryStatsPeriod).To(BeZero())
+		})
+
+		It("should not change the balloon statistics when the guest has the balloon driver", func() {
+			agentPoller.updateMemoryStatsPeriod(api.GuestDrivers{Balloon: true})
+			Expect(agentPoller.memoryStatsPeriod).To(BeZero())
+		})
+
+		It("should not change the balloon statistics unless the VMI opts in", func() {
+			agentPoller.disableMemoryStatsWithoutDriver = false
+
+			agentPoller.updateMemoryStatsPeriod(api.GuestDrivers{})
+			Expect(agentPoller.memoryStatsPeriod).To(BeZero())
+		})
+
+		It("should not change the balloon statistics when they are not enabled", func() {
+			mockLibvirt.DomainEXPECT().Free()
+			mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(`<domain><devices><memballoon model="none"></memballoon></devices></domain>`, nil)
+
+			agentPoller.updateMemoryStatsPeriod(api.GuestDrivers{})
+			Expect(agentPoller.memoryStatsPeriod).To(BeZero())
+		})
+
+		It("should fire an event for new guest drivers", func() {
+			agentStore.Store(GetDevices, api.GuestDrivers{Balloon: true})
+
+			Expect(agentStore.AgentUpdated).To(Receive(Equal(AgentUpdatedEvent{
+				DomainInfo: api.DomainGuestInfo{
+					GuestDrivers: &api.GuestDrivers{Balloon: true},
+				},
+			})))
+		})
+	})
+
+	Context("with the guest time", func() {
+		It("should measure the drift against the middle of the agent round trip", func() {
+			requestTime := time.Unix(1000, 0)
+			responseTime := requestTime.Add(2 * time.Second)
+			Expect(guestClockDrift(time.Unix(1004, 0), requestTime, responseTime)).To(Equal(3 * time.Second))
+			Expect(guestClockDrift(time.Unix(1000, 0), requestTime, responseTime)).To(Equal(-time.Second))
+		})
+
+		It("should fire an event only when the drift changes by a second", func() {
+			agentStore.Store(GetTime, 1200*time.Millisecond)
+			Expect(agentStore.AgentUpdated).To(Receive(Equal(AgentUpdatedEvent{
+				DomainInfo: api.DomainGuestInfo{
+					ClockDrift: &api.GuestClockDrift{Seconds: 1},
+				},
+			})))
+
+			agentStore.Store(GetTime, 1400*time.Millisecond)
+			Expect(agentStore.AgentUpdated).ToNot(Receive())
+
+			agentStore.Store(GetTime, -3*time.Second)
+			Expect(agentStore.AgentUpdated).To(Receive(Equal(AgentUpdatedEvent{
+				DomainInfo: api.DomainGuestInfo{
+					ClockDrift: &api.GuestClockDrift{Seconds: -3},
+				},
+			})))
+		})
+
+		It("should report the drift in the domain stats", func() {
+			Expect(agentStore.GetClockDriftStats()).To(Equal(&stats.DomainStatsClockDrift{}))
+
+			agentStore.Store(GetTime, -1500*time.Millisecond)
+			Expect(agentStore.GetClockDriftStats()).To(Equal(&stats.DomainStatsClockDrift{DriftSet: true, Drift: -1500000000}))
+		})
+	})
+
 	Context("with AsyncAgentStore", func() {
 		It("should store and load the data", func() {
 			agentVersion := AgentInfo{Version: "4.1"}
@@ -0,0 +1,220 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package agentpoller
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+const guestShutdownCommand = "guest-shutdown"
+
+// alwaysAllowedCommands are needed to detect the guest agent and the commands it supports
+var alwaysAllowedCommands = []string{"guest-ping", string(GetAgent)}
+
+// guestInfoCommands are the guest agent commands libvirt executes to fetch each type of guest info
+var guestInfoCommands = map[libvirt.DomainGuestInfoTypes]string{
+	libvirt.DOMAIN_GUEST_INFO_USERS:      "guest-get-users",
+	libvirt.DOMAIN_GUEST_INFO_OS:         "guest-get-osinfo",
+	libvirt.DOMAIN_GUEST_INFO_TIMEZONE:   "guest-get-timezone",
+	libvirt.DOMAIN_GUEST_INFO_HOSTNAME:   "guest-get-host-name",
+	libvirt.DOMAIN_GUEST_INFO_INTERFACES: "guest-network-get-interfaces",
+	libvirt.DOMAIN_GUEST_INFO_LOAD:       "guest-get-load",
+}
+
+// SetAllowedCommands restricts the guest agent commands executed by virt-launcher to the ones
+// allowed by the VMI. All commands are allowed when guestAgent is nil.
+func (s *AsyncAgentStore) SetAllowedCommands(guestAgent *v1.GuestAgent) {
+	if s == nil {
+		return
+	}
+	if guestAgent == nil {
+		s.allowedCommands.Store(nil)
+		return
+	}
+
+	allowed := make(map[string]struct{}, len(guestAgent.AllowedCommands)+len(alwaysAllowedCommands))
+	for _, command := range alwaysAllowedCommands {
+		allowed[command] = struct{}{}
+	}
+	for _, command := range guestAgent.AllowedCommands {
+		allowed[command] = struct{}{}
+	}
+	s.allowedCommands.Store(&allowed)
+}
+
+// CommandAllowed reports whether virt-launcher may execute the guest agent command
+func (s *AsyncAgentStore) CommandAllowed(command string) bool {
+	if s == nil {
+		return true
+	}
+	allowed := s.allowedCommands.Load()
+	if allowed == nil {
+		return true
+	}
+	_, ok := (*allowed)[command]
+	return ok
+}
+
+// allowedGuestInfoTypes drops the types of guest info which need a command that is not allowed
+func (s *AsyncAgentStore) allowedGuestInfoTypes(infoTypes libvirt.DomainGuestInfoTypes) libvirt.DomainGuestInfoTypes {
+	for infoType, command := range guestInfoCommands {
+		if infoTypes&infoType != 0 && !s.CommandAllowed(command) {
+			infoTypes &^= infoType
+		}
+	}
+	return infoTypes
+}
+
+// FilterAgentCommands returns a connection which refuses the guest agent commands the store does not allow
+func FilterAgentCommands(connection cli.Connection, store *AsyncAgentStore) cli.Connection {
+	return &agentCommandFilter{Connection: connection, store: store}
+}
+
+type agentCommandFilter struct {
+	cli.Connection
+	store *AsyncAgentStore
+}
+
+func (f *agentCommandFilter) QemuAgentCommand(command string, domainName string) (string, error) {
+	name := agentCommandName(command)
+	if !f.store.CommandAllowed(name) {
+		return "", fmt.Errorf("guest agent command %q is not allowed by the VMI", name)
+	}
+	return f.Connection.QemuAgentCommand(command, domainName)
+}
+
+func (f *agentCommandFilter) LookupDomainByName(name string) (cli.VirDomain, error) {
+	domain, err := f.Connection.LookupDomainByName(name)
+	if err != nil {
+		return nil, err
+	}
+	return &agentCommandFilterDomain{VirDomain: domain, store: f.store}, nil
+}
+
+func (f *agentCommandFilter) DomainDefineXML(xml string) (cli.VirDomain, error) {
+	domain, err := f.Connection.DomainDefineXML(xml)
+	if err != nil {
+		return nil, err
+	}
+	return &agentCommandFilterDomain{VirDomain: domain, store: f.store}, nil
+}
+
+// agentCommandFilterDomain refuses the domain operations libvirt implements with guest agent commands the store does not allow
+type agentCommandFilterDomain struct {
+	cli.VirDomain
+	store *AsyncAgentStore
+}
+
+func (d *agentCommandFilterDomain) requireCommands(commands ...string) error {
+	for _, command := range commands {
+		if !d.store.CommandAllowed(command) {
+			return fmt.Errorf("guest agent command %q is not allowed by the VMI", command)
+		}
+	}
+	return nil
+}
+
+// ShutdownFlags falls back to the ACPI power button when libvirt would otherwise pick the guest agent
+func (d *agentCommandFilterDomain) ShutdownFlags(flags libvirt.DomainShutdownFlags) error {
+	if d.store.CommandAllowed(guestShutdownCommand) {
+		return d.VirDomain.ShutdownFlags(flags)
+	}
+	if flags == libvirt.DOMAIN_SHUTDOWN_DEFAULT {
+		return d.VirDomain.ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_ACPI_POWER_BTN)
+	}
+	if flags&libvirt.DOMAIN_SHUTDOWN_GUEST_AGENT != 0 {
+		if flags &^= libvirt.DOMAIN_SHUTDOWN_GUEST_AGENT; flags == 0 {
+			return d.requireCommands(guestShutdownCommand)
+		}
+	}
+	return d.VirDomain.ShutdownFlags(flags)
+}
+
+// Reboot falls back to the ACPI power button when libvirt would otherwise pick the guest agent
+func (d *agentCommandFilterDomain) Reboot(flags libvirt.DomainRebootFlagValues) error {
+	if d.store.CommandAllowed(guestShutdownCommand) {
+		return d.VirDomain.Reboot(flags)
+	}
+	if flags == libvirt.DOMAIN_REBOOT_DEFAULT {
+		return d.VirDomain.Reboot(libvirt.DOMAIN_REBOOT_ACPI_POWER_BTN)
+	}
+	if flags&libvirt.DOMAIN_REBOOT_GUEST_AGENT != 0 {
+		if flags &^= libvirt.DOMAIN_REBOOT_GUEST_AGENT; flags == 0 {
+			return d.requireCommands(guestShutdownCommand)
+		}
+	}
+	return d.VirDomain.Reboot(flags)
+}
+
+func (d *agentCommandFilterDomain) FSFreeze(mounts []string, flags uint32) error {
+	command := "guest-fsfreeze-freeze"
+	if len(mounts) > 0 {
+		command = "guest-fsfreeze-freeze-list"
+	}
+	if err := d.requireCommands(command); err != nil {
+		return err
+	}
+	return d.VirDomain.FSFreeze(mounts, flags)
+}
+
+func (d *agentCommandFilterDomain) FSThaw(mounts []string, flags uint32) error {
+	if err := d.requireCommands("guest-fsfreeze-thaw"); err != nil {
+		return err
+	}
+	return d.VirDomain.FSThaw(mounts, flags)
+}
+
+func (d *agentCommandFilterDomain) SetTime(secs int64, nsecs uint, flags libvirt.DomainSetTimeFlags) error {
+	if err := d.requireCommands("guest-set-time"); err != nil {
+		return err
+	}
+	return d.VirDomain.SetTime(secs, nsecs, flags)
+}
+
+func (d *agentCommandFilterDomain) SetUserPassword(user string, password string, flags libvirt.DomainSetUserPasswordFlags) error {
+	if err := d.requireCommands("guest-set-user-password"); err != nil {
+		return err
+	}
+	return d.VirDomain.SetUserPassword(user, password, flags)
+}
+
+func (d *agentCommandFilterDomain) AuthorizedSSHKeysSet(user string, keys []string, flags libvirt.DomainAuthorizedSSHKeysFlags) error {
+	if err := d.requireCommands("guest-ssh-get-authorized-keys", "guest-ssh-add-authorized-keys", "guest-ssh-remove-authorized-keys"); err != nil {
+		return err
+	}
+	return d.VirDomain.AuthorizedSSHKeysSet(user, keys, flags)
+}
+
+func agentCommandName(command string) string {
+	var request struct {
+		Execute string `json:"execute"`
+	}
+	if err := json.Unmarshal([]byte(command), &request); err != nil {
+		return ""
+	}
+	return request.Execute
+}
@@ -0,0 +1,137 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package agentpoller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("Guest agent allowed commands", func() {
+	var agentStore AsyncAgentStore
+
+	BeforeEach(func() {
+		agentStore = NewAsyncAgentStore()
+	})
+
+	It("should allow all the commands when the VMI does not restrict them", func() {
+		agentStore.SetAllowedCommands(nil)
+		Expect(agentStore.CommandAllowed("guest-file-open")).To(BeTrue())
+	})
+
+	It("should only allow the listed commands and the ones needed to detect the agent", func() {
+		agentStore.SetAllowedCommands(&v1.GuestAgent{AllowedCommands: []string{"guest-get-fsinfo"}})
+		Expect(agentStore.CommandAllowed("guest-get-fsinfo")).To(BeTrue())
+		Expect(agentStore.CommandAllowed("guest-ping")).To(BeTrue())
+		Expect(agentStore.CommandAllowed("guest-info")).To(BeTrue())
+		Expect(agentStore.CommandAllowed("guest-file-open")).To(BeFalse())
+	})
+
+	It("should allow all the commands again when the restriction is removed", func() {
+		agentStore.SetAllowedCommands(&v1.GuestAgent{})
+		Expect(agentStore.CommandAllowed("guest-file-open")).To(BeFalse())
+		agentStore.SetAllowedCommands(nil)
+		Expect(agentStore.CommandAllowed("guest-file-open")).To(BeTrue())
+	})
+
+	It("should drop the guest info types whose command is not allowed", func() {
+		agentStore.SetAllowedCommands(&v1.GuestAgent{AllowedCommands: []string{"guest-get-osinfo"}})
+		infoTypes := agentStore.allowedGuestInfoTypes(libvirt.DOMAIN_GUEST_INFO_OS | libvirt.DOMAIN_GUEST_INFO_USERS)
+		Expect(infoTypes).To(Equal(libvirt.DOMAIN_GUEST_INFO_OS))
+	})
+
+	Context("with the filtered connection", func() {
+		var mockConnection *cli.MockConnection
+		var connection cli.Connection
+
+		BeforeEach(func() {
+			mockConnection = cli.NewMockConnection(gomock.NewController(GinkgoT()))
+			connection = FilterAgentCommands(mockConnection, &agentStore)
+			agentStore.SetAllowedCommands(&v1.GuestAgent{AllowedCommands: []string{"guest-exec"}})
+		})
+
+		It("should pass the allowed commands to the guest agent", func() {
+			const command = `{"execute":"guest-exec", "arguments": {"path": "/bin/true"}}`
+			mockConnection.EXPECT().QemuAgentCommand(command, "test").Return(`{"return":{"pid":1}}`, nil)
+			result, err := connection.QemuAgentCommand(command, "test")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(`{"return":{"pid":1}}`))
+		})
+
+		It("should refuse the commands which are not allowed", func() {
+			_, err := connection.QemuAgentCommand(`{"execute":"guest-file-open", "arguments": {"path": "/etc/shadow"}}`, "test")
+			Expect(err).To(MatchError(`guest agent command "guest-file-open" is not allowed by the VMI`))
+		})
+
+		Context("and domain", func() {
+			var mockDomain *cli.MockVirDomain
+			var domain cli.VirDomain
+
+			BeforeEach(func() {
+				mockDomain = cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+				mockConnection.EXPECT().LookupDomainByName("test").Return(mockDomain, nil)
+				var err error
+				domain, err = connection.LookupDomainByName("test")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should refuse to freeze the filesystems without the fsfreeze commands", func() {
+				Expect(domain.FSFreeze(nil, 0)).To(MatchError(`guest agent command "guest-fsfreeze-freeze" is not allowed by the VMI`))
+				Expect(domain.FSThaw(nil, 0)).To(MatchError(`guest agent command "guest-fsfreeze-thaw" is not allowed by the VMI`))
+			})
+
+			It("should freeze the filesystems with the fsfreeze commands", func() {
+				agentStore.SetAllowedCommands(&v1.GuestAgent{AllowedCommands: []string{"guest-fsfreeze-freeze", "guest-fsfreeze-thaw"}})
+				mockDomain.EXPECT().FSFreeze(nil, uint32(0)).Return(nil)
+				mockDomain.EXPECT().FSThaw(nil, uint32(0)).Return(nil)
+				Expect(domain.FSFreeze(nil, 0)).To(Succeed())
+				Expect(domain.FSThaw(nil, 0)).To(Succeed())
+			})
+
+			It("should shut down with the ACPI power button without guest-shutdown", func() {
+				mockDomain.EXPECT().ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_ACPI_POWER_BTN).Return(nil)
+				Expect(domain.ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_DEFAULT)).To(Succeed())
+			})
+
+			It("should refuse to shut down with the guest agent only without guest-shutdown", func() {
+				Expect(domain.ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_GUEST_AGENT)).To(
+					MatchError(`guest agent command "guest-shutdown" is not allowed by the VMI`))
+			})
+
+			It("should let libvirt pick the shutdown method with guest-shutdown", func() {
+				agentStore.SetAllowedCommands(&v1.GuestAgent{AllowedCommands: []string{"guest-shutdown"}})
+				mockDomain.EXPECT().ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_DEFAULT).Return(nil)
+				Expect(domain.ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_DEFAULT)).To(Succeed())
+			})
+
+			It("should reboot with the ACPI power button without guest-shutdown", func() {
+				mockDomain.EXPECT().Reboot(libvirt.DOMAIN_REBOOT_ACPI_POWER_BTN).Return(nil)
+				Expect(domain.Reboot(libvirt.DOMAIN_REBOOT_DEFAULT)).To(Succeed())
+			})
+		})
+	})
+})
@@ -0,0 +1,84 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package agentpoller
+
+import (
+	"encoding/xml"
+
+	"libvirt.org/go/libvirt"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// updateMemoryStatsPeriod disables the balloon statistics while the guest has no balloon driver,
+// if the VMI opts in to it. QEMU would otherwise keep on requesting statistics which the guest
+// never provides. The period is restored once the driver is installed. Free page reporting can
+// not be changed on a running domain and is left untouched.
+func (p *AgentPoller) updateMemoryStatsPeriod(guestDrivers api.GuestDrivers) {
+	if !p.disableMemoryStatsWithoutDriver {
+		return
+	}
+
+	disabled := p.memoryStatsPeriod != 0
+	if guestDrivers.Balloon != disabled {
+		return
+	}
+
+	domain, err := p.Connection.LookupDomainByName(p.domainName)
+	if err != nil {
+		log.Log.Reason(err).Error("Domain lookup failed")
+		return
+	}
+	defer func() { _ = domain.Free() }()
+
+	if disabled {
+		if err := domain.SetMemoryStatsPeriod(int(p.memoryStatsPeriod), libvirt.DOMAIN_MEM_LIVE); err != nil {
+			log.Log.Reason(err).Error("Failed to restore the balloon statistics period")
+			return
+		}
+		log.Log.Infof("Guest balloon driver found, restored the balloon statistics period to %d seconds", p.memoryStatsPeriod)
+		p.memoryStatsPeriod = 0
+		return
+	}
+
+	domainXML, err := domain.GetXMLDesc(0)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to get the domain specification")
+		return
+	}
+	spec := api.DomainSpec{}
+	if err := xml.Unmarshal([]byte(domainXML), &spec); err != nil {
+		log.Log.Reason(err).Error("Failed to parse the domain specification")
+		return
+	}
+	balloon := spec.Devices.Ballooning
+	if balloon == nil || balloon.Model == "none" || balloon.Stats == nil || balloon.Stats.Period == 0 {
+		return
+	}
+
+	if err := domain.SetMemoryStatsPeriod(0, libvirt.DOMAIN_MEM_LIVE); err != nil {
+		log.Log.Reason(err).Error("Failed to disable the balloon statistics")
+		return
+	}
+	log.Log.Info("Guest has no balloon driver, disabled the balloon statistics")
+	p.memoryStatsPeriod = balloon.Stats.Period
+}
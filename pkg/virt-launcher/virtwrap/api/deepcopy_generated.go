@@ -385,6 +385,11 @@ func (in *CPU) DeepCopyInto(out *CPU) {
 		*out = new(CPUTopology)
 		**out = **in
 	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(CPUCache)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NUMA != nil {
 		in, out := &in.NUMA, &out.NUMA
 		*out = new(NUMA)
@@ -403,6 +408,27 @@ func (in *CPU) DeepCopy() *CPU {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUCache) DeepCopyInto(out *CPUCache) {
+	*out = *in
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUCache.
+func (in *CPUCache) DeepCopy() *CPUCache {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CPUEmulatorPin) DeepCopyInto(out *CPUEmulatorPin) {
 	*out = *in
@@ -646,6 +672,60 @@ func (in *Clock) DeepCopy() *Clock {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Coalesce) DeepCopyInto(out *Coalesce) {
+	*out = *in
+	if in.Rx != nil {
+		in, out := &in.Rx, &out.Rx
+		*out = new(CoalesceRx)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Coalesce.
+func (in *Coalesce) DeepCopy() *Coalesce {
+	if in == nil {
+		return nil
+	}
+	out := new(Coalesce)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoalesceFrames) DeepCopyInto(out *CoalesceFrames) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoalesceFrames.
+func (in *CoalesceFrames) DeepCopy() *CoalesceFrames {
+	if in == nil {
+		return nil
+	}
+	out := new(CoalesceFrames)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoalesceRx) DeepCopyInto(out *CoalesceRx) {
+	*out = *in
+	out.Frames = in.Frames
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoalesceRx.
+func (in *CoalesceRx) DeepCopy() *CoalesceRx {
+	if in == nil {
+		return nil
+	}
+	out := new(CoalesceRx)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Commandline) DeepCopyInto(out *Commandline) {
 	*out = *in
@@ -985,7 +1065,9 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 	if in.TPMs != nil {
 		in, out := &in.TPMs, &out.TPMs
 		*out = make([]TPM, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.VSOCK != nil {
 		in, out := &in.VSOCK, &out.VSOCK
@@ -1070,6 +1152,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(Shareable)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		**out = **in
+	}
 	return
 }
 
@@ -1104,6 +1191,27 @@ func (in *DiskAuth) DeepCopy() *DiskAuth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskBlockJob) DeepCopyInto(out *DiskBlockJob) {
+	*out = *in
+	if in.RemainingSeconds != nil {
+		in, out := &in.RemainingSeconds, &out.RemainingSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskBlockJob.
+func (in *DiskBlockJob) DeepCopy() *DiskBlockJob {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskBlockJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskDriver) DeepCopyInto(out *DiskDriver) {
 	*out = *in
@@ -1135,6 +1243,23 @@ func (in *DiskDriver) DeepCopy() *DiskDriver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOErrors) DeepCopyInto(out *DiskIOErrors) {
+	*out = *in
+	in.LastErrorTime.DeepCopyInto(&out.LastErrorTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOErrors.
+func (in *DiskIOErrors) DeepCopy() *DiskIOErrors {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOErrors)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskIOThread) DeepCopyInto(out *DiskIOThread) {
 	*out = *in
@@ -1172,6 +1297,22 @@ func (in *DiskIOThreads) DeepCopy() *DiskIOThreads {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTune) DeepCopyInto(out *DiskIOTune) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTune.
+func (in *DiskIOTune) DeepCopy() *DiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskSecret) DeepCopyInto(out *DiskSecret) {
 	*out = *in
@@ -1211,6 +1352,11 @@ func (in *DiskSource) DeepCopyInto(out *DiskSource) {
 		*out = new(DataStore)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Reconnect != nil {
+		in, out := &in.Reconnect, &out.Reconnect
+		*out = new(Reconnect)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1358,6 +1504,16 @@ func (in *DomainGuestInfo) DeepCopyInto(out *DomainGuestInfo) {
 		*out = new(FSFreeze)
 		**out = **in
 	}
+	if in.GuestDrivers != nil {
+		in, out := &in.GuestDrivers, &out.GuestDrivers
+		*out = new(GuestDrivers)
+		**out = **in
+	}
+	if in.ClockDrift != nil {
+		in, out := &in.ClockDrift, &out.ClockDrift
+		*out = new(GuestClockDrift)
+		**out = **in
+	}
 	return
 }
 
@@ -1478,6 +1634,11 @@ func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 		*out = new(IOThreads)
 		**out = **in
 	}
+	if in.IOThreadIDs != nil {
+		in, out := &in.IOThreadIDs, &out.IOThreadIDs
+		*out = new(IOThreadIDs)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LaunchSecurity != nil {
 		in, out := &in.LaunchSecurity, &out.LaunchSecurity
 		*out = new(LaunchSecurity)
@@ -1508,6 +1669,30 @@ func (in *DomainStatus) DeepCopyInto(out *DomainStatus) {
 	}
 	out.OSInfo = in.OSInfo
 	out.FSFreezeStatus = in.FSFreezeStatus
+	if in.DiskIOErrors != nil {
+		in, out := &in.DiskIOErrors, &out.DiskIOErrors
+		*out = make([]DiskIOErrors, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BlockJobs != nil {
+		in, out := &in.BlockJobs, &out.BlockJobs
+		*out = make([]DiskBlockJob, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GuestDrivers != nil {
+		in, out := &in.GuestDrivers, &out.GuestDrivers
+		*out = new(GuestDrivers)
+		**out = **in
+	}
+	if in.ClockDrift != nil {
+		in, out := &in.ClockDrift, &out.ClockDrift
+		*out = new(GuestClockDrift)
+		**out = **in
+	}
 	return
 }
 
@@ -2068,6 +2253,11 @@ func (in *Graphics) DeepCopyInto(out *Graphics) {
 		*out = new(GraphicsListen)
 		**out = **in
 	}
+	if in.GL != nil {
+		in, out := &in.GL, &out.GL
+		*out = new(GraphicsGL)
+		**out = **in
+	}
 	return
 }
 
@@ -2081,6 +2271,22 @@ func (in *Graphics) DeepCopy() *Graphics {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GraphicsGL) DeepCopyInto(out *GraphicsGL) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GraphicsGL.
+func (in *GraphicsGL) DeepCopy() *GraphicsGL {
+	if in == nil {
+		return nil
+	}
+	out := new(GraphicsGL)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GraphicsListen) DeepCopyInto(out *GraphicsListen) {
 	*out = *in
@@ -2097,6 +2303,38 @@ func (in *GraphicsListen) DeepCopy() *GraphicsListen {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestClockDrift) DeepCopyInto(out *GuestClockDrift) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestClockDrift.
+func (in *GuestClockDrift) DeepCopy() *GuestClockDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestClockDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestDrivers) DeepCopyInto(out *GuestDrivers) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestDrivers.
+func (in *GuestDrivers) DeepCopy() *GuestDrivers {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestDrivers)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GuestOSInfo) DeepCopyInto(out *GuestOSInfo) {
 	*out = *in
@@ -2204,6 +2442,43 @@ func (in *HugePages) DeepCopy() *HugePages {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IOThreadID) DeepCopyInto(out *IOThreadID) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IOThreadID.
+func (in *IOThreadID) DeepCopy() *IOThreadID {
+	if in == nil {
+		return nil
+	}
+	out := new(IOThreadID)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IOThreadIDs) DeepCopyInto(out *IOThreadIDs) {
+	*out = *in
+	if in.IOThread != nil {
+		in, out := &in.IOThread, &out.IOThread
+		*out = make([]IOThreadID, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IOThreadIDs.
+func (in *IOThreadIDs) DeepCopy() *IOThreadIDs {
+	if in == nil {
+		return nil
+	}
+	out := new(IOThreadIDs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IOThreads) DeepCopyInto(out *IOThreads) {
 	*out = *in
@@ -2220,6 +2495,22 @@ func (in *IOThreads) DeepCopy() *IOThreads {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageIntegrityMetadata) DeepCopyInto(out *ImageIntegrityMetadata) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageIntegrityMetadata.
+func (in *ImageIntegrityMetadata) DeepCopy() *ImageIntegrityMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageIntegrityMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Input) DeepCopyInto(out *Input) {
 	*out = *in
@@ -2233,6 +2524,11 @@ func (in *Input) DeepCopyInto(out *Input) {
 		*out = new(Address)
 		**out = **in
 	}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(InputSource)
+		**out = **in
+	}
 	return
 }
 
@@ -2246,6 +2542,22 @@ func (in *Input) DeepCopy() *Input {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InputSource) DeepCopyInto(out *InputSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InputSource.
+func (in *InputSource) DeepCopy() *InputSource {
+	if in == nil {
+		return nil
+	}
+	out := new(InputSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Interface) DeepCopyInto(out *Interface) {
 	*out = *in
@@ -2328,6 +2640,11 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Coalesce != nil {
+		in, out := &in.Coalesce, &out.Coalesce
+		*out = new(Coalesce)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2365,6 +2682,16 @@ func (in *InterfaceDriver) DeepCopyInto(out *InterfaceDriver) {
 		*out = new(uint)
 		**out = **in
 	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(InterfaceDriverHost)
+		**out = **in
+	}
+	if in.Guest != nil {
+		in, out := &in.Guest, &out.Guest
+		*out = new(InterfaceDriverGuest)
+		**out = **in
+	}
 	return
 }
 
@@ -2378,6 +2705,38 @@ func (in *InterfaceDriver) DeepCopy() *InterfaceDriver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceDriverGuest) DeepCopyInto(out *InterfaceDriverGuest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceDriverGuest.
+func (in *InterfaceDriverGuest) DeepCopy() *InterfaceDriverGuest {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceDriverGuest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceDriverHost) DeepCopyInto(out *InterfaceDriverHost) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceDriverHost.
+func (in *InterfaceDriverHost) DeepCopy() *InterfaceDriverHost {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceDriverHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfacePortForward) DeepCopyInto(out *InterfacePortForward) {
 	*out = *in
@@ -2423,6 +2782,11 @@ func (in *InterfaceSource) DeepCopyInto(out *InterfaceSource) {
 		*out = new(Address)
 		**out = **in
 	}
+	if in.Reconnect != nil {
+		in, out := &in.Reconnect, &out.Reconnect
+		*out = new(Reconnect)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2501,6 +2865,16 @@ func (in *KubeVirtMetadata) DeepCopyInto(out *KubeVirtMetadata) {
 		*out = new(MemoryDumpMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ImageIntegrity != nil {
+		in, out := &in.ImageIntegrity, &out.ImageIntegrity
+		*out = new(ImageIntegrityMetadata)
+		**out = **in
+	}
+	if in.VhostUser != nil {
+		in, out := &in.VhostUser, &out.VhostUser
+		*out = new(VhostUserMetadata)
+		**out = **in
+	}
 	return
 }
 
@@ -2938,7 +3312,9 @@ func (in *NUMA) DeepCopyInto(out *NUMA) {
 	if in.Cells != nil {
 		in, out := &in.Cells, &out.Cells
 		*out = make([]NUMACell, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -2956,6 +3332,11 @@ func (in *NUMA) DeepCopy() *NUMA {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NUMACell) DeepCopyInto(out *NUMACell) {
 	*out = *in
+	if in.Distances != nil {
+		in, out := &in.Distances, &out.Distances
+		*out = new(NUMADistances)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2969,6 +3350,43 @@ func (in *NUMACell) DeepCopy() *NUMACell {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NUMADistances) DeepCopyInto(out *NUMADistances) {
+	*out = *in
+	if in.Siblings != nil {
+		in, out := &in.Siblings, &out.Siblings
+		*out = make([]NUMASibling, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMADistances.
+func (in *NUMADistances) DeepCopy() *NUMADistances {
+	if in == nil {
+		return nil
+	}
+	out := new(NUMADistances)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NUMASibling) DeepCopyInto(out *NUMASibling) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMASibling.
+func (in *NUMASibling) DeepCopy() *NUMASibling {
+	if in == nil {
+		return nil
+	}
+	out := new(NUMASibling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NUMATune) DeepCopyInto(out *NUMATune) {
 	*out = *in
@@ -3039,6 +3457,27 @@ func (in *NumaTuneMemory) DeepCopy() *NumaTuneMemory {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OEMStrings) DeepCopyInto(out *OEMStrings) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OEMStrings.
+func (in *OEMStrings) DeepCopy() *OEMStrings {
+	if in == nil {
+		return nil
+	}
+	out := new(OEMStrings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OS) DeepCopyInto(out *OS) {
 	*out = *in
@@ -3152,6 +3591,11 @@ func (in *PanicDevice) DeepCopyInto(out *PanicDevice) {
 		*out = new(v1.PanicDeviceModel)
 		**out = **in
 	}
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(PanicDeviceAddress)
+		**out = **in
+	}
 	return
 }
 
@@ -3165,6 +3609,22 @@ func (in *PanicDevice) DeepCopy() *PanicDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PanicDeviceAddress) DeepCopyInto(out *PanicDeviceAddress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PanicDeviceAddress.
+func (in *PanicDeviceAddress) DeepCopy() *PanicDeviceAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(PanicDeviceAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReadOnly) DeepCopyInto(out *ReadOnly) {
 	*out = *in
@@ -3181,6 +3641,27 @@ func (in *ReadOnly) DeepCopy() *ReadOnly {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Reconnect) DeepCopyInto(out *Reconnect) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(uint)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Reconnect.
+func (in *Reconnect) DeepCopy() *Reconnect {
+	if in == nil {
+		return nil
+	}
+	out := new(Reconnect)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedirectedDevice) DeepCopyInto(out *RedirectedDevice) {
 	*out = *in
@@ -3647,6 +4128,11 @@ func (in *SysInfo) DeepCopyInto(out *SysInfo) {
 		*out = make([]Entry, len(*in))
 		copy(*out, *in)
 	}
+	if in.OEMStrings != nil {
+		in, out := &in.OEMStrings, &out.OEMStrings
+		*out = new(OEMStrings)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3663,7 +4149,7 @@ func (in *SysInfo) DeepCopy() *SysInfo {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TPM) DeepCopyInto(out *TPM) {
 	*out = *in
-	out.Backend = in.Backend
+	in.Backend.DeepCopyInto(&out.Backend)
 	return
 }
 
@@ -3680,6 +4166,11 @@ func (in *TPM) DeepCopy() *TPM {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TPMBackend) DeepCopyInto(out *TPMBackend) {
 	*out = *in
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(TPMBackendSource)
+		**out = **in
+	}
 	return
 }
 
@@ -3693,6 +4184,22 @@ func (in *TPMBackend) DeepCopy() *TPMBackend {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TPMBackendSource) DeepCopyInto(out *TPMBackendSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TPMBackendSource.
+func (in *TPMBackendSource) DeepCopy() *TPMBackendSource {
+	if in == nil {
+		return nil
+	}
+	out := new(TPMBackendSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Timer) DeepCopyInto(out *Timer) {
 	*out = *in
@@ -3811,6 +4318,22 @@ func (in *VSOCK) DeepCopy() *VSOCK {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VhostUserMetadata) DeepCopyInto(out *VhostUserMetadata) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VhostUserMetadata.
+func (in *VhostUserMetadata) DeepCopy() *VhostUserMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(VhostUserMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Video) DeepCopyInto(out *Video) {
 	*out = *in
@@ -3828,6 +4351,22 @@ func (in *Video) DeepCopy() *Video {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VideoAccel) DeepCopyInto(out *VideoAccel) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VideoAccel.
+func (in *VideoAccel) DeepCopy() *VideoAccel {
+	if in == nil {
+		return nil
+	}
+	out := new(VideoAccel)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VideoModel) DeepCopyInto(out *VideoModel) {
 	*out = *in
@@ -3851,6 +4390,11 @@ func (in *VideoModel) DeepCopyInto(out *VideoModel) {
 		*out = new(uint)
 		**out = **in
 	}
+	if in.Accel != nil {
+		in, out := &in.Accel, &out.Accel
+		*out = new(VideoAccel)
+		**out = **in
+	}
 	return
 }
 
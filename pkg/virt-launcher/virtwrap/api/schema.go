@@ -101,6 +101,7 @@ const (
 	HostDeviceUSB  = "usb"
 	AddressPCI     = "pci"
 	AddressCCW     = "ccw"
+	AddressMMIO    = "virtio-mmio"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -117,6 +118,50 @@ type DomainStatus struct {
 	Interfaces     []InterfaceStatus
 	OSInfo         GuestOSInfo
 	FSFreezeStatus FSFreeze
+	DiskIOErrors   []DiskIOErrors
+	BlockJobs      []DiskBlockJob
+	GuestDrivers   *GuestDrivers
+	ClockDrift     *GuestClockDrift
+	ShutdownOrigin ShutdownOrigin
+	GuestPanicked  bool
+	WatchdogFired  bool
+}
+
+// ShutdownOrigin tells who requested the domain to shut down
+type ShutdownOrigin string
+
+const (
+	ShutdownOriginGuest ShutdownOrigin = "Guest"
+	ShutdownOriginHost  ShutdownOrigin = "Host"
+)
+
+// GuestDrivers reports whether the guest has drivers for its virtio devices, as seen by the guest agent.
+// Only the guest agent of Windows guests reports the devices.
+type GuestDrivers struct {
+	Balloon bool
+}
+
+// GuestClockDrift is the difference between the guest time reported by the guest agent and the host time.
+// It is rounded to whole seconds, positive when the guest clock is ahead of the host clock.
+type GuestClockDrift struct {
+	Seconds int64
+}
+
+// DiskIOErrors are the IO errors QEMU reported on the disk of a volume
+type DiskIOErrors struct {
+	VolumeName    string
+	Count         int64
+	LastError     string
+	LastErrorTime metav1.Time
+}
+
+// DiskBlockJob is the progress of the block job running on the disk of a volume
+type DiskBlockJob struct {
+	VolumeName       string
+	Type             string
+	Progress         int32
+	BytesPerSecond   int64
+	RemainingSeconds *int64
 }
 
 type DomainSysInfo struct {
@@ -182,6 +227,8 @@ type DomainGuestInfo struct {
 	Interfaces     []InterfaceStatus
 	OSInfo         *GuestOSInfo
 	FSFreezeStatus *FSFreeze
+	GuestDrivers   *GuestDrivers
+	ClockDrift     *GuestClockDrift
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -218,6 +265,7 @@ type DomainSpec struct {
 	CPUTune        *CPUTune        `xml:"cputune"`
 	NUMATune       *NUMATune       `xml:"numatune"`
 	IOThreads      *IOThreads      `xml:"iothreads,omitempty"`
+	IOThreadIDs    *IOThreadIDs    `xml:"iothreadids,omitempty"`
 	LaunchSecurity *LaunchSecurity `xml:"launchSecurity,omitempty"`
 }
 
@@ -278,19 +326,35 @@ type CPU struct {
 	Model    string       `xml:"model,omitempty"`
 	Features []CPUFeature `xml:"feature"`
 	Topology *CPUTopology `xml:"topology"`
+	Cache    *CPUCache    `xml:"cache,omitempty"`
 	NUMA     *NUMA        `xml:"numa,omitempty"`
 }
 
+type CPUCache struct {
+	Level *uint32 `xml:"level,attr,omitempty"`
+	Mode  string  `xml:"mode,attr"`
+}
+
 type NUMA struct {
 	Cells []NUMACell `xml:"cell"`
 }
 
 type NUMACell struct {
-	ID           string `xml:"id,attr"`
-	CPUs         string `xml:"cpus,attr"`
-	Memory       uint64 `xml:"memory,attr,omitempty"`
-	Unit         string `xml:"unit,attr,omitempty"`
-	MemoryAccess string `xml:"memAccess,attr,omitempty"`
+	ID           string         `xml:"id,attr"`
+	CPUs         string         `xml:"cpus,attr"`
+	Memory       uint64         `xml:"memory,attr,omitempty"`
+	Unit         string         `xml:"unit,attr,omitempty"`
+	MemoryAccess string         `xml:"memAccess,attr,omitempty"`
+	Distances    *NUMADistances `xml:"distances,omitempty"`
+}
+
+type NUMADistances struct {
+	Siblings []NUMASibling `xml:"sibling"`
+}
+
+type NUMASibling struct {
+	ID    string `xml:"id,attr"`
+	Value uint32 `xml:"value,attr"`
 }
 
 type CPUFeature struct {
@@ -313,6 +377,7 @@ type Features struct {
 	PVSpinlock *FeaturePVSpinlock `xml:"pvspinlock,omitempty"`
 	PMU        *FeatureState      `xml:"pmu,omitempty"`
 	VMPort     *FeatureState      `xml:"vmport,omitempty"`
+	PS2        *FeatureState      `xml:"ps2,omitempty"`
 }
 
 const HypervModePassthrough = "passthrough"
@@ -391,6 +456,8 @@ type KubeVirtMetadata struct {
 	Backup           *BackupMetadata           `xml:"backup,omitempty"`
 	AccessCredential *AccessCredentialMetadata `xml:"accessCredential,omitempty"`
 	MemoryDump       *MemoryDumpMetadata       `xml:"memoryDump,omitempty"`
+	ImageIntegrity   *ImageIntegrityMetadata   `xml:"imageIntegrity,omitempty"`
+	VhostUser        *VhostUserMetadata        `xml:"vhostUser,omitempty"`
 }
 
 type AccessCredentialMetadata struct {
@@ -398,6 +465,20 @@ type AccessCredentialMetadata struct {
 	Message   string `xml:"message,omitempty"`
 }
 
+// ImageIntegrityMetadata is the result of the last integrity check of the containerDisk
+// and ephemeral images
+type ImageIntegrityMetadata struct {
+	Corrupted bool   `xml:"corrupted,omitempty"`
+	Message   string `xml:"message,omitempty"`
+}
+
+// VhostUserMetadata is the result of the last check of the sockets of the vhost-user
+// interfaces, whose backends are not reachable when Disconnected is set
+type VhostUserMetadata struct {
+	Disconnected bool   `xml:"disconnected,omitempty"`
+	Message      string `xml:"message,omitempty"`
+}
+
 type MemoryDumpMetadata struct {
 	FileName       string       `xml:"fileName,omitempty"`
 	StartTimestamp *metav1.Time `xml:"startTimestamp,omitempty"`
@@ -430,6 +511,7 @@ type GracePeriodMetadata struct {
 	DeletionGracePeriodSeconds int64        `xml:"deletionGracePeriodSeconds"`
 	DeletionTimestamp          *metav1.Time `xml:"deletionTimestamp,omitempty"`
 	MarkedForGracefulShutdown  *bool        `xml:"markedForGracefulShutdown,omitempty"`
+	ShutdownMethod             string       `xml:"shutdownMethod,omitempty"`
 }
 
 // DomainBackup mirroring libvirt XML under https://libvirt.org/formatbackup.html#backup-xml-format
@@ -537,7 +619,7 @@ type HugePages struct {
 type HugePage struct {
 	Size    string `xml:"size,attr"`
 	Unit    string `xml:"unit,attr"`
-	NodeSet string `xml:"nodeset,attr"`
+	NodeSet string `xml:"nodeset,attr,omitempty"`
 }
 
 type MemoryBackingAccess struct {
@@ -568,6 +650,33 @@ type MemoryDevice struct {
 	Address *Address      `xml:"address,omitempty"`
 }
 
+// MemoryModelSGXEPC is the model of the memory device backing an SGX enclave page cache.
+const MemoryModelSGXEPC = "sgx-epc"
+
+// MarshalXML renders only the target size for sgx-epc devices, since libvirt
+// rejects the virtio-mem specific target elements on them.
+func (m MemoryDevice) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type memoryDevice MemoryDevice
+	start.Name = xml.Name{Local: "memory"}
+	if m.Model != MemoryModelSGXEPC || m.Target == nil {
+		return e.EncodeElement(memoryDevice(m), start)
+	}
+	type sgxEPCTarget struct {
+		Size Memory `xml:"size"`
+	}
+	return e.EncodeElement(struct {
+		Model   string       `xml:"model,attr"`
+		Target  sgxEPCTarget `xml:"target"`
+		Alias   *Alias       `xml:"alias,omitempty"`
+		Address *Address     `xml:"address,omitempty"`
+	}{
+		Model:   m.Model,
+		Target:  sgxEPCTarget{Size: m.Target.Size},
+		Alias:   m.Alias,
+		Address: m.Address,
+	}, start)
+}
+
 type Devices struct {
 	Emulator     string             `xml:"emulator,omitempty"`
 	Interfaces   []Interface        `xml:"interface"`
@@ -593,7 +702,13 @@ type Devices struct {
 }
 
 type PanicDevice struct {
-	Model *v1.PanicDeviceModel `xml:"model,attr,omitempty"`
+	Model   *v1.PanicDeviceModel `xml:"model,attr,omitempty"`
+	Address *PanicDeviceAddress  `xml:"address,omitempty"`
+}
+
+// PanicDeviceAddress only selects the bus of the panic device, libvirt assigns its address on that bus.
+type PanicDeviceAddress struct {
+	Type string `xml:"type,attr"`
 }
 
 type TPM struct {
@@ -602,9 +717,17 @@ type TPM struct {
 }
 
 type TPMBackend struct {
-	Type            string `xml:"type,attr"`
-	Version         string `xml:"version,attr"`
-	PersistentState string `xml:"persistent_state,attr,omitempty"`
+	Type            string            `xml:"type,attr"`
+	Version         string            `xml:"version,attr,omitempty"`
+	PersistentState string            `xml:"persistent_state,attr,omitempty"`
+	Source          *TPMBackendSource `xml:"source,omitempty"`
+}
+
+// TPMBackendSource is the socket of an external TPM backend
+type TPMBackendSource struct {
+	Type string `xml:"type,attr"`
+	Mode string `xml:"mode,attr"`
+	Path string `xml:"path,attr"`
 }
 
 // RedirectedDevice describes a device to be redirected
@@ -666,6 +789,11 @@ type Input struct {
 	Alias   *Alias       `xml:"alias,omitempty"`
 	Address *Address     `xml:"address,omitempty"`
 	Model   string       `xml:"model,attr,omitempty"`
+	Source  *InputSource `xml:"source,omitempty"`
+}
+
+type InputSource struct {
+	EvDev string `xml:"evdev,attr,omitempty"`
 }
 
 // BEGIN HostDevice -----------------------------
@@ -700,6 +828,7 @@ type Controller struct {
 	Alias     *Alias            `xml:"alias,omitempty"`
 	Address   *Address          `xml:"address,omitempty"`
 	PCIHole64 *PCIHole64        `xml:"pcihole64,omitempty"`
+	Serial    string            `xml:"serial,omitempty"`
 }
 
 // END Controller -----------------------------
@@ -743,6 +872,7 @@ type Disk struct {
 	Capacity           *int64        `xml:"capacity,omitempty"`
 	ExpandDisksEnabled bool          `xml:"expandDisksEnabled,omitempty"`
 	Shareable          *Shareable    `xml:"shareable,omitempty"`
+	IOTune             *DiskIOTune   `xml:"iotune,omitempty"`
 }
 
 type DiskAuth struct {
@@ -768,6 +898,14 @@ type DiskSource struct {
 	Reservations  *Reservations   `xml:"reservations,omitempty"`
 	Slices        []Slice         `xml:"slices,omitempty"`
 	DataStore     *DataStore      `xml:"dataStore,omitempty"`
+	Type          string          `xml:"type,attr,omitempty"`
+	Path          string          `xml:"path,attr,omitempty"`
+	Reconnect     *Reconnect      `xml:"reconnect,omitempty"`
+}
+
+type Reconnect struct {
+	Enabled string `xml:"enabled,attr"`
+	Timeout *uint  `xml:"timeout,attr,omitempty"`
 }
 
 type DiskTarget struct {
@@ -777,16 +915,18 @@ type DiskTarget struct {
 }
 
 type DiskDriver struct {
-	Cache       string             `xml:"cache,attr,omitempty"`
-	ErrorPolicy v1.DiskErrorPolicy `xml:"error_policy,attr,omitempty"`
-	IO          v1.DriverIO        `xml:"io,attr,omitempty"`
-	Name        string             `xml:"name,attr"`
-	Type        string             `xml:"type,attr"`
-	IOThread    *uint              `xml:"iothread,attr,omitempty"`
-	IOThreads   *DiskIOThreads     `xml:"iothreads"`
-	Queues      *uint              `xml:"queues,attr,omitempty"`
-	Discard     string             `xml:"discard,attr,omitempty"`
-	IOMMU       string             `xml:"iommu,attr,omitempty"`
+	Cache        string             `xml:"cache,attr,omitempty"`
+	ErrorPolicy  v1.DiskErrorPolicy `xml:"error_policy,attr,omitempty"`
+	RerrorPolicy v1.DiskErrorPolicy `xml:"rerror_policy,attr,omitempty"`
+	IO           v1.DriverIO        `xml:"io,attr,omitempty"`
+	Name         string             `xml:"name,attr"`
+	Type         string             `xml:"type,attr"`
+	IOThread     *uint              `xml:"iothread,attr,omitempty"`
+	IOThreads    *DiskIOThreads     `xml:"iothreads"`
+	Queues       *uint              `xml:"queues,attr,omitempty"`
+	QueueSize    *uint              `xml:"queue_size,attr,omitempty"`
+	Discard      string             `xml:"discard,attr,omitempty"`
+	IOMMU        string             `xml:"iommu,attr,omitempty"`
 }
 
 type DiskIOThreads struct {
@@ -828,6 +968,27 @@ type BlockIO struct {
 	DiscardGranularity *uint `xml:"discard_granularity,attr,omitempty"`
 }
 
+type DiskIOTune struct {
+	TotalBytesSec          uint64 `xml:"total_bytes_sec,omitempty"`
+	ReadBytesSec           uint64 `xml:"read_bytes_sec,omitempty"`
+	WriteBytesSec          uint64 `xml:"write_bytes_sec,omitempty"`
+	TotalIOPSSec           uint64 `xml:"total_iops_sec,omitempty"`
+	ReadIOPSSec            uint64 `xml:"read_iops_sec,omitempty"`
+	WriteIOPSSec           uint64 `xml:"write_iops_sec,omitempty"`
+	TotalBytesSecMax       uint64 `xml:"total_bytes_sec_max,omitempty"`
+	ReadBytesSecMax        uint64 `xml:"read_bytes_sec_max,omitempty"`
+	WriteBytesSecMax       uint64 `xml:"write_bytes_sec_max,omitempty"`
+	TotalIOPSSecMax        uint64 `xml:"total_iops_sec_max,omitempty"`
+	ReadIOPSSecMax         uint64 `xml:"read_iops_sec_max,omitempty"`
+	WriteIOPSSecMax        uint64 `xml:"write_iops_sec_max,omitempty"`
+	TotalBytesSecMaxLength uint64 `xml:"total_bytes_sec_max_length,omitempty"`
+	ReadBytesSecMaxLength  uint64 `xml:"read_bytes_sec_max_length,omitempty"`
+	WriteBytesSecMaxLength uint64 `xml:"write_bytes_sec_max_length,omitempty"`
+	TotalIOPSSecMaxLength  uint64 `xml:"total_iops_sec_max_length,omitempty"`
+	ReadIOPSSecMaxLength   uint64 `xml:"read_iops_sec_max_length,omitempty"`
+	WriteIOPSSecMaxLength  uint64 `xml:"write_iops_sec_max_length,omitempty"`
+}
+
 type Reservations struct {
 	Managed            string              `xml:"managed,attr,omitempty"`
 	SourceReservations *SourceReservations `xml:"source,omitempty"`
@@ -852,7 +1013,8 @@ type Serial struct {
 }
 
 type SerialTarget struct {
-	Port *uint `xml:"port,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+	Port *uint  `xml:"port,attr,omitempty"`
 }
 
 type SerialSource struct {
@@ -910,6 +1072,20 @@ type Interface struct {
 	ACPI                *ACPI                  `xml:"acpi,omitempty"`
 	Backend             *InterfaceBackend      `xml:"backend,omitempty"`
 	PortForward         []InterfacePortForward `xml:"portForward,omitempty"`
+	Coalesce            *Coalesce              `xml:"coalesce,omitempty"`
+}
+
+// Coalesce configures the interrupt coalescing of an interface backed by a tap device.
+type Coalesce struct {
+	Rx *CoalesceRx `xml:"rx,omitempty"`
+}
+
+type CoalesceRx struct {
+	Frames CoalesceFrames `xml:"frames"`
+}
+
+type CoalesceFrames struct {
+	Max uint32 `xml:"max,attr"`
 }
 
 type InterfacePortForward struct {
@@ -936,9 +1112,33 @@ type ACPI struct {
 }
 
 type InterfaceDriver struct {
-	Name   string `xml:"name,attr"`
-	Queues *uint  `xml:"queues,attr,omitempty"`
-	IOMMU  string `xml:"iommu,attr,omitempty"`
+	Name          string                `xml:"name,attr"`
+	Queues        *uint                 `xml:"queues,attr,omitempty"`
+	IOMMU         string                `xml:"iommu,attr,omitempty"`
+	RxQueueSize   uint32                `xml:"rx_queue_size,attr,omitempty"`
+	TxQueueSize   uint32                `xml:"tx_queue_size,attr,omitempty"`
+	RSS           string                `xml:"rss,attr,omitempty"`
+	RSSHashReport string                `xml:"rss_hash_report,attr,omitempty"`
+	Host          *InterfaceDriverHost  `xml:"host,omitempty"`
+	Guest         *InterfaceDriverGuest `xml:"guest,omitempty"`
+}
+
+// InterfaceDriverHost toggles the offloads of the host backend of a virtio-net interface.
+type InterfaceDriverHost struct {
+	CSum     string `xml:"csum,attr,omitempty"`
+	GSO      string `xml:"gso,attr,omitempty"`
+	TSO4     string `xml:"tso4,attr,omitempty"`
+	TSO6     string `xml:"tso6,attr,omitempty"`
+	UFO      string `xml:"ufo,attr,omitempty"`
+	MrgRxBuf string `xml:"mrg_rxbuf,attr,omitempty"`
+}
+
+// InterfaceDriverGuest toggles the offloads offered to the guest driver of a virtio-net interface.
+type InterfaceDriverGuest struct {
+	CSum string `xml:"csum,attr,omitempty"`
+	TSO4 string `xml:"tso4,attr,omitempty"`
+	TSO6 string `xml:"tso6,attr,omitempty"`
+	UFO  string `xml:"ufo,attr,omitempty"`
 }
 
 type LinkState struct {
@@ -965,11 +1165,14 @@ type FilterRef struct {
 }
 
 type InterfaceSource struct {
-	Network string   `xml:"network,attr,omitempty"`
-	Device  string   `xml:"dev,attr,omitempty"`
-	Bridge  string   `xml:"bridge,attr,omitempty"`
-	Mode    string   `xml:"mode,attr,omitempty"`
-	Address *Address `xml:"address,omitempty"`
+	Network   string     `xml:"network,attr,omitempty"`
+	Device    string     `xml:"dev,attr,omitempty"`
+	Bridge    string     `xml:"bridge,attr,omitempty"`
+	Mode      string     `xml:"mode,attr,omitempty"`
+	Address   *Address   `xml:"address,omitempty"`
+	Type      string     `xml:"type,attr,omitempty"`
+	Path      string     `xml:"path,attr,omitempty"`
+	Reconnect *Reconnect `xml:"reconnect,omitempty"`
 }
 
 type Model struct {
@@ -1111,11 +1314,16 @@ type BIOS struct {
 }
 
 type SysInfo struct {
-	Type      string  `xml:"type,attr"`
-	System    []Entry `xml:"system>entry"`
-	BIOS      []Entry `xml:"bios>entry"`
-	BaseBoard []Entry `xml:"baseBoard>entry"`
-	Chassis   []Entry `xml:"chassis>entry"`
+	Type       string      `xml:"type,attr"`
+	System     []Entry     `xml:"system>entry"`
+	BIOS       []Entry     `xml:"bios>entry"`
+	BaseBoard  []Entry     `xml:"baseBoard>entry"`
+	Chassis    []Entry     `xml:"chassis>entry"`
+	OEMStrings *OEMStrings `xml:"oemStrings,omitempty"`
+}
+
+type OEMStrings struct {
+	Entries []string `xml:"entry"`
 }
 
 type Entry struct {
@@ -1194,11 +1402,18 @@ type Video struct {
 }
 
 type VideoModel struct {
-	Type   string `xml:"type,attr"`
-	Heads  *uint  `xml:"heads,attr,omitempty"`
-	Ram    *uint  `xml:"ram,attr,omitempty"`
-	VRam   *uint  `xml:"vram,attr,omitempty"`
-	VGAMem *uint  `xml:"vgamem,attr,omitempty"`
+	Type   string      `xml:"type,attr"`
+	Heads  *uint       `xml:"heads,attr,omitempty"`
+	Ram    *uint       `xml:"ram,attr,omitempty"`
+	VRam   *uint       `xml:"vram,attr,omitempty"`
+	VGAMem *uint       `xml:"vgamem,attr,omitempty"`
+	Blob   string      `xml:"blob,attr,omitempty"`
+	Accel  *VideoAccel `xml:"acceleration,omitempty"`
+}
+
+type VideoAccel struct {
+	Accel3D    string `xml:"accel3d,attr,omitempty"`
+	RenderNode string `xml:"rendernode,attr,omitempty"`
 }
 
 type Graphics struct {
@@ -1209,6 +1424,11 @@ type Graphics struct {
 	Port          int32           `xml:"port,attr,omitempty"`
 	TLSPort       int             `xml:"tlsPort,attr,omitempty"`
 	Type          string          `xml:"type,attr"`
+	GL            *GraphicsGL     `xml:"gl,omitempty"`
+}
+
+type GraphicsGL struct {
+	RenderNode string `xml:"rendernode,attr,omitempty"`
 }
 
 type GraphicsListen struct {
@@ -1260,6 +1480,7 @@ type MemBalloon struct {
 	Address           *Address          `xml:"address,omitempty"`
 	Driver            *MemBalloonDriver `xml:"driver,omitempty"`
 	FreePageReporting string            `xml:"freePageReporting,attr,omitempty"`
+	Autodeflate       string            `xml:"autodeflate,attr,omitempty"`
 }
 
 type MemBalloonDriver struct {
@@ -1307,6 +1528,15 @@ type IOThreads struct {
 	IOThreads uint `xml:",chardata"`
 }
 
+// IOThreadIDs lists the ids of the iothreads, libvirt reports them once iothreads were added or removed
+type IOThreadIDs struct {
+	IOThread []IOThreadID `xml:"iothread"`
+}
+
+type IOThreadID struct {
+	ID uint `xml:"id,attr"`
+}
+
 // TODO ballooning, rng, cpu ...
 
 type SecretUsage struct {
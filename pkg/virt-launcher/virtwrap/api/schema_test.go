@@ -373,6 +373,20 @@ var _ = ginkgo.Describe("XML marshal of domain device", func() {
 	})
 })
 
+var _ = ginkgo.Describe("XML marshal of sysinfo", func() {
+	ginkgo.It("should marshal the OEM strings", func() {
+		sysInfo := SysInfo{Type: "smbios", OEMStrings: &OEMStrings{Entries: []string{"role:worker"}}}
+		xmlBytes, err := xml.Marshal(sysInfo)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(xmlBytes)).To(ContainSubstring(`<oemStrings><entry>role:worker</entry></oemStrings>`))
+	})
+	ginkgo.It("should omit the OEM strings when not set", func() {
+		xmlBytes, err := xml.Marshal(SysInfo{Type: "smbios"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(xmlBytes)).ToNot(ContainSubstring("oemStrings"))
+	})
+})
+
 var _ = ginkgo.Describe("JSON marshal of the alias of a domain device", func() {
 	ginkgo.It("should deal with package-private struct members for non-user-defined alias", func() {
 		alias := newLibvirtManagedAlias(testAliasName)
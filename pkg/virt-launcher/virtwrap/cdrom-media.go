@@ -0,0 +1,110 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
+)
+
+// ChangeMedia inserts a volume into a CD-ROM of the running domain, or ejects its medium.
+// The change only affects the live domain, the medium of the CD-ROM is restored from the
+// VMI spec when the domain is started again.
+func (l *LibvirtDomainManager) ChangeMedia(vmi *v1.VirtualMachineInstance, options *v1.ChangeMediaOptions) error {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	dom, err := l.virConn.LookupDomainByName(api.VMINamespaceKeyFunc(vmi))
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error(failedGetDomain)
+		return err
+	}
+	defer dom.Free()
+
+	domainSpec, err := util.GetDomainSpecWithFlags(dom, 0)
+	if err != nil {
+		return err
+	}
+
+	return changeCDRomMedium(dom, vmi, domainSpec, options)
+}
+
+func changeCDRomMedium(dom cli.VirDomain, vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec, options *v1.ChangeMediaOptions) error {
+	var cdrom *api.Disk
+	for i, disk := range domainSpec.Devices.Disks {
+		if disk.Alias != nil && disk.Alias.GetName() == options.DiskName {
+			cdrom = domainSpec.Devices.Disks[i].DeepCopy()
+			break
+		}
+	}
+	if cdrom == nil {
+		return fmt.Errorf("disk %s does not exist", options.DiskName)
+	}
+	if cdrom.Device != "cdrom" {
+		return fmt.Errorf("disk %s is not a CD-ROM", options.DiskName)
+	}
+
+	var volume *v1.Volume
+	isBlock := false
+	if options.VolumeName != "" {
+		for i, v := range vmi.Spec.Volumes {
+			if v.Name == options.VolumeName {
+				volume = &vmi.Spec.Volumes[i]
+				break
+			}
+		}
+		if volume == nil {
+			return fmt.Errorf("volume %s does not exist", options.VolumeName)
+		}
+		var err error
+		if isBlock, err = isBlockDeviceVolume(volume.Name); err != nil {
+			return err
+		}
+	}
+
+	if err := converter.Convert_v1_Volume_To_api_CDRomMedium(volume, isBlock, cdrom); err != nil {
+		return err
+	}
+
+	cdromXML, err := xml.Marshal(cdrom)
+	if err != nil {
+		return err
+	}
+	if err := dom.UpdateDeviceFlags(string(cdromXML), libvirt.DOMAIN_DEVICE_MODIFY_LIVE); err != nil {
+		return fmt.Errorf("failed to change the medium of CD-ROM %s: %v", options.DiskName, err)
+	}
+
+	if volume == nil {
+		log.Log.Object(vmi).Infof("Ejected the medium of CD-ROM %s", options.DiskName)
+	} else {
+		log.Log.Object(vmi).Infof("Inserted volume %s into CD-ROM %s", volume.Name, options.DiskName)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("CD-ROM media change", func() {
+	var (
+		mockDomain *cli.MockVirDomain
+		domainSpec *api.DomainSpec
+		vmi        *v1.VirtualMachineInstance
+	)
+
+	expectUpdatedCDRom := func(expected api.Disk) {
+		mockDomain.EXPECT().UpdateDeviceFlags(gomock.Any(), libvirt.DOMAIN_DEVICE_MODIFY_LIVE).DoAndReturn(
+			func(cdromXML string, _ libvirt.DomainDeviceModifyFlags) error {
+				var updated api.Disk
+				Expect(xml.Unmarshal([]byte(cdromXML), &updated)).To(Succeed())
+				Expect(updated).To(Equal(expected))
+				return nil
+			})
+	}
+
+	BeforeEach(func() {
+		mockDomain = cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+		vmi = libvmi.New(
+			libvmi.WithPersistentVolumeClaim("disk0", "rootdisk"),
+			libvmi.WithPersistentVolumeClaim("iso", "install-iso"),
+		)
+		domainSpec = &api.DomainSpec{}
+		domainSpec.Devices.Disks = []api.Disk{
+			{
+				Device: "disk",
+				Type:   "file",
+				Source: api.DiskSource{File: "/var/run/kubevirt-private/vmi-disks/disk0/disk.img"},
+				Target: api.DiskTarget{Bus: v1.DiskBusVirtio, Device: "vda"},
+				Driver: &api.DiskDriver{Name: "qemu", Type: "raw"},
+				Alias:  api.NewUserDefinedAlias("disk0"),
+			},
+			{
+				Device:   "cdrom",
+				Type:     "block",
+				Target:   api.DiskTarget{Bus: v1.DiskBusSATA, Device: "sda"},
+				Driver:   &api.DiskDriver{Name: "qemu", Type: "raw", Discard: "unmap"},
+				ReadOnly: &api.ReadOnly{},
+				Alias:    api.NewUserDefinedAlias("cdrom"),
+			},
+		}
+
+		origIsBlockDeviceVolume := isBlockDeviceVolume
+		DeferCleanup(func() { isBlockDeviceVolume = origIsBlockDeviceVolume })
+		isBlockDeviceVolume = func(volumeName string) (bool, error) {
+			return false, nil
+		}
+	})
+
+	It("should insert a filesystem volume into the CD-ROM", func() {
+		expectUpdatedCDRom(api.Disk{
+			Device:   "cdrom",
+			Type:     "file",
+			Source:   api.DiskSource{File: "/var/run/kubevirt-private/vmi-disks/iso/disk.img"},
+			Target:   api.DiskTarget{Bus: v1.DiskBusSATA, Device: "sda"},
+			Driver:   &api.DiskDriver{Name: "qemu", Type: "raw", Discard: "unmap", ErrorPolicy: v1.DiskErrorPolicyStop},
+			ReadOnly: &api.ReadOnly{},
+			Alias:    api.NewUserDefinedAlias("cdrom"),
+		})
+
+		Expect(changeCDRomMedium(mockDomain, vmi, domainSpec, &v1.ChangeMediaOptions{DiskName: "cdrom", VolumeName: "iso"})).To(Succeed())
+	})
+
+	It("should insert a block volume into the CD-ROM", func() {
+		isBlockDeviceVolume = func(volumeName string) (bool, error) {
+			return volumeName == "iso", nil
+		}
+		expectUpdatedCDRom(api.Disk{
+			Device:   "cdrom",
+			Type:     "block",
+			Source:   api.DiskSource{Name: "iso", Dev: "/dev/iso"},
+			Target:   api.DiskTarget{Bus: v1.DiskBusSATA, Device: "sda"},
+			Driver:   &api.DiskDriver{Name: "qemu", Type: "raw", Discard: "unmap", ErrorPolicy: v1.DiskErrorPolicyStop},
+			ReadOnly: &api.ReadOnly{},
+			Alias:    api.NewUserDefinedAlias("cdrom"),
+		})
+
+		Expect(changeCDRomMedium(mockDomain, vmi, domainSpec, &v1.ChangeMediaOptions{DiskName: "cdrom", VolumeName: "iso"})).To(Succeed())
+	})
+
+	It("should eject the medium of the CD-ROM", func() {
+		domainSpec.Devices.Disks[1].Type = "file"
+		domainSpec.Devices.Disks[1].Source = api.DiskSource{File: "/var/run/kubevirt-private/vmi-disks/iso/disk.img"}
+		expectUpdatedCDRom(api.Disk{
+			Device:   "cdrom",
+			Type:     "block",
+			Target:   api.DiskTarget{Bus: v1.DiskBusSATA, Device: "sda"},
+			Driver:   &api.DiskDriver{Name: "qemu", Type: "raw", Discard: "unmap"},
+			ReadOnly: &api.ReadOnly{},
+			Alias:    api.NewUserDefinedAlias("cdrom"),
+		})
+
+		Expect(changeCDRomMedium(mockDomain, vmi, domainSpec, &v1.ChangeMediaOptions{DiskName: "cdrom"})).To(Succeed())
+	})
+
+	DescribeTable("should refuse to change the medium", func(options *v1.ChangeMediaOptions, expectedErr string) {
+		Expect(changeCDRomMedium(mockDomain, vmi, domainSpec, options)).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("of a missing disk", &v1.ChangeMediaOptions{DiskName: "missing"}, "disk missing does not exist"),
+		Entry("of a disk which is not a CD-ROM", &v1.ChangeMediaOptions{DiskName: "disk0"}, "disk disk0 is not a CD-ROM"),
+		Entry("with a missing volume", &v1.ChangeMediaOptions{DiskName: "cdrom", VolumeName: "missing"}, "volume missing does not exist"),
+	)
+
+	It("should report a failing device update", func() {
+		mockDomain.EXPECT().UpdateDeviceFlags(gomock.Any(), libvirt.DOMAIN_DEVICE_MODIFY_LIVE).Return(fmt.Errorf("tray locked"))
+
+		err := changeCDRomMedium(mockDomain, vmi, domainSpec, &v1.ChangeMediaOptions{DiskName: "cdrom", VolumeName: "iso"})
+		Expect(err).To(MatchError(ContainSubstring("tray locked")))
+	})
+})
@@ -88,6 +88,20 @@ func (mr *MockConnectionMockRecorder) DomainDefineXML(xml any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainDefineXML", reflect.TypeOf((*MockConnection)(nil).DomainDefineXML), xml)
 }
 
+// DomainEventBlockJobRegister mocks base method.
+func (m *MockConnection) DomainEventBlockJobRegister(callback libvirt.DomainEventBlockJobCallback) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainEventBlockJobRegister", callback)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DomainEventBlockJobRegister indicates an expected call of DomainEventBlockJobRegister.
+func (mr *MockConnectionMockRecorder) DomainEventBlockJobRegister(callback any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainEventBlockJobRegister", reflect.TypeOf((*MockConnection)(nil).DomainEventBlockJobRegister), callback)
+}
+
 // DomainEventDeregister mocks base method.
 func (m *MockConnection) DomainEventDeregister(registrationID int) error {
 	m.ctrl.T.Helper()
@@ -130,6 +144,20 @@ func (mr *MockConnectionMockRecorder) DomainEventDeviceRemovedRegister(callback
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainEventDeviceRemovedRegister", reflect.TypeOf((*MockConnection)(nil).DomainEventDeviceRemovedRegister), callback)
 }
 
+// DomainEventIOErrorReasonRegister mocks base method.
+func (m *MockConnection) DomainEventIOErrorReasonRegister(callback libvirt.DomainEventIOErrorReasonCallback) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainEventIOErrorReasonRegister", callback)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DomainEventIOErrorReasonRegister indicates an expected call of DomainEventIOErrorReasonRegister.
+func (mr *MockConnectionMockRecorder) DomainEventIOErrorReasonRegister(callback any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainEventIOErrorReasonRegister", reflect.TypeOf((*MockConnection)(nil).DomainEventIOErrorReasonRegister), callback)
+}
+
 // DomainEventJobCompletedRegister mocks base method.
 func (m *MockConnection) DomainEventJobCompletedRegister(callback libvirt.DomainEventJobCompletedCallback) error {
 	m.ctrl.T.Helper()
@@ -172,6 +200,34 @@ func (mr *MockConnectionMockRecorder) DomainEventMemoryDeviceSizeChangeRegister(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainEventMemoryDeviceSizeChangeRegister", reflect.TypeOf((*MockConnection)(nil).DomainEventMemoryDeviceSizeChangeRegister), callback)
 }
 
+// DomainEventRebootRegister mocks base method.
+func (m *MockConnection) DomainEventRebootRegister(callback libvirt.DomainEventGenericCallback) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainEventRebootRegister", callback)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DomainEventRebootRegister indicates an expected call of DomainEventRebootRegister.
+func (mr *MockConnectionMockRecorder) DomainEventRebootRegister(callback any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainEventRebootRegister", reflect.TypeOf((*MockConnection)(nil).DomainEventRebootRegister), callback)
+}
+
+// DomainEventWatchdogRegister mocks base method.
+func (m *MockConnection) DomainEventWatchdogRegister(callback libvirt.DomainEventWatchdogCallback) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainEventWatchdogRegister", callback)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DomainEventWatchdogRegister indicates an expected call of DomainEventWatchdogRegister.
+func (mr *MockConnectionMockRecorder) DomainEventWatchdogRegister(callback any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainEventWatchdogRegister", reflect.TypeOf((*MockConnection)(nil).DomainEventWatchdogRegister), callback)
+}
+
 // GetAllDomainStats mocks base method.
 func (m *MockConnection) GetAllDomainStats(statsTypes libvirt.DomainStatsTypes, flags libvirt.ConnectGetAllDomainStatsFlags) ([]libvirt.DomainStats, error) {
 	m.ctrl.T.Helper()
@@ -454,6 +510,20 @@ func (mr *MockVirDomainMockRecorder) AbortJob() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortJob", reflect.TypeOf((*MockVirDomain)(nil).AbortJob))
 }
 
+// AddIOThread mocks base method.
+func (m *MockVirDomain) AddIOThread(id uint, flags libvirt.DomainModificationImpact) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddIOThread", id, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddIOThread indicates an expected call of AddIOThread.
+func (mr *MockVirDomainMockRecorder) AddIOThread(id, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddIOThread", reflect.TypeOf((*MockVirDomain)(nil).AddIOThread), id, flags)
+}
+
 // AttachDeviceFlags mocks base method.
 func (m *MockVirDomain) AttachDeviceFlags(xml string, flags libvirt.DomainDeviceModifyFlags) error {
 	m.ctrl.T.Helper()
@@ -496,6 +566,20 @@ func (mr *MockVirDomainMockRecorder) BackupBegin(backupXML, checkpointXML, flags
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupBegin", reflect.TypeOf((*MockVirDomain)(nil).BackupBegin), backupXML, checkpointXML, flags)
 }
 
+// BlockJobSetSpeed mocks base method.
+func (m *MockVirDomain) BlockJobSetSpeed(disk string, bandwidth uint64, flags libvirt.DomainBlockJobSetSpeedFlags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockJobSetSpeed", disk, bandwidth, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockJobSetSpeed indicates an expected call of BlockJobSetSpeed.
+func (mr *MockVirDomainMockRecorder) BlockJobSetSpeed(disk, bandwidth, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockJobSetSpeed", reflect.TypeOf((*MockVirDomain)(nil).BlockJobSetSpeed), disk, bandwidth, flags)
+}
+
 // BlockResize mocks base method.
 func (m *MockVirDomain) BlockResize(disk string, size uint64, flags libvirt.DomainBlockResizeFlags) error {
 	m.ctrl.T.Helper()
@@ -623,6 +707,21 @@ func (mr *MockVirDomainMockRecorder) GetBlockInfo(disk, flags any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockInfo", reflect.TypeOf((*MockVirDomain)(nil).GetBlockInfo), disk, flags)
 }
 
+// GetBlockJobInfo mocks base method.
+func (m *MockVirDomain) GetBlockJobInfo(disk string, flags libvirt.DomainBlockJobInfoFlags) (*libvirt.DomainBlockJobInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockJobInfo", disk, flags)
+	ret0, _ := ret[0].(*libvirt.DomainBlockJobInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockJobInfo indicates an expected call of GetBlockJobInfo.
+func (mr *MockVirDomainMockRecorder) GetBlockJobInfo(disk, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockJobInfo", reflect.TypeOf((*MockVirDomain)(nil).GetBlockJobInfo), disk, flags)
+}
+
 // GetDiskErrors mocks base method.
 func (m *MockVirDomain) GetDiskErrors(flags uint32) ([]libvirt.DomainDiskError, error) {
 	m.ctrl.T.Helper()
@@ -901,6 +1000,20 @@ func (mr *MockVirDomainMockRecorder) SetLaunchSecurityState(params, flags any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLaunchSecurityState", reflect.TypeOf((*MockVirDomain)(nil).SetLaunchSecurityState), params, flags)
 }
 
+// SetMemoryStatsPeriod mocks base method.
+func (m *MockVirDomain) SetMemoryStatsPeriod(period int, flags libvirt.DomainMemoryModFlags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMemoryStatsPeriod", period, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMemoryStatsPeriod indicates an expected call of SetMemoryStatsPeriod.
+func (mr *MockVirDomainMockRecorder) SetMemoryStatsPeriod(period, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMemoryStatsPeriod", reflect.TypeOf((*MockVirDomain)(nil).SetMemoryStatsPeriod), period, flags)
+}
+
 // SetTime mocks base method.
 func (m *MockVirDomain) SetTime(secs int64, nsecs uint, flags libvirt.DomainSetTimeFlags) error {
 	m.ctrl.T.Helper()
@@ -55,6 +55,10 @@ type Connection interface {
 	AgentEventLifecycleRegister(callback libvirt.DomainEventAgentLifecycleCallback) error
 	VolatileDomainEventDeviceRemovedRegister(domain VirDomain, callback libvirt.DomainEventDeviceRemovedCallback) (int, error)
 	DomainEventMemoryDeviceSizeChangeRegister(callback libvirt.DomainEventMemoryDeviceSizeChangeCallback) error
+	DomainEventIOErrorReasonRegister(callback libvirt.DomainEventIOErrorReasonCallback) error
+	DomainEventRebootRegister(callback libvirt.DomainEventGenericCallback) error
+	DomainEventWatchdogRegister(callback libvirt.DomainEventWatchdogCallback) error
+	DomainEventBlockJobRegister(callback libvirt.DomainEventBlockJobCallback) error
 	DomainEventDeregister(registrationID int) error
 	ListAllDomains(flags libvirt.ConnectListAllDomainsFlags) ([]VirDomain, error)
 	NewStream(flags libvirt.StreamFlags) (Stream, error)
@@ -97,6 +101,10 @@ type LibvirtConnection struct {
 	domainEventMigrationIterationCallbacks      []libvirt.DomainEventMigrationIterationCallback
 	agentEventCallbacks                         []libvirt.DomainEventAgentLifecycleCallback
 	domainDeviceMemoryDeviceSizeChangeCallbacks []libvirt.DomainEventMemoryDeviceSizeChangeCallback
+	domainEventIOErrorReasonCallbacks           []libvirt.DomainEventIOErrorReasonCallback
+	domainEventRebootCallbacks                  []libvirt.DomainEventGenericCallback
+	domainEventWatchdogCallbacks                []libvirt.DomainEventWatchdogCallback
+	domainEventBlockJobCallbacks                []libvirt.DomainEventBlockJobCallback
 }
 
 func (s *VirStream) Write(p []byte) (n int, err error) {
@@ -224,6 +232,51 @@ func (l *LibvirtConnection) DomainEventMemoryDeviceSizeChangeRegister(callback l
 	return
 }
 
+func (l *LibvirtConnection) DomainEventIOErrorReasonRegister(callback libvirt.DomainEventIOErrorReasonCallback) (err error) {
+	if err = l.reconnectIfNecessary(); err != nil {
+		return
+	}
+
+	l.domainEventIOErrorReasonCallbacks = append(l.domainEventIOErrorReasonCallbacks, callback)
+	_, err = l.Connect.DomainEventIOErrorReasonRegister(nil, callback)
+	l.checkConnectionLost(err)
+	return
+}
+
+func (l *LibvirtConnection) DomainEventRebootRegister(callback libvirt.DomainEventGenericCallback) (err error) {
+	if err = l.reconnectIfNecessary(); err != nil {
+		return
+	}
+
+	l.domainEventRebootCallbacks = append(l.domainEventRebootCallbacks, callback)
+	_, err = l.Connect.DomainEventRebootRegister(nil, callback)
+	l.checkConnectionLost(err)
+	return
+}
+
+func (l *LibvirtConnection) DomainEventWatchdogRegister(callback libvirt.DomainEventWatchdogCallback) (err error) {
+	if err = l.reconnectIfNecessary(); err != nil {
+		return
+	}
+
+	l.domainEventWatchdogCallbacks = append(l.domainEventWatchdogCallbacks, callback)
+	_, err = l.Connect.DomainEventWatchdogRegister(nil, callback)
+	l.checkConnectionLost(err)
+	return
+}
+
+// DomainEventBlockJobRegister registers for the block job events which report the disks by their target name
+func (l *LibvirtConnection) DomainEventBlockJobRegister(callback libvirt.DomainEventBlockJobCallback) (err error) {
+	if err = l.reconnectIfNecessary(); err != nil {
+		return
+	}
+
+	l.domainEventBlockJobCallbacks = append(l.domainEventBlockJobCallbacks, callback)
+	_, err = l.Connect.DomainEventBlockJob2Register(nil, callback)
+	l.checkConnectionLost(err)
+	return
+}
+
 func (l *LibvirtConnection) DomainEventDeregister(registrationID int) error {
 	return l.Connect.DomainEventDeregister(registrationID)
 }
@@ -591,6 +644,30 @@ func (l *LibvirtConnection) reconnectIfNecessary() (err error) {
 			return err
 		}
 	}
+	for _, callback := range l.domainEventIOErrorReasonCallbacks {
+		log.Log.Infof("Re-registered domain IO error callback: %p", callback)
+		if _, err = l.Connect.DomainEventIOErrorReasonRegister(nil, callback); err != nil {
+			return err
+		}
+	}
+	for _, callback := range l.domainEventRebootCallbacks {
+		log.Log.Infof("Re-registered domain reboot callback: %p", callback)
+		if _, err = l.Connect.DomainEventRebootRegister(nil, callback); err != nil {
+			return err
+		}
+	}
+	for _, callback := range l.domainEventWatchdogCallbacks {
+		log.Log.Infof("Re-registered domain watchdog callback: %p", callback)
+		if _, err = l.Connect.DomainEventWatchdogRegister(nil, callback); err != nil {
+			return err
+		}
+	}
+	for _, callback := range l.domainEventBlockJobCallbacks {
+		log.Log.Infof("Re-registered domain block job callback: %p", callback)
+		if _, err = l.Connect.DomainEventBlockJob2Register(nil, callback); err != nil {
+			return err
+		}
+	}
 
 	log.Log.Error("Re-registered domain and agent callbacks for new connection")
 
@@ -637,6 +714,7 @@ type VirDomain interface {
 	Resume() error
 	BlockResize(disk string, size uint64, flags libvirt.DomainBlockResizeFlags) error
 	GetBlockInfo(disk string, flags uint32) (*libvirt.DomainBlockInfo, error)
+	GetBlockJobInfo(disk string, flags libvirt.DomainBlockJobInfoFlags) (*libvirt.DomainBlockJobInfo, error)
 	AttachDeviceFlags(xml string, flags libvirt.DomainDeviceModifyFlags) error
 	UpdateDeviceFlags(xml string, flags libvirt.DomainDeviceModifyFlags) error
 	DetachDeviceFlags(xml string, flags libvirt.DomainDeviceModifyFlags) error
@@ -651,6 +729,7 @@ type VirDomain interface {
 	MigrateToURI3(string, *libvirt.DomainMigrateParameters, libvirt.DomainMigrateFlags) error
 	MigrateStartPostCopy(flags uint32) error
 	MemoryStats(nrStats uint32, flags uint32) ([]libvirt.DomainMemoryStat, error)
+	SetMemoryStatsPeriod(period int, flags libvirt.DomainMemoryModFlags) error
 	GetJobStats(flags libvirt.DomainGetJobStatsFlags) (*libvirt.DomainJobInfo, error)
 	GetJobInfo() (*libvirt.DomainJobInfo, error)
 	GetGuestInfo(types libvirt.DomainGuestInfoTypes, flags uint32) (*libvirt.DomainGuestInfo, error)
@@ -664,12 +743,14 @@ type VirDomain interface {
 	PinVcpuFlags(vcpu uint, cpuMap []bool, flags libvirt.DomainModificationImpact) error
 	PinEmulator(cpumap []bool, flags libvirt.DomainModificationImpact) error
 	SetVcpusFlags(vcpu uint, flags libvirt.DomainVcpuFlags) error
+	AddIOThread(id uint, flags libvirt.DomainModificationImpact) error
 	GetLaunchSecurityInfo(flags uint32) (*libvirt.DomainLaunchSecurityParameters, error)
 	SetLaunchSecurityState(params *libvirt.DomainLaunchSecurityStateParameters, flags uint32) error
 	FSFreeze(mounts []string, flags uint32) error
 	FSThaw(mounts []string, flags uint32) error
 	Screenshot(stream *libvirt.Stream, screen, flags uint32) (string, error)
 	BackupBegin(backupXML string, checkpointXML string, flags libvirt.DomainBackupBeginFlags) error
+	BlockJobSetSpeed(disk string, bandwidth uint64, flags libvirt.DomainBlockJobSetSpeedFlags) error
 }
 
 func NewConnection(uri string, user string, pass string, checkInterval time.Duration) (Connection, error) {
@@ -200,13 +200,15 @@ func (l *Launcher) SyncVirtualMachine(_ context.Context, request *cmdv1.VMIReque
 		return response, nil
 	}
 
-	if _, err := l.domainManager.SyncVMI(vmi, l.allowEmulation, request.Options); err != nil {
+	_, incomplete, err := l.domainManager.SyncVMI(vmi, l.allowEmulation, request.Options)
+	if err != nil {
 		log.Log.Object(vmi).Reason(err).Errorf("Failed to sync vmi")
 		response.Success = false
 		response.Message = getErrorMessage(err)
 		return response, nil
 	}
 
+	response.Incomplete = incomplete
 	log.Log.Object(vmi).Info("Synced vmi")
 	return response, nil
 }
@@ -861,3 +863,27 @@ func (l *Launcher) BackupVirtualMachine(_ context.Context, request *cmdv1.Backup
 	log.Log.Object(vmi).Info("VMI backup job initiated")
 	return response, nil
 }
+
+func (l *Launcher) ChangeMedia(_ context.Context, request *cmdv1.ChangeMediaRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	var options v1.ChangeMediaOptions
+	if err := json.Unmarshal(request.Options, &options); err != nil {
+		response.Success = false
+		response.Message = "No valid change media options present in command server request"
+		return response, nil
+	}
+
+	if err := l.domainManager.ChangeMedia(vmi, &options); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to change the medium of CD-ROM %s", options.DiskName)
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Infof("Changed the medium of CD-ROM %s", options.DiskName)
+	return response, nil
+}
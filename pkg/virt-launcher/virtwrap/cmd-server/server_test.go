@@ -84,9 +84,21 @@ var _ = Describe("Virt remote commands", func() {
 		It("should start a vmi", func() {
 			vmi := v1.NewVMIReferenceFromName("testvmi")
 			domain := api.NewMinimalDomain("testvmi")
-			domainManager.EXPECT().SyncVMI(vmi, allowEmulation, &cmdv1.VirtualMachineOptions{}).Return(&domain.Spec, nil)
+			domainManager.EXPECT().SyncVMI(vmi, allowEmulation, &cmdv1.VirtualMachineOptions{}).Return(&domain.Spec, false, nil)
 
-			Expect(client.SyncVirtualMachine(vmi, &cmdv1.VirtualMachineOptions{})).To(Succeed())
+			incomplete, err := client.SyncVirtualMachine(vmi, &cmdv1.VirtualMachineOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(incomplete).To(BeFalse())
+		})
+
+		It("should report a vmi sync which deferred a disk", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domain := api.NewMinimalDomain("testvmi")
+			domainManager.EXPECT().SyncVMI(vmi, allowEmulation, &cmdv1.VirtualMachineOptions{}).Return(&domain.Spec, true, nil)
+
+			incomplete, err := client.SyncVirtualMachine(vmi, &cmdv1.VirtualMachineOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(incomplete).To(BeTrue())
 		})
 
 		It("should kill a vmi", func() {
@@ -0,0 +1,146 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
+)
+
+func isColdplugValidationEnabled(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Annotations[v1.ColdplugValidation] == "true"
+}
+
+// syncPersistentDevices adds the disks, interfaces, host devices and memory device hot-added to the running domain
+// to its persistent definition when they are missing from it, and aligns the requested size of the memory device,
+// so that the devices are not dropped by a guest reboot or a migration.
+func syncPersistentDevices(dom cli.VirDomain, vmi *v1.VirtualMachineInstance) error {
+	liveSpec, err := util.GetDomainSpecWithFlags(dom, 0)
+	if err != nil {
+		return fmt.Errorf("failed to get the live domain: %v", err)
+	}
+	persistentSpec, err := util.GetDomainSpecWithFlags(dom, libvirt.DOMAIN_XML_INACTIVE)
+	if err != nil {
+		return fmt.Errorf("failed to get the persistent domain: %v", err)
+	}
+
+	var devices []interface{}
+	for _, disk := range getMissingDisks(liveSpec.Devices.Disks, persistentSpec.Devices.Disks) {
+		devices = append(devices, disk)
+	}
+	for _, iface := range getMissingInterfaces(liveSpec.Devices.Interfaces, persistentSpec.Devices.Interfaces) {
+		devices = append(devices, iface)
+	}
+	for _, hostDevice := range getMissingHostDevices(liveSpec.Devices.HostDevices, persistentSpec.Devices.HostDevices) {
+		devices = append(devices, hostDevice)
+	}
+	if liveSpec.Devices.Memory != nil && persistentSpec.Devices.Memory == nil {
+		devices = append(devices, *liveSpec.Devices.Memory)
+	}
+
+	logger := log.Log.Object(vmi)
+	for _, device := range devices {
+		deviceXML, err := xml.Marshal(device)
+		if err != nil {
+			return err
+		}
+		logger.V(2).Infof("Adding hot-added device to the persistent domain: %s", deviceXML)
+		if err := dom.AttachDeviceFlags(strings.ToLower(string(deviceXML)), libvirt.DOMAIN_DEVICE_MODIFY_CONFIG); err != nil {
+			return fmt.Errorf("failed to add hot-added device to the persistent domain: %v", err)
+		}
+	}
+
+	if liveSpec.Devices.Memory != nil && persistentSpec.Devices.Memory != nil &&
+		liveSpec.Devices.Memory.Target != nil && persistentSpec.Devices.Memory.Target != nil &&
+		liveSpec.Devices.Memory.Target.Requested != persistentSpec.Devices.Memory.Target.Requested {
+		memoryDevice := persistentSpec.Devices.Memory.DeepCopy()
+		memoryDevice.Target.Requested = liveSpec.Devices.Memory.Target.Requested
+		memoryDeviceXML, err := xml.Marshal(memoryDevice)
+		if err != nil {
+			return err
+		}
+		if err := dom.UpdateDeviceFlags(strings.ToLower(string(memoryDeviceXML)), libvirt.DOMAIN_DEVICE_MODIFY_CONFIG); err != nil {
+			return fmt.Errorf("failed to update the memory device of the persistent domain: %v", err)
+		}
+	}
+	return nil
+}
+
+func getMissingDisks(liveDisks, persistentDisks []api.Disk) []api.Disk {
+	persistentTargets := map[string]struct{}{}
+	for _, disk := range persistentDisks {
+		persistentTargets[disk.Target.Device] = struct{}{}
+	}
+	var missingDisks []api.Disk
+	for _, disk := range liveDisks {
+		if _, exists := persistentTargets[disk.Target.Device]; !exists {
+			missingDisks = append(missingDisks, disk)
+		}
+	}
+	return missingDisks
+}
+
+func getMissingInterfaces(liveInterfaces, persistentInterfaces []api.Interface) []api.Interface {
+	persistentAliases := map[string]struct{}{}
+	for _, iface := range persistentInterfaces {
+		if iface.Alias != nil {
+			persistentAliases[iface.Alias.GetName()] = struct{}{}
+		}
+	}
+	var missingInterfaces []api.Interface
+	for _, iface := range liveInterfaces {
+		if iface.Alias == nil || !iface.Alias.IsUserDefined() {
+			continue
+		}
+		if _, exists := persistentAliases[iface.Alias.GetName()]; !exists {
+			missingInterfaces = append(missingInterfaces, iface)
+		}
+	}
+	return missingInterfaces
+}
+
+func getMissingHostDevices(liveHostDevices, persistentHostDevices []api.HostDevice) []api.HostDevice {
+	persistentAliases := map[string]struct{}{}
+	for _, hostDevice := range persistentHostDevices {
+		if hostDevice.Alias != nil {
+			persistentAliases[hostDevice.Alias.GetName()] = struct{}{}
+		}
+	}
+	var missingHostDevices []api.HostDevice
+	for _, hostDevice := range liveHostDevices {
+		if hostDevice.Alias == nil || !hostDevice.Alias.IsUserDefined() {
+			continue
+		}
+		if _, exists := persistentAliases[hostDevice.Alias.GetName()]; !exists {
+			missingHostDevices = append(missingHostDevices, hostDevice)
+		}
+	}
+	return missingHostDevices
+}
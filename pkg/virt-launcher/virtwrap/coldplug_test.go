@@ -0,0 +1,137 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/xml"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("Cold-plug validation", func() {
+	var (
+		mockDomain     *cli.MockVirDomain
+		liveSpec       *api.DomainSpec
+		persistentSpec *api.DomainSpec
+	)
+
+	newDisk := func(device string) api.Disk {
+		return api.Disk{
+			Device: "disk",
+			Type:   "file",
+			Target: api.DiskTarget{Bus: v1.DiskBusSCSI, Device: device},
+			Alias:  api.NewUserDefinedAlias(device),
+		}
+	}
+
+	newMemoryDevice := func(requested uint64) *api.MemoryDevice {
+		return &api.MemoryDevice{
+			Model: "virtio-mem",
+			Target: &api.MemoryTarget{
+				Size:      api.Memory{Value: 2048, Unit: "MiB"},
+				Requested: api.Memory{Value: requested, Unit: "MiB"},
+				Block:     api.Memory{Value: 2, Unit: "MiB"},
+			},
+		}
+	}
+
+	expectDomainSpecs := func() {
+		liveXML, err := xml.Marshal(liveSpec)
+		Expect(err).ToNot(HaveOccurred())
+		persistentXML, err := xml.Marshal(persistentSpec)
+		Expect(err).ToNot(HaveOccurred())
+		mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(liveXML), nil)
+		mockDomain.EXPECT().GetXMLDesc(libvirt.DOMAIN_XML_INACTIVE).Return(string(persistentXML), nil)
+	}
+
+	BeforeEach(func() {
+		mockDomain = cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+		liveSpec = &api.DomainSpec{}
+		persistentSpec = &api.DomainSpec{}
+	})
+
+	It("should be enabled by the annotation", func() {
+		Expect(isColdplugValidationEnabled(libvmi.New())).To(BeFalse())
+		Expect(isColdplugValidationEnabled(libvmi.New(libvmi.WithAnnotation(v1.ColdplugValidation, "true")))).To(BeTrue())
+	})
+
+	It("should not modify the persistent domain when it is in sync", func() {
+		liveSpec.Devices.Disks = []api.Disk{newDisk("sda")}
+		liveSpec.Devices.Memory = newMemoryDevice(1024)
+		persistentSpec.Devices.Disks = []api.Disk{newDisk("sda")}
+		persistentSpec.Devices.Memory = newMemoryDevice(1024)
+		expectDomainSpecs()
+
+		Expect(syncPersistentDevices(mockDomain, libvmi.New())).To(Succeed())
+	})
+
+	It("should add the hot-added disks missing from the persistent domain", func() {
+		liveSpec.Devices.Disks = []api.Disk{newDisk("sda"), newDisk("sdb")}
+		persistentSpec.Devices.Disks = []api.Disk{newDisk("sda")}
+		expectDomainSpecs()
+
+		mockDomain.EXPECT().AttachDeviceFlags(`<disk device="disk" type="file"><source></source><target bus="scsi" dev="sdb"></target><alias name="ua-sdb"></alias></disk>`, libvirt.DOMAIN_DEVICE_MODIFY_CONFIG)
+
+		Expect(syncPersistentDevices(mockDomain, libvmi.New())).To(Succeed())
+	})
+
+	It("should add the hot-added interfaces and host devices missing from the persistent domain", func() {
+		liveSpec.Devices.Interfaces = []api.Interface{
+			{Type: "ethernet", Alias: api.NewUserDefinedAlias("default")},
+			{Type: "ethernet", Alias: api.NewUserDefinedAlias("hotplugged")},
+		}
+		liveSpec.Devices.HostDevices = []api.HostDevice{{Type: "pci", Managed: "no", Alias: api.NewUserDefinedAlias("sriov")}}
+		persistentSpec.Devices.Interfaces = []api.Interface{{Type: "ethernet", Alias: api.NewUserDefinedAlias("default")}}
+		expectDomainSpecs()
+
+		mockDomain.EXPECT().AttachDeviceFlags(`<interface type="ethernet"><source></source><alias name="ua-hotplugged"></alias></interface>`, libvirt.DOMAIN_DEVICE_MODIFY_CONFIG)
+		mockDomain.EXPECT().AttachDeviceFlags(`<hostdev type="pci" managed="no"><source></source><alias name="ua-sriov"></alias></hostdev>`, libvirt.DOMAIN_DEVICE_MODIFY_CONFIG)
+
+		Expect(syncPersistentDevices(mockDomain, libvmi.New())).To(Succeed())
+	})
+
+	It("should add the hot-added memory device missing from the persistent domain", func() {
+		liveSpec.Devices.Memory = newMemoryDevice(1024)
+		expectDomainSpecs()
+
+		mockDomain.EXPECT().AttachDeviceFlags(`<memory model="virtio-mem"><target><size unit="mib">2048</size><requested unit="mib">1024</requested><current unit="">0</current><node></node><block unit="mib">2</block></target></memory>`, libvirt.DOMAIN_DEVICE_MODIFY_CONFIG)
+
+		Expect(syncPersistentDevices(mockDomain, libvmi.New())).To(Succeed())
+	})
+
+	It("should persist the requested size of the memory device", func() {
+		liveSpec.Devices.Memory = newMemoryDevice(1024)
+		persistentSpec.Devices.Memory = newMemoryDevice(0)
+		expectDomainSpecs()
+
+		mockDomain.EXPECT().UpdateDeviceFlags(`<memory model="virtio-mem"><target><size unit="mib">2048</size><requested unit="mib">1024</requested><current unit="">0</current><node></node><block unit="mib">2</block></target></memory>`, libvirt.DOMAIN_DEVICE_MODIFY_CONFIG)
+
+		Expect(syncPersistentDevices(mockDomain, libvmi.New())).To(Succeed())
+	})
+})
@@ -0,0 +1,96 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"fmt"
+	"runtime"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	kutil "kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/arch"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/efi"
+)
+
+// generationConversions holds the parts of the domain conversion which only depend on the VMI
+// spec and on the launcher environment. They are resolved once per VMI generation instead of on
+// every sync. Everything depending on the VMI status or on the pod is still resolved per sync.
+type generationConversions struct {
+	uid              types.UID
+	generation       int64
+	architecture     arch.Converter
+	efiConfiguration *converter.EFIConfiguration
+}
+
+// conversionsForGeneration returns the cached conversions of the VMI generation, resolving them
+// when the VMI or its generation changed. Failed resolutions are not cached.
+// Callers must hold the domainModifyLock.
+func (l *LibvirtDomainManager) conversionsForGeneration(vmi *v1.VirtualMachineInstance) (*generationConversions, error) {
+	if cached := l.generationConversions; cached != nil && cached.uid == vmi.UID && cached.generation == vmi.Generation {
+		return cached, nil
+	}
+
+	efiConf, err := l.resolveEFIConfiguration(vmi)
+	if err != nil {
+		return nil, err
+	}
+	l.generationConversions = &generationConversions{
+		uid:              vmi.UID,
+		generation:       vmi.Generation,
+		architecture:     arch.NewConverter(runtime.GOARCH),
+		efiConfiguration: efiConf,
+	}
+	return l.generationConversions, nil
+}
+
+func (l *LibvirtDomainManager) resolveEFIConfiguration(vmi *v1.VirtualMachineInstance) (*converter.EFIConfiguration, error) {
+	if !vmi.IsBootloaderEFI() {
+		return nil, nil
+	}
+
+	secureBoot := vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot == nil || *vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot
+	sev := kutil.IsSEVVMI(vmi) && !kutil.IsSEVSNPVMI(vmi)
+	snp := kutil.IsSEVSNPVMI(vmi)
+	tdx := kutil.IsTDXVMI(vmi)
+
+	vmType := efi.None
+	if sev {
+		vmType = efi.SEV
+	} else if snp {
+		vmType = efi.SNP
+	} else if tdx {
+		vmType = efi.TDX
+	}
+	if !l.efiEnvironment.Bootable(secureBoot, vmType) {
+		log.Log.Errorf("EFI OVMF roms missing for booting in EFI mode with SecureBoot=%v, SEV/SEV-ES=%v, SEV-SNP=%v, TDX=%v", secureBoot, sev, snp, tdx)
+		return nil, fmt.Errorf("EFI OVMF roms missing for booting in EFI mode with SecureBoot=%v, SEV/SEV-ES=%v, SEV-SNP=%v, TDX=%v", secureBoot, sev, snp, tdx)
+	}
+
+	return &converter.EFIConfiguration{
+		EFICode:      l.efiEnvironment.EFICode(secureBoot, vmType),
+		EFIVars:      l.efiEnvironment.EFIVars(secureBoot, vmType),
+		SecureLoader: secureBoot,
+	}, nil
+}
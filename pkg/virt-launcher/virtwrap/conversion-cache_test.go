@@ -0,0 +1,95 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/efi"
+)
+
+var _ = Describe("Generation conversions", func() {
+	var (
+		manager *LibvirtDomainManager
+		vmi     *v1.VirtualMachineInstance
+	)
+
+	BeforeEach(func() {
+		if runtime.GOARCH != "amd64" {
+			Skip("the OVMF binaries are only faked for amd64")
+		}
+		ovmfPath := GinkgoT().TempDir()
+		for _, binary := range []string{efi.EFICode, efi.EFIVars} {
+			Expect(os.WriteFile(filepath.Join(ovmfPath, binary), nil, 0o644)).To(Succeed())
+		}
+		manager = &LibvirtDomainManager{efiEnvironment: efi.DetectEFIEnvironment(runtime.GOARCH, ovmfPath)}
+		vmi = libvmi.New(libvmi.WithUID("1234"), libvmi.WithUefi(false))
+		vmi.Generation = 1
+	})
+
+	It("should resolve the firmware of a VMI generation only once", func() {
+		conversions, err := manager.conversionsForGeneration(vmi)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(conversions.architecture).ToNot(BeNil())
+		Expect(conversions.efiConfiguration.EFICode).To(HaveSuffix(efi.EFICode))
+
+		manager.efiEnvironment = &efi.EFIEnvironment{}
+		Expect(manager.conversionsForGeneration(vmi)).To(BeIdenticalTo(conversions))
+	})
+
+	It("should resolve the firmware again once the generation changed", func() {
+		conversions, err := manager.conversionsForGeneration(vmi)
+		Expect(err).ToNot(HaveOccurred())
+
+		vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot = nil
+		vmi.Generation = 2
+		_, err = manager.conversionsForGeneration(vmi)
+		Expect(err).To(MatchError(ContainSubstring("EFI OVMF roms missing")))
+		Expect(manager.generationConversions).To(BeIdenticalTo(conversions))
+
+		vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot = pointer.P(false)
+		vmi.Generation = 3
+		updated, err := manager.conversionsForGeneration(vmi)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated).ToNot(BeIdenticalTo(conversions))
+		Expect(updated.generation).To(Equal(int64(3)))
+	})
+
+	It("should not reuse the conversions of another VMI", func() {
+		conversions, err := manager.conversionsForGeneration(vmi)
+		Expect(err).ToNot(HaveOccurred())
+
+		other := libvmi.New(libvmi.WithUID("5678"))
+		other.Generation = 1
+		otherConversions, err := manager.conversionsForGeneration(other)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(otherConversions).ToNot(BeIdenticalTo(conversions))
+		Expect(otherConversions.efiConfiguration).To(BeNil())
+	})
+})
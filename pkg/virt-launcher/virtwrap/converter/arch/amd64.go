@@ -55,6 +55,13 @@ func (converterAMD64) IsUSBNeeded(vmi *v1.VirtualMachineInstance) bool {
 		return true
 	}
 
+	// The usb-serial device of the serial console sits on the USB bus
+	autoattachSerialConsole := vmi.Spec.Domain.Devices.AutoattachSerialConsole
+	if vmi.Spec.Domain.Devices.SerialConsoleTargetType == v1.SerialTargetTypeUSB &&
+		(autoattachSerialConsole == nil || *autoattachSerialConsole) {
+		return true
+	}
+
 	if device.USBDevicesFound(vmi.Spec.Domain.Devices.HostDevices) {
 		return true
 	}
@@ -93,3 +100,24 @@ func (converterAMD64) HasVMPort() bool {
 func (converterAMD64) SupportPCIHole64Disabling() bool {
 	return true
 }
+
+func (converterAMD64) SupportCCWAddresses() bool {
+	return false
+}
+
+func (converterAMD64) SupportMicroVM() bool {
+	return true
+}
+
+func (converterAMD64) DefaultPanicDeviceModel() *v1.PanicDeviceModel {
+	// libvirt defaults to the isa model
+	return nil
+}
+
+func (converterAMD64) SupportedSerialTargetTypes() []v1.SerialTargetType {
+	return []v1.SerialTargetType{v1.SerialTargetTypeISA, v1.SerialTargetTypePCI, v1.SerialTargetTypeUSB}
+}
+
+func (converterAMD64) DeviceLimits() DeviceLimits {
+	return DeviceLimits{PCIDevices: pcieRootPorts}
+}
@@ -19,6 +19,7 @@ package arch
 import (
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -72,3 +73,25 @@ func (converterARM64) HasVMPort() bool {
 func (converterARM64) SupportPCIHole64Disabling() bool {
 	return false
 }
+
+func (converterARM64) SupportCCWAddresses() bool {
+	return false
+}
+
+func (converterARM64) SupportMicroVM() bool {
+	return false
+}
+
+func (converterARM64) DefaultPanicDeviceModel() *v1.PanicDeviceModel {
+	// There is no ISA bus, the panic device has to be on PCI
+	return pointer.P(v1.PvpanicPCI)
+}
+
+func (converterARM64) SupportedSerialTargetTypes() []v1.SerialTargetType {
+	// Only the default target type of the machine can be used
+	return nil
+}
+
+func (converterARM64) DeviceLimits() DeviceLimits {
+	return DeviceLimits{PCIDevices: pcieRootPorts}
+}
@@ -42,8 +42,26 @@ type Converter interface {
 	RequiresMPXCPUValidation() bool
 	ShouldVerboseLogsBeEnabled() bool
 	SupportPCIHole64Disabling() bool
+	SupportCCWAddresses() bool
+	SupportMicroVM() bool
+	DefaultPanicDeviceModel() *v1.PanicDeviceModel
+	SupportedSerialTargetTypes() []v1.SerialTargetType
+	DeviceLimits() DeviceLimits
 }
 
+// DeviceLimits are the maximum numbers of devices the domains can have on an architecture, zero meaning no limit
+type DeviceLimits struct {
+	// PCIDevices is the maximum number of devices taking a PCI slot, e.g. virtio disks, interfaces and host devices
+	PCIDevices int
+	// CCWDevices is the maximum number of devices with a CCW address
+	CCWDevices int
+}
+
+// pcieRootPorts is the number of PCIe root ports libvirt can plug on a PCIe root bus: its 32 slots, minus the ones of
+// the host bridge, of the video device and of the chipset, each hosting a multifunction device of 8 root ports.
+// Every device taking a PCI slot sits behind its own root port.
+const pcieRootPorts = (32 - 3) * 8
+
 func NewConverter(arch string) Converter {
 	switch arch {
 	case arm64:
@@ -19,6 +19,10 @@ package arch
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
 )
 
 var _ = Describe("Arch Converter", func() {
@@ -33,4 +37,25 @@ var _ = Describe("Arch Converter", func() {
 		Entry("s390x", "s390x", converterS390X{}),
 		Entry("unknown", "unknown", converterAMD64{}),
 	)
+	DescribeTable("Should pick a panic device model the architecture can host", func(arch string, model *v1.PanicDeviceModel) {
+		Expect(NewConverter(arch).DefaultPanicDeviceModel()).To(Equal(model))
+	},
+		Entry("amd64", "amd64", nil),
+		Entry("arm64", "arm64", pointer.P(v1.PvpanicPCI)),
+		Entry("s390x", "s390x", pointer.P(v1.PvpanicPCI)),
+	)
+	DescribeTable("Should only allow selecting the serial target type on amd64", func(arch string, targetTypes []v1.SerialTargetType) {
+		Expect(NewConverter(arch).SupportedSerialTargetTypes()).To(Equal(targetTypes))
+	},
+		Entry("amd64", "amd64", []v1.SerialTargetType{v1.SerialTargetTypeISA, v1.SerialTargetTypePCI, v1.SerialTargetTypeUSB}),
+		Entry("arm64", "arm64", nil),
+		Entry("s390x", "s390x", nil),
+	)
+	DescribeTable("Should limit the devices", func(arch string, limits DeviceLimits) {
+		Expect(NewConverter(arch).DeviceLimits()).To(Equal(limits))
+	},
+		Entry("amd64", "amd64", DeviceLimits{PCIDevices: 232}),
+		Entry("arm64", "arm64", DeviceLimits{PCIDevices: 232}),
+		Entry("s390x", "s390x", DeviceLimits{CCWDevices: 65536}),
+	)
 })
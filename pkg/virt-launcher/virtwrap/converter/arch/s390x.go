@@ -19,6 +19,7 @@ package arch
 import (
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -79,3 +80,29 @@ func (converterS390X) HasVMPort() bool {
 func (converterS390X) SupportPCIHole64Disabling() bool {
 	return false
 }
+
+func (converterS390X) SupportCCWAddresses() bool {
+	return true
+}
+
+func (converterS390X) SupportMicroVM() bool {
+	return false
+}
+
+func (converterS390X) DefaultPanicDeviceModel() *v1.PanicDeviceModel {
+	// There is no ISA bus, the panic device has to be on PCI
+	return pointer.P(v1.PvpanicPCI)
+}
+
+func (converterS390X) SupportedSerialTargetTypes() []v1.SerialTargetType {
+	// Only the default target type of the machine can be used
+	return nil
+}
+
+// ccwDevNos is the number of device numbers of the subchannel set libvirt assigns the CCW addresses from
+const ccwDevNos = 0x10000
+
+func (converterS390X) DeviceLimits() DeviceLimits {
+	// the virtio devices are not PCI devices but CCW ones
+	return DeviceLimits{CCWDevices: ccwDevNos}
+}
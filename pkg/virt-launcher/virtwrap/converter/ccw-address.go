@@ -0,0 +1,58 @@
+package converter
+
+import (
+	"fmt"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+type ccwDevice struct {
+	kind    string
+	name    string
+	address *api.Address
+}
+
+// validateCCWAddresses makes sure that no two devices are pinned to the same CCW device number.
+// Devices without an explicit address are placed by libvirt around the pinned ones.
+func validateCCWAddresses(spec *api.DomainSpec) error {
+	var devices []ccwDevice
+	for _, disk := range spec.Devices.Disks {
+		devices = append(devices, ccwDevice{kind: "disk", name: aliasName(disk.Alias), address: disk.Address})
+	}
+	for _, iface := range spec.Devices.Interfaces {
+		devices = append(devices, ccwDevice{kind: "interface", name: aliasName(iface.Alias), address: iface.Address})
+	}
+	for _, controller := range spec.Devices.Controllers {
+		devices = append(devices, ccwDevice{kind: "controller", name: controller.Type + controller.Index, address: controller.Address})
+	}
+	for _, input := range spec.Devices.Inputs {
+		devices = append(devices, ccwDevice{kind: "input", name: aliasName(input.Alias), address: input.Address})
+	}
+	if spec.Devices.Rng != nil {
+		devices = append(devices, ccwDevice{kind: "rng", address: spec.Devices.Rng.Address})
+	}
+	if spec.Devices.Ballooning != nil {
+		devices = append(devices, ccwDevice{kind: "memballoon", address: spec.Devices.Ballooning.Address})
+	}
+
+	pinned := map[string]ccwDevice{}
+	for _, dev := range devices {
+		if dev.address == nil || dev.address.Type != api.AddressCCW {
+			continue
+		}
+		busID := fmt.Sprintf("%s.%s.%s", dev.address.CSSID, dev.address.SSID, dev.address.DevNo)
+		if other, exists := pinned[busID]; exists {
+			return fmt.Errorf("%s %s and %s %s are both placed on the ccw address %s",
+				other.kind, other.name, dev.kind, dev.name, busID)
+		}
+		pinned[busID] = dev
+	}
+	return nil
+}
+
+func aliasName(alias *api.Alias) string {
+	if alias == nil {
+		return ""
+	}
+	return alias.GetName()
+}
@@ -26,6 +26,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/compute"
 )
@@ -96,4 +97,19 @@ var _ = Describe("Balloon Domain Configurator", func() {
 			Expect(domain).To(Equal(expectedDomain))
 		})
 	})
+
+	DescribeTable("Should set the autodeflate attribute of the MemBalloon", func(deflateOnOOM *bool, expectedAutodeflate string) {
+		vmi := libvmi.New()
+		vmi.Spec.Domain.Devices.Balloon = &v1.BalloonDevice{DeflateOnOOM: deflateOnOOM}
+		var domain api.Domain
+
+		configurator := compute.NewBalloonDomainConfigurator(compute.BalloonWithArchitecture("amd64"))
+
+		Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+		Expect(domain.Spec.Devices.Ballooning.Autodeflate).To(Equal(expectedAutodeflate))
+	},
+		Entry("when deflate on OOM is enabled", pointer.P(true), "on"),
+		Entry("when deflate on OOM is disabled", pointer.P(false), "off"),
+		Entry("but not when deflate on OOM is not set", nil, ""),
+	)
 })
@@ -69,6 +69,10 @@ func (b BalloonDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, dom
 	}
 
 	newBalloon.FreePageReporting = boolToOnOff(&b.freePageReporting, false)
+
+	if balloon := vmi.Spec.Domain.Devices.Balloon; balloon != nil && balloon.DeflateOnOOM != nil {
+		newBalloon.Autodeflate = boolToOnOff(balloon.DeflateOnOOM, false)
+	}
 	return nil
 }
 
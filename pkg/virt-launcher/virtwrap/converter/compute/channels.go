@@ -20,9 +20,12 @@
 package compute
 
 import (
+	"path/filepath"
+
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/downwardmetrics"
+	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -35,6 +38,10 @@ func (c ChannelsDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, do
 		domain.Spec.Devices.Channels = append(domain.Spec.Devices.Channels, newDownwardMetricsChannel())
 	}
 
+	for _, channel := range vmi.Spec.Domain.Devices.Channels {
+		domain.Spec.Devices.Channels = append(domain.Spec.Devices.Channels, newUserChannel(channel.Name))
+	}
+
 	return nil
 }
 
@@ -62,3 +69,22 @@ func newDownwardMetricsChannel() api.Channel {
 		},
 	}
 }
+
+func newUserChannel(name string) api.Channel {
+	return api.Channel{
+		Type: "unix",
+		Source: &api.ChannelSource{
+			Mode: "bind",
+			Path: ChannelSocketPath(name),
+		},
+		Target: &api.ChannelTarget{
+			Type: v1.VirtIO,
+			Name: name,
+		},
+	}
+}
+
+// ChannelSocketPath returns the path of the unix socket backing a user-defined channel in the virt-launcher pod.
+func ChannelSocketPath(name string) string {
+	return filepath.Join(util.VirtChannelsDir, name+".sock")
+}
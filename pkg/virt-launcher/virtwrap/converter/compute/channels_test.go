@@ -92,4 +92,24 @@ var _ = Describe("Channels Domain Configurator", func() {
 		}
 		Expect(domain).To(Equal(expectedDomain))
 	})
+
+	It("Should configure the channels specified on the VMI", func() {
+		vmi := libvmi.New(libvmi.WithChannel("org.example.agent.0"))
+		var domain api.Domain
+
+		Expect(compute.ChannelsDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.Channels).To(HaveLen(2))
+		Expect(domain.Spec.Devices.Channels[1]).To(Equal(api.Channel{
+			Type: "unix",
+			Source: &api.ChannelSource{
+				Mode: "bind",
+				Path: "/var/run/kubevirt-channels/org.example.agent.0.sock",
+			},
+			Target: &api.ChannelTarget{
+				Type: v1.VirtIO,
+				Name: "org.example.agent.0",
+			},
+		}))
+	})
 })
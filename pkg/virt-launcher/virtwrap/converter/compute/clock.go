@@ -20,6 +20,7 @@
 package compute
 
 import (
+	"fmt"
 	"strconv"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -28,7 +29,15 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
-type ClockDomainConfigurator struct{}
+type ClockDomainConfigurator struct {
+	architecture string
+}
+
+func NewClockDomainConfigurator(architecture string) ClockDomainConfigurator {
+	return ClockDomainConfigurator{
+		architecture: architecture,
+	}
+}
 
 func (c ClockDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 	if vmi.Spec.Domain.Clock != nil {
@@ -52,6 +61,35 @@ func (c ClockDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domai
 		domain.Spec.Clock = clock
 	}
 
+	if vmi.Spec.Domain.Clock != nil && vmi.Spec.Domain.Clock.PTP != nil {
+		return c.configurePTPClock(domain)
+	}
+
+	return nil
+}
+
+// configurePTPClock makes sure the guest can use the ptp_kvm driver. On arm64 it only
+// relies on KVM, while on amd64 it reads the host clock through kvmclock.
+func (c ClockDomainConfigurator) configurePTPClock(domain *api.Domain) error {
+	if c.architecture == "arm64" {
+		return nil
+	}
+	if c.architecture != "amd64" {
+		return fmt.Errorf("a PTP clock is not supported on %s", c.architecture)
+	}
+
+	if domain.Spec.Clock == nil {
+		domain.Spec.Clock = &api.Clock{}
+	}
+	for _, timer := range domain.Spec.Clock.Timer {
+		if timer.Name == "kvmclock" {
+			if timer.Present == "no" {
+				return fmt.Errorf("a PTP clock requires the kvm timer")
+			}
+			return nil
+		}
+	}
+	domain.Spec.Clock.Timer = append(domain.Spec.Clock.Timer, api.Timer{Name: "kvmclock", Present: "yes"})
 	return nil
 }
 
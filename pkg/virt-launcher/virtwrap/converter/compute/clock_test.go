@@ -37,7 +37,7 @@ var _ = Describe("Clock Domain Configurator", func() {
 
 		var domain api.Domain
 
-		Expect(compute.ClockDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		Expect(compute.NewClockDomainConfigurator("amd64").Configure(vmi, &domain)).To(Succeed())
 		Expect(domain).To(Equal(api.Domain{}))
 	})
 
@@ -53,7 +53,7 @@ var _ = Describe("Clock Domain Configurator", func() {
 
 		var domain api.Domain
 
-		Expect(compute.ClockDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		Expect(compute.NewClockDomainConfigurator("amd64").Configure(vmi, &domain)).To(Succeed())
 
 		expectedDomain := api.Domain{
 			Spec: api.DomainSpec{
@@ -67,4 +67,50 @@ var _ = Describe("Clock Domain Configurator", func() {
 		}
 		Expect(domain).To(Equal(expectedDomain))
 	})
+
+	Context("PTP clock", func() {
+		ptpClock := v1.Clock{PTP: &v1.PTPClock{}}
+
+		It("Should add the kvm timer on amd64", func() {
+			vmi := libvmi.New(libvmi.WithClock(ptpClock))
+			var domain api.Domain
+
+			Expect(compute.NewClockDomainConfigurator("amd64").Configure(vmi, &domain)).To(Succeed())
+
+			Expect(domain.Spec.Clock.Timer).To(ConsistOf(api.Timer{Name: "kvmclock", Present: "yes"}))
+		})
+
+		It("Should keep the kvm timer requested by the VMI", func() {
+			clock := ptpClock
+			clock.Timer = &v1.Timer{KVM: &v1.KVMTimer{}}
+			vmi := libvmi.New(libvmi.WithClock(clock))
+			var domain api.Domain
+
+			Expect(compute.NewClockDomainConfigurator("amd64").Configure(vmi, &domain)).To(Succeed())
+
+			Expect(domain.Spec.Clock.Timer).To(ConsistOf(api.Timer{Name: "kvmclock", Present: "yes"}))
+		})
+
+		It("Should not add timers on arm64", func() {
+			vmi := libvmi.New(libvmi.WithClock(ptpClock))
+			var domain api.Domain
+
+			Expect(compute.NewClockDomainConfigurator("arm64").Configure(vmi, &domain)).To(Succeed())
+
+			Expect(domain.Spec.Clock.Timer).To(BeEmpty())
+		})
+
+		DescribeTable("Should fail", func(arch string, clock v1.Clock, expectedErr string) {
+			vmi := libvmi.New(libvmi.WithClock(clock))
+			var domain api.Domain
+
+			Expect(compute.NewClockDomainConfigurator(arch).Configure(vmi, &domain)).To(MatchError(expectedErr))
+		},
+			Entry("on s390x", "s390x", ptpClock, "a PTP clock is not supported on s390x"),
+			Entry("when the kvm timer is disabled", "amd64",
+				v1.Clock{PTP: &v1.PTPClock{}, Timer: &v1.Timer{KVM: &v1.KVMTimer{Enabled: pointer.P(false)}}},
+				"a PTP clock requires the kvm timer",
+			),
+		)
+	})
 })
@@ -21,6 +21,7 @@ package compute
 
 import (
 	"fmt"
+	"slices"
 
 	v1 "kubevirt.io/api/core/v1"
 
@@ -30,12 +31,14 @@ import (
 )
 
 type ConsoleDomainConfigurator struct {
-	useSerialConsoleLog bool
+	useSerialConsoleLog        bool
+	supportedSerialTargetTypes []v1.SerialTargetType
 }
 
-func NewConsoleDomainConfigurator(useSerialConsoleLog bool) ConsoleDomainConfigurator {
+func NewConsoleDomainConfigurator(useSerialConsoleLog bool, supportedSerialTargetTypes []v1.SerialTargetType) ConsoleDomainConfigurator {
 	return ConsoleDomainConfigurator{
-		useSerialConsoleLog: useSerialConsoleLog,
+		useSerialConsoleLog:        useSerialConsoleLog,
+		supportedSerialTargetTypes: supportedSerialTargetTypes,
 	}
 }
 
@@ -75,6 +78,13 @@ func (c ConsoleDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, dom
 		},
 	}
 
+	if targetType := vmi.Spec.Domain.Devices.SerialConsoleTargetType; targetType != "" {
+		if !slices.Contains(c.supportedSerialTargetTypes, targetType) {
+			return fmt.Errorf("serial console target type %s is not supported on %s", targetType, vmi.Spec.Architecture)
+		}
+		serial.Target.Type = string(targetType)
+	}
+
 	if c.useSerialConsoleLog {
 		serial.Log = &api.SerialLog{
 			File:   fmt.Sprintf("%s-log", socketPath),
@@ -47,7 +47,7 @@ var _ = Describe("Console Domain Configurator", func() {
 			vmi.Spec.Domain.Devices.AutoattachSerialConsole = autoattach
 
 			var domain api.Domain
-			Expect(compute.NewConsoleDomainConfigurator(false).Configure(vmi, &domain)).To(Succeed())
+			Expect(compute.NewConsoleDomainConfigurator(false, nil).Configure(vmi, &domain)).To(Succeed())
 
 			expectedDomain := api.Domain{
 				Spec: api.DomainSpec{
@@ -86,7 +86,7 @@ var _ = Describe("Console Domain Configurator", func() {
 		vmi := libvmi.New(withAutoattachSerialConsole(false))
 		var domain api.Domain
 
-		Expect(compute.NewConsoleDomainConfigurator(false).Configure(vmi, &domain)).To(Succeed())
+		Expect(compute.NewConsoleDomainConfigurator(false, nil).Configure(vmi, &domain)).To(Succeed())
 		Expect(domain).To(Equal(api.Domain{}))
 	})
 
@@ -94,7 +94,7 @@ var _ = Describe("Console Domain Configurator", func() {
 		vmi := libvmi.New(libvmi.WithUID(uid))
 
 		var domain api.Domain
-		configurator := compute.NewConsoleDomainConfigurator(true)
+		configurator := compute.NewConsoleDomainConfigurator(true, nil)
 		Expect(configurator.Configure(vmi, &domain)).To(Succeed())
 
 		expectedDomain := api.Domain{
@@ -131,6 +131,27 @@ var _ = Describe("Console Domain Configurator", func() {
 
 		Expect(domain).To(Equal(expectedDomain))
 	})
+
+	It("should set the serial target type when it is supported", func() {
+		vmi := libvmi.New(libvmi.WithUID(uid))
+		vmi.Spec.Domain.Devices.SerialConsoleTargetType = v1.SerialTargetTypePCI
+
+		var domain api.Domain
+		configurator := compute.NewConsoleDomainConfigurator(false, []v1.SerialTargetType{v1.SerialTargetTypeISA, v1.SerialTargetTypePCI})
+		Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.Serials).To(HaveLen(1))
+		Expect(domain.Spec.Devices.Serials[0].Target).To(Equal(&api.SerialTarget{Type: "pci-serial", Port: &serialPort}))
+	})
+
+	It("should fail when the serial target type is not supported", func() {
+		vmi := libvmi.New(libvmi.WithUID(uid))
+		vmi.Spec.Domain.Devices.SerialConsoleTargetType = v1.SerialTargetTypeUSB
+
+		var domain api.Domain
+		Expect(compute.NewConsoleDomainConfigurator(false, nil).Configure(vmi, &domain)).To(
+			MatchError(ContainSubstring("serial console target type usb-serial is not supported")))
+	})
 })
 
 func withAutoattachSerialConsole(enabled bool) libvmi.Option {
@@ -37,12 +37,14 @@ const (
 type GraphicsDomainConfigurator struct {
 	architecture         string
 	useBochsForEFIGuests bool
+	gpuHostDevices       []api.HostDevice
 }
 
-func NewGraphicsDomainConfigurator(architecture string, useBochsForEFIGuests bool) GraphicsDomainConfigurator {
+func NewGraphicsDomainConfigurator(architecture string, useBochsForEFIGuests bool, gpuHostDevices []api.HostDevice) GraphicsDomainConfigurator {
 	return GraphicsDomainConfigurator{
 		architecture:         architecture,
 		useBochsForEFIGuests: useBochsForEFIGuests,
+		gpuHostDevices:       gpuHostDevices,
 	}
 }
 
@@ -68,14 +70,23 @@ func (g GraphicsDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, do
 
 func (g GraphicsDomainConfigurator) configureVideoDevice(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
 	if vmi.Spec.Domain.Devices.Video != nil {
-		video := api.Video{
-			Model: api.VideoModel{
-				Type:  vmi.Spec.Domain.Devices.Video.Type,
-				VRam:  pointer.P(graphicsDeviceDefaultVRAM),
-				Heads: pointer.P(graphicsDeviceDefaultHeads),
-			},
+		domain.Spec.Devices.Video = []api.Video{convertVideoDevice(vmi.Spec.Domain.Devices.Video)}
+		configureAccel3DGraphics(domain)
+		return
+	}
+
+	if len(vmi.Spec.Domain.Devices.Videos) > 0 {
+		domain.Spec.Devices.Video = nil
+		for i := range vmi.Spec.Domain.Devices.Videos {
+			domain.Spec.Devices.Video = append(domain.Spec.Devices.Video, convertVideoDevice(&vmi.Spec.Domain.Devices.Videos[i]))
 		}
-		domain.Spec.Devices.Video = []api.Video{video}
+		configureAccel3DGraphics(domain)
+		return
+	}
+
+	// The display of the vGPU is the console of the guest, ramfb shows the boot until the guest loads the vGPU driver
+	if g.hasVGPUDisplayWithRamFB(vmi) {
+		domain.Spec.Devices.Video = []api.Video{{Model: api.VideoModel{Type: "none"}}}
 		return
 	}
 
@@ -89,6 +100,27 @@ func (g GraphicsDomainConfigurator) configureVideoDevice(vmi *v1.VirtualMachineI
 	}
 }
 
+// hasVGPUDisplayWithRamFB returns true when a display with ramfb has been explicitly requested on a vGPU,
+// and has been configured on its mediated device
+func (g GraphicsDomainConfigurator) hasVGPUDisplayWithRamFB(vmi *v1.VirtualMachineInstance) bool {
+	requested := false
+	for _, gpu := range vmi.Spec.Domain.Devices.GPUs {
+		if gpu.VirtualGPUOptions != nil && gpu.VirtualGPUOptions.Display != nil {
+			requested = true
+			break
+		}
+	}
+	if !requested {
+		return false
+	}
+	for _, hostDevice := range g.gpuHostDevices {
+		if hostDevice.Display == "on" && hostDevice.RamFB == "on" {
+			return true
+		}
+	}
+	return false
+}
+
 func (g GraphicsDomainConfigurator) configureAMD64VideoDevice(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
 	// For AMD64 + EFI, use bochs. For BIOS, use VGA
 	if g.useBochsForEFIGuests && vmi.IsBootloaderEFI() {
@@ -133,3 +165,48 @@ func (g GraphicsDomainConfigurator) configureS390XVideoDevice(domain *api.Domain
 		},
 	}}
 }
+
+func convertVideoDevice(videoDevice *v1.VideoDevice) api.Video {
+	video := api.Video{
+		Model: api.VideoModel{
+			Type:  videoDevice.Type,
+			VRam:  pointer.P(graphicsDeviceDefaultVRAM),
+			Heads: pointer.P(graphicsDeviceDefaultHeads),
+		},
+	}
+	if videoDevice.Heads != nil {
+		video.Model.Heads = pointer.P(uint(*videoDevice.Heads))
+	}
+	if videoDevice.VRAM != nil {
+		// libvirt expects the video memory in KiB
+		video.Model.VRam = pointer.P(uint(videoDevice.VRAM.Value() / 1024))
+	}
+
+	switch videoDevice.Accel3D {
+	case v1.VideoAccel3DVirgl:
+		video.Model.Accel = &api.VideoAccel{Accel3D: "yes"}
+	case v1.VideoAccel3DVenus:
+		// Vulkan guests rely on blob resources on top of the 3D acceleration
+		video.Model.Accel = &api.VideoAccel{Accel3D: "yes"}
+		video.Model.Blob = "on"
+	}
+	return video
+}
+
+// Accel3DRenderNode is the DRM render node the 3D accelerated video devices render with.
+// virt-controller requests it for the launcher pod through the dri-render device plugin.
+const Accel3DRenderNode = "/dev/dri/renderD128"
+
+// configureAccel3DGraphics adds the headless OpenGL display the 3D accelerated video devices render to,
+// as VNC does not provide an OpenGL context by itself.
+func configureAccel3DGraphics(domain *api.Domain) {
+	for _, video := range domain.Spec.Devices.Video {
+		if video.Model.Accel != nil && video.Model.Accel.Accel3D == "yes" {
+			domain.Spec.Devices.Graphics = append(domain.Spec.Devices.Graphics, api.Graphics{
+				Type: "egl-headless",
+				GL:   &api.GraphicsGL{RenderNode: Accel3DRenderNode},
+			})
+			return
+		}
+	}
+}
@@ -25,6 +25,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
@@ -40,7 +42,7 @@ var _ = Describe("Graphics Domain Configurator", func() {
 			vmi := libvmi.New(libvmi.WithAutoattachGraphicsDevice(false))
 
 			domain := api.Domain{}
-			configurator := compute.NewGraphicsDomainConfigurator(arch, false)
+			configurator := compute.NewGraphicsDomainConfigurator(arch, false, nil)
 			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
 
 			Expect(domain).To(Equal(api.Domain{}))
@@ -55,7 +57,7 @@ var _ = Describe("Graphics Domain Configurator", func() {
 			vmi.Spec.Domain.Devices.AutoattachGraphicsDevice = autoAttach
 
 			domain := api.Domain{}
-			configurator := compute.NewGraphicsDomainConfigurator(arch, false)
+			configurator := compute.NewGraphicsDomainConfigurator(arch, false, nil)
 			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
 
 			expectedDomain := api.Domain{
@@ -91,7 +93,7 @@ var _ = Describe("Graphics Domain Configurator", func() {
 			vmi := libvmi.New(libvmi.WithVideo("virtio"))
 			var domain api.Domain
 
-			configurator := compute.NewGraphicsDomainConfigurator(arch, bochsForEFI)
+			configurator := compute.NewGraphicsDomainConfigurator(arch, bochsForEFI, nil)
 			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
 
 			expectedDomain := api.Domain{
@@ -132,7 +134,7 @@ var _ = Describe("Graphics Domain Configurator", func() {
 		DescribeTable("amd64 defaults to VGA with VRAM", func(vmi *v1.VirtualMachineInstance, bochsForEFI bool) {
 			var domain api.Domain
 
-			configurator := compute.NewGraphicsDomainConfigurator("amd64", bochsForEFI)
+			configurator := compute.NewGraphicsDomainConfigurator("amd64", bochsForEFI, nil)
 			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
 
 			expectedDomain := api.Domain{
@@ -171,7 +173,7 @@ var _ = Describe("Graphics Domain Configurator", func() {
 			vmi := libvmi.New(libvmi.WithUefi(true))
 			var domain api.Domain
 
-			configurator := compute.NewGraphicsDomainConfigurator("amd64", true)
+			configurator := compute.NewGraphicsDomainConfigurator("amd64", true, nil)
 			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
 
 			expectedDomain := api.Domain{
@@ -200,6 +202,92 @@ var _ = Describe("Graphics Domain Configurator", func() {
 
 			Expect(domain).To(Equal(expectedDomain))
 		})
+
+		It("should configure multiple video devices", func() {
+			vmi := libvmi.New()
+			vmi.Spec.Domain.Devices.Videos = []v1.VideoDevice{
+				{Type: "virtio", Heads: pointer.P(uint32(2)), VRAM: pointer.P(resource.MustParse("64Mi"))},
+				{Type: "virtio"},
+			}
+			var domain api.Domain
+
+			configurator := compute.NewGraphicsDomainConfigurator("amd64", false, nil)
+			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+
+			Expect(domain.Spec.Devices.Video).To(Equal([]api.Video{
+				{Model: api.VideoModel{Type: "virtio", Heads: pointer.P(uint(2)), VRam: pointer.P(uint(65536))}},
+				{Model: api.VideoModel{Type: "virtio", Heads: pointer.P(uint(1)), VRam: pointer.P(uint(16384))}},
+			}))
+			Expect(domain.Spec.Devices.Graphics).To(HaveLen(1))
+		})
+
+		DescribeTable("should configure the 3D acceleration", func(accel3D v1.VideoAccel3D, expectedBlob string) {
+			vmi := libvmi.New()
+			vmi.Spec.Domain.Devices.Video = &v1.VideoDevice{Type: "virtio", Accel3D: accel3D}
+			var domain api.Domain
+
+			configurator := compute.NewGraphicsDomainConfigurator("amd64", false, nil)
+			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+
+			Expect(domain.Spec.Devices.Video).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Video[0].Model.Accel).To(Equal(&api.VideoAccel{Accel3D: "yes"}))
+			Expect(domain.Spec.Devices.Video[0].Model.Blob).To(Equal(expectedBlob))
+			Expect(domain.Spec.Devices.Graphics).To(ContainElement(api.Graphics{
+				Type: "egl-headless",
+				GL:   &api.GraphicsGL{RenderNode: "/dev/dri/renderD128"},
+			}))
+		},
+			Entry("with virgl", v1.VideoAccel3DVirgl, ""),
+			Entry("with venus", v1.VideoAccel3DVenus, "on"),
+		)
+	})
+
+	Context("vGPU display", func() {
+		newVGPU := func(display *v1.VGPUDisplayOptions) v1.GPU {
+			gpu := v1.GPU{Name: "gpu1", DeviceName: "nvidia.com/GRID_T4-1Q"}
+			if display != nil {
+				gpu.VirtualGPUOptions = &v1.VGPUOptions{Display: display}
+			}
+			return gpu
+		}
+
+		DescribeTable("should configure the video device", func(gpu v1.GPU, video *v1.VideoDevice, hostDevice api.HostDevice, expectedVideo api.Video) {
+			vmi := libvmi.New()
+			vmi.Spec.Domain.Devices.GPUs = []v1.GPU{gpu}
+			vmi.Spec.Domain.Devices.Video = video
+			var domain api.Domain
+
+			configurator := compute.NewGraphicsDomainConfigurator("amd64", false, []api.HostDevice{hostDevice})
+			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+
+			Expect(domain.Spec.Devices.Video).To(Equal([]api.Video{expectedVideo}))
+		},
+			Entry("without a video device when the display with ramfb is requested",
+				newVGPU(&v1.VGPUDisplayOptions{}), nil,
+				api.HostDevice{Display: "on", RamFB: "on"},
+				api.Video{Model: api.VideoModel{Type: "none"}},
+			),
+			Entry("with VGA when the default display is used",
+				newVGPU(nil), nil,
+				api.HostDevice{Display: "on", RamFB: "on"},
+				newExpectedAMD64VideoDevice(),
+			),
+			Entry("with VGA when ramfb is disabled",
+				newVGPU(&v1.VGPUDisplayOptions{RamFB: &v1.FeatureState{Enabled: pointer.P(false)}}), nil,
+				api.HostDevice{Display: "on"},
+				newExpectedAMD64VideoDevice(),
+			),
+			Entry("with VGA when the GPU is not a mediated device",
+				newVGPU(&v1.VGPUDisplayOptions{}), nil,
+				api.HostDevice{},
+				newExpectedAMD64VideoDevice(),
+			),
+			Entry("with the user-specified video device",
+				newVGPU(&v1.VGPUDisplayOptions{}), &v1.VideoDevice{Type: "virtio"},
+				api.HostDevice{Display: "on", RamFB: "on"},
+				api.Video{Model: api.VideoModel{Type: "virtio", Heads: pointer.P(uint(1)), VRam: pointer.P(uint(16384))}},
+			),
+		)
 	})
 })
 
@@ -28,21 +28,23 @@ import (
 )
 
 type InputDeviceDomainConfigurator struct {
-	architecture string
+	architecture     string
+	hostInputDevices []api.Input
 }
 
-func NewInputDeviceDomainConfigurator(architecture string) InputDeviceDomainConfigurator {
+func NewInputDeviceDomainConfigurator(architecture string, hostInputDevices []api.Input) InputDeviceDomainConfigurator {
 	return InputDeviceDomainConfigurator{
-		architecture: architecture,
+		architecture:     architecture,
+		hostInputDevices: hostInputDevices,
 	}
 }
 
 func (i InputDeviceDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 	if vmi.Spec.Domain.Devices.Inputs != nil {
 		inputDevices := make([]api.Input, 0)
-		for i := range vmi.Spec.Domain.Devices.Inputs {
+		for idx := range vmi.Spec.Domain.Devices.Inputs {
 			inputDevice := api.Input{}
-			err := convert_v1_Input_To_api_InputDevice(&vmi.Spec.Domain.Devices.Inputs[i], &inputDevice)
+			err := i.convert_v1_Input_To_api_InputDevice(&vmi.Spec.Domain.Devices.Inputs[idx], &inputDevice)
 			if err != nil {
 				return err
 			}
@@ -51,6 +53,8 @@ func (i InputDeviceDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance,
 		domain.Spec.Devices.Inputs = inputDevices
 	}
 
+	domain.Spec.Devices.Inputs = append(domain.Spec.Devices.Inputs, i.hostInputDevices...)
+
 	if vmi.Spec.Domain.Devices.AutoattachGraphicsDevice == nil || *vmi.Spec.Domain.Devices.AutoattachGraphicsDevice {
 		if err := i.addArchitectureSpecificInputDevices(vmi, domain); err != nil {
 			return err
@@ -60,17 +64,23 @@ func (i InputDeviceDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance,
 	return nil
 }
 
-func convert_v1_Input_To_api_InputDevice(input *v1.Input, inputDevice *api.Input) error {
-	if input.Bus != v1.InputBusVirtio && input.Bus != v1.InputBusUSB && input.Bus != "" {
-		return fmt.Errorf("input contains unsupported bus %s", input.Bus)
-	}
+func (i InputDeviceDomainConfigurator) convert_v1_Input_To_api_InputDevice(input *v1.Input, inputDevice *api.Input) error {
+	if input.Bus == v1.InputBusPS2 {
+		if err := i.validatePS2Input(input); err != nil {
+			return err
+		}
+	} else {
+		if input.Bus != v1.InputBusVirtio && input.Bus != v1.InputBusUSB && input.Bus != "" {
+			return fmt.Errorf("input contains unsupported bus %s", input.Bus)
+		}
 
-	if input.Bus != v1.InputBusVirtio && input.Bus != v1.InputBusUSB {
-		input.Bus = v1.InputBusUSB
-	}
+		if input.Bus != v1.InputBusVirtio && input.Bus != v1.InputBusUSB {
+			input.Bus = v1.InputBusUSB
+		}
 
-	if input.Type != v1.InputTypeTablet {
-		return fmt.Errorf("input contains unsupported type %s", input.Type)
+		if input.Type != v1.InputTypeTablet {
+			return fmt.Errorf("input contains unsupported type %s", input.Type)
+		}
 	}
 
 	inputDevice.Bus = input.Bus
@@ -83,6 +93,27 @@ func convert_v1_Input_To_api_InputDevice(input *v1.Input, inputDevice *api.Input
 	return nil
 }
 
+// validatePS2Input checks a ps2 input device, which is served by the i8042 controller of x86 machines
+func (i InputDeviceDomainConfigurator) validatePS2Input(input *v1.Input) error {
+	if i.architecture != "amd64" {
+		return fmt.Errorf("input bus %s is not supported on %s", input.Bus, i.architecture)
+	}
+	if input.Type != v1.InputTypeKeyboard && input.Type != v1.InputTypeMouse {
+		return fmt.Errorf("input contains unsupported type %s for bus %s", input.Type, input.Bus)
+	}
+	return nil
+}
+
+// HasPS2InputDevice returns true if the VMI has an input device on the ps2 bus
+func HasPS2InputDevice(vmi *v1.VirtualMachineInstance) bool {
+	for _, input := range vmi.Spec.Domain.Devices.Inputs {
+		if input.Bus == v1.InputBusPS2 {
+			return true
+		}
+	}
+	return false
+}
+
 func (i InputDeviceDomainConfigurator) addArchitectureSpecificInputDevices(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 	switch i.architecture {
 	case "amd64":
@@ -37,7 +37,7 @@ var _ = Describe("Input Device Configurator", func() {
 			vmi := libvmi.New(libvmi.WithTablet("my-tablet", bus), libvmi.WithAutoattachGraphicsDevice(false))
 			var domain api.Domain
 
-			configurator := compute.NewInputDeviceDomainConfigurator(arch)
+			configurator := compute.NewInputDeviceDomainConfigurator(arch, nil)
 			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
 
 			expectedDomain := api.Domain{
@@ -75,13 +75,41 @@ var _ = Describe("Input Device Configurator", func() {
 			}
 			var domain api.Domain
 
-			configurator := compute.NewInputDeviceDomainConfigurator("amd64")
+			configurator := compute.NewInputDeviceDomainConfigurator("amd64", nil)
 			err := configurator.Configure(vmi, &domain)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring(expectedError))
 		},
-			Entry("unsupported bus", v1.InputBus("ps2"), v1.InputTypeTablet, "unsupported bus"),
+			Entry("unsupported bus", v1.InputBus("ide"), v1.InputTypeTablet, "unsupported bus"),
 			Entry("unsupported type", v1.InputBusUSB, v1.InputType("keyboard"), "unsupported type"),
+			Entry("unsupported type on the ps2 bus", v1.InputBusPS2, v1.InputTypeTablet, "unsupported type tablet for bus ps2"),
+		)
+
+		DescribeTable("should configure ps2 input devices on amd64", func(inputType v1.InputType) {
+			vmi := libvmi.New(libvmi.WithAutoattachGraphicsDevice(false))
+			vmi.Spec.Domain.Devices.Inputs = []v1.Input{{Name: "legacy", Type: inputType, Bus: v1.InputBusPS2}}
+			var domain api.Domain
+
+			configurator := compute.NewInputDeviceDomainConfigurator("amd64", nil)
+			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+			Expect(domain.Spec.Devices.Inputs).To(Equal([]api.Input{
+				{Type: inputType, Bus: v1.InputBusPS2, Alias: api.NewUserDefinedAlias("legacy")},
+			}))
+		},
+			Entry("with a keyboard", v1.InputTypeKeyboard),
+			Entry("with a mouse", v1.InputTypeMouse),
+		)
+
+		DescribeTable("should reject ps2 input devices on", func(arch string) {
+			vmi := libvmi.New()
+			vmi.Spec.Domain.Devices.Inputs = []v1.Input{{Name: "legacy", Type: v1.InputTypeKeyboard, Bus: v1.InputBusPS2}}
+			var domain api.Domain
+
+			configurator := compute.NewInputDeviceDomainConfigurator(arch, nil)
+			Expect(configurator.Configure(vmi, &domain)).To(MatchError(ContainSubstring("input bus ps2 is not supported on " + arch)))
+		},
+			Entry("arm64", "arm64"),
+			Entry("s390x", "s390x"),
 		)
 	})
 
@@ -92,7 +120,7 @@ var _ = Describe("Input Device Configurator", func() {
 				vmi.Spec.Domain.Devices.AutoattachGraphicsDevice = autoattachGraphicsDevice
 				var domain api.Domain
 
-				configurator := compute.NewInputDeviceDomainConfigurator(arch)
+				configurator := compute.NewInputDeviceDomainConfigurator(arch, nil)
 				Expect(configurator.Configure(vmi, &domain)).To(Succeed())
 
 				expectedDomain := api.Domain{Spec: api.DomainSpec{Devices: api.Devices{Inputs: expectedInputDevices}}}
@@ -122,7 +150,7 @@ var _ = Describe("Input Device Configurator", func() {
 				vmi.Spec.Domain.Devices.AutoattachGraphicsDevice = pointer.P(false)
 				var domain api.Domain
 
-				configurator := compute.NewInputDeviceDomainConfigurator(arch)
+				configurator := compute.NewInputDeviceDomainConfigurator(arch, nil)
 				Expect(configurator.Configure(vmi, &domain)).To(Succeed())
 
 				Expect(domain).To(Equal(api.Domain{}))
@@ -132,4 +160,25 @@ var _ = Describe("Input Device Configurator", func() {
 			Entry("s390x", "s390x"),
 		)
 	})
+
+	Context("Host input devices", func() {
+		It("should pass through the host input devices after the user-specified ones", func() {
+			vmi := libvmi.New(libvmi.WithTablet("my-tablet", v1.InputBusUSB), libvmi.WithAutoattachGraphicsDevice(false))
+			hostInput := api.Input{
+				Type:   "passthrough",
+				Bus:    v1.InputBusVirtio,
+				Alias:  api.NewUserDefinedAlias("hostdevice-keyboard"),
+				Source: &api.InputSource{EvDev: "/dev/input/event3"},
+			}
+			var domain api.Domain
+
+			configurator := compute.NewInputDeviceDomainConfigurator("amd64", []api.Input{hostInput})
+			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+
+			Expect(domain.Spec.Devices.Inputs).To(Equal([]api.Input{
+				{Type: "tablet", Bus: v1.InputBusUSB, Alias: api.NewUserDefinedAlias("my-tablet")},
+				hostInput,
+			}))
+		})
+	})
 })
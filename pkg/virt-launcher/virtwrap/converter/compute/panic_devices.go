@@ -25,14 +25,41 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
-type PanicDevicesDomainConfigurator struct{}
+type PanicDevicesDomainConfigurator struct {
+	defaultModel *v1.PanicDeviceModel
+}
+
+// NewPanicDevicesDomainConfigurator returns a configurator which uses defaultModel
+// for panic devices that do not specify a model. A nil defaultModel leaves the
+// choice to libvirt.
+func NewPanicDevicesDomainConfigurator(defaultModel *v1.PanicDeviceModel) PanicDevicesDomainConfigurator {
+	return PanicDevicesDomainConfigurator{
+		defaultModel: defaultModel,
+	}
+}
 
 func (p PanicDevicesDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 	for _, panicDevice := range vmi.Spec.Domain.Devices.PanicDevices {
-		domain.Spec.Devices.PanicDevices = append(domain.Spec.Devices.PanicDevices, api.PanicDevice{
-			Model: panicDevice.Model,
-		})
+		model := panicDevice.Model
+		if model == nil {
+			model = p.defaultModel
+		}
+		domain.Spec.Devices.PanicDevices = append(domain.Spec.Devices.PanicDevices, convertPanicDevice(model))
 	}
 
 	return nil
 }
+
+func convertPanicDevice(model *v1.PanicDeviceModel) api.PanicDevice {
+	if model == nil || *model != v1.PvpanicPCI {
+		return api.PanicDevice{Model: model}
+	}
+
+	// libvirt has no dedicated model for the PCI variant, it picks
+	// pvpanic-pci for a pvpanic device placed on the PCI bus.
+	pvpanic := v1.Pvpanic
+	return api.PanicDevice{
+		Model:   &pvpanic,
+		Address: &api.PanicDeviceAddress{Type: api.AddressPCI},
+	}
+}
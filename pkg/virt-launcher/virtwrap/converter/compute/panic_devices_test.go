@@ -26,6 +26,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/compute"
 )
@@ -65,4 +66,29 @@ var _ = Describe("Panic Device Domain Configurator", func() {
 		}
 		Expect(domain).To(Equal(expectedDomain))
 	})
+	It("Should place a pvpanic-pci device on the PCI bus", func() {
+		vmi := libvmi.New(libvmi.WithPanicDevice(v1.PvpanicPCI))
+		var domain api.Domain
+
+		Expect(compute.PanicDevicesDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+
+		pvpanicModel := v1.Pvpanic
+		Expect(domain.Spec.Devices.PanicDevices).To(Equal([]api.PanicDevice{
+			{Model: &pvpanicModel, Address: &api.PanicDeviceAddress{Type: api.AddressPCI}},
+		}))
+	})
+
+	DescribeTable("Should apply the default model to panic devices without a model", func(defaultModel *v1.PanicDeviceModel, expected api.PanicDevice) {
+		vmi := libvmi.New()
+		vmi.Spec.Domain.Devices.PanicDevices = []v1.PanicDevice{{}}
+		var domain api.Domain
+
+		Expect(compute.NewPanicDevicesDomainConfigurator(defaultModel).Configure(vmi, &domain)).To(Succeed())
+		Expect(domain.Spec.Devices.PanicDevices).To(Equal([]api.PanicDevice{expected}))
+	},
+		Entry("without a default", nil, api.PanicDevice{}),
+		Entry("with isa as default", pointer.P(v1.Isa), api.PanicDevice{Model: pointer.P(v1.Isa)}),
+		Entry("with pvpanic-pci as default", pointer.P(v1.PvpanicPCI),
+			api.PanicDevice{Model: pointer.P(v1.Pvpanic), Address: &api.PanicDeviceAddress{Type: api.AddressPCI}}),
+	)
 })
@@ -0,0 +1,67 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package compute
+
+import (
+	"fmt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// SerialsDomainConfigurator adds the additional serial ports of the VMI after the
+// auto-attached serial console, each of them backed by a pty and logged to a file named after it.
+// Only the serial console is paired with a console device, libvirt refuses to expose
+// more than one serial port as a console.
+type SerialsDomainConfigurator struct{}
+
+func (s SerialsDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
+	const (
+		serialTypePty = "pty"
+		logAppend     = "on"
+	)
+
+	firstPort := uint(len(domain.Spec.Devices.Serials))
+	for i, serial := range vmi.Spec.Domain.Devices.Serials {
+		port := api.Serial{
+			Type: serialTypePty,
+			Target: &api.SerialTarget{
+				Type: string(vmi.Spec.Domain.Devices.SerialConsoleTargetType),
+				Port: pointer.P(firstPort + uint(i)),
+			},
+			Alias: api.NewUserDefinedAlias("serial-" + serial.Name),
+			Log: &api.SerialLog{
+				File:   serialLogPath(vmi, serial.Name),
+				Append: logAppend,
+			},
+		}
+		domain.Spec.Devices.Serials = append(domain.Spec.Devices.Serials, port)
+	}
+
+	return nil
+}
+
+// serialLogPath returns the path of the log file of an additional serial port in the virt-launcher pod
+func serialLogPath(vmi *v1.VirtualMachineInstance, name string) string {
+	return fmt.Sprintf("%s/%s/virt-serial-%s-log", util.VirtPrivateDir, vmi.ObjectMeta.UID, name)
+}
@@ -0,0 +1,89 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package compute_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/compute"
+)
+
+var _ = Describe("Serials Domain Configurator", func() {
+	const uid = "test-uid"
+
+	It("should not add serial ports when none are specified", func() {
+		vmi := libvmi.New(libvmi.WithUID(uid))
+		var domain api.Domain
+
+		Expect(compute.SerialsDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		Expect(domain.Spec.Devices.Serials).To(BeEmpty())
+	})
+
+	It("should add the serial ports after the serial console", func() {
+		vmi := libvmi.New(libvmi.WithUID(uid), libvmi.WithSerial("uart1"), libvmi.WithSerial("uart2"))
+		domain := api.Domain{}
+		domain.Spec.Devices.Serials = []api.Serial{{Type: "unix", Target: &api.SerialTarget{Port: pointer.P(uint(0))}}}
+
+		Expect(compute.SerialsDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.Serials).To(HaveLen(3))
+		Expect(domain.Spec.Devices.Serials[1:]).To(Equal([]api.Serial{
+			{
+				Type:   "pty",
+				Target: &api.SerialTarget{Port: pointer.P(uint(1))},
+				Alias:  api.NewUserDefinedAlias("serial-uart1"),
+				Log:    &api.SerialLog{File: "/var/run/kubevirt-private/test-uid/virt-serial-uart1-log", Append: "on"},
+			},
+			{
+				Type:   "pty",
+				Target: &api.SerialTarget{Port: pointer.P(uint(2))},
+				Alias:  api.NewUserDefinedAlias("serial-uart2"),
+				Log:    &api.SerialLog{File: "/var/run/kubevirt-private/test-uid/virt-serial-uart2-log", Append: "on"},
+			},
+		}))
+		Expect(domain.Spec.Devices.Consoles).To(BeEmpty())
+	})
+
+	It("should start numbering the serial ports from 0 without serial console", func() {
+		vmi := libvmi.New(libvmi.WithUID(uid), libvmi.WithoutSerialConsole(), libvmi.WithSerial("uart0"))
+		var domain api.Domain
+
+		Expect(compute.SerialsDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.Serials).To(HaveLen(1))
+		Expect(domain.Spec.Devices.Serials[0].Target.Port).To(Equal(pointer.P(uint(0))))
+	})
+
+	It("should use the target type of the serial console", func() {
+		vmi := libvmi.New(libvmi.WithUID(uid), libvmi.WithSerial("uart1"))
+		vmi.Spec.Domain.Devices.SerialConsoleTargetType = v1.SerialTargetTypePCI
+		var domain api.Domain
+
+		Expect(compute.SerialsDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.Serials[0].Target.Type).To(Equal("pci-serial"))
+	})
+})
@@ -0,0 +1,46 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package compute
+
+import (
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+type SGXDomainConfigurator struct{}
+
+func (s SGXDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
+	sgx := vmi.Spec.Domain.Devices.SGX
+	if sgx == nil {
+		return nil
+	}
+
+	// The EPC is allocated by the device plugin in MiB, expose all of it to the guest.
+	domain.Spec.Devices.Memory = &api.MemoryDevice{
+		Model: api.MemoryModelSGXEPC,
+		Target: &api.MemoryTarget{
+			Size: api.Memory{Value: uint64(hardware.GetSGXEPCSizeMiB(sgx)) * 1024, Unit: "KiB"},
+		},
+	}
+
+	return nil
+}
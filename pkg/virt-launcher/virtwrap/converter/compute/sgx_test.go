@@ -0,0 +1,79 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package compute_test
+
+import (
+	"encoding/xml"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/compute"
+)
+
+var _ = Describe("SGX Domain Configurator", func() {
+	It("Should not configure an EPC when SGX is absent", func() {
+		vmi := libvmi.New()
+		var domain api.Domain
+
+		Expect(compute.SGXDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		Expect(domain).To(Equal(api.Domain{}))
+	})
+
+	It("Should configure an sgx-epc memory device rounded up to MiB", func() {
+		vmi := libvmi.New()
+		vmi.Spec.Domain.Devices.SGX = &v1.SGX{EPCSize: resource.MustParse("1500Ki")}
+		var domain api.Domain
+
+		Expect(compute.SGXDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+
+		expectedDomain := api.Domain{
+			Spec: api.DomainSpec{
+				Devices: api.Devices{
+					Memory: &api.MemoryDevice{
+						Model: api.MemoryModelSGXEPC,
+						Target: &api.MemoryTarget{
+							Size: api.Memory{Value: 2048, Unit: "KiB"},
+						},
+					},
+				},
+			},
+		}
+		Expect(domain).To(Equal(expectedDomain))
+	})
+
+	It("Should only render the target size of the sgx-epc memory device", func() {
+		vmi := libvmi.New()
+		vmi.Spec.Domain.Devices.SGX = &v1.SGX{EPCSize: resource.MustParse("64Mi")}
+		var domain api.Domain
+
+		Expect(compute.SGXDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+
+		xmlBytes, err := xml.Marshal(domain.Spec.Devices.Memory)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(xmlBytes)).To(Equal(`<memory model="sgx-epc"><target><size unit="KiB">65536</size></target></memory>`))
+	})
+})
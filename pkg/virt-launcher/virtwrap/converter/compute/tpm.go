@@ -33,15 +33,38 @@ func (t TPMDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain
 		return nil
 	}
 
+	if external := vmi.Spec.Domain.Devices.TPM.External; external != nil {
+		// The version and the state of the vTPM are owned by the external swtpm,
+		//   tpm-tis is used as it supports both TPM 1.2 and 2.0
+		domain.Spec.Devices.TPMs = []api.TPM{{
+			Model: "tpm-tis",
+			Backend: api.TPMBackend{
+				Type: "external",
+				Source: &api.TPMBackendSource{
+					Type: "unix",
+					Mode: "connect",
+					Path: tpm.GetExternalSocketPath(external),
+				},
+			},
+		}}
+		return nil
+	}
+
 	newTPMDevice := api.TPM{
 		Model: "tpm-tis",
 		Backend: api.TPMBackend{
 			Type:    "emulator",
-			Version: "2.0",
+			Version: string(v1.TPMVersion20),
 		},
 	}
 
-	if tpm.HasPersistentDevice(&vmi.Spec) {
+	if vmi.Spec.Domain.Devices.TPM.Version == v1.TPMVersion12 {
+		// TPM 1.2 is only implemented by the tpm-tis model
+		newTPMDevice.Backend.Version = string(v1.TPMVersion12)
+		if tpm.HasPersistentDevice(&vmi.Spec) {
+			newTPMDevice.Backend.PersistentState = "yes"
+		}
+	} else if tpm.HasPersistentDevice(&vmi.Spec) {
 		newTPMDevice.Backend.PersistentState = "yes"
 
 		// tpm-crb is not technically required for persistence, but since there was a desire for both,
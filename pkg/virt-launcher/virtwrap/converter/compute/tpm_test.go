@@ -23,6 +23,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	v1 "kubevirt.io/api/core/v1"
+
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/compute"
@@ -85,4 +87,42 @@ var _ = Describe("TPM Domain Configurator", func() {
 		}
 		Expect(domain).To(Equal(expectedDomain))
 	})
+
+	DescribeTable("Should configure a TPM 1.2 device on the tpm-tis model", func(persistent bool, expectedPersistentState string) {
+		vmi := libvmi.New(libvmi.WithTPM(persistent))
+		vmi.Spec.Domain.Devices.TPM.Version = v1.TPMVersion12
+		var domain api.Domain
+
+		Expect(compute.TPMDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		Expect(domain.Spec.Devices.TPMs).To(Equal([]api.TPM{{
+			Model: "tpm-tis",
+			Backend: api.TPMBackend{
+				Type:            "emulator",
+				Version:         "1.2",
+				PersistentState: expectedPersistentState,
+			},
+		}}))
+	},
+		Entry("when it is not persistent", false, ""),
+		Entry("when it is persistent", true, "yes"),
+	)
+
+	It("Should configure a TPM device connected to an external swtpm", func() {
+		vmi := libvmi.New(libvmi.WithTPM(false))
+		vmi.Spec.Domain.Devices.TPM.External = &v1.TPMExternal{SocketPath: "/var/run/vtpm/vm1.sock"}
+		var domain api.Domain
+
+		Expect(compute.TPMDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		Expect(domain.Spec.Devices.TPMs).To(Equal([]api.TPM{{
+			Model: "tpm-tis",
+			Backend: api.TPMBackend{
+				Type: "external",
+				Source: &api.TPMBackendSource{
+					Type: "unix",
+					Mode: "connect",
+					Path: "/var/run/kubevirt-private/swtpm-external/vm1.sock",
+				},
+			},
+		}}))
+	})
 })
@@ -79,6 +79,22 @@ var _ = Describe("Watchdog Domain Configurator", func() {
 				Action: "poweroff",
 			},
 		),
+		Entry("arm64 with I6300ESB",
+			"arm64",
+			v1.Watchdog{
+				Name: "mywatchdog",
+				WatchdogDevice: v1.WatchdogDevice{
+					I6300ESB: &v1.I6300ESBWatchdog{
+						Action: v1.WatchdogActionReset,
+					},
+				},
+			},
+			api.Watchdog{
+				Alias:  api.NewUserDefinedAlias("mywatchdog"),
+				Model:  "i6300esb",
+				Action: "reset",
+			},
+		),
 		Entry("s390x with Diag288",
 			"s390x",
 			v1.Watchdog{
@@ -107,10 +123,10 @@ var _ = Describe("Watchdog Domain Configurator", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring(expectedErrMsg))
 		},
-		Entry("arm64 not supported",
+		Entry("arm64 with no watchdog type",
 			"arm64",
-			v1.Watchdog{Name: "unsupportedwatchdog"},
-			"not supported on architecture",
+			v1.Watchdog{Name: "emptywatchdog"},
+			"can't be mapped",
 		),
 		Entry("amd64 with no watchdog type",
 			"amd64",
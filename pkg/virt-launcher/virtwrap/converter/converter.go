@@ -26,6 +26,8 @@ package converter
 */
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
@@ -38,6 +40,8 @@ import (
 	"golang.org/x/sys/unix"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
@@ -51,12 +55,15 @@ import (
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/ignition"
+	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
 	"kubevirt.io/kubevirt/pkg/os/disk"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/safepath"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/storage/vhostuserblk"
 	"kubevirt.io/kubevirt/pkg/util"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/arch"
@@ -73,6 +80,12 @@ const (
 	defaultIOThread            = uint(1)
 	bootMenuTimeoutMS          = uint(10000)
 	QEMUSeaBiosDebugPipe       = "/var/run/kubevirt-private/QEMUSeaBiosDebugPipe"
+
+	// vhostUserBlkReconnectTimeout is the delay in seconds before QEMU reconnects to a restarted vhost-user-blk backend
+	vhostUserBlkReconnectTimeout = uint(10)
+
+	// zonedModelNone is the zoned model reported by sysfs for regular block devices
+	zonedModelNone = "none"
 )
 
 type deviceNamer struct {
@@ -103,10 +116,12 @@ type ConverterContext struct {
 	SMBios                          *cmdv1.SMBios
 	SRIOVDevices                    []api.HostDevice
 	GenericHostDevices              []api.HostDevice
+	HostInputDevices                []api.Input
 	GPUHostDevices                  []api.HostDevice
 	EFIConfiguration                *EFIConfiguration
 	MemBalloonStatsPeriod           uint
 	UseVirtioTransitional           bool
+	VirtioTransitionalDevices       []v1.VirtioDeviceClass
 	EphemeraldiskCreator            ephemeraldisk.EphemeralDiskCreatorInterface
 	VolumesDiscardIgnore            []string
 	Topology                        *cmdv1.Topology
@@ -118,20 +133,46 @@ type ConverterContext struct {
 	BochsForEFIGuests               bool
 	SerialConsoleLog                bool
 	DomainAttachmentByInterfaceName map[string]string
+	VDPADevicePathByInterfaceName   map[string]string
+	MachineTypeAliases              map[string]string
+	PrHelperSocketPath              string
+}
+
+// useVirtioTransitional reports whether the virtio devices of the class fall back to legacy virtio 0.9 support
+func (c *ConverterContext) useVirtioTransitional(class v1.VirtioDeviceClass) bool {
+	return c.UseVirtioTransitional || slices.Contains(c.VirtioTransitionalDevices, class)
 }
 
-func assignDiskToSCSIController(disk *api.Disk, unit int) {
+func (c *ConverterContext) virtioModel(class v1.VirtioDeviceClass) string {
+	useVirtioTransitional := c.useVirtioTransitional(class)
+	return virtio.InterpretTransitionalModelType(&useVirtioTransitional, c.Architecture.GetArchitecture())
+}
+
+func assignDiskToSCSIController(disk *api.Disk, controller *uint32, unit int) {
 	// Ensure we assign this disk to the correct scsi controller
 	if disk.Address == nil {
 		disk.Address = &api.Address{}
 	}
 	disk.Address.Type = "drive"
-	// This should be the index of the virtio-scsi controller, which is hard coded to 0
+	// This is the index of the virtio-scsi controller, the first one unless requested otherwise
 	disk.Address.Controller = "0"
+	if controller != nil {
+		disk.Address.Controller = strconv.FormatUint(uint64(*controller), 10)
+	}
 	disk.Address.Bus = "0"
 	disk.Address.Unit = strconv.Itoa(unit)
 }
 
+// assignDiskToNVMeController places the disk as namespace unit+1 of the single emulated NVMe controller
+func assignDiskToNVMeController(disk *api.Disk, unit int) {
+	disk.Address = &api.Address{
+		Type:       "drive",
+		Controller: "0",
+		Bus:        "0",
+		Unit:       strconv.Itoa(unit),
+	}
+}
+
 func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk *api.Disk, prefixMap map[string]deviceNamer, numQueues *uint, volumeStatusMap map[string]v1.VolumeStatus) error {
 	if diskDevice.Disk != nil {
 		var unit int
@@ -139,7 +180,10 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 		disk.Target.Bus = diskDevice.Disk.Bus
 		disk.Target.Device, unit = makeDeviceName(diskDevice.Name, diskDevice.Disk.Bus, prefixMap)
 		if diskDevice.Disk.Bus == "scsi" {
-			assignDiskToSCSIController(disk, unit)
+			assignDiskToSCSIController(disk, diskDevice.Disk.SCSIController, unit)
+		}
+		if diskDevice.Disk.Bus == v1.DiskBusNVMe {
+			assignDiskToNVMeController(disk, unit)
 		}
 		if diskDevice.Disk.PciAddress != "" {
 			if diskDevice.Disk.Bus != v1.DiskBusVirtio {
@@ -151,8 +195,21 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 			}
 			disk.Address = addr
 		}
+		if diskDevice.Disk.CCWAddress != "" {
+			if diskDevice.Disk.Bus != v1.DiskBusVirtio {
+				return fmt.Errorf("setting a ccw address is not allowed for non-virtio bus types, for disk %s", diskDevice.Name)
+			}
+			if !c.Architecture.SupportCCWAddresses() {
+				return fmt.Errorf("setting a ccw address is not supported on %s, for disk %s", c.Architecture.GetArchitecture(), diskDevice.Name)
+			}
+			addr, err := device.NewCCWAddressField(diskDevice.Disk.CCWAddress)
+			if err != nil {
+				return fmt.Errorf("failed to configure disk %s: %v", diskDevice.Name, err)
+			}
+			disk.Address = addr
+		}
 		if diskDevice.Disk.Bus == v1.DiskBusVirtio {
-			disk.Model = virtio.InterpretTransitionalModelType(&c.UseVirtioTransitional, c.Architecture.GetArchitecture())
+			disk.Model = c.virtioModel(v1.VirtioDeviceClassDisk)
 		}
 		disk.ReadOnly = toApiReadOnly(diskDevice.Disk.ReadOnly)
 		disk.Serial = diskDevice.Serial
@@ -173,11 +230,11 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 		disk.Target.Bus = diskDevice.LUN.Bus
 		disk.Target.Device, unit = makeDeviceName(diskDevice.Name, diskDevice.LUN.Bus, prefixMap)
 		if diskDevice.LUN.Bus == "scsi" {
-			assignDiskToSCSIController(disk, unit)
+			assignDiskToSCSIController(disk, diskDevice.LUN.SCSIController, unit)
 		}
 		disk.ReadOnly = toApiReadOnly(diskDevice.LUN.ReadOnly)
 		if diskDevice.LUN.Reservation {
-			setReservation(disk)
+			setReservation(disk, c.PrHelperSocketPath)
 		}
 	} else if diskDevice.CDRom != nil {
 		disk.Device = "cdrom"
@@ -209,6 +266,9 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 	if numQueues != nil && disk.Target.Bus == v1.DiskBusVirtio {
 		disk.Driver.Queues = numQueues
 	}
+	if diskDevice.Disk != nil && diskDevice.Disk.QueueSize != nil && disk.Target.Bus == v1.DiskBusVirtio {
+		disk.Driver.QueueSize = pointer.P(uint(*diskDevice.Disk.QueueSize))
+	}
 	disk.Alias = api.NewUserDefinedAlias(diskDevice.Name)
 	if diskDevice.BootOrder != nil {
 		disk.BootOrder = &api.BootOrder{Order: *diskDevice.BootOrder}
@@ -220,18 +280,37 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 	return nil
 }
 
-func setReservation(disk *api.Disk) {
+func setReservation(disk *api.Disk, socketPath string) {
+	if socketPath == "" {
+		socketPath = reservation.GetPrHelperSocketPath()
+	}
 	disk.Source.Reservations = &api.Reservations{
 		Managed: "no",
 		SourceReservations: &api.SourceReservations{
 			Type: "unix",
-			Path: reservation.GetPrHelperSocketPath(),
+			Path: socketPath,
 			Mode: "client",
 		},
 	}
 }
 
+// setHotplugReservation points a hotplugged LUN requesting a reservation to the pr-helper socket virt-handler
+// exposes in the hotplug disks directory, the pod may have been started without the pr-helper device.
+func setHotplugReservation(disk *api.Disk, socketPath string) {
+	if disk.Source.Reservations == nil || disk.Source.Reservations.SourceReservations == nil {
+		return
+	}
+	disk.Source.Reservations.SourceReservations.Path = GetHotplugPrHelperSocketPath(socketPath)
+}
+
 func setErrorPolicy(diskDevice *v1.Disk, disk *api.Disk) error {
+	// vhost-user-blk backends handle IO errors themselves
+	if disk.Type == "vhostuser" {
+		return nil
+	}
+	if err := setReadErrorPolicy(diskDevice, disk); err != nil {
+		return err
+	}
 	if diskDevice.ErrorPolicy == nil {
 		disk.Driver.ErrorPolicy = v1.DiskErrorPolicyStop
 		return nil
@@ -245,6 +324,66 @@ func setErrorPolicy(diskDevice *v1.Disk, disk *api.Disk) error {
 	return nil
 }
 
+// setReadErrorPolicy overrides the error policy for read errors, libvirt applies
+// the error policy to them otherwise.
+func setReadErrorPolicy(diskDevice *v1.Disk, disk *api.Disk) error {
+	if diskDevice.RerrorPolicy == nil {
+		return nil
+	}
+	switch *diskDevice.RerrorPolicy {
+	case v1.DiskErrorPolicyStop, v1.DiskErrorPolicyIgnore, v1.DiskErrorPolicyReport:
+		disk.Driver.RerrorPolicy = *diskDevice.RerrorPolicy
+	default:
+		return fmt.Errorf("read error policy %s not recognized", *diskDevice.RerrorPolicy)
+	}
+	return nil
+}
+
+// setIOTune translates the IO throttling of the disk, burst limits are only
+// honoured by libvirt together with their base limit, which the admitter ensures.
+func setIOTune(diskDevice *v1.Disk, disk *api.Disk) {
+	// vhost-user-blk backends are not throttled by QEMU
+	if diskDevice.IOTune == nil || disk.Type == "vhostuser" {
+		return
+	}
+	ioTune := diskDevice.IOTune
+	disk.IOTune = &api.DiskIOTune{
+		TotalBytesSec: ioTune.TotalBytesSec,
+		ReadBytesSec:  ioTune.ReadBytesSec,
+		WriteBytesSec: ioTune.WriteBytesSec,
+		TotalIOPSSec:  ioTune.TotalIOPSSec,
+		ReadIOPSSec:   ioTune.ReadIOPSSec,
+		WriteIOPSSec:  ioTune.WriteIOPSSec,
+	}
+	burst := ioTune.Burst
+	if burst == nil {
+		return
+	}
+	disk.IOTune.TotalBytesSecMax = burst.TotalBytesSec
+	disk.IOTune.ReadBytesSecMax = burst.ReadBytesSec
+	disk.IOTune.WriteBytesSecMax = burst.WriteBytesSec
+	disk.IOTune.TotalIOPSSecMax = burst.TotalIOPSSec
+	disk.IOTune.ReadIOPSSecMax = burst.ReadIOPSSec
+	disk.IOTune.WriteIOPSSecMax = burst.WriteIOPSSec
+	if burst.LengthSeconds == 0 {
+		return
+	}
+	disk.IOTune.TotalBytesSecMaxLength = burstLength(burst.TotalBytesSec, burst.LengthSeconds)
+	disk.IOTune.ReadBytesSecMaxLength = burstLength(burst.ReadBytesSec, burst.LengthSeconds)
+	disk.IOTune.WriteBytesSecMaxLength = burstLength(burst.WriteBytesSec, burst.LengthSeconds)
+	disk.IOTune.TotalIOPSSecMaxLength = burstLength(burst.TotalIOPSSec, burst.LengthSeconds)
+	disk.IOTune.ReadIOPSSecMaxLength = burstLength(burst.ReadIOPSSec, burst.LengthSeconds)
+	disk.IOTune.WriteIOPSSecMaxLength = burstLength(burst.WriteIOPSSec, burst.LengthSeconds)
+}
+
+// burstLength returns the length of a burst, libvirt rejects a length for limits without a burst.
+func burstLength(limit, length uint64) uint64 {
+	if limit == 0 {
+		return 0
+	}
+	return length
+}
+
 type DirectIOChecker interface {
 	CheckBlockDevice(path string) (bool, error)
 	CheckFile(path string) (bool, error)
@@ -316,6 +455,46 @@ func Convert_v1_BlockSize_To_api_BlockIO(source *v1.Disk, disk *api.Disk) error
 	return nil
 }
 
+// Convert_v1_Zoned_To_api_Disk prepares a disk backed by a zoned block device so that QEMU
+// exposes the zones to the guest with the virtio-blk zoned model. Zoned devices need direct
+// I/O and the block sizes of the device, which are matched unless set explicitly.
+func Convert_v1_Zoned_To_api_Disk(source *v1.Disk, disk *api.Disk) error {
+	if source.Disk == nil || !source.Disk.Zoned {
+		return nil
+	}
+
+	if disk.Source.Dev == "" {
+		return fmt.Errorf("zoned disk %s must be backed by a block device", source.Name)
+	}
+	safePath, err := safepath.JoinAndResolveWithRelativeRoot("/", disk.Source.Dev)
+	if err != nil {
+		return err
+	}
+	zonedModel, err := getZonedModel(safePath)
+	if err != nil {
+		return err
+	}
+	if zonedModel == zonedModelNone {
+		return fmt.Errorf("block device %s of disk %s is not a zoned device", disk.Source.Dev, source.Name)
+	}
+	log.Log.Infof("Passing the %s zoned model of device %s through to disk %s", zonedModel, disk.Source.Dev, source.Name)
+
+	if disk.Driver == nil {
+		disk.Driver = &api.DiskDriver{}
+	}
+	if disk.Driver.Cache == "" {
+		disk.Driver.Cache = string(v1.CacheNone)
+	}
+	if disk.BlockIO == nil {
+		blockIO, err := getOptimalBlockIOForDevice(disk.Source.Dev)
+		if err != nil {
+			return fmt.Errorf("failed to configure the block sizes of zoned disk %s: %v", source.Name, err)
+		}
+		disk.BlockIO = blockIO
+	}
+	return nil
+}
+
 func getOptimalBlockIO(disk *api.Disk) (*api.BlockIO, error) {
 	if disk.Source.Dev != "" {
 		return getOptimalBlockIOForDevice(disk.Source.Dev)
@@ -364,6 +543,14 @@ func getOptimalBlockIOForDevice(path string) (*api.BlockIO, error) {
 
 	log.Log.Infof("Detected discard granularity of %d for device %v", discardGranularity, path)
 
+	zonedModel, err := getZonedModel(safePath)
+	if err != nil {
+		return nil, err
+	}
+	if zonedModel != zonedModelNone {
+		log.Log.Infof("Detected zoned model %s for device %v", zonedModel, path)
+	}
+
 	blockIO := &api.BlockIO{
 		LogicalBlockSize:   uint(logicalSize),
 		PhysicalBlockSize:  uint(physicalSize),
@@ -385,25 +572,38 @@ func getOptimalBlockIOForDevice(path string) (*api.BlockIO, error) {
 	return blockIO, nil
 }
 
-func getDiscardGranularity(safePath *safepath.Path) (uint64, error) {
+// readBlockQueueAttribute reads an attribute of the sysfs queue directory of the block device.
+// An empty value is returned when the attribute does not exist.
+func readBlockQueueAttribute(safePath *safepath.Path, attribute string) (string, error) {
 	fileInfo, err := safepath.StatAtNoFollow(safePath)
 	if err != nil {
-		return 0, fmt.Errorf("could not stat file %s. Reason: %w", safePath.String(), err)
+		return "", fmt.Errorf("could not stat file %s. Reason: %w", safePath.String(), err)
 	}
 	stat := fileInfo.Sys().(*syscall.Stat_t)
 	rdev := uint64(stat.Rdev) //nolint:unconvert // Rdev is uint32 on e.g. MIPS.
 	major := unix.Major(rdev)
 	minor := unix.Minor(rdev)
 
-	raw, err := os.ReadFile(fmt.Sprintf("/sys/dev/block/%d:%d/queue/discard_granularity", major, minor))
+	raw, err := os.ReadFile(fmt.Sprintf("/sys/dev/block/%d:%d/queue/%s", major, minor, attribute))
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
-			// On the off chance that we can't stat the discard granularity, set it to disabled.
-			return 0, nil
+			return "", nil
 		}
-		return 0, fmt.Errorf("cannot read discard granularity for device %s: %w", safePath.String(), err)
+		return "", fmt.Errorf("cannot read %s for device %s: %w", attribute, safePath.String(), err)
 	}
-	discardGranularity, err := strconv.ParseUint(strings.TrimSpace(string(raw)), 10, 0)
+	return strings.TrimSpace(string(raw)), nil
+}
+
+func getDiscardGranularity(safePath *safepath.Path) (uint64, error) {
+	raw, err := readBlockQueueAttribute(safePath, "discard_granularity")
+	if err != nil {
+		return 0, err
+	}
+	if raw == "" {
+		// On the off chance that we can't stat the discard granularity, set it to disabled.
+		return 0, nil
+	}
+	discardGranularity, err := strconv.ParseUint(raw, 10, 0)
 	if err != nil {
 		return 0, err
 	}
@@ -411,6 +611,18 @@ func getDiscardGranularity(safePath *safepath.Path) (uint64, error) {
 	return discardGranularity, err
 }
 
+// getZonedModel returns the zoned model of the block device, one of none, host-aware or host-managed.
+func getZonedModel(safePath *safepath.Path) (string, error) {
+	zonedModel, err := readBlockQueueAttribute(safePath, "zoned")
+	if err != nil {
+		return "", err
+	}
+	if zonedModel == "" {
+		return zonedModelNone, nil
+	}
+	return zonedModel, nil
+}
+
 // getOptimalBlockIOForFile determines the optimal sizes based on the filesystem settings
 // the VM's disk image is residing on. A filesystem does not differentiate between sizes.
 // The physical size will always match the logical size. The rest is up to the filesystem.
@@ -442,6 +654,9 @@ func SetDriverCacheMode(disk *api.Disk, directIOChecker DirectIOChecker) error {
 	// handle empty cdrom
 	case disk.Device == "cdrom":
 		return nil
+	// the vhost-user-blk backend handles the cache
+	case disk.Type == "vhostuser":
+		return nil
 	default:
 		return fmt.Errorf("unable to set a driver cache mode, disk is neither a block device nor a file")
 	}
@@ -555,18 +770,15 @@ func makeDeviceName(diskName string, bus v1.DiskBus, prefixMap map[string]device
 	}
 	deviceNamer := prefixMap[prefix]
 	if name, ok := deviceNamer.getExistingVolumeValue(diskName); ok {
-		for i := 0; i < 26*26*26; i++ {
-			calculatedName := FormatDeviceName(prefix, i)
-			if calculatedName == name {
-				return name, i
-			}
+		if index, ok := parseDeviceNameIndex(prefix, name); ok {
+			return name, index
 		}
 		log.Log.Error("Unable to determine index of device")
 		return name, 0
 	}
 	// Name not found yet, generate next new one.
 	for i := 0; i < 26*26*26; i++ {
-		name := FormatDeviceName(prefix, i)
+		name := formatDeviceName(prefix, i)
 		if _, ok := deviceNamer.getExistingTargetValue(name); !ok {
 			deviceNamer.existingNameMap[diskName] = name
 			deviceNamer.usedDeviceMap[name] = diskName
@@ -576,16 +788,60 @@ func makeDeviceName(diskName string, bus v1.DiskBus, prefixMap map[string]device
 	return "", 0
 }
 
+const deviceNameBase = 'z' - 'a' + 1
+
+const (
+	// nvmeDevicePrefix names the namespaces of the first NVMe controller, e.g. nvme0n1
+	nvmeDevicePrefix = "nvme0n"
+	// nvmeControllerSerial is required by QEMU for the emulated NVMe controller
+	nvmeControllerSerial = "kubevirt-nvme0"
+)
+
 // port of http://elixir.free-electrons.com/linux/v4.15/source/drivers/scsi/sd.c#L3211
 func FormatDeviceName(prefix string, index int) string {
-	base := int('z' - 'a' + 1)
-	name := ""
-
+	// Large enough for any non-negative int, the name is built from its end
+	var name [16]byte
+	i := len(name)
 	for index >= 0 {
-		name = string(rune('a'+(index%base))) + name
-		index = (index / base) - 1
+		i--
+		name[i] = byte('a' + index%deviceNameBase)
+		index = index/deviceNameBase - 1
 	}
-	return prefix + name
+	return prefix + string(name[i:])
+}
+
+// formatDeviceName names NVMe namespaces after their 1-based namespace ID and letters everything else
+func formatDeviceName(prefix string, index int) string {
+	if prefix == nvmeDevicePrefix {
+		return prefix + strconv.Itoa(index+1)
+	}
+	return FormatDeviceName(prefix, index)
+}
+
+// parseDeviceNameIndex is the inverse of formatDeviceName, limited to the 26*26*26 names the converter hands out.
+func parseDeviceNameIndex(prefix, name string) (int, bool) {
+	suffix, found := strings.CutPrefix(name, prefix)
+	if !found || suffix == "" {
+		return 0, false
+	}
+	if prefix == nvmeDevicePrefix {
+		nsid, err := strconv.Atoi(suffix)
+		if err != nil || nsid < 1 || nsid > 26*26*26 {
+			return 0, false
+		}
+		return nsid - 1, true
+	}
+	if len(suffix) > 3 {
+		return 0, false
+	}
+	index := 0
+	for _, c := range []byte(suffix) {
+		if c < 'a' || c > 'z' {
+			return 0, false
+		}
+		index = index*deviceNameBase + int(c-'a') + 1
+	}
+	return index - 1, true
 }
 
 func toApiReadOnly(src bool) *api.ReadOnly {
@@ -642,6 +898,12 @@ func Convert_v1_Volume_To_api_Disk(source *v1.Volume, disk *api.Disk, c *Convert
 	if source.DownwardMetrics != nil {
 		return Convert_v1_DownwardMetricSource_To_api_Disk(disk, c)
 	}
+	if source.VhostUserBlk != nil {
+		return Convert_v1_VhostUserBlkSource_To_api_Disk(source.Name, disk, c)
+	}
+	if source.HostBlockDevice != nil {
+		return Convert_v1_BlockVolumeSource_To_api_Disk(source.Name, disk, c.VolumesDiscardIgnore)
+	}
 
 	return fmt.Errorf("disk %s references an unsupported source", disk.Alias.GetName())
 }
@@ -664,6 +926,25 @@ func Convert_v1_Hotplug_Volume_To_api_Disk(source *v1.Volume, disk *api.Disk, c
 	return fmt.Errorf("hotplug disk %s references an unsupported source", disk.Alias.GetName())
 }
 
+// hotplugDiskSerialLength is the longest serial virtio-blk exposes to the guest
+const hotplugDiskSerialLength = 20
+
+// hotplugDiskSerial returns the serial a hotplugged disk without a user provided serial gets.
+// It only depends on the VMI UID and the volume name, so that it survives migrations and
+// re-attachments and the guest can locate the disk by it.
+func hotplugDiskSerial(vmiUID types.UID, volumeName string) string {
+	sum := sha256.Sum256([]byte(string(vmiUID) + "/" + volumeName))
+	return hex.EncodeToString(sum[:])[:hotplugDiskSerialLength]
+}
+
+func setHotplugDiskSerial(vmiUID types.UID, volumeName string, disk *api.Disk) {
+	// LUNs pass the serial of the backing device through
+	if disk.Serial != "" || disk.Device == "lun" {
+		return
+	}
+	disk.Serial = hotplugDiskSerial(vmiUID, volumeName)
+}
+
 // Convert_v1_Missing_Volume_To_api_Disk sets defaults when no volume for disk (cdrom, floppy, etc) is provided
 func Convert_v1_Missing_Volume_To_api_Disk(disk *api.Disk) error {
 	disk.Type = "block"
@@ -672,6 +953,26 @@ func Convert_v1_Missing_Volume_To_api_Disk(disk *api.Disk) error {
 	return nil
 }
 
+// Convert_v1_Volume_To_api_CDRomMedium replaces the medium of a CD-ROM disk with the given volume,
+// a nil volume ejects the medium and leaves the tray empty
+func Convert_v1_Volume_To_api_CDRomMedium(volume *v1.Volume, isBlock bool, disk *api.Disk) error {
+	disk.Source = api.DiskSource{}
+	disk.BackingStore = nil
+	if disk.Driver == nil {
+		disk.Driver = &api.DiskDriver{Name: "qemu"}
+	}
+	if volume == nil {
+		return Convert_v1_Missing_Volume_To_api_Disk(disk)
+	}
+	if volume.PersistentVolumeClaim == nil && volume.DataVolume == nil {
+		return fmt.Errorf("volume %s can not be inserted into a CD-ROM, only persistentVolumeClaim and dataVolume volumes can", volume.Name)
+	}
+	if isBlock {
+		return Convert_v1_BlockVolumeSource_To_api_Disk(volume.Name, disk, nil)
+	}
+	return Convert_v1_FilesystemVolumeSource_To_api_Disk(volume.Name, disk, nil)
+}
+
 func Convert_v1_Config_To_api_Disk(volumeName string, disk *api.Disk, configType config.Type) error {
 	disk.Type = "file"
 	setDiskDriver(disk, "raw", false)
@@ -700,6 +1001,15 @@ func GetHotplugFilesystemVolumePath(volumeName string) string {
 	return filepath.Join(string(filepath.Separator), "var", "run", "kubevirt", "hotplug-disks", fmt.Sprintf("%s.img", volumeName))
 }
 
+// GetHotplugPrHelperSocketPath returns the path of the pr-helper socket used by hotplugged LUNs
+func GetHotplugPrHelperSocketPath(socketPath string) string {
+	socket := reservation.GetPrHelperSocket()
+	if socketPath != "" {
+		socket = filepath.Base(socketPath)
+	}
+	return filepath.Join(string(filepath.Separator), "var", "run", "kubevirt", "hotplug-disks", reservation.GetHotplugPrHelperDir(), socket)
+}
+
 func GetBlockDeviceVolumePath(volumeName string) string {
 	return filepath.Join(string(filepath.Separator), "dev", volumeName)
 }
@@ -771,6 +1081,7 @@ func Convert_v1_PersistentVolumeClaim_To_api_Disk(name string, disk *api.Disk, c
 
 // Convert_v1_Hotplug_PersistentVolumeClaim_To_api_Disk converts a Hotplugged PVC to an api disk
 func Convert_v1_Hotplug_PersistentVolumeClaim_To_api_Disk(name string, disk *api.Disk, c *ConverterContext) error {
+	setHotplugReservation(disk, c.PrHelperSocketPath)
 	if c.IsBlockPVC[name] {
 		return Convert_v1_Hotplug_BlockVolumeSource_To_api_Disk(name, disk, c.VolumesDiscardIgnore)
 	}
@@ -783,6 +1094,7 @@ func Convert_v1_DataVolume_To_api_Disk(name string, disk *api.Disk, c *Converter
 
 // Convert_v1_Hotplug_DataVolume_To_api_Disk converts a Hotplugged DataVolume to an api disk
 func Convert_v1_Hotplug_DataVolume_To_api_Disk(name string, disk *api.Disk, c *ConverterContext) error {
+	setHotplugReservation(disk, c.PrHelperSocketPath)
 	if c.IsBlockDV[name] {
 		return Convert_v1_Hotplug_BlockVolumeSource_To_api_Disk(name, disk, c.VolumesDiscardIgnore)
 	}
@@ -885,13 +1197,37 @@ func Convert_v1_DownwardMetricSource_To_api_Disk(disk *api.Disk, c *ConverterCon
 		Name: "qemu",
 	}
 	// This disk always needs `virtio`. Validation ensures that bus is unset or is already virtio
-	disk.Model = virtio.InterpretTransitionalModelType(&c.UseVirtioTransitional, c.Architecture.GetArchitecture())
+	disk.Model = c.virtioModel(v1.VirtioDeviceClassDisk)
 	disk.Source = api.DiskSource{
 		File: config.DownwardMetricDisk,
 	}
 	return nil
 }
 
+// Convert_v1_VhostUserBlkSource_To_api_Disk connects the disk to the unix socket of the vhost-user-blk backend,
+// as recorded in the volume status
+func Convert_v1_VhostUserBlkSource_To_api_Disk(volumeName string, disk *api.Disk, c *ConverterContext) error {
+	volumeStatus, ok := c.PermanentVolumes[volumeName]
+	if !ok || volumeStatus.VhostUserBlkVolume == nil {
+		return fmt.Errorf("the socket of vhost-user-blk volume %s is not known yet", volumeName)
+	}
+
+	disk.Type = "vhostuser"
+	disk.Snapshot = "no"
+	disk.Source.Type = "unix"
+	disk.Source.Path = volumeStatus.VhostUserBlkVolume.SocketPath
+	disk.Source.Reconnect = &api.Reconnect{
+		Enabled: "yes",
+		Timeout: pointer.P(vhostUserBlkReconnectTimeout),
+	}
+	// Caching, IO and discard are up to the backend, libvirt rejects them on vhost-user disks
+	disk.Driver.Type = "raw"
+	disk.Driver.Cache = ""
+	disk.Driver.IO = ""
+	disk.Driver.Discard = ""
+	return nil
+}
+
 func Convert_v1_EmptyDiskSource_To_api_Disk(volumeName string, _ *v1.EmptyDiskSource, disk *api.Disk) error {
 	if disk.Type == "lun" {
 		return fmt.Errorf(deviceTypeNotCompatibleFmt, disk.Alias.GetName())
@@ -1116,6 +1452,17 @@ func setupDomainMemory(vmi *v1.VirtualMachineInstance, domain *api.Domain) error
 		Value: maxMemory.Value,
 	}
 
+	if memory.IsVirtioMemHotplug(vmi.Spec.Domain.Memory) {
+		memoryDevice, err := memory.BuildBootMemoryDevice(vmi)
+		if err != nil {
+			return err
+		}
+		domain.Spec.Devices.Memory = memoryDevice
+		// The domain memory includes the memory of the virtio-mem device
+		domain.Spec.Memory = api.Memory{Unit: maxMemory.Unit, Value: maxMemory.Value}
+		return nil
+	}
+
 	currentMemory, err := vcpu.QuantityToByte(*vmi.Spec.Domain.Memory.Guest)
 	if err != nil {
 		return err
@@ -1126,6 +1473,40 @@ func setupDomainMemory(vmi *v1.VirtualMachineInstance, domain *api.Domain) error
 	return nil
 }
 
+// setupHugepagesNUMAPolicy binds the hugepages backing every guest NUMA cell to the host NUMA nodes of the policy.
+// The nodes have to be ones the CPUs of the pod run on, which the scheduler aligns with the hugepages of the pod.
+func setupHugepagesNUMAPolicy(hugepages *v1.Hugepages, domain *api.Domain, c *ConverterContext) error {
+	policy := hugepages.NUMAPolicy
+	if err := vcpu.CheckNUMANodeSetOfPod(policy.NodeSet, c.Topology, c.CPUSet); err != nil {
+		return fmt.Errorf("invalid hugepages NUMA policy: %v", err)
+	}
+	pageSize, err := resource.ParseQuantity(hugepages.PageSize)
+	if err != nil {
+		return fmt.Errorf("could not parse hugepage value %v: %v", hugepages.PageSize, err)
+	}
+
+	var guestCells []string
+	if domain.Spec.CPU.NUMA != nil {
+		for _, cell := range domain.Spec.CPU.NUMA.Cells {
+			guestCells = append(guestCells, cell.ID)
+		}
+	}
+
+	domain.Spec.MemoryBacking.HugePages.HugePage = []api.HugePage{{
+		Size:    strconv.FormatInt(pageSize.Value(), 10),
+		Unit:    "b",
+		NodeSet: strings.Join(guestCells, ","),
+	}}
+	if domain.Spec.NUMATune == nil {
+		domain.Spec.NUMATune = &api.NUMATune{}
+	}
+	domain.Spec.NUMATune.Memory = api.NumaTuneMemory{
+		Mode:    string(policy.Mode),
+		NodeSet: policy.NodeSet,
+	}
+	return nil
+}
+
 func Convert_v1_Firmware_To_related_apis(vmi *v1.VirtualMachineInstance, domain *api.Domain, c *ConverterContext) error {
 	firmware := vmi.Spec.Domain.Firmware
 	if firmware == nil {
@@ -1174,6 +1555,18 @@ func Convert_v1_Firmware_To_related_apis(vmi *v1.VirtualMachineInstance, domain
 		})
 	}
 
+	// The SKU may also be set cluster-wide, in which case it is overridden when the SMBIOS entries are merged
+	if len(firmware.SKU) > 0 {
+		domain.Spec.SysInfo.System = append(domain.Spec.SysInfo.System, api.Entry{
+			Name:  "sku",
+			Value: firmware.SKU,
+		})
+	}
+
+	if len(firmware.OEMStrings) > 0 {
+		domain.Spec.SysInfo.OEMStrings = &api.OEMStrings{Entries: firmware.OEMStrings}
+	}
+
 	if util.HasKernelBootContainerImage(vmi) {
 		kb := firmware.KernelBoot
 
@@ -1196,12 +1589,19 @@ func Convert_v1_Firmware_To_related_apis(vmi *v1.VirtualMachineInstance, domain
 	if firmware.KernelBoot != nil {
 		log.Log.Object(vmi).Infof("setting custom kernel arguments: %s", firmware.KernelBoot.KernelArgs)
 		domain.Spec.OS.KernelArgs = firmware.KernelBoot.KernelArgs
+		if rootFilesystem := firmware.KernelBoot.RootFilesystem; rootFilesystem != "" {
+			domain.Spec.OS.KernelArgs = withVirtiofsRoot(domain.Spec.OS.KernelArgs, rootFilesystem)
+		}
 	}
 
 	if err := Convert_v1_Firmware_ACPI_To_related_apis(firmware, domain, vmi.Spec.Volumes); err != nil {
 		return err
 	}
 
+	if err := Convert_v1_Firmware_FWCfg_To_related_apis(firmware, domain, vmi.Spec.Volumes); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1261,6 +1661,48 @@ func createACPITable(source, volumeName string, volumes []v1.Volume) (*api.ACPIT
 	return nil, fmt.Errorf("Firmware's volume for %s was not found", source)
 }
 
+// Convert_v1_Firmware_FWCfg_To_related_apis passes the fw_cfg entries to QEMU,
+// the same way the ignition config is passed.
+func Convert_v1_Firmware_FWCfg_To_related_apis(firmware *v1.Firmware, domain *api.Domain, volumes []v1.Volume) error {
+	for _, entry := range firmware.FWCfg {
+		sourcePath, err := fwCfgSourcePath(entry, volumes)
+		if err != nil {
+			return err
+		}
+		initializeQEMUCmdAndQEMUArg(domain)
+		domain.Spec.QEMUCmd.QEMUArg = append(domain.Spec.QEMUCmd.QEMUArg,
+			api.Arg{Value: "-fw_cfg"},
+			api.Arg{Value: fmt.Sprintf("name=%s,file=%s", escapeQEMUOptionValue(entry.Name), escapeQEMUOptionValue(sourcePath))},
+		)
+	}
+	return nil
+}
+
+// escapeQEMUOptionValue escapes the commas of a value passed in a QEMU option list,
+// so that it cannot add properties to the option.
+func escapeQEMUOptionValue(value string) string {
+	return strings.ReplaceAll(value, ",", ",,")
+}
+
+func fwCfgSourcePath(entry v1.FWCfgEntry, volumes []v1.Volume) (string, error) {
+	for _, volume := range volumes {
+		if volume.Name != entry.VolumeNameRef {
+			continue
+		}
+
+		switch {
+		case volume.ConfigMap != nil:
+			return filepath.Join(config.GetConfigMapSourcePath(volume.Name), entry.Key), nil
+		case volume.Secret != nil:
+			return filepath.Join(config.GetSecretSourcePath(volume.Name), entry.Key), nil
+		default:
+			return "", fmt.Errorf("volume type of fw_cfg entry %s is unsupported", entry.Name)
+		}
+	}
+
+	return "", fmt.Errorf("volume of fw_cfg entry %s was not found", entry.Name)
+}
+
 func hasIOThreads(vmi *v1.VirtualMachineInstance) bool {
 	if vmi.Spec.Domain.IOThreadsPolicy != nil {
 		return true
@@ -1345,16 +1787,16 @@ func setIOThreads(vmi *v1.VirtualMachineInstance, domain *api.Domain, vcpus uint
 			iothreads.IOThread = append(iothreads.IOThread, api.DiskIOThread{Id: uint32(id)})
 		}
 		for i, disk := range domain.Spec.Devices.Disks {
-			// Only disks with virtio bus support IOThreads
-			if disk.Target.Bus == v1.DiskBusVirtio {
+			// Only disks with virtio bus support IOThreads, vhost-user-blk backends run their own
+			if disk.Target.Bus == v1.DiskBusVirtio && disk.Type != "vhostuser" {
 				domain.Spec.Devices.Disks[i].Driver.IOThreads = iothreads
 			}
 		}
 	} else {
 		currentDedicatedThread := uint(autoThreads + 1)
 		for i, disk := range domain.Spec.Devices.Disks {
-			// Only disks with virtio bus support IOThreads
-			if disk.Target.Bus == v1.DiskBusVirtio {
+			// Only disks with virtio bus support IOThreads, vhost-user-blk backends run their own
+			if disk.Target.Bus == v1.DiskBusVirtio && disk.Type != "vhostuser" {
 				if vmi.Spec.Domain.Devices.Disks[i].DedicatedIOThread != nil && *vmi.Spec.Domain.Devices.Disks[i].DedicatedIOThread {
 					domain.Spec.Devices.Disks[i].Driver.IOThread = pointer.P(currentDedicatedThread)
 					currentDedicatedThread += 1
@@ -1388,7 +1830,10 @@ func setIOThreads(vmi *v1.VirtualMachineInstance, domain *api.Domain, vcpus uint
 				domain.Spec.Devices.Controllers[i].Driver = &api.ControllerDriver{}
 			}
 			domain.Spec.Devices.Controllers[i].Driver.IOThread = pointer.P(currentAutoThread)
-			domain.Spec.Devices.Controllers[i].Driver.Queues = pointer.P(vcpus)
+			// the queues requested explicitly are kept
+			if domain.Spec.Devices.Controllers[i].Driver.Queues == nil {
+				domain.Spec.Devices.Controllers[i].Driver.Queues = pointer.P(vcpus)
+			}
 		}
 	}
 }
@@ -1406,31 +1851,33 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		metadata.DomainConfigurator{},
 		network.NewDomainConfigurator(
 			network.WithDomainAttachmentByInterfaceName(c.DomainAttachmentByInterfaceName),
+			network.WithVDPADevicePathByInterfaceName(c.VDPADevicePathByInterfaceName),
 			network.WithUseLaunchSecuritySEV(c.UseLaunchSecuritySEV),
 			network.WithUseLaunchSecurityPV(c.UseLaunchSecurityPV),
 		),
 		compute.TPMDomainConfigurator{},
 		compute.VSOCKDomainConfigurator{},
+		compute.SGXDomainConfigurator{},
 		compute.NewHypervisorDomainConfigurator(c.AllowEmulation, c.KvmAvailable),
 		compute.NewLaunchSecurityDomainConfigurator(architecture),
 		compute.ChannelsDomainConfigurator{},
-		compute.ClockDomainConfigurator{},
+		compute.NewClockDomainConfigurator(architecture),
 		compute.NewRNGDomainConfigurator(
 			compute.RNGWithArchitecture(architecture),
-			compute.RNGWithUseVirtioTransitional(c.UseVirtioTransitional),
+			compute.RNGWithUseVirtioTransitional(c.useVirtioTransitional(v1.VirtioDeviceClassRng)),
 			compute.RNGWithUseLaunchSecuritySEV(c.UseLaunchSecuritySEV),
 			compute.RNGWithUseLaunchSecurityPV(c.UseLaunchSecurityPV),
 		),
-		compute.NewInputDeviceDomainConfigurator(architecture),
+		compute.NewInputDeviceDomainConfigurator(architecture, c.HostInputDevices),
 		compute.NewBalloonDomainConfigurator(
 			compute.BalloonWithArchitecture(architecture),
-			compute.BalloonWithUseVirtioTransitional(c.UseVirtioTransitional),
+			compute.BalloonWithUseVirtioTransitional(c.useVirtioTransitional(v1.VirtioDeviceClassBalloon)),
 			compute.BalloonWithUseLaunchSecuritySEV(c.UseLaunchSecuritySEV),
 			compute.BalloonWithUseLaunchSecurityPV(c.UseLaunchSecurityPV),
 			compute.BalloonWithFreePageReporting(c.FreePageReporting),
 			compute.BalloonWithMemBalloonStatsPeriod(c.MemBalloonStatsPeriod),
 		),
-		compute.NewGraphicsDomainConfigurator(architecture, c.BochsForEFIGuests),
+		compute.NewGraphicsDomainConfigurator(architecture, c.BochsForEFIGuests, c.GPUHostDevices),
 		compute.SoundDomainConfigurator{},
 		compute.NewHostDeviceDomainConfigurator(
 			c.GenericHostDevices,
@@ -1438,8 +1885,9 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			c.SRIOVDevices,
 		),
 		compute.NewWatchdogDomainConfigurator(architecture),
-		compute.NewConsoleDomainConfigurator(c.SerialConsoleLog),
-		compute.PanicDevicesDomainConfigurator{},
+		compute.NewConsoleDomainConfigurator(c.SerialConsoleLog, c.Architecture.SupportedSerialTargetTypes()),
+		compute.SerialsDomainConfigurator{},
+		compute.NewPanicDevicesDomainConfigurator(c.Architecture.DefaultPanicDeviceModel()),
 	)
 	if err := builder.Build(vmi, domain); err != nil {
 		return err
@@ -1489,15 +1937,18 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 				Name:  "product",
 				Value: c.SMBios.Product,
 			},
-			api.Entry{
+		)
+		// A SKU set on the VMI takes precedence over the cluster-wide one
+		if !slices.ContainsFunc(domain.Spec.SysInfo.System, func(entry api.Entry) bool { return entry.Name == "sku" }) {
+			domain.Spec.SysInfo.System = append(domain.Spec.SysInfo.System, api.Entry{
 				Name:  "sku",
 				Value: c.SMBios.Sku,
-			},
-			api.Entry{
-				Name:  "version",
-				Value: c.SMBios.Version,
-			},
-		)
+			})
+		}
+		domain.Spec.SysInfo.System = append(domain.Spec.SysInfo.System, api.Entry{
+			Name:  "version",
+			Value: c.SMBios.Version,
+		})
 	}
 
 	// Take SMBios values from the VirtualMachineOptions
@@ -1536,6 +1987,12 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		return err
 	}
 
+	if vcpu.IsNumaManual(vmi) {
+		if domain.Spec.CPU.NUMA, err = vcpu.ManualNUMATopology(vmi); err != nil {
+			return err
+		}
+	}
+
 	var isMemfdRequired = false
 	if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Hugepages != nil {
 		domain.Spec.MemoryBacking = &api.MemoryBacking{
@@ -1545,8 +2002,8 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			isMemfdRequired = true
 		}
 	}
-	// virtiofs require shared access
-	if util.IsVMIVirtiofsEnabled(vmi) {
+	// virtiofs and vhost-user-blk require shared access
+	if util.IsVMIVirtiofsEnabled(vmi) || vhostuserblk.HasVhostUserBlkVolume(vmi.Spec.Volumes) {
 		if domain.Spec.MemoryBacking == nil {
 			domain.Spec.MemoryBacking = &api.MemoryBacking{}
 		}
@@ -1576,6 +2033,12 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		}
 	}
 
+	if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Hugepages != nil && vmi.Spec.Domain.Memory.Hugepages.NUMAPolicy != nil {
+		if err := setupHugepagesNUMAPolicy(vmi.Spec.Domain.Memory.Hugepages, domain, c); err != nil {
+			return err
+		}
+	}
+
 	volumeIndices := map[string]int{}
 	volumes := map[string]*v1.Volume{}
 	for i, volume := range vmi.Spec.Volumes {
@@ -1617,11 +2080,19 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		}
 
 		hpStatus, hpOk := c.HotplugVolumes[disk.Name]
+		hotplugReady := hpOk && (hpStatus.Phase == v1.HotplugVolumeMounted || hpStatus.Phase == v1.VolumeReady)
+		// A CD-ROM whose media is not plugged yet is defined as an empty drive, the media is inserted
+		// once ready. This keeps the drive, and its boot order, in the domain.
+		if hpOk && !hotplugReady && disk.CDRom != nil {
+			emptyCDRom = true
+		}
+
 		switch {
 		case emptyCDRom:
 			err = Convert_v1_Missing_Volume_To_api_Disk(&newDisk)
 		case hpOk:
 			err = Convert_v1_Hotplug_Volume_To_api_Disk(volume, &newDisk, c)
+			setHotplugDiskSerial(vmi.UID, disk.Name, &newDisk)
 		default:
 			err = Convert_v1_Volume_To_api_Disk(volume, &newDisk, c, volumeIndices[disk.Name])
 		}
@@ -1634,10 +2105,15 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			return err
 		}
 
+		if err := Convert_v1_Zoned_To_api_Disk(&disk, &newDisk); err != nil {
+			return err
+		}
+
+		setIOTune(&disk, &newDisk)
+
 		_, isPermVolume := c.PermanentVolumes[disk.Name]
 		// if len(c.PermanentVolumes) == 0, it means the vmi is not ready yet, add all disks
 		permReady := isPermVolume || len(c.PermanentVolumes) == 0
-		hotplugReady := hpOk && (hpStatus.Phase == v1.HotplugVolumeMounted || hpStatus.Phase == v1.VolumeReady)
 
 		if permReady || hotplugReady || emptyCDRom {
 			domain.Spec.Devices.Disks = append(domain.Spec.Devices.Disks, newDisk)
@@ -1666,8 +2142,30 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 	domain.Spec.Devices.Controllers = append(domain.Spec.Devices.Controllers, usbController)
 
 	if needsSCSIController(vmi) {
-		scsiController := c.Architecture.ScsiController(virtio.InterpretTransitionalModelType(&c.UseVirtioTransitional, c.Architecture.GetArchitecture()), controllerDriver)
-		domain.Spec.Devices.Controllers = append(domain.Spec.Devices.Controllers, scsiController)
+		scsiModel := c.virtioModel(v1.VirtioDeviceClassController)
+		for i := uint32(0); i < scsiControllerCount(vmi); i++ {
+			scsiController := c.Architecture.ScsiController(scsiModel, controllerDriver)
+			scsiController.Index = strconv.FormatUint(uint64(i), 10)
+			if queues := vmi.Spec.Domain.Devices.SCSIControllerQueues; queues != nil {
+				driver := &api.ControllerDriver{}
+				if scsiController.Driver != nil {
+					driver = scsiController.Driver.DeepCopy()
+				}
+				driver.Queues = pointer.P(uint(*queues))
+				scsiController.Driver = driver
+			}
+			domain.Spec.Devices.Controllers = append(domain.Spec.Devices.Controllers, scsiController)
+		}
+	}
+
+	if needsNVMeController(vmi) {
+		domain.Spec.Devices.Controllers = append(domain.Spec.Devices.Controllers,
+			api.Controller{
+				Type:   "nvme",
+				Index:  "0",
+				Serial: nvmeControllerSerial,
+			},
+		)
 	}
 
 	if c.Architecture.SupportPCIHole64Disabling() && shouldDisablePCIHole64(vmi) {
@@ -1697,8 +2195,19 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		}
 	}
 
+	// Make sure the i8042 controller serving ps2 input devices is emulated
+	if compute.HasPS2InputDevice(vmi) {
+		if domain.Spec.Features == nil {
+			domain.Spec.Features = &api.Features{}
+		}
+		domain.Spec.Features.PS2 = &api.FeatureState{State: "on"}
+	}
+
 	if machine := vmi.Spec.Domain.Machine; machine != nil {
 		domain.Spec.OS.Type.Machine = machine.Type
+		if resolved, isAlias := c.MachineTypeAliases[machine.Type]; isAlias {
+			domain.Spec.OS.Type.Machine = resolved
+		}
 	}
 
 	if vmi.Spec.Domain.CPU != nil {
@@ -1737,6 +2246,13 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 						once the issue is resolved we can remove mpx disablement
 		*/
 
+		if cache := vmi.Spec.Domain.CPU.Cache; cache != nil {
+			domain.Spec.CPU.Cache = &api.CPUCache{
+				Mode:  string(cache.Mode),
+				Level: cache.Level,
+			}
+		}
+
 		_, exists := existingFeatures["mpx"]
 		if c.Architecture.RequiresMPXCPUValidation() && !exists && vmi.Spec.Domain.CPU.Model != v1.CPUModeHostModel && vmi.Spec.Domain.CPU.Model != v1.CPUModeHostPassthrough {
 			domain.Spec.CPU.Features = append(domain.Spec.CPU.Features, api.CPUFeature{
@@ -1763,7 +2279,7 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		domain.Spec.Devices.Controllers = append(domain.Spec.Devices.Controllers, api.Controller{
 			Type:   "virtio-serial",
 			Index:  "0",
-			Model:  virtio.InterpretTransitionalModelType(&c.UseVirtioTransitional, c.Architecture.GetArchitecture()),
+			Model:  c.virtioModel(v1.VirtioDeviceClassController),
 			Driver: controllerDriver,
 		})
 	}
@@ -1777,12 +2293,25 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		domain.Spec.QEMUCmd.QEMUArg = append(domain.Spec.QEMUCmd.QEMUArg, api.Arg{Value: fmt.Sprintf("name=opt/com.coreos/config,file=%s", ignitionpath)})
 	}
 
+	if c.Architecture.SupportCCWAddresses() {
+		if err := validateCCWAddresses(&domain.Spec); err != nil {
+			return err
+		}
+	}
+
 	if val := vmi.Annotations[v1.PlacePCIDevicesOnRootComplex]; val == "true" {
 		if err := PlacePCIDevicesOnRootComplex(&domain.Spec); err != nil {
 			return err
 		}
 	}
 
+	if virtconfig.IsMicroVM(&vmi.Spec) {
+		if err := validateMicroVMArchitecture(c); err != nil {
+			return err
+		}
+		convertForMicroVM(&domain.Spec)
+	}
+
 	if c.Architecture.ShouldVerboseLogsBeEnabled() {
 		virtLauncherLogVerbosity, err := strconv.Atoi(os.Getenv(services.ENV_VAR_VIRT_LAUNCHER_LOG_VERBOSITY))
 		if err == nil && virtLauncherLogVerbosity > services.EXT_LOG_VERBOSITY_THRESHOLD {
@@ -1807,6 +2336,10 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 
 	setIOThreads(vmi, domain, vcpus)
 
+	if val := vmi.Annotations[v1.PublishFeaturesOEMString]; val == "true" {
+		publishFeaturesOEMString(&domain.Spec)
+	}
+
 	return nil
 }
 
@@ -1841,6 +2374,22 @@ func needsSCSIController(vmi *v1.VirtualMachineInstance) bool {
 	return !vmi.Spec.Domain.Devices.DisableHotplug
 }
 
+func needsNVMeController(vmi *v1.VirtualMachineInstance) bool {
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		if getBusFromDisk(disk) == v1.DiskBusNVMe {
+			return true
+		}
+	}
+	return false
+}
+
+func scsiControllerCount(vmi *v1.VirtualMachineInstance) uint32 {
+	if count := vmi.Spec.Domain.Devices.SCSIControllerCount; count != nil && *count > 0 {
+		return *count
+	}
+	return 1
+}
+
 func shouldDisablePCIHole64(vmi *v1.VirtualMachineInstance) bool {
 	if val, ok := vmi.Annotations[v1.DisablePCIHole64]; ok {
 		return strings.EqualFold(val, "true")
@@ -1867,6 +2416,8 @@ func getPrefixFromBus(bus v1.DiskBus) string {
 		return "vd"
 	case v1.DiskBusSATA, v1.DiskBusSCSI, v1.DiskBusUSB:
 		return "sd"
+	case v1.DiskBusNVMe:
+		return nvmeDevicePrefix
 	default:
 		log.Log.Errorf("Unrecognized bus '%s'", bus)
 		return ""
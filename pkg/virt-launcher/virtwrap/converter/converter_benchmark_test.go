@@ -0,0 +1,82 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package converter_test
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/golden"
+)
+
+// Run with: go test -run '^$' -bench . -benchmem ./pkg/virt-launcher/virtwrap/converter/
+func BenchmarkConvert_v1_VirtualMachineInstance_To_api_Domain(b *testing.B) {
+	for _, architecture := range []string{"amd64", "arm64", "s390x"} {
+		for _, devices := range []int{1, 16} {
+			b.Run(fmt.Sprintf("%s/%d-devices", architecture, devices), func(b *testing.B) {
+				vmi := newBenchmarkVMI(architecture, devices)
+				c, err := golden.NewConverterContext(vmi, golden.Options{})
+				if err != nil {
+					b.Fatal(err)
+				}
+
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if err := converter.Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &api.Domain{}, c); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// newBenchmarkVMI returns a defaulted VMI with the given number of container disks and bridged interfaces
+func newBenchmarkVMI(architecture string, devices int) *v1.VirtualMachineInstance {
+	opts := []libvmi.Option{
+		libvmi.WithName("testvmi"),
+		libvmi.WithNamespace("mynamespace"),
+		libvmi.WithArchitecture(architecture),
+		libvmi.WithMemoryRequest("1Gi"),
+		libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+		libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		libvmi.WithRng(),
+	}
+	for i := 0; i < devices; i++ {
+		opts = append(opts, libvmi.WithContainerDisk(fmt.Sprintf("disk%d", i), "quay.io/containerdisks/fedora:latest"))
+	}
+	for i := 1; i < devices; i++ {
+		name := fmt.Sprintf("net%d", i)
+		opts = append(opts,
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(name)),
+			libvmi.WithNetwork(libvmi.MultusNetwork(name, name)),
+		)
+	}
+
+	vmi := libvmi.New(opts...)
+	v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+	return vmi
+}
@@ -173,6 +173,45 @@ var _ = Describe("Converter", func() {
 			}),
 		)
 
+		DescribeTable("Should assign the requested scsi controller to", func(diskDevice v1.DiskDevice) {
+			context := &ConverterContext{}
+			v1Disk := v1.Disk{
+				Name:       "myvolume",
+				DiskDevice: diskDevice,
+			}
+			apiDisk := api.Disk{}
+			devicePerBus := map[string]deviceNamer{}
+			numQueues := uint(2)
+			volumeStatusMap := map[string]v1.VolumeStatus{"myvolume": {}}
+			Expect(Convert_v1_Disk_To_api_Disk(context, &v1Disk, &apiDisk, devicePerBus, &numQueues, volumeStatusMap)).To(Succeed())
+			Expect(apiDisk.Address).To(Equal(&api.Address{Type: "drive", Controller: "2", Bus: "0", Unit: "0"}))
+		},
+			Entry("LUN-type disk", v1.DiskDevice{
+				LUN: &v1.LunTarget{Bus: "scsi", SCSIController: pointer.P(uint32(2))},
+			}),
+			Entry("Disk-type disk", v1.DiskDevice{
+				Disk: &v1.DiskTarget{Bus: "scsi", SCSIController: pointer.P(uint32(2))},
+			}),
+		)
+
+		It("Should place an nvme disk as a namespace of the nvme controller", func() {
+			context := &ConverterContext{}
+			devicePerBus := map[string]deviceNamer{}
+			numQueues := uint(2)
+			volumeStatusMap := map[string]v1.VolumeStatus{"first": {}, "second": {}}
+			for i, name := range []string{"first", "second"} {
+				v1Disk := v1.Disk{
+					Name:       name,
+					DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusNVMe}},
+				}
+				apiDisk := api.Disk{}
+				Expect(Convert_v1_Disk_To_api_Disk(context, &v1Disk, &apiDisk, devicePerBus, &numQueues, volumeStatusMap)).To(Succeed())
+				Expect(apiDisk.Target.Bus).To(Equal(v1.DiskBusNVMe))
+				Expect(apiDisk.Target.Device).To(Equal(fmt.Sprintf("nvme0n%d", i+1)))
+				Expect(apiDisk.Address).To(Equal(&api.Address{Type: "drive", Controller: "0", Bus: "0", Unit: strconv.Itoa(i)}))
+			}
+		})
+
 		DescribeTable("Should add boot order when provided", func(arch, expectedModel string) {
 			order := uint(1)
 			kubevirtDisk := &v1.Disk{
@@ -658,6 +697,28 @@ var _ = Describe("Converter", func() {
 			//TODO add s390x entry with custom check of model used (disks/interfaces/controllers/devices will use different models)
 		)
 
+		It("should use virtio-transitional models only for the requested device classes", func() {
+			c.Architecture = archconverter.NewConverter(amd64)
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.Rng = &v1.Rng{}
+			vmi.Spec.Domain.Devices.DisableHotplug = false
+			c.VirtioTransitionalDevices = []v1.VirtioDeviceClass{v1.VirtioDeviceClassDisk, v1.VirtioDeviceClassRng}
+			dom := vmiToDomain(vmi, c)
+
+			for _, disk := range dom.Spec.Devices.Disks {
+				if disk.Target.Bus == v1.DiskBusVirtio {
+					Expect(disk.Model).To(Equal("virtio-transitional"), disk.Alias.GetName())
+				}
+			}
+			Expect(dom.Spec.Devices.Rng.Model).To(Equal("virtio-transitional"))
+			Expect(dom.Spec.Devices.Ballooning.Model).To(Equal("virtio-non-transitional"))
+			for _, controller := range dom.Spec.Devices.Controllers {
+				if controller.Type == "scsi" || controller.Type == "virtio-serial" {
+					Expect(controller.Model).To(Equal("virtio-non-transitional"), controller.Type)
+				}
+			}
+		})
+
 		Context("with ephemeral disk", func() {
 			DescribeTable("a scsi controller should ", func(enabled bool, expectedType, expectedModel, arch string) {
 				c.Architecture = archconverter.NewConverter(arch)
@@ -947,6 +1008,31 @@ var _ = Describe("Converter", func() {
 				Entry(v1.CPUModeHostPassthrough, v1.CPUModeHostPassthrough),
 				Entry(v1.CPUModeHostModel, v1.CPUModeHostModel),
 			)
+
+			DescribeTable("should convert CPU cache", func(cache *v1.CPUCache, expectedCache *api.CPUCache) {
+				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+				vmi.Spec.Domain.CPU = &v1.CPU{
+					Model: v1.CPUModeHostPassthrough,
+					Cache: cache,
+				}
+				domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+
+				Expect(domainSpec.CPU.Cache).To(Equal(expectedCache))
+			},
+				Entry("when not set", nil, nil),
+				Entry("with passthrough mode",
+					&v1.CPUCache{Mode: v1.CPUCacheModePassthrough},
+					&api.CPUCache{Mode: "passthrough"},
+				),
+				Entry("with emulate mode of level 3",
+					&v1.CPUCache{Mode: v1.CPUCacheModeEmulate, Level: pointer.P(uint32(3))},
+					&api.CPUCache{Mode: "emulate", Level: pointer.P(uint32(3))},
+				),
+				Entry("with disable mode",
+					&v1.CPUCache{Mode: v1.CPUCacheModeDisable},
+					&api.CPUCache{Mode: "disable"},
+				),
+			)
 		})
 
 		Context("when CPU spec defined and model not", func() {
@@ -1037,7 +1123,101 @@ var _ = Describe("Converter", func() {
 			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &api.Domain{}, c)).ToNot(Succeed())
 		})
 
-		It("should succeed with SCSI reservation", func() {
+		Context("with pinned ccw addresses", func() {
+			BeforeEach(func() {
+				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+				vmi.Spec.Architecture = s390x
+				c.Architecture = archconverter.NewConverter(s390x)
+				vmi.Spec.Domain.Devices.Disks[0].Disk.Bus = v1.DiskBusVirtio
+			})
+
+			It("should place the disk and the interface on the requested device numbers", func() {
+				vmi.Spec.Domain.Devices.Disks[0].Disk.CCWAddress = "0.0.0001"
+				vmi.Spec.Domain.Devices.Interfaces[0].CCWAddress = "0.0.00A2"
+				domain := vmiToDomain(vmi, c)
+				Expect(domain.Spec.Devices.Disks[0].Address).To(Equal(
+					&api.Address{Type: api.AddressCCW, CSSID: "0xfe", SSID: "0x0", DevNo: "0x0001"}))
+				Expect(domain.Spec.Devices.Interfaces[0].Address).To(Equal(
+					&api.Address{Type: api.AddressCCW, CSSID: "0xfe", SSID: "0x0", DevNo: "0x00a2"}))
+			})
+
+			It("should fail when two devices are pinned to the same device number", func() {
+				vmi.Spec.Domain.Devices.Disks[0].Disk.CCWAddress = "0.0.0001"
+				vmi.Spec.Domain.Devices.Interfaces[0].CCWAddress = "0.0.0001"
+				Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &api.Domain{}, c)).To(MatchError(
+					MatchRegexp(`^disk .* and interface default are both placed on the ccw address 0xfe\.0x0\.0x0001$`)))
+			})
+
+			It("should fail with a non virtio bus", func() {
+				vmi.Spec.Domain.Devices.Disks[0].Disk.CCWAddress = "0.0.0001"
+				vmi.Spec.Domain.Devices.Disks[0].Disk.Bus = v1.DiskBusSCSI
+				Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &api.Domain{}, c)).To(MatchError(
+					ContainSubstring("setting a ccw address is not allowed for non-virtio bus types")))
+			})
+
+			It("should fail on other architectures", func() {
+				vmi.Spec.Architecture = amd64
+				c.Architecture = archconverter.NewConverter(amd64)
+				vmi.Spec.Domain.Devices.Disks[0].Disk.CCWAddress = "0.0.0001"
+				Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &api.Domain{}, c)).To(MatchError(
+					ContainSubstring("setting a ccw address is not supported on amd64")))
+			})
+		})
+
+		Context("with the microvm machine type", func() {
+			BeforeEach(func() {
+				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+				vmi.Spec.Domain.Machine = &v1.Machine{Type: "microvm"}
+				vmi.Spec.Domain.Devices.Rng = &v1.Rng{}
+				vmi.Spec.Domain.Devices.Disks[0].Disk.Bus = v1.DiskBusVirtio
+				c.Architecture = archconverter.NewConverter(amd64)
+			})
+
+			It("should attach the virtio devices through virtio-mmio", func() {
+				domain := vmiToDomain(vmi, c)
+				mmio := &api.Address{Type: api.AddressMMIO}
+				Expect(domain.Spec.OS.Type.Machine).To(Equal("microvm"))
+				Expect(domain.Spec.Devices.Disks[0].Address).To(Equal(mmio))
+				Expect(domain.Spec.Devices.Interfaces[0].Address).To(Equal(mmio))
+				Expect(domain.Spec.Devices.Rng.Address).To(Equal(mmio))
+				Expect(domain.Spec.Devices.Ballooning.Address).To(Equal(mmio))
+				for _, controller := range domain.Spec.Devices.Controllers {
+					Expect(controller.Type).ToNot(Equal("pci"))
+					if controller.Type == "virtio-serial" || controller.Type == "scsi" {
+						Expect(controller.Address).To(Equal(mmio))
+					}
+				}
+			})
+
+			It("should drop the legacy PC devices and firmware tables", func() {
+				domain := vmiToDomain(vmi, c)
+				Expect(domain.Spec.Devices.Video).To(BeEmpty())
+				Expect(domain.Spec.Devices.Graphics).To(BeEmpty())
+				Expect(domain.Spec.OS.SMBios).To(BeNil())
+				Expect(domain.Spec.SysInfo).To(BeNil())
+				Expect(domain.Spec.Features.VMPort).To(BeNil())
+			})
+
+			It("should fail on other architectures", func() {
+				vmi.Spec.Architecture = arm64
+				c.Architecture = archconverter.NewConverter(arm64)
+				Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &api.Domain{}, c)).To(MatchError(
+					"the microvm machine type is not supported on arm64"))
+			})
+		})
+
+		DescribeTable("should set the machine type", func(machineType, expectedMachineType string) {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Machine = &v1.Machine{Type: machineType}
+			c.MachineTypeAliases = map[string]string{"stable": "pc-q35-8.2"}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.OS.Type.Machine).To(Equal(expectedMachineType))
+		},
+			Entry("resolving a machine type alias", "stable", "pc-q35-8.2"),
+			Entry("keeping a machine type which is not an alias", "pc-q35-9.0", "pc-q35-9.0"),
+		)
+
+		DescribeTable("should succeed with SCSI reservation", func(socketPath, expectedPath string) {
 			name := "scsi-reservation"
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			vmi.Spec.Domain.Devices.Disks = []v1.Disk{
@@ -1063,13 +1243,17 @@ var _ = Describe("Converter", func() {
 			})
 			c.DisksInfo = make(map[string]*disk.DiskInfo)
 			c.DisksInfo[name] = &disk.DiskInfo{}
+			c.PrHelperSocketPath = socketPath
 			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
 			reserv := domainSpec.Devices.Disks[0].Source.Reservations
 			Expect(reserv.Managed).To(Equal("no"))
 			Expect(reserv.SourceReservations.Type).To(Equal("unix"))
-			Expect(reserv.SourceReservations.Path).To(Equal("/var/run/kubevirt/daemons/pr/pr-helper.sock"))
+			Expect(reserv.SourceReservations.Path).To(Equal(expectedPath))
 			Expect(reserv.SourceReservations.Mode).To(Equal("client"))
-		})
+		},
+			Entry("on the default pr-helper socket", "", "/var/run/kubevirt/daemons/pr/pr-helper.sock"),
+			Entry("on the configured pr-helper socket", "/run/pr/multipath-pr.sock", "/run/pr/multipath-pr.sock"),
+		)
 
 		It("should allow CD-ROM with no volume", func() {
 			name := "empty-cdrom"
@@ -1356,12 +1540,43 @@ var _ = Describe("Converter", func() {
 			Entry("not be set on s390x when annotation was set not to true", s390x, "something", false),
 		)
 
-		It("should fail when input device is set to ps2 bus", func() {
+		DescribeTable("features OEM string should", func(value string, expected bool) {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			if value != "" {
+				if vmi.Annotations == nil {
+					vmi.Annotations = make(map[string]string)
+				}
+				vmi.Annotations[v1.PublishFeaturesOEMString] = value
+			}
+			domain := vmiToDomain(vmi, c)
+
+			if expected {
+				Expect(domain.Spec.SysInfo.OEMStrings).ToNot(BeNil())
+				Expect(domain.Spec.SysInfo.OEMStrings.Entries).To(ContainElement(HavePrefix("kubevirt.io/features=")))
+			} else {
+				Expect(domain.Spec.SysInfo.OEMStrings).To(BeNil())
+			}
+		},
+			Entry("be published when annotation was set to true", "true", true),
+			Entry("not be published when annotation was not set", "", false),
+			Entry("not be published when annotation was set not to true", "something", false),
+		)
+
+		It("should fail when a tablet input device is set to ps2 bus", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
-			vmi.Spec.Domain.Devices.Inputs[0].Bus = "ps2"
+			vmi.Spec.Domain.Devices.Inputs[0].Bus = v1.InputBusPS2
 			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &api.Domain{}, c)).ToNot(Succeed(), "Expect error")
 		})
 
+		It("should enable the ps2 controller when a keyboard input device is set to ps2 bus", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.Inputs[0].Bus = v1.InputBusPS2
+			vmi.Spec.Domain.Devices.Inputs[0].Type = v1.InputTypeKeyboard
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Features.PS2).To(Equal(&api.FeatureState{State: "on"}))
+			Expect(domain.Spec.Devices.Inputs[0].Bus).To(Equal(v1.InputBusPS2))
+		})
+
 		It("should fail when input device is set to keyboard type", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			vmi.Spec.Domain.Devices.Inputs[0].Type = "keyboard"
@@ -1456,6 +1671,22 @@ var _ = Describe("Converter", func() {
 			Entry("should be disabled on s390x", s390x, "none"),
 		)
 
+		DescribeTable("usb serial console", func(autoattachSerialConsole *bool, expectedModel string) {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.SerialConsoleTargetType = v1.SerialTargetTypeUSB
+			vmi.Spec.Domain.Devices.AutoattachSerialConsole = autoattachSerialConsole
+			c.Architecture = archconverter.NewConverter(amd64)
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.Controllers).To(ContainElement(api.Controller{
+				Type:  "usb",
+				Index: "0",
+				Model: expectedModel,
+			}))
+		},
+			Entry("should enable the USB controller", nil, "qemu-xhci"),
+			Entry("should not enable the USB controller without serial console", pointer.P(false), "none"),
+		)
+
 		It("should not enable usb redirection when numberOfDevices == 0", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			vmi.Spec.Domain.Devices.ClientPassthrough = nil
@@ -1563,6 +1794,47 @@ var _ = Describe("Converter", func() {
 			Expect(domainSpec.Memory.Unit).To(Equal("b"))
 		})
 
+		It("should bind the hugepages to the host NUMA nodes of the policy", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Memory = &v1.Memory{
+				Hugepages: &v1.Hugepages{
+					PageSize:   "2Mi",
+					NUMAPolicy: &v1.HugepagesNUMAPolicy{Mode: v1.HugepagesNUMAModeInterleave, NodeSet: "0-1"},
+				},
+			}
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			Expect(domainSpec.MemoryBacking.HugePages.HugePage).To(Equal([]api.HugePage{
+				{Size: "2097152", Unit: "b", NodeSet: "0"},
+			}))
+			Expect(domainSpec.NUMATune).To(Equal(&api.NUMATune{
+				Memory: api.NumaTuneMemory{Mode: "interleave", NodeSet: "0-1"},
+			}))
+		})
+
+		DescribeTable("should only bind the hugepages to host NUMA nodes the pod CPUs run on", func(nodeSet string, matchErr types.GomegaMatcher) {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Memory = &v1.Memory{
+				Hugepages: &v1.Hugepages{
+					PageSize:   "2Mi",
+					NUMAPolicy: &v1.HugepagesNUMAPolicy{Mode: v1.HugepagesNUMAModeStrict, NodeSet: nodeSet},
+				},
+			}
+			podContext := *c
+			podContext.CPUSet = []int{0, 1}
+			podContext.Topology = &cmdv1.Topology{
+				NumaCells: []*cmdv1.Cell{
+					{Id: 0, Cpus: []*cmdv1.CPU{{Id: 0}, {Id: 1}}},
+					{Id: 1, Cpus: []*cmdv1.CPU{{Id: 2}, {Id: 3}}},
+				},
+			}
+			domain := &api.Domain{}
+			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, domain, &podContext)).To(matchErr)
+		},
+			Entry("accepting a node of the pod CPUs", "0", Succeed()),
+			Entry("rejecting a node without pod CPUs", "0-1",
+				MatchError(ContainSubstring("host NUMA node 1 of the node set 0-1 is not one the pod CPUs run on"))),
+		)
+
 		It("should use guest memory instead of requested memory if present", func() {
 			guestMemory := resource.MustParse("123Mi")
 			vmi.Spec.Domain.Memory = &v1.Memory{
@@ -1671,6 +1943,68 @@ var _ = Describe("Converter", func() {
 			Entry("ErrorPolicy equal to report", pointer.P(v1.DiskErrorPolicyReport), "report"),
 			Entry("ErrorPolicy equal to enospace", pointer.P(v1.DiskErrorPolicyEnospace), "enospace"),
 		)
+		DescribeTable("Should set the read error policy", func(rpolicy *v1.DiskErrorPolicy, expected string) {
+			vmi.Spec.Domain.Devices.Disks[0] = v1.Disk{
+				Name: "mydisk",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{
+						Bus: v1.VirtIO,
+					},
+				},
+				ErrorPolicy:  pointer.P(v1.DiskErrorPolicyStop),
+				RerrorPolicy: rpolicy,
+			}
+			vmi.Spec.Volumes[0] = v1.Volume{
+				Name: "mydisk",
+				VolumeSource: v1.VolumeSource{
+					Ephemeral: &v1.EphemeralVolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "testclaim",
+						},
+					},
+				},
+			}
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			Expect(domainSpec.Devices.Disks[0].Driver.ErrorPolicy).To(Equal(v1.DiskErrorPolicyStop))
+			Expect(string(domainSpec.Devices.Disks[0].Driver.RerrorPolicy)).To(Equal(expected))
+		},
+			Entry("RerrorPolicy not specified", nil, ""),
+			Entry("RerrorPolicy equal to stop", pointer.P(v1.DiskErrorPolicyStop), "stop"),
+			Entry("RerrorPolicy equal to ignore", pointer.P(v1.DiskErrorPolicyIgnore), "ignore"),
+			Entry("RerrorPolicy equal to report", pointer.P(v1.DiskErrorPolicyReport), "report"),
+		)
+		DescribeTable("Should set the IO throttling", func(ioTune *v1.DiskIOTune, expected *api.DiskIOTune) {
+			vmi.Spec.Domain.Devices.Disks[0] = v1.Disk{
+				Name: "mydisk",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{
+						Bus: v1.VirtIO,
+					},
+				},
+				IOTune: ioTune,
+			}
+			vmi.Spec.Volumes[0] = v1.Volume{
+				Name: "mydisk",
+				VolumeSource: v1.VolumeSource{
+					Ephemeral: &v1.EphemeralVolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "testclaim",
+						},
+					},
+				},
+			}
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			Expect(domainSpec.Devices.Disks[0].IOTune).To(Equal(expected))
+		},
+			Entry("IOTune not specified", nil, nil),
+			Entry("with limits", &v1.DiskIOTune{ReadBytesSec: 1000, WriteIOPSSec: 100},
+				&api.DiskIOTune{ReadBytesSec: 1000, WriteIOPSSec: 100}),
+			Entry("with burst limits", &v1.DiskIOTune{TotalIOPSSec: 100, Burst: &v1.DiskIOTuneBurst{TotalIOPSSec: 500}},
+				&api.DiskIOTune{TotalIOPSSec: 100, TotalIOPSSecMax: 500}),
+			Entry("with burst length only on the set burst limits",
+				&v1.DiskIOTune{TotalBytesSec: 1000, TotalIOPSSec: 100, Burst: &v1.DiskIOTuneBurst{TotalIOPSSec: 500, LengthSeconds: 10}},
+				&api.DiskIOTune{TotalBytesSec: 1000, TotalIOPSSec: 100, TotalIOPSSecMax: 500, TotalIOPSSecMaxLength: 10}),
+		)
 		DescribeTable("Should set the vmport by arch", func(arch string) {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			c.Architecture = archconverter.NewConverter(arch)
@@ -1719,6 +2053,35 @@ var _ = Describe("Converter", func() {
 				Expect(Convert_v1_BlockSize_To_api_BlockIO(&v1Disk, &apiDisk)).To(MatchError(ContainSubstring(blockIoConfigErrorMessage)))
 			})
 		})
+
+		Context("Zoned", func() {
+			zonedDisk := func(zoned bool) *v1.Disk {
+				return &v1.Disk{
+					Name:       "test",
+					DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio, Zoned: zoned}},
+				}
+			}
+
+			It("Should not change a disk which is not zoned", func() {
+				apiDisk := api.Disk{Source: api.DiskSource{File: "/"}}
+				Expect(Convert_v1_Zoned_To_api_Disk(zonedDisk(false), &apiDisk)).To(Succeed())
+				Expect(apiDisk.Driver).To(BeNil())
+				Expect(apiDisk.BlockIO).To(BeNil())
+			})
+
+			It("Should fail for a zoned disk backed by a file", func() {
+				apiDisk := api.Disk{Source: api.DiskSource{File: "/"}}
+				Expect(Convert_v1_Zoned_To_api_Disk(zonedDisk(true), &apiDisk)).To(
+					MatchError("zoned disk test must be backed by a block device"))
+			})
+
+			It("Should fail for a zoned disk backed by a device which is not zoned", func() {
+				apiDisk := api.Disk{Source: api.DiskSource{Dev: "/dev/null"}, Driver: &api.DiskDriver{}}
+				Expect(Convert_v1_Zoned_To_api_Disk(zonedDisk(true), &apiDisk)).To(
+					MatchError("block device /dev/null of disk test is not a zoned device"))
+				Expect(apiDisk.Driver.Cache).To(BeEmpty())
+			})
+		})
 	})
 	Context("Network convert", func() {
 		var vmi *v1.VirtualMachineInstance
@@ -1805,6 +2168,40 @@ var _ = Describe("Converter", func() {
 			Expect(domain.Spec.Devices.Interfaces[1].Type).To(Equal("ethernet"))
 			Expect(domain.Spec.Devices.Interfaces[2].Type).To(Equal("ethernet"))
 		})
+		It("Should set a vdpa domain interface pointing to the vhost-vdpa device", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:       netName1,
+				Binding:    &v1.PluginBinding{Name: "vdpa"},
+				MacAddress: "de:ad:00:00:be:af",
+			}}
+			vmi.Spec.Networks = []v1.Network{{
+				Name:          netName1,
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "vdpa-net"}},
+			}}
+			c.DomainAttachmentByInterfaceName = map[string]string{netName1: string(v1.VDPA)}
+			c.VDPADevicePathByInterfaceName = map[string]string{netName1: "/dev/vhost-vdpa-0"}
+
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Interfaces[0].Type).To(Equal("vdpa"))
+			Expect(domain.Spec.Devices.Interfaces[0].Source).To(Equal(api.InterfaceSource{Device: "/dev/vhost-vdpa-0"}))
+			Expect(domain.Spec.Devices.Interfaces[0].MAC).To(Equal(&api.MAC{MAC: "de:ad:00:00:be:af"}))
+			Expect(domain.Spec.Devices.Interfaces[0].Driver).To(BeNil())
+		})
+		It("Should fail to convert a vdpa interface without a vhost-vdpa device", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: netName1, Binding: &v1.PluginBinding{Name: "vdpa"}}}
+			vmi.Spec.Networks = []v1.Network{{
+				Name:          netName1,
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "vdpa-net"}},
+			}}
+			c.DomainAttachmentByInterfaceName = map[string]string{netName1: string(v1.VDPA)}
+
+			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &api.Domain{}, c)).
+				To(MatchError(ContainSubstring("failed to find the vhost-vdpa device of interface")))
+		})
 		It("Should set domain interface source correctly for default multus", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
@@ -1937,6 +2334,65 @@ var _ = Describe("Converter", func() {
 			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, domain, c)).To(Succeed())
 			Expect(domain.Spec.Devices.HostDevices).To(Equal([]api.HostDevice{{Type: identifyDevice}}))
 		})
+		DescribeTable("should configure the interrupt coalescing of the interface", func(model string, expectedCoalesce *api.Coalesce) {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "default",
+				Model:                  model,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				Coalesce:               &v1.InterfaceCoalesce{RxMaxFrames: 64},
+			}}
+
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.Devices.Interfaces[0].Coalesce).To(Equal(expectedCoalesce))
+		},
+			Entry("on a virtio interface", v1.VirtIO, &api.Coalesce{Rx: &api.CoalesceRx{Frames: api.CoalesceFrames{Max: 64}}}),
+			Entry("but not on an emulated interface", "e1000", nil),
+		)
+		DescribeTable("should configure the offloads of the interface", func(model string, expectedDriver *api.InterfaceDriver) {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "default",
+				Model:                  model,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				Offloads: &v1.InterfaceOffloads{
+					Host:  &v1.InterfaceHostOffloads{Checksum: pointer.P(true), TSO4: pointer.P(false), MergeRxBuffers: pointer.P(false)},
+					Guest: &v1.InterfaceGuestOffloads{TSO4: pointer.P(false), UFO: pointer.P(false)},
+				},
+			}}
+
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.Devices.Interfaces[0].Driver).To(Equal(expectedDriver))
+		},
+			Entry("on a virtio interface", v1.VirtIO, &api.InterfaceDriver{
+				Name:  "vhost",
+				Host:  &api.InterfaceDriverHost{CSum: "on", TSO4: "off", MrgRxBuf: "off"},
+				Guest: &api.InterfaceDriverGuest{TSO4: "off", UFO: "off"},
+			}),
+			Entry("but not on an emulated interface", "e1000", nil),
+		)
+		DescribeTable("should configure the queue sizes of the interface", func(model string, expectedDriver *api.InterfaceDriver) {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "default",
+				Model:                  model,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				RxQueueSize:            1024,
+				TxQueueSize:            512,
+			}}
+
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.Devices.Interfaces[0].Driver).To(Equal(expectedDriver))
+		},
+			Entry("on a virtio interface", v1.VirtIO, &api.InterfaceDriver{Name: "vhost", RxQueueSize: 1024, TxQueueSize: 512}),
+			Entry("but not on an emulated interface", "e1000", nil),
+		)
 	})
 
 	Context("graphics and video device", func() {
@@ -2139,6 +2595,41 @@ var _ = Describe("Converter", func() {
 		)
 	})
 
+	It("should merge the VMI SMBIOS overrides into sysinfo", func() {
+		vmi := libvmi.New()
+		v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+		vmi.Spec.Domain.Firmware = &v1.Firmware{
+			UUID:       "e4ea4c9a-3d4b-4bbf-9e5f-3b1c6b1a1d3f",
+			Serial:     "vm-serial",
+			SKU:        "vm-sku",
+			OEMStrings: []string{"provisioning:enabled", "role:worker"},
+		}
+		domain := vmiToDomain(vmi, &ConverterContext{
+			Architecture:   archconverter.NewConverter(runtime.GOARCH),
+			AllowEmulation: true,
+			SMBios:         &cmdv1.SMBios{Manufacturer: "KubeVirt", Sku: "cluster-sku"},
+		})
+		Expect(domain.Spec.SysInfo.System).To(ContainElements(
+			api.Entry{Name: "serial", Value: "vm-serial"},
+			api.Entry{Name: "sku", Value: "vm-sku"},
+			api.Entry{Name: "manufacturer", Value: "KubeVirt"},
+		))
+		Expect(domain.Spec.SysInfo.System).ToNot(ContainElement(api.Entry{Name: "sku", Value: "cluster-sku"}))
+		Expect(domain.Spec.SysInfo.OEMStrings).To(Equal(&api.OEMStrings{Entries: []string{"provisioning:enabled", "role:worker"}}))
+	})
+
+	It("should use the cluster-wide SKU when the VMI does not set one", func() {
+		vmi := libvmi.New()
+		v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+		domain := vmiToDomain(vmi, &ConverterContext{
+			Architecture:   archconverter.NewConverter(runtime.GOARCH),
+			AllowEmulation: true,
+			SMBios:         &cmdv1.SMBios{Sku: "cluster-sku"},
+		})
+		Expect(domain.Spec.SysInfo.System).To(ContainElement(api.Entry{Name: "sku", Value: "cluster-sku"}))
+		Expect(domain.Spec.SysInfo.OEMStrings).To(BeNil())
+	})
+
 	Context("IOThreads", func() {
 
 		DescribeTable("Should use correct IOThreads policies", func(policy v1.IOThreadsPolicy, cpuCores int, threadCount int, threadIDs []int) {
@@ -2496,6 +2987,22 @@ var _ = Describe("Converter", func() {
 			Expect(apiDisk.Driver.Queues).To(BeNil(), "expected no queues to be requested")
 		})
 
+		DescribeTable("should set the queue size of a disk", func(bus v1.DiskBus, expectedQueueSize *uint) {
+			v1Disk := v1.Disk{
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{Bus: bus, QueueSize: pointer.P(uint32(256))},
+				},
+			}
+			apiDisk := api.Disk{}
+			devicePerBus := map[string]deviceNamer{}
+			Expect(Convert_v1_Disk_To_api_Disk(context, &v1Disk, &apiDisk, devicePerBus, nil, make(map[string]v1.VolumeStatus))).
+				To(Succeed())
+			Expect(apiDisk.Driver.QueueSize).To(Equal(expectedQueueSize))
+		},
+			Entry("on the virtio bus", v1.DiskBusVirtio, pointer.P(uint(256))),
+			Entry("but not on the sata bus", v1.DiskBusSATA, nil),
+		)
+
 		It("should assign correct number of queues with CPU hotplug topology", func() {
 			vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{}
 			vmi.Spec.Domain.CPU = &v1.CPU{
@@ -2526,6 +3033,156 @@ var _ = Describe("Converter", func() {
 		})
 	})
 
+	Context("vhost-user-blk disks", func() {
+		const socketPath = "/var/run/kubevirt-private/vhost-user-blk/mydisk/vhost.0"
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = libvmi.New(
+				libvmi.WithName("testvmi"),
+				libvmi.WithNamespace("mynamespace"),
+			)
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{{
+				Name: "mydisk",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio},
+				},
+			}}
+			vmi.Spec.Volumes = []v1.Volume{{
+				Name: "mydisk",
+				VolumeSource: v1.VolumeSource{
+					VhostUserBlk: &v1.VhostUserBlkVolumeSource{Path: "/var/tmp/spdk/vhost.0"},
+				},
+			}}
+		})
+
+		newContext := func(volumeStatuses ...v1.VolumeStatus) *ConverterContext {
+			c := &ConverterContext{
+				Architecture:     archconverter.NewConverter(runtime.GOARCH),
+				AllowEmulation:   true,
+				SMBios:           &cmdv1.SMBios{},
+				PermanentVolumes: map[string]v1.VolumeStatus{},
+			}
+			for _, volumeStatus := range volumeStatuses {
+				c.PermanentVolumes[volumeStatus.Name] = volumeStatus
+			}
+			return c
+		}
+
+		It("should connect the disk to the socket from the volume status", func() {
+			domain := vmiToDomain(vmi, newContext(v1.VolumeStatus{
+				Name:               "mydisk",
+				VhostUserBlkVolume: &v1.VhostUserBlkVolumeInfo{SocketPath: socketPath},
+			}))
+
+			Expect(domain.Spec.Devices.Disks).To(HaveLen(1))
+			disk := domain.Spec.Devices.Disks[0]
+			Expect(disk.Type).To(Equal("vhostuser"))
+			Expect(disk.Snapshot).To(Equal("no"))
+			Expect(disk.Source).To(Equal(api.DiskSource{
+				Type:      "unix",
+				Path:      socketPath,
+				Reconnect: &api.Reconnect{Enabled: "yes", Timeout: pointer.P(uint(10))},
+			}))
+			Expect(disk.Driver.Type).To(Equal("raw"))
+			Expect(disk.Driver.Cache).To(BeEmpty())
+			Expect(disk.Driver.Discard).To(BeEmpty())
+			Expect(disk.Driver.ErrorPolicy).To(BeEmpty())
+		})
+
+		It("should share the guest memory with the backend", func() {
+			domain := vmiToDomain(vmi, newContext(v1.VolumeStatus{
+				Name:               "mydisk",
+				VhostUserBlkVolume: &v1.VhostUserBlkVolumeInfo{SocketPath: socketPath},
+			}))
+
+			Expect(domain.Spec.MemoryBacking).ToNot(BeNil())
+			Expect(domain.Spec.MemoryBacking.Access).To(Equal(&api.MemoryBackingAccess{Mode: "shared"}))
+			Expect(domain.Spec.MemoryBacking.Source).To(Equal(&api.MemoryBackingSource{Type: "memfd"}))
+		})
+
+		It("should fail while the socket is not in the volume status", func() {
+			domain := &api.Domain{}
+			err := Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, domain, newContext(v1.VolumeStatus{Name: "mydisk"}))
+			Expect(err).To(MatchError("the socket of vhost-user-blk volume mydisk is not known yet"))
+		})
+
+		It("should not set a driver cache mode", func() {
+			disk := &api.Disk{
+				Type:   "vhostuser",
+				Device: "disk",
+				Driver: &api.DiskDriver{},
+			}
+			Expect(SetDriverCacheMode(disk, nil)).To(Succeed())
+			Expect(disk.Driver.Cache).To(BeEmpty())
+		})
+	})
+
+	Context("host block device disks", func() {
+		It("should pass the mounted device through as a raw block disk", func() {
+			vmi := libvmi.New(
+				libvmi.WithName("testvmi"),
+				libvmi.WithNamespace("mynamespace"),
+			)
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{{
+				Name: "mydisk",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio},
+				},
+			}}
+			vmi.Spec.Volumes = []v1.Volume{{
+				Name: "mydisk",
+				VolumeSource: v1.VolumeSource{
+					HostBlockDevice: &v1.HostBlockDeviceVolumeSource{Path: "/dev/nvme1n1"},
+				},
+			}}
+
+			domain := vmiToDomain(vmi, &ConverterContext{Architecture: archconverter.NewConverter(runtime.GOARCH), AllowEmulation: true, SMBios: &cmdv1.SMBios{}})
+			Expect(domain.Spec.Devices.Disks).To(HaveLen(1))
+			disk := domain.Spec.Devices.Disks[0]
+			Expect(disk.Type).To(Equal("block"))
+			Expect(disk.Source.Dev).To(Equal("/dev/mydisk"))
+			Expect(disk.Driver.Type).To(Equal("raw"))
+		})
+	})
+
+	Context("CD-ROM media", func() {
+		var cdrom *api.Disk
+
+		BeforeEach(func() {
+			cdrom = &api.Disk{
+				Device: "cdrom",
+				Type:   "file",
+				Source: api.DiskSource{File: GetFilesystemVolumePath("old-iso")},
+				Driver: &api.DiskDriver{Name: "qemu", Type: "raw"},
+			}
+		})
+
+		It("should insert a block volume", func() {
+			volume := &v1.Volume{Name: "iso", VolumeSource: v1.VolumeSource{
+				DataVolume: &v1.DataVolumeSource{Name: "iso-dv"},
+			}}
+			Expect(Convert_v1_Volume_To_api_CDRomMedium(volume, true, cdrom)).To(Succeed())
+			Expect(cdrom.Type).To(Equal("block"))
+			Expect(cdrom.Source).To(Equal(api.DiskSource{Name: "iso", Dev: GetBlockDeviceVolumePath("iso")}))
+		})
+
+		It("should eject the medium without a volume", func() {
+			Expect(Convert_v1_Volume_To_api_CDRomMedium(nil, false, cdrom)).To(Succeed())
+			Expect(cdrom.Type).To(Equal("block"))
+			Expect(cdrom.Source).To(Equal(api.DiskSource{}))
+		})
+
+		It("should refuse volumes which are not persistent volume claims or data volumes", func() {
+			volume := &v1.Volume{Name: "iso", VolumeSource: v1.VolumeSource{
+				ContainerDisk: &v1.ContainerDiskSource{Image: "my-iso"},
+			}}
+			Expect(Convert_v1_Volume_To_api_CDRomMedium(volume, false, cdrom)).To(MatchError(ContainSubstring("only persistentVolumeClaim and dataVolume volumes")))
+		})
+	})
+
 	Context("Correctly handle IsolateEmulatorThread with dedicated cpus", func() {
 		DescribeTable("should succeed assigning CPUs to emulatorThread",
 			func(cpu v1.CPU, converterContext *ConverterContext, vmiAnnotations map[string]string, expectedEmulatorThreads int) {
@@ -2760,6 +3417,50 @@ var _ = Describe("Converter", func() {
 			isExpectedThreadsLayout := equality.Semantic.DeepEqual(expectedLayout, domain.Spec.CPUTune.IOThreadPin)
 			Expect(isExpectedThreadsLayout).To(BeTrue())
 		})
+
+		Context("with an explicit pinning", func() {
+			var c *ConverterContext
+
+			BeforeEach(func() {
+				vmi.Spec.Domain.CPU.Cores = 4
+				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+				c = &ConverterContext{
+					Architecture:   archconverter.NewConverter(runtime.GOARCH),
+					CPUSet:         []int{5, 6, 7, 8},
+					AllowEmulation: true,
+					Topology: &cmdv1.Topology{
+						NumaCells: []*cmdv1.Cell{{
+							Cpus: []*cmdv1.CPU{{Id: 5}, {Id: 6}, {Id: 7}, {Id: 8}},
+						}},
+					},
+				}
+			})
+
+			It("should pin the iothreads to the requested cpus", func() {
+				vmi.Spec.Domain.IOThreads = &v1.DiskIOThreads{Pinning: map[string]string{"2": "5-6"}}
+				domain := vmiToDomain(vmi, c)
+				domain.Spec.IOThreads = &api.IOThreads{IOThreads: 2}
+
+				Expect(vcpu.FormatDomainIOThreadPin(vmi, domain, "0", c.CPUSet)).To(Succeed())
+				Expect(domain.Spec.CPUTune.IOThreadPin).To(Equal([]api.CPUTuneIOThreadPin{
+					{IOThread: 1, CPUSet: "5,6"},
+					{IOThread: 2, CPUSet: "5-6"},
+				}))
+			})
+
+			DescribeTable("should reject", func(pinning map[string]string, expectedError string) {
+				vmi.Spec.Domain.IOThreads = &v1.DiskIOThreads{Pinning: pinning}
+				domain := vmiToDomain(vmi, c)
+				domain.Spec.IOThreads = &api.IOThreads{IOThreads: 2}
+
+				Expect(vcpu.FormatDomainIOThreadPin(vmi, domain, "0", c.CPUSet)).To(MatchError(ContainSubstring(expectedError)))
+			},
+				Entry("a missing iothread", map[string]string{"3": "5"}, "cannot pin iothread 3, the domain has 2 iothreads"),
+				Entry("an invalid iothread", map[string]string{"01": "5"}, "cannot pin iothread 01"),
+				Entry("an invalid cpuset", map[string]string{"1": "5-a"}, "invalid cpuset"),
+				Entry("cpus not allocated to the VMI", map[string]string{"1": "7-9"}, "cannot pin iothread 1 to CPU 9"),
+			)
+		})
 	})
 	Context("virtio-net multi-queue", func() {
 		var vmi *v1.VirtualMachineInstance
@@ -2824,6 +3525,28 @@ var _ = Describe("Converter", func() {
 				"should be capped to the maximum number of queues on tap devices")
 		})
 
+		DescribeTable("should configure RSS and hash reporting", func(cores uint32, expectedDriver, expectedRSS string) {
+			vmi.Spec.Domain.Devices.NetworkInterfaceRSS = pointer.P(true)
+			vmi.Spec.Domain.CPU = &v1.CPU{
+				Cores: cores,
+			}
+			domain := vmiToDomain(vmi, &ConverterContext{Architecture: archconverter.NewConverter(runtime.GOARCH), AllowEmulation: true})
+			Expect(domain.Spec.Devices.Interfaces[0].Driver.Name).To(Equal(expectedDriver))
+			Expect(domain.Spec.Devices.Interfaces[0].Driver.RSS).To(Equal(expectedRSS))
+			Expect(domain.Spec.Devices.Interfaces[0].Driver.RSSHashReport).To(Equal(expectedRSS))
+		},
+			Entry("on an interface with multiple queues served by QEMU", uint32(4), "qemu", "on"),
+			Entry("but not on an interface with a single queue", uint32(1), "vhost", ""),
+		)
+
+		It("should not configure RSS unless requested", func() {
+			vmi.Spec.Domain.CPU = &v1.CPU{
+				Cores: 4,
+			}
+			domain := vmiToDomain(vmi, &ConverterContext{Architecture: archconverter.NewConverter(runtime.GOARCH), AllowEmulation: true})
+			Expect(domain.Spec.Devices.Interfaces[0].Driver.Name).To(Equal("vhost"))
+			Expect(domain.Spec.Devices.Interfaces[0].Driver.RSS).To(BeEmpty())
+		})
 	})
 	Context("Realtime", func() {
 		var vmi *v1.VirtualMachineInstance
@@ -3106,6 +3829,66 @@ var _ = Describe("Converter", func() {
 					},
 				}, ""),
 		)
+
+		DescribeTable("fw_cfg entries should be passed to QEMU", func(volume v1.Volume, expectedPath string) {
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, volume)
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				FWCfg: []v1.FWCfgEntry{{Name: "opt/com.example/config", VolumeNameRef: volume.Name, Key: "config"}},
+			}
+			c = &ConverterContext{
+				Architecture:   archconverter.NewConverter(runtime.GOARCH),
+				VirtualMachine: vmi,
+				AllowEmulation: true,
+			}
+
+			domain := &api.Domain{}
+			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, domain, c)).To(Succeed())
+			Expect(domain.Spec.QEMUCmd).ToNot(BeNil())
+			Expect(domain.Spec.QEMUCmd.QEMUArg).To(ContainElements(
+				api.Arg{Value: "-fw_cfg"},
+				api.Arg{Value: "name=opt/com.example/config,file=" + expectedPath},
+			))
+		},
+			Entry("from a configmap",
+				v1.Volume{Name: "vol-fwcfg", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{}}},
+				filepath.Join(config.GetConfigMapSourcePath("vol-fwcfg"), "config")),
+			Entry("from a secret",
+				v1.Volume{Name: "vol-fwcfg", VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "secret-fwcfg"}}},
+				filepath.Join(config.GetSecretSourcePath("vol-fwcfg"), "config")),
+		)
+
+		It("should escape the commas of the fw_cfg entries", func() {
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes,
+				v1.Volume{Name: "vol-fwcfg", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{}}})
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				FWCfg: []v1.FWCfgEntry{{Name: "opt/a,file=/etc/shadow", VolumeNameRef: "vol-fwcfg", Key: "config"}},
+			}
+			c = &ConverterContext{
+				Architecture:   archconverter.NewConverter(runtime.GOARCH),
+				VirtualMachine: vmi,
+				AllowEmulation: true,
+			}
+
+			domain := &api.Domain{}
+			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, domain, c)).To(Succeed())
+			Expect(domain.Spec.QEMUCmd.QEMUArg).To(ContainElement(
+				api.Arg{Value: "name=opt/a,,file=/etc/shadow,file=" + filepath.Join(config.GetConfigMapSourcePath("vol-fwcfg"), "config")},
+			))
+		})
+
+		It("should fail when the fw_cfg volume is not found", func() {
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				FWCfg: []v1.FWCfgEntry{{Name: "opt/com.example/config", VolumeNameRef: "vol-fwcfg", Key: "config"}},
+			}
+			c = &ConverterContext{
+				Architecture:   archconverter.NewConverter(runtime.GOARCH),
+				VirtualMachine: vmi,
+				AllowEmulation: true,
+			}
+
+			err := Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &api.Domain{}, c)
+			Expect(err).To(MatchError(ContainSubstring("volume of fw_cfg entry opt/com.example/config was not found")))
+		})
 	})
 
 	Context("Kernel Boot", func() {
@@ -3221,6 +4004,69 @@ var _ = Describe("Converter", func() {
 				Expect(domain.Spec.Devices.Controllers).To(HaveLen(2))
 			})
 
+			It("should add an nvme controller only for nvme disks", func() {
+				hasNVMeController := func(domain *api.Domain) bool {
+					for _, controller := range domain.Spec.Devices.Controllers {
+						if controller.Type == "nvme" {
+							return true
+						}
+					}
+					return false
+				}
+				Expect(hasNVMeController(vmiToDomain(vmi, c))).To(BeFalse())
+
+				vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+					Name:       "nvme-disk",
+					DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusNVMe}},
+				})
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+					Name:         "nvme-disk",
+					VolumeSource: v1.VolumeSource{EmptyDisk: &v1.EmptyDiskSource{Capacity: resource.MustParse("1Gi")}},
+				})
+				Expect(hasNVMeController(vmiToDomain(vmi, c))).To(BeTrue())
+			})
+
+			It("should add the requested number of virtio-scsi controllers", func() {
+				vmi.Spec.Domain.Devices.SCSIControllerCount = pointer.P(uint32(3))
+				domain := vmiToDomain(vmi, c)
+				var scsiControllerIndexes []string
+				for _, controller := range domain.Spec.Devices.Controllers {
+					if controller.Type == "scsi" {
+						scsiControllerIndexes = append(scsiControllerIndexes, controller.Index)
+					}
+				}
+				Expect(scsiControllerIndexes).To(Equal([]string{"0", "1", "2"}))
+			})
+
+			It("should set the requested queues on the virtio-scsi controllers", func() {
+				vmi.Spec.Domain.Devices.SCSIControllerCount = pointer.P(uint32(2))
+				vmi.Spec.Domain.Devices.SCSIControllerQueues = pointer.P(uint32(8))
+				domain := vmiToDomain(vmi, c)
+				var scsiControllerQueues []*uint
+				for _, controller := range domain.Spec.Devices.Controllers {
+					if controller.Type == "scsi" {
+						Expect(controller.Driver).ToNot(BeNil())
+						scsiControllerQueues = append(scsiControllerQueues, controller.Driver.Queues)
+					}
+				}
+				Expect(scsiControllerQueues).To(Equal([]*uint{pointer.P(uint(8)), pointer.P(uint(8))}))
+			})
+
+			It("should keep the requested virtio-scsi controller queues with a dedicated IOThread", func() {
+				vmi := libvmi.New(
+					libvmi.WithPersistentVolumeClaim("disk0", "pvc0"),
+				)
+				vmi.Spec.Domain.Devices.Disks[0].Disk.Bus = v1.DiskBusSCSI
+				vmi.Spec.Domain.Devices.Disks[0].DedicatedIOThread = pointer.P(true)
+				domain := &api.Domain{}
+				domain.Spec.Devices.Disks = []api.Disk{{Target: api.DiskTarget{Bus: v1.DiskBusSCSI}, Driver: &api.DiskDriver{}}}
+				domain.Spec.Devices.Controllers = []api.Controller{{Type: "scsi", Index: "0", Driver: &api.ControllerDriver{Queues: pointer.P(uint(8))}}}
+
+				setIOThreads(vmi, domain, 2)
+				Expect(domain.Spec.Devices.Controllers[0].Driver.IOThread).ToNot(BeNil())
+				Expect(domain.Spec.Devices.Controllers[0].Driver.Queues).To(Equal(pointer.P(uint(8))))
+			})
+
 			DescribeTable("should convert",
 				func(converterFunc ConverterFunc, volumeName string, isBlockMode bool, ignoreDiscard bool) {
 					expectedDisk := &api.Disk{}
@@ -3251,6 +4097,133 @@ var _ = Describe("Converter", func() {
 				Entry("block mode DV", Convert_v1_Hotplug_DataVolume_To_api_Disk, "test-block-dv", true, false),
 				Entry("'discard ignore' DV", Convert_v1_Hotplug_DataVolume_To_api_Disk, "test-discard-ignore", false, true),
 			)
+
+			DescribeTable("should point a LUN reservation to the hotplug pr-helper socket",
+				func(converterFunc func(name string, disk *api.Disk, c *ConverterContext) error, volumeName, socketPath, expectedPath string) {
+					c.PrHelperSocketPath = socketPath
+					disk := &api.Disk{Device: "lun", Driver: &api.DiskDriver{}}
+					setReservation(disk, socketPath)
+
+					Expect(converterFunc(volumeName, disk, c)).To(Succeed())
+					Expect(disk.Source.Reservations).To(Equal(&api.Reservations{
+						Managed: "no",
+						SourceReservations: &api.SourceReservations{
+							Type: "unix",
+							Path: expectedPath,
+							Mode: "client",
+						},
+					}))
+				},
+				Entry("block mode PVC", Convert_v1_Hotplug_PersistentVolumeClaim_To_api_Disk, "test-block-pvc", "",
+					"/var/run/kubevirt/hotplug-disks/.pr-helper/pr-helper.sock"),
+				Entry("block mode DV", Convert_v1_Hotplug_DataVolume_To_api_Disk, "test-block-dv", "",
+					"/var/run/kubevirt/hotplug-disks/.pr-helper/pr-helper.sock"),
+				Entry("block mode PVC with a configured socket path", Convert_v1_Hotplug_PersistentVolumeClaim_To_api_Disk, "test-block-pvc", "/run/pr/multipath-pr.sock",
+					"/var/run/kubevirt/hotplug-disks/.pr-helper/multipath-pr.sock"),
+			)
+
+			Context("serial", func() {
+				addHotplugDisk := func(name string, diskDevice v1.DiskDevice, serial string) {
+					vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+						Name:       name,
+						DiskDevice: diskDevice,
+						Serial:     serial,
+					})
+					vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+						Name: name,
+						VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: name},
+						}},
+					})
+					if c.HotplugVolumes == nil {
+						c.HotplugVolumes = map[string]v1.VolumeStatus{}
+					}
+					c.HotplugVolumes[name] = v1.VolumeStatus{Name: name, Phase: v1.VolumeReady}
+				}
+
+				serialOf := func(domain *api.Domain, name string) string {
+					for _, disk := range domain.Spec.Devices.Disks {
+						if disk.Alias.GetName() == name {
+							return disk.Serial
+						}
+					}
+					Fail("disk " + name + " not found")
+					return ""
+				}
+
+				BeforeEach(func() {
+					vmi.UID = "f4686d2c-6e8d-4335-b8fd-81bee22f4814"
+				})
+
+				It("should be generated from the VMI UID and volume name", func() {
+					addHotplugDisk("hp1", v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSCSI}}, "")
+					addHotplugDisk("hp2", v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}, "")
+
+					domain := vmiToDomain(vmi, c)
+					serial := serialOf(domain, "hp1")
+					Expect(serial).To(HaveLen(20))
+					Expect(serial).To(Equal(hotplugDiskSerial(vmi.UID, "hp1")))
+					Expect(serialOf(domain, "hp2")).ToNot(Equal(serial))
+
+					By("converting again")
+					Expect(serialOf(vmiToDomain(vmi, c), "hp1")).To(Equal(serial))
+				})
+
+				It("should keep a user provided serial", func() {
+					addHotplugDisk("hp1", v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSCSI}}, "myserial")
+					Expect(serialOf(vmiToDomain(vmi, c), "hp1")).To(Equal("myserial"))
+				})
+
+				It("should not be generated for LUNs", func() {
+					addHotplugDisk("hp1", v1.DiskDevice{LUN: &v1.LunTarget{Bus: v1.DiskBusSCSI}}, "")
+					Expect(serialOf(vmiToDomain(vmi, c), "hp1")).To(BeEmpty())
+				})
+			})
+
+			Context("CD-ROM boot order", func() {
+				const cdromName = "cdrom1"
+
+				diskByAlias := func(domain *api.Domain, name string) *api.Disk {
+					for i, disk := range domain.Spec.Devices.Disks {
+						if disk.Alias.GetName() == name {
+							return &domain.Spec.Devices.Disks[i]
+						}
+					}
+					return nil
+				}
+
+				BeforeEach(func() {
+					vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+						Name:       cdromName,
+						DiskDevice: v1.DiskDevice{CDRom: &v1.CDRomTarget{Bus: v1.DiskBusSATA}},
+						BootOrder:  pointer.P(uint(1)),
+					})
+				})
+
+				It("should be kept on an empty drive", func() {
+					disk := diskByAlias(vmiToDomain(vmi, c), cdromName)
+					Expect(disk).ToNot(BeNil())
+					Expect(disk.Source).To(Equal(api.DiskSource{}))
+					Expect(disk.BootOrder).To(Equal(&api.BootOrder{Order: 1}))
+				})
+
+				It("should be kept on a drive whose media is not plugged yet", func() {
+					vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+						Name: cdromName,
+						VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "iso"},
+							Hotpluggable:                      true,
+						}},
+					})
+					c.HotplugVolumes = map[string]v1.VolumeStatus{cdromName: {Name: cdromName, Phase: v1.HotplugVolumeAttachedToNode}}
+					c.PermanentVolumes = map[string]v1.VolumeStatus{"myvolume": {Name: "myvolume"}}
+
+					disk := diskByAlias(vmiToDomain(vmi, c), cdromName)
+					Expect(disk).ToNot(BeNil())
+					Expect(disk.Source).To(Equal(api.DiskSource{}))
+					Expect(disk.BootOrder).To(Equal(&api.BootOrder{Order: 1}))
+				})
+			})
 		})
 
 		Context("memory", func() {
@@ -3324,6 +4297,28 @@ var _ = Describe("Converter", func() {
 				Expect(domain.Spec.Memory).ToNot(BeNil())
 				Expect(domain.Spec.Memory.Unit).To(Equal("b"))
 				Expect(domain.Spec.Memory.Value).To(Equal(uint64(guestMemory.Value())))
+				Expect(domain.Spec.Devices.Memory).To(BeNil())
+			})
+
+			It("should create the virtio-mem device at boot when virtio-mem hotplug is set", func() {
+				blockSize := resource.MustParse("4Mi")
+				vmi.Spec.Domain.Memory.Hotplug = &v1.MemoryHotplug{
+					VirtioMem: &v1.VirtioMemHotplug{BlockSize: &blockSize},
+				}
+
+				Expect(setupDomainMemory(vmi, domain)).To(Succeed())
+
+				Expect(domain.Spec.MaxMemory).To(Equal(&api.MaxMemory{Unit: "b", Value: uint64(maxGuestMemory.Value())}))
+				Expect(domain.Spec.Memory).To(Equal(api.Memory{Unit: "b", Value: uint64(maxGuestMemory.Value())}))
+				Expect(domain.Spec.Devices.Memory).To(Equal(&api.MemoryDevice{
+					Model: "virtio-mem",
+					Target: &api.MemoryTarget{
+						Size:      api.Memory{Unit: "b", Value: uint64(maxGuestMemory.Value() - guestMemory.Value())},
+						Node:      "0",
+						Block:     api.Memory{Unit: "b", Value: uint64(blockSize.Value())},
+						Requested: api.Memory{Unit: "b", Value: 0},
+					},
+				}))
 			})
 
 			DescribeTable("should correctly convert memory configuration from VMI spec to domain",
@@ -3365,6 +4360,65 @@ var _ = Describe("Converter", func() {
 				),
 			)
 		})
+
+		Context("with a manual guest NUMA topology", func() {
+			newManualNUMAVMI := func(opts ...libvmi.Option) *v1.VirtualMachineInstance {
+				opts = append([]libvmi.Option{
+					libvmi.WithCPUCount(2, 1, 2),
+					libvmi.WithGuestMemory("2Gi"),
+					libvmi.WithNUMANode("0-1", "1536Mi", v1.NUMADistance{Node: 1, Value: 21}),
+					libvmi.WithNUMANode("2-3", "512Mi"),
+				}, opts...)
+				vmi := libvmi.New(opts...)
+				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+				return vmi
+			}
+
+			expectedCells := []api.NUMACell{
+				{
+					ID: "0", CPUs: "0-1", Memory: 1536 * 1024 * 1024, Unit: "b",
+					Distances: &api.NUMADistances{Siblings: []api.NUMASibling{{ID: "0", Value: 10}, {ID: "1", Value: 21}}},
+				},
+				{
+					ID: "1", CPUs: "2-3", Memory: 512 * 1024 * 1024, Unit: "b",
+					Distances: &api.NUMADistances{Siblings: []api.NUMASibling{{ID: "0", Value: 21}, {ID: "1", Value: 10}}},
+				},
+			}
+
+			It("should define the guest NUMA cells and their distances", func() {
+				vmi := newManualNUMAVMI()
+				c := &ConverterContext{VirtualMachine: vmi, AllowEmulation: true, Architecture: archconverter.NewConverter(runtime.GOARCH)}
+
+				domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+				Expect(domainSpec.CPU.NUMA).To(Equal(&api.NUMA{Cells: expectedCells}))
+			})
+
+			It("should keep the guest NUMA cells when memfd is used", func() {
+				vmi := newManualNUMAVMI(libvmi.WithHugepages("2Mi"))
+				c := &ConverterContext{VirtualMachine: vmi, AllowEmulation: true, Architecture: archconverter.NewConverter(runtime.GOARCH)}
+
+				domain := vmiToDomain(vmi, c)
+				Expect(domain.Spec.MemoryBacking.Source).To(Equal(&api.MemoryBackingSource{Type: "memfd"}))
+				Expect(domain.Spec.CPU.NUMA).To(Equal(&api.NUMA{Cells: expectedCells}))
+			})
+
+			It("should leave the distances to libvirt when none is set", func() {
+				vmi := libvmi.New(
+					libvmi.WithCPUCount(2, 1, 2),
+					libvmi.WithGuestMemory("2Gi"),
+					libvmi.WithNUMANode("0-1", "1536Mi"),
+					libvmi.WithNUMANode("2-3", "512Mi"),
+				)
+				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+				c := &ConverterContext{VirtualMachine: vmi, AllowEmulation: true, Architecture: archconverter.NewConverter(runtime.GOARCH)}
+
+				domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+				Expect(domainSpec.CPU.NUMA.Cells).To(HaveLen(2))
+				for _, cell := range domainSpec.CPU.NUMA.Cells {
+					Expect(cell.Distances).To(BeNil())
+				}
+			})
+		})
 	})
 
 	Context("with AMD SEV LaunchSecurity", func() {
@@ -3829,6 +4883,30 @@ var _ = Describe("disk device naming", func() {
 		Expect(res).To(Equal("sdyz"))
 	})
 
+	It("parseDeviceNameIndex should revert FormatDeviceName", func() {
+		for i := 0; i < 26*26*26; i++ {
+			index, ok := parseDeviceNameIndex("vd", FormatDeviceName("vd", i))
+			Expect(ok).To(BeTrue())
+			Expect(index).To(Equal(i))
+		}
+		for _, name := range []string{"vd", "sda", "vdA", "vdaaaa"} {
+			_, ok := parseDeviceNameIndex("vd", name)
+			Expect(ok).To(BeFalse(), name)
+		}
+	})
+
+	It("parseDeviceNameIndex should revert the nvme namespace names", func() {
+		for i := 0; i < 100; i++ {
+			index, ok := parseDeviceNameIndex(nvmeDevicePrefix, formatDeviceName(nvmeDevicePrefix, i))
+			Expect(ok).To(BeTrue())
+			Expect(index).To(Equal(i))
+		}
+		for _, name := range []string{"nvme0n", "nvme0n0", "nvme0na", "nvme1n1"} {
+			_, ok := parseDeviceNameIndex(nvmeDevicePrefix, name)
+			Expect(ok).To(BeFalse(), name)
+		}
+	})
+
 	It("makeDeviceName should generate proper name", func() {
 		prefixMap := make(map[string]deviceNamer)
 		res, index := makeDeviceName("test1", v1.VirtIO, prefixMap)
@@ -4066,6 +5144,22 @@ var _ = Describe("Defaults", func() {
 		Expect(vmi.Spec.Domain.Devices.Watchdog).To(BeNil())
 	})
 
+	It("should set the default watchdog and the default watchdog action for arm64", func() {
+		vmi := &v1.VirtualMachineInstance{
+			Spec: v1.VirtualMachineInstanceSpec{
+				Domain: v1.DomainSpec{
+					Devices: v1.Devices{
+						Watchdog: &v1.Watchdog{},
+					},
+				},
+			},
+		}
+
+		defaults.SetArm64Defaults(&vmi.Spec)
+		Expect(vmi.Spec.Domain.Devices.Watchdog.I6300ESB).ToNot(BeNil())
+		Expect(vmi.Spec.Domain.Devices.Watchdog.I6300ESB.Action).To(Equal(v1.WatchdogActionReset))
+	})
+
 	It("should set the default watchdog and the default watchdog action for s390x", func() {
 		vmi := &v1.VirtualMachineInstance{
 			Spec: v1.VirtualMachineInstanceSpec{
@@ -0,0 +1,194 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package golden renders the canonical libvirt domain of a VirtualMachineInstance
+// for any supported architecture, without a node or a running virt-launcher.
+// It is meant for documentation, support tooling and dry-runs, and the rendered
+// domain is not guaranteed to match a specific node byte for byte: node specific
+// inputs like the CPU set or the host devices are left out.
+package golden
+
+import (
+	"encoding/xml"
+	"fmt"
+	"path/filepath"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/defaults"
+	ephemeraldisk "kubevirt.io/kubevirt/pkg/ephemeral-disk"
+	"kubevirt.io/kubevirt/pkg/network/domainspec"
+	"kubevirt.io/kubevirt/pkg/os/disk"
+	"kubevirt.io/kubevirt/pkg/util/firmware"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/arch"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/efi"
+)
+
+const (
+	amd64 = "amd64"
+	arm64 = "arm64"
+	s390x = "s390x"
+
+	// Paths used by virt-launcher with its default flags
+	ovmfPath         = "/usr/share/OVMF"
+	ephemeralDiskDir = "/var/run/kubevirt-ephemeral-disks/disk-data"
+
+	defaultMemBalloonStatsPeriod = 10
+	defaultContainerDiskFormat   = "qcow2"
+)
+
+// Options tunes the converter context the golden domain is rendered with.
+type Options struct {
+	// Architecture to render the domain for. Defaults to the architecture of the VMI, or amd64.
+	Architecture string
+	// Emulation renders a domain which runs without KVM.
+	Emulation bool
+	// MemBalloonStatsPeriod of the memory balloon, defaults to the KubeVirt default of 10 seconds.
+	MemBalloonStatsPeriod *uint
+	// NetworkBindings are the network binding plugins registered in the KubeVirt CR.
+	NetworkBindings map[string]v1.InterfaceBindingPlugin
+	// DisksInfo of the container disks, indexed by volume name. The images of
+	// container disks without info are assumed to be qcow2.
+	DisksInfo map[string]*disk.DiskInfo
+}
+
+// DomainXML renders the domain of the VMI as indented libvirt XML.
+func DomainXML(vmi *v1.VirtualMachineInstance, options Options) (string, error) {
+	domain, err := Domain(vmi, options)
+	if err != nil {
+		return "", err
+	}
+	data, err := xml.MarshalIndent(domain.Spec, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the domain: %v", err)
+	}
+	return string(data), nil
+}
+
+// Domain applies the defaults virt-api sets on creation to a copy of the VMI,
+// converts it and applies the libvirt defaults, like virt-launcher does before
+// defining the domain.
+func Domain(vmi *v1.VirtualMachineInstance, options Options) (*api.Domain, error) {
+	vmi = vmi.DeepCopy()
+	if options.Architecture != "" {
+		vmi.Spec.Architecture = options.Architecture
+	}
+	if vmi.Spec.Architecture == "" {
+		vmi.Spec.Architecture = amd64
+	}
+	// Keep the rendering stable, like virt-controller does for the VMIs of a VM
+	if vmi.Spec.Domain.Firmware == nil {
+		vmi.Spec.Domain.Firmware = &v1.Firmware{}
+	}
+	if vmi.Spec.Domain.Firmware.UUID == "" {
+		vmi.Spec.Domain.Firmware.UUID = firmware.CalculateLegacyUUID(vmi.Name)
+	}
+	setArchitectureDefaults(vmi)
+
+	c, err := NewConverterContext(vmi, options)
+	if err != nil {
+		return nil, err
+	}
+
+	domain := &api.Domain{}
+	if err := converter.Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, domain, c); err != nil {
+		return nil, fmt.Errorf("conversion failed: %v", err)
+	}
+	api.NewDefaulter(c.Architecture.GetArchitecture()).SetObjectDefaults_Domain(domain)
+	return domain, nil
+}
+
+// NewConverterContext returns the canonical converter context of an already defaulted VMI.
+func NewConverterContext(vmi *v1.VirtualMachineInstance, options Options) (*converter.ConverterContext, error) {
+	architecture := vmi.Spec.Architecture
+	switch architecture {
+	case amd64, arm64, s390x:
+	default:
+		return nil, fmt.Errorf("unsupported architecture %q", architecture)
+	}
+
+	memBalloonStatsPeriod := uint(defaultMemBalloonStatsPeriod)
+	if options.MemBalloonStatsPeriod != nil {
+		memBalloonStatsPeriod = *options.MemBalloonStatsPeriod
+	}
+
+	return &converter.ConverterContext{
+		Architecture:                    arch.NewConverter(architecture),
+		VirtualMachine:                  vmi,
+		AllowEmulation:                  options.Emulation,
+		KvmAvailable:                    !options.Emulation,
+		EFIConfiguration:                efiConfiguration(vmi),
+		MemBalloonStatsPeriod:           memBalloonStatsPeriod,
+		UseVirtioTransitional:           vmi.Spec.Domain.Devices.UseVirtioTransitional != nil && *vmi.Spec.Domain.Devices.UseVirtioTransitional,
+		VirtioTransitionalDevices:       vmi.Spec.Domain.Devices.VirtioTransitionalDevices,
+		EphemeraldiskCreator:            ephemeraldisk.NewEphemeralDiskCreator(ephemeralDiskDir),
+		DisksInfo:                       containerDisksInfo(vmi, options.DisksInfo),
+		FreePageReporting:               true,
+		DomainAttachmentByInterfaceName: domainspec.DomainAttachmentByInterfaceName(vmi.Spec.Domain.Devices.Interfaces, options.NetworkBindings),
+	}, nil
+}
+
+func setArchitectureDefaults(vmi *v1.VirtualMachineInstance) {
+	v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+	switch vmi.Spec.Architecture {
+	case arm64:
+		defaults.SetArm64Defaults(&vmi.Spec)
+	case s390x:
+		defaults.SetS390xDefaults(&vmi.Spec)
+	default:
+		defaults.SetAmd64Defaults(&vmi.Spec)
+	}
+}
+
+func containerDisksInfo(vmi *v1.VirtualMachineInstance, disksInfo map[string]*disk.DiskInfo) map[string]*disk.DiskInfo {
+	info := map[string]*disk.DiskInfo{}
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.ContainerDisk == nil {
+			continue
+		}
+		if disksInfo[volume.Name] != nil {
+			info[volume.Name] = disksInfo[volume.Name]
+		} else {
+			info[volume.Name] = &disk.DiskInfo{Format: defaultContainerDiskFormat}
+		}
+	}
+	return info
+}
+
+func efiConfiguration(vmi *v1.VirtualMachineInstance) *converter.EFIConfiguration {
+	if !vmi.IsBootloaderEFI() {
+		return nil
+	}
+	secureBoot := vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot == nil || *vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot
+
+	code, vars := efi.EFICode, efi.EFIVars
+	switch {
+	case vmi.Spec.Architecture == arm64:
+		code, vars = efi.EFICodeAARCH64, efi.EFIVarsAARCH64
+	case secureBoot:
+		code, vars = efi.EFICodeSecureBoot, efi.EFIVarsSecureBoot
+	}
+	return &converter.EFIConfiguration{
+		EFICode:      filepath.Join(ovmfPath, code),
+		EFIVars:      filepath.Join(ovmfPath, vars),
+		SecureLoader: secureBoot,
+	}
+}
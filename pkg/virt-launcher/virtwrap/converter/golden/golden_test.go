@@ -0,0 +1,105 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package golden_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/golden"
+)
+
+// Set to true to regenerate the golden domains after an intended change of the converter
+const updateEnvVar = "UPDATE_GOLDEN_DOMAIN_DATA"
+
+func canonicalVMI() *v1.VirtualMachineInstance {
+	return libvmi.New(
+		libvmi.WithName("testvmi"),
+		libvmi.WithNamespace("mynamespace"),
+		libvmi.WithUID("e4686d2c-6e8d-4335-b8fd-81bee22f4814"),
+		libvmi.WithMemoryRequest("1Gi"),
+		libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+		libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		libvmi.WithContainerDisk("disk0", "quay.io/containerdisks/fedora:latest"),
+		libvmi.WithRng(),
+	)
+}
+
+var _ = Describe("Golden domain", func() {
+	DescribeTable("should render the canonical domain", func(architecture string) {
+		domainXML, err := golden.DomainXML(canonicalVMI(), golden.Options{Architecture: architecture})
+		Expect(err).ToNot(HaveOccurred())
+
+		goldenFile := filepath.Join("testdata", fmt.Sprintf("domain_%s.xml", architecture))
+		if os.Getenv(updateEnvVar) == "true" {
+			Expect(os.WriteFile(goldenFile, []byte(domainXML+"\n"), 0o644)).To(Succeed())
+		}
+		expected, err := os.ReadFile(goldenFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(domainXML+"\n").To(Equal(string(expected)),
+			"if the change is intended, re-run with %s=true to update the golden domains", updateEnvVar)
+	},
+		Entry("for amd64", "amd64"),
+		Entry("for arm64", "arm64"),
+		Entry("for s390x", "s390x"),
+	)
+
+	It("should not modify the VMI", func() {
+		vmi := canonicalVMI()
+		_, err := golden.Domain(vmi, golden.Options{Architecture: "s390x"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vmi).To(Equal(canonicalVMI()))
+	})
+
+	It("should use the architecture of the VMI by default", func() {
+		vmi := canonicalVMI()
+		vmi.Spec.Architecture = "arm64"
+		domain, err := golden.Domain(vmi, golden.Options{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(domain.Spec.OS.Type.Arch).To(Equal("aarch64"))
+	})
+
+	It("should render an emulated domain", func() {
+		domain, err := golden.Domain(canonicalVMI(), golden.Options{Emulation: true})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(domain.Spec.Type).To(Equal("qemu"))
+	})
+
+	It("should configure the EFI firmware", func() {
+		vmi := canonicalVMI()
+		vmi.Spec.Domain.Firmware = &v1.Firmware{Bootloader: &v1.Bootloader{EFI: &v1.EFI{SecureBoot: pointer.P(false)}}}
+		domain, err := golden.Domain(vmi, golden.Options{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(domain.Spec.OS.BootLoader.Path).To(Equal("/usr/share/OVMF/OVMF_CODE.fd"))
+	})
+
+	It("should reject unsupported architectures", func() {
+		_, err := golden.Domain(canonicalVMI(), golden.Options{Architecture: "ppc64le"})
+		Expect(err).To(MatchError(`unsupported architecture "ppc64le"`))
+	})
+})
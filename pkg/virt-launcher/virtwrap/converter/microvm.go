@@ -0,0 +1,86 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package converter
+
+import (
+	"fmt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// convertForMicroVM adapts the domain to the microvm machine type. The machine has no PCI bus,
+// the virtio devices are therefore attached through virtio-mmio. It boots with the minimal qboot
+// firmware and emulates none of the legacy PC devices, so that the SMBIOS tables, the graphics
+// and the PC machine options are dropped. The devices microvm cannot host are rejected on creation.
+func convertForMicroVM(spec *api.DomainSpec) {
+	for i := range spec.Devices.Disks {
+		if spec.Devices.Disks[i].Target.Bus == v1.DiskBusVirtio {
+			spec.Devices.Disks[i].Address = newMMIOAddress()
+		}
+	}
+	for i := range spec.Devices.Interfaces {
+		spec.Devices.Interfaces[i].Address = newMMIOAddress()
+	}
+	var controllers []api.Controller
+	for _, controller := range spec.Devices.Controllers {
+		switch controller.Type {
+		case "pci":
+			continue
+		case "scsi", "virtio-serial":
+			controller.Address = newMMIOAddress()
+		}
+		controllers = append(controllers, controller)
+	}
+	spec.Devices.Controllers = controllers
+	for i := range spec.Devices.Inputs {
+		if spec.Devices.Inputs[i].Bus == v1.InputBusVirtio {
+			spec.Devices.Inputs[i].Address = newMMIOAddress()
+		}
+	}
+	if spec.Devices.Rng != nil {
+		spec.Devices.Rng.Address = newMMIOAddress()
+	}
+	if spec.Devices.Ballooning != nil && spec.Devices.Ballooning.Model != "none" {
+		spec.Devices.Ballooning.Address = newMMIOAddress()
+	}
+
+	spec.Devices.Video = nil
+	spec.Devices.Graphics = nil
+	spec.OS.SMBios = nil
+	spec.OS.BIOS = nil
+	spec.OS.BootMenu = nil
+	spec.SysInfo = nil
+	if spec.Features != nil {
+		spec.Features.VMPort = nil
+	}
+}
+
+func newMMIOAddress() *api.Address {
+	return &api.Address{Type: api.AddressMMIO}
+}
+
+func validateMicroVMArchitecture(c *ConverterContext) error {
+	if !c.Architecture.SupportMicroVM() {
+		return fmt.Errorf("the microvm machine type is not supported on %s", c.Architecture.GetArchitecture())
+	}
+	return nil
+}
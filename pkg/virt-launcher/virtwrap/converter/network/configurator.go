@@ -33,6 +33,7 @@ import (
 
 type DomainConfigurator struct {
 	domainAttachmentByInterfaceName map[string]string
+	vdpaDevicePathByInterfaceName   map[string]string
 	useLaunchSecuritySEV            bool
 	useLaunchSecurityPV             bool
 }
@@ -57,7 +58,7 @@ func (d DomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *ap
 	})
 	nonAbsentNets := netvmispec.FilterNetworksByInterfaces(vmi.Spec.Networks, nonAbsentIfaces)
 
-	networks := indexNetworksByName(nonAbsentNets)
+	networks := netvmispec.IndexNetworkSpecByName(nonAbsentNets)
 
 	for i, iface := range nonAbsentIfaces {
 		_, isExist := networks[iface.Name]
@@ -65,20 +66,51 @@ func (d DomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *ap
 			return fmt.Errorf("failed to find network %s", iface.Name)
 		}
 
-		if (iface.Binding != nil && d.domainAttachmentByInterfaceName[iface.Name] != string(v1.Tap)) || iface.SRIOV != nil {
+		domainAttachment := d.domainAttachmentByInterfaceName[iface.Name]
+		if (iface.Binding != nil && domainAttachment != string(v1.Tap) && domainAttachment != string(v1.VDPA)) || iface.SRIOV != nil {
 			continue
 		}
 
 		ifaceType := getInterfaceType(&nonAbsentIfaces[i])
 		domainIface := api.Interface{
 			Model: &api.Model{
-				Type: translateModel(vmi.Spec.Domain.Devices.UseVirtioTransitional, ifaceType, vmi.Spec.Architecture),
+				Type: translateModel(&vmi.Spec.Domain.Devices, ifaceType, vmi.Spec.Architecture),
 			},
 			Alias: api.NewUserDefinedAlias(iface.Name),
 		}
 
 		if queueCount := uint(calculateNetworkQueues(vmi, ifaceType)); queueCount != 0 {
 			domainIface.Driver = &api.InterfaceDriver{Name: "vhost", Queues: &queueCount}
+			if queueCount > 1 && isTrue(vmi.Spec.Domain.Devices.NetworkInterfaceRSS) {
+				// vhost can only steer the packets with the eBPF RSS program, which the unprivileged
+				// launcher can't load, QEMU steers them itself.
+				// https://libvirt.org/formatdomain.html#setting-nic-driver-specific-options
+				domainIface.Driver.Name = "qemu"
+				domainIface.Driver.RSS = "on"
+				domainIface.Driver.RSSHashReport = "on"
+			}
+		}
+
+		if (iface.RxQueueSize != 0 || iface.TxQueueSize != 0) && ifaceType == v1.VirtIO {
+			if domainIface.Driver == nil {
+				domainIface.Driver = &api.InterfaceDriver{Name: "vhost"}
+			}
+			domainIface.Driver.RxQueueSize = iface.RxQueueSize
+			domainIface.Driver.TxQueueSize = iface.TxQueueSize
+		}
+
+		if iface.Offloads != nil && ifaceType == v1.VirtIO {
+			if domainIface.Driver == nil {
+				domainIface.Driver = &api.InterfaceDriver{Name: "vhost"}
+			}
+			domainIface.Driver.Host = newDriverHostOffloads(iface.Offloads.Host)
+			domainIface.Driver.Guest = newDriverGuestOffloads(iface.Offloads.Guest)
+		}
+
+		if iface.Coalesce != nil && ifaceType == v1.VirtIO {
+			domainIface.Coalesce = &api.Coalesce{
+				Rx: &api.CoalesceRx{Frames: api.CoalesceFrames{Max: iface.Coalesce.RxMaxFrames}},
+			}
 		}
 
 		// Add a pciAddress if specified
@@ -90,19 +122,43 @@ func (d DomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *ap
 			domainIface.Address = addr
 		}
 
+		if iface.CCWAddress != "" {
+			if !arch.NewConverter(vmi.Spec.Architecture).SupportCCWAddresses() {
+				return fmt.Errorf("setting a ccw address is not supported on %s, for interface %s", vmi.Spec.Architecture, iface.Name)
+			}
+			addr, err := device.NewCCWAddressField(iface.CCWAddress)
+			if err != nil {
+				return fmt.Errorf("failed to configure interface %s: %v", iface.Name, err)
+			}
+			domainIface.Address = addr
+		}
+
 		if iface.ACPIIndex > 0 {
 			domainIface.ACPI = &api.ACPI{Index: uint(iface.ACPIIndex)}
 		}
 
-		if d.domainAttachmentByInterfaceName[iface.Name] == string(v1.Tap) {
+		switch domainAttachment {
+		case string(v1.Tap):
 			// use "ethernet" interface type, since we're using pre-configured tap devices
 			// https://libvirt.org/formatdomain.html#elementsNICSEthernet
 			domainIface.Type = "ethernet"
-			if iface.BootOrder != nil {
-				domainIface.BootOrder = &api.BootOrder{Order: *iface.BootOrder}
-			} else if arch.NewConverter(vmi.Spec.Architecture).IsROMTuningSupported() {
-				domainIface.Rom = &api.Rom{Enabled: "no"}
+			setBootOrderOrDisableROM(&domainIface, iface, vmi.Spec.Architecture)
+		case string(v1.VDPA):
+			devicePath, exists := d.vdpaDevicePathByInterfaceName[iface.Name]
+			if !exists {
+				return fmt.Errorf("failed to find the vhost-vdpa device of interface %s", iface.Name)
+			}
+			// the vDPA device is not plugged by virt-launcher, the vhost-vdpa device is passed to QEMU as is
+			// https://libvirt.org/formatdomain.html#vdpa-devices
+			domainIface.Type = "vdpa"
+			domainIface.Source = api.InterfaceSource{Device: devicePath}
+			// the queues and their interrupts are provided by the vDPA device
+			domainIface.Driver = nil
+			domainIface.Coalesce = nil
+			if iface.MacAddress != "" {
+				domainIface.MAC = &api.MAC{MAC: iface.MacAddress}
 			}
+			setBootOrderOrDisableROM(&domainIface, iface, vmi.Spec.Architecture)
 		}
 
 		if d.useLaunchSecuritySEV || d.useLaunchSecurityPV {
@@ -135,6 +191,12 @@ func WithDomainAttachmentByInterfaceName(domainAttachmentByInterfaceName map[str
 	}
 }
 
+func WithVDPADevicePathByInterfaceName(vdpaDevicePathByInterfaceName map[string]string) option {
+	return func(d *DomainConfigurator) {
+		d.vdpaDevicePathByInterfaceName = vdpaDevicePathByInterfaceName
+	}
+}
+
 func WithUseLaunchSecuritySEV(useLaunchSecuritySEV bool) option {
 	return func(d *DomainConfigurator) {
 		d.useLaunchSecuritySEV = useLaunchSecuritySEV
@@ -147,6 +209,14 @@ func WithUseLaunchSecurityPV(useLaunchSecurityPV bool) option {
 	}
 }
 
+func setBootOrderOrDisableROM(domainIface *api.Interface, iface v1.Interface, architecture string) {
+	if iface.BootOrder != nil {
+		domainIface.BootOrder = &api.BootOrder{Order: *iface.BootOrder}
+	} else if arch.NewConverter(architecture).IsROMTuningSupported() {
+		domainIface.Rom = &api.Rom{Enabled: "no"}
+	}
+}
+
 func getInterfaceType(iface *v1.Interface) string {
 	if iface.Model != "" {
 		return iface.Model
@@ -154,14 +224,6 @@ func getInterfaceType(iface *v1.Interface) string {
 	return v1.VirtIO
 }
 
-func indexNetworksByName(networks []v1.Network) map[string]*v1.Network {
-	netsByName := map[string]*v1.Network{}
-	for _, network := range networks {
-		netsByName[network.Name] = network.DeepCopy()
-	}
-	return netsByName
-}
-
 func calculateNetworkQueues(vmi *v1.VirtualMachineInstance, ifaceType string) uint32 {
 	if ifaceType != v1.VirtIO {
 		return 0
@@ -169,9 +231,10 @@ func calculateNetworkQueues(vmi *v1.VirtualMachineInstance, ifaceType string) ui
 	return NetworkQueuesCapacity(vmi)
 }
 
-func translateModel(useVirtioTransitional *bool, bus string, archString string) string {
+func translateModel(devices *v1.Devices, bus string, archString string) string {
 	if bus == v1.VirtIO {
-		return virtio.InterpretTransitionalModelType(useVirtioTransitional, archString)
+		useVirtioTransitional := virtio.UseTransitionalModel(devices, v1.VirtioDeviceClassInterface)
+		return virtio.InterpretTransitionalModelType(&useVirtioTransitional, archString)
 	}
 	return bus
 }
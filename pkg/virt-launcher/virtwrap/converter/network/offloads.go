@@ -0,0 +1,64 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package network
+
+import (
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// https://libvirt.org/formatdomain.html#setting-nic-driver-specific-options
+func newDriverHostOffloads(offloads *v1.InterfaceHostOffloads) *api.InterfaceDriverHost {
+	if offloads == nil {
+		return nil
+	}
+	return &api.InterfaceDriverHost{
+		CSum:     offloadState(offloads.Checksum),
+		GSO:      offloadState(offloads.GSO),
+		TSO4:     offloadState(offloads.TSO4),
+		TSO6:     offloadState(offloads.TSO6),
+		UFO:      offloadState(offloads.UFO),
+		MrgRxBuf: offloadState(offloads.MergeRxBuffers),
+	}
+}
+
+func newDriverGuestOffloads(offloads *v1.InterfaceGuestOffloads) *api.InterfaceDriverGuest {
+	if offloads == nil {
+		return nil
+	}
+	return &api.InterfaceDriverGuest{
+		CSum: offloadState(offloads.Checksum),
+		TSO4: offloadState(offloads.TSO4),
+		TSO6: offloadState(offloads.TSO6),
+		UFO:  offloadState(offloads.UFO),
+	}
+}
+
+// offloadState leaves an unset offload out of the domain, so it keeps the QEMU default
+func offloadState(toggle *bool) string {
+	if toggle == nil {
+		return ""
+	}
+	if *toggle {
+		return "on"
+	}
+	return "off"
+}
@@ -0,0 +1,80 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package converter
+
+import (
+	"strings"
+
+	"kubevirt.io/kubevirt/pkg/downwardmetrics"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	featuresOEMStringPrefix = "kubevirt.io/features="
+
+	featureBalloon         = "balloon"
+	featureCPUHotplug      = "cpu-hotplug"
+	featureDownwardMetrics = "downward-metrics"
+	featureGuestAgent      = "guest-agent"
+	featureMemoryHotplug   = "memory-hotplug"
+)
+
+// publishFeaturesOEMString appends an SMBIOS OEM string to the domain listing the KubeVirt features
+// enabled on it, e.g. "kubevirt.io/features=balloon,guest-agent". Features are derived from the
+// converted domain and are listed in alphabetical order.
+func publishFeaturesOEMString(spec *api.DomainSpec) {
+	if spec.SysInfo == nil {
+		spec.SysInfo = &api.SysInfo{}
+	}
+	if spec.SysInfo.OEMStrings == nil {
+		spec.SysInfo.OEMStrings = &api.OEMStrings{}
+	}
+	spec.SysInfo.OEMStrings.Entries = append(spec.SysInfo.OEMStrings.Entries,
+		featuresOEMStringPrefix+strings.Join(enabledFeatures(spec), ","))
+}
+
+func enabledFeatures(spec *api.DomainSpec) []string {
+	var features []string
+	if spec.Devices.Ballooning != nil && spec.Devices.Ballooning.Model != "none" {
+		features = append(features, featureBalloon)
+	}
+	if spec.VCPUs != nil {
+		features = append(features, featureCPUHotplug)
+	}
+	if hasChannel(spec, downwardmetrics.DownwardMetricsSerialDeviceName) {
+		features = append(features, featureDownwardMetrics)
+	}
+	if hasChannel(spec, "org.qemu.guest_agent.0") {
+		features = append(features, featureGuestAgent)
+	}
+	if spec.MaxMemory != nil {
+		features = append(features, featureMemoryHotplug)
+	}
+	return features
+}
+
+func hasChannel(spec *api.DomainSpec, name string) bool {
+	for _, channel := range spec.Devices.Channels {
+		if channel.Target != nil && channel.Target.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package converter
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/downwardmetrics"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("features OEM string", func() {
+	It("should list the features enabled on the domain", func() {
+		spec := &api.DomainSpec{
+			SysInfo:   &api.SysInfo{},
+			VCPUs:     &api.VCPUs{},
+			MaxMemory: &api.MaxMemory{},
+			Devices: api.Devices{
+				Ballooning: &api.MemBalloon{Model: "virtio"},
+				Channels: []api.Channel{
+					{Type: "unix", Target: &api.ChannelTarget{Name: "org.qemu.guest_agent.0"}},
+					{Type: "unix", Target: &api.ChannelTarget{Name: downwardmetrics.DownwardMetricsSerialDeviceName}},
+				},
+			},
+		}
+		publishFeaturesOEMString(spec)
+
+		Expect(spec.SysInfo.OEMStrings).To(Equal(&api.OEMStrings{Entries: []string{
+			"kubevirt.io/features=balloon,cpu-hotplug,downward-metrics,guest-agent,memory-hotplug",
+		}}))
+	})
+
+	It("should not list a disabled balloon", func() {
+		spec := &api.DomainSpec{
+			Devices: api.Devices{Ballooning: &api.MemBalloon{Model: "none"}},
+		}
+		publishFeaturesOEMString(spec)
+
+		Expect(spec.SysInfo.OEMStrings.Entries).To(ConsistOf("kubevirt.io/features="))
+	})
+
+	It("should keep the OEM strings set on the VMI", func() {
+		spec := &api.DomainSpec{
+			SysInfo: &api.SysInfo{OEMStrings: &api.OEMStrings{Entries: []string{"role:worker"}}},
+			Devices: api.Devices{
+				Channels: []api.Channel{{Type: "unix", Target: &api.ChannelTarget{Name: "org.qemu.guest_agent.0"}}},
+			},
+		}
+		publishFeaturesOEMString(spec)
+
+		Expect(spec.SysInfo.OEMStrings.Entries).To(Equal([]string{"role:worker", "kubevirt.io/features=guest-agent"}))
+	})
+})
@@ -359,6 +359,72 @@ func isNumaPassthrough(vmi *v12.VirtualMachineInstance) bool {
 	return vmi.Spec.Domain.CPU.NUMA != nil && vmi.Spec.Domain.CPU.NUMA.GuestMappingPassthrough != nil
 }
 
+func IsNumaManual(vmi *v12.VirtualMachineInstance) bool {
+	return vmi.Spec.Domain.CPU != nil && vmi.Spec.Domain.CPU.NUMA != nil && vmi.Spec.Domain.CPU.NUMA.Manual != nil
+}
+
+// ManualNUMATopology converts the guest NUMA nodes defined by the user to domain NUMA cells.
+func ManualNUMATopology(vmi *v12.VirtualMachineInstance) (*api.NUMA, error) {
+	numa := &api.NUMA{}
+	distances := manualNUMADistances(vmi.Spec.Domain.CPU.NUMA.Manual.Nodes)
+	for i, node := range vmi.Spec.Domain.CPU.NUMA.Manual.Nodes {
+		memory, err := QuantityToByte(node.Memory)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert the memory of NUMA node %d: %v", i, err)
+		}
+		cell := api.NUMACell{
+			ID:     strconv.Itoa(i),
+			CPUs:   node.CPUs,
+			Memory: memory.Value,
+			Unit:   memory.Unit,
+		}
+		if distances != nil {
+			cell.Distances = &api.NUMADistances{}
+			for j, distance := range distances[i] {
+				cell.Distances.Siblings = append(cell.Distances.Siblings, api.NUMASibling{
+					ID:    strconv.Itoa(j),
+					Value: distance,
+				})
+			}
+		}
+		numa.Cells = append(numa.Cells, cell)
+	}
+	return numa, nil
+}
+
+// manualNUMADistances returns the complete distance matrix of the guest NUMA nodes, as QEMU requires the
+// distances between all the nodes once one is set. An unset distance is the one set from the other node back
+// to this one, or the default of 10 to the node itself and of 20 to the other nodes.
+// It returns nil when no distance is set, leaving the defaults to libvirt.
+func manualNUMADistances(nodes []v12.NUMANode) [][]uint32 {
+	set := map[[2]int]uint32{}
+	for i, node := range nodes {
+		for _, distance := range node.Distances {
+			set[[2]int{i, int(distance.Node)}] = distance.Value
+		}
+	}
+	if len(set) == 0 {
+		return nil
+	}
+
+	distances := make([][]uint32, len(nodes))
+	for i := range nodes {
+		distances[i] = make([]uint32, len(nodes))
+		for j := range nodes {
+			if value, ok := set[[2]int{i, j}]; ok {
+				distances[i][j] = value
+			} else if value, ok := set[[2]int{j, i}]; ok {
+				distances[i][j] = value
+			} else if i == j {
+				distances[i][j] = 10
+			} else {
+				distances[i][j] = 20
+			}
+		}
+	}
+	return distances
+}
+
 func appendDomainEmulatorThreadPin(domain *api.Domain, cpuSet string) {
 	emulatorThreads := api.CPUEmulatorPin{
 		CPUSet: cpuSet,
@@ -378,7 +444,8 @@ func FormatDomainIOThreadPin(vmi *v12.VirtualMachineInstance, domain *api.Domain
 	vcpus := int(CalculateRequestedVCPUs(domain.Spec.CPU.Topology))
 
 	switch {
-	case vmi.Spec.Domain.IOThreads != nil && *vmi.Spec.Domain.IOThreads.SupplementalPoolThreadCount > 0:
+	case vmi.Spec.Domain.IOThreads != nil && vmi.Spec.Domain.IOThreads.SupplementalPoolThreadCount != nil &&
+		*vmi.Spec.Domain.IOThreads.SupplementalPoolThreadCount > 0:
 		indexEmulatorThread := 0
 		if emulatorThreadsCPUSet != "" {
 			indexEmulatorThread++
@@ -417,6 +484,52 @@ func FormatDomainIOThreadPin(vmi *v12.VirtualMachineInstance, domain *api.Domain
 			curr = end + 1
 		}
 	}
+	if vmi.Spec.Domain.IOThreads != nil && len(vmi.Spec.Domain.IOThreads.Pinning) > 0 {
+		return applyExplicitIOThreadPin(domain, vmi.Spec.Domain.IOThreads.Pinning, cpuset)
+	}
+	return nil
+}
+
+// applyExplicitIOThreadPin overrides the derived pinning of the iothreads with the one requested on the VMI,
+// after making sure that it only uses CPUs allocated to the VMI
+func applyExplicitIOThreadPin(domain *api.Domain, pinning map[string]string, cpuset []int) error {
+	iothreads := int(domain.Spec.IOThreads.IOThreads)
+	for id := range pinning {
+		if thread, err := strconv.Atoi(id); err != nil || strconv.Itoa(thread) != id || thread < 1 || thread > iothreads {
+			return fmt.Errorf("cannot pin iothread %s, the domain has %d iothreads", id, iothreads)
+		}
+	}
+
+	allocatedCPUs := map[int]struct{}{}
+	for _, cpu := range cpuset {
+		allocatedCPUs[cpu] = struct{}{}
+	}
+	for thread := 1; thread <= iothreads; thread++ {
+		cpus, exists := pinning[strconv.Itoa(thread)]
+		if !exists {
+			continue
+		}
+		pinnedCPUs, err := hardware.ParseCPUSetLine(cpus, 50000)
+		if err != nil {
+			return fmt.Errorf("invalid cpuset %q for iothread %d: %v", cpus, thread, err)
+		}
+		for _, cpu := range pinnedCPUs {
+			if _, allocated := allocatedCPUs[cpu]; !allocated {
+				return fmt.Errorf("cannot pin iothread %d to CPU %d, it is not allocated to the VMI", thread, cpu)
+			}
+		}
+
+		pinned := false
+		for i := range domain.Spec.CPUTune.IOThreadPin {
+			if domain.Spec.CPUTune.IOThreadPin[i].IOThread == uint32(thread) {
+				domain.Spec.CPUTune.IOThreadPin[i].CPUSet = cpus
+				pinned = true
+			}
+		}
+		if !pinned {
+			appendDomainIOThreadPin(domain, uint32(thread), cpus)
+		}
+	}
 	return nil
 }
 
@@ -548,6 +661,35 @@ func cpuToCell(topology *v1.Topology) map[uint32]*v1.Cell {
 	return cpumap
 }
 
+// CheckNUMANodeSetOfPod verifies that the host NUMA nodes of nodeSet are ones the CPUs of the pod run on,
+// since the memory of the pod can't be allocated from the other nodes.
+func CheckNUMANodeSetOfPod(nodeSet string, topology *v1.Topology, podCPUSet []int) error {
+	if topology == nil || len(topology.NumaCells) == 0 {
+		return nil
+	}
+	cpumap := cpuToCell(topology)
+	podNodes := map[int]struct{}{}
+	for _, cpu := range podCPUSet {
+		if cell, exists := cpumap[uint32(cpu)]; exists {
+			podNodes[int(cell.Id)] = struct{}{}
+		}
+	}
+	if len(podNodes) == 0 {
+		return nil
+	}
+
+	nodes, err := hardware.ParseCPUSetLine(nodeSet, 1024)
+	if err != nil {
+		return fmt.Errorf("failed to parse the NUMA node set %s: %v", nodeSet, err)
+	}
+	for _, node := range nodes {
+		if _, exists := podNodes[node]; !exists {
+			return fmt.Errorf("host NUMA node %d of the node set %s is not one the pod CPUs run on", node, nodeSet)
+		}
+	}
+	return nil
+}
+
 func involvedCells(cpumap map[uint32]*v1.Cell, cpuTune *api.CPUTune) (map[uint32][]uint32, error) {
 	numamap := map[uint32][]uint32{}
 	for _, tune := range cpuTune.VCPUPin {
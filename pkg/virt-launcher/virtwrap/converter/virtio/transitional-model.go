@@ -19,9 +19,24 @@
 
 package virtio
 
-import "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/arch"
+import (
+	"slices"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/arch"
+)
 
 func InterpretTransitionalModelType(useVirtioTransitional *bool, archString string) string {
 	vtenabled := useVirtioTransitional != nil && *useVirtioTransitional
 	return arch.NewConverter(archString).TransitionalModelType(vtenabled)
 }
+
+// UseTransitionalModel reports whether the virtio devices of the class fall back to legacy virtio 0.9 support,
+// either because all virtio devices do or because the class is listed in VirtioTransitionalDevices.
+func UseTransitionalModel(devices *v1.Devices, class v1.VirtioDeviceClass) bool {
+	if devices.UseVirtioTransitional != nil && *devices.UseVirtioTransitional {
+		return true
+	}
+	return slices.Contains(devices.VirtioTransitionalDevices, class)
+}
@@ -1,12 +1,18 @@
 package converter
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
 
+const defaultVirtiofsQueueSize = 1024
+
 func convertFileSystems(fileSystems []v1.Filesystem) []api.FilesystemDevice {
 	domainFileSystems := []api.FilesystemDevice{}
 	for _, fs := range fileSystems {
@@ -14,13 +20,18 @@ func convertFileSystems(fileSystems []v1.Filesystem) []api.FilesystemDevice {
 			continue
 		}
 
+		queueSize := uint32(defaultVirtiofsQueueSize)
+		if fs.Virtiofs.QueueSize != nil {
+			queueSize = *fs.Virtiofs.QueueSize
+		}
+
 		domainFileSystems = append(domainFileSystems,
 			api.FilesystemDevice{
 				Type:       "mount",
 				AccessMode: "passthrough",
 				Driver: &api.FilesystemDriver{
 					Type:  "virtiofs",
-					Queue: "1024",
+					Queue: strconv.FormatUint(uint64(queueSize), 10),
 				},
 				Source: &api.FilesystemSource{
 					Socket: virtiofs.VirtioFSSocketPath(fs.Name),
@@ -33,3 +44,9 @@ func convertFileSystems(fileSystems []v1.Filesystem) []api.FilesystemDevice {
 
 	return domainFileSystems
 }
+
+// withVirtiofsRoot appends the kernel arguments mounting the virtiofs filesystem as the root
+// filesystem. The tag of the filesystem is its name.
+func withVirtiofsRoot(kernelArgs, rootFilesystem string) string {
+	return strings.TrimSpace(fmt.Sprintf("%s root=%s rootfstype=virtiofs", kernelArgs, rootFilesystem))
+}
@@ -0,0 +1,73 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package converter
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virtiofs"
+)
+
+var _ = Describe("virtiofs filesystems", func() {
+	It("should connect the filesystem to the virtiofsd socket", func() {
+		filesystems := convertFileSystems([]v1.Filesystem{{Name: "shared", Virtiofs: &v1.FilesystemVirtiofs{}}})
+
+		Expect(filesystems).To(Equal([]api.FilesystemDevice{{
+			Type:       "mount",
+			AccessMode: "passthrough",
+			Driver: &api.FilesystemDriver{
+				Type:  "virtiofs",
+				Queue: "1024",
+			},
+			Source: &api.FilesystemSource{
+				Socket: virtiofs.VirtioFSSocketPath("shared"),
+			},
+			Target: &api.FilesystemTarget{
+				Dir: "shared",
+			},
+		}}))
+	})
+
+	It("should set the queue size of the filesystem", func() {
+		filesystems := convertFileSystems([]v1.Filesystem{{
+			Name:     "shared",
+			Virtiofs: &v1.FilesystemVirtiofs{QueueSize: pointer.P(uint32(256))},
+		}})
+
+		Expect(filesystems).To(HaveLen(1))
+		Expect(filesystems[0].Driver.Queue).To(Equal("256"))
+	})
+
+	It("should skip filesystems which are not virtiofs", func() {
+		Expect(convertFileSystems([]v1.Filesystem{{Name: "shared"}})).To(BeEmpty())
+	})
+
+	DescribeTable("should mount the root filesystem from virtiofs", func(kernelArgs, expected string) {
+		Expect(withVirtiofsRoot(kernelArgs, "rootfs")).To(Equal(expected))
+	},
+		Entry("without kernel arguments", "", "root=rootfs rootfstype=virtiofs"),
+		Entry("with kernel arguments", "console=ttyS0 rw", "console=ttyS0 rw root=rootfs rootfstype=virtiofs"),
+	)
+})
@@ -43,6 +43,12 @@ func NewUSBAddressPool(hostDevices []v1.HostDevice) *hostdevice.AddressPool {
 	return hostdevice.NewAddressPool(v1.USBResourcePrefix, extractResources(hostDevices))
 }
 
+// NewInputAddressPool creates an input device address pool based on the environment variable
+// that describes the resource. The addresses are the paths of the evdev devices.
+func NewInputAddressPool(hostDevices []v1.HostDevice) *hostdevice.AddressPool {
+	return hostdevice.NewAddressPool(v1.InputResourcePrefix, extractResources(hostDevices))
+}
+
 func extractResources(hostDevices []v1.HostDevice) []string {
 	var resourceSet = make(map[string]struct{})
 	for _, hostDevice := range hostDevices {
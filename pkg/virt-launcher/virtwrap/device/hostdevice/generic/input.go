@@ -0,0 +1,59 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package generic
+
+import (
+	v1 "kubevirt.io/api/core/v1"
+
+	drautil "kubevirt.io/kubevirt/pkg/dra"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice"
+)
+
+const inputTypePassthrough = "passthrough"
+
+// CreateInputDevices creates a virtio-input-host device for each host-device backed by
+// a host input (evdev) device. The other host-devices are returned untouched.
+func CreateInputDevices(vmiHostDevices []v1.HostDevice) ([]api.Input, []v1.HostDevice) {
+	return CreateInputDevicesFromPool(vmiHostDevices, NewInputAddressPool(vmiHostDevices))
+}
+
+func CreateInputDevicesFromPool(vmiHostDevices []v1.HostDevice, inputAddressPool hostdevice.AddressPooler) ([]api.Input, []v1.HostDevice) {
+	var inputDevices []api.Input
+	var hostDevices []v1.HostDevice
+	for _, dev := range vmiHostDevices {
+		if drautil.IsHostDeviceDRA(dev) {
+			hostDevices = append(hostDevices, dev)
+			continue
+		}
+		devicePath, err := inputAddressPool.Pop(dev.DeviceName)
+		if err != nil {
+			hostDevices = append(hostDevices, dev)
+			continue
+		}
+		inputDevices = append(inputDevices, api.Input{
+			Type:   inputTypePassthrough,
+			Bus:    v1.InputBusVirtio,
+			Alias:  api.NewUserDefinedAlias(AliasPrefix + dev.Name),
+			Source: &api.InputSource{EvDev: devicePath},
+		})
+	}
+	return inputDevices, hostDevices
+}
@@ -0,0 +1,68 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package generic_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/generic"
+)
+
+var _ = Describe("Generic input devices", func() {
+	const evdevPath = "/dev/input/by-id/usb-keyboard-event-kbd"
+
+	It("creates no input device given no host-devices", func() {
+		inputs, hostDevices := generic.CreateInputDevices(nil)
+		Expect(inputs).To(BeEmpty())
+		Expect(hostDevices).To(BeEmpty())
+	})
+
+	It("creates a passthrough input device for host-devices backed by an evdev device", func() {
+		vmiHostDevices := []v1.HostDevice{
+			{DeviceName: hostdevResource0, Name: hostdevName0},
+			{DeviceName: hostdevResource1, Name: hostdevName1},
+		}
+		inputPool := newAddressPoolStub()
+		inputPool.AddResource(hostdevResource1, evdevPath)
+
+		inputs, hostDevices := generic.CreateInputDevicesFromPool(vmiHostDevices, inputPool)
+		Expect(inputs).To(Equal([]api.Input{{
+			Type:   "passthrough",
+			Bus:    v1.InputBusVirtio,
+			Alias:  api.NewUserDefinedAlias(generic.AliasPrefix + hostdevName1),
+			Source: &api.InputSource{EvDev: evdevPath},
+		}}))
+		Expect(hostDevices).To(Equal([]v1.HostDevice{{DeviceName: hostdevResource0, Name: hostdevName0}}))
+	})
+
+	It("reads the evdev device path from the environment", func() {
+		GinkgoT().Setenv("INPUT_RESOURCE_"+envHostDevResource0, evdevPath)
+		vmiHostDevices := []v1.HostDevice{{DeviceName: hostdevResource0, Name: hostdevName0}}
+
+		inputs, hostDevices := generic.CreateInputDevices(vmiHostDevices)
+		Expect(inputs).To(HaveLen(1))
+		Expect(inputs[0].Source.EvDev).To(Equal(evdevPath))
+		Expect(hostDevices).To(BeEmpty())
+	})
+})
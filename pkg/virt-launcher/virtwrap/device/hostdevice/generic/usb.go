@@ -0,0 +1,92 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package generic
+
+import (
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice"
+)
+
+// GetUSBHostDevicesToAttach returns the USB host-devices requested by the VMI which are not attached to the domain.
+// Only USB devices which have been allocated to the pod can be created, the others are ignored.
+func GetUSBHostDevicesToAttach(vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec) ([]api.HostDevice, error) {
+	vmiHostDevices := vmi.Spec.Domain.Devices.HostDevices
+	return GetUSBHostDevicesToAttachFromPool(vmiHostDevices, domainSpec, NewUSBAddressPool(vmiHostDevices))
+}
+
+func GetUSBHostDevicesToAttachFromPool(vmiHostDevices []v1.HostDevice, domainSpec *api.DomainSpec, usbAddressPool hostdevice.AddressPooler) ([]api.HostDevice, error) {
+	attachedUSBHostDevices := hostdevice.FilterHostDevicesByAlias(domainSpec.Devices.HostDevices, device.USBHostDeviceAliasPrefix)
+
+	attachedAliases := make(map[string]struct{}, len(attachedUSBHostDevices))
+	usedAddresses := make(map[string]struct{}, len(attachedUSBHostDevices))
+	for _, hostDev := range attachedUSBHostDevices {
+		attachedAliases[hostDev.Alias.GetName()] = struct{}{}
+		if address := hostDev.Source.Address; address != nil {
+			usedAddresses[address.Bus+":"+address.Device] = struct{}{}
+		}
+	}
+
+	var hostDevicesMetaData []hostdevice.HostDeviceMetaData
+	for _, metaData := range createHostDevicesMetadata(vmiHostDevices) {
+		if _, attached := attachedAliases[device.USBHostDeviceAliasPrefix+metaData.Name]; !attached {
+			hostDevicesMetaData = append(hostDevicesMetaData, metaData)
+		}
+	}
+
+	pool := hostdevice.NewBestEffortAddressPool(&unusedAddressPool{pool: usbAddressPool, usedAddresses: usedAddresses})
+	return hostdevice.CreateUSBHostDevices(hostDevicesMetaData, pool)
+}
+
+// GetUSBHostDevicesToDetach returns the USB host-devices attached to the domain which are no longer requested by the VMI.
+func GetUSBHostDevicesToDetach(vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec) []api.HostDevice {
+	requestedAliases := make(map[string]struct{}, len(vmi.Spec.Domain.Devices.HostDevices))
+	for _, hostDev := range vmi.Spec.Domain.Devices.HostDevices {
+		requestedAliases[device.USBHostDeviceAliasPrefix+hostDev.Name] = struct{}{}
+	}
+
+	var hostDevicesToDetach []api.HostDevice
+	for _, hostDev := range hostdevice.FilterHostDevicesByAlias(domainSpec.Devices.HostDevices, device.USBHostDeviceAliasPrefix) {
+		if _, requested := requestedAliases[hostDev.Alias.GetName()]; !requested {
+			hostDevicesToDetach = append(hostDevicesToDetach, hostDev)
+		}
+	}
+	return hostDevicesToDetach
+}
+
+// unusedAddressPool skips the addresses which are already in use by the domain.
+type unusedAddressPool struct {
+	pool          hostdevice.AddressPooler
+	usedAddresses map[string]struct{}
+}
+
+func (p *unusedAddressPool) Pop(resource string) (string, error) {
+	for {
+		address, err := p.pool.Pop(resource)
+		if err != nil {
+			return "", err
+		}
+		if _, used := p.usedAddresses[address]; !used {
+			return address, nil
+		}
+	}
+}
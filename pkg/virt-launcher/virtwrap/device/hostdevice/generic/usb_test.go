@@ -0,0 +1,99 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package generic_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/generic"
+)
+
+var _ = Describe("USB HostDevice hotplug", func() {
+	const (
+		usbResource = "kubevirt.io/usb-storage"
+		usbAddress0 = "001:002"
+		usbAddress1 = "001:003"
+	)
+
+	newUSBHostDevice := func(name, bus, deviceNumber string) api.HostDevice {
+		return api.HostDevice{
+			Type:  api.HostDeviceUSB,
+			Mode:  "subsystem",
+			Alias: api.NewUserDefinedAlias(device.USBHostDeviceAliasPrefix + name),
+			Source: api.HostDeviceSource{
+				Address: &api.Address{Bus: bus, Device: deviceNumber},
+			},
+		}
+	}
+
+	Context("attach", func() {
+		It("creates the USB host-devices which are not attached to the domain", func() {
+			vmiHostDevices := []v1.HostDevice{
+				{Name: hostdevName0, DeviceName: usbResource},
+				{Name: hostdevName1, DeviceName: usbResource},
+			}
+			domainSpec := &api.DomainSpec{}
+			domainSpec.Devices.HostDevices = []api.HostDevice{newUSBHostDevice(hostdevName0, "001", "002")}
+			usbPool := newAddressPoolStub()
+			usbPool.AddResource(usbResource, usbAddress0, usbAddress1)
+
+			Expect(generic.GetUSBHostDevicesToAttachFromPool(vmiHostDevices, domainSpec, usbPool)).
+				To(Equal([]api.HostDevice{newUSBHostDevice(hostdevName1, "001", "003")}))
+		})
+
+		It("ignores host-devices which have not been allocated to the pod", func() {
+			vmiHostDevices := []v1.HostDevice{{Name: hostdevName0, DeviceName: usbResource}}
+			usbPool := newAddressPoolStub()
+
+			Expect(generic.GetUSBHostDevicesToAttachFromPool(vmiHostDevices, &api.DomainSpec{}, usbPool)).To(BeEmpty())
+		})
+
+		It("ignores addresses which are in use by the domain", func() {
+			vmiHostDevices := []v1.HostDevice{{Name: hostdevName1, DeviceName: usbResource}}
+			domainSpec := &api.DomainSpec{}
+			domainSpec.Devices.HostDevices = []api.HostDevice{newUSBHostDevice(hostdevName0, "001", "002")}
+			usbPool := newAddressPoolStub()
+			usbPool.AddResource(usbResource, usbAddress0)
+
+			Expect(generic.GetUSBHostDevicesToAttachFromPool(vmiHostDevices, domainSpec, usbPool)).To(BeEmpty())
+		})
+	})
+
+	Context("detach", func() {
+		It("returns the USB host-devices which are no longer requested", func() {
+			vmi := &v1.VirtualMachineInstance{}
+			vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{{Name: hostdevName0, DeviceName: usbResource}}
+			domainSpec := &api.DomainSpec{}
+			domainSpec.Devices.HostDevices = []api.HostDevice{
+				newUSBHostDevice(hostdevName0, "001", "002"),
+				newUSBHostDevice(hostdevName1, "001", "003"),
+				{Alias: api.NewUserDefinedAlias(generic.AliasPrefix + "pci-dev")},
+			}
+
+			Expect(generic.GetUSBHostDevicesToDetach(vmi, domainSpec)).
+				To(Equal([]api.HostDevice{newUSBHostDevice(hostdevName1, "001", "003")}))
+		})
+	})
+})
@@ -170,7 +170,7 @@ func createMDEVHostDevice(hostDeviceData HostDeviceMetaData, mdevUUID string) (*
 	return domainHostDevice, nil
 }
 
-func createUSBHostDevice(device HostDeviceMetaData, usbAddress string) (*api.HostDevice, error) {
+func createUSBHostDevice(hostDeviceData HostDeviceMetaData, usbAddress string) (*api.HostDevice, error) {
 	strs := strings.Split(usbAddress, ":")
 	if len(strs) != 2 {
 		return nil, fmt.Errorf("Bad value: %s", usbAddress)
@@ -180,7 +180,7 @@ func createUSBHostDevice(device HostDeviceMetaData, usbAddress string) (*api.Hos
 	return &api.HostDevice{
 		Type:  api.HostDeviceUSB,
 		Mode:  "subsystem",
-		Alias: api.NewUserDefinedAlias("usb-host-" + device.Name),
+		Alias: api.NewUserDefinedAlias(device.USBHostDeviceAliasPrefix + hostDeviceData.Name),
 		Source: api.HostDeviceSource{
 			Address: &api.Address{
 				Bus:    bus,
@@ -20,6 +20,10 @@
 package device
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
@@ -38,3 +42,90 @@ func NewPciAddressField(address string) (*api.Address, error) {
 		Function: "0x" + dbsfFields[3],
 	}, nil
 }
+
+func NewCCWAddressField(address string) (*api.Address, error) {
+	ccwFields, err := hwutil.ParseCCWAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Address{
+		Type:  api.AddressCCW,
+		CSSID: "0xfe",
+		SSID:  "0x" + ccwFields[0],
+		DevNo: "0x" + strings.ToLower(ccwFields[1]),
+	}, nil
+}
+
+// maxPciSlot is the last slot of a PCI bus
+const maxPciSlot = 0x1f
+
+type pciFunction struct {
+	domain, bus, slot, function uint64
+}
+
+func (f pciFunction) String() string {
+	return fmt.Sprintf("%04x:%02x:%02x.%x", f.domain, f.bus, f.slot, f.function)
+}
+
+func parsePciFunction(address string) (pciFunction, error) {
+	dbsfFields, err := hwutil.ParsePciAddress(address)
+	if err != nil {
+		return pciFunction{}, err
+	}
+	var values [4]uint64
+	for i, field := range dbsfFields {
+		if values[i], err = strconv.ParseUint(field, 16, 64); err != nil {
+			return pciFunction{}, fmt.Errorf("failed to parse pci address %s: %v", address, err)
+		}
+	}
+	return pciFunction{domain: values[0], bus: values[1], slot: values[2], function: values[3]}, nil
+}
+
+// PciAddressAllocator tracks the PCI addresses requested for the devices of a domain,
+// so that conflicting addresses are detected before libvirt rejects the domain definition.
+type PciAddressAllocator struct {
+	owners map[pciFunction]string
+}
+
+func NewPciAddressAllocator() *PciAddressAllocator {
+	return &PciAddressAllocator{owners: map[pciFunction]string{}}
+}
+
+// Reserve reserves the PCI address for the given owner.
+// When the address is already reserved, the owner holding it is returned and the reservation is left unchanged.
+func (a *PciAddressAllocator) Reserve(address, owner string) (string, error) {
+	function, err := parsePciFunction(address)
+	if err != nil {
+		return "", err
+	}
+	if previousOwner, exists := a.owners[function]; exists {
+		return previousOwner, nil
+	}
+	a.owners[function] = owner
+	return "", nil
+}
+
+// NextFreeSlot returns the address of the first function of the next slot on the bus of the given address
+// which has none of its functions reserved, wrapping around the bus, or an empty string when the bus is full.
+// Slot 0 of the root bus is left to the host bridge.
+func (a *PciAddressAllocator) NextFreeSlot(address string) (string, error) {
+	function, err := parsePciFunction(address)
+	if err != nil {
+		return "", err
+	}
+	usedSlots := map[uint64]bool{}
+	for reserved := range a.owners {
+		if reserved.domain == function.domain && reserved.bus == function.bus {
+			usedSlots[reserved.slot] = true
+		}
+	}
+	for i := uint64(1); i <= maxPciSlot; i++ {
+		slot := (function.slot + i) % (maxPciSlot + 1)
+		if usedSlots[slot] || (slot == 0 && function.bus == 0) {
+			continue
+		}
+		return pciFunction{domain: function.domain, bus: function.bus, slot: slot}.String(), nil
+	}
+	return "", nil
+}
@@ -20,6 +20,8 @@
 package device_test
 
 import (
+	"fmt"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -46,3 +48,66 @@ var _ = Describe("PCI Address", func() {
 		Expect(address).To(BeNil())
 	})
 })
+
+var _ = Describe("PCI Address allocator", func() {
+	var allocator *device.PciAddressAllocator
+
+	BeforeEach(func() {
+		allocator = device.NewPciAddressAllocator()
+	})
+
+	It("reserves distinct addresses", func() {
+		Expect(allocator.Reserve("0000:00:05.0", "disk0")).To(BeEmpty())
+		Expect(allocator.Reserve("0000:00:05.1", "disk1")).To(BeEmpty())
+		Expect(allocator.Reserve("0000:01:05.0", "net0")).To(BeEmpty())
+	})
+
+	It("returns the owner of a conflicting address", func() {
+		Expect(allocator.Reserve("0000:00:0a.0", "disk0")).To(BeEmpty())
+		Expect(allocator.Reserve("0000:00:0A.0", "net0")).To(Equal("disk0"))
+	})
+
+	It("fails to reserve an invalid address", func() {
+		_, err := allocator.Reserve("0000:00:0a", "disk0")
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("suggests the next free slot", func(reserved []string, address, expectedAddress string) {
+		for _, address := range reserved {
+			Expect(allocator.Reserve(address, address)).To(BeEmpty())
+		}
+		Expect(allocator.NextFreeSlot(address)).To(Equal(expectedAddress))
+	},
+		Entry("after the slot of the address", []string{"0000:00:05.0"}, "0000:00:05.0", "0000:00:06.0"),
+		Entry("skipping the slots with a reserved function", []string{"0000:00:05.0", "0000:00:06.3"}, "0000:00:05.0", "0000:00:07.0"),
+		Entry("on the bus of the address", []string{"0000:02:05.0", "0000:00:06.0"}, "0000:02:05.0", "0000:02:06.0"),
+		Entry("wrapping around the bus", []string{"0000:00:1f.0"}, "0000:00:1f.0", "0000:00:01.0"),
+		Entry("wrapping around a secondary bus", []string{"0000:03:1f.0"}, "0000:03:1f.0", "0000:03:00.0"),
+	)
+
+	It("does not suggest a slot when the bus is full", func() {
+		for slot := 1; slot <= 0x1f; slot++ {
+			Expect(allocator.Reserve(fmt.Sprintf("0000:00:%02x.0", slot), "dev")).To(BeEmpty())
+		}
+		Expect(allocator.NextFreeSlot("0000:00:05.0")).To(BeEmpty())
+	})
+})
+
+var _ = Describe("CCW Address", func() {
+
+	It("is parsed into a domain CCW Address spec on the virtual channel subsystem", func() {
+		Expect(device.NewCCWAddressField("0.1.00A2")).To(Equal(
+			&api.Address{
+				Type:  api.AddressCCW,
+				CSSID: "0xfe",
+				SSID:  "0x1",
+				DevNo: "0x00a2",
+			}))
+	})
+
+	It("fails to parse an invalid CCW address", func() {
+		address, err := device.NewCCWAddressField("0.0.1")
+		Expect(err).To(HaveOccurred())
+		Expect(address).To(BeNil())
+	})
+})
@@ -27,6 +27,9 @@ import (
 	"kubevirt.io/kubevirt/pkg/util"
 )
 
+// USBHostDeviceAliasPrefix prefixes the domain alias of USB host-devices, it is followed by the VMI host-device name.
+const USBHostDeviceAliasPrefix = "usb-host-"
+
 func USBDevicesFound(vmiHostDevices []v1.HostDevice) bool {
 	for _, device := range vmiHostDevices {
 		env := util.ResourceNameToEnvVar(v1.USBResourcePrefix, device.DeviceName)
@@ -0,0 +1,75 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package efi
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/sys/unix"
+)
+
+// PrepareNVRAM creates the NVRAM of a domain from its template, unless it exists already.
+// The template is cloned when the filesystem supports reflinks, so the NVRAM only takes
+// space once the guest writes its variables. libvirt leaves an existing NVRAM untouched.
+func PrepareNVRAM(template, nvram string) error {
+	if _, err := os.Stat(nvram); err == nil {
+		return nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	src, err := os.Open(template)
+	if err != nil {
+		return fmt.Errorf("failed to open the NVRAM template: %v", err)
+	}
+	defer src.Close()
+
+	if err := os.MkdirAll(filepath.Dir(nvram), 0755); err != nil {
+		return err
+	}
+	// Copy to a temporary file first, an interrupted copy must not be taken for the NVRAM
+	dst, err := os.CreateTemp(filepath.Dir(nvram), filepath.Base(nvram)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(dst.Name())
+
+	if err := cloneOrCopy(dst, src); err != nil {
+		dst.Close()
+		return fmt.Errorf("failed to copy the NVRAM template: %v", err)
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+	return os.Rename(dst.Name(), nvram)
+}
+
+func cloneOrCopy(dst, src *os.File) error {
+	if err := unix.IoctlFileClone(int(dst.Fd()), int(src.Fd())); err == nil {
+		return nil
+	}
+	// The filesystem does not support reflinks, or the files are on different ones
+	_, err := io.Copy(dst, src)
+	return err
+}
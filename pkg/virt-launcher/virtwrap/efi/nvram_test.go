@@ -0,0 +1,57 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package efi
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NVRAM", func() {
+	var template, nvram string
+
+	BeforeEach(func() {
+		dir := GinkgoT().TempDir()
+		template = filepath.Join(dir, EFIVars)
+		nvram = filepath.Join(dir, "nvram", "testvmi_VARS.fd")
+		Expect(os.WriteFile(template, []byte("template"), 0644)).To(Succeed())
+	})
+
+	It("should create the NVRAM from the template", func() {
+		Expect(PrepareNVRAM(template, nvram)).To(Succeed())
+		Expect(os.ReadFile(nvram)).To(BeEquivalentTo("template"))
+		Expect(filepath.Glob(nvram + ".tmp-*")).To(BeEmpty())
+	})
+
+	It("should keep an existing NVRAM", func() {
+		Expect(os.MkdirAll(filepath.Dir(nvram), 0755)).To(Succeed())
+		Expect(os.WriteFile(nvram, []byte("boot entries"), 0600)).To(Succeed())
+		Expect(PrepareNVRAM(template, nvram)).To(Succeed())
+		Expect(os.ReadFile(nvram)).To(BeEquivalentTo("boot entries"))
+	})
+
+	It("should fail without template", func() {
+		Expect(PrepareNVRAM(template+".missing", nvram)).To(MatchError(ContainSubstring("failed to open the NVRAM template")))
+		Expect(nvram).ToNot(BeAnExistingFile())
+	})
+})
@@ -75,6 +75,20 @@ func (mr *MockDomainManagerMockRecorder) CancelVMIMigration(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelVMIMigration", reflect.TypeOf((*MockDomainManager)(nil).CancelVMIMigration), arg0)
 }
 
+// ChangeMedia mocks base method.
+func (m *MockDomainManager) ChangeMedia(arg0 *v1.VirtualMachineInstance, arg1 *v1.ChangeMediaOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeMedia", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeMedia indicates an expected call of ChangeMedia.
+func (mr *MockDomainManagerMockRecorder) ChangeMedia(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeMedia", reflect.TypeOf((*MockDomainManager)(nil).ChangeMedia), arg0, arg1)
+}
+
 // DeleteVMI mocks base method.
 func (m *MockDomainManager) DeleteVMI(arg0 *v1.VirtualMachineInstance) error {
 	m.ctrl.T.Helper()
@@ -474,12 +488,13 @@ func (mr *MockDomainManagerMockRecorder) SoftRebootVMI(arg0 any) *gomock.Call {
 }
 
 // SyncVMI mocks base method.
-func (m *MockDomainManager) SyncVMI(arg0 *v1.VirtualMachineInstance, arg1 bool, arg2 *v10.VirtualMachineOptions) (*api.DomainSpec, error) {
+func (m *MockDomainManager) SyncVMI(arg0 *v1.VirtualMachineInstance, arg1 bool, arg2 *v10.VirtualMachineOptions) (*api.DomainSpec, bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SyncVMI", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*api.DomainSpec)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // SyncVMI indicates an expected call of SyncVMI.
@@ -0,0 +1,73 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"fmt"
+	"slices"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+// syncIOThreads adds iothreads to the running domain until it holds the supplemental pool
+// requested by the VMI. Disks plugged afterwards are spread over the grown pool.
+// The pool can't shrink while running, as every disk is served by all its iothreads.
+func syncIOThreads(dom cli.VirDomain, vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec) error {
+	if vmi.Spec.Domain.IOThreadsPolicy == nil || *vmi.Spec.Domain.IOThreadsPolicy != v1.IOThreadsPolicySupplementalPool ||
+		vmi.Spec.Domain.IOThreads == nil || vmi.Spec.Domain.IOThreads.SupplementalPoolThreadCount == nil {
+		return nil
+	}
+	requestedCount := uint(*vmi.Spec.Domain.IOThreads.SupplementalPoolThreadCount)
+
+	currentIDs := getIOThreadIDs(domainSpec)
+	for id := uint(1); id <= requestedCount; id++ {
+		if slices.Contains(currentIDs, id) {
+			continue
+		}
+		if err := dom.AddIOThread(id, affectDomainLiveAndConfigLibvirtFlags); err != nil {
+			return fmt.Errorf("failed to add iothread %d: %v", id, err)
+		}
+		log.Log.Object(vmi).Infof("Added iothread %d", id)
+	}
+
+	return nil
+}
+
+// getIOThreadIDs returns the ids of the iothreads of the domain, which are numbered
+// from 1 unless iothreads were added or removed.
+func getIOThreadIDs(domainSpec *api.DomainSpec) []uint {
+	var ids []uint
+	if domainSpec.IOThreadIDs != nil {
+		for _, iothread := range domainSpec.IOThreadIDs.IOThread {
+			ids = append(ids, iothread.ID)
+		}
+		return ids
+	}
+	if domainSpec.IOThreads != nil {
+		for id := uint(1); id <= domainSpec.IOThreads.IOThreads; id++ {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,79 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("IOThreads hotplug", func() {
+	var (
+		mockDomain *cli.MockVirDomain
+		domainSpec *api.DomainSpec
+	)
+
+	withSupplementalPool := func(count uint32) libvmi.Option {
+		return func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Domain.IOThreadsPolicy = pointer.P(v1.IOThreadsPolicySupplementalPool)
+			vmi.Spec.Domain.IOThreads = &v1.DiskIOThreads{SupplementalPoolThreadCount: pointer.P(count)}
+		}
+	}
+
+	newDiskServedBy := func(ids ...uint32) api.Disk {
+		iothreads := &api.DiskIOThreads{}
+		for _, id := range ids {
+			iothreads.IOThread = append(iothreads.IOThread, api.DiskIOThread{Id: id})
+		}
+		return api.Disk{Driver: &api.DiskDriver{IOThreads: iothreads}}
+	}
+
+	BeforeEach(func() {
+		mockDomain = cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+		domainSpec = &api.DomainSpec{IOThreads: &api.IOThreads{IOThreads: 2}}
+		domainSpec.Devices.Disks = []api.Disk{newDiskServedBy(1, 2)}
+	})
+
+	It("should add the iothreads missing from the supplemental pool", func() {
+		mockDomain.EXPECT().AddIOThread(uint(3), affectDomainLiveAndConfigLibvirtFlags)
+		mockDomain.EXPECT().AddIOThread(uint(4), affectDomainLiveAndConfigLibvirtFlags)
+
+		Expect(syncIOThreads(mockDomain, libvmi.New(withSupplementalPool(4)), domainSpec)).To(Succeed())
+	})
+
+	It("should keep the iothreads beyond the supplemental pool", func() {
+		Expect(syncIOThreads(mockDomain, libvmi.New(withSupplementalPool(1)), domainSpec)).To(Succeed())
+	})
+
+	It("should not modify the iothreads without the supplementalPool policy", func() {
+		vmi := libvmi.New()
+		vmi.Spec.Domain.IOThreadsPolicy = pointer.P(v1.IOThreadsPolicyShared)
+
+		Expect(syncIOThreads(mockDomain, vmi, &api.DomainSpec{IOThreads: &api.IOThreads{IOThreads: 1}})).To(Succeed())
+	})
+})
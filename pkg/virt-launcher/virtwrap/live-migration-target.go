@@ -159,6 +159,7 @@ func (l *LibvirtDomainManager) prepareMigrationTarget(
 	options *cmdv1.VirtualMachineOptions,
 ) error {
 	logger := log.Log.Object(vmi)
+	l.agentData.SetAllowedCommands(vmi.Spec.GuestAgent)
 	if l.imageVolumeFeatureGateEnabled {
 		err := l.linkImageVolumeFilePaths(vmi)
 		if err != nil {
@@ -32,10 +32,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -71,6 +73,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
 	"kubevirt.io/kubevirt/pkg/network/cache"
 	netsriov "kubevirt.io/kubevirt/pkg/network/deviceinfo"
+	"kubevirt.io/kubevirt/pkg/network/downwardapi"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	osdisk "kubevirt.io/kubevirt/pkg/os/disk"
@@ -90,7 +93,6 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter"
-	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/arch"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/generic"
@@ -127,7 +129,7 @@ type contextStore struct {
 }
 
 type DomainManager interface {
-	SyncVMI(*v1.VirtualMachineInstance, bool, *cmdv1.VirtualMachineOptions) (*api.DomainSpec, error)
+	SyncVMI(*v1.VirtualMachineInstance, bool, *cmdv1.VirtualMachineOptions) (*api.DomainSpec, bool, error)
 	PauseVMI(*v1.VirtualMachineInstance) error
 	UnpauseVMI(*v1.VirtualMachineInstance) error
 	FreezeVMI(*v1.VirtualMachineInstance, int32) error
@@ -162,6 +164,7 @@ type DomainManager interface {
 	UpdateGuestMemory(vmi *v1.VirtualMachineInstance) error
 	GetDomainDirtyRateStats(calculationDuration time.Duration) (*stats.DomainStatsDirtyRate, error)
 	GetScreenshot(vmi *v1.VirtualMachineInstance) (*cmdv1.ScreenshotResponse, error)
+	ChangeMedia(*v1.VirtualMachineInstance, *v1.ChangeMediaOptions) error
 }
 
 type LibvirtDomainManager struct {
@@ -198,6 +201,9 @@ type LibvirtDomainManager struct {
 	cpuSetGetter                  func() ([]int, error)
 	imageVolumeFeatureGateEnabled bool
 	setTimeOnce                   sync.Once
+
+	// implicitly locked by domainModifyLock
+	generationConversions *generationConversions
 }
 
 type pausedVMIs struct {
@@ -339,6 +345,12 @@ func (l *LibvirtDomainManager) UpdateGuestMemory(vmi *v1.VirtualMachineInstance)
 		}
 	}
 
+	if isColdplugValidationEnabled(vmi) {
+		if err := syncPersistentDevices(dom, vmi); err != nil {
+			return fmt.Errorf("%s: %v", errMsgPrefix, err)
+		}
+	}
+
 	log.Log.V(2).Infof("hotplugging guest memory to %v", vmi.Spec.Domain.Memory.Guest.Value())
 	return nil
 }
@@ -548,7 +560,8 @@ func maxSlice(slice []int) int {
 	return max
 }
 
-// HotplugHostDevices attach host-devices to running domain, currently only SRIOV host-devices are supported.
+// HotplugHostDevices attach host-devices to running domain, currently SRIOV host-devices are supported
+// as well as USB host-devices and USB redirection devices, which can also be detached.
 // This operation runs in the background, only one hotplug operation can occur at a time.
 func (l *LibvirtDomainManager) HotplugHostDevices(vmi *v1.VirtualMachineInstance) error {
 	select {
@@ -594,6 +607,10 @@ func (l *LibvirtDomainManager) hotPlugHostDevices(vmi *v1.VirtualMachineInstance
 		return fmt.Errorf("%s: %v", errMsgPrefix, hostdevice.AttachHostDevices(domain, sriovHostDevices))
 	}
 
+	if err := l.hotPlugUSBDevices(domain, vmi, domainSpec); err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
 	return nil
 }
 
@@ -785,6 +802,12 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 	if err != nil {
 		return domain, fmt.Errorf("preparing ephemeral images failed: %v", err)
 	}
+	// clone the EFI NVRAM from its template, libvirt would copy it
+	if nvram := domain.Spec.OS.NVRam; nvram != nil && nvram.Template != "" {
+		if err := efi.PrepareNVRAM(nvram.Template, nvram.NVRam); err != nil {
+			return domain, fmt.Errorf("preparing the EFI NVRAM failed: %v", err)
+		}
+	}
 	// create empty disks if they exist
 	if err := emptydisk.NewEmptyDiskCreator().CreateTemporaryDisks(vmi); err != nil {
 		return domain, fmt.Errorf("creating empty disks failed: %v", err)
@@ -1002,31 +1025,9 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 		}
 	}
 
-	var efiConf *converter.EFIConfiguration
-	if vmi.IsBootloaderEFI() {
-		secureBoot := vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot == nil || *vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot
-		sev := kutil.IsSEVVMI(vmi) && !kutil.IsSEVSNPVMI(vmi)
-		snp := kutil.IsSEVSNPVMI(vmi)
-		tdx := kutil.IsTDXVMI(vmi)
-
-		vmType := efi.None
-		if sev {
-			vmType = efi.SEV
-		} else if snp {
-			vmType = efi.SNP
-		} else if tdx {
-			vmType = efi.TDX
-		}
-		if !l.efiEnvironment.Bootable(secureBoot, vmType) {
-			log.Log.Errorf("EFI OVMF roms missing for booting in EFI mode with SecureBoot=%v, SEV/SEV-ES=%v, SEV-SNP=%v, TDX=%v", secureBoot, sev, snp, tdx)
-			return nil, fmt.Errorf("EFI OVMF roms missing for booting in EFI mode with SecureBoot=%v, SEV/SEV-ES=%v, SEV-SNP=%v, TDX=%v", secureBoot, sev, snp, tdx)
-		}
-
-		efiConf = &converter.EFIConfiguration{
-			EFICode:      l.efiEnvironment.EFICode(secureBoot, vmType),
-			EFIVars:      l.efiEnvironment.EFIVars(secureBoot, vmType),
-			SecureLoader: secureBoot,
-		}
+	conversions, err := l.conversionsForGeneration(vmi)
+	if err != nil {
+		return nil, err
 	}
 
 	// Check KVM device availability
@@ -1042,22 +1043,23 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 
 	// Map the VirtualMachineInstance to the Domain
 	c := &converter.ConverterContext{
-		Architecture:          arch.NewConverter(runtime.GOARCH),
-		VirtualMachine:        vmi,
-		AllowEmulation:        allowEmulation,
-		KvmAvailable:          kvmAvailable,
-		CPUSet:                podCPUSet,
-		IsBlockPVC:            isBlockPVCMap,
-		IsBlockDV:             isBlockDVMap,
-		EFIConfiguration:      efiConf,
-		UseVirtioTransitional: vmi.Spec.Domain.Devices.UseVirtioTransitional != nil && *vmi.Spec.Domain.Devices.UseVirtioTransitional,
-		PermanentVolumes:      permanentVolumes,
-		EphemeraldiskCreator:  l.ephemeralDiskCreator,
-		UseLaunchSecuritySEV:  kutil.IsSEVVMI(vmi), // Return true whenever SEV/ES/SNP is set
-		UseLaunchSecurityTDX:  kutil.IsTDXVMI(vmi),
-		UseLaunchSecurityPV:   kutil.IsSecureExecutionVMI(vmi),
-		FreePageReporting:     isFreePageReportingEnabled(false, vmi),
-		SerialConsoleLog:      isSerialConsoleLogEnabled(false, vmi),
+		Architecture:              conversions.architecture,
+		VirtualMachine:            vmi,
+		AllowEmulation:            allowEmulation,
+		KvmAvailable:              kvmAvailable,
+		CPUSet:                    podCPUSet,
+		IsBlockPVC:                isBlockPVCMap,
+		IsBlockDV:                 isBlockDVMap,
+		EFIConfiguration:          conversions.efiConfiguration,
+		UseVirtioTransitional:     vmi.Spec.Domain.Devices.UseVirtioTransitional != nil && *vmi.Spec.Domain.Devices.UseVirtioTransitional,
+		VirtioTransitionalDevices: vmi.Spec.Domain.Devices.VirtioTransitionalDevices,
+		PermanentVolumes:          permanentVolumes,
+		EphemeraldiskCreator:      l.ephemeralDiskCreator,
+		UseLaunchSecuritySEV:      kutil.IsSEVVMI(vmi), // Return true whenever SEV/ES/SNP is set
+		UseLaunchSecurityTDX:      kutil.IsTDXVMI(vmi),
+		UseLaunchSecurityPV:       kutil.IsSecureExecutionVMI(vmi),
+		FreePageReporting:         isFreePageReportingEnabled(false, vmi),
+		SerialConsoleLog:          isSerialConsoleLogEnabled(false, vmi),
 	}
 
 	if options != nil {
@@ -1068,7 +1070,7 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 		if options.Topology != nil {
 			c.Topology = options.Topology
 		}
-		c.MemBalloonStatsPeriod = uint(options.MemBalloonStatsPeriod)
+		c.MemBalloonStatsPeriod = memBalloonStatsPeriod(uint(options.MemBalloonStatsPeriod), vmi)
 		// Add preallocated and thick-provisioned volumes for which we need to avoid the discard=unmap option
 		c.VolumesDiscardIgnore = options.PreallocatedVolumes
 
@@ -1077,9 +1079,18 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 			c.FreePageReporting = isFreePageReportingEnabled(options.GetClusterConfig().GetFreePageReportingDisabled(), vmi)
 			c.BochsForEFIGuests = options.GetClusterConfig().GetBochsDisplayForEFIGuests()
 			c.SerialConsoleLog = isSerialConsoleLogEnabled(options.GetClusterConfig().GetSerialConsoleLogDisabled(), vmi)
+			c.MachineTypeAliases = options.GetClusterConfig().GetMachineTypeAliases()
+			c.PrHelperSocketPath = options.GetClusterConfig().GetPrHelperSocketPath()
 		}
 
 		c.DomainAttachmentByInterfaceName = options.GetInterfaceDomainAttachment()
+		if slices.Contains(slices.Collect(maps.Values(c.DomainAttachmentByInterfaceName)), string(v1.VDPA)) {
+			vdpaDevicePaths, err := readVDPADevicePaths()
+			if err != nil {
+				return nil, err
+			}
+			c.VDPADevicePathByInterfaceName = vdpaDevicePaths
+		}
 	}
 	c.DisksInfo = l.disksInfo
 
@@ -1092,7 +1103,10 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 		c.HotplugVolumes = hotplugVolumes
 		c.SRIOVDevices = sriovDevices
 
-		genericHostDevices, err := generic.CreateHostDevices(vmi.Spec.Domain.Devices.HostDevices)
+		hostInputDevices, vmiHostDevices := generic.CreateInputDevices(vmi.Spec.Domain.Devices.HostDevices)
+		c.HostInputDevices = hostInputDevices
+
+		genericHostDevices, err := generic.CreateHostDevices(vmiHostDevices)
 		if err != nil {
 			return nil, err
 		}
@@ -1121,9 +1135,17 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 }
 
 func isFreePageReportingEnabled(clusterFreePageReportingDisabled bool, vmi *v1.VirtualMachineInstance) bool {
+	if (vmi.Spec.Domain.Devices.AutoattachMemBalloon != nil && *vmi.Spec.Domain.Devices.AutoattachMemBalloon == false) ||
+		vmi.IsHighPerformanceVMI() {
+		return false
+	}
+
+	// The setting of the vmi overrides the cluster-wide setting and the annotation
+	if balloon := vmi.Spec.Domain.Devices.Balloon; balloon != nil && balloon.FreePageReporting != nil {
+		return *balloon.FreePageReporting
+	}
+
 	if clusterFreePageReportingDisabled ||
-		(vmi.Spec.Domain.Devices.AutoattachMemBalloon != nil && *vmi.Spec.Domain.Devices.AutoattachMemBalloon == false) ||
-		vmi.IsHighPerformanceVMI() ||
 		vmi.GetAnnotations()[v1.FreePageReportingDisabledAnnotation] == "true" {
 		return false
 	}
@@ -1131,11 +1153,29 @@ func isFreePageReportingEnabled(clusterFreePageReportingDisabled bool, vmi *v1.V
 	return true
 }
 
+// memBalloonStatsPeriod returns the period of the balloon statistics of the vmi,
+// which may override the cluster-wide period.
+func memBalloonStatsPeriod(clusterMemBalloonStatsPeriod uint, vmi *v1.VirtualMachineInstance) uint {
+	value, exists := vmi.GetAnnotations()[v1.MemBalloonStatsPeriodAnnotation]
+	if !exists {
+		return clusterMemBalloonStatsPeriod
+	}
+
+	period, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Warningf("Ignoring the invalid %s annotation", v1.MemBalloonStatsPeriodAnnotation)
+		return clusterMemBalloonStatsPeriod
+	}
+	return uint(period)
+}
+
 func isSerialConsoleLogEnabled(clusterSerialConsoleLogDisabled bool, vmi *v1.VirtualMachineInstance) bool {
 	return (vmi.Spec.Domain.Devices.LogSerialConsole != nil && *vmi.Spec.Domain.Devices.LogSerialConsole) || (vmi.Spec.Domain.Devices.LogSerialConsole == nil && !clusterSerialConsoleLogDisabled)
 }
 
-func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmulation bool, options *cmdv1.VirtualMachineOptions) (*api.DomainSpec, error) {
+// SyncVMI brings the domain in line with the VMI. It reports whether a disk attachment or update
+// was deferred because the disk is not ready yet, in which case the sync has to be repeated.
+func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmulation bool, options *cmdv1.VirtualMachineOptions) (*api.DomainSpec, bool, error) {
 	l.domainModifyLock.Lock()
 	defer l.domainModifyLock.Unlock()
 
@@ -1143,30 +1183,33 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 
 	domain := &api.Domain{}
 
+	// The allowed guest agent commands are known once the VMI is synced, before the agent can connect
+	l.agentData.SetAllowedCommands(vmi.Spec.GuestAgent)
+
 	if l.imageVolumeFeatureGateEnabled {
 		err := l.linkImageVolumeFilePaths(vmi)
 		if err != nil {
 			logger.Reason(err).Error("failed link ImageVolumeFilePaths")
-			return nil, err
+			return nil, false, err
 		}
 	}
 
 	c, err := l.generateConverterContext(vmi, allowEmulation, options, false)
 	if err != nil {
 		logger.Reason(err).Error("failed to generate libvirt domain from VMI spec")
-		return nil, err
+		return nil, false, err
 	}
 
 	if cbt.HasCBTStateEnabled(vmi.Status.ChangedBlockTracking) {
 		if err := storage.ApplyChangedBlockTracking(vmi, c); err != nil {
 			logger.Reason(err).Error("failed to apply CBT")
-			return nil, err
+			return nil, false, err
 		}
 	}
 
 	if err := converter.Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, domain, c); err != nil {
 		logger.Error("Conversion failed.")
-		return nil, err
+		return nil, false, err
 	}
 
 	// Set defaults which are not coming from the cluster
@@ -1174,13 +1217,13 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 
 	dom, err := l.lookupOrCreateVirDomain(domain, vmi, options)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	defer dom.Free()
 	domState, _, err := dom.GetState()
 	if err != nil {
 		logger.Reason(err).Error(failedGetDomainState)
-		return nil, err
+		return nil, false, err
 	}
 
 	// TODO Suspend, Pause, ..., for now we only support reaching the running state
@@ -1189,13 +1232,13 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 	switch {
 	case cli.IsDown(domState) && !vmi.IsRunning() && !vmi.IsFinal():
 		if err := l.startDomain(vmi, dom); err != nil {
-			return nil, err
+			return nil, false, err
 		}
 	case cli.IsPaused(domState) && !l.paused.contains(vmi.UID):
 		// TODO: if state change reason indicates a system error, we could try something smarter
 		if err := dom.Resume(); err != nil {
 			logger.Reason(err).Error("unpausing the VirtualMachineInstance failed.")
-			return nil, err
+			return nil, false, err
 		}
 		logger.Info("Domain unpaused.")
 	}
@@ -1203,11 +1246,17 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 	oldSpec, err := util.GetDomainSpecWithFlags(dom, 0)
 	if err != nil {
 		logger.Reason(err).Error("Parsing domain XML failed.")
-		return nil, err
+		return nil, false, err
 	}
 
-	if err := l.syncDisks(domain, oldSpec, dom, vmi); err != nil {
-		return nil, err
+	// iothreads have to exist before the disks they serve are attached
+	if err := syncIOThreads(dom, vmi, oldSpec); err != nil {
+		return nil, false, err
+	}
+
+	incomplete, err := l.syncDisks(domain, oldSpec, dom, vmi)
+	if err != nil {
+		return nil, false, err
 	}
 
 	var domainAttachments map[string]string
@@ -1215,13 +1264,20 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 		domainAttachments = options.GetInterfaceDomainAttachment()
 	}
 	if err := network.Sync(domain, oldSpec, dom, vmi, domainAttachments); err != nil {
-		return nil, err
+		return nil, false, err
+	}
+
+	if isColdplugValidationEnabled(vmi) {
+		if err := syncPersistentDevices(dom, vmi); err != nil {
+			logger.Reason(err).Error("Cold-plug validation of the hot-added devices failed.")
+			return nil, false, err
+		}
 	}
 
 	l.syncGracePeriod(vmi)
 
 	// TODO: check if VirtualMachineInstance Spec and Domain Spec are equal or if we have to sync
-	return oldSpec, nil
+	return oldSpec, incomplete, nil
 }
 
 func (l *LibvirtDomainManager) syncDisks(
@@ -1229,8 +1285,9 @@ func (l *LibvirtDomainManager) syncDisks(
 	spec *api.DomainSpec,
 	dom cli.VirDomain,
 	vmi *v1.VirtualMachineInstance,
-) error {
+) (bool, error) {
 	logger := log.Log.Object(vmi)
+	incomplete := false
 
 	// Look up all the disks to detach
 	for _, detachDisk := range getDetachedDisks(spec.Devices.Disks, domain.Spec.Devices.Disks) {
@@ -1238,40 +1295,41 @@ func (l *LibvirtDomainManager) syncDisks(
 		detachBytes, err := xml.Marshal(detachDisk)
 		if err != nil {
 			logger.Reason(err).Error("marshalling detached disk failed")
-			return err
+			return false, err
 		}
 		err = dom.DetachDeviceFlags(strings.ToLower(string(detachBytes)), affectDeviceLiveAndConfigLibvirtFlags)
 		if err != nil {
 			logger.Reason(err).Error("detaching device")
-			return err
+			return false, err
 		}
 	}
 	// Look up all the disks to attach
 	for _, attachDisk := range getAttachedDisks(spec.Devices.Disks, domain.Spec.Devices.Disks) {
 		allowAttach, err := checkIfDiskReadyToUse(getSourceFile(attachDisk))
 		if err != nil {
-			return err
+			return false, err
 		}
 		if !allowAttach {
+			incomplete = true
 			continue
 		}
 		logger.V(1).Infof("Attaching disk %s, target %s", attachDisk.Alias.GetName(), attachDisk.Target.Device)
 		// set drivers cache mode
 		err = converter.SetDriverCacheMode(&attachDisk, l.directIOChecker)
 		if err != nil {
-			return err
+			return false, err
 		}
 		converter.SetOptimalIOMode(&attachDisk, converter.IsPreAllocated)
 
 		attachBytes, err := xml.Marshal(attachDisk)
 		if err != nil {
 			logger.Reason(err).Error("marshalling attached disk failed")
-			return err
+			return false, err
 		}
 		err = dom.AttachDeviceFlags(strings.ToLower(string(attachBytes)), affectDeviceLiveAndConfigLibvirtFlags)
 		if err != nil {
 			logger.Reason(err).Error("attaching device")
-			return err
+			return false, err
 		}
 	}
 	// Look up all the disks to UPDATE
@@ -1280,9 +1338,10 @@ func (l *LibvirtDomainManager) syncDisks(
 		if sourceFile != "" {
 			allowUpdate, err := checkIfDiskReadyToUse(getSourceFile(updateDisk))
 			if err != nil {
-				return err
+				return false, err
 			}
 			if !allowUpdate {
+				incomplete = true
 				continue
 			}
 		}
@@ -1292,13 +1351,13 @@ func (l *LibvirtDomainManager) syncDisks(
 		updateBytes, err := xml.Marshal(updateDisk)
 		if err != nil {
 			logger.Reason(err).Error("marshalling updated disk failed")
-			return err
+			return false, err
 		}
 
 		err = dom.UpdateDeviceFlags(strings.ToLower(string(updateBytes)), affectDeviceLiveAndConfigLibvirtFlags)
 		if err != nil {
 			logger.Reason(err).Error("updating device")
-			return err
+			return false, err
 		}
 	}
 
@@ -1317,7 +1376,7 @@ func (l *LibvirtDomainManager) syncDisks(
 		}
 	}
 
-	return nil
+	return incomplete, nil
 }
 
 func (l *LibvirtDomainManager) startDomain(
@@ -1795,6 +1854,10 @@ func (l *LibvirtDomainManager) SignalShutdownVMI(vmi *v1.VirtualMachineInstance)
 	}
 
 	if domState == libvirt.DOMAIN_RUNNING || domState == libvirt.DOMAIN_PAUSED {
+		if vmi.Spec.ShutdownLadder != nil {
+			return l.signalShutdownLadderStep(vmi, dom)
+		}
+
 		err = dom.ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_DEFAULT)
 		if err != nil {
 			log.Log.Object(vmi).Reason(err).Error("Signalling graceful shutdown failed.")
@@ -1991,6 +2054,7 @@ func (l *LibvirtDomainManager) getDomainStats() ([]*stats.DomainStats, error) {
 	if l.agentData != nil {
 		for _, ds := range domstats {
 			ds.Load = l.agentData.GetLoad()
+			ds.ClockDrift = l.agentData.GetClockDriftStats()
 		}
 	}
 
@@ -2536,3 +2600,12 @@ func (l *LibvirtDomainManager) BackupVirtualMachine(vmi *v1.VirtualMachineInstan
 		return fmt.Errorf("recieved unknown backup command")
 	}
 }
+
+// readVDPADevicePaths reads the vhost-vdpa devices of the networks from the network-info the CNI reported.
+func readVDPADevicePaths() (map[string]string, error) {
+	networkInfoBytes, err := os.ReadFile(filepath.Join(downwardapi.MountPath, downwardapi.NetworkInfoVolumePath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the vDPA devices network-info: %v", err)
+	}
+	return netsriov.VDPADevicePathsByNetworkName(networkInfoBytes)
+}
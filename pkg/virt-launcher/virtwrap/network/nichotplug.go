@@ -236,10 +236,11 @@ func WithNetworkIfacesResources(vmi *v1.VirtualMachineInstance, domainSpec *api.
 
 func AppendPlaceholderInterfacesToTheDomain(vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec, count int) *api.DomainSpec {
 	domainSpecWithIfacesResource := domainSpec.DeepCopy()
+	useVirtioTransitional := virtio.UseTransitionalModel(&vmi.Spec.Domain.Devices, v1.VirtioDeviceClassInterface)
 	for i := 0; i < count; i++ {
 		domainSpecWithIfacesResource.Devices.Interfaces = append(
 			domainSpecWithIfacesResource.Devices.Interfaces,
-			newInterfacePlaceholder(i, virtio.InterpretTransitionalModelType(vmi.Spec.Domain.Devices.UseVirtioTransitional, vmi.Spec.Architecture)),
+			newInterfacePlaceholder(i, virtio.InterpretTransitionalModelType(&useVirtioTransitional, vmi.Spec.Architecture)),
 		)
 	}
 	return domainSpecWithIfacesResource
@@ -0,0 +1,89 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+// signalShutdownLadderStep signals the step of the shutdown ladder which is due since the first
+// shutdown signal. When the guest cannot be signalled with that step, the following steps are tried.
+// The method which was accepted is recorded in the grace period metadata, the final destroy is
+// left to virt-handler once the grace period expired.
+func (l *LibvirtDomainManager) signalShutdownLadderStep(vmi *v1.VirtualMachineInstance, dom cli.VirDomain) error {
+	now := metav1.Now()
+	var startedAt metav1.Time
+	l.metadataCache.GracePeriod.WithSafeBlock(func(gracePeriodMetadata *api.GracePeriodMetadata, _ bool) {
+		if gracePeriodMetadata.DeletionTimestamp == nil {
+			gracePeriodMetadata.DeletionTimestamp = &now
+		}
+		startedAt = *gracePeriodMetadata.DeletionTimestamp
+	})
+
+	steps := vmi.Spec.ShutdownLadder.Steps
+	var err error
+	for i := shutdownLadderStepIndex(steps, now.Sub(startedAt.Time)); i < len(steps); i++ {
+		method := steps[i].Method
+		if err = dom.ShutdownFlags(shutdownFlagsForMethod(method)); err != nil {
+			log.Log.Object(vmi).Reason(err).Warningf("Signalling %s shutdown failed, escalating to the next step.", method)
+			continue
+		}
+		log.Log.Object(vmi).Infof("Signaled %s shutdown for %s", method, vmi.GetObjectMeta().GetName())
+
+		l.metadataCache.GracePeriod.WithSafeBlock(func(gracePeriodMetadata *api.GracePeriodMetadata, _ bool) {
+			gracePeriodMetadata.ShutdownMethod = string(method)
+		})
+		return nil
+	}
+	return err
+}
+
+// shutdownLadderStepIndex returns the index of the step which is due once elapsed passed since the
+// first shutdown signal. The last step is kept once every step timed out.
+func shutdownLadderStepIndex(steps []v1.ShutdownStep, elapsed time.Duration) int {
+	var deadline time.Duration
+	for i, step := range steps {
+		deadline += time.Duration(step.TimeoutSeconds) * time.Second
+		if elapsed < deadline {
+			return i
+		}
+	}
+	return len(steps) - 1
+}
+
+func shutdownFlagsForMethod(method v1.ShutdownMethod) libvirt.DomainShutdownFlags {
+	switch method {
+	case v1.ShutdownMethodGuestAgent:
+		return libvirt.DOMAIN_SHUTDOWN_GUEST_AGENT
+	case v1.ShutdownMethodACPI:
+		return libvirt.DOMAIN_SHUTDOWN_ACPI_POWER_BTN
+	default:
+		return libvirt.DOMAIN_SHUTDOWN_DEFAULT
+	}
+}
@@ -0,0 +1,107 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("Shutdown ladder", func() {
+	var (
+		mockDomain *cli.MockVirDomain
+		manager    *LibvirtDomainManager
+		vmi        *v1.VirtualMachineInstance
+	)
+
+	startShutdownAgo := func(elapsed time.Duration) {
+		manager.metadataCache.GracePeriod.Set(api.GracePeriodMetadata{
+			DeletionTimestamp: &metav1.Time{Time: time.Now().Add(-elapsed)},
+		})
+	}
+
+	recordedMethod := func() string {
+		gracePeriod, _ := manager.metadataCache.GracePeriod.Load()
+		return gracePeriod.ShutdownMethod
+	}
+
+	BeforeEach(func() {
+		mockDomain = cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+		manager = &LibvirtDomainManager{metadataCache: metadata.NewCache()}
+		vmi = libvmi.New()
+		vmi.Spec.ShutdownLadder = &v1.ShutdownLadder{Steps: []v1.ShutdownStep{
+			{Method: v1.ShutdownMethodGuestAgent, TimeoutSeconds: 30},
+			{Method: v1.ShutdownMethodACPI, TimeoutSeconds: 60},
+		}}
+	})
+
+	It("should start with the first step and record the start of the shutdown", func() {
+		mockDomain.EXPECT().ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_GUEST_AGENT).Return(nil)
+
+		Expect(manager.signalShutdownLadderStep(vmi, mockDomain)).To(Succeed())
+
+		gracePeriod, _ := manager.metadataCache.GracePeriod.Load()
+		Expect(gracePeriod.DeletionTimestamp).ToNot(BeNil())
+		Expect(gracePeriod.ShutdownMethod).To(Equal(string(v1.ShutdownMethodGuestAgent)))
+	})
+
+	It("should escalate once the timeout of a step expired", func() {
+		startShutdownAgo(45 * time.Second)
+		mockDomain.EXPECT().ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_ACPI_POWER_BTN).Return(nil)
+
+		Expect(manager.signalShutdownLadderStep(vmi, mockDomain)).To(Succeed())
+		Expect(recordedMethod()).To(Equal(string(v1.ShutdownMethodACPI)))
+	})
+
+	It("should keep signalling the last step once every step timed out", func() {
+		startShutdownAgo(5 * time.Minute)
+		mockDomain.EXPECT().ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_ACPI_POWER_BTN).Return(nil)
+
+		Expect(manager.signalShutdownLadderStep(vmi, mockDomain)).To(Succeed())
+	})
+
+	It("should escalate immediately when the guest cannot be signalled", func() {
+		mockDomain.EXPECT().ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_GUEST_AGENT).Return(libvirt.Error{Code: libvirt.ERR_AGENT_UNRESPONSIVE})
+		mockDomain.EXPECT().ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_ACPI_POWER_BTN).Return(nil)
+
+		Expect(manager.signalShutdownLadderStep(vmi, mockDomain)).To(Succeed())
+		Expect(recordedMethod()).To(Equal(string(v1.ShutdownMethodACPI)))
+	})
+
+	It("should fail when no step could be signalled", func() {
+		startShutdownAgo(45 * time.Second)
+		mockDomain.EXPECT().ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_ACPI_POWER_BTN).Return(libvirt.Error{Code: libvirt.ERR_OPERATION_FAILED})
+
+		Expect(manager.signalShutdownLadderStep(vmi, mockDomain)).ToNot(Succeed())
+		Expect(recordedMethod()).To(BeEmpty())
+	})
+})
@@ -68,11 +68,19 @@ type DomainStats struct {
 	Block []DomainStatsBlock
 	// omitted from libvirt-go: Perf
 	// extra stats
-	CPUMapSet bool
-	CPUMap    [][]bool
-	NrVirtCpu uint
-	DirtyRate *DomainStatsDirtyRate
-	Load      *DomainStatsLoad
+	CPUMapSet  bool
+	CPUMap     [][]bool
+	NrVirtCpu  uint
+	DirtyRate  *DomainStatsDirtyRate
+	Load       *DomainStatsLoad
+	ClockDrift *DomainStatsClockDrift
+}
+
+type DomainStatsClockDrift struct {
+	DriftSet bool
+	// Drift of the guest clock from the host clock in nanoseconds,
+	// positive when the guest clock is ahead
+	Drift int64
 }
 
 type DomainStatsLoad struct {
@@ -285,7 +285,8 @@ var Testdataexpected = `{
      "MegabytesPerSecondSet": false,
      "MegabytesPerSecond": 0
    },
-   "Load": null
+   "Load": null,
+   "ClockDrift": null
  }`
 
 func LoadStats() ([]libvirt.DomainStats, error) {
@@ -26,6 +26,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"libvirt.org/go/libvirt"
 
@@ -183,7 +184,30 @@ func (m *StorageManager) backup(vmi *v1.VirtualMachineInstance, backupOptions *b
 		}
 	}()
 
-	return dom.BackupBegin(strings.ToLower(string(backupXML)), strings.ToLower(string(checkpointXML)), 0)
+	if err := dom.BackupBegin(strings.ToLower(string(backupXML)), strings.ToLower(string(checkpointXML)), 0); err != nil {
+		return err
+	}
+
+	throttleBackupJob(dom, domainBackup, backupOptions.Bandwidth)
+	return nil
+}
+
+// throttleBackupJob limits the rate at which the backup job reads each disk, so that the guest IO
+// is not starved while the backup runs. The limit belongs to the job and goes away once it completes.
+func throttleBackupJob(dom cli.VirDomain, domainBackup *api.DomainBackup, bandwidth *resource.Quantity) {
+	if bandwidth == nil || bandwidth.Value() <= 0 || domainBackup.BackupDisks == nil {
+		return
+	}
+
+	for _, disk := range domainBackup.BackupDisks.Disks {
+		if disk.Backup != "yes" {
+			continue
+		}
+		if err := dom.BlockJobSetSpeed(disk.Name, uint64(bandwidth.Value()), libvirt.DOMAIN_BLOCK_JOB_SPEED_BANDWIDTH_BYTES); err != nil {
+			// The backup is already running, keep it going unthrottled rather than failing it
+			log.Log.Reason(err).Warningf("Failed to limit the backup bandwidth of disk %s", disk.Name)
+		}
+	}
 }
 
 func generateDomainBackup(disks []api.Disk, backupOptions *backupv1.BackupOptions, backupPath string) (*api.DomainBackup, *api.DomainCheckpoint) {
@@ -30,6 +30,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"libvirt.org/go/libvirt"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	backupv1 "kubevirt.io/api/backup/v1alpha1"
@@ -350,6 +351,44 @@ var _ = Describe("Backup", func() {
 		})
 	})
 
+	Describe("throttleBackupJob", func() {
+		var domainBackup *api.DomainBackup
+
+		BeforeEach(func() {
+			domainBackup = &api.DomainBackup{
+				BackupDisks: &api.BackupDisks{
+					Disks: []api.BackupDisk{
+						{Name: "vda", Backup: "yes"},
+						{Name: "vdb", Backup: "no"},
+						{Name: "vdc", Backup: "yes"},
+					},
+				},
+			}
+		})
+
+		It("should limit the bandwidth of every disk being backed up", func() {
+			mockDomain.EXPECT().BlockJobSetSpeed("vda", uint64(100*1024*1024), libvirt.DOMAIN_BLOCK_JOB_SPEED_BANDWIDTH_BYTES).Return(nil)
+			mockDomain.EXPECT().BlockJobSetSpeed("vdc", uint64(100*1024*1024), libvirt.DOMAIN_BLOCK_JOB_SPEED_BANDWIDTH_BYTES).Return(nil)
+
+			throttleBackupJob(mockDomain, domainBackup, pointer.P(resource.MustParse("100Mi")))
+		})
+
+		It("should keep throttling the other disks when one fails", func() {
+			mockDomain.EXPECT().BlockJobSetSpeed("vda", gomock.Any(), gomock.Any()).Return(fmt.Errorf("no active block job"))
+			mockDomain.EXPECT().BlockJobSetSpeed("vdc", gomock.Any(), gomock.Any()).Return(nil)
+
+			throttleBackupJob(mockDomain, domainBackup, pointer.P(resource.MustParse("100Mi")))
+		})
+
+		DescribeTable("should not limit the bandwidth", func(bandwidth *resource.Quantity) {
+			// No BlockJobSetSpeed call is expected
+			throttleBackupJob(mockDomain, domainBackup, bandwidth)
+		},
+			Entry("when it is not set", nil),
+			Entry("when it is 0", pointer.P(resource.MustParse("0"))),
+		)
+	})
+
 	Describe("HandleBackupJobCompletedEvent", func() {
 		var (
 			mockDomain *cli.MockVirDomain
@@ -21,9 +21,11 @@ package storage
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"syscall"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"libvirt.org/go/libvirt"
@@ -31,7 +33,9 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
 	api "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
 )
 
 func (m *StorageManager) MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error {
@@ -71,7 +75,11 @@ func (m *StorageManager) memoryDump(vmi *v1.VirtualMachineInstance, dumpPath str
 	logger.Infof("Starting memory dump")
 	failed := false
 	reason := ""
-	err = dom.CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY)
+	if publicKey := memoryDumpEncryptionPublicKey(vmi, dumpPath); publicKey != "" {
+		err = encryptedCoreDump(dom, dumpPath, publicKey)
+	} else {
+		err = dom.CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY)
+	}
 	if err != nil {
 		failed = true
 		reason = fmt.Sprintf("%s: %s", FailedDomainMemoryDump, err)
@@ -83,6 +91,70 @@ func (m *StorageManager) memoryDump(vmi *v1.VirtualMachineInstance, dumpPath str
 	return err
 }
 
+// memoryDumpEncryptionPublicKey returns the public key of the memory dump volume mounted in the directory of dumpPath.
+func memoryDumpEncryptionPublicKey(vmi *v1.VirtualMachineInstance, dumpPath string) string {
+	volumeName := filepath.Base(filepath.Dir(dumpPath))
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name == volumeName && volume.MemoryDump != nil {
+			return volume.MemoryDump.EncryptionPublicKey
+		}
+	}
+	return ""
+}
+
+// encryptedCoreDump has libvirt dump the memory into a pipe and encrypts it on its way to dumpPath,
+// so the memory is never written to the volume in plaintext.
+func encryptedCoreDump(dom cli.VirDomain, dumpPath, publicKeyPEM string) error {
+	publicKey, err := memorydump.ParseEncryptionPublicKey(publicKeyPEM)
+	if err != nil {
+		return err
+	}
+
+	pipeDir, err := os.MkdirTemp("", "memory-dump")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(pipeDir)
+	pipePath := filepath.Join(pipeDir, "memory.dump.pipe")
+	if err := syscall.Mkfifo(pipePath, 0600); err != nil {
+		return fmt.Errorf("failed to create the memory dump pipe: %v", err)
+	}
+
+	dumpFile, err := os.OpenFile(dumpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer dumpFile.Close()
+
+	// Holding a write end of the pipe lets the read end open without waiting for libvirt,
+	// and keeps the reader from seeing the end of the dump before libvirt is done with it.
+	pipeWriter, err := os.OpenFile(pipePath, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	pipeReader, err := os.Open(pipePath)
+	if err != nil {
+		pipeWriter.Close()
+		return err
+	}
+	defer pipeReader.Close()
+
+	encryptionErr := make(chan error, 1)
+	go func() {
+		err := memorydump.EncryptMemoryDump(dumpFile, pipeReader, publicKey)
+		// keep draining the pipe so libvirt does not block on a failed encryption
+		_, _ = io.Copy(io.Discard, pipeReader)
+		encryptionErr <- err
+	}()
+
+	dumpErr := dom.CoreDumpWithFormat(pipePath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY)
+	pipeWriter.Close()
+	if err := <-encryptionErr; err != nil && dumpErr == nil {
+		return fmt.Errorf("failed to encrypt the memory dump: %v", err)
+	}
+	return dumpErr
+}
+
 func (m *StorageManager) shouldSkipMemoryDump(dumpPath string) bool {
 	memoryDumpMetadata, _ := m.metadataCache.MemoryDump.Load()
 	if memoryDumpMetadata.FileName == filepath.Base(dumpPath) {
@@ -20,7 +20,14 @@
 package storage
 
 import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -32,6 +39,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
 )
@@ -128,4 +136,42 @@ var _ = Describe("MemoryDump", func() {
 			return memoryDump.Failed
 		}, 5*time.Second).Should(BeTrue(), "failed memory dump result wasn't set")
 	})
+
+	It("should encrypt the memory dump when the volume has an encryption public key", func() {
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+		publicKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&privateKey.PublicKey)})
+
+		dumpDir := filepath.Join(GinkgoT().TempDir(), "vol1")
+		Expect(os.Mkdir(dumpDir, 0700)).To(Succeed())
+		dumpPath := filepath.Join(dumpDir, "vol1.memory.dump")
+		memory := bytes.Repeat([]byte("guest memory"), 10000)
+
+		mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+		mockDomain.EXPECT().CoreDumpWithFormat(gomock.Not(dumpPath), libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY).DoAndReturn(
+			func(pipePath string, _ libvirt.DomainCoreDumpFormat, _ libvirt.DomainCoreDumpFlags) error {
+				return os.WriteFile(pipePath, memory, 0)
+			})
+
+		vmi := newVMI(testNamespace, testVmName)
+		vmi.Spec.Volumes = []v1.Volume{{
+			Name: "vol1",
+			VolumeSource: v1.VolumeSource{
+				MemoryDump: &v1.MemoryDumpVolumeSource{EncryptionPublicKey: string(publicKey)},
+			},
+		}}
+		Expect(manager.MemoryDump(vmi, dumpPath)).To(Succeed())
+		Eventually(func() bool {
+			memoryDump, _ := metadataCache.MemoryDump.Load()
+			return memoryDump.Completed
+		}, 5*time.Second).Should(BeTrue())
+
+		encrypted, err := os.ReadFile(dumpPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bytes.Contains(encrypted, []byte("guest memory"))).To(BeFalse())
+
+		decrypted := &bytes.Buffer{}
+		Expect(memorydump.DecryptMemoryDump(decrypted, bytes.NewReader(encrypted), privateKey)).To(Succeed())
+		Expect(decrypted.Bytes()).To(Equal(memory))
+	})
 })
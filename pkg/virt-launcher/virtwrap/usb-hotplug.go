@@ -0,0 +1,136 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/generic"
+)
+
+const waitForUSBHostDevicesDetachTimeout = 30 * time.Second
+
+// hotPlugUSBDevices aligns the USB host-devices and the USB redirection devices of the domain with the VMI spec.
+// USB host-devices are allocated to the pod by the device plugins, therefore only the devices
+// which the pod already holds can be attached.
+func (l *LibvirtDomainManager) hotPlugUSBDevices(dom cli.VirDomain, vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec) error {
+	eventChan := make(chan interface{}, hostdevice.MaxConcurrentHotPlugDevicesEvents)
+	var callback libvirt.DomainEventDeviceRemovedCallback = func(c *libvirt.Connect, d *libvirt.Domain, event *libvirt.DomainEventDeviceRemoved) {
+		eventChan <- event.DevAlias
+	}
+	domainEvent := cli.NewDomainEventDeviceRemoved(l.virConn, dom, callback, eventChan)
+
+	return syncUSBDevices(dom, domainEvent, vmi, domainSpec)
+}
+
+func syncUSBDevices(dom cli.VirDomain, eventRegistrar hostdevice.EventRegistrar, vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec) error {
+	hostDevicesToAttach, err := generic.GetUSBHostDevicesToAttach(vmi, domainSpec)
+	if err != nil {
+		return err
+	}
+	redirsToAttach, err := getRedirectedDevicesToAttach(vmi, domainSpec)
+	if err != nil {
+		return err
+	}
+	if (len(hostDevicesToAttach) > 0 || len(redirsToAttach) > 0) && !hasUSBController(domainSpec) {
+		return fmt.Errorf("the domain has no USB controller, USB devices can only be hot-plugged to a VMI started with USB devices")
+	}
+
+	hostDevicesToDetach := generic.GetUSBHostDevicesToDetach(vmi, domainSpec)
+	if err := hostdevice.SafelyDetachHostDevices(hostDevicesToDetach, eventRegistrar, dom, waitForUSBHostDevicesDetachTimeout); err != nil {
+		return err
+	}
+	for _, redir := range getRedirectedDevicesToDetach(vmi, domainSpec) {
+		redirXML, err := redirectedDeviceXML(redir)
+		if err != nil {
+			return err
+		}
+		if err := dom.DetachDeviceFlags(redirXML, affectDeviceLiveAndConfigLibvirtFlags); err != nil {
+			return fmt.Errorf("failed to detach redirdev %s: %v", redirXML, err)
+		}
+		log.Log.Object(vmi).Infof("Successfully hot-unplug redirdev: %s", redir.Source.Path)
+	}
+
+	if err := hostdevice.AttachHostDevices(dom, hostDevicesToAttach); err != nil {
+		return err
+	}
+	for _, redir := range redirsToAttach {
+		redirXML, err := redirectedDeviceXML(redir)
+		if err != nil {
+			return err
+		}
+		if err := dom.AttachDeviceFlags(redirXML, affectDeviceLiveAndConfigLibvirtFlags); err != nil {
+			return fmt.Errorf("failed to attach redirdev %s: %v", redirXML, err)
+		}
+		log.Log.Object(vmi).Infof("Successfully hot-plug redirdev: %s", redir.Source.Path)
+	}
+
+	return nil
+}
+
+func getRedirectedDevicesToAttach(vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec) ([]api.RedirectedDevice, error) {
+	if vmi.Spec.Domain.Devices.ClientPassthrough == nil || len(domainSpec.Devices.Redirs) > 0 {
+		return nil, nil
+	}
+
+	var devices api.Devices
+	if err := converter.Convert_v1_Usbredir_To_api_Usbredir(vmi, &devices, nil); err != nil {
+		return nil, err
+	}
+	return devices.Redirs, nil
+}
+
+func getRedirectedDevicesToDetach(vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec) []api.RedirectedDevice {
+	if vmi.Spec.Domain.Devices.ClientPassthrough != nil {
+		return nil
+	}
+	return domainSpec.Devices.Redirs
+}
+
+func hasUSBController(domainSpec *api.DomainSpec) bool {
+	for _, controller := range domainSpec.Devices.Controllers {
+		if controller.Type == "usb" && controller.Model != "none" {
+			return true
+		}
+	}
+	return false
+}
+
+func redirectedDeviceXML(redir api.RedirectedDevice) (string, error) {
+	redirXML, err := xml.Marshal(struct {
+		XMLName xml.Name `xml:"redirdev"`
+		api.RedirectedDevice
+	}{RedirectedDevice: redir})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode (xml) redirdev %v, err: %v", redir, err)
+	}
+	return string(redirXML), nil
+}
@@ -0,0 +1,92 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("USB devices hotplug", func() {
+	var (
+		mockDomain *cli.MockVirDomain
+		domainSpec *api.DomainSpec
+	)
+
+	newRedirectedDevice := func(path string) api.RedirectedDevice {
+		return api.RedirectedDevice{
+			Type:   "unix",
+			Bus:    "usb",
+			Source: api.RedirectedDeviceSource{Mode: "bind", Path: path},
+		}
+	}
+
+	BeforeEach(func() {
+		mockDomain = cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+		domainSpec = &api.DomainSpec{}
+		domainSpec.Devices.Controllers = []api.Controller{{Type: "usb", Index: "0", Model: "qemu-xhci"}}
+	})
+
+	It("should attach the redirection devices when client passthrough is requested", func() {
+		vmi := libvmi.New(libvmi.WithUID("1234"))
+		vmi.Spec.Domain.Devices.ClientPassthrough = &v1.ClientPassthroughDevices{}
+
+		mockDomain.EXPECT().
+			AttachDeviceFlags(gomock.Any(), affectDeviceLiveAndConfigLibvirtFlags).
+			Times(v1.UsbClientPassthroughMaxNumberOf)
+
+		Expect(syncUSBDevices(mockDomain, nil, vmi, domainSpec)).To(Succeed())
+	})
+
+	It("should detach the redirection devices when client passthrough is no longer requested", func() {
+		vmi := libvmi.New()
+		domainSpec.Devices.Redirs = []api.RedirectedDevice{newRedirectedDevice("/var/run/kubevirt-private/1234/virt-usbredir-0")}
+
+		mockDomain.EXPECT().DetachDeviceFlags(
+			`<redirdev type="unix" bus="usb"><source mode="bind" path="/var/run/kubevirt-private/1234/virt-usbredir-0"></source></redirdev>`,
+			affectDeviceLiveAndConfigLibvirtFlags,
+		)
+
+		Expect(syncUSBDevices(mockDomain, nil, vmi, domainSpec)).To(Succeed())
+	})
+
+	It("should not modify the domain when the USB devices are in sync", func() {
+		vmi := libvmi.New()
+		vmi.Spec.Domain.Devices.ClientPassthrough = &v1.ClientPassthroughDevices{}
+		domainSpec.Devices.Redirs = []api.RedirectedDevice{newRedirectedDevice("/var/run/kubevirt-private/1234/virt-usbredir-0")}
+
+		Expect(syncUSBDevices(mockDomain, nil, vmi, domainSpec)).To(Succeed())
+	})
+
+	It("should fail to attach USB devices when the domain has no USB controller", func() {
+		vmi := libvmi.New()
+		vmi.Spec.Domain.Devices.ClientPassthrough = &v1.ClientPassthroughDevices{}
+		domainSpec.Devices.Controllers = []api.Controller{{Type: "usb", Index: "0", Model: "none"}}
+
+		Expect(syncUSBDevices(mockDomain, nil, vmi, domainSpec)).To(MatchError(ContainSubstring("the domain has no USB controller")))
+	})
+})
@@ -29,6 +29,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/hooks"
+	"kubevirt.io/kubevirt/pkg/network/vhostuser"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
@@ -146,6 +147,12 @@ func SetDomainSpecStrWithHooks(virConn cli.Connection, vmi *v1.VirtualMachineIns
 		return nil, err
 	}
 
+	// Binding plugins add the vhost-user interfaces through the hooks
+	domainSpec, err = vhostuser.SetDefaultReconnect(domainSpec)
+	if err != nil {
+		return nil, err
+	}
+
 	// update wantedSpec to reflect changes made to domain spec by hooks
 	domainSpecObj := &api.DomainSpec{}
 	if err = xml.Unmarshal([]byte(domainSpec), domainSpecObj); err != nil {
@@ -275,6 +275,45 @@ var _ = Describe("LibvirtHelper", func() {
 		Expect(wantedSpec.Devices.Disks).To(Equal(mutatedSpec.Devices.Disks))
 	})
 
+	It("should enable the reconnection of the vhost-user interfaces added by hooks", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockLibvirt := testing.NewLibvirt(ctrl)
+
+		vmi := api2.NewMinimalVMIWithNS("test-namespace", "test-vmi")
+		wantedSpec := &api.DomainSpec{Name: "test-namespace_test-vmi"}
+
+		mutatedSpec := wantedSpec.DeepCopy()
+		mutatedSpec.Devices.Interfaces = []api.Interface{{
+			Type:   "vhostuser",
+			Source: api.InterfaceSource{Type: "unix", Path: "/var/run/vhostuser/sock0", Mode: "client"},
+		}}
+		mutatedSpecXml, err := xml.Marshal(mutatedSpec)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectedSpec := mutatedSpec.DeepCopy()
+		expectedSpec.Devices.Interfaces[0].Source.Reconnect = &api.Reconnect{Enabled: "yes", Timeout: pointer.P(uint(1))}
+		// Only the reconnect element is added to the XML the hooks returned
+		expectedSpecXml := strings.Replace(string(mutatedSpecXml), `sock0">`, `sock0"><reconnect enabled="yes" timeout="1"/>`, 1)
+
+		mockHookManager := hooks.NewMockManager(ctrl)
+		getHookManager = func() hooks.Manager {
+			return mockHookManager
+		}
+		defer func() {
+			getHookManager = hooks.GetManager
+		}()
+		mockHookManager.EXPECT().OnDefineDomain(wantedSpec, vmi).Return(string(mutatedSpecXml), nil)
+		mockLibvirt.ConnectionEXPECT().DomainDefineXML(expectedSpecXml).Return(mockLibvirt.VirtDomain, nil)
+		mockLibvirt.DomainEXPECT().Free()
+
+		dom, err := SetDomainSpecStrWithHooks(mockLibvirt.VirtConnection, vmi, wantedSpec)
+		Expect(err).NotTo(HaveOccurred())
+		dom.Free()
+
+		Expect(wantedSpec.Devices.Interfaces).To(HaveLen(1))
+		Expect(wantedSpec.Devices.Interfaces[0].Source).To(Equal(expectedSpec.Devices.Interfaces[0].Source))
+	})
+
 	Context("getLibvirtLogFilters()", func() {
 
 		DescribeTable("should return customLogFilters if defined and not empty with", func(libvirtLogVerbosityEnvVar *string, libvirtDebugLogsEnvVarDefined bool) {
@@ -88,8 +88,8 @@ const (
 
 	NAMESPACE = "kubevirt-test"
 
-	resourceCount = 88
-	patchCount    = 56
+	resourceCount = 90
+	patchCount    = 58
 	updateCount   = 33
 )
 
@@ -103,6 +103,7 @@ var (
 		components.NewVirtualMachineClusterInstancetypeCrd, components.NewVirtualMachinePoolCrd,
 		components.NewMigrationPolicyCrd, components.NewVirtualMachinePreferenceCrd,
 		components.NewVirtualMachineClusterPreferenceCrd, components.NewVirtualMachineCloneCrd,
+		components.NewOvercommitProfileCrd, components.NewVirtualizationQuotaCrd,
 	}
 	numCRDs = len(crdFunctions)
 )
@@ -3548,7 +3549,8 @@ func getDefaultVirtHandlerDaemonSet(namespace string, config *util.KubeVirtDeplo
 		nil,
 		config.GetVerbosity(),
 		config.GetExtraEnv(),
-		false)
+		false,
+		"")
 }
 
 func getDefaultExportProxyDeployment(namespace string, config *util.KubeVirtDeploymentConfig) *appsv1.Deployment {
@@ -347,7 +347,8 @@ var _ = Describe("Apply Apps", func() {
 				nil,
 				virtHandlerConfig.GetVerbosity(),
 				virtHandlerConfig.GetExtraEnv(),
-				false)
+				false,
+				"")
 			markHandlerReady(daemonSet)
 			daemonSet.UID = "random-id"
 			daemonSet.Generation = 1
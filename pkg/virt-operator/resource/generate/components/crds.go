@@ -32,6 +32,8 @@ import (
 	"kubevirt.io/api/migrations"
 
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/api/overcommit"
+	overcommitv1alpha1 "kubevirt.io/api/overcommit/v1alpha1"
 
 	schedulingv1 "k8s.io/api/scheduling/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -70,6 +72,8 @@ var (
 	MIGRATIONPOLICY                  = "migrationpolicies." + migrationsv1.MigrationPolicyKind.Group
 	VIRTUALMACHINECLONE              = "virtualmachineclones." + clone.GroupName
 	VIRTUALMACHINEBACKUP             = "virtualmachinebackups." + backupv1alpha1.SchemeGroupVersion.Group
+	OVERCOMMITPROFILE                = overcommit.ResourceOvercommitProfiles + "." + overcommit.GroupName
+	VIRTUALIZATIONQUOTA              = overcommit.ResourceVirtualizationQuotas + "." + overcommit.GroupName
 )
 
 func addFieldsToVersion(version *extv1.CustomResourceDefinitionVersion, fields ...interface{}) error {
@@ -865,6 +869,74 @@ func NewMigrationPolicyCrd() (*extv1.CustomResourceDefinition, error) {
 	return crd, nil
 }
 
+func NewOvercommitProfileCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = OVERCOMMITPROFILE
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: overcommitv1alpha1.OvercommitProfileKind.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    overcommitv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.ClusterScoped,
+
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:   overcommit.ResourceOvercommitProfiles,
+			Singular: "overcommitprofile",
+			Kind:     overcommitv1alpha1.OvercommitProfileKind.Kind,
+		},
+	}
+	err := addFieldsToAllVersions(crd, &extv1.CustomResourceSubresources{
+		Status: &extv1.CustomResourceSubresourceStatus{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualizationQuotaCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALIZATIONQUOTA
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: overcommitv1alpha1.VirtualizationQuotaKind.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    overcommitv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.NamespaceScoped,
+
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:   overcommit.ResourceVirtualizationQuotas,
+			Singular: "virtualizationquota",
+			Kind:     overcommitv1alpha1.VirtualizationQuotaKind.Kind,
+		},
+	}
+	err := addFieldsToAllVersions(crd, &extv1.CustomResourceSubresources{
+		Status: &extv1.CustomResourceSubresourceStatus{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewVirtualMachineCloneCrd() (*extv1.CustomResourceDefinition, error) {
 	crd := newBlankCrd()
 
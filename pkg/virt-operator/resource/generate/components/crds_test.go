@@ -50,6 +50,8 @@ var _ = Describe("CRDs", func() {
 		Entry("for VirtualMachineClusterPreference", NewVirtualMachineClusterPreferenceCrd),
 		Entry("for VirtualMachineClone", NewVirtualMachineCloneCrd),
 		Entry("for MigrationPolicy", NewMigrationPolicyCrd),
+		Entry("for OvercommitProfile", NewOvercommitProfileCrd),
+		Entry("for VirtualizationQuota", NewVirtualizationQuotaCrd),
 	)
 
 	It("DataVolumeTemplates should have nullable a XPreserveUnknownFields on metadata", func() {
@@ -143,6 +145,8 @@ var _ = Describe("CRDs", func() {
 		Entry("for VirtualMachineClusterPreference", NewVirtualMachineClusterPreferenceCrd),
 		Entry("for VirtualMachineClone", NewVirtualMachineCloneCrd, "Phase", "SourceVirtualMachine", "TargetVirtualMachine"),
 		Entry("for MigrationPolicy", NewMigrationPolicyCrd),
+		Entry("for OvercommitProfile", NewOvercommitProfileCrd),
+		Entry("for VirtualizationQuota", NewVirtualizationQuotaCrd),
 	)
 
 	DescribeTable("Additional printer columns map to expected value", func(crdFunc func() (*extv1.CustomResourceDefinition, error), obj any, expected ...string) {
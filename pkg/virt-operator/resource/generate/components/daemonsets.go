@@ -2,6 +2,7 @@ package components
 
 import (
 	"fmt"
+	"path/filepath"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -31,7 +32,7 @@ const (
 	SupportsMigrationCNsValidation = "kubevirt.io/supports-migration-cn-types"
 )
 
-func RenderPrHelperContainer(image string, pullPolicy corev1.PullPolicy) corev1.Container {
+func RenderPrHelperContainer(image string, pullPolicy corev1.PullPolicy, socketPath string) corev1.Container {
 	bidi := corev1.MountPropagationBidirectional
 	return corev1.Container{
 		Name:            PrHelperName,
@@ -39,12 +40,12 @@ func RenderPrHelperContainer(image string, pullPolicy corev1.PullPolicy) corev1.
 		ImagePullPolicy: pullPolicy,
 		Command:         []string{"/entrypoint.sh"},
 		Args: []string{
-			"-k", reservation.GetPrHelperSocketPath(),
+			"-k", socketPath,
 		},
 		VolumeMounts: []corev1.VolumeMount{
 			{
 				Name:             prVolumeName,
-				MountPath:        reservation.GetPrHelperSocketDir(),
+				MountPath:        filepath.Dir(socketPath),
 				MountPropagation: &bidi,
 			},
 			{
@@ -66,7 +67,7 @@ func RenderPrHelperContainer(image string, pullPolicy corev1.PullPolicy) corev1.
 	}
 }
 
-func NewHandlerDaemonSet(namespace, repository, imagePrefix, version, launcherVersion, prHelperVersion, sidecarShimVersion, productName, productVersion, productComponent, image, launcherImage, prHelperImage, sidecarShimImage string, pullPolicy corev1.PullPolicy, imagePullSecrets []corev1.LocalObjectReference, migrationNetwork *string, verbosity string, extraEnv map[string]string, enablePrHelper bool) *appsv1.DaemonSet {
+func NewHandlerDaemonSet(namespace, repository, imagePrefix, version, launcherVersion, prHelperVersion, sidecarShimVersion, productName, productVersion, productComponent, image, launcherImage, prHelperImage, sidecarShimImage string, pullPolicy corev1.PullPolicy, imagePullSecrets []corev1.LocalObjectReference, migrationNetwork *string, verbosity string, extraEnv map[string]string, enablePrHelper bool, prHelperSocketPath string) *appsv1.DaemonSet {
 
 	deploymentName := VirtHandlerName
 	imageName := fmt.Sprintf("%s%s", imagePrefix, deploymentName)
@@ -355,12 +356,15 @@ func NewHandlerDaemonSet(namespace, repository, imagePrefix, version, launcherVe
 	}
 
 	if enablePrHelper {
+		if prHelperSocketPath == "" {
+			prHelperSocketPath = reservation.GetPrHelperSocketPath()
+		}
 		directoryOrCreate := corev1.HostPathDirectoryOrCreate
 		pod.Volumes = append(pod.Volumes, corev1.Volume{
 			Name: prVolumeName,
 			VolumeSource: corev1.VolumeSource{
 				HostPath: &corev1.HostPathVolumeSource{
-					Path: reservation.GetPrHelperSocketDir(),
+					Path: filepath.Dir(prHelperSocketPath),
 					Type: &directoryOrCreate,
 				},
 			}}, corev1.Volume{
@@ -377,7 +381,7 @@ func NewHandlerDaemonSet(namespace, repository, imagePrefix, version, launcherVe
 					Type: pointer.P(corev1.HostPathDirectoryOrCreate),
 				},
 			}})
-		pod.Containers = append(pod.Containers, RenderPrHelperContainer(prHelperImage, pullPolicy))
+		pod.Containers = append(pod.Containers, RenderPrHelperContainer(prHelperImage, pullPolicy, prHelperSocketPath))
 	}
 	return daemonset
 
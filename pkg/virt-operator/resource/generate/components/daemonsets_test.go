@@ -0,0 +1,56 @@
+package components
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("DaemonSets", func() {
+	newHandlerDaemonSet := func(enablePrHelper bool, prHelperSocketPath string) *appsv1.DaemonSet {
+		return NewHandlerDaemonSet("kubevirt", "registry", "", "v1", "", "", "", "", "", "", "", "", "", "",
+			corev1.PullIfNotPresent, nil, nil, "2", nil, enablePrHelper, prHelperSocketPath)
+	}
+
+	findContainer := func(daemonSet *appsv1.DaemonSet, name string) *corev1.Container {
+		for i, container := range daemonSet.Spec.Template.Spec.Containers {
+			if container.Name == name {
+				return &daemonSet.Spec.Template.Spec.Containers[i]
+			}
+		}
+		return nil
+	}
+
+	findVolume := func(daemonSet *appsv1.DaemonSet, name string) *corev1.Volume {
+		for i, volume := range daemonSet.Spec.Template.Spec.Volumes {
+			if volume.Name == name {
+				return &daemonSet.Spec.Template.Spec.Volumes[i]
+			}
+		}
+		return nil
+	}
+
+	It("should not render the pr-helper when it is disabled", func() {
+		daemonSet := newHandlerDaemonSet(false, "")
+		Expect(findContainer(daemonSet, PrHelperName)).To(BeNil())
+		Expect(findVolume(daemonSet, prVolumeName)).To(BeNil())
+	})
+
+	DescribeTable("should render the pr-helper", func(prHelperSocketPath, expectedSocketPath, expectedSocketDir string) {
+		daemonSet := newHandlerDaemonSet(true, prHelperSocketPath)
+
+		prHelper := findContainer(daemonSet, PrHelperName)
+		Expect(prHelper).ToNot(BeNil())
+		Expect(prHelper.Args).To(Equal([]string{"-k", expectedSocketPath}))
+		Expect(prHelper.VolumeMounts).To(ContainElement(HaveField("MountPath", expectedSocketDir)))
+
+		volume := findVolume(daemonSet, prVolumeName)
+		Expect(volume).ToNot(BeNil())
+		Expect(volume.HostPath.Path).To(Equal(expectedSocketDir))
+	},
+		Entry("on the default socket path", "", "/var/run/kubevirt/daemons/pr/pr-helper.sock", "/var/run/kubevirt/daemons/pr"),
+		Entry("on the configured socket path", "/run/pr/multipath-pr.sock", "/run/pr/multipath-pr.sock", "/run/pr"),
+	)
+})
@@ -757,6 +757,13 @@ var CRDsValidation map[string]string = map[string]string{
                       x-kubernetes-list-type: atomic
                     machineType:
                       type: string
+                    machineTypeAliases:
+                      additionalProperties:
+                        type: string
+                      description: |-
+                        MachineTypeAliases maps an alias, e.g. stable, to the machine type it stands for, e.g. pc-q35-rhel9.6.0.
+                        A VMI requesting an alias runs with the machine type the alias resolves to when the domain is created.
+                      type: object
                     ovmfPath:
                       type: string
                   type: object
@@ -769,6 +776,13 @@ var CRDsValidation map[string]string = map[string]string{
                       x-kubernetes-list-type: atomic
                     machineType:
                       type: string
+                    machineTypeAliases:
+                      additionalProperties:
+                        type: string
+                      description: |-
+                        MachineTypeAliases maps an alias, e.g. stable, to the machine type it stands for, e.g. pc-q35-rhel9.6.0.
+                        A VMI requesting an alias runs with the machine type the alias resolves to when the domain is created.
+                      type: object
                     ovmfPath:
                       type: string
                   type: object
@@ -784,6 +798,13 @@ var CRDsValidation map[string]string = map[string]string{
                       x-kubernetes-list-type: atomic
                     machineType:
                       type: string
+                    machineTypeAliases:
+                      additionalProperties:
+                        type: string
+                      description: |-
+                        MachineTypeAliases maps an alias, e.g. stable, to the machine type it stands for, e.g. pc-q35-rhel9.6.0.
+                        A VMI requesting an alias runs with the machine type the alias resolves to when the domain is created.
+                      type: object
                     ovmfPath:
                       type: string
                   type: object
@@ -796,6 +817,13 @@ var CRDsValidation map[string]string = map[string]string{
                       x-kubernetes-list-type: atomic
                     machineType:
                       type: string
+                    machineTypeAliases:
+                      additionalProperties:
+                        type: string
+                      description: |-
+                        MachineTypeAliases maps an alias, e.g. stable, to the machine type it stands for, e.g. pc-q35-rhel9.6.0.
+                        A VMI requesting an alias runs with the machine type the alias resolves to when the domain is created.
+                      type: object
                     ovmfPath:
                       type: string
                   type: object
@@ -1113,6 +1141,50 @@ var CRDsValidation map[string]string = map[string]string{
                 migrated instead of shut-off in case of a node drain. If the VirtualMachineInstance specific
                 field is set it overrides the cluster level one.
               type: string
+            externalTPMSockets:
+              description: ExternalTPMSockets defines the directories of the nodes
+                the sockets of external swtpm instances may be located in.
+              nullable: true
+              properties:
+                permittedDirectories:
+                  description: |-
+                    PermittedDirectories are the absolute paths of the directories on the nodes which may hold the
+                    sockets, like /var/tmp/spdk. The sockets of a namespace are in its directory below a permitted
+                    directory, like /var/tmp/spdk/<namespace>, or in a subdirectory of it. VMIs can't use the sockets
+                    of other namespaces.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
+              required:
+              - permittedDirectories
+              type: object
+            guestCrashNotifications:
+              description: GuestCrashNotifications defines the webhook sink virt-handler
+                notifies when a guest crashes.
+              nullable: true
+              properties:
+                url:
+                  description: URL is the http or https endpoint the notifications
+                    are posted to as JSON.
+                  type: string
+              required:
+              - url
+              type: object
+            guestTime:
+              description: GuestTime defines the time synchronization policy injected
+                into the guests.
+              nullable: true
+              properties:
+                ntpServers:
+                  description: NTPServers are the IPv4 addresses of the NTP servers.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
+              required:
+              - ntpServers
+              type: object
             handlerConfiguration:
               description: |-
                 ReloadableComponentConfiguration holds all generic k8s configuration options which can
@@ -1145,6 +1217,22 @@ var CRDsValidation map[string]string = map[string]string{
                       type: object
                   type: object
               type: object
+            hostBlockDevices:
+              description: HostBlockDevices defines the block devices of the nodes
+                VirtualMachineInstances may consume with hostBlockDevice volumes.
+              nullable: true
+              properties:
+                permittedPaths:
+                  description: |-
+                    PermittedPaths are the absolute paths of the block devices on the nodes which hostBlockDevice volumes
+                    may reference, like /dev/nvme1n1 or /dev/disk/by-id/nvme-eui.0025388b91b2d3a1.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
+              required:
+              - permittedPaths
+              type: object
             imagePullPolicy:
               description: PullPolicy describes a policy for if/when to pull a container
                 image
@@ -1438,7 +1526,7 @@ var CRDsValidation map[string]string = map[string]string{
                       domainAttachmentType:
                         description: |-
                           DomainAttachmentType is a standard domain network attachment method kubevirt supports.
-                          Supported values: "tap", "managedTap" (since v1.4).
+                          Supported values: "tap", "managedTap" (since v1.4), "ovs", "none", "vdpa".
                           The standard domain attachment can be used instead or in addition to the sidecarImage.
                           version: 1alphav1
                         type: string
@@ -1476,6 +1564,35 @@ var CRDsValidation map[string]string = map[string]string{
                   type: object
                 defaultNetworkInterface:
                   type: string
+                masqueradeIPAM:
+                  description: |-
+                    MasqueradeIPAM allocates the guest subnet of masquerade interfaces on the pod network,
+                    when vmNetworkCIDR or vmIPv6NetworkCIDR is not set on the VMI.
+                  properties:
+                    excludedCIDRs:
+                      description: |-
+                        ExcludedCIDRs are networks guests have to reach, e.g. corporate networks.
+                        Allocated guest subnets never overlap them.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    ipv4Pool:
+                      description: IPv4Pool is the CIDR guest IPv4 subnets are allocated
+                        from.
+                      type: string
+                    ipv6Pool:
+                      description: IPv6Pool is the CIDR guest IPv6 subnets are allocated
+                        from.
+                      type: string
+                    plugin:
+                      description: |-
+                        Plugin is the name of the IPAM plugin allocating the guest subnets.
+                        Supported values: "pool".
+                      type: string
+                  required:
+                  - plugin
+                  type: object
                 permitBridgeInterfaceOnPodNetwork:
                   type: boolean
                 permitSlirpInterface:
@@ -1484,6 +1601,27 @@ var CRDsValidation map[string]string = map[string]string{
                     Deprecated: Removed in v1.3.
                   type: boolean
               type: object
+            nodeGuardrails:
+              description: |-
+                NodeGuardrails defines per-node policies enforced by virt-handler before starting a VirtualMachineInstance.
+                A VirtualMachineInstance that would violate them is refused instead of being started in a degraded state.
+              nullable: true
+              properties:
+                maxVirtualMachineInstances:
+                  description: |-
+                    MaxVirtualMachineInstances is the maximum number of running VirtualMachineInstances per node.
+                    Unlimited if not set.
+                  format: int32
+                  type: integer
+                minHugepagesLocalityPercent:
+                  description: |-
+                    MinHugepagesLocalityPercent is the minimal percentage of the hugepages backing a VirtualMachineInstance
+                    that has to be available on a single host NUMA node. VirtualMachineInstances whose hugepages would be
+                    fragmented across NUMA nodes beyond this threshold are refused. Disabled if not set.
+                  format: int32
+                  maximum: 100
+                  type: integer
+              type: object
             obsoleteCPUModels:
               additionalProperties:
                 type: boolean
@@ -1495,6 +1633,36 @@ var CRDsValidation map[string]string = map[string]string{
               description: PermittedHostDevices holds information about devices allowed
                 for passthrough
               properties:
+                inputDevices:
+                  items:
+                    description: |-
+                      InputHostDevice represents a host input (evdev) device allowed for passthrough,
+                      e.g. a keyboard or a touchscreen attached to the node.
+                      The device is attached to the guest as a virtio-input-host device.
+                    properties:
+                      devicePath:
+                        description: |-
+                          The path of the evdev device on the node, under /dev/input.
+                          Stable paths like /dev/input/by-id/usb-Vendor_Keyboard-event-kbd should be
+                          preferred, as /dev/input/eventX is not guaranteed to survive a reboot.
+                        type: string
+                      externalResourceProvider:
+                        description: |-
+                          If true, KubeVirt will leave the allocation and monitoring to an
+                          external device plugin
+                        type: boolean
+                      resourceName:
+                        description: |-
+                          The name of the resource that is representing the device. Exposed by
+                          a device plugin and requested by VMs.
+                          e.g: kubevirt.io/kiosk-keyboard
+                        type: string
+                    required:
+                    - devicePath
+                    - resourceName
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 mediatedDevices:
                   items:
                     description: MediatedHostDevice represents a host mediated device
@@ -1553,9 +1721,20 @@ var CRDsValidation map[string]string = map[string]string{
                       selectors:
                         items:
                           properties:
+                            class:
+                              description: |-
+                                The class code of the USB device, or of one of its interfaces, in hexadecimal.
+                                e.g: 0b for smart card readers. Any class matches when not set.
+                              type: string
                             product:
+                              description: |-
+                                The product ID of the USB device, in hexadecimal.
+                                "*" matches any product.
                               type: string
                             vendor:
+                              description: |-
+                                The vendor ID of the USB device, in hexadecimal.
+                                "*" matches any vendor.
                               type: string
                           required:
                           - product
@@ -1568,6 +1747,54 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                   type: array
                   x-kubernetes-list-type: atomic
+                vdpaDevices:
+                  items:
+                    description: |-
+                      VDPAHostDevice represents the host vDPA devices allowed to back network interfaces,
+                      e.g. the vDPA devices created on the VFs of a NIC.
+                      The vDPA devices must be bound to the vhost_vdpa driver. They are allocated by
+                      the PCI address of their parent device, as SR-IOV VFs are.
+                    properties:
+                      externalResourceProvider:
+                        description: |-
+                          If true, KubeVirt will leave the allocation and monitoring to an
+                          external device plugin
+                        type: boolean
+                      pciVendorSelector:
+                        description: The vendor_id:product_id tuple of the PCI device
+                          the vDPA devices are created on
+                        type: string
+                      resourceName:
+                        description: |-
+                          The name of the resource that is representing the devices. Exposed by
+                          a device plugin and requested by networks.
+                          e.g: kubevirt.io/vdpa-mlx5
+                        type: string
+                    required:
+                    - pciVendorSelector
+                    - resourceName
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+              type: object
+            persistentReservation:
+              description: PersistentReservation configures the pr-helper daemon serving
+                the SCSI persistent reservations of LUNs.
+              nullable: true
+              properties:
+                socketPath:
+                  description: |-
+                    SocketPath is the absolute path of the pr-helper socket on the nodes.
+                    Defaults to /var/run/kubevirt/daemons/pr/pr-helper.sock
+                  type: string
+                storageClasses:
+                  description: |-
+                    StorageClasses restricts the persistent reservations to LUNs backed by a PVC of one of the storage classes.
+                    Reservations are allowed for any storage class when empty.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
               type: object
             seccompConfiguration:
               description: SeccompConfiguration holds Seccomp configuration for Kubevirt
@@ -1683,6 +1910,24 @@ var CRDsValidation map[string]string = map[string]string{
                   - VersionTLS13
                   type: string
               type: object
+            vhostUserBlkSockets:
+              description: VhostUserBlkSockets defines the directories of the nodes
+                the sockets of vhostUserBlk volumes may be located in.
+              nullable: true
+              properties:
+                permittedDirectories:
+                  description: |-
+                    PermittedDirectories are the absolute paths of the directories on the nodes which may hold the
+                    sockets, like /var/tmp/spdk. The sockets of a namespace are in its directory below a permitted
+                    directory, like /var/tmp/spdk/<namespace>, or in a subdirectory of it. VMIs can't use the sockets
+                    of other namespaces.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
+              required:
+              - permittedDirectories
+              type: object
             virtualMachineInstancesPerNode:
               type: integer
             virtualMachineOptions:
@@ -4042,6 +4287,129 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"overcommitprofile": `openAPIV3Schema:
+  description: |-
+    OvercommitProfile defines the CPU and memory overcommit applied to the VMIs of a group of namespaces.
+    The ratios replace the cluster wide cpuAllocationRatio and memoryOvercommit when the resources of
+    the virt-launcher pod are computed from the domain resources of a VMI.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      properties:
+        cpuAllocationRatio:
+          description: |-
+            CPUAllocationRatio is the number of vCPUs sharing one requested CPU.
+            The launcher pod requests 1/CPUAllocationRatio CPU per vCPU.
+          format: int32
+          minimum: 1
+          type: integer
+        memoryOvercommit:
+          description: |-
+            MemoryOvercommit is the guest memory in percent of the memory requested by the launcher pod,
+            e.g. 150 requests two thirds of the guest memory.
+          format: int32
+          minimum: 100
+          type: integer
+        namespaceSelector:
+          additionalProperties:
+            type: string
+          description: |-
+            NamespaceSelector selects the namespaces the profile applies to by their labels.
+            When several profiles select a namespace, the one with the most labels is applied.
+            Among profiles with as many labels, the first one in lexicographic name order is applied.
+          type: object
+      required:
+      - namespaceSelector
+      type: object
+    status:
+      nullable: true
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualizationquota": `openAPIV3Schema:
+  description: |-
+    VirtualizationQuota limits the virtualization resources used by the VMIs of its namespace.
+    Unlike a ResourceQuota, which counts the requests of the virt-launcher pods, it counts the
+    resources of the domains, which exceed the pod requests of overcommitted VMIs.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      properties:
+        hard:
+          additionalProperties:
+            anyOf:
+            - type: integer
+            - type: string
+            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+            x-kubernetes-int-or-string: true
+          description: |-
+            Hard is the amount of each resource the VMIs of the namespace may use together.
+            Supported resources are vcpus, guestMemory, gpus and hostDevices.
+          type: object
+      required:
+      - hard
+      type: object
+    status:
+      nullable: true
+      properties:
+        hard:
+          additionalProperties:
+            anyOf:
+            - type: integer
+            - type: string
+            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+            x-kubernetes-int-or-string: true
+          description: Hard is the amount of each resource enforced by the quota.
+          type: object
+        used:
+          additionalProperties:
+            anyOf:
+            - type: integer
+            - type: string
+            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+            x-kubernetes-int-or-string: true
+          description: Used is the amount of each resource used by the VMIs of the
+            namespace which are not in a final phase.
+          type: object
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"virtualmachine": `openAPIV3Schema:
   description: |-
@@ -4071,6 +4439,42 @@ var CRDsValidation map[string]string = map[string]string{
     spec:
       description: Spec contains the specification of VirtualMachineInstance created
       properties:
+        cpuBurst:
+          description: |-
+            CPUBurst raises the CPU limit of the virt-launcher pod during daily windows,
+            e.g. while backup jobs run in the guest.
+          properties:
+            cpuLimit:
+              anyOf:
+              - type: integer
+              - type: string
+              description: CPULimit is the CPU limit of the compute container during
+                a window.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            windows:
+              description: Windows are the daily windows during which the CPU limit
+                is raised.
+              items:
+                description: CPUBurstWindow is a daily window.
+                properties:
+                  duration:
+                    description: Duration of the window, at most 24 hours.
+                    type: string
+                  start:
+                    description: Start is the time of day the window opens, in the
+                      24-hour "15:04" format and in UTC.
+                    type: string
+                required:
+                - duration
+                - start
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+          required:
+          - cpuLimit
+          - windows
+          type: object
         dataVolumeTemplates:
           description: |-
             dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.
@@ -4748,6 +5152,17 @@ var CRDsValidation map[string]string = map[string]string{
                 captured the first time the instancetype is applied to the VirtualMachineInstance.
               type: string
           type: object
+        maintenanceSnapshot:
+          description: |-
+            MaintenanceSnapshot enables taking a VirtualMachineSnapshot before CPU, memory or
+            interface hotplug and before firmware changes are applied to the running VM.
+          properties:
+            successWindow:
+              description: |-
+                SuccessWindow is how long the VM has to stay ready after the snapshot has been taken
+                before the snapshot is garbage collected. Defaults to 24h.
+              type: string
+          type: object
         preference:
           description: PreferenceMatcher references a set of preference that is used
             to fill fields in Template
@@ -5914,6 +6329,12 @@ var CRDsValidation map[string]string = map[string]string{
                     clock:
                       description: Clock sets the clock and timers of the vmi.
                       properties:
+                        ptp:
+                          description: |-
+                            PTP exposes a virtual PTP hardware clock (kvm-ptp) to the guest, so that it can
+                            discipline its clock to the host clock through the ptp_kvm driver.
+                            Only supported on amd64, where it requires the kvm timer, and arm64.
+                          type: object
                         timer:
                           description: Timer specifies whih timers are attached to
                             the vmi.
@@ -6011,6 +6432,23 @@ var CRDsValidation map[string]string = map[string]string{
                       description: CPU allow specified the detailed CPU topology inside
                         the vmi.
                       properties:
+                        cache:
+                          description: Cache describes how the CPU cache is presented
+                            to the guest, e.g. passing the host L3 cache through.
+                          properties:
+                            level:
+                              description: |-
+                                Level is the cache level the mode applies to.
+                                Only level 3 can be emulated or disabled, passthrough applies to all the levels.
+                              format: int32
+                              type: integer
+                            mode:
+                              description: Mode is the cache mode, one of passthrough,
+                                emulate or disable.
+                              type: string
+                          required:
+                          - mode
+                          type: object
                         cores:
                           description: |-
                             Cores specifies the number of cores inside the vmi.
@@ -6073,20 +6511,78 @@ var CRDsValidation map[string]string = map[string]string{
                                 GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
                                 The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
                               type: object
-                          type: object
-                        realtime:
-                          description: Realtime instructs the virt-launcher to tune
-                            the VMI for lower latency, optional for real time workloads
-                          properties:
-                            mask:
+                            manual:
                               description: |-
-                                Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.
-                                Example: "0-3,^1","0,2,3","2-3"
-                              type: string
-                          type: object
-                        sockets:
-                          description: |-
-                            Sockets specifies the number of sockets inside the vmi.
+                                Manual defines the guest numa topology explicitly, independently of the host numa topology.
+                                It is mutually exclusive with GuestMappingPassthrough.
+                              properties:
+                                nodes:
+                                  description: Nodes of the guest numa topology. The
+                                    index of a node in the list is its numa node id.
+                                  items:
+                                    description: NUMANode describes a guest numa node.
+                                    properties:
+                                      cpus:
+                                        description: CPUs is the list of vCPUs belonging
+                                          to the node, e.g. "0-3,8". Every vCPU must
+                                          belong to exactly one node.
+                                        type: string
+                                      distances:
+                                        description: |-
+                                          Distances from this node to the other nodes. An unset distance is the distance set from the other node back
+                                          to this one, or defaults to 10 to the node itself and to 20 to the other nodes.
+                                        items:
+                                          description: NUMADistance is the distance
+                                            from a guest numa node to another one.
+                                          properties:
+                                            node:
+                                              description: Node is the id of the other
+                                                numa node.
+                                              format: int32
+                                              type: integer
+                                            value:
+                                              description: Value of the distance,
+                                                between 10 and 255.
+                                              format: int32
+                                              type: integer
+                                          required:
+                                          - node
+                                          - value
+                                          type: object
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                      memory:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Memory of the node. The memory
+                                          of all the nodes must add up to the guest
+                                          memory.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - cpus
+                                    - memory
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - nodes
+                              type: object
+                          type: object
+                        realtime:
+                          description: Realtime instructs the virt-launcher to tune
+                            the VMI for lower latency, optional for real time workloads
+                          properties:
+                            mask:
+                              description: |-
+                                Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.
+                                Example: "0-3,^1","0,2,3","2-3"
+                              type: string
+                          type: object
+                        sockets:
+                          description: |-
+                            Sockets specifies the number of sockets inside the vmi.
                             Must be a value greater or equal 1.
                           format: int32
                           type: integer
@@ -6131,11 +6627,54 @@ var CRDsValidation map[string]string = map[string]string{
                             Whether to attach the VSOCK CID to the VM or not.
                             VSOCK access will be available if set to true. Defaults to false.
                           type: boolean
+                        balloon:
+                          description: Balloon configures the memory reclaim behavior
+                            of the memory balloon device.
+                          properties:
+                            deflateOnOOM:
+                              description: |-
+                                DeflateOnOOM lets the guest deflate the balloon when it runs out of memory,
+                                instead of invoking its OOM killer.
+                                Defaults to false.
+                              type: boolean
+                            disableStatsWithoutDriver:
+                              description: |-
+                                DisableStatsWithoutDriver stops the balloon statistics while the guest agent reports that the
+                                guest has no balloon driver, e.g. a Windows guest without the virtio drivers, as QEMU would keep on
+                                requesting statistics which the guest never provides. They are restored once the driver is installed.
+                                Defaults to false.
+                              type: boolean
+                            freePageReporting:
+                              description: |-
+                                FreePageReporting lets the guest report its free pages to the host so they can be reclaimed.
+                                It overrides the cluster-wide setting and the free page reporting annotation, but is always
+                                disabled for high performance vmis, e.g. with dedicated CPUs or hugepages.
+                              type: boolean
+                          type: object
                         blockMultiQueue:
                           description: |-
                             Whether or not to enable virtio multi-queue for block devices.
                             Defaults to false.
                           type: boolean
+                        channels:
+                          description: |-
+                            Channels describes additional virtio-serial channels exposed to the vmi,
+                            each backed by a unix socket in the virt-launcher pod, e.g. for host-guest agents.
+                          items:
+                            description: Channel represents a virtio-serial channel
+                              backed by a unix socket in the virt-launcher pod.
+                            properties:
+                              name:
+                                description: |-
+                                  Name is the name of the virtio-serial port seen by the guest, e.g. org.example.agent.0.
+                                  The socket is created at /var/run/kubevirt-channels/<name>.sock in the virt-launcher pod. The directory
+                                  is a volume shared with the hook sidecars of the vmi, which connect to the socket to talk to the guest.
+                                type: string
+                            required:
+                            - name
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
                         clientPassthrough:
                           description: To configure and access client devices such
                             as redirecting USB
@@ -6228,18 +6767,43 @@ var CRDsValidation map[string]string = map[string]string{
                                   bus:
                                     description: |-
                                       Bus indicates the type of disk device to emulate.
-                                      supported values: virtio, sata, scsi, usb.
+                                      supported values: virtio, sata, scsi, usb, nvme.
+                                    type: string
+                                  ccwAddress:
+                                    description: |-
+                                      If specified, the virtual disk will be placed on the guests CCW address with the specified device number.
+                                      Only supported on s390x with the virtio bus. For example: 0.0.0001
                                     type: string
                                   pciAddress:
                                     description: 'If specified, the virtual disk will
                                       be placed on the guests pci address with the
                                       specified PCI address. For example: 0000:81:01.10'
                                     type: string
+                                  queueSize:
+                                    description: |-
+                                      QueueSize is the size of each virtqueue of the disk.
+                                      Only supported with the virtio bus. Must be a power of 2 between 4 and 1024.
+                                      Disks on the scsi bus share the virtqueues of their virtio-scsi controller,
+                                      whose size libvirt does not allow to set.
+                                    format: int32
+                                    type: integer
                                   readonly:
                                     description: |-
                                       ReadOnly.
                                       Defaults to false.
                                     type: boolean
+                                  scsiController:
+                                    description: |-
+                                      SCSIController is the index of the SCSI controller the disk is attached to.
+                                      Only valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.
+                                    format: int32
+                                    type: integer
+                                  zoned:
+                                    description: |-
+                                      Zoned passes the zone semantics of a zoned block device, like a ZNS NVMe namespace,
+                                      through to the guest using the virtio-blk zoned model. Only supported with the virtio bus
+                                      on block volumes. Defaults to false.
+                                    type: boolean
                                 type: object
                               errorPolicy:
                                 description: If specified, it can change the default
@@ -6250,6 +6814,85 @@ var CRDsValidation map[string]string = map[string]string{
                                   IO specifies which QEMU disk IO mode should be used.
                                   Supported values are: native, default, threads.
                                 type: string
+                              ioTune:
+                                description: |-
+                                  IOTune throttles the IO of the disk, so that a single VMI cannot saturate the storage
+                                  shared with other tenants.
+                                properties:
+                                  burst:
+                                    description: Burst lets the disk exceed its limits
+                                      for a limited time.
+                                    properties:
+                                      lengthSeconds:
+                                        description: LengthSeconds is the longest
+                                          duration of a burst. Defaults to 1 second.
+                                        format: int64
+                                        type: integer
+                                      readBytesSec:
+                                        description: ReadBytesSec is the read throughput
+                                          the disk may reach while bursting, in bytes
+                                          per second.
+                                        format: int64
+                                        type: integer
+                                      readIopsSec:
+                                        description: ReadIOPSSec is the read operations
+                                          per second the disk may reach while bursting.
+                                        format: int64
+                                        type: integer
+                                      totalBytesSec:
+                                        description: TotalBytesSec is the throughput
+                                          the disk may reach while bursting, in bytes
+                                          per second.
+                                        format: int64
+                                        type: integer
+                                      totalIopsSec:
+                                        description: TotalIOPSSec is the IO operations
+                                          per second the disk may reach while bursting.
+                                        format: int64
+                                        type: integer
+                                      writeBytesSec:
+                                        description: WriteBytesSec is the write throughput
+                                          the disk may reach while bursting, in bytes
+                                          per second.
+                                        format: int64
+                                        type: integer
+                                      writeIopsSec:
+                                        description: WriteIOPSSec is the write operations
+                                          per second the disk may reach while bursting.
+                                        format: int64
+                                        type: integer
+                                    type: object
+                                  readBytesSec:
+                                    description: ReadBytesSec limits the read throughput
+                                      of the disk, in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  readIopsSec:
+                                    description: ReadIOPSSec limits the read operations
+                                      per second of the disk.
+                                    format: int64
+                                    type: integer
+                                  totalBytesSec:
+                                    description: TotalBytesSec limits the throughput
+                                      of the disk, in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  totalIopsSec:
+                                    description: TotalIOPSSec limits the IO operations
+                                      per second of the disk.
+                                    format: int64
+                                    type: integer
+                                  writeBytesSec:
+                                    description: WriteBytesSec limits the write throughput
+                                      of the disk, in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  writeIopsSec:
+                                    description: WriteIOPSSec limits the write operations
+                                      per second of the disk.
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -6268,10 +6911,22 @@ var CRDsValidation map[string]string = map[string]string{
                                       needs to support the persistent reservation
                                       for the SCSI disk
                                     type: boolean
+                                  scsiController:
+                                    description: |-
+                                      SCSIController is the index of the SCSI controller the LUN is attached to.
+                                      Only valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.
+                                    format: int32
+                                    type: integer
                                 type: object
                               name:
                                 description: Name is the device name
                                 type: string
+                              rerrorPolicy:
+                                description: |-
+                                  RerrorPolicy, if specified, overrides the error policy for read errors,
+                                  e.g. to report read errors while stopping on write errors.
+                                  Supported values are: stop, report, ignore. Defaults to the error policy.
+                                type: string
                               serial:
                                 description: Serial provides the ability to specify
                                   a serial number for the disk device.
@@ -6303,6 +6958,23 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                               virtiofs:
                                 description: Virtiofs is supported
+                                properties:
+                                  cache:
+                                    description: |-
+                                      Cache is the caching policy of virtiofsd for the shared directory.
+                                      Supported values: auto, always, metadata, never. Defaults to auto.
+                                    type: string
+                                  queueSize:
+                                    description: |-
+                                      QueueSize is the size of the virtqueue of the filesystem device.
+                                      Must be a power of 2 between 4 and 1024. Defaults to 1024.
+                                    format: int32
+                                    type: integer
+                                  xattr:
+                                    description: |-
+                                      Xattr enables the support of extended attributes in the shared directory.
+                                      Defaults to false.
+                                    type: boolean
                                 type: object
                             required:
                             - name
@@ -6401,7 +7073,7 @@ var CRDsValidation map[string]string = map[string]string{
                               bus:
                                 description: |-
                                   Bus indicates the bus of input device to emulate.
-                                  Supported values: virtio, usb.
+                                  Supported values: virtio, usb, ps2.
                                 type: string
                               name:
                                 description: Name is the device name
@@ -6409,7 +7081,7 @@ var CRDsValidation map[string]string = map[string]string{
                               type:
                                 description: |-
                                   Type indicated the type of input device.
-                                  Supported values: tablet.
+                                  Supported values: tablet, and keyboard or mouse on the ps2 bus.
                                 type: string
                             required:
                             - name
@@ -6452,6 +7124,26 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: InterfaceBridge connects to a given network
                                   via a linux bridge.
                                 type: object
+                              ccwAddress:
+                                description: |-
+                                  If specified, the virtual network interface will be placed on the guests CCW address with the specified device number.
+                                  Only supported on s390x. For example: 0.0.0002
+                                type: string
+                              coalesce:
+                                description: |-
+                                  Coalesce configures the interrupt coalescing of the interface, reducing the interrupt load
+                                  on the vCPUs of guests handling high packet rates.
+                                  Only supported by virtio interfaces backed by a tap device.
+                                properties:
+                                  rxMaxFrames:
+                                    description: RxMaxFrames is the maximum number
+                                      of received frames coalesced into a single interrupt.
+                                    format: int32
+                                    minimum: 1
+                                    type: integer
+                                required:
+                                - rxMaxFrames
+                                type: object
                               dhcpOptions:
                                 description: If specified the network interface will
                                   pass additional DHCP options to the VMI
@@ -6460,6 +7152,19 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: If specified will pass option 67
                                       to interface's DHCP server
                                     type: string
+                                  dnsServers:
+                                    description: |-
+                                      If specified will pass the configured DNS servers to the VM instead of the ones of the pod.
+                                      The pod itself keeps its DNS configuration. Only supported by the masquerade binding.
+                                    items:
+                                      type: string
+                                    type: array
+                                  nextServer:
+                                    description: |-
+                                      If specified will be passed as the BOOTP next server address (siaddr) to the interface's DHCP server.
+                                      Network boot firmwares which only look at the BOOTP header, like the s390x network boot loader,
+                                      need it to locate the TFTP server. Defaults to tftpServerName when that is an IPv4 address.
+                                    type: string
                                   ntpServers:
                                     description: If specified will pass the configured
                                       NTP server to the VM via DHCP option 042.
@@ -6488,6 +7193,13 @@ var CRDsValidation map[string]string = map[string]string{
                                       - value
                                       type: object
                                     type: array
+                                  searchDomains:
+                                    description: |-
+                                      If specified will pass the configured search domains to the VM instead of the ones of the pod.
+                                      The pod itself keeps its DNS configuration. Only supported by the masquerade binding.
+                                    items:
+                                      type: string
+                                    type: array
                                   tftpServerName:
                                     description: If specified will pass option 66
                                       to interface's DHCP server
@@ -6518,6 +7230,63 @@ var CRDsValidation map[string]string = map[string]string{
                                   Logical name of the interface as well as a reference to the associated networks.
                                   Must match the Name of a Network.
                                 type: string
+                              offloads:
+                                description: |-
+                                  Offloads toggles the checksum and segmentation offloads of the interface, e.g. for guests
+                                  running their own tunneling or encapsulation stack. Unset offloads keep their default.
+                                  Only supported by virtio interfaces.
+                                properties:
+                                  guest:
+                                    description: Guest toggles the offloads offered
+                                      to the guest driver.
+                                    properties:
+                                      csum:
+                                        description: Checksum toggles the checksum
+                                          offload.
+                                        type: boolean
+                                      tso4:
+                                        description: TSO4 toggles the TCP segmentation
+                                          offload over IPv4.
+                                        type: boolean
+                                      tso6:
+                                        description: TSO6 toggles the TCP segmentation
+                                          offload over IPv6.
+                                        type: boolean
+                                      ufo:
+                                        description: UFO toggles the UDP fragmentation
+                                          offload.
+                                        type: boolean
+                                    type: object
+                                  host:
+                                    description: Host toggles the offloads of the
+                                      interface backend on the host.
+                                    properties:
+                                      csum:
+                                        description: Checksum toggles the checksum
+                                          offload.
+                                        type: boolean
+                                      gso:
+                                        description: GSO toggles the generic segmentation
+                                          offload.
+                                        type: boolean
+                                      mrgRxBuf:
+                                        description: MergeRxBuffers toggles the mergeable
+                                          receive buffers.
+                                        type: boolean
+                                      tso4:
+                                        description: TSO4 toggles the TCP segmentation
+                                          offload over IPv4.
+                                        type: boolean
+                                      tso6:
+                                        description: TSO6 toggles the TCP segmentation
+                                          offload over IPv6.
+                                        type: boolean
+                                      ufo:
+                                        description: UFO toggles the UDP fragmentation
+                                          offload.
+                                        type: boolean
+                                    type: object
+                                type: object
                               passt:
                                 description: |-
                                   DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -6559,6 +7328,13 @@ var CRDsValidation map[string]string = map[string]string{
                                   - port
                                   type: object
                                 type: array
+                              rxQueueSize:
+                                description: |-
+                                  RxQueueSize is the number of descriptors of the receive virtqueues of the interface.
+                                  It must be a power of 2 between 256 and 1024. Defaults to 256.
+                                  Only supported by virtio interfaces.
+                                format: int32
+                                type: integer
                               slirp:
                                 description: |-
                                   DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -6582,6 +7358,13 @@ var CRDsValidation map[string]string = map[string]string{
                                   address and its tag will be provided to the guest
                                   via config drive
                                 type: string
+                              txQueueSize:
+                                description: |-
+                                  TxQueueSize is the number of descriptors of the transmit virtqueues of the interface.
+                                  It must be a power of 2 between 256 and 1024. Defaults to 256.
+                                  Only supported by virtio interfaces, QEMU applies it to vhost-user backends only.
+                                format: int32
+                                type: integer
                             required:
                             - name
                             type: object
@@ -6601,6 +7384,13 @@ var CRDsValidation map[string]string = map[string]string{
                             depends on additional factors of the VirtualMachineInstance,
                             like the number of guest CPUs.
                           type: boolean
+                        networkInterfaceRSS:
+                          description: If specified, virtio network interfaces with
+                            multiple queues distribute the received packets across
+                            their queues with receive side scaling (RSS) and report
+                            the packet hash to the guest. These interfaces are served
+                            by QEMU instead of the vhost kernel driver. Requires networkInterfaceMultiqueue.
+                          type: boolean
                         panicDevices:
                           description: PanicDevices provides additional crash information
                             when a guest crashes.
@@ -6610,7 +7400,7 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: |-
                                   Model specifies what type of panic device is provided.
                                   The panic model used when this attribute is missing depends on the hypervisor and guest arch.
-                                  One of: isa, hyperv, pvpanic.
+                                  One of: isa, hyperv, pvpanic, pvpanic-pci.
                                 type: string
                             type: object
                           type: array
@@ -6618,6 +7408,65 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Whether to have random number generator from
                             host
                           type: object
+                        scsiControllerCount:
+                          description: |-
+                            SCSIControllerCount is the number of virtio-scsi controllers to create.
+                            Disks and LUNs on the scsi bus can be mapped to a controller with scsiController,
+                            e.g. to exceed the queue limits of a single controller or to isolate workloads.
+                            Defaults to 1.
+                          format: int32
+                          minimum: 1
+                          type: integer
+                        scsiControllerQueues:
+                          description: |-
+                            SCSIControllerQueues is the number of request queues of each virtio-scsi controller.
+                            It allows multi-queue SCSI independently of the number of vCPUs, which is used otherwise
+                            when a disk on the scsi bus has a dedicated IOThread.
+                          format: int32
+                          minimum: 1
+                          type: integer
+                        serialConsoleTargetType:
+                          description: |-
+                            SerialConsoleTargetType selects the device exposing the auto-attached serial console to the guest.
+                            One of: isa-serial, pci-serial, usb-serial.
+                            Only supported on amd64, where it defaults to isa-serial.
+                          type: string
+                        serials:
+                          description: |-
+                            Serials describes additional serial ports exposed to the guest after the auto-attached serial console,
+                            e.g. for appliances expecting multiple UARTs.
+                            Up to 4 serial ports are supported, including the auto-attached serial console.
+                          items:
+                            description: Serial represents an additional serial port
+                              of the vmi, backed by a pty in the virt-launcher pod.
+                            properties:
+                              name:
+                                description: |-
+                                  Name of the serial port. Its output is logged to /var/run/kubevirt-private/<vmi uid>/virt-serial-<name>-log
+                                  in the virt-launcher pod.
+                                type: string
+                            required:
+                            - name
+                            type: object
+                          maxItems: 4
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        sgx:
+                          description: SGX provides an Intel SGX enclave page cache
+                            to the guest.
+                          properties:
+                            epcSize:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: |-
+                                EPCSize is the size of the enclave page cache of the guest, it is rounded up to a multiple of 1Mi.
+                                It is allocated from the EPC of the node, which is exposed as the devices.kubevirt.io/sgx-epc resource.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                          required:
+                          - epcSize
+                          type: object
                         sound:
                           description: Whether to emulate a sound device.
                           properties:
@@ -6641,11 +7490,31 @@ var CRDsValidation map[string]string = map[string]string{
                                 Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine
                                 Defaults to True
                               type: boolean
+                            external:
+                              description: |-
+                                External connects the vTPM to an externally managed swtpm listening on a unix socket on the node,
+                                e.g. for clusters with a centralized vTPM key management, instead of a swtpm spawned by virt-launcher.
+                                The version is then set by the external swtpm. It cannot be combined with persistent.
+                              properties:
+                                socketPath:
+                                  description: |-
+                                    SocketPath is the absolute path of the unix socket of the swtpm on the node.
+                                    It has to be in the directory of the namespace of the VMI in a permitted directory, like /var/run/vtpm/<namespace>/vm.sock.
+                                    The directory of the socket is mounted into the virt-launcher pod.
+                                  type: string
+                              required:
+                              - socketPath
+                              type: object
                             persistent:
                               description: |-
                                 Persistent indicates the state of the TPM device should be kept accross reboots
                                 Defaults to false
                               type: boolean
+                            version:
+                              description: |-
+                                Version is the version of the TPM specification implemented by the vTPM.
+                                One of: 1.2, 2.0. Defaults to 2.0.
+                              type: string
                           type: object
                         useVirtioTransitional:
                           description: |-
@@ -6657,12 +7526,75 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Video describes the video device configuration
                             for the vmi.
                           properties:
+                            accel3d:
+                              description: |-
+                                Accel3D enables the 3D acceleration of a virtio video device, either with virgl (OpenGL)
+                                or with venus (Vulkan). It requires a render node of a host GPU.
+                              type: string
+                            heads:
+                              description: |-
+                                Heads is the number of display heads of the video device.
+                                Defaults to 1.
+                              format: int32
+                              type: integer
                             type:
                               description: |-
                                 Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).
                                 If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).
                               type: string
+                            vram:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: |-
+                                VRAM is the size of the video memory of the video device.
+                                Defaults to 16Mi.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
                           type: object
+                        videos:
+                          description: |-
+                            Videos describes multiple video devices for the vmi, the first one being the primary device.
+                            It cannot be combined with video.
+                          items:
+                            properties:
+                              accel3d:
+                                description: |-
+                                  Accel3D enables the 3D acceleration of a virtio video device, either with virgl (OpenGL)
+                                  or with venus (Vulkan). It requires a render node of a host GPU.
+                                type: string
+                              heads:
+                                description: |-
+                                  Heads is the number of display heads of the video device.
+                                  Defaults to 1.
+                                format: int32
+                                type: integer
+                              type:
+                                description: |-
+                                  Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).
+                                  If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).
+                                type: string
+                              vram:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: |-
+                                  VRAM is the size of the video memory of the video device.
+                                  Defaults to 16Mi.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        virtioTransitionalDevices:
+                          description: |-
+                            VirtioTransitionalDevices lists the classes of virtio devices falling back to legacy virtio 0.9 support,
+                            e.g. for guests shipping a legacy disk driver but a virtio 1.0 network driver.
+                            All virtio devices fall back to legacy virtio 0.9 support when UseVirtioTransitional is set.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
                         watchdog:
                           description: Watchdog describes a watchdog device which
                             can be added to the vmi.
@@ -6987,6 +7919,32 @@ var CRDsValidation map[string]string = map[string]string{
                                   type: boolean
                               type: object
                           type: object
+                        fwCfg:
+                          description: |-
+                            FWCfg are the QEMU firmware configuration (fw_cfg) entries exposed to the guest,
+                            e.g. an ignition config or a custom early-boot provisioning blob.
+                          items:
+                            properties:
+                              key:
+                                description: Key of the ConfigMap or Secret whose
+                                  data is the content of the entry.
+                                type: string
+                              name:
+                                description: |-
+                                  Name of the entry as seen by the guest, e.g. opt/com.coreos/config.
+                                  It must start with opt/ and must not exceed 55 characters.
+                                type: string
+                              volumeNameRef:
+                                description: VolumeNameRef should match the volume
+                                  name of a ConfigMap or Secret object.
+                                type: string
+                            required:
+                            - key
+                            - name
+                            - volumeNameRef
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
                         kernelBoot:
                           description: Settings to set the kernel for booting.
                           properties:
@@ -7026,10 +7984,27 @@ var CRDsValidation map[string]string = map[string]string{
                               description: Arguments to be passed to the kernel at
                                 boot time
                               type: string
+                            rootFilesystem:
+                              description: |-
+                                RootFilesystem is the name of a virtiofs filesystem the guest mounts as its root filesystem,
+                                so that it boots without any disk. The kernel arguments must not set root or rootfstype.
+                              type: string
                           type: object
+                        oemStrings:
+                          description: |-
+                            OEMStrings are exposed to the guest as SMBIOS OEM strings (type 11),
+                            e.g. to pass provisioning hints to the guest.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
                         serial:
                           description: The system-serial-number in SMBIOS
                           type: string
+                        sku:
+                          description: The system SKU number in SMBIOS, overriding
+                            the cluster-wide SMBIOS configuration.
+                          type: string
                         uuid:
                           description: |-
                             UUID reported by the vmi bios.
@@ -7039,6 +8014,14 @@ var CRDsValidation map[string]string = map[string]string{
                     ioThreads:
                       description: IOThreads specifies the IOThreads options.
                       properties:
+                        pinning:
+                          additionalProperties:
+                            type: string
+                          description: |-
+                            Pinning pins iothreads to explicit host CPUs instead of the ones derived from the dedicated CPUs of the vmi.
+                            The keys are the iothread IDs, starting from 1, and the values are the cpusets, e.g. "4-5,7".
+                            The CPUs have to be allocated to the vmi. Requires dedicatedCpuPlacement.
+                          type: object
                         supplementalPoolThreadCount:
                           description: SupplementalPoolThreadCount specifies how many
                             iothreads are allocated for the supplementalPool policy.
@@ -7108,10 +8091,50 @@ var CRDsValidation map[string]string = map[string]string{
                             Defaults to the requested memory in the resources section if not specified.
                           pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                           x-kubernetes-int-or-string: true
+                        hotplug:
+                          description: Hotplug configures how the memory between Guest
+                            and MaxGuest is hot(un)plugged.
+                          properties:
+                            virtioMem:
+                              description: |-
+                                VirtioMem plugs the memory through a virtio-mem device which is created when the VirtualMachineInstance starts.
+                                The guest memory can then be grown and shrunk in blocks of BlockSize.
+                              properties:
+                                blockSize:
+                                  anyOf:
+                                  - type: integer
+                                  - type: string
+                                  description: |-
+                                    BlockSize is the granularity in which memory is plugged and unplugged.
+                                    It must be a power of 2 and at least 2Mi, or 1Gi when 1Gi hugepages are used.
+                                    Defaults to the smallest allowed value.
+                                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                  x-kubernetes-int-or-string: true
+                              type: object
+                          type: object
                         hugepages:
                           description: Hugepages allow to use hugepages for the VirtualMachineInstance
                             instead of regular memory.
                           properties:
+                            numaPolicy:
+                              description: |-
+                                NUMAPolicy binds the hugepages backing the guest memory to host NUMA nodes,
+                                so that large guests do not allocate their pages across nodes.
+                              properties:
+                                mode:
+                                  description: |-
+                                    Mode is the host NUMA memory allocation policy.
+                                    One of: strict, interleave, preferred.
+                                  type: string
+                                nodeSet:
+                                  description: |-
+                                    NodeSet is the list of host NUMA nodes the hugepages are allocated from, e.g. "0-1,3".
+                                    The nodes must be allowed by the cpuset of the virt-launcher pod.
+                                  type: string
+                              required:
+                              - mode
+                              - nodeSet
+                              type: object
                             pageSize:
                               description: PageSize specifies the hugepage size, for
                                 x86_64 architecture valid values are 1Gi and 2Mi.
@@ -7172,6 +8195,20 @@ var CRDsValidation map[string]string = map[string]string{
                     - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                     - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                   type: string
+                guestAgent:
+                  description: GuestAgent restricts the qemu-guest-agent commands
+                    KubeVirt sends to the guest.
+                  properties:
+                    allowedCommands:
+                      description: |-
+                        AllowedCommands lists the guest agent commands KubeVirt may execute in the guest, e.g. guest-get-fsinfo.
+                        The other commands are refused by virt-launcher, except guest-ping and guest-info which are
+                        needed to detect the agent. All commands are allowed when guestAgent is not set.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: set
+                  type: object
                 hostname:
                   description: |-
                     Specifies the hostname of the vmi
@@ -7574,6 +8611,37 @@ var CRDsValidation map[string]string = map[string]string{
                     If specified, the VMI will be dispatched by specified scheduler.
                     If not specified, the VMI will be dispatched by default scheduler.
                   type: string
+                shutdownLadder:
+                  description: |-
+                    ShutdownLadder escalates the graceful shutdown through steps with their own timeouts before the
+                    VirtualMachineInstance is force terminated. TerminationGracePeriodSeconds defaults to the sum
+                    of the step timeouts when it is not set.
+                  properties:
+                    steps:
+                      description: |-
+                        Steps are tried in order, each one for its own timeout, before escalating to the next one.
+                        The domain is destroyed once the last step timed out.
+                      items:
+                        description: ShutdownStep is one step of a ShutdownLadder.
+                        properties:
+                          method:
+                            description: Method used to ask the guest to power off,
+                              one of GuestAgent or ACPI.
+                            type: string
+                          timeoutSeconds:
+                            description: TimeoutSeconds is the time given to the
+                              guest to power off before escalating to the next step.
+                            format: int64
+                            type: integer
+                        required:
+                        - method
+                        - timeoutSeconds
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  required:
+                  - steps
+                  type: object
                 startStrategy:
                   description: StartStrategy can be set to "Paused" if Virtual Machine
                     should be started in paused state.
@@ -8150,6 +9218,19 @@ var CRDsValidation map[string]string = map[string]string{
                             - claimName
                             type: object
                         type: object
+                      hostBlockDevice:
+                        description: |-
+                          HostBlockDevice passes a raw block device of the node straight through to the vmi as a virtio disk.
+                          The path has to be permitted in the hostBlockDevices configuration of KubeVirt and the device is
+                          used exclusively by the vmi.
+                        properties:
+                          path:
+                            description: Path of the block device on the node, like
+                              /dev/nvme1n1.
+                            type: string
+                        required:
+                        - path
+                        type: object
                       hostDisk:
                         description: HostDisk represents a disk created on the cluster
                           level
@@ -8187,6 +9268,11 @@ var CRDsValidation map[string]string = map[string]string{
                               claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                               More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                             type: string
+                          encryptionPublicKey:
+                            description: |-
+                              EncryptionPublicKey is a PEM encoded RSA public key.
+                              When set, the memory dump is encrypted with it before being written to the pvc.
+                            type: string
                           hotpluggable:
                             description: Hotpluggable indicates whether the volume
                               can be hotplugged and hotunplugged.
@@ -8297,6 +9383,19 @@ var CRDsValidation map[string]string = map[string]string{
                             type: object
                             x-kubernetes-map-type: atomic
                         type: object
+                      vhostUserBlk:
+                        description: |-
+                          VhostUserBlk represents a block device served by a vhost-user-blk backend, such as SPDK,
+                          listening on a unix socket on the node.
+                        properties:
+                          path:
+                            description: |-
+                              Path of the unix socket of the vhost-user-blk backend on the node.
+                              It has to be in the directory of the namespace of the VMI in a permitted directory, like /var/tmp/spdk/<namespace>/vhost.0.
+                            type: string
+                        required:
+                        - path
+                        type: object
                     required:
                     - name
                     type: object
@@ -8429,6 +9528,11 @@ var CRDsValidation map[string]string = map[string]string{
               description: ClaimName is the name of the pvc that will contain the
                 memory dump
               type: string
+            encryptionPublicKey:
+              description: |-
+                EncryptionPublicKey is a PEM encoded RSA public key.
+                When set, the memory dump is encrypted with it before being written to the pvc.
+              type: string
             endTimestamp:
               description: EndTimestamp represents the time the memory dump was completed
               format: date-time
@@ -8644,18 +9748,43 @@ var CRDsValidation map[string]string = map[string]string{
                           bus:
                             description: |-
                               Bus indicates the type of disk device to emulate.
-                              supported values: virtio, sata, scsi, usb.
+                              supported values: virtio, sata, scsi, usb, nvme.
+                            type: string
+                          ccwAddress:
+                            description: |-
+                              If specified, the virtual disk will be placed on the guests CCW address with the specified device number.
+                              Only supported on s390x with the virtio bus. For example: 0.0.0001
                             type: string
                           pciAddress:
                             description: 'If specified, the virtual disk will be placed
                               on the guests pci address with the specified PCI address.
                               For example: 0000:81:01.10'
                             type: string
+                          queueSize:
+                            description: |-
+                              QueueSize is the size of each virtqueue of the disk.
+                              Only supported with the virtio bus. Must be a power of 2 between 4 and 1024.
+                              Disks on the scsi bus share the virtqueues of their virtio-scsi controller,
+                              whose size libvirt does not allow to set.
+                            format: int32
+                            type: integer
                           readonly:
                             description: |-
                               ReadOnly.
                               Defaults to false.
                             type: boolean
+                          scsiController:
+                            description: |-
+                              SCSIController is the index of the SCSI controller the disk is attached to.
+                              Only valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.
+                            format: int32
+                            type: integer
+                          zoned:
+                            description: |-
+                              Zoned passes the zone semantics of a zoned block device, like a ZNS NVMe namespace,
+                              through to the guest using the virtio-blk zoned model. Only supported with the virtio bus
+                              on block volumes. Defaults to false.
+                            type: boolean
                         type: object
                       errorPolicy:
                         description: If specified, it can change the default error
@@ -8666,6 +9795,83 @@ var CRDsValidation map[string]string = map[string]string{
                           IO specifies which QEMU disk IO mode should be used.
                           Supported values are: native, default, threads.
                         type: string
+                      ioTune:
+                        description: |-
+                          IOTune throttles the IO of the disk, so that a single VMI cannot saturate the storage
+                          shared with other tenants.
+                        properties:
+                          burst:
+                            description: Burst lets the disk exceed its limits for
+                              a limited time.
+                            properties:
+                              lengthSeconds:
+                                description: LengthSeconds is the longest duration
+                                  of a burst. Defaults to 1 second.
+                                format: int64
+                                type: integer
+                              readBytesSec:
+                                description: ReadBytesSec is the read throughput the
+                                  disk may reach while bursting, in bytes per second.
+                                format: int64
+                                type: integer
+                              readIopsSec:
+                                description: ReadIOPSSec is the read operations per
+                                  second the disk may reach while bursting.
+                                format: int64
+                                type: integer
+                              totalBytesSec:
+                                description: TotalBytesSec is the throughput the disk
+                                  may reach while bursting, in bytes per second.
+                                format: int64
+                                type: integer
+                              totalIopsSec:
+                                description: TotalIOPSSec is the IO operations per
+                                  second the disk may reach while bursting.
+                                format: int64
+                                type: integer
+                              writeBytesSec:
+                                description: WriteBytesSec is the write throughput
+                                  the disk may reach while bursting, in bytes per
+                                  second.
+                                format: int64
+                                type: integer
+                              writeIopsSec:
+                                description: WriteIOPSSec is the write operations
+                                  per second the disk may reach while bursting.
+                                format: int64
+                                type: integer
+                            type: object
+                          readBytesSec:
+                            description: ReadBytesSec limits the read throughput of
+                              the disk, in bytes per second.
+                            format: int64
+                            type: integer
+                          readIopsSec:
+                            description: ReadIOPSSec limits the read operations per
+                              second of the disk.
+                            format: int64
+                            type: integer
+                          totalBytesSec:
+                            description: TotalBytesSec limits the throughput of the
+                              disk, in bytes per second.
+                            format: int64
+                            type: integer
+                          totalIopsSec:
+                            description: TotalIOPSSec limits the IO operations per
+                              second of the disk.
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            description: WriteBytesSec limits the write throughput
+                              of the disk, in bytes per second.
+                            format: int64
+                            type: integer
+                          writeIopsSec:
+                            description: WriteIOPSSec limits the write operations
+                              per second of the disk.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -8683,10 +9889,22 @@ var CRDsValidation map[string]string = map[string]string{
                             description: Reservation indicates if the disk needs to
                               support the persistent reservation for the SCSI disk
                             type: boolean
+                          scsiController:
+                            description: |-
+                              SCSIController is the index of the SCSI controller the LUN is attached to.
+                              Only valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.
+                            format: int32
+                            type: integer
                         type: object
                       name:
                         description: Name is the device name
                         type: string
+                      rerrorPolicy:
+                        description: |-
+                          RerrorPolicy, if specified, overrides the error policy for read errors,
+                          e.g. to report read errors while stopping on write errors.
+                          Supported values are: stop, report, ignore. Defaults to the error policy.
+                        type: string
                       serial:
                         description: Serial provides the ability to specify a serial
                           number for the disk device.
@@ -8971,6 +10189,16 @@ var CRDsValidation map[string]string = map[string]string{
       description: VirtualMachineBackupSpec is the spec for a VirtualMachineBackup
         resource
       properties:
+        bandwidth:
+          anyOf:
+          - type: integer
+          - type: string
+          description: |-
+            Bandwidth limits the rate at which each disk is read by the backup job,
+            so that the guest IO is not starved while the backup runs.
+            The value is in quantity per second. Defaults to 0 (no limit)
+          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+          x-kubernetes-int-or-string: true
         forceFullBackup:
           description: ForceFullBackup indicates that a full backup is desired
           type: boolean
@@ -9300,6 +10528,62 @@ var CRDsValidation map[string]string = map[string]string{
                     GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
                     The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
                   type: object
+                manual:
+                  description: |-
+                    Manual defines the guest numa topology explicitly, independently of the host numa topology.
+                    It is mutually exclusive with GuestMappingPassthrough.
+                  properties:
+                    nodes:
+                      description: Nodes of the guest numa topology. The index of
+                        a node in the list is its numa node id.
+                      items:
+                        description: NUMANode describes a guest numa node.
+                        properties:
+                          cpus:
+                            description: CPUs is the list of vCPUs belonging to the
+                              node, e.g. "0-3,8". Every vCPU must belong to exactly
+                              one node.
+                            type: string
+                          distances:
+                            description: |-
+                              Distances from this node to the other nodes. An unset distance is the distance set from the other node back
+                              to this one, or defaults to 10 to the node itself and to 20 to the other nodes.
+                            items:
+                              description: NUMADistance is the distance from a guest
+                                numa node to another one.
+                              properties:
+                                node:
+                                  description: Node is the id of the other numa node.
+                                  format: int32
+                                  type: integer
+                                value:
+                                  description: Value of the distance, between 10 and
+                                    255.
+                                  format: int32
+                                  type: integer
+                              required:
+                              - node
+                              - value
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          memory:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: Memory of the node. The memory of all the
+                              nodes must add up to the guest memory.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                        required:
+                        - cpus
+                        - memory
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  required:
+                  - nodes
+                  type: object
               type: object
             realtime:
               description: Realtime instructs the virt-launcher to tune the VMI for
@@ -9397,6 +10681,14 @@ var CRDsValidation map[string]string = map[string]string{
           description: Optionally specifies the IOThreads options to be used by the
             instancetype.
           properties:
+            pinning:
+              additionalProperties:
+                type: string
+              description: |-
+                Pinning pins iothreads to explicit host CPUs instead of the ones derived from the dedicated CPUs of the vmi.
+                The keys are the iothread IDs, starting from 1, and the values are the cpusets, e.g. "4-5,7".
+                The CPUs have to be allocated to the vmi. Requires dedicatedCpuPlacement.
+              type: object
             supplementalPoolThreadCount:
               description: SupplementalPoolThreadCount specifies how many iothreads
                 are allocated for the supplementalPool policy.
@@ -9455,6 +10747,25 @@ var CRDsValidation map[string]string = map[string]string{
               description: Optionally enables the use of hugepages for the VirtualMachineInstance
                 instead of regular memory.
               properties:
+                numaPolicy:
+                  description: |-
+                    NUMAPolicy binds the hugepages backing the guest memory to host NUMA nodes,
+                    so that large guests do not allocate their pages across nodes.
+                  properties:
+                    mode:
+                      description: |-
+                        Mode is the host NUMA memory allocation policy.
+                        One of: strict, interleave, preferred.
+                      type: string
+                    nodeSet:
+                      description: |-
+                        NodeSet is the list of host NUMA nodes the hugepages are allocated from, e.g. "0-1,3".
+                        The nodes must be allowed by the cpuset of the virt-launcher pod.
+                      type: string
+                  required:
+                  - mode
+                  - nodeSet
+                  type: object
                 pageSize:
                   description: PageSize specifies the hugepage size, for x86_64 architecture
                     valid values are 1Gi and 2Mi.
@@ -9808,16 +11119,44 @@ var CRDsValidation map[string]string = map[string]string{
                     Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine
                     Defaults to True
                   type: boolean
+                external:
+                  description: |-
+                    External connects the vTPM to an externally managed swtpm listening on a unix socket on the node,
+                    e.g. for clusters with a centralized vTPM key management, instead of a swtpm spawned by virt-launcher.
+                    The version is then set by the external swtpm. It cannot be combined with persistent.
+                  properties:
+                    socketPath:
+                      description: |-
+                        SocketPath is the absolute path of the unix socket of the swtpm on the node.
+                        It has to be in the directory of the namespace of the VMI in a permitted directory, like /var/run/vtpm/<namespace>/vm.sock.
+                        The directory of the socket is mounted into the virt-launcher pod.
+                      type: string
+                  required:
+                  - socketPath
+                  type: object
                 persistent:
                   description: |-
                     Persistent indicates the state of the TPM device should be kept accross reboots
                     Defaults to false
                   type: boolean
+                version:
+                  description: |-
+                    Version is the version of the TPM specification implemented by the vTPM.
+                    One of: 1.2, 2.0. Defaults to 2.0.
+                  type: string
               type: object
             preferredUseVirtioTransitional:
               description: PreferredUseVirtioTransitional optionally defines the preferred
                 value of UseVirtioTransitional
               type: boolean
+            preferredVirtioTransitionalDevices:
+              description: |-
+                PreferredVirtioTransitionalDevices optionally defines the preferred value of VirtioTransitionalDevices,
+                e.g. to mark the device classes for which an old guest only has legacy virtio 0.9 drivers.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
             preferredVirtualGPUOptions:
               description: PreferredVirtualGPUOptions optionally defines the preferred
                 value of VirtualGPUOptions
@@ -11568,6 +12907,12 @@ var CRDsValidation map[string]string = map[string]string{
             clock:
               description: Clock sets the clock and timers of the vmi.
               properties:
+                ptp:
+                  description: |-
+                    PTP exposes a virtual PTP hardware clock (kvm-ptp) to the guest, so that it can
+                    discipline its clock to the host clock through the ptp_kvm driver.
+                    Only supported on amd64, where it requires the kvm timer, and arm64.
+                  type: object
                 timer:
                   description: Timer specifies whih timers are attached to the vmi.
                   properties:
@@ -11662,6 +13007,23 @@ var CRDsValidation map[string]string = map[string]string{
               description: CPU allow specified the detailed CPU topology inside the
                 vmi.
               properties:
+                cache:
+                  description: Cache describes how the CPU cache is presented to the
+                    guest, e.g. passing the host L3 cache through.
+                  properties:
+                    level:
+                      description: |-
+                        Level is the cache level the mode applies to.
+                        Only level 3 can be emulated or disabled, passthrough applies to all the levels.
+                      format: int32
+                      type: integer
+                    mode:
+                      description: Mode is the cache mode, one of passthrough, emulate
+                        or disable.
+                      type: string
+                  required:
+                  - mode
+                  type: object
                 cores:
                   description: |-
                     Cores specifies the number of cores inside the vmi.
@@ -11724,6 +13086,63 @@ var CRDsValidation map[string]string = map[string]string{
                         GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
                         The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
                       type: object
+                    manual:
+                      description: |-
+                        Manual defines the guest numa topology explicitly, independently of the host numa topology.
+                        It is mutually exclusive with GuestMappingPassthrough.
+                      properties:
+                        nodes:
+                          description: Nodes of the guest numa topology. The index
+                            of a node in the list is its numa node id.
+                          items:
+                            description: NUMANode describes a guest numa node.
+                            properties:
+                              cpus:
+                                description: CPUs is the list of vCPUs belonging to
+                                  the node, e.g. "0-3,8". Every vCPU must belong to
+                                  exactly one node.
+                                type: string
+                              distances:
+                                description: |-
+                                  Distances from this node to the other nodes. An unset distance is the distance set from the other node back
+                                  to this one, or defaults to 10 to the node itself and to 20 to the other nodes.
+                                items:
+                                  description: NUMADistance is the distance from a
+                                    guest numa node to another one.
+                                  properties:
+                                    node:
+                                      description: Node is the id of the other numa
+                                        node.
+                                      format: int32
+                                      type: integer
+                                    value:
+                                      description: Value of the distance, between
+                                        10 and 255.
+                                      format: int32
+                                      type: integer
+                                  required:
+                                  - node
+                                  - value
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              memory:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Memory of the node. The memory of all
+                                  the nodes must add up to the guest memory.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - cpus
+                            - memory
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      required:
+                      - nodes
+                      type: object
                   type: object
                 realtime:
                   description: Realtime instructs the virt-launcher to tune the VMI
@@ -11781,11 +13200,54 @@ var CRDsValidation map[string]string = map[string]string{
                     Whether to attach the VSOCK CID to the VM or not.
                     VSOCK access will be available if set to true. Defaults to false.
                   type: boolean
+                balloon:
+                  description: Balloon configures the memory reclaim behavior of the
+                    memory balloon device.
+                  properties:
+                    deflateOnOOM:
+                      description: |-
+                        DeflateOnOOM lets the guest deflate the balloon when it runs out of memory,
+                        instead of invoking its OOM killer.
+                        Defaults to false.
+                      type: boolean
+                    disableStatsWithoutDriver:
+                      description: |-
+                        DisableStatsWithoutDriver stops the balloon statistics while the guest agent reports that the
+                        guest has no balloon driver, e.g. a Windows guest without the virtio drivers, as QEMU would keep on
+                        requesting statistics which the guest never provides. They are restored once the driver is installed.
+                        Defaults to false.
+                      type: boolean
+                    freePageReporting:
+                      description: |-
+                        FreePageReporting lets the guest report its free pages to the host so they can be reclaimed.
+                        It overrides the cluster-wide setting and the free page reporting annotation, but is always
+                        disabled for high performance vmis, e.g. with dedicated CPUs or hugepages.
+                      type: boolean
+                  type: object
                 blockMultiQueue:
                   description: |-
                     Whether or not to enable virtio multi-queue for block devices.
                     Defaults to false.
                   type: boolean
+                channels:
+                  description: |-
+                    Channels describes additional virtio-serial channels exposed to the vmi,
+                    each backed by a unix socket in the virt-launcher pod, e.g. for host-guest agents.
+                  items:
+                    description: Channel represents a virtio-serial channel backed
+                      by a unix socket in the virt-launcher pod.
+                    properties:
+                      name:
+                        description: |-
+                          Name is the name of the virtio-serial port seen by the guest, e.g. org.example.agent.0.
+                          The socket is created at /var/run/kubevirt-channels/<name>.sock in the virt-launcher pod. The directory
+                          is a volume shared with the hook sidecars of the vmi, which connect to the socket to talk to the guest.
+                        type: string
+                    required:
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 clientPassthrough:
                   description: To configure and access client devices such as redirecting
                     USB
@@ -11876,18 +13338,43 @@ var CRDsValidation map[string]string = map[string]string{
                           bus:
                             description: |-
                               Bus indicates the type of disk device to emulate.
-                              supported values: virtio, sata, scsi, usb.
+                              supported values: virtio, sata, scsi, usb, nvme.
+                            type: string
+                          ccwAddress:
+                            description: |-
+                              If specified, the virtual disk will be placed on the guests CCW address with the specified device number.
+                              Only supported on s390x with the virtio bus. For example: 0.0.0001
                             type: string
                           pciAddress:
                             description: 'If specified, the virtual disk will be placed
                               on the guests pci address with the specified PCI address.
                               For example: 0000:81:01.10'
                             type: string
+                          queueSize:
+                            description: |-
+                              QueueSize is the size of each virtqueue of the disk.
+                              Only supported with the virtio bus. Must be a power of 2 between 4 and 1024.
+                              Disks on the scsi bus share the virtqueues of their virtio-scsi controller,
+                              whose size libvirt does not allow to set.
+                            format: int32
+                            type: integer
                           readonly:
                             description: |-
                               ReadOnly.
                               Defaults to false.
                             type: boolean
+                          scsiController:
+                            description: |-
+                              SCSIController is the index of the SCSI controller the disk is attached to.
+                              Only valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.
+                            format: int32
+                            type: integer
+                          zoned:
+                            description: |-
+                              Zoned passes the zone semantics of a zoned block device, like a ZNS NVMe namespace,
+                              through to the guest using the virtio-blk zoned model. Only supported with the virtio bus
+                              on block volumes. Defaults to false.
+                            type: boolean
                         type: object
                       errorPolicy:
                         description: If specified, it can change the default error
@@ -11898,6 +13385,83 @@ var CRDsValidation map[string]string = map[string]string{
                           IO specifies which QEMU disk IO mode should be used.
                           Supported values are: native, default, threads.
                         type: string
+                      ioTune:
+                        description: |-
+                          IOTune throttles the IO of the disk, so that a single VMI cannot saturate the storage
+                          shared with other tenants.
+                        properties:
+                          burst:
+                            description: Burst lets the disk exceed its limits for
+                              a limited time.
+                            properties:
+                              lengthSeconds:
+                                description: LengthSeconds is the longest duration
+                                  of a burst. Defaults to 1 second.
+                                format: int64
+                                type: integer
+                              readBytesSec:
+                                description: ReadBytesSec is the read throughput the
+                                  disk may reach while bursting, in bytes per second.
+                                format: int64
+                                type: integer
+                              readIopsSec:
+                                description: ReadIOPSSec is the read operations per
+                                  second the disk may reach while bursting.
+                                format: int64
+                                type: integer
+                              totalBytesSec:
+                                description: TotalBytesSec is the throughput the disk
+                                  may reach while bursting, in bytes per second.
+                                format: int64
+                                type: integer
+                              totalIopsSec:
+                                description: TotalIOPSSec is the IO operations per
+                                  second the disk may reach while bursting.
+                                format: int64
+                                type: integer
+                              writeBytesSec:
+                                description: WriteBytesSec is the write throughput
+                                  the disk may reach while bursting, in bytes per
+                                  second.
+                                format: int64
+                                type: integer
+                              writeIopsSec:
+                                description: WriteIOPSSec is the write operations
+                                  per second the disk may reach while bursting.
+                                format: int64
+                                type: integer
+                            type: object
+                          readBytesSec:
+                            description: ReadBytesSec limits the read throughput of
+                              the disk, in bytes per second.
+                            format: int64
+                            type: integer
+                          readIopsSec:
+                            description: ReadIOPSSec limits the read operations per
+                              second of the disk.
+                            format: int64
+                            type: integer
+                          totalBytesSec:
+                            description: TotalBytesSec limits the throughput of the
+                              disk, in bytes per second.
+                            format: int64
+                            type: integer
+                          totalIopsSec:
+                            description: TotalIOPSSec limits the IO operations per
+                              second of the disk.
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            description: WriteBytesSec limits the write throughput
+                              of the disk, in bytes per second.
+                            format: int64
+                            type: integer
+                          writeIopsSec:
+                            description: WriteIOPSSec limits the write operations
+                              per second of the disk.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -11915,10 +13479,22 @@ var CRDsValidation map[string]string = map[string]string{
                             description: Reservation indicates if the disk needs to
                               support the persistent reservation for the SCSI disk
                             type: boolean
+                          scsiController:
+                            description: |-
+                              SCSIController is the index of the SCSI controller the LUN is attached to.
+                              Only valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.
+                            format: int32
+                            type: integer
                         type: object
                       name:
                         description: Name is the device name
                         type: string
+                      rerrorPolicy:
+                        description: |-
+                          RerrorPolicy, if specified, overrides the error policy for read errors,
+                          e.g. to report read errors while stopping on write errors.
+                          Supported values are: stop, report, ignore. Defaults to the error policy.
+                        type: string
                       serial:
                         description: Serial provides the ability to specify a serial
                           number for the disk device.
@@ -11950,6 +13526,23 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                       virtiofs:
                         description: Virtiofs is supported
+                        properties:
+                          cache:
+                            description: |-
+                              Cache is the caching policy of virtiofsd for the shared directory.
+                              Supported values: auto, always, metadata, never. Defaults to auto.
+                            type: string
+                          queueSize:
+                            description: |-
+                              QueueSize is the size of the virtqueue of the filesystem device.
+                              Must be a power of 2 between 4 and 1024. Defaults to 1024.
+                            format: int32
+                            type: integer
+                          xattr:
+                            description: |-
+                              Xattr enables the support of extended attributes in the shared directory.
+                              Defaults to false.
+                            type: boolean
                         type: object
                     required:
                     - name
@@ -12046,7 +13639,7 @@ var CRDsValidation map[string]string = map[string]string{
                       bus:
                         description: |-
                           Bus indicates the bus of input device to emulate.
-                          Supported values: virtio, usb.
+                          Supported values: virtio, usb, ps2.
                         type: string
                       name:
                         description: Name is the device name
@@ -12054,7 +13647,7 @@ var CRDsValidation map[string]string = map[string]string{
                       type:
                         description: |-
                           Type indicated the type of input device.
-                          Supported values: tablet.
+                          Supported values: tablet, and keyboard or mouse on the ps2 bus.
                         type: string
                     required:
                     - name
@@ -12097,6 +13690,26 @@ var CRDsValidation map[string]string = map[string]string{
                         description: InterfaceBridge connects to a given network via
                           a linux bridge.
                         type: object
+                      ccwAddress:
+                        description: |-
+                          If specified, the virtual network interface will be placed on the guests CCW address with the specified device number.
+                          Only supported on s390x. For example: 0.0.0002
+                        type: string
+                      coalesce:
+                        description: |-
+                          Coalesce configures the interrupt coalescing of the interface, reducing the interrupt load
+                          on the vCPUs of guests handling high packet rates.
+                          Only supported by virtio interfaces backed by a tap device.
+                        properties:
+                          rxMaxFrames:
+                            description: RxMaxFrames is the maximum number of received
+                              frames coalesced into a single interrupt.
+                            format: int32
+                            minimum: 1
+                            type: integer
+                        required:
+                        - rxMaxFrames
+                        type: object
                       dhcpOptions:
                         description: If specified the network interface will pass
                           additional DHCP options to the VMI
@@ -12105,6 +13718,19 @@ var CRDsValidation map[string]string = map[string]string{
                             description: If specified will pass option 67 to interface's
                               DHCP server
                             type: string
+                          dnsServers:
+                            description: |-
+                              If specified will pass the configured DNS servers to the VM instead of the ones of the pod.
+                              The pod itself keeps its DNS configuration. Only supported by the masquerade binding.
+                            items:
+                              type: string
+                            type: array
+                          nextServer:
+                            description: |-
+                              If specified will be passed as the BOOTP next server address (siaddr) to the interface's DHCP server.
+                              Network boot firmwares which only look at the BOOTP header, like the s390x network boot loader,
+                              need it to locate the TFTP server. Defaults to tftpServerName when that is an IPv4 address.
+                            type: string
                           ntpServers:
                             description: If specified will pass the configured NTP
                               server to the VM via DHCP option 042.
@@ -12133,6 +13759,13 @@ var CRDsValidation map[string]string = map[string]string{
                               - value
                               type: object
                             type: array
+                          searchDomains:
+                            description: |-
+                              If specified will pass the configured search domains to the VM instead of the ones of the pod.
+                              The pod itself keeps its DNS configuration. Only supported by the masquerade binding.
+                            items:
+                              type: string
+                            type: array
                           tftpServerName:
                             description: If specified will pass option 66 to interface's
                               DHCP server
@@ -12163,6 +13796,59 @@ var CRDsValidation map[string]string = map[string]string{
                           Logical name of the interface as well as a reference to the associated networks.
                           Must match the Name of a Network.
                         type: string
+                      offloads:
+                        description: |-
+                          Offloads toggles the checksum and segmentation offloads of the interface, e.g. for guests
+                          running their own tunneling or encapsulation stack. Unset offloads keep their default.
+                          Only supported by virtio interfaces.
+                        properties:
+                          guest:
+                            description: Guest toggles the offloads offered to the
+                              guest driver.
+                            properties:
+                              csum:
+                                description: Checksum toggles the checksum offload.
+                                type: boolean
+                              tso4:
+                                description: TSO4 toggles the TCP segmentation offload
+                                  over IPv4.
+                                type: boolean
+                              tso6:
+                                description: TSO6 toggles the TCP segmentation offload
+                                  over IPv6.
+                                type: boolean
+                              ufo:
+                                description: UFO toggles the UDP fragmentation offload.
+                                type: boolean
+                            type: object
+                          host:
+                            description: Host toggles the offloads of the interface
+                              backend on the host.
+                            properties:
+                              csum:
+                                description: Checksum toggles the checksum offload.
+                                type: boolean
+                              gso:
+                                description: GSO toggles the generic segmentation
+                                  offload.
+                                type: boolean
+                              mrgRxBuf:
+                                description: MergeRxBuffers toggles the mergeable
+                                  receive buffers.
+                                type: boolean
+                              tso4:
+                                description: TSO4 toggles the TCP segmentation offload
+                                  over IPv4.
+                                type: boolean
+                              tso6:
+                                description: TSO6 toggles the TCP segmentation offload
+                                  over IPv6.
+                                type: boolean
+                              ufo:
+                                description: UFO toggles the UDP fragmentation offload.
+                                type: boolean
+                            type: object
+                        type: object
                       passt:
                         description: |-
                           DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -12204,6 +13890,13 @@ var CRDsValidation map[string]string = map[string]string{
                           - port
                           type: object
                         type: array
+                      rxQueueSize:
+                        description: |-
+                          RxQueueSize is the number of descriptors of the receive virtqueues of the interface.
+                          It must be a power of 2 between 256 and 1024. Defaults to 256.
+                          Only supported by virtio interfaces.
+                        format: int32
+                        type: integer
                       slirp:
                         description: |-
                           DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -12226,6 +13919,13 @@ var CRDsValidation map[string]string = map[string]string{
                         description: If specified, the virtual network interface address
                           and its tag will be provided to the guest via config drive
                         type: string
+                      txQueueSize:
+                        description: |-
+                          TxQueueSize is the number of descriptors of the transmit virtqueues of the interface.
+                          It must be a power of 2 between 256 and 1024. Defaults to 256.
+                          Only supported by virtio interfaces, QEMU applies it to vhost-user backends only.
+                        format: int32
+                        type: integer
                     required:
                     - name
                     type: object
@@ -12245,6 +13945,13 @@ var CRDsValidation map[string]string = map[string]string{
                     factors of the VirtualMachineInstance, like the number of guest
                     CPUs.
                   type: boolean
+                networkInterfaceRSS:
+                  description: If specified, virtio network interfaces with multiple
+                    queues distribute the received packets across their queues with
+                    receive side scaling (RSS) and report the packet hash to the guest.
+                    These interfaces are served by QEMU instead of the vhost kernel
+                    driver. Requires networkInterfaceMultiqueue.
+                  type: boolean
                 panicDevices:
                   description: PanicDevices provides additional crash information
                     when a guest crashes.
@@ -12254,13 +13961,72 @@ var CRDsValidation map[string]string = map[string]string{
                         description: |-
                           Model specifies what type of panic device is provided.
                           The panic model used when this attribute is missing depends on the hypervisor and guest arch.
-                          One of: isa, hyperv, pvpanic.
+                          One of: isa, hyperv, pvpanic, pvpanic-pci.
                         type: string
                     type: object
                   type: array
                 rng:
                   description: Whether to have random number generator from host
                   type: object
+                scsiControllerCount:
+                  description: |-
+                    SCSIControllerCount is the number of virtio-scsi controllers to create.
+                    Disks and LUNs on the scsi bus can be mapped to a controller with scsiController,
+                    e.g. to exceed the queue limits of a single controller or to isolate workloads.
+                    Defaults to 1.
+                  format: int32
+                  minimum: 1
+                  type: integer
+                scsiControllerQueues:
+                  description: |-
+                    SCSIControllerQueues is the number of request queues of each virtio-scsi controller.
+                    It allows multi-queue SCSI independently of the number of vCPUs, which is used otherwise
+                    when a disk on the scsi bus has a dedicated IOThread.
+                  format: int32
+                  minimum: 1
+                  type: integer
+                serialConsoleTargetType:
+                  description: |-
+                    SerialConsoleTargetType selects the device exposing the auto-attached serial console to the guest.
+                    One of: isa-serial, pci-serial, usb-serial.
+                    Only supported on amd64, where it defaults to isa-serial.
+                  type: string
+                serials:
+                  description: |-
+                    Serials describes additional serial ports exposed to the guest after the auto-attached serial console,
+                    e.g. for appliances expecting multiple UARTs.
+                    Up to 4 serial ports are supported, including the auto-attached serial console.
+                  items:
+                    description: Serial represents an additional serial port of the
+                      vmi, backed by a pty in the virt-launcher pod.
+                    properties:
+                      name:
+                        description: |-
+                          Name of the serial port. Its output is logged to /var/run/kubevirt-private/<vmi uid>/virt-serial-<name>-log
+                          in the virt-launcher pod.
+                        type: string
+                    required:
+                    - name
+                    type: object
+                  maxItems: 4
+                  type: array
+                  x-kubernetes-list-type: atomic
+                sgx:
+                  description: SGX provides an Intel SGX enclave page cache to the
+                    guest.
+                  properties:
+                    epcSize:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        EPCSize is the size of the enclave page cache of the guest, it is rounded up to a multiple of 1Mi.
+                        It is allocated from the EPC of the node, which is exposed as the devices.kubevirt.io/sgx-epc resource.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                  required:
+                  - epcSize
+                  type: object
                 sound:
                   description: Whether to emulate a sound device.
                   properties:
@@ -12284,11 +14050,31 @@ var CRDsValidation map[string]string = map[string]string{
                         Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine
                         Defaults to True
                       type: boolean
+                    external:
+                      description: |-
+                        External connects the vTPM to an externally managed swtpm listening on a unix socket on the node,
+                        e.g. for clusters with a centralized vTPM key management, instead of a swtpm spawned by virt-launcher.
+                        The version is then set by the external swtpm. It cannot be combined with persistent.
+                      properties:
+                        socketPath:
+                          description: |-
+                            SocketPath is the absolute path of the unix socket of the swtpm on the node.
+                            It has to be in the directory of the namespace of the VMI in a permitted directory, like /var/run/vtpm/<namespace>/vm.sock.
+                            The directory of the socket is mounted into the virt-launcher pod.
+                          type: string
+                      required:
+                      - socketPath
+                      type: object
                     persistent:
                       description: |-
                         Persistent indicates the state of the TPM device should be kept accross reboots
                         Defaults to false
                       type: boolean
+                    version:
+                      description: |-
+                        Version is the version of the TPM specification implemented by the vTPM.
+                        One of: 1.2, 2.0. Defaults to 2.0.
+                      type: string
                   type: object
                 useVirtioTransitional:
                   description: |-
@@ -12300,12 +14086,75 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Video describes the video device configuration for
                     the vmi.
                   properties:
+                    accel3d:
+                      description: |-
+                        Accel3D enables the 3D acceleration of a virtio video device, either with virgl (OpenGL)
+                        or with venus (Vulkan). It requires a render node of a host GPU.
+                      type: string
+                    heads:
+                      description: |-
+                        Heads is the number of display heads of the video device.
+                        Defaults to 1.
+                      format: int32
+                      type: integer
                     type:
                       description: |-
                         Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).
                         If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).
                       type: string
+                    vram:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        VRAM is the size of the video memory of the video device.
+                        Defaults to 16Mi.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
                   type: object
+                videos:
+                  description: |-
+                    Videos describes multiple video devices for the vmi, the first one being the primary device.
+                    It cannot be combined with video.
+                  items:
+                    properties:
+                      accel3d:
+                        description: |-
+                          Accel3D enables the 3D acceleration of a virtio video device, either with virgl (OpenGL)
+                          or with venus (Vulkan). It requires a render node of a host GPU.
+                        type: string
+                      heads:
+                        description: |-
+                          Heads is the number of display heads of the video device.
+                          Defaults to 1.
+                        format: int32
+                        type: integer
+                      type:
+                        description: |-
+                          Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).
+                          If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).
+                        type: string
+                      vram:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: |-
+                          VRAM is the size of the video memory of the video device.
+                          Defaults to 16Mi.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                virtioTransitionalDevices:
+                  description: |-
+                    VirtioTransitionalDevices lists the classes of virtio devices falling back to legacy virtio 0.9 support,
+                    e.g. for guests shipping a legacy disk driver but a virtio 1.0 network driver.
+                    All virtio devices fall back to legacy virtio 0.9 support when UseVirtioTransitional is set.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
                 watchdog:
                   description: Watchdog describes a watchdog device which can be added
                     to the vmi.
@@ -12626,6 +14475,32 @@ var CRDsValidation map[string]string = map[string]string{
                           type: boolean
                       type: object
                   type: object
+                fwCfg:
+                  description: |-
+                    FWCfg are the QEMU firmware configuration (fw_cfg) entries exposed to the guest,
+                    e.g. an ignition config or a custom early-boot provisioning blob.
+                  items:
+                    properties:
+                      key:
+                        description: Key of the ConfigMap or Secret whose data is
+                          the content of the entry.
+                        type: string
+                      name:
+                        description: |-
+                          Name of the entry as seen by the guest, e.g. opt/com.coreos/config.
+                          It must start with opt/ and must not exceed 55 characters.
+                        type: string
+                      volumeNameRef:
+                        description: VolumeNameRef should match the volume name of
+                          a ConfigMap or Secret object.
+                        type: string
+                    required:
+                    - key
+                    - name
+                    - volumeNameRef
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 kernelBoot:
                   description: Settings to set the kernel for booting.
                   properties:
@@ -12663,10 +14538,27 @@ var CRDsValidation map[string]string = map[string]string{
                     kernelArgs:
                       description: Arguments to be passed to the kernel at boot time
                       type: string
+                    rootFilesystem:
+                      description: |-
+                        RootFilesystem is the name of a virtiofs filesystem the guest mounts as its root filesystem,
+                        so that it boots without any disk. The kernel arguments must not set root or rootfstype.
+                      type: string
                   type: object
+                oemStrings:
+                  description: |-
+                    OEMStrings are exposed to the guest as SMBIOS OEM strings (type 11),
+                    e.g. to pass provisioning hints to the guest.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
                 serial:
                   description: The system-serial-number in SMBIOS
                   type: string
+                sku:
+                  description: The system SKU number in SMBIOS, overriding the cluster-wide
+                    SMBIOS configuration.
+                  type: string
                 uuid:
                   description: |-
                     UUID reported by the vmi bios.
@@ -12676,6 +14568,14 @@ var CRDsValidation map[string]string = map[string]string{
             ioThreads:
               description: IOThreads specifies the IOThreads options.
               properties:
+                pinning:
+                  additionalProperties:
+                    type: string
+                  description: |-
+                    Pinning pins iothreads to explicit host CPUs instead of the ones derived from the dedicated CPUs of the vmi.
+                    The keys are the iothread IDs, starting from 1, and the values are the cpusets, e.g. "4-5,7".
+                    The CPUs have to be allocated to the vmi. Requires dedicatedCpuPlacement.
+                  type: object
                 supplementalPoolThreadCount:
                   description: SupplementalPoolThreadCount specifies how many iothreads
                     are allocated for the supplementalPool policy.
@@ -12743,10 +14643,50 @@ var CRDsValidation map[string]string = map[string]string{
                     Defaults to the requested memory in the resources section if not specified.
                   pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                   x-kubernetes-int-or-string: true
+                hotplug:
+                  description: Hotplug configures how the memory between Guest and
+                    MaxGuest is hot(un)plugged.
+                  properties:
+                    virtioMem:
+                      description: |-
+                        VirtioMem plugs the memory through a virtio-mem device which is created when the VirtualMachineInstance starts.
+                        The guest memory can then be grown and shrunk in blocks of BlockSize.
+                      properties:
+                        blockSize:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: |-
+                            BlockSize is the granularity in which memory is plugged and unplugged.
+                            It must be a power of 2 and at least 2Mi, or 1Gi when 1Gi hugepages are used.
+                            Defaults to the smallest allowed value.
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                      type: object
+                  type: object
                 hugepages:
                   description: Hugepages allow to use hugepages for the VirtualMachineInstance
                     instead of regular memory.
                   properties:
+                    numaPolicy:
+                      description: |-
+                        NUMAPolicy binds the hugepages backing the guest memory to host NUMA nodes,
+                        so that large guests do not allocate their pages across nodes.
+                      properties:
+                        mode:
+                          description: |-
+                            Mode is the host NUMA memory allocation policy.
+                            One of: strict, interleave, preferred.
+                          type: string
+                        nodeSet:
+                          description: |-
+                            NodeSet is the list of host NUMA nodes the hugepages are allocated from, e.g. "0-1,3".
+                            The nodes must be allowed by the cpuset of the virt-launcher pod.
+                          type: string
+                      required:
+                      - mode
+                      - nodeSet
+                      type: object
                     pageSize:
                       description: PageSize specifies the hugepage size, for x86_64
                         architecture valid values are 1Gi and 2Mi.
@@ -12807,6 +14747,20 @@ var CRDsValidation map[string]string = map[string]string{
             - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
             - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
           type: string
+        guestAgent:
+          description: GuestAgent restricts the qemu-guest-agent commands KubeVirt
+            sends to the guest.
+          properties:
+            allowedCommands:
+              description: |-
+                AllowedCommands lists the guest agent commands KubeVirt may execute in the guest, e.g. guest-get-fsinfo.
+                The other commands are refused by virt-launcher, except guest-ping and guest-info which are
+                needed to detect the agent. All commands are allowed when guestAgent is not set.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: set
+          type: object
         hostname:
           description: |-
             Specifies the hostname of the vmi
@@ -13208,6 +15162,37 @@ var CRDsValidation map[string]string = map[string]string{
             If specified, the VMI will be dispatched by specified scheduler.
             If not specified, the VMI will be dispatched by default scheduler.
           type: string
+        shutdownLadder:
+          description: |-
+            ShutdownLadder escalates the graceful shutdown through steps with their own timeouts before the
+            VirtualMachineInstance is force terminated. TerminationGracePeriodSeconds defaults to the sum
+            of the step timeouts when it is not set.
+          properties:
+            steps:
+              description: |-
+                Steps are tried in order, each one for its own timeout, before escalating to the next one.
+                The domain is destroyed once the last step timed out.
+              items:
+                description: ShutdownStep is one step of a ShutdownLadder.
+                properties:
+                  method:
+                    description: Method used to ask the guest to power off, one of
+                      GuestAgent or ACPI.
+                    type: string
+                  timeoutSeconds:
+                    description: TimeoutSeconds is the time given to the guest to
+                      power off before escalating to the next step.
+                    format: int64
+                    type: integer
+                required:
+                - method
+                - timeoutSeconds
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+          required:
+          - steps
+          type: object
         startStrategy:
           description: StartStrategy can be set to "Paused" if Virtual Machine should
             be started in paused state.
@@ -13776,6 +15761,18 @@ var CRDsValidation map[string]string = map[string]string{
                     - claimName
                     type: object
                 type: object
+              hostBlockDevice:
+                description: |-
+                  HostBlockDevice passes a raw block device of the node straight through to the vmi as a virtio disk.
+                  The path has to be permitted in the hostBlockDevices configuration of KubeVirt and the device is
+                  used exclusively by the vmi.
+                properties:
+                  path:
+                    description: Path of the block device on the node, like /dev/nvme1n1.
+                    type: string
+                required:
+                - path
+                type: object
               hostDisk:
                 description: HostDisk represents a disk created on the cluster level
                 properties:
@@ -13811,6 +15808,11 @@ var CRDsValidation map[string]string = map[string]string{
                       claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                       More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                     type: string
+                  encryptionPublicKey:
+                    description: |-
+                      EncryptionPublicKey is a PEM encoded RSA public key.
+                      When set, the memory dump is encrypted with it before being written to the pvc.
+                    type: string
                   hotpluggable:
                     description: Hotpluggable indicates whether the volume can be
                       hotplugged and hotunplugged.
@@ -13920,6 +15922,19 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                     x-kubernetes-map-type: atomic
                 type: object
+              vhostUserBlk:
+                description: |-
+                  VhostUserBlk represents a block device served by a vhost-user-blk backend, such as SPDK,
+                  listening on a unix socket on the node.
+                properties:
+                  path:
+                    description: |-
+                      Path of the unix socket of the vhost-user-blk backend on the node.
+                      It has to be in the directory of the namespace of the VMI in a permitted directory, like /var/tmp/spdk/<namespace>/vhost.0.
+                    type: string
+                required:
+                - path
+                type: object
             required:
             - name
             type: object
@@ -14028,6 +16043,13 @@ var CRDsValidation map[string]string = map[string]string{
               format: int32
               type: integer
           type: object
+        currentIOThreadCount:
+          description: |-
+            CurrentIOThreadCount specifies the number of iothreads currently allocated to the VM workload.
+            It may differ from the supplemental pool thread count in the spec while iothreads are
+            added or removed.
+          format: int32
+          type: integer
         deviceStatus:
           description: |-
             DeviceStatus reflects the state of devices requested in spec.domain.devices. This is an optional field available
@@ -14734,6 +16756,10 @@ var CRDsValidation map[string]string = map[string]string{
           description: SELinuxContext is the actual SELinux context of the virt-launcher
             pod
           type: string
+        shutdownMethod:
+          description: ShutdownMethod records the step of the shutdown ladder which
+            brought the guest down.
+          type: string
         topologyHints:
           properties:
             tscFrequency:
@@ -14751,6 +16777,34 @@ var CRDsValidation map[string]string = map[string]string{
             description: VolumeStatus represents information about the status of volumes
               attached to the VirtualMachineInstance.
             properties:
+              blockJob:
+                description: |-
+                  BlockJob shows the progress of the block job running on the volume, if any,
+                  eg: a storage migration, a snapshot commit or a backup
+                properties:
+                  bytesPerSecond:
+                    description: BytesPerSecond is the throughput of the job, measured
+                      between its last two samples
+                    format: int64
+                    type: integer
+                  progress:
+                    description: Progress is the percentage of the volume data already
+                      processed by the job
+                    format: int32
+                    type: integer
+                  remainingSeconds:
+                    description: RemainingSeconds is the estimated time until the
+                      job completes at its current throughput
+                    format: int64
+                    type: integer
+                  type:
+                    description: 'Type is the type of the block job, one of: Pull,
+                      Copy, Commit, ActiveCommit, Backup'
+                    type: string
+                required:
+                - progress
+                - type
+                type: object
               containerDiskVolume:
                 description: ContainerDiskVolume shows info about the containerdisk,
                   if the volume is a containerdisk
@@ -14774,6 +16828,34 @@ var CRDsValidation map[string]string = map[string]string{
                       the volume to the node.
                     type: string
                 type: object
+              ioErrors:
+                description: IOErrors shows the IO errors reported on the volume,
+                  if any
+                properties:
+                  count:
+                    description: Count is the number of IO errors reported on the
+                      volume
+                    format: int64
+                    type: integer
+                  lastError:
+                    description: 'LastError is the reason of the last IO error, eg:
+                      enospc'
+                    type: string
+                  lastErrorTime:
+                    description: LastErrorTime is the time the last IO error was reported
+                    format: date-time
+                    type: string
+                required:
+                - count
+                type: object
+              ioThreads:
+                description: IOThreads lists the ids of the iothreads serving the
+                  disk of the volume
+                items:
+                  format: int32
+                  type: integer
+                type: array
+                x-kubernetes-list-type: atomic
               memoryDumpVolume:
                 description: If the volume is memorydump volume, this will contain
                   the memorydump info.
@@ -14858,6 +16940,11 @@ var CRDsValidation map[string]string = map[string]string{
                 description: Reason is a brief description of why we are in the current
                   hotplug volume phase
                 type: string
+              serial:
+                description: |-
+                  Serial is the serial number the guest sees for the disk of the volume.
+                  Hotplugged disks without a serial get one generated from the VMI UID and the volume name.
+                type: string
               size:
                 description: Represents the size of the volume
                 format: int64
@@ -14866,6 +16953,17 @@ var CRDsValidation map[string]string = map[string]string{
                 description: 'Target is the target name used when adding the volume
                   to the VM, eg: vda'
                 type: string
+              vhostUserBlkVolume:
+                description: VhostUserBlkVolume shows info about the vhost-user-blk
+                  backend, if the volume is a vhost-user-blk volume
+                properties:
+                  socketPath:
+                    description: SocketPath is the path of the unix socket of the
+                      backend inside the virt-launcher pod
+                    type: string
+                required:
+                - socketPath
+                type: object
             required:
             - name
             - target
@@ -15361,6 +17459,12 @@ var CRDsValidation map[string]string = map[string]string{
             clock:
               description: Clock sets the clock and timers of the vmi.
               properties:
+                ptp:
+                  description: |-
+                    PTP exposes a virtual PTP hardware clock (kvm-ptp) to the guest, so that it can
+                    discipline its clock to the host clock through the ptp_kvm driver.
+                    Only supported on amd64, where it requires the kvm timer, and arm64.
+                  type: object
                 timer:
                   description: Timer specifies whih timers are attached to the vmi.
                   properties:
@@ -15455,6 +17559,23 @@ var CRDsValidation map[string]string = map[string]string{
               description: CPU allow specified the detailed CPU topology inside the
                 vmi.
               properties:
+                cache:
+                  description: Cache describes how the CPU cache is presented to the
+                    guest, e.g. passing the host L3 cache through.
+                  properties:
+                    level:
+                      description: |-
+                        Level is the cache level the mode applies to.
+                        Only level 3 can be emulated or disabled, passthrough applies to all the levels.
+                      format: int32
+                      type: integer
+                    mode:
+                      description: Mode is the cache mode, one of passthrough, emulate
+                        or disable.
+                      type: string
+                  required:
+                  - mode
+                  type: object
                 cores:
                   description: |-
                     Cores specifies the number of cores inside the vmi.
@@ -15517,6 +17638,63 @@ var CRDsValidation map[string]string = map[string]string{
                         GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
                         The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
                       type: object
+                    manual:
+                      description: |-
+                        Manual defines the guest numa topology explicitly, independently of the host numa topology.
+                        It is mutually exclusive with GuestMappingPassthrough.
+                      properties:
+                        nodes:
+                          description: Nodes of the guest numa topology. The index
+                            of a node in the list is its numa node id.
+                          items:
+                            description: NUMANode describes a guest numa node.
+                            properties:
+                              cpus:
+                                description: CPUs is the list of vCPUs belonging to
+                                  the node, e.g. "0-3,8". Every vCPU must belong to
+                                  exactly one node.
+                                type: string
+                              distances:
+                                description: |-
+                                  Distances from this node to the other nodes. An unset distance is the distance set from the other node back
+                                  to this one, or defaults to 10 to the node itself and to 20 to the other nodes.
+                                items:
+                                  description: NUMADistance is the distance from a
+                                    guest numa node to another one.
+                                  properties:
+                                    node:
+                                      description: Node is the id of the other numa
+                                        node.
+                                      format: int32
+                                      type: integer
+                                    value:
+                                      description: Value of the distance, between
+                                        10 and 255.
+                                      format: int32
+                                      type: integer
+                                  required:
+                                  - node
+                                  - value
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              memory:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Memory of the node. The memory of all
+                                  the nodes must add up to the guest memory.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - cpus
+                            - memory
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      required:
+                      - nodes
+                      type: object
                   type: object
                 realtime:
                   description: Realtime instructs the virt-launcher to tune the VMI
@@ -15574,11 +17752,54 @@ var CRDsValidation map[string]string = map[string]string{
                     Whether to attach the VSOCK CID to the VM or not.
                     VSOCK access will be available if set to true. Defaults to false.
                   type: boolean
+                balloon:
+                  description: Balloon configures the memory reclaim behavior of the
+                    memory balloon device.
+                  properties:
+                    deflateOnOOM:
+                      description: |-
+                        DeflateOnOOM lets the guest deflate the balloon when it runs out of memory,
+                        instead of invoking its OOM killer.
+                        Defaults to false.
+                      type: boolean
+                    disableStatsWithoutDriver:
+                      description: |-
+                        DisableStatsWithoutDriver stops the balloon statistics while the guest agent reports that the
+                        guest has no balloon driver, e.g. a Windows guest without the virtio drivers, as QEMU would keep on
+                        requesting statistics which the guest never provides. They are restored once the driver is installed.
+                        Defaults to false.
+                      type: boolean
+                    freePageReporting:
+                      description: |-
+                        FreePageReporting lets the guest report its free pages to the host so they can be reclaimed.
+                        It overrides the cluster-wide setting and the free page reporting annotation, but is always
+                        disabled for high performance vmis, e.g. with dedicated CPUs or hugepages.
+                      type: boolean
+                  type: object
                 blockMultiQueue:
                   description: |-
                     Whether or not to enable virtio multi-queue for block devices.
                     Defaults to false.
                   type: boolean
+                channels:
+                  description: |-
+                    Channels describes additional virtio-serial channels exposed to the vmi,
+                    each backed by a unix socket in the virt-launcher pod, e.g. for host-guest agents.
+                  items:
+                    description: Channel represents a virtio-serial channel backed
+                      by a unix socket in the virt-launcher pod.
+                    properties:
+                      name:
+                        description: |-
+                          Name is the name of the virtio-serial port seen by the guest, e.g. org.example.agent.0.
+                          The socket is created at /var/run/kubevirt-channels/<name>.sock in the virt-launcher pod. The directory
+                          is a volume shared with the hook sidecars of the vmi, which connect to the socket to talk to the guest.
+                        type: string
+                    required:
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 clientPassthrough:
                   description: To configure and access client devices such as redirecting
                     USB
@@ -15669,18 +17890,43 @@ var CRDsValidation map[string]string = map[string]string{
                           bus:
                             description: |-
                               Bus indicates the type of disk device to emulate.
-                              supported values: virtio, sata, scsi, usb.
+                              supported values: virtio, sata, scsi, usb, nvme.
+                            type: string
+                          ccwAddress:
+                            description: |-
+                              If specified, the virtual disk will be placed on the guests CCW address with the specified device number.
+                              Only supported on s390x with the virtio bus. For example: 0.0.0001
                             type: string
                           pciAddress:
                             description: 'If specified, the virtual disk will be placed
                               on the guests pci address with the specified PCI address.
                               For example: 0000:81:01.10'
                             type: string
+                          queueSize:
+                            description: |-
+                              QueueSize is the size of each virtqueue of the disk.
+                              Only supported with the virtio bus. Must be a power of 2 between 4 and 1024.
+                              Disks on the scsi bus share the virtqueues of their virtio-scsi controller,
+                              whose size libvirt does not allow to set.
+                            format: int32
+                            type: integer
                           readonly:
                             description: |-
                               ReadOnly.
                               Defaults to false.
                             type: boolean
+                          scsiController:
+                            description: |-
+                              SCSIController is the index of the SCSI controller the disk is attached to.
+                              Only valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.
+                            format: int32
+                            type: integer
+                          zoned:
+                            description: |-
+                              Zoned passes the zone semantics of a zoned block device, like a ZNS NVMe namespace,
+                              through to the guest using the virtio-blk zoned model. Only supported with the virtio bus
+                              on block volumes. Defaults to false.
+                            type: boolean
                         type: object
                       errorPolicy:
                         description: If specified, it can change the default error
@@ -15691,6 +17937,83 @@ var CRDsValidation map[string]string = map[string]string{
                           IO specifies which QEMU disk IO mode should be used.
                           Supported values are: native, default, threads.
                         type: string
+                      ioTune:
+                        description: |-
+                          IOTune throttles the IO of the disk, so that a single VMI cannot saturate the storage
+                          shared with other tenants.
+                        properties:
+                          burst:
+                            description: Burst lets the disk exceed its limits for
+                              a limited time.
+                            properties:
+                              lengthSeconds:
+                                description: LengthSeconds is the longest duration
+                                  of a burst. Defaults to 1 second.
+                                format: int64
+                                type: integer
+                              readBytesSec:
+                                description: ReadBytesSec is the read throughput the
+                                  disk may reach while bursting, in bytes per second.
+                                format: int64
+                                type: integer
+                              readIopsSec:
+                                description: ReadIOPSSec is the read operations per
+                                  second the disk may reach while bursting.
+                                format: int64
+                                type: integer
+                              totalBytesSec:
+                                description: TotalBytesSec is the throughput the disk
+                                  may reach while bursting, in bytes per second.
+                                format: int64
+                                type: integer
+                              totalIopsSec:
+                                description: TotalIOPSSec is the IO operations per
+                                  second the disk may reach while bursting.
+                                format: int64
+                                type: integer
+                              writeBytesSec:
+                                description: WriteBytesSec is the write throughput
+                                  the disk may reach while bursting, in bytes per
+                                  second.
+                                format: int64
+                                type: integer
+                              writeIopsSec:
+                                description: WriteIOPSSec is the write operations
+                                  per second the disk may reach while bursting.
+                                format: int64
+                                type: integer
+                            type: object
+                          readBytesSec:
+                            description: ReadBytesSec limits the read throughput of
+                              the disk, in bytes per second.
+                            format: int64
+                            type: integer
+                          readIopsSec:
+                            description: ReadIOPSSec limits the read operations per
+                              second of the disk.
+                            format: int64
+                            type: integer
+                          totalBytesSec:
+                            description: TotalBytesSec limits the throughput of the
+                              disk, in bytes per second.
+                            format: int64
+                            type: integer
+                          totalIopsSec:
+                            description: TotalIOPSSec limits the IO operations per
+                              second of the disk.
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            description: WriteBytesSec limits the write throughput
+                              of the disk, in bytes per second.
+                            format: int64
+                            type: integer
+                          writeIopsSec:
+                            description: WriteIOPSSec limits the write operations
+                              per second of the disk.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -15708,10 +18031,22 @@ var CRDsValidation map[string]string = map[string]string{
                             description: Reservation indicates if the disk needs to
                               support the persistent reservation for the SCSI disk
                             type: boolean
+                          scsiController:
+                            description: |-
+                              SCSIController is the index of the SCSI controller the LUN is attached to.
+                              Only valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.
+                            format: int32
+                            type: integer
                         type: object
                       name:
                         description: Name is the device name
                         type: string
+                      rerrorPolicy:
+                        description: |-
+                          RerrorPolicy, if specified, overrides the error policy for read errors,
+                          e.g. to report read errors while stopping on write errors.
+                          Supported values are: stop, report, ignore. Defaults to the error policy.
+                        type: string
                       serial:
                         description: Serial provides the ability to specify a serial
                           number for the disk device.
@@ -15743,6 +18078,23 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                       virtiofs:
                         description: Virtiofs is supported
+                        properties:
+                          cache:
+                            description: |-
+                              Cache is the caching policy of virtiofsd for the shared directory.
+                              Supported values: auto, always, metadata, never. Defaults to auto.
+                            type: string
+                          queueSize:
+                            description: |-
+                              QueueSize is the size of the virtqueue of the filesystem device.
+                              Must be a power of 2 between 4 and 1024. Defaults to 1024.
+                            format: int32
+                            type: integer
+                          xattr:
+                            description: |-
+                              Xattr enables the support of extended attributes in the shared directory.
+                              Defaults to false.
+                            type: boolean
                         type: object
                     required:
                     - name
@@ -15839,7 +18191,7 @@ var CRDsValidation map[string]string = map[string]string{
                       bus:
                         description: |-
                           Bus indicates the bus of input device to emulate.
-                          Supported values: virtio, usb.
+                          Supported values: virtio, usb, ps2.
                         type: string
                       name:
                         description: Name is the device name
@@ -15847,7 +18199,7 @@ var CRDsValidation map[string]string = map[string]string{
                       type:
                         description: |-
                           Type indicated the type of input device.
-                          Supported values: tablet.
+                          Supported values: tablet, and keyboard or mouse on the ps2 bus.
                         type: string
                     required:
                     - name
@@ -15890,6 +18242,26 @@ var CRDsValidation map[string]string = map[string]string{
                         description: InterfaceBridge connects to a given network via
                           a linux bridge.
                         type: object
+                      ccwAddress:
+                        description: |-
+                          If specified, the virtual network interface will be placed on the guests CCW address with the specified device number.
+                          Only supported on s390x. For example: 0.0.0002
+                        type: string
+                      coalesce:
+                        description: |-
+                          Coalesce configures the interrupt coalescing of the interface, reducing the interrupt load
+                          on the vCPUs of guests handling high packet rates.
+                          Only supported by virtio interfaces backed by a tap device.
+                        properties:
+                          rxMaxFrames:
+                            description: RxMaxFrames is the maximum number of received
+                              frames coalesced into a single interrupt.
+                            format: int32
+                            minimum: 1
+                            type: integer
+                        required:
+                        - rxMaxFrames
+                        type: object
                       dhcpOptions:
                         description: If specified the network interface will pass
                           additional DHCP options to the VMI
@@ -15898,6 +18270,19 @@ var CRDsValidation map[string]string = map[string]string{
                             description: If specified will pass option 67 to interface's
                               DHCP server
                             type: string
+                          dnsServers:
+                            description: |-
+                              If specified will pass the configured DNS servers to the VM instead of the ones of the pod.
+                              The pod itself keeps its DNS configuration. Only supported by the masquerade binding.
+                            items:
+                              type: string
+                            type: array
+                          nextServer:
+                            description: |-
+                              If specified will be passed as the BOOTP next server address (siaddr) to the interface's DHCP server.
+                              Network boot firmwares which only look at the BOOTP header, like the s390x network boot loader,
+                              need it to locate the TFTP server. Defaults to tftpServerName when that is an IPv4 address.
+                            type: string
                           ntpServers:
                             description: If specified will pass the configured NTP
                               server to the VM via DHCP option 042.
@@ -15926,6 +18311,13 @@ var CRDsValidation map[string]string = map[string]string{
                               - value
                               type: object
                             type: array
+                          searchDomains:
+                            description: |-
+                              If specified will pass the configured search domains to the VM instead of the ones of the pod.
+                              The pod itself keeps its DNS configuration. Only supported by the masquerade binding.
+                            items:
+                              type: string
+                            type: array
                           tftpServerName:
                             description: If specified will pass option 66 to interface's
                               DHCP server
@@ -15956,6 +18348,59 @@ var CRDsValidation map[string]string = map[string]string{
                           Logical name of the interface as well as a reference to the associated networks.
                           Must match the Name of a Network.
                         type: string
+                      offloads:
+                        description: |-
+                          Offloads toggles the checksum and segmentation offloads of the interface, e.g. for guests
+                          running their own tunneling or encapsulation stack. Unset offloads keep their default.
+                          Only supported by virtio interfaces.
+                        properties:
+                          guest:
+                            description: Guest toggles the offloads offered to the
+                              guest driver.
+                            properties:
+                              csum:
+                                description: Checksum toggles the checksum offload.
+                                type: boolean
+                              tso4:
+                                description: TSO4 toggles the TCP segmentation offload
+                                  over IPv4.
+                                type: boolean
+                              tso6:
+                                description: TSO6 toggles the TCP segmentation offload
+                                  over IPv6.
+                                type: boolean
+                              ufo:
+                                description: UFO toggles the UDP fragmentation offload.
+                                type: boolean
+                            type: object
+                          host:
+                            description: Host toggles the offloads of the interface
+                              backend on the host.
+                            properties:
+                              csum:
+                                description: Checksum toggles the checksum offload.
+                                type: boolean
+                              gso:
+                                description: GSO toggles the generic segmentation
+                                  offload.
+                                type: boolean
+                              mrgRxBuf:
+                                description: MergeRxBuffers toggles the mergeable
+                                  receive buffers.
+                                type: boolean
+                              tso4:
+                                description: TSO4 toggles the TCP segmentation offload
+                                  over IPv4.
+                                type: boolean
+                              tso6:
+                                description: TSO6 toggles the TCP segmentation offload
+                                  over IPv6.
+                                type: boolean
+                              ufo:
+                                description: UFO toggles the UDP fragmentation offload.
+                                type: boolean
+                            type: object
+                        type: object
                       passt:
                         description: |-
                           DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -15997,6 +18442,13 @@ var CRDsValidation map[string]string = map[string]string{
                           - port
                           type: object
                         type: array
+                      rxQueueSize:
+                        description: |-
+                          RxQueueSize is the number of descriptors of the receive virtqueues of the interface.
+                          It must be a power of 2 between 256 and 1024. Defaults to 256.
+                          Only supported by virtio interfaces.
+                        format: int32
+                        type: integer
                       slirp:
                         description: |-
                           DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -16019,6 +18471,13 @@ var CRDsValidation map[string]string = map[string]string{
                         description: If specified, the virtual network interface address
                           and its tag will be provided to the guest via config drive
                         type: string
+                      txQueueSize:
+                        description: |-
+                          TxQueueSize is the number of descriptors of the transmit virtqueues of the interface.
+                          It must be a power of 2 between 256 and 1024. Defaults to 256.
+                          Only supported by virtio interfaces, QEMU applies it to vhost-user backends only.
+                        format: int32
+                        type: integer
                     required:
                     - name
                     type: object
@@ -16038,6 +18497,13 @@ var CRDsValidation map[string]string = map[string]string{
                     factors of the VirtualMachineInstance, like the number of guest
                     CPUs.
                   type: boolean
+                networkInterfaceRSS:
+                  description: If specified, virtio network interfaces with multiple
+                    queues distribute the received packets across their queues with
+                    receive side scaling (RSS) and report the packet hash to the guest.
+                    These interfaces are served by QEMU instead of the vhost kernel
+                    driver. Requires networkInterfaceMultiqueue.
+                  type: boolean
                 panicDevices:
                   description: PanicDevices provides additional crash information
                     when a guest crashes.
@@ -16047,13 +18513,72 @@ var CRDsValidation map[string]string = map[string]string{
                         description: |-
                           Model specifies what type of panic device is provided.
                           The panic model used when this attribute is missing depends on the hypervisor and guest arch.
-                          One of: isa, hyperv, pvpanic.
+                          One of: isa, hyperv, pvpanic, pvpanic-pci.
                         type: string
                     type: object
                   type: array
                 rng:
                   description: Whether to have random number generator from host
                   type: object
+                scsiControllerCount:
+                  description: |-
+                    SCSIControllerCount is the number of virtio-scsi controllers to create.
+                    Disks and LUNs on the scsi bus can be mapped to a controller with scsiController,
+                    e.g. to exceed the queue limits of a single controller or to isolate workloads.
+                    Defaults to 1.
+                  format: int32
+                  minimum: 1
+                  type: integer
+                scsiControllerQueues:
+                  description: |-
+                    SCSIControllerQueues is the number of request queues of each virtio-scsi controller.
+                    It allows multi-queue SCSI independently of the number of vCPUs, which is used otherwise
+                    when a disk on the scsi bus has a dedicated IOThread.
+                  format: int32
+                  minimum: 1
+                  type: integer
+                serialConsoleTargetType:
+                  description: |-
+                    SerialConsoleTargetType selects the device exposing the auto-attached serial console to the guest.
+                    One of: isa-serial, pci-serial, usb-serial.
+                    Only supported on amd64, where it defaults to isa-serial.
+                  type: string
+                serials:
+                  description: |-
+                    Serials describes additional serial ports exposed to the guest after the auto-attached serial console,
+                    e.g. for appliances expecting multiple UARTs.
+                    Up to 4 serial ports are supported, including the auto-attached serial console.
+                  items:
+                    description: Serial represents an additional serial port of the
+                      vmi, backed by a pty in the virt-launcher pod.
+                    properties:
+                      name:
+                        description: |-
+                          Name of the serial port. Its output is logged to /var/run/kubevirt-private/<vmi uid>/virt-serial-<name>-log
+                          in the virt-launcher pod.
+                        type: string
+                    required:
+                    - name
+                    type: object
+                  maxItems: 4
+                  type: array
+                  x-kubernetes-list-type: atomic
+                sgx:
+                  description: SGX provides an Intel SGX enclave page cache to the
+                    guest.
+                  properties:
+                    epcSize:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        EPCSize is the size of the enclave page cache of the guest, it is rounded up to a multiple of 1Mi.
+                        It is allocated from the EPC of the node, which is exposed as the devices.kubevirt.io/sgx-epc resource.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                  required:
+                  - epcSize
+                  type: object
                 sound:
                   description: Whether to emulate a sound device.
                   properties:
@@ -16077,11 +18602,31 @@ var CRDsValidation map[string]string = map[string]string{
                         Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine
                         Defaults to True
                       type: boolean
+                    external:
+                      description: |-
+                        External connects the vTPM to an externally managed swtpm listening on a unix socket on the node,
+                        e.g. for clusters with a centralized vTPM key management, instead of a swtpm spawned by virt-launcher.
+                        The version is then set by the external swtpm. It cannot be combined with persistent.
+                      properties:
+                        socketPath:
+                          description: |-
+                            SocketPath is the absolute path of the unix socket of the swtpm on the node.
+                            It has to be in the directory of the namespace of the VMI in a permitted directory, like /var/run/vtpm/<namespace>/vm.sock.
+                            The directory of the socket is mounted into the virt-launcher pod.
+                          type: string
+                      required:
+                      - socketPath
+                      type: object
                     persistent:
                       description: |-
                         Persistent indicates the state of the TPM device should be kept accross reboots
                         Defaults to false
                       type: boolean
+                    version:
+                      description: |-
+                        Version is the version of the TPM specification implemented by the vTPM.
+                        One of: 1.2, 2.0. Defaults to 2.0.
+                      type: string
                   type: object
                 useVirtioTransitional:
                   description: |-
@@ -16093,12 +18638,75 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Video describes the video device configuration for
                     the vmi.
                   properties:
+                    accel3d:
+                      description: |-
+                        Accel3D enables the 3D acceleration of a virtio video device, either with virgl (OpenGL)
+                        or with venus (Vulkan). It requires a render node of a host GPU.
+                      type: string
+                    heads:
+                      description: |-
+                        Heads is the number of display heads of the video device.
+                        Defaults to 1.
+                      format: int32
+                      type: integer
                     type:
                       description: |-
                         Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).
                         If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).
                       type: string
+                    vram:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        VRAM is the size of the video memory of the video device.
+                        Defaults to 16Mi.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
                   type: object
+                videos:
+                  description: |-
+                    Videos describes multiple video devices for the vmi, the first one being the primary device.
+                    It cannot be combined with video.
+                  items:
+                    properties:
+                      accel3d:
+                        description: |-
+                          Accel3D enables the 3D acceleration of a virtio video device, either with virgl (OpenGL)
+                          or with venus (Vulkan). It requires a render node of a host GPU.
+                        type: string
+                      heads:
+                        description: |-
+                          Heads is the number of display heads of the video device.
+                          Defaults to 1.
+                        format: int32
+                        type: integer
+                      type:
+                        description: |-
+                          Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).
+                          If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).
+                        type: string
+                      vram:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: |-
+                          VRAM is the size of the video memory of the video device.
+                          Defaults to 16Mi.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                virtioTransitionalDevices:
+                  description: |-
+                    VirtioTransitionalDevices lists the classes of virtio devices falling back to legacy virtio 0.9 support,
+                    e.g. for guests shipping a legacy disk driver but a virtio 1.0 network driver.
+                    All virtio devices fall back to legacy virtio 0.9 support when UseVirtioTransitional is set.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
                 watchdog:
                   description: Watchdog describes a watchdog device which can be added
                     to the vmi.
@@ -16419,6 +19027,32 @@ var CRDsValidation map[string]string = map[string]string{
                           type: boolean
                       type: object
                   type: object
+                fwCfg:
+                  description: |-
+                    FWCfg are the QEMU firmware configuration (fw_cfg) entries exposed to the guest,
+                    e.g. an ignition config or a custom early-boot provisioning blob.
+                  items:
+                    properties:
+                      key:
+                        description: Key of the ConfigMap or Secret whose data is
+                          the content of the entry.
+                        type: string
+                      name:
+                        description: |-
+                          Name of the entry as seen by the guest, e.g. opt/com.coreos/config.
+                          It must start with opt/ and must not exceed 55 characters.
+                        type: string
+                      volumeNameRef:
+                        description: VolumeNameRef should match the volume name of
+                          a ConfigMap or Secret object.
+                        type: string
+                    required:
+                    - key
+                    - name
+                    - volumeNameRef
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 kernelBoot:
                   description: Settings to set the kernel for booting.
                   properties:
@@ -16456,10 +19090,27 @@ var CRDsValidation map[string]string = map[string]string{
                     kernelArgs:
                       description: Arguments to be passed to the kernel at boot time
                       type: string
+                    rootFilesystem:
+                      description: |-
+                        RootFilesystem is the name of a virtiofs filesystem the guest mounts as its root filesystem,
+                        so that it boots without any disk. The kernel arguments must not set root or rootfstype.
+                      type: string
                   type: object
+                oemStrings:
+                  description: |-
+                    OEMStrings are exposed to the guest as SMBIOS OEM strings (type 11),
+                    e.g. to pass provisioning hints to the guest.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
                 serial:
                   description: The system-serial-number in SMBIOS
                   type: string
+                sku:
+                  description: The system SKU number in SMBIOS, overriding the cluster-wide
+                    SMBIOS configuration.
+                  type: string
                 uuid:
                   description: |-
                     UUID reported by the vmi bios.
@@ -16469,6 +19120,14 @@ var CRDsValidation map[string]string = map[string]string{
             ioThreads:
               description: IOThreads specifies the IOThreads options.
               properties:
+                pinning:
+                  additionalProperties:
+                    type: string
+                  description: |-
+                    Pinning pins iothreads to explicit host CPUs instead of the ones derived from the dedicated CPUs of the vmi.
+                    The keys are the iothread IDs, starting from 1, and the values are the cpusets, e.g. "4-5,7".
+                    The CPUs have to be allocated to the vmi. Requires dedicatedCpuPlacement.
+                  type: object
                 supplementalPoolThreadCount:
                   description: SupplementalPoolThreadCount specifies how many iothreads
                     are allocated for the supplementalPool policy.
@@ -16536,10 +19195,50 @@ var CRDsValidation map[string]string = map[string]string{
                     Defaults to the requested memory in the resources section if not specified.
                   pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                   x-kubernetes-int-or-string: true
+                hotplug:
+                  description: Hotplug configures how the memory between Guest and
+                    MaxGuest is hot(un)plugged.
+                  properties:
+                    virtioMem:
+                      description: |-
+                        VirtioMem plugs the memory through a virtio-mem device which is created when the VirtualMachineInstance starts.
+                        The guest memory can then be grown and shrunk in blocks of BlockSize.
+                      properties:
+                        blockSize:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: |-
+                            BlockSize is the granularity in which memory is plugged and unplugged.
+                            It must be a power of 2 and at least 2Mi, or 1Gi when 1Gi hugepages are used.
+                            Defaults to the smallest allowed value.
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                      type: object
+                  type: object
                 hugepages:
                   description: Hugepages allow to use hugepages for the VirtualMachineInstance
                     instead of regular memory.
                   properties:
+                    numaPolicy:
+                      description: |-
+                        NUMAPolicy binds the hugepages backing the guest memory to host NUMA nodes,
+                        so that large guests do not allocate their pages across nodes.
+                      properties:
+                        mode:
+                          description: |-
+                            Mode is the host NUMA memory allocation policy.
+                            One of: strict, interleave, preferred.
+                          type: string
+                        nodeSet:
+                          description: |-
+                            NodeSet is the list of host NUMA nodes the hugepages are allocated from, e.g. "0-1,3".
+                            The nodes must be allowed by the cpuset of the virt-launcher pod.
+                          type: string
+                      required:
+                      - mode
+                      - nodeSet
+                      type: object
                     pageSize:
                       description: PageSize specifies the hugepage size, for x86_64
                         architecture valid values are 1Gi and 2Mi.
@@ -17841,6 +20540,12 @@ var CRDsValidation map[string]string = map[string]string{
                     clock:
                       description: Clock sets the clock and timers of the vmi.
                       properties:
+                        ptp:
+                          description: |-
+                            PTP exposes a virtual PTP hardware clock (kvm-ptp) to the guest, so that it can
+                            discipline its clock to the host clock through the ptp_kvm driver.
+                            Only supported on amd64, where it requires the kvm timer, and arm64.
+                          type: object
                         timer:
                           description: Timer specifies whih timers are attached to
                             the vmi.
@@ -17938,6 +20643,23 @@ var CRDsValidation map[string]string = map[string]string{
                       description: CPU allow specified the detailed CPU topology inside
                         the vmi.
                       properties:
+                        cache:
+                          description: Cache describes how the CPU cache is presented
+                            to the guest, e.g. passing the host L3 cache through.
+                          properties:
+                            level:
+                              description: |-
+                                Level is the cache level the mode applies to.
+                                Only level 3 can be emulated or disabled, passthrough applies to all the levels.
+                              format: int32
+                              type: integer
+                            mode:
+                              description: Mode is the cache mode, one of passthrough,
+                                emulate or disable.
+                              type: string
+                          required:
+                          - mode
+                          type: object
                         cores:
                           description: |-
                             Cores specifies the number of cores inside the vmi.
@@ -18000,6 +20722,64 @@ var CRDsValidation map[string]string = map[string]string{
                                 GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
                                 The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
                               type: object
+                            manual:
+                              description: |-
+                                Manual defines the guest numa topology explicitly, independently of the host numa topology.
+                                It is mutually exclusive with GuestMappingPassthrough.
+                              properties:
+                                nodes:
+                                  description: Nodes of the guest numa topology. The
+                                    index of a node in the list is its numa node id.
+                                  items:
+                                    description: NUMANode describes a guest numa node.
+                                    properties:
+                                      cpus:
+                                        description: CPUs is the list of vCPUs belonging
+                                          to the node, e.g. "0-3,8". Every vCPU must
+                                          belong to exactly one node.
+                                        type: string
+                                      distances:
+                                        description: |-
+                                          Distances from this node to the other nodes. An unset distance is the distance set from the other node back
+                                          to this one, or defaults to 10 to the node itself and to 20 to the other nodes.
+                                        items:
+                                          description: NUMADistance is the distance
+                                            from a guest numa node to another one.
+                                          properties:
+                                            node:
+                                              description: Node is the id of the other
+                                                numa node.
+                                              format: int32
+                                              type: integer
+                                            value:
+                                              description: Value of the distance,
+                                                between 10 and 255.
+                                              format: int32
+                                              type: integer
+                                          required:
+                                          - node
+                                          - value
+                                          type: object
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                      memory:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Memory of the node. The memory
+                                          of all the nodes must add up to the guest
+                                          memory.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - cpus
+                                    - memory
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - nodes
+                              type: object
                           type: object
                         realtime:
                           description: Realtime instructs the virt-launcher to tune
@@ -18058,11 +20838,54 @@ var CRDsValidation map[string]string = map[string]string{
                             Whether to attach the VSOCK CID to the VM or not.
                             VSOCK access will be available if set to true. Defaults to false.
                           type: boolean
+                        balloon:
+                          description: Balloon configures the memory reclaim behavior
+                            of the memory balloon device.
+                          properties:
+                            deflateOnOOM:
+                              description: |-
+                                DeflateOnOOM lets the guest deflate the balloon when it runs out of memory,
+                                instead of invoking its OOM killer.
+                                Defaults to false.
+                              type: boolean
+                            disableStatsWithoutDriver:
+                              description: |-
+                                DisableStatsWithoutDriver stops the balloon statistics while the guest agent reports that the
+                                guest has no balloon driver, e.g. a Windows guest without the virtio drivers, as QEMU would keep on
+                                requesting statistics which the guest never provides. They are restored once the driver is installed.
+                                Defaults to false.
+                              type: boolean
+                            freePageReporting:
+                              description: |-
+                                FreePageReporting lets the guest report its free pages to the host so they can be reclaimed.
+                                It overrides the cluster-wide setting and the free page reporting annotation, but is always
+                                disabled for high performance vmis, e.g. with dedicated CPUs or hugepages.
+                              type: boolean
+                          type: object
                         blockMultiQueue:
                           description: |-
                             Whether or not to enable virtio multi-queue for block devices.
                             Defaults to false.
                           type: boolean
+                        channels:
+                          description: |-
+                            Channels describes additional virtio-serial channels exposed to the vmi,
+                            each backed by a unix socket in the virt-launcher pod, e.g. for host-guest agents.
+                          items:
+                            description: Channel represents a virtio-serial channel
+                              backed by a unix socket in the virt-launcher pod.
+                            properties:
+                              name:
+                                description: |-
+                                  Name is the name of the virtio-serial port seen by the guest, e.g. org.example.agent.0.
+                                  The socket is created at /var/run/kubevirt-channels/<name>.sock in the virt-launcher pod. The directory
+                                  is a volume shared with the hook sidecars of the vmi, which connect to the socket to talk to the guest.
+                                type: string
+                            required:
+                            - name
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
                         clientPassthrough:
                           description: To configure and access client devices such
                             as redirecting USB
@@ -18155,18 +20978,43 @@ var CRDsValidation map[string]string = map[string]string{
                                   bus:
                                     description: |-
                                       Bus indicates the type of disk device to emulate.
-                                      supported values: virtio, sata, scsi, usb.
+                                      supported values: virtio, sata, scsi, usb, nvme.
+                                    type: string
+                                  ccwAddress:
+                                    description: |-
+                                      If specified, the virtual disk will be placed on the guests CCW address with the specified device number.
+                                      Only supported on s390x with the virtio bus. For example: 0.0.0001
                                     type: string
                                   pciAddress:
                                     description: 'If specified, the virtual disk will
                                       be placed on the guests pci address with the
                                       specified PCI address. For example: 0000:81:01.10'
                                     type: string
+                                  queueSize:
+                                    description: |-
+                                      QueueSize is the size of each virtqueue of the disk.
+                                      Only supported with the virtio bus. Must be a power of 2 between 4 and 1024.
+                                      Disks on the scsi bus share the virtqueues of their virtio-scsi controller,
+                                      whose size libvirt does not allow to set.
+                                    format: int32
+                                    type: integer
                                   readonly:
                                     description: |-
                                       ReadOnly.
                                       Defaults to false.
                                     type: boolean
+                                  scsiController:
+                                    description: |-
+                                      SCSIController is the index of the SCSI controller the disk is attached to.
+                                      Only valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.
+                                    format: int32
+                                    type: integer
+                                  zoned:
+                                    description: |-
+                                      Zoned passes the zone semantics of a zoned block device, like a ZNS NVMe namespace,
+                                      through to the guest using the virtio-blk zoned model. Only supported with the virtio bus
+                                      on block volumes. Defaults to false.
+                                    type: boolean
                                 type: object
                               errorPolicy:
                                 description: If specified, it can change the default
@@ -18177,6 +21025,85 @@ var CRDsValidation map[string]string = map[string]string{
                                   IO specifies which QEMU disk IO mode should be used.
                                   Supported values are: native, default, threads.
                                 type: string
+                              ioTune:
+                                description: |-
+                                  IOTune throttles the IO of the disk, so that a single VMI cannot saturate the storage
+                                  shared with other tenants.
+                                properties:
+                                  burst:
+                                    description: Burst lets the disk exceed its limits
+                                      for a limited time.
+                                    properties:
+                                      lengthSeconds:
+                                        description: LengthSeconds is the longest
+                                          duration of a burst. Defaults to 1 second.
+                                        format: int64
+                                        type: integer
+                                      readBytesSec:
+                                        description: ReadBytesSec is the read throughput
+                                          the disk may reach while bursting, in bytes
+                                          per second.
+                                        format: int64
+                                        type: integer
+                                      readIopsSec:
+                                        description: ReadIOPSSec is the read operations
+                                          per second the disk may reach while bursting.
+                                        format: int64
+                                        type: integer
+                                      totalBytesSec:
+                                        description: TotalBytesSec is the throughput
+                                          the disk may reach while bursting, in bytes
+                                          per second.
+                                        format: int64
+                                        type: integer
+                                      totalIopsSec:
+                                        description: TotalIOPSSec is the IO operations
+                                          per second the disk may reach while bursting.
+                                        format: int64
+                                        type: integer
+                                      writeBytesSec:
+                                        description: WriteBytesSec is the write throughput
+                                          the disk may reach while bursting, in bytes
+                                          per second.
+                                        format: int64
+                                        type: integer
+                                      writeIopsSec:
+                                        description: WriteIOPSSec is the write operations
+                                          per second the disk may reach while bursting.
+                                        format: int64
+                                        type: integer
+                                    type: object
+                                  readBytesSec:
+                                    description: ReadBytesSec limits the read throughput
+                                      of the disk, in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  readIopsSec:
+                                    description: ReadIOPSSec limits the read operations
+                                      per second of the disk.
+                                    format: int64
+                                    type: integer
+                                  totalBytesSec:
+                                    description: TotalBytesSec limits the throughput
+                                      of the disk, in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  totalIopsSec:
+                                    description: TotalIOPSSec limits the IO operations
+                                      per second of the disk.
+                                    format: int64
+                                    type: integer
+                                  writeBytesSec:
+                                    description: WriteBytesSec limits the write throughput
+                                      of the disk, in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  writeIopsSec:
+                                    description: WriteIOPSSec limits the write operations
+                                      per second of the disk.
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -18195,10 +21122,22 @@ var CRDsValidation map[string]string = map[string]string{
                                       needs to support the persistent reservation
                                       for the SCSI disk
                                     type: boolean
+                                  scsiController:
+                                    description: |-
+                                      SCSIController is the index of the SCSI controller the LUN is attached to.
+                                      Only valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.
+                                    format: int32
+                                    type: integer
                                 type: object
                               name:
                                 description: Name is the device name
                                 type: string
+                              rerrorPolicy:
+                                description: |-
+                                  RerrorPolicy, if specified, overrides the error policy for read errors,
+                                  e.g. to report read errors while stopping on write errors.
+                                  Supported values are: stop, report, ignore. Defaults to the error policy.
+                                type: string
                               serial:
                                 description: Serial provides the ability to specify
                                   a serial number for the disk device.
@@ -18230,6 +21169,23 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                               virtiofs:
                                 description: Virtiofs is supported
+                                properties:
+                                  cache:
+                                    description: |-
+                                      Cache is the caching policy of virtiofsd for the shared directory.
+                                      Supported values: auto, always, metadata, never. Defaults to auto.
+                                    type: string
+                                  queueSize:
+                                    description: |-
+                                      QueueSize is the size of the virtqueue of the filesystem device.
+                                      Must be a power of 2 between 4 and 1024. Defaults to 1024.
+                                    format: int32
+                                    type: integer
+                                  xattr:
+                                    description: |-
+                                      Xattr enables the support of extended attributes in the shared directory.
+                                      Defaults to false.
+                                    type: boolean
                                 type: object
                             required:
                             - name
@@ -18328,7 +21284,7 @@ var CRDsValidation map[string]string = map[string]string{
                               bus:
                                 description: |-
                                   Bus indicates the bus of input device to emulate.
-                                  Supported values: virtio, usb.
+                                  Supported values: virtio, usb, ps2.
                                 type: string
                               name:
                                 description: Name is the device name
@@ -18336,7 +21292,7 @@ var CRDsValidation map[string]string = map[string]string{
                               type:
                                 description: |-
                                   Type indicated the type of input device.
-                                  Supported values: tablet.
+                                  Supported values: tablet, and keyboard or mouse on the ps2 bus.
                                 type: string
                             required:
                             - name
@@ -18379,6 +21335,26 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: InterfaceBridge connects to a given network
                                   via a linux bridge.
                                 type: object
+                              ccwAddress:
+                                description: |-
+                                  If specified, the virtual network interface will be placed on the guests CCW address with the specified device number.
+                                  Only supported on s390x. For example: 0.0.0002
+                                type: string
+                              coalesce:
+                                description: |-
+                                  Coalesce configures the interrupt coalescing of the interface, reducing the interrupt load
+                                  on the vCPUs of guests handling high packet rates.
+                                  Only supported by virtio interfaces backed by a tap device.
+                                properties:
+                                  rxMaxFrames:
+                                    description: RxMaxFrames is the maximum number
+                                      of received frames coalesced into a single interrupt.
+                                    format: int32
+                                    minimum: 1
+                                    type: integer
+                                required:
+                                - rxMaxFrames
+                                type: object
                               dhcpOptions:
                                 description: If specified the network interface will
                                   pass additional DHCP options to the VMI
@@ -18387,6 +21363,19 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: If specified will pass option 67
                                       to interface's DHCP server
                                     type: string
+                                  dnsServers:
+                                    description: |-
+                                      If specified will pass the configured DNS servers to the VM instead of the ones of the pod.
+                                      The pod itself keeps its DNS configuration. Only supported by the masquerade binding.
+                                    items:
+                                      type: string
+                                    type: array
+                                  nextServer:
+                                    description: |-
+                                      If specified will be passed as the BOOTP next server address (siaddr) to the interface's DHCP server.
+                                      Network boot firmwares which only look at the BOOTP header, like the s390x network boot loader,
+                                      need it to locate the TFTP server. Defaults to tftpServerName when that is an IPv4 address.
+                                    type: string
                                   ntpServers:
                                     description: If specified will pass the configured
                                       NTP server to the VM via DHCP option 042.
@@ -18415,6 +21404,13 @@ var CRDsValidation map[string]string = map[string]string{
                                       - value
                                       type: object
                                     type: array
+                                  searchDomains:
+                                    description: |-
+                                      If specified will pass the configured search domains to the VM instead of the ones of the pod.
+                                      The pod itself keeps its DNS configuration. Only supported by the masquerade binding.
+                                    items:
+                                      type: string
+                                    type: array
                                   tftpServerName:
                                     description: If specified will pass option 66
                                       to interface's DHCP server
@@ -18445,6 +21441,63 @@ var CRDsValidation map[string]string = map[string]string{
                                   Logical name of the interface as well as a reference to the associated networks.
                                   Must match the Name of a Network.
                                 type: string
+                              offloads:
+                                description: |-
+                                  Offloads toggles the checksum and segmentation offloads of the interface, e.g. for guests
+                                  running their own tunneling or encapsulation stack. Unset offloads keep their default.
+                                  Only supported by virtio interfaces.
+                                properties:
+                                  guest:
+                                    description: Guest toggles the offloads offered
+                                      to the guest driver.
+                                    properties:
+                                      csum:
+                                        description: Checksum toggles the checksum
+                                          offload.
+                                        type: boolean
+                                      tso4:
+                                        description: TSO4 toggles the TCP segmentation
+                                          offload over IPv4.
+                                        type: boolean
+                                      tso6:
+                                        description: TSO6 toggles the TCP segmentation
+                                          offload over IPv6.
+                                        type: boolean
+                                      ufo:
+                                        description: UFO toggles the UDP fragmentation
+                                          offload.
+                                        type: boolean
+                                    type: object
+                                  host:
+                                    description: Host toggles the offloads of the
+                                      interface backend on the host.
+                                    properties:
+                                      csum:
+                                        description: Checksum toggles the checksum
+                                          offload.
+                                        type: boolean
+                                      gso:
+                                        description: GSO toggles the generic segmentation
+                                          offload.
+                                        type: boolean
+                                      mrgRxBuf:
+                                        description: MergeRxBuffers toggles the mergeable
+                                          receive buffers.
+                                        type: boolean
+                                      tso4:
+                                        description: TSO4 toggles the TCP segmentation
+                                          offload over IPv4.
+                                        type: boolean
+                                      tso6:
+                                        description: TSO6 toggles the TCP segmentation
+                                          offload over IPv6.
+                                        type: boolean
+                                      ufo:
+                                        description: UFO toggles the UDP fragmentation
+                                          offload.
+                                        type: boolean
+                                    type: object
+                                type: object
                               passt:
                                 description: |-
                                   DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -18486,6 +21539,13 @@ var CRDsValidation map[string]string = map[string]string{
                                   - port
                                   type: object
                                 type: array
+                              rxQueueSize:
+                                description: |-
+                                  RxQueueSize is the number of descriptors of the receive virtqueues of the interface.
+                                  It must be a power of 2 between 256 and 1024. Defaults to 256.
+                                  Only supported by virtio interfaces.
+                                format: int32
+                                type: integer
                               slirp:
                                 description: |-
                                   DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -18509,6 +21569,13 @@ var CRDsValidation map[string]string = map[string]string{
                                   address and its tag will be provided to the guest
                                   via config drive
                                 type: string
+                              txQueueSize:
+                                description: |-
+                                  TxQueueSize is the number of descriptors of the transmit virtqueues of the interface.
+                                  It must be a power of 2 between 256 and 1024. Defaults to 256.
+                                  Only supported by virtio interfaces, QEMU applies it to vhost-user backends only.
+                                format: int32
+                                type: integer
                             required:
                             - name
                             type: object
@@ -18528,6 +21595,13 @@ var CRDsValidation map[string]string = map[string]string{
                             depends on additional factors of the VirtualMachineInstance,
                             like the number of guest CPUs.
                           type: boolean
+                        networkInterfaceRSS:
+                          description: If specified, virtio network interfaces with
+                            multiple queues distribute the received packets across
+                            their queues with receive side scaling (RSS) and report
+                            the packet hash to the guest. These interfaces are served
+                            by QEMU instead of the vhost kernel driver. Requires networkInterfaceMultiqueue.
+                          type: boolean
                         panicDevices:
                           description: PanicDevices provides additional crash information
                             when a guest crashes.
@@ -18537,7 +21611,7 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: |-
                                   Model specifies what type of panic device is provided.
                                   The panic model used when this attribute is missing depends on the hypervisor and guest arch.
-                                  One of: isa, hyperv, pvpanic.
+                                  One of: isa, hyperv, pvpanic, pvpanic-pci.
                                 type: string
                             type: object
                           type: array
@@ -18545,6 +21619,65 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Whether to have random number generator from
                             host
                           type: object
+                        scsiControllerCount:
+                          description: |-
+                            SCSIControllerCount is the number of virtio-scsi controllers to create.
+                            Disks and LUNs on the scsi bus can be mapped to a controller with scsiController,
+                            e.g. to exceed the queue limits of a single controller or to isolate workloads.
+                            Defaults to 1.
+                          format: int32
+                          minimum: 1
+                          type: integer
+                        scsiControllerQueues:
+                          description: |-
+                            SCSIControllerQueues is the number of request queues of each virtio-scsi controller.
+                            It allows multi-queue SCSI independently of the number of vCPUs, which is used otherwise
+                            when a disk on the scsi bus has a dedicated IOThread.
+                          format: int32
+                          minimum: 1
+                          type: integer
+                        serialConsoleTargetType:
+                          description: |-
+                            SerialConsoleTargetType selects the device exposing the auto-attached serial console to the guest.
+                            One of: isa-serial, pci-serial, usb-serial.
+                            Only supported on amd64, where it defaults to isa-serial.
+                          type: string
+                        serials:
+                          description: |-
+                            Serials describes additional serial ports exposed to the guest after the auto-attached serial console,
+                            e.g. for appliances expecting multiple UARTs.
+                            Up to 4 serial ports are supported, including the auto-attached serial console.
+                          items:
+                            description: Serial represents an additional serial port
+                              of the vmi, backed by a pty in the virt-launcher pod.
+                            properties:
+                              name:
+                                description: |-
+                                  Name of the serial port. Its output is logged to /var/run/kubevirt-private/<vmi uid>/virt-serial-<name>-log
+                                  in the virt-launcher pod.
+                                type: string
+                            required:
+                            - name
+                            type: object
+                          maxItems: 4
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        sgx:
+                          description: SGX provides an Intel SGX enclave page cache
+                            to the guest.
+                          properties:
+                            epcSize:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: |-
+                                EPCSize is the size of the enclave page cache of the guest, it is rounded up to a multiple of 1Mi.
+                                It is allocated from the EPC of the node, which is exposed as the devices.kubevirt.io/sgx-epc resource.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                          required:
+                          - epcSize
+                          type: object
                         sound:
                           description: Whether to emulate a sound device.
                           properties:
@@ -18568,11 +21701,31 @@ var CRDsValidation map[string]string = map[string]string{
                                 Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine
                                 Defaults to True
                               type: boolean
+                            external:
+                              description: |-
+                                External connects the vTPM to an externally managed swtpm listening on a unix socket on the node,
+                                e.g. for clusters with a centralized vTPM key management, instead of a swtpm spawned by virt-launcher.
+                                The version is then set by the external swtpm. It cannot be combined with persistent.
+                              properties:
+                                socketPath:
+                                  description: |-
+                                    SocketPath is the absolute path of the unix socket of the swtpm on the node.
+                                    It has to be in the directory of the namespace of the VMI in a permitted directory, like /var/run/vtpm/<namespace>/vm.sock.
+                                    The directory of the socket is mounted into the virt-launcher pod.
+                                  type: string
+                              required:
+                              - socketPath
+                              type: object
                             persistent:
                               description: |-
                                 Persistent indicates the state of the TPM device should be kept accross reboots
                                 Defaults to false
                               type: boolean
+                            version:
+                              description: |-
+                                Version is the version of the TPM specification implemented by the vTPM.
+                                One of: 1.2, 2.0. Defaults to 2.0.
+                              type: string
                           type: object
                         useVirtioTransitional:
                           description: |-
@@ -18584,12 +21737,75 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Video describes the video device configuration
                             for the vmi.
                           properties:
+                            accel3d:
+                              description: |-
+                                Accel3D enables the 3D acceleration of a virtio video device, either with virgl (OpenGL)
+                                or with venus (Vulkan). It requires a render node of a host GPU.
+                              type: string
+                            heads:
+                              description: |-
+                                Heads is the number of display heads of the video device.
+                                Defaults to 1.
+                              format: int32
+                              type: integer
                             type:
                               description: |-
                                 Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).
                                 If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).
                               type: string
+                            vram:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: |-
+                                VRAM is the size of the video memory of the video device.
+                                Defaults to 16Mi.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
                           type: object
+                        videos:
+                          description: |-
+                            Videos describes multiple video devices for the vmi, the first one being the primary device.
+                            It cannot be combined with video.
+                          items:
+                            properties:
+                              accel3d:
+                                description: |-
+                                  Accel3D enables the 3D acceleration of a virtio video device, either with virgl (OpenGL)
+                                  or with venus (Vulkan). It requires a render node of a host GPU.
+                                type: string
+                              heads:
+                                description: |-
+                                  Heads is the number of display heads of the video device.
+                                  Defaults to 1.
+                                format: int32
+                                type: integer
+                              type:
+                                description: |-
+                                  Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).
+                                  If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).
+                                type: string
+                              vram:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: |-
+                                  VRAM is the size of the video memory of the video device.
+                                  Defaults to 16Mi.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        virtioTransitionalDevices:
+                          description: |-
+                            VirtioTransitionalDevices lists the classes of virtio devices falling back to legacy virtio 0.9 support,
+                            e.g. for guests shipping a legacy disk driver but a virtio 1.0 network driver.
+                            All virtio devices fall back to legacy virtio 0.9 support when UseVirtioTransitional is set.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
                         watchdog:
                           description: Watchdog describes a watchdog device which
                             can be added to the vmi.
@@ -18914,6 +22130,32 @@ var CRDsValidation map[string]string = map[string]string{
                                   type: boolean
                               type: object
                           type: object
+                        fwCfg:
+                          description: |-
+                            FWCfg are the QEMU firmware configuration (fw_cfg) entries exposed to the guest,
+                            e.g. an ignition config or a custom early-boot provisioning blob.
+                          items:
+                            properties:
+                              key:
+                                description: Key of the ConfigMap or Secret whose
+                                  data is the content of the entry.
+                                type: string
+                              name:
+                                description: |-
+                                  Name of the entry as seen by the guest, e.g. opt/com.coreos/config.
+                                  It must start with opt/ and must not exceed 55 characters.
+                                type: string
+                              volumeNameRef:
+                                description: VolumeNameRef should match the volume
+                                  name of a ConfigMap or Secret object.
+                                type: string
+                            required:
+                            - key
+                            - name
+                            - volumeNameRef
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
                         kernelBoot:
                           description: Settings to set the kernel for booting.
                           properties:
@@ -18953,10 +22195,27 @@ var CRDsValidation map[string]string = map[string]string{
                               description: Arguments to be passed to the kernel at
                                 boot time
                               type: string
+                            rootFilesystem:
+                              description: |-
+                                RootFilesystem is the name of a virtiofs filesystem the guest mounts as its root filesystem,
+                                so that it boots without any disk. The kernel arguments must not set root or rootfstype.
+                              type: string
                           type: object
+                        oemStrings:
+                          description: |-
+                            OEMStrings are exposed to the guest as SMBIOS OEM strings (type 11),
+                            e.g. to pass provisioning hints to the guest.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
                         serial:
                           description: The system-serial-number in SMBIOS
                           type: string
+                        sku:
+                          description: The system SKU number in SMBIOS, overriding
+                            the cluster-wide SMBIOS configuration.
+                          type: string
                         uuid:
                           description: |-
                             UUID reported by the vmi bios.
@@ -18966,6 +22225,14 @@ var CRDsValidation map[string]string = map[string]string{
                     ioThreads:
                       description: IOThreads specifies the IOThreads options.
                       properties:
+                        pinning:
+                          additionalProperties:
+                            type: string
+                          description: |-
+                            Pinning pins iothreads to explicit host CPUs instead of the ones derived from the dedicated CPUs of the vmi.
+                            The keys are the iothread IDs, starting from 1, and the values are the cpusets, e.g. "4-5,7".
+                            The CPUs have to be allocated to the vmi. Requires dedicatedCpuPlacement.
+                          type: object
                         supplementalPoolThreadCount:
                           description: SupplementalPoolThreadCount specifies how many
                             iothreads are allocated for the supplementalPool policy.
@@ -19035,10 +22302,50 @@ var CRDsValidation map[string]string = map[string]string{
                             Defaults to the requested memory in the resources section if not specified.
                           pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                           x-kubernetes-int-or-string: true
+                        hotplug:
+                          description: Hotplug configures how the memory between Guest
+                            and MaxGuest is hot(un)plugged.
+                          properties:
+                            virtioMem:
+                              description: |-
+                                VirtioMem plugs the memory through a virtio-mem device which is created when the VirtualMachineInstance starts.
+                                The guest memory can then be grown and shrunk in blocks of BlockSize.
+                              properties:
+                                blockSize:
+                                  anyOf:
+                                  - type: integer
+                                  - type: string
+                                  description: |-
+                                    BlockSize is the granularity in which memory is plugged and unplugged.
+                                    It must be a power of 2 and at least 2Mi, or 1Gi when 1Gi hugepages are used.
+                                    Defaults to the smallest allowed value.
+                                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                  x-kubernetes-int-or-string: true
+                              type: object
+                          type: object
                         hugepages:
                           description: Hugepages allow to use hugepages for the VirtualMachineInstance
                             instead of regular memory.
                           properties:
+                            numaPolicy:
+                              description: |-
+                                NUMAPolicy binds the hugepages backing the guest memory to host NUMA nodes,
+                                so that large guests do not allocate their pages across nodes.
+                              properties:
+                                mode:
+                                  description: |-
+                                    Mode is the host NUMA memory allocation policy.
+                                    One of: strict, interleave, preferred.
+                                  type: string
+                                nodeSet:
+                                  description: |-
+                                    NodeSet is the list of host NUMA nodes the hugepages are allocated from, e.g. "0-1,3".
+                                    The nodes must be allowed by the cpuset of the virt-launcher pod.
+                                  type: string
+                              required:
+                              - mode
+                              - nodeSet
+                              type: object
                             pageSize:
                               description: PageSize specifies the hugepage size, for
                                 x86_64 architecture valid values are 1Gi and 2Mi.
@@ -19099,6 +22406,20 @@ var CRDsValidation map[string]string = map[string]string{
                     - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                     - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                   type: string
+                guestAgent:
+                  description: GuestAgent restricts the qemu-guest-agent commands
+                    KubeVirt sends to the guest.
+                  properties:
+                    allowedCommands:
+                      description: |-
+                        AllowedCommands lists the guest agent commands KubeVirt may execute in the guest, e.g. guest-get-fsinfo.
+                        The other commands are refused by virt-launcher, except guest-ping and guest-info which are
+                        needed to detect the agent. All commands are allowed when guestAgent is not set.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: set
+                  type: object
                 hostname:
                   description: |-
                     Specifies the hostname of the vmi
@@ -19501,6 +22822,37 @@ var CRDsValidation map[string]string = map[string]string{
                     If specified, the VMI will be dispatched by specified scheduler.
                     If not specified, the VMI will be dispatched by default scheduler.
                   type: string
+                shutdownLadder:
+                  description: |-
+                    ShutdownLadder escalates the graceful shutdown through steps with their own timeouts before the
+                    VirtualMachineInstance is force terminated. TerminationGracePeriodSeconds defaults to the sum
+                    of the step timeouts when it is not set.
+                  properties:
+                    steps:
+                      description: |-
+                        Steps are tried in order, each one for its own timeout, before escalating to the next one.
+                        The domain is destroyed once the last step timed out.
+                      items:
+                        description: ShutdownStep is one step of a ShutdownLadder.
+                        properties:
+                          method:
+                            description: Method used to ask the guest to power off,
+                              one of GuestAgent or ACPI.
+                            type: string
+                          timeoutSeconds:
+                            description: TimeoutSeconds is the time given to the
+                              guest to power off before escalating to the next step.
+                            format: int64
+                            type: integer
+                        required:
+                        - method
+                        - timeoutSeconds
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  required:
+                  - steps
+                  type: object
                 startStrategy:
                   description: StartStrategy can be set to "Paused" if Virtual Machine
                     should be started in paused state.
@@ -20077,6 +23429,19 @@ var CRDsValidation map[string]string = map[string]string{
                             - claimName
                             type: object
                         type: object
+                      hostBlockDevice:
+                        description: |-
+                          HostBlockDevice passes a raw block device of the node straight through to the vmi as a virtio disk.
+                          The path has to be permitted in the hostBlockDevices configuration of KubeVirt and the device is
+                          used exclusively by the vmi.
+                        properties:
+                          path:
+                            description: Path of the block device on the node, like
+                              /dev/nvme1n1.
+                            type: string
+                        required:
+                        - path
+                        type: object
                       hostDisk:
                         description: HostDisk represents a disk created on the cluster
                           level
@@ -20114,6 +23479,11 @@ var CRDsValidation map[string]string = map[string]string{
                               claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                               More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                             type: string
+                          encryptionPublicKey:
+                            description: |-
+                              EncryptionPublicKey is a PEM encoded RSA public key.
+                              When set, the memory dump is encrypted with it before being written to the pvc.
+                            type: string
                           hotpluggable:
                             description: Hotpluggable indicates whether the volume
                               can be hotplugged and hotunplugged.
@@ -20224,6 +23594,19 @@ var CRDsValidation map[string]string = map[string]string{
                             type: object
                             x-kubernetes-map-type: atomic
                         type: object
+                      vhostUserBlk:
+                        description: |-
+                          VhostUserBlk represents a block device served by a vhost-user-blk backend, such as SPDK,
+                          listening on a unix socket on the node.
+                        properties:
+                          path:
+                            description: |-
+                              Path of the unix socket of the vhost-user-blk backend on the node.
+                              It has to be in the directory of the namespace of the VMI in a permitted directory, like /var/tmp/spdk/<namespace>/vhost.0.
+                            type: string
+                        required:
+                        - path
+                        type: object
                     required:
                     - name
                     type: object
@@ -20356,6 +23739,62 @@ var CRDsValidation map[string]string = map[string]string{
                     GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
                     The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
                   type: object
+                manual:
+                  description: |-
+                    Manual defines the guest numa topology explicitly, independently of the host numa topology.
+                    It is mutually exclusive with GuestMappingPassthrough.
+                  properties:
+                    nodes:
+                      description: Nodes of the guest numa topology. The index of
+                        a node in the list is its numa node id.
+                      items:
+                        description: NUMANode describes a guest numa node.
+                        properties:
+                          cpus:
+                            description: CPUs is the list of vCPUs belonging to the
+                              node, e.g. "0-3,8". Every vCPU must belong to exactly
+                              one node.
+                            type: string
+                          distances:
+                            description: |-
+                              Distances from this node to the other nodes. An unset distance is the distance set from the other node back
+                              to this one, or defaults to 10 to the node itself and to 20 to the other nodes.
+                            items:
+                              description: NUMADistance is the distance from a guest
+                                numa node to another one.
+                              properties:
+                                node:
+                                  description: Node is the id of the other numa node.
+                                  format: int32
+                                  type: integer
+                                value:
+                                  description: Value of the distance, between 10 and
+                                    255.
+                                  format: int32
+                                  type: integer
+                              required:
+                              - node
+                              - value
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          memory:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: Memory of the node. The memory of all the
+                              nodes must add up to the guest memory.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                        required:
+                        - cpus
+                        - memory
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  required:
+                  - nodes
+                  type: object
               type: object
             realtime:
               description: Realtime instructs the virt-launcher to tune the VMI for
@@ -20453,6 +23892,14 @@ var CRDsValidation map[string]string = map[string]string{
           description: Optionally specifies the IOThreads options to be used by the
             instancetype.
           properties:
+            pinning:
+              additionalProperties:
+                type: string
+              description: |-
+                Pinning pins iothreads to explicit host CPUs instead of the ones derived from the dedicated CPUs of the vmi.
+                The keys are the iothread IDs, starting from 1, and the values are the cpusets, e.g. "4-5,7".
+                The CPUs have to be allocated to the vmi. Requires dedicatedCpuPlacement.
+              type: object
             supplementalPoolThreadCount:
               description: SupplementalPoolThreadCount specifies how many iothreads
                 are allocated for the supplementalPool policy.
@@ -20511,6 +23958,25 @@ var CRDsValidation map[string]string = map[string]string{
               description: Optionally enables the use of hugepages for the VirtualMachineInstance
                 instead of regular memory.
               properties:
+                numaPolicy:
+                  description: |-
+                    NUMAPolicy binds the hugepages backing the guest memory to host NUMA nodes,
+                    so that large guests do not allocate their pages across nodes.
+                  properties:
+                    mode:
+                      description: |-
+                        Mode is the host NUMA memory allocation policy.
+                        One of: strict, interleave, preferred.
+                      type: string
+                    nodeSet:
+                      description: |-
+                        NodeSet is the list of host NUMA nodes the hugepages are allocated from, e.g. "0-1,3".
+                        The nodes must be allowed by the cpuset of the virt-launcher pod.
+                      type: string
+                  required:
+                  - mode
+                  - nodeSet
+                  type: object
                 pageSize:
                   description: PageSize specifies the hugepage size, for x86_64 architecture
                     valid values are 1Gi and 2Mi.
@@ -20947,6 +24413,42 @@ var CRDsValidation map[string]string = map[string]string{
             spec:
               description: VirtualMachineSpec contains the VirtualMachine specification.
               properties:
+                cpuBurst:
+                  description: |-
+                    CPUBurst raises the CPU limit of the virt-launcher pod during daily windows,
+                    e.g. while backup jobs run in the guest.
+                  properties:
+                    cpuLimit:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: CPULimit is the CPU limit of the compute container
+                        during a window.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    windows:
+                      description: Windows are the daily windows during which the
+                        CPU limit is raised.
+                      items:
+                        description: CPUBurstWindow is a daily window.
+                        properties:
+                          duration:
+                            description: Duration of the window, at most 24 hours.
+                            type: string
+                          start:
+                            description: Start is the time of day the window opens,
+                              in the 24-hour "15:04" format and in UTC.
+                            type: string
+                        required:
+                        - duration
+                        - start
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  required:
+                  - cpuLimit
+                  - windows
+                  type: object
                 dataVolumeTemplates:
                   description: |-
                     dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.
@@ -21646,6 +25148,17 @@ var CRDsValidation map[string]string = map[string]string{
                         captured the first time the instancetype is applied to the VirtualMachineInstance.
                       type: string
                   type: object
+                maintenanceSnapshot:
+                  description: |-
+                    MaintenanceSnapshot enables taking a VirtualMachineSnapshot before CPU, memory or
+                    interface hotplug and before firmware changes are applied to the running VM.
+                  properties:
+                    successWindow:
+                      description: |-
+                        SuccessWindow is how long the VM has to stay ready after the snapshot has been taken
+                        before the snapshot is garbage collected. Defaults to 24h.
+                      type: string
+                  type: object
                 preference:
                   description: PreferenceMatcher references a set of preference that
                     is used to fill fields in Template
@@ -22823,6 +26336,12 @@ var CRDsValidation map[string]string = map[string]string{
                               description: Clock sets the clock and timers of the
                                 vmi.
                               properties:
+                                ptp:
+                                  description: |-
+                                    PTP exposes a virtual PTP hardware clock (kvm-ptp) to the guest, so that it can
+                                    discipline its clock to the host clock through the ptp_kvm driver.
+                                    Only supported on amd64, where it requires the kvm timer, and arm64.
+                                  type: object
                                 timer:
                                   description: Timer specifies whih timers are attached
                                     to the vmi.
@@ -22921,6 +26440,24 @@ var CRDsValidation map[string]string = map[string]string{
                               description: CPU allow specified the detailed CPU topology
                                 inside the vmi.
                               properties:
+                                cache:
+                                  description: Cache describes how the CPU cache is
+                                    presented to the guest, e.g. passing the host
+                                    L3 cache through.
+                                  properties:
+                                    level:
+                                      description: |-
+                                        Level is the cache level the mode applies to.
+                                        Only level 3 can be emulated or disabled, passthrough applies to all the levels.
+                                      format: int32
+                                      type: integer
+                                    mode:
+                                      description: Mode is the cache mode, one of
+                                        passthrough, emulate or disable.
+                                      type: string
+                                  required:
+                                  - mode
+                                  type: object
                                 cores:
                                   description: |-
                                     Cores specifies the number of cores inside the vmi.
@@ -22984,6 +26521,68 @@ var CRDsValidation map[string]string = map[string]string{
                                         GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
                                         The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
                                       type: object
+                                    manual:
+                                      description: |-
+                                        Manual defines the guest numa topology explicitly, independently of the host numa topology.
+                                        It is mutually exclusive with GuestMappingPassthrough.
+                                      properties:
+                                        nodes:
+                                          description: Nodes of the guest numa topology.
+                                            The index of a node in the list is its
+                                            numa node id.
+                                          items:
+                                            description: NUMANode describes a guest
+                                              numa node.
+                                            properties:
+                                              cpus:
+                                                description: CPUs is the list of vCPUs
+                                                  belonging to the node, e.g. "0-3,8".
+                                                  Every vCPU must belong to exactly
+                                                  one node.
+                                                type: string
+                                              distances:
+                                                description: |-
+                                                  Distances from this node to the other nodes. An unset distance is the distance set from the other node back
+                                                  to this one, or defaults to 10 to the node itself and to 20 to the other nodes.
+                                                items:
+                                                  description: NUMADistance is the
+                                                    distance from a guest numa node
+                                                    to another one.
+                                                  properties:
+                                                    node:
+                                                      description: Node is the id
+                                                        of the other numa node.
+                                                      format: int32
+                                                      type: integer
+                                                    value:
+                                                      description: Value of the distance,
+                                                        between 10 and 255.
+                                                      format: int32
+                                                      type: integer
+                                                  required:
+                                                  - node
+                                                  - value
+                                                  type: object
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                              memory:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Memory of the node. The
+                                                  memory of all the nodes must add
+                                                  up to the guest memory.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                            required:
+                                            - cpus
+                                            - memory
+                                            type: object
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                      required:
+                                      - nodes
+                                      type: object
                                   type: object
                                 realtime:
                                   description: Realtime instructs the virt-launcher
@@ -23043,11 +26642,55 @@ var CRDsValidation map[string]string = map[string]string{
                                     Whether to attach the VSOCK CID to the VM or not.
                                     VSOCK access will be available if set to true. Defaults to false.
                                   type: boolean
+                                balloon:
+                                  description: Balloon configures the memory reclaim
+                                    behavior of the memory balloon device.
+                                  properties:
+                                    deflateOnOOM:
+                                      description: |-
+                                        DeflateOnOOM lets the guest deflate the balloon when it runs out of memory,
+                                        instead of invoking its OOM killer.
+                                        Defaults to false.
+                                      type: boolean
+                                    disableStatsWithoutDriver:
+                                      description: |-
+                                        DisableStatsWithoutDriver stops the balloon statistics while the guest agent reports that the
+                                        guest has no balloon driver, e.g. a Windows guest without the virtio drivers, as QEMU would keep on
+                                        requesting statistics which the guest never provides. They are restored once the driver is installed.
+                                        Defaults to false.
+                                      type: boolean
+                                    freePageReporting:
+                                      description: |-
+                                        FreePageReporting lets the guest report its free pages to the host so they can be reclaimed.
+                                        It overrides the cluster-wide setting and the free page reporting annotation, but is always
+                                        disabled for high performance vmis, e.g. with dedicated CPUs or hugepages.
+                                      type: boolean
+                                  type: object
                                 blockMultiQueue:
                                   description: |-
                                     Whether or not to enable virtio multi-queue for block devices.
                                     Defaults to false.
                                   type: boolean
+                                channels:
+                                  description: |-
+                                    Channels describes additional virtio-serial channels exposed to the vmi,
+                                    each backed by a unix socket in the virt-launcher pod, e.g. for host-guest agents.
+                                  items:
+                                    description: Channel represents a virtio-serial
+                                      channel backed by a unix socket in the virt-launcher
+                                      pod.
+                                    properties:
+                                      name:
+                                        description: |-
+                                          Name is the name of the virtio-serial port seen by the guest, e.g. org.example.agent.0.
+                                          The socket is created at /var/run/kubevirt-channels/<name>.sock in the virt-launcher pod. The directory
+                                          is a volume shared with the hook sidecars of the vmi, which connect to the socket to talk to the guest.
+                                        type: string
+                                    required:
+                                    - name
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
                                 clientPassthrough:
                                   description: To configure and access client devices
                                     such as redirecting USB
@@ -23143,7 +26786,12 @@ var CRDsValidation map[string]string = map[string]string{
                                           bus:
                                             description: |-
                                               Bus indicates the type of disk device to emulate.
-                                              supported values: virtio, sata, scsi, usb.
+                                              supported values: virtio, sata, scsi, usb, nvme.
+                                            type: string
+                                          ccwAddress:
+                                            description: |-
+                                              If specified, the virtual disk will be placed on the guests CCW address with the specified device number.
+                                              Only supported on s390x with the virtio bus. For example: 0.0.0001
                                             type: string
                                           pciAddress:
                                             description: 'If specified, the virtual
@@ -23151,11 +26799,31 @@ var CRDsValidation map[string]string = map[string]string{
                                               address with the specified PCI address.
                                               For example: 0000:81:01.10'
                                             type: string
+                                          queueSize:
+                                            description: |-
+                                              QueueSize is the size of each virtqueue of the disk.
+                                              Only supported with the virtio bus. Must be a power of 2 between 4 and 1024.
+                                              Disks on the scsi bus share the virtqueues of their virtio-scsi controller,
+                                              whose size libvirt does not allow to set.
+                                            format: int32
+                                            type: integer
                                           readonly:
                                             description: |-
                                               ReadOnly.
                                               Defaults to false.
                                             type: boolean
+                                          scsiController:
+                                            description: |-
+                                              SCSIController is the index of the SCSI controller the disk is attached to.
+                                              Only valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.
+                                            format: int32
+                                            type: integer
+                                          zoned:
+                                            description: |-
+                                              Zoned passes the zone semantics of a zoned block device, like a ZNS NVMe namespace,
+                                              through to the guest using the virtio-blk zoned model. Only supported with the virtio bus
+                                              on block volumes. Defaults to false.
+                                            type: boolean
                                         type: object
                                       errorPolicy:
                                         description: If specified, it can change the
@@ -23166,6 +26834,92 @@ var CRDsValidation map[string]string = map[string]string{
                                           IO specifies which QEMU disk IO mode should be used.
                                           Supported values are: native, default, threads.
                                         type: string
+                                      ioTune:
+                                        description: |-
+                                          IOTune throttles the IO of the disk, so that a single VMI cannot saturate the storage
+                                          shared with other tenants.
+                                        properties:
+                                          burst:
+                                            description: Burst lets the disk exceed
+                                              its limits for a limited time.
+                                            properties:
+                                              lengthSeconds:
+                                                description: LengthSeconds is the
+                                                  longest duration of a burst. Defaults
+                                                  to 1 second.
+                                                format: int64
+                                                type: integer
+                                              readBytesSec:
+                                                description: ReadBytesSec is the read
+                                                  throughput the disk may reach while
+                                                  bursting, in bytes per second.
+                                                format: int64
+                                                type: integer
+                                              readIopsSec:
+                                                description: ReadIOPSSec is the read
+                                                  operations per second the disk may
+                                                  reach while bursting.
+                                                format: int64
+                                                type: integer
+                                              totalBytesSec:
+                                                description: TotalBytesSec is the
+                                                  throughput the disk may reach while
+                                                  bursting, in bytes per second.
+                                                format: int64
+                                                type: integer
+                                              totalIopsSec:
+                                                description: TotalIOPSSec is the IO
+                                                  operations per second the disk may
+                                                  reach while bursting.
+                                                format: int64
+                                                type: integer
+                                              writeBytesSec:
+                                                description: WriteBytesSec is the
+                                                  write throughput the disk may reach
+                                                  while bursting, in bytes per second.
+                                                format: int64
+                                                type: integer
+                                              writeIopsSec:
+                                                description: WriteIOPSSec is the write
+                                                  operations per second the disk may
+                                                  reach while bursting.
+                                                format: int64
+                                                type: integer
+                                            type: object
+                                          readBytesSec:
+                                            description: ReadBytesSec limits the read
+                                              throughput of the disk, in bytes per
+                                              second.
+                                            format: int64
+                                            type: integer
+                                          readIopsSec:
+                                            description: ReadIOPSSec limits the read
+                                              operations per second of the disk.
+                                            format: int64
+                                            type: integer
+                                          totalBytesSec:
+                                            description: TotalBytesSec limits the
+                                              throughput of the disk, in bytes per
+                                              second.
+                                            format: int64
+                                            type: integer
+                                          totalIopsSec:
+                                            description: TotalIOPSSec limits the IO
+                                              operations per second of the disk.
+                                            format: int64
+                                            type: integer
+                                          writeBytesSec:
+                                            description: WriteBytesSec limits the
+                                              write throughput of the disk, in bytes
+                                              per second.
+                                            format: int64
+                                            type: integer
+                                          writeIopsSec:
+                                            description: WriteIOPSSec limits the write
+                                              operations per second of the disk.
+                                            format: int64
+                                            type: integer
+                                        type: object
                                       lun:
                                         description: Attach a volume as a LUN to the
                                           vmi.
@@ -23185,10 +26939,22 @@ var CRDsValidation map[string]string = map[string]string{
                                               the disk needs to support the persistent
                                               reservation for the SCSI disk
                                             type: boolean
+                                          scsiController:
+                                            description: |-
+                                              SCSIController is the index of the SCSI controller the LUN is attached to.
+                                              Only valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.
+                                            format: int32
+                                            type: integer
                                         type: object
                                       name:
                                         description: Name is the device name
                                         type: string
+                                      rerrorPolicy:
+                                        description: |-
+                                          RerrorPolicy, if specified, overrides the error policy for read errors,
+                                          e.g. to report read errors while stopping on write errors.
+                                          Supported values are: stop, report, ignore. Defaults to the error policy.
+                                        type: string
                                       serial:
                                         description: Serial provides the ability to
                                           specify a serial number for the disk device.
@@ -23222,6 +26988,23 @@ var CRDsValidation map[string]string = map[string]string{
                                         type: string
                                       virtiofs:
                                         description: Virtiofs is supported
+                                        properties:
+                                          cache:
+                                            description: |-
+                                              Cache is the caching policy of virtiofsd for the shared directory.
+                                              Supported values: auto, always, metadata, never. Defaults to auto.
+                                            type: string
+                                          queueSize:
+                                            description: |-
+                                              QueueSize is the size of the virtqueue of the filesystem device.
+                                              Must be a power of 2 between 4 and 1024. Defaults to 1024.
+                                            format: int32
+                                            type: integer
+                                          xattr:
+                                            description: |-
+                                              Xattr enables the support of extended attributes in the shared directory.
+                                              Defaults to false.
+                                            type: boolean
                                         type: object
                                     required:
                                     - name
@@ -23322,7 +27105,7 @@ var CRDsValidation map[string]string = map[string]string{
                                       bus:
                                         description: |-
                                           Bus indicates the bus of input device to emulate.
-                                          Supported values: virtio, usb.
+                                          Supported values: virtio, usb, ps2.
                                         type: string
                                       name:
                                         description: Name is the device name
@@ -23330,7 +27113,7 @@ var CRDsValidation map[string]string = map[string]string{
                                       type:
                                         description: |-
                                           Type indicated the type of input device.
-                                          Supported values: tablet.
+                                          Supported values: tablet, and keyboard or mouse on the ps2 bus.
                                         type: string
                                     required:
                                     - name
@@ -23373,6 +27156,27 @@ var CRDsValidation map[string]string = map[string]string{
                                         description: InterfaceBridge connects to a
                                           given network via a linux bridge.
                                         type: object
+                                      ccwAddress:
+                                        description: |-
+                                          If specified, the virtual network interface will be placed on the guests CCW address with the specified device number.
+                                          Only supported on s390x. For example: 0.0.0002
+                                        type: string
+                                      coalesce:
+                                        description: |-
+                                          Coalesce configures the interrupt coalescing of the interface, reducing the interrupt load
+                                          on the vCPUs of guests handling high packet rates.
+                                          Only supported by virtio interfaces backed by a tap device.
+                                        properties:
+                                          rxMaxFrames:
+                                            description: RxMaxFrames is the maximum
+                                              number of received frames coalesced
+                                              into a single interrupt.
+                                            format: int32
+                                            minimum: 1
+                                            type: integer
+                                        required:
+                                        - rxMaxFrames
+                                        type: object
                                       dhcpOptions:
                                         description: If specified the network interface
                                           will pass additional DHCP options to the
@@ -23382,6 +27186,19 @@ var CRDsValidation map[string]string = map[string]string{
                                             description: If specified will pass option
                                               67 to interface's DHCP server
                                             type: string
+                                          dnsServers:
+                                            description: |-
+                                              If specified will pass the configured DNS servers to the VM instead of the ones of the pod.
+                                              The pod itself keeps its DNS configuration. Only supported by the masquerade binding.
+                                            items:
+                                              type: string
+                                            type: array
+                                          nextServer:
+                                            description: |-
+                                              If specified will be passed as the BOOTP next server address (siaddr) to the interface's DHCP server.
+                                              Network boot firmwares which only look at the BOOTP header, like the s390x network boot loader,
+                                              need it to locate the TFTP server. Defaults to tftpServerName when that is an IPv4 address.
+                                            type: string
                                           ntpServers:
                                             description: If specified will pass the
                                               configured NTP server to the VM via
@@ -23412,6 +27229,13 @@ var CRDsValidation map[string]string = map[string]string{
                                               - value
                                               type: object
                                             type: array
+                                          searchDomains:
+                                            description: |-
+                                              If specified will pass the configured search domains to the VM instead of the ones of the pod.
+                                              The pod itself keeps its DNS configuration. Only supported by the masquerade binding.
+                                            items:
+                                              type: string
+                                            type: array
                                           tftpServerName:
                                             description: If specified will pass option
                                               66 to interface's DHCP server
@@ -23443,6 +27267,63 @@ var CRDsValidation map[string]string = map[string]string{
                                           Logical name of the interface as well as a reference to the associated networks.
                                           Must match the Name of a Network.
                                         type: string
+                                      offloads:
+                                        description: |-
+                                          Offloads toggles the checksum and segmentation offloads of the interface, e.g. for guests
+                                          running their own tunneling or encapsulation stack. Unset offloads keep their default.
+                                          Only supported by virtio interfaces.
+                                        properties:
+                                          guest:
+                                            description: Guest toggles the offloads
+                                              offered to the guest driver.
+                                            properties:
+                                              csum:
+                                                description: Checksum toggles the
+                                                  checksum offload.
+                                                type: boolean
+                                              tso4:
+                                                description: TSO4 toggles the TCP
+                                                  segmentation offload over IPv4.
+                                                type: boolean
+                                              tso6:
+                                                description: TSO6 toggles the TCP
+                                                  segmentation offload over IPv6.
+                                                type: boolean
+                                              ufo:
+                                                description: UFO toggles the UDP fragmentation
+                                                  offload.
+                                                type: boolean
+                                            type: object
+                                          host:
+                                            description: Host toggles the offloads
+                                              of the interface backend on the host.
+                                            properties:
+                                              csum:
+                                                description: Checksum toggles the
+                                                  checksum offload.
+                                                type: boolean
+                                              gso:
+                                                description: GSO toggles the generic
+                                                  segmentation offload.
+                                                type: boolean
+                                              mrgRxBuf:
+                                                description: MergeRxBuffers toggles
+                                                  the mergeable receive buffers.
+                                                type: boolean
+                                              tso4:
+                                                description: TSO4 toggles the TCP
+                                                  segmentation offload over IPv4.
+                                                type: boolean
+                                              tso6:
+                                                description: TSO6 toggles the TCP
+                                                  segmentation offload over IPv6.
+                                                type: boolean
+                                              ufo:
+                                                description: UFO toggles the UDP fragmentation
+                                                  offload.
+                                                type: boolean
+                                            type: object
+                                        type: object
                                       passt:
                                         description: |-
                                           DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -23485,6 +27366,13 @@ var CRDsValidation map[string]string = map[string]string{
                                           - port
                                           type: object
                                         type: array
+                                      rxQueueSize:
+                                        description: |-
+                                          RxQueueSize is the number of descriptors of the receive virtqueues of the interface.
+                                          It must be a power of 2 between 256 and 1024. Defaults to 256.
+                                          Only supported by virtio interfaces.
+                                        format: int32
+                                        type: integer
                                       slirp:
                                         description: |-
                                           DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -23509,6 +27397,13 @@ var CRDsValidation map[string]string = map[string]string{
                                           interface address and its tag will be provided
                                           to the guest via config drive
                                         type: string
+                                      txQueueSize:
+                                        description: |-
+                                          TxQueueSize is the number of descriptors of the transmit virtqueues of the interface.
+                                          It must be a power of 2 between 256 and 1024. Defaults to 256.
+                                          Only supported by virtio interfaces, QEMU applies it to vhost-user backends only.
+                                        format: int32
+                                        type: integer
                                     required:
                                     - name
                                     type: object
@@ -23529,6 +27424,14 @@ var CRDsValidation map[string]string = map[string]string{
                                     factors of the VirtualMachineInstance, like the
                                     number of guest CPUs.
                                   type: boolean
+                                networkInterfaceRSS:
+                                  description: If specified, virtio network interfaces
+                                    with multiple queues distribute the received packets
+                                    across their queues with receive side scaling
+                                    (RSS) and report the packet hash to the guest.
+                                    These interfaces are served by QEMU instead of
+                                    the vhost kernel driver. Requires networkInterfaceMultiqueue.
+                                  type: boolean
                                 panicDevices:
                                   description: PanicDevices provides additional crash
                                     information when a guest crashes.
@@ -23538,7 +27441,7 @@ var CRDsValidation map[string]string = map[string]string{
                                         description: |-
                                           Model specifies what type of panic device is provided.
                                           The panic model used when this attribute is missing depends on the hypervisor and guest arch.
-                                          One of: isa, hyperv, pvpanic.
+                                          One of: isa, hyperv, pvpanic, pvpanic-pci.
                                         type: string
                                     type: object
                                   type: array
@@ -23546,6 +27449,66 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: Whether to have random number generator
                                     from host
                                   type: object
+                                scsiControllerCount:
+                                  description: |-
+                                    SCSIControllerCount is the number of virtio-scsi controllers to create.
+                                    Disks and LUNs on the scsi bus can be mapped to a controller with scsiController,
+                                    e.g. to exceed the queue limits of a single controller or to isolate workloads.
+                                    Defaults to 1.
+                                  format: int32
+                                  minimum: 1
+                                  type: integer
+                                scsiControllerQueues:
+                                  description: |-
+                                    SCSIControllerQueues is the number of request queues of each virtio-scsi controller.
+                                    It allows multi-queue SCSI independently of the number of vCPUs, which is used otherwise
+                                    when a disk on the scsi bus has a dedicated IOThread.
+                                  format: int32
+                                  minimum: 1
+                                  type: integer
+                                serialConsoleTargetType:
+                                  description: |-
+                                    SerialConsoleTargetType selects the device exposing the auto-attached serial console to the guest.
+                                    One of: isa-serial, pci-serial, usb-serial.
+                                    Only supported on amd64, where it defaults to isa-serial.
+                                  type: string
+                                serials:
+                                  description: |-
+                                    Serials describes additional serial ports exposed to the guest after the auto-attached serial console,
+                                    e.g. for appliances expecting multiple UARTs.
+                                    Up to 4 serial ports are supported, including the auto-attached serial console.
+                                  items:
+                                    description: Serial represents an additional
+                                      serial port of the vmi, backed by a pty in
+                                      the virt-launcher pod.
+                                    properties:
+                                      name:
+                                        description: |-
+                                          Name of the serial port. Its output is logged to /var/run/kubevirt-private/<vmi uid>/virt-serial-<name>-log
+                                          in the virt-launcher pod.
+                                        type: string
+                                    required:
+                                    - name
+                                    type: object
+                                  maxItems: 4
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                sgx:
+                                  description: SGX provides an Intel SGX enclave page
+                                    cache to the guest.
+                                  properties:
+                                    epcSize:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      description: |-
+                                        EPCSize is the size of the enclave page cache of the guest, it is rounded up to a multiple of 1Mi.
+                                        It is allocated from the EPC of the node, which is exposed as the devices.kubevirt.io/sgx-epc resource.
+                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                      x-kubernetes-int-or-string: true
+                                  required:
+                                  - epcSize
+                                  type: object
                                 sound:
                                   description: Whether to emulate a sound device.
                                   properties:
@@ -23570,11 +27533,31 @@ var CRDsValidation map[string]string = map[string]string{
                                         Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine
                                         Defaults to True
                                       type: boolean
+                                    external:
+                                      description: |-
+                                        External connects the vTPM to an externally managed swtpm listening on a unix socket on the node,
+                                        e.g. for clusters with a centralized vTPM key management, instead of a swtpm spawned by virt-launcher.
+                                        The version is then set by the external swtpm. It cannot be combined with persistent.
+                                      properties:
+                                        socketPath:
+                                          description: |-
+                                            SocketPath is the absolute path of the unix socket of the swtpm on the node.
+                                            It has to be in the directory of the namespace of the VMI in a permitted directory, like /var/run/vtpm/<namespace>/vm.sock.
+                                            The directory of the socket is mounted into the virt-launcher pod.
+                                          type: string
+                                      required:
+                                      - socketPath
+                                      type: object
                                     persistent:
                                       description: |-
                                         Persistent indicates the state of the TPM device should be kept accross reboots
                                         Defaults to false
                                       type: boolean
+                                    version:
+                                      description: |-
+                                        Version is the version of the TPM specification implemented by the vTPM.
+                                        One of: 1.2, 2.0. Defaults to 2.0.
+                                      type: string
                                   type: object
                                 useVirtioTransitional:
                                   description: |-
@@ -23586,12 +27569,75 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: Video describes the video device configuration
                                     for the vmi.
                                   properties:
+                                    accel3d:
+                                      description: |-
+                                        Accel3D enables the 3D acceleration of a virtio video device, either with virgl (OpenGL)
+                                        or with venus (Vulkan). It requires a render node of a host GPU.
+                                      type: string
+                                    heads:
+                                      description: |-
+                                        Heads is the number of display heads of the video device.
+                                        Defaults to 1.
+                                      format: int32
+                                      type: integer
                                     type:
                                       description: |-
                                         Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).
                                         If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).
                                       type: string
+                                    vram:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      description: |-
+                                        VRAM is the size of the video memory of the video device.
+                                        Defaults to 16Mi.
+                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                      x-kubernetes-int-or-string: true
                                   type: object
+                                videos:
+                                  description: |-
+                                    Videos describes multiple video devices for the vmi, the first one being the primary device.
+                                    It cannot be combined with video.
+                                  items:
+                                    properties:
+                                      accel3d:
+                                        description: |-
+                                          Accel3D enables the 3D acceleration of a virtio video device, either with virgl (OpenGL)
+                                          or with venus (Vulkan). It requires a render node of a host GPU.
+                                        type: string
+                                      heads:
+                                        description: |-
+                                          Heads is the number of display heads of the video device.
+                                          Defaults to 1.
+                                        format: int32
+                                        type: integer
+                                      type:
+                                        description: |-
+                                          Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).
+                                          If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).
+                                        type: string
+                                      vram:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: |-
+                                          VRAM is the size of the video memory of the video device.
+                                          Defaults to 16Mi.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                virtioTransitionalDevices:
+                                  description: |-
+                                    VirtioTransitionalDevices lists the classes of virtio devices falling back to legacy virtio 0.9 support,
+                                    e.g. for guests shipping a legacy disk driver but a virtio 1.0 network driver.
+                                    All virtio devices fall back to legacy virtio 0.9 support when UseVirtioTransitional is set.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
                                 watchdog:
                                   description: Watchdog describes a watchdog device
                                     which can be added to the vmi.
@@ -23919,6 +27965,32 @@ var CRDsValidation map[string]string = map[string]string{
                                           type: boolean
                                       type: object
                                   type: object
+                                fwCfg:
+                                  description: |-
+                                    FWCfg are the QEMU firmware configuration (fw_cfg) entries exposed to the guest,
+                                    e.g. an ignition config or a custom early-boot provisioning blob.
+                                  items:
+                                    properties:
+                                      key:
+                                        description: Key of the ConfigMap or Secret
+                                          whose data is the content of the entry.
+                                        type: string
+                                      name:
+                                        description: |-
+                                          Name of the entry as seen by the guest, e.g. opt/com.coreos/config.
+                                          It must start with opt/ and must not exceed 55 characters.
+                                        type: string
+                                      volumeNameRef:
+                                        description: VolumeNameRef should match the
+                                          volume name of a ConfigMap or Secret object.
+                                        type: string
+                                    required:
+                                    - key
+                                    - name
+                                    - volumeNameRef
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
                                 kernelBoot:
                                   description: Settings to set the kernel for booting.
                                   properties:
@@ -23959,10 +28031,27 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: Arguments to be passed to the kernel
                                         at boot time
                                       type: string
+                                    rootFilesystem:
+                                      description: |-
+                                        RootFilesystem is the name of a virtiofs filesystem the guest mounts as its root filesystem,
+                                        so that it boots without any disk. The kernel arguments must not set root or rootfstype.
+                                      type: string
                                   type: object
+                                oemStrings:
+                                  description: |-
+                                    OEMStrings are exposed to the guest as SMBIOS OEM strings (type 11),
+                                    e.g. to pass provisioning hints to the guest.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
                                 serial:
                                   description: The system-serial-number in SMBIOS
                                   type: string
+                                sku:
+                                  description: The system SKU number in SMBIOS, overriding
+                                    the cluster-wide SMBIOS configuration.
+                                  type: string
                                 uuid:
                                   description: |-
                                     UUID reported by the vmi bios.
@@ -23972,6 +28061,14 @@ var CRDsValidation map[string]string = map[string]string{
                             ioThreads:
                               description: IOThreads specifies the IOThreads options.
                               properties:
+                                pinning:
+                                  additionalProperties:
+                                    type: string
+                                  description: |-
+                                    Pinning pins iothreads to explicit host CPUs instead of the ones derived from the dedicated CPUs of the vmi.
+                                    The keys are the iothread IDs, starting from 1, and the values are the cpusets, e.g. "4-5,7".
+                                    The CPUs have to be allocated to the vmi. Requires dedicatedCpuPlacement.
+                                  type: object
                                 supplementalPoolThreadCount:
                                   description: SupplementalPoolThreadCount specifies
                                     how many iothreads are allocated for the supplementalPool
@@ -24045,11 +28142,51 @@ var CRDsValidation map[string]string = map[string]string{
                                     Defaults to the requested memory in the resources section if not specified.
                                   pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                                   x-kubernetes-int-or-string: true
+                                hotplug:
+                                  description: Hotplug configures how the memory between
+                                    Guest and MaxGuest is hot(un)plugged.
+                                  properties:
+                                    virtioMem:
+                                      description: |-
+                                        VirtioMem plugs the memory through a virtio-mem device which is created when the VirtualMachineInstance starts.
+                                        The guest memory can then be grown and shrunk in blocks of BlockSize.
+                                      properties:
+                                        blockSize:
+                                          anyOf:
+                                          - type: integer
+                                          - type: string
+                                          description: |-
+                                            BlockSize is the granularity in which memory is plugged and unplugged.
+                                            It must be a power of 2 and at least 2Mi, or 1Gi when 1Gi hugepages are used.
+                                            Defaults to the smallest allowed value.
+                                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                          x-kubernetes-int-or-string: true
+                                      type: object
+                                  type: object
                                 hugepages:
                                   description: Hugepages allow to use hugepages for
                                     the VirtualMachineInstance instead of regular
                                     memory.
                                   properties:
+                                    numaPolicy:
+                                      description: |-
+                                        NUMAPolicy binds the hugepages backing the guest memory to host NUMA nodes,
+                                        so that large guests do not allocate their pages across nodes.
+                                      properties:
+                                        mode:
+                                          description: |-
+                                            Mode is the host NUMA memory allocation policy.
+                                            One of: strict, interleave, preferred.
+                                          type: string
+                                        nodeSet:
+                                          description: |-
+                                            NodeSet is the list of host NUMA nodes the hugepages are allocated from, e.g. "0-1,3".
+                                            The nodes must be allowed by the cpuset of the virt-launcher pod.
+                                          type: string
+                                      required:
+                                      - mode
+                                      - nodeSet
+                                      type: object
                                     pageSize:
                                       description: PageSize specifies the hugepage
                                         size, for x86_64 architecture valid values
@@ -24111,6 +28248,20 @@ var CRDsValidation map[string]string = map[string]string{
                             - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                             - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                           type: string
+                        guestAgent:
+                          description: GuestAgent restricts the qemu-guest-agent
+                            commands KubeVirt sends to the guest.
+                          properties:
+                            allowedCommands:
+                              description: |-
+                                AllowedCommands lists the guest agent commands KubeVirt may execute in the guest, e.g. guest-get-fsinfo.
+                                The other commands are refused by virt-launcher, except guest-ping and guest-info which are
+                                needed to detect the agent. All commands are allowed when guestAgent is not set.
+                              items:
+                                type: string
+                              type: array
+                              x-kubernetes-list-type: set
+                          type: object
                         hostname:
                           description: |-
                             Specifies the hostname of the vmi
@@ -24513,6 +28664,38 @@ var CRDsValidation map[string]string = map[string]string{
                             If specified, the VMI will be dispatched by specified scheduler.
                             If not specified, the VMI will be dispatched by default scheduler.
                           type: string
+                        shutdownLadder:
+                          description: |-
+                            ShutdownLadder escalates the graceful shutdown through steps with their own timeouts before the
+                            VirtualMachineInstance is force terminated. TerminationGracePeriodSeconds defaults to the sum
+                            of the step timeouts when it is not set.
+                          properties:
+                            steps:
+                              description: |-
+                                Steps are tried in order, each one for its own timeout, before escalating to the next one.
+                                The domain is destroyed once the last step timed out.
+                              items:
+                                description: ShutdownStep is one step of a ShutdownLadder.
+                                properties:
+                                  method:
+                                    description: Method used to ask the guest to
+                                      power off, one of GuestAgent or ACPI.
+                                    type: string
+                                  timeoutSeconds:
+                                    description: TimeoutSeconds is the time given
+                                      to the guest to power off before escalating
+                                      to the next step.
+                                    format: int64
+                                    type: integer
+                                required:
+                                - method
+                                - timeoutSeconds
+                                type: object
+                              type: array
+                              x-kubernetes-list-type: atomic
+                          required:
+                          - steps
+                          type: object
                         startStrategy:
                           description: StartStrategy can be set to "Paused" if Virtual
                             Machine should be started in paused state.
@@ -25099,6 +29282,19 @@ var CRDsValidation map[string]string = map[string]string{
                                     - claimName
                                     type: object
                                 type: object
+                              hostBlockDevice:
+                                description: |-
+                                  HostBlockDevice passes a raw block device of the node straight through to the vmi as a virtio disk.
+                                  The path has to be permitted in the hostBlockDevices configuration of KubeVirt and the device is
+                                  used exclusively by the vmi.
+                                properties:
+                                  path:
+                                    description: Path of the block device on the
+                                      node, like /dev/nvme1n1.
+                                    type: string
+                                required:
+                                - path
+                                type: object
                               hostDisk:
                                 description: HostDisk represents a disk created on
                                   the cluster level
@@ -25136,6 +29332,11 @@ var CRDsValidation map[string]string = map[string]string{
                                       claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                                       More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                                     type: string
+                                  encryptionPublicKey:
+                                    description: |-
+                                      EncryptionPublicKey is a PEM encoded RSA public key.
+                                      When set, the memory dump is encrypted with it before being written to the pvc.
+                                    type: string
                                   hotpluggable:
                                     description: Hotpluggable indicates whether the
                                       volume can be hotplugged and hotunplugged.
@@ -25246,6 +29447,19 @@ var CRDsValidation map[string]string = map[string]string{
                                     type: object
                                     x-kubernetes-map-type: atomic
                                 type: object
+                              vhostUserBlk:
+                                description: |-
+                                  VhostUserBlk represents a block device served by a vhost-user-blk backend, such as SPDK,
+                                  listening on a unix socket on the node.
+                                properties:
+                                  path:
+                                    description: |-
+                                      Path of the unix socket of the vhost-user-blk backend on the node.
+                                      It has to be in the directory of the namespace of the VMI in a permitted directory, like /var/tmp/spdk/<namespace>/vhost.0.
+                                    type: string
+                                required:
+                                - path
+                                type: object
                             required:
                             - name
                             type: object
@@ -25610,16 +29824,44 @@ var CRDsValidation map[string]string = map[string]string{
                     Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine
                     Defaults to True
                   type: boolean
+                external:
+                  description: |-
+                    External connects the vTPM to an externally managed swtpm listening on a unix socket on the node,
+                    e.g. for clusters with a centralized vTPM key management, instead of a swtpm spawned by virt-launcher.
+                    The version is then set by the external swtpm. It cannot be combined with persistent.
+                  properties:
+                    socketPath:
+                      description: |-
+                        SocketPath is the absolute path of the unix socket of the swtpm on the node.
+                        It has to be in the directory of the namespace of the VMI in a permitted directory, like /var/run/vtpm/<namespace>/vm.sock.
+                        The directory of the socket is mounted into the virt-launcher pod.
+                      type: string
+                  required:
+                  - socketPath
+                  type: object
                 persistent:
                   description: |-
                     Persistent indicates the state of the TPM device should be kept accross reboots
                     Defaults to false
                   type: boolean
+                version:
+                  description: |-
+                    Version is the version of the TPM specification implemented by the vTPM.
+                    One of: 1.2, 2.0. Defaults to 2.0.
+                  type: string
               type: object
             preferredUseVirtioTransitional:
               description: PreferredUseVirtioTransitional optionally defines the preferred
                 value of UseVirtioTransitional
               type: boolean
+            preferredVirtioTransitionalDevices:
+              description: |-
+                PreferredVirtioTransitionalDevices optionally defines the preferred value of VirtioTransitionalDevices,
+                e.g. to mark the device classes for which an old guest only has legacy virtio 0.9 drivers.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
             preferredVirtualGPUOptions:
               description: PreferredVirtualGPUOptions optionally defines the preferred
                 value of VirtualGPUOptions
@@ -26353,6 +30595,42 @@ var CRDsValidation map[string]string = map[string]string{
                 spec:
                   description: VirtualMachineSpec contains the VirtualMachine specification.
                   properties:
+                    cpuBurst:
+                      description: |-
+                        CPUBurst raises the CPU limit of the virt-launcher pod during daily windows,
+                        e.g. while backup jobs run in the guest.
+                      properties:
+                        cpuLimit:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: CPULimit is the CPU limit of the compute container
+                            during a window.
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        windows:
+                          description: Windows are the daily windows during which
+                            the CPU limit is raised.
+                          items:
+                            description: CPUBurstWindow is a daily window.
+                            properties:
+                              duration:
+                                description: Duration of the window, at most 24 hours.
+                                type: string
+                              start:
+                                description: Start is the time of day the window opens,
+                                  in the 24-hour "15:04" format and in UTC.
+                                type: string
+                            required:
+                            - duration
+                            - start
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      required:
+                      - cpuLimit
+                      - windows
+                      type: object
                     dataVolumeTemplates:
                       description: |-
                         dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.
@@ -27063,6 +31341,17 @@ var CRDsValidation map[string]string = map[string]string{
                             captured the first time the instancetype is applied to the VirtualMachineInstance.
                           type: string
                       type: object
+                    maintenanceSnapshot:
+                      description: |-
+                        MaintenanceSnapshot enables taking a VirtualMachineSnapshot before CPU, memory or
+                        interface hotplug and before firmware changes are applied to the running VM.
+                      properties:
+                        successWindow:
+                          description: |-
+                            SuccessWindow is how long the VM has to stay ready after the snapshot has been taken
+                            before the snapshot is garbage collected. Defaults to 24h.
+                          type: string
+                      type: object
                     preference:
                       description: PreferenceMatcher references a set of preference
                         that is used to fill fields in Template
@@ -28255,6 +32544,12 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: Clock sets the clock and timers of
                                     the vmi.
                                   properties:
+                                    ptp:
+                                      description: |-
+                                        PTP exposes a virtual PTP hardware clock (kvm-ptp) to the guest, so that it can
+                                        discipline its clock to the host clock through the ptp_kvm driver.
+                                        Only supported on amd64, where it requires the kvm timer, and arm64.
+                                      type: object
                                     timer:
                                       description: Timer specifies whih timers are
                                         attached to the vmi.
@@ -28354,6 +32649,24 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: CPU allow specified the detailed CPU
                                     topology inside the vmi.
                                   properties:
+                                    cache:
+                                      description: Cache describes how the CPU cache
+                                        is presented to the guest, e.g. passing the
+                                        host L3 cache through.
+                                      properties:
+                                        level:
+                                          description: |-
+                                            Level is the cache level the mode applies to.
+                                            Only level 3 can be emulated or disabled, passthrough applies to all the levels.
+                                          format: int32
+                                          type: integer
+                                        mode:
+                                          description: Mode is the cache mode, one
+                                            of passthrough, emulate or disable.
+                                          type: string
+                                      required:
+                                      - mode
+                                      type: object
                                     cores:
                                       description: |-
                                         Cores specifies the number of cores inside the vmi.
@@ -28417,6 +32730,69 @@ var CRDsValidation map[string]string = map[string]string{
                                             GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
                                             The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
                                           type: object
+                                        manual:
+                                          description: |-
+                                            Manual defines the guest numa topology explicitly, independently of the host numa topology.
+                                            It is mutually exclusive with GuestMappingPassthrough.
+                                          properties:
+                                            nodes:
+                                              description: Nodes of the guest numa
+                                                topology. The index of a node in the
+                                                list is its numa node id.
+                                              items:
+                                                description: NUMANode describes a
+                                                  guest numa node.
+                                                properties:
+                                                  cpus:
+                                                    description: CPUs is the list
+                                                      of vCPUs belonging to the node,
+                                                      e.g. "0-3,8". Every vCPU must
+                                                      belong to exactly one node.
+                                                    type: string
+                                                  distances:
+                                                    description: |-
+                                                      Distances from this node to the other nodes. An unset distance is the distance set from the other node back
+                                                      to this one, or defaults to 10 to the node itself and to 20 to the other nodes.
+                                                    items:
+                                                      description: NUMADistance is
+                                                        the distance from a guest
+                                                        numa node to another one.
+                                                      properties:
+                                                        node:
+                                                          description: Node is the
+                                                            id of the other numa node.
+                                                          format: int32
+                                                          type: integer
+                                                        value:
+                                                          description: Value of the
+                                                            distance, between 10 and
+                                                            255.
+                                                          format: int32
+                                                          type: integer
+                                                      required:
+                                                      - node
+                                                      - value
+                                                      type: object
+                                                    type: array
+                                                    x-kubernetes-list-type: atomic
+                                                  memory:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Memory of the node.
+                                                      The memory of all the nodes
+                                                      must add up to the guest memory.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                required:
+                                                - cpus
+                                                - memory
+                                                type: object
+                                              type: array
+                                              x-kubernetes-list-type: atomic
+                                          required:
+                                          - nodes
+                                          type: object
                                       type: object
                                     realtime:
                                       description: Realtime instructs the virt-launcher
@@ -28476,11 +32852,55 @@ var CRDsValidation map[string]string = map[string]string{
                                         Whether to attach the VSOCK CID to the VM or not.
                                         VSOCK access will be available if set to true. Defaults to false.
                                       type: boolean
+                                    balloon:
+                                      description: Balloon configures the memory reclaim
+                                        behavior of the memory balloon device.
+                                      properties:
+                                        deflateOnOOM:
+                                          description: |-
+                                            DeflateOnOOM lets the guest deflate the balloon when it runs out of memory,
+                                            instead of invoking its OOM killer.
+                                            Defaults to false.
+                                          type: boolean
+                                        disableStatsWithoutDriver:
+                                          description: |-
+                                            DisableStatsWithoutDriver stops the balloon statistics while the guest agent reports that the
+                                            guest has no balloon driver, e.g. a Windows guest without the virtio drivers, as QEMU would keep on
+                                            requesting statistics which the guest never provides. They are restored once the driver is installed.
+                                            Defaults to false.
+                                          type: boolean
+                                        freePageReporting:
+                                          description: |-
+                                            FreePageReporting lets the guest report its free pages to the host so they can be reclaimed.
+                                            It overrides the cluster-wide setting and the free page reporting annotation, but is always
+                                            disabled for high performance vmis, e.g. with dedicated CPUs or hugepages.
+                                          type: boolean
+                                      type: object
                                     blockMultiQueue:
                                       description: |-
                                         Whether or not to enable virtio multi-queue for block devices.
                                         Defaults to false.
                                       type: boolean
+                                    channels:
+                                      description: |-
+                                        Channels describes additional virtio-serial channels exposed to the vmi,
+                                        each backed by a unix socket in the virt-launcher pod, e.g. for host-guest agents.
+                                      items:
+                                        description: Channel represents a virtio-serial
+                                          channel backed by a unix socket in the virt-launcher
+                                          pod.
+                                        properties:
+                                          name:
+                                            description: |-
+                                              Name is the name of the virtio-serial port seen by the guest, e.g. org.example.agent.0.
+                                              The socket is created at /var/run/kubevirt-channels/<name>.sock in the virt-launcher pod. The directory
+                                              is a volume shared with the hook sidecars of the vmi, which connect to the socket to talk to the guest.
+                                            type: string
+                                        required:
+                                        - name
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
                                     clientPassthrough:
                                       description: To configure and access client
                                         devices such as redirecting USB
@@ -28577,7 +32997,12 @@ var CRDsValidation map[string]string = map[string]string{
                                               bus:
                                                 description: |-
                                                   Bus indicates the type of disk device to emulate.
-                                                  supported values: virtio, sata, scsi, usb.
+                                                  supported values: virtio, sata, scsi, usb, nvme.
+                                                type: string
+                                              ccwAddress:
+                                                description: |-
+                                                  If specified, the virtual disk will be placed on the guests CCW address with the specified device number.
+                                                  Only supported on s390x with the virtio bus. For example: 0.0.0001
                                                 type: string
                                               pciAddress:
                                                 description: 'If specified, the virtual
@@ -28585,11 +33010,31 @@ var CRDsValidation map[string]string = map[string]string{
                                                   pci address with the specified PCI
                                                   address. For example: 0000:81:01.10'
                                                 type: string
+                                              queueSize:
+                                                description: |-
+                                                  QueueSize is the size of each virtqueue of the disk.
+                                                  Only supported with the virtio bus. Must be a power of 2 between 4 and 1024.
+                                                  Disks on the scsi bus share the virtqueues of their virtio-scsi controller,
+                                                  whose size libvirt does not allow to set.
+                                                format: int32
+                                                type: integer
                                               readonly:
                                                 description: |-
                                                   ReadOnly.
                                                   Defaults to false.
                                                 type: boolean
+                                              scsiController:
+                                                description: |-
+                                                  SCSIController is the index of the SCSI controller the disk is attached to.
+                                                  Only valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.
+                                                format: int32
+                                                type: integer
+                                              zoned:
+                                                description: |-
+                                                  Zoned passes the zone semantics of a zoned block device, like a ZNS NVMe namespace,
+                                                  through to the guest using the virtio-blk zoned model. Only supported with the virtio bus
+                                                  on block volumes. Defaults to false.
+                                                type: boolean
                                             type: object
                                           errorPolicy:
                                             description: If specified, it can change
@@ -28601,6 +33046,98 @@ var CRDsValidation map[string]string = map[string]string{
                                               IO specifies which QEMU disk IO mode should be used.
                                               Supported values are: native, default, threads.
                                             type: string
+                                          ioTune:
+                                            description: |-
+                                              IOTune throttles the IO of the disk, so that a single VMI cannot saturate the storage
+                                              shared with other tenants.
+                                            properties:
+                                              burst:
+                                                description: Burst lets the disk exceed
+                                                  its limits for a limited time.
+                                                properties:
+                                                  lengthSeconds:
+                                                    description: LengthSeconds is
+                                                      the longest duration of a burst.
+                                                      Defaults to 1 second.
+                                                    format: int64
+                                                    type: integer
+                                                  readBytesSec:
+                                                    description: ReadBytesSec is the
+                                                      read throughput the disk may
+                                                      reach while bursting, in bytes
+                                                      per second.
+                                                    format: int64
+                                                    type: integer
+                                                  readIopsSec:
+                                                    description: ReadIOPSSec is the
+                                                      read operations per second the
+                                                      disk may reach while bursting.
+                                                    format: int64
+                                                    type: integer
+                                                  totalBytesSec:
+                                                    description: TotalBytesSec is
+                                                      the throughput the disk may
+                                                      reach while bursting, in bytes
+                                                      per second.
+                                                    format: int64
+                                                    type: integer
+                                                  totalIopsSec:
+                                                    description: TotalIOPSSec is the
+                                                      IO operations per second the
+                                                      disk may reach while bursting.
+                                                    format: int64
+                                                    type: integer
+                                                  writeBytesSec:
+                                                    description: WriteBytesSec is
+                                                      the write throughput the disk
+                                                      may reach while bursting, in
+                                                      bytes per second.
+                                                    format: int64
+                                                    type: integer
+                                                  writeIopsSec:
+                                                    description: WriteIOPSSec is the
+                                                      write operations per second
+                                                      the disk may reach while bursting.
+                                                    format: int64
+                                                    type: integer
+                                                type: object
+                                              readBytesSec:
+                                                description: ReadBytesSec limits the
+                                                  read throughput of the disk, in
+                                                  bytes per second.
+                                                format: int64
+                                                type: integer
+                                              readIopsSec:
+                                                description: ReadIOPSSec limits the
+                                                  read operations per second of the
+                                                  disk.
+                                                format: int64
+                                                type: integer
+                                              totalBytesSec:
+                                                description: TotalBytesSec limits
+                                                  the throughput of the disk, in bytes
+                                                  per second.
+                                                format: int64
+                                                type: integer
+                                              totalIopsSec:
+                                                description: TotalIOPSSec limits the
+                                                  IO operations per second of the
+                                                  disk.
+                                                format: int64
+                                                type: integer
+                                              writeBytesSec:
+                                                description: WriteBytesSec limits
+                                                  the write throughput of the disk,
+                                                  in bytes per second.
+                                                format: int64
+                                                type: integer
+                                              writeIopsSec:
+                                                description: WriteIOPSSec limits the
+                                                  write operations per second of the
+                                                  disk.
+                                                format: int64
+                                                type: integer
+                                            type: object
                                           lun:
                                             description: Attach a volume as a LUN
                                               to the vmi.
@@ -28621,10 +33158,22 @@ var CRDsValidation map[string]string = map[string]string{
                                                   persistent reservation for the SCSI
                                                   disk
                                                 type: boolean
+                                              scsiController:
+                                                description: |-
+                                                  SCSIController is the index of the SCSI controller the LUN is attached to.
+                                                  Only valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.
+                                                format: int32
+                                                type: integer
                                             type: object
                                           name:
                                             description: Name is the device name
                                             type: string
+                                          rerrorPolicy:
+                                            description: |-
+                                              RerrorPolicy, if specified, overrides the error policy for read errors,
+                                              e.g. to report read errors while stopping on write errors.
+                                              Supported values are: stop, report, ignore. Defaults to the error policy.
+                                            type: string
                                           serial:
                                             description: Serial provides the ability
                                               to specify a serial number for the disk
@@ -28660,6 +33209,23 @@ var CRDsValidation map[string]string = map[string]string{
                                             type: string
                                           virtiofs:
                                             description: Virtiofs is supported
+                                            properties:
+                                              cache:
+                                                description: |-
+                                                  Cache is the caching policy of virtiofsd for the shared directory.
+                                                  Supported values: auto, always, metadata, never. Defaults to auto.
+                                                type: string
+                                              queueSize:
+                                                description: |-
+                                                  QueueSize is the size of the virtqueue of the filesystem device.
+                                                  Must be a power of 2 between 4 and 1024. Defaults to 1024.
+                                                format: int32
+                                                type: integer
+                                              xattr:
+                                                description: |-
+                                                  Xattr enables the support of extended attributes in the shared directory.
+                                                  Defaults to false.
+                                                type: boolean
                                             type: object
                                         required:
                                         - name
@@ -28762,7 +33328,7 @@ var CRDsValidation map[string]string = map[string]string{
                                           bus:
                                             description: |-
                                               Bus indicates the bus of input device to emulate.
-                                              Supported values: virtio, usb.
+                                              Supported values: virtio, usb, ps2.
                                             type: string
                                           name:
                                             description: Name is the device name
@@ -28770,7 +33336,7 @@ var CRDsValidation map[string]string = map[string]string{
                                           type:
                                             description: |-
                                               Type indicated the type of input device.
-                                              Supported values: tablet.
+                                              Supported values: tablet, and keyboard or mouse on the ps2 bus.
                                             type: string
                                         required:
                                         - name
@@ -28813,6 +33379,27 @@ var CRDsValidation map[string]string = map[string]string{
                                             description: InterfaceBridge connects
                                               to a given network via a linux bridge.
                                             type: object
+                                          ccwAddress:
+                                            description: |-
+                                              If specified, the virtual network interface will be placed on the guests CCW address with the specified device number.
+                                              Only supported on s390x. For example: 0.0.0002
+                                            type: string
+                                          coalesce:
+                                            description: |-
+                                              Coalesce configures the interrupt coalescing of the interface, reducing the interrupt load
+                                              on the vCPUs of guests handling high packet rates.
+                                              Only supported by virtio interfaces backed by a tap device.
+                                            properties:
+                                              rxMaxFrames:
+                                                description: RxMaxFrames is the maximum
+                                                  number of received frames coalesced
+                                                  into a single interrupt.
+                                                format: int32
+                                                minimum: 1
+                                                type: integer
+                                            required:
+                                            - rxMaxFrames
+                                            type: object
                                           dhcpOptions:
                                             description: If specified the network
                                               interface will pass additional DHCP
@@ -28822,6 +33409,19 @@ var CRDsValidation map[string]string = map[string]string{
                                                 description: If specified will pass
                                                   option 67 to interface's DHCP server
                                                 type: string
+                                              dnsServers:
+                                                description: |-
+                                                  If specified will pass the configured DNS servers to the VM instead of the ones of the pod.
+                                                  The pod itself keeps its DNS configuration. Only supported by the masquerade binding.
+                                                items:
+                                                  type: string
+                                                type: array
+                                              nextServer:
+                                                description: |-
+                                                  If specified will be passed as the BOOTP next server address (siaddr) to the interface's DHCP server.
+                                                  Network boot firmwares which only look at the BOOTP header, like the s390x network boot loader,
+                                                  need it to locate the TFTP server. Defaults to tftpServerName when that is an IPv4 address.
+                                                type: string
                                               ntpServers:
                                                 description: If specified will pass
                                                   the configured NTP server to the
@@ -28852,6 +33452,13 @@ var CRDsValidation map[string]string = map[string]string{
                                                   - value
                                                   type: object
                                                 type: array
+                                              searchDomains:
+                                                description: |-
+                                                  If specified will pass the configured search domains to the VM instead of the ones of the pod.
+                                                  The pod itself keeps its DNS configuration. Only supported by the masquerade binding.
+                                                items:
+                                                  type: string
+                                                type: array
                                               tftpServerName:
                                                 description: If specified will pass
                                                   option 66 to interface's DHCP server
@@ -28883,6 +33490,68 @@ var CRDsValidation map[string]string = map[string]string{
                                               Logical name of the interface as well as a reference to the associated networks.
                                               Must match the Name of a Network.
                                             type: string
+                                          offloads:
+                                            description: |-
+                                              Offloads toggles the checksum and segmentation offloads of the interface, e.g. for guests
+                                              running their own tunneling or encapsulation stack. Unset offloads keep their default.
+                                              Only supported by virtio interfaces.
+                                            properties:
+                                              guest:
+                                                description: Guest toggles the offloads
+                                                  offered to the guest driver.
+                                                properties:
+                                                  csum:
+                                                    description: Checksum toggles
+                                                      the checksum offload.
+                                                    type: boolean
+                                                  tso4:
+                                                    description: TSO4 toggles the
+                                                      TCP segmentation offload over
+                                                      IPv4.
+                                                    type: boolean
+                                                  tso6:
+                                                    description: TSO6 toggles the
+                                                      TCP segmentation offload over
+                                                      IPv6.
+                                                    type: boolean
+                                                  ufo:
+                                                    description: UFO toggles the UDP
+                                                      fragmentation offload.
+                                                    type: boolean
+                                                type: object
+                                              host:
+                                                description: Host toggles the offloads
+                                                  of the interface backend on the
+                                                  host.
+                                                properties:
+                                                  csum:
+                                                    description: Checksum toggles
+                                                      the checksum offload.
+                                                    type: boolean
+                                                  gso:
+                                                    description: GSO toggles the generic
+                                                      segmentation offload.
+                                                    type: boolean
+                                                  mrgRxBuf:
+                                                    description: MergeRxBuffers toggles
+                                                      the mergeable receive buffers.
+                                                    type: boolean
+                                                  tso4:
+                                                    description: TSO4 toggles the
+                                                      TCP segmentation offload over
+                                                      IPv4.
+                                                    type: boolean
+                                                  tso6:
+                                                    description: TSO6 toggles the
+                                                      TCP segmentation offload over
+                                                      IPv6.
+                                                    type: boolean
+                                                  ufo:
+                                                    description: UFO toggles the UDP
+                                                      fragmentation offload.
+                                                    type: boolean
+                                                type: object
+                                            type: object
                                           passt:
                                             description: |-
                                               DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -28925,6 +33594,13 @@ var CRDsValidation map[string]string = map[string]string{
                                               - port
                                               type: object
                                             type: array
+                                          rxQueueSize:
+                                            description: |-
+                                              RxQueueSize is the number of descriptors of the receive virtqueues of the interface.
+                                              It must be a power of 2 between 256 and 1024. Defaults to 256.
+                                              Only supported by virtio interfaces.
+                                            format: int32
+                                            type: integer
                                           slirp:
                                             description: |-
                                               DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -28950,6 +33626,13 @@ var CRDsValidation map[string]string = map[string]string{
                                               will be provided to the guest via config
                                               drive
                                             type: string
+                                          txQueueSize:
+                                            description: |-
+                                              TxQueueSize is the number of descriptors of the transmit virtqueues of the interface.
+                                              It must be a power of 2 between 256 and 1024. Defaults to 256.
+                                              Only supported by virtio interfaces, QEMU applies it to vhost-user backends only.
+                                            format: int32
+                                            type: integer
                                         required:
                                         - name
                                         type: object
@@ -28970,6 +33653,15 @@ var CRDsValidation map[string]string = map[string]string{
                                         factors of the VirtualMachineInstance, like
                                         the number of guest CPUs.
                                       type: boolean
+                                    networkInterfaceRSS:
+                                      description: If specified, virtio network interfaces
+                                        with multiple queues distribute the received
+                                        packets across their queues with receive side
+                                        scaling (RSS) and report the packet hash to
+                                        the guest. These interfaces are served by
+                                        QEMU instead of the vhost kernel driver. Requires
+                                        networkInterfaceMultiqueue.
+                                      type: boolean
                                     panicDevices:
                                       description: PanicDevices provides additional
                                         crash information when a guest crashes.
@@ -28979,7 +33671,7 @@ var CRDsValidation map[string]string = map[string]string{
                                             description: |-
                                               Model specifies what type of panic device is provided.
                                               The panic model used when this attribute is missing depends on the hypervisor and guest arch.
-                                              One of: isa, hyperv, pvpanic.
+                                              One of: isa, hyperv, pvpanic, pvpanic-pci.
                                             type: string
                                         type: object
                                       type: array
@@ -28987,6 +33679,66 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: Whether to have random number generator
                                         from host
                                       type: object
+                                    scsiControllerCount:
+                                      description: |-
+                                        SCSIControllerCount is the number of virtio-scsi controllers to create.
+                                        Disks and LUNs on the scsi bus can be mapped to a controller with scsiController,
+                                        e.g. to exceed the queue limits of a single controller or to isolate workloads.
+                                        Defaults to 1.
+                                      format: int32
+                                      minimum: 1
+                                      type: integer
+                                    scsiControllerQueues:
+                                      description: |-
+                                        SCSIControllerQueues is the number of request queues of each virtio-scsi controller.
+                                        It allows multi-queue SCSI independently of the number of vCPUs, which is used otherwise
+                                        when a disk on the scsi bus has a dedicated IOThread.
+                                      format: int32
+                                      minimum: 1
+                                      type: integer
+                                    serialConsoleTargetType:
+                                      description: |-
+                                        SerialConsoleTargetType selects the device exposing the auto-attached serial console to the guest.
+                                        One of: isa-serial, pci-serial, usb-serial.
+                                        Only supported on amd64, where it defaults to isa-serial.
+                                      type: string
+                                    serials:
+                                      description: |-
+                                        Serials describes additional serial ports exposed to the guest after the auto-attached serial console,
+                                        e.g. for appliances expecting multiple UARTs.
+                                        Up to 4 serial ports are supported, including the auto-attached serial console.
+                                      items:
+                                        description: Serial represents an additional
+                                          serial port of the vmi, backed by a pty
+                                          in the virt-launcher pod.
+                                        properties:
+                                          name:
+                                            description: |-
+                                              Name of the serial port. Its output is logged to /var/run/kubevirt-private/<vmi uid>/virt-serial-<name>-log
+                                              in the virt-launcher pod.
+                                            type: string
+                                        required:
+                                        - name
+                                        type: object
+                                      maxItems: 4
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    sgx:
+                                      description: SGX provides an Intel SGX enclave
+                                        page cache to the guest.
+                                      properties:
+                                        epcSize:
+                                          anyOf:
+                                          - type: integer
+                                          - type: string
+                                          description: |-
+                                            EPCSize is the size of the enclave page cache of the guest, it is rounded up to a multiple of 1Mi.
+                                            It is allocated from the EPC of the node, which is exposed as the devices.kubevirt.io/sgx-epc resource.
+                                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                          x-kubernetes-int-or-string: true
+                                      required:
+                                      - epcSize
+                                      type: object
                                     sound:
                                       description: Whether to emulate a sound device.
                                       properties:
@@ -29011,11 +33763,31 @@ var CRDsValidation map[string]string = map[string]string{
                                             Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine
                                             Defaults to True
                                           type: boolean
+                                        external:
+                                          description: |-
+                                            External connects the vTPM to an externally managed swtpm listening on a unix socket on the node,
+                                            e.g. for clusters with a centralized vTPM key management, instead of a swtpm spawned by virt-launcher.
+                                            The version is then set by the external swtpm. It cannot be combined with persistent.
+                                          properties:
+                                            socketPath:
+                                              description: |-
+                                                SocketPath is the absolute path of the unix socket of the swtpm on the node.
+                                                It has to be in the directory of the namespace of the VMI in a permitted directory, like /var/run/vtpm/<namespace>/vm.sock.
+                                                The directory of the socket is mounted into the virt-launcher pod.
+                                              type: string
+                                          required:
+                                          - socketPath
+                                          type: object
                                         persistent:
                                           description: |-
                                             Persistent indicates the state of the TPM device should be kept accross reboots
                                             Defaults to false
                                           type: boolean
+                                        version:
+                                          description: |-
+                                            Version is the version of the TPM specification implemented by the vTPM.
+                                            One of: 1.2, 2.0. Defaults to 2.0.
+                                          type: string
                                       type: object
                                     useVirtioTransitional:
                                       description: |-
@@ -29027,12 +33799,75 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: Video describes the video device
                                         configuration for the vmi.
                                       properties:
+                                        accel3d:
+                                          description: |-
+                                            Accel3D enables the 3D acceleration of a virtio video device, either with virgl (OpenGL)
+                                            or with venus (Vulkan). It requires a render node of a host GPU.
+                                          type: string
+                                        heads:
+                                          description: |-
+                                            Heads is the number of display heads of the video device.
+                                            Defaults to 1.
+                                          format: int32
+                                          type: integer
                                         type:
                                           description: |-
                                             Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).
                                             If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).
                                           type: string
+                                        vram:
+                                          anyOf:
+                                          - type: integer
+                                          - type: string
+                                          description: |-
+                                            VRAM is the size of the video memory of the video device.
+                                            Defaults to 16Mi.
+                                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                          x-kubernetes-int-or-string: true
                                       type: object
+                                    videos:
+                                      description: |-
+                                        Videos describes multiple video devices for the vmi, the first one being the primary device.
+                                        It cannot be combined with video.
+                                      items:
+                                        properties:
+                                          accel3d:
+                                            description: |-
+                                              Accel3D enables the 3D acceleration of a virtio video device, either with virgl (OpenGL)
+                                              or with venus (Vulkan). It requires a render node of a host GPU.
+                                            type: string
+                                          heads:
+                                            description: |-
+                                              Heads is the number of display heads of the video device.
+                                              Defaults to 1.
+                                            format: int32
+                                            type: integer
+                                          type:
+                                            description: |-
+                                              Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).
+                                              If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).
+                                            type: string
+                                          vram:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: |-
+                                              VRAM is the size of the video memory of the video device.
+                                              Defaults to 16Mi.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    virtioTransitionalDevices:
+                                      description: |-
+                                        VirtioTransitionalDevices lists the classes of virtio devices falling back to legacy virtio 0.9 support,
+                                        e.g. for guests shipping a legacy disk driver but a virtio 1.0 network driver.
+                                        All virtio devices fall back to legacy virtio 0.9 support when UseVirtioTransitional is set.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
                                     watchdog:
                                       description: Watchdog describes a watchdog device
                                         which can be added to the vmi.
@@ -29360,6 +34195,33 @@ var CRDsValidation map[string]string = map[string]string{
                                               type: boolean
                                           type: object
                                       type: object
+                                    fwCfg:
+                                      description: |-
+                                        FWCfg are the QEMU firmware configuration (fw_cfg) entries exposed to the guest,
+                                        e.g. an ignition config or a custom early-boot provisioning blob.
+                                      items:
+                                        properties:
+                                          key:
+                                            description: Key of the ConfigMap or Secret
+                                              whose data is the content of the entry.
+                                            type: string
+                                          name:
+                                            description: |-
+                                              Name of the entry as seen by the guest, e.g. opt/com.coreos/config.
+                                              It must start with opt/ and must not exceed 55 characters.
+                                            type: string
+                                          volumeNameRef:
+                                            description: VolumeNameRef should match
+                                              the volume name of a ConfigMap or Secret
+                                              object.
+                                            type: string
+                                        required:
+                                        - key
+                                        - name
+                                        - volumeNameRef
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
                                     kernelBoot:
                                       description: Settings to set the kernel for
                                         booting.
@@ -29401,10 +34263,27 @@ var CRDsValidation map[string]string = map[string]string{
                                           description: Arguments to be passed to the
                                             kernel at boot time
                                           type: string
+                                        rootFilesystem:
+                                          description: |-
+                                            RootFilesystem is the name of a virtiofs filesystem the guest mounts as its root filesystem,
+                                            so that it boots without any disk. The kernel arguments must not set root or rootfstype.
+                                          type: string
                                       type: object
+                                    oemStrings:
+                                      description: |-
+                                        OEMStrings are exposed to the guest as SMBIOS OEM strings (type 11),
+                                        e.g. to pass provisioning hints to the guest.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
                                     serial:
                                       description: The system-serial-number in SMBIOS
                                       type: string
+                                    sku:
+                                      description: The system SKU number in SMBIOS,
+                                        overriding the cluster-wide SMBIOS configuration.
+                                      type: string
                                     uuid:
                                       description: |-
                                         UUID reported by the vmi bios.
@@ -29414,6 +34293,14 @@ var CRDsValidation map[string]string = map[string]string{
                                 ioThreads:
                                   description: IOThreads specifies the IOThreads options.
                                   properties:
+                                    pinning:
+                                      additionalProperties:
+                                        type: string
+                                      description: |-
+                                        Pinning pins iothreads to explicit host CPUs instead of the ones derived from the dedicated CPUs of the vmi.
+                                        The keys are the iothread IDs, starting from 1, and the values are the cpusets, e.g. "4-5,7".
+                                        The CPUs have to be allocated to the vmi. Requires dedicatedCpuPlacement.
+                                      type: object
                                     supplementalPoolThreadCount:
                                       description: SupplementalPoolThreadCount specifies
                                         how many iothreads are allocated for the supplementalPool
@@ -29487,11 +34374,51 @@ var CRDsValidation map[string]string = map[string]string{
                                         Defaults to the requested memory in the resources section if not specified.
                                       pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                                       x-kubernetes-int-or-string: true
+                                    hotplug:
+                                      description: Hotplug configures how the memory
+                                        between Guest and MaxGuest is hot(un)plugged.
+                                      properties:
+                                        virtioMem:
+                                          description: |-
+                                            VirtioMem plugs the memory through a virtio-mem device which is created when the VirtualMachineInstance starts.
+                                            The guest memory can then be grown and shrunk in blocks of BlockSize.
+                                          properties:
+                                            blockSize:
+                                              anyOf:
+                                              - type: integer
+                                              - type: string
+                                              description: |-
+                                                BlockSize is the granularity in which memory is plugged and unplugged.
+                                                It must be a power of 2 and at least 2Mi, or 1Gi when 1Gi hugepages are used.
+                                                Defaults to the smallest allowed value.
+                                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                              x-kubernetes-int-or-string: true
+                                          type: object
+                                      type: object
                                     hugepages:
                                       description: Hugepages allow to use hugepages
                                         for the VirtualMachineInstance instead of
                                         regular memory.
                                       properties:
+                                        numaPolicy:
+                                          description: |-
+                                            NUMAPolicy binds the hugepages backing the guest memory to host NUMA nodes,
+                                            so that large guests do not allocate their pages across nodes.
+                                          properties:
+                                            mode:
+                                              description: |-
+                                                Mode is the host NUMA memory allocation policy.
+                                                One of: strict, interleave, preferred.
+                                              type: string
+                                            nodeSet:
+                                              description: |-
+                                                NodeSet is the list of host NUMA nodes the hugepages are allocated from, e.g. "0-1,3".
+                                                The nodes must be allowed by the cpuset of the virt-launcher pod.
+                                              type: string
+                                          required:
+                                          - mode
+                                          - nodeSet
+                                          type: object
                                         pageSize:
                                           description: PageSize specifies the hugepage
                                             size, for x86_64 architecture valid values
@@ -29553,6 +34480,20 @@ var CRDsValidation map[string]string = map[string]string{
                                 - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                                 - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                               type: string
+                            guestAgent:
+                              description: GuestAgent restricts the qemu-guest-agent
+                                commands KubeVirt sends to the guest.
+                              properties:
+                                allowedCommands:
+                                  description: |-
+                                    AllowedCommands lists the guest agent commands KubeVirt may execute in the guest, e.g. guest-get-fsinfo.
+                                    The other commands are refused by virt-launcher, except guest-ping and guest-info which are
+                                    needed to detect the agent. All commands are allowed when guestAgent is not set.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: set
+                              type: object
                             hostname:
                               description: |-
                                 Specifies the hostname of the vmi
@@ -29958,6 +34899,38 @@ var CRDsValidation map[string]string = map[string]string{
                                 If specified, the VMI will be dispatched by specified scheduler.
                                 If not specified, the VMI will be dispatched by default scheduler.
                               type: string
+                            shutdownLadder:
+                              description: |-
+                                ShutdownLadder escalates the graceful shutdown through steps with their own timeouts before the
+                                VirtualMachineInstance is force terminated. TerminationGracePeriodSeconds defaults to the sum
+                                of the step timeouts when it is not set.
+                              properties:
+                                steps:
+                                  description: |-
+                                    Steps are tried in order, each one for its own timeout, before escalating to the next one.
+                                    The domain is destroyed once the last step timed out.
+                                  items:
+                                    description: ShutdownStep is one step of a ShutdownLadder.
+                                    properties:
+                                      method:
+                                        description: Method used to ask the guest
+                                          to power off, one of GuestAgent or ACPI.
+                                        type: string
+                                      timeoutSeconds:
+                                        description: TimeoutSeconds is the time given
+                                          to the guest to power off before escalating
+                                          to the next step.
+                                        format: int64
+                                        type: integer
+                                    required:
+                                    - method
+                                    - timeoutSeconds
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - steps
+                              type: object
                             startStrategy:
                               description: StartStrategy can be set to "Paused" if
                                 Virtual Machine should be started in paused state.
@@ -30551,6 +35524,19 @@ var CRDsValidation map[string]string = map[string]string{
                                         - claimName
                                         type: object
                                     type: object
+                                  hostBlockDevice:
+                                    description: |-
+                                      HostBlockDevice passes a raw block device of the node straight through to the vmi as a virtio disk.
+                                      The path has to be permitted in the hostBlockDevices configuration of KubeVirt and the device is
+                                      used exclusively by the vmi.
+                                    properties:
+                                      path:
+                                        description: Path of the block device on
+                                          the node, like /dev/nvme1n1.
+                                        type: string
+                                    required:
+                                    - path
+                                    type: object
                                   hostDisk:
                                     description: HostDisk represents a disk created
                                       on the cluster level
@@ -30589,6 +35575,11 @@ var CRDsValidation map[string]string = map[string]string{
                                           claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                                           More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                                         type: string
+                                      encryptionPublicKey:
+                                        description: |-
+                                          EncryptionPublicKey is a PEM encoded RSA public key.
+                                          When set, the memory dump is encrypted with it before being written to the pvc.
+                                        type: string
                                       hotpluggable:
                                         description: Hotpluggable indicates whether
                                           the volume can be hotplugged and hotunplugged.
@@ -30701,6 +35692,19 @@ var CRDsValidation map[string]string = map[string]string{
                                         type: object
                                         x-kubernetes-map-type: atomic
                                     type: object
+                                  vhostUserBlk:
+                                    description: |-
+                                      VhostUserBlk represents a block device served by a vhost-user-blk backend, such as SPDK,
+                                      listening on a unix socket on the node.
+                                    properties:
+                                      path:
+                                        description: |-
+                                          Path of the unix socket of the vhost-user-blk backend on the node.
+                                          It has to be in the directory of the namespace of the VMI in a permitted directory, like /var/tmp/spdk/<namespace>/vhost.0.
+                                        type: string
+                                    required:
+                                    - path
+                                    type: object
                                 required:
                                 - name
                                 type: object
@@ -30840,6 +35844,11 @@ var CRDsValidation map[string]string = map[string]string{
                           description: ClaimName is the name of the pvc that will
                             contain the memory dump
                           type: string
+                        encryptionPublicKey:
+                          description: |-
+                            EncryptionPublicKey is a PEM encoded RSA public key.
+                            When set, the memory dump is encrypted with it before being written to the pvc.
+                          type: string
                         endTimestamp:
                           description: EndTimestamp represents the time the memory
                             dump was completed
@@ -31065,7 +36074,12 @@ var CRDsValidation map[string]string = map[string]string{
                                       bus:
                                         description: |-
                                           Bus indicates the type of disk device to emulate.
-                                          supported values: virtio, sata, scsi, usb.
+                                          supported values: virtio, sata, scsi, usb, nvme.
+                                        type: string
+                                      ccwAddress:
+                                        description: |-
+                                          If specified, the virtual disk will be placed on the guests CCW address with the specified device number.
+                                          Only supported on s390x with the virtio bus. For example: 0.0.0001
                                         type: string
                                       pciAddress:
                                         description: 'If specified, the virtual disk
@@ -31073,11 +36087,31 @@ var CRDsValidation map[string]string = map[string]string{
                                           with the specified PCI address. For example:
                                           0000:81:01.10'
                                         type: string
+                                      queueSize:
+                                        description: |-
+                                          QueueSize is the size of each virtqueue of the disk.
+                                          Only supported with the virtio bus. Must be a power of 2 between 4 and 1024.
+                                          Disks on the scsi bus share the virtqueues of their virtio-scsi controller,
+                                          whose size libvirt does not allow to set.
+                                        format: int32
+                                        type: integer
                                       readonly:
                                         description: |-
                                           ReadOnly.
                                           Defaults to false.
                                         type: boolean
+                                      scsiController:
+                                        description: |-
+                                          SCSIController is the index of the SCSI controller the disk is attached to.
+                                          Only valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.
+                                        format: int32
+                                        type: integer
+                                      zoned:
+                                        description: |-
+                                          Zoned passes the zone semantics of a zoned block device, like a ZNS NVMe namespace,
+                                          through to the guest using the virtio-blk zoned model. Only supported with the virtio bus
+                                          on block volumes. Defaults to false.
+                                        type: boolean
                                     type: object
                                   errorPolicy:
                                     description: If specified, it can change the default
@@ -31088,6 +36122,88 @@ var CRDsValidation map[string]string = map[string]string{
                                       IO specifies which QEMU disk IO mode should be used.
                                       Supported values are: native, default, threads.
                                     type: string
+                                  ioTune:
+                                    description: |-
+                                      IOTune throttles the IO of the disk, so that a single VMI cannot saturate the storage
+                                      shared with other tenants.
+                                    properties:
+                                      burst:
+                                        description: Burst lets the disk exceed its
+                                          limits for a limited time.
+                                        properties:
+                                          lengthSeconds:
+                                            description: LengthSeconds is the longest
+                                              duration of a burst. Defaults to 1 second.
+                                            format: int64
+                                            type: integer
+                                          readBytesSec:
+                                            description: ReadBytesSec is the read
+                                              throughput the disk may reach while
+                                              bursting, in bytes per second.
+                                            format: int64
+                                            type: integer
+                                          readIopsSec:
+                                            description: ReadIOPSSec is the read operations
+                                              per second the disk may reach while
+                                              bursting.
+                                            format: int64
+                                            type: integer
+                                          totalBytesSec:
+                                            description: TotalBytesSec is the throughput
+                                              the disk may reach while bursting, in
+                                              bytes per second.
+                                            format: int64
+                                            type: integer
+                                          totalIopsSec:
+                                            description: TotalIOPSSec is the IO operations
+                                              per second the disk may reach while
+                                              bursting.
+                                            format: int64
+                                            type: integer
+                                          writeBytesSec:
+                                            description: WriteBytesSec is the write
+                                              throughput the disk may reach while
+                                              bursting, in bytes per second.
+                                            format: int64
+                                            type: integer
+                                          writeIopsSec:
+                                            description: WriteIOPSSec is the write
+                                              operations per second the disk may reach
+                                              while bursting.
+                                            format: int64
+                                            type: integer
+                                        type: object
+                                      readBytesSec:
+                                        description: ReadBytesSec limits the read
+                                          throughput of the disk, in bytes per second.
+                                        format: int64
+                                        type: integer
+                                      readIopsSec:
+                                        description: ReadIOPSSec limits the read operations
+                                          per second of the disk.
+                                        format: int64
+                                        type: integer
+                                      totalBytesSec:
+                                        description: TotalBytesSec limits the throughput
+                                          of the disk, in bytes per second.
+                                        format: int64
+                                        type: integer
+                                      totalIopsSec:
+                                        description: TotalIOPSSec limits the IO operations
+                                          per second of the disk.
+                                        format: int64
+                                        type: integer
+                                      writeBytesSec:
+                                        description: WriteBytesSec limits the write
+                                          throughput of the disk, in bytes per second.
+                                        format: int64
+                                        type: integer
+                                      writeIopsSec:
+                                        description: WriteIOPSSec limits the write
+                                          operations per second of the disk.
+                                        format: int64
+                                        type: integer
+                                    type: object
                                   lun:
                                     description: Attach a volume as a LUN to the vmi.
                                     properties:
@@ -31106,10 +36222,22 @@ var CRDsValidation map[string]string = map[string]string{
                                           disk needs to support the persistent reservation
                                           for the SCSI disk
                                         type: boolean
+                                      scsiController:
+                                        description: |-
+                                          SCSIController is the index of the SCSI controller the LUN is attached to.
+                                          Only valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.
+                                        format: int32
+                                        type: integer
                                     type: object
                                   name:
                                     description: Name is the device name
                                     type: string
+                                  rerrorPolicy:
+                                    description: |-
+                                      RerrorPolicy, if specified, overrides the error policy for read errors,
+                                      e.g. to report read errors while stopping on write errors.
+                                      Supported values are: stop, report, ignore. Defaults to the error policy.
+                                    type: string
                                   serial:
                                     description: Serial provides the ability to specify
                                       a serial number for the disk device.
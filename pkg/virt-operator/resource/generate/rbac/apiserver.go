@@ -30,6 +30,7 @@ import (
 
 	virtv1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/migrations"
+	"kubevirt.io/api/overcommit"
 )
 
 const (
@@ -268,6 +269,18 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					overcommit.GroupName,
+				},
+				Resources: []string{
+					overcommit.ResourceOvercommitProfiles,
+					overcommit.ResourceVirtualizationQuotas,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					"apps",
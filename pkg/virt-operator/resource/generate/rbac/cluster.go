@@ -34,6 +34,7 @@ import (
 	virtv1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/api/migrations"
+	"kubevirt.io/api/overcommit"
 )
 
 const (
@@ -68,6 +69,7 @@ const (
 	apiVMMemoryDump     = "virtualmachines/memorydump"
 	apiVMObjectGraph    = "virtualmachines/objectgraph"
 	apiVMEvacuateCancel = "virtualmachines/evacuate/cancel"
+	apiVMRevert         = "virtualmachines/revert"
 
 	apiVMInstancesConsole                   = "virtualmachineinstances/console"
 	apiVMInstancesVNC                       = "virtualmachineinstances/vnc"
@@ -77,6 +79,7 @@ const (
 	apiVMInstancesUnpause                   = "virtualmachineinstances/unpause"
 	apiVMInstancesAddVolume                 = "virtualmachineinstances/addvolume"
 	apiVMInstancesRemoveVolume              = "virtualmachineinstances/removevolume"
+	apiVMInstancesChangeMedia               = "virtualmachineinstances/changemedia"
 	apiVMInstancesFreeze                    = "virtualmachineinstances/freeze"
 	apiVMInstancesUnfreeze                  = "virtualmachineinstances/unfreeze"
 	apiVMInstancesSoftReboot                = "virtualmachineinstances/softreboot"
@@ -84,6 +87,7 @@ const (
 	apiVMInstancesGuestOSInfo               = "virtualmachineinstances/guestosinfo"
 	apiVMInstancesFileSysList               = "virtualmachineinstances/filesystemlist"
 	apiVMInstancesUserList                  = "virtualmachineinstances/userlist"
+	apiVMInstancesStats                     = "virtualmachineinstances/stats"
 	apiVMInstancesSEVFetchCertChain         = "virtualmachineinstances/sev/fetchcertchain"
 	apiVMInstancesSEVQueryLaunchMeasurement = "virtualmachineinstances/sev/querylaunchmeasurement"
 	apiVMInstancesSEVSetupSession           = "virtualmachineinstances/sev/setupsession"
@@ -206,6 +210,7 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesStats,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMInstancesUSBRedir,
@@ -225,6 +230,7 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesUnpause,
 					apiVMInstancesAddVolume,
 					apiVMInstancesRemoveVolume,
+					apiVMInstancesChangeMedia,
 					apiVMInstancesFreeze,
 					apiVMInstancesUnfreeze,
 					apiVMInstancesSoftReboot,
@@ -261,6 +267,7 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMRemoveVolume,
 					apiVMMemoryDump,
 					apiVMEvacuateCancel,
+					apiVMRevert,
 				},
 				Verbs: []string{
 					"update",
@@ -384,6 +391,18 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					overcommit.GroupName,
+				},
+				Resources: []string{
+					overcommit.ResourceOvercommitProfiles,
+					overcommit.ResourceVirtualizationQuotas,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 		},
 	}
 }
@@ -414,6 +433,7 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesStats,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMInstancesUSBRedir,
@@ -433,6 +453,7 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesUnpause,
 					apiVMInstancesAddVolume,
 					apiVMInstancesRemoveVolume,
+					apiVMInstancesChangeMedia,
 					apiVMInstancesFreeze,
 					apiVMInstancesUnfreeze,
 					apiVMInstancesSoftReboot,
@@ -469,6 +490,7 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMRemoveVolume,
 					apiVMMemoryDump,
 					apiVMEvacuateCancel,
+					apiVMRevert,
 				},
 				Verbs: []string{
 					"update",
@@ -603,6 +625,18 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					overcommit.GroupName,
+				},
+				Resources: []string{
+					overcommit.ResourceOvercommitProfiles,
+					overcommit.ResourceVirtualizationQuotas,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 		},
 	}
 }
@@ -680,6 +714,7 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesStats,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMObjectGraph,
@@ -797,6 +832,18 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					overcommit.GroupName,
+				},
+				Resources: []string{
+					overcommit.ResourceOvercommitProfiles,
+					overcommit.ResourceVirtualizationQuotas,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 		},
 	}
 }
@@ -33,6 +33,7 @@ import (
 
 	virtv1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/migrations"
+	"kubevirt.io/api/overcommit"
 )
 
 func GetAllController(namespace string) []runtime.Object {
@@ -261,6 +262,17 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"patch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"pods/resize",
+				},
+				Verbs: []string{
+					"update",
+				},
+			},
 			{
 				APIGroups: []string{
 					"",
@@ -530,6 +542,28 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					overcommit.GroupName,
+				},
+				Resources: []string{
+					overcommit.ResourceVirtualizationQuotas,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					overcommit.GroupName,
+				},
+				Resources: []string{
+					overcommit.ResourceVirtualizationQuotas + "/status",
+				},
+				Verbs: []string{
+					"update",
+				},
+			},
 			{
 				APIGroups: []string{
 					clone.GroupName,
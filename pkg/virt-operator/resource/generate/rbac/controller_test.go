@@ -58,5 +58,17 @@ var _ = Describe("RBAC", func() {
 			Entry("for vms", "kubevirt.io", "virtualmachines"),
 			Entry("for vmis", "kubevirt.io", "virtualmachineinstances"),
 		)
+
+		It("can update the status of virtualization quotas", func() {
+			clusterRole := getObject(forController, reflect.TypeOf(&rbacv1.ClusterRole{}), components.ControllerServiceAccountName).(*rbacv1.ClusterRole)
+			Expect(clusterRole).ToNot(BeNil())
+			Expect(clusterRole.Rules).To(
+				ContainElement(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+					"APIGroups": ContainElement("overcommit.kubevirt.io"),
+					"Resources": ContainElement("virtualizationquotas/status"),
+					"Verbs":     ContainElement("update"),
+				})),
+			)
+		})
 	})
 })
@@ -85,6 +85,9 @@ const (
 	// lookup key in AdditionalProperties
 	AdditionalPropertiesPersistentReservationEnabled = "PersistentReservationEnabled"
 
+	// lookup key in AdditionalProperties
+	AdditionalPropertiesPrHelperSocketPath = "PrHelperSocketPath"
+
 	// lookup key in AdditionalProperties
 	AdditionalPropertiesSynchronizationPort       = "SynchronizationPort"
 	DefaultSynchronizationPort              int32 = 9185
@@ -195,6 +198,9 @@ func GetTargetConfigFromKVWithEnvVarManager(kv *v1.KubeVirt, envVarManager EnvVa
 			}
 		}
 	}
+	if kv.Spec.Configuration.PersistentReservation != nil && kv.Spec.Configuration.PersistentReservation.SocketPath != "" {
+		additionalProperties[AdditionalPropertiesPrHelperSocketPath] = kv.Spec.Configuration.PersistentReservation.SocketPath
+	}
 	// don't use status.target* here, as that is always set, but we need to know if it was set by the spec and with that
 	// overriding shasums from env vars
 	return getConfig(kv.Spec.ImageRegistry,
@@ -537,6 +543,11 @@ func (c *KubeVirtDeploymentConfig) PersistentReservationEnabled() bool {
 	return enabled
 }
 
+// GetPrHelperSocketPath returns the pr-helper socket path set in the KubeVirt CR, empty when the default one is used
+func (c *KubeVirtDeploymentConfig) GetPrHelperSocketPath() string {
+	return c.AdditionalProperties[AdditionalPropertiesPrHelperSocketPath]
+}
+
 func (c *KubeVirtDeploymentConfig) GetMigrationNetwork() *string {
 	value, enabled := c.AdditionalProperties[AdditionalPropertiesMigrationNetwork]
 	if enabled {
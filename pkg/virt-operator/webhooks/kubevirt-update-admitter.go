@@ -23,7 +23,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"net"
+	"net/url"
+	"path/filepath"
+	"slices"
 	"strconv"
+	"strings"
 
 	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
@@ -43,6 +49,8 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/network/domainspec"
+	"kubevirt.io/kubevirt/pkg/network/ipam"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
@@ -111,6 +119,34 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ctx context.Context, ar *admission
 		results = append(results, validateInfraReplicas(newKV.Spec.Infra.Replicas)...)
 	}
 
+	if networkConfig := newKV.Spec.Configuration.NetworkConfiguration; networkConfig != nil {
+		results = append(results,
+			validateMasqueradeIPAM(field.NewPath("spec", "configuration", "network", "masqueradeIPAM"), networkConfig.MasqueradeIPAM)...)
+		results = append(results,
+			validateNetworkBindings(field.NewPath("spec", "configuration", "network", "binding"), networkConfig.Binding)...)
+	}
+
+	results = append(results,
+		validateGuestTime(field.NewPath("spec", "configuration", "guestTime"), newKV.Spec.Configuration.GuestTime)...)
+
+	results = append(results,
+		validateMachineTypeAliases(field.NewPath("spec", "configuration", "architectureConfiguration"), newKV.Spec.Configuration.ArchitectureConfiguration)...)
+
+	results = append(results,
+		validatePersistentReservation(field.NewPath("spec", "configuration", "persistentReservation"), newKV.Spec.Configuration.PersistentReservation)...)
+
+	results = append(results,
+		validateHostBlockDevices(field.NewPath("spec", "configuration", "hostBlockDevices"), newKV.Spec.Configuration.HostBlockDevices)...)
+
+	results = append(results,
+		validateGuestCrashNotifications(field.NewPath("spec", "configuration", "guestCrashNotifications"), newKV.Spec.Configuration.GuestCrashNotifications)...)
+
+	results = append(results,
+		validateSocketDirectories(field.NewPath("spec", "configuration", "vhostUserBlkSockets"), newKV.Spec.Configuration.VhostUserBlkSockets)...)
+
+	results = append(results,
+		validateSocketDirectories(field.NewPath("spec", "configuration", "externalTPMSockets"), newKV.Spec.Configuration.ExternalTPMSockets)...)
+
 	response := validating_webhooks.NewAdmissionResponse(results)
 
 	if featureGatesChanged(&currKV.Spec, &newKV.Spec) {
@@ -469,6 +505,197 @@ func warnDeprecatedArchitectures(archConfiguration *v1.ArchConfiguration) []stri
 	return nil
 }
 
+func validateMasqueradeIPAM(field *field.Path, config *v1.MasqueradeIPAMConfiguration) []metav1.StatusCause {
+	if config == nil {
+		return nil
+	}
+
+	allocator, err := ipam.LookupPlugin(config.Plugin)
+	if err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: err.Error(),
+			Field:   field.Child("plugin").String(),
+		}}
+	}
+
+	if err := allocator.Validate(config); err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("invalid masquerade IPAM configuration: %v", err),
+			Field:   field.String(),
+		}}
+	}
+
+	return nil
+}
+
+func validateNetworkBindings(field *field.Path, bindings map[string]v1.InterfaceBindingPlugin) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for _, name := range slices.Sorted(maps.Keys(bindings)) {
+		binding := bindings[name]
+		if binding.DomainAttachmentType == "" {
+			continue
+		}
+		if !domainspec.IsSupportedDomainAttachmentType(binding.DomainAttachmentType) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("domain attachment type %q is not supported", binding.DomainAttachmentType),
+				Field:   field.Key(name).Child("domainAttachmentType").String(),
+			})
+			continue
+		}
+		if binding.DomainAttachmentType == v1.NoAttachment && binding.SidecarImage == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("a sidecar image is required to define the domain interface with the %q domain attachment type", v1.NoAttachment),
+				Field:   field.Key(name).Child("sidecarImage").String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateGuestTime(field *field.Path, config *v1.GuestTimeConfiguration) []metav1.StatusCause {
+	if config == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	for idx, server := range config.NTPServers {
+		// The NTP servers are offered through DHCP, which only supports IPv4 addresses
+		if ip := net.ParseIP(server); ip == nil || ip.To4() == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("NTP server %q is not a valid IPv4 address", server),
+				Field:   field.Child("ntpServers").Index(idx).String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validatePersistentReservation(field *field.Path, config *v1.PersistentReservationConfiguration) []metav1.StatusCause {
+	if config == nil || config.SocketPath == "" {
+		return nil
+	}
+
+	// The socket directory is mounted at the same path in the pr-helper and virt-launcher containers
+	if !filepath.IsAbs(config.SocketPath) || filepath.Clean(config.SocketPath) != config.SocketPath || filepath.Dir(config.SocketPath) == "/" {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("pr-helper socket path %q must be a clean absolute path outside of the root directory", config.SocketPath),
+			Field:   field.Child("socketPath").String(),
+		}}
+	}
+	return nil
+}
+
+func validateHostBlockDevices(field *field.Path, config *v1.HostBlockDevicesConfiguration) []metav1.StatusCause {
+	if config == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	for idx, path := range config.PermittedPaths {
+		// Permitting the whole /dev directory or a path outside of it would expose arbitrary host files
+		if !filepath.IsAbs(path) || filepath.Clean(path) != path || !strings.HasPrefix(path, "/dev/") {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("host block device path %q must be a clean absolute path below /dev", path),
+				Field:   field.Child("permittedPaths").Index(idx).String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateGuestCrashNotifications(field *field.Path, config *v1.GuestCrashNotificationsConfiguration) []metav1.StatusCause {
+	if config == nil {
+		return nil
+	}
+
+	sinkURL, err := url.Parse(config.URL)
+	if err != nil || (sinkURL.Scheme != "http" && sinkURL.Scheme != "https") || sinkURL.Host == "" {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("guest crash notifications URL %q must be an absolute http or https URL", config.URL),
+			Field:   field.Child("url").String(),
+		}}
+	}
+	return nil
+}
+
+func validateSocketDirectories(field *field.Path, config *v1.HostSocketDirectoriesConfiguration) []metav1.StatusCause {
+	if config == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	for idx, dir := range config.PermittedDirectories {
+		// The directories are mounted into virt-launcher pods, permitting the root directory would expose the whole node
+		if !filepath.IsAbs(dir) || filepath.Clean(dir) != dir || dir == string(filepath.Separator) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("socket directory %q must be a clean absolute path outside of the root directory", dir),
+				Field:   field.Child("permittedDirectories").Index(idx).String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateMachineTypeAliases(field *field.Path, archConfiguration *v1.ArchConfiguration) []metav1.StatusCause {
+	if archConfiguration == nil {
+		return nil
+	}
+
+	archSpecificConfigurations := []struct {
+		arch          string
+		configuration *v1.ArchSpecificConfiguration
+	}{
+		{"amd64", archConfiguration.Amd64},
+		{"arm64", archConfiguration.Arm64},
+		{"s390x", archConfiguration.S390x},
+	}
+
+	var causes []metav1.StatusCause
+	for _, archSpecificConfiguration := range archSpecificConfigurations {
+		if archSpecificConfiguration.configuration == nil {
+			continue
+		}
+		aliases := archSpecificConfiguration.configuration.MachineTypeAliases
+		for _, alias := range slices.Sorted(maps.Keys(aliases)) {
+			aliasField := field.Child(archSpecificConfiguration.arch, "machineTypeAliases").Key(alias)
+			machineType := aliases[alias]
+			switch {
+			case alias == "":
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "a machine type alias must not be empty",
+					Field:   aliasField.String(),
+				})
+			case machineType == "":
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueRequired,
+					Message: fmt.Sprintf("machine type alias %q must resolve to a machine type", alias),
+					Field:   aliasField.String(),
+				})
+			default:
+				// Aliases are resolved once, an alias resolving to another alias would never reach a machine type
+				if _, isAlias := aliases[machineType]; isAlias {
+					causes = append(causes, metav1.StatusCause{
+						Type:    metav1.CauseTypeFieldValueInvalid,
+						Message: fmt.Sprintf("machine type alias %q must not resolve to the machine type alias %q", alias, machineType),
+						Field:   aliasField.String(),
+					})
+				}
+			}
+		}
+	}
+	return causes
+}
+
 func validateGuestToRequestHeadroom(ratioStrPtr *string) (causes []metav1.StatusCause) {
 	if ratioStrPtr == nil {
 		return
@@ -34,6 +34,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/network/ipam"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
@@ -164,6 +165,187 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		)
 	})
 
+	Context("with MasqueradeIPAM", func() {
+		ipamField := test.Child("masqueradeIPAM")
+
+		DescribeTable("should reject", func(config *v1.MasqueradeIPAMConfiguration, expectedField string) {
+			causes := validateMasqueradeIPAM(ipamField, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("an unknown plugin", &v1.MasqueradeIPAMConfiguration{Plugin: "unknown"}, ipamField.Child("plugin").String()),
+			Entry("a pool plugin without pool", &v1.MasqueradeIPAMConfiguration{Plugin: ipam.PoolPlugin}, ipamField.String()),
+			Entry("a pool smaller than a guest subnet",
+				&v1.MasqueradeIPAMConfiguration{Plugin: ipam.PoolPlugin, IPv4Pool: "10.10.0.0/25"}, ipamField.String(),
+			),
+		)
+
+		It("should accept a pool plugin with a valid pool", func() {
+			config := &v1.MasqueradeIPAMConfiguration{Plugin: ipam.PoolPlugin, IPv4Pool: "10.10.0.0/16", ExcludedCIDRs: []string{"10.10.0.0/24"}}
+			Expect(validateMasqueradeIPAM(ipamField, config)).To(BeEmpty())
+		})
+	})
+
+	Context("with network bindings", func() {
+		bindingField := test.Child("network", "binding")
+
+		It("should accept the supported domain attachment types", func() {
+			bindings := map[string]v1.InterfaceBindingPlugin{
+				"tap":        {DomainAttachmentType: v1.Tap},
+				"managedtap": {DomainAttachmentType: v1.ManagedTap},
+				"ovs":        {DomainAttachmentType: v1.OVS},
+				"sidecar":    {SidecarImage: "sidecar:latest", DomainAttachmentType: v1.NoAttachment},
+				"passt":      {SidecarImage: "passt:latest"},
+			}
+			Expect(validateNetworkBindings(bindingField, bindings)).To(BeEmpty())
+		})
+
+		DescribeTable("should reject", func(binding v1.InterfaceBindingPlugin, expectedField string) {
+			causes := validateNetworkBindings(bindingField, map[string]v1.InterfaceBindingPlugin{"plugin": binding})
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("an unknown domain attachment type",
+				v1.InterfaceBindingPlugin{DomainAttachmentType: "macvtap"},
+				bindingField.Key("plugin").Child("domainAttachmentType").String(),
+			),
+			Entry("a none domain attachment type without sidecar",
+				v1.InterfaceBindingPlugin{DomainAttachmentType: v1.NoAttachment},
+				bindingField.Key("plugin").Child("sidecarImage").String(),
+			),
+		)
+	})
+
+	Context("with GuestTime", func() {
+		guestTimeField := test.Child("guestTime")
+
+		It("should accept IPv4 NTP servers", func() {
+			config := &v1.GuestTimeConfiguration{NTPServers: []string{"10.0.0.1", "192.168.0.1"}}
+			Expect(validateGuestTime(guestTimeField, config)).To(BeEmpty())
+		})
+
+		DescribeTable("should reject", func(server string) {
+			causes := validateGuestTime(guestTimeField, &v1.GuestTimeConfiguration{NTPServers: []string{"10.0.0.1", server}})
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(guestTimeField.Child("ntpServers").Index(1).String()))
+		},
+			Entry("an IPv6 NTP server", "fd10::1"),
+			Entry("an NTP server hostname", "pool.ntp.org"),
+		)
+	})
+
+	Context("with PersistentReservation", func() {
+		persistentReservationField := test.Child("persistentReservation")
+
+		It("should accept an absolute socket path", func() {
+			config := &v1.PersistentReservationConfiguration{SocketPath: "/run/pr/multipath-pr.sock"}
+			Expect(validatePersistentReservation(persistentReservationField, config)).To(BeEmpty())
+		})
+
+		DescribeTable("should reject", func(socketPath string) {
+			causes := validatePersistentReservation(persistentReservationField, &v1.PersistentReservationConfiguration{SocketPath: socketPath})
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(persistentReservationField.Child("socketPath").String()))
+		},
+			Entry("a relative socket path", "pr/pr-helper.sock"),
+			Entry("a socket path which is not clean", "/run/../pr/pr-helper.sock"),
+			Entry("a socket in the root directory", "/pr-helper.sock"),
+		)
+	})
+
+	Context("with HostBlockDevices", func() {
+		hostBlockDevicesField := test.Child("hostBlockDevices")
+
+		It("should accept device paths below /dev", func() {
+			config := &v1.HostBlockDevicesConfiguration{PermittedPaths: []string{"/dev/nvme1n1", "/dev/disk/by-id/nvme-eui.0025388b91b2d3a1"}}
+			Expect(validateHostBlockDevices(hostBlockDevicesField, config)).To(BeEmpty())
+		})
+
+		DescribeTable("should reject", func(path string) {
+			causes := validateHostBlockDevices(hostBlockDevicesField, &v1.HostBlockDevicesConfiguration{PermittedPaths: []string{"/dev/nvme1n1", path}})
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(hostBlockDevicesField.Child("permittedPaths").Index(1).String()))
+		},
+			Entry("a relative path", "dev/nvme2n1"),
+			Entry("a path which is not clean", "/dev/../etc/shadow"),
+			Entry("a path outside of /dev", "/var/lib/disk.img"),
+			Entry("the /dev directory", "/dev/"),
+		)
+	})
+
+	Context("with socket directories", func() {
+		socketsField := test.Child("vhostUserBlkSockets")
+
+		It("should accept clean absolute directories", func() {
+			config := &v1.HostSocketDirectoriesConfiguration{PermittedDirectories: []string{"/var/tmp/spdk", "/run/vhost"}}
+			Expect(validateSocketDirectories(socketsField, config)).To(BeEmpty())
+		})
+
+		DescribeTable("should reject", func(dir string) {
+			causes := validateSocketDirectories(socketsField, &v1.HostSocketDirectoriesConfiguration{PermittedDirectories: []string{"/var/tmp/spdk", dir}})
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(socketsField.Child("permittedDirectories").Index(1).String()))
+		},
+			Entry("a relative directory", "var/tmp/spdk"),
+			Entry("a directory which is not clean", "/var/tmp/spdk/../../etc"),
+			Entry("a directory with a trailing slash", "/var/tmp/"),
+			Entry("the root directory", "/"),
+		)
+	})
+
+	Context("with GuestCrashNotifications", func() {
+		guestCrashNotificationsField := test.Child("guestCrashNotifications")
+
+		It("should accept an https URL", func() {
+			config := &v1.GuestCrashNotificationsConfiguration{URL: "https://incidents.example.com/hooks/kubevirt"}
+			Expect(validateGuestCrashNotifications(guestCrashNotificationsField, config)).To(BeEmpty())
+		})
+
+		DescribeTable("should reject", func(sinkURL string) {
+			causes := validateGuestCrashNotifications(guestCrashNotificationsField, &v1.GuestCrashNotificationsConfiguration{URL: sinkURL})
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(guestCrashNotificationsField.Child("url").String()))
+		},
+			Entry("a relative URL", "/hooks/kubevirt"),
+			Entry("an URL without host", "https://"),
+			Entry("an URL with another scheme", "ftp://incidents.example.com"),
+		)
+	})
+
+	Context("with machine type aliases", func() {
+		archConfigurationField := test.Child("architectureConfiguration")
+
+		It("should accept aliases resolving to machine types", func() {
+			archConfiguration := &v1.ArchConfiguration{
+				Amd64: &v1.ArchSpecificConfiguration{MachineTypeAliases: map[string]string{"stable": "pc-q35-8.2", "next": "pc-q35-9.0"}},
+				Arm64: &v1.ArchSpecificConfiguration{MachineTypeAliases: map[string]string{"stable": "virt-8.2"}},
+			}
+			Expect(validateMachineTypeAliases(archConfigurationField, archConfiguration)).To(BeEmpty())
+		})
+
+		DescribeTable("should reject", func(aliases map[string]string, expectedField string) {
+			archConfiguration := &v1.ArchConfiguration{
+				Amd64: &v1.ArchSpecificConfiguration{MachineTypeAliases: aliases},
+			}
+			causes := validateMachineTypeAliases(archConfigurationField, archConfiguration)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("an empty alias",
+				map[string]string{"": "pc-q35-8.2"},
+				archConfigurationField.Child("amd64", "machineTypeAliases").Key("").String(),
+			),
+			Entry("an alias without machine type",
+				map[string]string{"stable": ""},
+				archConfigurationField.Child("amd64", "machineTypeAliases").Key("stable").String(),
+			),
+			Entry("an alias resolving to another alias",
+				map[string]string{"stable": "next", "next": "pc-q35-9.0"},
+				archConfigurationField.Child("amd64", "machineTypeAliases").Key("stable").String(),
+			),
+		)
+	})
+
 	Context("deprecations", func() {
 		var admitter *KubeVirtUpdateAdmitter
 
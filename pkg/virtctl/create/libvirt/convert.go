@@ -0,0 +1,610 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package libvirt
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"libvirt.org/go/libvirtxml"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	stateOn  = "on"
+	stateOff = "off"
+	yes      = "yes"
+)
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// converter accumulates the VirtualMachine spec built from a libvirt domain, the list
+// of domain elements which could not be converted and the follow-up steps the converted
+// VirtualMachine requires.
+type converter struct {
+	vm          *v1.VirtualMachine
+	unsupported []string
+	notices     []string
+}
+
+// flag records a domain element which is lost or altered by the conversion.
+func (c *converter) flag(format string, args ...interface{}) {
+	c.unsupported = append(c.unsupported, fmt.Sprintf(format, args...))
+}
+
+// notice records a step required before the VirtualMachine can run, the conversion itself is lossless.
+func (c *converter) notice(format string, args ...interface{}) {
+	c.notices = append(c.notices, fmt.Sprintf(format, args...))
+}
+
+func (c *converter) spec() *v1.VirtualMachineInstanceSpec {
+	return &c.vm.Spec.Template.Spec
+}
+
+// ConvertDomain produces the closest VirtualMachine to the given libvirt domain.
+// It returns the VirtualMachine together with a description of every domain element
+// which could not be converted and of the resources the VirtualMachine expects to exist.
+func ConvertDomain(domain *libvirtxml.Domain, name string) (*v1.VirtualMachine, []string, []string, error) {
+	if domain.Type != "" && domain.Type != "kvm" && domain.Type != "qemu" {
+		return nil, nil, nil, fmt.Errorf("domain type %s is not supported, only kvm and qemu domains can be converted", domain.Type)
+	}
+
+	if name == "" {
+		name = strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(domain.Name), "-"), "-")
+	}
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return nil, nil, nil, fmt.Errorf("cannot derive a valid VM name from domain %q: %s", domain.Name, strings.Join(errs, ", "))
+	}
+
+	c := &converter{
+		vm: &v1.VirtualMachine{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       v1.VirtualMachineGroupVersionKind.Kind,
+				APIVersion: v1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: v1.VirtualMachineSpec{
+				RunStrategy: pointer.P(v1.RunStrategyAlways),
+				Template:    &v1.VirtualMachineInstanceTemplateSpec{},
+			},
+		},
+	}
+
+	if err := c.convertMemory(domain); err != nil {
+		return nil, nil, nil, err
+	}
+	c.convertCPU(domain)
+	c.convertOS(domain)
+	if domain.UUID != "" {
+		if c.spec().Domain.Firmware == nil {
+			c.spec().Domain.Firmware = &v1.Firmware{}
+		}
+		c.spec().Domain.Firmware.UUID = types.UID(domain.UUID)
+	}
+	c.convertFeatures(domain.Features)
+	c.convertClock(domain.Clock)
+	if domain.Devices != nil {
+		c.convertDisks(domain.Devices.Disks)
+		c.convertInterfaces(domain.Devices.Interfaces)
+		c.convertDevices(domain.Devices)
+	}
+	c.flagUnsupported(domain)
+
+	return c.vm, c.unsupported, c.notices, nil
+}
+
+func (c *converter) convertMemory(domain *libvirtxml.Domain) error {
+	if domain.Memory == nil {
+		return fmt.Errorf("the domain does not define its memory")
+	}
+	guest, err := memoryToQuantity(domain.Memory.Value, domain.Memory.Unit)
+	if err != nil {
+		return err
+	}
+	c.spec().Domain.Memory = &v1.Memory{Guest: guest}
+
+	backing := domain.MemoryBacking
+	if backing == nil || backing.MemoryHugePages == nil {
+		return nil
+	}
+	pages := backing.MemoryHugePages.Hugepages
+	switch len(pages) {
+	case 0:
+		c.spec().Domain.Memory.Hugepages = &v1.Hugepages{PageSize: "2Mi"}
+	case 1:
+		pageSize, err := memoryToQuantity(pages[0].Size, pages[0].Unit)
+		if err != nil {
+			return err
+		}
+		c.spec().Domain.Memory.Hugepages = &v1.Hugepages{PageSize: pageSize.String()}
+	default:
+		c.flag("memoryBacking: only a single hugepage size is supported, %d were found", len(pages))
+	}
+	return nil
+}
+
+// memoryToQuantity converts a libvirt scaled integer, which defaults to KiB, into a quantity.
+func memoryToQuantity(value uint, unit string) (*resource.Quantity, error) {
+	var suffix string
+	switch unit {
+	case "b", "bytes":
+		suffix = ""
+	case "KB":
+		suffix = "k"
+	case "", "k", "KiB":
+		suffix = "Ki"
+	case "MB":
+		suffix = "M"
+	case "M", "MiB":
+		suffix = "Mi"
+	case "GB":
+		suffix = "G"
+	case "G", "GiB":
+		suffix = "Gi"
+	case "TB":
+		suffix = "T"
+	case "T", "TiB":
+		suffix = "Ti"
+	default:
+		return nil, fmt.Errorf("unknown memory unit %s", unit)
+	}
+	quantity, err := resource.ParseQuantity(fmt.Sprintf("%d%s", value, suffix))
+	if err != nil {
+		return nil, err
+	}
+	// Re-create the quantity from its value to get the most readable binary suffix
+	return resource.NewQuantity(quantity.Value(), resource.BinarySI), nil
+}
+
+func (c *converter) convertCPU(domain *libvirtxml.Domain) {
+	cpu := &v1.CPU{}
+	if domain.CPU != nil && domain.CPU.Topology != nil {
+		topology := domain.CPU.Topology
+		cpu.Sockets = uint32(topology.Sockets)
+		cpu.Cores = uint32(topology.Cores)
+		cpu.Threads = uint32(topology.Threads)
+		if topology.Dies > 1 || topology.Clusters > 1 {
+			c.flag("cpu: dies and clusters are not supported in the CPU topology")
+		}
+	} else if domain.VCPU != nil {
+		// Without an explicit topology libvirt exposes each vCPU as a socket
+		cpu.Sockets = uint32(domain.VCPU.Value)
+		cpu.Cores = 1
+		cpu.Threads = 1
+	}
+
+	if domain.CPU != nil {
+		switch domain.CPU.Mode {
+		case v1.CPUModeHostPassthrough:
+			cpu.Model = v1.CPUModeHostPassthrough
+		case v1.CPUModeHostModel:
+			cpu.Model = v1.CPUModeHostModel
+		case "", "custom":
+			if domain.CPU.Model != nil && domain.CPU.Model.Value != "" {
+				cpu.Model = domain.CPU.Model.Value
+			}
+		default:
+			c.flag("cpu: mode %s is not supported", domain.CPU.Mode)
+		}
+		for _, feature := range domain.CPU.Features {
+			cpu.Features = append(cpu.Features, v1.CPUFeature{Name: feature.Name, Policy: feature.Policy})
+		}
+		if domain.CPU.Numa != nil {
+			c.flag("cpu: guest NUMA cells are not converted, consider spec.domain.cpu.numa.guestMappingPassthrough")
+		}
+	}
+
+	if domain.CPUTune != nil || domain.NUMATune != nil {
+		c.flag("cputune/numatune: CPU and NUMA pinning is not converted, consider spec.domain.cpu.dedicatedCpuPlacement")
+	}
+
+	c.spec().Domain.CPU = cpu
+}
+
+func (c *converter) convertOS(domain *libvirtxml.Domain) {
+	osSpec := domain.OS
+	if osSpec == nil {
+		return
+	}
+
+	if osSpec.Type != nil && osSpec.Type.Machine != "" {
+		switch {
+		case strings.Contains(osSpec.Type.Machine, "q35"):
+			c.spec().Domain.Machine = &v1.Machine{Type: "q35"}
+		case strings.HasPrefix(osSpec.Type.Machine, "s390-ccw-virtio"), strings.HasPrefix(osSpec.Type.Machine, "virt"):
+			c.spec().Domain.Machine = &v1.Machine{Type: osSpec.Type.Machine}
+		default:
+			c.flag("os: machine type %s is not supported, the cluster default is used", osSpec.Type.Machine)
+		}
+	}
+
+	isEFI := osSpec.Firmware == "efi" || (osSpec.Loader != nil && osSpec.Loader.Type == "pflash")
+	if isEFI {
+		secureBoot := osSpec.Loader != nil && osSpec.Loader.Secure == yes
+		if osSpec.FirmwareInfo != nil {
+			for _, feature := range osSpec.FirmwareInfo.Features {
+				if feature.Name == "secure-boot" {
+					secureBoot = feature.Enabled == yes
+				}
+			}
+		}
+		c.spec().Domain.Firmware = &v1.Firmware{
+			Bootloader: &v1.Bootloader{EFI: &v1.EFI{SecureBoot: pointer.P(secureBoot)}},
+		}
+	}
+
+	if osSpec.Kernel != "" || osSpec.Initrd != "" {
+		c.flag("os: direct kernel boot from host paths is not converted, consider spec.domain.firmware.kernelBoot with a container")
+	}
+	if len(osSpec.BootDevices) > 0 {
+		c.flag("os: boot devices are not converted, boot order is taken from the disks and interfaces")
+	}
+}
+
+func featureEnabled(state *libvirtxml.DomainFeatureState) *v1.FeatureState {
+	if state == nil {
+		return nil
+	}
+	return &v1.FeatureState{Enabled: pointer.P(state.State != stateOff)}
+}
+
+func (c *converter) convertFeatures(features *libvirtxml.DomainFeatureList) {
+	if features == nil {
+		return
+	}
+
+	converted := &v1.Features{}
+	if features.ACPI == nil {
+		converted.ACPI = v1.FeatureState{Enabled: pointer.P(false)}
+	}
+	if features.APIC != nil {
+		converted.APIC = &v1.FeatureAPIC{EndOfInterrupt: features.APIC.EOI == stateOn}
+	}
+	if features.SMM != nil {
+		converted.SMM = &v1.FeatureState{Enabled: pointer.P(features.SMM.State != stateOff)}
+	}
+	if features.PVSpinlock != nil {
+		converted.Pvspinlock = featureEnabled(features.PVSpinlock)
+	}
+	if features.KVM != nil && features.KVM.Hidden != nil {
+		converted.KVM = &v1.FeatureKVM{Hidden: features.KVM.Hidden.State == stateOn}
+	}
+	if hyperv := features.HyperV; hyperv != nil {
+		if hyperv.Mode == "passthrough" {
+			converted.HypervPassthrough = &v1.HyperVPassthrough{Enabled: pointer.P(true)}
+		} else {
+			converted.Hyperv = &v1.FeatureHyperv{
+				Relaxed:         featureEnabled(hyperv.Relaxed),
+				VAPIC:           featureEnabled(hyperv.VAPIC),
+				VPIndex:         featureEnabled(hyperv.VPIndex),
+				Runtime:         featureEnabled(hyperv.Runtime),
+				SyNIC:           featureEnabled(hyperv.Synic),
+				Reset:           featureEnabled(hyperv.Reset),
+				Frequencies:     featureEnabled(hyperv.Frequencies),
+				Reenlightenment: featureEnabled(hyperv.ReEnlightenment),
+				IPI:             featureEnabled(hyperv.IPI),
+				EVMCS:           featureEnabled(hyperv.EVMCS),
+			}
+			if hyperv.Spinlocks != nil {
+				converted.Hyperv.Spinlocks = &v1.FeatureSpinlocks{
+					Enabled: pointer.P(hyperv.Spinlocks.State != stateOff),
+				}
+				if hyperv.Spinlocks.Retries != 0 {
+					converted.Hyperv.Spinlocks.Retries = pointer.P(uint32(hyperv.Spinlocks.Retries))
+				}
+			}
+			if hyperv.STimer != nil {
+				converted.Hyperv.SyNICTimer = &v1.SyNICTimer{Enabled: pointer.P(hyperv.STimer.State != stateOff)}
+			}
+			if hyperv.TLBFlush != nil {
+				converted.Hyperv.TLBFlush = &v1.FeatureState{Enabled: pointer.P(hyperv.TLBFlush.State != stateOff)}
+			}
+			if hyperv.VendorId != nil && hyperv.VendorId.Value != "" {
+				converted.Hyperv.VendorID = &v1.FeatureVendorID{
+					Enabled:  pointer.P(hyperv.VendorId.State != stateOff),
+					VendorID: hyperv.VendorId.Value,
+				}
+			}
+		}
+	}
+
+	c.spec().Domain.Features = converted
+}
+
+func (c *converter) convertClock(clock *libvirtxml.DomainClock) {
+	if clock == nil {
+		return
+	}
+
+	switch {
+	case clock.Offset == "utc":
+		c.spec().Domain.Clock = &v1.Clock{ClockOffset: v1.ClockOffset{UTC: &v1.ClockOffsetUTC{}}}
+	case clock.Offset == "timezone" && clock.TimeZone != "":
+		c.spec().Domain.Clock = &v1.Clock{ClockOffset: v1.ClockOffset{Timezone: pointer.P(v1.ClockOffsetTimezone(clock.TimeZone))}}
+	case clock.Offset != "":
+		c.flag("clock: offset %s is not supported, the guest clock is set to UTC", clock.Offset)
+	}
+}
+
+func diskBus(bus string) (v1.DiskBus, bool) {
+	switch bus {
+	case "virtio":
+		return v1.DiskBusVirtio, true
+	case "sata":
+		return v1.DiskBusSATA, true
+	case "scsi":
+		return v1.DiskBusSCSI, true
+	case "usb":
+		return v1.DiskBusUSB, true
+	case "nvme":
+		return v1.DiskBusNVMe, true
+	}
+	return "", false
+}
+
+func diskSourcePath(source *libvirtxml.DomainDiskSource) string {
+	switch {
+	case source == nil:
+		return ""
+	case source.File != nil:
+		return source.File.File
+	case source.Block != nil:
+		return source.Block.Dev
+	}
+	return ""
+}
+
+func (c *converter) convertDisks(disks []libvirtxml.DomainDisk) {
+	spec := c.spec()
+	for _, disk := range disks {
+		if disk.Target == nil || disk.Target.Dev == "" {
+			c.flag("disk: a disk without target device is skipped")
+			continue
+		}
+		name := disk.Target.Dev
+
+		bus, supported := diskBus(disk.Target.Bus)
+		if !supported {
+			bus = v1.DiskBusSATA
+			c.flag("disk %s: bus %s is not supported, %s is used instead", name, disk.Target.Bus, bus)
+		}
+
+		converted := v1.Disk{Name: name, Serial: disk.Serial}
+		switch disk.Device {
+		case "", "disk":
+			converted.Disk = &v1.DiskTarget{Bus: bus, ReadOnly: disk.ReadOnly != nil}
+		case "cdrom":
+			converted.CDRom = &v1.CDRomTarget{Bus: bus}
+		case "lun":
+			converted.LUN = &v1.LunTarget{Bus: bus, ReadOnly: disk.ReadOnly != nil}
+		default:
+			c.flag("disk %s: device %s is not supported", name, disk.Device)
+			continue
+		}
+		if disk.Boot != nil {
+			converted.BootOrder = pointer.P(disk.Boot.Order)
+		}
+		if disk.Driver != nil {
+			switch disk.Driver.Cache {
+			case "":
+			case string(v1.CacheNone), string(v1.CacheWriteThrough), string(v1.CacheWriteBack):
+				converted.Cache = v1.DriverCache(disk.Driver.Cache)
+			default:
+				c.flag("disk %s: cache mode %s is not supported", name, disk.Driver.Cache)
+			}
+		}
+
+		path := diskSourcePath(disk.Source)
+		if path == "" {
+			if disk.Source != nil && disk.Source.Network != nil {
+				c.flag("disk %s: network disks are not supported", name)
+			} else {
+				c.flag("disk %s: disks without a source are not supported", name)
+			}
+			continue
+		}
+
+		claimName := fmt.Sprintf("%s-%s", c.vm.Name, name)
+		spec.Domain.Devices.Disks = append(spec.Domain.Devices.Disks, converted)
+		spec.Volumes = append(spec.Volumes, v1.Volume{
+			Name: name,
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+						ClaimName: claimName,
+						ReadOnly:  disk.ReadOnly != nil,
+					},
+				},
+			},
+		})
+		c.notice("disk %s: the content of %s has to be imported into PersistentVolumeClaim %s", name, path, claimName)
+	}
+}
+
+func interfaceModel(model *libvirtxml.DomainInterfaceModel) string {
+	if model == nil {
+		return ""
+	}
+	return model.Type
+}
+
+func (c *converter) convertInterfaces(interfaces []libvirtxml.DomainInterface) {
+	spec := c.spec()
+	for i, iface := range interfaces {
+		var source string
+		switch {
+		case iface.Source == nil:
+		case iface.Source.Network != nil:
+			source = iface.Source.Network.Network
+		case iface.Source.Bridge != nil:
+			source = iface.Source.Bridge.Bridge
+		default:
+			c.flag("interface %d: only network and bridge interfaces are supported", i)
+			continue
+		}
+
+		converted := v1.Interface{Model: interfaceModel(iface.Model)}
+		if iface.MAC != nil {
+			converted.MacAddress = iface.MAC.Address
+		}
+		if iface.Boot != nil {
+			converted.BootOrder = pointer.P(iface.Boot.Order)
+		}
+
+		if len(spec.Networks) == 0 {
+			// The first interface is connected to the pod network
+			converted.Name = v1.DefaultPodNetwork().Name
+			converted.InterfaceBindingMethod = v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}
+			spec.Networks = append(spec.Networks, *v1.DefaultPodNetwork())
+		} else {
+			if source == "" {
+				c.flag("interface %d: an interface without source network or bridge is skipped", i)
+				continue
+			}
+			converted.Name = fmt.Sprintf("net%d", i)
+			converted.InterfaceBindingMethod = v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}
+			spec.Networks = append(spec.Networks, v1.Network{
+				Name: converted.Name,
+				NetworkSource: v1.NetworkSource{
+					Multus: &v1.MultusNetwork{NetworkName: source},
+				},
+			})
+			c.notice("interface %d: a NetworkAttachmentDefinition %s providing the connectivity of %s is required", i, source, source)
+		}
+		spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, converted)
+	}
+}
+
+func (c *converter) convertDevices(devices *libvirtxml.DomainDeviceList) {
+	converted := &c.spec().Domain.Devices
+
+	if len(devices.Graphics) == 0 {
+		converted.AutoattachGraphicsDevice = pointer.P(false)
+	}
+	if devices.MemBalloon != nil && devices.MemBalloon.Model == "none" {
+		converted.AutoattachMemBalloon = pointer.P(false)
+	}
+	if devices.VSock != nil {
+		converted.AutoattachVSOCK = pointer.P(true)
+	}
+	if len(devices.TPMs) > 0 {
+		converted.TPM = &v1.TPMDevice{}
+	}
+	for _, rng := range devices.RNGs {
+		if rng.Model == "virtio" {
+			converted.Rng = &v1.Rng{}
+		}
+	}
+
+	for i, input := range devices.Inputs {
+		if input.Type != string(v1.InputTypeTablet) {
+			// Keyboard and mouse are always provided by the machine type
+			continue
+		}
+		bus := v1.InputBusUSB
+		if input.Bus == string(v1.InputBusVirtio) {
+			bus = v1.InputBusVirtio
+		}
+		converted.Inputs = append(converted.Inputs, v1.Input{
+			Name: fmt.Sprintf("tablet%d", i),
+			Type: v1.InputTypeTablet,
+			Bus:  bus,
+		})
+	}
+
+	for i, watchdog := range devices.Watchdogs {
+		if watchdog.Model != "i6300esb" {
+			c.flag("watchdog: model %s is not supported", watchdog.Model)
+			continue
+		}
+		action := v1.WatchdogActionReset
+		switch watchdog.Action {
+		case "", "reset":
+		case "poweroff":
+			action = v1.WatchdogActionPoweroff
+		case "shutdown":
+			action = v1.WatchdogActionShutdown
+		default:
+			c.flag("watchdog: action %s is not supported, reset is used instead", watchdog.Action)
+		}
+		converted.Watchdog = &v1.Watchdog{
+			Name: fmt.Sprintf("watchdog%d", i),
+			WatchdogDevice: v1.WatchdogDevice{
+				I6300ESB: &v1.I6300ESBWatchdog{Action: action},
+			},
+		}
+	}
+}
+
+// flagUnsupported reports the domain elements which have no VirtualMachine counterpart.
+func (c *converter) flagUnsupported(domain *libvirtxml.Domain) {
+	if domain.QEMUCommandline != nil {
+		c.flag("qemu:commandline: %d arguments and %d environment variables are not supported, consider a hook sidecar",
+			len(domain.QEMUCommandline.Args), len(domain.QEMUCommandline.Envs))
+	}
+	if domain.QEMUCapabilities != nil {
+		c.flag("qemu:capabilities: overriding QEMU capabilities is not supported")
+	}
+	if domain.QEMUOverride != nil {
+		c.flag("qemu:override: overriding QEMU device properties is not supported")
+	}
+	if domain.LaunchSecurity != nil {
+		c.flag("launchSecurity: is not converted, consider spec.domain.launchSecurity")
+	}
+	if len(domain.SysInfo) > 0 {
+		c.flag("sysinfo: SMBIOS values are not converted")
+	}
+
+	devices := domain.Devices
+	if devices == nil {
+		return
+	}
+	unsupportedDevices := []struct {
+		name  string
+		count int
+	}{
+		{"hostdev", len(devices.Hostdevs)},
+		{"filesystem", len(devices.Filesystems)},
+		{"smartcard", len(devices.Smartcards)},
+		{"parallel", len(devices.Parallels)},
+		{"sound", len(devices.Sounds)},
+		{"redirdev", len(devices.RedirDevs)},
+		{"hub", len(devices.Hubs)},
+		{"shmem", len(devices.Shmems)},
+		{"memory", len(devices.Memorydevs)},
+		{"crypto", len(devices.Crypto)},
+	}
+	for _, device := range unsupportedDevices {
+		if device.count > 0 {
+			c.flag("%s: %d device(s) are not supported", device.name, device.count)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package libvirt
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"libvirt.org/go/libvirtxml"
+	"sigs.k8s.io/yaml"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+)
+
+const (
+	VMFromLibvirt = "vm-from-libvirt"
+
+	DomainXMLFlag = "domain-xml"
+	NameFlag      = "name"
+	StrictFlag    = "strict"
+)
+
+type createVMFromLibvirt struct {
+	domainXML string
+	name      string
+	namespace string
+	strict    bool
+}
+
+func NewCommand() *cobra.Command {
+	c := createVMFromLibvirt{}
+	cmd := &cobra.Command{
+		Use:   VMFromLibvirt,
+		Short: "Create a VirtualMachine manifest from a libvirt domain XML.",
+		Long: "Create a VirtualMachine manifest from a libvirt domain XML.\n\n" +
+			"The closest VirtualMachine spec is generated from the domain definition. Elements which cannot be\n" +
+			"converted, like qemu:commandline arguments or host devices, are reported on stderr.\n" +
+			"Disk images are not imported, each disk is backed by a PersistentVolumeClaim which has to be populated separately.",
+		Example: c.usage(),
+		Args:    cobra.NoArgs,
+		RunE:    c.run,
+	}
+
+	cmd.Flags().StringVar(&c.domainXML, DomainXMLFlag, "",
+		"Path to the libvirt domain XML, as obtained with 'virsh dumpxml'.")
+	cmd.Flags().StringVar(&c.name, NameFlag, "",
+		"Specify the name of the VM. If not specified, it is derived from the domain name.")
+	cmd.Flags().BoolVar(&c.strict, StrictFlag, false,
+		"Fail instead of only reporting domain elements which cannot be converted.")
+
+	if err := cmd.MarkFlagRequired(DomainXMLFlag); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+func (c *createVMFromLibvirt) usage() string {
+	return `  # Create a manifest for a VirtualMachine from a libvirt domain:
+  virsh dumpxml mydomain > mydomain.xml
+  {{ProgramName}} create vm-from-libvirt --domain-xml mydomain.xml
+
+  # Create a manifest for a VirtualMachine with a specified name from a libvirt domain:
+  {{ProgramName}} create vm-from-libvirt --domain-xml mydomain.xml --name my-vm
+
+  # Refuse to create the manifest if some elements of the domain cannot be converted:
+  {{ProgramName}} create vm-from-libvirt --domain-xml mydomain.xml --strict
+
+  # Create a manifest and use it to create a resource with kubectl
+  {{ProgramName}} create vm-from-libvirt --domain-xml mydomain.xml | kubectl create -f -`
+}
+
+func (c *createVMFromLibvirt) run(cmd *cobra.Command, _ []string) error {
+	_, namespace, overridden, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+	if overridden {
+		c.namespace = namespace
+	}
+
+	content, err := os.ReadFile(c.domainXML)
+	if err != nil {
+		return fmt.Errorf("failed to read the domain XML: %w", err)
+	}
+
+	domain := &libvirtxml.Domain{}
+	if err := domain.Unmarshal(string(content)); err != nil {
+		return fmt.Errorf("failed to parse the domain XML: %w", err)
+	}
+
+	vm, unsupported, notices, err := ConvertDomain(domain, c.name)
+	if err != nil {
+		return err
+	}
+	if c.namespace != "" {
+		vm.Namespace = c.namespace
+	}
+
+	if len(unsupported) > 0 {
+		if c.strict {
+			return fmt.Errorf("the domain cannot be fully converted:\n  %s", strings.Join(unsupported, "\n  "))
+		}
+		for _, msg := range unsupported {
+			cmd.PrintErrf("Warning: %s\n", msg)
+		}
+	}
+	for _, msg := range notices {
+		cmd.PrintErrf("Note: %s\n", msg)
+	}
+
+	out, err := yaml.Marshal(vm)
+	if err != nil {
+		return err
+	}
+
+	cmd.Print(string(out))
+	return nil
+}
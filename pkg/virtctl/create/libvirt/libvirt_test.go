@@ -0,0 +1,272 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package libvirt_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/create/libvirt"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+const create = "create"
+
+const domainXML = `<domain type='kvm'>
+  <name>My_Domain</name>
+  <uuid>4dea22b3-1d52-d8f3-2516-782e98ab3fa0</uuid>
+  <memory unit='GiB'>2</memory>
+  <vcpu>4</vcpu>
+  <os firmware='efi'>
+    <type arch='x86_64' machine='pc-q35-8.2'>hvm</type>
+    <loader secure='yes'/>
+  </os>
+  <features>
+    <acpi/>
+    <apic/>
+    <smm state='on'/>
+  </features>
+  <cpu mode='host-passthrough'>
+    <topology sockets='1' cores='2' threads='2'/>
+  </cpu>
+  <clock offset='utc'/>
+  <devices>
+    <disk type='file' device='disk'>
+      <driver name='qemu' type='qcow2' cache='none'/>
+      <source file='/var/lib/libvirt/images/root.qcow2'/>
+      <target dev='vda' bus='virtio'/>
+      <boot order='1'/>
+    </disk>
+    <disk type='file' device='cdrom'>
+      <source file='/var/lib/libvirt/images/install.iso'/>
+      <target dev='sda' bus='sata'/>
+      <readonly/>
+    </disk>
+    <interface type='network'>
+      <mac address='52:54:00:6b:3c:58'/>
+      <source network='default'/>
+      <model type='virtio'/>
+    </interface>
+    <interface type='bridge'>
+      <source bridge='br1'/>
+      <model type='e1000e'/>
+    </interface>
+    <input type='tablet' bus='usb'/>
+    <input type='keyboard' bus='ps2'/>
+    <watchdog model='i6300esb' action='poweroff'/>
+    <rng model='virtio'>
+      <backend model='random'>/dev/urandom</backend>
+    </rng>
+    <memballoon model='none'/>
+  </devices>
+</domain>`
+
+const qemuCommandlineXML = `<domain type='kvm' xmlns:qemu='http://libvirt.org/schemas/domain/qemu/1.0'>
+  <name>legacy</name>
+  <memory>1048576</memory>
+  <vcpu>2</vcpu>
+  <devices>
+    <graphics type='vnc'/>
+    <hostdev mode='subsystem' type='pci'/>
+  </devices>
+  <qemu:commandline>
+    <qemu:arg value='-device'/>
+    <qemu:arg value='ivshmem'/>
+  </qemu:commandline>
+</domain>`
+
+const convertibleXML = `<domain type='kvm'>
+  <name>convertible</name>
+  <memory unit='GiB'>1</memory>
+  <vcpu>1</vcpu>
+  <devices>
+    <disk type='file' device='disk'>
+      <source file='/var/lib/libvirt/images/root.qcow2'/>
+      <target dev='vda' bus='virtio'/>
+    </disk>
+    <interface type='network'>
+      <source network='default'/>
+    </interface>
+    <interface type='bridge'>
+      <source bridge='br1'/>
+    </interface>
+    <interface type='bridge'/>
+  </devices>
+</domain>`
+
+var _ = Describe("create vm-from-libvirt", func() {
+	writeDomainXML := func(content string) string {
+		path := filepath.Join(GinkgoT().TempDir(), "domain.xml")
+		Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+		return path
+	}
+
+	It("should require the domain XML", func() {
+		_, err := runCmd()
+		Expect(err).To(MatchError(ContainSubstring(libvirt.DomainXMLFlag)))
+	})
+
+	It("should fail with an invalid domain XML", func() {
+		_, err := runCmd(setFlag(libvirt.DomainXMLFlag, writeDomainXML("<domain")))
+		Expect(err).To(MatchError(ContainSubstring("failed to parse the domain XML")))
+	})
+
+	It("should refuse domains of other hypervisors", func() {
+		_, err := runCmd(setFlag(libvirt.DomainXMLFlag, writeDomainXML(`<domain type='xen'><name>xen</name></domain>`)))
+		Expect(err).To(MatchError("domain type xen is not supported, only kvm and qemu domains can be converted"))
+	})
+
+	It("should convert the domain into the closest VirtualMachine", func() {
+		vm, err := runCmd(setFlag(libvirt.DomainXMLFlag, writeDomainXML(domainXML)))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(vm.Name).To(Equal("my-domain"))
+		Expect(vm.Spec.RunStrategy).To(HaveValue(Equal(v1.RunStrategyAlways)))
+
+		spec := vm.Spec.Template.Spec
+		Expect(spec.Domain.Memory.Guest).To(HaveValue(Equal(resource.MustParse("2Gi"))))
+		Expect(spec.Domain.CPU.Model).To(Equal(v1.CPUModeHostPassthrough))
+		Expect(spec.Domain.CPU.Sockets).To(Equal(uint32(1)))
+		Expect(spec.Domain.CPU.Cores).To(Equal(uint32(2)))
+		Expect(spec.Domain.CPU.Threads).To(Equal(uint32(2)))
+		Expect(spec.Domain.Machine).To(Equal(&v1.Machine{Type: "q35"}))
+		Expect(spec.Domain.Firmware.UUID).To(BeEquivalentTo("4dea22b3-1d52-d8f3-2516-782e98ab3fa0"))
+		Expect(spec.Domain.Firmware.Bootloader.EFI.SecureBoot).To(HaveValue(BeTrue()))
+		Expect(spec.Domain.Features.SMM.Enabled).To(HaveValue(BeTrue()))
+		Expect(spec.Domain.Clock.UTC).ToNot(BeNil())
+
+		Expect(spec.Domain.Devices.Disks).To(ConsistOf(
+			v1.Disk{
+				Name:       "vda",
+				DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}},
+				BootOrder:  pointer.P(uint(1)),
+				Cache:      v1.CacheNone,
+			},
+			v1.Disk{
+				Name:       "sda",
+				DiskDevice: v1.DiskDevice{CDRom: &v1.CDRomTarget{Bus: v1.DiskBusSATA}},
+			},
+		))
+		Expect(spec.Volumes).To(HaveLen(2))
+		Expect(spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal("my-domain-vda"))
+		Expect(spec.Volumes[1].PersistentVolumeClaim.ClaimName).To(Equal("my-domain-sda"))
+		Expect(spec.Volumes[1].PersistentVolumeClaim.ReadOnly).To(BeTrue())
+
+		Expect(spec.Domain.Devices.Interfaces).To(HaveLen(2))
+		Expect(spec.Domain.Devices.Interfaces[0].Name).To(Equal("default"))
+		Expect(spec.Domain.Devices.Interfaces[0].Masquerade).ToNot(BeNil())
+		Expect(spec.Domain.Devices.Interfaces[0].MacAddress).To(Equal("52:54:00:6b:3c:58"))
+		Expect(spec.Domain.Devices.Interfaces[1].Bridge).ToNot(BeNil())
+		Expect(spec.Domain.Devices.Interfaces[1].Model).To(Equal("e1000e"))
+		Expect(spec.Networks).To(ConsistOf(
+			*v1.DefaultPodNetwork(),
+			v1.Network{Name: "net1", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "br1"}}},
+		))
+
+		Expect(spec.Domain.Devices.Inputs).To(ConsistOf(v1.Input{Name: "tablet0", Type: v1.InputTypeTablet, Bus: v1.InputBusUSB}))
+		Expect(spec.Domain.Devices.Watchdog.I6300ESB.Action).To(Equal(v1.WatchdogActionPoweroff))
+		Expect(spec.Domain.Devices.Rng).ToNot(BeNil())
+		Expect(spec.Domain.Devices.AutoattachMemBalloon).To(HaveValue(BeFalse()))
+		Expect(spec.Domain.Devices.AutoattachGraphicsDevice).To(HaveValue(BeFalse()))
+	})
+
+	It("should use the provided name", func() {
+		vm, err := runCmd(
+			setFlag(libvirt.DomainXMLFlag, writeDomainXML(domainXML)),
+			setFlag(libvirt.NameFlag, "my-vm"),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vm.Name).To(Equal("my-vm"))
+		Expect(vm.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal("my-vm-vda"))
+	})
+
+	It("should only warn about unsupported elements by default", func() {
+		vm, err := runCmd(setFlag(libvirt.DomainXMLFlag, writeDomainXML(qemuCommandlineXML)))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vm.Spec.Template.Spec.Domain.Memory.Guest).To(HaveValue(Equal(resource.MustParse("1Gi"))))
+		Expect(vm.Spec.Template.Spec.Domain.CPU.Sockets).To(Equal(uint32(2)))
+		Expect(vm.Spec.Template.Spec.Domain.Devices.AutoattachGraphicsDevice).To(BeNil())
+	})
+
+	It("should flag unsupported elements in strict mode", func() {
+		_, err := runCmd(
+			setFlag(libvirt.DomainXMLFlag, writeDomainXML(qemuCommandlineXML)),
+			fmt.Sprintf("--%s", libvirt.StrictFlag),
+		)
+		Expect(err).To(MatchError(And(
+			ContainSubstring("the domain cannot be fully converted"),
+			ContainSubstring("qemu:commandline: 2 arguments and 0 environment variables are not supported"),
+			ContainSubstring("hostdev: 1 device(s) are not supported"),
+		)))
+	})
+
+	It("should not fail in strict mode on the resources the VirtualMachine requires", func() {
+		vm, err := runCmd(
+			setFlag(libvirt.DomainXMLFlag, writeDomainXML(strings.Replace(convertibleXML, "<interface type='bridge'/>", "", 1))),
+			fmt.Sprintf("--%s", libvirt.StrictFlag),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vm.Spec.Template.Spec.Volumes).To(HaveLen(1))
+		Expect(vm.Spec.Template.Spec.Networks).To(HaveLen(2))
+	})
+
+	It("should skip secondary interfaces without source", func() {
+		vm, err := runCmd(setFlag(libvirt.DomainXMLFlag, writeDomainXML(convertibleXML)))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces).To(HaveLen(2))
+		Expect(vm.Spec.Template.Spec.Networks).To(ConsistOf(
+			*v1.DefaultPodNetwork(),
+			v1.Network{Name: "net1", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "br1"}}},
+		))
+
+		_, err = runCmd(
+			setFlag(libvirt.DomainXMLFlag, writeDomainXML(convertibleXML)),
+			fmt.Sprintf("--%s", libvirt.StrictFlag),
+		)
+		Expect(err).To(MatchError(ContainSubstring("interface 2: an interface without source network or bridge is skipped")))
+	})
+})
+
+func setFlag(flag, parameter string) string {
+	return fmt.Sprintf("--%s=%s", flag, parameter)
+}
+
+func runCmd(args ...string) (*v1.VirtualMachine, error) {
+	_args := append([]string{create, libvirt.VMFromLibvirt}, args...)
+	bytes, err := testing.NewRepeatableVirtctlCommandWithOut(_args...)()
+	if err != nil {
+		return nil, err
+	}
+
+	vm := &v1.VirtualMachine{}
+	Expect(yaml.Unmarshal(bytes, vm)).To(Succeed())
+	return vm, nil
+}
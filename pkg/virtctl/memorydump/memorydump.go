@@ -20,8 +20,11 @@
 package memorydump
 
 import (
+	"bufio"
+	"compress/gzip"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"time"
 
@@ -35,6 +38,7 @@ import (
 	"kubevirt.io/client-go/kubecli"
 
 	virtwait "kubevirt.io/kubevirt/pkg/apimachinery/wait"
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	kutil "kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
@@ -52,6 +56,9 @@ const (
 	LocalPortFlag    = "local-port"
 	OutputFileFlag   = "output"
 
+	EncryptionPublicKeyFlag  = "encryption-public-key"
+	DecryptionPrivateKeyFlag = "decryption-private-key"
+
 	configName         = "config"
 	filesystemOverhead = v1.Percent("0.055")
 	fsOverheadMsg      = "Using default 5.5%% filesystem overhead for pvc size"
@@ -69,6 +76,9 @@ var (
 	storageClass string
 	accessMode   string
 	outputFile   string
+
+	encryptionPublicKeyFile  string
+	decryptionPrivateKeyFile string
 )
 
 type command struct{}
@@ -86,6 +96,12 @@ func usageMemoryDump() string {
   #Create and download memory dump to the given output file.
   {{ProgramName}} memory-dump get myvm --claim-name=memoryvolume --create-claim --output=memoryDump.dump.gz
 
+  #Dump memory of a virtual machine instance called 'myvm' encrypted with the RSA public key in 'key.pub'.
+  {{ProgramName}} memory-dump get myvm --claim-name=memoryvolume --encryption-public-key=key.pub
+
+  #Decrypt the downloaded memory dump 'memoryDump.dump.gz' with the RSA private key in 'key.pem'.
+  {{ProgramName}} memory-dump decrypt memoryDump.dump.gz --decryption-private-key=key.pem --output=memoryDump.dump
+
   #Dump memory again to the same virtual machine with an already associated pvc(existing memory dump on vm status).
   {{ProgramName}} memory-dump get myvm
 
@@ -102,7 +118,7 @@ func usageMemoryDump() string {
 func NewMemoryDumpCommand() *cobra.Command {
 	c := command{}
 	cmd := &cobra.Command{
-		Use:     "memory-dump get/download/remove (VM)",
+		Use:     "memory-dump get/download/remove (VM) | decrypt (FILE)",
 		Short:   "Dump the memory of a running VM to a pvc",
 		Example: usageMemoryDump(),
 		Args:    cobra.ExactArgs(2),
@@ -117,11 +133,18 @@ func NewMemoryDumpCommand() *cobra.Command {
 	cmd.Flags().StringVar(&storageClass, StorageClassFlag, "", "The storage class for the PVC.")
 	cmd.Flags().StringVar(&accessMode, AccessModeFlag, "", "The access mode for the PVC.")
 	cmd.Flags().StringVar(&outputFile, OutputFileFlag, "", "Specifies the output path of the memory dump to be downloaded.")
+	cmd.Flags().StringVar(&encryptionPublicKeyFile, EncryptionPublicKeyFlag, "", "Path to a PEM encoded RSA public key the memory dump is encrypted with before being written to the pvc.")
+	cmd.Flags().StringVar(&decryptionPrivateKeyFile, DecryptionPrivateKeyFlag, "", "Path to the PEM encoded RSA private key an encrypted memory dump is decrypted with.")
 
 	return cmd
 }
 
 func (c *command) run(cmd *cobra.Command, args []string) error {
+	// Decrypting a downloaded memory dump does not involve the cluster
+	if args[0] == "decrypt" {
+		return decryptMemoryDump(args[1])
+	}
+
 	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
 	if err != nil {
 		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
@@ -266,6 +289,13 @@ func createMemoryDump(namespace, vmName, claimName string, virtClient kubecli.Ku
 	memoryDumpRequest := &v1.VirtualMachineMemoryDumpRequest{
 		ClaimName: claimName,
 	}
+	if encryptionPublicKeyFile != "" {
+		publicKey, err := os.ReadFile(encryptionPublicKeyFile)
+		if err != nil {
+			return fmt.Errorf("error reading the encryption public key, %v", err)
+		}
+		memoryDumpRequest.EncryptionPublicKey = string(publicKey)
+	}
 
 	err := virtClient.VirtualMachine(namespace).MemoryDump(context.Background(), vmName, memoryDumpRequest)
 	if err != nil {
@@ -349,6 +379,54 @@ func downloadMemoryDump(namespace, vmName string, virtClient kubecli.KubevirtCli
 	return vmexport.DownloadVirtualMachineExport(virtClient, vmExportInfo)
 }
 
+func decryptMemoryDump(inputFile string) error {
+	if decryptionPrivateKeyFile == "" {
+		return fmt.Errorf("missing decryption private key to decrypt the memory dump")
+	}
+	if outputFile == "" {
+		return fmt.Errorf("missing outputFile to write the decrypted memory dump")
+	}
+
+	privateKeyPEM, err := os.ReadFile(decryptionPrivateKeyFile)
+	if err != nil {
+		return fmt.Errorf("error reading the decryption private key, %v", err)
+	}
+	privateKey, err := memorydump.ParseDecryptionPrivateKey(string(privateKeyPEM))
+	if err != nil {
+		return err
+	}
+
+	input, err := os.Open(inputFile)
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	// The memory dump is gzipped unless it was downloaded in the raw format
+	bufferedInput := bufio.NewReader(input)
+	var reader io.Reader = bufferedInput
+	if magic, err := bufferedInput.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return err
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+
+	output, err := os.Create(outputFile)
+	if err != nil {
+		return err
+	}
+	defer output.Close()
+	if err := memorydump.DecryptMemoryDump(output, reader, privateKey); err != nil {
+		os.Remove(outputFile)
+		return fmt.Errorf("error decrypting the memory dump, %v", err)
+	}
+	fmt.Printf("Successfully decrypted the memory dump to %s\n", outputFile)
+	return nil
+}
+
 func WaitForMemoryDumpComplete(virtClient kubecli.KubevirtClient, namespace, vmName string, interval, timeout time.Duration) (string, error) {
 	var claimName string
 	err := virtwait.PollImmediately(interval, timeout, func(ctx context.Context) (bool, error) {
@@ -20,8 +20,13 @@
 package memorydump_test
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	cryptorand "crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
@@ -52,6 +57,7 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	storagememorydump "kubevirt.io/kubevirt/pkg/storage/memorydump"
 	"kubevirt.io/kubevirt/pkg/virtctl/memorydump"
 	"kubevirt.io/kubevirt/pkg/virtctl/testing"
 	"kubevirt.io/kubevirt/pkg/virtctl/vmexport"
@@ -166,6 +172,107 @@ var _ = Describe("MemoryDump", func() {
 		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "memorydump")).To(HaveLen(1))
 	})
 
+	It("should call memory dump subresource with the encryption public key", func() {
+		const publicKey = "-----BEGIN PUBLIC KEY-----"
+		publicKeyFile := filepath.Join(GinkgoT().TempDir(), "key.pub")
+		Expect(os.WriteFile(publicKeyFile, []byte(publicKey), 0600)).To(Succeed())
+
+		virtClient.PrependReactor("put", "virtualmachines/memorydump", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+			request := action.(kvtesting.PutAction[*v1.VirtualMachineMemoryDumpRequest]).GetOptions()
+			Expect(request.EncryptionPublicKey).To(Equal(publicKey))
+			return true, nil, nil
+		})
+		Expect(runGetCmd(
+			setFlag(memorydump.ClaimNameFlag, pvcName),
+			setFlag(memorydump.EncryptionPublicKeyFlag, publicKeyFile),
+		)).To(Succeed())
+		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "memorydump")).To(HaveLen(1))
+	})
+
+	It("should fail call memory dump subresource with a missing encryption public key file", func() {
+		err := runGetCmd(
+			setFlag(memorydump.ClaimNameFlag, pvcName),
+			setFlag(memorydump.EncryptionPublicKeyFlag, filepath.Join(GinkgoT().TempDir(), "missing.pub")),
+		)
+		Expect(err).To(MatchError(ContainSubstring("error reading the encryption public key")))
+		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "memorydump")).To(BeEmpty())
+	})
+
+	Context("decrypt", func() {
+		var (
+			dump           []byte
+			privateKeyFile string
+			outputPath     string
+		)
+
+		writeEncryptedDump := func(gzipped bool) string {
+			privateKey, err := rsa.GenerateKey(cryptorand.Reader, 2048)
+			Expect(err).ToNot(HaveOccurred())
+			privateKeyFile = filepath.Join(GinkgoT().TempDir(), "key.pem")
+			Expect(os.WriteFile(privateKeyFile, pem.EncodeToMemory(&pem.Block{
+				Type:  "RSA PRIVATE KEY",
+				Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+			}), 0600)).To(Succeed())
+
+			encrypted := &bytes.Buffer{}
+			Expect(storagememorydump.EncryptMemoryDump(encrypted, bytes.NewReader(dump), &privateKey.PublicKey)).To(Succeed())
+			content := encrypted.Bytes()
+			if gzipped {
+				compressed := &bytes.Buffer{}
+				gzipWriter := gzip.NewWriter(compressed)
+				_, err := gzipWriter.Write(content)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(gzipWriter.Close()).To(Succeed())
+				content = compressed.Bytes()
+			}
+			inputFile := filepath.Join(GinkgoT().TempDir(), "memory.dump")
+			Expect(os.WriteFile(inputFile, content, 0600)).To(Succeed())
+			return inputFile
+		}
+
+		BeforeEach(func() {
+			dump = make([]byte, 100*1024)
+			_, err := cryptorand.Read(dump)
+			Expect(err).ToNot(HaveOccurred())
+			outputPath = filepath.Join(GinkgoT().TempDir(), "decrypted.dump")
+		})
+
+		DescribeTable("should decrypt the memory dump", func(gzipped bool) {
+			inputFile := writeEncryptedDump(gzipped)
+			Expect(runCmd("decrypt", inputFile,
+				setFlag(memorydump.DecryptionPrivateKeyFlag, privateKeyFile),
+				setFlag(memorydump.OutputFileFlag, outputPath),
+			)).To(Succeed())
+			Expect(os.ReadFile(outputPath)).To(Equal(dump))
+		},
+			Entry("downloaded gzipped", true),
+			Entry("downloaded raw", false),
+		)
+
+		It("should fail without the decryption private key", func() {
+			inputFile := writeEncryptedDump(false)
+			err := runCmd("decrypt", inputFile, setFlag(memorydump.OutputFileFlag, outputPath))
+			Expect(err).To(MatchError(ContainSubstring("missing decryption private key")))
+		})
+
+		It("should fail without the output file", func() {
+			inputFile := writeEncryptedDump(false)
+			err := runCmd("decrypt", inputFile, setFlag(memorydump.DecryptionPrivateKeyFlag, privateKeyFile))
+			Expect(err).To(MatchError(ContainSubstring("missing outputFile")))
+		})
+
+		It("should fail and not leave a partial output with another private key", func() {
+			inputFile := writeEncryptedDump(false)
+			writeEncryptedDump(false)
+			err := runCmd("decrypt", inputFile,
+				setFlag(memorydump.DecryptionPrivateKeyFlag, privateKeyFile),
+				setFlag(memorydump.OutputFileFlag, outputPath),
+			)
+			Expect(err).To(MatchError(ContainSubstring("error decrypting the memory dump")))
+			Expect(outputPath).ToNot(BeAnExistingFile())
+		})
+	})
+
 	It("should call memory dump subresource without claim-name no create", func() {
 		expectVMEndpointMemoryDump("")
 		Expect(runGetCmd()).To(Succeed())
@@ -34,6 +34,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virtctl/softreboot"
 	"kubevirt.io/kubevirt/pkg/virtctl/ssh"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+	"kubevirt.io/kubevirt/pkg/virtctl/top"
 	"kubevirt.io/kubevirt/pkg/virtctl/unpause"
 	"kubevirt.io/kubevirt/pkg/virtctl/usbredir"
 	"kubevirt.io/kubevirt/pkg/virtctl/version"
@@ -119,6 +120,7 @@ func NewVirtctlCommandFn() *cobra.Command {
 		vm.NewFSListCommand(),
 		vm.NewAddVolumeCommand(),
 		vm.NewRemoveVolumeCommand(),
+		vm.NewChangeMediaCommand(),
 		vm.NewExpandCommand(),
 		vm.NewEvacuateCancelCommand(),
 		memorydump.NewMemoryDumpCommand(),
@@ -135,6 +137,7 @@ func NewVirtctlCommandFn() *cobra.Command {
 		credentials.NewCommand(),
 		adm.NewCommand(),
 		objectgraph.NewCommand(),
+		top.NewCommand(),
 		optionsCmd,
 	)
 
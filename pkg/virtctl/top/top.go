@@ -0,0 +1,245 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package top
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_TOP = "top"
+
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+type command struct {
+	outputFormat string
+	noHeaders    bool
+	watch        bool
+	interval     time.Duration
+}
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   COMMAND_TOP,
+		Short: "Display the resource usage of virtual machine instances.",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Println(cmd.UsageString())
+		},
+	}
+	cmd.AddCommand(newVMIsCommand())
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newVMIsCommand() *cobra.Command {
+	c := command{}
+	cmd := &cobra.Command{
+		Use:     "vmis [VMI]",
+		Aliases: []string{"vmi"},
+		Short:   "Display the CPU, memory, disk and network usage of virtual machine instances.",
+		Long: `Display the CPU, memory, disk and network usage of the running virtual machine instances of a namespace, or of a single one.
+The usage is sampled by virt-handler, no monitoring stack is needed.
+CPU% is relative to the vCPUs of the virtual machine instance, the guest memory is reported by the balloon driver.`,
+		Args:    cobra.MaximumNArgs(1),
+		Example: usage(),
+		RunE:    c.run,
+	}
+	cmd.Flags().StringVarP(&c.outputFormat, "output", "o", outputTable, "Output format. One of: table|json|yaml")
+	cmd.Flags().BoolVar(&c.noHeaders, "no-headers", false, "Do not print the headers of the table.")
+	cmd.Flags().BoolVarP(&c.watch, "watch", "w", false, "Keep displaying the usage until interrupted.")
+	cmd.Flags().DurationVar(&c.interval, "interval", 5*time.Second, "Interval between two displays of the usage with --watch.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	return `  # Display the usage of all running virtual machine instances of the namespace:
+  {{ProgramName}} top vmis
+
+  # Display the usage of the virtual machine instance 'myvmi', refreshing it every 10 seconds:
+  {{ProgramName}} top vmis myvmi --watch --interval 10s
+
+  # Display the usage per disk and network interface of 'myvmi':
+  {{ProgramName}} top vmis myvmi -o yaml`
+}
+
+// vmiStats is the usage of a VMI, as displayed with the json and yaml output formats
+type vmiStats struct {
+	Name  string                         `json:"name"`
+	Stats v1.VirtualMachineInstanceStats `json:"stats"`
+}
+
+func (c *command) run(cmd *cobra.Command, args []string) error {
+	switch c.outputFormat {
+	case outputTable, outputJSON, outputYAML:
+	default:
+		return fmt.Errorf("unsupported output format: %s (must be 'table', 'json' or 'yaml')", c.outputFormat)
+	}
+	if c.watch && c.interval <= 0 {
+		return fmt.Errorf("the interval must be positive")
+	}
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	for {
+		stats, err := c.getStats(cmd.Context(), virtClient, namespace, args)
+		if err != nil {
+			return err
+		}
+		if err := c.print(cmd.OutOrStdout(), stats); err != nil {
+			return err
+		}
+		if !c.watch {
+			return nil
+		}
+		select {
+		case <-cmd.Context().Done():
+			return nil
+		case <-time.After(c.interval):
+		}
+	}
+}
+
+func (c *command) getStats(ctx context.Context, virtClient kubecli.KubevirtClient, namespace string, args []string) ([]vmiStats, error) {
+	var names []string
+	if len(args) == 1 {
+		names = append(names, args[0])
+	} else {
+		vmis, err := virtClient.VirtualMachineInstance(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error listing VirtualMachineInstances: %v", err)
+		}
+		for _, vmi := range vmis.Items {
+			if vmi.Status.Phase == v1.Running {
+				names = append(names, vmi.Name)
+			}
+		}
+	}
+
+	var stats []vmiStats
+	for _, name := range names {
+		vmiUsage, err := virtClient.VirtualMachineInstance(namespace).Stats(ctx, name)
+		if err != nil {
+			if len(args) == 1 {
+				return nil, fmt.Errorf("error getting the usage of VirtualMachineInstance %s: %v", name, err)
+			}
+			// the VMI may have stopped since it was listed
+			continue
+		}
+		stats = append(stats, vmiStats{Name: name, Stats: vmiUsage})
+	}
+	return stats, nil
+}
+
+func (c *command) print(out io.Writer, stats []vmiStats) error {
+	switch c.outputFormat {
+	case outputJSON:
+		output, err := json.MarshalIndent(stats, "", "  ")
+		if err != nil {
+			return fmt.Errorf("cannot marshal the usage to JSON: %v", err)
+		}
+		_, err = fmt.Fprintln(out, string(output))
+		return err
+	case outputYAML:
+		output, err := yaml.Marshal(stats)
+		if err != nil {
+			return fmt.Errorf("cannot marshal the usage to YAML: %v", err)
+		}
+		_, err = fmt.Fprint(out, string(output))
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	if !c.noHeaders {
+		fmt.Fprintln(w, "NAME\tCPU(cores)\tCPU%\tMEMORY(RSS)\tGUEST MEMORY\tDISK READ\tDISK WRITE\tNET RX\tNET TX")
+	}
+	for _, vmi := range stats {
+		var diskRead, diskWrite, netRx, netTx int64
+		for _, disk := range vmi.Stats.Disks {
+			diskRead += disk.ReadBytesPerSecond
+			diskWrite += disk.WriteBytesPerSecond
+		}
+		for _, iface := range vmi.Stats.Interfaces {
+			netRx += iface.RxBytesPerSecond
+			netTx += iface.TxBytesPerSecond
+		}
+		fmt.Fprintf(w, "%s\t%dm\t%s\t%s\t%s\t%s/s\t%s/s\t%s/s\t%s/s\n",
+			vmi.Name,
+			vmi.Stats.CPU.UsageMillicores,
+			cpuPercent(vmi.Stats.CPU),
+			formatBytes(vmi.Stats.Memory.RSSBytes),
+			guestMemory(vmi.Stats.Memory),
+			formatBytes(diskRead), formatBytes(diskWrite),
+			formatBytes(netRx), formatBytes(netTx),
+		)
+	}
+	return w.Flush()
+}
+
+func cpuPercent(cpu v1.VirtualMachineInstanceCPUStats) string {
+	if cpu.VCPUs == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d%%", cpu.UsageMillicores/(int64(cpu.VCPUs)*10))
+}
+
+func guestMemory(memory v1.VirtualMachineInstanceMemoryStats) string {
+	if memory.GuestTotalBytes == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%s/%s", formatBytes(memory.GuestUsedBytes), formatBytes(memory.GuestTotalBytes))
+}
+
+// formatBytes formats a number of bytes with binary prefixes, eg: 1.5Mi
+func formatBytes(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d", bytes)
+	}
+	value := float64(bytes)
+	for _, prefix := range []string{"Ki", "Mi", "Gi", "Ti"} {
+		value /= unit
+		if value < unit || prefix == "Ti" {
+			return fmt.Sprintf("%.1f%s", value, prefix)
+		}
+	}
+	return ""
+}
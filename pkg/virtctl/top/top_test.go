@@ -0,0 +1,90 @@
+package top_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+	"kubevirt.io/kubevirt/pkg/virtctl/top"
+)
+
+var _ = Describe("Top", func() {
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+	newStats := func() v1.VirtualMachineInstanceStats {
+		return v1.VirtualMachineInstanceStats{
+			CPU:    v1.VirtualMachineInstanceCPUStats{VCPUs: 2, UsageMillicores: 500},
+			Memory: v1.VirtualMachineInstanceMemoryStats{RSSBytes: 512 * 1024 * 1024, GuestUsedBytes: 256 * 1024 * 1024, GuestTotalBytes: 1024 * 1024 * 1024},
+			Disks: []v1.VirtualMachineInstanceDiskStats{
+				{Name: "rootdisk", ReadBytesPerSecond: 1024, WriteBytesPerSecond: 2048},
+				{Name: "datadisk", ReadBytesPerSecond: 1024},
+			},
+			Interfaces: []v1.VirtualMachineInstanceInterfaceStats{
+				{Name: "default", RxBytesPerSecond: 3 * 1024 * 1024 / 2, TxBytesPerSecond: 100},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+	})
+
+	It("should display the usage of a VMI", func() {
+		vmiInterface.EXPECT().Stats(gomock.Any(), "testvmi").Return(newStats(), nil)
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut(top.COMMAND_TOP, "vmis", "testvmi")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(MatchRegexp(`NAME\s+CPU\(cores\)\s+CPU%\s+MEMORY\(RSS\)\s+GUEST MEMORY\s+DISK READ\s+DISK WRITE\s+NET RX\s+NET TX`))
+		Expect(string(out)).To(MatchRegexp(`testvmi\s+500m\s+25%\s+512.0Mi\s+256.0Mi/1.0Gi\s+2.0Ki/s\s+2.0Ki/s\s+1.5Mi/s\s+100/s`))
+	})
+
+	It("should display the usage of the running VMIs of the namespace", func() {
+		running := libvmi.New(libvmi.WithName("running"))
+		running.Status.Phase = v1.Running
+		pending := libvmi.New(libvmi.WithName("pending"))
+		pending.Status.Phase = v1.Pending
+		vmiInterface.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(&v1.VirtualMachineInstanceList{
+			Items: []v1.VirtualMachineInstance{*running, *pending},
+		}, nil)
+		vmiInterface.EXPECT().Stats(gomock.Any(), "running").Return(newStats(), nil)
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut(top.COMMAND_TOP, "vmis", "--no-headers")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(HavePrefix("running "))
+		Expect(string(out)).ToNot(ContainSubstring("pending"))
+		Expect(string(out)).ToNot(ContainSubstring("NAME"))
+	})
+
+	It("should display the usage per disk and interface as JSON", func() {
+		vmiInterface.EXPECT().Stats(gomock.Any(), "testvmi").Return(newStats(), nil)
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut(top.COMMAND_TOP, "vmis", "testvmi", "-o", "json")()
+		Expect(err).ToNot(HaveOccurred())
+		var stats []struct {
+			Name  string                         `json:"name"`
+			Stats v1.VirtualMachineInstanceStats `json:"stats"`
+		}
+		Expect(json.Unmarshal(out, &stats)).To(Succeed())
+		Expect(stats).To(HaveLen(1))
+		Expect(stats[0].Name).To(Equal("testvmi"))
+		Expect(stats[0].Stats.Disks).To(Equal(newStats().Disks))
+	})
+
+	It("should fail with an unsupported output format", func() {
+		_, err := testing.NewRepeatableVirtctlCommandWithOut(top.COMMAND_TOP, "vmis", "testvmi", "-o", "xml")()
+		Expect(err).To(MatchError(ContainSubstring("unsupported output format")))
+	})
+})
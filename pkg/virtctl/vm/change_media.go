@@ -0,0 +1,85 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const diskNameArg = "disk-name"
+
+var diskName string
+
+func NewChangeMediaCommand() *cobra.Command {
+	c := Command{}
+	cmd := &cobra.Command{
+		Use:     "changemedia VMI",
+		Short:   "insert a volume into a CD-ROM of a running VM, or eject its medium",
+		Example: usageChangeMedia(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.changeMediaRun,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	cmd.Flags().StringVar(&diskName, diskNameArg, "", "name of the CD-ROM in the disks section of spec")
+	cmd.MarkFlagRequired(diskNameArg)
+	cmd.Flags().StringVar(&volumeName, volumeNameArg, "", "name used in volumes section of spec, the medium is ejected if not set")
+	return cmd
+}
+
+func usageChangeMedia() string {
+	return `  #Insert the volume example-iso into the CD-ROM cdrom of a running VM.
+  {{ProgramName}} changemedia fedora-vm --disk-name=cdrom --volume-name=example-iso
+
+  #Eject the medium of the CD-ROM cdrom of a running VM.
+  {{ProgramName}} changemedia fedora-vm --disk-name=cdrom
+  `
+}
+
+func (o *Command) changeMediaRun(cmd *cobra.Command, args []string) error {
+	vmiName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	err = virtClient.VirtualMachineInstance(namespace).ChangeMedia(context.Background(), vmiName, &v1.ChangeMediaOptions{
+		DiskName:   diskName,
+		VolumeName: volumeName,
+	})
+	if err != nil {
+		return fmt.Errorf("error changing media, %v", err)
+	}
+
+	if volumeName == "" {
+		fmt.Printf("Successfully submitted eject request to VM %s for CD-ROM %s\n", vmiName, diskName)
+	} else {
+		fmt.Printf("Successfully submitted change media request to VM %s for CD-ROM %s with volume %s\n", vmiName, diskName, volumeName)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	kvtesting "kubevirt.io/client-go/testing"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Change media command", func() {
+	const vmiName = "testvmi"
+
+	var virtClient *kubevirtfake.Clientset
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		virtClient = kubevirtfake.NewSimpleClientset()
+	})
+
+	expectVMIEndpointChangeMedia := func(expected *v1.ChangeMediaOptions, err error) {
+		kubecli.MockKubevirtClientInstance.
+			EXPECT().
+			VirtualMachineInstance(metav1.NamespaceDefault).
+			Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).
+			Times(1)
+		virtClient.PrependReactor("put", "virtualmachineinstances/changemedia", func(action k8stesting.Action) (handled bool, ret runtime.Object, _ error) {
+			switch action := action.(type) {
+			case kvtesting.PutAction[*v1.ChangeMediaOptions]:
+				Expect(action.GetOptions()).To(Equal(expected))
+				return true, nil, err
+			default:
+				Fail("unexpected action type on changemedia")
+				return false, nil, nil
+			}
+		})
+	}
+
+	DescribeTable("should fail with missing required or invalid parameters", func(expected string, extraArgs ...string) {
+		args := append([]string{"changemedia"}, extraArgs...)
+		cmd := testing.NewRepeatableVirtctlCommand(args...)
+		Expect(cmd()).To(MatchError(ContainSubstring(expected)))
+	},
+		Entry("no args", "accepts 1 arg(s), received 0"),
+		Entry("with name, missing required disk-name", "required flag(s)", vmiName),
+		Entry("with name and disk-name but invalid extra parameter", "unknown flag", vmiName, "--disk-name=cdrom", "--invalid=test"),
+	)
+
+	DescribeTable("should call the VMI endpoint", func(expected *v1.ChangeMediaOptions, extraArgs ...string) {
+		expectVMIEndpointChangeMedia(expected, nil)
+		args := append([]string{"changemedia", vmiName}, extraArgs...)
+		cmd := testing.NewRepeatableVirtctlCommand(args...)
+		Expect(cmd()).To(Succeed())
+		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachineinstances", "changemedia")).To(HaveLen(1))
+	},
+		Entry("to insert a volume", &v1.ChangeMediaOptions{DiskName: "cdrom", VolumeName: "iso"}, "--disk-name=cdrom", "--volume-name=iso"),
+		Entry("to eject the medium", &v1.ChangeMediaOptions{DiskName: "cdrom"}, "--disk-name=cdrom"),
+	)
+
+	It("should report an error returned by the call", func() {
+		expectVMIEndpointChangeMedia(&v1.ChangeMediaOptions{DiskName: "cdrom", VolumeName: "iso"}, errors.New("tray locked"))
+		cmd := testing.NewRepeatableVirtctlCommand("changemedia", vmiName, "--disk-name=cdrom", "--volume-name=iso")
+		Expect(cmd()).To(MatchError(ContainSubstring("error changing media, tray locked")))
+	})
+})
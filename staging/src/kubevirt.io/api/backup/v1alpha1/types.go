@@ -21,6 +21,7 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -50,12 +51,13 @@ const (
 
 // BackupOptions are options used to configure virtual machine backup job
 type BackupOptions struct {
-	BackupName      string       `json:"backupName,omitempty"`
-	Cmd             BackupCmd    `json:"cmd,omitempty"`
-	Mode            BackupMode   `json:"mode,omitempty"`
-	BackupStartTime *metav1.Time `json:"backupStartTime,omitempty"`
-	PushPath        *string      `json:"pushPath,omitempty"`
-	SkipQuiesce     bool         `json:"skipQuiesce,omitempty"`
+	BackupName      string             `json:"backupName,omitempty"`
+	Cmd             BackupCmd          `json:"cmd,omitempty"`
+	Mode            BackupMode         `json:"mode,omitempty"`
+	BackupStartTime *metav1.Time       `json:"backupStartTime,omitempty"`
+	PushPath        *string            `json:"pushPath,omitempty"`
+	SkipQuiesce     bool               `json:"skipQuiesce,omitempty"`
+	Bandwidth       *resource.Quantity `json:"bandwidth,omitempty"`
 }
 
 // VirtualMachineBackup defines the operation of backing up a VM
@@ -100,6 +102,11 @@ type VirtualMachineBackupSpec struct {
 	// +optional
 	// ForceFullBackup indicates that a full backup is desired
 	ForceFullBackup bool `json:"forceFullBackup,omitempty"`
+	// +optional
+	// Bandwidth limits the rate at which each disk is read by the backup job,
+	// so that the guest IO is not starved while the backup runs.
+	// The value is in quantity per second. Defaults to 0 (no limit)
+	Bandwidth *resource.Quantity `json:"bandwidth,omitempty"`
 }
 
 // VirtualMachineBackupStatus is the status for a VirtualMachineBackup resource
@@ -30,6 +30,7 @@ func (VirtualMachineBackupSpec) SwaggerDoc() map[string]string {
 		"pvcName":         "+optional\nPvcName required in push mode. Specifies the name of the PVC\nwhere the backup output will be stored",
 		"skipQuiesce":     "+optional\nSkipQuiesce indicates whether the VM's filesystem shoule not be quiesced before the backup",
 		"forceFullBackup": "+optional\nForceFullBackup indicates that a full backup is desired",
+		"bandwidth":       "+optional\nBandwidth limits the rate at which each disk is read by the backup job,\nso that the guest IO is not starved while the backup runs.\nThe value is in quantity per second. Defaults to 0 (no limit)",
 	}
 }
 
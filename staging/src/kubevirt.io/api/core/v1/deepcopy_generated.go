@@ -163,6 +163,13 @@ func (in *ArchSpecificConfiguration) DeepCopyInto(out *ArchSpecificConfiguration
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MachineTypeAliases != nil {
+		in, out := &in.MachineTypeAliases, &out.MachineTypeAliases
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -213,6 +220,37 @@ func (in *BIOS) DeepCopy() *BIOS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BalloonDevice) DeepCopyInto(out *BalloonDevice) {
+	*out = *in
+	if in.DeflateOnOOM != nil {
+		in, out := &in.DeflateOnOOM, &out.DeflateOnOOM
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableStatsWithoutDriver != nil {
+		in, out := &in.DisableStatsWithoutDriver, &out.DisableStatsWithoutDriver
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FreePageReporting != nil {
+		in, out := &in.FreePageReporting, &out.FreePageReporting
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BalloonDevice.
+func (in *BalloonDevice) DeepCopy() *BalloonDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(BalloonDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlockSize) DeepCopyInto(out *BlockSize) {
 	*out = *in
@@ -304,6 +342,11 @@ func (in *CPU) DeepCopyInto(out *CPU) {
 		*out = new(Realtime)
 		**out = **in
 	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(CPUCache)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -317,6 +360,66 @@ func (in *CPU) DeepCopy() *CPU {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUBurst) DeepCopyInto(out *CPUBurst) {
+	*out = *in
+	out.CPULimit = in.CPULimit.DeepCopy()
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]CPUBurstWindow, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUBurst.
+func (in *CPUBurst) DeepCopy() *CPUBurst {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUBurst)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUBurstWindow) DeepCopyInto(out *CPUBurstWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUBurstWindow.
+func (in *CPUBurstWindow) DeepCopy() *CPUBurstWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUBurstWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUCache) DeepCopyInto(out *CPUCache) {
+	*out = *in
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUCache.
+func (in *CPUCache) DeepCopy() *CPUCache {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CPUFeature) DeepCopyInto(out *CPUFeature) {
 	*out = *in
@@ -375,6 +478,22 @@ func (in *CertConfig) DeepCopy() *CertConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChangeMediaOptions) DeepCopyInto(out *ChangeMediaOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangeMediaOptions.
+func (in *ChangeMediaOptions) DeepCopy() *ChangeMediaOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangeMediaOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChangedBlockTrackingSelectors) DeepCopyInto(out *ChangedBlockTrackingSelectors) {
 	*out = *in
@@ -422,6 +541,22 @@ func (in *ChangedBlockTrackingStatus) DeepCopy() *ChangedBlockTrackingStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Channel) DeepCopyInto(out *Channel) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Channel.
+func (in *Channel) DeepCopy() *Channel {
+	if in == nil {
+		return nil
+	}
+	out := new(Channel)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Chassis) DeepCopyInto(out *Chassis) {
 	*out = *in
@@ -489,6 +624,11 @@ func (in *Clock) DeepCopyInto(out *Clock) {
 		*out = new(Timer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PTP != nil {
+		in, out := &in.PTP, &out.PTP
+		*out = new(PTPClock)
+		**out = **in
+	}
 	return
 }
 
@@ -870,6 +1010,16 @@ func (in *DHCPOptions) DeepCopyInto(out *DHCPOptions) {
 		*out = make([]DHCPPrivateOptions, len(*in))
 		copy(*out, *in)
 	}
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SearchDomains != nil {
+		in, out := &in.SearchDomains, &out.SearchDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1162,6 +1312,21 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.VirtioTransitionalDevices != nil {
+		in, out := &in.VirtioTransitionalDevices, &out.VirtioTransitionalDevices
+		*out = make([]VirtioDeviceClass, len(*in))
+		copy(*out, *in)
+	}
+	if in.SCSIControllerCount != nil {
+		in, out := &in.SCSIControllerCount, &out.SCSIControllerCount
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SCSIControllerQueues != nil {
+		in, out := &in.SCSIControllerQueues, &out.SCSIControllerQueues
+		*out = new(uint32)
+		**out = **in
+	}
 	if in.Disks != nil {
 		in, out := &in.Disks, &out.Disks
 		*out = make([]Disk, len(*in))
@@ -1236,6 +1401,11 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.NetworkInterfaceRSS != nil {
+		in, out := &in.NetworkInterfaceRSS, &out.NetworkInterfaceRSS
+		*out = new(bool)
+		**out = **in
+	}
 	if in.GPUs != nil {
 		in, out := &in.GPUs, &out.GPUs
 		*out = make([]GPU, len(*in))
@@ -1287,7 +1457,34 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 	if in.Video != nil {
 		in, out := &in.Video, &out.Video
 		*out = new(VideoDevice)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Videos != nil {
+		in, out := &in.Videos, &out.Videos
+		*out = make([]VideoDevice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Channels != nil {
+		in, out := &in.Channels, &out.Channels
+		*out = make([]Channel, len(*in))
+		copy(*out, *in)
+	}
+	if in.Balloon != nil {
+		in, out := &in.Balloon, &out.Balloon
+		*out = new(BalloonDevice)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SGX != nil {
+		in, out := &in.SGX, &out.SGX
+		*out = new(SGX)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Serials != nil {
+		in, out := &in.Serials, &out.Serials
+		*out = make([]Serial, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
@@ -1379,11 +1576,21 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(DiskErrorPolicy)
 		**out = **in
 	}
+	if in.RerrorPolicy != nil {
+		in, out := &in.RerrorPolicy, &out.RerrorPolicy
+		*out = new(DiskErrorPolicy)
+		**out = **in
+	}
 	if in.ChangedBlockTracking != nil {
 		in, out := &in.ChangedBlockTracking, &out.ChangedBlockTracking
 		*out = new(bool)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1403,12 +1610,12 @@ func (in *DiskDevice) DeepCopyInto(out *DiskDevice) {
 	if in.Disk != nil {
 		in, out := &in.Disk, &out.Disk
 		*out = new(DiskTarget)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.LUN != nil {
 		in, out := &in.LUN, &out.LUN
 		*out = new(LunTarget)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.CDRom != nil {
 		in, out := &in.CDRom, &out.CDRom
@@ -1436,6 +1643,13 @@ func (in *DiskIOThreads) DeepCopyInto(out *DiskIOThreads) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.Pinning != nil {
+		in, out := &in.Pinning, &out.Pinning
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1449,9 +1663,56 @@ func (in *DiskIOThreads) DeepCopy() *DiskIOThreads {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTune) DeepCopyInto(out *DiskIOTune) {
+	*out = *in
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(DiskIOTuneBurst)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTune.
+func (in *DiskIOTune) DeepCopy() *DiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTuneBurst) DeepCopyInto(out *DiskIOTuneBurst) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTuneBurst.
+func (in *DiskIOTuneBurst) DeepCopy() *DiskIOTuneBurst {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTuneBurst)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskTarget) DeepCopyInto(out *DiskTarget) {
 	*out = *in
+	if in.SCSIController != nil {
+		in, out := &in.SCSIController, &out.SCSIController
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.QueueSize != nil {
+		in, out := &in.QueueSize, &out.QueueSize
+		*out = new(uint32)
+		**out = **in
+	}
 	return
 }
 
@@ -1719,6 +1980,22 @@ func (in *EvacuateCancelOptions) DeepCopy() *EvacuateCancelOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FWCfgEntry) DeepCopyInto(out *FWCfgEntry) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FWCfgEntry.
+func (in *FWCfgEntry) DeepCopy() *FWCfgEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(FWCfgEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeatureAPIC) DeepCopyInto(out *FeatureAPIC) {
 	*out = *in
@@ -1963,7 +2240,7 @@ func (in *Filesystem) DeepCopyInto(out *Filesystem) {
 	if in.Virtiofs != nil {
 		in, out := &in.Virtiofs, &out.Virtiofs
 		*out = new(FilesystemVirtiofs)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -1981,6 +2258,16 @@ func (in *Filesystem) DeepCopy() *Filesystem {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FilesystemVirtiofs) DeepCopyInto(out *FilesystemVirtiofs) {
 	*out = *in
+	if in.QueueSize != nil {
+		in, out := &in.QueueSize, &out.QueueSize
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Xattr != nil {
+		in, out := &in.Xattr, &out.Xattr
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -2002,6 +2289,11 @@ func (in *Firmware) DeepCopyInto(out *Firmware) {
 		*out = new(Bootloader)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OEMStrings != nil {
+		in, out := &in.OEMStrings, &out.OEMStrings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.KernelBoot != nil {
 		in, out := &in.KernelBoot, &out.KernelBoot
 		*out = new(KernelBoot)
@@ -2012,6 +2304,11 @@ func (in *Firmware) DeepCopyInto(out *Firmware) {
 		*out = new(ACPI)
 		**out = **in
 	}
+	if in.FWCfg != nil {
+		in, out := &in.FWCfg, &out.FWCfg
+		*out = make([]FWCfgEntry, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2125,6 +2422,27 @@ func (in *GenerationStatus) DeepCopy() *GenerationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestAgent) DeepCopyInto(out *GuestAgent) {
+	*out = *in
+	if in.AllowedCommands != nil {
+		in, out := &in.AllowedCommands, &out.AllowedCommands
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestAgent.
+func (in *GuestAgent) DeepCopy() *GuestAgent {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestAgent)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GuestAgentCommandInfo) DeepCopyInto(out *GuestAgentCommandInfo) {
 	*out = *in
@@ -2157,6 +2475,43 @@ func (in *GuestAgentPing) DeepCopy() *GuestAgentPing {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestCrashNotificationsConfiguration) DeepCopyInto(out *GuestCrashNotificationsConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestCrashNotificationsConfiguration.
+func (in *GuestCrashNotificationsConfiguration) DeepCopy() *GuestCrashNotificationsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestCrashNotificationsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestTimeConfiguration) DeepCopyInto(out *GuestTimeConfiguration) {
+	*out = *in
+	if in.NTPServers != nil {
+		in, out := &in.NTPServers, &out.NTPServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestTimeConfiguration.
+func (in *GuestTimeConfiguration) DeepCopy() *GuestTimeConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestTimeConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HPETTimer) DeepCopyInto(out *HPETTimer) {
 	*out = *in
@@ -2215,34 +2570,71 @@ func (in *Handler) DeepCopy() *Handler {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HostDevice) DeepCopyInto(out *HostDevice) {
+func (in *HostBlockDeviceVolumeSource) DeepCopyInto(out *HostBlockDeviceVolumeSource) {
 	*out = *in
-	if in.ClaimRequest != nil {
-		in, out := &in.ClaimRequest, &out.ClaimRequest
-		*out = new(ClaimRequest)
-		(*in).DeepCopyInto(*out)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostDevice.
-func (in *HostDevice) DeepCopy() *HostDevice {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostBlockDeviceVolumeSource.
+func (in *HostBlockDeviceVolumeSource) DeepCopy() *HostBlockDeviceVolumeSource {
 	if in == nil {
 		return nil
 	}
-	out := new(HostDevice)
+	out := new(HostBlockDeviceVolumeSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HostDisk) DeepCopyInto(out *HostDisk) {
+func (in *HostBlockDevicesConfiguration) DeepCopyInto(out *HostBlockDevicesConfiguration) {
 	*out = *in
-	out.Capacity = in.Capacity.DeepCopy()
-	if in.Shared != nil {
-		in, out := &in.Shared, &out.Shared
-		*out = new(bool)
-		**out = **in
+	if in.PermittedPaths != nil {
+		in, out := &in.PermittedPaths, &out.PermittedPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostBlockDevicesConfiguration.
+func (in *HostBlockDevicesConfiguration) DeepCopy() *HostBlockDevicesConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(HostBlockDevicesConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostDevice) DeepCopyInto(out *HostDevice) {
+	*out = *in
+	if in.ClaimRequest != nil {
+		in, out := &in.ClaimRequest, &out.ClaimRequest
+		*out = new(ClaimRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostDevice.
+func (in *HostDevice) DeepCopy() *HostDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(HostDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostDisk) DeepCopyInto(out *HostDisk) {
+	*out = *in
+	out.Capacity = in.Capacity.DeepCopy()
+	if in.Shared != nil {
+		in, out := &in.Shared, &out.Shared
+		*out = new(bool)
+		**out = **in
 	}
 	return
 }
@@ -2257,6 +2649,27 @@ func (in *HostDisk) DeepCopy() *HostDisk {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostSocketDirectoriesConfiguration) DeepCopyInto(out *HostSocketDirectoriesConfiguration) {
+	*out = *in
+	if in.PermittedDirectories != nil {
+		in, out := &in.PermittedDirectories, &out.PermittedDirectories
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostSocketDirectoriesConfiguration.
+func (in *HostSocketDirectoriesConfiguration) DeepCopy() *HostSocketDirectoriesConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(HostSocketDirectoriesConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HotplugVolumeSource) DeepCopyInto(out *HotplugVolumeSource) {
 	*out = *in
@@ -2302,6 +2715,11 @@ func (in *HotplugVolumeStatus) DeepCopy() *HotplugVolumeStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Hugepages) DeepCopyInto(out *Hugepages) {
 	*out = *in
+	if in.NUMAPolicy != nil {
+		in, out := &in.NUMAPolicy, &out.NUMAPolicy
+		*out = new(HugepagesNUMAPolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -2315,6 +2733,22 @@ func (in *Hugepages) DeepCopy() *Hugepages {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HugepagesNUMAPolicy) DeepCopyInto(out *HugepagesNUMAPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HugepagesNUMAPolicy.
+func (in *HugepagesNUMAPolicy) DeepCopy() *HugepagesNUMAPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(HugepagesNUMAPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HyperVPassthrough) DeepCopyInto(out *HyperVPassthrough) {
 	*out = *in
@@ -2405,6 +2839,22 @@ func (in *Input) DeepCopy() *Input {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InputHostDevice) DeepCopyInto(out *InputHostDevice) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InputHostDevice.
+func (in *InputHostDevice) DeepCopy() *InputHostDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(InputHostDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstancetypeConfiguration) DeepCopyInto(out *InstancetypeConfiguration) {
 	*out = *in
@@ -2497,6 +2947,16 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 		*out = new(DHCPOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Coalesce != nil {
+		in, out := &in.Coalesce, &out.Coalesce
+		*out = new(InterfaceCoalesce)
+		**out = **in
+	}
+	if in.Offloads != nil {
+		in, out := &in.Offloads, &out.Offloads
+		*out = new(InterfaceOffloads)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2614,6 +3074,104 @@ func (in *InterfaceBridge) DeepCopy() *InterfaceBridge {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceCoalesce) DeepCopyInto(out *InterfaceCoalesce) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceCoalesce.
+func (in *InterfaceCoalesce) DeepCopy() *InterfaceCoalesce {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceCoalesce)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceGuestOffloads) DeepCopyInto(out *InterfaceGuestOffloads) {
+	*out = *in
+	if in.Checksum != nil {
+		in, out := &in.Checksum, &out.Checksum
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TSO4 != nil {
+		in, out := &in.TSO4, &out.TSO4
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TSO6 != nil {
+		in, out := &in.TSO6, &out.TSO6
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UFO != nil {
+		in, out := &in.UFO, &out.UFO
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceGuestOffloads.
+func (in *InterfaceGuestOffloads) DeepCopy() *InterfaceGuestOffloads {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceGuestOffloads)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceHostOffloads) DeepCopyInto(out *InterfaceHostOffloads) {
+	*out = *in
+	if in.Checksum != nil {
+		in, out := &in.Checksum, &out.Checksum
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GSO != nil {
+		in, out := &in.GSO, &out.GSO
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TSO4 != nil {
+		in, out := &in.TSO4, &out.TSO4
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TSO6 != nil {
+		in, out := &in.TSO6, &out.TSO6
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UFO != nil {
+		in, out := &in.UFO, &out.UFO
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MergeRxBuffers != nil {
+		in, out := &in.MergeRxBuffers, &out.MergeRxBuffers
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceHostOffloads.
+func (in *InterfaceHostOffloads) DeepCopy() *InterfaceHostOffloads {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceHostOffloads)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceMasquerade) DeepCopyInto(out *InterfaceMasquerade) {
 	*out = *in
@@ -2630,6 +3188,32 @@ func (in *InterfaceMasquerade) DeepCopy() *InterfaceMasquerade {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceOffloads) DeepCopyInto(out *InterfaceOffloads) {
+	*out = *in
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(InterfaceHostOffloads)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Guest != nil {
+		in, out := &in.Guest, &out.Guest
+		*out = new(InterfaceGuestOffloads)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceOffloads.
+func (in *InterfaceOffloads) DeepCopy() *InterfaceOffloads {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceOffloads)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceSRIOV) DeepCopyInto(out *InterfaceSRIOV) {
 	*out = *in
@@ -2991,6 +3575,41 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(ChangedBlockTrackingSelectors)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NodeGuardrails != nil {
+		in, out := &in.NodeGuardrails, &out.NodeGuardrails
+		*out = new(NodeGuardrailsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GuestTime != nil {
+		in, out := &in.GuestTime, &out.GuestTime
+		*out = new(GuestTimeConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PersistentReservation != nil {
+		in, out := &in.PersistentReservation, &out.PersistentReservation
+		*out = new(PersistentReservationConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostBlockDevices != nil {
+		in, out := &in.HostBlockDevices, &out.HostBlockDevices
+		*out = new(HostBlockDevicesConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GuestCrashNotifications != nil {
+		in, out := &in.GuestCrashNotifications, &out.GuestCrashNotifications
+		*out = new(GuestCrashNotificationsConfiguration)
+		**out = **in
+	}
+	if in.VhostUserBlkSockets != nil {
+		in, out := &in.VhostUserBlkSockets, &out.VhostUserBlkSockets
+		*out = new(HostSocketDirectoriesConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalTPMSockets != nil {
+		in, out := &in.ExternalTPMSockets, &out.ExternalTPMSockets
+		*out = new(HostSocketDirectoriesConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3270,6 +3889,11 @@ func (in *LogVerbosity) DeepCopy() *LogVerbosity {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LunTarget) DeepCopyInto(out *LunTarget) {
 	*out = *in
+	if in.SCSIController != nil {
+		in, out := &in.SCSIController, &out.SCSIController
+		*out = new(uint32)
+		**out = **in
+	}
 	return
 }
 
@@ -3299,6 +3923,48 @@ func (in *Machine) DeepCopy() *Machine {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceSnapshotPolicy) DeepCopyInto(out *MaintenanceSnapshotPolicy) {
+	*out = *in
+	if in.SuccessWindow != nil {
+		in, out := &in.SuccessWindow, &out.SuccessWindow
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceSnapshotPolicy.
+func (in *MaintenanceSnapshotPolicy) DeepCopy() *MaintenanceSnapshotPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceSnapshotPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MasqueradeIPAMConfiguration) DeepCopyInto(out *MasqueradeIPAMConfiguration) {
+	*out = *in
+	if in.ExcludedCIDRs != nil {
+		in, out := &in.ExcludedCIDRs, &out.ExcludedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MasqueradeIPAMConfiguration.
+func (in *MasqueradeIPAMConfiguration) DeepCopy() *MasqueradeIPAMConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(MasqueradeIPAMConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MediatedDevicesConfiguration) DeepCopyInto(out *MediatedDevicesConfiguration) {
 	*out = *in
@@ -3354,7 +4020,7 @@ func (in *Memory) DeepCopyInto(out *Memory) {
 	if in.Hugepages != nil {
 		in, out := &in.Hugepages, &out.Hugepages
 		*out = new(Hugepages)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Guest != nil {
 		in, out := &in.Guest, &out.Guest
@@ -3366,6 +4032,11 @@ func (in *Memory) DeepCopyInto(out *Memory) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.Hotplug != nil {
+		in, out := &in.Hotplug, &out.Hotplug
+		*out = new(MemoryHotplug)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3396,6 +4067,27 @@ func (in *MemoryDumpVolumeSource) DeepCopy() *MemoryDumpVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryHotplug) DeepCopyInto(out *MemoryHotplug) {
+	*out = *in
+	if in.VirtioMem != nil {
+		in, out := &in.VirtioMem, &out.VirtioMem
+		*out = new(VirtioMemHotplug)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryHotplug.
+func (in *MemoryHotplug) DeepCopy() *MemoryHotplug {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryHotplug)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MemoryStatus) DeepCopyInto(out *MemoryStatus) {
 	*out = *in
@@ -3566,6 +4258,11 @@ func (in *NUMA) DeepCopyInto(out *NUMA) {
 		*out = new(NUMAGuestMappingPassthrough)
 		**out = **in
 	}
+	if in.Manual != nil {
+		in, out := &in.Manual, &out.Manual
+		*out = new(NUMAManualTopology)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3579,6 +4276,22 @@ func (in *NUMA) DeepCopy() *NUMA {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NUMADistance) DeepCopyInto(out *NUMADistance) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMADistance.
+func (in *NUMADistance) DeepCopy() *NUMADistance {
+	if in == nil {
+		return nil
+	}
+	out := new(NUMADistance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NUMAGuestMappingPassthrough) DeepCopyInto(out *NUMAGuestMappingPassthrough) {
 	*out = *in
@@ -3595,6 +4308,51 @@ func (in *NUMAGuestMappingPassthrough) DeepCopy() *NUMAGuestMappingPassthrough {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NUMAManualTopology) DeepCopyInto(out *NUMAManualTopology) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]NUMANode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMAManualTopology.
+func (in *NUMAManualTopology) DeepCopy() *NUMAManualTopology {
+	if in == nil {
+		return nil
+	}
+	out := new(NUMAManualTopology)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NUMANode) DeepCopyInto(out *NUMANode) {
+	*out = *in
+	out.Memory = in.Memory.DeepCopy()
+	if in.Distances != nil {
+		in, out := &in.Distances, &out.Distances
+		*out = make([]NUMADistance, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMANode.
+func (in *NUMANode) DeepCopy() *NUMANode {
+	if in == nil {
+		return nil
+	}
+	out := new(NUMANode)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Network) DeepCopyInto(out *Network) {
 	*out = *in
@@ -3632,6 +4390,11 @@ func (in *NetworkConfiguration) DeepCopyInto(out *NetworkConfiguration) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.MasqueradeIPAM != nil {
+		in, out := &in.MasqueradeIPAM, &out.MasqueradeIPAM
+		*out = new(MasqueradeIPAMConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3687,6 +4450,32 @@ func (in *NoCloudSSHPublicKeyAccessCredentialPropagation) DeepCopy() *NoCloudSSH
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGuardrailsConfiguration) DeepCopyInto(out *NodeGuardrailsConfiguration) {
+	*out = *in
+	if in.MaxVirtualMachineInstances != nil {
+		in, out := &in.MaxVirtualMachineInstances, &out.MaxVirtualMachineInstances
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MinHugepagesLocalityPercent != nil {
+		in, out := &in.MinHugepagesLocalityPercent, &out.MinHugepagesLocalityPercent
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGuardrailsConfiguration.
+func (in *NodeGuardrailsConfiguration) DeepCopy() *NodeGuardrailsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGuardrailsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeMediatedDeviceTypesConfig) DeepCopyInto(out *NodeMediatedDeviceTypesConfig) {
 	*out = *in
@@ -3847,6 +4636,22 @@ func (in *PITTimer) DeepCopy() *PITTimer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PTPClock) DeepCopyInto(out *PTPClock) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PTPClock.
+func (in *PTPClock) DeepCopy() *PTPClock {
+	if in == nil {
+		return nil
+	}
+	out := new(PTPClock)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PanicDevice) DeepCopyInto(out *PanicDevice) {
 	*out = *in
@@ -3926,6 +4731,16 @@ func (in *PermittedHostDevices) DeepCopyInto(out *PermittedHostDevices) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.InputDevices != nil {
+		in, out := &in.InputDevices, &out.InputDevices
+		*out = make([]InputHostDevice, len(*in))
+		copy(*out, *in)
+	}
+	if in.VDPADevices != nil {
+		in, out := &in.VDPADevices, &out.VDPADevices
+		*out = make([]VDPAHostDevice, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3939,6 +4754,27 @@ func (in *PermittedHostDevices) DeepCopy() *PermittedHostDevices {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentReservationConfiguration) DeepCopyInto(out *PersistentReservationConfiguration) {
+	*out = *in
+	if in.StorageClasses != nil {
+		in, out := &in.StorageClasses, &out.StorageClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentReservationConfiguration.
+func (in *PersistentReservationConfiguration) DeepCopy() *PersistentReservationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentReservationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PersistentVolumeClaimInfo) DeepCopyInto(out *PersistentVolumeClaimInfo) {
 	*out = *in
@@ -4353,12 +5189,34 @@ func (in *RestartOptions) DeepCopyInto(out *RestartOptions) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestartOptions.
-func (in *RestartOptions) DeepCopy() *RestartOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestartOptions.
+func (in *RestartOptions) DeepCopy() *RestartOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(RestartOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RevertOptions) DeepCopyInto(out *RevertOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RevertOptions.
+func (in *RevertOptions) DeepCopy() *RevertOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(RestartOptions)
+	out := new(RevertOptions)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4540,6 +5398,23 @@ func (in *SEVSessionOptions) DeepCopy() *SEVSessionOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SGX) DeepCopyInto(out *SGX) {
+	*out = *in
+	out.EPCSize = in.EPCSize.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SGX.
+func (in *SGX) DeepCopy() *SGX {
+	if in == nil {
+		return nil
+	}
+	out := new(SGX)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SMBiosConfiguration) DeepCopyInto(out *SMBiosConfiguration) {
 	*out = *in
@@ -4684,6 +5559,22 @@ func (in *SecretVolumeSource) DeepCopy() *SecretVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Serial) DeepCopyInto(out *Serial) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Serial.
+func (in *Serial) DeepCopy() *Serial {
+	if in == nil {
+		return nil
+	}
+	out := new(Serial)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccountVolumeSource) DeepCopyInto(out *ServiceAccountVolumeSource) {
 	*out = *in
@@ -4700,6 +5591,43 @@ func (in *ServiceAccountVolumeSource) DeepCopy() *ServiceAccountVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShutdownLadder) DeepCopyInto(out *ShutdownLadder) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]ShutdownStep, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShutdownLadder.
+func (in *ShutdownLadder) DeepCopy() *ShutdownLadder {
+	if in == nil {
+		return nil
+	}
+	out := new(ShutdownLadder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShutdownStep) DeepCopyInto(out *ShutdownStep) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShutdownStep.
+func (in *ShutdownStep) DeepCopy() *ShutdownStep {
+	if in == nil {
+		return nil
+	}
+	out := new(ShutdownStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SoundDevice) DeepCopyInto(out *SoundDevice) {
 	*out = *in
@@ -4910,6 +5838,11 @@ func (in *TPMDevice) DeepCopyInto(out *TPMDevice) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(TPMExternal)
+		**out = **in
+	}
 	return
 }
 
@@ -4923,6 +5856,22 @@ func (in *TPMDevice) DeepCopy() *TPMDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TPMExternal) DeepCopyInto(out *TPMExternal) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TPMExternal.
+func (in *TPMExternal) DeepCopy() *TPMExternal {
+	if in == nil {
+		return nil
+	}
+	out := new(TPMExternal)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Timer) DeepCopyInto(out *Timer) {
 	*out = *in
@@ -5142,6 +6091,22 @@ func (in *UtilityVolume) DeepCopy() *UtilityVolume {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VDPAHostDevice) DeepCopyInto(out *VDPAHostDevice) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VDPAHostDevice.
+func (in *VDPAHostDevice) DeepCopy() *VDPAHostDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(VDPAHostDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VGPUDisplayOptions) DeepCopyInto(out *VGPUDisplayOptions) {
 	*out = *in
@@ -5226,9 +6191,51 @@ func (in *VSOCKOptions) DeepCopy() *VSOCKOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VhostUserBlkVolumeInfo) DeepCopyInto(out *VhostUserBlkVolumeInfo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VhostUserBlkVolumeInfo.
+func (in *VhostUserBlkVolumeInfo) DeepCopy() *VhostUserBlkVolumeInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(VhostUserBlkVolumeInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VhostUserBlkVolumeSource) DeepCopyInto(out *VhostUserBlkVolumeSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VhostUserBlkVolumeSource.
+func (in *VhostUserBlkVolumeSource) DeepCopy() *VhostUserBlkVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(VhostUserBlkVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VideoDevice) DeepCopyInto(out *VideoDevice) {
 	*out = *in
+	if in.Heads != nil {
+		in, out := &in.Heads, &out.Heads
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.VRAM != nil {
+		in, out := &in.VRAM, &out.VRAM
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	return
 }
 
@@ -5242,6 +6249,27 @@ func (in *VideoDevice) DeepCopy() *VideoDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtioMemHotplug) DeepCopyInto(out *VirtioMemHotplug) {
+	*out = *in
+	if in.BlockSize != nil {
+		in, out := &in.BlockSize, &out.BlockSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtioMemHotplug.
+func (in *VirtioMemHotplug) DeepCopy() *VirtioMemHotplug {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtioMemHotplug)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachine) DeepCopyInto(out *VirtualMachine) {
 	*out = *in
@@ -5345,6 +6373,22 @@ func (in *VirtualMachineInstanceBackupStatus) DeepCopy() *VirtualMachineInstance
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceCPUStats) DeepCopyInto(out *VirtualMachineInstanceCPUStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceCPUStats.
+func (in *VirtualMachineInstanceCPUStats) DeepCopy() *VirtualMachineInstanceCPUStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceCPUStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceCommonMigrationState) DeepCopyInto(out *VirtualMachineInstanceCommonMigrationState) {
 	*out = *in
@@ -5404,6 +6448,22 @@ func (in *VirtualMachineInstanceCondition) DeepCopy() *VirtualMachineInstanceCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceDiskStats) DeepCopyInto(out *VirtualMachineInstanceDiskStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceDiskStats.
+func (in *VirtualMachineInstanceDiskStats) DeepCopy() *VirtualMachineInstanceDiskStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceDiskStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceFileSystem) DeepCopyInto(out *VirtualMachineInstanceFileSystem) {
 	*out = *in
@@ -5597,6 +6657,22 @@ func (in *VirtualMachineInstanceGuestOSUserList) DeepCopyObject() runtime.Object
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceInterfaceStats) DeepCopyInto(out *VirtualMachineInstanceInterfaceStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceInterfaceStats.
+func (in *VirtualMachineInstanceInterfaceStats) DeepCopy() *VirtualMachineInstanceInterfaceStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceInterfaceStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceList) DeepCopyInto(out *VirtualMachineInstanceList) {
 	*out = *in
@@ -5630,6 +6706,22 @@ func (in *VirtualMachineInstanceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMemoryStats) DeepCopyInto(out *VirtualMachineInstanceMemoryStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMemoryStats.
+func (in *VirtualMachineInstanceMemoryStats) DeepCopy() *VirtualMachineInstanceMemoryStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMemoryStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceMigration) DeepCopyInto(out *VirtualMachineInstanceMigration) {
 	*out = *in
@@ -6287,6 +7379,11 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 		*out = new(int64)
 		**out = **in
 	}
+	if in.ShutdownLadder != nil {
+		in, out := &in.ShutdownLadder, &out.ShutdownLadder
+		*out = new(ShutdownLadder)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
 		*out = make([]Volume, len(*in))
@@ -6323,6 +7420,11 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.GuestAgent != nil {
+		in, out := &in.GuestAgent, &out.GuestAgent
+		*out = new(GuestAgent)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ResourceClaims != nil {
 		in, out := &in.ResourceClaims, &out.ResourceClaims
 		*out = make([]corev1.PodResourceClaim, len(*in))
@@ -6350,6 +7452,45 @@ func (in *VirtualMachineInstanceSpec) DeepCopy() *VirtualMachineInstanceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceStats) DeepCopyInto(out *VirtualMachineInstanceStats) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	out.Window = in.Window
+	out.CPU = in.CPU
+	out.Memory = in.Memory
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]VirtualMachineInstanceDiskStats, len(*in))
+		copy(*out, *in)
+	}
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]VirtualMachineInstanceInterfaceStats, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceStats.
+func (in *VirtualMachineInstanceStats) DeepCopy() *VirtualMachineInstanceStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceStats) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstanceStatus) {
 	*out = *in
@@ -6424,6 +7565,11 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 		*out = new(CPUTopology)
 		**out = **in
 	}
+	if in.CurrentIOThreadCount != nil {
+		in, out := &in.CurrentIOThreadCount, &out.CurrentIOThreadCount
+		*out = new(uint32)
+		**out = **in
+	}
 	if in.Memory != nil {
 		in, out := &in.Memory, &out.Memory
 		*out = new(MemoryStatus)
@@ -6605,6 +7751,16 @@ func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 		*out = new(UpdateVolumesStrategy)
 		**out = **in
 	}
+	if in.CPUBurst != nil {
+		in, out := &in.CPUBurst, &out.CPUBurst
+		*out = new(CPUBurst)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceSnapshot != nil {
+		in, out := &in.MaintenanceSnapshot, &out.MaintenanceSnapshot
+		*out = new(MaintenanceSnapshotPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6791,6 +7947,44 @@ func (in *Volume) DeepCopy() *Volume {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeBlockJob) DeepCopyInto(out *VolumeBlockJob) {
+	*out = *in
+	if in.RemainingSeconds != nil {
+		in, out := &in.RemainingSeconds, &out.RemainingSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeBlockJob.
+func (in *VolumeBlockJob) DeepCopy() *VolumeBlockJob {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeBlockJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeIOErrors) DeepCopyInto(out *VolumeIOErrors) {
+	*out = *in
+	in.LastErrorTime.DeepCopyInto(&out.LastErrorTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeIOErrors.
+func (in *VolumeIOErrors) DeepCopy() *VolumeIOErrors {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeIOErrors)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeMigrationState) DeepCopyInto(out *VolumeMigrationState) {
 	*out = *in
@@ -6908,6 +8102,16 @@ func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
 		*out = new(MemoryDumpVolumeSource)
 		**out = **in
 	}
+	if in.VhostUserBlk != nil {
+		in, out := &in.VhostUserBlk, &out.VhostUserBlk
+		*out = new(VhostUserBlkVolumeSource)
+		**out = **in
+	}
+	if in.HostBlockDevice != nil {
+		in, out := &in.HostBlockDevice, &out.HostBlockDevice
+		*out = new(HostBlockDeviceVolumeSource)
+		**out = **in
+	}
 	return
 }
 
@@ -6944,6 +8148,26 @@ func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
 		*out = new(ContainerDiskInfo)
 		**out = **in
 	}
+	if in.IOErrors != nil {
+		in, out := &in.IOErrors, &out.IOErrors
+		*out = new(VolumeIOErrors)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VhostUserBlkVolume != nil {
+		in, out := &in.VhostUserBlkVolume, &out.VhostUserBlkVolume
+		*out = new(VhostUserBlkVolumeInfo)
+		**out = **in
+	}
+	if in.IOThreads != nil {
+		in, out := &in.IOThreads, &out.IOThreads
+		*out = make([]uint32, len(*in))
+		copy(*out, *in)
+	}
+	if in.BlockJob != nil {
+		in, out := &in.BlockJob, &out.BlockJob
+		*out = new(VolumeBlockJob)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -52,9 +52,10 @@ const (
 type PanicDeviceModel string
 
 const (
-	Hyperv  PanicDeviceModel = "hyperv"
-	Isa     PanicDeviceModel = "isa"
-	Pvpanic PanicDeviceModel = "pvpanic"
+	Hyperv     PanicDeviceModel = "hyperv"
+	Isa        PanicDeviceModel = "isa"
+	Pvpanic    PanicDeviceModel = "pvpanic"
+	PvpanicPCI PanicDeviceModel = "pvpanic-pci"
 )
 
 /*
@@ -293,6 +294,10 @@ type KernelBoot struct {
 	KernelArgs string `json:"kernelArgs,omitempty"`
 	// Container defines the container that containes kernel artifacts
 	Container *KernelBootContainer `json:"container,omitempty"`
+	// RootFilesystem is the name of a virtiofs filesystem the guest mounts as its root filesystem,
+	// so that it boots without any disk. The kernel arguments must not set root or rootfstype.
+	// +optional
+	RootFilesystem string `json:"rootFilesystem,omitempty"`
 }
 
 type ResourceRequirements struct {
@@ -350,6 +355,9 @@ type CPU struct {
 	// Realtime instructs the virt-launcher to tune the VMI for lower latency, optional for real time workloads
 	// +optional
 	Realtime *Realtime `json:"realtime,omitempty"`
+	// Cache describes how the CPU cache is presented to the guest, e.g. passing the host L3 cache through.
+	// +optional
+	Cache *CPUCache `json:"cache,omitempty"`
 }
 
 // Realtime holds the tuning knobs specific for realtime workloads.
@@ -360,6 +368,27 @@ type Realtime struct {
 	Mask string `json:"mask,omitempty"`
 }
 
+type CPUCacheMode string
+
+const (
+	// CPUCacheModePassthrough passes the cache information of the host CPU through, it requires the host-passthrough CPU model.
+	CPUCacheModePassthrough CPUCacheMode = "passthrough"
+	// CPUCacheModeEmulate presents an emulated cache of the given level, only level 3 can be emulated.
+	CPUCacheModeEmulate CPUCacheMode = "emulate"
+	// CPUCacheModeDisable presents no cache of the given level, or no cache at all when no level is given.
+	CPUCacheModeDisable CPUCacheMode = "disable"
+)
+
+// CPUCache describes how the CPU cache is presented to the guest.
+type CPUCache struct {
+	// Mode is the cache mode, one of passthrough, emulate or disable.
+	Mode CPUCacheMode `json:"mode"`
+	// Level is the cache level the mode applies to.
+	// Only level 3 can be emulated or disabled, passthrough applies to all the levels.
+	// +optional
+	Level *uint32 `json:"level,omitempty"`
+}
+
 // NUMAGuestMappingPassthrough instructs kubevirt to model numa topology which is compatible with the CPU pinning on the guest.
 // This will result in a subset of the node numa topology being passed through, ensuring that virtual numa nodes and their memory
 // never cross boundaries coming from the node numa mapping.
@@ -371,6 +400,38 @@ type NUMA struct {
 	// The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
 	// +optional
 	GuestMappingPassthrough *NUMAGuestMappingPassthrough `json:"guestMappingPassthrough,omitempty"`
+	// Manual defines the guest numa topology explicitly, independently of the host numa topology.
+	// It is mutually exclusive with GuestMappingPassthrough.
+	// +optional
+	Manual *NUMAManualTopology `json:"manual,omitempty"`
+}
+
+// NUMAManualTopology describes the numa nodes exposed to the guest.
+type NUMAManualTopology struct {
+	// Nodes of the guest numa topology. The index of a node in the list is its numa node id.
+	// +listType=atomic
+	Nodes []NUMANode `json:"nodes"`
+}
+
+// NUMANode describes a guest numa node.
+type NUMANode struct {
+	// CPUs is the list of vCPUs belonging to the node, e.g. "0-3,8". Every vCPU must belong to exactly one node.
+	CPUs string `json:"cpus"`
+	// Memory of the node. The memory of all the nodes must add up to the guest memory.
+	Memory resource.Quantity `json:"memory"`
+	// Distances from this node to the other nodes. An unset distance is the distance set from the other node back
+	// to this one, or defaults to 10 to the node itself and to 20 to the other nodes.
+	// +optional
+	// +listType=atomic
+	Distances []NUMADistance `json:"distances,omitempty"`
+}
+
+// NUMADistance is the distance from a guest numa node to another one.
+type NUMADistance struct {
+	// Node is the id of the other numa node.
+	Node uint32 `json:"node"`
+	// Value of the distance, between 10 and 255.
+	Value uint32 `json:"value"`
 }
 
 // CPUFeature allows specifying a CPU feature.
@@ -401,6 +462,25 @@ type Memory struct {
 	// MaxGuest allows to specify the maximum amount of memory which is visible inside the Guest OS.
 	// The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
 	MaxGuest *resource.Quantity `json:"maxGuest,omitempty"`
+	// Hotplug configures how the memory between Guest and MaxGuest is hot(un)plugged.
+	// +optional
+	Hotplug *MemoryHotplug `json:"hotplug,omitempty"`
+}
+
+// MemoryHotplug configures how memory is hot(un)plugged into the guest.
+type MemoryHotplug struct {
+	// VirtioMem plugs the memory through a virtio-mem device which is created when the VirtualMachineInstance starts.
+	// The guest memory can then be grown and shrunk in blocks of BlockSize.
+	// +optional
+	VirtioMem *VirtioMemHotplug `json:"virtioMem,omitempty"`
+}
+
+type VirtioMemHotplug struct {
+	// BlockSize is the granularity in which memory is plugged and unplugged.
+	// It must be a power of 2 and at least 2Mi, or 1Gi when 1Gi hugepages are used.
+	// Defaults to the smallest allowed value.
+	// +optional
+	BlockSize *resource.Quantity `json:"blockSize,omitempty"`
 }
 
 type MemoryStatus struct {
@@ -419,8 +499,30 @@ type MemoryStatus struct {
 type Hugepages struct {
 	// PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.
 	PageSize string `json:"pageSize,omitempty"`
+	// NUMAPolicy binds the hugepages backing the guest memory to host NUMA nodes,
+	// so that large guests do not allocate their pages across nodes.
+	// +optional
+	NUMAPolicy *HugepagesNUMAPolicy `json:"numaPolicy,omitempty"`
 }
 
+// HugepagesNUMAPolicy binds the hugepages of the VirtualMachineInstance to host NUMA nodes.
+type HugepagesNUMAPolicy struct {
+	// Mode is the host NUMA memory allocation policy.
+	// One of: strict, interleave, preferred.
+	Mode HugepagesNUMAMode `json:"mode"`
+	// NodeSet is the list of host NUMA nodes the hugepages are allocated from, e.g. "0-1,3".
+	// The nodes must be allowed by the cpuset of the virt-launcher pod.
+	NodeSet string `json:"nodeSet"`
+}
+
+type HugepagesNUMAMode string
+
+const (
+	HugepagesNUMAModeStrict     HugepagesNUMAMode = "strict"
+	HugepagesNUMAModeInterleave HugepagesNUMAMode = "interleave"
+	HugepagesNUMAModePreferred  HugepagesNUMAMode = "preferred"
+)
+
 type Machine struct {
 	// QEMU machine type is the actual chipset of the VirtualMachineInstance.
 	// +optional
@@ -436,11 +538,34 @@ type Firmware struct {
 	Bootloader *Bootloader `json:"bootloader,omitempty"`
 	// The system-serial-number in SMBIOS
 	Serial string `json:"serial,omitempty"`
+	// The system SKU number in SMBIOS, overriding the cluster-wide SMBIOS configuration.
+	// +optional
+	SKU string `json:"sku,omitempty"`
+	// OEMStrings are exposed to the guest as SMBIOS OEM strings (type 11),
+	// e.g. to pass provisioning hints to the guest.
+	// +optional
+	// +listType=atomic
+	OEMStrings []string `json:"oemStrings,omitempty"`
 	// Settings to set the kernel for booting.
 	// +optional
 	KernelBoot *KernelBoot `json:"kernelBoot,omitempty"`
 	// Information that can be set in the ACPI table
 	ACPI *ACPI `json:"acpi,omitempty"`
+	// FWCfg are the QEMU firmware configuration (fw_cfg) entries exposed to the guest,
+	// e.g. an ignition config or a custom early-boot provisioning blob.
+	// +optional
+	// +listType=atomic
+	FWCfg []FWCfgEntry `json:"fwCfg,omitempty"`
+}
+
+type FWCfgEntry struct {
+	// Name of the entry as seen by the guest, e.g. opt/com.coreos/config.
+	// It must start with opt/ and must not exceed 55 characters.
+	Name string `json:"name"`
+	// VolumeNameRef should match the volume name of a ConfigMap or Secret object.
+	VolumeNameRef string `json:"volumeNameRef"`
+	// Key of the ConfigMap or Secret whose data is the content of the entry.
+	Key string `json:"key"`
 }
 
 type ACPI struct {
@@ -458,8 +583,27 @@ type Devices struct {
 	// This is helpful for old machines like CentOS6 or RHEL6 which
 	// do not understand virtio_non_transitional (virtio 1.0).
 	UseVirtioTransitional *bool `json:"useVirtioTransitional,omitempty"`
+	// VirtioTransitionalDevices lists the classes of virtio devices falling back to legacy virtio 0.9 support,
+	// e.g. for guests shipping a legacy disk driver but a virtio 1.0 network driver.
+	// All virtio devices fall back to legacy virtio 0.9 support when UseVirtioTransitional is set.
+	// +optional
+	// +listType=atomic
+	VirtioTransitionalDevices []VirtioDeviceClass `json:"virtioTransitionalDevices,omitempty"`
 	// DisableHotplug disabled the ability to hotplug disks.
 	DisableHotplug bool `json:"disableHotplug,omitempty"`
+	// SCSIControllerCount is the number of virtio-scsi controllers to create.
+	// Disks and LUNs on the scsi bus can be mapped to a controller with scsiController,
+	// e.g. to exceed the queue limits of a single controller or to isolate workloads.
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	SCSIControllerCount *uint32 `json:"scsiControllerCount,omitempty"`
+	// SCSIControllerQueues is the number of request queues of each virtio-scsi controller.
+	// It allows multi-queue SCSI independently of the number of vCPUs, which is used otherwise
+	// when a disk on the scsi bus has a dedicated IOThread.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	SCSIControllerQueues *uint32 `json:"scsiControllerQueues,omitempty"`
 	// Disks describes disks, cdroms and luns which are connected to the vmi.
 	// +kubebuilder:validation:MaxItems:=256
 	Disks []Disk `json:"disks,omitempty"`
@@ -505,6 +649,9 @@ type Devices struct {
 	// If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.
 	// +optional
 	NetworkInterfaceMultiQueue *bool `json:"networkInterfaceMultiqueue,omitempty"`
+	// If specified, virtio network interfaces with multiple queues distribute the received packets across their queues with receive side scaling (RSS) and report the packet hash to the guest. These interfaces are served by QEMU instead of the vhost kernel driver. Requires networkInterfaceMultiqueue.
+	// +optional
+	NetworkInterfaceRSS *bool `json:"networkInterfaceRSS,omitempty"`
 	//Whether to attach a GPU device to the vmi.
 	// +optional
 	// +listType=atomic
@@ -536,6 +683,34 @@ type Devices struct {
 	// Video describes the video device configuration for the vmi.
 	// +optional
 	Video *VideoDevice `json:"video,omitempty"`
+	// Videos describes multiple video devices for the vmi, the first one being the primary device.
+	// It cannot be combined with video.
+	// +optional
+	// +listType=atomic
+	Videos []VideoDevice `json:"videos,omitempty"`
+	// Channels describes additional virtio-serial channels exposed to the vmi,
+	// each backed by a unix socket in the virt-launcher pod, e.g. for host-guest agents.
+	// +optional
+	// +listType=atomic
+	Channels []Channel `json:"channels,omitempty"`
+	// Balloon configures the memory reclaim behavior of the memory balloon device.
+	// +optional
+	Balloon *BalloonDevice `json:"balloon,omitempty"`
+	// SerialConsoleTargetType selects the device exposing the auto-attached serial console to the guest.
+	// One of: isa-serial, pci-serial, usb-serial.
+	// Only supported on amd64, where it defaults to isa-serial.
+	// +optional
+	SerialConsoleTargetType SerialTargetType `json:"serialConsoleTargetType,omitempty"`
+	// SGX provides an Intel SGX enclave page cache to the guest.
+	// +optional
+	SGX *SGX `json:"sgx,omitempty"`
+	// Serials describes additional serial ports exposed to the guest after the auto-attached serial console,
+	// e.g. for appliances expecting multiple UARTs.
+	// Up to 4 serial ports are supported, including the auto-attached serial console.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems:=4
+	Serials []Serial `json:"serials,omitempty"`
 }
 
 // Represent a subset of client devices that can be accessed by VMI. At the
@@ -571,6 +746,37 @@ type TPMDevice struct {
 	// Persistent indicates the state of the TPM device should be kept accross reboots
 	// Defaults to false
 	Persistent *bool `json:"persistent,omitempty"`
+	// Version is the version of the TPM specification implemented by the vTPM.
+	// One of: 1.2, 2.0. Defaults to 2.0.
+	// +optional
+	Version TPMVersion `json:"version,omitempty"`
+	// External connects the vTPM to an externally managed swtpm listening on a unix socket on the node,
+	// e.g. for clusters with a centralized vTPM key management, instead of a swtpm spawned by virt-launcher.
+	// The version is then set by the external swtpm. It cannot be combined with persistent.
+	// +optional
+	External *TPMExternal `json:"external,omitempty"`
+}
+
+type TPMVersion string
+
+const (
+	TPMVersion12 TPMVersion = "1.2"
+	TPMVersion20 TPMVersion = "2.0"
+)
+
+// TPMExternal represents an externally managed swtpm listening on a unix socket on the node.
+type TPMExternal struct {
+	// SocketPath is the absolute path of the unix socket of the swtpm on the node.
+	// It has to be in the directory of the namespace of the VMI in a permitted directory, like /var/run/vtpm/<namespace>/vm.sock.
+	// The directory of the socket is mounted into the virt-launcher pod.
+	SocketPath string `json:"socketPath"`
+}
+
+// SGX represents the Intel SGX enclave page cache (EPC) of the guest.
+type SGX struct {
+	// EPCSize is the size of the enclave page cache of the guest, it is rounded up to a multiple of 1Mi.
+	// It is allocated from the EPC of the node, which is exposed as the devices.kubevirt.io/sgx-epc resource.
+	EPCSize resource.Quantity `json:"epcSize"`
 }
 
 type VideoDevice struct {
@@ -578,6 +784,80 @@ type VideoDevice struct {
 	// If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).
 	// +optional
 	Type string `json:"type,omitempty"`
+	// Heads is the number of display heads of the video device.
+	// Defaults to 1.
+	// +optional
+	Heads *uint32 `json:"heads,omitempty"`
+	// VRAM is the size of the video memory of the video device.
+	// Defaults to 16Mi.
+	// +optional
+	VRAM *resource.Quantity `json:"vram,omitempty"`
+	// Accel3D enables the 3D acceleration of a virtio video device, either with virgl (OpenGL)
+	// or with venus (Vulkan). It requires a render node of a host GPU.
+	// +optional
+	Accel3D VideoAccel3D `json:"accel3d,omitempty"`
+}
+
+type VideoAccel3D string
+
+const (
+	VideoAccel3DVirgl VideoAccel3D = "virgl"
+	VideoAccel3DVenus VideoAccel3D = "venus"
+)
+
+// VirtioDeviceClass is a class of virtio devices sharing the choice between transitional and non-transitional models.
+type VirtioDeviceClass string
+
+const (
+	VirtioDeviceClassDisk      VirtioDeviceClass = "disk"
+	VirtioDeviceClassInterface VirtioDeviceClass = "interface"
+	// VirtioDeviceClassController covers the virtio-scsi and virtio-serial controllers.
+	VirtioDeviceClassController VirtioDeviceClass = "controller"
+	VirtioDeviceClassRng        VirtioDeviceClass = "rng"
+	VirtioDeviceClassBalloon    VirtioDeviceClass = "balloon"
+)
+
+type SerialTargetType string
+
+const (
+	SerialTargetTypeISA SerialTargetType = "isa-serial"
+	SerialTargetTypePCI SerialTargetType = "pci-serial"
+	SerialTargetTypeUSB SerialTargetType = "usb-serial"
+)
+
+// BalloonDevice represents the memory reclaim settings of the memory balloon device.
+type BalloonDevice struct {
+	// DeflateOnOOM lets the guest deflate the balloon when it runs out of memory,
+	// instead of invoking its OOM killer.
+	// Defaults to false.
+	// +optional
+	DeflateOnOOM *bool `json:"deflateOnOOM,omitempty"`
+	// DisableStatsWithoutDriver stops the balloon statistics while the guest agent reports that the
+	// guest has no balloon driver, e.g. a Windows guest without the virtio drivers, as QEMU would keep on
+	// requesting statistics which the guest never provides. They are restored once the driver is installed.
+	// Defaults to false.
+	// +optional
+	DisableStatsWithoutDriver *bool `json:"disableStatsWithoutDriver,omitempty"`
+	// FreePageReporting lets the guest report its free pages to the host so they can be reclaimed.
+	// It overrides the cluster-wide setting and the free page reporting annotation, but is always
+	// disabled for high performance vmis, e.g. with dedicated CPUs or hugepages.
+	// +optional
+	FreePageReporting *bool `json:"freePageReporting,omitempty"`
+}
+
+// Channel represents a virtio-serial channel backed by a unix socket in the virt-launcher pod.
+type Channel struct {
+	// Name is the name of the virtio-serial port seen by the guest, e.g. org.example.agent.0.
+	// The socket is created at /var/run/kubevirt-channels/<name>.sock in the virt-launcher pod. The directory
+	// is a volume shared with the hook sidecars of the vmi, which connect to the socket to talk to the guest.
+	Name string `json:"name"`
+}
+
+// Serial represents an additional serial port of the vmi, backed by a pty in the virt-launcher pod.
+type Serial struct {
+	// Name of the serial port. Its output is logged to /var/run/kubevirt-private/<vmi uid>/virt-serial-<name>-log
+	// in the virt-launcher pod.
+	Name string `json:"name"`
 }
 
 type InputBus string
@@ -585,6 +865,7 @@ type InputBus string
 const (
 	InputBusUSB    InputBus = "usb"
 	InputBusVirtio InputBus = "virtio"
+	InputBusPS2    InputBus = "ps2"
 )
 
 type InputType string
@@ -592,14 +873,15 @@ type InputType string
 const (
 	InputTypeTablet   InputType = "tablet"
 	InputTypeKeyboard InputType = "keyboard"
+	InputTypeMouse    InputType = "mouse"
 )
 
 type Input struct {
 	// Bus indicates the bus of input device to emulate.
-	// Supported values: virtio, usb.
+	// Supported values: virtio, usb, ps2.
 	Bus InputBus `json:"bus,omitempty"`
 	// Type indicated the type of input device.
-	// Supported values: tablet.
+	// Supported values: tablet, and keyboard or mouse on the ps2 bus.
 	Type InputType `json:"type"`
 	// Name is the device name
 	Name string `json:"name"`
@@ -612,7 +894,29 @@ type Filesystem struct {
 	Virtiofs *FilesystemVirtiofs `json:"virtiofs"`
 }
 
-type FilesystemVirtiofs struct{}
+type FilesystemVirtiofs struct {
+	// Cache is the caching policy of virtiofsd for the shared directory.
+	// Supported values: auto, always, metadata, never. Defaults to auto.
+	// +optional
+	Cache VirtiofsCacheMode `json:"cache,omitempty"`
+	// QueueSize is the size of the virtqueue of the filesystem device.
+	// Must be a power of 2 between 4 and 1024. Defaults to 1024.
+	// +optional
+	QueueSize *uint32 `json:"queueSize,omitempty"`
+	// Xattr enables the support of extended attributes in the shared directory.
+	// Defaults to false.
+	// +optional
+	Xattr *bool `json:"xattr,omitempty"`
+}
+
+type VirtiofsCacheMode string
+
+const (
+	VirtiofsCacheAuto     VirtiofsCacheMode = "auto"
+	VirtiofsCacheAlways   VirtiofsCacheMode = "always"
+	VirtiofsCacheMetadata VirtiofsCacheMode = "metadata"
+	VirtiofsCacheNever    VirtiofsCacheMode = "never"
+)
 
 type DownwardMetrics struct{}
 
@@ -661,7 +965,7 @@ type VGPUDisplayOptions struct {
 type PanicDevice struct {
 	// Model specifies what type of panic device is provided.
 	// The panic model used when this attribute is missing depends on the hypervisor and guest arch.
-	// One of: isa, hyperv, pvpanic.
+	// One of: isa, hyperv, pvpanic, pvpanic-pci.
 	// +optional
 	Model *PanicDeviceModel `json:"model,omitempty"`
 }
@@ -725,10 +1029,19 @@ type Disk struct {
 	// If specified, it can change the default error policy (stop) for the disk
 	// +optional
 	ErrorPolicy *DiskErrorPolicy `json:"errorPolicy,omitempty"`
+	// RerrorPolicy, if specified, overrides the error policy for read errors,
+	// e.g. to report read errors while stopping on write errors.
+	// Supported values are: stop, report, ignore. Defaults to the error policy.
+	// +optional
+	RerrorPolicy *DiskErrorPolicy `json:"rerrorPolicy,omitempty"`
 	// ChangedBlockTracking indicates this disk should have CBT option
 	// Defaults to false.
 	// +optional
 	ChangedBlockTracking *bool `json:"changedBlockTracking,omitempty"`
+	// IOTune throttles the IO of the disk, so that a single VMI cannot saturate the storage
+	// shared with other tenants.
+	// +optional
+	IOTune *DiskIOTune `json:"ioTune,omitempty"`
 }
 
 // CustomBlockSize represents the desired logical and physical block size for a VM disk.
@@ -745,6 +1058,58 @@ type BlockSize struct {
 	MatchVolume *FeatureState    `json:"matchVolume,omitempty"`
 }
 
+// DiskIOTune represents the IO throttling of a disk.
+// A total limit cannot be combined with the read or write limit of the same kind.
+type DiskIOTune struct {
+	// TotalBytesSec limits the throughput of the disk, in bytes per second.
+	// +optional
+	TotalBytesSec uint64 `json:"totalBytesSec,omitempty"`
+	// ReadBytesSec limits the read throughput of the disk, in bytes per second.
+	// +optional
+	ReadBytesSec uint64 `json:"readBytesSec,omitempty"`
+	// WriteBytesSec limits the write throughput of the disk, in bytes per second.
+	// +optional
+	WriteBytesSec uint64 `json:"writeBytesSec,omitempty"`
+	// TotalIOPSSec limits the IO operations per second of the disk.
+	// +optional
+	TotalIOPSSec uint64 `json:"totalIopsSec,omitempty"`
+	// ReadIOPSSec limits the read operations per second of the disk.
+	// +optional
+	ReadIOPSSec uint64 `json:"readIopsSec,omitempty"`
+	// WriteIOPSSec limits the write operations per second of the disk.
+	// +optional
+	WriteIOPSSec uint64 `json:"writeIopsSec,omitempty"`
+	// Burst lets the disk exceed its limits for a limited time.
+	// +optional
+	Burst *DiskIOTuneBurst `json:"burst,omitempty"`
+}
+
+// DiskIOTuneBurst represents the limits a disk may reach while bursting.
+// Each burst limit requires the matching limit of the disk and must not be lower than it.
+type DiskIOTuneBurst struct {
+	// TotalBytesSec is the throughput the disk may reach while bursting, in bytes per second.
+	// +optional
+	TotalBytesSec uint64 `json:"totalBytesSec,omitempty"`
+	// ReadBytesSec is the read throughput the disk may reach while bursting, in bytes per second.
+	// +optional
+	ReadBytesSec uint64 `json:"readBytesSec,omitempty"`
+	// WriteBytesSec is the write throughput the disk may reach while bursting, in bytes per second.
+	// +optional
+	WriteBytesSec uint64 `json:"writeBytesSec,omitempty"`
+	// TotalIOPSSec is the IO operations per second the disk may reach while bursting.
+	// +optional
+	TotalIOPSSec uint64 `json:"totalIopsSec,omitempty"`
+	// ReadIOPSSec is the read operations per second the disk may reach while bursting.
+	// +optional
+	ReadIOPSSec uint64 `json:"readIopsSec,omitempty"`
+	// WriteIOPSSec is the write operations per second the disk may reach while bursting.
+	// +optional
+	WriteIOPSSec uint64 `json:"writeIopsSec,omitempty"`
+	// LengthSeconds is the longest duration of a burst. Defaults to 1 second.
+	// +optional
+	LengthSeconds uint64 `json:"lengthSeconds,omitempty"`
+}
+
 // Represents the target of a volume to mount.
 // Only one of its members may be specified.
 type DiskDevice struct {
@@ -763,11 +1128,12 @@ const (
 	DiskBusSATA   DiskBus = "sata"
 	DiskBusVirtio DiskBus = VirtIO
 	DiskBusUSB    DiskBus = "usb"
+	DiskBusNVMe   DiskBus = "nvme"
 )
 
 type DiskTarget struct {
 	// Bus indicates the type of disk device to emulate.
-	// supported values: virtio, sata, scsi, usb.
+	// supported values: virtio, sata, scsi, usb, nvme.
 	Bus DiskBus `json:"bus,omitempty"`
 	// ReadOnly.
 	// Defaults to false.
@@ -775,6 +1141,25 @@ type DiskTarget struct {
 	// If specified, the virtual disk will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10
 	// +optional
 	PciAddress string `json:"pciAddress,omitempty"`
+	// If specified, the virtual disk will be placed on the guests CCW address with the specified device number.
+	// Only supported on s390x with the virtio bus. For example: 0.0.0001
+	// +optional
+	CCWAddress string `json:"ccwAddress,omitempty"`
+	// SCSIController is the index of the SCSI controller the disk is attached to.
+	// Only valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.
+	// +optional
+	SCSIController *uint32 `json:"scsiController,omitempty"`
+	// QueueSize is the size of each virtqueue of the disk.
+	// Only supported with the virtio bus. Must be a power of 2 between 4 and 1024.
+	// Disks on the scsi bus share the virtqueues of their virtio-scsi controller,
+	// whose size libvirt does not allow to set.
+	// +optional
+	QueueSize *uint32 `json:"queueSize,omitempty"`
+	// Zoned passes the zone semantics of a zoned block device, like a ZNS NVMe namespace,
+	// through to the guest using the virtio-blk zoned model. Only supported with the virtio bus
+	// on block volumes. Defaults to false.
+	// +optional
+	Zoned bool `json:"zoned,omitempty"`
 }
 
 type LaunchSecurity struct {
@@ -825,6 +1210,10 @@ type LunTarget struct {
 	ReadOnly bool `json:"readonly,omitempty"`
 	// Reservation indicates if the disk needs to support the persistent reservation for the SCSI disk
 	Reservation bool `json:"reservation,omitempty"`
+	// SCSIController is the index of the SCSI controller the LUN is attached to.
+	// Only valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.
+	// +optional
+	SCSIController *uint32 `json:"scsiController,omitempty"`
 }
 
 // TrayState indicates if a tray of a cdrom is open or closed.
@@ -922,6 +1311,15 @@ type VolumeSource struct {
 	DownwardMetrics *DownwardMetricsVolumeSource `json:"downwardMetrics,omitempty"`
 	// MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi
 	MemoryDump *MemoryDumpVolumeSource `json:"memoryDump,omitempty"`
+	// VhostUserBlk represents a block device served by a vhost-user-blk backend, such as SPDK,
+	// listening on a unix socket on the node.
+	// +optional
+	VhostUserBlk *VhostUserBlkVolumeSource `json:"vhostUserBlk,omitempty"`
+	// HostBlockDevice passes a raw block device of the node straight through to the vmi as a virtio disk.
+	// The path has to be permitted in the hostBlockDevices configuration of KubeVirt and the device is
+	// used exclusively by the vmi.
+	// +optional
+	HostBlockDevice *HostBlockDeviceVolumeSource `json:"hostBlockDevice,omitempty"`
 }
 
 // HotplugVolumeSource Represents the source of a volume to mount which are capable
@@ -962,6 +1360,23 @@ type MemoryDumpVolumeSource struct {
 	// Directly attached to the virt launcher
 	// +optional
 	PersistentVolumeClaimVolumeSource `json:",inline"`
+	// EncryptionPublicKey is a PEM encoded RSA public key.
+	// When set, the memory dump is encrypted with it before being written to the pvc.
+	// +optional
+	EncryptionPublicKey string `json:"encryptionPublicKey,omitempty"`
+}
+
+// VhostUserBlkVolumeSource represents a vhost-user-blk backend listening on a unix socket on the node.
+type VhostUserBlkVolumeSource struct {
+	// Path of the unix socket of the vhost-user-blk backend on the node.
+	// It has to be in the directory of the namespace of the VMI in a permitted directory, like /var/tmp/spdk/<namespace>/vhost.0.
+	Path string `json:"path"`
+}
+
+// HostBlockDeviceVolumeSource represents a raw block device of the node.
+type HostBlockDeviceVolumeSource struct {
+	// Path of the block device on the node, like /dev/nvme1n1.
+	Path string `json:"path"`
 }
 
 type EphemeralVolumeSource struct {
@@ -1046,6 +1461,15 @@ type Clock struct {
 	// Timer specifies whih timers are attached to the vmi.
 	// +optional
 	Timer *Timer `json:"timer,omitempty"`
+	// PTP exposes a virtual PTP hardware clock (kvm-ptp) to the guest, so that it can
+	// discipline its clock to the host clock through the ptp_kvm driver.
+	// Only supported on amd64, where it requires the kvm timer, and arm64.
+	// +optional
+	PTP *PTPClock `json:"ptp,omitempty"`
+}
+
+// PTPClock represents the virtual PTP hardware clock passed from host
+type PTPClock struct {
 }
 
 // Represents all available timers in a vmi.
@@ -1380,6 +1804,10 @@ type Interface struct {
 	// If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10
 	// +optional
 	PciAddress string `json:"pciAddress,omitempty"`
+	// If specified, the virtual network interface will be placed on the guests CCW address with the specified device number.
+	// Only supported on s390x. For example: 0.0.0002
+	// +optional
+	CCWAddress string `json:"ccwAddress,omitempty"`
 	// If specified the network interface will pass additional DHCP options to the VMI
 	// +optional
 	DHCPOptions *DHCPOptions `json:"dhcpOptions,omitempty"`
@@ -1399,6 +1827,81 @@ type Interface struct {
 	// Empty value functions as `up`.
 	// +optional
 	State InterfaceState `json:"state,omitempty"`
+	// Coalesce configures the interrupt coalescing of the interface, reducing the interrupt load
+	// on the vCPUs of guests handling high packet rates.
+	// Only supported by virtio interfaces backed by a tap device.
+	// +optional
+	Coalesce *InterfaceCoalesce `json:"coalesce,omitempty"`
+	// RxQueueSize is the number of descriptors of the receive virtqueues of the interface.
+	// It must be a power of 2 between 256 and 1024. Defaults to 256.
+	// Only supported by virtio interfaces.
+	// +optional
+	RxQueueSize uint32 `json:"rxQueueSize,omitempty"`
+	// TxQueueSize is the number of descriptors of the transmit virtqueues of the interface.
+	// It must be a power of 2 between 256 and 1024. Defaults to 256.
+	// Only supported by virtio interfaces, QEMU applies it to vhost-user backends only.
+	// +optional
+	TxQueueSize uint32 `json:"txQueueSize,omitempty"`
+	// Offloads toggles the checksum and segmentation offloads of the interface, e.g. for guests
+	// running their own tunneling or encapsulation stack. Unset offloads keep their default.
+	// Only supported by virtio interfaces.
+	// +optional
+	Offloads *InterfaceOffloads `json:"offloads,omitempty"`
+}
+
+// InterfaceCoalesce represents the interrupt coalescing settings of an interface.
+type InterfaceCoalesce struct {
+	// RxMaxFrames is the maximum number of received frames coalesced into a single interrupt.
+	// +kubebuilder:validation:Minimum:=1
+	RxMaxFrames uint32 `json:"rxMaxFrames"`
+}
+
+// InterfaceOffloads represents the offload toggles of a virtio-net interface.
+type InterfaceOffloads struct {
+	// Host toggles the offloads of the interface backend on the host.
+	// +optional
+	Host *InterfaceHostOffloads `json:"host,omitempty"`
+	// Guest toggles the offloads offered to the guest driver.
+	// +optional
+	Guest *InterfaceGuestOffloads `json:"guest,omitempty"`
+}
+
+// InterfaceHostOffloads represents the offloads of the host backend of a virtio-net interface.
+type InterfaceHostOffloads struct {
+	// Checksum toggles the checksum offload.
+	// +optional
+	Checksum *bool `json:"csum,omitempty"`
+	// GSO toggles the generic segmentation offload.
+	// +optional
+	GSO *bool `json:"gso,omitempty"`
+	// TSO4 toggles the TCP segmentation offload over IPv4.
+	// +optional
+	TSO4 *bool `json:"tso4,omitempty"`
+	// TSO6 toggles the TCP segmentation offload over IPv6.
+	// +optional
+	TSO6 *bool `json:"tso6,omitempty"`
+	// UFO toggles the UDP fragmentation offload.
+	// +optional
+	UFO *bool `json:"ufo,omitempty"`
+	// MergeRxBuffers toggles the mergeable receive buffers.
+	// +optional
+	MergeRxBuffers *bool `json:"mrgRxBuf,omitempty"`
+}
+
+// InterfaceGuestOffloads represents the offloads offered to the guest driver of a virtio-net interface.
+type InterfaceGuestOffloads struct {
+	// Checksum toggles the checksum offload.
+	// +optional
+	Checksum *bool `json:"csum,omitempty"`
+	// TSO4 toggles the TCP segmentation offload over IPv4.
+	// +optional
+	TSO4 *bool `json:"tso4,omitempty"`
+	// TSO6 toggles the TCP segmentation offload over IPv6.
+	// +optional
+	TSO6 *bool `json:"tso6,omitempty"`
+	// UFO toggles the UDP fragmentation offload.
+	// +optional
+	UFO *bool `json:"ufo,omitempty"`
 }
 
 type InterfaceState string
@@ -1417,12 +1920,25 @@ type DHCPOptions struct {
 	// If specified will pass option 66 to interface's DHCP server
 	// +optional
 	TFTPServerName string `json:"tftpServerName,omitempty"`
+	// If specified will be passed as the BOOTP next server address (siaddr) to the interface's DHCP server.
+	// Network boot firmwares which only look at the BOOTP header, like the s390x network boot loader,
+	// need it to locate the TFTP server. Defaults to tftpServerName when that is an IPv4 address.
+	// +optional
+	NextServer string `json:"nextServer,omitempty"`
 	// If specified will pass the configured NTP server to the VM via DHCP option 042.
 	// +optional
 	NTPServers []string `json:"ntpServers,omitempty"`
 	// If specified will pass extra DHCP options for private use, range: 224-254
 	// +optional
 	PrivateOptions []DHCPPrivateOptions `json:"privateOptions,omitempty"`
+	// If specified will pass the configured DNS servers to the VM instead of the ones of the pod.
+	// The pod itself keeps its DNS configuration. Only supported by the masquerade binding.
+	// +optional
+	DNSServers []string `json:"dnsServers,omitempty"`
+	// If specified will pass the configured search domains to the VM instead of the ones of the pod.
+	// The pod itself keeps its DNS configuration. Only supported by the masquerade binding.
+	// +optional
+	SearchDomains []string `json:"searchDomains,omitempty"`
 }
 
 func (d *DHCPOptions) UnmarshalJSON(data []byte) error {
@@ -1439,6 +1955,12 @@ func (d *DHCPOptions) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	for i, dnsServer := range dhcpOptionsAlias.DNSServers {
+		if sanitizedIP, err := sanitizeIP(dnsServer); err == nil {
+			dhcpOptionsAlias.DNSServers[i] = sanitizedIP
+		}
+	}
+
 	*d = DHCPOptions(dhcpOptionsAlias)
 	return nil
 }
@@ -1633,6 +2155,16 @@ type AccessCredential struct {
 	UserPassword *UserPasswordAccessCredential `json:"userPassword,omitempty"`
 }
 
+// GuestAgent restricts the qemu-guest-agent commands KubeVirt sends to the guest.
+type GuestAgent struct {
+	// AllowedCommands lists the guest agent commands KubeVirt may execute in the guest, e.g. guest-get-fsinfo.
+	// The other commands are refused by virt-launcher, except guest-ping and guest-info which are
+	// needed to detect the agent. All commands are allowed when guestAgent is not set.
+	// +listType=set
+	// +optional
+	AllowedCommands []string `json:"allowedCommands,omitempty"`
+}
+
 // Network represents a network type and a resource that should be connected to the vm.
 type Network struct {
 	// Network name.
@@ -1712,4 +2244,9 @@ type DiskIOThreads struct {
 	// SupplementalPoolThreadCount specifies how many iothreads are allocated for the supplementalPool policy.
 	// +optional
 	SupplementalPoolThreadCount *uint32 `json:"supplementalPoolThreadCount,omitempty"`
+	// Pinning pins iothreads to explicit host CPUs instead of the ones derived from the dedicated CPUs of the vmi.
+	// The keys are the iothread IDs, starting from 1, and the values are the cpusets, e.g. "4-5,7".
+	// The CPUs have to be allocated to the vmi. Requires dedicatedCpuPlacement.
+	// +optional
+	Pinning map[string]string `json:"pinning,omitempty"`
 }
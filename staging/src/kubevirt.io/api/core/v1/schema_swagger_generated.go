@@ -141,9 +141,10 @@ func (KernelBootContainer) SwaggerDoc() map[string]string {
 
 func (KernelBoot) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":           "Represents the firmware blob used to assist in the kernel boot process.\nUsed for setting the kernel, initrd and command line arguments",
-		"kernelArgs": "Arguments to be passed to the kernel at boot time",
-		"container":  "Container defines the container that containes kernel artifacts",
+		"":               "Represents the firmware blob used to assist in the kernel boot process.\nUsed for setting the kernel, initrd and command line arguments",
+		"kernelArgs":     "Arguments to be passed to the kernel at boot time",
+		"container":      "Container defines the container that containes kernel artifacts",
+		"rootFilesystem": "RootFilesystem is the name of a virtiofs filesystem the guest mounts as its root filesystem,\nso that it boots without any disk. The kernel arguments must not set root or rootfstype.\n+optional",
 	}
 }
 
@@ -168,6 +169,7 @@ func (CPU) SwaggerDoc() map[string]string {
 		"numa":                  "NUMA allows specifying settings for the guest NUMA topology\n+optional",
 		"isolateEmulatorThread": "IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place\nthe emulator thread on it.\n+optional",
 		"realtime":              "Realtime instructs the virt-launcher to tune the VMI for lower latency, optional for real time workloads\n+optional",
+		"cache":                 "Cache describes how the CPU cache is presented to the guest, e.g. passing the host L3 cache through.\n+optional",
 	}
 }
 
@@ -178,6 +180,14 @@ func (Realtime) SwaggerDoc() map[string]string {
 	}
 }
 
+func (CPUCache) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "CPUCache describes how the CPU cache is presented to the guest.",
+		"mode":  "Mode is the cache mode, one of passthrough, emulate or disable.",
+		"level": "Level is the cache level the mode applies to.\nOnly level 3 can be emulated or disabled, passthrough applies to all the levels.\n+optional",
+	}
+}
+
 func (NUMAGuestMappingPassthrough) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"": "NUMAGuestMappingPassthrough instructs kubevirt to model numa topology which is compatible with the CPU pinning on the guest.\nThis will result in a subset of the node numa topology being passed through, ensuring that virtual numa nodes and their memory\nnever cross boundaries coming from the node numa mapping.",
@@ -187,6 +197,31 @@ func (NUMAGuestMappingPassthrough) SwaggerDoc() map[string]string {
 func (NUMA) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"guestMappingPassthrough": "GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.\nThe created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.\n+optional",
+		"manual":                  "Manual defines the guest numa topology explicitly, independently of the host numa topology.\nIt is mutually exclusive with GuestMappingPassthrough.\n+optional",
+	}
+}
+
+func (NUMAManualTopology) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "NUMAManualTopology describes the numa nodes exposed to the guest.",
+		"nodes": "Nodes of the guest numa topology. The index of a node in the list is its numa node id.\n+listType=atomic",
+	}
+}
+
+func (NUMANode) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "NUMANode describes a guest numa node.",
+		"cpus":      "CPUs is the list of vCPUs belonging to the node, e.g. \"0-3,8\". Every vCPU must belong to exactly one node.",
+		"memory":    "Memory of the node. The memory of all the nodes must add up to the guest memory.",
+		"distances": "Distances from this node to the other nodes. An unset distance is the distance set from the other node back\nto this one, or defaults to 10 to the node itself and to 20 to the other nodes.\n+optional\n+listType=atomic",
+	}
+}
+
+func (NUMADistance) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "NUMADistance is the distance from a guest numa node to another one.",
+		"node":  "Node is the id of the other numa node.",
+		"value": "Value of the distance, between 10 and 255.",
 	}
 }
 
@@ -204,6 +239,20 @@ func (Memory) SwaggerDoc() map[string]string {
 		"hugepages": "Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.\n+optional",
 		"guest":     "Guest allows to specifying the amount of memory which is visible inside the Guest OS.\nThe Guest must lie between Requests and Limits from the resources section.\nDefaults to the requested memory in the resources section if not specified.\n+ optional",
 		"maxGuest":  "MaxGuest allows to specify the maximum amount of memory which is visible inside the Guest OS.\nThe delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.",
+		"hotplug":   "Hotplug configures how the memory between Guest and MaxGuest is hot(un)plugged.\n+optional",
+	}
+}
+
+func (MemoryHotplug) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "MemoryHotplug configures how memory is hot(un)plugged into the guest.",
+		"virtioMem": "VirtioMem plugs the memory through a virtio-mem device which is created when the VirtualMachineInstance starts.\nThe guest memory can then be grown and shrunk in blocks of BlockSize.\n+optional",
+	}
+}
+
+func (VirtioMemHotplug) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"blockSize": "BlockSize is the granularity in which memory is plugged and unplugged.\nIt must be a power of 2 and at least 2Mi, or 1Gi when 1Gi hugepages are used.\nDefaults to the smallest allowed value.\n+optional",
 	}
 }
 
@@ -217,8 +266,17 @@ func (MemoryStatus) SwaggerDoc() map[string]string {
 
 func (Hugepages) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":         "Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.",
-		"pageSize": "PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.",
+		"":           "Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.",
+		"pageSize":   "PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.",
+		"numaPolicy": "NUMAPolicy binds the hugepages backing the guest memory to host NUMA nodes,\nso that large guests do not allocate their pages across nodes.\n+optional",
+	}
+}
+
+func (HugepagesNUMAPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "HugepagesNUMAPolicy binds the hugepages of the VirtualMachineInstance to host NUMA nodes.",
+		"mode":    "Mode is the host NUMA memory allocation policy.\nOne of: strict, interleave, preferred.",
+		"nodeSet": "NodeSet is the list of host NUMA nodes the hugepages are allocated from, e.g. \"0-1,3\".\nThe nodes must be allowed by the cpuset of the virt-launcher pod.",
 	}
 }
 
@@ -233,8 +291,19 @@ func (Firmware) SwaggerDoc() map[string]string {
 		"uuid":       "UUID reported by the vmi bios.\nDefaults to a random generated uid.",
 		"bootloader": "Settings to control the bootloader that is used.\n+optional",
 		"serial":     "The system-serial-number in SMBIOS",
+		"sku":        "The system SKU number in SMBIOS, overriding the cluster-wide SMBIOS configuration.\n+optional",
+		"oemStrings": "OEMStrings are exposed to the guest as SMBIOS OEM strings (type 11),\ne.g. to pass provisioning hints to the guest.\n+optional\n+listType=atomic",
 		"kernelBoot": "Settings to set the kernel for booting.\n+optional",
 		"acpi":       "Information that can be set in the ACPI table",
+		"fwCfg":      "FWCfg are the QEMU firmware configuration (fw_cfg) entries exposed to the guest,\ne.g. an ignition config or a custom early-boot provisioning blob.\n+optional\n+listType=atomic",
+	}
+}
+
+func (FWCfgEntry) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"name":          "Name of the entry as seen by the guest, e.g. opt/com.coreos/config.\nIt must start with opt/ and must not exceed 55 characters.",
+		"volumeNameRef": "VolumeNameRef should match the volume name of a ConfigMap or Secret object.",
+		"key":           "Key of the ConfigMap or Secret whose data is the content of the entry.",
 	}
 }
 
@@ -248,7 +317,10 @@ func (ACPI) SwaggerDoc() map[string]string {
 func (Devices) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"useVirtioTransitional":      "Fall back to legacy virtio 0.9 support if virtio bus is selected on devices.\nThis is helpful for old machines like CentOS6 or RHEL6 which\ndo not understand virtio_non_transitional (virtio 1.0).",
+		"virtioTransitionalDevices":  "VirtioTransitionalDevices lists the classes of virtio devices falling back to legacy virtio 0.9 support,\ne.g. for guests shipping a legacy disk driver but a virtio 1.0 network driver.\nAll virtio devices fall back to legacy virtio 0.9 support when UseVirtioTransitional is set.\n+optional\n+listType=atomic",
 		"disableHotplug":             "DisableHotplug disabled the ability to hotplug disks.",
+		"scsiControllerCount":        "SCSIControllerCount is the number of virtio-scsi controllers to create.\nDisks and LUNs on the scsi bus can be mapped to a controller with scsiController,\ne.g. to exceed the queue limits of a single controller or to isolate workloads.\nDefaults to 1.\n+kubebuilder:validation:Minimum:=1\n+optional",
+		"scsiControllerQueues":       "SCSIControllerQueues is the number of request queues of each virtio-scsi controller.\nIt allows multi-queue SCSI independently of the number of vCPUs, which is used otherwise\nwhen a disk on the scsi bus has a dedicated IOThread.\n+kubebuilder:validation:Minimum:=1\n+optional",
 		"disks":                      "Disks describes disks, cdroms and luns which are connected to the vmi.\n+kubebuilder:validation:MaxItems:=256",
 		"watchdog":                   "Watchdog describes a watchdog device which can be added to the vmi.",
 		"interfaces":                 "Interfaces describe network interfaces which are added to the vmi.\n+kubebuilder:validation:MaxItems:=256",
@@ -263,6 +335,7 @@ func (Devices) SwaggerDoc() map[string]string {
 		"rng":                        "Whether to have random number generator from host\n+optional",
 		"blockMultiQueue":            "Whether or not to enable virtio multi-queue for block devices.\nDefaults to false.\n+optional",
 		"networkInterfaceMultiqueue": "If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.\n+optional",
+		"networkInterfaceRSS":        "If specified, virtio network interfaces with multiple queues distribute the received packets across their queues with receive side scaling (RSS) and report the packet hash to the guest. These interfaces are served by QEMU instead of the vhost kernel driver. Requires networkInterfaceMultiqueue.\n+optional",
 		"gpus":                       "Whether to attach a GPU device to the vmi.\n+optional\n+listType=atomic",
 		"downwardMetrics":            "DownwardMetrics creates a virtio serials for exposing the downward metrics to the vmi.\n+optional",
 		"panicDevices":               "PanicDevices provides additional crash information when a guest crashes.\n+optional\n+listtype=atomic",
@@ -272,6 +345,12 @@ func (Devices) SwaggerDoc() map[string]string {
 		"sound":                      "Whether to emulate a sound device.\n+optional",
 		"tpm":                        "Whether to emulate a TPM device.\n+optional",
 		"video":                      "Video describes the video device configuration for the vmi.\n+optional",
+		"videos":                     "Videos describes multiple video devices for the vmi, the first one being the primary device.\nIt cannot be combined with video.\n+optional\n+listType=atomic",
+		"channels":                   "Channels describes additional virtio-serial channels exposed to the vmi,\neach backed by a unix socket in the virt-launcher pod, e.g. for host-guest agents.\n+optional\n+listType=atomic",
+		"balloon":                    "Balloon configures the memory reclaim behavior of the memory balloon device.\n+optional",
+		"serialConsoleTargetType":    "SerialConsoleTargetType selects the device exposing the auto-attached serial console to the guest.\nOne of: isa-serial, pci-serial, usb-serial.\nOnly supported on amd64, where it defaults to isa-serial.\n+optional",
+		"sgx":                        "SGX provides an Intel SGX enclave page cache to the guest.\n+optional",
+		"serials":                    "Serials describes additional serial ports exposed to the guest after the auto-attached serial console,\ne.g. for appliances expecting multiple UARTs.\nUp to 4 serial ports are supported, including the auto-attached serial console.\n+optional\n+listType=atomic\n+kubebuilder:validation:MaxItems:=4",
 	}
 }
 
@@ -293,19 +372,61 @@ func (TPMDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"enabled":    "Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine\nDefaults to True",
 		"persistent": "Persistent indicates the state of the TPM device should be kept accross reboots\nDefaults to false",
+		"version":    "Version is the version of the TPM specification implemented by the vTPM.\nOne of: 1.2, 2.0. Defaults to 2.0.\n+optional",
+		"external":   "External connects the vTPM to an externally managed swtpm listening on a unix socket on the node,\ne.g. for clusters with a centralized vTPM key management, instead of a swtpm spawned by virt-launcher.\nThe version is then set by the external swtpm. It cannot be combined with persistent.\n+optional",
+	}
+}
+
+func (TPMExternal) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "TPMExternal represents an externally managed swtpm listening on a unix socket on the node.",
+		"socketPath": "SocketPath is the absolute path of the unix socket of the swtpm on the node.\nIt has to be in the directory of the namespace of the VMI in a permitted directory, like /var/run/vtpm/<namespace>/vm.sock.\nThe directory of the socket is mounted into the virt-launcher pod.",
+	}
+}
+
+func (SGX) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "SGX represents the Intel SGX enclave page cache (EPC) of the guest.",
+		"epcSize": "EPCSize is the size of the enclave page cache of the guest, it is rounded up to a multiple of 1Mi.\nIt is allocated from the EPC of the node, which is exposed as the devices.kubevirt.io/sgx-epc resource.",
 	}
 }
 
 func (VideoDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"type": "Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).\nIf not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).\n+optional",
+		"type":    "Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).\nIf not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).\n+optional",
+		"heads":   "Heads is the number of display heads of the video device.\nDefaults to 1.\n+optional",
+		"vram":    "VRAM is the size of the video memory of the video device.\nDefaults to 16Mi.\n+optional",
+		"accel3d": "Accel3D enables the 3D acceleration of a virtio video device, either with virgl (OpenGL)\nor with venus (Vulkan). It requires a render node of a host GPU.\n+optional",
+	}
+}
+
+func (BalloonDevice) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                          "BalloonDevice represents the memory reclaim settings of the memory balloon device.",
+		"deflateOnOOM":              "DeflateOnOOM lets the guest deflate the balloon when it runs out of memory,\ninstead of invoking its OOM killer.\nDefaults to false.\n+optional",
+		"disableStatsWithoutDriver": "DisableStatsWithoutDriver stops the balloon statistics while the guest agent reports that the\nguest has no balloon driver, e.g. a Windows guest without the virtio drivers, as QEMU would keep on\nrequesting statistics which the guest never provides. They are restored once the driver is installed.\nDefaults to false.\n+optional",
+		"freePageReporting":         "FreePageReporting lets the guest report its free pages to the host so they can be reclaimed.\nIt overrides the cluster-wide setting and the free page reporting annotation, but is always\ndisabled for high performance vmis, e.g. with dedicated CPUs or hugepages.\n+optional",
+	}
+}
+
+func (Channel) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "Channel represents a virtio-serial channel backed by a unix socket in the virt-launcher pod.",
+		"name": "Name is the name of the virtio-serial port seen by the guest, e.g. org.example.agent.0.\nThe socket is created at /var/run/kubevirt-channels/<name>.sock in the virt-launcher pod. The directory\nis a volume shared with the hook sidecars of the vmi, which connect to the socket to talk to the guest.",
+	}
+}
+
+func (Serial) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "Serial represents an additional serial port of the vmi, backed by a pty in the virt-launcher pod.",
+		"name": "Name of the serial port. Its output is logged to /var/run/kubevirt-private/<vmi uid>/virt-serial-<name>-log\nin the virt-launcher pod.",
 	}
 }
 
 func (Input) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"bus":  "Bus indicates the bus of input device to emulate.\nSupported values: virtio, usb.",
-		"type": "Type indicated the type of input device.\nSupported values: tablet.",
+		"bus":  "Bus indicates the bus of input device to emulate.\nSupported values: virtio, usb, ps2.",
+		"type": "Type indicated the type of input device.\nSupported values: tablet, and keyboard or mouse on the ps2 bus.",
 		"name": "Name is the device name",
 	}
 }
@@ -318,7 +439,11 @@ func (Filesystem) SwaggerDoc() map[string]string {
 }
 
 func (FilesystemVirtiofs) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"cache":     "Cache is the caching policy of virtiofsd for the shared directory.\nSupported values: auto, always, metadata, never. Defaults to auto.\n+optional",
+		"queueSize": "QueueSize is the size of the virtqueue of the filesystem device.\nMust be a power of 2 between 4 and 1024. Defaults to 1024.\n+optional",
+		"xattr":     "Xattr enables the support of extended attributes in the shared directory.\nDefaults to false.\n+optional",
+	}
 }
 
 func (DownwardMetrics) SwaggerDoc() map[string]string {
@@ -353,7 +478,7 @@ func (VGPUDisplayOptions) SwaggerDoc() map[string]string {
 
 func (PanicDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"model": "Model specifies what type of panic device is provided.\nThe panic model used when this attribute is missing depends on the hypervisor and guest arch.\nOne of: isa, hyperv, pvpanic.\n+optional",
+		"model": "Model specifies what type of panic device is provided.\nThe panic model used when this attribute is missing depends on the hypervisor and guest arch.\nOne of: isa, hyperv, pvpanic, pvpanic-pci.\n+optional",
 	}
 }
 
@@ -376,7 +501,9 @@ func (Disk) SwaggerDoc() map[string]string {
 		"blockSize":            "If specified, the virtual disk will be presented with the given block sizes.\n+optional",
 		"shareable":            "If specified the disk is made sharable and multiple write from different VMs are permitted\n+optional",
 		"errorPolicy":          "If specified, it can change the default error policy (stop) for the disk\n+optional",
+		"rerrorPolicy":         "RerrorPolicy, if specified, overrides the error policy for read errors,\ne.g. to report read errors while stopping on write errors.\nSupported values are: stop, report, ignore. Defaults to the error policy.\n+optional",
 		"changedBlockTracking": "ChangedBlockTracking indicates this disk should have CBT option\nDefaults to false.\n+optional",
+		"ioTune":               "IOTune throttles the IO of the disk, so that a single VMI cannot saturate the storage\nshared with other tenants.\n+optional",
 	}
 }
 
@@ -392,6 +519,32 @@ func (BlockSize) SwaggerDoc() map[string]string {
 	}
 }
 
+func (DiskIOTune) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "DiskIOTune represents the IO throttling of a disk.\nA total limit cannot be combined with the read or write limit of the same kind.",
+		"totalBytesSec": "TotalBytesSec limits the throughput of the disk, in bytes per second.\n+optional",
+		"readBytesSec":  "ReadBytesSec limits the read throughput of the disk, in bytes per second.\n+optional",
+		"writeBytesSec": "WriteBytesSec limits the write throughput of the disk, in bytes per second.\n+optional",
+		"totalIopsSec":  "TotalIOPSSec limits the IO operations per second of the disk.\n+optional",
+		"readIopsSec":   "ReadIOPSSec limits the read operations per second of the disk.\n+optional",
+		"writeIopsSec":  "WriteIOPSSec limits the write operations per second of the disk.\n+optional",
+		"burst":         "Burst lets the disk exceed its limits for a limited time.\n+optional",
+	}
+}
+
+func (DiskIOTuneBurst) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "DiskIOTuneBurst represents the limits a disk may reach while bursting.\nEach burst limit requires the matching limit of the disk and must not be lower than it.",
+		"totalBytesSec": "TotalBytesSec is the throughput the disk may reach while bursting, in bytes per second.\n+optional",
+		"readBytesSec":  "ReadBytesSec is the read throughput the disk may reach while bursting, in bytes per second.\n+optional",
+		"writeBytesSec": "WriteBytesSec is the write throughput the disk may reach while bursting, in bytes per second.\n+optional",
+		"totalIopsSec":  "TotalIOPSSec is the IO operations per second the disk may reach while bursting.\n+optional",
+		"readIopsSec":   "ReadIOPSSec is the read operations per second the disk may reach while bursting.\n+optional",
+		"writeIopsSec":  "WriteIOPSSec is the write operations per second the disk may reach while bursting.\n+optional",
+		"lengthSeconds": "LengthSeconds is the longest duration of a burst. Defaults to 1 second.\n+optional",
+	}
+}
+
 func (DiskDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":      "Represents the target of a volume to mount.\nOnly one of its members may be specified.",
@@ -403,9 +556,13 @@ func (DiskDevice) SwaggerDoc() map[string]string {
 
 func (DiskTarget) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"bus":        "Bus indicates the type of disk device to emulate.\nsupported values: virtio, sata, scsi, usb.",
-		"readonly":   "ReadOnly.\nDefaults to false.",
-		"pciAddress": "If specified, the virtual disk will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10\n+optional",
+		"bus":            "Bus indicates the type of disk device to emulate.\nsupported values: virtio, sata, scsi, usb, nvme.",
+		"readonly":       "ReadOnly.\nDefaults to false.",
+		"pciAddress":     "If specified, the virtual disk will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10\n+optional",
+		"ccwAddress":     "If specified, the virtual disk will be placed on the guests CCW address with the specified device number.\nOnly supported on s390x with the virtio bus. For example: 0.0.0001\n+optional",
+		"scsiController": "SCSIController is the index of the SCSI controller the disk is attached to.\nOnly valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.\n+optional",
+		"queueSize":      "QueueSize is the size of each virtqueue of the disk.\nOnly supported with the virtio bus. Must be a power of 2 between 4 and 1024.\nDisks on the scsi bus share the virtqueues of their virtio-scsi controller,\nwhose size libvirt does not allow to set.\n+optional",
+		"zoned":          "Zoned passes the zone semantics of a zoned block device, like a ZNS NVMe namespace,\nthrough to the guest using the virtio-blk zoned model. Only supported with the virtio bus\non block volumes. Defaults to false.\n+optional",
 	}
 }
 
@@ -446,9 +603,10 @@ func (TDX) SwaggerDoc() map[string]string {
 
 func (LunTarget) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"bus":         "Bus indicates the type of disk device to emulate.\nsupported values: virtio, sata, scsi.",
-		"readonly":    "ReadOnly.\nDefaults to false.",
-		"reservation": "Reservation indicates if the disk needs to support the persistent reservation for the SCSI disk",
+		"bus":            "Bus indicates the type of disk device to emulate.\nsupported values: virtio, sata, scsi.",
+		"readonly":       "ReadOnly.\nDefaults to false.",
+		"reservation":    "Reservation indicates if the disk needs to support the persistent reservation for the SCSI disk",
+		"scsiController": "SCSIController is the index of the SCSI controller the LUN is attached to.\nOnly valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.\n+optional",
 	}
 }
 
@@ -485,6 +643,8 @@ func (VolumeSource) SwaggerDoc() map[string]string {
 		"serviceAccount":        "ServiceAccountVolumeSource represents a reference to a service account.\nThere can only be one volume of this type!\nMore info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/\n+optional",
 		"downwardMetrics":       "DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest\nmetrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.",
 		"memoryDump":            "MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi",
+		"vhostUserBlk":          "VhostUserBlk represents a block device served by a vhost-user-blk backend, such as SPDK,\nlistening on a unix socket on the node.\n+optional",
+		"hostBlockDevice":       "HostBlockDevice passes a raw block device of the node straight through to the vmi as a virtio disk.\nThe path has to be permitted in the hostBlockDevices configuration of KubeVirt and the device is\nused exclusively by the vmi.\n+optional",
 	}
 }
 
@@ -511,7 +671,23 @@ func (PersistentVolumeClaimVolumeSource) SwaggerDoc() map[string]string {
 }
 
 func (MemoryDumpVolumeSource) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"encryptionPublicKey": "EncryptionPublicKey is a PEM encoded RSA public key.\nWhen set, the memory dump is encrypted with it before being written to the pvc.\n+optional",
+	}
+}
+
+func (VhostUserBlkVolumeSource) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "VhostUserBlkVolumeSource represents a vhost-user-blk backend listening on a unix socket on the node.",
+		"path": "Path of the unix socket of the vhost-user-blk backend on the node.\nIt has to be in the directory of the namespace of the VMI in a permitted directory, like /var/tmp/spdk/<namespace>/vhost.0.",
+	}
+}
+
+func (HostBlockDeviceVolumeSource) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "HostBlockDeviceVolumeSource represents a raw block device of the node.",
+		"path": "Path of the block device on the node, like /dev/nvme1n1.",
+	}
 }
 
 func (EphemeralVolumeSource) SwaggerDoc() map[string]string {
@@ -563,6 +739,13 @@ func (Clock) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":      "Represents the clock and timers of a vmi.\n+kubebuilder:pruning:PreserveUnknownFields",
 		"timer": "Timer specifies whih timers are attached to the vmi.\n+optional",
+		"ptp":   "PTP exposes a virtual PTP hardware clock (kvm-ptp) to the guest, so that it can\ndiscipline its clock to the host clock through the ptp_kvm driver.\nOnly supported on amd64, where it requires the kvm timer, and arm64.\n+optional",
+	}
+}
+
+func (PTPClock) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "PTPClock represents the virtual PTP hardware clock passed from host",
 	}
 }
 
@@ -725,10 +908,52 @@ func (Interface) SwaggerDoc() map[string]string {
 		"macAddress":  "Interface MAC address. For example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.",
 		"bootOrder":   "BootOrder is an integer value > 0, used to determine ordering of boot devices.\nLower values take precedence.\nEach interface or disk that has a boot order must have a unique value.\nInterfaces without a boot order are not tried.\n+optional",
 		"pciAddress":  "If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10\n+optional",
+		"ccwAddress":  "If specified, the virtual network interface will be placed on the guests CCW address with the specified device number.\nOnly supported on s390x. For example: 0.0.0002\n+optional",
 		"dhcpOptions": "If specified the network interface will pass additional DHCP options to the VMI\n+optional",
 		"tag":         "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
 		"acpiIndex":   "If specified, the ACPI index is used to provide network interface device naming, that is stable across changes\nin PCI addresses assigned to the device.\nThis value is required to be unique across all devices and be between 1 and (16*1024-1).\n+optional",
 		"state":       "State represents the requested operational state of the interface.\nThe supported values are:\n`absent`, expressing a request to remove the interface.\n`down`, expressing a request to set the link down.\n`up`, expressing a request to set the link up.\nEmpty value functions as `up`.\n+optional",
+		"coalesce":    "Coalesce configures the interrupt coalescing of the interface, reducing the interrupt load\non the vCPUs of guests handling high packet rates.\nOnly supported by virtio interfaces backed by a tap device.\n+optional",
+		"rxQueueSize": "RxQueueSize is the number of descriptors of the receive virtqueues of the interface.\nIt must be a power of 2 between 256 and 1024. Defaults to 256.\nOnly supported by virtio interfaces.\n+optional",
+		"txQueueSize": "TxQueueSize is the number of descriptors of the transmit virtqueues of the interface.\nIt must be a power of 2 between 256 and 1024. Defaults to 256.\nOnly supported by virtio interfaces, QEMU applies it to vhost-user backends only.\n+optional",
+		"offloads":    "Offloads toggles the checksum and segmentation offloads of the interface, e.g. for guests\nrunning their own tunneling or encapsulation stack. Unset offloads keep their default.\nOnly supported by virtio interfaces.\n+optional",
+	}
+}
+
+func (InterfaceCoalesce) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "InterfaceCoalesce represents the interrupt coalescing settings of an interface.",
+		"rxMaxFrames": "RxMaxFrames is the maximum number of received frames coalesced into a single interrupt.",
+	}
+}
+
+func (InterfaceOffloads) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "InterfaceOffloads represents the offload toggles of a virtio-net interface.",
+		"host":  "Host toggles the offloads of the interface backend on the host.\n+optional",
+		"guest": "Guest toggles the offloads offered to the guest driver.\n+optional",
+	}
+}
+
+func (InterfaceHostOffloads) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "InterfaceHostOffloads represents the offloads of the host backend of a virtio-net interface.",
+		"csum":     "Checksum toggles the checksum offload.\n+optional",
+		"gso":      "GSO toggles the generic segmentation offload.\n+optional",
+		"tso4":     "TSO4 toggles the TCP segmentation offload over IPv4.\n+optional",
+		"tso6":     "TSO6 toggles the TCP segmentation offload over IPv6.\n+optional",
+		"ufo":      "UFO toggles the UDP fragmentation offload.\n+optional",
+		"mrgRxBuf": "MergeRxBuffers toggles the mergeable receive buffers.\n+optional",
+	}
+}
+
+func (InterfaceGuestOffloads) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "InterfaceGuestOffloads represents the offloads offered to the guest driver of a virtio-net interface.",
+		"csum": "Checksum toggles the checksum offload.\n+optional",
+		"tso4": "TSO4 toggles the TCP segmentation offload over IPv4.\n+optional",
+		"tso6": "TSO6 toggles the TCP segmentation offload over IPv6.\n+optional",
+		"ufo":  "UFO toggles the UDP fragmentation offload.\n+optional",
 	}
 }
 
@@ -737,8 +962,11 @@ func (DHCPOptions) SwaggerDoc() map[string]string {
 		"":               "Extra DHCP options to use in the interface.",
 		"bootFileName":   "If specified will pass option 67 to interface's DHCP server\n+optional",
 		"tftpServerName": "If specified will pass option 66 to interface's DHCP server\n+optional",
+		"nextServer":     "If specified will be passed as the BOOTP next server address (siaddr) to the interface's DHCP server.\nNetwork boot firmwares which only look at the BOOTP header, like the s390x network boot loader,\nneed it to locate the TFTP server. Defaults to tftpServerName when that is an IPv4 address.\n+optional",
 		"ntpServers":     "If specified will pass the configured NTP server to the VM via DHCP option 042.\n+optional",
 		"privateOptions": "If specified will pass extra DHCP options for private use, range: 224-254\n+optional",
+		"dnsServers":     "If specified will pass the configured DNS servers to the VM instead of the ones of the pod.\nThe pod itself keeps its DNS configuration. Only supported by the masquerade binding.\n+optional",
+		"searchDomains":  "If specified will pass the configured search domains to the VM instead of the ones of the pod.\nThe pod itself keeps its DNS configuration. Only supported by the masquerade binding.\n+optional",
 	}
 }
 
@@ -896,6 +1124,13 @@ func (AccessCredential) SwaggerDoc() map[string]string {
 	}
 }
 
+func (GuestAgent) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "GuestAgent restricts the qemu-guest-agent commands KubeVirt sends to the guest.",
+		"allowedCommands": "AllowedCommands lists the guest agent commands KubeVirt may execute in the guest, e.g. guest-get-fsinfo.\nThe other commands are refused by virt-launcher, except guest-ping and guest-info which are\nneeded to detect the agent. All commands are allowed when guestAgent is not set.\n+listType=set\n+optional",
+	}
+}
+
 func (Network) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":     "Network represents a network type and a resource that should be connected to the vm.",
@@ -943,5 +1178,6 @@ func (CPUTopology) SwaggerDoc() map[string]string {
 func (DiskIOThreads) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"supplementalPoolThreadCount": "SupplementalPoolThreadCount specifies how many iothreads are allocated for the supplementalPool policy.\n+optional",
+		"pinning":                     "Pinning pins iothreads to explicit host CPUs instead of the ones derived from the dedicated CPUs of the vmi.\nThe keys are the iothread IDs, starting from 1, and the values are the cpusets, e.g. \"4-5,7\".\nThe CPUs have to be allocated to the vmi. Requires dedicatedCpuPlacement.\n+optional",
 	}
 }
@@ -77,6 +77,34 @@ const (
 	StartStrategyPaused StartStrategy = "Paused"
 )
 
+// ShutdownMethod is a way of asking the guest to power itself off.
+type ShutdownMethod string
+
+const (
+	// ShutdownMethodGuestAgent asks the guest agent to power the guest off.
+	ShutdownMethodGuestAgent ShutdownMethod = "GuestAgent"
+	// ShutdownMethodACPI presses the ACPI power button of the guest.
+	ShutdownMethodACPI ShutdownMethod = "ACPI"
+	// ShutdownMethodDestroy forcefully stops the domain once every step of the shutdown ladder timed out.
+	ShutdownMethodDestroy ShutdownMethod = "Destroy"
+)
+
+// ShutdownLadder escalates the graceful shutdown of a VirtualMachineInstance through a list of steps.
+type ShutdownLadder struct {
+	// Steps are tried in order, each one for its own timeout, before escalating to the next one.
+	// The domain is destroyed once the last step timed out.
+	// +listType=atomic
+	Steps []ShutdownStep `json:"steps"`
+}
+
+// ShutdownStep is one step of a ShutdownLadder.
+type ShutdownStep struct {
+	// Method used to ask the guest to power off, one of GuestAgent or ACPI.
+	Method ShutdownMethod `json:"method"`
+	// TimeoutSeconds is the time given to the guest to power off before escalating to the next step.
+	TimeoutSeconds int64 `json:"timeoutSeconds"`
+}
+
 // VirtualMachineInstanceSpec is a description of a VirtualMachineInstance.
 type VirtualMachineInstanceSpec struct {
 
@@ -124,6 +152,11 @@ type VirtualMachineInstanceSpec struct {
 	StartStrategy *StartStrategy `json:"startStrategy,omitempty"`
 	// Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.
 	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+	// ShutdownLadder escalates the graceful shutdown through steps with their own timeouts before the
+	// VirtualMachineInstance is force terminated. TerminationGracePeriodSeconds defaults to the sum
+	// of the step timeouts when it is not set.
+	// +optional
+	ShutdownLadder *ShutdownLadder `json:"shutdownLadder,omitempty"`
 	// List of volumes that can be mounted by disks belonging to the vmi.
 	// +kubebuilder:validation:MaxItems:=256
 	Volumes []Volume `json:"volumes,omitempty"`
@@ -169,6 +202,9 @@ type VirtualMachineInstanceSpec struct {
 	// +optional
 	// +kubebuilder:validation:MaxItems:=256
 	AccessCredentials []AccessCredential `json:"accessCredentials,omitempty"`
+	// GuestAgent restricts the qemu-guest-agent commands KubeVirt sends to the guest.
+	// +optional
+	GuestAgent *GuestAgent `json:"guestAgent,omitempty"`
 	// Specifies the architecture of the vm guest you are attempting to run. Defaults to the compiled architecture of the KubeVirt components
 	Architecture string `json:"architecture,omitempty"`
 	// ResourceClaims define which ResourceClaims must be allocated
@@ -316,6 +352,12 @@ type VirtualMachineInstanceStatus struct {
 	// takes place.
 	CurrentCPUTopology *CPUTopology `json:"currentCPUTopology,omitempty"`
 
+	// CurrentIOThreadCount specifies the number of iothreads currently allocated to the VM workload.
+	// It may differ from the supplemental pool thread count in the spec while iothreads are
+	// added or removed.
+	// +optional
+	CurrentIOThreadCount *uint32 `json:"currentIOThreadCount,omitempty"`
+
 	// Memory shows various informations about the VirtualMachine memory.
 	// +optional
 	Memory *MemoryStatus `json:"memory,omitempty"`
@@ -335,6 +377,10 @@ type VirtualMachineInstanceStatus struct {
 	// +nullable
 	// +optional
 	ChangedBlockTracking *ChangedBlockTrackingStatus `json:"changedBlockTracking,omitempty" optional:"true"`
+
+	// ShutdownMethod records the step of the shutdown ladder which brought the guest down.
+	// +optional
+	ShutdownMethod ShutdownMethod `json:"shutdownMethod,omitempty"`
 }
 
 // DeviceStatus has the information of all devices allocated spec.domain.devices
@@ -434,6 +480,10 @@ type VolumeStatus struct {
 	Name string `json:"name"`
 	// Target is the target name used when adding the volume to the VM, eg: vda
 	Target string `json:"target"`
+	// Serial is the serial number the guest sees for the disk of the volume.
+	// Hotplugged disks without a serial get one generated from the VMI UID and the volume name.
+	// +optional
+	Serial string `json:"serial,omitempty"`
 	// Phase is the phase
 	Phase VolumePhase `json:"phase,omitempty"`
 	// Reason is a brief description of why we are in the current hotplug volume phase
@@ -450,6 +500,53 @@ type VolumeStatus struct {
 	MemoryDumpVolume *DomainMemoryDumpInfo `json:"memoryDumpVolume,omitempty"`
 	// ContainerDiskVolume shows info about the containerdisk, if the volume is a containerdisk
 	ContainerDiskVolume *ContainerDiskInfo `json:"containerDiskVolume,omitempty"`
+	// IOErrors shows the IO errors reported on the volume, if any
+	IOErrors *VolumeIOErrors `json:"ioErrors,omitempty"`
+	// VhostUserBlkVolume shows info about the vhost-user-blk backend, if the volume is a vhost-user-blk volume
+	VhostUserBlkVolume *VhostUserBlkVolumeInfo `json:"vhostUserBlkVolume,omitempty"`
+	// IOThreads lists the ids of the iothreads serving the disk of the volume
+	// +listType=atomic
+	// +optional
+	IOThreads []uint32 `json:"ioThreads,omitempty"`
+	// BlockJob shows the progress of the block job running on the volume, if any,
+	// eg: a storage migration, a snapshot commit or a backup
+	// +optional
+	BlockJob *VolumeBlockJob `json:"blockJob,omitempty"`
+}
+
+// VolumeBlockJobType is the type of a block job running on a volume
+type VolumeBlockJobType string
+
+const (
+	VolumeBlockJobPull         VolumeBlockJobType = "Pull"
+	VolumeBlockJobCopy         VolumeBlockJobType = "Copy"
+	VolumeBlockJobCommit       VolumeBlockJobType = "Commit"
+	VolumeBlockJobActiveCommit VolumeBlockJobType = "ActiveCommit"
+	VolumeBlockJobBackup       VolumeBlockJobType = "Backup"
+)
+
+// VolumeBlockJob shows the progress of a long running block job on a volume
+type VolumeBlockJob struct {
+	// Type is the type of the block job, one of: Pull, Copy, Commit, ActiveCommit, Backup
+	Type VolumeBlockJobType `json:"type"`
+	// Progress is the percentage of the volume data already processed by the job
+	Progress int32 `json:"progress"`
+	// BytesPerSecond is the throughput of the job, measured between its last two samples
+	// +optional
+	BytesPerSecond int64 `json:"bytesPerSecond,omitempty"`
+	// RemainingSeconds is the estimated time until the job completes at its current throughput
+	// +optional
+	RemainingSeconds *int64 `json:"remainingSeconds,omitempty"`
+}
+
+// VolumeIOErrors shows the IO errors QEMU reported on a volume since the domain started on its current node
+type VolumeIOErrors struct {
+	// Count is the number of IO errors reported on the volume
+	Count int64 `json:"count"`
+	// LastError is the reason of the last IO error, eg: enospc
+	LastError string `json:"lastError,omitempty"`
+	// LastErrorTime is the time the last IO error was reported
+	LastErrorTime metav1.Time `json:"lastErrorTime,omitempty"`
 }
 
 // KernelInfo show info about the kernel image
@@ -498,6 +595,12 @@ type ContainerDiskInfo struct {
 	Checksum uint32 `json:"checksum,omitempty"`
 }
 
+// VhostUserBlkVolumeInfo shows info about a vhost-user-blk volume
+type VhostUserBlkVolumeInfo struct {
+	// SocketPath is the path of the unix socket of the backend inside the virt-launcher pod
+	SocketPath string `json:"socketPath"`
+}
+
 // VolumePhase indicates the current phase of the hotplug process.
 type VolumePhase string
 
@@ -738,10 +841,46 @@ const (
 
 	// VirtualMachineInstanceEvictionRequested indicates that an eviction has been requested for the VMI
 	VirtualMachineInstanceEvictionRequested VirtualMachineInstanceConditionType = "EvictionRequested"
+
+	// VirtualMachineInstanceDegraded indicates that the VMI runs, but not as expected.
+	// The reason is the most severe degradation, the message lists all of them.
+	VirtualMachineInstanceDegraded VirtualMachineInstanceConditionType = "Degraded"
+
+	// VirtualMachineInstanceGuestInitiatedShutdown indicates that the guest powered itself off,
+	// as opposed to a shutdown requested through KubeVirt or a crash.
+	VirtualMachineInstanceGuestInitiatedShutdown VirtualMachineInstanceConditionType = "GuestInitiatedShutdown"
+
+	// VirtualMachineInstanceGuestPanicked indicates that the guest kernel panicked,
+	// as reported by a panic device of the VMI.
+	VirtualMachineInstanceGuestPanicked VirtualMachineInstanceConditionType = "GuestPanicked"
+
+	// VirtualMachineInstanceGuestWatchdogFired indicates that the watchdog device of the VMI expired,
+	// because the guest stopped serving it.
+	VirtualMachineInstanceGuestWatchdogFired VirtualMachineInstanceConditionType = "GuestWatchdogFired"
 )
 
 // These are valid reasons for VMI conditions.
 const (
+	// Reason means that the VMI is paused because of an IO error
+	VirtualMachineInstanceReasonIOError = "IOError"
+	// Reason means that the guest agent of the VMI disconnected
+	VirtualMachineInstanceReasonAgentDisconnected = "AgentDisconnected"
+	// Reason means that the link of a VMI interface is down, while its desired state is up
+	VirtualMachineInstanceReasonInterfaceLinkDown = "InterfaceLinkDown"
+	// Reason means that the integrity check found corruptions in a containerDisk or ephemeral image of the VMI
+	VirtualMachineInstanceReasonImageCorrupted = "ImageCorrupted"
+	// Reason means that the backend of a vhost-user interface of the VMI, such as OVS-DPDK, is not serving its socket
+	VirtualMachineInstanceReasonVhostUserDisconnected = "VhostUserBackendDisconnected"
+	// Reason means that the guest has no driver for one of its virtio devices
+	VirtualMachineInstanceReasonGuestDriverMissing = "GuestDriverMissing"
+	// Reason means that the guest clock drifted away from the host clock
+	VirtualMachineInstanceReasonClockDrift = "GuestClockDrift"
+	// Reason means that the guest requested to power off
+	VirtualMachineInstanceReasonGuestPoweroff = "GuestPoweroff"
+	// Reason means that a panic device of the VMI reported a guest kernel panic
+	VirtualMachineInstanceReasonGuestPanic = "GuestPanic"
+	// Reason means that the watchdog device of the VMI expired
+	VirtualMachineInstanceReasonGuestWatchdog = "GuestWatchdog"
 	// Reason means that VMI is not live migratioable because of it's disks collection
 	VirtualMachineInstanceReasonDisksNotMigratable = "DisksNotLiveMigratable"
 	// Reason means that VMI is not live migratioable because of it's network interfaces collection
@@ -783,6 +922,9 @@ const (
 
 	// Indicates that an eviction has been requested for the VMI
 	VirtualMachineInstanceReasonEvictionRequested = "EvictionRequested"
+
+	// Indicates that virt-handler refused to start the VMI because it would violate the node guardrails
+	VirtualMachineInstanceReasonNodeGuardrailViolated = "NodeGuardrailViolated"
 )
 
 const (
@@ -808,6 +950,8 @@ const (
 	VirtualMachineInstanceMigrationRejectedByResourceQuota VirtualMachineInstanceMigrationConditionType = "migrationRejectedByResourceQuota"
 	// VirtualMachineInstanceMigrationBlockedByUtilityVolumes indicates that migration is waiting for utility volumes to detach
 	VirtualMachineInstanceMigrationBlockedByUtilityVolumes VirtualMachineInstanceMigrationConditionType = "migrationBlockedByUtilityVolumes"
+	// VirtualMachineInstanceMigrationRejectedByIncompatibleCPU indicates that no node provides the host-model CPU model and features of the VMI
+	VirtualMachineInstanceMigrationRejectedByIncompatibleCPU VirtualMachineInstanceMigrationConditionType = "migrationRejectedByIncompatibleCPU"
 )
 
 type VirtualMachineInstanceCondition struct {
@@ -1376,6 +1520,10 @@ const (
 	// in which freePageReporting is always disabled.
 	FreePageReportingDisabledAnnotation string = "kubevirt.io/free-page-reporting-disabled"
 
+	// MemBalloonStatsPeriodAnnotation overrides the cluster-wide period of the memory balloon statistics,
+	// in seconds, for the vmi. A period of 0 disables the statistics, e.g. for guests without a balloon driver.
+	MemBalloonStatsPeriodAnnotation string = "kubevirt.io/memballoon-stats-period"
+
 	// VirtualMachinePodCPULimitsLabel indicates VMI pod CPU resource limits
 	VirtualMachinePodCPULimitsLabel string = "kubevirt.io/vmi-pod-cpu-resource-limits"
 	// VirtualMachinePodMemoryRequestsLabel indicates VMI pod Memory resource requests
@@ -1397,6 +1545,10 @@ const (
 	// EmulatorThreadCompleteToEvenParity alpha annotation will cause Kubevirt to complete the VMI's CPU count to an even parity when IsolateEmulatorThread options are requested
 	EmulatorThreadCompleteToEvenParity string = "alpha.kubevirt.io/EmulatorThreadCompleteToEvenParity"
 
+	// GuestNTPServersAnnotation holds the comma separated NTP servers of the cluster time policy,
+	// which are passed to the guest through cloud-init vendor data.
+	GuestNTPServersAnnotation string = "kubevirt.io/guest-ntp-servers"
+
 	// VolumesUpdateMigration indicates that the migration copies and update
 	// the volumes
 	VolumesUpdateMigration string = "kubevirt.io/volume-update-migration"
@@ -1409,6 +1561,15 @@ const (
 	// This annotation might be deprecated in the future if we decided to add a struct for it.
 	DisablePCIHole64 string = "kubevirt.io/disablePCIHole64"
 
+	// PublishFeaturesOEMString indicates that the KubeVirt features enabled on a VirtualMachineInstance should be
+	// published to the guest as an SMBIOS OEM string, so that in-guest agents do not need to probe for devices.
+	PublishFeaturesOEMString string = "kubevirt.io/publishFeaturesOEMString"
+
+	// ColdplugValidation indicates that virt-launcher should verify that the devices hot-added to a running
+	// VirtualMachineInstance are part of its persistent domain definition, and add them when they are not, so they
+	// are not dropped by a guest reboot or a migration.
+	ColdplugValidation string = "kubevirt.io/coldplugValidation"
+
 	// EvictionSourceAnnotation indicates the origin of an api initiated eviction in the VirtualMachineInstance.
 	// This annotation might be empty if the source is not a recognized actor (an admin for example).
 	// This could be useful to distinguish evictions originated from the descheduler.
@@ -1983,6 +2144,47 @@ type VirtualMachineSpec struct {
 
 	// UpdateVolumesStrategy is the strategy to apply on volumes updates
 	UpdateVolumesStrategy *UpdateVolumesStrategy `json:"updateVolumesStrategy,omitempty"`
+
+	// CPUBurst raises the CPU limit of the virt-launcher pod during daily windows,
+	// e.g. while backup jobs run in the guest.
+	// +optional
+	CPUBurst *CPUBurst `json:"cpuBurst,omitempty"`
+
+	// MaintenanceSnapshot enables taking a VirtualMachineSnapshot before CPU, memory or
+	// interface hotplug and before firmware changes are applied to the running VM.
+	// +optional
+	MaintenanceSnapshot *MaintenanceSnapshotPolicy `json:"maintenanceSnapshot,omitempty"`
+}
+
+// MaintenanceSnapshotPolicy defines how maintenance snapshots are kept.
+// A maintenance snapshot is taken from the running VM before a risky change is applied,
+// and can be restored if the change fails.
+type MaintenanceSnapshotPolicy struct {
+	// SuccessWindow is how long the VM has to stay ready after the snapshot has been taken
+	// before the snapshot is garbage collected. Defaults to 24h.
+	// +optional
+	SuccessWindow *metav1.Duration `json:"successWindow,omitempty"`
+}
+
+// CPUBurst defines the windows during which the CPU limit of the virt-launcher pod is raised.
+// The pod is resized in place, so that only pods with a CPU limit and without the Guaranteed
+// QoS class can burst. The steady-state limit is restored once a window is over.
+type CPUBurst struct {
+	// CPULimit is the CPU limit of the compute container during a window.
+	CPULimit resource.Quantity `json:"cpuLimit"`
+
+	// Windows are the daily windows during which the CPU limit is raised.
+	// +listType=atomic
+	Windows []CPUBurstWindow `json:"windows"`
+}
+
+// CPUBurstWindow is a daily window.
+type CPUBurstWindow struct {
+	// Start is the time of day the window opens, in the 24-hour "15:04" format and in UTC.
+	Start string `json:"start"`
+
+	// Duration of the window, at most 24 hours.
+	Duration metav1.Duration `json:"duration"`
 }
 
 // StateChangeRequestType represents the existing state change requests that are possible
@@ -2807,6 +3009,22 @@ type EvacuateCancelOptions struct {
 	EvacuationNodeName string `json:"evacuationNodeName"`
 }
 
+// RevertOptions are provided on revert request.
+type RevertOptions struct {
+	metav1.TypeMeta `json:",inline"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty" protobuf:"bytes,1,rep,name=dryRun"`
+
+	// VirtualMachineSnapshotName is the name of the snapshot of the VirtualMachine to revert to
+	VirtualMachineSnapshotName string `json:"virtualMachineSnapshotName"`
+}
+
 // VirtualMachineInstanceGuestAgentInfo represents information from the installed guest agent
 //
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -2887,6 +3105,70 @@ type VirtualMachineInstanceFileSystem struct {
 	Disk           []VirtualMachineInstanceFileSystemDisk `json:"disk,omitempty"`
 }
 
+// VirtualMachineInstanceStats is the resource usage of a VirtualMachineInstance, sampled by virt-handler
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceStats struct {
+	metav1.TypeMeta `json:",inline"`
+	// Timestamp is the time the usage was sampled at
+	Timestamp metav1.Time `json:"timestamp"`
+	// Window is the interval the rates were measured over
+	Window metav1.Duration `json:"window"`
+	// CPU is the CPU usage of the VirtualMachineInstance
+	CPU VirtualMachineInstanceCPUStats `json:"cpu"`
+	// Memory is the memory usage of the VirtualMachineInstance
+	Memory VirtualMachineInstanceMemoryStats `json:"memory"`
+	// Disks is the throughput of the disks of the VirtualMachineInstance
+	// +optional
+	// +listType=atomic
+	Disks []VirtualMachineInstanceDiskStats `json:"disks,omitempty"`
+	// Interfaces is the throughput of the network interfaces of the VirtualMachineInstance
+	// +optional
+	// +listType=atomic
+	Interfaces []VirtualMachineInstanceInterfaceStats `json:"interfaces,omitempty"`
+}
+
+// VirtualMachineInstanceCPUStats is the CPU usage of a VirtualMachineInstance
+type VirtualMachineInstanceCPUStats struct {
+	// VCPUs is the number of vCPUs of the VirtualMachineInstance
+	VCPUs int32 `json:"vcpus"`
+	// UsageMillicores is the CPU time used by the VirtualMachineInstance, in thousandths of a CPU
+	UsageMillicores int64 `json:"usageMillicores"`
+}
+
+// VirtualMachineInstanceMemoryStats is the memory usage of a VirtualMachineInstance
+type VirtualMachineInstanceMemoryStats struct {
+	// RSSBytes is the resident set size of the VirtualMachineInstance on the node
+	// +optional
+	RSSBytes int64 `json:"rssBytes,omitempty"`
+	// GuestUsedBytes is the memory used by the guest, as reported by the balloon driver
+	// +optional
+	GuestUsedBytes int64 `json:"guestUsedBytes,omitempty"`
+	// GuestTotalBytes is the memory usable by the guest, as reported by the balloon driver
+	// +optional
+	GuestTotalBytes int64 `json:"guestTotalBytes,omitempty"`
+}
+
+// VirtualMachineInstanceDiskStats is the throughput of a disk of a VirtualMachineInstance
+type VirtualMachineInstanceDiskStats struct {
+	// Name is the name of the volume of the disk
+	Name                string `json:"name"`
+	ReadBytesPerSecond  int64  `json:"readBytesPerSecond"`
+	WriteBytesPerSecond int64  `json:"writeBytesPerSecond"`
+	ReadIOPS            int64  `json:"readIOPS"`
+	WriteIOPS           int64  `json:"writeIOPS"`
+}
+
+// VirtualMachineInstanceInterfaceStats is the throughput of a network interface of a VirtualMachineInstance
+type VirtualMachineInstanceInterfaceStats struct {
+	// Name is the name of the network interface
+	Name               string `json:"name"`
+	RxBytesPerSecond   int64  `json:"rxBytesPerSecond"`
+	TxBytesPerSecond   int64  `json:"txBytesPerSecond"`
+	RxPacketsPerSecond int64  `json:"rxPacketsPerSecond"`
+	TxPacketsPerSecond int64  `json:"txPacketsPerSecond"`
+}
+
 // FreezeUnfreezeTimeout represent the time unfreeze will be triggered if guest was not unfrozen by unfreeze command
 type FreezeUnfreezeTimeout struct {
 	UnfreezeTimeout *metav1.Duration `json:"unfreezeTimeout"`
@@ -2913,6 +3195,10 @@ type VirtualMachineMemoryDumpRequest struct {
 	// Message is a detailed message about failure of the memory dump
 	// +optional
 	Message string `json:"message,omitempty"`
+	// EncryptionPublicKey is a PEM encoded RSA public key.
+	// When set, the memory dump is encrypted with it before being written to the pvc.
+	// +optional
+	EncryptionPublicKey string `json:"encryptionPublicKey,omitempty"`
 }
 
 type MemoryDumpPhase string
@@ -2976,6 +3262,17 @@ type RemoveVolumeOptions struct {
 	DryRun []string `json:"dryRun,omitempty"`
 }
 
+// ChangeMediaOptions is provided when changing the medium of a CD-ROM of a running VMI
+type ChangeMediaOptions struct {
+	// DiskName is the name of the CD-ROM disk whose medium is changed
+	DiskName string `json:"diskName"`
+	// VolumeName is the name of the volume of the VMI which is inserted as the new medium.
+	// It has to be a persistentVolumeClaim or a dataVolume volume which is not used by any disk.
+	// The medium of the CD-ROM is ejected when it is empty.
+	// +optional
+	VolumeName string `json:"volumeName,omitempty"`
+}
+
 type TokenBucketRateLimiter struct {
 	// QPS indicates the maximum QPS to the apiserver from this client.
 	// If it's zero, the component default will be used
@@ -3088,6 +3385,35 @@ type KubeVirtConfiguration struct {
 	// Enabling changedBlockTracking is mandatory for performing storage-agnostic backups and incremental backups.
 	// +nullable
 	ChangedBlockTrackingLabelSelectors *ChangedBlockTrackingSelectors `json:"changedBlockTrackingLabelSelectors,omitempty"`
+
+	// NodeGuardrails defines per-node policies enforced by virt-handler before starting a VirtualMachineInstance.
+	// A VirtualMachineInstance that would violate them is refused instead of being started in a degraded state.
+	// +nullable
+	NodeGuardrails *NodeGuardrailsConfiguration `json:"nodeGuardrails,omitempty"`
+
+	// GuestTime defines the time synchronization policy injected into the guests.
+	// +nullable
+	GuestTime *GuestTimeConfiguration `json:"guestTime,omitempty"`
+
+	// PersistentReservation configures the pr-helper daemon serving the SCSI persistent reservations of LUNs.
+	// +nullable
+	PersistentReservation *PersistentReservationConfiguration `json:"persistentReservation,omitempty"`
+
+	// HostBlockDevices defines the block devices of the nodes VirtualMachineInstances may consume with hostBlockDevice volumes.
+	// +nullable
+	HostBlockDevices *HostBlockDevicesConfiguration `json:"hostBlockDevices,omitempty"`
+
+	// GuestCrashNotifications defines the webhook sink virt-handler notifies when a guest crashes.
+	// +nullable
+	GuestCrashNotifications *GuestCrashNotificationsConfiguration `json:"guestCrashNotifications,omitempty"`
+
+	// VhostUserBlkSockets defines the directories of the nodes the sockets of vhostUserBlk volumes may be located in.
+	// +nullable
+	VhostUserBlkSockets *HostSocketDirectoriesConfiguration `json:"vhostUserBlkSockets,omitempty"`
+
+	// ExternalTPMSockets defines the directories of the nodes the sockets of external swtpm instances may be located in.
+	// +nullable
+	ExternalTPMSockets *HostSocketDirectoriesConfiguration `json:"externalTPMSockets,omitempty"`
 }
 
 type ChangedBlockTrackingSelectors struct {
@@ -3099,6 +3425,71 @@ type ChangedBlockTrackingSelectors struct {
 	VirtualMachineLabelSelector *metav1.LabelSelector `json:"virtualMachineLabelSelector,omitempty"`
 }
 
+// NodeGuardrailsConfiguration holds the per-node policies checked by virt-handler before a
+// VirtualMachineInstance is started. When set, VirtualMachineInstances with dedicated CPUs are also
+// refused if their cpuset cannot hold the vCPUs together with the isolated emulator thread and IOThreads.
+type NodeGuardrailsConfiguration struct {
+	// MaxVirtualMachineInstances is the maximum number of running VirtualMachineInstances per node.
+	// Unlimited if not set.
+	// +optional
+	MaxVirtualMachineInstances *uint32 `json:"maxVirtualMachineInstances,omitempty"`
+	// MinHugepagesLocalityPercent is the minimal percentage of the hugepages backing a VirtualMachineInstance
+	// that has to be available on a single host NUMA node. VirtualMachineInstances whose hugepages would be
+	// fragmented across NUMA nodes beyond this threshold are refused. Disabled if not set.
+	// +optional
+	// +kubebuilder:validation:Maximum=100
+	MinHugepagesLocalityPercent *uint32 `json:"minHugepagesLocalityPercent,omitempty"`
+}
+
+// GuestTimeConfiguration holds the NTP servers guests synchronize their clock with.
+// They are offered through DHCP on bridge and masquerade interfaces which do not set their own NTP servers,
+// and through cloud-init vendor data to guests with a cloud-init volume.
+type GuestTimeConfiguration struct {
+	// NTPServers are the IPv4 addresses of the NTP servers.
+	// +listType=atomic
+	NTPServers []string `json:"ntpServers"`
+}
+
+// HostBlockDevicesConfiguration holds the allowlist of the block devices of the nodes hostBlockDevice volumes may use.
+type HostBlockDevicesConfiguration struct {
+	// PermittedPaths are the absolute paths of the block devices on the nodes which hostBlockDevice volumes
+	// may reference, like /dev/nvme1n1 or /dev/disk/by-id/nvme-eui.0025388b91b2d3a1.
+	// +listType=atomic
+	PermittedPaths []string `json:"permittedPaths"`
+}
+
+// HostSocketDirectoriesConfiguration holds the allowlist of the directories of the nodes unix sockets
+// consumed by VirtualMachineInstances may be located in. The directory of a socket is mounted into the
+// virt-launcher pod, so it has to be dedicated to the sockets.
+type HostSocketDirectoriesConfiguration struct {
+	// PermittedDirectories are the absolute paths of the directories on the nodes which may hold the
+	// sockets, like /var/tmp/spdk. The sockets of a namespace are in its directory below a permitted
+	// directory, like /var/tmp/spdk/<namespace>, or in a subdirectory of it. VMIs can't use the sockets
+	// of other namespaces.
+	// +listType=atomic
+	PermittedDirectories []string `json:"permittedDirectories"`
+}
+
+// PersistentReservationConfiguration holds the configuration of the pr-helper daemon.
+type PersistentReservationConfiguration struct {
+	// SocketPath is the absolute path of the pr-helper socket on the nodes.
+	// Defaults to /var/run/kubevirt/daemons/pr/pr-helper.sock
+	// +optional
+	SocketPath string `json:"socketPath,omitempty"`
+	// StorageClasses restricts the persistent reservations to LUNs backed by a PVC of one of the storage classes.
+	// Reservations are allowed for any storage class when empty.
+	// +optional
+	// +listType=atomic
+	StorageClasses []string `json:"storageClasses,omitempty"`
+}
+
+// GuestCrashNotificationsConfiguration holds the webhook sink guest crash notifications are posted to.
+// A notification is sent when a panic device reports a guest kernel panic and when the watchdog device of a guest expires.
+type GuestCrashNotificationsConfiguration struct {
+	// URL is the http or https endpoint the notifications are posted to as JSON.
+	URL string `json:"url"`
+}
+
 type InstancetypeConfiguration struct {
 	// ReferencePolicy defines how an instance type or preference should be referenced by the VM after submission, supported values are:
 	// reference (default) - Where a copy of the original object is stashed in a ControllerRevision and referenced by the VM.
@@ -3150,6 +3541,10 @@ type ArchSpecificConfiguration struct {
 	// +listType=atomic
 	EmulatedMachines []string `json:"emulatedMachines,omitempty,flow"`
 	MachineType      string   `json:"machineType,omitempty"`
+	// MachineTypeAliases maps an alias, e.g. stable, to the machine type it stands for, e.g. pc-q35-rhel9.6.0.
+	// A VMI requesting an alias runs with the machine type the alias resolves to when the domain is created.
+	// +optional
+	MachineTypeAliases map[string]string `json:"machineTypeAliases,omitempty"`
 }
 
 type SMBiosConfiguration struct {
@@ -3362,9 +3757,11 @@ type LogVerbosity struct {
 }
 
 const (
-	PCIResourcePrefix  = "PCI_RESOURCE"
-	MDevResourcePrefix = "MDEV_PCI_RESOURCE"
-	USBResourcePrefix  = "USB_RESOURCE"
+	PCIResourcePrefix   = "PCI_RESOURCE"
+	MDevResourcePrefix  = "MDEV_PCI_RESOURCE"
+	USBResourcePrefix   = "USB_RESOURCE"
+	InputResourcePrefix = "INPUT_RESOURCE"
+	VDPAResourcePrefix  = "VDPA_RESOURCE"
 )
 
 // PermittedHostDevices holds information about devices allowed for passthrough
@@ -3375,6 +3772,10 @@ type PermittedHostDevices struct {
 	MediatedDevices []MediatedHostDevice `json:"mediatedDevices,omitempty"`
 	// +listType=atomic
 	USB []USBHostDevice `json:"usb,omitempty"`
+	// +listType=atomic
+	InputDevices []InputHostDevice `json:"inputDevices,omitempty"`
+	// +listType=atomic
+	VDPADevices []VDPAHostDevice `json:"vdpaDevices,omitempty"`
 }
 
 type USBHostDevice struct {
@@ -3389,8 +3790,49 @@ type USBHostDevice struct {
 }
 
 type USBSelector struct {
-	Vendor  string `json:"vendor"`
+	// The vendor ID of the USB device, in hexadecimal.
+	// "*" matches any vendor.
+	Vendor string `json:"vendor"`
+	// The product ID of the USB device, in hexadecimal.
+	// "*" matches any product.
 	Product string `json:"product"`
+	// The class code of the USB device, or of one of its interfaces, in hexadecimal.
+	// e.g: 0b for smart card readers. Any class matches when not set.
+	// +optional
+	Class string `json:"class,omitempty"`
+}
+
+// InputHostDevice represents a host input (evdev) device allowed for passthrough,
+// e.g. a keyboard or a touchscreen attached to the node.
+// The device is attached to the guest as a virtio-input-host device.
+type InputHostDevice struct {
+	// The name of the resource that is representing the device. Exposed by
+	// a device plugin and requested by VMs.
+	// e.g: kubevirt.io/kiosk-keyboard
+	ResourceName string `json:"resourceName"`
+	// The path of the evdev device on the node, under /dev/input.
+	// Stable paths like /dev/input/by-id/usb-Vendor_Keyboard-event-kbd should be
+	// preferred, as /dev/input/eventX is not guaranteed to survive a reboot.
+	DevicePath string `json:"devicePath"`
+	// If true, KubeVirt will leave the allocation and monitoring to an
+	// external device plugin
+	ExternalResourceProvider bool `json:"externalResourceProvider,omitempty"`
+}
+
+// VDPAHostDevice represents the host vDPA devices allowed to back network interfaces,
+// e.g. the vDPA devices created on the VFs of a NIC.
+// The vDPA devices must be bound to the vhost_vdpa driver. They are allocated by
+// the PCI address of their parent device, as SR-IOV VFs are.
+type VDPAHostDevice struct {
+	// The vendor_id:product_id tuple of the PCI device the vDPA devices are created on
+	PCIVendorSelector string `json:"pciVendorSelector"`
+	// The name of the resource that is representing the devices. Exposed by
+	// a device plugin and requested by networks.
+	// e.g: kubevirt.io/vdpa-mlx5
+	ResourceName string `json:"resourceName"`
+	// If true, KubeVirt will leave the allocation and monitoring to an
+	// external device plugin
+	ExternalResourceProvider bool `json:"externalResourceProvider,omitempty"`
 }
 
 // PciHostDevice represents a host PCI device allowed for passthrough
@@ -3460,6 +3902,28 @@ type NetworkConfiguration struct {
 	DeprecatedPermitSlirpInterface    *bool                             `json:"permitSlirpInterface,omitempty"`
 	PermitBridgeInterfaceOnPodNetwork *bool                             `json:"permitBridgeInterfaceOnPodNetwork,omitempty"`
 	Binding                           map[string]InterfaceBindingPlugin `json:"binding,omitempty"`
+	// MasqueradeIPAM allocates the guest subnet of masquerade interfaces on the pod network,
+	// when vmNetworkCIDR or vmIPv6NetworkCIDR is not set on the VMI.
+	// +optional
+	MasqueradeIPAM *MasqueradeIPAMConfiguration `json:"masqueradeIPAM,omitempty"`
+}
+
+// MasqueradeIPAMConfiguration configures the IPAM plugin allocating guest subnets of masquerade interfaces.
+type MasqueradeIPAMConfiguration struct {
+	// Plugin is the name of the IPAM plugin allocating the guest subnets.
+	// Supported values: "pool".
+	Plugin string `json:"plugin"`
+	// IPv4Pool is the CIDR guest IPv4 subnets are allocated from.
+	// +optional
+	IPv4Pool string `json:"ipv4Pool,omitempty"`
+	// IPv6Pool is the CIDR guest IPv6 subnets are allocated from.
+	// +optional
+	IPv6Pool string `json:"ipv6Pool,omitempty"`
+	// ExcludedCIDRs are networks guests have to reach, e.g. corporate networks.
+	// Allocated guest subnets never overlap them.
+	// +listType=atomic
+	// +optional
+	ExcludedCIDRs []string `json:"excludedCIDRs,omitempty"`
 }
 
 type InterfaceBindingPlugin struct {
@@ -3473,7 +3937,7 @@ type InterfaceBindingPlugin struct {
 	// version: 1alphav1
 	NetworkAttachmentDefinition string `json:"networkAttachmentDefinition,omitempty"`
 	// DomainAttachmentType is a standard domain network attachment method kubevirt supports.
-	// Supported values: "tap", "managedTap" (since v1.4).
+	// Supported values: "tap", "managedTap" (since v1.4), "ovs", "none", "vdpa".
 	// The standard domain attachment can be used instead or in addition to the sidecarImage.
 	// version: 1alphav1
 	DomainAttachmentType DomainAttachmentType `json:"domainAttachmentType,omitempty"`
@@ -3516,6 +3980,15 @@ const (
 	// ManagedTap domain attachment type is binding an ethernet connection into guests using a tap device.
 	// The tap device is created (unless already present) on the network pod interface with a Linux bridge.
 	ManagedTap DomainAttachmentType = "managedTap"
+	// OVS domain attachment type is binding an ethernet connection into guests using a tap device
+	// which the binding CNI has already plugged into an Open vSwitch bridge.
+	OVS DomainAttachmentType = "ovs"
+	// NoAttachment domain attachment type leaves the domain interface entirely to the binding plugin sidecar.
+	NoAttachment DomainAttachmentType = "none"
+	// VDPA domain attachment type is binding a vDPA device into guests using its vhost-vdpa character device.
+	// The device path is taken from the device info the CNI reports in the network status.
+	// https://libvirt.org/formatdomain.html#vdpa-devices
+	VDPA DomainAttachmentType = "vdpa"
 )
 
 type NetworkBindingDownwardAPIType string
@@ -16,6 +16,21 @@ func (VirtualMachineInstanceList) SwaggerDoc() map[string]string {
 	}
 }
 
+func (ShutdownLadder) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "ShutdownLadder escalates the graceful shutdown of a VirtualMachineInstance through a list of steps.",
+		"steps": "Steps are tried in order, each one for its own timeout, before escalating to the next one.\nThe domain is destroyed once the last step timed out.\n+listType=atomic",
+	}
+}
+
+func (ShutdownStep) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "ShutdownStep is one step of a ShutdownLadder.",
+		"method":         "Method used to ask the guest to power off, one of GuestAgent or ACPI.",
+		"timeoutSeconds": "TimeoutSeconds is the time given to the guest to power off before escalating to the next step.",
+	}
+}
+
 func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                              "VirtualMachineInstanceSpec is a description of a VirtualMachineInstance.",
@@ -29,6 +44,7 @@ func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 		"evictionStrategy":              "EvictionStrategy describes the strategy to follow when a node drain occurs.\nThe possible options are:\n- \"None\": No action will be taken, according to the specified 'RunStrategy' the VirtualMachine will be restarted or shutdown.\n- \"LiveMigrate\": the VirtualMachineInstance will be migrated instead of being shutdown.\n- \"LiveMigrateIfPossible\": the same as \"LiveMigrate\" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as \"None\".\n- \"External\": the VirtualMachineInstance will be protected and `vmi.Status.EvacuationNodeName` will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.\n+optional",
 		"startStrategy":                 "StartStrategy can be set to \"Paused\" if Virtual Machine should be started in paused state.\n\n+optional",
 		"terminationGracePeriodSeconds": "Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.",
+		"shutdownLadder":                "ShutdownLadder escalates the graceful shutdown through steps with their own timeouts before the\nVirtualMachineInstance is force terminated. TerminationGracePeriodSeconds defaults to the sum\nof the step timeouts when it is not set.\n+optional",
 		"volumes":                       "List of volumes that can be mounted by disks belonging to the vmi.\n+kubebuilder:validation:MaxItems:=256",
 		"livenessProbe":                 "Periodic probe of VirtualMachineInstance liveness.\nVirtualmachineInstances will be stopped if the probe fails.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes\n+optional",
 		"readinessProbe":                "Periodic probe of VirtualMachineInstance service readiness.\nVirtualmachineInstances will be removed from service endpoints if the probe fails.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes\n+optional",
@@ -38,6 +54,7 @@ func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 		"dnsPolicy":                     "Set DNS policy for the pod.\nDefaults to \"ClusterFirst\".\nValid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'.\nDNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy.\nTo have DNS options set along with hostNetwork, you have to specify DNS policy\nexplicitly to 'ClusterFirstWithHostNet'.\n+optional",
 		"dnsConfig":                     "Specifies the DNS parameters of a pod.\nParameters specified here will be merged to the generated DNS\nconfiguration based on DNSPolicy.\n+optional",
 		"accessCredentials":             "Specifies a set of public keys to inject into the vm guest\n+listType=atomic\n+optional\n+kubebuilder:validation:MaxItems:=256",
+		"guestAgent":                    "GuestAgent restricts the qemu-guest-agent commands KubeVirt sends to the guest.\n+optional",
 		"architecture":                  "Specifies the architecture of the vm guest you are attempting to run. Defaults to the compiled architecture of the KubeVirt components",
 		"resourceClaims":                "ResourceClaims define which ResourceClaims must be allocated\nand reserved before the VMI, hence virt-launcher pod is allowed to start. The resources\nwill be made available to the domain which consumes them\nby name.\n\nThis is an alpha field and requires enabling the\nDynamicResourceAllocation feature gate in kubernetes\n https://kubernetes.io/docs/concepts/scheduling-eviction/dynamic-resource-allocation/\nThis field should only be configured if one of the feature-gates GPUsWithDRA or HostDevicesWithDRA is enabled.\nThis feature is in alpha.\n\n+listType=map\n+listMapKey=name\n+optional",
 		"utilityVolumes":                "List of utility volumes that can be mounted to the vmi virt-launcher pod\nwithout having a matching disk in the domain.\nUsed to collect data for various operational workflows.\n+kubebuilder:validation:MaxItems:=256\n+listType=map\n+listMapKey=name\n+optional",
@@ -83,10 +100,12 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"selinuxContext":                "SELinuxContext is the actual SELinux context of the virt-launcher pod\n+optional",
 		"machine":                       "Machine shows the final resulting qemu machine type. This can be different\nthan the machine type selected in the spec, due to qemus machine type alias mechanism.\n+optional",
 		"currentCPUTopology":            "CurrentCPUTopology specifies the current CPU topology used by the VM workload.\nCurrent topology may differ from the desired topology in the spec while CPU hotplug\ntakes place.",
+		"currentIOThreadCount":          "CurrentIOThreadCount specifies the number of iothreads currently allocated to the VM workload.\nIt may differ from the supplemental pool thread count in the spec while iothreads are\nadded or removed.\n+optional",
 		"memory":                        "Memory shows various informations about the VirtualMachine memory.\n+optional",
 		"migratedVolumes":               "MigratedVolumes lists the source and destination volumes during the volume migration\n+listType=atomic\n+optional",
 		"deviceStatus":                  "DeviceStatus reflects the state of devices requested in spec.domain.devices. This is an optional field available\nonly when DRA feature gate is enabled\nThis field will only be populated if one of the feature-gates GPUsWithDRA or HostDevicesWithDRA is enabled.\nThis feature is in alpha.\n+optional",
 		"changedBlockTracking":          "ChangedBlockTracking represents the status of the changedBlockTracking\n+nullable\n+optional",
+		"shutdownMethod":                "ShutdownMethod records the step of the shutdown ladder which brought the guest down.\n+optional",
 	}
 }
 
@@ -149,6 +168,7 @@ func (VolumeStatus) SwaggerDoc() map[string]string {
 		"":                          "VolumeStatus represents information about the status of volumes attached to the VirtualMachineInstance.",
 		"name":                      "Name is the name of the volume",
 		"target":                    "Target is the target name used when adding the volume to the VM, eg: vda",
+		"serial":                    "Serial is the serial number the guest sees for the disk of the volume.\nHotplugged disks without a serial get one generated from the VMI UID and the volume name.\n+optional",
 		"phase":                     "Phase is the phase",
 		"reason":                    "Reason is a brief description of why we are in the current hotplug volume phase",
 		"message":                   "Message is a detailed message about the current hotplug volume phase",
@@ -157,6 +177,29 @@ func (VolumeStatus) SwaggerDoc() map[string]string {
 		"size":                      "Represents the size of the volume",
 		"memoryDumpVolume":          "If the volume is memorydump volume, this will contain the memorydump info.",
 		"containerDiskVolume":       "ContainerDiskVolume shows info about the containerdisk, if the volume is a containerdisk",
+		"ioErrors":                  "IOErrors shows the IO errors reported on the volume, if any",
+		"vhostUserBlkVolume":        "VhostUserBlkVolume shows info about the vhost-user-blk backend, if the volume is a vhost-user-blk volume",
+		"ioThreads":                 "IOThreads lists the ids of the iothreads serving the disk of the volume\n+listType=atomic\n+optional",
+		"blockJob":                  "BlockJob shows the progress of the block job running on the volume, if any,\neg: a storage migration, a snapshot commit or a backup\n+optional",
+	}
+}
+
+func (VolumeBlockJob) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VolumeBlockJob shows the progress of a long running block job on a volume",
+		"type":             "Type is the type of the block job, one of: Pull, Copy, Commit, ActiveCommit, Backup",
+		"progress":         "Progress is the percentage of the volume data already processed by the job",
+		"bytesPerSecond":   "BytesPerSecond is the throughput of the job, measured between its last two samples\n+optional",
+		"remainingSeconds": "RemainingSeconds is the estimated time until the job completes at its current throughput\n+optional",
+	}
+}
+
+func (VolumeIOErrors) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "VolumeIOErrors shows the IO errors QEMU reported on a volume since the domain started on its current node",
+		"count":         "Count is the number of IO errors reported on the volume",
+		"lastError":     "LastError is the reason of the last IO error, eg: enospc",
+		"lastErrorTime": "LastErrorTime is the time the last IO error was reported",
 	}
 }
 
@@ -207,6 +250,13 @@ func (ContainerDiskInfo) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VhostUserBlkVolumeInfo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "VhostUserBlkVolumeInfo shows info about a vhost-user-blk volume",
+		"socketPath": "SocketPath is the path of the unix socket of the backend inside the virt-launcher pod",
+	}
+}
+
 func (VirtualMachineInstanceCondition) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"lastProbeTime":      "+nullable",
@@ -472,6 +522,31 @@ func (VirtualMachineSpec) SwaggerDoc() map[string]string {
 		"template":              "Template is the direct specification of VirtualMachineInstance",
 		"dataVolumeTemplates":   "dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.\nDataVolumes in this list are dynamically created for the VirtualMachine and are tied to the VirtualMachine's life-cycle.",
 		"updateVolumesStrategy": "UpdateVolumesStrategy is the strategy to apply on volumes updates",
+		"cpuBurst":              "CPUBurst raises the CPU limit of the virt-launcher pod during daily windows,\ne.g. while backup jobs run in the guest.\n+optional",
+		"maintenanceSnapshot":   "MaintenanceSnapshot enables taking a VirtualMachineSnapshot before CPU, memory or\ninterface hotplug and before firmware changes are applied to the running VM.\n+optional",
+	}
+}
+
+func (MaintenanceSnapshotPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "MaintenanceSnapshotPolicy defines how maintenance snapshots are kept.\nA maintenance snapshot is taken from the running VM before a risky change is applied,\nand can be restored if the change fails.",
+		"successWindow": "SuccessWindow is how long the VM has to stay ready after the snapshot has been taken\nbefore the snapshot is garbage collected. Defaults to 24h.\n+optional",
+	}
+}
+
+func (CPUBurst) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "CPUBurst defines the windows during which the CPU limit of the virt-launcher pod is raised.\nThe pod is resized in place, so that only pods with a CPU limit and without the Guaranteed\nQoS class can burst. The steady-state limit is restored once a window is over.",
+		"cpuLimit": "CPULimit is the CPU limit of the compute container during a window.",
+		"windows":  "Windows are the daily windows during which the CPU limit is raised.\n+listType=atomic",
+	}
+}
+
+func (CPUBurstWindow) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "CPUBurstWindow is a daily window.",
+		"start":    "Start is the time of day the window opens, in the 24-hour \"15:04\" format and in UTC.",
+		"duration": "Duration of the window, at most 24 hours.",
 	}
 }
 
@@ -769,6 +844,14 @@ func (EvacuateCancelOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (RevertOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                           "RevertOptions are provided on revert request.",
+		"dryRun":                     "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+		"virtualMachineSnapshotName": "VirtualMachineSnapshotName is the name of the snapshot of the VirtualMachine to revert to",
+	}
+}
+
 func (VirtualMachineInstanceGuestAgentInfo) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                  "VirtualMachineInstanceGuestAgentInfo represents information from the installed guest agent\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
@@ -826,6 +909,49 @@ func (VirtualMachineInstanceFileSystem) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineInstanceStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "VirtualMachineInstanceStats is the resource usage of a VirtualMachineInstance, sampled by virt-handler\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"timestamp":  "Timestamp is the time the usage was sampled at",
+		"window":     "Window is the interval the rates were measured over",
+		"cpu":        "CPU is the CPU usage of the VirtualMachineInstance",
+		"memory":     "Memory is the memory usage of the VirtualMachineInstance",
+		"disks":      "Disks is the throughput of the disks of the VirtualMachineInstance\n+optional\n+listType=atomic",
+		"interfaces": "Interfaces is the throughput of the network interfaces of the VirtualMachineInstance\n+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineInstanceCPUStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineInstanceCPUStats is the CPU usage of a VirtualMachineInstance",
+		"vcpus":           "VCPUs is the number of vCPUs of the VirtualMachineInstance",
+		"usageMillicores": "UsageMillicores is the CPU time used by the VirtualMachineInstance, in thousandths of a CPU",
+	}
+}
+
+func (VirtualMachineInstanceMemoryStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineInstanceMemoryStats is the memory usage of a VirtualMachineInstance",
+		"rssBytes":        "RSSBytes is the resident set size of the VirtualMachineInstance on the node\n+optional",
+		"guestUsedBytes":  "GuestUsedBytes is the memory used by the guest, as reported by the balloon driver\n+optional",
+		"guestTotalBytes": "GuestTotalBytes is the memory usable by the guest, as reported by the balloon driver\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceDiskStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "VirtualMachineInstanceDiskStats is the throughput of a disk of a VirtualMachineInstance",
+		"name": "Name is the name of the volume of the disk",
+	}
+}
+
+func (VirtualMachineInstanceInterfaceStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "VirtualMachineInstanceInterfaceStats is the throughput of a network interface of a VirtualMachineInstance",
+		"name": "Name is the name of the network interface",
+	}
+}
+
 func (FreezeUnfreezeTimeout) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"": "FreezeUnfreezeTimeout represent the time unfreeze will be triggered if guest was not unfrozen by unfreeze command",
@@ -834,14 +960,15 @@ func (FreezeUnfreezeTimeout) SwaggerDoc() map[string]string {
 
 func (VirtualMachineMemoryDumpRequest) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":               "VirtualMachineMemoryDumpRequest represent the memory dump request phase and info",
-		"claimName":      "ClaimName is the name of the pvc that will contain the memory dump",
-		"phase":          "Phase represents the memory dump phase",
-		"remove":         "Remove represents request of dissociating the memory dump pvc\n+optional",
-		"startTimestamp": "StartTimestamp represents the time the memory dump started\n+optional",
-		"endTimestamp":   "EndTimestamp represents the time the memory dump was completed\n+optional",
-		"fileName":       "FileName represents the name of the output file\n+optional",
-		"message":        "Message is a detailed message about failure of the memory dump\n+optional",
+		"":                    "VirtualMachineMemoryDumpRequest represent the memory dump request phase and info",
+		"claimName":           "ClaimName is the name of the pvc that will contain the memory dump",
+		"phase":               "Phase represents the memory dump phase",
+		"remove":              "Remove represents request of dissociating the memory dump pvc\n+optional",
+		"startTimestamp":      "StartTimestamp represents the time the memory dump started\n+optional",
+		"endTimestamp":        "EndTimestamp represents the time the memory dump was completed\n+optional",
+		"fileName":            "FileName represents the name of the output file\n+optional",
+		"message":             "Message is a detailed message about failure of the memory dump\n+optional",
+		"encryptionPublicKey": "EncryptionPublicKey is a PEM encoded RSA public key.\nWhen set, the memory dump is encrypted with it before being written to the pvc.\n+optional",
 	}
 }
 
@@ -871,6 +998,14 @@ func (RemoveVolumeOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (ChangeMediaOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "ChangeMediaOptions is provided when changing the medium of a CD-ROM of a running VMI",
+		"diskName":   "DiskName is the name of the CD-ROM disk whose medium is changed",
+		"volumeName": "VolumeName is the name of the volume of the VMI which is inserted as the new medium.\nIt has to be a persistentVolumeClaim or a dataVolume volume which is not used by any disk.\nThe medium of the CD-ROM is ejected when it is empty.\n+optional",
+	}
+}
+
 func (TokenBucketRateLimiter) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"qps":   "QPS indicates the maximum QPS to the apiserver from this client.\nIf it's zero, the component default will be used",
@@ -915,6 +1050,13 @@ func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 		"commonInstancetypesDeployment":      "CommonInstancetypesDeployment controls the deployment of common-instancetypes resources\n+nullable",
 		"instancetype":                       "Instancetype configuration\n+nullable",
 		"changedBlockTrackingLabelSelectors": "ChangedBlockTrackingLabelSelectors defines label selectors. VMs matching these selectors will have changed block tracking enabled.\nEnabling changedBlockTracking is mandatory for performing storage-agnostic backups and incremental backups.\n+nullable",
+		"nodeGuardrails":                     "NodeGuardrails defines per-node policies enforced by virt-handler before starting a VirtualMachineInstance.\nA VirtualMachineInstance that would violate them is refused instead of being started in a degraded state.\n+nullable",
+		"guestTime":                          "GuestTime defines the time synchronization policy injected into the guests.\n+nullable",
+		"persistentReservation":              "PersistentReservation configures the pr-helper daemon serving the SCSI persistent reservations of LUNs.\n+nullable",
+		"hostBlockDevices":                   "HostBlockDevices defines the block devices of the nodes VirtualMachineInstances may consume with hostBlockDevice volumes.\n+nullable",
+		"guestCrashNotifications":            "GuestCrashNotifications defines the webhook sink virt-handler notifies when a guest crashes.\n+nullable",
+		"vhostUserBlkSockets":                "VhostUserBlkSockets defines the directories of the nodes the sockets of vhostUserBlk volumes may be located in.\n+nullable",
+		"externalTPMSockets":                 "ExternalTPMSockets defines the directories of the nodes the sockets of external swtpm instances may be located in.\n+nullable",
 	}
 }
 
@@ -925,6 +1067,50 @@ func (ChangedBlockTrackingSelectors) SwaggerDoc() map[string]string {
 	}
 }
 
+func (NodeGuardrailsConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                            "NodeGuardrailsConfiguration holds the per-node policies checked by virt-handler before a\nVirtualMachineInstance is started. When set, VirtualMachineInstances with dedicated CPUs are also\nrefused if their cpuset cannot hold the vCPUs together with the isolated emulator thread and IOThreads.",
+		"maxVirtualMachineInstances":  "MaxVirtualMachineInstances is the maximum number of running VirtualMachineInstances per node.\nUnlimited if not set.\n+optional",
+		"minHugepagesLocalityPercent": "MinHugepagesLocalityPercent is the minimal percentage of the hugepages backing a VirtualMachineInstance\nthat has to be available on a single host NUMA node. VirtualMachineInstances whose hugepages would be\nfragmented across NUMA nodes beyond this threshold are refused. Disabled if not set.\n+optional\n+kubebuilder:validation:Maximum=100",
+	}
+}
+
+func (GuestTimeConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "GuestTimeConfiguration holds the NTP servers guests synchronize their clock with.\nThey are offered through DHCP on bridge and masquerade interfaces which do not set their own NTP servers,\nand through cloud-init vendor data to guests with a cloud-init volume.",
+		"ntpServers": "NTPServers are the IPv4 addresses of the NTP servers.\n+listType=atomic",
+	}
+}
+
+func (HostBlockDevicesConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "HostBlockDevicesConfiguration holds the allowlist of the block devices of the nodes hostBlockDevice volumes may use.",
+		"permittedPaths": "PermittedPaths are the absolute paths of the block devices on the nodes which hostBlockDevice volumes\nmay reference, like /dev/nvme1n1 or /dev/disk/by-id/nvme-eui.0025388b91b2d3a1.\n+listType=atomic",
+	}
+}
+
+func (HostSocketDirectoriesConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "HostSocketDirectoriesConfiguration holds the allowlist of the directories of the nodes unix sockets\nconsumed by VirtualMachineInstances may be located in. The directory of a socket is mounted into the\nvirt-launcher pod, so it has to be dedicated to the sockets.",
+		"permittedDirectories": "PermittedDirectories are the absolute paths of the directories on the nodes which may hold the\nsockets, like /var/tmp/spdk. The sockets of a namespace are in its directory below a permitted\ndirectory, like /var/tmp/spdk/<namespace>, or in a subdirectory of it. VMIs can't use the sockets\nof other namespaces.\n+listType=atomic",
+	}
+}
+
+func (PersistentReservationConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "PersistentReservationConfiguration holds the configuration of the pr-helper daemon.",
+		"socketPath":     "SocketPath is the absolute path of the pr-helper socket on the nodes.\nDefaults to /var/run/kubevirt/daemons/pr/pr-helper.sock\n+optional",
+		"storageClasses": "StorageClasses restricts the persistent reservations to LUNs backed by a PVC of one of the storage classes.\nReservations are allowed for any storage class when empty.\n+optional\n+listType=atomic",
+	}
+}
+
+func (GuestCrashNotificationsConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":    "GuestCrashNotificationsConfiguration holds the webhook sink guest crash notifications are posted to.\nA notification is sent when a panic device reports a guest kernel panic and when the watchdog device of a guest expires.",
+		"url": "URL is the http or https endpoint the notifications are posted to as JSON.",
+	}
+}
+
 func (InstancetypeConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"referencePolicy": "ReferencePolicy defines how an instance type or preference should be referenced by the VM after submission, supported values are:\nreference (default) - Where a copy of the original object is stashed in a ControllerRevision and referenced by the VM.\nexpand - Where the instance type or preference are expanded into the VM if no revisionNames have been populated.\nexpandAll - Where the instance type or preference are expanded into the VM regardless of revisionNames previously being populated.\n+nullable\n+kubebuilder:validation:Enum=reference;expand;expandAll",
@@ -945,7 +1131,8 @@ func (ArchConfiguration) SwaggerDoc() map[string]string {
 
 func (ArchSpecificConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"emulatedMachines": "+listType=atomic",
+		"emulatedMachines":   "+listType=atomic",
+		"machineTypeAliases": "MachineTypeAliases maps an alias, e.g. stable, to the machine type it stands for, e.g. pc-q35-rhel9.6.0.\nA VMI requesting an alias runs with the machine type the alias resolves to when the domain is created.\n+optional",
 	}
 }
 
@@ -1054,6 +1241,9 @@ func (PermittedHostDevices) SwaggerDoc() map[string]string {
 		"pciHostDevices":  "+listType=atomic",
 		"mediatedDevices": "+listType=atomic",
 		"usb":             "+listType=atomic",
+		"inputDevices":    "+listType=atomic",
+		"ptpDevices":      "+listType=atomic",
+		"vdpaDevices":     "+listType=atomic",
 	}
 }
 
@@ -1066,7 +1256,29 @@ func (USBHostDevice) SwaggerDoc() map[string]string {
 }
 
 func (USBSelector) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"vendor":  "The vendor ID of the USB device, in hexadecimal.\n\"*\" matches any vendor.",
+		"product": "The product ID of the USB device, in hexadecimal.\n\"*\" matches any product.",
+		"class":   "The class code of the USB device, or of one of its interfaces, in hexadecimal.\ne.g: 0b for smart card readers. Any class matches when not set.",
+	}
+}
+
+func (InputHostDevice) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                         "InputHostDevice represents a host input (evdev) device allowed for passthrough,\ne.g. a keyboard or a touchscreen attached to the node.\nThe device is attached to the guest as a virtio-input-host device.",
+		"resourceName":             "The name of the resource that is representing the device. Exposed by\na device plugin and requested by VMs.\ne.g: kubevirt.io/kiosk-keyboard",
+		"devicePath":               "The path of the evdev device on the node, under /dev/input.\nStable paths like /dev/input/by-id/usb-Vendor_Keyboard-event-kbd should be\npreferred, as /dev/input/eventX is not guaranteed to survive a reboot.",
+		"externalResourceProvider": "If true, KubeVirt will leave the allocation and monitoring to an\nexternal device plugin",
+	}
+}
+
+func (VDPAHostDevice) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                         "VDPAHostDevice represents the host vDPA devices allowed to back network interfaces,\ne.g. the vDPA devices created on the VFs of a NIC.\nThe vDPA devices must be bound to the vhost_vdpa driver. They are allocated by\nthe PCI address of their parent device, as SR-IOV VFs are.",
+		"pciVendorSelector":        "The vendor_id:product_id tuple of the PCI device the vDPA devices are created on",
+		"resourceName":             "The name of the resource that is representing the devices. Exposed by\na device plugin and requested by networks.\ne.g: kubevirt.io/vdpa-mlx5",
+		"externalResourceProvider": "If true, KubeVirt will leave the allocation and monitoring to an\nexternal device plugin",
+	}
 }
 
 func (PciHostDevice) SwaggerDoc() map[string]string {
@@ -1113,6 +1325,17 @@ func (NetworkConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                     "NetworkConfiguration holds network options",
 		"permitSlirpInterface": "DeprecatedPermitSlirpInterface is an alias for the deprecated PermitSlirpInterface.\nDeprecated: Removed in v1.3.",
+		"masqueradeIPAM":       "MasqueradeIPAM allocates the guest subnet of masquerade interfaces on the pod network,\nwhen vmNetworkCIDR or vmIPv6NetworkCIDR is not set on the VMI.\n+optional",
+	}
+}
+
+func (MasqueradeIPAMConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "MasqueradeIPAMConfiguration configures the IPAM plugin allocating guest subnets of masquerade interfaces.",
+		"plugin":        "Plugin is the name of the IPAM plugin allocating the guest subnets.\nSupported values: \"pool\".",
+		"ipv4Pool":      "IPv4Pool is the CIDR guest IPv4 subnets are allocated from.\n+optional",
+		"ipv6Pool":      "IPv6Pool is the CIDR guest IPv6 subnets are allocated from.\n+optional",
+		"excludedCIDRs": "ExcludedCIDRs are networks guests have to reach, e.g. corporate networks.\nAllocated guest subnets never overlap them.\n+listType=atomic\n+optional",
 	}
 }
 
@@ -1120,7 +1343,7 @@ func (InterfaceBindingPlugin) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"sidecarImage":                "SidecarImage references a container image that runs in the virt-launcher pod.\nThe sidecar handles (libvirt) domain configuration and optional services.\nversion: 1alphav1",
 		"networkAttachmentDefinition": "NetworkAttachmentDefinition references to a NetworkAttachmentDefinition CR object.\nFormat: <name>, <namespace>/<name>.\nIf namespace is not specified, VMI namespace is assumed.\nversion: 1alphav1",
-		"domainAttachmentType":        "DomainAttachmentType is a standard domain network attachment method kubevirt supports.\nSupported values: \"tap\", \"managedTap\" (since v1.4).\nThe standard domain attachment can be used instead or in addition to the sidecarImage.\nversion: 1alphav1",
+		"domainAttachmentType":        "DomainAttachmentType is a standard domain network attachment method kubevirt supports.\nSupported values: \"tap\", \"managedTap\" (since v1.4), \"ovs\", \"none\", \"vdpa\".\nThe standard domain attachment can be used instead or in addition to the sidecarImage.\nversion: 1alphav1",
 		"migration":                   "Migration means the VM using the plugin can be safely migrated\nversion: 1alphav1",
 		"downwardAPI":                 "DownwardAPI specifies what kind of data should be exposed to the binding plugin sidecar.\nSupported values: \"device-info\"\nversion: v1alphav1\n+optional",
 		"computeResourceOverhead":     "ComputeResourceOverhead specifies the resource overhead that should be added to the compute container when using the binding.\nversion: v1alphav1\n+optional",
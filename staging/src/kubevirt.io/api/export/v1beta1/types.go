@@ -157,6 +157,8 @@ const (
 	AllManifests ExportManifestType = "all"
 	// AuthHeader returns a CDI compatible secret containing the token as an Auth header
 	AuthHeader ExportManifestType = "auth-header-secret"
+	// OVA returns an OVA archive of the VM, containing an OVF descriptor and the disks as streamOptimized VMDK images
+	OVA ExportManifestType = "ova"
 )
 
 // VirtualMachineExportVolume contains the name and available formats for the exported volume
@@ -190,6 +190,11 @@ func (in *DevicePreferences) DeepCopyInto(out *DevicePreferences) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.PreferredVirtioTransitionalDevices != nil {
+		in, out := &in.PreferredVirtioTransitionalDevices, &out.PreferredVirtioTransitionalDevices
+		*out = make([]v1.VirtioDeviceClass, len(*in))
+		copy(*out, *in)
+	}
 	if in.PreferredDiskDedicatedIoThread != nil {
 		in, out := &in.PreferredDiskDedicatedIoThread, &out.PreferredDiskDedicatedIoThread
 		*out = new(bool)
@@ -353,7 +358,7 @@ func (in *MemoryInstancetype) DeepCopyInto(out *MemoryInstancetype) {
 	if in.Hugepages != nil {
 		in, out := &in.Hugepages, &out.Hugepages
 		*out = new(v1.Hugepages)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.MaxGuest != nil {
 		in, out := &in.MaxGuest, &out.MaxGuest
@@ -454,6 +454,13 @@ type DevicePreferences struct {
 	// +optional
 	PreferredUseVirtioTransitional *bool `json:"preferredUseVirtioTransitional,omitempty"`
 
+	// PreferredVirtioTransitionalDevices optionally defines the preferred value of VirtioTransitionalDevices,
+	// e.g. to mark the device classes for which an old guest only has legacy virtio 0.9 drivers.
+	//
+	// +optional
+	// +listType=atomic
+	PreferredVirtioTransitionalDevices []v1.VirtioDeviceClass `json:"preferredVirtioTransitionalDevices,omitempty"`
+
 	// PreferredInputBus optionally defines the preferred bus for Input devices.
 	//
 	// +optional
@@ -148,6 +148,7 @@ func (DevicePreferences) SwaggerDoc() map[string]string {
 		"preferredVirtualGPUOptions":          "PreferredVirtualGPUOptions optionally defines the preferred value of VirtualGPUOptions\n\n+optional",
 		"preferredSoundModel":                 "PreferredSoundModel optionally defines the preferred model for Sound devices.\n\n+optional",
 		"preferredUseVirtioTransitional":      "PreferredUseVirtioTransitional optionally defines the preferred value of UseVirtioTransitional\n\n+optional",
+		"preferredVirtioTransitionalDevices":  "PreferredVirtioTransitionalDevices optionally defines the preferred value of VirtioTransitionalDevices,\ne.g. to mark the device classes for which an old guest only has legacy virtio 0.9 drivers.\n\n+optional\n+listType=atomic",
 		"preferredInputBus":                   "PreferredInputBus optionally defines the preferred bus for Input devices.\n\n+optional",
 		"preferredInputType":                  "PreferredInputType optionally defines the preferred type for Input devices.\n\n+optional",
 		"preferredDiskBus":                    "PreferredDiskBus optionally defines the preferred bus for Disk Disk devices.\n\n+optional",
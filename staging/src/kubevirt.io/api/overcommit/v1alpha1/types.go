@@ -0,0 +1,128 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OvercommitProfile defines the CPU and memory overcommit applied to the VMIs of a group of namespaces.
+// The ratios replace the cluster wide cpuAllocationRatio and memoryOvercommit when the resources of
+// the virt-launcher pod are computed from the domain resources of a VMI.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+// +genclient:nonNamespaced
+type OvercommitProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              OvercommitProfileSpec `json:"spec" valid:"required"`
+	// +nullable
+	Status OvercommitProfileStatus `json:"status,omitempty"`
+}
+
+type OvercommitProfileSpec struct {
+	// NamespaceSelector selects the namespaces the profile applies to by their labels.
+	// When several profiles select a namespace, the one with the most labels is applied.
+	// Among profiles with as many labels, the first one in lexicographic name order is applied.
+	NamespaceSelector LabelSelector `json:"namespaceSelector"`
+
+	// CPUAllocationRatio is the number of vCPUs sharing one requested CPU.
+	// The launcher pod requests 1/CPUAllocationRatio CPU per vCPU.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	CPUAllocationRatio *uint32 `json:"cpuAllocationRatio,omitempty"`
+
+	// MemoryOvercommit is the guest memory in percent of the memory requested by the launcher pod,
+	// e.g. 150 requests two thirds of the guest memory.
+	// +kubebuilder:validation:Minimum=100
+	// +optional
+	MemoryOvercommit *uint32 `json:"memoryOvercommit,omitempty"`
+}
+
+type LabelSelector map[string]string
+
+type OvercommitProfileStatus struct {
+}
+
+// OvercommitProfileList is a list of OvercommitProfile
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type OvercommitProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []OvercommitProfile `json:"items"`
+}
+
+// VirtualizationQuota limits the virtualization resources used by the VMIs of its namespace.
+// Unlike a ResourceQuota, which counts the requests of the virt-launcher pods, it counts the
+// resources of the domains, which exceed the pod requests of overcommitted VMIs.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+type VirtualizationQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              VirtualizationQuotaSpec `json:"spec" valid:"required"`
+	// +nullable
+	Status VirtualizationQuotaStatus `json:"status,omitempty"`
+}
+
+const (
+	// ResourceVCPUs is the number of vCPUs of the VMIs
+	ResourceVCPUs k8sv1.ResourceName = "vcpus"
+	// ResourceGuestMemory is the guest memory of the VMIs
+	ResourceGuestMemory k8sv1.ResourceName = "guestMemory"
+	// ResourceGPUs is the number of GPUs assigned to the VMIs
+	ResourceGPUs k8sv1.ResourceName = "gpus"
+	// ResourceHostDevices is the number of host devices assigned to the VMIs
+	ResourceHostDevices k8sv1.ResourceName = "hostDevices"
+)
+
+type VirtualizationQuotaSpec struct {
+	// Hard is the amount of each resource the VMIs of the namespace may use together.
+	// Supported resources are vcpus, guestMemory, gpus and hostDevices.
+	Hard k8sv1.ResourceList `json:"hard"`
+}
+
+type VirtualizationQuotaStatus struct {
+	// Hard is the amount of each resource enforced by the quota.
+	// +optional
+	Hard k8sv1.ResourceList `json:"hard,omitempty"`
+	// Used is the amount of each resource used by the VMIs of the namespace which are not in a final phase.
+	// +optional
+	Used k8sv1.ResourceList `json:"used,omitempty"`
+}
+
+// VirtualizationQuotaList is a list of VirtualizationQuota
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualizationQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []VirtualizationQuota `json:"items"`
+}
@@ -0,0 +1,56 @@
+// Code generated by swagger-doc. DO NOT EDIT.
+
+package v1alpha1
+
+func (OvercommitProfile) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "OvercommitProfile defines the CPU and memory overcommit applied to the VMIs of a group of namespaces.\nThe ratios replace the cluster wide cpuAllocationRatio and memoryOvercommit when the resources of\nthe virt-launcher pod are computed from the domain resources of a VMI.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+k8s:openapi-gen=true\n+genclient\n+genclient:nonNamespaced",
+		"status": "+nullable",
+	}
+}
+
+func (OvercommitProfileSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"namespaceSelector":  "NamespaceSelector selects the namespaces the profile applies to by their labels.\nWhen several profiles select a namespace, the one with the most labels is applied.\nAmong profiles with as many labels, the first one in lexicographic name order is applied.",
+		"cpuAllocationRatio": "CPUAllocationRatio is the number of vCPUs sharing one requested CPU.\nThe launcher pod requests 1/CPUAllocationRatio CPU per vCPU.\n+kubebuilder:validation:Minimum=1\n+optional",
+		"memoryOvercommit":   "MemoryOvercommit is the guest memory in percent of the memory requested by the launcher pod,\ne.g. 150 requests two thirds of the guest memory.\n+kubebuilder:validation:Minimum=100\n+optional",
+	}
+}
+
+func (OvercommitProfileStatus) SwaggerDoc() map[string]string {
+	return map[string]string{}
+}
+
+func (OvercommitProfileList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "OvercommitProfileList is a list of OvercommitProfile\n\n+k8s:openapi-gen=true\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"items": "+listType=atomic",
+	}
+}
+
+func (VirtualizationQuota) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualizationQuota limits the virtualization resources used by the VMIs of its namespace.\nUnlike a ResourceQuota, which counts the requests of the virt-launcher pods, it counts the\nresources of the domains, which exceed the pod requests of overcommitted VMIs.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+k8s:openapi-gen=true\n+genclient",
+		"status": "+nullable",
+	}
+}
+
+func (VirtualizationQuotaSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"hard": "Hard is the amount of each resource the VMIs of the namespace may use together.\nSupported resources are vcpus, guestMemory, gpus and hostDevices.",
+	}
+}
+
+func (VirtualizationQuotaStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"hard": "Hard is the amount of each resource enforced by the quota.\n+optional",
+		"used": "Used is the amount of each resource used by the VMIs of the namespace which are not in a final phase.\n+optional",
+	}
+}
+
+func (VirtualizationQuotaList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "VirtualizationQuotaList is a list of VirtualizationQuota\n\n+k8s:openapi-gen=true\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"items": "+listType=atomic",
+	}
+}
@@ -351,15 +351,21 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.ArchSpecificConfiguration":                                               schema_kubevirtio_api_core_v1_ArchSpecificConfiguration(ref),
 		"kubevirt.io/api/core/v1.AuthorizedKeysFile":                                                      schema_kubevirtio_api_core_v1_AuthorizedKeysFile(ref),
 		"kubevirt.io/api/core/v1.BIOS":                                                                    schema_kubevirtio_api_core_v1_BIOS(ref),
+		"kubevirt.io/api/core/v1.BalloonDevice":                                                           schema_kubevirtio_api_core_v1_BalloonDevice(ref),
 		"kubevirt.io/api/core/v1.BlockSize":                                                               schema_kubevirtio_api_core_v1_BlockSize(ref),
 		"kubevirt.io/api/core/v1.Bootloader":                                                              schema_kubevirtio_api_core_v1_Bootloader(ref),
 		"kubevirt.io/api/core/v1.CDRomTarget":                                                             schema_kubevirtio_api_core_v1_CDRomTarget(ref),
 		"kubevirt.io/api/core/v1.CPU":                                                                     schema_kubevirtio_api_core_v1_CPU(ref),
+		"kubevirt.io/api/core/v1.CPUBurst":                                                                schema_kubevirtio_api_core_v1_CPUBurst(ref),
+		"kubevirt.io/api/core/v1.CPUBurstWindow":                                                          schema_kubevirtio_api_core_v1_CPUBurstWindow(ref),
+		"kubevirt.io/api/core/v1.CPUCache":                                                                schema_kubevirtio_api_core_v1_CPUCache(ref),
 		"kubevirt.io/api/core/v1.CPUFeature":                                                              schema_kubevirtio_api_core_v1_CPUFeature(ref),
 		"kubevirt.io/api/core/v1.CPUTopology":                                                             schema_kubevirtio_api_core_v1_CPUTopology(ref),
 		"kubevirt.io/api/core/v1.CertConfig":                                                              schema_kubevirtio_api_core_v1_CertConfig(ref),
+		"kubevirt.io/api/core/v1.ChangeMediaOptions":                                                      schema_kubevirtio_api_core_v1_ChangeMediaOptions(ref),
 		"kubevirt.io/api/core/v1.ChangedBlockTrackingSelectors":                                           schema_kubevirtio_api_core_v1_ChangedBlockTrackingSelectors(ref),
 		"kubevirt.io/api/core/v1.ChangedBlockTrackingStatus":                                              schema_kubevirtio_api_core_v1_ChangedBlockTrackingStatus(ref),
+		"kubevirt.io/api/core/v1.Channel":                                                                 schema_kubevirtio_api_core_v1_Channel(ref),
 		"kubevirt.io/api/core/v1.Chassis":                                                                 schema_kubevirtio_api_core_v1_Chassis(ref),
 		"kubevirt.io/api/core/v1.ClaimRequest":                                                            schema_kubevirtio_api_core_v1_ClaimRequest(ref),
 		"kubevirt.io/api/core/v1.ClientPassthroughDevices":                                                schema_kubevirtio_api_core_v1_ClientPassthroughDevices(ref),
@@ -401,6 +407,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.Disk":                                                                    schema_kubevirtio_api_core_v1_Disk(ref),
 		"kubevirt.io/api/core/v1.DiskDevice":                                                              schema_kubevirtio_api_core_v1_DiskDevice(ref),
 		"kubevirt.io/api/core/v1.DiskIOThreads":                                                           schema_kubevirtio_api_core_v1_DiskIOThreads(ref),
+		"kubevirt.io/api/core/v1.DiskIOTune":                                                              schema_kubevirtio_api_core_v1_DiskIOTune(ref),
+		"kubevirt.io/api/core/v1.DiskIOTuneBurst":                                                         schema_kubevirtio_api_core_v1_DiskIOTuneBurst(ref),
 		"kubevirt.io/api/core/v1.DiskTarget":                                                              schema_kubevirtio_api_core_v1_DiskTarget(ref),
 		"kubevirt.io/api/core/v1.DiskVerification":                                                        schema_kubevirtio_api_core_v1_DiskVerification(ref),
 		"kubevirt.io/api/core/v1.DomainMemoryDumpInfo":                                                    schema_kubevirtio_api_core_v1_DomainMemoryDumpInfo(ref),
@@ -412,6 +420,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.EmptyDiskSource":                                                         schema_kubevirtio_api_core_v1_EmptyDiskSource(ref),
 		"kubevirt.io/api/core/v1.EphemeralVolumeSource":                                                   schema_kubevirtio_api_core_v1_EphemeralVolumeSource(ref),
 		"kubevirt.io/api/core/v1.EvacuateCancelOptions":                                                   schema_kubevirtio_api_core_v1_EvacuateCancelOptions(ref),
+		"kubevirt.io/api/core/v1.FWCfgEntry":                                                              schema_kubevirtio_api_core_v1_FWCfgEntry(ref),
 		"kubevirt.io/api/core/v1.FeatureAPIC":                                                             schema_kubevirtio_api_core_v1_FeatureAPIC(ref),
 		"kubevirt.io/api/core/v1.FeatureHyperv":                                                           schema_kubevirtio_api_core_v1_FeatureHyperv(ref),
 		"kubevirt.io/api/core/v1.FeatureKVM":                                                              schema_kubevirtio_api_core_v1_FeatureKVM(ref),
@@ -426,20 +435,28 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.FreezeUnfreezeTimeout":                                                   schema_kubevirtio_api_core_v1_FreezeUnfreezeTimeout(ref),
 		"kubevirt.io/api/core/v1.GPU":                                                                     schema_kubevirtio_api_core_v1_GPU(ref),
 		"kubevirt.io/api/core/v1.GenerationStatus":                                                        schema_kubevirtio_api_core_v1_GenerationStatus(ref),
+		"kubevirt.io/api/core/v1.GuestAgent":                                                              schema_kubevirtio_api_core_v1_GuestAgent(ref),
 		"kubevirt.io/api/core/v1.GuestAgentCommandInfo":                                                   schema_kubevirtio_api_core_v1_GuestAgentCommandInfo(ref),
 		"kubevirt.io/api/core/v1.GuestAgentPing":                                                          schema_kubevirtio_api_core_v1_GuestAgentPing(ref),
+		"kubevirt.io/api/core/v1.GuestCrashNotificationsConfiguration":                                    schema_kubevirtio_api_core_v1_GuestCrashNotificationsConfiguration(ref),
+		"kubevirt.io/api/core/v1.GuestTimeConfiguration":                                                  schema_kubevirtio_api_core_v1_GuestTimeConfiguration(ref),
 		"kubevirt.io/api/core/v1.HPETTimer":                                                               schema_kubevirtio_api_core_v1_HPETTimer(ref),
 		"kubevirt.io/api/core/v1.Handler":                                                                 schema_kubevirtio_api_core_v1_Handler(ref),
+		"kubevirt.io/api/core/v1.HostBlockDeviceVolumeSource":                                             schema_kubevirtio_api_core_v1_HostBlockDeviceVolumeSource(ref),
+		"kubevirt.io/api/core/v1.HostBlockDevicesConfiguration":                                           schema_kubevirtio_api_core_v1_HostBlockDevicesConfiguration(ref),
 		"kubevirt.io/api/core/v1.HostDevice":                                                              schema_kubevirtio_api_core_v1_HostDevice(ref),
 		"kubevirt.io/api/core/v1.HostDisk":                                                                schema_kubevirtio_api_core_v1_HostDisk(ref),
+		"kubevirt.io/api/core/v1.HostSocketDirectoriesConfiguration":                                      schema_kubevirtio_api_core_v1_HostSocketDirectoriesConfiguration(ref),
 		"kubevirt.io/api/core/v1.HotplugVolumeSource":                                                     schema_kubevirtio_api_core_v1_HotplugVolumeSource(ref),
 		"kubevirt.io/api/core/v1.HotplugVolumeStatus":                                                     schema_kubevirtio_api_core_v1_HotplugVolumeStatus(ref),
 		"kubevirt.io/api/core/v1.Hugepages":                                                               schema_kubevirtio_api_core_v1_Hugepages(ref),
+		"kubevirt.io/api/core/v1.HugepagesNUMAPolicy":                                                     schema_kubevirtio_api_core_v1_HugepagesNUMAPolicy(ref),
 		"kubevirt.io/api/core/v1.HyperVPassthrough":                                                       schema_kubevirtio_api_core_v1_HyperVPassthrough(ref),
 		"kubevirt.io/api/core/v1.HypervTimer":                                                             schema_kubevirtio_api_core_v1_HypervTimer(ref),
 		"kubevirt.io/api/core/v1.I6300ESBWatchdog":                                                        schema_kubevirtio_api_core_v1_I6300ESBWatchdog(ref),
 		"kubevirt.io/api/core/v1.InitrdInfo":                                                              schema_kubevirtio_api_core_v1_InitrdInfo(ref),
 		"kubevirt.io/api/core/v1.Input":                                                                   schema_kubevirtio_api_core_v1_Input(ref),
+		"kubevirt.io/api/core/v1.InputHostDevice":                                                         schema_kubevirtio_api_core_v1_InputHostDevice(ref),
 		"kubevirt.io/api/core/v1.InstancetypeConfiguration":                                               schema_kubevirtio_api_core_v1_InstancetypeConfiguration(ref),
 		"kubevirt.io/api/core/v1.InstancetypeMatcher":                                                     schema_kubevirtio_api_core_v1_InstancetypeMatcher(ref),
 		"kubevirt.io/api/core/v1.InstancetypeStatusRef":                                                   schema_kubevirtio_api_core_v1_InstancetypeStatusRef(ref),
@@ -448,7 +465,11 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.InterfaceBindingMigration":                                               schema_kubevirtio_api_core_v1_InterfaceBindingMigration(ref),
 		"kubevirt.io/api/core/v1.InterfaceBindingPlugin":                                                  schema_kubevirtio_api_core_v1_InterfaceBindingPlugin(ref),
 		"kubevirt.io/api/core/v1.InterfaceBridge":                                                         schema_kubevirtio_api_core_v1_InterfaceBridge(ref),
+		"kubevirt.io/api/core/v1.InterfaceCoalesce":                                                       schema_kubevirtio_api_core_v1_InterfaceCoalesce(ref),
+		"kubevirt.io/api/core/v1.InterfaceGuestOffloads":                                                  schema_kubevirtio_api_core_v1_InterfaceGuestOffloads(ref),
+		"kubevirt.io/api/core/v1.InterfaceHostOffloads":                                                   schema_kubevirtio_api_core_v1_InterfaceHostOffloads(ref),
 		"kubevirt.io/api/core/v1.InterfaceMasquerade":                                                     schema_kubevirtio_api_core_v1_InterfaceMasquerade(ref),
+		"kubevirt.io/api/core/v1.InterfaceOffloads":                                                       schema_kubevirtio_api_core_v1_InterfaceOffloads(ref),
 		"kubevirt.io/api/core/v1.InterfaceSRIOV":                                                          schema_kubevirtio_api_core_v1_InterfaceSRIOV(ref),
 		"kubevirt.io/api/core/v1.KSMConfiguration":                                                        schema_kubevirtio_api_core_v1_KSMConfiguration(ref),
 		"kubevirt.io/api/core/v1.KVMTimer":                                                                schema_kubevirtio_api_core_v1_KVMTimer(ref),
@@ -470,29 +491,38 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.LogVerbosity":                                                            schema_kubevirtio_api_core_v1_LogVerbosity(ref),
 		"kubevirt.io/api/core/v1.LunTarget":                                                               schema_kubevirtio_api_core_v1_LunTarget(ref),
 		"kubevirt.io/api/core/v1.Machine":                                                                 schema_kubevirtio_api_core_v1_Machine(ref),
+		"kubevirt.io/api/core/v1.MaintenanceSnapshotPolicy":                                               schema_kubevirtio_api_core_v1_MaintenanceSnapshotPolicy(ref),
+		"kubevirt.io/api/core/v1.MasqueradeIPAMConfiguration":                                             schema_kubevirtio_api_core_v1_MasqueradeIPAMConfiguration(ref),
 		"kubevirt.io/api/core/v1.MediatedDevicesConfiguration":                                            schema_kubevirtio_api_core_v1_MediatedDevicesConfiguration(ref),
 		"kubevirt.io/api/core/v1.MediatedHostDevice":                                                      schema_kubevirtio_api_core_v1_MediatedHostDevice(ref),
 		"kubevirt.io/api/core/v1.Memory":                                                                  schema_kubevirtio_api_core_v1_Memory(ref),
 		"kubevirt.io/api/core/v1.MemoryDumpVolumeSource":                                                  schema_kubevirtio_api_core_v1_MemoryDumpVolumeSource(ref),
+		"kubevirt.io/api/core/v1.MemoryHotplug":                                                           schema_kubevirtio_api_core_v1_MemoryHotplug(ref),
 		"kubevirt.io/api/core/v1.MemoryStatus":                                                            schema_kubevirtio_api_core_v1_MemoryStatus(ref),
 		"kubevirt.io/api/core/v1.MigrateOptions":                                                          schema_kubevirtio_api_core_v1_MigrateOptions(ref),
 		"kubevirt.io/api/core/v1.MigrationConfiguration":                                                  schema_kubevirtio_api_core_v1_MigrationConfiguration(ref),
 		"kubevirt.io/api/core/v1.MultusNetwork":                                                           schema_kubevirtio_api_core_v1_MultusNetwork(ref),
 		"kubevirt.io/api/core/v1.NUMA":                                                                    schema_kubevirtio_api_core_v1_NUMA(ref),
+		"kubevirt.io/api/core/v1.NUMADistance":                                                            schema_kubevirtio_api_core_v1_NUMADistance(ref),
 		"kubevirt.io/api/core/v1.NUMAGuestMappingPassthrough":                                             schema_kubevirtio_api_core_v1_NUMAGuestMappingPassthrough(ref),
+		"kubevirt.io/api/core/v1.NUMAManualTopology":                                                      schema_kubevirtio_api_core_v1_NUMAManualTopology(ref),
+		"kubevirt.io/api/core/v1.NUMANode":                                                                schema_kubevirtio_api_core_v1_NUMANode(ref),
 		"kubevirt.io/api/core/v1.Network":                                                                 schema_kubevirtio_api_core_v1_Network(ref),
 		"kubevirt.io/api/core/v1.NetworkConfiguration":                                                    schema_kubevirtio_api_core_v1_NetworkConfiguration(ref),
 		"kubevirt.io/api/core/v1.NetworkSource":                                                           schema_kubevirtio_api_core_v1_NetworkSource(ref),
 		"kubevirt.io/api/core/v1.NoCloudSSHPublicKeyAccessCredentialPropagation":                          schema_kubevirtio_api_core_v1_NoCloudSSHPublicKeyAccessCredentialPropagation(ref),
+		"kubevirt.io/api/core/v1.NodeGuardrailsConfiguration":                                             schema_kubevirtio_api_core_v1_NodeGuardrailsConfiguration(ref),
 		"kubevirt.io/api/core/v1.NodeMediatedDeviceTypesConfig":                                           schema_kubevirtio_api_core_v1_NodeMediatedDeviceTypesConfig(ref),
 		"kubevirt.io/api/core/v1.NodePlacement":                                                           schema_kubevirtio_api_core_v1_NodePlacement(ref),
 		"kubevirt.io/api/core/v1.ObjectGraphNode":                                                         schema_kubevirtio_api_core_v1_ObjectGraphNode(ref),
 		"kubevirt.io/api/core/v1.ObjectGraphOptions":                                                      schema_kubevirtio_api_core_v1_ObjectGraphOptions(ref),
 		"kubevirt.io/api/core/v1.PITTimer":                                                                schema_kubevirtio_api_core_v1_PITTimer(ref),
+		"kubevirt.io/api/core/v1.PTPClock":                                                                schema_kubevirtio_api_core_v1_PTPClock(ref),
 		"kubevirt.io/api/core/v1.PanicDevice":                                                             schema_kubevirtio_api_core_v1_PanicDevice(ref),
 		"kubevirt.io/api/core/v1.PauseOptions":                                                            schema_kubevirtio_api_core_v1_PauseOptions(ref),
 		"kubevirt.io/api/core/v1.PciHostDevice":                                                           schema_kubevirtio_api_core_v1_PciHostDevice(ref),
 		"kubevirt.io/api/core/v1.PermittedHostDevices":                                                    schema_kubevirtio_api_core_v1_PermittedHostDevices(ref),
+		"kubevirt.io/api/core/v1.PersistentReservationConfiguration":                                      schema_kubevirtio_api_core_v1_PersistentReservationConfiguration(ref),
 		"kubevirt.io/api/core/v1.PersistentVolumeClaimInfo":                                               schema_kubevirtio_api_core_v1_PersistentVolumeClaimInfo(ref),
 		"kubevirt.io/api/core/v1.PersistentVolumeClaimVolumeSource":                                       schema_kubevirtio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
 		"kubevirt.io/api/core/v1.PluginBinding":                                                           schema_kubevirtio_api_core_v1_PluginBinding(ref),
@@ -512,6 +542,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.ResourceRequirements":                                                    schema_kubevirtio_api_core_v1_ResourceRequirements(ref),
 		"kubevirt.io/api/core/v1.ResourceRequirementsWithoutClaims":                                       schema_kubevirtio_api_core_v1_ResourceRequirementsWithoutClaims(ref),
 		"kubevirt.io/api/core/v1.RestartOptions":                                                          schema_kubevirtio_api_core_v1_RestartOptions(ref),
+		"kubevirt.io/api/core/v1.RevertOptions":                                                           schema_kubevirtio_api_core_v1_RevertOptions(ref),
 		"kubevirt.io/api/core/v1.Rng":                                                                     schema_kubevirtio_api_core_v1_Rng(ref),
 		"kubevirt.io/api/core/v1.SEV":                                                                     schema_kubevirtio_api_core_v1_SEV(ref),
 		"kubevirt.io/api/core/v1.SEVAttestation":                                                          schema_kubevirtio_api_core_v1_SEVAttestation(ref),
@@ -521,6 +552,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.SEVSNP":                                                                  schema_kubevirtio_api_core_v1_SEVSNP(ref),
 		"kubevirt.io/api/core/v1.SEVSecretOptions":                                                        schema_kubevirtio_api_core_v1_SEVSecretOptions(ref),
 		"kubevirt.io/api/core/v1.SEVSessionOptions":                                                       schema_kubevirtio_api_core_v1_SEVSessionOptions(ref),
+		"kubevirt.io/api/core/v1.SGX":                                                                     schema_kubevirtio_api_core_v1_SGX(ref),
 		"kubevirt.io/api/core/v1.SMBiosConfiguration":                                                     schema_kubevirtio_api_core_v1_SMBiosConfiguration(ref),
 		"kubevirt.io/api/core/v1.SSHPublicKeyAccessCredential":                                            schema_kubevirtio_api_core_v1_SSHPublicKeyAccessCredential(ref),
 		"kubevirt.io/api/core/v1.SSHPublicKeyAccessCredentialPropagationMethod":                           schema_kubevirtio_api_core_v1_SSHPublicKeyAccessCredentialPropagationMethod(ref),
@@ -528,7 +560,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.ScreenshotOptions":                                                       schema_kubevirtio_api_core_v1_ScreenshotOptions(ref),
 		"kubevirt.io/api/core/v1.SeccompConfiguration":                                                    schema_kubevirtio_api_core_v1_SeccompConfiguration(ref),
 		"kubevirt.io/api/core/v1.SecretVolumeSource":                                                      schema_kubevirtio_api_core_v1_SecretVolumeSource(ref),
+		"kubevirt.io/api/core/v1.Serial":                                                                  schema_kubevirtio_api_core_v1_Serial(ref),
 		"kubevirt.io/api/core/v1.ServiceAccountVolumeSource":                                              schema_kubevirtio_api_core_v1_ServiceAccountVolumeSource(ref),
+		"kubevirt.io/api/core/v1.ShutdownLadder":                                                          schema_kubevirtio_api_core_v1_ShutdownLadder(ref),
+		"kubevirt.io/api/core/v1.ShutdownStep":                                                            schema_kubevirtio_api_core_v1_ShutdownStep(ref),
 		"kubevirt.io/api/core/v1.SoundDevice":                                                             schema_kubevirtio_api_core_v1_SoundDevice(ref),
 		"kubevirt.io/api/core/v1.StartOptions":                                                            schema_kubevirtio_api_core_v1_StartOptions(ref),
 		"kubevirt.io/api/core/v1.StopOptions":                                                             schema_kubevirtio_api_core_v1_StopOptions(ref),
@@ -539,6 +574,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.TDX":                                                                     schema_kubevirtio_api_core_v1_TDX(ref),
 		"kubevirt.io/api/core/v1.TLSConfiguration":                                                        schema_kubevirtio_api_core_v1_TLSConfiguration(ref),
 		"kubevirt.io/api/core/v1.TPMDevice":                                                               schema_kubevirtio_api_core_v1_TPMDevice(ref),
+		"kubevirt.io/api/core/v1.TPMExternal":                                                             schema_kubevirtio_api_core_v1_TPMExternal(ref),
 		"kubevirt.io/api/core/v1.Timer":                                                                   schema_kubevirtio_api_core_v1_Timer(ref),
 		"kubevirt.io/api/core/v1.TokenBucketRateLimiter":                                                  schema_kubevirtio_api_core_v1_TokenBucketRateLimiter(ref),
 		"kubevirt.io/api/core/v1.TopologyHints":                                                           schema_kubevirtio_api_core_v1_TopologyHints(ref),
@@ -549,17 +585,23 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.UserPasswordAccessCredentialPropagationMethod":                           schema_kubevirtio_api_core_v1_UserPasswordAccessCredentialPropagationMethod(ref),
 		"kubevirt.io/api/core/v1.UserPasswordAccessCredentialSource":                                      schema_kubevirtio_api_core_v1_UserPasswordAccessCredentialSource(ref),
 		"kubevirt.io/api/core/v1.UtilityVolume":                                                           schema_kubevirtio_api_core_v1_UtilityVolume(ref),
+		"kubevirt.io/api/core/v1.VDPAHostDevice":                                                          schema_kubevirtio_api_core_v1_VDPAHostDevice(ref),
 		"kubevirt.io/api/core/v1.VGPUDisplayOptions":                                                      schema_kubevirtio_api_core_v1_VGPUDisplayOptions(ref),
 		"kubevirt.io/api/core/v1.VGPUOptions":                                                             schema_kubevirtio_api_core_v1_VGPUOptions(ref),
 		"kubevirt.io/api/core/v1.VMISelector":                                                             schema_kubevirtio_api_core_v1_VMISelector(ref),
 		"kubevirt.io/api/core/v1.VSOCKOptions":                                                            schema_kubevirtio_api_core_v1_VSOCKOptions(ref),
+		"kubevirt.io/api/core/v1.VhostUserBlkVolumeInfo":                                                  schema_kubevirtio_api_core_v1_VhostUserBlkVolumeInfo(ref),
+		"kubevirt.io/api/core/v1.VhostUserBlkVolumeSource":                                                schema_kubevirtio_api_core_v1_VhostUserBlkVolumeSource(ref),
 		"kubevirt.io/api/core/v1.VideoDevice":                                                             schema_kubevirtio_api_core_v1_VideoDevice(ref),
+		"kubevirt.io/api/core/v1.VirtioMemHotplug":                                                        schema_kubevirtio_api_core_v1_VirtioMemHotplug(ref),
 		"kubevirt.io/api/core/v1.VirtualMachine":                                                          schema_kubevirtio_api_core_v1_VirtualMachine(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineCondition":                                                 schema_kubevirtio_api_core_v1_VirtualMachineCondition(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstance":                                                  schema_kubevirtio_api_core_v1_VirtualMachineInstance(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceBackupStatus":                                      schema_kubevirtio_api_core_v1_VirtualMachineInstanceBackupStatus(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceCPUStats":                                          schema_kubevirtio_api_core_v1_VirtualMachineInstanceCPUStats(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceCommonMigrationState":                              schema_kubevirtio_api_core_v1_VirtualMachineInstanceCommonMigrationState(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceCondition":                                         schema_kubevirtio_api_core_v1_VirtualMachineInstanceCondition(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceDiskStats":                                         schema_kubevirtio_api_core_v1_VirtualMachineInstanceDiskStats(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystem":                                        schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystem(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemDisk":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemDisk(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemInfo":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemInfo(ref),
@@ -568,7 +610,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo":                                       schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSInfo(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSUser":                                       schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSUser(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSUserList":                                   schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSUserList(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceInterfaceStats":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceInterfaceStats(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceList":                                              schema_kubevirtio_api_core_v1_VirtualMachineInstanceList(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceMemoryStats":                                       schema_kubevirtio_api_core_v1_VirtualMachineInstanceMemoryStats(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigration":                                         schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigration(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationCondition":                                schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationCondition(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationList":                                     schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationList(ref),
@@ -592,6 +636,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceReplicaSetSpec":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceReplicaSetSpec(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceReplicaSetStatus":                                  schema_kubevirtio_api_core_v1_VirtualMachineInstanceReplicaSetStatus(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceSpec":                                              schema_kubevirtio_api_core_v1_VirtualMachineInstanceSpec(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceStats":                                             schema_kubevirtio_api_core_v1_VirtualMachineInstanceStats(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceStatus":                                            schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceTemplateSpec":                                      schema_kubevirtio_api_core_v1_VirtualMachineInstanceTemplateSpec(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineList":                                                      schema_kubevirtio_api_core_v1_VirtualMachineList(ref),
@@ -603,6 +648,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.VirtualMachineStatus":                                                    schema_kubevirtio_api_core_v1_VirtualMachineStatus(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineVolumeRequest":                                             schema_kubevirtio_api_core_v1_VirtualMachineVolumeRequest(ref),
 		"kubevirt.io/api/core/v1.Volume":                                                                  schema_kubevirtio_api_core_v1_Volume(ref),
+		"kubevirt.io/api/core/v1.VolumeBlockJob":                                                          schema_kubevirtio_api_core_v1_VolumeBlockJob(ref),
+		"kubevirt.io/api/core/v1.VolumeIOErrors":                                                          schema_kubevirtio_api_core_v1_VolumeIOErrors(ref),
 		"kubevirt.io/api/core/v1.VolumeMigrationState":                                                    schema_kubevirtio_api_core_v1_VolumeMigrationState(ref),
 		"kubevirt.io/api/core/v1.VolumeSnapshotStatus":                                                    schema_kubevirtio_api_core_v1_VolumeSnapshotStatus(ref),
 		"kubevirt.io/api/core/v1.VolumeSource":                                                            schema_kubevirtio_api_core_v1_VolumeSource(ref),
@@ -658,6 +705,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/migrations/v1alpha1.MigrationPolicySpec":                                         schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicySpec(ref),
 		"kubevirt.io/api/migrations/v1alpha1.MigrationPolicyStatus":                                       schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicyStatus(ref),
 		"kubevirt.io/api/migrations/v1alpha1.Selectors":                                                   schema_kubevirtio_api_migrations_v1alpha1_Selectors(ref),
+		"kubevirt.io/api/overcommit/v1alpha1.OvercommitProfile":                                           schema_kubevirtio_api_overcommit_v1alpha1_OvercommitProfile(ref),
+		"kubevirt.io/api/overcommit/v1alpha1.OvercommitProfileList":                                       schema_kubevirtio_api_overcommit_v1alpha1_OvercommitProfileList(ref),
+		"kubevirt.io/api/overcommit/v1alpha1.OvercommitProfileSpec":                                       schema_kubevirtio_api_overcommit_v1alpha1_OvercommitProfileSpec(ref),
+		"kubevirt.io/api/overcommit/v1alpha1.OvercommitProfileStatus":                                     schema_kubevirtio_api_overcommit_v1alpha1_OvercommitProfileStatus(ref),
+		"kubevirt.io/api/overcommit/v1alpha1.VirtualizationQuota":                                         schema_kubevirtio_api_overcommit_v1alpha1_VirtualizationQuota(ref),
+		"kubevirt.io/api/overcommit/v1alpha1.VirtualizationQuotaList":                                     schema_kubevirtio_api_overcommit_v1alpha1_VirtualizationQuotaList(ref),
+		"kubevirt.io/api/overcommit/v1alpha1.VirtualizationQuotaSpec":                                     schema_kubevirtio_api_overcommit_v1alpha1_VirtualizationQuotaSpec(ref),
+		"kubevirt.io/api/overcommit/v1alpha1.VirtualizationQuotaStatus":                                   schema_kubevirtio_api_overcommit_v1alpha1_VirtualizationQuotaStatus(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachineOpportunisticUpdateStrategy":                         schema_kubevirtio_api_pool_v1alpha1_VirtualMachineOpportunisticUpdateStrategy(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePool":                                                schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePool(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolAutohealingStrategy":                             schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolAutohealingStrategy(ref),
@@ -17275,11 +17330,16 @@ func schema_kubevirtio_api_backup_v1alpha1_BackupOptions(ref common.ReferenceCal
 							Format: "",
 						},
 					},
+					"bandwidth": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -17477,11 +17537,17 @@ func schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupSpec(ref common.R
 							Format:      "",
 						},
 					},
+					"bandwidth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bandwidth limits the rate at which each disk is read by the backup job, so that the guest IO is not starved while the backup runs. The value is in quantity per second. Defaults to 0 (no limit)",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.TypedLocalObjectReference"},
+			"k8s.io/api/core/v1.TypedLocalObjectReference", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -18481,6 +18547,22 @@ func schema_kubevirtio_api_core_v1_ArchSpecificConfiguration(ref common.Referenc
 							Format: "",
 						},
 					},
+					"machineTypeAliases": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineTypeAliases maps an alias, e.g. stable, to the machine type it stands for, e.g. pc-q35-rhel9.6.0. A VMI requesting an alias runs with the machine type the alias resolves to when the domain is created.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -18529,6 +18611,40 @@ func schema_kubevirtio_api_core_v1_BIOS(ref common.ReferenceCallback) common.Ope
 	}
 }
 
+func schema_kubevirtio_api_core_v1_BalloonDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BalloonDevice represents the memory reclaim settings of the memory balloon device.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deflateOnOOM": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeflateOnOOM lets the guest deflate the balloon when it runs out of memory, instead of invoking its OOM killer. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"disableStatsWithoutDriver": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DisableStatsWithoutDriver stops the balloon statistics while the guest agent reports that the guest has no balloon driver, e.g. a Windows guest without the virtio drivers, as QEMU would keep on requesting statistics which the guest never provides. They are restored once the driver is installed. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"freePageReporting": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FreePageReporting lets the guest report its free pages to the host so they can be reclaimed. It overrides the cluster-wide setting and the free page reporting annotation, but is always disabled for high performance vmis, e.g. with dedicated CPUs or hugepages.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_BlockSize(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -18696,11 +18812,117 @@ func schema_kubevirtio_api_core_v1_CPU(ref common.ReferenceCallback) common.Open
 							Ref:         ref("kubevirt.io/api/core/v1.Realtime"),
 						},
 					},
+					"cache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cache describes how the CPU cache is presented to the guest, e.g. passing the host L3 cache through.",
+							Ref:         ref("kubevirt.io/api/core/v1.CPUCache"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.CPUCache", "kubevirt.io/api/core/v1.CPUFeature", "kubevirt.io/api/core/v1.NUMA", "kubevirt.io/api/core/v1.Realtime"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_CPUBurst(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CPUBurst defines the windows during which the CPU limit of the virt-launcher pod is raised. The pod is resized in place, so that only pods with a CPU limit and without the Guaranteed QoS class can burst. The steady-state limit is restored once a window is over.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cpuLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPULimit is the CPU limit of the compute container during a window.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"windows": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Windows are the daily windows during which the CPU limit is raised.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.CPUBurstWindow"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"cpuLimit", "windows"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/core/v1.CPUBurstWindow"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_CPUBurstWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CPUBurstWindow is a daily window.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is the time of day the window opens, in the 24-hour \"15:04\" format and in UTC.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration of the window, at most 24 hours.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
+				Required: []string{"start", "duration"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.CPUFeature", "kubevirt.io/api/core/v1.NUMA", "kubevirt.io/api/core/v1.Realtime"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_CPUCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CPUCache describes how the CPU cache is presented to the guest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode is the cache mode, one of passthrough, emulate or disable.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"level": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Level is the cache level the mode applies to. Only level 3 can be emulated or disabled, passthrough applies to all the levels.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"mode"},
+			},
+		},
 	}
 }
 
@@ -18794,6 +19016,35 @@ func schema_kubevirtio_api_core_v1_CertConfig(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_kubevirtio_api_core_v1_ChangeMediaOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ChangeMediaOptions is provided when changing the medium of a CD-ROM of a running VMI",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"diskName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DiskName is the name of the CD-ROM disk whose medium is changed",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeName is the name of the volume of the VMI which is inserted as the new medium. It has to be a persistentVolumeClaim or a dataVolume volume which is not used by any disk. The medium of the CD-ROM is ejected when it is empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"diskName"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_ChangedBlockTrackingSelectors(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -18850,6 +19101,28 @@ func schema_kubevirtio_api_core_v1_ChangedBlockTrackingStatus(ref common.Referen
 	}
 }
 
+func schema_kubevirtio_api_core_v1_Channel(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Channel represents a virtio-serial channel backed by a unix socket in the virt-launcher pod.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the virtio-serial port seen by the guest, e.g. org.example.agent.0. The socket is created at /var/run/kubevirt-channels/<name>.sock in the virt-launcher pod. The directory is a volume shared with the hook sidecars of the vmi, which connect to the socket to talk to the guest.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_Chassis(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -18956,11 +19229,17 @@ func schema_kubevirtio_api_core_v1_Clock(ref common.ReferenceCallback) common.Op
 							Ref:         ref("kubevirt.io/api/core/v1.Timer"),
 						},
 					},
+					"ptp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PTP exposes a virtual PTP hardware clock (kvm-ptp) to the guest, so that it can discipline its clock to the host clock through the ptp_kvm driver. Only supported on amd64, where it requires the kvm timer, and arm64.",
+							Ref:         ref("kubevirt.io/api/core/v1.PTPClock"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.ClockOffsetUTC", "kubevirt.io/api/core/v1.Timer"},
+			"kubevirt.io/api/core/v1.ClockOffsetUTC", "kubevirt.io/api/core/v1.PTPClock", "kubevirt.io/api/core/v1.Timer"},
 	}
 }
 
@@ -19518,6 +19797,13 @@ func schema_kubevirtio_api_core_v1_DHCPOptions(ref common.ReferenceCallback) com
 							Format:      "",
 						},
 					},
+					"nextServer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified will be passed as the BOOTP next server address (siaddr) to the interface's DHCP server. Network boot firmwares which only look at the BOOTP header, like the s390x network boot loader, need it to locate the TFTP server. Defaults to tftpServerName when that is an IPv4 address.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"ntpServers": {
 						SchemaProps: spec.SchemaProps{
 							Description: "If specified will pass the configured NTP server to the VM via DHCP option 042.",
@@ -19547,6 +19833,36 @@ func schema_kubevirtio_api_core_v1_DHCPOptions(ref common.ReferenceCallback) com
 							},
 						},
 					},
+					"dnsServers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified will pass the configured DNS servers to the VM instead of the ones of the pod. The pod itself keeps its DNS configuration. Only supported by the masquerade binding.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"searchDomains": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified will pass the configured search domains to the VM instead of the ones of the pod. The pod itself keeps its DNS configuration. Only supported by the masquerade binding.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -19966,6 +20282,26 @@ func schema_kubevirtio_api_core_v1_Devices(ref common.ReferenceCallback) common.
 							Format:      "",
 						},
 					},
+					"virtioTransitionalDevices": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtioTransitionalDevices lists the classes of virtio devices falling back to legacy virtio 0.9 support, e.g. for guests shipping a legacy disk driver but a virtio 1.0 network driver. All virtio devices fall back to legacy virtio 0.9 support when UseVirtioTransitional is set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 					"disableHotplug": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DisableHotplug disabled the ability to hotplug disks.",
@@ -19973,6 +20309,20 @@ func schema_kubevirtio_api_core_v1_Devices(ref common.ReferenceCallback) common.
 							Format:      "",
 						},
 					},
+					"scsiControllerCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SCSIControllerCount is the number of virtio-scsi controllers to create. Disks and LUNs on the scsi bus can be mapped to a controller with scsiController, e.g. to exceed the queue limits of a single controller or to isolate workloads. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"scsiControllerQueues": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SCSIControllerQueues is the number of request queues of each virtio-scsi controller. It allows multi-queue SCSI independently of the number of vCPUs, which is used otherwise when a disk on the scsi bus has a dedicated IOThread.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 					"disks": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Disks describes disks, cdroms and luns which are connected to the vmi.",
@@ -20090,6 +20440,13 @@ func schema_kubevirtio_api_core_v1_Devices(ref common.ReferenceCallback) common.
 							Format:      "",
 						},
 					},
+					"networkInterfaceRSS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, virtio network interfaces with multiple queues distribute the received packets across their queues with receive side scaling (RSS) and report the packet hash to the guest. These interfaces are served by QEMU instead of the vhost kernel driver. Requires networkInterfaceMultiqueue.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"gpus": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -20191,11 +20548,87 @@ func schema_kubevirtio_api_core_v1_Devices(ref common.ReferenceCallback) common.
 							Ref:         ref("kubevirt.io/api/core/v1.VideoDevice"),
 						},
 					},
+					"videos": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Videos describes multiple video devices for the vmi, the first one being the primary device. It cannot be combined with video.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VideoDevice"),
+									},
+								},
+							},
+						},
+					},
+					"channels": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Channels describes additional virtio-serial channels exposed to the vmi, each backed by a unix socket in the virt-launcher pod, e.g. for host-guest agents.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.Channel"),
+									},
+								},
+							},
+						},
+					},
+					"balloon": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Balloon configures the memory reclaim behavior of the memory balloon device.",
+							Ref:         ref("kubevirt.io/api/core/v1.BalloonDevice"),
+						},
+					},
+					"serialConsoleTargetType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SerialConsoleTargetType selects the device exposing the auto-attached serial console to the guest. One of: isa-serial, pci-serial, usb-serial. Only supported on amd64, where it defaults to isa-serial.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sgx": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SGX provides an Intel SGX enclave page cache to the guest.",
+							Ref:         ref("kubevirt.io/api/core/v1.SGX"),
+						},
+					},
+					"serials": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Serials describes additional serial ports exposed to the guest after the auto-attached serial console, e.g. for appliances expecting multiple UARTs. Up to 4 serial ports are supported, including the auto-attached serial console.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.Serial"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.ClientPassthroughDevices", "kubevirt.io/api/core/v1.Disk", "kubevirt.io/api/core/v1.DownwardMetrics", "kubevirt.io/api/core/v1.Filesystem", "kubevirt.io/api/core/v1.GPU", "kubevirt.io/api/core/v1.HostDevice", "kubevirt.io/api/core/v1.Input", "kubevirt.io/api/core/v1.Interface", "kubevirt.io/api/core/v1.PanicDevice", "kubevirt.io/api/core/v1.Rng", "kubevirt.io/api/core/v1.SoundDevice", "kubevirt.io/api/core/v1.TPMDevice", "kubevirt.io/api/core/v1.VideoDevice", "kubevirt.io/api/core/v1.Watchdog"},
+			"kubevirt.io/api/core/v1.BalloonDevice", "kubevirt.io/api/core/v1.Channel", "kubevirt.io/api/core/v1.ClientPassthroughDevices", "kubevirt.io/api/core/v1.Disk", "kubevirt.io/api/core/v1.DownwardMetrics", "kubevirt.io/api/core/v1.Filesystem", "kubevirt.io/api/core/v1.GPU", "kubevirt.io/api/core/v1.HostDevice", "kubevirt.io/api/core/v1.Input", "kubevirt.io/api/core/v1.Interface", "kubevirt.io/api/core/v1.PanicDevice", "kubevirt.io/api/core/v1.Rng", "kubevirt.io/api/core/v1.SGX", "kubevirt.io/api/core/v1.Serial", "kubevirt.io/api/core/v1.SoundDevice", "kubevirt.io/api/core/v1.TPMDevice", "kubevirt.io/api/core/v1.VideoDevice", "kubevirt.io/api/core/v1.Watchdog"},
 	}
 }
 
@@ -20333,6 +20766,13 @@ func schema_kubevirtio_api_core_v1_Disk(ref common.ReferenceCallback) common.Ope
 							Format:      "",
 						},
 					},
+					"rerrorPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RerrorPolicy, if specified, overrides the error policy for read errors, e.g. to report read errors while stopping on write errors. Supported values are: stop, report, ignore. Defaults to the error policy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"changedBlockTracking": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ChangedBlockTracking indicates this disk should have CBT option Defaults to false.",
@@ -20340,12 +20780,18 @@ func schema_kubevirtio_api_core_v1_Disk(ref common.ReferenceCallback) common.Ope
 							Format:      "",
 						},
 					},
+					"ioTune": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IOTune throttles the IO of the disk, so that a single VMI cannot saturate the storage shared with other tenants.",
+							Ref:         ref("kubevirt.io/api/core/v1.DiskIOTune"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.BlockSize", "kubevirt.io/api/core/v1.CDRomTarget", "kubevirt.io/api/core/v1.DiskTarget", "kubevirt.io/api/core/v1.LunTarget"},
+			"kubevirt.io/api/core/v1.BlockSize", "kubevirt.io/api/core/v1.CDRomTarget", "kubevirt.io/api/core/v1.DiskIOTune", "kubevirt.io/api/core/v1.DiskTarget", "kubevirt.io/api/core/v1.LunTarget"},
 	}
 }
 
@@ -20395,6 +20841,147 @@ func schema_kubevirtio_api_core_v1_DiskIOThreads(ref common.ReferenceCallback) c
 							Format:      "int64",
 						},
 					},
+					"pinning": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pinning pins iothreads to explicit host CPUs instead of the ones derived from the dedicated CPUs of the vmi. The keys are the iothread IDs, starting from 1, and the values are the cpusets, e.g. \"4-5,7\". The CPUs have to be allocated to the vmi. Requires dedicatedCpuPlacement.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_DiskIOTune(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiskIOTune represents the IO throttling of a disk. A total limit cannot be combined with the read or write limit of the same kind.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"totalBytesSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalBytesSec limits the throughput of the disk, in bytes per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"readBytesSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadBytesSec limits the read throughput of the disk, in bytes per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"writeBytesSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WriteBytesSec limits the write throughput of the disk, in bytes per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"totalIopsSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalIOPSSec limits the IO operations per second of the disk.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"readIopsSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadIOPSSec limits the read operations per second of the disk.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"writeIopsSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WriteIOPSSec limits the write operations per second of the disk.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst lets the disk exceed its limits for a limited time.",
+							Ref:         ref("kubevirt.io/api/core/v1.DiskIOTuneBurst"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.DiskIOTuneBurst"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_DiskIOTuneBurst(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiskIOTuneBurst represents the limits a disk may reach while bursting. Each burst limit requires the matching limit of the disk and must not be lower than it.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"totalBytesSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalBytesSec is the throughput the disk may reach while bursting, in bytes per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"readBytesSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadBytesSec is the read throughput the disk may reach while bursting, in bytes per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"writeBytesSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WriteBytesSec is the write throughput the disk may reach while bursting, in bytes per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"totalIopsSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalIOPSSec is the IO operations per second the disk may reach while bursting.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"readIopsSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadIOPSSec is the read operations per second the disk may reach while bursting.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"writeIopsSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WriteIOPSSec is the write operations per second the disk may reach while bursting.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lengthSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LengthSeconds is the longest duration of a burst. Defaults to 1 second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 			},
 		},
@@ -20409,7 +20996,7 @@ func schema_kubevirtio_api_core_v1_DiskTarget(ref common.ReferenceCallback) comm
 				Properties: map[string]spec.Schema{
 					"bus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi, usb.",
+							Description: "Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi, usb, nvme.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -20428,6 +21015,34 @@ func schema_kubevirtio_api_core_v1_DiskTarget(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"ccwAddress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, the virtual disk will be placed on the guests CCW address with the specified device number. Only supported on s390x with the virtio bus. For example: 0.0.0001",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"scsiController": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SCSIController is the index of the SCSI controller the disk is attached to. Only valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"queueSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueueSize is the size of each virtqueue of the disk. Only supported with the virtio bus. Must be a power of 2 between 4 and 1024. Disks on the scsi bus share the virtqueues of their virtio-scsi controller, whose size libvirt does not allow to set.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"zoned": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zoned passes the zone semantics of a zoned block device, like a ZNS NVMe namespace, through to the guest using the virtio-blk zoned model. Only supported with the virtio bus on block volumes. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -20767,6 +21382,43 @@ func schema_kubevirtio_api_core_v1_EvacuateCancelOptions(ref common.ReferenceCal
 	}
 }
 
+func schema_kubevirtio_api_core_v1_FWCfgEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the entry as seen by the guest, e.g. opt/com.coreos/config. It must start with opt/ and must not exceed 55 characters.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeNameRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeNameRef should match the volume name of a ConfigMap or Secret object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key of the ConfigMap or Secret whose data is the content of the entry.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "volumeNameRef", "key"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_FeatureAPIC(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21074,6 +21726,29 @@ func schema_kubevirtio_api_core_v1_FilesystemVirtiofs(ref common.ReferenceCallba
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cache is the caching policy of virtiofsd for the shared directory. Supported values: auto, always, metadata, never. Defaults to auto.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"queueSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueueSize is the size of the virtqueue of the filesystem device. Must be a power of 2 between 4 and 1024. Defaults to 1024.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"xattr": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Xattr enables the support of extended attributes in the shared directory. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
 			},
 		},
 	}
@@ -21105,6 +21780,33 @@ func schema_kubevirtio_api_core_v1_Firmware(ref common.ReferenceCallback) common
 							Format:      "",
 						},
 					},
+					"sku": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The system SKU number in SMBIOS, overriding the cluster-wide SMBIOS configuration.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"oemStrings": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "OEMStrings are exposed to the guest as SMBIOS OEM strings (type 11), e.g. to pass provisioning hints to the guest.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 					"kernelBoot": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Settings to set the kernel for booting.",
@@ -21117,11 +21819,30 @@ func schema_kubevirtio_api_core_v1_Firmware(ref common.ReferenceCallback) common
 							Ref:         ref("kubevirt.io/api/core/v1.ACPI"),
 						},
 					},
+					"fwCfg": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "FWCfg are the QEMU firmware configuration (fw_cfg) entries exposed to the guest, e.g. an ignition config or a custom early-boot provisioning blob.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.FWCfgEntry"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.ACPI", "kubevirt.io/api/core/v1.Bootloader", "kubevirt.io/api/core/v1.KernelBoot"},
+			"kubevirt.io/api/core/v1.ACPI", "kubevirt.io/api/core/v1.Bootloader", "kubevirt.io/api/core/v1.FWCfgEntry", "kubevirt.io/api/core/v1.KernelBoot"},
 	}
 }
 
@@ -21320,6 +22041,39 @@ func schema_kubevirtio_api_core_v1_GenerationStatus(ref common.ReferenceCallback
 	}
 }
 
+func schema_kubevirtio_api_core_v1_GuestAgent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestAgent restricts the qemu-guest-agent commands KubeVirt sends to the guest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"allowedCommands": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedCommands lists the guest agent commands KubeVirt may execute in the guest, e.g. guest-get-fsinfo. The other commands are refused by virt-launcher, except guest-ping and guest-info which are needed to detect the agent. All commands are allowed when guestAgent is not set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_GuestAgentCommandInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21358,6 +22112,62 @@ func schema_kubevirtio_api_core_v1_GuestAgentPing(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_GuestCrashNotificationsConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestCrashNotificationsConfiguration holds the webhook sink guest crash notifications are posted to. A notification is sent when a panic device reports a guest kernel panic and when the watchdog device of a guest expires.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the http or https endpoint the notifications are posted to as JSON.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_GuestTimeConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GuestTimeConfiguration holds the NTP servers guests synchronize their clock with. They are offered through DHCP on bridge and masquerade interfaces which do not set their own NTP servers, and through cloud-init vendor data to guests with a cloud-init volume.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ntpServers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "NTPServers are the IPv4 addresses of the NTP servers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"ntpServers"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_HPETTimer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21423,6 +22233,62 @@ func schema_kubevirtio_api_core_v1_Handler(ref common.ReferenceCallback) common.
 	}
 }
 
+func schema_kubevirtio_api_core_v1_HostBlockDeviceVolumeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HostBlockDeviceVolumeSource represents a raw block device of the node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path of the block device on the node, like /dev/nvme1n1.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"path"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_HostBlockDevicesConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HostBlockDevicesConfiguration holds the allowlist of the block devices of the nodes hostBlockDevice volumes may use.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"permittedPaths": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "PermittedPaths are the absolute paths of the block devices on the nodes which hostBlockDevice volumes may reference, like /dev/nvme1n1 or /dev/disk/by-id/nvme-eui.0025388b91b2d3a1.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"permittedPaths"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_HostDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21516,6 +22382,40 @@ func schema_kubevirtio_api_core_v1_HostDisk(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_kubevirtio_api_core_v1_HostSocketDirectoriesConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HostSocketDirectoriesConfiguration holds the allowlist of the directories of the nodes unix sockets consumed by VirtualMachineInstances may be located in. The directory of a socket is mounted into the virt-launcher pod, so it has to be dedicated to the sockets.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"permittedDirectories": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "PermittedDirectories are the absolute paths of the directories on the nodes which may hold the sockets, like /var/tmp/spdk. The sockets of a namespace are in its directory below a permitted directory, like /var/tmp/spdk/<namespace>, or in a subdirectory of it. VMIs can't use the sockets of other namespaces.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"permittedDirectories"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_HotplugVolumeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21584,9 +22484,47 @@ func schema_kubevirtio_api_core_v1_Hugepages(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"numaPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NUMAPolicy binds the hugepages backing the guest memory to host NUMA nodes, so that large guests do not allocate their pages across nodes.",
+							Ref:         ref("kubevirt.io/api/core/v1.HugepagesNUMAPolicy"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.HugepagesNUMAPolicy"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_HugepagesNUMAPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HugepagesNUMAPolicy binds the hugepages of the VirtualMachineInstance to host NUMA nodes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode is the host NUMA memory allocation policy. One of: strict, interleave, preferred.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodeSet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSet is the list of host NUMA nodes the hugepages are allocated from, e.g. \"0-1,3\". The nodes must be allowed by the cpuset of the virt-launcher pod.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"mode", "nodeSet"},
+			},
+		},
 	}
 }
 
@@ -21675,14 +22613,14 @@ func schema_kubevirtio_api_core_v1_Input(ref common.ReferenceCallback) common.Op
 				Properties: map[string]spec.Schema{
 					"bus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Bus indicates the bus of input device to emulate. Supported values: virtio, usb.",
+							Description: "Bus indicates the bus of input device to emulate. Supported values: virtio, usb, ps2.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type indicated the type of input device. Supported values: tablet.",
+							Description: "Type indicated the type of input device. Supported values: tablet, and keyboard or mouse on the ps2 bus.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -21703,6 +22641,43 @@ func schema_kubevirtio_api_core_v1_Input(ref common.ReferenceCallback) common.Op
 	}
 }
 
+func schema_kubevirtio_api_core_v1_InputHostDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InputHostDevice represents a host input (evdev) device allowed for passthrough, e.g. a keyboard or a touchscreen attached to the node. The device is attached to the guest as a virtio-input-host device.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the resource that is representing the device. Exposed by a device plugin and requested by VMs. e.g: kubevirt.io/kiosk-keyboard",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"devicePath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The path of the evdev device on the node, under /dev/input. Stable paths like /dev/input/by-id/usb-Vendor_Keyboard-event-kbd should be preferred, as /dev/input/eventX is not guaranteed to survive a reboot.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"externalResourceProvider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If true, KubeVirt will leave the allocation and monitoring to an external device plugin",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"resourceName", "devicePath"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_InstancetypeConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21913,6 +22888,13 @@ func schema_kubevirtio_api_core_v1_Interface(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"ccwAddress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, the virtual network interface will be placed on the guests CCW address with the specified device number. Only supported on s390x. For example: 0.0.0002",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"dhcpOptions": {
 						SchemaProps: spec.SchemaProps{
 							Description: "If specified the network interface will pass additional DHCP options to the VMI",
@@ -21940,12 +22922,38 @@ func schema_kubevirtio_api_core_v1_Interface(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"coalesce": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Coalesce configures the interrupt coalescing of the interface, reducing the interrupt load on the vCPUs of guests handling high packet rates. Only supported by virtio interfaces backed by a tap device.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceCoalesce"),
+						},
+					},
+					"rxQueueSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RxQueueSize is the number of descriptors of the receive virtqueues of the interface. It must be a power of 2 between 256 and 1024. Defaults to 256. Only supported by virtio interfaces.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"txQueueSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TxQueueSize is the number of descriptors of the transmit virtqueues of the interface. It must be a power of 2 between 256 and 1024. Defaults to 256. Only supported by virtio interfaces, QEMU applies it to vhost-user backends only.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"offloads": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Offloads toggles the checksum and segmentation offloads of the interface, e.g. for guests running their own tunneling or encapsulation stack. Unset offloads keep their default. Only supported by virtio interfaces.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceOffloads"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.DHCPOptions", "kubevirt.io/api/core/v1.DeprecatedInterfaceMacvtap", "kubevirt.io/api/core/v1.DeprecatedInterfacePasst", "kubevirt.io/api/core/v1.DeprecatedInterfaceSlirp", "kubevirt.io/api/core/v1.InterfaceBridge", "kubevirt.io/api/core/v1.InterfaceMasquerade", "kubevirt.io/api/core/v1.InterfaceSRIOV", "kubevirt.io/api/core/v1.PluginBinding", "kubevirt.io/api/core/v1.Port"},
+			"kubevirt.io/api/core/v1.DHCPOptions", "kubevirt.io/api/core/v1.DeprecatedInterfaceMacvtap", "kubevirt.io/api/core/v1.DeprecatedInterfacePasst", "kubevirt.io/api/core/v1.DeprecatedInterfaceSlirp", "kubevirt.io/api/core/v1.InterfaceBridge", "kubevirt.io/api/core/v1.InterfaceCoalesce", "kubevirt.io/api/core/v1.InterfaceMasquerade", "kubevirt.io/api/core/v1.InterfaceOffloads", "kubevirt.io/api/core/v1.InterfaceSRIOV", "kubevirt.io/api/core/v1.PluginBinding", "kubevirt.io/api/core/v1.Port"},
 	}
 }
 
@@ -22038,7 +23046,7 @@ func schema_kubevirtio_api_core_v1_InterfaceBindingPlugin(ref common.ReferenceCa
 					},
 					"domainAttachmentType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DomainAttachmentType is a standard domain network attachment method kubevirt supports. Supported values: \"tap\", \"managedTap\" (since v1.4). The standard domain attachment can be used instead or in addition to the sidecarImage. version: 1alphav1",
+							Description: "DomainAttachmentType is a standard domain network attachment method kubevirt supports. Supported values: \"tap\", \"managedTap\" (since v1.4), \"ovs\", \"none\", \"vdpa\". The standard domain attachment can be used instead or in addition to the sidecarImage. version: 1alphav1",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -22081,6 +23089,124 @@ func schema_kubevirtio_api_core_v1_InterfaceBridge(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_InterfaceCoalesce(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceCoalesce represents the interrupt coalescing settings of an interface.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rxMaxFrames": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RxMaxFrames is the maximum number of received frames coalesced into a single interrupt.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"rxMaxFrames"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_InterfaceGuestOffloads(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceGuestOffloads represents the offloads offered to the guest driver of a virtio-net interface.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"csum": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Checksum toggles the checksum offload.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tso4": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TSO4 toggles the TCP segmentation offload over IPv4.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tso6": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TSO6 toggles the TCP segmentation offload over IPv6.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"ufo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UFO toggles the UDP fragmentation offload.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_InterfaceHostOffloads(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceHostOffloads represents the offloads of the host backend of a virtio-net interface.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"csum": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Checksum toggles the checksum offload.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"gso": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GSO toggles the generic segmentation offload.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tso4": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TSO4 toggles the TCP segmentation offload over IPv4.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tso6": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TSO6 toggles the TCP segmentation offload over IPv6.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"ufo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UFO toggles the UDP fragmentation offload.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"mrgRxBuf": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MergeRxBuffers toggles the mergeable receive buffers.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_InterfaceMasquerade(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -22092,6 +23218,33 @@ func schema_kubevirtio_api_core_v1_InterfaceMasquerade(ref common.ReferenceCallb
 	}
 }
 
+func schema_kubevirtio_api_core_v1_InterfaceOffloads(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceOffloads represents the offload toggles of a virtio-net interface.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Host toggles the offloads of the interface backend on the host.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceHostOffloads"),
+						},
+					},
+					"guest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Guest toggles the offloads offered to the guest driver.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceGuestOffloads"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.InterfaceGuestOffloads", "kubevirt.io/api/core/v1.InterfaceHostOffloads"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_InterfaceSRIOV(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -22163,6 +23316,13 @@ func schema_kubevirtio_api_core_v1_KernelBoot(ref common.ReferenceCallback) comm
 							Ref:         ref("kubevirt.io/api/core/v1.KernelBootContainer"),
 						},
 					},
+					"rootFilesystem": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RootFilesystem is the name of a virtiofs filesystem the guest mounts as its root filesystem, so that it boots without any disk. The kernel arguments must not set root or rootfstype.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -22660,11 +23820,53 @@ func schema_kubevirtio_api_core_v1_KubeVirtConfiguration(ref common.ReferenceCal
 							Ref:         ref("kubevirt.io/api/core/v1.ChangedBlockTrackingSelectors"),
 						},
 					},
+					"nodeGuardrails": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeGuardrails defines per-node policies enforced by virt-handler before starting a VirtualMachineInstance. A VirtualMachineInstance that would violate them is refused instead of being started in a degraded state.",
+							Ref:         ref("kubevirt.io/api/core/v1.NodeGuardrailsConfiguration"),
+						},
+					},
+					"guestTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestTime defines the time synchronization policy injected into the guests.",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestTimeConfiguration"),
+						},
+					},
+					"persistentReservation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PersistentReservation configures the pr-helper daemon serving the SCSI persistent reservations of LUNs.",
+							Ref:         ref("kubevirt.io/api/core/v1.PersistentReservationConfiguration"),
+						},
+					},
+					"hostBlockDevices": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostBlockDevices defines the block devices of the nodes VirtualMachineInstances may consume with hostBlockDevice volumes.",
+							Ref:         ref("kubevirt.io/api/core/v1.HostBlockDevicesConfiguration"),
+						},
+					},
+					"guestCrashNotifications": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestCrashNotifications defines the webhook sink virt-handler notifies when a guest crashes.",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestCrashNotificationsConfiguration"),
+						},
+					},
+					"vhostUserBlkSockets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VhostUserBlkSockets defines the directories of the nodes the sockets of vhostUserBlk volumes may be located in.",
+							Ref:         ref("kubevirt.io/api/core/v1.HostSocketDirectoriesConfiguration"),
+						},
+					},
+					"externalTPMSockets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExternalTPMSockets defines the directories of the nodes the sockets of external swtpm instances may be located in.",
+							Ref:         ref("kubevirt.io/api/core/v1.HostSocketDirectoriesConfiguration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/core/v1.ArchConfiguration", "kubevirt.io/api/core/v1.ChangedBlockTrackingSelectors", "kubevirt.io/api/core/v1.CommonInstancetypesDeployment", "kubevirt.io/api/core/v1.DeveloperConfiguration", "kubevirt.io/api/core/v1.InstancetypeConfiguration", "kubevirt.io/api/core/v1.KSMConfiguration", "kubevirt.io/api/core/v1.LiveUpdateConfiguration", "kubevirt.io/api/core/v1.MediatedDevicesConfiguration", "kubevirt.io/api/core/v1.MigrationConfiguration", "kubevirt.io/api/core/v1.NetworkConfiguration", "kubevirt.io/api/core/v1.PermittedHostDevices", "kubevirt.io/api/core/v1.ReloadableComponentConfiguration", "kubevirt.io/api/core/v1.SMBiosConfiguration", "kubevirt.io/api/core/v1.SeccompConfiguration", "kubevirt.io/api/core/v1.SupportContainerResources", "kubevirt.io/api/core/v1.TLSConfiguration", "kubevirt.io/api/core/v1.VirtualMachineOptions"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/core/v1.ArchConfiguration", "kubevirt.io/api/core/v1.ChangedBlockTrackingSelectors", "kubevirt.io/api/core/v1.CommonInstancetypesDeployment", "kubevirt.io/api/core/v1.DeveloperConfiguration", "kubevirt.io/api/core/v1.GuestCrashNotificationsConfiguration", "kubevirt.io/api/core/v1.GuestTimeConfiguration", "kubevirt.io/api/core/v1.HostBlockDevicesConfiguration", "kubevirt.io/api/core/v1.HostSocketDirectoriesConfiguration", "kubevirt.io/api/core/v1.InstancetypeConfiguration", "kubevirt.io/api/core/v1.KSMConfiguration", "kubevirt.io/api/core/v1.LiveUpdateConfiguration", "kubevirt.io/api/core/v1.MediatedDevicesConfiguration", "kubevirt.io/api/core/v1.MigrationConfiguration", "kubevirt.io/api/core/v1.NetworkConfiguration", "kubevirt.io/api/core/v1.NodeGuardrailsConfiguration", "kubevirt.io/api/core/v1.PermittedHostDevices", "kubevirt.io/api/core/v1.PersistentReservationConfiguration", "kubevirt.io/api/core/v1.ReloadableComponentConfiguration", "kubevirt.io/api/core/v1.SMBiosConfiguration", "kubevirt.io/api/core/v1.SeccompConfiguration", "kubevirt.io/api/core/v1.SupportContainerResources", "kubevirt.io/api/core/v1.TLSConfiguration", "kubevirt.io/api/core/v1.VirtualMachineOptions"},
 	}
 }
 
@@ -23259,6 +24461,13 @@ func schema_kubevirtio_api_core_v1_LunTarget(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"scsiController": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SCSIController is the index of the SCSI controller the LUN is attached to. Only valid for the scsi bus and lower than scsiControllerCount. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 			},
 		},
@@ -23285,6 +24494,83 @@ func schema_kubevirtio_api_core_v1_Machine(ref common.ReferenceCallback) common.
 	}
 }
 
+func schema_kubevirtio_api_core_v1_MaintenanceSnapshotPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceSnapshotPolicy defines how maintenance snapshots are kept. A maintenance snapshot is taken from the running VM before a risky change is applied, and can be restored if the change fails.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"successWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuccessWindow is how long the VM has to stay ready after the snapshot has been taken before the snapshot is garbage collected. Defaults to 24h.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_MasqueradeIPAMConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MasqueradeIPAMConfiguration configures the IPAM plugin allocating guest subnets of masquerade interfaces.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"plugin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plugin is the name of the IPAM plugin allocating the guest subnets. Supported values: \"pool\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ipv4Pool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPv4Pool is the CIDR guest IPv4 subnets are allocated from.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ipv6Pool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPv6Pool is the CIDR guest IPv6 subnets are allocated from.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"excludedCIDRs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ExcludedCIDRs are networks guests have to reach, e.g. corporate networks. Allocated guest subnets never overlap them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"plugin"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_MediatedDevicesConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -23416,11 +24702,17 @@ func schema_kubevirtio_api_core_v1_Memory(ref common.ReferenceCallback) common.O
 							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
+					"hotplug": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hotplug configures how the memory between Guest and MaxGuest is hot(un)plugged.",
+							Ref:         ref("kubevirt.io/api/core/v1.MemoryHotplug"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/core/v1.Hugepages"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/core/v1.Hugepages", "kubevirt.io/api/core/v1.MemoryHotplug"},
 	}
 }
 
@@ -23452,6 +24744,13 @@ func schema_kubevirtio_api_core_v1_MemoryDumpVolumeSource(ref common.ReferenceCa
 							Format:      "",
 						},
 					},
+					"encryptionPublicKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EncryptionPublicKey is a PEM encoded RSA public key. When set, the memory dump is encrypted with it before being written to the pvc.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"claimName"},
 			},
@@ -23459,6 +24758,27 @@ func schema_kubevirtio_api_core_v1_MemoryDumpVolumeSource(ref common.ReferenceCa
 	}
 }
 
+func schema_kubevirtio_api_core_v1_MemoryHotplug(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MemoryHotplug configures how memory is hot(un)plugged into the guest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"virtioMem": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtioMem plugs the memory through a virtio-mem device which is created when the VirtualMachineInstance starts. The guest memory can then be grown and shrunk in blocks of BlockSize.",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtioMemHotplug"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.VirtioMemHotplug"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_MemoryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -23707,11 +25027,47 @@ func schema_kubevirtio_api_core_v1_NUMA(ref common.ReferenceCallback) common.Ope
 							Ref:         ref("kubevirt.io/api/core/v1.NUMAGuestMappingPassthrough"),
 						},
 					},
+					"manual": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Manual defines the guest numa topology explicitly, independently of the host numa topology. It is mutually exclusive with GuestMappingPassthrough.",
+							Ref:         ref("kubevirt.io/api/core/v1.NUMAManualTopology"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.NUMAGuestMappingPassthrough"},
+			"kubevirt.io/api/core/v1.NUMAGuestMappingPassthrough", "kubevirt.io/api/core/v1.NUMAManualTopology"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_NUMADistance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NUMADistance is the distance from a guest numa node to another one.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"node": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Node is the id of the other numa node.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value of the distance, between 10 and 255.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"node", "value"},
+			},
+		},
 	}
 }
 
@@ -23726,6 +25082,90 @@ func schema_kubevirtio_api_core_v1_NUMAGuestMappingPassthrough(ref common.Refere
 	}
 }
 
+func schema_kubevirtio_api_core_v1_NUMAManualTopology(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NUMAManualTopology describes the numa nodes exposed to the guest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes of the guest numa topology. The index of a node in the list is its numa node id.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.NUMANode"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"nodes"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.NUMANode"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_NUMANode(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NUMANode describes a guest numa node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cpus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUs is the list of vCPUs belonging to the node, e.g. \"0-3,8\". Every vCPU must belong to exactly one node.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory of the node. The memory of all the nodes must add up to the guest memory.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"distances": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Distances from this node to the other nodes. An unset distance is the distance set from the other node back to this one, or defaults to 10 to the node itself and to 20 to the other nodes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.NUMADistance"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"cpus", "memory"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/core/v1.NUMADistance"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_Network(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -23800,11 +25240,17 @@ func schema_kubevirtio_api_core_v1_NetworkConfiguration(ref common.ReferenceCall
 							},
 						},
 					},
+					"masqueradeIPAM": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MasqueradeIPAM allocates the guest subnet of masquerade interfaces on the pod network, when vmNetworkCIDR or vmIPv6NetworkCIDR is not set on the VMI.",
+							Ref:         ref("kubevirt.io/api/core/v1.MasqueradeIPAMConfiguration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.InterfaceBindingPlugin"},
+			"kubevirt.io/api/core/v1.InterfaceBindingPlugin", "kubevirt.io/api/core/v1.MasqueradeIPAMConfiguration"},
 	}
 }
 
@@ -23843,6 +25289,33 @@ func schema_kubevirtio_api_core_v1_NoCloudSSHPublicKeyAccessCredentialPropagatio
 	}
 }
 
+func schema_kubevirtio_api_core_v1_NodeGuardrailsConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeGuardrailsConfiguration holds the per-node policies checked by virt-handler before a VirtualMachineInstance is started. When set, VirtualMachineInstances with dedicated CPUs are also refused if their cpuset cannot hold the vCPUs together with the isolated emulator thread and IOThreads.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxVirtualMachineInstances": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxVirtualMachineInstances is the maximum number of running VirtualMachineInstances per node. Unlimited if not set.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"minHugepagesLocalityPercent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinHugepagesLocalityPercent is the minimal percentage of the hugepages backing a VirtualMachineInstance that has to be available on a single host NUMA node. VirtualMachineInstances whose hugepages would be fragmented across NUMA nodes beyond this threshold are refused. Disabled if not set.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_NodeMediatedDeviceTypesConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24092,6 +25565,17 @@ func schema_kubevirtio_api_core_v1_PITTimer(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_kubevirtio_api_core_v1_PTPClock(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PTPClock represents the virtual PTP hardware clock passed from host",
+				Type:        []string{"object"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_PanicDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24100,7 +25584,7 @@ func schema_kubevirtio_api_core_v1_PanicDevice(ref common.ReferenceCallback) com
 				Properties: map[string]spec.Schema{
 					"model": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Model specifies what type of panic device is provided. The panic model used when this attribute is missing depends on the hypervisor and guest arch. One of: isa, hyperv, pvpanic.",
+							Description: "Model specifies what type of panic device is provided. The panic model used when this attribute is missing depends on the hypervisor and guest arch. One of: isa, hyperv, pvpanic, pvpanic-pci.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -24256,11 +25740,87 @@ func schema_kubevirtio_api_core_v1_PermittedHostDevices(ref common.ReferenceCall
 							},
 						},
 					},
+					"inputDevices": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.InputHostDevice"),
+									},
+								},
+							},
+						},
+					},
+					"vdpaDevices": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VDPAHostDevice"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.MediatedHostDevice", "kubevirt.io/api/core/v1.PciHostDevice", "kubevirt.io/api/core/v1.USBHostDevice"},
+			"kubevirt.io/api/core/v1.InputHostDevice", "kubevirt.io/api/core/v1.MediatedHostDevice", "kubevirt.io/api/core/v1.PciHostDevice", "kubevirt.io/api/core/v1.USBHostDevice", "kubevirt.io/api/core/v1.VDPAHostDevice"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_PersistentReservationConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PersistentReservationConfiguration holds the configuration of the pr-helper daemon.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"socketPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SocketPath is the absolute path of the pr-helper socket on the nodes. Defaults to /var/run/kubevirt/daemons/pr/pr-helper.sock",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"storageClasses": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageClasses restricts the persistent reservations to LUNs backed by a PVC of one of the storage classes. Reservations are allowed for any storage class when empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -24971,6 +26531,62 @@ func schema_kubevirtio_api_core_v1_RestartOptions(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_RevertOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RevertOptions are provided on revert request.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dryRun": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "When present, indicates that modifications should not be persisted. An invalid or unrecognized dryRun directive will result in an error response and no further processing of the request. Valid values are: - All: all dry run stages will be processed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"virtualMachineSnapshotName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineSnapshotName is the name of the snapshot of the VirtualMachine to revert to",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"virtualMachineSnapshotName"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_Rng(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -25225,6 +26841,28 @@ func schema_kubevirtio_api_core_v1_SEVSessionOptions(ref common.ReferenceCallbac
 	}
 }
 
+func schema_kubevirtio_api_core_v1_SGX(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SGX represents the Intel SGX enclave page cache (EPC) of the guest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"epcSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EPCSize is the size of the enclave page cache of the guest, it is rounded up to a multiple of 1Mi. It is allocated from the EPC of the node, which is exposed as the devices.kubevirt.io/sgx-epc resource.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+				Required: []string{"epcSize"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_SMBiosConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -25426,6 +27064,28 @@ func schema_kubevirtio_api_core_v1_SecretVolumeSource(ref common.ReferenceCallba
 	}
 }
 
+func schema_kubevirtio_api_core_v1_Serial(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Serial represents an additional serial port of the vmi, backed by a pty in the virt-launcher pod.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the serial port. Its output is logged to /var/run/kubevirt-private/<vmi uid>/virt-serial-<name>-log in the virt-launcher pod.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_ServiceAccountVolumeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -25446,6 +27106,71 @@ func schema_kubevirtio_api_core_v1_ServiceAccountVolumeSource(ref common.Referen
 	}
 }
 
+func schema_kubevirtio_api_core_v1_ShutdownLadder(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShutdownLadder escalates the graceful shutdown of a VirtualMachineInstance through a list of steps.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"steps": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Steps are tried in order, each one for its own timeout, before escalating to the next one. The domain is destroyed once the last step timed out.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.ShutdownStep"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"steps"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.ShutdownStep"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_ShutdownStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShutdownStep is one step of a ShutdownLadder.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method used to ask the guest to power off, one of GuestAgent or ACPI.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeoutSeconds is the time given to the guest to power off before escalating to the next step.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"method", "timeoutSeconds"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_SoundDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -25769,9 +27494,46 @@ func schema_kubevirtio_api_core_v1_TPMDevice(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the TPM specification implemented by the vTPM. One of: 1.2, 2.0. Defaults to 2.0.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"external": {
+						SchemaProps: spec.SchemaProps{
+							Description: "External connects the vTPM to an externally managed swtpm listening on a unix socket on the node, e.g. for clusters with a centralized vTPM key management, instead of a swtpm spawned by virt-launcher. The version is then set by the external swtpm. It cannot be combined with persistent.",
+							Ref:         ref("kubevirt.io/api/core/v1.TPMExternal"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.TPMExternal"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_TPMExternal(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TPMExternal represents an externally managed swtpm listening on a unix socket on the node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"socketPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SocketPath is the absolute path of the unix socket of the swtpm on the node. It has to be in the directory of the namespace of the VMI in a permitted directory, like /var/run/vtpm/<namespace>/vm.sock. The directory of the socket is mounted into the virt-launcher pod.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"socketPath"},
+			},
+		},
 	}
 }
 
@@ -25923,16 +27685,25 @@ func schema_kubevirtio_api_core_v1_USBSelector(ref common.ReferenceCallback) com
 				Properties: map[string]spec.Schema{
 					"vendor": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "The vendor ID of the USB device, in hexadecimal. \"*\" matches any vendor.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"product": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "The product ID of the USB device, in hexadecimal. \"*\" matches any product.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"class": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The class code of the USB device, or of one of its interfaces, in hexadecimal. e.g: 0b for smart card readers. Any class matches when not set.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
@@ -26104,6 +27875,43 @@ func schema_kubevirtio_api_core_v1_UtilityVolume(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VDPAHostDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VDPAHostDevice represents the host vDPA devices allowed to back network interfaces, e.g. the vDPA devices created on the VFs of a NIC. The vDPA devices must be bound to the vhost_vdpa driver. They are allocated by the PCI address of their parent device, as SR-IOV VFs are.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pciVendorSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The vendor_id:product_id tuple of the PCI device the vDPA devices are created on",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the resource that is representing the devices. Exposed by a device plugin and requested by networks. e.g: kubevirt.io/vdpa-mlx5",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"externalResourceProvider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If true, KubeVirt will leave the allocation and monitoring to an external device plugin",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"pciVendorSelector", "resourceName"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VGPUDisplayOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26197,6 +28005,50 @@ func schema_kubevirtio_api_core_v1_VSOCKOptions(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VhostUserBlkVolumeInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VhostUserBlkVolumeInfo shows info about a vhost-user-blk volume",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"socketPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SocketPath is the path of the unix socket of the backend inside the virt-launcher pod",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"socketPath"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VhostUserBlkVolumeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VhostUserBlkVolumeSource represents a vhost-user-blk backend listening on a unix socket on the node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path of the unix socket of the vhost-user-blk backend on the node. It has to be in the directory of the namespace of the VMI in a permitted directory, like /var/tmp/spdk/<namespace>/vhost.0.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"path"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VideoDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26210,9 +28062,51 @@ func schema_kubevirtio_api_core_v1_VideoDevice(ref common.ReferenceCallback) com
 							Format:      "",
 						},
 					},
+					"heads": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Heads is the number of display heads of the video device. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"vram": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VRAM is the size of the video memory of the video device. Defaults to 16Mi.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"accel3d": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Accel3D enables the 3D acceleration of a virtio video device, either with virgl (OpenGL) or with venus (Vulkan). It requires a render node of a host GPU.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtioMemHotplug(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"blockSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BlockSize is the granularity in which memory is plugged and unplugged. It must be a power of 2 and at least 2Mi, or 1Gi when 1Gi hugepages are used. Defaults to the smallest allowed value.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -26415,6 +28309,36 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceBackupStatus(ref common
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceCPUStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceCPUStats is the CPU usage of a VirtualMachineInstance",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"vcpus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VCPUs is the number of vCPUs of the VirtualMachineInstance",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"usageMillicores": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UsageMillicores is the CPU time used by the VirtualMachineInstance, in thousandths of a CPU",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"vcpus", "usageMillicores"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineInstanceCommonMigrationState(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26541,6 +28465,56 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceCondition(ref common.Re
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceDiskStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceDiskStats is the throughput of a disk of a VirtualMachineInstance",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the volume of the disk",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"readBytesPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"writeBytesPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"readIOPS": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"writeIOPS": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+				},
+				Required: []string{"name", "readBytesPerSecond", "writeBytesPerSecond", "readIOPS", "writeIOPS"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystem(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26965,6 +28939,56 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSUserList(ref com
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceInterfaceStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceInterfaceStats is the throughput of a network interface of a VirtualMachineInstance",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the network interface",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rxBytesPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"txBytesPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"rxPacketsPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"txPacketsPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+				},
+				Required: []string{"name", "rxBytesPerSecond", "txBytesPerSecond", "rxPacketsPerSecond", "txPacketsPerSecond"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineInstanceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -27014,6 +29038,40 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceList(ref common.Referen
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMemoryStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceMemoryStats is the memory usage of a VirtualMachineInstance",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rssBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RSSBytes is the resident set size of the VirtualMachineInstance on the node",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"guestUsedBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestUsedBytes is the memory used by the guest, as reported by the balloon driver",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"guestTotalBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestTotalBytes is the memory usable by the guest, as reported by the balloon driver",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -28430,6 +30488,12 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceSpec(ref common.Referen
 							Format:      "int64",
 						},
 					},
+					"shutdownLadder": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShutdownLadder escalates the graceful shutdown through steps with their own timeouts before the VirtualMachineInstance is force terminated. TerminationGracePeriodSeconds defaults to the sum of the step timeouts when it is not set.",
+							Ref:         ref("kubevirt.io/api/core/v1.ShutdownLadder"),
+						},
+					},
 					"volumes": {
 						SchemaProps: spec.SchemaProps{
 							Description: "List of volumes that can be mounted by disks belonging to the vmi.",
@@ -28517,6 +30581,12 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceSpec(ref common.Referen
 							},
 						},
 					},
+					"guestAgent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestAgent restricts the qemu-guest-agent commands KubeVirt sends to the guest.",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestAgent"),
+						},
+					},
 					"architecture": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Specifies the architecture of the vm guest you are attempting to run. Defaults to the compiled architecture of the KubeVirt components",
@@ -28573,7 +30643,101 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceSpec(ref common.Referen
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodResourceClaim", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.TopologySpreadConstraint", "kubevirt.io/api/core/v1.AccessCredential", "kubevirt.io/api/core/v1.DomainSpec", "kubevirt.io/api/core/v1.Network", "kubevirt.io/api/core/v1.Probe", "kubevirt.io/api/core/v1.UtilityVolume", "kubevirt.io/api/core/v1.Volume"},
+			"k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodResourceClaim", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.TopologySpreadConstraint", "kubevirt.io/api/core/v1.AccessCredential", "kubevirt.io/api/core/v1.DomainSpec", "kubevirt.io/api/core/v1.GuestAgent", "kubevirt.io/api/core/v1.Network", "kubevirt.io/api/core/v1.Probe", "kubevirt.io/api/core/v1.ShutdownLadder", "kubevirt.io/api/core/v1.UtilityVolume", "kubevirt.io/api/core/v1.Volume"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceStats is the resource usage of a VirtualMachineInstance, sampled by virt-handler",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timestamp is the time the usage was sampled at",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"window": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Window is the interval the rates were measured over",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU is the CPU usage of the VirtualMachineInstance",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceCPUStats"),
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory is the memory usage of the VirtualMachineInstance",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceMemoryStats"),
+						},
+					},
+					"disks": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Disks is the throughput of the disks of the VirtualMachineInstance",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineInstanceDiskStats"),
+									},
+								},
+							},
+						},
+					},
+					"interfaces": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Interfaces is the throughput of the network interfaces of the VirtualMachineInstance",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineInstanceInterfaceStats"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"timestamp", "window", "cpu", "memory"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.VirtualMachineInstanceCPUStats", "kubevirt.io/api/core/v1.VirtualMachineInstanceDiskStats", "kubevirt.io/api/core/v1.VirtualMachineInstanceInterfaceStats", "kubevirt.io/api/core/v1.VirtualMachineInstanceMemoryStats"},
 	}
 }
 
@@ -28795,6 +30959,13 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref common.Refer
 							Ref:         ref("kubevirt.io/api/core/v1.CPUTopology"),
 						},
 					},
+					"currentIOThreadCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentIOThreadCount specifies the number of iothreads currently allocated to the VM workload. It may differ from the supplemental pool thread count in the spec while iothreads are added or removed.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 					"memory": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Memory shows various informations about the VirtualMachine memory.",
@@ -28832,6 +31003,13 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref common.Refer
 							Ref:         ref("kubevirt.io/api/core/v1.ChangedBlockTrackingStatus"),
 						},
 					},
+					"shutdownMethod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShutdownMethod records the step of the shutdown ladder which brought the guest down.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -28971,6 +31149,13 @@ func schema_kubevirtio_api_core_v1_VirtualMachineMemoryDumpRequest(ref common.Re
 							Format:      "",
 						},
 					},
+					"encryptionPublicKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EncryptionPublicKey is a PEM encoded RSA public key. When set, the memory dump is encrypted with it before being written to the pvc.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"claimName", "phase"},
 			},
@@ -29067,12 +31252,24 @@ func schema_kubevirtio_api_core_v1_VirtualMachineSpec(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
+					"cpuBurst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUBurst raises the CPU limit of the virt-launcher pod during daily windows, e.g. while backup jobs run in the guest.",
+							Ref:         ref("kubevirt.io/api/core/v1.CPUBurst"),
+						},
+					},
+					"maintenanceSnapshot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceSnapshot enables taking a VirtualMachineSnapshot before CPU, memory or interface hotplug and before firmware changes are applied to the running VM.",
+							Ref:         ref("kubevirt.io/api/core/v1.MaintenanceSnapshotPolicy"),
+						},
+					},
 				},
 				Required: []string{"template"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.DataVolumeTemplateSpec", "kubevirt.io/api/core/v1.InstancetypeMatcher", "kubevirt.io/api/core/v1.PreferenceMatcher", "kubevirt.io/api/core/v1.VirtualMachineInstanceTemplateSpec"},
+			"kubevirt.io/api/core/v1.CPUBurst", "kubevirt.io/api/core/v1.DataVolumeTemplateSpec", "kubevirt.io/api/core/v1.InstancetypeMatcher", "kubevirt.io/api/core/v1.MaintenanceSnapshotPolicy", "kubevirt.io/api/core/v1.PreferenceMatcher", "kubevirt.io/api/core/v1.VirtualMachineInstanceTemplateSpec"},
 	}
 }
 
@@ -29451,12 +31648,105 @@ func schema_kubevirtio_api_core_v1_Volume(ref common.ReferenceCallback) common.O
 							Ref:         ref("kubevirt.io/api/core/v1.MemoryDumpVolumeSource"),
 						},
 					},
+					"vhostUserBlk": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VhostUserBlk represents a block device served by a vhost-user-blk backend, such as SPDK, listening on a unix socket on the node.",
+							Ref:         ref("kubevirt.io/api/core/v1.VhostUserBlkVolumeSource"),
+						},
+					},
+					"hostBlockDevice": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostBlockDevice passes a raw block device of the node straight through to the vmi as a virtio disk. The path has to be permitted in the hostBlockDevices configuration of KubeVirt and the device is used exclusively by the vmi.",
+							Ref:         ref("kubevirt.io/api/core/v1.HostBlockDeviceVolumeSource"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.CloudInitConfigDriveSource", "kubevirt.io/api/core/v1.CloudInitNoCloudSource", "kubevirt.io/api/core/v1.ConfigMapVolumeSource", "kubevirt.io/api/core/v1.ContainerDiskSource", "kubevirt.io/api/core/v1.DataVolumeSource", "kubevirt.io/api/core/v1.DownwardAPIVolumeSource", "kubevirt.io/api/core/v1.DownwardMetricsVolumeSource", "kubevirt.io/api/core/v1.EmptyDiskSource", "kubevirt.io/api/core/v1.EphemeralVolumeSource", "kubevirt.io/api/core/v1.HostDisk", "kubevirt.io/api/core/v1.MemoryDumpVolumeSource", "kubevirt.io/api/core/v1.PersistentVolumeClaimVolumeSource", "kubevirt.io/api/core/v1.SecretVolumeSource", "kubevirt.io/api/core/v1.ServiceAccountVolumeSource", "kubevirt.io/api/core/v1.SysprepSource"},
+			"kubevirt.io/api/core/v1.CloudInitConfigDriveSource", "kubevirt.io/api/core/v1.CloudInitNoCloudSource", "kubevirt.io/api/core/v1.ConfigMapVolumeSource", "kubevirt.io/api/core/v1.ContainerDiskSource", "kubevirt.io/api/core/v1.DataVolumeSource", "kubevirt.io/api/core/v1.DownwardAPIVolumeSource", "kubevirt.io/api/core/v1.DownwardMetricsVolumeSource", "kubevirt.io/api/core/v1.EmptyDiskSource", "kubevirt.io/api/core/v1.EphemeralVolumeSource", "kubevirt.io/api/core/v1.HostBlockDeviceVolumeSource", "kubevirt.io/api/core/v1.HostDisk", "kubevirt.io/api/core/v1.MemoryDumpVolumeSource", "kubevirt.io/api/core/v1.PersistentVolumeClaimVolumeSource", "kubevirt.io/api/core/v1.SecretVolumeSource", "kubevirt.io/api/core/v1.ServiceAccountVolumeSource", "kubevirt.io/api/core/v1.SysprepSource", "kubevirt.io/api/core/v1.VhostUserBlkVolumeSource"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VolumeBlockJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeBlockJob shows the progress of a long running block job on a volume",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the block job, one of: Pull, Copy, Commit, ActiveCommit, Backup",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"progress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Progress is the percentage of the volume data already processed by the job",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"bytesPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BytesPerSecond is the throughput of the job, measured between its last two samples",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"remainingSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemainingSeconds is the estimated time until the job completes at its current throughput",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"type", "progress"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VolumeIOErrors(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeIOErrors shows the IO errors QEMU reported on a volume since the domain started on its current node",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the number of IO errors reported on the volume",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError is the reason of the last IO error, eg: enospc",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastErrorTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastErrorTime is the time the last IO error was reported",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"count"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -29626,11 +31916,23 @@ func schema_kubevirtio_api_core_v1_VolumeSource(ref common.ReferenceCallback) co
 							Ref:         ref("kubevirt.io/api/core/v1.MemoryDumpVolumeSource"),
 						},
 					},
+					"vhostUserBlk": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VhostUserBlk represents a block device served by a vhost-user-blk backend, such as SPDK, listening on a unix socket on the node.",
+							Ref:         ref("kubevirt.io/api/core/v1.VhostUserBlkVolumeSource"),
+						},
+					},
+					"hostBlockDevice": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostBlockDevice passes a raw block device of the node straight through to the vmi as a virtio disk. The path has to be permitted in the hostBlockDevices configuration of KubeVirt and the device is used exclusively by the vmi.",
+							Ref:         ref("kubevirt.io/api/core/v1.HostBlockDeviceVolumeSource"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.CloudInitConfigDriveSource", "kubevirt.io/api/core/v1.CloudInitNoCloudSource", "kubevirt.io/api/core/v1.ConfigMapVolumeSource", "kubevirt.io/api/core/v1.ContainerDiskSource", "kubevirt.io/api/core/v1.DataVolumeSource", "kubevirt.io/api/core/v1.DownwardAPIVolumeSource", "kubevirt.io/api/core/v1.DownwardMetricsVolumeSource", "kubevirt.io/api/core/v1.EmptyDiskSource", "kubevirt.io/api/core/v1.EphemeralVolumeSource", "kubevirt.io/api/core/v1.HostDisk", "kubevirt.io/api/core/v1.MemoryDumpVolumeSource", "kubevirt.io/api/core/v1.PersistentVolumeClaimVolumeSource", "kubevirt.io/api/core/v1.SecretVolumeSource", "kubevirt.io/api/core/v1.ServiceAccountVolumeSource", "kubevirt.io/api/core/v1.SysprepSource"},
+			"kubevirt.io/api/core/v1.CloudInitConfigDriveSource", "kubevirt.io/api/core/v1.CloudInitNoCloudSource", "kubevirt.io/api/core/v1.ConfigMapVolumeSource", "kubevirt.io/api/core/v1.ContainerDiskSource", "kubevirt.io/api/core/v1.DataVolumeSource", "kubevirt.io/api/core/v1.DownwardAPIVolumeSource", "kubevirt.io/api/core/v1.DownwardMetricsVolumeSource", "kubevirt.io/api/core/v1.EmptyDiskSource", "kubevirt.io/api/core/v1.EphemeralVolumeSource", "kubevirt.io/api/core/v1.HostBlockDeviceVolumeSource", "kubevirt.io/api/core/v1.HostDisk", "kubevirt.io/api/core/v1.MemoryDumpVolumeSource", "kubevirt.io/api/core/v1.PersistentVolumeClaimVolumeSource", "kubevirt.io/api/core/v1.SecretVolumeSource", "kubevirt.io/api/core/v1.ServiceAccountVolumeSource", "kubevirt.io/api/core/v1.SysprepSource", "kubevirt.io/api/core/v1.VhostUserBlkVolumeSource"},
 	}
 }
 
@@ -29657,6 +31959,13 @@ func schema_kubevirtio_api_core_v1_VolumeStatus(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"serial": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Serial is the serial number the guest sees for the disk of the volume. Hotplugged disks without a serial get one generated from the VMI UID and the volume name.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"phase": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Phase is the phase",
@@ -29709,12 +32018,50 @@ func schema_kubevirtio_api_core_v1_VolumeStatus(ref common.ReferenceCallback) co
 							Ref:         ref("kubevirt.io/api/core/v1.ContainerDiskInfo"),
 						},
 					},
+					"ioErrors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IOErrors shows the IO errors reported on the volume, if any",
+							Ref:         ref("kubevirt.io/api/core/v1.VolumeIOErrors"),
+						},
+					},
+					"vhostUserBlkVolume": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VhostUserBlkVolume shows info about the vhost-user-blk backend, if the volume is a vhost-user-blk volume",
+							Ref:         ref("kubevirt.io/api/core/v1.VhostUserBlkVolumeInfo"),
+						},
+					},
+					"ioThreads": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "IOThreads lists the ids of the iothreads serving the disk of the volume",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int64",
+									},
+								},
+							},
+						},
+					},
+					"blockJob": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BlockJob shows the progress of the block job running on the volume, if any, eg: a storage migration, a snapshot commit or a backup",
+							Ref:         ref("kubevirt.io/api/core/v1.VolumeBlockJob"),
+						},
+					},
 				},
 				Required: []string{"name", "target"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.ContainerDiskInfo", "kubevirt.io/api/core/v1.DomainMemoryDumpInfo", "kubevirt.io/api/core/v1.HotplugVolumeStatus", "kubevirt.io/api/core/v1.PersistentVolumeClaimInfo"},
+			"kubevirt.io/api/core/v1.ContainerDiskInfo", "kubevirt.io/api/core/v1.DomainMemoryDumpInfo", "kubevirt.io/api/core/v1.HotplugVolumeStatus", "kubevirt.io/api/core/v1.PersistentVolumeClaimInfo", "kubevirt.io/api/core/v1.VhostUserBlkVolumeInfo", "kubevirt.io/api/core/v1.VolumeBlockJob", "kubevirt.io/api/core/v1.VolumeIOErrors"},
 	}
 }
 
@@ -30937,6 +33284,26 @@ func schema_kubevirtio_api_instancetype_v1beta1_DevicePreferences(ref common.Ref
 							Format:      "",
 						},
 					},
+					"preferredVirtioTransitionalDevices": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "PreferredVirtioTransitionalDevices optionally defines the preferred value of VirtioTransitionalDevices, e.g. to mark the device classes for which an old guest only has legacy virtio 0.9 drivers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 					"preferredInputBus": {
 						SchemaProps: spec.SchemaProps{
 							Description: "PreferredInputBus optionally defines the preferred bus for Input devices.",
@@ -32127,6 +34494,334 @@ func schema_kubevirtio_api_migrations_v1alpha1_Selectors(ref common.ReferenceCal
 	}
 }
 
+func schema_kubevirtio_api_overcommit_v1alpha1_OvercommitProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OvercommitProfile defines the CPU and memory overcommit applied to the VMIs of a group of namespaces. The ratios replace the cluster wide cpuAllocationRatio and memoryOvercommit when the resources of the virt-launcher pod are computed from the domain resources of a VMI.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/overcommit/v1alpha1.OvercommitProfileSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/overcommit/v1alpha1.OvercommitProfileStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/overcommit/v1alpha1.OvercommitProfileSpec", "kubevirt.io/api/overcommit/v1alpha1.OvercommitProfileStatus"},
+	}
+}
+
+func schema_kubevirtio_api_overcommit_v1alpha1_OvercommitProfileList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OvercommitProfileList is a list of OvercommitProfile",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/overcommit/v1alpha1.OvercommitProfile"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/overcommit/v1alpha1.OvercommitProfile"},
+	}
+}
+
+func schema_kubevirtio_api_overcommit_v1alpha1_OvercommitProfileSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector selects the namespaces the profile applies to by their labels. When several profiles select a namespace, the one with the most labels is applied. Among profiles with as many labels, the first one in lexicographic name order is applied.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"cpuAllocationRatio": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUAllocationRatio is the number of vCPUs sharing one requested CPU. The launcher pod requests 1/CPUAllocationRatio CPU per vCPU.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"memoryOvercommit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryOvercommit is the guest memory in percent of the memory requested by the launcher pod, e.g. 150 requests two thirds of the guest memory.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"namespaceSelector"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_overcommit_v1alpha1_OvercommitProfileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_overcommit_v1alpha1_VirtualizationQuota(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualizationQuota limits the virtualization resources used by the VMIs of its namespace. Unlike a ResourceQuota, which counts the requests of the virt-launcher pods, it counts the resources of the domains, which exceed the pod requests of overcommitted VMIs.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/overcommit/v1alpha1.VirtualizationQuotaSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/overcommit/v1alpha1.VirtualizationQuotaStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/overcommit/v1alpha1.VirtualizationQuotaSpec", "kubevirt.io/api/overcommit/v1alpha1.VirtualizationQuotaStatus"},
+	}
+}
+
+func schema_kubevirtio_api_overcommit_v1alpha1_VirtualizationQuotaList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualizationQuotaList is a list of VirtualizationQuota",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/overcommit/v1alpha1.VirtualizationQuota"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/overcommit/v1alpha1.VirtualizationQuota"},
+	}
+}
+
+func schema_kubevirtio_api_overcommit_v1alpha1_VirtualizationQuotaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hard is the amount of each resource the VMIs of the namespace may use together. Supported resources are vcpus, guestMemory, gpus and hostDevices.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"hard"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_kubevirtio_api_overcommit_v1alpha1_VirtualizationQuotaStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hard is the amount of each resource enforced by the quota.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"used": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Used is the amount of each resource used by the VMIs of the namespace which are not in a final phase.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_kubevirtio_api_pool_v1alpha1_VirtualMachineOpportunisticUpdateStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
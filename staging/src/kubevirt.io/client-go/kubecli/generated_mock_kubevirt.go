@@ -82,17 +82,19 @@ import (
 	rest "k8s.io/client-go/rest"
 	v1alpha18 "kubevirt.io/api/backup/v1alpha1"
 	v122 "kubevirt.io/api/core/v1"
+	v1beta117 "kubevirt.io/api/snapshot/v1beta1"
 	containerizeddataimporter "kubevirt.io/client-go/containerizeddataimporter"
 	externalsnapshotter "kubevirt.io/client-go/externalsnapshotter"
 	kubevirt "kubevirt.io/client-go/kubevirt"
 	v1alpha19 "kubevirt.io/client-go/kubevirt/typed/backup/v1alpha1"
-	v1beta117 "kubevirt.io/client-go/kubevirt/typed/clone/v1beta1"
+	v1beta118 "kubevirt.io/client-go/kubevirt/typed/clone/v1beta1"
 	v123 "kubevirt.io/client-go/kubevirt/typed/core/v1"
-	v1beta118 "kubevirt.io/client-go/kubevirt/typed/export/v1beta1"
-	v1beta119 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1"
+	v1beta119 "kubevirt.io/client-go/kubevirt/typed/export/v1beta1"
+	v1beta120 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1"
 	v1alpha110 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1"
-	v1beta120 "kubevirt.io/client-go/kubevirt/typed/pool/v1beta1"
-	v1beta121 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1beta1"
+	v1alpha111 "kubevirt.io/client-go/kubevirt/typed/overcommit/v1alpha1"
+	v1beta121 "kubevirt.io/client-go/kubevirt/typed/pool/v1beta1"
+	v1beta122 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1beta1"
 	networkattachmentdefinitionclient "kubevirt.io/client-go/networkattachmentdefinitionclient"
 	prometheusoperator "kubevirt.io/client-go/prometheusoperator"
 	version "kubevirt.io/client-go/version"
@@ -878,6 +880,20 @@ func (mr *MockKubevirtClientMockRecorder) NodeV1beta1() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeV1beta1", reflect.TypeOf((*MockKubevirtClient)(nil).NodeV1beta1))
 }
 
+// OvercommitProfile mocks base method.
+func (m *MockKubevirtClient) OvercommitProfile() v1alpha111.OvercommitProfileInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OvercommitProfile")
+	ret0, _ := ret[0].(v1alpha111.OvercommitProfileInterface)
+	return ret0
+}
+
+// OvercommitProfile indicates an expected call of OvercommitProfile.
+func (mr *MockKubevirtClientMockRecorder) OvercommitProfile() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OvercommitProfile", reflect.TypeOf((*MockKubevirtClient)(nil).OvercommitProfile))
+}
+
 // PolicyV1 mocks base method.
 func (m *MockKubevirtClient) PolicyV1() v117.PolicyV1Interface {
 	m.ctrl.T.Helper()
@@ -1230,10 +1246,10 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineBackup(namespace any) *g
 }
 
 // VirtualMachineClone mocks base method.
-func (m *MockKubevirtClient) VirtualMachineClone(namespace string) v1beta117.VirtualMachineCloneInterface {
+func (m *MockKubevirtClient) VirtualMachineClone(namespace string) v1beta118.VirtualMachineCloneInterface {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "VirtualMachineClone", namespace)
-	ret0, _ := ret[0].(v1beta117.VirtualMachineCloneInterface)
+	ret0, _ := ret[0].(v1beta118.VirtualMachineCloneInterface)
 	return ret0
 }
 
@@ -1244,10 +1260,10 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineClone(namespace any) *go
 }
 
 // VirtualMachineClusterInstancetype mocks base method.
-func (m *MockKubevirtClient) VirtualMachineClusterInstancetype() v1beta119.VirtualMachineClusterInstancetypeInterface {
+func (m *MockKubevirtClient) VirtualMachineClusterInstancetype() v1beta120.VirtualMachineClusterInstancetypeInterface {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "VirtualMachineClusterInstancetype")
-	ret0, _ := ret[0].(v1beta119.VirtualMachineClusterInstancetypeInterface)
+	ret0, _ := ret[0].(v1beta120.VirtualMachineClusterInstancetypeInterface)
 	return ret0
 }
 
@@ -1258,10 +1274,10 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineClusterInstancetype() *g
 }
 
 // VirtualMachineClusterPreference mocks base method.
-func (m *MockKubevirtClient) VirtualMachineClusterPreference() v1beta119.VirtualMachineClusterPreferenceInterface {
+func (m *MockKubevirtClient) VirtualMachineClusterPreference() v1beta120.VirtualMachineClusterPreferenceInterface {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "VirtualMachineClusterPreference")
-	ret0, _ := ret[0].(v1beta119.VirtualMachineClusterPreferenceInterface)
+	ret0, _ := ret[0].(v1beta120.VirtualMachineClusterPreferenceInterface)
 	return ret0
 }
 
@@ -1272,10 +1288,10 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineClusterPreference() *gom
 }
 
 // VirtualMachineExport mocks base method.
-func (m *MockKubevirtClient) VirtualMachineExport(namespace string) v1beta118.VirtualMachineExportInterface {
+func (m *MockKubevirtClient) VirtualMachineExport(namespace string) v1beta119.VirtualMachineExportInterface {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "VirtualMachineExport", namespace)
-	ret0, _ := ret[0].(v1beta118.VirtualMachineExportInterface)
+	ret0, _ := ret[0].(v1beta119.VirtualMachineExportInterface)
 	return ret0
 }
 
@@ -1328,10 +1344,10 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineInstancePreset(namespace
 }
 
 // VirtualMachineInstancetype mocks base method.
-func (m *MockKubevirtClient) VirtualMachineInstancetype(namespace string) v1beta119.VirtualMachineInstancetypeInterface {
+func (m *MockKubevirtClient) VirtualMachineInstancetype(namespace string) v1beta120.VirtualMachineInstancetypeInterface {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "VirtualMachineInstancetype", namespace)
-	ret0, _ := ret[0].(v1beta119.VirtualMachineInstancetypeInterface)
+	ret0, _ := ret[0].(v1beta120.VirtualMachineInstancetypeInterface)
 	return ret0
 }
 
@@ -1342,10 +1358,10 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineInstancetype(namespace a
 }
 
 // VirtualMachinePool mocks base method.
-func (m *MockKubevirtClient) VirtualMachinePool(namespace string) v1beta120.VirtualMachinePoolInterface {
+func (m *MockKubevirtClient) VirtualMachinePool(namespace string) v1beta121.VirtualMachinePoolInterface {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "VirtualMachinePool", namespace)
-	ret0, _ := ret[0].(v1beta120.VirtualMachinePoolInterface)
+	ret0, _ := ret[0].(v1beta121.VirtualMachinePoolInterface)
 	return ret0
 }
 
@@ -1356,10 +1372,10 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachinePool(namespace any) *gom
 }
 
 // VirtualMachinePreference mocks base method.
-func (m *MockKubevirtClient) VirtualMachinePreference(namespace string) v1beta119.VirtualMachinePreferenceInterface {
+func (m *MockKubevirtClient) VirtualMachinePreference(namespace string) v1beta120.VirtualMachinePreferenceInterface {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "VirtualMachinePreference", namespace)
-	ret0, _ := ret[0].(v1beta119.VirtualMachinePreferenceInterface)
+	ret0, _ := ret[0].(v1beta120.VirtualMachinePreferenceInterface)
 	return ret0
 }
 
@@ -1370,10 +1386,10 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachinePreference(namespace any
 }
 
 // VirtualMachineRestore mocks base method.
-func (m *MockKubevirtClient) VirtualMachineRestore(namespace string) v1beta121.VirtualMachineRestoreInterface {
+func (m *MockKubevirtClient) VirtualMachineRestore(namespace string) v1beta122.VirtualMachineRestoreInterface {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "VirtualMachineRestore", namespace)
-	ret0, _ := ret[0].(v1beta121.VirtualMachineRestoreInterface)
+	ret0, _ := ret[0].(v1beta122.VirtualMachineRestoreInterface)
 	return ret0
 }
 
@@ -1384,10 +1400,10 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineRestore(namespace any) *
 }
 
 // VirtualMachineSnapshot mocks base method.
-func (m *MockKubevirtClient) VirtualMachineSnapshot(namespace string) v1beta121.VirtualMachineSnapshotInterface {
+func (m *MockKubevirtClient) VirtualMachineSnapshot(namespace string) v1beta122.VirtualMachineSnapshotInterface {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "VirtualMachineSnapshot", namespace)
-	ret0, _ := ret[0].(v1beta121.VirtualMachineSnapshotInterface)
+	ret0, _ := ret[0].(v1beta122.VirtualMachineSnapshotInterface)
 	return ret0
 }
 
@@ -1398,10 +1414,10 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineSnapshot(namespace any)
 }
 
 // VirtualMachineSnapshotContent mocks base method.
-func (m *MockKubevirtClient) VirtualMachineSnapshotContent(namespace string) v1beta121.VirtualMachineSnapshotContentInterface {
+func (m *MockKubevirtClient) VirtualMachineSnapshotContent(namespace string) v1beta122.VirtualMachineSnapshotContentInterface {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "VirtualMachineSnapshotContent", namespace)
-	ret0, _ := ret[0].(v1beta121.VirtualMachineSnapshotContentInterface)
+	ret0, _ := ret[0].(v1beta122.VirtualMachineSnapshotContentInterface)
 	return ret0
 }
 
@@ -1411,6 +1427,20 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineSnapshotContent(namespac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineSnapshotContent", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineSnapshotContent), namespace)
 }
 
+// VirtualizationQuota mocks base method.
+func (m *MockKubevirtClient) VirtualizationQuota(namespace string) v1alpha111.VirtualizationQuotaInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualizationQuota", namespace)
+	ret0, _ := ret[0].(v1alpha111.VirtualizationQuotaInterface)
+	return ret0
+}
+
+// VirtualizationQuota indicates an expected call of VirtualizationQuota.
+func (mr *MockKubevirtClientMockRecorder) VirtualizationQuota(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualizationQuota", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualizationQuota), namespace)
+}
+
 // MockVirtualMachineInstanceInterface is a mock of VirtualMachineInstanceInterface interface.
 type MockVirtualMachineInstanceInterface struct {
 	ctrl     *gomock.Controller
@@ -1463,6 +1493,20 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Backup(ctx, name, bac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Backup), ctx, name, backupOptions)
 }
 
+// ChangeMedia mocks base method.
+func (m *MockVirtualMachineInstanceInterface) ChangeMedia(ctx context.Context, name string, changeMediaOptions *v122.ChangeMediaOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeMedia", ctx, name, changeMediaOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeMedia indicates an expected call of ChangeMedia.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) ChangeMedia(ctx, name, changeMediaOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeMedia", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).ChangeMedia), ctx, name, changeMediaOptions)
+}
+
 // Create mocks base method.
 func (m *MockVirtualMachineInstanceInterface) Create(ctx context.Context, virtualMachineInstance *v122.VirtualMachineInstance, opts v12.CreateOptions) (*v122.VirtualMachineInstance, error) {
 	m.ctrl.T.Helper()
@@ -1788,6 +1832,21 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) SoftReboot(ctx, name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftReboot", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).SoftReboot), ctx, name)
 }
 
+// Stats mocks base method.
+func (m *MockVirtualMachineInstanceInterface) Stats(ctx context.Context, name string) (v122.VirtualMachineInstanceStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats", ctx, name)
+	ret0, _ := ret[0].(v122.VirtualMachineInstanceStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Stats(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Stats), ctx, name)
+}
+
 // USBRedir mocks base method.
 func (m *MockVirtualMachineInstanceInterface) USBRedir(vmiName string) (v123.StreamInterface, error) {
 	m.ctrl.T.Helper()
@@ -2535,6 +2594,21 @@ func (mr *MockVirtualMachineInterfaceMockRecorder) Restart(ctx, name, restartOpt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restart", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Restart), ctx, name, restartOptions)
 }
 
+// Revert mocks base method.
+func (m *MockVirtualMachineInterface) Revert(ctx context.Context, name string, revertOptions *v122.RevertOptions) (*v1beta117.VirtualMachineRestore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revert", ctx, name, revertOptions)
+	ret0, _ := ret[0].(*v1beta117.VirtualMachineRestore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revert indicates an expected call of Revert.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Revert(ctx, name, revertOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revert", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Revert), ctx, name, revertOptions)
+}
+
 // Start mocks base method.
 func (m *MockVirtualMachineInterface) Start(ctx context.Context, name string, startOptions *v122.StartOptions) error {
 	m.ctrl.T.Helper()
@@ -48,6 +48,7 @@ import (
 	exportv1 "kubevirt.io/client-go/kubevirt/typed/export/v1beta1"
 	instancetypev1beta1 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1"
 	migrationsv1 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1"
+	overcommitv1 "kubevirt.io/client-go/kubevirt/typed/overcommit/v1alpha1"
 	poolv1 "kubevirt.io/client-go/kubevirt/typed/pool/v1beta1"
 	snapshotv1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1beta1"
 	networkclient "kubevirt.io/client-go/networkattachmentdefinitionclient"
@@ -73,6 +74,8 @@ type KubevirtClient interface {
 	VirtualMachinePreference(namespace string) instancetypev1beta1.VirtualMachinePreferenceInterface
 	VirtualMachineClusterPreference() instancetypev1beta1.VirtualMachineClusterPreferenceInterface
 	MigrationPolicy() migrationsv1.MigrationPolicyInterface
+	OvercommitProfile() overcommitv1.OvercommitProfileInterface
+	VirtualizationQuota(namespace string) overcommitv1.VirtualizationQuotaInterface
 	ExpandSpec(namespace string) ExpandSpecInterface
 	ServerVersion() ServerVersionInterface
 	VirtualMachineClone(namespace string) clone.VirtualMachineCloneInterface
@@ -225,6 +228,14 @@ func (k kubevirtClient) MigrationPolicyClient() *migrationsv1.MigrationsV1alpha1
 	return k.migrationsClient
 }
 
+func (k kubevirtClient) OvercommitProfile() overcommitv1.OvercommitProfileInterface {
+	return k.generatedKubeVirtClient.OvercommitV1alpha1().OvercommitProfiles()
+}
+
+func (k kubevirtClient) VirtualizationQuota(namespace string) overcommitv1.VirtualizationQuotaInterface {
+	return k.generatedKubeVirtClient.OvercommitV1alpha1().VirtualizationQuotas(namespace)
+}
+
 func (k kubevirtClient) VirtualMachineClone(namespace string) clone.VirtualMachineCloneInterface {
 	return k.generatedKubeVirtClient.CloneV1beta1().VirtualMachineClones(namespace)
 }
@@ -449,6 +449,29 @@ var _ = Describe("Kubevirt VirtualMachineInstance Client", func() {
 		Entry("with proxied server URL", proxyPath),
 	)
 
+	DescribeTable("should fetch Stats from VirtualMachineInstance via subresource", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		stats := v1.VirtualMachineInstanceStats{
+			CPU:    v1.VirtualMachineInstanceCPUStats{VCPUs: 2, UsageMillicores: 500},
+			Memory: v1.VirtualMachineInstanceMemoryStats{RSSBytes: 1024},
+			Disks:  []v1.VirtualMachineInstanceDiskStats{{Name: "rootdisk", ReadBytesPerSecond: 2048}},
+		}
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path.Join(proxyPath, subVMIPath, "stats")),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, stats),
+		))
+		fetchedStats, err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).Stats(context.Background(), "testvm")
+
+		Expect(err).ToNot(HaveOccurred(), "should fetch stats normally")
+		Expect(fetchedStats).To(Equal(stats), "fetched stats should be the same as passed in")
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
 	DescribeTable("should fetch SEV platform info via subresource", func(proxyPath string) {
 		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
 		Expect(err).ToNot(HaveOccurred())
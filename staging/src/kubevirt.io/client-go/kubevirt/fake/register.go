@@ -34,6 +34,7 @@ import (
 	exportv1beta1 "kubevirt.io/api/export/v1beta1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
+	overcommitv1alpha1 "kubevirt.io/api/overcommit/v1alpha1"
 	poolv1alpha1 "kubevirt.io/api/pool/v1alpha1"
 	poolv1beta1 "kubevirt.io/api/pool/v1beta1"
 	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
@@ -52,6 +53,7 @@ var localSchemeBuilder = runtime.SchemeBuilder{
 	exportv1beta1.AddToScheme,
 	instancetypev1beta1.AddToScheme,
 	migrationsv1alpha1.AddToScheme,
+	overcommitv1alpha1.AddToScheme,
 	poolv1alpha1.AddToScheme,
 	poolv1beta1.AddToScheme,
 	snapshotv1alpha1.AddToScheme,
@@ -27,6 +27,7 @@ import (
 	"k8s.io/client-go/testing"
 
 	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	kubevirtv1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 	fake2 "kubevirt.io/client-go/testing"
 )
@@ -118,3 +119,13 @@ func (c *fakeVirtualMachines) EvacuateCancel(ctx context.Context, name string, e
 
 	return err
 }
+
+func (c *fakeVirtualMachines) Revert(ctx context.Context, name string, revertOptions *v1.RevertOptions) (*snapshotv1.VirtualMachineRestore, error) {
+	obj, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "revert", name, revertOptions), &snapshotv1.VirtualMachineRestore{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*snapshotv1.VirtualMachineRestore), err
+}
@@ -115,6 +115,13 @@ func (c *fakeVirtualMachineInstances) FilesystemList(ctx context.Context, name s
 	return v1.VirtualMachineInstanceFileSystemList{}, err
 }
 
+func (c *fakeVirtualMachineInstances) Stats(ctx context.Context, name string) (v1.VirtualMachineInstanceStats, error) {
+	_, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(c.Resource(), c.Namespace(), "stats", name), &v1.VirtualMachineInstanceStats{})
+
+	return v1.VirtualMachineInstanceStats{}, err
+}
+
 func (c *fakeVirtualMachineInstances) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "addvolume", name, addVolumeOptions), nil)
@@ -129,6 +136,13 @@ func (c *fakeVirtualMachineInstances) RemoveVolume(ctx context.Context, name str
 	return err
 }
 
+func (c *fakeVirtualMachineInstances) ChangeMedia(ctx context.Context, name string, changeMediaOptions *v1.ChangeMediaOptions) error {
+	_, err := c.Fake.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "changemedia", name, changeMediaOptions), nil)
+
+	return err
+}
+
 func (c *fakeVirtualMachineInstances) VSOCK(name string, options *v1.VSOCKOptions) (kvcorev1.StreamInterface, error) {
 	return nil, nil
 }
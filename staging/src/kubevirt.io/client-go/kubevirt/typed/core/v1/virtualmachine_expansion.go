@@ -27,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 )
 
 const (
@@ -48,6 +49,7 @@ type VirtualMachineExpansion interface {
 	RemoveMemoryDump(ctx context.Context, name string) error
 	ObjectGraph(ctx context.Context, name string, objectGraphOptions *v1.ObjectGraphOptions) (v1.ObjectGraphNode, error)
 	EvacuateCancel(ctx context.Context, name string, evacuateCancelOptions *v1.EvacuateCancelOptions) error
+	Revert(ctx context.Context, name string, revertOptions *v1.RevertOptions) (*snapshotv1.VirtualMachineRestore, error)
 }
 
 func (c *virtualMachines) GetWithExpandedSpec(ctx context.Context, name string) (*v1.VirtualMachine, error) {
@@ -237,3 +239,25 @@ func (c *virtualMachines) EvacuateCancel(ctx context.Context, name string, evacu
 		Do(ctx).
 		Error()
 }
+
+func (c *virtualMachines) Revert(ctx context.Context, name string, revertOptions *v1.RevertOptions) (*snapshotv1.VirtualMachineRestore, error) {
+	body, err := json.Marshal(revertOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	restore := &snapshotv1.VirtualMachineRestore{}
+	err = c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("revert").
+		Body(body).
+		Do(ctx).
+		Into(restore)
+	if err != nil {
+		return nil, err
+	}
+	return restore, nil
+}
@@ -1922,6 +1922,7 @@ var _ = Describe(SIG("Export", func() {
 		Expect(export.Status.Links.Internal).ToNot(BeNil())
 		Expect(getManifestUrl(export.Status.Links.Internal.Manifests, exportv1.AllManifests)).To(Equal(fmt.Sprintf("https://%s.%s.svc/internal/manifests/all", fmt.Sprintf("virt-export-%s", export.Name), export.Namespace)))
 		Expect(getManifestUrl(export.Status.Links.Internal.Manifests, exportv1.AuthHeader)).To(Equal(fmt.Sprintf("https://%s.%s.svc/internal/manifests/secret", fmt.Sprintf("virt-export-%s", export.Name), export.Namespace)))
+		Expect(getManifestUrl(export.Status.Links.Internal.Manifests, exportv1.OVA)).To(Equal(fmt.Sprintf("https://%s.%s.svc/internal/manifests/ova", fmt.Sprintf("virt-export-%s", export.Name), export.Namespace)))
 		Expect(err).ToNot(HaveOccurred())
 		caConfigMap := createCaConfigMapInternal("export-cacerts", vm.Namespace, export)
 		Expect(caConfigMap).ToNot(BeNil())
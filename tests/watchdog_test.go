@@ -43,7 +43,7 @@ var _ = Describe("[sig-compute]Watchdog", decorators.SigCompute, func() {
 
 	Context("A VirtualMachineInstance with a watchdog device", func() {
 
-		It("[test_id:4641]should be shut down when the watchdog expires", decorators.Conformance, decorators.WgS390x, func() {
+		It("[test_id:4641]should be shut down when the watchdog expires", decorators.Conformance, decorators.WgS390x, decorators.WgArm64, func() {
 			vmi := libvmops.RunVMIAndExpectLaunch(
 				libvmifact.NewAlpine(libvmi.WithWatchdog(v1.WatchdogActionPoweroff, libnode.GetArch())), libvmops.StartupTimeoutSecondsXHuge)
 